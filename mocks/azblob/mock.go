@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/pkg/plugins/storage/azblob/iface (interfaces: AzblobServiceUrlIface,AzblobContainerUrlIface,AzblobBlockBlobUrlIface,AzblobDownloadResponse)
+// Source: github.com/nitrictech/nitric/pkg/plugins/storage/azblob/iface (interfaces: AzblobServiceUrlIface,AzblobContainerUrlIface,AzblobBlockBlobUrlIface,AzblobAppendBlobUrlIface,AzblobDownloadResponse)
 
 // Package mock_iface is a generated GoMock package.
 package mock_iface
@@ -105,6 +105,20 @@ func (mr *MockAzblobContainerUrlIfaceMockRecorder) ListBlobsFlatSegment(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobsFlatSegment", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).ListBlobsFlatSegment), arg0, arg1, arg2)
 }
 
+// NewAppendBlobURL mocks base method.
+func (m *MockAzblobContainerUrlIface) NewAppendBlobURL(arg0 string) azblob_service_iface.AzblobAppendBlobUrlIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAppendBlobURL", arg0)
+	ret0, _ := ret[0].(azblob_service_iface.AzblobAppendBlobUrlIface)
+	return ret0
+}
+
+// NewAppendBlobURL indicates an expected call of NewAppendBlobURL.
+func (mr *MockAzblobContainerUrlIfaceMockRecorder) NewAppendBlobURL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAppendBlobURL", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).NewAppendBlobURL), arg0)
+}
+
 // NewBlockBlobURL mocks base method.
 func (m *MockAzblobContainerUrlIface) NewBlockBlobURL(arg0 string) azblob_service_iface.AzblobBlockBlobUrlIface {
 	m.ctrl.T.Helper()
@@ -119,6 +133,20 @@ func (mr *MockAzblobContainerUrlIfaceMockRecorder) NewBlockBlobURL(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBlockBlobURL", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).NewBlockBlobURL), arg0)
 }
 
+// Url mocks base method.
+func (m *MockAzblobContainerUrlIface) Url() url.URL {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Url")
+	ret0, _ := ret[0].(url.URL)
+	return ret0
+}
+
+// Url indicates an expected call of Url.
+func (mr *MockAzblobContainerUrlIfaceMockRecorder) Url() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Url", reflect.TypeOf((*MockAzblobContainerUrlIface)(nil).Url))
+}
+
 // MockAzblobBlockBlobUrlIface is a mock of AzblobBlockBlobUrlIface interface.
 type MockAzblobBlockBlobUrlIface struct {
 	ctrl     *gomock.Controller
@@ -172,6 +200,36 @@ func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) Download(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).Download), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// GetTags mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) GetTags(arg0 context.Context, arg1 *int32, arg2, arg3, arg4, arg5 *string) (*azblob.BlobTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*azblob.BlobTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) GetTags(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).GetTags), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetTags mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) SetTags(arg0 context.Context, arg1 *int32, arg2 *string, arg3, arg4 []byte, arg5, arg6 *string, arg7 azblob.BlobTagsMap) (*azblob.BlobSetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTags", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(*azblob.BlobSetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTags indicates an expected call of SetTags.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) SetTags(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).SetTags), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
 // Upload mocks base method.
 func (m *MockAzblobBlockBlobUrlIface) Upload(arg0 context.Context, arg1 io.ReadSeeker, arg2 azblob.BlobHTTPHeaders, arg3 azblob.Metadata, arg4 azblob.BlobAccessConditions, arg5 azblob.AccessTierType, arg6 azblob.BlobTagsMap, arg7 azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobUploadResponse, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +259,73 @@ func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) Url() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Url", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).Url))
 }
 
+// WithVersionID mocks base method.
+func (m *MockAzblobBlockBlobUrlIface) WithVersionID(arg0 string) azblob_service_iface.AzblobBlockBlobUrlIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithVersionID", arg0)
+	ret0, _ := ret[0].(azblob_service_iface.AzblobBlockBlobUrlIface)
+	return ret0
+}
+
+// WithVersionID indicates an expected call of WithVersionID.
+func (mr *MockAzblobBlockBlobUrlIfaceMockRecorder) WithVersionID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithVersionID", reflect.TypeOf((*MockAzblobBlockBlobUrlIface)(nil).WithVersionID), arg0)
+}
+
+// MockAzblobAppendBlobUrlIface is a mock of AzblobAppendBlobUrlIface interface.
+type MockAzblobAppendBlobUrlIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAzblobAppendBlobUrlIfaceMockRecorder
+}
+
+// MockAzblobAppendBlobUrlIfaceMockRecorder is the mock recorder for MockAzblobAppendBlobUrlIface.
+type MockAzblobAppendBlobUrlIfaceMockRecorder struct {
+	mock *MockAzblobAppendBlobUrlIface
+}
+
+// NewMockAzblobAppendBlobUrlIface creates a new mock instance.
+func NewMockAzblobAppendBlobUrlIface(ctrl *gomock.Controller) *MockAzblobAppendBlobUrlIface {
+	mock := &MockAzblobAppendBlobUrlIface{ctrl: ctrl}
+	mock.recorder = &MockAzblobAppendBlobUrlIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAzblobAppendBlobUrlIface) EXPECT() *MockAzblobAppendBlobUrlIfaceMockRecorder {
+	return m.recorder
+}
+
+// AppendBlock mocks base method.
+func (m *MockAzblobAppendBlobUrlIface) AppendBlock(arg0 context.Context, arg1 io.ReadSeeker, arg2 azblob.AppendBlobAccessConditions, arg3 []byte, arg4 azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobAppendBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendBlock", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*azblob.AppendBlobAppendBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendBlock indicates an expected call of AppendBlock.
+func (mr *MockAzblobAppendBlobUrlIfaceMockRecorder) AppendBlock(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBlock", reflect.TypeOf((*MockAzblobAppendBlobUrlIface)(nil).AppendBlock), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Create mocks base method.
+func (m *MockAzblobAppendBlobUrlIface) Create(arg0 context.Context, arg1 azblob.BlobHTTPHeaders, arg2 azblob.Metadata, arg3 azblob.BlobAccessConditions, arg4 azblob.BlobTagsMap, arg5 azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobCreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*azblob.AppendBlobCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAzblobAppendBlobUrlIfaceMockRecorder) Create(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAzblobAppendBlobUrlIface)(nil).Create), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // MockAzblobDownloadResponse is a mock of AzblobDownloadResponse interface.
 type MockAzblobDownloadResponse struct {
 	ctrl     *gomock.Controller
@@ -237,3 +362,59 @@ func (mr *MockAzblobDownloadResponseMockRecorder) Body(arg0 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Body", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).Body), arg0)
 }
+
+// ContentEncoding mocks base method.
+func (m *MockAzblobDownloadResponse) ContentEncoding() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentEncoding")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ContentEncoding indicates an expected call of ContentEncoding.
+func (mr *MockAzblobDownloadResponseMockRecorder) ContentEncoding() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentEncoding", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).ContentEncoding))
+}
+
+// ContentMD5 mocks base method.
+func (m *MockAzblobDownloadResponse) ContentMD5() []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentMD5")
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// ContentMD5 indicates an expected call of ContentMD5.
+func (mr *MockAzblobDownloadResponseMockRecorder) ContentMD5() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentMD5", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).ContentMD5))
+}
+
+// ContentType mocks base method.
+func (m *MockAzblobDownloadResponse) ContentType() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentType")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ContentType indicates an expected call of ContentType.
+func (mr *MockAzblobDownloadResponseMockRecorder) ContentType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentType", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).ContentType))
+}
+
+// ETag mocks base method.
+func (m *MockAzblobDownloadResponse) ETag() azblob.ETag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ETag")
+	ret0, _ := ret[0].(azblob.ETag)
+	return ret0
+}
+
+// ETag indicates an expected call of ETag.
+func (mr *MockAzblobDownloadResponseMockRecorder) ETag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETag", reflect.TypeOf((*MockAzblobDownloadResponse)(nil).ETag))
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/pkg/plugins/queue/azqueue/iface (interfaces: AzqueueServiceUrlIface,AzqueueQueueUrlIface,AzqueueMessageUrlIface,AzqueueMessageIdUrlIface,DequeueMessagesResponseIface)
+// Source: github.com/nitrictech/nitric/pkg/plugins/queue/azqueue/iface (interfaces: AzqueueServiceUrlIface,AzqueueQueueUrlIface,AzqueueMessageUrlIface,AzqueueMessageIdUrlIface,DequeueMessagesResponseIface,PeekedMessagesResponseIface,QueueGetPropertiesResponseIface)
 
 // Package mock_iface is a generated GoMock package.
 package mock_iface
@@ -74,6 +74,21 @@ func (m *MockAzqueueQueueUrlIface) EXPECT() *MockAzqueueQueueUrlIfaceMockRecorde
 	return m.recorder
 }
 
+// GetProperties mocks base method.
+func (m *MockAzqueueQueueUrlIface) GetProperties(arg0 context.Context) (azqueue_service_iface.QueueGetPropertiesResponseIface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProperties", arg0)
+	ret0, _ := ret[0].(azqueue_service_iface.QueueGetPropertiesResponseIface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProperties indicates an expected call of GetProperties.
+func (mr *MockAzqueueQueueUrlIfaceMockRecorder) GetProperties(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperties", reflect.TypeOf((*MockAzqueueQueueUrlIface)(nil).GetProperties), arg0)
+}
+
 // NewMessageURL mocks base method.
 func (m *MockAzqueueQueueUrlIface) NewMessageURL() azqueue_service_iface.AzqueueMessageUrlIface {
 	m.ctrl.T.Helper()
@@ -155,6 +170,21 @@ func (mr *MockAzqueueMessageUrlIfaceMockRecorder) NewMessageIDURL(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMessageIDURL", reflect.TypeOf((*MockAzqueueMessageUrlIface)(nil).NewMessageIDURL), arg0)
 }
 
+// Peek mocks base method.
+func (m *MockAzqueueMessageUrlIface) Peek(arg0 context.Context, arg1 int32) (azqueue_service_iface.PeekedMessagesResponseIface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", arg0, arg1)
+	ret0, _ := ret[0].(azqueue_service_iface.PeekedMessagesResponseIface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockAzqueueMessageUrlIfaceMockRecorder) Peek(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockAzqueueMessageUrlIface)(nil).Peek), arg0, arg1)
+}
+
 // MockAzqueueMessageIdUrlIface is a mock of AzqueueMessageIdUrlIface interface.
 type MockAzqueueMessageIdUrlIface struct {
 	ctrl     *gomock.Controller
@@ -193,6 +223,21 @@ func (mr *MockAzqueueMessageIdUrlIfaceMockRecorder) Delete(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAzqueueMessageIdUrlIface)(nil).Delete), arg0, arg1)
 }
 
+// Update mocks base method.
+func (m *MockAzqueueMessageIdUrlIface) Update(arg0 context.Context, arg1 azqueue.PopReceipt, arg2 time.Duration, arg3 string) (*azqueue.UpdatedMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*azqueue.UpdatedMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAzqueueMessageIdUrlIfaceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAzqueueMessageIdUrlIface)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
 // MockDequeueMessagesResponseIface is a mock of DequeueMessagesResponseIface interface.
 type MockDequeueMessagesResponseIface struct {
 	ctrl     *gomock.Controller
@@ -243,3 +288,91 @@ func (mr *MockDequeueMessagesResponseIfaceMockRecorder) NumMessages() *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumMessages", reflect.TypeOf((*MockDequeueMessagesResponseIface)(nil).NumMessages))
 }
+
+// MockPeekedMessagesResponseIface is a mock of PeekedMessagesResponseIface interface.
+type MockPeekedMessagesResponseIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPeekedMessagesResponseIfaceMockRecorder
+}
+
+// MockPeekedMessagesResponseIfaceMockRecorder is the mock recorder for MockPeekedMessagesResponseIface.
+type MockPeekedMessagesResponseIfaceMockRecorder struct {
+	mock *MockPeekedMessagesResponseIface
+}
+
+// NewMockPeekedMessagesResponseIface creates a new mock instance.
+func NewMockPeekedMessagesResponseIface(ctrl *gomock.Controller) *MockPeekedMessagesResponseIface {
+	mock := &MockPeekedMessagesResponseIface{ctrl: ctrl}
+	mock.recorder = &MockPeekedMessagesResponseIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPeekedMessagesResponseIface) EXPECT() *MockPeekedMessagesResponseIfaceMockRecorder {
+	return m.recorder
+}
+
+// Message mocks base method.
+func (m *MockPeekedMessagesResponseIface) Message(arg0 int32) *azqueue.PeekedMessage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Message", arg0)
+	ret0, _ := ret[0].(*azqueue.PeekedMessage)
+	return ret0
+}
+
+// Message indicates an expected call of Message.
+func (mr *MockPeekedMessagesResponseIfaceMockRecorder) Message(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Message", reflect.TypeOf((*MockPeekedMessagesResponseIface)(nil).Message), arg0)
+}
+
+// NumMessages mocks base method.
+func (m *MockPeekedMessagesResponseIface) NumMessages() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumMessages")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// NumMessages indicates an expected call of NumMessages.
+func (mr *MockPeekedMessagesResponseIfaceMockRecorder) NumMessages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumMessages", reflect.TypeOf((*MockPeekedMessagesResponseIface)(nil).NumMessages))
+}
+
+// MockQueueGetPropertiesResponseIface is a mock of QueueGetPropertiesResponseIface interface.
+type MockQueueGetPropertiesResponseIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueGetPropertiesResponseIfaceMockRecorder
+}
+
+// MockQueueGetPropertiesResponseIfaceMockRecorder is the mock recorder for MockQueueGetPropertiesResponseIface.
+type MockQueueGetPropertiesResponseIfaceMockRecorder struct {
+	mock *MockQueueGetPropertiesResponseIface
+}
+
+// NewMockQueueGetPropertiesResponseIface creates a new mock instance.
+func NewMockQueueGetPropertiesResponseIface(ctrl *gomock.Controller) *MockQueueGetPropertiesResponseIface {
+	mock := &MockQueueGetPropertiesResponseIface{ctrl: ctrl}
+	mock.recorder = &MockQueueGetPropertiesResponseIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueueGetPropertiesResponseIface) EXPECT() *MockQueueGetPropertiesResponseIfaceMockRecorder {
+	return m.recorder
+}
+
+// ApproximateMessagesCount mocks base method.
+func (m *MockQueueGetPropertiesResponseIface) ApproximateMessagesCount() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproximateMessagesCount")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// ApproximateMessagesCount indicates an expected call of ApproximateMessagesCount.
+func (mr *MockQueueGetPropertiesResponseIfaceMockRecorder) ApproximateMessagesCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproximateMessagesCount", reflect.TypeOf((*MockQueueGetPropertiesResponseIface)(nil).ApproximateMessagesCount))
+}
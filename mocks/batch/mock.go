@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nitrictech/nitric/pkg/plugins/batch (interfaces: BatchService)
+
+// Package mock_batch is a generated GoMock package.
+package mock_batch
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	batch "github.com/nitrictech/nitric/pkg/plugins/batch"
+)
+
+// MockBatchService is a mock of BatchService interface.
+type MockBatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchServiceMockRecorder
+}
+
+// MockBatchServiceMockRecorder is the mock recorder for MockBatchService.
+type MockBatchServiceMockRecorder struct {
+	mock *MockBatchService
+}
+
+// NewMockBatchService creates a new mock instance.
+func NewMockBatchService(ctrl *gomock.Controller) *MockBatchService {
+	mock := &MockBatchService{ctrl: ctrl}
+	mock.recorder = &MockBatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchService) EXPECT() *MockBatchServiceMockRecorder {
+	return m.recorder
+}
+
+// Logs mocks base method.
+func (m *MockBatchService) Logs(arg0, arg1 string) (*batch.LogResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", arg0, arg1)
+	ret0, _ := ret[0].(*batch.LogResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockBatchServiceMockRecorder) Logs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockBatchService)(nil).Logs), arg0, arg1)
+}
+
+// Status mocks base method.
+func (m *MockBatchService) Status(arg0 string) (*batch.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", arg0)
+	ret0, _ := ret[0].(*batch.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockBatchServiceMockRecorder) Status(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockBatchService)(nil).Status), arg0)
+}
+
+// Submit mocks base method.
+func (m *MockBatchService) Submit(arg0 *batch.JobSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockBatchServiceMockRecorder) Submit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockBatchService)(nil).Submit), arg0)
+}
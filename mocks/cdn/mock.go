@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nitrictech/nitric/pkg/plugins/cdn (interfaces: CdnService)
+
+// Package mock_cdn is a generated GoMock package.
+package mock_cdn
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	cdn "github.com/nitrictech/nitric/pkg/plugins/cdn"
+)
+
+// MockCdnService is a mock of CdnService interface.
+type MockCdnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCdnServiceMockRecorder
+}
+
+// MockCdnServiceMockRecorder is the mock recorder for MockCdnService.
+type MockCdnServiceMockRecorder struct {
+	mock *MockCdnService
+}
+
+// NewMockCdnService creates a new mock instance.
+func NewMockCdnService(ctrl *gomock.Controller) *MockCdnService {
+	mock := &MockCdnService{ctrl: ctrl}
+	mock.recorder = &MockCdnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCdnService) EXPECT() *MockCdnServiceMockRecorder {
+	return m.recorder
+}
+
+// SignCookies mocks base method.
+func (m *MockCdnService) SignCookies(arg0, arg1 string, arg2 time.Duration) ([]*cdn.Cookie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignCookies", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*cdn.Cookie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignCookies indicates an expected call of SignCookies.
+func (mr *MockCdnServiceMockRecorder) SignCookies(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignCookies", reflect.TypeOf((*MockCdnService)(nil).SignCookies), arg0, arg1, arg2)
+}
+
+// SignUrl mocks base method.
+func (m *MockCdnService) SignUrl(arg0, arg1 string, arg2 time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUrl", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUrl indicates an expected call of SignUrl.
+func (mr *MockCdnServiceMockRecorder) SignUrl(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUrl", reflect.TypeOf((*MockCdnService)(nil).SignUrl), arg0, arg1, arg2)
+}
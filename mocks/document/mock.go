@@ -34,6 +34,35 @@ func (m *MockDocumentService) EXPECT() *MockDocumentServiceMockRecorder {
 	return m.recorder
 }
 
+// Aggregate mocks base method.
+func (m *MockDocumentService) Aggregate(arg0 *document.Collection, arg1 []document.QueryExpression, arg2 []document.Aggregation) ([]document.AggregateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]document.AggregateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockDocumentServiceMockRecorder) Aggregate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockDocumentService)(nil).Aggregate), arg0, arg1, arg2)
+}
+
+// CompareAndSet mocks base method.
+func (m *MockDocumentService) CompareAndSet(arg0 *document.Key, arg1 string, arg2 int64, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareAndSet indicates an expected call of CompareAndSet.
+func (mr *MockDocumentServiceMockRecorder) CompareAndSet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSet", reflect.TypeOf((*MockDocumentService)(nil).CompareAndSet), arg0, arg1, arg2, arg3)
+}
+
 // Delete mocks base method.
 func (m *MockDocumentService) Delete(arg0 *document.Key) error {
 	m.ctrl.T.Helper()
@@ -48,6 +77,21 @@ func (mr *MockDocumentServiceMockRecorder) Delete(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDocumentService)(nil).Delete), arg0)
 }
 
+// DeleteBatch mocks base method.
+func (m *MockDocumentService) DeleteBatch(arg0 []*document.Key) (*document.BatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", arg0)
+	ret0, _ := ret[0].(*document.BatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockDocumentServiceMockRecorder) DeleteBatch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockDocumentService)(nil).DeleteBatch), arg0)
+}
+
 // Get mocks base method.
 func (m *MockDocumentService) Get(arg0 *document.Key) (*document.Document, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +107,21 @@ func (mr *MockDocumentServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDocumentService)(nil).Get), arg0)
 }
 
+// Increment mocks base method.
+func (m *MockDocumentService) Increment(arg0 *document.Key, arg1 string, arg2 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockDocumentServiceMockRecorder) Increment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockDocumentService)(nil).Increment), arg0, arg1, arg2)
+}
+
 // Query mocks base method.
 func (m *MockDocumentService) Query(arg0 *document.Collection, arg1 []document.QueryExpression, arg2 int, arg3 map[string]string) (*document.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -105,3 +164,18 @@ func (mr *MockDocumentServiceMockRecorder) Set(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockDocumentService)(nil).Set), arg0, arg1)
 }
+
+// SetBatch mocks base method.
+func (m *MockDocumentService) SetBatch(arg0 []document.Document) (*document.BatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBatch", arg0)
+	ret0, _ := ret[0].(*document.BatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBatch indicates an expected call of SetBatch.
+func (mr *MockDocumentServiceMockRecorder) SetBatch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBatch", reflect.TypeOf((*MockDocumentService)(nil).SetBatch), arg0)
+}
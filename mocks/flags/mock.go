@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nitrictech/nitric/pkg/plugins/flags (interfaces: FlagsService)
+
+// Package mock_flags is a generated GoMock package.
+package mock_flags
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	flags "github.com/nitrictech/nitric/pkg/plugins/flags"
+)
+
+// MockFlagsService is a mock of FlagsService interface.
+type MockFlagsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlagsServiceMockRecorder
+}
+
+// MockFlagsServiceMockRecorder is the mock recorder for MockFlagsService.
+type MockFlagsServiceMockRecorder struct {
+	mock *MockFlagsService
+}
+
+// NewMockFlagsService creates a new mock instance.
+func NewMockFlagsService(ctrl *gomock.Controller) *MockFlagsService {
+	mock := &MockFlagsService{ctrl: ctrl}
+	mock.recorder = &MockFlagsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlagsService) EXPECT() *MockFlagsServiceMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockFlagsService) Evaluate(arg0 string, arg1 *flags.Context) (*flags.Evaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", arg0, arg1)
+	ret0, _ := ret[0].(*flags.Evaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockFlagsServiceMockRecorder) Evaluate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockFlagsService)(nil).Evaluate), arg0, arg1)
+}
@@ -12,6 +12,7 @@ import (
 	gax "github.com/googleapis/gax-go/v2"
 	ifaces_gcloud_secret "github.com/nitrictech/nitric/pkg/ifaces/gcloud_secret"
 	secretmanager "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	iam "google.golang.org/genproto/googleapis/iam/v1"
 )
 
 // MockSecretManagerClient is a mock of SecretManagerClient interface.
@@ -77,6 +78,46 @@ func (mr *MockSecretManagerClientMockRecorder) AddSecretVersion(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretVersion", reflect.TypeOf((*MockSecretManagerClient)(nil).AddSecretVersion), varargs...)
 }
 
+// CreateSecret mocks base method.
+func (m *MockSecretManagerClient) CreateSecret(arg0 context.Context, arg1 *secretmanager.CreateSecretRequest, arg2 ...gax.CallOption) (*secretmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecret", varargs...)
+	ret0, _ := ret[0].(*secretmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockSecretManagerClientMockRecorder) CreateSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretManagerClient)(nil).CreateSecret), varargs...)
+}
+
+// GetSecret mocks base method.
+func (m *MockSecretManagerClient) GetSecret(arg0 context.Context, arg1 *secretmanager.GetSecretRequest, arg2 ...gax.CallOption) (*secretmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecret", varargs...)
+	ret0, _ := ret[0].(*secretmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockSecretManagerClientMockRecorder) GetSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretManagerClient)(nil).GetSecret), varargs...)
+}
+
 // ListSecrets mocks base method.
 func (m *MockSecretManagerClient) ListSecrets(arg0 context.Context, arg1 *secretmanager.ListSecretsRequest, arg2 ...gax.CallOption) ifaces_gcloud_secret.SecretIterator {
 	m.ctrl.T.Helper()
@@ -96,6 +137,26 @@ func (mr *MockSecretManagerClientMockRecorder) ListSecrets(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretManagerClient)(nil).ListSecrets), varargs...)
 }
 
+// TestIamPermissions mocks base method.
+func (m *MockSecretManagerClient) TestIamPermissions(arg0 context.Context, arg1 *iam.TestIamPermissionsRequest, arg2 ...gax.CallOption) (*iam.TestIamPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TestIamPermissions", varargs...)
+	ret0, _ := ret[0].(*iam.TestIamPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestIamPermissions indicates an expected call of TestIamPermissions.
+func (mr *MockSecretManagerClientMockRecorder) TestIamPermissions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestIamPermissions", reflect.TypeOf((*MockSecretManagerClient)(nil).TestIamPermissions), varargs...)
+}
+
 // UpdateSecret mocks base method.
 func (m *MockSecretManagerClient) UpdateSecret(arg0 context.Context, arg1 *secretmanager.UpdateSecretRequest, arg2 ...gax.CallOption) (*secretmanager.Secret, error) {
 	m.ctrl.T.Helper()
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/pkg/ifaces/gcloud_storage (interfaces: Reader,Writer,ObjectHandle,BucketHandle,BucketIterator,StorageClient,ObjectIterator)
+// Source: github.com/nitrictech/nitric/pkg/ifaces/gcloud_storage (interfaces: Reader,Writer,ObjectHandle,Composer,BucketHandle,BucketIterator,StorageClient,ObjectIterator,Copier)
 
 // Package mock_gcloud_storage is a generated GoMock package.
 package mock_gcloud_storage
@@ -50,6 +50,34 @@ func (mr *MockReaderMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReader)(nil).Close))
 }
 
+// ContentEncoding mocks base method.
+func (m *MockReader) ContentEncoding() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentEncoding")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ContentEncoding indicates an expected call of ContentEncoding.
+func (mr *MockReaderMockRecorder) ContentEncoding() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentEncoding", reflect.TypeOf((*MockReader)(nil).ContentEncoding))
+}
+
+// Generation mocks base method.
+func (m *MockReader) Generation() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generation")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Generation indicates an expected call of Generation.
+func (mr *MockReaderMockRecorder) Generation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generation", reflect.TypeOf((*MockReader)(nil).Generation))
+}
+
 // Read mocks base method.
 func (m *MockReader) Read(arg0 []byte) (int, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +116,20 @@ func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
 	return m.recorder
 }
 
+// Attrs mocks base method.
+func (m *MockWriter) Attrs() *storage.ObjectAttrs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attrs")
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	return ret0
+}
+
+// Attrs indicates an expected call of Attrs.
+func (mr *MockWriterMockRecorder) Attrs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attrs", reflect.TypeOf((*MockWriter)(nil).Attrs))
+}
+
 // Close mocks base method.
 func (m *MockWriter) Close() error {
 	m.ctrl.T.Helper()
@@ -102,6 +144,20 @@ func (mr *MockWriterMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWriter)(nil).Close))
 }
 
+// ObjectAttrs mocks base method.
+func (m *MockWriter) ObjectAttrs() *storage.ObjectAttrs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectAttrs")
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	return ret0
+}
+
+// ObjectAttrs indicates an expected call of ObjectAttrs.
+func (mr *MockWriterMockRecorder) ObjectAttrs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectAttrs", reflect.TypeOf((*MockWriter)(nil).ObjectAttrs))
+}
+
 // Write mocks base method.
 func (m *MockWriter) Write(arg0 []byte) (int, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +196,53 @@ func (m *MockObjectHandle) EXPECT() *MockObjectHandleMockRecorder {
 	return m.recorder
 }
 
+// Attrs mocks base method.
+func (m *MockObjectHandle) Attrs(arg0 context.Context) (*storage.ObjectAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attrs", arg0)
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attrs indicates an expected call of Attrs.
+func (mr *MockObjectHandleMockRecorder) Attrs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attrs", reflect.TypeOf((*MockObjectHandle)(nil).Attrs), arg0)
+}
+
+// ComposerFrom mocks base method.
+func (m *MockObjectHandle) ComposerFrom(arg0 ...ifaces_gcloud_storage.ObjectHandle) ifaces_gcloud_storage.Composer {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ComposerFrom", varargs...)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.Composer)
+	return ret0
+}
+
+// ComposerFrom indicates an expected call of ComposerFrom.
+func (mr *MockObjectHandleMockRecorder) ComposerFrom(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComposerFrom", reflect.TypeOf((*MockObjectHandle)(nil).ComposerFrom), arg0...)
+}
+
+// CopierFrom mocks base method.
+func (m *MockObjectHandle) CopierFrom(arg0 ifaces_gcloud_storage.ObjectHandle) ifaces_gcloud_storage.Copier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopierFrom", arg0)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.Copier)
+	return ret0
+}
+
+// CopierFrom indicates an expected call of CopierFrom.
+func (mr *MockObjectHandleMockRecorder) CopierFrom(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopierFrom", reflect.TypeOf((*MockObjectHandle)(nil).CopierFrom), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockObjectHandle) Delete(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -154,6 +257,34 @@ func (mr *MockObjectHandleMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockObjectHandle)(nil).Delete), arg0)
 }
 
+// Generation mocks base method.
+func (m *MockObjectHandle) Generation(arg0 int64) ifaces_gcloud_storage.ObjectHandle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generation", arg0)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.ObjectHandle)
+	return ret0
+}
+
+// Generation indicates an expected call of Generation.
+func (mr *MockObjectHandleMockRecorder) Generation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generation", reflect.TypeOf((*MockObjectHandle)(nil).Generation), arg0)
+}
+
+// If mocks base method.
+func (m *MockObjectHandle) If(arg0 storage.Conditions) ifaces_gcloud_storage.ObjectHandle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "If", arg0)
+	ret0, _ := ret[0].(ifaces_gcloud_storage.ObjectHandle)
+	return ret0
+}
+
+// If indicates an expected call of If.
+func (mr *MockObjectHandleMockRecorder) If(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "If", reflect.TypeOf((*MockObjectHandle)(nil).If), arg0)
+}
+
 // NewReader mocks base method.
 func (m *MockObjectHandle) NewReader(arg0 context.Context) (ifaces_gcloud_storage.Reader, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +314,73 @@ func (mr *MockObjectHandleMockRecorder) NewWriter(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWriter", reflect.TypeOf((*MockObjectHandle)(nil).NewWriter), arg0)
 }
 
+// Update mocks base method.
+func (m *MockObjectHandle) Update(arg0 context.Context, arg1 storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockObjectHandleMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockObjectHandle)(nil).Update), arg0, arg1)
+}
+
+// MockComposer is a mock of Composer interface.
+type MockComposer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComposerMockRecorder
+}
+
+// MockComposerMockRecorder is the mock recorder for MockComposer.
+type MockComposerMockRecorder struct {
+	mock *MockComposer
+}
+
+// NewMockComposer creates a new mock instance.
+func NewMockComposer(ctrl *gomock.Controller) *MockComposer {
+	mock := &MockComposer{ctrl: ctrl}
+	mock.recorder = &MockComposerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComposer) EXPECT() *MockComposerMockRecorder {
+	return m.recorder
+}
+
+// ObjectAttrs mocks base method.
+func (m *MockComposer) ObjectAttrs() *storage.ObjectAttrs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectAttrs")
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	return ret0
+}
+
+// ObjectAttrs indicates an expected call of ObjectAttrs.
+func (mr *MockComposerMockRecorder) ObjectAttrs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectAttrs", reflect.TypeOf((*MockComposer)(nil).ObjectAttrs))
+}
+
+// Run mocks base method.
+func (m *MockComposer) Run(arg0 context.Context) (*storage.ObjectAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0)
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockComposerMockRecorder) Run(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockComposer)(nil).Run), arg0)
+}
+
 // MockBucketHandle is a mock of BucketHandle interface.
 type MockBucketHandle struct {
 	ctrl     *gomock.Controller
@@ -206,6 +404,35 @@ func (m *MockBucketHandle) EXPECT() *MockBucketHandleMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockBucketHandle) Create(arg0 context.Context, arg1 string, arg2 *storage.BucketAttrs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBucketHandleMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBucketHandle)(nil).Create), arg0, arg1, arg2)
+}
+
+// GenerateSignedPostPolicyV4 mocks base method.
+func (m *MockBucketHandle) GenerateSignedPostPolicyV4(arg0 string, arg1 *storage.PostPolicyV4Options) (*storage.PostPolicyV4, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSignedPostPolicyV4", arg0, arg1)
+	ret0, _ := ret[0].(*storage.PostPolicyV4)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSignedPostPolicyV4 indicates an expected call of GenerateSignedPostPolicyV4.
+func (mr *MockBucketHandleMockRecorder) GenerateSignedPostPolicyV4(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSignedPostPolicyV4", reflect.TypeOf((*MockBucketHandle)(nil).GenerateSignedPostPolicyV4), arg0, arg1)
+}
+
 // Object mocks base method.
 func (m *MockBucketHandle) Object(arg0 string) ifaces_gcloud_storage.ObjectHandle {
 	m.ctrl.T.Helper()
@@ -249,6 +476,36 @@ func (mr *MockBucketHandleMockRecorder) SignedURL(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignedURL", reflect.TypeOf((*MockBucketHandle)(nil).SignedURL), arg0, arg1)
 }
 
+// TestPermissions mocks base method.
+func (m *MockBucketHandle) TestPermissions(arg0 context.Context, arg1 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestPermissions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestPermissions indicates an expected call of TestPermissions.
+func (mr *MockBucketHandleMockRecorder) TestPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestPermissions", reflect.TypeOf((*MockBucketHandle)(nil).TestPermissions), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockBucketHandle) Update(arg0 context.Context, arg1 storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*storage.BucketAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBucketHandleMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBucketHandle)(nil).Update), arg0, arg1)
+}
+
 // MockBucketIterator is a mock of BucketIterator interface.
 type MockBucketIterator struct {
 	ctrl     *gomock.Controller
@@ -375,3 +632,41 @@ func (mr *MockObjectIteratorMockRecorder) Next() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockObjectIterator)(nil).Next))
 }
+
+// MockCopier is a mock of Copier interface.
+type MockCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCopierMockRecorder
+}
+
+// MockCopierMockRecorder is the mock recorder for MockCopier.
+type MockCopierMockRecorder struct {
+	mock *MockCopier
+}
+
+// NewMockCopier creates a new mock instance.
+func NewMockCopier(ctrl *gomock.Controller) *MockCopier {
+	mock := &MockCopier{ctrl: ctrl}
+	mock.recorder = &MockCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCopier) EXPECT() *MockCopierMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockCopier) Run(arg0 context.Context) (*storage.ObjectAttrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0)
+	ret0, _ := ret[0].(*storage.ObjectAttrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockCopierMockRecorder) Run(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCopier)(nil).Run), arg0)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/nitrictech/nitric/pkg/api/nitric/v1 (interfaces: FaasService_TriggerStreamServer)
+// Source: github.com/nitrictech/nitric/pkg/api/nitric/v1 (interfaces: FaasService_TriggerStreamServer,DocumentService_QueryStreamServer)
 
 // Package mock_v1 is a generated GoMock package.
 package mock_v1
@@ -146,3 +146,122 @@ func (mr *MockFaasService_TriggerStreamServerMockRecorder) SetTrailer(arg0 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockFaasService_TriggerStreamServer)(nil).SetTrailer), arg0)
 }
+
+// MockDocumentService_QueryStreamServer is a mock of DocumentService_QueryStreamServer interface.
+type MockDocumentService_QueryStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentService_QueryStreamServerMockRecorder
+}
+
+// MockDocumentService_QueryStreamServerMockRecorder is the mock recorder for MockDocumentService_QueryStreamServer.
+type MockDocumentService_QueryStreamServerMockRecorder struct {
+	mock *MockDocumentService_QueryStreamServer
+}
+
+// NewMockDocumentService_QueryStreamServer creates a new mock instance.
+func NewMockDocumentService_QueryStreamServer(ctrl *gomock.Controller) *MockDocumentService_QueryStreamServer {
+	mock := &MockDocumentService_QueryStreamServer{ctrl: ctrl}
+	mock.recorder = &MockDocumentService_QueryStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentService_QueryStreamServer) EXPECT() *MockDocumentService_QueryStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockDocumentService_QueryStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockDocumentService_QueryStreamServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockDocumentService_QueryStreamServer) Send(arg0 *v1.DocumentQueryStreamResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockDocumentService_QueryStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockDocumentService_QueryStreamServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockDocumentService_QueryStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockDocumentService_QueryStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockDocumentService_QueryStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockDocumentService_QueryStreamServer)(nil).SetTrailer), arg0)
+}
@@ -33,6 +33,18 @@ func (m *MockAwsProvider) EXPECT() *MockAwsProviderMockRecorder {
 	return m.recorder
 }
 
+// AddResource mocks base method.
+func (m *MockAwsProvider) AddResource(arg0, arg1, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddResource", arg0, arg1, arg2)
+}
+
+// AddResource indicates an expected call of AddResource.
+func (mr *MockAwsProviderMockRecorder) AddResource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResource", reflect.TypeOf((*MockAwsProvider)(nil).AddResource), arg0, arg1, arg2)
+}
+
 // GetResources mocks base method.
 func (m *MockAwsProvider) GetResources(arg0 string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ package mock_queue
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	queue "github.com/nitrictech/nitric/pkg/plugins/queue"
@@ -48,6 +49,36 @@ func (mr *MockQueueServiceMockRecorder) Complete(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockQueueService)(nil).Complete), arg0, arg1)
 }
 
+// CompleteBatch mocks base method.
+func (m *MockQueueService) CompleteBatch(arg0 string, arg1 []string) (*queue.CompleteBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteBatch", arg0, arg1)
+	ret0, _ := ret[0].(*queue.CompleteBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteBatch indicates an expected call of CompleteBatch.
+func (mr *MockQueueServiceMockRecorder) CompleteBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteBatch", reflect.TypeOf((*MockQueueService)(nil).CompleteBatch), arg0, arg1)
+}
+
+// GetQueueStats mocks base method.
+func (m *MockQueueService) GetQueueStats(arg0 string) (*queue.QueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStats", arg0)
+	ret0, _ := ret[0].(*queue.QueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueStats indicates an expected call of GetQueueStats.
+func (mr *MockQueueServiceMockRecorder) GetQueueStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStats", reflect.TypeOf((*MockQueueService)(nil).GetQueueStats), arg0)
+}
+
 // Receive mocks base method.
 func (m *MockQueueService) Receive(arg0 queue.ReceiveOptions) ([]queue.NitricTask, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +94,20 @@ func (mr *MockQueueServiceMockRecorder) Receive(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockQueueService)(nil).Receive), arg0)
 }
 
+// Release mocks base method.
+func (m *MockQueueService) Release(arg0, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockQueueServiceMockRecorder) Release(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockQueueService)(nil).Release), arg0, arg1, arg2)
+}
+
 // Send mocks base method.
 func (m *MockQueueService) Send(arg0 string, arg1 queue.NitricTask) error {
 	m.ctrl.T.Helper()
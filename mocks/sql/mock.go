@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nitrictech/nitric/pkg/plugins/sql (interfaces: SqlService)
+
+// Package mock_sql is a generated GoMock package.
+package mock_sql
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	sql "github.com/nitrictech/nitric/pkg/plugins/sql"
+)
+
+// MockSqlService is a mock of SqlService interface.
+type MockSqlService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSqlServiceMockRecorder
+}
+
+// MockSqlServiceMockRecorder is the mock recorder for MockSqlService.
+type MockSqlServiceMockRecorder struct {
+	mock *MockSqlService
+}
+
+// NewMockSqlService creates a new mock instance.
+func NewMockSqlService(ctrl *gomock.Controller) *MockSqlService {
+	mock := &MockSqlService{ctrl: ctrl}
+	mock.recorder = &MockSqlServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSqlService) EXPECT() *MockSqlServiceMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockSqlService) Begin(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockSqlServiceMockRecorder) Begin(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockSqlService)(nil).Begin), arg0)
+}
+
+// Commit mocks base method.
+func (m *MockSqlService) Commit(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockSqlServiceMockRecorder) Commit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockSqlService)(nil).Commit), arg0)
+}
+
+// Exec mocks base method.
+func (m *MockSqlService) Exec(arg0, arg1 string, arg2 []interface{}, arg3 string) (*sql.ExecResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*sql.ExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockSqlServiceMockRecorder) Exec(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockSqlService)(nil).Exec), arg0, arg1, arg2, arg3)
+}
+
+// Query mocks base method.
+func (m *MockSqlService) Query(arg0, arg1 string, arg2 []interface{}, arg3 string) (*sql.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*sql.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockSqlServiceMockRecorder) Query(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockSqlService)(nil).Query), arg0, arg1, arg2, arg3)
+}
+
+// Rollback mocks base method.
+func (m *MockSqlService) Rollback(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockSqlServiceMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockSqlService)(nil).Rollback), arg0)
+}
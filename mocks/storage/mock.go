@@ -34,33 +34,145 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 	return m.recorder
 }
 
+// AbortUpload mocks base method.
+func (m *MockStorageService) AbortUpload(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortUpload indicates an expected call of AbortUpload.
+func (mr *MockStorageServiceMockRecorder) AbortUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortUpload", reflect.TypeOf((*MockStorageService)(nil).AbortUpload), arg0, arg1, arg2)
+}
+
+// Append mocks base method.
+func (m *MockStorageService) Append(arg0, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockStorageServiceMockRecorder) Append(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockStorageService)(nil).Append), arg0, arg1, arg2)
+}
+
+// BeginUpload mocks base method.
+func (m *MockStorageService) BeginUpload(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginUpload", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginUpload indicates an expected call of BeginUpload.
+func (mr *MockStorageServiceMockRecorder) BeginUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginUpload", reflect.TypeOf((*MockStorageService)(nil).BeginUpload), arg0, arg1)
+}
+
+// CompleteUpload mocks base method.
+func (m *MockStorageService) CompleteUpload(arg0, arg1, arg2 string, arg3 []*storage.UploadPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockStorageServiceMockRecorder) CompleteUpload(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockStorageService)(nil).CompleteUpload), arg0, arg1, arg2, arg3)
+}
+
 // Delete mocks base method.
-func (m *MockStorageService) Delete(arg0, arg1 string) error {
+func (m *MockStorageService) Delete(arg0, arg1 string, arg2 ...func(*storage.DeleteOptions)) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockStorageServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStorageServiceMockRecorder) Delete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorageService)(nil).Delete), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorageService)(nil).Delete), varargs...)
+}
+
+// GetTags mocks base method.
+func (m *MockStorageService) GetTags(arg0, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockStorageServiceMockRecorder) GetTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockStorageService)(nil).GetTags), arg0, arg1)
+}
+
+// GrantAccess mocks base method.
+func (m *MockStorageService) GrantAccess(arg0, arg1 string, arg2 []storage.Operation, arg3 uint32) (*storage.AccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*storage.AccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockStorageServiceMockRecorder) GrantAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockStorageService)(nil).GrantAccess), arg0, arg1, arg2, arg3)
 }
 
 // ListFiles mocks base method.
-func (m *MockStorageService) ListFiles(arg0 string) ([]*storage.FileInfo, error) {
+func (m *MockStorageService) ListFiles(arg0 string, arg1 ...func(*storage.ListOptions)) ([]*storage.FileInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFiles", arg0)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFiles", varargs...)
 	ret0, _ := ret[0].([]*storage.FileInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListFiles indicates an expected call of ListFiles.
-func (mr *MockStorageServiceMockRecorder) ListFiles(arg0 interface{}) *gomock.Call {
+func (mr *MockStorageServiceMockRecorder) ListFiles(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockStorageService)(nil).ListFiles), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockStorageService)(nil).ListFiles), varargs...)
+}
+
+// ListVersions mocks base method.
+func (m *MockStorageService) ListVersions(arg0, arg1 string) ([]*storage.VersionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1)
+	ret0, _ := ret[0].([]*storage.VersionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockStorageServiceMockRecorder) ListVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockStorageService)(nil).ListVersions), arg0, arg1)
 }
 
 // PreSignUrl mocks base method.
@@ -79,30 +191,85 @@ func (mr *MockStorageServiceMockRecorder) PreSignUrl(arg0, arg1, arg2, arg3 inte
 }
 
 // Read mocks base method.
-func (m *MockStorageService) Read(arg0, arg1 string) ([]byte, error) {
+func (m *MockStorageService) Read(arg0, arg1 string, arg2 ...func(*storage.ReadOptions)) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Read", varargs...)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Read indicates an expected call of Read.
-func (mr *MockStorageServiceMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStorageServiceMockRecorder) Read(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockStorageService)(nil).Read), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockStorageService)(nil).Read), varargs...)
 }
 
-// Write mocks base method.
-func (m *MockStorageService) Write(arg0, arg1 string, arg2 []byte) error {
+// RestoreVersion mocks base method.
+func (m *MockStorageService) RestoreVersion(arg0, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion.
+func (mr *MockStorageServiceMockRecorder) RestoreVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockStorageService)(nil).RestoreVersion), arg0, arg1, arg2)
+}
+
+// SetTags mocks base method.
+func (m *MockStorageService) SetTags(arg0, arg1 string, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Write", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetTags", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// SetTags indicates an expected call of SetTags.
+func (mr *MockStorageServiceMockRecorder) SetTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockStorageService)(nil).SetTags), arg0, arg1, arg2)
+}
+
+// UploadPart mocks base method.
+func (m *MockStorageService) UploadPart(arg0, arg1, arg2 string, arg3 *storage.UploadPart, arg4 []byte, arg5 bool) (*storage.UploadPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPart", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*storage.UploadPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPart indicates an expected call of UploadPart.
+func (mr *MockStorageServiceMockRecorder) UploadPart(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPart", reflect.TypeOf((*MockStorageService)(nil).UploadPart), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Write mocks base method.
+func (m *MockStorageService) Write(arg0, arg1 string, arg2 []byte, arg3 ...func(*storage.WriteOptions)) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Write", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Write indicates an expected call of Write.
-func (mr *MockStorageServiceMockRecorder) Write(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockStorageServiceMockRecorder) Write(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStorageService)(nil).Write), arg0, arg1, arg2)
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStorageService)(nil).Write), varargs...)
 }
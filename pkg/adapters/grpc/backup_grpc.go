@@ -0,0 +1,116 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/backup"
+)
+
+// BackupServiceServer - GRPC Interface for taking and restoring snapshots of collections and buckets
+type BackupServiceServer struct {
+	pb.UnimplementedBackupServiceServer
+	manager *backup.Manager
+}
+
+func (s *BackupServiceServer) checkManagerConfigured() error {
+	if s.manager == nil {
+		return NewPluginNotRegisteredError("Backup")
+	}
+
+	return nil
+}
+
+func snapshotToWire(snapshot *backup.Snapshot) *pb.BackupSnapshot {
+	documents := make(map[string]int64, len(snapshot.Documents))
+	for k, v := range snapshot.Documents {
+		documents[k] = int64(v)
+	}
+
+	files := make(map[string]int64, len(snapshot.Files))
+	for k, v := range snapshot.Files {
+		files[k] = int64(v)
+	}
+
+	return &pb.BackupSnapshot{
+		Id:        snapshot.Id,
+		CreatedAt: timestamppb.New(snapshot.CreatedAt),
+		Documents: documents,
+		Files:     files,
+	}
+}
+
+func (s *BackupServiceServer) Snapshot(ctx context.Context, req *pb.BackupSnapshotRequest) (*pb.BackupSnapshotResponse, error) {
+	if err := s.checkManagerConfigured(); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.manager.Snapshot()
+	if err != nil {
+		return nil, NewGrpcError("BackupService.Snapshot", err)
+	}
+
+	return &pb.BackupSnapshotResponse{
+		Snapshot: snapshotToWire(snapshot),
+	}, nil
+}
+
+func (s *BackupServiceServer) List(ctx context.Context, req *pb.BackupListRequest) (*pb.BackupListResponse, error) {
+	if err := s.checkManagerConfigured(); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.manager.List()
+	if err != nil {
+		return nil, NewGrpcError("BackupService.List", err)
+	}
+
+	wire := make([]*pb.BackupSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		wire = append(wire, snapshotToWire(snapshot))
+	}
+
+	return &pb.BackupListResponse{
+		Snapshots: wire,
+	}, nil
+}
+
+func (s *BackupServiceServer) Restore(ctx context.Context, req *pb.BackupRestoreRequest) (*pb.BackupRestoreResponse, error) {
+	if err := s.checkManagerConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "BackupService.Restore", err)
+	}
+
+	if err := s.manager.Restore(req.GetId(), req.GetCollections(), req.GetBuckets()); err != nil {
+		return nil, NewGrpcError("BackupService.Restore", err)
+	}
+
+	return &pb.BackupRestoreResponse{}, nil
+}
+
+// NewBackupServer - Creates a backup server, backups are unavailable if the manager is nil
+func NewBackupServer(manager *backup.Manager) pb.BackupServiceServer {
+	return &BackupServiceServer{
+		manager: manager,
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	mock_storage "github.com/nitrictech/nitric/mocks/storage"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/backup"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("GRPC Backup", func() {
+	Context("List", func() {
+		When("backups aren't configured", func() {
+			resp, err := grpc.NewBackupServer(nil).List(context.Background(), &v1.BackupListRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Backup plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("snapshots exist", func() {
+			g := gomock.NewController(GinkgoT())
+			mockStorage := mock_storage.NewMockStorageService(g)
+			manager, _ := backup.New(mock_document.NewMockDocumentService(g), mockStorage, &backup.Config{Bucket: "backups", Schedule: "1 day"})
+
+			mockStorage.EXPECT().ListFiles("backups").Return([]*storage.FileInfo{
+				{Key: "snapshots/20220301T000000Z/manifest.json"},
+			}, nil)
+			mockStorage.EXPECT().Read("backups", "snapshots/20220301T000000Z/manifest.json").Return(
+				[]byte(`{"id": "20220301T000000Z", "createdAt": "2022-03-01T00:00:00Z", "documents": {"orders": 2}}`), nil,
+			)
+
+			resp, err := grpc.NewBackupServer(manager).List(context.Background(), &v1.BackupListRequest{})
+
+			It("Should return the snapshots", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Snapshots).To(HaveLen(1))
+				Expect(resp.Snapshots[0].Id).To(Equal("20220301T000000Z"))
+				Expect(resp.Snapshots[0].Documents).To(Equal(map[string]int64{"orders": 2}))
+			})
+		})
+	})
+
+	Context("Restore", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			manager, _ := backup.New(mock_document.NewMockDocumentService(g), mock_storage.NewMockStorageService(g), &backup.Config{Bucket: "backups", Schedule: "1 day"})
+
+			resp, err := grpc.NewBackupServer(manager).Restore(context.Background(), &v1.BackupRestoreRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid BackupRestoreRequest.Id"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,141 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+)
+
+// GRPC Interface for registered Nitric Batch Plugins
+type BatchServer struct {
+	pb.UnimplementedBatchServiceServer
+	batchPlugin batch.BatchService
+}
+
+var batchJobStates = map[batch.JobState]pb.BatchJobState{
+	batch.Pending:   pb.BatchJobState_Pending,
+	batch.Running:   pb.BatchJobState_Running,
+	batch.Succeeded: pb.BatchJobState_Succeeded,
+	batch.Failed:    pb.BatchJobState_Failed,
+}
+
+func (s *BatchServer) checkPluginRegistered() error {
+	if s.batchPlugin == nil {
+		return NewPluginNotRegisteredError("Batch")
+	}
+
+	return nil
+}
+
+// timestampOrNil - leaves times the provider hasn't reported unset
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func (s *BatchServer) Submit(ctx context.Context, req *pb.BatchSubmitRequest) (*pb.BatchSubmitResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "BatchService.Submit", err)
+	}
+
+	job := req.GetJob()
+	id, err := s.batchPlugin.Submit(&batch.JobSpec{
+		Name:       job.GetName(),
+		Definition: job.GetDefinition(),
+		Image:      job.GetImage(),
+		Command:    job.GetCommand(),
+		Env:        job.GetEnv(),
+		Cpu:        job.GetCpu(),
+		MemoryMiB:  job.GetMemoryMib(),
+		Timeout:    job.GetTimeout().AsDuration(),
+	})
+	if err != nil {
+		return nil, NewGrpcError("BatchService.Submit", err)
+	}
+
+	return &pb.BatchSubmitResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *BatchServer) Status(ctx context.Context, req *pb.BatchStatusRequest) (*pb.BatchStatusResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "BatchService.Status", err)
+	}
+
+	status, err := s.batchPlugin.Status(req.GetId())
+	if err != nil {
+		return nil, NewGrpcError("BatchService.Status", err)
+	}
+
+	return &pb.BatchStatusResponse{
+		State:     batchJobStates[status.State],
+		Reason:    status.Reason,
+		StartedAt: timestampOrNil(status.StartedAt),
+		StoppedAt: timestampOrNil(status.StoppedAt),
+	}, nil
+}
+
+func (s *BatchServer) Logs(ctx context.Context, req *pb.BatchLogsRequest) (*pb.BatchLogsResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "BatchService.Logs", err)
+	}
+
+	result, err := s.batchPlugin.Logs(req.GetId(), req.GetPagingToken())
+	if err != nil {
+		return nil, NewGrpcError("BatchService.Logs", err)
+	}
+
+	entries := make([]*pb.BatchLogEntry, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		entries = append(entries, &pb.BatchLogEntry{
+			Time:    timestampOrNil(e.Time),
+			Message: e.Message,
+		})
+	}
+
+	return &pb.BatchLogsResponse{
+		Entries:     entries,
+		PagingToken: result.PagingToken,
+	}, nil
+}
+
+func NewBatchServer(batchPlugin batch.BatchService) pb.BatchServiceServer {
+	return &BatchServer{
+		batchPlugin: batchPlugin,
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mock_batch "github.com/nitrictech/nitric/mocks/batch"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+)
+
+var _ = Describe("GRPC Batch", func() {
+	Context("Submit", func() {
+		When("plugin not registered", func() {
+			bs := &grpc.BatchServer{}
+			resp, err := bs.Submit(context.Background(), &v1.BatchSubmitRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Batch plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockBatch := mock_batch.NewMockBatchService(g)
+			resp, err := grpc.NewBatchServer(mockBatch).Submit(context.Background(), &v1.BatchSubmitRequest{
+				Job: &v1.BatchJob{Image: "example/report", Cpu: -1},
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid BatchJob.Cpu"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockBatch := mock_batch.NewMockBatchService(g)
+
+			mockBatch.EXPECT().Submit(&batch.JobSpec{
+				Name:      "report",
+				Image:     "example/report",
+				Command:   []string{"report"},
+				Env:       map[string]string{"MODE": "full"},
+				MemoryMiB: 512,
+				Timeout:   time.Hour,
+			}).Return("job-1", nil)
+
+			resp, err := grpc.NewBatchServer(mockBatch).Submit(context.Background(), &v1.BatchSubmitRequest{
+				Job: &v1.BatchJob{
+					Name:      "report",
+					Image:     "example/report",
+					Command:   []string{"report"},
+					Env:       map[string]string{"MODE": "full"},
+					MemoryMib: 512,
+					Timeout:   durationpb.New(time.Hour),
+				},
+			})
+
+			It("Should return the job id", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Id).To(Equal("job-1"))
+			})
+		})
+	})
+
+	Context("Status", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockBatch := mock_batch.NewMockBatchService(g)
+
+			started := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+			mockBatch.EXPECT().Status("job-1").Return(&batch.JobStatus{
+				Id:        "job-1",
+				State:     batch.Running,
+				StartedAt: started,
+			}, nil)
+
+			resp, err := grpc.NewBatchServer(mockBatch).Status(context.Background(), &v1.BatchStatusRequest{Id: "job-1"})
+
+			It("Should return the job's state", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.State).To(Equal(v1.BatchJobState_Running))
+				Expect(resp.StartedAt.AsTime()).To(Equal(started))
+				Expect(resp.StoppedAt).To(BeNil())
+			})
+		})
+	})
+
+	Context("Logs", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockBatch := mock_batch.NewMockBatchService(g)
+
+			mockBatch.EXPECT().Logs("job-1", "token-1").Return(&batch.LogResult{
+				Entries:     []*batch.LogEntry{{Time: time.Now(), Message: "started"}},
+				PagingToken: "token-2",
+			}, nil)
+
+			resp, err := grpc.NewBatchServer(mockBatch).Logs(context.Background(), &v1.BatchLogsRequest{
+				Id:          "job-1",
+				PagingToken: "token-1",
+			})
+
+			It("Should return the entries and the next token", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Entries[0].Message).To(Equal("started"))
+				Expect(resp.PagingToken).To(Equal("token-2"))
+			})
+		})
+	})
+})
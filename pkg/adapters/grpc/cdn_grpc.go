@@ -0,0 +1,132 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// GRPC Interface for registered Nitric CDN Plugins
+type CdnServer struct {
+	pb.UnimplementedCdnServiceServer
+	cdnPlugin cdn.CdnService
+	tenancy   *tenancy.Tenancy
+}
+
+type CdnServerOption interface {
+	Apply(*CdnServer)
+}
+
+type withCdnTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withCdnTenancy) Apply(server *CdnServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithCdnTenancy - restricts signed URLs and cookies to the object keys of the tenant of each call
+func WithCdnTenancy(t *tenancy.Tenancy) CdnServerOption {
+	return &withCdnTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *CdnServer) checkPluginRegistered() error {
+	if s.cdnPlugin == nil {
+		return NewPluginNotRegisteredError("Cdn")
+	}
+
+	return nil
+}
+
+func (s *CdnServer) SignUrl(ctx context.Context, req *pb.CdnSignUrlRequest) (*pb.CdnSignUrlResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "CdnService.SignUrl", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "CdnService.SignUrl", err)
+	}
+
+	url, err := s.cdnPlugin.SignUrl(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), time.Duration(req.GetExpiry())*time.Second)
+	if err != nil {
+		return nil, NewGrpcError("CdnService.SignUrl", err)
+	}
+
+	return &pb.CdnSignUrlResponse{
+		Url: url,
+	}, nil
+}
+
+func (s *CdnServer) SignCookies(ctx context.Context, req *pb.CdnSignCookiesRequest) (*pb.CdnSignCookiesResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "CdnService.SignCookies", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "CdnService.SignCookies", err)
+	}
+
+	// a tenant's blank prefix still only grants access to the tenant's own items
+	cookies, err := s.cdnPlugin.SignCookies(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetPrefix()), time.Duration(req.GetExpiry())*time.Second)
+	if err != nil {
+		return nil, NewGrpcError("CdnService.SignCookies", err)
+	}
+
+	pbCookies := make([]*pb.CdnCookie, 0, len(cookies))
+	for _, c := range cookies {
+		pbCookies = append(pbCookies, &pb.CdnCookie{
+			Name:    c.Name,
+			Value:   c.Value,
+			Domain:  c.Domain,
+			Path:    c.Path,
+			Expires: timestampOrNil(c.Expires),
+		})
+	}
+
+	return &pb.CdnSignCookiesResponse{
+		Cookies: pbCookies,
+	}, nil
+}
+
+func NewCdnServer(cdnPlugin cdn.CdnService, opts ...CdnServerOption) pb.CdnServiceServer {
+	server := &CdnServer{
+		cdnPlugin: cdnPlugin,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	mock_cdn "github.com/nitrictech/nitric/mocks/cdn"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+var _ = Describe("GRPC CDN", func() {
+	Context("SignUrl", func() {
+		When("plugin not registered", func() {
+			cs := &grpc.CdnServer{}
+			resp, err := cs.SignUrl(context.Background(), &v1.CdnSignUrlRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Cdn plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			resp, err := grpc.NewCdnServer(mockCdn).SignUrl(context.Background(), &v1.CdnSignUrlRequest{
+				BucketName: "media",
+				Key:        "video.mp4",
+			})
+
+			It("Should report an invalid argument", func() {
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(err.Error()).Should(ContainSubstring("CdnSignUrlRequest.Expiry"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			mockCdn.EXPECT().SignUrl("media", "video.mp4", 5*time.Minute).Return("https://cdn.example.com/video.mp4?sig", nil)
+
+			resp, err := grpc.NewCdnServer(mockCdn).SignUrl(context.Background(), &v1.CdnSignUrlRequest{
+				BucketName: "media",
+				Key:        "video.mp4",
+				Expiry:     300,
+			})
+
+			It("Should return the signed url", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Url).To(Equal("https://cdn.example.com/video.mp4?sig"))
+			})
+		})
+
+		When("the plugin fails", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			mockCdn.EXPECT().SignUrl(gomock.Any(), gomock.Any(), gomock.Any()).Return("", fmt.Errorf("mock-error"))
+
+			resp, err := grpc.NewCdnServer(mockCdn).SignUrl(context.Background(), &v1.CdnSignUrlRequest{
+				BucketName: "media",
+				Key:        "video.mp4",
+				Expiry:     300,
+			})
+
+			It("Should report the error", func() {
+				Expect(err.Error()).Should(ContainSubstring("mock-error"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			mockCdn.EXPECT().SignUrl("media", "acme/video.mp4", gomock.Any()).Return("https://cdn.example.com/acme/video.mp4?sig", nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewCdnServer(mockCdn, grpc.WithCdnTenancy(tenancy.New(tenancy.Optional))).SignUrl(ctx, &v1.CdnSignUrlRequest{
+				BucketName: "media",
+				Key:        "video.mp4",
+				Expiry:     300,
+			})
+
+			It("Should sign the tenant's key", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("SignCookies", func() {
+		When("plugin not registered", func() {
+			cs := &grpc.CdnServer{}
+			resp, err := cs.SignCookies(context.Background(), &v1.CdnSignCookiesRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Cdn plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			expires := time.Unix(1700000000, 0)
+			mockCdn.EXPECT().SignCookies("media", "videos/", time.Hour).Return([]*cdn.Cookie{{
+				Name:    "CloudFront-Signature",
+				Value:   "sig",
+				Domain:  "cdn.example.com",
+				Path:    "/",
+				Expires: expires,
+			}}, nil)
+
+			resp, err := grpc.NewCdnServer(mockCdn).SignCookies(context.Background(), &v1.CdnSignCookiesRequest{
+				BucketName: "media",
+				Prefix:     "videos/",
+				Expiry:     3600,
+			})
+
+			It("Should return the signed cookies", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Cookies).To(HaveLen(1))
+				Expect(resp.Cookies[0].Name).To(Equal("CloudFront-Signature"))
+				Expect(resp.Cookies[0].Value).To(Equal("sig"))
+				Expect(resp.Cookies[0].Domain).To(Equal("cdn.example.com"))
+				Expect(resp.Cookies[0].Path).To(Equal("/"))
+				Expect(resp.Cookies[0].Expires.AsTime()).To(Equal(expires.UTC()))
+			})
+		})
+
+		When("the call is made for a tenant without a prefix", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			mockCdn.EXPECT().SignCookies("media", "acme/", gomock.Any()).Return([]*cdn.Cookie{}, nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewCdnServer(mockCdn, grpc.WithCdnTenancy(tenancy.New(tenancy.Optional))).SignCookies(ctx, &v1.CdnSignCookiesRequest{
+				BucketName: "media",
+				Expiry:     3600,
+			})
+
+			It("Should only grant access to the tenant's items", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("the plugin doesn't support cookies", func() {
+			g := gomock.NewController(GinkgoT())
+			mockCdn := mock_cdn.NewMockCdnService(g)
+			mockCdn.EXPECT().SignCookies(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("UNIMPLEMENTED"))
+
+			resp, err := grpc.NewCdnServer(mockCdn).SignCookies(context.Background(), &v1.CdnSignCookiesRequest{
+				BucketName: "media",
+				Expiry:     3600,
+			})
+
+			It("Should report the error", func() {
+				Expect(err).ShouldNot(BeNil())
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
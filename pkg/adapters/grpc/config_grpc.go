@@ -0,0 +1,98 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+)
+
+// GRPC Interface for registered Nitric Config Plugins
+type ConfigServer struct {
+	pb.UnimplementedConfigServiceServer
+	configPlugin config.ConfigService
+}
+
+func (s *ConfigServer) checkPluginRegistered() error {
+	if s.configPlugin == nil {
+		return NewPluginNotRegisteredError("Config")
+	}
+
+	return nil
+}
+
+// hasType - returns true if the value has the type, or the type is Any
+func hasType(value interface{}, t pb.ConfigValueType) bool {
+	switch t {
+	case pb.ConfigValueType_String:
+		_, ok := value.(string)
+		return ok
+	case pb.ConfigValueType_Number:
+		_, ok := value.(float64)
+		return ok
+	case pb.ConfigValueType_Bool:
+		_, ok := value.(bool)
+		return ok
+	case pb.ConfigValueType_Object:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case pb.ConfigValueType_List:
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+func (s *ConfigServer) Get(ctx context.Context, req *pb.ConfigGetRequest) (*pb.ConfigGetResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "ConfigService.Get", err)
+	}
+
+	value, err := s.configPlugin.Get(req.GetKey())
+	if err != nil {
+		return nil, NewGrpcError("ConfigService.Get", err)
+	}
+
+	if !hasType(value.Content, req.GetType()) {
+		return nil, newGrpcErrorWithCode(codes.FailedPrecondition, "ConfigService.Get", fmt.Errorf("value of %s is not a %s", req.GetKey(), req.GetType()))
+	}
+
+	content, err := structpb.NewValue(value.Content)
+	if err != nil {
+		return nil, NewGrpcError("ConfigService.Get", err)
+	}
+
+	return &pb.ConfigGetResponse{
+		Value:   content,
+		Version: value.Version,
+	}, nil
+}
+
+func NewConfigServer(configPlugin config.ConfigService) pb.ConfigServiceServer {
+	return &ConfigServer{
+		configPlugin: configPlugin,
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_config "github.com/nitrictech/nitric/mocks/config"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("GRPC Config", func() {
+	Context("Get", func() {
+		When("plugin not registered", func() {
+			cs := &grpc.ConfigServer{}
+			resp, err := cs.Get(context.Background(), &v1.ConfigGetRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Config plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockConfig := mock_config.NewMockConfigService(g)
+			resp, err := grpc.NewConfigServer(mockConfig).Get(context.Background(), &v1.ConfigGetRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid ConfigGetRequest.Key"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the value has the requested type", func() {
+			g := gomock.NewController(GinkgoT())
+			mockConfig := mock_config.NewMockConfigService(g)
+
+			mockConfig.EXPECT().Get("pricing").Return(&config.Value{
+				Content: map[string]interface{}{"discount": 0.1},
+				Version: "3",
+			}, nil)
+
+			resp, err := grpc.NewConfigServer(mockConfig).Get(context.Background(), &v1.ConfigGetRequest{
+				Key:  "pricing",
+				Type: v1.ConfigValueType_Object,
+			})
+
+			It("Should return the value and its version", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue().AsInterface()).To(Equal(map[string]interface{}{"discount": 0.1}))
+				Expect(resp.GetVersion()).To(Equal("3"))
+			})
+		})
+
+		When("the value has another type", func() {
+			g := gomock.NewController(GinkgoT())
+			mockConfig := mock_config.NewMockConfigService(g)
+
+			mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "cheap", Version: "3"}, nil)
+
+			resp, err := grpc.NewConfigServer(mockConfig).Get(context.Background(), &v1.ConfigGetRequest{
+				Key:  "pricing",
+				Type: v1.ConfigValueType_Number,
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("value of pricing is not a Number"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the key doesn't exist", func() {
+			g := gomock.NewController(GinkgoT())
+			mockConfig := mock_config.NewMockConfigService(g)
+
+			mockConfig.EXPECT().Get("missing").Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "configuration not found", nil))
+
+			resp, err := grpc.NewConfigServer(mockConfig).Get(context.Background(), &v1.ConfigGetRequest{Key: "missing"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("configuration not found"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
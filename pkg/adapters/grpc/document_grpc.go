@@ -16,21 +16,68 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strconv"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/outbox"
 	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/tenancy"
 	"github.com/nitrictech/protoutils"
 )
 
+// RequestChargeMetadataKey - the response header carrying the request units a query consumed, if its provider reports them
+const RequestChargeMetadataKey = "x-nitric-request-charge"
+
 // DocumentServiceServer - GRPC Interface for registered Nitric Document Plugin
 type DocumentServiceServer struct {
 	pb.UnimplementedDocumentServiceServer
 	// TODO: Support multiple plugin registrations
 	// Just need to settle on a way of addressing them on calls
 	documentPlugin document.DocumentService
+	outbox         *outbox.Outbox
+	tenancy        *tenancy.Tenancy
+}
+
+type DocumentServiceServerOption interface {
+	Apply(*DocumentServiceServer)
+}
+
+type withOutbox struct {
+	outbox *outbox.Outbox
+}
+
+func (w *withOutbox) Apply(server *DocumentServiceServer) {
+	server.outbox = w.outbox
+}
+
+// WithOutbox - enables publishing events with document writes
+func WithOutbox(o *outbox.Outbox) DocumentServiceServerOption {
+	return &withOutbox{
+		outbox: o,
+	}
+}
+
+type withDocumentTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withDocumentTenancy) Apply(server *DocumentServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithDocumentTenancy - namespaces root collections by the tenant of each call
+func WithDocumentTenancy(t *tenancy.Tenancy) DocumentServiceServerOption {
+	return &withDocumentTenancy{
+		tenancy: t,
+	}
 }
 
 func (s *DocumentServiceServer) checkPluginRegistered() error {
@@ -50,14 +97,19 @@ func (s *DocumentServiceServer) Get(ctx context.Context, req *pb.DocumentGetRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Get", err)
 	}
 
-	key := keyFromWire(req.Key)
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Get", err)
+	}
+
+	key := tenancy.Key(tenant, keyFromWire(req.Key))
 
 	doc, err := s.documentPlugin.Get(key)
 	if err != nil {
 		return nil, NewGrpcError("DocumentService.Get", err)
 	}
 
-	pbDoc, err := documentToWire(doc)
+	pbDoc, err := documentToWire(tenantDocument(tenant, doc))
 	if err != nil {
 		return nil, NewGrpcError("DocumentService.Get", err)
 	}
@@ -76,9 +128,38 @@ func (s *DocumentServiceServer) Set(ctx context.Context, req *pb.DocumentSetRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Set", err)
 	}
 
-	key := keyFromWire(req.Key)
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Set", err)
+	}
+
+	key := tenancy.Key(tenant, keyFromWire(req.Key))
+
+	if len(req.GetOutbox()) > 0 {
+		if s.outbox == nil {
+			return nil, newGrpcErrorWithCode(codes.FailedPrecondition, "DocumentService.Set", fmt.Errorf("outbox is not enabled"))
+		}
+
+		pending := make([]*outbox.PendingEvent, 0, len(req.GetOutbox()))
+		for _, evt := range req.GetOutbox() {
+			event := &events.NitricEvent{
+				ID:          evt.GetId(),
+				PayloadType: evt.GetPayloadType(),
+				Payload:     evt.GetPayload().AsMap(),
+			}
+			tenancy.TagEvent(tenant, event)
+
+			pending = append(pending, &outbox.PendingEvent{
+				Topic: evt.GetTopic(),
+				Event: event,
+			})
+		}
+
+		err = s.outbox.Set(key, req.GetContent().AsMap(), pending)
+	} else {
+		err = s.documentPlugin.Set(key, req.GetContent().AsMap())
+	}
 
-	err := s.documentPlugin.Set(key, req.GetContent().AsMap())
 	if err != nil {
 		return nil, NewGrpcError("DocumentService.Set", err)
 	}
@@ -95,9 +176,14 @@ func (s *DocumentServiceServer) Delete(ctx context.Context, req *pb.DocumentDele
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Delete", err)
 	}
 
-	key := keyFromWire(req.Key)
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Delete", err)
+	}
 
-	err := s.documentPlugin.Delete(key)
+	key := tenancy.Key(tenant, keyFromWire(req.Key))
+
+	err = s.documentPlugin.Delete(key)
 	if err != nil {
 		return nil, NewGrpcError("DocumentService.Delete", err)
 	}
@@ -105,6 +191,91 @@ func (s *DocumentServiceServer) Delete(ctx context.Context, req *pb.DocumentDele
 	return &pb.DocumentDeleteResponse{}, nil
 }
 
+func (s *DocumentServiceServer) SetBatch(ctx context.Context, req *pb.DocumentSetBatchRequest) (*pb.DocumentSetBatchResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.SetBatch", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.SetBatch", err)
+	}
+
+	docs := make([]document.Document, 0, len(req.GetDocuments()))
+	for _, doc := range req.GetDocuments() {
+		docs = append(docs, document.Document{
+			Key:     tenancy.Key(tenant, keyFromWire(doc.GetKey())),
+			Content: doc.GetContent().AsMap(),
+		})
+	}
+
+	resp, err := s.documentPlugin.SetBatch(docs)
+	if err != nil {
+		return nil, NewGrpcError("DocumentService.SetBatch", err)
+	}
+
+	return &pb.DocumentSetBatchResponse{
+		FailedWrites: failedWritesToWire(tenant, resp.FailedWrites),
+	}, nil
+}
+
+func (s *DocumentServiceServer) DeleteBatch(ctx context.Context, req *pb.DocumentDeleteBatchRequest) (*pb.DocumentDeleteBatchResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.DeleteBatch", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.DeleteBatch", err)
+	}
+
+	keys := make([]*document.Key, 0, len(req.GetKeys()))
+	for _, key := range req.GetKeys() {
+		keys = append(keys, tenancy.Key(tenant, keyFromWire(key)))
+	}
+
+	resp, err := s.documentPlugin.DeleteBatch(keys)
+	if err != nil {
+		return nil, NewGrpcError("DocumentService.DeleteBatch", err)
+	}
+
+	return &pb.DocumentDeleteBatchResponse{
+		FailedWrites: failedWritesToWire(tenant, resp.FailedWrites),
+	}, nil
+}
+
+func (s *DocumentServiceServer) Increment(ctx context.Context, req *pb.DocumentIncrementRequest) (*pb.DocumentIncrementResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Increment", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Increment", err)
+	}
+
+	value, err := s.documentPlugin.Increment(tenancy.Key(tenant, keyFromWire(req.GetKey())), req.GetField(), req.GetDelta())
+	if err != nil {
+		return nil, NewGrpcError("DocumentService.Increment", err)
+	}
+
+	return &pb.DocumentIncrementResponse{
+		Value: value,
+	}, nil
+}
+
 func (s *DocumentServiceServer) Query(ctx context.Context, req *pb.DocumentQueryRequest) (*pb.DocumentQueryResponse, error) {
 	if err := s.checkPluginRegistered(); err != nil {
 		return nil, err
@@ -114,7 +285,12 @@ func (s *DocumentServiceServer) Query(ctx context.Context, req *pb.DocumentQuery
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Query", err)
 	}
 
-	collection := collectionFromWire(req.Collection)
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Query", err)
+	}
+
+	collection := tenancy.Collection(tenant, collectionFromWire(req.Collection))
 	expressions := expressionsFromWire(req.GetExpressions())
 
 	limit := int(req.GetLimit())
@@ -127,7 +303,7 @@ func (s *DocumentServiceServer) Query(ctx context.Context, req *pb.DocumentQuery
 
 	pbDocuments := make([]*pb.Document, 0, len(qr.Documents))
 	for _, doc := range qr.Documents {
-		pbDoc, err := documentToWire(&doc)
+		pbDoc, err := documentToWire(tenantDocument(tenant, &doc))
 		if err != nil {
 			return nil, NewGrpcError("DocumentService.Query", err)
 		}
@@ -135,6 +311,10 @@ func (s *DocumentServiceServer) Query(ctx context.Context, req *pb.DocumentQuery
 		pbDocuments = append(pbDocuments, pbDoc)
 	}
 
+	if qr.RequestCharge > 0 {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestChargeMetadataKey, strconv.FormatFloat(qr.RequestCharge, 'f', -1, 64)))
+	}
+
 	return &pb.DocumentQueryResponse{
 		Documents:   pbDocuments,
 		PagingToken: qr.PagingToken,
@@ -146,7 +326,16 @@ func (s *DocumentServiceServer) QueryStream(req *pb.DocumentQueryStreamRequest,
 		return err
 	}
 
-	col := collectionFromWire(req.Collection)
+	if err := req.ValidateAll(); err != nil {
+		return newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.QueryStream", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(srv.Context())
+	if err != nil {
+		return newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.QueryStream", err)
+	}
+
+	col := tenancy.Collection(tenant, collectionFromWire(req.Collection))
 	expressions := expressionsFromWire(req.Expressions)
 
 	next := s.documentPlugin.QueryStream(col, expressions, int(req.Limit))
@@ -156,8 +345,8 @@ func (s *DocumentServiceServer) QueryStream(req *pb.DocumentQueryStreamRequest,
 			return NewGrpcError("DocumentService.QueryStream", err)
 		}
 
-		if d, docErr := documentToWire(doc); docErr != nil {
-			return NewGrpcError("DocumentService.QueryStream", err)
+		if d, docErr := documentToWire(tenantDocument(tenant, doc)); docErr != nil {
+			return NewGrpcError("DocumentService.QueryStream", docErr)
 		} else {
 			err = srv.Send(&pb.DocumentQueryStreamResponse{
 				Document: d,
@@ -171,10 +360,86 @@ func (s *DocumentServiceServer) QueryStream(req *pb.DocumentQueryStreamRequest,
 	return nil
 }
 
-func NewDocumentServer(docPlugin document.DocumentService) pb.DocumentServiceServer {
-	return &DocumentServiceServer{
+func (s *DocumentServiceServer) Aggregate(ctx context.Context, req *pb.DocumentAggregateRequest) (*pb.DocumentAggregateResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Aggregate", err)
+	}
+
+	aggregations := make([]document.Aggregation, 0, len(req.GetAggregations()))
+	for _, a := range req.GetAggregations() {
+		aggregations = append(aggregations, document.Aggregation{
+			Function: a.GetFunction(),
+			Field:    a.GetField(),
+		})
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Aggregate", err)
+	}
+
+	results, err := s.documentPlugin.Aggregate(tenancy.Collection(tenant, collectionFromWire(req.Collection)), expressionsFromWire(req.GetExpressions()), aggregations)
+	if err != nil {
+		return nil, NewGrpcError("DocumentService.Aggregate", err)
+	}
+
+	pbResults := make([]*pb.AggregateResult, 0, len(results))
+	for i, result := range results {
+		value, err := structpb.NewValue(result.Value)
+		if err != nil {
+			return nil, NewGrpcError("DocumentService.Aggregate", err)
+		}
+
+		pbResults = append(pbResults, &pb.AggregateResult{
+			Aggregation: req.GetAggregations()[i],
+			Value:       value,
+		})
+	}
+
+	return &pb.DocumentAggregateResponse{
+		Results: pbResults,
+	}, nil
+}
+
+func NewDocumentServer(docPlugin document.DocumentService, opts ...DocumentServiceServerOption) pb.DocumentServiceServer {
+	server := &DocumentServiceServer{
 		documentPlugin: docPlugin,
 	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
+
+// tenantDocument - returns the document with its key as the tenant addressed it
+func tenantDocument(tenant string, doc *document.Document) *document.Document {
+	if tenant == "" {
+		return doc
+	}
+
+	return &document.Document{
+		Key:     tenancy.StripKey(tenant, doc.Key),
+		Content: doc.Content,
+	}
+}
+
+// failedWritesToWire - returns the failed writes with their keys as the tenant addressed them
+func failedWritesToWire(tenant string, failed []*document.FailedWrite) []*pb.FailedWrite {
+	wire := make([]*pb.FailedWrite, 0, len(failed))
+	for _, f := range failed {
+		wire = append(wire, &pb.FailedWrite{
+			Key:     keyToWire(tenancy.StripKey(tenant, f.Key)),
+			Message: f.Message,
+		})
+	}
+
+	return wire
 }
 
 func documentToWire(doc *document.Document) (*pb.Document, error) {
@@ -271,5 +536,35 @@ func toExpValue(x *pb.ExpressionValue) interface{} {
 	if x, ok := x.GetKind().(*pb.ExpressionValue_BoolValue); ok {
 		return x.BoolValue
 	}
+	if x, ok := x.GetKind().(*pb.ExpressionValue_ListValue); ok {
+		values := make([]interface{}, 0, len(x.ListValue.GetValues()))
+		for _, v := range x.ListValue.GetValues() {
+			values = append(values, toExpValue(v))
+		}
+		return values
+	}
+	if x, ok := x.GetKind().(*pb.ExpressionValue_GroupsValue); ok {
+		groups := make([][]document.QueryExpression, 0, len(x.GroupsValue.GetGroups()))
+		for _, g := range x.GroupsValue.GetGroups() {
+			groups = append(groups, expressionsFromWire(g.GetExpressions()))
+		}
+		return groups
+	}
+	if x, ok := x.GetKind().(*pb.ExpressionValue_GeoRadiusValue); ok {
+		return document.GeoRadius{
+			Center: geoPointFromWire(x.GeoRadiusValue.GetCenter()),
+			Meters: x.GeoRadiusValue.GetMeters(),
+		}
+	}
+	if x, ok := x.GetKind().(*pb.ExpressionValue_GeoBoxValue); ok {
+		return document.GeoBox{
+			SouthWest: geoPointFromWire(x.GeoBoxValue.GetSouthWest()),
+			NorthEast: geoPointFromWire(x.GeoBoxValue.GetNorthEast()),
+		}
+	}
 	return nil
 }
+
+func geoPointFromWire(p *pb.GeoPoint) document.GeoPoint {
+	return document.GeoPoint{Lat: p.GetLat(), Lng: p.GetLng()}
+}
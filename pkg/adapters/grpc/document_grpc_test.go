@@ -16,13 +16,18 @@ package grpc_test
 
 import (
 	"context"
+	"io"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/golang/mock/gomock"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	mock_document "github.com/nitrictech/nitric/mocks/document"
+	mock_nitric "github.com/nitrictech/nitric/mocks/nitric"
 	"github.com/nitrictech/nitric/pkg/adapters/grpc"
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/document"
@@ -159,6 +164,195 @@ var _ = Describe("GRPC Document", func() {
 				Expect(resp.Documents[0].Key.Id).Should(Equal("123456"))
 			})
 		})
+
+		When("an expression has a list value", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Query(&document.Collection{Name: "zed"}, []document.QueryExpression{
+				{
+					Operand:  "status",
+					Operator: "in",
+					Value:    []interface{}{"open", int64(3)},
+				},
+			}, 0, nil).Return(&document.QueryResult{
+				Documents: []document.Document{},
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			_, err := dss.Query(context.Background(), &v1.DocumentQueryRequest{
+				Collection: &v1.Collection{
+					Name: "zed",
+				},
+				Expressions: []*v1.Expression{
+					{
+						Operand:  "status",
+						Operator: "in",
+						Value: &v1.ExpressionValue{Kind: &v1.ExpressionValue_ListValue{ListValue: &v1.ExpressionValueList{
+							Values: []*v1.ExpressionValue{
+								{Kind: &v1.ExpressionValue_StringValue{StringValue: "open"}},
+								{Kind: &v1.ExpressionValue_IntValue{IntValue: 3}},
+							},
+						}}},
+					},
+				},
+			})
+
+			It("Should query with the list of values", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("the provider reports the request charge", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Query(&document.Collection{Name: "zed"}, gomock.Any(), 0, gomock.Any()).Return(&document.QueryResult{
+				Documents:     []document.Document{},
+				RequestCharge: 2.5,
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			stream := &headerStream{}
+			_, err := dss.Query(grpcgo.NewContextWithServerTransportStream(context.Background(), stream), &v1.DocumentQueryRequest{
+				Collection: &v1.Collection{Name: "zed"},
+			})
+
+			It("Should send it in the response header", func() {
+				Expect(err).Should(BeNil())
+				Expect(stream.header.Get(grpc.RequestChargeMetadataKey)).To(Equal([]string{"2.5"}))
+			})
+		})
+
+		When("an expression has groups of expressions", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Query(&document.Collection{Name: "zed"}, []document.QueryExpression{
+				document.Or(
+					[]document.QueryExpression{{Operand: "status", Operator: "==", Value: "open"}},
+					[]document.QueryExpression{{Operand: "age", Operator: ">", Value: int64(3)}},
+				),
+			}, 0, nil).Return(&document.QueryResult{
+				Documents: []document.Document{},
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			_, err := dss.Query(context.Background(), &v1.DocumentQueryRequest{
+				Collection: &v1.Collection{
+					Name: "zed",
+				},
+				Expressions: []*v1.Expression{
+					{
+						Operator: "or",
+						Value: &v1.ExpressionValue{Kind: &v1.ExpressionValue_GroupsValue{GroupsValue: &v1.ExpressionGroupList{
+							Groups: []*v1.ExpressionGroup{
+								{Expressions: []*v1.Expression{{
+									Operand:  "status",
+									Operator: "==",
+									Value:    &v1.ExpressionValue{Kind: &v1.ExpressionValue_StringValue{StringValue: "open"}},
+								}}},
+								{Expressions: []*v1.Expression{{
+									Operand:  "age",
+									Operator: ">",
+									Value:    &v1.ExpressionValue{Kind: &v1.ExpressionValue_IntValue{IntValue: 3}},
+								}}},
+							},
+						}}},
+					},
+				},
+			})
+
+			It("Should query with an or expression", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("an expression has a geo radius", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Query(&document.Collection{Name: "stores"}, []document.QueryExpression{{
+				Operand:  "location",
+				Operator: document.GeoRadiusOperator,
+				Value:    document.GeoRadius{Center: document.GeoPoint{Lat: -33.87, Lng: 151.21}, Meters: 500},
+			}}, 0, nil).Return(&document.QueryResult{
+				Documents: []document.Document{},
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			_, err := dss.Query(context.Background(), &v1.DocumentQueryRequest{
+				Collection: &v1.Collection{
+					Name: "stores",
+				},
+				Expressions: []*v1.Expression{
+					{
+						Operand:  "location",
+						Operator: "within-radius",
+						Value: &v1.ExpressionValue{Kind: &v1.ExpressionValue_GeoRadiusValue{GeoRadiusValue: &v1.GeoRadius{
+							Center: &v1.GeoPoint{Lat: -33.87, Lng: 151.21},
+							Meters: 500,
+						}}},
+					},
+				},
+			})
+
+			It("Should query with a geo expression", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("QueryStream", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			mockSrv := mock_nitric.NewMockDocumentService_QueryStreamServer(g)
+			dss := grpc.NewDocumentServer(mockDS)
+
+			err := dss.QueryStream(&v1.DocumentQueryStreamRequest{}, mockSrv)
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid DocumentQueryStreamRequest.Collection: value is required"))
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			mockSrv := mock_nitric.NewMockDocumentService_QueryStreamServer(g)
+
+			docs := []*document.Document{
+				{Key: &document.Key{Collection: &document.Collection{Name: "test"}, Id: "1"}, Content: map[string]interface{}{"a": "1"}},
+				{Key: &document.Key{Collection: &document.Collection{Name: "test"}, Id: "2"}, Content: map[string]interface{}{"a": "2"}},
+			}
+			mockDS.EXPECT().QueryStream(&document.Collection{Name: "test"}, []document.QueryExpression{}, 0).Return(func() (*document.Document, error) {
+				if len(docs) == 0 {
+					return nil, io.EOF
+				}
+
+				doc := docs[0]
+				docs = docs[1:]
+				return doc, nil
+			})
+
+			sent := make([]string, 0)
+			mockSrv.EXPECT().Context().Return(context.Background())
+			mockSrv.EXPECT().Send(gomock.Any()).Times(2).DoAndReturn(func(resp *v1.DocumentQueryStreamResponse) error {
+				sent = append(sent, resp.Document.Key.Id)
+				return nil
+			})
+
+			dss := grpc.NewDocumentServer(mockDS)
+			err := dss.QueryStream(&v1.DocumentQueryStreamRequest{
+				Collection: &v1.Collection{Name: "test"},
+			}, mockSrv)
+
+			It("Should send each document", func() {
+				Expect(err).Should(BeNil())
+				Expect(sent).To(Equal([]string{"1", "2"}))
+			})
+		})
 	})
 
 	Context("Set", func() {
@@ -221,6 +415,29 @@ var _ = Describe("GRPC Document", func() {
 				Expect(resp.String()).Should(Equal(""))
 			})
 		})
+
+		When("events are provided without the outbox enabled", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			content, _ := protoutils.NewStruct(map[string]interface{}{"x": "y"})
+
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.Set(context.Background(), &v1.DocumentSetRequest{
+				Key: &v1.Key{
+					Collection: &v1.Collection{Name: "test"},
+					Id:         "123456",
+				},
+				Content: content,
+				Outbox: []*v1.DocumentOutboxEvent{
+					{Topic: "created"},
+				},
+			})
+
+			It("Should report an error without writing the document", func() {
+				Expect(err.Error()).Should(ContainSubstring("outbox is not enabled"))
+				Expect(resp).Should(BeNil())
+			})
+		})
 	})
 
 	Context("Delete", func() {
@@ -283,4 +500,208 @@ var _ = Describe("GRPC Document", func() {
 			})
 		})
 	})
+
+	Context("SetBatch", func() {
+		When("plugin not registered", func() {
+			dss := &grpc.DocumentServiceServer{}
+			resp, err := dss.SetBatch(context.Background(), &v1.DocumentSetBatchRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Document plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request has no documents", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.SetBatch(context.Background(), &v1.DocumentSetBatchRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid DocumentSetBatchRequest.Documents: value must contain at least 1 item(s)"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("some writes fail", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			ok := &document.Key{Collection: &document.Collection{Name: "test"}, Id: "1"}
+			bad := &document.Key{Collection: &document.Collection{Name: "test"}, Id: "2"}
+			content, _ := protoutils.NewStruct(map[string]interface{}{"x": "y"})
+
+			mockDS.EXPECT().SetBatch([]document.Document{
+				{Key: ok, Content: map[string]interface{}{"x": "y"}},
+				{Key: bad, Content: map[string]interface{}{"x": "y"}},
+			}).Return(&document.BatchResponse{
+				FailedWrites: []*document.FailedWrite{{Key: bad, Message: "too large"}},
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.SetBatch(context.Background(), &v1.DocumentSetBatchRequest{
+				Documents: []*v1.Document{
+					{Key: &v1.Key{Collection: &v1.Collection{Name: "test"}, Id: "1"}, Content: content},
+					{Key: &v1.Key{Collection: &v1.Collection{Name: "test"}, Id: "2"}, Content: content},
+				},
+			})
+
+			It("Should report the failed writes", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.FailedWrites).To(HaveLen(1))
+				Expect(resp.FailedWrites[0].Key.Id).To(Equal("2"))
+				Expect(resp.FailedWrites[0].Message).To(Equal("too large"))
+			})
+		})
+	})
+
+	Context("DeleteBatch", func() {
+		When("plugin not registered", func() {
+			dss := &grpc.DocumentServiceServer{}
+			resp, err := dss.DeleteBatch(context.Background(), &v1.DocumentDeleteBatchRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Document plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request has no keys", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.DeleteBatch(context.Background(), &v1.DocumentDeleteBatchRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid DocumentDeleteBatchRequest.Keys: value must contain at least 1 item(s)"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			key := &document.Key{Collection: &document.Collection{Name: "test"}, Id: "1"}
+
+			mockDS.EXPECT().DeleteBatch([]*document.Key{key}).Return(&document.BatchResponse{}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.DeleteBatch(context.Background(), &v1.DocumentDeleteBatchRequest{
+				Keys: []*v1.Key{{Collection: &v1.Collection{Name: "test"}, Id: "1"}},
+			})
+
+			It("Should delete the docs", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.FailedWrites).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Increment", func() {
+		When("request has no field", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.Increment(context.Background(), &v1.DocumentIncrementRequest{
+				Key: &v1.Key{Collection: &v1.Collection{Name: "stock"}, Id: "widget"},
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid DocumentIncrementRequest.Field"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			key := &document.Key{Collection: &document.Collection{Name: "stock"}, Id: "widget"}
+
+			mockDS.EXPECT().Increment(key, "quantity", int64(-1)).Return(int64(9), nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.Increment(context.Background(), &v1.DocumentIncrementRequest{
+				Key:   &v1.Key{Collection: &v1.Collection{Name: "stock"}, Id: "widget"},
+				Field: "quantity",
+				Delta: -1,
+			})
+
+			It("Should return the field's new value", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Value).To(Equal(int64(9)))
+			})
+		})
+	})
+
+	Context("Aggregate", func() {
+		When("plugin not registered", func() {
+			dss := &grpc.DocumentServiceServer{}
+			resp, err := dss.Aggregate(context.Background(), &v1.DocumentAggregateRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Document plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request has an unsupported aggregate function", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.Aggregate(context.Background(), &v1.DocumentAggregateRequest{
+				Collection:   &v1.Collection{Name: "orders"},
+				Aggregations: []*v1.Aggregation{{Function: "median", Field: "price"}},
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid DocumentAggregateRequest.Aggregations[0]"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			collection := &document.Collection{Name: "orders"}
+			aggregations := []document.Aggregation{
+				{Function: document.AggregateCount},
+				{Function: document.AggregateAvg, Field: "price"},
+			}
+
+			mockDS.EXPECT().Aggregate(collection, []document.QueryExpression{}, aggregations).Return([]document.AggregateResult{
+				{Aggregation: aggregations[0], Value: int64(2)},
+				{Aggregation: aggregations[1], Value: nil},
+			}, nil)
+
+			dss := grpc.NewDocumentServer(mockDS)
+			resp, err := dss.Aggregate(context.Background(), &v1.DocumentAggregateRequest{
+				Collection: &v1.Collection{Name: "orders"},
+				Aggregations: []*v1.Aggregation{
+					{Function: "count"},
+					{Function: "avg", Field: "price"},
+				},
+			})
+
+			It("Should return the results in the order they were requested", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Results).To(HaveLen(2))
+				Expect(resp.Results[0].Aggregation.Function).To(Equal("count"))
+				Expect(resp.Results[0].Value.GetNumberValue()).To(Equal(float64(2)))
+				Expect(resp.Results[1].Value.GetNullValue()).To(Equal(structpb.NullValue_NULL_VALUE))
+			})
+		})
+	})
 })
+
+// headerStream - records the headers set by a server method
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(md metadata.MD) error { return nil }
@@ -17,6 +17,8 @@ package grpc
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,14 +29,17 @@ import (
 
 // Provides GRPC error reporting
 func NewGrpcError(operation string, err error) error {
-	if pe, ok := err.(*errors.PluginError); ok {
-		code := codes.Code(errors.Code(pe))
+	if pe, ok := errors.AsPluginError(err); ok {
+		code := codes.Code(pe.Code)
 
 		ed := &v1.ErrorDetails{}
 		ed.Message = pe.Msg
 		if pe.Cause != nil {
 			ed.Cause = pe.Cause.Error()
 		}
+		ed.Retryable = pe.Retryable
+		ed.ProviderCode = pe.ProviderCode
+		ed.Resources = pe.Resources
 		ed.Scope = &v1.ErrorScope{
 			Service: operation,
 			Plugin:  pe.Plugin,
@@ -80,9 +85,17 @@ func NewPluginNotRegisteredError(plugin string) error {
 	return s.Err()
 }
 
+// LogArg - formats a plugin arg for error details, only including struct fields with a log tag.
+// Map entries are sorted by key, so the same args are always formatted the same way
 func LogArg(arg interface{}) string {
 	value := getValue(arg)
 
+	if t, ok := arg.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	} else if t, ok := arg.(*time.Time); ok && t != nil {
+		return t.Format(time.RFC3339Nano)
+	}
+
 	if value.Kind() == reflect.Struct {
 		str := "{"
 		for i := 0; i < value.NumField(); i++ {
@@ -103,13 +116,18 @@ func LogArg(arg interface{}) string {
 
 		return str
 	} else if value.Kind() == reflect.Map {
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+		})
+
 		str := "{"
 
-		for k, v := range arg.(map[string]interface{}) {
+		for _, k := range keys {
 			if len(str) > 1 {
 				str += ", "
 			}
-			str += fmt.Sprintf("%v", k) + ": " + LogArg(v)
+			str += fmt.Sprintf("%v", k.Interface()) + ": " + LogArg(value.MapIndex(k).Interface())
 		}
 
 		str += "}"
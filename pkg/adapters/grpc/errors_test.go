@@ -16,11 +16,15 @@ package grpc_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 )
@@ -64,6 +68,37 @@ var _ = Describe("GRPC Errors", func() {
 				Expect(grpcErr.Error()).To(ContainSubstring("rpc error: code = InvalidArgument desc = bad param"))
 			})
 		})
+		When("plugin.errors.Unavailable with resources", func() {
+			It("Should report the resources, provider code and retryability in the error details", func() {
+				newErr := errors.ErrorsWithScope("test", map[string]interface{}{"bucket": "images", "key": "cat.png", "options": 1})
+				err := newErr(
+					codes.Unavailable,
+					"service unavailable",
+					status.Error(grpcCodes.ResourceExhausted, "quota exceeded"),
+				)
+				// Wrapped plugin errors keep their details
+				grpcErr := grpc.NewGrpcError("BadServer.BadCall", fmt.Errorf("wrapped: %w", err))
+
+				s, ok := status.FromError(grpcErr)
+				Expect(ok).To(BeTrue())
+				Expect(s.Code()).To(Equal(grpcCodes.Unavailable))
+				Expect(s.Details()).To(HaveLen(1))
+
+				ed, ok := s.Details()[0].(*v1.ErrorDetails)
+				Expect(ok).To(BeTrue())
+				Expect(ed.Retryable).To(BeTrue())
+				Expect(ed.ProviderCode).To(Equal("ResourceExhausted"))
+				Expect(ed.Resources).To(Equal(map[string]string{"bucket": "images", "key": "cat.png"}))
+			})
+		})
+		When("plugin.errors.NotFound", func() {
+			It("Should not be retryable", func() {
+				newErr := errors.ErrorsWithScope("test", nil)
+				err := newErr(codes.NotFound, "not found", nil)
+				Expect(errors.Retryable(err)).To(BeFalse())
+				Expect(errors.Code(fmt.Errorf("wrapped: %w", err))).To(Equal(codes.NotFound))
+			})
+		})
 		When("Standard Error", func() {
 			It("Should report GRPC Internal error", func() {
 				err := fmt.Errorf("internal error")
@@ -144,6 +179,18 @@ var _ = Describe("GRPC Errors", func() {
 				Expect(value).To(ContainSubstring("secret: {Name: name, Version: 3, Value: {Type: key}}"))
 				Expect(value).To(ContainSubstring("key: value"))
 			})
+
+			It("return entries sorted by key", func() {
+				value := grpc.LogArg(map[string]string{"b": "2", "c": "3", "a": "1"})
+				Expect(value).To(Equal("{a: 1, b: 2, c: 3}"))
+			})
+		})
+
+		When("time", func() {
+			It("return RFC 3339 value", func() {
+				t := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+				Expect(grpc.LogArg(t)).To(Equal("2022-01-02T03:04:05Z"))
+			})
 		})
 	})
 })
@@ -16,18 +16,57 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/tenancy"
 )
 
 // GRPC Interface for registered Nitric events Plugins
 type EventServiceServer struct {
 	pb.UnimplementedEventServiceServer
 	eventPlugin events.EventService
+	schemas     *schema.Registry
+	tenancy     *tenancy.Tenancy
+}
+
+type EventServiceServerOption interface {
+	Apply(*EventServiceServer)
+}
+
+type withTopicSchemas struct {
+	schemas *schema.Registry
+}
+
+func (w *withTopicSchemas) Apply(server *EventServiceServer) {
+	server.schemas = w.schemas
+}
+
+// WithTopicSchemas - reject published events with payloads that don't conform to their topic's schema
+func WithTopicSchemas(schemas *schema.Registry) EventServiceServerOption {
+	return &withTopicSchemas{
+		schemas: schemas,
+	}
+}
+
+type withEventTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withEventTenancy) Apply(server *EventServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithEventTenancy - tags published events with the tenant of each call
+func WithEventTenancy(t *tenancy.Tenancy) EventServiceServerOption {
+	return &withEventTenancy{
+		tenancy: t,
+	}
 }
 
 func (s *EventServiceServer) checkPluginRegistered() error {
@@ -47,6 +86,11 @@ func (s *EventServiceServer) Publish(ctx context.Context, req *pb.EventPublishRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "EventService.Publish", err)
 	}
 
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "EventService.Publish", err)
+	}
+
 	// auto generate an ID if we did not receive one
 	ID := req.GetEvent().GetId()
 	if ID == "" {
@@ -57,7 +101,14 @@ func (s *EventServiceServer) Publish(ctx context.Context, req *pb.EventPublishRe
 		ID:          ID,
 		PayloadType: req.GetEvent().GetPayloadType(),
 		Payload:     req.GetEvent().GetPayload().AsMap(),
+		OrderingKey: req.GetEvent().GetOrderingKey(),
 	}
+	tenancy.TagEvent(tenant, event)
+
+	if err := s.schemas.Validate(schema.Topic, req.GetTopic(), event.Payload); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "EventService.Publish", err)
+	}
+
 	if err := s.eventPlugin.Publish(req.GetTopic(), event); err == nil {
 		return &pb.EventPublishResponse{
 			Id: ID,
@@ -67,10 +118,16 @@ func (s *EventServiceServer) Publish(ctx context.Context, req *pb.EventPublishRe
 	}
 }
 
-func NewEventServiceServer(eventsPlugin events.EventService) pb.EventServiceServer {
-	return &EventServiceServer{
+func NewEventServiceServer(eventsPlugin events.EventService, opts ...EventServiceServerOption) pb.EventServiceServer {
+	server := &EventServiceServer{
 		eventPlugin: eventsPlugin,
 	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
 }
 
 type TopicServiceServer struct {
@@ -111,6 +168,28 @@ func (s *TopicServiceServer) List(ctx context.Context, req *pb.TopicListRequest)
 	}
 }
 
+func (s *TopicServiceServer) Replay(ctx context.Context, req *pb.TopicReplayRequest) (*pb.TopicReplayResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TopicService.Replay", err)
+	}
+
+	// An unset upper bound replays everything published since from
+	to := time.Time{}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	if err := s.eventPlugin.Replay(req.GetTopic(), req.GetFrom().AsTime(), to); err == nil {
+		return &pb.TopicReplayResponse{}, nil
+	} else {
+		return nil, NewGrpcError("TopicService.Replay", err)
+	}
+}
+
 func NewTopicServiceServer(eventService events.EventService) pb.TopicServiceServer {
 	// The external topic/event interfaces are separate. Internally, they're fulfilled together,
 	// so the event plugin is all that's needed for both the Event and Topic servers currently.
@@ -16,14 +16,20 @@ package grpc_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/nitrictech/nitric/pkg/adapters/grpc"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/schema"
 )
 
 type MockEventService struct {
@@ -33,6 +39,13 @@ type MockEventService struct {
 
 	TopicList      []string
 	TopicListError error
+
+	ReplayError error
+	ReplayTopic string
+	ReplayFrom  time.Time
+	ReplayTo    time.Time
+
+	Backlogs map[string]int64
 }
 
 func (m *MockEventService) Publish(topic string, event *events.NitricEvent) error {
@@ -45,8 +58,49 @@ func (m *MockEventService) ListTopics() ([]string, error) {
 	return m.TopicList, m.TopicListError
 }
 
+func (m *MockEventService) Replay(topic string, from time.Time, to time.Time) error {
+	m.ReplayTopic = topic
+	m.ReplayFrom = from
+	m.ReplayTo = to
+	return m.ReplayError
+}
+
+func (m *MockEventService) Backlog(topic string) (int64, error) {
+	return m.Backlogs[topic], nil
+}
+
 var _ = Describe("Event Service gRPC Adapter", func() {
 	Context("Publish", func() {
+		When("The payload doesn't conform to the topic's schema", func() {
+			mockService := &MockEventService{}
+			schemas := schema.NewRegistry()
+			_ = schemas.Register(schema.Topic, "test-topic", []byte(`{
+				"type": "object",
+				"properties": {
+					"amount": { "type": "number" }
+				}
+			}`))
+
+			eventServer := grpc.NewEventServiceServer(mockService, grpc.WithTopicSchemas(schemas))
+			_, err := eventServer.Publish(context.Background(), &v1.EventPublishRequest{
+				Topic: "test-topic",
+				Event: &v1.NitricEvent{
+					Payload: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"amount": structpb.NewStringValue("ten"),
+					}},
+				},
+			})
+
+			It("Should return an invalid argument error", func() {
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(err.Error()).To(ContainSubstring("/amount"))
+			})
+
+			It("Should not publish the event", func() {
+				Expect(mockService.PublishEvent).To(BeNil())
+			})
+		})
+
 		When("No request id is provided", func() {
 			mockService := &MockEventService{
 				PublishError:   nil,
@@ -106,3 +160,65 @@ var _ = Describe("Event Service gRPC Adapter", func() {
 		})
 	})
 })
+
+var _ = Describe("Topic Service gRPC Adapter", func() {
+	Context("Replay", func() {
+		from := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+		When("No from time is provided", func() {
+			mockService := &MockEventService{}
+
+			topicServer := grpc.NewTopicServiceServer(mockService)
+			_, err := topicServer.Replay(context.Background(), &v1.TopicReplayRequest{
+				Topic: "test-topic",
+			})
+
+			It("Should return an invalid argument error", func() {
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+
+			It("Should not replay the topic", func() {
+				Expect(mockService.ReplayTopic).To(BeEmpty())
+			})
+		})
+
+		When("No to time is provided", func() {
+			mockService := &MockEventService{}
+
+			topicServer := grpc.NewTopicServiceServer(mockService)
+			_, err := topicServer.Replay(context.Background(), &v1.TopicReplayRequest{
+				Topic: "test-topic",
+				From:  timestamppb.New(from),
+			})
+
+			It("Should not return an error", func() {
+				Expect(err).To(BeNil())
+			})
+
+			It("Should replay all events since the from time", func() {
+				Expect(mockService.ReplayTopic).To(Equal("test-topic"))
+				Expect(mockService.ReplayFrom).To(BeTemporally("==", from))
+				Expect(mockService.ReplayTo.IsZero()).To(BeTrue())
+			})
+		})
+
+		When("A to time is provided", func() {
+			mockService := &MockEventService{}
+
+			topicServer := grpc.NewTopicServiceServer(mockService)
+			_, err := topicServer.Replay(context.Background(), &v1.TopicReplayRequest{
+				Topic: "test-topic",
+				From:  timestamppb.New(from),
+				To:    timestamppb.New(from.Add(time.Hour)),
+			})
+
+			It("Should not return an error", func() {
+				Expect(err).To(BeNil())
+			})
+
+			It("Should pass the time range to the implementing service plugin", func() {
+				Expect(mockService.ReplayTo).To(BeTemporally("==", from.Add(time.Hour)))
+			})
+		})
+	})
+})
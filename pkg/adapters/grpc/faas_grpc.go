@@ -22,12 +22,67 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/worker"
 )
 
 type FaasServer struct {
 	pb.UnimplementedFaasServiceServer
-	pool worker.WorkerPool
+	pool    worker.WorkerPool
+	runtime worker.RuntimeHandler
+	// The stack and environment triggers are handled in, sent with each trigger
+	identity *stack.Identity
+	// Trigger data of at least this many bytes is compressed for workers that accept it, disabled if zero
+	compressionMinSize int
+}
+
+type FaasServerOption interface {
+	Apply(*FaasServer)
+}
+
+type withRuntime struct {
+	runtime worker.RuntimeHandler
+}
+
+func (w *withRuntime) Apply(server *FaasServer) {
+	server.runtime = w.runtime
+}
+
+// WithRuntime - enables workers to make runtime API calls over their trigger streams
+func WithRuntime(runtime worker.RuntimeHandler) FaasServerOption {
+	return &withRuntime{
+		runtime: runtime,
+	}
+}
+
+type withStack struct {
+	identity *stack.Identity
+}
+
+func (w *withStack) Apply(server *FaasServer) {
+	server.identity = w.identity
+}
+
+// WithStack - tells workers the stack and environment they're handling triggers in, with each trigger
+func WithStack(identity *stack.Identity) FaasServerOption {
+	return &withStack{
+		identity: identity,
+	}
+}
+
+type withPayloadCompression struct {
+	minSize int
+}
+
+func (w *withPayloadCompression) Apply(server *FaasServer) {
+	server.compressionMinSize = w.minSize
+}
+
+// WithPayloadCompression - compresses trigger data of at least minSize bytes for workers that accept a compressed encoding
+func WithPayloadCompression(minSize int) FaasServerOption {
+	return &withPayloadCompression{
+		minSize: minSize,
+	}
 }
 
 // Starts a new stream
@@ -48,7 +103,11 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 	}
 
 	var wrkr worker.Worker
-	adapter := worker.NewGrpcAdapter(stream)
+	adapter := worker.NewGrpcAdapter(stream, s.runtime, s.identity)
+	adapter.SetGeneration(ir.Generation)
+	if s.compressionMinSize > 0 {
+		adapter.NegotiateCompression(ir.AcceptEncodings, s.compressionMinSize)
+	}
 
 	if api := ir.GetApi(); api != nil {
 		// Create a new route worker
@@ -59,11 +118,24 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 		})
 	} else if subscription := ir.GetSubscription(); subscription != nil {
 		wrkr = worker.NewSubscriptionWorker(adapter, &worker.SubscriptionWorkerOptions{
-			Topic: subscription.Topic,
+			Topic:      subscription.Topic,
+			DeadLetter: subscription.DeadLetter,
 		})
 	} else if schedule := ir.GetSchedule(); schedule != nil {
 		wrkr = worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{
-			Key: schedule.Key,
+			Key:  schedule.Key,
+			Rate: schedule.GetRate().GetRate(),
+			Cron: schedule.GetCron().GetCron(),
+		})
+	} else if config := ir.GetConfig(); config != nil {
+		wrkr = worker.NewConfigWorker(adapter, &worker.ConfigWorkerOptions{
+			Key: config.Key,
+		})
+	} else if notification := ir.GetBucketNotification(); notification != nil {
+		wrkr = worker.NewBucketNotificationWorker(adapter, &worker.BucketNotificationWorkerOptions{
+			Bucket:    notification.Bucket,
+			Type:      notificationTypeFromWire(notification.Type),
+			KeyPrefix: notification.KeyPrefix,
 		})
 	} else {
 		// XXX: Catch all worker type
@@ -100,8 +172,26 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 	return err
 }
 
-func NewFaasServer(workerPool worker.WorkerPool) *FaasServer {
-	return &FaasServer{
+// notificationTypeFromWire - returns the notification type a bucket notification worker registered for
+func notificationTypeFromWire(t pb.BucketNotificationType) worker.NotificationType {
+	switch t {
+	case pb.BucketNotificationType_Created:
+		return worker.NotificationCreated
+	case pb.BucketNotificationType_Deleted:
+		return worker.NotificationDeleted
+	default:
+		return worker.NotificationAll
+	}
+}
+
+func NewFaasServer(workerPool worker.WorkerPool, opts ...FaasServerOption) *FaasServer {
+	server := &FaasServer{
 		pool: workerPool,
 	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
 }
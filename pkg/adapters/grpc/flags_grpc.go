@@ -0,0 +1,143 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+)
+
+// GRPC Interface for registered Nitric Flags Plugins
+type FlagsServer struct {
+	pb.UnimplementedFlagsServiceServer
+	flagsPlugin flags.FlagsService
+}
+
+func (s *FlagsServer) checkPluginRegistered() error {
+	if s.flagsPlugin == nil {
+		return NewPluginNotRegisteredError("Flags")
+	}
+
+	return nil
+}
+
+// defaultEvaluation - describes serving the caller's default value
+var defaultEvaluation = &pb.FlagsEvaluation{
+	Reason: flags.ReasonDefault,
+}
+
+// evaluate - evaluates a flag, returning a nil evaluation when the flag doesn't exist so the caller's default is served
+func (s *FlagsServer) evaluate(flag string, ctx *pb.FlagsContext) (*flags.Evaluation, error) {
+	eval, err := s.flagsPlugin.Evaluate(flag, &flags.Context{
+		Key:        ctx.GetKey(),
+		Attributes: ctx.GetAttributes().AsMap(),
+	})
+	if err != nil {
+		if codes.Code(errors.Code(err)) == codes.NotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return eval, nil
+}
+
+func evaluation(eval *flags.Evaluation) *pb.FlagsEvaluation {
+	return &pb.FlagsEvaluation{
+		Reason:    eval.Reason,
+		Variation: eval.Variation,
+	}
+}
+
+func (s *FlagsServer) Bool(ctx context.Context, req *pb.FlagsBoolRequest) (*pb.FlagsBoolResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "FlagsService.Bool", err)
+	}
+
+	eval, err := s.evaluate(req.GetFlag(), req.GetContext())
+	if err != nil {
+		return nil, NewGrpcError("FlagsService.Bool", err)
+	}
+
+	if eval != nil {
+		if value, ok := eval.Value.(bool); ok {
+			return &pb.FlagsBoolResponse{Value: value, Evaluation: evaluation(eval)}, nil
+		}
+	}
+
+	return &pb.FlagsBoolResponse{Value: req.GetDefaultValue(), Evaluation: defaultEvaluation}, nil
+}
+
+func (s *FlagsServer) String(ctx context.Context, req *pb.FlagsStringRequest) (*pb.FlagsStringResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "FlagsService.String", err)
+	}
+
+	eval, err := s.evaluate(req.GetFlag(), req.GetContext())
+	if err != nil {
+		return nil, NewGrpcError("FlagsService.String", err)
+	}
+
+	if eval != nil {
+		if value, ok := eval.Value.(string); ok {
+			return &pb.FlagsStringResponse{Value: value, Evaluation: evaluation(eval)}, nil
+		}
+	}
+
+	return &pb.FlagsStringResponse{Value: req.GetDefaultValue(), Evaluation: defaultEvaluation}, nil
+}
+
+func (s *FlagsServer) Number(ctx context.Context, req *pb.FlagsNumberRequest) (*pb.FlagsNumberResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "FlagsService.Number", err)
+	}
+
+	eval, err := s.evaluate(req.GetFlag(), req.GetContext())
+	if err != nil {
+		return nil, NewGrpcError("FlagsService.Number", err)
+	}
+
+	if eval != nil {
+		if value, ok := eval.Value.(float64); ok {
+			return &pb.FlagsNumberResponse{Value: value, Evaluation: evaluation(eval)}, nil
+		}
+	}
+
+	return &pb.FlagsNumberResponse{Value: req.GetDefaultValue(), Evaluation: defaultEvaluation}, nil
+}
+
+func NewFlagsServer(flagsPlugin flags.FlagsService) pb.FlagsServiceServer {
+	return &FlagsServer{
+		flagsPlugin: flagsPlugin,
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mock_flags "github.com/nitrictech/nitric/mocks/flags"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+)
+
+var _ = Describe("GRPC Flags", func() {
+	Context("Bool", func() {
+		When("plugin not registered", func() {
+			fs := &grpc.FlagsServer{}
+			resp, err := fs.Bool(context.Background(), &v1.FlagsBoolRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Flags plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+			resp, err := grpc.NewFlagsServer(mockFlags).Bool(context.Background(), &v1.FlagsBoolRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid FlagsBoolRequest.Flag"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the flag is evaluated", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+			attributes, _ := structpb.NewStruct(map[string]interface{}{"plan": "enterprise"})
+
+			mockFlags.EXPECT().Evaluate("new-checkout", &flags.Context{
+				Key:        "user-1",
+				Attributes: map[string]interface{}{"plan": "enterprise"},
+			}).Return(&flags.Evaluation{
+				Value:     true,
+				Reason:    flags.ReasonTargeted,
+				Variation: "rule-0",
+			}, nil)
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Bool(context.Background(), &v1.FlagsBoolRequest{
+				Flag:    "new-checkout",
+				Context: &v1.FlagsContext{Key: "user-1", Attributes: attributes},
+			})
+
+			It("Should return the flag's value and evaluation", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(BeTrue())
+				Expect(resp.GetEvaluation().GetReason()).To(Equal(flags.ReasonTargeted))
+				Expect(resp.GetEvaluation().GetVariation()).To(Equal("rule-0"))
+			})
+		})
+
+		When("the flag doesn't exist", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("missing", gomock.Any()).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "flag not found", nil))
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Bool(context.Background(), &v1.FlagsBoolRequest{
+				Flag:         "missing",
+				DefaultValue: true,
+			})
+
+			It("Should serve the default value", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(BeTrue())
+				Expect(resp.GetEvaluation().GetReason()).To(Equal(flags.ReasonDefault))
+			})
+		})
+
+		When("the flag isn't a bool", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("banner", gomock.Any()).Return(&flags.Evaluation{Value: "blue", Reason: flags.ReasonFallthrough}, nil)
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Bool(context.Background(), &v1.FlagsBoolRequest{
+				Flag: "banner",
+			})
+
+			It("Should serve the default value", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(BeFalse())
+				Expect(resp.GetEvaluation().GetReason()).To(Equal(flags.ReasonDefault))
+			})
+		})
+
+		When("the plugin fails", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("new-checkout", gomock.Any()).Return(nil, fmt.Errorf("mock error"))
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Bool(context.Background(), &v1.FlagsBoolRequest{
+				Flag: "new-checkout",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("mock error"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+
+	Context("String", func() {
+		When("the flag is evaluated", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("banner", gomock.Any()).Return(&flags.Evaluation{Value: "blue", Reason: flags.ReasonRollout}, nil)
+
+			resp, err := grpc.NewFlagsServer(mockFlags).String(context.Background(), &v1.FlagsStringRequest{
+				Flag:         "banner",
+				DefaultValue: "red",
+			})
+
+			It("Should return the flag's value", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(Equal("blue"))
+				Expect(resp.GetEvaluation().GetReason()).To(Equal(flags.ReasonRollout))
+			})
+		})
+	})
+
+	Context("Number", func() {
+		When("the flag is evaluated", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("page-size", gomock.Any()).Return(&flags.Evaluation{Value: float64(50), Reason: flags.ReasonFallthrough}, nil)
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Number(context.Background(), &v1.FlagsNumberRequest{
+				Flag:         "page-size",
+				DefaultValue: 20,
+			})
+
+			It("Should return the flag's value", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(Equal(float64(50)))
+			})
+		})
+
+		When("the flag doesn't exist", func() {
+			g := gomock.NewController(GinkgoT())
+			mockFlags := mock_flags.NewMockFlagsService(g)
+
+			mockFlags.EXPECT().Evaluate("page-size", gomock.Any()).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "flag not found", nil))
+
+			resp, err := grpc.NewFlagsServer(mockFlags).Number(context.Background(), &v1.FlagsNumberRequest{
+				Flag:         "page-size",
+				DefaultValue: 20,
+			})
+
+			It("Should serve the default value", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetValue()).To(Equal(float64(20)))
+				Expect(resp.GetEvaluation().GetReason()).To(Equal(flags.ReasonDefault))
+			})
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/egress"
+)
+
+// HttpServiceServer - GRPC Interface for outbound http requests made through the membrane
+type HttpServiceServer struct {
+	pb.UnimplementedHttpServiceServer
+	client *egress.Client
+}
+
+func (s *HttpServiceServer) checkClientConfigured() error {
+	if s.client == nil {
+		return NewPluginNotRegisteredError("Http")
+	}
+
+	return nil
+}
+
+func headersFromWire(headers map[string]*pb.HttpHeaderValue) map[string][]string {
+	h := make(map[string][]string, len(headers))
+	for k, v := range headers {
+		h[k] = v.GetValue()
+	}
+
+	return h
+}
+
+func headersToWire(header map[string][]string) map[string]*pb.HttpHeaderValue {
+	headers := make(map[string]*pb.HttpHeaderValue, len(header))
+	for k, v := range header {
+		headers[k] = &pb.HttpHeaderValue{Value: v}
+	}
+
+	return headers
+}
+
+func (s *HttpServiceServer) Send(ctx context.Context, req *pb.HttpSendRequest) (*pb.HttpSendResponse, error) {
+	if err := s.checkClientConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "HttpService.Send", err)
+	}
+
+	resp, err := s.client.Do(ctx, &egress.Request{
+		Method:  req.GetMethod(),
+		Url:     req.GetUrl(),
+		Header:  headersFromWire(req.GetHeaders()),
+		Body:    req.GetBody(),
+		Timeout: req.GetTimeout().AsDuration(),
+		NoRetry: req.GetNoRetry(),
+	})
+	if err != nil {
+		return nil, NewGrpcError("HttpService.Send", err)
+	}
+
+	return &pb.HttpSendResponse{
+		Status:   int32(resp.Status),
+		Headers:  headersToWire(resp.Header),
+		Body:     resp.Body,
+		Attempts: int32(resp.Attempts),
+	}, nil
+}
+
+// NewHttpServer - Creates an outbound http server, requests are unavailable if the client is nil
+func NewHttpServer(client *egress.Client) pb.HttpServiceServer {
+	return &HttpServiceServer{
+		client: client,
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/egress"
+)
+
+var _ = Describe("GRPC Http", func() {
+	Context("Send", func() {
+		When("outbound requests aren't configured", func() {
+			resp, err := grpc.NewHttpServer(nil).Send(context.Background(), &v1.HttpSendRequest{Url: "https://example.com"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Http plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			resp, err := grpc.NewHttpServer(egress.New(egress.DefaultConfig)).Send(context.Background(), &v1.HttpSendRequest{
+				Method: "CONNECT",
+				Url:    "https://example.com",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid HttpSendRequest.Method"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the request succeeds", func() {
+			It("Should return the response", func() {
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body, _ := ioutil.ReadAll(r.Body)
+					w.Header().Set("X-Echo", r.Header.Get("X-Test"))
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write(body)
+				}))
+				defer srv.Close()
+
+				resp, err := grpc.NewHttpServer(egress.New(egress.DefaultConfig)).Send(context.Background(), &v1.HttpSendRequest{
+					Method:  "POST",
+					Url:     srv.URL + "/orders",
+					Headers: map[string]*v1.HttpHeaderValue{"X-Test": {Value: []string{"value"}}},
+					Body:    []byte("order"),
+				})
+
+				Expect(err).Should(BeNil())
+				Expect(resp.Status).To(Equal(int32(201)))
+				Expect(resp.Body).To(Equal([]byte("order")))
+				Expect(resp.Headers["X-Echo"].Value).To(Equal([]string{"value"}))
+				Expect(resp.Attempts).To(Equal(int32(1)))
+			})
+		})
+	})
+})
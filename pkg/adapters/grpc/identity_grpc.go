@@ -0,0 +1,155 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+)
+
+// GRPC Interface for registered Nitric Identity Plugins
+type IdentityServer struct {
+	pb.UnimplementedIdentityServiceServer
+	identityPlugin identity.IdentityService
+}
+
+func (s *IdentityServer) checkPluginRegistered() error {
+	if s.identityPlugin == nil {
+		return NewPluginNotRegisteredError("Identity")
+	}
+
+	return nil
+}
+
+func userToWire(user *identity.User) *pb.IdentityUser {
+	return &pb.IdentityUser{
+		Id:         user.Id,
+		Email:      user.Email,
+		Disabled:   user.Disabled,
+		Attributes: user.Attributes,
+		CreatedAt:  timestampOrNil(user.CreatedAt),
+	}
+}
+
+func (s *IdentityServer) VerifyToken(ctx context.Context, req *pb.IdentityVerifyTokenRequest) (*pb.IdentityVerifyTokenResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "IdentityService.VerifyToken", err)
+	}
+
+	token, err := s.identityPlugin.VerifyToken(req.GetToken())
+	if err != nil {
+		return nil, NewGrpcError("IdentityService.VerifyToken", err)
+	}
+
+	claims, err := structpb.NewStruct(token.Claims)
+	if err != nil {
+		return nil, NewGrpcError("IdentityService.VerifyToken", err)
+	}
+
+	return &pb.IdentityVerifyTokenResponse{
+		UserId:    token.UserId,
+		Claims:    claims,
+		ExpiresAt: timestampOrNil(token.ExpiresAt),
+	}, nil
+}
+
+func (s *IdentityServer) GetUser(ctx context.Context, req *pb.IdentityGetUserRequest) (*pb.IdentityGetUserResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "IdentityService.GetUser", err)
+	}
+
+	user, err := s.identityPlugin.GetUser(req.GetId())
+	if err != nil {
+		return nil, NewGrpcError("IdentityService.GetUser", err)
+	}
+
+	return &pb.IdentityGetUserResponse{
+		User: userToWire(user),
+	}, nil
+}
+
+func (s *IdentityServer) CreateUser(ctx context.Context, req *pb.IdentityCreateUserRequest) (*pb.IdentityCreateUserResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "IdentityService.CreateUser", err)
+	}
+
+	user, err := s.identityPlugin.CreateUser(&identity.NewUser{
+		Email:      req.GetEmail(),
+		Password:   req.GetPassword(),
+		Attributes: req.GetAttributes(),
+	})
+	if err != nil {
+		return nil, NewGrpcError("IdentityService.CreateUser", err)
+	}
+
+	return &pb.IdentityCreateUserResponse{
+		User: userToWire(user),
+	}, nil
+}
+
+func (s *IdentityServer) SetDisabled(ctx context.Context, req *pb.IdentitySetDisabledRequest) (*pb.IdentitySetDisabledResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "IdentityService.SetDisabled", err)
+	}
+
+	if err := s.identityPlugin.SetDisabled(req.GetId(), req.GetDisabled()); err != nil {
+		return nil, NewGrpcError("IdentityService.SetDisabled", err)
+	}
+
+	return &pb.IdentitySetDisabledResponse{}, nil
+}
+
+func (s *IdentityServer) SetAttributes(ctx context.Context, req *pb.IdentitySetAttributesRequest) (*pb.IdentitySetAttributesResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "IdentityService.SetAttributes", err)
+	}
+
+	if err := s.identityPlugin.SetAttributes(req.GetId(), req.GetAttributes()); err != nil {
+		return nil, NewGrpcError("IdentityService.SetAttributes", err)
+	}
+
+	return &pb.IdentitySetAttributesResponse{}, nil
+}
+
+func NewIdentityServer(identityPlugin identity.IdentityService) pb.IdentityServiceServer {
+	return &IdentityServer{
+		identityPlugin: identityPlugin,
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_identity "github.com/nitrictech/nitric/mocks/identity"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+)
+
+var _ = Describe("GRPC Identity", func() {
+	Context("VerifyToken", func() {
+		When("plugin not registered", func() {
+			is := &grpc.IdentityServer{}
+			resp, err := is.VerifyToken(context.Background(), &v1.IdentityVerifyTokenRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Identity plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the token is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+			expires := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			mockIdentity.EXPECT().VerifyToken("token").Return(&identity.Token{
+				UserId:    "user-1",
+				Claims:    map[string]interface{}{"sub": "user-1", "exp": float64(expires.Unix())},
+				ExpiresAt: expires,
+			}, nil)
+
+			resp, err := grpc.NewIdentityServer(mockIdentity).VerifyToken(context.Background(), &v1.IdentityVerifyTokenRequest{
+				Token: "token",
+			})
+
+			It("Should return the user and claims", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetUserId()).To(Equal("user-1"))
+				Expect(resp.GetClaims().AsMap()).To(HaveKeyWithValue("sub", "user-1"))
+				Expect(resp.GetExpiresAt().AsTime()).To(Equal(expires))
+			})
+		})
+
+		When("the token is invalid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+
+			mockIdentity.EXPECT().VerifyToken("token").Return(nil, errors.ErrorsWithScope("test", nil)(codes.Unauthenticated, "invalid token: expired", nil))
+
+			resp, err := grpc.NewIdentityServer(mockIdentity).VerifyToken(context.Background(), &v1.IdentityVerifyTokenRequest{
+				Token: "token",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid token"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+
+	Context("GetUser", func() {
+		When("the user exists", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+
+			mockIdentity.EXPECT().GetUser("user-1").Return(&identity.User{
+				Id:         "user-1",
+				Email:      "jane@example.com",
+				Attributes: map[string]string{"plan": "pro"},
+			}, nil)
+
+			resp, err := grpc.NewIdentityServer(mockIdentity).GetUser(context.Background(), &v1.IdentityGetUserRequest{
+				Id: "user-1",
+			})
+
+			It("Should return the user", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetUser().GetEmail()).To(Equal("jane@example.com"))
+				Expect(resp.GetUser().GetAttributes()).To(Equal(map[string]string{"plan": "pro"}))
+				Expect(resp.GetUser().GetCreatedAt()).To(BeNil())
+			})
+		})
+	})
+
+	Context("CreateUser", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+			resp, err := grpc.NewIdentityServer(mockIdentity).CreateUser(context.Background(), &v1.IdentityCreateUserRequest{
+				Email: "not-an-email",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid IdentityCreateUserRequest.Email"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the user is created", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+
+			mockIdentity.EXPECT().CreateUser(&identity.NewUser{
+				Email:    "jane@example.com",
+				Password: "s3cret!!",
+			}).Return(&identity.User{Id: "user-1", Email: "jane@example.com"}, nil)
+
+			resp, err := grpc.NewIdentityServer(mockIdentity).CreateUser(context.Background(), &v1.IdentityCreateUserRequest{
+				Email:    "jane@example.com",
+				Password: "s3cret!!",
+			})
+
+			It("Should return the created user", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetUser().GetId()).To(Equal("user-1"))
+			})
+		})
+	})
+
+	Context("SetDisabled", func() {
+		When("the user is disabled", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+
+			mockIdentity.EXPECT().SetDisabled("user-1", true).Return(nil)
+
+			_, err := grpc.NewIdentityServer(mockIdentity).SetDisabled(context.Background(), &v1.IdentitySetDisabledRequest{
+				Id:       "user-1",
+				Disabled: true,
+			})
+
+			It("Should succeed", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("SetAttributes", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+			resp, err := grpc.NewIdentityServer(mockIdentity).SetAttributes(context.Background(), &v1.IdentitySetAttributesRequest{
+				Id: "user-1",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid IdentitySetAttributesRequest.Attributes"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the user doesn't exist", func() {
+			g := gomock.NewController(GinkgoT())
+			mockIdentity := mock_identity.NewMockIdentityService(g)
+
+			mockIdentity.EXPECT().SetAttributes("user-1", map[string]string{"plan": "pro"}).Return(errors.ErrorsWithScope("test", nil)(codes.NotFound, "user not found", nil))
+
+			resp, err := grpc.NewIdentityServer(mockIdentity).SetAttributes(context.Background(), &v1.IdentitySetAttributesRequest{
+				Id:         "user-1",
+				Attributes: map[string]string{"plan": "pro"},
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("user not found"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
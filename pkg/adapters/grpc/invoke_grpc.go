@@ -0,0 +1,72 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/invoke"
+)
+
+// InvokeServiceServer - GRPC Interface for calling the other services of a stack
+type InvokeServiceServer struct {
+	pb.UnimplementedInvokeServiceServer
+	invoker *invoke.Invoker
+}
+
+func (s *InvokeServiceServer) checkInvokerConfigured() error {
+	if s.invoker == nil {
+		return NewPluginNotRegisteredError("Invoke")
+	}
+
+	return nil
+}
+
+func (s *InvokeServiceServer) Invoke(ctx context.Context, req *pb.InvokeRequest) (*pb.InvokeResponse, error) {
+	if err := s.checkInvokerConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "InvokeService.Invoke", err)
+	}
+
+	resp, err := s.invoker.Invoke(ctx, &invoke.Request{
+		Service: req.GetService(),
+		Path:    req.GetPath(),
+		Method:  req.GetMethod(),
+		Header:  headersFromWire(req.GetHeaders()),
+		Payload: req.GetPayload(),
+	})
+	if err != nil {
+		return nil, NewGrpcError("InvokeService.Invoke", err)
+	}
+
+	return &pb.InvokeResponse{
+		Status:  int32(resp.Status),
+		Headers: headersToWire(resp.Header),
+		Payload: resp.Body,
+	}, nil
+}
+
+// NewInvokeServer - Creates an invoke server, invocations are unavailable if the invoker is nil
+func NewInvokeServer(invoker *invoke.Invoker) pb.InvokeServiceServer {
+	return &InvokeServiceServer{
+		invoker: invoker,
+	}
+}
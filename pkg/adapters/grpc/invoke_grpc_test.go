@@ -0,0 +1,60 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/egress"
+	"github.com/nitrictech/nitric/pkg/invoke"
+)
+
+var _ = Describe("GRPC Invoke", func() {
+	Context("Invoke", func() {
+		When("invocations aren't configured", func() {
+			resp, err := grpc.NewInvokeServer(nil).Invoke(context.Background(), &v1.InvokeRequest{Service: "orders", Path: "/"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Invoke plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			invoker, _ := invoke.New(invoke.EnvResolver{}, egress.New(egress.DefaultConfig), "", nil)
+			resp, err := grpc.NewInvokeServer(invoker).Invoke(context.Background(), &v1.InvokeRequest{Service: "orders", Path: "orders"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid InvokeRequest.Path"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the service can't be found", func() {
+			invoker, _ := invoke.New(invoke.EnvResolver{}, egress.New(egress.DefaultConfig), "", nil)
+			resp, err := grpc.NewInvokeServer(invoker).Invoke(context.Background(), &v1.InvokeRequest{Service: "unknown-service", Path: "/"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("service unknown-service not found"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,204 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/protoutils"
+)
+
+// DefaultKeyValueCollection - the collection key-value pairs are stored in when none is configured
+const DefaultKeyValueCollection = "nitric-kv"
+
+// counterField - the field of a key's document that counters are stored in
+const counterField = "value"
+
+// KeyValueServiceServer - GRPC Interface for simple key-value lookups, stored as documents in a single collection by the document plugin
+type KeyValueServiceServer struct {
+	pb.UnimplementedKeyValueServiceServer
+	documentPlugin document.DocumentService
+	collection     string
+	tenancy        *tenancy.Tenancy
+}
+
+type KeyValueServiceServerOption interface {
+	Apply(*KeyValueServiceServer)
+}
+
+type withKeyValueCollection struct {
+	collection string
+}
+
+func (w *withKeyValueCollection) Apply(server *KeyValueServiceServer) {
+	server.collection = w.collection
+}
+
+// WithKeyValueCollection - stores key-value pairs in the given collection instead of the default
+func WithKeyValueCollection(collection string) KeyValueServiceServerOption {
+	return &withKeyValueCollection{
+		collection: collection,
+	}
+}
+
+type withKeyValueTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withKeyValueTenancy) Apply(server *KeyValueServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithKeyValueTenancy - namespaces the key-value collection by the tenant of each call
+func WithKeyValueTenancy(t *tenancy.Tenancy) KeyValueServiceServerOption {
+	return &withKeyValueTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *KeyValueServiceServer) checkPluginRegistered() error {
+	if s.documentPlugin == nil {
+		return NewPluginNotRegisteredError("Document")
+	}
+
+	return nil
+}
+
+// documentKey - returns the key of the document a value is stored in
+func (s *KeyValueServiceServer) documentKey(ctx context.Context, key string) (*document.Key, error) {
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenancy.Key(tenant, &document.Key{
+		Collection: &document.Collection{Name: s.collection},
+		Id:         key,
+	}), nil
+}
+
+func (s *KeyValueServiceServer) Get(ctx context.Context, req *pb.KeyValueGetRequest) (*pb.KeyValueGetResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Get", err)
+	}
+
+	key, err := s.documentKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Get", err)
+	}
+
+	doc, err := s.documentPlugin.Get(key)
+	if err != nil {
+		return nil, NewGrpcError("KeyValueService.Get", err)
+	}
+
+	value, err := protoutils.NewStruct(doc.Content)
+	if err != nil {
+		return nil, NewGrpcError("KeyValueService.Get", err)
+	}
+
+	return &pb.KeyValueGetResponse{
+		Value: value,
+	}, nil
+}
+
+func (s *KeyValueServiceServer) Set(ctx context.Context, req *pb.KeyValueSetRequest) (*pb.KeyValueSetResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Set", err)
+	}
+
+	key, err := s.documentKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Set", err)
+	}
+
+	if err := s.documentPlugin.Set(key, req.GetValue().AsMap()); err != nil {
+		return nil, NewGrpcError("KeyValueService.Set", err)
+	}
+
+	return &pb.KeyValueSetResponse{}, nil
+}
+
+func (s *KeyValueServiceServer) Delete(ctx context.Context, req *pb.KeyValueDeleteRequest) (*pb.KeyValueDeleteResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Delete", err)
+	}
+
+	key, err := s.documentKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Delete", err)
+	}
+
+	if err := s.documentPlugin.Delete(key); err != nil {
+		return nil, NewGrpcError("KeyValueService.Delete", err)
+	}
+
+	return &pb.KeyValueDeleteResponse{}, nil
+}
+
+func (s *KeyValueServiceServer) Increment(ctx context.Context, req *pb.KeyValueIncrementRequest) (*pb.KeyValueIncrementResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Increment", err)
+	}
+
+	key, err := s.documentKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "KeyValueService.Increment", err)
+	}
+
+	value, err := s.documentPlugin.Increment(key, counterField, req.GetDelta())
+	if err != nil {
+		return nil, NewGrpcError("KeyValueService.Increment", err)
+	}
+
+	return &pb.KeyValueIncrementResponse{
+		Value: value,
+	}, nil
+}
+
+// NewKeyValueServer - creates a key-value server backed by the document plugin
+func NewKeyValueServer(docPlugin document.DocumentService, opts ...KeyValueServiceServerOption) pb.KeyValueServiceServer {
+	server := &KeyValueServiceServer{
+		documentPlugin: docPlugin,
+		collection:     DefaultKeyValueCollection,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+var _ = Describe("GRPC Key-Value", func() {
+	key := &document.Key{
+		Collection: &document.Collection{Name: grpc.DefaultKeyValueCollection},
+		Id:         "greeting",
+	}
+
+	Context("Get", func() {
+		When("plugin not registered", func() {
+			kvs := &grpc.KeyValueServiceServer{}
+			resp, err := kvs.Get(context.Background(), &v1.KeyValueGetRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Document plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			resp, err := grpc.NewKeyValueServer(mockDS).Get(context.Background(), &v1.KeyValueGetRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid KeyValueGetRequest.Key"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Get(key).Return(&document.Document{
+				Key:     key,
+				Content: map[string]interface{}{"text": "hello"},
+			}, nil)
+
+			resp, err := grpc.NewKeyValueServer(mockDS).Get(context.Background(), &v1.KeyValueGetRequest{Key: "greeting"})
+
+			It("Should return the stored value", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Value.AsMap()).To(Equal(map[string]interface{}{"text": "hello"}))
+			})
+		})
+	})
+
+	Context("Set", func() {
+		When("a collection is configured", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Set(&document.Key{
+				Collection: &document.Collection{Name: "lookups"},
+				Id:         "greeting",
+			}, map[string]interface{}{"text": "hello"}).Return(nil)
+
+			value, _ := structpb.NewStruct(map[string]interface{}{"text": "hello"})
+			_, err := grpc.NewKeyValueServer(mockDS, grpc.WithKeyValueCollection("lookups")).Set(context.Background(), &v1.KeyValueSetRequest{
+				Key:   "greeting",
+				Value: value,
+			})
+
+			It("Should store the value in that collection", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("the value is missing", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			_, err := grpc.NewKeyValueServer(mockDS).Set(context.Background(), &v1.KeyValueSetRequest{Key: "greeting"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid KeyValueSetRequest.Value: value is required"))
+			})
+		})
+	})
+
+	Context("Delete", func() {
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Delete(&document.Key{
+				Collection: &document.Collection{Name: "acme-" + grpc.DefaultKeyValueCollection},
+				Id:         "greeting",
+			}).Return(nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewKeyValueServer(mockDS, grpc.WithKeyValueTenancy(tenancy.New(tenancy.Optional))).Delete(ctx, &v1.KeyValueDeleteRequest{Key: "greeting"})
+
+			It("Should delete the tenant's value", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Increment", func() {
+		When("the key has a counter", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+
+			mockDS.EXPECT().Increment(&document.Key{
+				Collection: &document.Collection{Name: grpc.DefaultKeyValueCollection},
+				Id:         "visits",
+			}, "value", int64(-2)).Return(int64(40), nil)
+
+			resp, err := grpc.NewKeyValueServer(mockDS).Increment(context.Background(), &v1.KeyValueIncrementRequest{
+				Key:   "visits",
+				Delta: -2,
+			})
+
+			It("Should return the counter's new value", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Value).To(Equal(int64(40)))
+			})
+		})
+
+		When("the key is missing", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			_, err := grpc.NewKeyValueServer(mockDS).Increment(context.Background(), &v1.KeyValueIncrementRequest{Delta: 1})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid KeyValueIncrementRequest.Key"))
+			})
+		})
+	})
+})
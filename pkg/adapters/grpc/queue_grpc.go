@@ -16,19 +16,101 @@ package grpc
 
 import (
 	"context"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/dedupe"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/triggers"
 	"github.com/nitrictech/protoutils"
 )
 
 // GRPC Interface for registered Nitric Storage Plugins
 type QueueServiceServer struct {
 	pb.UnimplementedQueueServiceServer
-	plugin queue.QueueService
+	plugin       queue.QueueService
+	deduplicator dedupe.Deduplicator
+	schemas      *schema.Registry
+	tenancy      *tenancy.Tenancy
+	// Dedupe keys of the tasks leased by Receive, keyed by queue and lease ID. Used to mark tasks as processed on Complete.
+	leaseLock sync.Mutex
+	leases    map[string]*leasedTask
+}
+
+type leasedTask struct {
+	id       string
+	received time.Time
+}
+
+// leaseRetention - how long a leased task is tracked for deduplication without being completed,
+// after which it's assumed the lease has expired and the task will be redelivered
+const leaseRetention = time.Hour
+
+type QueueServiceServerOption interface {
+	Apply(*QueueServiceServer)
+}
+
+type withDeduplicator struct {
+	deduplicator dedupe.Deduplicator
+}
+
+func (w *withDeduplicator) Apply(server *QueueServiceServer) {
+	server.deduplicator = w.deduplicator
+}
+
+// WithDeduplicator - skip received tasks that have previously been completed
+func WithDeduplicator(deduplicator dedupe.Deduplicator) QueueServiceServerOption {
+	return &withDeduplicator{
+		deduplicator: deduplicator,
+	}
+}
+
+type withQueueSchemas struct {
+	schemas *schema.Registry
+}
+
+func (w *withQueueSchemas) Apply(server *QueueServiceServer) {
+	server.schemas = w.schemas
+}
+
+// WithQueueSchemas - reject sent tasks with payloads that don't conform to their queue's schema
+func WithQueueSchemas(schemas *schema.Registry) QueueServiceServerOption {
+	return &withQueueSchemas{
+		schemas: schemas,
+	}
+}
+
+type withQueueTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withQueueTenancy) Apply(server *QueueServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithQueueTenancy - namespaces queue names by the tenant of each call
+func WithQueueTenancy(t *tenancy.Tenancy) QueueServiceServerOption {
+	return &withQueueTenancy{
+		tenancy: t,
+	}
+}
+
+// queueName - returns the name of the queue a call is for, namespaced by its tenant
+func (s *QueueServiceServer) queueName(ctx context.Context, queue string) (string, error) {
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return tenancy.Name(tenant, queue), nil
 }
 
 func (s *QueueServiceServer) checkPluginRegistered() error {
@@ -48,6 +130,11 @@ func (s *QueueServiceServer) Send(ctx context.Context, req *pb.QueueSendRequest)
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Send", err)
 	}
 
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Send", err)
+	}
+
 	task := req.GetTask()
 
 	// auto generate an ID if we did not receive one
@@ -60,9 +147,14 @@ func (s *QueueServiceServer) Send(ctx context.Context, req *pb.QueueSendRequest)
 		ID:          ID,
 		PayloadType: task.GetPayloadType(),
 		Payload:     task.GetPayload().AsMap(),
+		Attributes:  task.GetAttributes(),
 	}
 
-	if err := s.plugin.Send(req.GetQueue(), nitricTask); err != nil {
+	if err := s.schemas.Validate(schema.Queue, req.GetQueue(), nitricTask.Payload); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Send", err)
+	}
+
+	if err := s.plugin.Send(queueName, nitricTask); err != nil {
 		return nil, err
 	}
 
@@ -79,41 +171,66 @@ func (s *QueueServiceServer) SendBatch(ctx context.Context, req *pb.QueueSendBat
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.SendBatch", err)
 	}
 
-	// Translate tasks
-	tasks := make([]queue.NitricTask, len(req.GetTasks()))
-	for i, task := range req.GetTasks() {
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.SendBatch", err)
+	}
+
+	// Translate tasks, tasks that don't conform to the queue's schema are failed without being sent
+	tasks := make([]queue.NitricTask, 0, len(req.GetTasks()))
+	invalidTasks := make([]*queue.FailedTask, 0)
+	for _, task := range req.GetTasks() {
 		// auto generate an ID if we did not receive one
 		ID := task.GetId()
 		if ID == "" {
 			ID = uuid.New().String()
 		}
 
-		tasks[i] = queue.NitricTask{
+		nitricTask := queue.NitricTask{
 			ID:          ID,
 			PayloadType: task.GetPayloadType(),
 			Payload:     task.GetPayload().AsMap(),
+			Attributes:  task.GetAttributes(),
+		}
+
+		if err := s.schemas.Validate(schema.Queue, req.GetQueue(), nitricTask.Payload); err != nil {
+			invalidTasks = append(invalidTasks, &queue.FailedTask{
+				Task:    &nitricTask,
+				Message: err.Error(),
+			})
+			continue
 		}
+
+		tasks = append(tasks, nitricTask)
 	}
 
-	if resp, err := s.plugin.SendBatch(req.GetQueue(), tasks); err == nil {
-		failedTasks := make([]*pb.FailedTask, len(resp.FailedTasks))
-		for i, failedTask := range resp.FailedTasks {
-			st, _ := protoutils.NewStruct(failedTask.Task.Payload)
-			failedTasks[i] = &pb.FailedTask{
-				Message: failedTask.Message,
-				Task: &pb.NitricTask{
-					Id:          failedTask.Task.ID,
-					PayloadType: failedTask.Task.PayloadType,
-					Payload:     st,
-				},
-			}
+	resp := &queue.SendBatchResponse{
+		FailedTasks: make([]*queue.FailedTask, 0),
+	}
+	// Skip sending if every task failed validation
+	if len(tasks) > 0 || len(invalidTasks) == 0 {
+		if resp, err = s.plugin.SendBatch(queueName, tasks); err != nil {
+			return nil, NewGrpcError("QueueService.SendBatch", err)
 		}
-		return &pb.QueueSendBatchResponse{
-			FailedTasks: failedTasks,
-		}, nil
-	} else {
-		return nil, NewGrpcError("QueueService.SendBatch", err)
 	}
+
+	failedTasks := make([]*pb.FailedTask, 0, len(invalidTasks)+len(resp.FailedTasks))
+	for _, failedTask := range append(invalidTasks, resp.FailedTasks...) {
+		st, _ := protoutils.NewStruct(failedTask.Task.Payload)
+		failedTasks = append(failedTasks, &pb.FailedTask{
+			Message: failedTask.Message,
+			Task: &pb.NitricTask{
+				Id:          failedTask.Task.ID,
+				PayloadType: failedTask.Task.PayloadType,
+				Payload:     st,
+				Attributes:  failedTask.Task.Attributes,
+			},
+		})
+	}
+
+	return &pb.QueueSendBatchResponse{
+		FailedTasks: failedTasks,
+	}, nil
 }
 
 func (s *QueueServiceServer) Receive(ctx context.Context, req *pb.QueueReceiveRequest) (*pb.QueueReceiveResponse, error) {
@@ -125,11 +242,17 @@ func (s *QueueServiceServer) Receive(ctx context.Context, req *pb.QueueReceiveRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Receive", err)
 	}
 
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Receive", err)
+	}
+
 	// Convert gRPC request to plugin params
 	depth := uint32(req.GetDepth())
 	popOptions := queue.ReceiveOptions{
-		QueueName: req.GetQueue(),
-		Depth:     &depth,
+		QueueName:  queueName,
+		Depth:      &depth,
+		Attributes: req.GetAttributes(),
 	}
 
 	// Perform the Queue Receive operation
@@ -138,15 +261,22 @@ func (s *QueueServiceServer) Receive(ctx context.Context, req *pb.QueueReceiveRe
 		return nil, NewGrpcError("QueueService.Receive", err)
 	}
 
+	if s.deduplicator != nil {
+		tasks = s.dedupeTasks(queueName, tasks)
+	}
+
 	// Convert the NitricTasks to the gRPC type
 	grpcTasks := make([]*pb.NitricTask, 0, len(tasks))
 	for _, task := range tasks {
 		st, _ := protoutils.NewStruct(task.Payload)
 		grpcTasks = append(grpcTasks, &pb.NitricTask{
-			Id:          task.ID,
-			Payload:     st,
-			LeaseId:     task.LeaseID,
-			PayloadType: task.PayloadType,
+			Id:           task.ID,
+			Payload:      st,
+			LeaseId:      task.LeaseID,
+			PayloadType:  task.PayloadType,
+			Attributes:   task.Attributes,
+			ProviderId:   task.ProviderID,
+			ReceiveCount: int32(task.ReceiveCount),
 		})
 	}
 
@@ -165,22 +295,184 @@ func (s *QueueServiceServer) Complete(ctx context.Context, req *pb.QueueComplete
 	if err := req.ValidateAll(); err != nil {
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Complete", err)
 	}
+
 	// Convert gRPC request to plugin params
-	queueName := req.GetQueue()
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Complete", err)
+	}
 	leaseId := req.GetLeaseId()
 
 	// Perform the Queue Complete operation
-	err := s.plugin.Complete(queueName, leaseId)
-	if err != nil {
+	if err := s.plugin.Complete(queueName, leaseId); err != nil {
 		return nil, NewGrpcError("QueueService.Complete", err)
 	}
 
+	if s.deduplicator != nil {
+		s.markCompleted(queueName, leaseId)
+	}
+
 	// Return a successful response
 	return &pb.QueueCompleteResponse{}, nil
 }
 
-func NewQueueServiceServer(plugin queue.QueueService) pb.QueueServiceServer {
-	return &QueueServiceServer{
+func (s *QueueServiceServer) CompleteBatch(ctx context.Context, req *pb.QueueCompleteBatchRequest) (*pb.QueueCompleteBatchResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.CompleteBatch", err)
+	}
+
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.CompleteBatch", err)
+	}
+
+	resp, err := s.plugin.CompleteBatch(queueName, req.GetLeaseIds())
+	if err != nil {
+		return nil, NewGrpcError("QueueService.CompleteBatch", err)
+	}
+
+	failed := make(map[string]bool, len(resp.FailedLeases))
+	failedLeases := make([]*pb.FailedLease, 0, len(resp.FailedLeases))
+	for _, failedLease := range resp.FailedLeases {
+		failed[failedLease.LeaseID] = true
+		failedLeases = append(failedLeases, &pb.FailedLease{
+			LeaseId: failedLease.LeaseID,
+			Message: failedLease.Message,
+		})
+	}
+
+	if s.deduplicator != nil {
+		for _, leaseId := range req.GetLeaseIds() {
+			if !failed[leaseId] {
+				s.markCompleted(queueName, leaseId)
+			}
+		}
+	}
+
+	return &pb.QueueCompleteBatchResponse{
+		FailedLeases: failedLeases,
+	}, nil
+}
+
+func (s *QueueServiceServer) Release(ctx context.Context, req *pb.QueueReleaseRequest) (*pb.QueueReleaseResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Release", err)
+	}
+
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Release", err)
+	}
+
+	// The task will be redelivered, so it's left unmarked for the deduplicator
+	if err := s.plugin.Release(queueName, req.GetLeaseId(), req.GetDelay().AsDuration()); err != nil {
+		return nil, NewGrpcError("QueueService.Release", err)
+	}
+
+	return &pb.QueueReleaseResponse{}, nil
+}
+
+func (s *QueueServiceServer) GetStats(ctx context.Context, req *pb.QueueGetStatsRequest) (*pb.QueueGetStatsResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.GetStats", err)
+	}
+
+	queueName, err := s.queueName(ctx, req.GetQueue())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.GetStats", err)
+	}
+
+	stats, err := s.plugin.GetQueueStats(queueName)
+	if err != nil {
+		return nil, NewGrpcError("QueueService.GetStats", err)
+	}
+
+	return &pb.QueueGetStatsResponse{
+		Depth:         stats.Depth,
+		InFlight:      stats.InFlight,
+		OldestTaskAge: durationpb.New(stats.OldestTaskAge),
+	}, nil
+}
+
+// dedupeTasks - removes tasks that have already been completed, completing them again so they aren't redelivered
+func (s *QueueServiceServer) dedupeTasks(queueName string, tasks []queue.NitricTask) []queue.NitricTask {
+	s.leaseLock.Lock()
+	defer s.leaseLock.Unlock()
+
+	now := time.Now()
+	for key, lease := range s.leases {
+		if now.Sub(lease.received) > leaseRetention {
+			delete(s.leases, key)
+		}
+	}
+
+	unseen := make([]queue.NitricTask, 0, len(tasks))
+	for _, task := range tasks {
+		// Tasks sent with an idempotency key are identified by it, so tasks sent more than once with new IDs are still skipped
+		key := task.ID
+		if k := task.Attributes[triggers.IdempotencyKeyAttribute]; k != "" {
+			key = k
+		}
+
+		if key != "" {
+			// Tasks are still delivered if the dedupe records can't be read, duplicates are preferable to losing tasks
+			if seen, err := s.deduplicator.Seen(queueName, key); err != nil {
+				log.Default().Printf("error checking for duplicate task %s: %v", task.ID, err)
+			} else if seen {
+				if err := s.plugin.Complete(queueName, task.LeaseID); err != nil {
+					log.Default().Printf("error completing duplicate task %s: %v", task.ID, err)
+				}
+				continue
+			}
+
+			s.leases[queueName+"/"+task.LeaseID] = &leasedTask{
+				id:       key,
+				received: now,
+			}
+		}
+
+		unseen = append(unseen, task)
+	}
+
+	return unseen
+}
+
+func (s *QueueServiceServer) markCompleted(queueName string, leaseId string) {
+	s.leaseLock.Lock()
+	lease, ok := s.leases[queueName+"/"+leaseId]
+	delete(s.leases, queueName+"/"+leaseId)
+	s.leaseLock.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if err := s.deduplicator.Mark(queueName, lease.id); err != nil {
+		log.Default().Printf("error recording completed task %s: %v", lease.id, err)
+	}
+}
+
+func NewQueueServiceServer(plugin queue.QueueService, opts ...QueueServiceServerOption) pb.QueueServiceServer {
+	server := &QueueServiceServer{
 		plugin: plugin,
+		leases: make(map[string]*leasedTask),
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
 	}
+
+	return server
 }
@@ -16,16 +16,23 @@ package grpc_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mock_queue "github.com/nitrictech/nitric/mocks/queue"
 	"github.com/nitrictech/nitric/pkg/adapters/grpc"
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/tenancy"
 )
 
 var _ = Describe("GRPC Queue", func() {
@@ -78,6 +85,23 @@ var _ = Describe("GRPC Queue", func() {
 				Expect(resp.String()).To(Equal(""))
 			})
 		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			mockSS.EXPECT().Send("acme-job", gomock.Any()).Return(nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewQueueServiceServer(mockSS, grpc.WithQueueTenancy(tenancy.New(tenancy.Optional))).Send(ctx, &v1.QueueSendRequest{
+				Queue: "job",
+				Task:  &v1.NitricTask{Id: "tsk"},
+			})
+
+			It("Should use the tenant's queue", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
 	})
 
 	Context("Receive", func() {
@@ -116,6 +140,8 @@ var _ = Describe("GRPC Queue", func() {
 					Payload: map[string]interface{}{
 						"ff": "88",
 					},
+					ProviderID:   "msg",
+					ReceiveCount: 2,
 				},
 			}, nil)
 
@@ -128,6 +154,157 @@ var _ = Describe("GRPC Queue", func() {
 				Expect(err).Should(BeNil())
 				Expect(resp.Tasks[0].Id).To(Equal("tsk"))
 				Expect(resp.Tasks[0].PayloadType).To(Equal("food"))
+				Expect(resp.Tasks[0].ProviderId).To(Equal("msg"))
+				Expect(resp.Tasks[0].ReceiveCount).To(Equal(int32(2)))
+			})
+		})
+
+		When("filtering by attribute", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			one := uint32(1)
+			mockSS.EXPECT().Receive(queue.ReceiveOptions{
+				QueueName:  "job",
+				Depth:      &one,
+				Attributes: map[string]string{"tenant": "a"},
+			}).Return([]queue.NitricTask{
+				{
+					ID:         "tsk",
+					Attributes: map[string]string{"tenant": "a"},
+				},
+			}, nil)
+
+			resp, err := grpc.NewQueueServiceServer(mockSS).Receive(context.Background(), &v1.QueueReceiveRequest{
+				Queue:      "job",
+				Depth:      int32(1),
+				Attributes: map[string]string{"tenant": "a"},
+			})
+
+			It("Should pass the filter to the plugin and return the task attributes", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Tasks[0].Attributes).To(Equal(map[string]string{"tenant": "a"}))
+			})
+		})
+	})
+
+	Context("Deduplication", func() {
+		When("a received task has already been completed", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{"job/dup": true}}
+			server := grpc.NewQueueServiceServer(mockSS, grpc.WithDeduplicator(deduplicator))
+
+			It("Should only deliver unprocessed tasks", func() {
+				mockSS.EXPECT().Receive(gomock.Any()).Return([]queue.NitricTask{
+					{ID: "dup", LeaseID: "1"},
+					{ID: "new", LeaseID: "2"},
+				}, nil)
+
+				By("completing the duplicate task")
+				mockSS.EXPECT().Complete("job", "1").Return(nil)
+
+				resp, err := server.Receive(context.Background(), &v1.QueueReceiveRequest{
+					Queue: "job",
+					Depth: int32(2),
+				})
+				Expect(err).Should(BeNil())
+				Expect(resp.Tasks).To(HaveLen(1))
+				Expect(resp.Tasks[0].Id).To(Equal("new"))
+
+				By("marking the task as processed once it's completed")
+				mockSS.EXPECT().Complete("job", "2").Return(nil)
+
+				_, err = server.Complete(context.Background(), &v1.QueueCompleteRequest{
+					Queue:   "job",
+					LeaseId: "2",
+				})
+				Expect(err).Should(BeNil())
+				Expect(deduplicator.seen).To(HaveKey("job/new"))
+			})
+		})
+
+		When("a received task was sent with an idempotency key", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{"job/order-1": true}}
+			server := grpc.NewQueueServiceServer(mockSS, grpc.WithDeduplicator(deduplicator))
+
+			It("Should identify the task by its key instead of its ID", func() {
+				mockSS.EXPECT().Receive(gomock.Any()).Return([]queue.NitricTask{
+					{ID: "resent", LeaseID: "1", Attributes: map[string]string{"x-nitric-idempotency-key": "order-1"}},
+				}, nil)
+
+				By("completing the duplicate task")
+				mockSS.EXPECT().Complete("job", "1").Return(nil)
+
+				resp, err := server.Receive(context.Background(), &v1.QueueReceiveRequest{
+					Queue: "job",
+					Depth: int32(1),
+				})
+				Expect(err).Should(BeNil())
+				Expect(resp.Tasks).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Schema validation", func() {
+		schemas := schema.NewRegistry()
+		_ = schemas.Register(schema.Queue, "job", []byte(`{
+			"type": "object",
+			"required": ["x"]
+		}`))
+
+		When("sending a task that doesn't conform to the queue's schema", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			resp, err := grpc.NewQueueServiceServer(mockSS, grpc.WithQueueSchemas(schemas)).Send(context.Background(), &v1.QueueSendRequest{
+				Queue: "job",
+				Task: &v1.NitricTask{
+					Id:      "tsk",
+					Payload: &structpb.Struct{},
+				},
+			})
+
+			It("Should reject the task", func() {
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(err.Error()).Should(ContainSubstring("payload does not conform to the schema for queue job"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("sending a batch containing non-conforming tasks", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			It("Should only send the conforming tasks", func() {
+				mockSS.EXPECT().SendBatch("job", []queue.NitricTask{
+					{ID: "valid", Payload: map[string]interface{}{"x": "y"}},
+				}).Return(&queue.SendBatchResponse{FailedTasks: []*queue.FailedTask{}}, nil)
+
+				resp, err := grpc.NewQueueServiceServer(mockSS, grpc.WithQueueSchemas(schemas)).SendBatch(context.Background(), &v1.QueueSendBatchRequest{
+					Queue: "job",
+					Tasks: []*v1.NitricTask{
+						{
+							Id: "valid",
+							Payload: &structpb.Struct{Fields: map[string]*structpb.Value{
+								"x": structpb.NewStringValue("y"),
+							}},
+						},
+						{
+							Id:      "invalid",
+							Payload: &structpb.Struct{},
+						},
+					},
+				})
+
+				Expect(err).Should(BeNil())
+				By("returning the non-conforming tasks as failed")
+				Expect(resp.FailedTasks).To(HaveLen(1))
+				Expect(resp.FailedTasks[0].Task.Id).To(Equal("invalid"))
+				Expect(resp.FailedTasks[0].Message).To(ContainSubstring("missing properties"))
 			})
 		})
 	})
@@ -170,4 +347,129 @@ var _ = Describe("GRPC Queue", func() {
 			})
 		})
 	})
+
+	Context("Release", func() {
+		When("the delay is too long", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+			resp, err := grpc.NewQueueServiceServer(mockSS).Release(context.Background(), &v1.QueueReleaseRequest{
+				Queue:   "job",
+				LeaseId: "45",
+				Delay:   durationpb.New(13 * time.Hour),
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid QueueReleaseRequest.Delay"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			mockSS.EXPECT().Release("job", "45", 30*time.Second).Return(nil)
+
+			resp, err := grpc.NewQueueServiceServer(mockSS).Release(context.Background(), &v1.QueueReleaseRequest{
+				Queue:   "job",
+				LeaseId: "45",
+				Delay:   durationpb.New(30 * time.Second),
+			})
+
+			It("Should hand the delay to the plugin", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.String()).To(Equal(""))
+			})
+		})
+	})
+
+	Context("GetStats", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+
+			mockSS.EXPECT().GetQueueStats("job").Return(&queue.QueueStats{
+				Depth:         4,
+				InFlight:      2,
+				OldestTaskAge: 30 * time.Second,
+			}, nil)
+
+			resp, err := grpc.NewQueueServiceServer(mockSS).GetStats(context.Background(), &v1.QueueGetStatsRequest{
+				Queue: "job",
+			})
+
+			It("Should return the queue stats", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Depth).To(Equal(int64(4)))
+				Expect(resp.InFlight).To(Equal(int64(2)))
+				Expect(resp.OldestTaskAge.AsDuration()).To(Equal(30 * time.Second))
+			})
+		})
+	})
+
+	Context("CompleteBatch", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+			resp, err := grpc.NewQueueServiceServer(mockSS).CompleteBatch(context.Background(), &v1.QueueCompleteBatchRequest{
+				Queue: "job",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid QueueCompleteBatchRequest.LeaseIds: value must contain at least 1 item(s)"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("some leases fail to complete", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_queue.NewMockQueueService(g)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{}}
+			server := grpc.NewQueueServiceServer(mockSS, grpc.WithDeduplicator(deduplicator))
+
+			It("Should return the failed leases and only mark the completed tasks as processed", func() {
+				mockSS.EXPECT().Receive(gomock.Any()).Return([]queue.NitricTask{
+					{ID: "a", LeaseID: "1"},
+					{ID: "b", LeaseID: "2"},
+				}, nil)
+
+				_, err := server.Receive(context.Background(), &v1.QueueReceiveRequest{
+					Queue: "job",
+					Depth: int32(2),
+				})
+				Expect(err).Should(BeNil())
+
+				mockSS.EXPECT().CompleteBatch("job", []string{"1", "2"}).Return(&queue.CompleteBatchResponse{
+					FailedLeases: []*queue.FailedLease{
+						{LeaseID: "2", Message: "lease expired"},
+					},
+				}, nil)
+
+				resp, err := server.CompleteBatch(context.Background(), &v1.QueueCompleteBatchRequest{
+					Queue:    "job",
+					LeaseIds: []string{"1", "2"},
+				})
+				Expect(err).Should(BeNil())
+				Expect(resp.FailedLeases).To(HaveLen(1))
+				Expect(resp.FailedLeases[0].LeaseId).To(Equal("2"))
+				Expect(resp.FailedLeases[0].Message).To(Equal("lease expired"))
+
+				Expect(deduplicator.seen).To(HaveKey("job/a"))
+				Expect(deduplicator.seen).NotTo(HaveKey("job/b"))
+			})
+		})
+	})
 })
+
+type memoryDeduplicator struct {
+	seen map[string]bool
+}
+
+func (d *memoryDeduplicator) Seen(scope string, id string) (bool, error) {
+	return d.seen[scope+"/"+id], nil
+}
+
+func (d *memoryDeduplicator) Mark(scope string, id string) error {
+	d.seen[scope+"/"+id] = true
+	return nil
+}
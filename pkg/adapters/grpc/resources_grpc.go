@@ -18,18 +18,98 @@ import (
 	"context"
 
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/resources"
 )
 
 type ResourcesServiceServer struct {
 	v1.UnimplementedResourceServiceServer
+	registry    *resources.Registry
+	provisioner *resources.AutoProvisioner
+}
+
+type ResourcesServiceServerOption interface {
+	Apply(*ResourcesServiceServer)
+}
+
+type withResourceRegistry struct {
+	registry *resources.Registry
+}
+
+func (w *withResourceRegistry) Apply(rs *ResourcesServiceServer) {
+	rs.registry = w.registry
+}
+
+// WithResourceRegistry - records declared resources in the registry, so they can be validated
+func WithResourceRegistry(registry *resources.Registry) ResourcesServiceServerOption {
+	return &withResourceRegistry{
+		registry: registry,
+	}
+}
+
+type withResourceProvisioner struct {
+	provisioner *resources.AutoProvisioner
+}
+
+func (w *withResourceProvisioner) Apply(rs *ResourcesServiceServer) {
+	rs.provisioner = w.provisioner
+}
+
+// WithResourceProvisioner - creates declared resources that don't exist before the declaration returns
+func WithResourceProvisioner(provisioner *resources.AutoProvisioner) ResourcesServiceServerOption {
+	return &withResourceProvisioner{
+		provisioner: provisioner,
+	}
+}
+
+// declaredTypes - the declared resource types that can be validated
+var declaredTypes = map[v1.ResourceType]resources.Type{
+	v1.ResourceType_Bucket:     resources.Bucket,
+	v1.ResourceType_Topic:      resources.Topic,
+	v1.ResourceType_Queue:      resources.Queue,
+	v1.ResourceType_Collection: resources.Collection,
+	v1.ResourceType_Secret:     resources.Secret,
 }
 
 func (rs *ResourcesServiceServer) Declare(ctx context.Context, req *v1.ResourceDeclareRequest) (*v1.ResourceDeclareResponse, error) {
-	// Currently a no-op at runtime
+	// Resources are provisioned by deployments, unless they're auto provisioned for dev and test environments
 	// TODO: Implement a strategy pattern for resolving resources, by their declared resource name in nitric
+	t, ok := declaredTypes[req.GetResource().GetType()]
+	if ok && rs.provisioner != nil {
+		if err := rs.provisioner.Provision(resources.Resource{Type: t, Name: req.GetResource().GetName()}); err != nil {
+			return nil, NewGrpcError("ResourceService.Declare", err)
+		}
+	}
+
+	if rs.registry == nil {
+		return &v1.ResourceDeclareResponse{}, nil
+	}
+
+	if ok {
+		rs.registry.Declare(resources.Resource{Type: t, Name: req.GetResource().GetName()})
+	}
+
+	if policy := req.GetPolicy(); policy != nil {
+		actions := make([]resources.Action, 0, len(policy.GetActions()))
+		for _, a := range policy.GetActions() {
+			actions = append(actions, resources.Action(a.String()))
+		}
+
+		for _, res := range policy.GetResources() {
+			if t, ok := declaredTypes[res.GetType()]; ok {
+				rs.registry.Allow(resources.Resource{Type: t, Name: res.GetName()}, actions...)
+			}
+		}
+	}
+
 	return &v1.ResourceDeclareResponse{}, nil
 }
 
-func NewResourcesServiceServer() v1.ResourceServiceServer {
-	return &ResourcesServiceServer{}
+func NewResourcesServiceServer(opts ...ResourcesServiceServerOption) v1.ResourceServiceServer {
+	server := &ResourcesServiceServer{}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
 }
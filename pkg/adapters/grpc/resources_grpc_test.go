@@ -0,0 +1,114 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
+)
+
+type provisionedStorage struct {
+	storage.UnimplementedStoragePlugin
+	provisioned []string
+	err         error
+}
+
+func (p *provisionedStorage) Provision(name string, identity *stack.Identity) error {
+	p.provisioned = append(p.provisioned, name)
+	return p.err
+}
+
+var _ = Describe("GRPC Resources", func() {
+	Context("Declare", func() {
+		When("a registry is configured", func() {
+			registry := &resources.Registry{}
+			rs := grpc.NewResourcesServiceServer(grpc.WithResourceRegistry(registry))
+
+			It("should record declared resources", func() {
+				_, err := rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Bucket, Name: "images"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Api, Name: "public"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(registry.Resources()).To(Equal([]resources.Resource{{Type: resources.Bucket, Name: "images"}}))
+			})
+
+			It("should record the actions the application is allowed", func() {
+				_, err := rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Policy},
+					Config: &v1.ResourceDeclareRequest_Policy{
+						Policy: &v1.PolicyResource{
+							Actions: []v1.Action{v1.Action_SecretAccess},
+							Resources: []*v1.Resource{
+								{Type: v1.ResourceType_Secret, Name: "api-key"},
+							},
+						},
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				apiKey := resources.Resource{Type: resources.Secret, Name: "api-key"}
+				Expect(registry.Allowed(apiKey, resources.SecretAccess)).To(BeTrue())
+				Expect(registry.Allowed(apiKey, resources.SecretPut)).To(BeFalse())
+				Expect(registry.Actions(apiKey)).To(Equal([]resources.Action{resources.SecretAccess}))
+			})
+		})
+
+		When("a provisioner is configured", func() {
+			It("should provision declared resources", func() {
+				storage := &provisionedStorage{}
+				rs := grpc.NewResourcesServiceServer(grpc.WithResourceProvisioner(&resources.AutoProvisioner{Plugins: resources.Plugins{Storage: storage}}))
+
+				_, err := rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Bucket, Name: "images"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(storage.provisioned).To(Equal([]string{"images"}))
+			})
+
+			It("should fail the declaration if the resource can't be provisioned", func() {
+				storage := &provisionedStorage{err: fmt.Errorf("access denied")}
+				rs := grpc.NewResourcesServiceServer(grpc.WithResourceProvisioner(&resources.AutoProvisioner{Plugins: resources.Plugins{Storage: storage}}))
+
+				_, err := rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Bucket, Name: "images"},
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("no registry is configured", func() {
+			It("should succeed", func() {
+				_, err := grpc.NewResourcesServiceServer().Declare(context.Background(), &v1.ResourceDeclareRequest{
+					Resource: &v1.Resource{Type: v1.ResourceType_Bucket, Name: "images"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,157 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type runtimeMethod = func(ctx context.Context, dec func(interface{}) error) (interface{}, error)
+
+// RuntimeServer - serves the runtime API calls FaaS workers make over their trigger streams.
+// Services registered with it are registered with the underlying gRPC server too, so both share the same implementations.
+type RuntimeServer struct {
+	registrar   grpc.ServiceRegistrar
+	interceptor grpc.UnaryServerInterceptor
+	lock        sync.RWMutex
+	methods     map[string]runtimeMethod
+}
+
+type RuntimeServerOption interface {
+	Apply(*RuntimeServer)
+}
+
+type withRuntimeInterceptor struct {
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (w *withRuntimeInterceptor) Apply(server *RuntimeServer) {
+	outer, inner := server.interceptor, w.interceptor
+	if outer == nil {
+		server.interceptor = inner
+		return
+	}
+
+	server.interceptor = func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// WithRuntimeInterceptor - intercepts runtime API calls made over trigger streams, as the gRPC server's interceptors do for direct calls.
+// Interceptors are chained in the order they're given, the first being the outermost
+func WithRuntimeInterceptor(interceptor grpc.UnaryServerInterceptor) RuntimeServerOption {
+	return &withRuntimeInterceptor{
+		interceptor: interceptor,
+	}
+}
+
+var _ grpc.ServiceRegistrar = &RuntimeServer{}
+var _ worker.RuntimeHandler = &RuntimeServer{}
+
+// RegisterService - registers the unary methods of a service, streaming methods can't be called over a trigger stream
+func (s *RuntimeServer) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	if s.registrar != nil {
+		s.registrar.RegisterService(desc, impl)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, m := range desc.Methods {
+		handler := m.Handler
+		s.methods[fmt.Sprintf("/%s/%s", desc.ServiceName, m.MethodName)] = func(ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+			return handler(impl, ctx, dec, s.interceptor)
+		}
+	}
+}
+
+func runtimeError(err error) *pb.RuntimeResponse {
+	st := status.Convert(err)
+
+	return &pb.RuntimeResponse{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+		Details: st.Proto().GetDetails(),
+	}
+}
+
+// HandleRuntimeRequest - calls the requested method, returning its response or the status of the failed call
+func (s *RuntimeServer) HandleRuntimeRequest(ctx context.Context, req *pb.RuntimeRequest) *pb.RuntimeResponse {
+	s.lock.RLock()
+	method, ok := s.methods[req.GetMethod()]
+	s.lock.RUnlock()
+
+	if !ok {
+		return runtimeError(status.Errorf(codes.Unimplemented, "unknown runtime method %s", req.GetMethod()))
+	}
+
+	// Calls over the stream carry their own metadata, as they can't set gRPC metadata per call
+	if len(req.GetMetadata()) > 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.New(req.GetMetadata())))
+	}
+
+	resp, err := method(ctx, func(msg interface{}) error {
+		m, ok := msg.(proto.Message)
+		if !ok {
+			return status.Errorf(codes.Internal, "unable to decode request for %s", req.GetMethod())
+		}
+
+		if err := proto.Unmarshal(req.GetPayload(), m); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request payload for %s: %v", req.GetMethod(), err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return runtimeError(err)
+	}
+
+	payload, err := proto.Marshal(resp.(proto.Message))
+	if err != nil {
+		return runtimeError(status.Errorf(codes.Internal, "unable to encode response for %s: %v", req.GetMethod(), err))
+	}
+
+	return &pb.RuntimeResponse{
+		Payload: payload,
+	}
+}
+
+// NewRuntimeServer - creates a runtime server that also registers its services with the given registrar, which may be nil
+func NewRuntimeServer(registrar grpc.ServiceRegistrar, opts ...RuntimeServerOption) *RuntimeServer {
+	server := &RuntimeServer{
+		registrar: registrar,
+		methods:   make(map[string]runtimeMethod),
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
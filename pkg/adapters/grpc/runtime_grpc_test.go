@@ -0,0 +1,144 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+var _ = Describe("GRPC Runtime", func() {
+	Context("HandleRuntimeRequest", func() {
+		When("the method is registered", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_secret.NewMockSecretService(g)
+
+			rs := grpc.NewRuntimeServer(nil)
+			v1.RegisterSecretServiceServer(rs, grpc.NewSecretServer(mockSS))
+
+			It("Should call the method with the decoded request", func() {
+				mockSS.EXPECT().Access(&secret.SecretVersion{Secret: &secret.Secret{Name: "foo"}, Version: "latest"}).Return(&secret.SecretAccessResponse{
+					SecretVersion: &secret.SecretVersion{
+						Secret:  &secret.Secret{Name: "foo"},
+						Version: "3",
+					},
+					Value: []byte("the value"),
+				}, nil)
+
+				payload, _ := proto.Marshal(&v1.SecretAccessRequest{
+					SecretVersion: &v1.SecretVersion{
+						Secret:  &v1.Secret{Name: "foo"},
+						Version: "latest",
+					},
+				})
+
+				resp := rs.HandleRuntimeRequest(context.Background(), &v1.RuntimeRequest{
+					Method:  "/nitric.secret.v1.SecretService/Access",
+					Payload: payload,
+				})
+				Expect(resp.Code).To(Equal(int32(codes.OK)))
+
+				accessResp := &v1.SecretAccessResponse{}
+				Expect(proto.Unmarshal(resp.Payload, accessResp)).To(Succeed())
+				Expect(accessResp.SecretVersion.Version).To(Equal("3"))
+				Expect(accessResp.Value).To(Equal([]byte("the value")))
+			})
+
+			It("Should pass the request metadata to the method", func() {
+				tenantRs := grpc.NewRuntimeServer(nil)
+				v1.RegisterSecretServiceServer(tenantRs, grpc.NewSecretServer(mockSS, grpc.WithSecretTenancy(tenancy.New(tenancy.Required))))
+
+				mockSS.EXPECT().Access(&secret.SecretVersion{Secret: &secret.Secret{Name: "acme-foo"}, Version: "latest"}).Return(&secret.SecretAccessResponse{
+					SecretVersion: &secret.SecretVersion{
+						Secret:  &secret.Secret{Name: "acme-foo"},
+						Version: "1",
+					},
+					Value: []byte("the value"),
+				}, nil)
+
+				payload, _ := proto.Marshal(&v1.SecretAccessRequest{
+					SecretVersion: &v1.SecretVersion{
+						Secret:  &v1.Secret{Name: "foo"},
+						Version: "latest",
+					},
+				})
+
+				resp := tenantRs.HandleRuntimeRequest(context.Background(), &v1.RuntimeRequest{
+					Method:   "/nitric.secret.v1.SecretService/Access",
+					Payload:  payload,
+					Metadata: map[string]string{tenancy.MetadataKey: "acme"},
+				})
+				Expect(resp.Code).To(Equal(int32(codes.OK)))
+
+				accessResp := &v1.SecretAccessResponse{}
+				Expect(proto.Unmarshal(resp.Payload, accessResp)).To(Succeed())
+				Expect(accessResp.SecretVersion.Secret.Name).To(Equal("foo"))
+			})
+
+			It("Should return the status of failed calls", func() {
+				resp := rs.HandleRuntimeRequest(context.Background(), &v1.RuntimeRequest{
+					Method: "/nitric.secret.v1.SecretService/Access",
+				})
+				Expect(resp.Code).To(Equal(int32(codes.InvalidArgument)))
+				Expect(resp.Message).To(ContainSubstring("SecretVersion: value is required"))
+				Expect(resp.Payload).To(BeEmpty())
+			})
+		})
+
+		When("several interceptors are given", func() {
+			It("Should chain them in order", func() {
+				calls := []string{}
+				interceptor := func(name string) ggrpc.UnaryServerInterceptor {
+					return func(ctx context.Context, req interface{}, info *ggrpc.UnaryServerInfo, handler ggrpc.UnaryHandler) (interface{}, error) {
+						calls = append(calls, name)
+						return handler(ctx, req)
+					}
+				}
+
+				rs := grpc.NewRuntimeServer(nil, grpc.WithRuntimeInterceptor(interceptor("outer")), grpc.WithRuntimeInterceptor(interceptor("inner")))
+				v1.RegisterSecretServiceServer(rs, grpc.NewSecretServer(nil))
+
+				resp := rs.HandleRuntimeRequest(context.Background(), &v1.RuntimeRequest{
+					Method: "/nitric.secret.v1.SecretService/Access",
+				})
+				Expect(resp.Code).To(Equal(int32(codes.Unimplemented)))
+				Expect(calls).To(Equal([]string{"outer", "inner"}))
+			})
+		})
+
+		When("the method is not registered", func() {
+			rs := grpc.NewRuntimeServer(nil)
+
+			It("Should return unimplemented", func() {
+				resp := rs.HandleRuntimeRequest(context.Background(), &v1.RuntimeRequest{
+					Method: "/nitric.secret.v1.SecretService/Access",
+				})
+				Expect(resp.Code).To(Equal(int32(codes.Unimplemented)))
+			})
+		})
+	})
+})
@@ -0,0 +1,283 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// Types of workload a scaled object can be scaled on, set by the type scaler metadata
+const (
+	ScalerTypeQueue       = "queue"
+	ScalerTypeTopic       = "topic"
+	ScalerTypeConcurrency = "concurrency"
+)
+
+// defaultScalerTarget - the queued tasks or undelivered events each replica is expected to handle, unless target is set
+const defaultScalerTarget = 5
+
+// ExternalScalerServer - A KEDA external scaler, reporting the depth of queues, the backlog of topics
+// and the number of triggers being handled as metrics for scaling Kubernetes deployments.
+//
+// Scaled objects configure the metric with their trigger's metadata:
+// type (queue, topic or concurrency), name (the queue or topic) and target (the value each replica is expected to handle)
+type ExternalScalerServer struct {
+	pb.UnimplementedExternalScalerServer
+	queuePlugin  queue.QueueService
+	eventsPlugin events.EventService
+	utilization  worker.UtilizationSource
+	pollInterval time.Duration
+}
+
+type ExternalScalerServerOption interface {
+	Apply(*ExternalScalerServer)
+}
+
+type withScalerQueues struct {
+	plugin queue.QueueService
+}
+
+func (w *withScalerQueues) Apply(server *ExternalScalerServer) {
+	server.queuePlugin = w.plugin
+}
+
+// WithScalerQueues - scale on the depth of queues
+func WithScalerQueues(plugin queue.QueueService) ExternalScalerServerOption {
+	return &withScalerQueues{
+		plugin: plugin,
+	}
+}
+
+type withScalerTopics struct {
+	plugin events.EventService
+}
+
+func (w *withScalerTopics) Apply(server *ExternalScalerServer) {
+	server.eventsPlugin = w.plugin
+}
+
+// WithScalerTopics - scale on the backlog of topics
+func WithScalerTopics(plugin events.EventService) ExternalScalerServerOption {
+	return &withScalerTopics{
+		plugin: plugin,
+	}
+}
+
+type withScalerUtilization struct {
+	source worker.UtilizationSource
+}
+
+func (w *withScalerUtilization) Apply(server *ExternalScalerServer) {
+	server.utilization = w.source
+}
+
+// WithScalerUtilization - scale on the number of triggers being handled.
+// The source only counts the triggers handled by this membrane's workers
+func WithScalerUtilization(source worker.UtilizationSource) ExternalScalerServerOption {
+	return &withScalerUtilization{
+		source: source,
+	}
+}
+
+type withScalerPollInterval struct {
+	interval time.Duration
+}
+
+func (w *withScalerPollInterval) Apply(server *ExternalScalerServer) {
+	server.pollInterval = w.interval
+}
+
+// WithScalerPollInterval - how often metrics are checked for changes to push with StreamIsActive
+func WithScalerPollInterval(interval time.Duration) ExternalScalerServerOption {
+	return &withScalerPollInterval{
+		interval: interval,
+	}
+}
+
+// scalerMetric - the workload a scaled object is scaled on
+type scalerMetric struct {
+	name   string
+	target int64
+	value  func() (int64, error)
+}
+
+// metric - resolves the workload described by the metadata of a scaled object's trigger
+func (s *ExternalScalerServer) metric(ref *pb.ScaledObjectRef) (*scalerMetric, error) {
+	metadata := ref.GetScalerMetadata()
+	name := metadata["name"]
+
+	metric := &scalerMetric{
+		target: defaultScalerTarget,
+	}
+
+	switch metadata["type"] {
+	case ScalerTypeQueue:
+		if s.queuePlugin == nil {
+			return nil, fmt.Errorf("queues are not available to scale on")
+		}
+		if name == "" {
+			return nil, fmt.Errorf("name of the queue is required")
+		}
+		metric.name = "nitric-queue-" + name
+		metric.value = func() (int64, error) {
+			stats, err := s.queuePlugin.GetQueueStats(name)
+			if err != nil {
+				return 0, err
+			}
+			// Tasks being handled still need a replica, so they're counted with those waiting
+			return stats.Depth + stats.InFlight, nil
+		}
+	case ScalerTypeTopic:
+		if s.eventsPlugin == nil {
+			return nil, fmt.Errorf("topics are not available to scale on")
+		}
+		if name == "" {
+			return nil, fmt.Errorf("name of the topic is required")
+		}
+		metric.name = "nitric-topic-" + name
+		metric.value = func() (int64, error) {
+			return s.eventsPlugin.Backlog(name)
+		}
+	case ScalerTypeConcurrency:
+		if s.utilization == nil {
+			return nil, fmt.Errorf("concurrency is not available to scale on")
+		}
+		metric.name = "nitric-concurrency"
+		// Each replica is expected to handle as many triggers as its workers can
+		if capacity := s.utilization.Utilization().Capacity; capacity > 0 {
+			metric.target = capacity
+		}
+		metric.value = func() (int64, error) {
+			return s.utilization.Utilization().InFlight, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown scaler type %q, expected queue, topic or concurrency", metadata["type"])
+	}
+
+	if targetStr, ok := metadata["target"]; ok {
+		target, err := strconv.ParseInt(targetStr, 10, 64)
+		if err != nil || target < 1 {
+			return nil, fmt.Errorf("invalid target, expected positive integer, got %v", targetStr)
+		}
+		metric.target = target
+	}
+
+	return metric, nil
+}
+
+func (s *ExternalScalerServer) IsActive(ctx context.Context, req *pb.ScaledObjectRef) (*pb.IsActiveResponse, error) {
+	metric, err := s.metric(req)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "ExternalScaler.IsActive", err)
+	}
+
+	value, err := metric.value()
+	if err != nil {
+		return nil, NewGrpcError("ExternalScaler.IsActive", err)
+	}
+
+	return &pb.IsActiveResponse{
+		Result: value > 0,
+	}, nil
+}
+
+// StreamIsActive - checks the metric at the poll interval, pushing whether the scaled object is active when it changes.
+// Errors reading the metric are retried at the next interval rather than ending the stream
+func (s *ExternalScalerServer) StreamIsActive(req *pb.ScaledObjectRef, stream pb.ExternalScaler_StreamIsActiveServer) error {
+	metric, err := s.metric(req)
+	if err != nil {
+		return newGrpcErrorWithCode(codes.InvalidArgument, "ExternalScaler.StreamIsActive", err)
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	var active *bool
+	for {
+		if value, err := metric.value(); err == nil {
+			if isActive := value > 0; active == nil || *active != isActive {
+				if err := stream.Send(&pb.IsActiveResponse{Result: isActive}); err != nil {
+					return err
+				}
+				active = &isActive
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ExternalScalerServer) GetMetricSpec(ctx context.Context, req *pb.ScaledObjectRef) (*pb.GetMetricSpecResponse, error) {
+	metric, err := s.metric(req)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "ExternalScaler.GetMetricSpec", err)
+	}
+
+	return &pb.GetMetricSpecResponse{
+		MetricSpecs: []*pb.MetricSpec{
+			{
+				MetricName: metric.name,
+				TargetSize: metric.target,
+			},
+		},
+	}, nil
+}
+
+func (s *ExternalScalerServer) GetMetrics(ctx context.Context, req *pb.GetMetricsRequest) (*pb.GetMetricsResponse, error) {
+	metric, err := s.metric(req.GetScaledObjectRef())
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "ExternalScaler.GetMetrics", err)
+	}
+
+	value, err := metric.value()
+	if err != nil {
+		return nil, NewGrpcError("ExternalScaler.GetMetrics", err)
+	}
+
+	return &pb.GetMetricsResponse{
+		MetricValues: []*pb.MetricValue{
+			{
+				MetricName:  metric.name,
+				MetricValue: value,
+			},
+		},
+	}, nil
+}
+
+func NewExternalScalerServer(opts ...ExternalScalerServerOption) pb.ExternalScalerServer {
+	server := &ExternalScalerServer{
+		pollInterval: 5 * time.Second,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
@@ -0,0 +1,210 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mock_queue "github.com/nitrictech/nitric/mocks/queue"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type staticUtilization struct {
+	utilization worker.Utilization
+}
+
+func (s *staticUtilization) Utilization() worker.Utilization {
+	return s.utilization
+}
+
+type recordingIsActiveStream struct {
+	grpclib.ServerStream
+	ctx  context.Context
+	sent []bool
+}
+
+func (r *recordingIsActiveStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *recordingIsActiveStream) Send(resp *v1.IsActiveResponse) error {
+	r.sent = append(r.sent, resp.Result)
+	return nil
+}
+
+func scaledObject(metadata map[string]string) *v1.ScaledObjectRef {
+	return &v1.ScaledObjectRef{
+		Name:           "orders",
+		Namespace:      "default",
+		ScalerMetadata: metadata,
+	}
+}
+
+var _ = Describe("External Scaler gRPC Adapter", func() {
+	Context("GetMetricSpec", func() {
+		When("scaling on a queue without a target", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerQueues(&queue.UnimplementedQueuePlugin{}))
+			resp, err := server.GetMetricSpec(context.Background(), scaledObject(map[string]string{
+				"type": "queue",
+				"name": "jobs",
+			}))
+
+			It("should use the default target", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.MetricSpecs).To(HaveLen(1))
+				Expect(resp.MetricSpecs[0].MetricName).To(Equal("nitric-queue-jobs"))
+				Expect(resp.MetricSpecs[0].TargetSize).To(Equal(int64(5)))
+			})
+		})
+
+		When("scaling on concurrency", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerUtilization(&staticUtilization{
+				utilization: worker.Utilization{InFlight: 2, Capacity: 8},
+			}))
+			resp, err := server.GetMetricSpec(context.Background(), scaledObject(map[string]string{
+				"type": "concurrency",
+			}))
+
+			It("should target the capacity of each replica", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.MetricSpecs[0].MetricName).To(Equal("nitric-concurrency"))
+				Expect(resp.MetricSpecs[0].TargetSize).To(Equal(int64(8)))
+			})
+		})
+
+		When("the metadata is invalid", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerQueues(&queue.UnimplementedQueuePlugin{}))
+
+			It("should reject unknown types", func() {
+				_, err := server.GetMetricSpec(context.Background(), scaledObject(map[string]string{
+					"type": "cpu",
+				}))
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should reject invalid targets", func() {
+				_, err := server.GetMetricSpec(context.Background(), scaledObject(map[string]string{
+					"type":   "queue",
+					"name":   "jobs",
+					"target": "0",
+				}))
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should reject workloads that aren't available", func() {
+				_, err := server.GetMetricSpec(context.Background(), scaledObject(map[string]string{
+					"type": "topic",
+					"name": "updates",
+				}))
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("GetMetrics", func() {
+		When("scaling on a queue", func() {
+			g := gomock.NewController(GinkgoT())
+			mockQueue := mock_queue.NewMockQueueService(g)
+			server := grpc.NewExternalScalerServer(grpc.WithScalerQueues(mockQueue))
+
+			mockQueue.EXPECT().GetQueueStats("jobs").Return(&queue.QueueStats{
+				Depth:    9,
+				InFlight: 3,
+			}, nil)
+
+			resp, err := server.GetMetrics(context.Background(), &v1.GetMetricsRequest{
+				ScaledObjectRef: scaledObject(map[string]string{
+					"type":   "queue",
+					"name":   "jobs",
+					"target": "10",
+				}),
+				MetricName: "nitric-queue-jobs",
+			})
+
+			It("should count the waiting and in flight tasks", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.MetricValues).To(HaveLen(1))
+				Expect(resp.MetricValues[0].MetricName).To(Equal("nitric-queue-jobs"))
+				Expect(resp.MetricValues[0].MetricValue).To(Equal(int64(12)))
+			})
+		})
+
+		When("scaling on a topic", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerTopics(&MockEventService{
+				Backlogs: map[string]int64{"updates": 40},
+			}))
+
+			resp, err := server.GetMetrics(context.Background(), &v1.GetMetricsRequest{
+				ScaledObjectRef: scaledObject(map[string]string{
+					"type": "topic",
+					"name": "updates",
+				}),
+			})
+
+			It("should return the topic backlog", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.MetricValues[0].MetricName).To(Equal("nitric-topic-updates"))
+				Expect(resp.MetricValues[0].MetricValue).To(Equal(int64(40)))
+			})
+		})
+	})
+
+	Context("IsActive", func() {
+		When("the topic has no backlog", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerTopics(&MockEventService{}))
+
+			resp, err := server.IsActive(context.Background(), scaledObject(map[string]string{
+				"type": "topic",
+				"name": "updates",
+			}))
+
+			It("should be inactive", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Result).To(BeFalse())
+			})
+		})
+	})
+
+	Context("StreamIsActive", func() {
+		When("the stream is closed", func() {
+			server := grpc.NewExternalScalerServer(grpc.WithScalerUtilization(&staticUtilization{
+				utilization: worker.Utilization{InFlight: 1, Capacity: 1},
+			}))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			stream := &recordingIsActiveStream{ctx: ctx}
+
+			err := server.StreamIsActive(scaledObject(map[string]string{
+				"type": "concurrency",
+			}), stream)
+
+			It("should send the current state before returning", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.sent).To(Equal([]bool{true}))
+			})
+		})
+	})
+})
@@ -0,0 +1,186 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// GRPC Interface for registered Nitric Search Plugins
+type SearchServer struct {
+	pb.UnimplementedSearchServiceServer
+	searchPlugin search.SearchService
+	tenancy      *tenancy.Tenancy
+}
+
+type SearchServerOption interface {
+	Apply(*SearchServer)
+}
+
+type withSearchTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withSearchTenancy) Apply(server *SearchServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithSearchTenancy - namespaces index names by the tenant of each call
+func WithSearchTenancy(t *tenancy.Tenancy) SearchServerOption {
+	return &withSearchTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *SearchServer) checkPluginRegistered() error {
+	if s.searchPlugin == nil {
+		return NewPluginNotRegisteredError("Search")
+	}
+
+	return nil
+}
+
+func (s *SearchServer) Index(ctx context.Context, req *pb.SearchIndexRequest) (*pb.SearchIndexResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Index", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Index", err)
+	}
+
+	if err := s.searchPlugin.Index(tenancy.Name(tenant, req.GetIndex()), req.GetId(), req.GetContent().AsMap()); err != nil {
+		return nil, NewGrpcError("SearchService.Index", err)
+	}
+
+	return &pb.SearchIndexResponse{}, nil
+}
+
+func (s *SearchServer) Delete(ctx context.Context, req *pb.SearchDeleteRequest) (*pb.SearchDeleteResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Delete", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Delete", err)
+	}
+
+	if err := s.searchPlugin.Delete(tenancy.Name(tenant, req.GetIndex()), req.GetId()); err != nil {
+		return nil, NewGrpcError("SearchService.Delete", err)
+	}
+
+	return &pb.SearchDeleteResponse{}, nil
+}
+
+func (s *SearchServer) Query(ctx context.Context, req *pb.SearchQueryRequest) (*pb.SearchQueryResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Query", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SearchService.Query", err)
+	}
+
+	filters := make([]search.Filter, 0, len(req.GetFilters()))
+	for _, f := range req.GetFilters() {
+		filters = append(filters, search.Filter{
+			Field: f.GetField(),
+			Value: f.GetValue().AsInterface(),
+		})
+	}
+
+	result, err := s.searchPlugin.Query(tenancy.Name(tenant, req.GetIndex()), &search.Query{
+		Text:        req.GetText(),
+		Filters:     filters,
+		Facets:      req.GetFacets(),
+		Limit:       int(req.GetLimit()),
+		PagingToken: req.GetPagingToken(),
+	})
+	if err != nil {
+		return nil, NewGrpcError("SearchService.Query", err)
+	}
+
+	hits := make([]*pb.SearchHit, 0, len(result.Hits))
+	for _, h := range result.Hits {
+		content, err := structpb.NewStruct(h.Content)
+		if err != nil {
+			return nil, NewGrpcError("SearchService.Query", err)
+		}
+
+		hits = append(hits, &pb.SearchHit{
+			Id:      h.Id,
+			Score:   h.Score,
+			Content: content,
+		})
+	}
+
+	// Facets are returned in the order they were requested
+	facets := make([]*pb.SearchFacet, 0, len(req.GetFacets()))
+	for _, field := range req.GetFacets() {
+		values := make([]*pb.SearchFacetValue, 0, len(result.Facets[field]))
+		for _, v := range result.Facets[field] {
+			values = append(values, &pb.SearchFacetValue{
+				Value: v.Value,
+				Count: v.Count,
+			})
+		}
+
+		facets = append(facets, &pb.SearchFacet{
+			Field:  field,
+			Values: values,
+		})
+	}
+
+	return &pb.SearchQueryResponse{
+		Hits:        hits,
+		Total:       result.Total,
+		Facets:      facets,
+		PagingToken: result.PagingToken,
+	}, nil
+}
+
+func NewSearchServer(searchPlugin search.SearchService, opts ...SearchServerOption) pb.SearchServiceServer {
+	server := &SearchServer{
+		searchPlugin: searchPlugin,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mock_search "github.com/nitrictech/nitric/mocks/search"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+var _ = Describe("GRPC Search", func() {
+	Context("Index", func() {
+		When("plugin not registered", func() {
+			ss := &grpc.SearchServer{}
+			resp, err := ss.Index(context.Background(), &v1.SearchIndexRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Search plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+			resp, err := grpc.NewSearchServer(mockSearch).Index(context.Background(), &v1.SearchIndexRequest{
+				Index: "products",
+				Id:    "p1",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid SearchIndexRequest.Content"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+
+			mockSearch.EXPECT().Index("products", "p1", map[string]interface{}{"name": "Widget"}).Return(nil)
+
+			content, _ := structpb.NewStruct(map[string]interface{}{"name": "Widget"})
+			_, err := grpc.NewSearchServer(mockSearch).Index(context.Background(), &v1.SearchIndexRequest{
+				Index:   "products",
+				Id:      "p1",
+				Content: content,
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+
+			mockSearch.EXPECT().Index("acme-products", "p1", map[string]interface{}{"name": "Widget"}).Return(nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			content, _ := structpb.NewStruct(map[string]interface{}{"name": "Widget"})
+			_, err := grpc.NewSearchServer(mockSearch, grpc.WithSearchTenancy(tenancy.New(tenancy.Optional))).Index(ctx, &v1.SearchIndexRequest{
+				Index:   "products",
+				Id:      "p1",
+				Content: content,
+			})
+
+			It("Should use the tenant's index", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Delete", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+
+			mockSearch.EXPECT().Delete("products", "p1").Return(nil)
+
+			_, err := grpc.NewSearchServer(mockSearch).Delete(context.Background(), &v1.SearchDeleteRequest{
+				Index: "products",
+				Id:    "p1",
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Query", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+
+			mockSearch.EXPECT().Query("products", &search.Query{
+				Text:        "widget",
+				Filters:     []search.Filter{{Field: "color", Value: "red"}},
+				Facets:      []string{"size", "color"},
+				Limit:       10,
+				PagingToken: "",
+			}).Return(&search.QueryResult{
+				Hits: []*search.Hit{
+					{Id: "p1", Score: 1.2, Content: map[string]interface{}{"name": "Widget"}},
+				},
+				Total: 1,
+				Facets: map[string][]*search.FacetValue{
+					"color": {{Value: "red", Count: 1}},
+				},
+			}, nil)
+
+			resp, err := grpc.NewSearchServer(mockSearch).Query(context.Background(), &v1.SearchQueryRequest{
+				Index: "products",
+				Text:  "widget",
+				Filters: []*v1.SearchFilter{
+					{Field: "color", Value: structpb.NewStringValue("red")},
+				},
+				Facets: []string{"size", "color"},
+				Limit:  10,
+			})
+
+			It("Should return the hits", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Total).To(Equal(int64(1)))
+				Expect(resp.Hits).To(HaveLen(1))
+				Expect(resp.Hits[0].Id).To(Equal("p1"))
+				Expect(resp.Hits[0].Content.AsMap()).To(Equal(map[string]interface{}{"name": "Widget"}))
+			})
+
+			It("Should return the facets in the requested order", func() {
+				Expect(resp.Facets).To(HaveLen(2))
+				Expect(resp.Facets[0].Field).To(Equal("size"))
+				Expect(resp.Facets[0].Values).To(BeEmpty())
+				Expect(resp.Facets[1].Field).To(Equal("color"))
+				Expect(resp.Facets[1].Values[0].Value).To(Equal("red"))
+			})
+		})
+
+		When("the limit is too large", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSearch := mock_search.NewMockSearchService(g)
+			resp, err := grpc.NewSearchServer(mockSearch).Query(context.Background(), &v1.SearchQueryRequest{
+				Index: "products",
+				Limit: 5000,
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid SearchQueryRequest.Limit"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+})
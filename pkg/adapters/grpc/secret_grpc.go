@@ -21,12 +21,33 @@ import (
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/tenancy"
 )
 
 // GRPC Interface for registered Nitric Secret Plugins
 type SecretServer struct {
 	pb.UnimplementedSecretServiceServer
 	secretPlugin secret.SecretService
+	tenancy      *tenancy.Tenancy
+}
+
+type SecretServerOption interface {
+	Apply(*SecretServer)
+}
+
+type withSecretTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withSecretTenancy) Apply(server *SecretServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithSecretTenancy - namespaces secret names by the tenant of each call
+func WithSecretTenancy(t *tenancy.Tenancy) SecretServerOption {
+	return &withSecretTenancy{
+		tenancy: t,
+	}
 }
 
 func (s *SecretServer) checkPluginRegistered() error {
@@ -46,13 +67,18 @@ func (s *SecretServer) Put(ctx context.Context, req *pb.SecretPutRequest) (*pb.S
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Put", err)
 	}
 
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Put", err)
+	}
+
 	if r, err := s.secretPlugin.Put(&secret.Secret{
-		Name: req.GetSecret().GetName(),
+		Name: tenancy.Name(tenant, req.GetSecret().GetName()),
 	}, req.GetValue()); err == nil {
 		return &pb.SecretPutResponse{
 			SecretVersion: &pb.SecretVersion{
 				Secret: &pb.Secret{
-					Name: r.SecretVersion.Secret.Name,
+					Name: tenancy.StripName(tenant, r.SecretVersion.Secret.Name),
 				},
 				Version: r.SecretVersion.Version,
 			},
@@ -71,16 +97,21 @@ func (s *SecretServer) Access(ctx context.Context, req *pb.SecretAccessRequest)
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Access", err)
 	}
 
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Access", err)
+	}
+
 	if s, err := s.secretPlugin.Access(&secret.SecretVersion{
 		Secret: &secret.Secret{
-			Name: req.GetSecretVersion().GetSecret().GetName(),
+			Name: tenancy.Name(tenant, req.GetSecretVersion().GetSecret().GetName()),
 		},
 		Version: req.GetSecretVersion().GetVersion(),
 	}); err == nil {
 		return &pb.SecretAccessResponse{
 			SecretVersion: &pb.SecretVersion{
 				Secret: &pb.Secret{
-					Name: s.SecretVersion.Secret.Name,
+					Name: tenancy.StripName(tenant, s.SecretVersion.Secret.Name),
 				},
 				Version: s.SecretVersion.Version,
 			},
@@ -91,8 +122,14 @@ func (s *SecretServer) Access(ctx context.Context, req *pb.SecretAccessRequest)
 	}
 }
 
-func NewSecretServer(secretPlugin secret.SecretService) pb.SecretServiceServer {
-	return &SecretServer{
+func NewSecretServer(secretPlugin secret.SecretService, opts ...SecretServerOption) pb.SecretServiceServer {
+	server := &SecretServer{
 		secretPlugin: secretPlugin,
 	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
 }
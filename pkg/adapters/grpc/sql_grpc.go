@@ -0,0 +1,197 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/sql"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// GRPC Interface for registered Nitric SQL Plugins
+type SqlServer struct {
+	pb.UnimplementedSqlServiceServer
+	sqlPlugin sql.SqlService
+	tenancy   *tenancy.Tenancy
+}
+
+type SqlServerOption interface {
+	Apply(*SqlServer)
+}
+
+type withSqlTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withSqlTenancy) Apply(server *SqlServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithSqlTenancy - namespaces database names by the tenant of each call.
+// Transactions are bound to their database, so tenants can only query in their own transactions
+func WithSqlTenancy(t *tenancy.Tenancy) SqlServerOption {
+	return &withSqlTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *SqlServer) checkPluginRegistered() error {
+	if s.sqlPlugin == nil {
+		return NewPluginNotRegisteredError("Sql")
+	}
+
+	return nil
+}
+
+func paramsFromWire(params []*structpb.Value) []interface{} {
+	values := make([]interface{}, 0, len(params))
+	for _, p := range params {
+		values = append(values, p.AsInterface())
+	}
+
+	return values
+}
+
+func (s *SqlServer) Query(ctx context.Context, req *pb.SqlQueryRequest) (*pb.SqlQueryResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Query", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Query", err)
+	}
+
+	result, err := s.sqlPlugin.Query(tenancy.Name(tenant, req.GetDatabase()), req.GetQuery(), paramsFromWire(req.GetParams()), req.GetTransactionId())
+	if err != nil {
+		return nil, NewGrpcError("SqlService.Query", err)
+	}
+
+	rows := make([]*structpb.Struct, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		pbRow, err := structpb.NewStruct(row)
+		if err != nil {
+			return nil, NewGrpcError("SqlService.Query", err)
+		}
+
+		rows = append(rows, pbRow)
+	}
+
+	return &pb.SqlQueryResponse{
+		Columns: result.Columns,
+		Rows:    rows,
+	}, nil
+}
+
+func (s *SqlServer) Exec(ctx context.Context, req *pb.SqlExecRequest) (*pb.SqlExecResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Exec", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Exec", err)
+	}
+
+	result, err := s.sqlPlugin.Exec(tenancy.Name(tenant, req.GetDatabase()), req.GetStatement(), paramsFromWire(req.GetParams()), req.GetTransactionId())
+	if err != nil {
+		return nil, NewGrpcError("SqlService.Exec", err)
+	}
+
+	return &pb.SqlExecResponse{
+		RowsAffected: result.RowsAffected,
+	}, nil
+}
+
+func (s *SqlServer) Begin(ctx context.Context, req *pb.SqlBeginRequest) (*pb.SqlBeginResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Begin", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Begin", err)
+	}
+
+	transactionId, err := s.sqlPlugin.Begin(tenancy.Name(tenant, req.GetDatabase()))
+	if err != nil {
+		return nil, NewGrpcError("SqlService.Begin", err)
+	}
+
+	return &pb.SqlBeginResponse{
+		TransactionId: transactionId,
+	}, nil
+}
+
+func (s *SqlServer) Commit(ctx context.Context, req *pb.SqlCommitRequest) (*pb.SqlCommitResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Commit", err)
+	}
+
+	if err := s.sqlPlugin.Commit(req.GetTransactionId()); err != nil {
+		return nil, NewGrpcError("SqlService.Commit", err)
+	}
+
+	return &pb.SqlCommitResponse{}, nil
+}
+
+func (s *SqlServer) Rollback(ctx context.Context, req *pb.SqlRollbackRequest) (*pb.SqlRollbackResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SqlService.Rollback", err)
+	}
+
+	if err := s.sqlPlugin.Rollback(req.GetTransactionId()); err != nil {
+		return nil, NewGrpcError("SqlService.Rollback", err)
+	}
+
+	return &pb.SqlRollbackResponse{}, nil
+}
+
+func NewSqlServer(sqlPlugin sql.SqlService, opts ...SqlServerOption) pb.SqlServiceServer {
+	server := &SqlServer{
+		sqlPlugin: sqlPlugin,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mock_sql "github.com/nitrictech/nitric/mocks/sql"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/sql"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+var _ = Describe("GRPC SQL", func() {
+	Context("Query", func() {
+		When("plugin not registered", func() {
+			ss := &grpc.SqlServer{}
+			resp, err := ss.Query(context.Background(), &v1.SqlQueryRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Sql plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+			resp, err := grpc.NewSqlServer(mockSql).Query(context.Background(), &v1.SqlQueryRequest{
+				Database: "app",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid SqlQueryRequest.Query"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Query("app", "SELECT * FROM users WHERE id = $1", []interface{}{"1"}, "").Return(&sql.QueryResult{
+				Columns: []string{"id", "name"},
+				Rows: []map[string]interface{}{
+					{"id": "1", "name": "Jane"},
+				},
+			}, nil)
+
+			resp, err := grpc.NewSqlServer(mockSql).Query(context.Background(), &v1.SqlQueryRequest{
+				Database: "app",
+				Query:    "SELECT * FROM users WHERE id = $1",
+				Params:   []*structpb.Value{structpb.NewStringValue("1")},
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Columns).To(Equal([]string{"id", "name"}))
+				Expect(resp.Rows).To(HaveLen(1))
+				Expect(resp.Rows[0].AsMap()["name"]).To(Equal("Jane"))
+			})
+		})
+	})
+
+	Context("Exec", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Exec("app", "DELETE FROM users", []interface{}{}, "tx").Return(&sql.ExecResult{
+				RowsAffected: 2,
+			}, nil)
+
+			resp, err := grpc.NewSqlServer(mockSql).Exec(context.Background(), &v1.SqlExecRequest{
+				Database:      "app",
+				Statement:     "DELETE FROM users",
+				TransactionId: "tx",
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.RowsAffected).To(Equal(int64(2)))
+			})
+		})
+	})
+
+	Context("Begin", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Begin("app").Return("tx", nil)
+
+			resp, err := grpc.NewSqlServer(mockSql).Begin(context.Background(), &v1.SqlBeginRequest{
+				Database: "app",
+			})
+
+			It("Should return the transaction id", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.TransactionId).To(Equal("tx"))
+			})
+		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Begin("acme-app").Return("tx", nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewSqlServer(mockSql, grpc.WithSqlTenancy(tenancy.New(tenancy.Optional))).Begin(ctx, &v1.SqlBeginRequest{
+				Database: "app",
+			})
+
+			It("Should use the tenant's database", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Commit", func() {
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+			resp, err := grpc.NewSqlServer(mockSql).Commit(context.Background(), &v1.SqlCommitRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid SqlCommitRequest.TransactionId"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Commit("tx").Return(nil)
+
+			_, err := grpc.NewSqlServer(mockSql).Commit(context.Background(), &v1.SqlCommitRequest{
+				TransactionId: "tx",
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Rollback", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSql := mock_sql.NewMockSqlService(g)
+
+			mockSql.EXPECT().Rollback("tx").Return(nil)
+
+			_, err := grpc.NewSqlServer(mockSql).Rollback(context.Background(), &v1.SqlRollbackRequest{
+				TransactionId: "tx",
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+})
@@ -19,15 +19,70 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/uploads"
 )
 
 // GRPC Interface for registered Nitric Storage Plugins
 type StorageServiceServer struct {
 	pb.UnimplementedStorageServiceServer
 	storagePlugin storage.StorageService
+	compression   *storage.CompressionConfig
+	tenancy       *tenancy.Tenancy
+	uploads       *uploads.Manager
+}
+
+type StorageServiceServerOption interface {
+	Apply(*StorageServiceServer)
+}
+
+type withCompression struct {
+	config *storage.CompressionConfig
+}
+
+func (w *withCompression) Apply(server *StorageServiceServer) {
+	server.compression = w.config
+}
+
+// WithCompression - compress written items according to the given per bucket configuration
+func WithCompression(config *storage.CompressionConfig) StorageServiceServerOption {
+	return &withCompression{
+		config: config,
+	}
+}
+
+type withStorageTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withStorageTenancy) Apply(server *StorageServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithStorageTenancy - prefixes object keys with the tenant of each call
+func WithStorageTenancy(t *tenancy.Tenancy) StorageServiceServerOption {
+	return &withStorageTenancy{
+		tenancy: t,
+	}
+}
+
+type withUploads struct {
+	manager *uploads.Manager
+}
+
+func (w *withUploads) Apply(server *StorageServiceServer) {
+	server.uploads = w.manager
+}
+
+// WithUploads - serves resumable uploads with the manager
+func WithUploads(manager *uploads.Manager) StorageServiceServerOption {
+	return &withUploads{
+		manager: manager,
+	}
 }
 
 func (s *StorageServiceServer) checkPluginRegistered() error {
@@ -47,13 +102,87 @@ func (s *StorageServiceServer) Write(ctx context.Context, req *pb.StorageWriteRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Write", err)
 	}
 
-	if err := s.storagePlugin.Write(req.GetBucketName(), req.GetKey(), req.GetBody()); err == nil {
-		return &pb.StorageWriteResponse{}, nil
+	if req.GetChecksum() != "" && req.GetChecksumAlgorithm() == "" {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Write", fmt.Errorf("a checksum requires a checksum algorithm"))
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Write", err)
+	}
+
+	// The compression requested for this write takes precedence over the bucket default
+	codec := req.GetCompression()
+	if codec == "" {
+		codec = s.compression.CodecFor(req.GetBucketName())
+	}
+
+	opts := make([]storage.WriteOption, 0)
+	if storage.IsCompressed(codec) {
+		if _, err := storage.GetCodec(codec); err != nil {
+			return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Write", err)
+		}
+
+		opts = append(opts, storage.WithCompression(codec))
+	}
+
+	if class := req.GetStorageClass(); class != "" {
+		opts = append(opts, storage.WithStorageClass(class))
+	}
+
+	if etag := req.GetIfMatch(); etag != "" {
+		opts = append(opts, storage.WithIfMatch(etag))
+	}
+
+	if req.GetIfNotExists() {
+		opts = append(opts, storage.WithIfNotExists())
+	}
+
+	// The body is checked as it's received, the provider checks the bytes it stores
+	checksum := ""
+	if algorithm := req.GetChecksumAlgorithm(); algorithm != "" {
+		if checksum, err = storage.VerifyChecksum(algorithm, req.GetChecksum(), req.GetBody()); err != nil {
+			return nil, newGrpcErrorWithCode(codes.DataLoss, "StorageService.Write", err)
+		}
+
+		opts = append(opts, storage.WithIntegrityCheck())
+	}
+
+	etag := ""
+	opts = append(opts, storage.WithWrittenETag(&etag))
+
+	if version, err := s.storagePlugin.Write(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), req.GetBody(), opts...); err == nil {
+		return &pb.StorageWriteResponse{
+			Version:  version,
+			Checksum: checksum,
+			Etag:     etag,
+		}, nil
 	} else {
 		return nil, NewGrpcError("StorageService.Write", err)
 	}
 }
 
+func (s *StorageServiceServer) Append(ctx context.Context, req *pb.StorageAppendRequest) (*pb.StorageAppendResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Append", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Append", err)
+	}
+
+	if err := s.storagePlugin.Append(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), req.GetBody()); err == nil {
+		return &pb.StorageAppendResponse{}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.Append", err)
+	}
+}
+
 func (s *StorageServiceServer) Read(ctx context.Context, req *pb.StorageReadRequest) (*pb.StorageReadResponse, error) {
 	if err := s.checkPluginRegistered(); err != nil {
 		return nil, err
@@ -63,13 +192,44 @@ func (s *StorageServiceServer) Read(ctx context.Context, req *pb.StorageReadRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Read", err)
 	}
 
-	if object, err := s.storagePlugin.Read(req.GetBucketName(), req.GetKey()); err == nil {
-		return &pb.StorageReadResponse{
-			Body: object,
-		}, nil
-	} else {
+	if req.GetChecksum() != "" && req.GetChecksumAlgorithm() == "" {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Read", fmt.Errorf("a checksum requires a checksum algorithm"))
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Read", err)
+	}
+
+	readOpts := make([]storage.ReadOption, 0)
+	if req.GetVersion() != "" {
+		readOpts = append(readOpts, storage.WithVersion(req.GetVersion()))
+	}
+
+	if req.GetChecksumAlgorithm() != "" {
+		readOpts = append(readOpts, storage.WithReadIntegrityCheck())
+	}
+
+	etag := ""
+	readOpts = append(readOpts, storage.WithReadETag(&etag))
+
+	object, err := s.storagePlugin.Read(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), readOpts...)
+	if err != nil {
 		return nil, NewGrpcError("StorageService.Read", err)
 	}
+
+	checksum := ""
+	if algorithm := req.GetChecksumAlgorithm(); algorithm != "" {
+		if checksum, err = storage.VerifyChecksum(algorithm, req.GetChecksum(), object); err != nil {
+			return nil, newGrpcErrorWithCode(codes.DataLoss, "StorageService.Read", err)
+		}
+	}
+
+	return &pb.StorageReadResponse{
+		Body:     object,
+		Checksum: checksum,
+		Etag:     etag,
+	}, nil
 }
 
 func (s *StorageServiceServer) Delete(ctx context.Context, req *pb.StorageDeleteRequest) (*pb.StorageDeleteResponse, error) {
@@ -81,7 +241,17 @@ func (s *StorageServiceServer) Delete(ctx context.Context, req *pb.StorageDelete
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Delete", err)
 	}
 
-	if err := s.storagePlugin.Delete(req.GetBucketName(), req.GetKey()); err == nil {
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Delete", err)
+	}
+
+	opts := make([]storage.DeleteOption, 0)
+	if etag := req.GetIfMatch(); etag != "" {
+		opts = append(opts, storage.WithDeleteIfMatch(etag))
+	}
+
+	if err := s.storagePlugin.Delete(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), opts...); err == nil {
 		return &pb.StorageDeleteResponse{}, nil
 	} else {
 		return nil, NewGrpcError("StorageService.Delete", err)
@@ -106,13 +276,18 @@ func (s *StorageServiceServer) PreSignUrl(ctx context.Context, req *pb.StoragePr
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.PreSignUrl", err)
 	}
 
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.PreSignUrl", err)
+	}
+
 	intendedOp, err := convertOperation(req.GetOperation())
 	// For safety, don't set a default operation (like read). Only perform known operations
 	if err != nil {
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.PreSignUrl", err)
 	}
 
-	if url, err := s.storagePlugin.PreSignUrl(req.GetBucketName(), req.GetKey(), intendedOp, req.GetExpiry()); err == nil {
+	if url, err := s.storagePlugin.PreSignUrl(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), intendedOp, req.GetExpiry()); err == nil {
 		return &pb.StoragePreSignUrlResponse{
 			Url: url,
 		}, nil
@@ -121,6 +296,69 @@ func (s *StorageServiceServer) PreSignUrl(ctx context.Context, req *pb.StoragePr
 	}
 }
 
+func (s *StorageServiceServer) GrantAccess(ctx context.Context, req *pb.StorageGrantAccessRequest) (*pb.StorageGrantAccessResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.GrantAccess", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.GrantAccess", err)
+	}
+
+	operations := make([]storage.Operation, 0, len(req.GetOperations()))
+	for _, op := range req.GetOperations() {
+		operation, err := convertOperation(op)
+		if err != nil {
+			return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.GrantAccess", err)
+		}
+		operations = append(operations, operation)
+	}
+
+	// a tenant's blank prefix still only grants access to the tenant's own items
+	grant, err := s.storagePlugin.GrantAccess(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetPrefix()), operations, req.GetExpiry())
+	if err != nil {
+		return nil, NewGrpcError("StorageService.GrantAccess", err)
+	}
+
+	resp := &pb.StorageGrantAccessResponse{
+		Expires: timestampOrNil(grant.Expires),
+	}
+
+	switch {
+	case grant.Credentials != nil:
+		resp.Grant = &pb.StorageGrantAccessResponse_Credentials{
+			Credentials: &pb.StorageTemporaryCredentials{
+				AccessKeyId:     grant.Credentials.AccessKeyId,
+				SecretAccessKey: grant.Credentials.SecretAccessKey,
+				SessionToken:    grant.Credentials.SessionToken,
+				Bucket:          grant.Credentials.Bucket,
+				Region:          grant.Credentials.Region,
+			},
+		}
+	case grant.PostPolicy != nil:
+		resp.Grant = &pb.StorageGrantAccessResponse_PostPolicy{
+			PostPolicy: &pb.StoragePostPolicy{
+				Url:    grant.PostPolicy.Url,
+				Fields: grant.PostPolicy.Fields,
+			},
+		}
+	case grant.Sas != nil:
+		resp.Grant = &pb.StorageGrantAccessResponse_Sas{
+			Sas: &pb.StorageSasToken{
+				Url:   grant.Sas.Url,
+				Token: grant.Sas.Token,
+			},
+		}
+	}
+
+	return resp, nil
+}
+
 func (s *StorageServiceServer) ListFiles(ctx context.Context, req *pb.StorageListFilesRequest) (*pb.StorageListFilesResponse, error) {
 	if err := s.checkPluginRegistered(); err != nil {
 		return nil, err
@@ -130,12 +368,29 @@ func (s *StorageServiceServer) ListFiles(ctx context.Context, req *pb.StorageLis
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.ListFiles", err)
 	}
 
-	if files, err := s.storagePlugin.ListFiles(req.BucketName); err == nil {
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.ListFiles", err)
+	}
+
+	opts := make([]storage.ListOption, 0)
+	if len(req.GetTags()) > 0 {
+		opts = append(opts, storage.WithTagFilter(req.GetTags()))
+	}
+
+	if files, err := s.storagePlugin.ListFiles(req.BucketName, opts...); err == nil {
 		pbFiles := make([]*pb.File, 0, len(files))
 
 		for _, file := range files {
+			// Only list the tenant's own objects
+			key, ok := tenancy.StripObjectKey(tenant, file.Key)
+			if !ok {
+				continue
+			}
+
 			pbFiles = append(pbFiles, &pb.File{
-				Key: file.Key,
+				Key:  key,
+				Etag: file.ETag,
 			})
 		}
 
@@ -147,8 +402,253 @@ func (s *StorageServiceServer) ListFiles(ctx context.Context, req *pb.StorageLis
 	}
 }
 
-func NewStorageServiceServer(storagePlugin storage.StorageService) pb.StorageServiceServer {
-	return &StorageServiceServer{
+func (s *StorageServiceServer) ListVersions(ctx context.Context, req *pb.StorageListVersionsRequest) (*pb.StorageListVersionsResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.ListVersions", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.ListVersions", err)
+	}
+
+	if versions, err := s.storagePlugin.ListVersions(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey())); err == nil {
+		pbVersions := make([]*pb.FileVersion, 0, len(versions))
+
+		for _, v := range versions {
+			pbVersions = append(pbVersions, &pb.FileVersion{
+				Version:      v.Version,
+				Latest:       v.Latest,
+				LastModified: timestamppb.New(v.LastModified),
+				Size:         v.Size,
+			})
+		}
+
+		return &pb.StorageListVersionsResponse{
+			Versions: pbVersions,
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.ListVersions", err)
+	}
+}
+
+func (s *StorageServiceServer) RestoreVersion(ctx context.Context, req *pb.StorageRestoreVersionRequest) (*pb.StorageRestoreVersionResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.RestoreVersion", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.RestoreVersion", err)
+	}
+
+	if version, err := s.storagePlugin.RestoreVersion(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), req.GetVersion()); err == nil {
+		return &pb.StorageRestoreVersionResponse{
+			Version: version,
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.RestoreVersion", err)
+	}
+}
+
+func (s *StorageServiceServer) SetTags(ctx context.Context, req *pb.StorageSetTagsRequest) (*pb.StorageSetTagsResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.SetTags", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.SetTags", err)
+	}
+
+	if err := s.storagePlugin.SetTags(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey()), req.GetTags()); err == nil {
+		return &pb.StorageSetTagsResponse{}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.SetTags", err)
+	}
+}
+
+func (s *StorageServiceServer) GetTags(ctx context.Context, req *pb.StorageGetTagsRequest) (*pb.StorageGetTagsResponse, error) {
+	if err := s.checkPluginRegistered(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.GetTags", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.GetTags", err)
+	}
+
+	if tags, err := s.storagePlugin.GetTags(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey())); err == nil {
+		return &pb.StorageGetTagsResponse{
+			Tags: tags,
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.GetTags", err)
+	}
+}
+
+func (s *StorageServiceServer) checkUploadsConfigured() error {
+	if s.uploads == nil {
+		return NewPluginNotRegisteredError("Uploads")
+	}
+
+	return nil
+}
+
+// upload - returns an upload of the caller's tenant and the key of its item, uploads of other tenants aren't found
+func (s *StorageServiceServer) upload(ctx context.Context, operation string, id string) (*uploads.Upload, string, error) {
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, "", newGrpcErrorWithCode(codes.InvalidArgument, operation, err)
+	}
+
+	upload, err := s.uploads.Get(id)
+	if err != nil {
+		return nil, "", NewGrpcError(operation, err)
+	}
+
+	key, ok := tenancy.StripObjectKey(tenant, upload.Key)
+	if !ok {
+		return nil, "", newGrpcErrorWithCode(codes.NotFound, operation, fmt.Errorf("upload %s not found", id))
+	}
+
+	return upload, key, nil
+}
+
+func (s *StorageServiceServer) BeginUpload(ctx context.Context, req *pb.StorageBeginUploadRequest) (*pb.StorageBeginUploadResponse, error) {
+	if err := s.checkUploadsConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.BeginUpload", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.BeginUpload", err)
+	}
+
+	if upload, err := s.uploads.Begin(req.GetBucketName(), tenancy.ObjectKey(tenant, req.GetKey())); err == nil {
+		return &pb.StorageBeginUploadResponse{
+			UploadId: upload.ID,
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.BeginUpload", err)
+	}
+}
+
+func (s *StorageServiceServer) AppendUpload(ctx context.Context, req *pb.StorageAppendUploadRequest) (*pb.StorageAppendUploadResponse, error) {
+	if err := s.checkUploadsConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.AppendUpload", err)
+	}
+
+	if _, _, err := s.upload(ctx, "StorageService.AppendUpload", req.GetUploadId()); err != nil {
+		return nil, err
+	}
+
+	if upload, err := s.uploads.Append(req.GetUploadId(), req.GetOffset(), req.GetBody()); err == nil {
+		return &pb.StorageAppendUploadResponse{
+			Offset:   upload.Size(),
+			Complete: upload.Final,
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.AppendUpload", err)
+	}
+}
+
+func (s *StorageServiceServer) UploadStatus(ctx context.Context, req *pb.StorageUploadStatusRequest) (*pb.StorageUploadStatusResponse, error) {
+	if err := s.checkUploadsConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.UploadStatus", err)
+	}
+
+	upload, key, err := s.upload(ctx, "StorageService.UploadStatus", req.GetUploadId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StorageUploadStatusResponse{
+		BucketName: upload.Bucket,
+		Key:        key,
+		Offset:     upload.Size(),
+		Complete:   upload.Final,
+	}, nil
+}
+
+func (s *StorageServiceServer) CommitUpload(ctx context.Context, req *pb.StorageCommitUploadRequest) (*pb.StorageCommitUploadResponse, error) {
+	if err := s.checkUploadsConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.CommitUpload", err)
+	}
+
+	if _, _, err := s.upload(ctx, "StorageService.CommitUpload", req.GetUploadId()); err != nil {
+		return nil, err
+	}
+
+	if upload, err := s.uploads.Commit(req.GetUploadId()); err == nil {
+		return &pb.StorageCommitUploadResponse{
+			Size: upload.Size(),
+		}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.CommitUpload", err)
+	}
+}
+
+func (s *StorageServiceServer) AbortUpload(ctx context.Context, req *pb.StorageAbortUploadRequest) (*pb.StorageAbortUploadResponse, error) {
+	if err := s.checkUploadsConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.AbortUpload", err)
+	}
+
+	if _, _, err := s.upload(ctx, "StorageService.AbortUpload", req.GetUploadId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.uploads.Abort(req.GetUploadId()); err == nil {
+		return &pb.StorageAbortUploadResponse{}, nil
+	} else {
+		return nil, NewGrpcError("StorageService.AbortUpload", err)
+	}
+}
+
+func NewStorageServiceServer(storagePlugin storage.StorageService, opts ...StorageServiceServerOption) pb.StorageServiceServer {
+	server := &StorageServiceServer{
 		storagePlugin: storagePlugin,
 	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
 }
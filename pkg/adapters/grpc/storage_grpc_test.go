@@ -16,15 +16,23 @@ package grpc_test
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	mock_storage "github.com/nitrictech/nitric/mocks/storage"
 	"github.com/nitrictech/nitric/pkg/adapters/grpc"
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/uploads"
 )
 
 var _ = Describe("GRPC Storage", func() {
@@ -54,7 +62,10 @@ var _ = Describe("GRPC Storage", func() {
 			mockSS := mock_storage.NewMockStorageService(g)
 
 			val := []byte("hush")
-			mockSS.EXPECT().Write("bucky", "key", val)
+			mockSS.EXPECT().Write("bucky", "key", val, gomock.Any()).DoAndReturn(func(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+				*storage.NewWriteOptions(opts...).ETag = "\"etag\""
+				return "", nil
+			})
 
 			resp, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
 				BucketName: "bucky",
@@ -62,9 +73,202 @@ var _ = Describe("GRPC Storage", func() {
 				Body:       val,
 			})
 
+			It("Should succeed and return the written item's etag", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Etag).To(Equal("\"etag\""))
+			})
+		})
+
+		When("the bucket has compression configured", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			config, _ := storage.ParseCompressionConfig("bucky=gzip")
+
+			val := []byte("hush")
+			mockSS.EXPECT().Write("bucky", "key", val, gomock.Any(), gomock.Any()).DoAndReturn(func(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+				Expect(storage.NewWriteOptions(opts...).Compression).To(Equal(storage.CompressionGzip))
+				return "", nil
+			})
+
+			_, err := grpc.NewStorageServiceServer(mockSS, grpc.WithCompression(config)).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Body:       val,
+			})
+
+			It("Should write with the bucket codec", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("an unknown compression codec is requested", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:  "bucky",
+				Key:         "key",
+				Body:        []byte("hush"),
+				Compression: "lz4",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("unsupported compression codec"))
+			})
+		})
+
+		When("the write must not overwrite an existing item", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Write("bucky", "key", gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+				Expect(storage.NewWriteOptions(opts...).IfNotExists).To(BeTrue())
+				return "", nil
+			})
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:  "bucky",
+				Key:         "key",
+				Body:        []byte("hush"),
+				IfNotExists: true,
+			})
+
+			It("Should write with the precondition", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("a storage class is requested", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Write("bucky", "key", gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+				Expect(storage.NewWriteOptions(opts...).StorageClass).To(Equal(storage.StorageClassArchive))
+				return "", nil
+			})
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:   "bucky",
+				Key:          "key",
+				Body:         []byte("hush"),
+				StorageClass: "archive",
+			})
+
+			It("Should write with the storage class", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("an unknown storage class is requested", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:   "bucky",
+				Key:          "key",
+				Body:         []byte("hush"),
+				StorageClass: "GLACIER",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid StorageWriteRequest.StorageClass"))
+			})
+		})
+
+		When("a checksum algorithm is requested", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Write("bucky", "key", gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+				Expect(storage.NewWriteOptions(opts...).IntegrityCheck).To(BeTrue())
+				return "", nil
+			})
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:        "bucky",
+				Key:               "key",
+				Body:              []byte("hush"),
+				ChecksumAlgorithm: storage.ChecksumSHA256,
+			})
+
+			It("Should write with an integrity check and return the body's checksum", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Checksum).To(Equal("3IMxvLIoPonSdaHC/mb3v1rDFj7Bln8UndfieQY2Xww="))
+			})
+		})
+
+		When("the body doesn't match its checksum", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName:        "bucky",
+				Key:               "key",
+				Body:              []byte("hush"),
+				ChecksumAlgorithm: storage.ChecksumSHA256,
+				Checksum:          "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+			})
+
+			It("Should not write the item", func() {
+				Expect(status.Code(err)).To(Equal(codes.DataLoss))
+				Expect(err.Error()).Should(ContainSubstring(storage.ChecksumMismatch))
+			})
+		})
+
+		When("a checksum is given without its algorithm", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Write(context.Background(), &v1.StorageWriteRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Body:       []byte("hush"),
+				Checksum:   "3IMxvLIoPonSdaHC/mb3v1rDFj7Bln8UndfieQY2Xww=",
+			})
+
+			It("Should report an error", func() {
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Append", func() {
+		When("plugin not registered", func() {
+			ss := &grpc.StorageServiceServer{}
+			resp, err := ss.Append(context.Background(), &v1.StorageAppendRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Storage plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			resp, err := grpc.NewStorageServiceServer(mockSS).Append(context.Background(), &v1.StorageAppendRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid StorageAppendRequest.BucketName"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			val := []byte("line\n")
+			mockSS.EXPECT().Append("bucky", "key", val).Return(nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).Append(context.Background(), &v1.StorageAppendRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Body:       val,
+			})
+
 			It("Should succeed", func() {
 				Expect(err).Should(BeNil())
-				Expect(resp.String()).To(Equal(""))
+				Expect(resp).ShouldNot(BeNil())
 			})
 		})
 	})
@@ -95,16 +299,63 @@ var _ = Describe("GRPC Storage", func() {
 			mockSS := mock_storage.NewMockStorageService(g)
 
 			val := []byte("hush")
-			mockSS.EXPECT().Read("bucky", "key").Return(val, nil)
+			mockSS.EXPECT().Read("bucky", "key", gomock.Any()).DoAndReturn(func(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+				*storage.NewReadOptions(opts...).ETag = "\"etag\""
+				return val, nil
+			})
 
 			resp, err := grpc.NewStorageServiceServer(mockSS).Read(context.Background(), &v1.StorageReadRequest{
 				BucketName: "bucky",
 				Key:        "key",
 			})
 
-			It("Should succeed", func() {
+			It("Should succeed and return the item's etag", func() {
+				Expect(err).Should(BeNil())
+				Expect(string(resp.Body)).To(Equal("hush"))
+				Expect(resp.Etag).To(Equal("\"etag\""))
+			})
+		})
+
+		When("the item matches its checksum", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Read("bucky", "key", gomock.Any(), gomock.Any()).DoAndReturn(func(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+				Expect(storage.NewReadOptions(opts...).IntegrityCheck).To(BeTrue())
+				return []byte("hush"), nil
+			})
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).Read(context.Background(), &v1.StorageReadRequest{
+				BucketName:        "bucky",
+				Key:               "key",
+				ChecksumAlgorithm: storage.ChecksumSHA256,
+				Checksum:          "3IMxvLIoPonSdaHC/mb3v1rDFj7Bln8UndfieQY2Xww=",
+			})
+
+			It("Should return the item with its checksum", func() {
 				Expect(err).Should(BeNil())
 				Expect(string(resp.Body)).To(Equal("hush"))
+				Expect(resp.Checksum).To(Equal("3IMxvLIoPonSdaHC/mb3v1rDFj7Bln8UndfieQY2Xww="))
+			})
+		})
+
+		When("the item doesn't match its checksum", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Read("bucky", "key", gomock.Any(), gomock.Any()).Return([]byte("hash"), nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).Read(context.Background(), &v1.StorageReadRequest{
+				BucketName:        "bucky",
+				Key:               "key",
+				ChecksumAlgorithm: storage.ChecksumSHA256,
+				Checksum:          "3IMxvLIoPonSdaHC/mb3v1rDFj7Bln8UndfieQY2Xww=",
+			})
+
+			It("Should report a data loss error", func() {
+				Expect(status.Code(err)).To(Equal(codes.DataLoss))
+				Expect(err.Error()).Should(ContainSubstring(storage.ChecksumMismatch))
+				Expect(resp).Should(BeNil())
 			})
 		})
 	})
@@ -145,6 +396,26 @@ var _ = Describe("GRPC Storage", func() {
 				Expect(err).Should(BeNil())
 			})
 		})
+
+		When("the delete is conditional", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().Delete("bucky", "key", gomock.Any()).DoAndReturn(func(bucket string, key string, opts ...storage.DeleteOption) error {
+				Expect(storage.NewDeleteOptions(opts...).IfMatch).To(Equal("etag"))
+				return nil
+			})
+
+			_, err := grpc.NewStorageServiceServer(mockSS).Delete(context.Background(), &v1.StorageDeleteRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				IfMatch:    "etag",
+			})
+
+			It("Should delete with the precondition", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
 	})
 
 	Context("PreSignURL", func() {
@@ -201,6 +472,120 @@ var _ = Describe("GRPC Storage", func() {
 		})
 	})
 
+	Context("GrantAccess", func() {
+		When("plugin not registered", func() {
+			ss := &grpc.StorageServiceServer{}
+			resp, err := ss.GrantAccess(context.Background(), &v1.StorageGrantAccessRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Storage plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid - operations", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			resp, err := grpc.NewStorageServiceServer(mockSS).GrantAccess(context.Background(), &v1.StorageGrantAccessRequest{
+				BucketName: "bucky",
+				Expiry:     3600,
+			})
+
+			It("Should report an invalid argument", func() {
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(err.Error()).Should(ContainSubstring("invalid StorageGrantAccessRequest.Operations"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("the provider issues temporary credentials", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			mockSS.EXPECT().GrantAccess("bucky", "uploads/", []storage.Operation{storage.READ, storage.WRITE}, uint32(3600)).Return(&storage.AccessGrant{
+				Credentials: &storage.TemporaryCredentials{
+					AccessKeyId:     "ASIAEXAMPLE",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Bucket:          "bucky-aaa111",
+					Region:          "us-east-1",
+				},
+			}, nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).GrantAccess(context.Background(), &v1.StorageGrantAccessRequest{
+				BucketName: "bucky",
+				Prefix:     "uploads/",
+				Operations: []v1.StoragePreSignUrlRequest_Operation{v1.StoragePreSignUrlRequest_READ, v1.StoragePreSignUrlRequest_WRITE},
+				Expiry:     3600,
+			})
+
+			It("Should return the credentials", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.GetCredentials().AccessKeyId).To(Equal("ASIAEXAMPLE"))
+				Expect(resp.GetCredentials().Bucket).To(Equal("bucky-aaa111"))
+				Expect(resp.GetCredentials().Region).To(Equal("us-east-1"))
+			})
+		})
+
+		When("the provider issues a post policy", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			mockSS.EXPECT().GrantAccess("bucky", "", []storage.Operation{storage.WRITE}, uint32(600)).Return(&storage.AccessGrant{
+				PostPolicy: &storage.PostPolicy{
+					Url:    "https://storage.googleapis.com/bucky/",
+					Fields: map[string]string{"policy": "abc"},
+				},
+			}, nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).GrantAccess(context.Background(), &v1.StorageGrantAccessRequest{
+				BucketName: "bucky",
+				Operations: []v1.StoragePreSignUrlRequest_Operation{v1.StoragePreSignUrlRequest_WRITE},
+				Expiry:     600,
+			})
+
+			It("Should return the policy", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.GetPostPolicy().Url).To(Equal("https://storage.googleapis.com/bucky/"))
+				Expect(resp.GetPostPolicy().Fields).To(HaveKeyWithValue("policy", "abc"))
+			})
+		})
+
+		When("the call is made for a tenant without a prefix", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			mockSS.EXPECT().GrantAccess("bucky", "acme/", gomock.Any(), gomock.Any()).Return(&storage.AccessGrant{
+				Sas: &storage.SasToken{Url: "https://account/bucky?sig", Token: "sig"},
+			}, nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			resp, err := grpc.NewStorageServiceServer(mockSS, grpc.WithStorageTenancy(tenancy.New(tenancy.Optional))).GrantAccess(ctx, &v1.StorageGrantAccessRequest{
+				BucketName: "bucky",
+				Operations: []v1.StoragePreSignUrlRequest_Operation{v1.StoragePreSignUrlRequest_READ},
+				Expiry:     600,
+			})
+
+			It("Should only grant access to the tenant's items", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.GetSas().Token).To(Equal("sig"))
+			})
+		})
+
+		When("the provider can't grant access", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			mockSS.EXPECT().GrantAccess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("mock-error"))
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).GrantAccess(context.Background(), &v1.StorageGrantAccessRequest{
+				BucketName: "bucky",
+				Operations: []v1.StoragePreSignUrlRequest_Operation{v1.StoragePreSignUrlRequest_READ},
+				Expiry:     600,
+			})
+
+			It("Should report the error", func() {
+				Expect(err.Error()).Should(ContainSubstring("mock-error"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+
 	Context("List", func() {
 		When("plugin not registered", func() {
 			ss := &grpc.StorageServiceServer{}
@@ -236,5 +621,230 @@ var _ = Describe("GRPC Storage", func() {
 				Expect(err).Should(BeNil())
 			})
 		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().ListFiles("bucky").Return([]*storage.FileInfo{
+				{Key: "acme/report.pdf"},
+				{Key: "other/report.pdf"},
+			}, nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			resp, err := grpc.NewStorageServiceServer(mockSS, grpc.WithStorageTenancy(tenancy.New(tenancy.Required))).ListFiles(ctx, &v1.StorageListFilesRequest{
+				BucketName: "bucky",
+			})
+
+			It("Should only list the tenant's files", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Files).To(HaveLen(1))
+				Expect(resp.Files[0].Key).To(Equal("report.pdf"))
+			})
+		})
+	})
+
+	Context("ListVersions", func() {
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().ListVersions("bucky", "acme/key").Return([]*storage.VersionInfo{
+				{Version: "v2", Latest: true, Size: 4},
+				{Version: "v1", Size: 2},
+			}, nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			resp, err := grpc.NewStorageServiceServer(mockSS, grpc.WithStorageTenancy(tenancy.New(tenancy.Required))).ListVersions(ctx, &v1.StorageListVersionsRequest{
+				BucketName: "bucky",
+				Key:        "key",
+			})
+
+			It("Should list the versions of the tenant's file", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Versions).To(HaveLen(2))
+				Expect(resp.Versions[0].Version).To(Equal("v2"))
+				Expect(resp.Versions[0].Latest).To(BeTrue())
+				Expect(resp.Versions[1].Size).To(Equal(int64(2)))
+			})
+		})
+	})
+
+	Context("RestoreVersion", func() {
+		When("no version is provided", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			_, err := grpc.NewStorageServiceServer(mockSS).RestoreVersion(context.Background(), &v1.StorageRestoreVersionRequest{
+				BucketName: "bucky",
+				Key:        "key",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid StorageRestoreVersionRequest.Version"))
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().RestoreVersion("bucky", "key", "v1").Return("v3", nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).RestoreVersion(context.Background(), &v1.StorageRestoreVersionRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Version:    "v1",
+			})
+
+			It("Should return the new version", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Version).To(Equal("v3"))
+			})
+		})
+	})
+
+	Context("SetTags", func() {
+		When("too many tags are provided", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			tags := map[string]string{}
+			for i := 0; i <= storage.MaxTags; i++ {
+				tags[fmt.Sprintf("tag-%d", i)] = "value"
+			}
+
+			_, err := grpc.NewStorageServiceServer(mockSS).SetTags(context.Background(), &v1.StorageSetTagsRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Tags:       tags,
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid StorageSetTagsRequest.Tags"))
+			})
+		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().SetTags("bucky", "acme/key", map[string]string{"class": "invoice"}).Return(nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewStorageServiceServer(mockSS, grpc.WithStorageTenancy(tenancy.New(tenancy.Required))).SetTags(ctx, &v1.StorageSetTagsRequest{
+				BucketName: "bucky",
+				Key:        "key",
+				Tags:       map[string]string{"class": "invoice"},
+			})
+
+			It("Should tag the tenant's file", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("GetTags", func() {
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			mockSS.EXPECT().GetTags("bucky", "key").Return(map[string]string{"class": "invoice"}, nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).GetTags(context.Background(), &v1.StorageGetTagsRequest{
+				BucketName: "bucky",
+				Key:        "key",
+			})
+
+			It("Should return the tags", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp.Tags).To(Equal(map[string]string{"class": "invoice"}))
+			})
+		})
+	})
+
+	Context("Uploads", func() {
+		When("uploads are not configured", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS).BeginUpload(context.Background(), &v1.StorageBeginUploadRequest{
+				BucketName: "bucky",
+				Key:        "key",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Uploads plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		var dir string
+		var mockSS *mock_storage.MockStorageService
+		var ss v1.StorageServiceServer
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "uploads")
+			Expect(err).ShouldNot(HaveOccurred())
+			os.Setenv("LOCAL_DB_DIR", dir)
+
+			docs, err := boltdb_service.New()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mockSS = mock_storage.NewMockStorageService(gomock.NewController(GinkgoT()))
+			manager, err := uploads.New(docs, mockSS, "")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ss = grpc.NewStorageServiceServer(mockSS, grpc.WithUploads(manager), grpc.WithStorageTenancy(tenancy.New(tenancy.Optional)))
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("LOCAL_DB_DIR")
+			os.RemoveAll(dir)
+		})
+
+		It("Should write the tenant's file from the appended body", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+
+			mockSS.EXPECT().BeginUpload("bucky", "acme/key").Return("session", nil)
+			mockSS.EXPECT().UploadPart("bucky", "acme/key", "session", gomock.Any(), []byte("body"), true).Return(&storage.UploadPart{
+				Number: 1,
+				Size:   4,
+				ETag:   "etag",
+			}, nil)
+			mockSS.EXPECT().CompleteUpload("bucky", "acme/key", "session", gomock.Len(1)).Return(nil)
+
+			begin, err := ss.BeginUpload(ctx, &v1.StorageBeginUploadRequest{BucketName: "bucky", Key: "key"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			appended, err := ss.AppendUpload(ctx, &v1.StorageAppendUploadRequest{UploadId: begin.UploadId, Body: []byte("body")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(appended.Offset).To(Equal(int64(4)))
+			Expect(appended.Complete).To(BeTrue())
+
+			status, err := ss.UploadStatus(ctx, &v1.StorageUploadStatusRequest{UploadId: begin.UploadId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.BucketName).To(Equal("bucky"))
+			Expect(status.Key).To(Equal("key"))
+			Expect(status.Offset).To(Equal(int64(4)))
+
+			committed, err := ss.CommitUpload(ctx, &v1.StorageCommitUploadRequest{UploadId: begin.UploadId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(committed.Size).To(Equal(int64(4)))
+		})
+
+		It("Should not find the uploads of other tenants", func() {
+			mockSS.EXPECT().BeginUpload("bucky", "acme/key").Return("session", nil)
+
+			acme := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			begin, err := ss.BeginUpload(acme, &v1.StorageBeginUploadRequest{BucketName: "bucky", Key: "key"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			other := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "other"))
+			_, err = ss.AbortUpload(other, &v1.StorageAbortUploadRequest{UploadId: begin.UploadId})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("NotFound"))
+		})
 	})
 })
@@ -0,0 +1,177 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/timeseries"
+	"github.com/nitrictech/protoutils"
+)
+
+// TimeSeriesServiceServer - GRPC Interface for appending to and reading time series collections
+type TimeSeriesServiceServer struct {
+	pb.UnimplementedTimeSeriesServiceServer
+	store   *timeseries.Store
+	tenancy *tenancy.Tenancy
+}
+
+type TimeSeriesServerOption interface {
+	Apply(*TimeSeriesServiceServer)
+}
+
+type withTimeSeriesTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withTimeSeriesTenancy) Apply(server *TimeSeriesServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithTimeSeriesTenancy - scopes the points of each series to the tenant of each call
+func WithTimeSeriesTenancy(t *tenancy.Tenancy) TimeSeriesServerOption {
+	return &withTimeSeriesTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *TimeSeriesServiceServer) checkStoreConfigured() error {
+	if s.store == nil {
+		return NewPluginNotRegisteredError("TimeSeries")
+	}
+
+	return nil
+}
+
+func (s *TimeSeriesServiceServer) Append(ctx context.Context, req *pb.TimeSeriesAppendRequest) (*pb.TimeSeriesAppendResponse, error) {
+	if err := s.checkStoreConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Append", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Append", err)
+	}
+
+	points := make([]timeseries.Point, 0, len(req.GetPoints()))
+	for _, p := range req.GetPoints() {
+		points = append(points, timeseries.Point{
+			Time:   p.GetTime().AsTime(),
+			Values: p.GetValues().AsMap(),
+		})
+	}
+
+	if err := s.store.Append(tenant, req.GetCollection(), points); err != nil {
+		return nil, NewGrpcError("TimeSeriesService.Append", err)
+	}
+
+	return &pb.TimeSeriesAppendResponse{}, nil
+}
+
+func (s *TimeSeriesServiceServer) Range(ctx context.Context, req *pb.TimeSeriesRangeRequest) (*pb.TimeSeriesRangeResponse, error) {
+	if err := s.checkStoreConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Range", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Range", err)
+	}
+
+	points, err := s.store.Range(tenant, req.GetCollection(), req.GetStart().AsTime(), req.GetEnd().AsTime(), int(req.GetLimit()))
+	if err != nil {
+		return nil, NewGrpcError("TimeSeriesService.Range", err)
+	}
+
+	wire := make([]*pb.TimeSeriesPoint, 0, len(points))
+	for _, p := range points {
+		values, err := protoutils.NewStruct(p.Values)
+		if err != nil {
+			return nil, NewGrpcError("TimeSeriesService.Range", err)
+		}
+
+		wire = append(wire, &pb.TimeSeriesPoint{
+			Time:   timestamppb.New(p.Time),
+			Values: values,
+		})
+	}
+
+	return &pb.TimeSeriesRangeResponse{
+		Points: wire,
+	}, nil
+}
+
+func (s *TimeSeriesServiceServer) Rollup(ctx context.Context, req *pb.TimeSeriesRollupRequest) (*pb.TimeSeriesRollupResponse, error) {
+	if err := s.checkStoreConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Rollup", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TimeSeriesService.Rollup", err)
+	}
+
+	rollups, err := s.store.Rollup(tenant, req.GetCollection(), req.GetField(), req.GetStart().AsTime(), req.GetEnd().AsTime())
+	if err != nil {
+		return nil, NewGrpcError("TimeSeriesService.Rollup", err)
+	}
+
+	wire := make([]*pb.TimeSeriesRollup, 0, len(rollups))
+	for _, r := range rollups {
+		wire = append(wire, &pb.TimeSeriesRollup{
+			Start: timestamppb.New(r.Start),
+			End:   timestamppb.New(r.End),
+			Count: r.Count,
+			Sum:   r.Sum,
+			Min:   r.Min,
+			Max:   r.Max,
+			Avg:   r.Avg,
+		})
+	}
+
+	return &pb.TimeSeriesRollupResponse{
+		Rollups: wire,
+	}, nil
+}
+
+// NewTimeSeriesServer - Creates a time series server, time series are unavailable if the store is nil
+func NewTimeSeriesServer(store *timeseries.Store, opts ...TimeSeriesServerOption) pb.TimeSeriesServiceServer {
+	server := &TimeSeriesServiceServer{
+		store: store,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
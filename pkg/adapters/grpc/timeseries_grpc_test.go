@@ -0,0 +1,144 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/timeseries"
+)
+
+var _ = Describe("GRPC Time Series", func() {
+	series := map[string]*timeseries.Series{
+		"readings": {Name: "readings", Window: time.Hour},
+	}
+	values, _ := structpb.NewStruct(map[string]interface{}{"temperature": 21})
+	at := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	Context("Append", func() {
+		When("time series aren't configured", func() {
+			resp, err := grpc.NewTimeSeriesServer(nil).Append(context.Background(), &v1.TimeSeriesAppendRequest{})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("TimeSeries plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request has no points", func() {
+			g := gomock.NewController(GinkgoT())
+			store, _ := timeseries.New(mock_document.NewMockDocumentService(g), series, time.Hour)
+
+			_, err := grpc.NewTimeSeriesServer(store).Append(context.Background(), &v1.TimeSeriesAppendRequest{Collection: "readings"})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid TimeSeriesAppendRequest.Points"))
+			})
+		})
+
+		When("the collection isn't a series", func() {
+			g := gomock.NewController(GinkgoT())
+			store, _ := timeseries.New(mock_document.NewMockDocumentService(g), series, time.Hour)
+
+			_, err := grpc.NewTimeSeriesServer(store).Append(context.Background(), &v1.TimeSeriesAppendRequest{
+				Collection: "orders",
+				Points:     []*v1.TimeSeriesPoint{{Time: timestamppb.New(at), Values: values}},
+			})
+
+			It("Should report not found", func() {
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			store, _ := timeseries.New(mockDS, series, time.Hour)
+
+			bucket := &document.Key{Collection: &document.Collection{Name: "readings"}, Id: "20240301T100000Z"}
+			mockDS.EXPECT().Set(bucket, map[string]interface{}{"start": "20240301T100000Z", "end": "20240301T110000Z", "tenant": ""}).Return(nil)
+			mockDS.EXPECT().SetBatch(gomock.Any()).DoAndReturn(func(docs []document.Document) (*document.BatchResponse, error) {
+				Expect(docs).To(HaveLen(1))
+				Expect(docs[0].Key.Collection.Parent).To(Equal(bucket))
+				Expect(docs[0].Content).To(HaveKeyWithValue("temperature", float64(21)))
+				return &document.BatchResponse{}, nil
+			})
+
+			_, err := grpc.NewTimeSeriesServer(store).Append(context.Background(), &v1.TimeSeriesAppendRequest{
+				Collection: "readings",
+				Points:     []*v1.TimeSeriesPoint{{Time: timestamppb.New(at), Values: values}},
+			})
+
+			It("Should write the point to the bucket of its window", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+
+		When("the call is made for a tenant", func() {
+			g := gomock.NewController(GinkgoT())
+			mockDS := mock_document.NewMockDocumentService(g)
+			store, _ := timeseries.New(mockDS, series, time.Hour)
+
+			bucket := &document.Key{Collection: &document.Collection{Name: "readings"}, Id: "acme-20240301T100000Z"}
+			mockDS.EXPECT().Set(bucket, map[string]interface{}{"start": "20240301T100000Z", "end": "20240301T110000Z", "tenant": "acme"}).Return(nil)
+			mockDS.EXPECT().SetBatch(gomock.Any()).DoAndReturn(func(docs []document.Document) (*document.BatchResponse, error) {
+				Expect(docs[0].Key.Collection.Parent).To(Equal(bucket))
+				return &document.BatchResponse{}, nil
+			})
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := grpc.NewTimeSeriesServer(store, grpc.WithTimeSeriesTenancy(tenancy.New(tenancy.Optional))).Append(ctx, &v1.TimeSeriesAppendRequest{
+				Collection: "readings",
+				Points:     []*v1.TimeSeriesPoint{{Time: timestamppb.New(at), Values: values}},
+			})
+
+			It("Should write the point to the tenant's bucket", func() {
+				Expect(err).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Rollup", func() {
+		When("request has no field", func() {
+			g := gomock.NewController(GinkgoT())
+			store, _ := timeseries.New(mock_document.NewMockDocumentService(g), series, time.Hour)
+
+			_, err := grpc.NewTimeSeriesServer(store).Rollup(context.Background(), &v1.TimeSeriesRollupRequest{
+				Collection: "readings",
+				Start:      timestamppb.New(at),
+				End:        timestamppb.New(at.Add(time.Hour)),
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid TimeSeriesRollupRequest.Field"))
+			})
+		})
+	})
+})
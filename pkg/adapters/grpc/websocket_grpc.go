@@ -0,0 +1,224 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/connections"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// GRPC Interface for the registry of websocket connections
+type WebsocketServer struct {
+	pb.UnimplementedWebsocketServiceServer
+	registry *connections.Registry
+	tenancy  *tenancy.Tenancy
+}
+
+type WebsocketServerOption interface {
+	Apply(*WebsocketServer)
+}
+
+type withWebsocketTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withWebsocketTenancy) Apply(server *WebsocketServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithWebsocketTenancy - scopes connections to the tenant that registered them
+func WithWebsocketTenancy(t *tenancy.Tenancy) WebsocketServerOption {
+	return &withWebsocketTenancy{
+		tenancy: t,
+	}
+}
+
+func (s *WebsocketServer) checkRegistryAvailable() error {
+	if s.registry == nil {
+		return NewPluginNotRegisteredError("Connections")
+	}
+
+	return nil
+}
+
+func connectionToWire(conn *connections.Connection) *pb.WebsocketConnection {
+	return &pb.WebsocketConnection{
+		Id:         conn.ID,
+		Attributes: conn.Attributes,
+		Connected:  timestampOrNil(conn.Connected),
+	}
+}
+
+func (s *WebsocketServer) RegisterConnection(ctx context.Context, req *pb.WebsocketRegisterConnectionRequest) (*pb.WebsocketRegisterConnectionResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.RegisterConnection", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.RegisterConnection", err)
+	}
+
+	conn, err := s.registry.Store(tenant, req.GetConnectionId(), req.GetAttributes())
+	if err != nil {
+		return nil, NewGrpcError("WebsocketService.RegisterConnection", err)
+	}
+
+	return &pb.WebsocketRegisterConnectionResponse{
+		Connection: connectionToWire(conn),
+	}, nil
+}
+
+func (s *WebsocketServer) GetConnection(ctx context.Context, req *pb.WebsocketGetConnectionRequest) (*pb.WebsocketGetConnectionResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.GetConnection", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.GetConnection", err)
+	}
+
+	conn, err := s.registry.Get(tenant, req.GetConnectionId())
+	if err != nil {
+		return nil, NewGrpcError("WebsocketService.GetConnection", err)
+	}
+
+	return &pb.WebsocketGetConnectionResponse{
+		Connection: connectionToWire(conn),
+	}, nil
+}
+
+func (s *WebsocketServer) DeleteConnection(ctx context.Context, req *pb.WebsocketDeleteConnectionRequest) (*pb.WebsocketDeleteConnectionResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.DeleteConnection", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.DeleteConnection", err)
+	}
+
+	if err := s.registry.Delete(tenant, req.GetConnectionId()); err != nil {
+		return nil, NewGrpcError("WebsocketService.DeleteConnection", err)
+	}
+
+	return &pb.WebsocketDeleteConnectionResponse{}, nil
+}
+
+func (s *WebsocketServer) ListConnections(ctx context.Context, req *pb.WebsocketListConnectionsRequest) (*pb.WebsocketListConnectionsResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.ListConnections", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.ListConnections", err)
+	}
+
+	conns, err := s.registry.List(tenant, req.GetAttributes())
+	if err != nil {
+		return nil, NewGrpcError("WebsocketService.ListConnections", err)
+	}
+
+	wireConns := make([]*pb.WebsocketConnection, 0, len(conns))
+	for _, conn := range conns {
+		wireConns = append(wireConns, connectionToWire(conn))
+	}
+
+	return &pb.WebsocketListConnectionsResponse{
+		Connections: wireConns,
+	}, nil
+}
+
+func (s *WebsocketServer) Send(ctx context.Context, req *pb.WebsocketSendRequest) (*pb.WebsocketSendResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.Send", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.Send", err)
+	}
+
+	if err := s.registry.Send(tenant, req.GetConnectionId(), req.GetData()); err != nil {
+		return nil, NewGrpcError("WebsocketService.Send", err)
+	}
+
+	return &pb.WebsocketSendResponse{}, nil
+}
+
+func (s *WebsocketServer) Broadcast(ctx context.Context, req *pb.WebsocketBroadcastRequest) (*pb.WebsocketBroadcastResponse, error) {
+	if err := s.checkRegistryAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.Broadcast", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WebsocketService.Broadcast", err)
+	}
+
+	result, err := s.registry.Broadcast(tenant, req.GetAttributes(), req.GetData())
+	if err != nil {
+		return nil, NewGrpcError("WebsocketService.Broadcast", err)
+	}
+
+	return &pb.WebsocketBroadcastResponse{
+		Sent:    int32(result.Sent),
+		Removed: result.Removed,
+		Failed:  result.Failed,
+	}, nil
+}
+
+func NewWebsocketServer(registry *connections.Registry, opts ...WebsocketServerOption) pb.WebsocketServiceServer {
+	server := &WebsocketServer{
+		registry: registry,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
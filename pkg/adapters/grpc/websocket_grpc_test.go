@@ -0,0 +1,149 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/connections"
+	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	pluginCodes "github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// closedSender - fails every send as though the client has disconnected
+type closedSender struct{}
+
+func (closedSender) Send(connectionId string, message []byte) error {
+	return errors.ErrorsWithScope("closedSender.Send", nil)(pluginCodes.NotFound, "connection has closed", nil)
+}
+
+var _ = Describe("GRPC Websocket", func() {
+	When("the registry is not available", func() {
+		ws := &grpc.WebsocketServer{}
+		resp, err := ws.RegisterConnection(context.Background(), &v1.WebsocketRegisterConnectionRequest{})
+		It("Should report an error", func() {
+			Expect(err.Error()).Should(ContainSubstring("Connections plugin not registered"))
+			Expect(resp).Should(BeNil())
+		})
+	})
+
+	Context("with a registry", func() {
+		var dir string
+		var ws v1.WebsocketServiceServer
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "websocket")
+			Expect(err).ShouldNot(HaveOccurred())
+			os.Setenv("LOCAL_DB_DIR", dir)
+
+			docs, err := boltdb_service.New()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			registry, err := connections.New(docs, closedSender{}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			ws = grpc.NewWebsocketServer(registry, grpc.WithWebsocketTenancy(tenancy.New(tenancy.Optional)))
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("LOCAL_DB_DIR")
+			os.RemoveAll(dir)
+		})
+
+		It("Should reject a registration without a connection id", func() {
+			resp, err := ws.RegisterConnection(context.Background(), &v1.WebsocketRegisterConnectionRequest{})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(resp).Should(BeNil())
+		})
+
+		It("Should register and list connections by attribute", func() {
+			resp, err := ws.RegisterConnection(context.Background(), &v1.WebsocketRegisterConnectionRequest{
+				ConnectionId: "conn-1",
+				Attributes:   map[string]string{"room": "lobby"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Connection.Id).To(Equal("conn-1"))
+			Expect(resp.Connection.Connected).ShouldNot(BeNil())
+
+			_, err = ws.RegisterConnection(context.Background(), &v1.WebsocketRegisterConnectionRequest{
+				ConnectionId: "conn-2",
+				Attributes:   map[string]string{"room": "kitchen"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			list, err := ws.ListConnections(context.Background(), &v1.WebsocketListConnectionsRequest{
+				Attributes: map[string]string{"room": "lobby"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list.Connections).To(HaveLen(1))
+			Expect(list.Connections[0].Id).To(Equal("conn-1"))
+		})
+
+		It("Should report unknown connections as not found", func() {
+			resp, err := ws.GetConnection(context.Background(), &v1.WebsocketGetConnectionRequest{
+				ConnectionId: "missing",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(resp).Should(BeNil())
+		})
+
+		It("Should only find the connections of the calling tenant", func() {
+			acme := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, "acme"))
+			_, err := ws.RegisterConnection(acme, &v1.WebsocketRegisterConnectionRequest{
+				ConnectionId: "conn-1",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = ws.GetConnection(context.Background(), &v1.WebsocketGetConnectionRequest{
+				ConnectionId: "conn-1",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			list, err := ws.ListConnections(acme, &v1.WebsocketListConnectionsRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list.Connections).To(HaveLen(1))
+		})
+
+		It("Should remove closed connections when broadcasting", func() {
+			_, err := ws.RegisterConnection(context.Background(), &v1.WebsocketRegisterConnectionRequest{
+				ConnectionId: "conn-1",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := ws.Broadcast(context.Background(), &v1.WebsocketBroadcastRequest{
+				Data: []byte("hello"),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Sent).To(Equal(int32(0)))
+			Expect(resp.Removed).To(Equal([]string{"conn-1"}))
+
+			_, err = ws.GetConnection(context.Background(), &v1.WebsocketGetConnectionRequest{
+				ConnectionId: "conn-1",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/workflow"
+	"github.com/nitrictech/protoutils"
+)
+
+// WorkflowServiceServer - GRPC Interface for running durable multi-step workflows
+type WorkflowServiceServer struct {
+	pb.UnimplementedWorkflowServiceServer
+	engine  *workflow.Engine
+	tenancy *tenancy.Tenancy
+}
+
+type WorkflowServerOption interface {
+	Apply(*WorkflowServiceServer)
+}
+
+type withWorkflowTenancy struct {
+	tenancy *tenancy.Tenancy
+}
+
+func (w *withWorkflowTenancy) Apply(server *WorkflowServiceServer) {
+	server.tenancy = w.tenancy
+}
+
+// WithWorkflowTenancy - scopes executions to the tenant of each call
+func WithWorkflowTenancy(t *tenancy.Tenancy) WorkflowServerOption {
+	return &withWorkflowTenancy{
+		tenancy: t,
+	}
+}
+
+var workflowStatuses = map[workflow.Status]pb.WorkflowStatus{
+	workflow.Running:      pb.WorkflowStatus_Running,
+	workflow.Waiting:      pb.WorkflowStatus_Waiting,
+	workflow.Completed:    pb.WorkflowStatus_Completed,
+	workflow.Failed:       pb.WorkflowStatus_Failed,
+	workflow.Cancelled:    pb.WorkflowStatus_Cancelled,
+	workflow.Compensating: pb.WorkflowStatus_Compensating,
+	workflow.Compensated:  pb.WorkflowStatus_Compensated,
+}
+
+func (s *WorkflowServiceServer) checkEngineConfigured() error {
+	if s.engine == nil {
+		return NewPluginNotRegisteredError("Workflow")
+	}
+
+	return nil
+}
+
+func (s *WorkflowServiceServer) Start(ctx context.Context, req *pb.WorkflowStartRequest) (*pb.WorkflowStartResponse, error) {
+	if err := s.checkEngineConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Start", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Start", err)
+	}
+
+	exec, err := s.engine.Start(tenant, req.GetWorkflow(), req.GetId(), req.GetInput().AsMap())
+	if err != nil {
+		return nil, NewGrpcError("WorkflowService.Start", err)
+	}
+
+	return &pb.WorkflowStartResponse{
+		Id: exec.ID,
+	}, nil
+}
+
+func (s *WorkflowServiceServer) Get(ctx context.Context, req *pb.WorkflowGetRequest) (*pb.WorkflowGetResponse, error) {
+	if err := s.checkEngineConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Get", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Get", err)
+	}
+
+	exec, err := s.engine.Get(tenant, req.GetId())
+	if err != nil {
+		return nil, NewGrpcError("WorkflowService.Get", err)
+	}
+
+	outputs, err := protoutils.NewStruct(exec.Outputs)
+	if err != nil {
+		return nil, NewGrpcError("WorkflowService.Get", err)
+	}
+
+	return &pb.WorkflowGetResponse{
+		Execution: &pb.WorkflowExecution{
+			Id:       exec.ID,
+			Workflow: exec.Workflow,
+			Status:   workflowStatuses[exec.Status],
+			Step:     s.engine.StepName(exec),
+			Outputs:  outputs,
+			Error:    exec.Error,
+		},
+	}, nil
+}
+
+func (s *WorkflowServiceServer) Signal(ctx context.Context, req *pb.WorkflowSignalRequest) (*pb.WorkflowSignalResponse, error) {
+	if err := s.checkEngineConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Signal", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Signal", err)
+	}
+
+	if err := s.engine.Signal(tenant, req.GetId(), req.GetSignal(), req.GetPayload().AsMap()); err != nil {
+		return nil, NewGrpcError("WorkflowService.Signal", err)
+	}
+
+	return &pb.WorkflowSignalResponse{}, nil
+}
+
+func (s *WorkflowServiceServer) Cancel(ctx context.Context, req *pb.WorkflowCancelRequest) (*pb.WorkflowCancelResponse, error) {
+	if err := s.checkEngineConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Cancel", err)
+	}
+
+	tenant, err := s.tenancy.Tenant(ctx)
+	if err != nil {
+		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "WorkflowService.Cancel", err)
+	}
+
+	if err := s.engine.Cancel(tenant, req.GetId()); err != nil {
+		return nil, NewGrpcError("WorkflowService.Cancel", err)
+	}
+
+	return &pb.WorkflowCancelResponse{}, nil
+}
+
+// NewWorkflowServer - Creates a workflow server, workflows are unavailable if the engine is nil
+func NewWorkflowServer(engine *workflow.Engine, opts ...WorkflowServerOption) pb.WorkflowServiceServer {
+	server := &WorkflowServiceServer{
+		engine: engine,
+	}
+
+	for _, o := range opts {
+		o.Apply(server)
+	}
+
+	return server
+}
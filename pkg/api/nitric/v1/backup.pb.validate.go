@@ -0,0 +1,857 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: backup/v1/backup.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BackupSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BackupSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BackupSnapshotMultiError,
+// or nil if none found.
+func (m *BackupSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BackupSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BackupSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BackupSnapshotValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Documents
+
+	// no validation rules for Files
+
+	if len(errors) > 0 {
+		return BackupSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupSnapshotMultiError is an error wrapping multiple validation errors
+// returned by BackupSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type BackupSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupSnapshotMultiError) AllErrors() []error { return m }
+
+// BackupSnapshotValidationError is the validation error returned by
+// BackupSnapshot.Validate if the designated constraints aren't met.
+type BackupSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupSnapshotValidationError) ErrorName() string { return "BackupSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackupSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupSnapshotValidationError{}
+
+// Validate checks the field values on BackupSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BackupSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupSnapshotRequestMultiError, or nil if none found.
+func (m *BackupSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BackupSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by BackupSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BackupSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// BackupSnapshotRequestValidationError is the validation error returned by
+// BackupSnapshotRequest.Validate if the designated constraints aren't met.
+type BackupSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupSnapshotRequestValidationError) ErrorName() string {
+	return "BackupSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupSnapshotRequestValidationError{}
+
+// Validate checks the field values on BackupSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BackupSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupSnapshotResponseMultiError, or nil if none found.
+func (m *BackupSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BackupSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BackupSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BackupSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BackupSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by BackupSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BackupSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// BackupSnapshotResponseValidationError is the validation error returned by
+// BackupSnapshotResponse.Validate if the designated constraints aren't met.
+type BackupSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupSnapshotResponseValidationError) ErrorName() string {
+	return "BackupSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupSnapshotResponseValidationError{}
+
+// Validate checks the field values on BackupListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BackupListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupListRequestMultiError, or nil if none found.
+func (m *BackupListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BackupListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupListRequestMultiError is an error wrapping multiple validation errors
+// returned by BackupListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BackupListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupListRequestMultiError) AllErrors() []error { return m }
+
+// BackupListRequestValidationError is the validation error returned by
+// BackupListRequest.Validate if the designated constraints aren't met.
+type BackupListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupListRequestValidationError) ErrorName() string {
+	return "BackupListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupListRequestValidationError{}
+
+// Validate checks the field values on BackupListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BackupListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupListResponseMultiError, or nil if none found.
+func (m *BackupListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BackupListResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BackupListResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BackupListResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BackupListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupListResponseMultiError is an error wrapping multiple validation errors
+// returned by BackupListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BackupListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupListResponseMultiError) AllErrors() []error { return m }
+
+// BackupListResponseValidationError is the validation error returned by
+// BackupListResponse.Validate if the designated constraints aren't met.
+type BackupListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupListResponseValidationError) ErrorName() string {
+	return "BackupListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupListResponseValidationError{}
+
+// Validate checks the field values on BackupRestoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BackupRestoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupRestoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupRestoreRequestMultiError, or nil if none found.
+func (m *BackupRestoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupRestoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := BackupRestoreRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BackupRestoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupRestoreRequestMultiError is an error wrapping multiple validation
+// errors returned by BackupRestoreRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BackupRestoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupRestoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupRestoreRequestMultiError) AllErrors() []error { return m }
+
+// BackupRestoreRequestValidationError is the validation error returned by
+// BackupRestoreRequest.Validate if the designated constraints aren't met.
+type BackupRestoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupRestoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupRestoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupRestoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupRestoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupRestoreRequestValidationError) ErrorName() string {
+	return "BackupRestoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupRestoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupRestoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupRestoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupRestoreRequestValidationError{}
+
+// Validate checks the field values on BackupRestoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BackupRestoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupRestoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackupRestoreResponseMultiError, or nil if none found.
+func (m *BackupRestoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupRestoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BackupRestoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupRestoreResponseMultiError is an error wrapping multiple validation
+// errors returned by BackupRestoreResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BackupRestoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupRestoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupRestoreResponseMultiError) AllErrors() []error { return m }
+
+// BackupRestoreResponseValidationError is the validation error returned by
+// BackupRestoreResponse.Validate if the designated constraints aren't met.
+type BackupRestoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupRestoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupRestoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupRestoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupRestoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupRestoreResponseValidationError) ErrorName() string {
+	return "BackupRestoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackupRestoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupRestoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupRestoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupRestoreResponseValidationError{}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: backup/v1/backup.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BackupServiceClient is the client API for BackupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BackupServiceClient interface {
+	// Take a snapshot now, rather than waiting for the next scheduled snapshot
+	Snapshot(ctx context.Context, in *BackupSnapshotRequest, opts ...grpc.CallOption) (*BackupSnapshotResponse, error)
+	// List the completed snapshots, newest first
+	List(ctx context.Context, in *BackupListRequest, opts ...grpc.CallOption) (*BackupListResponse, error)
+	// Restore the documents and items in a snapshot, overwriting their current content
+	Restore(ctx context.Context, in *BackupRestoreRequest, opts ...grpc.CallOption) (*BackupRestoreResponse, error)
+}
+
+type backupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackupServiceClient(cc grpc.ClientConnInterface) BackupServiceClient {
+	return &backupServiceClient{cc}
+}
+
+func (c *backupServiceClient) Snapshot(ctx context.Context, in *BackupSnapshotRequest, opts ...grpc.CallOption) (*BackupSnapshotResponse, error) {
+	out := new(BackupSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/nitric.backup.v1.BackupService/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) List(ctx context.Context, in *BackupListRequest, opts ...grpc.CallOption) (*BackupListResponse, error) {
+	out := new(BackupListResponse)
+	err := c.cc.Invoke(ctx, "/nitric.backup.v1.BackupService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) Restore(ctx context.Context, in *BackupRestoreRequest, opts ...grpc.CallOption) (*BackupRestoreResponse, error) {
+	out := new(BackupRestoreResponse)
+	err := c.cc.Invoke(ctx, "/nitric.backup.v1.BackupService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackupServiceServer is the server API for BackupService service.
+// All implementations must embed UnimplementedBackupServiceServer
+// for forward compatibility
+type BackupServiceServer interface {
+	// Take a snapshot now, rather than waiting for the next scheduled snapshot
+	Snapshot(context.Context, *BackupSnapshotRequest) (*BackupSnapshotResponse, error)
+	// List the completed snapshots, newest first
+	List(context.Context, *BackupListRequest) (*BackupListResponse, error)
+	// Restore the documents and items in a snapshot, overwriting their current content
+	Restore(context.Context, *BackupRestoreRequest) (*BackupRestoreResponse, error)
+	mustEmbedUnimplementedBackupServiceServer()
+}
+
+// UnimplementedBackupServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBackupServiceServer struct {
+}
+
+func (UnimplementedBackupServiceServer) Snapshot(context.Context, *BackupSnapshotRequest) (*BackupSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (UnimplementedBackupServiceServer) List(context.Context, *BackupListRequest) (*BackupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBackupServiceServer) Restore(context.Context, *BackupRestoreRequest) (*BackupRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedBackupServiceServer) mustEmbedUnimplementedBackupServiceServer() {}
+
+// UnsafeBackupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackupServiceServer will
+// result in compilation errors.
+type UnsafeBackupServiceServer interface {
+	mustEmbedUnimplementedBackupServiceServer()
+}
+
+func RegisterBackupServiceServer(s grpc.ServiceRegistrar, srv BackupServiceServer) {
+	s.RegisterService(&BackupService_ServiceDesc, srv)
+}
+
+func _BackupService_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.backup.v1.BackupService/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).Snapshot(ctx, req.(*BackupSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.backup.v1.BackupService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).List(ctx, req.(*BackupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.backup.v1.BackupService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).Restore(ctx, req.(*BackupRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BackupService_ServiceDesc is the grpc.ServiceDesc for BackupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.backup.v1.BackupService",
+	HandlerType: (*BackupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Snapshot",
+			Handler:    _BackupService_Snapshot_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _BackupService_List_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _BackupService_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backup/v1/backup.proto",
+}
@@ -0,0 +1,1109 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: batch/v1/batch.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BatchJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchJobMultiError, or nil
+// if none found.
+func (m *BatchJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 128 {
+		err := BatchJobValidationError{
+			field:  "Name",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Definition
+
+	// no validation rules for Image
+
+	// no validation rules for Env
+
+	if m.GetCpu() < 0 {
+		err := BatchJobValidationError{
+			field:  "Cpu",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMemoryMib() < 0 {
+		err := BatchJobValidationError{
+			field:  "MemoryMib",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BatchJobValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := BatchJobValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchJobMultiError is an error wrapping multiple validation errors returned
+// by BatchJob.ValidateAll() if the designated constraints aren't met.
+type BatchJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchJobMultiError) AllErrors() []error { return m }
+
+// BatchJobValidationError is the validation error returned by
+// BatchJob.Validate if the designated constraints aren't met.
+type BatchJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchJobValidationError) ErrorName() string { return "BatchJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchJobValidationError{}
+
+// Validate checks the field values on BatchSubmitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchSubmitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSubmitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchSubmitRequestMultiError, or nil if none found.
+func (m *BatchSubmitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSubmitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetJob() == nil {
+		err := BatchSubmitRequestValidationError{
+			field:  "Job",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchSubmitRequestValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchSubmitRequestValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchSubmitRequestValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchSubmitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSubmitRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchSubmitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchSubmitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSubmitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSubmitRequestMultiError) AllErrors() []error { return m }
+
+// BatchSubmitRequestValidationError is the validation error returned by
+// BatchSubmitRequest.Validate if the designated constraints aren't met.
+type BatchSubmitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSubmitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSubmitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSubmitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSubmitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSubmitRequestValidationError) ErrorName() string {
+	return "BatchSubmitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSubmitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSubmitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSubmitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSubmitRequestValidationError{}
+
+// Validate checks the field values on BatchSubmitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchSubmitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSubmitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchSubmitResponseMultiError, or nil if none found.
+func (m *BatchSubmitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSubmitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return BatchSubmitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSubmitResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchSubmitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchSubmitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSubmitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSubmitResponseMultiError) AllErrors() []error { return m }
+
+// BatchSubmitResponseValidationError is the validation error returned by
+// BatchSubmitResponse.Validate if the designated constraints aren't met.
+type BatchSubmitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSubmitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSubmitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSubmitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSubmitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSubmitResponseValidationError) ErrorName() string {
+	return "BatchSubmitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSubmitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSubmitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSubmitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSubmitResponseValidationError{}
+
+// Validate checks the field values on BatchStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchStatusRequestMultiError, or nil if none found.
+func (m *BatchStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := BatchStatusRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchStatusRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchStatusRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchStatusRequestMultiError) AllErrors() []error { return m }
+
+// BatchStatusRequestValidationError is the validation error returned by
+// BatchStatusRequest.Validate if the designated constraints aren't met.
+type BatchStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchStatusRequestValidationError) ErrorName() string {
+	return "BatchStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchStatusRequestValidationError{}
+
+// Validate checks the field values on BatchStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchStatusResponseMultiError, or nil if none found.
+func (m *BatchStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchStatusResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchStatusResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchStatusResponseValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStoppedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchStatusResponseValidationError{
+					field:  "StoppedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchStatusResponseValidationError{
+					field:  "StoppedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStoppedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchStatusResponseValidationError{
+				field:  "StoppedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchStatusResponseMultiError) AllErrors() []error { return m }
+
+// BatchStatusResponseValidationError is the validation error returned by
+// BatchStatusResponse.Validate if the designated constraints aren't met.
+type BatchStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchStatusResponseValidationError) ErrorName() string {
+	return "BatchStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchStatusResponseValidationError{}
+
+// Validate checks the field values on BatchLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchLogsRequestMultiError, or nil if none found.
+func (m *BatchLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := BatchLogsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return BatchLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchLogsRequestMultiError) AllErrors() []error { return m }
+
+// BatchLogsRequestValidationError is the validation error returned by
+// BatchLogsRequest.Validate if the designated constraints aren't met.
+type BatchLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchLogsRequestValidationError) ErrorName() string { return "BatchLogsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchLogsRequestValidationError{}
+
+// Validate checks the field values on BatchLogEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchLogEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchLogEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchLogEntryMultiError, or
+// nil if none found.
+func (m *BatchLogEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchLogEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchLogEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchLogEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchLogEntryValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchLogEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchLogEntryMultiError is an error wrapping multiple validation errors
+// returned by BatchLogEntry.ValidateAll() if the designated constraints
+// aren't met.
+type BatchLogEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchLogEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchLogEntryMultiError) AllErrors() []error { return m }
+
+// BatchLogEntryValidationError is the validation error returned by
+// BatchLogEntry.Validate if the designated constraints aren't met.
+type BatchLogEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchLogEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchLogEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchLogEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchLogEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchLogEntryValidationError) ErrorName() string { return "BatchLogEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchLogEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchLogEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchLogEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchLogEntryValidationError{}
+
+// Validate checks the field values on BatchLogsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchLogsResponseMultiError, or nil if none found.
+func (m *BatchLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchLogsResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchLogsResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchLogsResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return BatchLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchLogsResponseMultiError is an error wrapping multiple validation errors
+// returned by BatchLogsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BatchLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchLogsResponseMultiError) AllErrors() []error { return m }
+
+// BatchLogsResponseValidationError is the validation error returned by
+// BatchLogsResponse.Validate if the designated constraints aren't met.
+type BatchLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchLogsResponseValidationError) ErrorName() string {
+	return "BatchLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchLogsResponseValidationError{}
@@ -0,0 +1,520 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: cdn/v1/cdn.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to sign a URL for an item
+type CdnSignUrlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nitric name of the bucket containing the item
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Key of the item
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Seconds until the URL expires
+	Expiry uint32 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *CdnSignUrlRequest) Reset() {
+	*x = CdnSignUrlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdn_v1_cdn_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CdnSignUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CdnSignUrlRequest) ProtoMessage() {}
+
+func (x *CdnSignUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdn_v1_cdn_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CdnSignUrlRequest.ProtoReflect.Descriptor instead.
+func (*CdnSignUrlRequest) Descriptor() ([]byte, []int) {
+	return file_cdn_v1_cdn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CdnSignUrlRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *CdnSignUrlRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CdnSignUrlRequest) GetExpiry() uint32 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+// Result of signing a URL
+type CdnSignUrlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signed URL
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *CdnSignUrlResponse) Reset() {
+	*x = CdnSignUrlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdn_v1_cdn_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CdnSignUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CdnSignUrlResponse) ProtoMessage() {}
+
+func (x *CdnSignUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdn_v1_cdn_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CdnSignUrlResponse.ProtoReflect.Descriptor instead.
+func (*CdnSignUrlResponse) Descriptor() ([]byte, []int) {
+	return file_cdn_v1_cdn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CdnSignUrlResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Request to sign cookies for the items of a bucket
+type CdnSignCookiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nitric name of the bucket containing the items
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// The key prefix of the items the cookies grant access to, every item if unset
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Seconds until the cookies expire
+	Expiry uint32 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *CdnSignCookiesRequest) Reset() {
+	*x = CdnSignCookiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdn_v1_cdn_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CdnSignCookiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CdnSignCookiesRequest) ProtoMessage() {}
+
+func (x *CdnSignCookiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cdn_v1_cdn_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CdnSignCookiesRequest.ProtoReflect.Descriptor instead.
+func (*CdnSignCookiesRequest) Descriptor() ([]byte, []int) {
+	return file_cdn_v1_cdn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CdnSignCookiesRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *CdnSignCookiesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CdnSignCookiesRequest) GetExpiry() uint32 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+// A signed cookie, to be set on the client with these attributes
+type CdnCookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Domain  string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Path    string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Expires *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *CdnCookie) Reset() {
+	*x = CdnCookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdn_v1_cdn_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CdnCookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CdnCookie) ProtoMessage() {}
+
+func (x *CdnCookie) ProtoReflect() protoreflect.Message {
+	mi := &file_cdn_v1_cdn_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CdnCookie.ProtoReflect.Descriptor instead.
+func (*CdnCookie) Descriptor() ([]byte, []int) {
+	return file_cdn_v1_cdn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CdnCookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CdnCookie) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CdnCookie) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CdnCookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CdnCookie) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+// Result of signing cookies
+type CdnSignCookiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cookies []*CdnCookie `protobuf:"bytes,1,rep,name=cookies,proto3" json:"cookies,omitempty"`
+}
+
+func (x *CdnSignCookiesResponse) Reset() {
+	*x = CdnSignCookiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cdn_v1_cdn_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CdnSignCookiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CdnSignCookiesResponse) ProtoMessage() {}
+
+func (x *CdnSignCookiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cdn_v1_cdn_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CdnSignCookiesResponse.ProtoReflect.Descriptor instead.
+func (*CdnSignCookiesResponse) Descriptor() ([]byte, []int) {
+	return file_cdn_v1_cdn_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CdnSignCookiesResponse) GetCookies() []*CdnCookie {
+	if x != nil {
+		return x.Cookies
+	}
+	return nil
+}
+
+var File_cdn_v1_cdn_proto protoreflect.FileDescriptor
+
+var file_cdn_v1_cdn_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x63, 0x64, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x64, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x63, 0x64, 0x6e, 0x2e, 0x76,
+	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x11,
+	0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x28, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5c, 0x77, 0x2b, 0x28, 0x5b, 0x2e, 0x5c, 0x2d, 0x5d, 0x5c, 0x77, 0x2b, 0x29,
+	0x2a, 0x24, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x26, 0x0a, 0x12, 0x43, 0x64,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x28, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5c, 0x77,
+	0x2b, 0x28, 0x5b, 0x2e, 0x5c, 0x2d, 0x5d, 0x5c, 0x77, 0x2b, 0x29, 0x2a, 0x24, 0x52, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x1f, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x43, 0x64, 0x6e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x16,
+	0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x2e, 0x63, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x64, 0x6e, 0x43, 0x6f, 0x6f, 0x6b, 0x69,
+	0x65, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x32, 0xb8, 0x01, 0x0a, 0x0a, 0x43,
+	0x64, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x63, 0x64,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x72, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x63, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x72,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x53, 0x69, 0x67,
+	0x6e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x63, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e,
+	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x63, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x64, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5a, 0x0a, 0x16, 0x69, 0x6f, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0x42,
+	0x04, 0x43, 0x64, 0x6e, 0x73, 0x50, 0x01, 0x5a, 0x0c, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x31, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x64, 0x6e, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x5c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x43, 0x64, 0x6e, 0x5c, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cdn_v1_cdn_proto_rawDescOnce sync.Once
+	file_cdn_v1_cdn_proto_rawDescData = file_cdn_v1_cdn_proto_rawDesc
+)
+
+func file_cdn_v1_cdn_proto_rawDescGZIP() []byte {
+	file_cdn_v1_cdn_proto_rawDescOnce.Do(func() {
+		file_cdn_v1_cdn_proto_rawDescData = protoimpl.X.CompressGZIP(file_cdn_v1_cdn_proto_rawDescData)
+	})
+	return file_cdn_v1_cdn_proto_rawDescData
+}
+
+var file_cdn_v1_cdn_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cdn_v1_cdn_proto_goTypes = []interface{}{
+	(*CdnSignUrlRequest)(nil),      // 0: nitric.cdn.v1.CdnSignUrlRequest
+	(*CdnSignUrlResponse)(nil),     // 1: nitric.cdn.v1.CdnSignUrlResponse
+	(*CdnSignCookiesRequest)(nil),  // 2: nitric.cdn.v1.CdnSignCookiesRequest
+	(*CdnCookie)(nil),              // 3: nitric.cdn.v1.CdnCookie
+	(*CdnSignCookiesResponse)(nil), // 4: nitric.cdn.v1.CdnSignCookiesResponse
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+}
+var file_cdn_v1_cdn_proto_depIdxs = []int32{
+	5, // 0: nitric.cdn.v1.CdnCookie.expires:type_name -> google.protobuf.Timestamp
+	3, // 1: nitric.cdn.v1.CdnSignCookiesResponse.cookies:type_name -> nitric.cdn.v1.CdnCookie
+	0, // 2: nitric.cdn.v1.CdnService.SignUrl:input_type -> nitric.cdn.v1.CdnSignUrlRequest
+	2, // 3: nitric.cdn.v1.CdnService.SignCookies:input_type -> nitric.cdn.v1.CdnSignCookiesRequest
+	1, // 4: nitric.cdn.v1.CdnService.SignUrl:output_type -> nitric.cdn.v1.CdnSignUrlResponse
+	4, // 5: nitric.cdn.v1.CdnService.SignCookies:output_type -> nitric.cdn.v1.CdnSignCookiesResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cdn_v1_cdn_proto_init() }
+func file_cdn_v1_cdn_proto_init() {
+	if File_cdn_v1_cdn_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cdn_v1_cdn_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdnSignUrlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdn_v1_cdn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdnSignUrlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdn_v1_cdn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdnSignCookiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdn_v1_cdn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdnCookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cdn_v1_cdn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdnSignCookiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cdn_v1_cdn_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cdn_v1_cdn_proto_goTypes,
+		DependencyIndexes: file_cdn_v1_cdn_proto_depIdxs,
+		MessageInfos:      file_cdn_v1_cdn_proto_msgTypes,
+	}.Build()
+	File_cdn_v1_cdn_proto = out.File
+	file_cdn_v1_cdn_proto_rawDesc = nil
+	file_cdn_v1_cdn_proto_goTypes = nil
+	file_cdn_v1_cdn_proto_depIdxs = nil
+}
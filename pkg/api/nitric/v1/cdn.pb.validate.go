@@ -0,0 +1,699 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: cdn/v1/cdn.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CdnSignUrlRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CdnSignUrlRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CdnSignUrlRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CdnSignUrlRequestMultiError, or nil if none found.
+func (m *CdnSignUrlRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CdnSignUrlRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := CdnSignUrlRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CdnSignUrlRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := CdnSignUrlRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := CdnSignUrlRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiry() <= 0 {
+		err := CdnSignUrlRequestValidationError{
+			field:  "Expiry",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CdnSignUrlRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CdnSignUrlRequestMultiError is an error wrapping multiple validation errors
+// returned by CdnSignUrlRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CdnSignUrlRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CdnSignUrlRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CdnSignUrlRequestMultiError) AllErrors() []error { return m }
+
+// CdnSignUrlRequestValidationError is the validation error returned by
+// CdnSignUrlRequest.Validate if the designated constraints aren't met.
+type CdnSignUrlRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CdnSignUrlRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CdnSignUrlRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CdnSignUrlRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CdnSignUrlRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CdnSignUrlRequestValidationError) ErrorName() string {
+	return "CdnSignUrlRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CdnSignUrlRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCdnSignUrlRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CdnSignUrlRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CdnSignUrlRequestValidationError{}
+
+var _CdnSignUrlRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on CdnSignUrlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CdnSignUrlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CdnSignUrlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CdnSignUrlResponseMultiError, or nil if none found.
+func (m *CdnSignUrlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CdnSignUrlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return CdnSignUrlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CdnSignUrlResponseMultiError is an error wrapping multiple validation errors
+// returned by CdnSignUrlResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CdnSignUrlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CdnSignUrlResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CdnSignUrlResponseMultiError) AllErrors() []error { return m }
+
+// CdnSignUrlResponseValidationError is the validation error returned by
+// CdnSignUrlResponse.Validate if the designated constraints aren't met.
+type CdnSignUrlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CdnSignUrlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CdnSignUrlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CdnSignUrlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CdnSignUrlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CdnSignUrlResponseValidationError) ErrorName() string {
+	return "CdnSignUrlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CdnSignUrlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCdnSignUrlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CdnSignUrlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CdnSignUrlResponseValidationError{}
+
+// Validate checks the field values on CdnSignCookiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CdnSignCookiesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CdnSignCookiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CdnSignCookiesRequestMultiError, or nil if none found.
+func (m *CdnSignCookiesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CdnSignCookiesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := CdnSignCookiesRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CdnSignCookiesRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := CdnSignCookiesRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prefix
+
+	if m.GetExpiry() <= 0 {
+		err := CdnSignCookiesRequestValidationError{
+			field:  "Expiry",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CdnSignCookiesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CdnSignCookiesRequestMultiError is an error wrapping multiple validation
+// errors returned by CdnSignCookiesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CdnSignCookiesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CdnSignCookiesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CdnSignCookiesRequestMultiError) AllErrors() []error { return m }
+
+// CdnSignCookiesRequestValidationError is the validation error returned by
+// CdnSignCookiesRequest.Validate if the designated constraints aren't met.
+type CdnSignCookiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CdnSignCookiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CdnSignCookiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CdnSignCookiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CdnSignCookiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CdnSignCookiesRequestValidationError) ErrorName() string {
+	return "CdnSignCookiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CdnSignCookiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCdnSignCookiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CdnSignCookiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CdnSignCookiesRequestValidationError{}
+
+var _CdnSignCookiesRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on CdnCookie with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CdnCookie) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CdnCookie with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CdnCookieMultiError, or nil
+// if none found.
+func (m *CdnCookie) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CdnCookie) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Value
+
+	// no validation rules for Domain
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetExpires()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CdnCookieValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CdnCookieValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpires()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CdnCookieValidationError{
+				field:  "Expires",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CdnCookieMultiError(errors)
+	}
+
+	return nil
+}
+
+// CdnCookieMultiError is an error wrapping multiple validation errors returned
+// by CdnCookie.ValidateAll() if the designated constraints aren't met.
+type CdnCookieMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CdnCookieMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CdnCookieMultiError) AllErrors() []error { return m }
+
+// CdnCookieValidationError is the validation error returned by
+// CdnCookie.Validate if the designated constraints aren't met.
+type CdnCookieValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CdnCookieValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CdnCookieValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CdnCookieValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CdnCookieValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CdnCookieValidationError) ErrorName() string { return "CdnCookieValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CdnCookieValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCdnCookie.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CdnCookieValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CdnCookieValidationError{}
+
+// Validate checks the field values on CdnSignCookiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CdnSignCookiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CdnSignCookiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CdnSignCookiesResponseMultiError, or nil if none found.
+func (m *CdnSignCookiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CdnSignCookiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCookies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CdnSignCookiesResponseValidationError{
+						field:  fmt.Sprintf("Cookies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CdnSignCookiesResponseValidationError{
+						field:  fmt.Sprintf("Cookies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CdnSignCookiesResponseValidationError{
+					field:  fmt.Sprintf("Cookies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CdnSignCookiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CdnSignCookiesResponseMultiError is an error wrapping multiple validation
+// errors returned by CdnSignCookiesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CdnSignCookiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CdnSignCookiesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CdnSignCookiesResponseMultiError) AllErrors() []error { return m }
+
+// CdnSignCookiesResponseValidationError is the validation error returned by
+// CdnSignCookiesResponse.Validate if the designated constraints aren't met.
+type CdnSignCookiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CdnSignCookiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CdnSignCookiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CdnSignCookiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CdnSignCookiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CdnSignCookiesResponseValidationError) ErrorName() string {
+	return "CdnSignCookiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CdnSignCookiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCdnSignCookiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CdnSignCookiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CdnSignCookiesResponseValidationError{}
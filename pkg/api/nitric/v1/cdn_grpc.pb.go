@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: cdn/v1/cdn.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CdnServiceClient is the client API for CdnService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CdnServiceClient interface {
+	// Generate a signed URL serving an item through its bucket's CDN
+	SignUrl(ctx context.Context, in *CdnSignUrlRequest, opts ...grpc.CallOption) (*CdnSignUrlResponse, error)
+	// Generate signed cookies granting access to the items of a bucket through its CDN
+	SignCookies(ctx context.Context, in *CdnSignCookiesRequest, opts ...grpc.CallOption) (*CdnSignCookiesResponse, error)
+}
+
+type cdnServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCdnServiceClient(cc grpc.ClientConnInterface) CdnServiceClient {
+	return &cdnServiceClient{cc}
+}
+
+func (c *cdnServiceClient) SignUrl(ctx context.Context, in *CdnSignUrlRequest, opts ...grpc.CallOption) (*CdnSignUrlResponse, error) {
+	out := new(CdnSignUrlResponse)
+	err := c.cc.Invoke(ctx, "/nitric.cdn.v1.CdnService/SignUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cdnServiceClient) SignCookies(ctx context.Context, in *CdnSignCookiesRequest, opts ...grpc.CallOption) (*CdnSignCookiesResponse, error) {
+	out := new(CdnSignCookiesResponse)
+	err := c.cc.Invoke(ctx, "/nitric.cdn.v1.CdnService/SignCookies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CdnServiceServer is the server API for CdnService service.
+// All implementations must embed UnimplementedCdnServiceServer
+// for forward compatibility
+type CdnServiceServer interface {
+	// Generate a signed URL serving an item through its bucket's CDN
+	SignUrl(context.Context, *CdnSignUrlRequest) (*CdnSignUrlResponse, error)
+	// Generate signed cookies granting access to the items of a bucket through its CDN
+	SignCookies(context.Context, *CdnSignCookiesRequest) (*CdnSignCookiesResponse, error)
+	mustEmbedUnimplementedCdnServiceServer()
+}
+
+// UnimplementedCdnServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCdnServiceServer struct {
+}
+
+func (UnimplementedCdnServiceServer) SignUrl(context.Context, *CdnSignUrlRequest) (*CdnSignUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUrl not implemented")
+}
+func (UnimplementedCdnServiceServer) SignCookies(context.Context, *CdnSignCookiesRequest) (*CdnSignCookiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCookies not implemented")
+}
+func (UnimplementedCdnServiceServer) mustEmbedUnimplementedCdnServiceServer() {}
+
+// UnsafeCdnServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CdnServiceServer will
+// result in compilation errors.
+type UnsafeCdnServiceServer interface {
+	mustEmbedUnimplementedCdnServiceServer()
+}
+
+func RegisterCdnServiceServer(s grpc.ServiceRegistrar, srv CdnServiceServer) {
+	s.RegisterService(&CdnService_ServiceDesc, srv)
+}
+
+func _CdnService_SignUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CdnSignUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CdnServiceServer).SignUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.cdn.v1.CdnService/SignUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CdnServiceServer).SignUrl(ctx, req.(*CdnSignUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CdnService_SignCookies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CdnSignCookiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CdnServiceServer).SignCookies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.cdn.v1.CdnService/SignCookies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CdnServiceServer).SignCookies(ctx, req.(*CdnSignCookiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CdnService_ServiceDesc is the grpc.ServiceDesc for CdnService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CdnService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.cdn.v1.CdnService",
+	HandlerType: (*CdnServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUrl",
+			Handler:    _CdnService_SignUrl_Handler,
+		},
+		{
+			MethodName: "SignCookies",
+			Handler:    _CdnService_SignCookies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cdn/v1/cdn.proto",
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: config/v1/config.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ConfigGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfigGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigGetRequestMultiError, or nil if none found.
+func (m *ConfigGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := ConfigGetRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ConfigValueType_name[int32(m.GetType())]; !ok {
+		err := ConfigGetRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfigGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigGetRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfigGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigGetRequestMultiError) AllErrors() []error { return m }
+
+// ConfigGetRequestValidationError is the validation error returned by
+// ConfigGetRequest.Validate if the designated constraints aren't met.
+type ConfigGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigGetRequestValidationError) ErrorName() string { return "ConfigGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigGetRequestValidationError{}
+
+// Validate checks the field values on ConfigGetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfigGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigGetResponseMultiError, or nil if none found.
+func (m *ConfigGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigGetResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigGetResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigGetResponseValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return ConfigGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigGetResponseMultiError is an error wrapping multiple validation errors
+// returned by ConfigGetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigGetResponseMultiError) AllErrors() []error { return m }
+
+// ConfigGetResponseValidationError is the validation error returned by
+// ConfigGetResponse.Validate if the designated constraints aren't met.
+type ConfigGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigGetResponseValidationError) ErrorName() string {
+	return "ConfigGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfigGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigGetResponseValidationError{}
@@ -551,6 +551,130 @@ func (m *ExpressionValue) validate(all bool) error {
 	case *ExpressionValue_BoolValue:
 		// no validation rules for BoolValue
 
+	case *ExpressionValue_ListValue:
+
+		if all {
+			switch v := interface{}(m.GetListValue()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "ListValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "ListValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetListValue()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionValueValidationError{
+					field:  "ListValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExpressionValue_GroupsValue:
+
+		if all {
+			switch v := interface{}(m.GetGroupsValue()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GroupsValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GroupsValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGroupsValue()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionValueValidationError{
+					field:  "GroupsValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExpressionValue_GeoRadiusValue:
+
+		if all {
+			switch v := interface{}(m.GetGeoRadiusValue()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GeoRadiusValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GeoRadiusValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGeoRadiusValue()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionValueValidationError{
+					field:  "GeoRadiusValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExpressionValue_GeoBoxValue:
+
+		if all {
+			switch v := interface{}(m.GetGeoBoxValue()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GeoBoxValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionValueValidationError{
+						field:  "GeoBoxValue",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGeoBoxValue()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionValueValidationError{
+					field:  "GeoBoxValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -631,34 +755,32 @@ var _ interface {
 	ErrorName() string
 } = ExpressionValueValidationError{}
 
-// Validate checks the field values on Expression with the rules defined in the
+// Validate checks the field values on GeoPoint with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Expression) Validate() error {
+func (m *GeoPoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Expression with the rules defined in
+// ValidateAll checks the field values on GeoPoint with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExpressionMultiError, or
-// nil if none found.
-func (m *Expression) ValidateAll() error {
+// result is a list of violation errors wrapped in GeoPointMultiError, or nil
+// if none found.
+func (m *GeoPoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Expression) validate(all bool) error {
+func (m *GeoPoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Operand
-
-	if _, ok := _Expression_Operator_InLookup[m.GetOperator()]; !ok {
-		err := ExpressionValidationError{
-			field:  "Operator",
-			reason: "value must be in list [== < <= > >= startsWith]",
+	if val := m.GetLat(); val < -90 || val > 90 {
+		err := GeoPointValidationError{
+			field:  "Lat",
+			reason: "value must be inside range [-90, 90]",
 		}
 		if !all {
 			return err
@@ -666,10 +788,10 @@ func (m *Expression) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetValue() == nil {
-		err := ExpressionValidationError{
-			field:  "Value",
-			reason: "value is required",
+	if val := m.GetLng(); val < -180 || val > 180 {
+		err := GeoPointValidationError{
+			field:  "Lng",
+			reason: "value must be inside range [-180, 180]",
 		}
 		if !all {
 			return err
@@ -677,48 +799,19 @@ func (m *Expression) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExpressionValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExpressionValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExpressionValidationError{
-				field:  "Value",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ExpressionMultiError(errors)
+		return GeoPointMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExpressionMultiError is an error wrapping multiple validation errors
-// returned by Expression.ValidateAll() if the designated constraints aren't met.
-type ExpressionMultiError []error
+// GeoPointMultiError is an error wrapping multiple validation errors returned
+// by GeoPoint.ValidateAll() if the designated constraints aren't met.
+type GeoPointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExpressionMultiError) Error() string {
+func (m GeoPointMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -727,11 +820,11 @@ func (m ExpressionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExpressionMultiError) AllErrors() []error { return m }
+func (m GeoPointMultiError) AllErrors() []error { return m }
 
-// ExpressionValidationError is the validation error returned by
-// Expression.Validate if the designated constraints aren't met.
-type ExpressionValidationError struct {
+// GeoPointValidationError is the validation error returned by
+// GeoPoint.Validate if the designated constraints aren't met.
+type GeoPointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -739,22 +832,22 @@ type ExpressionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExpressionValidationError) Field() string { return e.field }
+func (e GeoPointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExpressionValidationError) Reason() string { return e.reason }
+func (e GeoPointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExpressionValidationError) Cause() error { return e.cause }
+func (e GeoPointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExpressionValidationError) Key() bool { return e.key }
+func (e GeoPointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExpressionValidationError) ErrorName() string { return "ExpressionValidationError" }
+func (e GeoPointValidationError) ErrorName() string { return "GeoPointValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExpressionValidationError) Error() string {
+func (e GeoPointValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -766,14 +859,14 @@ func (e ExpressionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExpression.%s: %s%s",
+		"invalid %sGeoPoint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExpressionValidationError{}
+var _ error = GeoPointValidationError{}
 
 var _ interface {
 	Field() string
@@ -781,42 +874,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExpressionValidationError{}
+} = GeoPointValidationError{}
 
-var _Expression_Operator_InLookup = map[string]struct{}{
-	"==":         {},
-	"<":          {},
-	"<=":         {},
-	">":          {},
-	">=":         {},
-	"startsWith": {},
-}
-
-// Validate checks the field values on DocumentGetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentGetRequest) Validate() error {
+// Validate checks the field values on GeoRadius with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoRadius) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentGetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DocumentGetRequestMultiError, or nil if none found.
-func (m *DocumentGetRequest) ValidateAll() error {
+// ValidateAll checks the field values on GeoRadius with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoRadiusMultiError, or nil
+// if none found.
+func (m *GeoRadius) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentGetRequest) validate(all bool) error {
+func (m *GeoRadius) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetKey() == nil {
-		err := DocumentGetRequestValidationError{
-			field:  "Key",
+	if m.GetCenter() == nil {
+		err := GeoRadiusValidationError{
+			field:  "Center",
 			reason: "value is required",
 		}
 		if !all {
@@ -826,48 +910,58 @@ func (m *DocumentGetRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetKey()).(type) {
+		switch v := interface{}(m.GetCenter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentGetRequestValidationError{
-					field:  "Key",
+				errors = append(errors, GeoRadiusValidationError{
+					field:  "Center",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentGetRequestValidationError{
-					field:  "Key",
+				errors = append(errors, GeoRadiusValidationError{
+					field:  "Center",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCenter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DocumentGetRequestValidationError{
-				field:  "Key",
+			return GeoRadiusValidationError{
+				field:  "Center",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetMeters() <= 0 {
+		err := GeoRadiusValidationError{
+			field:  "Meters",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DocumentGetRequestMultiError(errors)
+		return GeoRadiusMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentGetRequestMultiError is an error wrapping multiple validation errors
-// returned by DocumentGetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DocumentGetRequestMultiError []error
+// GeoRadiusMultiError is an error wrapping multiple validation errors returned
+// by GeoRadius.ValidateAll() if the designated constraints aren't met.
+type GeoRadiusMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentGetRequestMultiError) Error() string {
+func (m GeoRadiusMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -876,11 +970,11 @@ func (m DocumentGetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentGetRequestMultiError) AllErrors() []error { return m }
+func (m GeoRadiusMultiError) AllErrors() []error { return m }
 
-// DocumentGetRequestValidationError is the validation error returned by
-// DocumentGetRequest.Validate if the designated constraints aren't met.
-type DocumentGetRequestValidationError struct {
+// GeoRadiusValidationError is the validation error returned by
+// GeoRadius.Validate if the designated constraints aren't met.
+type GeoRadiusValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -888,24 +982,22 @@ type DocumentGetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentGetRequestValidationError) Field() string { return e.field }
+func (e GeoRadiusValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentGetRequestValidationError) Reason() string { return e.reason }
+func (e GeoRadiusValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentGetRequestValidationError) Cause() error { return e.cause }
+func (e GeoRadiusValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentGetRequestValidationError) Key() bool { return e.key }
+func (e GeoRadiusValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentGetRequestValidationError) ErrorName() string {
-	return "DocumentGetRequestValidationError"
-}
+func (e GeoRadiusValidationError) ErrorName() string { return "GeoRadiusValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentGetRequestValidationError) Error() string {
+func (e GeoRadiusValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -917,14 +1009,14 @@ func (e DocumentGetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentGetRequest.%s: %s%s",
+		"invalid %sGeoRadius.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentGetRequestValidationError{}
+var _ error = GeoRadiusValidationError{}
 
 var _ interface {
 	Field() string
@@ -932,74 +1024,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentGetRequestValidationError{}
+} = GeoRadiusValidationError{}
 
-// Validate checks the field values on DocumentGetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentGetResponse) Validate() error {
+// Validate checks the field values on GeoBox with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoBox) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentGetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DocumentGetResponseMultiError, or nil if none found.
-func (m *DocumentGetResponse) ValidateAll() error {
+// ValidateAll checks the field values on GeoBox with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GeoBoxMultiError, or nil if none found.
+func (m *GeoBox) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentGetResponse) validate(all bool) error {
+func (m *GeoBox) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSouthWest() == nil {
+		err := GeoBoxValidationError{
+			field:  "SouthWest",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetDocument()).(type) {
+		switch v := interface{}(m.GetSouthWest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentGetResponseValidationError{
-					field:  "Document",
+				errors = append(errors, GeoBoxValidationError{
+					field:  "SouthWest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentGetResponseValidationError{
-					field:  "Document",
+				errors = append(errors, GeoBoxValidationError{
+					field:  "SouthWest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSouthWest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DocumentGetResponseValidationError{
-				field:  "Document",
+			return GeoBoxValidationError{
+				field:  "SouthWest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return DocumentGetResponseMultiError(errors)
+	if m.GetNorthEast() == nil {
+		err := GeoBoxValidationError{
+			field:  "NorthEast",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// DocumentGetResponseMultiError is an error wrapping multiple validation
-// errors returned by DocumentGetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DocumentGetResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m DocumentGetResponseMultiError) Error() string {
-	var msgs []string
+	if all {
+		switch v := interface{}(m.GetNorthEast()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GeoBoxValidationError{
+					field:  "NorthEast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GeoBoxValidationError{
+					field:  "NorthEast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNorthEast()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GeoBoxValidationError{
+				field:  "NorthEast",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GeoBoxMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoBoxMultiError is an error wrapping multiple validation errors returned by
+// GeoBox.ValidateAll() if the designated constraints aren't met.
+type GeoBoxMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoBoxMultiError) Error() string {
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1007,11 +1148,11 @@ func (m DocumentGetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentGetResponseMultiError) AllErrors() []error { return m }
+func (m GeoBoxMultiError) AllErrors() []error { return m }
 
-// DocumentGetResponseValidationError is the validation error returned by
-// DocumentGetResponse.Validate if the designated constraints aren't met.
-type DocumentGetResponseValidationError struct {
+// GeoBoxValidationError is the validation error returned by GeoBox.Validate if
+// the designated constraints aren't met.
+type GeoBoxValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1019,24 +1160,22 @@ type DocumentGetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentGetResponseValidationError) Field() string { return e.field }
+func (e GeoBoxValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentGetResponseValidationError) Reason() string { return e.reason }
+func (e GeoBoxValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentGetResponseValidationError) Cause() error { return e.cause }
+func (e GeoBoxValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentGetResponseValidationError) Key() bool { return e.key }
+func (e GeoBoxValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentGetResponseValidationError) ErrorName() string {
-	return "DocumentGetResponseValidationError"
-}
+func (e GeoBoxValidationError) ErrorName() string { return "GeoBoxValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentGetResponseValidationError) Error() string {
+func (e GeoBoxValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1048,14 +1187,14 @@ func (e DocumentGetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentGetResponse.%s: %s%s",
+		"invalid %sGeoBox.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentGetResponseValidationError{}
+var _ error = GeoBoxValidationError{}
 
 var _ interface {
 	Field() string
@@ -1063,124 +1202,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentGetResponseValidationError{}
+} = GeoBoxValidationError{}
 
-// Validate checks the field values on DocumentSetRequest with the rules
+// Validate checks the field values on ExpressionValueList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentSetRequest) Validate() error {
+func (m *ExpressionValueList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentSetRequest with the rules
+// ValidateAll checks the field values on ExpressionValueList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentSetRequestMultiError, or nil if none found.
-func (m *DocumentSetRequest) ValidateAll() error {
+// ExpressionValueListMultiError, or nil if none found.
+func (m *ExpressionValueList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentSetRequest) validate(all bool) error {
+func (m *ExpressionValueList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetKey() == nil {
-		err := DocumentSetRequestValidationError{
-			field:  "Key",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetKey()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentSetRequestValidationError{
-					field:  "Key",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionValueListValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionValueListValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentSetRequestValidationError{
-					field:  "Key",
+				return ExpressionValueListValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DocumentSetRequestValidationError{
-				field:  "Key",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
-
-	if m.GetContent() == nil {
-		err := DocumentSetRequestValidationError{
-			field:  "Content",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
 
-	if all {
-		switch v := interface{}(m.GetContent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentSetRequestValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentSetRequestValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DocumentSetRequestValidationError{
-				field:  "Content",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
 	if len(errors) > 0 {
-		return DocumentSetRequestMultiError(errors)
+		return ExpressionValueListMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentSetRequestMultiError is an error wrapping multiple validation errors
-// returned by DocumentSetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DocumentSetRequestMultiError []error
+// ExpressionValueListMultiError is an error wrapping multiple validation
+// errors returned by ExpressionValueList.ValidateAll() if the designated
+// constraints aren't met.
+type ExpressionValueListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentSetRequestMultiError) Error() string {
+func (m ExpressionValueListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1189,11 +1282,11 @@ func (m DocumentSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentSetRequestMultiError) AllErrors() []error { return m }
+func (m ExpressionValueListMultiError) AllErrors() []error { return m }
 
-// DocumentSetRequestValidationError is the validation error returned by
-// DocumentSetRequest.Validate if the designated constraints aren't met.
-type DocumentSetRequestValidationError struct {
+// ExpressionValueListValidationError is the validation error returned by
+// ExpressionValueList.Validate if the designated constraints aren't met.
+type ExpressionValueListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1201,24 +1294,24 @@ type DocumentSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentSetRequestValidationError) Field() string { return e.field }
+func (e ExpressionValueListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentSetRequestValidationError) Reason() string { return e.reason }
+func (e ExpressionValueListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentSetRequestValidationError) Cause() error { return e.cause }
+func (e ExpressionValueListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentSetRequestValidationError) Key() bool { return e.key }
+func (e ExpressionValueListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentSetRequestValidationError) ErrorName() string {
-	return "DocumentSetRequestValidationError"
+func (e ExpressionValueListValidationError) ErrorName() string {
+	return "ExpressionValueListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DocumentSetRequestValidationError) Error() string {
+func (e ExpressionValueListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1230,14 +1323,14 @@ func (e DocumentSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentSetRequest.%s: %s%s",
+		"invalid %sExpressionValueList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentSetRequestValidationError{}
+var _ error = ExpressionValueListValidationError{}
 
 var _ interface {
 	Field() string
@@ -1245,44 +1338,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentSetRequestValidationError{}
+} = ExpressionValueListValidationError{}
 
-// Validate checks the field values on DocumentSetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentSetResponse) Validate() error {
+// Validate checks the field values on ExpressionGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpressionGroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentSetResponse with the rules
+// ValidateAll checks the field values on ExpressionGroup with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentSetResponseMultiError, or nil if none found.
-func (m *DocumentSetResponse) ValidateAll() error {
+// ExpressionGroupMultiError, or nil if none found.
+func (m *ExpressionGroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentSetResponse) validate(all bool) error {
+func (m *ExpressionGroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetExpressions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionGroupValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionGroupValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionGroupValidationError{
+					field:  fmt.Sprintf("Expressions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DocumentSetResponseMultiError(errors)
+		return ExpressionGroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentSetResponseMultiError is an error wrapping multiple validation
-// errors returned by DocumentSetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DocumentSetResponseMultiError []error
+// ExpressionGroupMultiError is an error wrapping multiple validation errors
+// returned by ExpressionGroup.ValidateAll() if the designated constraints
+// aren't met.
+type ExpressionGroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentSetResponseMultiError) Error() string {
+func (m ExpressionGroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1291,11 +1418,11 @@ func (m DocumentSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentSetResponseMultiError) AllErrors() []error { return m }
+func (m ExpressionGroupMultiError) AllErrors() []error { return m }
 
-// DocumentSetResponseValidationError is the validation error returned by
-// DocumentSetResponse.Validate if the designated constraints aren't met.
-type DocumentSetResponseValidationError struct {
+// ExpressionGroupValidationError is the validation error returned by
+// ExpressionGroup.Validate if the designated constraints aren't met.
+type ExpressionGroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1303,24 +1430,22 @@ type DocumentSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentSetResponseValidationError) Field() string { return e.field }
+func (e ExpressionGroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentSetResponseValidationError) Reason() string { return e.reason }
+func (e ExpressionGroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentSetResponseValidationError) Cause() error { return e.cause }
+func (e ExpressionGroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentSetResponseValidationError) Key() bool { return e.key }
+func (e ExpressionGroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentSetResponseValidationError) ErrorName() string {
-	return "DocumentSetResponseValidationError"
-}
+func (e ExpressionGroupValidationError) ErrorName() string { return "ExpressionGroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentSetResponseValidationError) Error() string {
+func (e ExpressionGroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1332,14 +1457,14 @@ func (e DocumentSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentSetResponse.%s: %s%s",
+		"invalid %sExpressionGroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentSetResponseValidationError{}
+var _ error = ExpressionGroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -1347,84 +1472,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentSetResponseValidationError{}
+} = ExpressionGroupValidationError{}
 
-// Validate checks the field values on DocumentDeleteRequest with the rules
+// Validate checks the field values on ExpressionGroupList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentDeleteRequest) Validate() error {
+func (m *ExpressionGroupList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentDeleteRequest with the rules
+// ValidateAll checks the field values on ExpressionGroupList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentDeleteRequestMultiError, or nil if none found.
-func (m *DocumentDeleteRequest) ValidateAll() error {
+// ExpressionGroupListMultiError, or nil if none found.
+func (m *ExpressionGroupList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentDeleteRequest) validate(all bool) error {
+func (m *ExpressionGroupList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetKey() == nil {
-		err := DocumentDeleteRequestValidationError{
-			field:  "Key",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetKey()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentDeleteRequestValidationError{
-					field:  "Key",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentDeleteRequestValidationError{
-					field:  "Key",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpressionGroupListValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpressionGroupListValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		}
-	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DocumentDeleteRequestValidationError{
-				field:  "Key",
-				reason: "embedded message failed validation",
-				cause:  err,
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpressionGroupListValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return DocumentDeleteRequestMultiError(errors)
+		return ExpressionGroupListMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by DocumentDeleteRequest.ValidateAll() if the designated
+// ExpressionGroupListMultiError is an error wrapping multiple validation
+// errors returned by ExpressionGroupList.ValidateAll() if the designated
 // constraints aren't met.
-type DocumentDeleteRequestMultiError []error
+type ExpressionGroupListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentDeleteRequestMultiError) Error() string {
+func (m ExpressionGroupListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1433,11 +1552,11 @@ func (m DocumentDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentDeleteRequestMultiError) AllErrors() []error { return m }
+func (m ExpressionGroupListMultiError) AllErrors() []error { return m }
 
-// DocumentDeleteRequestValidationError is the validation error returned by
-// DocumentDeleteRequest.Validate if the designated constraints aren't met.
-type DocumentDeleteRequestValidationError struct {
+// ExpressionGroupListValidationError is the validation error returned by
+// ExpressionGroupList.Validate if the designated constraints aren't met.
+type ExpressionGroupListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1445,24 +1564,24 @@ type DocumentDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentDeleteRequestValidationError) Field() string { return e.field }
+func (e ExpressionGroupListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentDeleteRequestValidationError) Reason() string { return e.reason }
+func (e ExpressionGroupListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentDeleteRequestValidationError) Cause() error { return e.cause }
+func (e ExpressionGroupListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentDeleteRequestValidationError) Key() bool { return e.key }
+func (e ExpressionGroupListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentDeleteRequestValidationError) ErrorName() string {
-	return "DocumentDeleteRequestValidationError"
+func (e ExpressionGroupListValidationError) ErrorName() string {
+	return "ExpressionGroupListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DocumentDeleteRequestValidationError) Error() string {
+func (e ExpressionGroupListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1474,14 +1593,14 @@ func (e DocumentDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentDeleteRequest.%s: %s%s",
+		"invalid %sExpressionGroupList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentDeleteRequestValidationError{}
+var _ error = ExpressionGroupListValidationError{}
 
 var _ interface {
 	Field() string
@@ -1489,44 +1608,96 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentDeleteRequestValidationError{}
+} = ExpressionGroupListValidationError{}
 
-// Validate checks the field values on DocumentDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentDeleteResponse) Validate() error {
+// Validate checks the field values on Expression with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Expression) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DocumentDeleteResponseMultiError, or nil if none found.
-func (m *DocumentDeleteResponse) ValidateAll() error {
+// ValidateAll checks the field values on Expression with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExpressionMultiError, or
+// nil if none found.
+func (m *Expression) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentDeleteResponse) validate(all bool) error {
+func (m *Expression) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Operand
+
+	if _, ok := _Expression_Operator_InLookup[m.GetOperator()]; !ok {
+		err := ExpressionValidationError{
+			field:  "Operator",
+			reason: "value must be in list [== < <= > >= startsWith in not-in array-contains array-contains-any within-radius within-box or]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValue() == nil {
+		err := ExpressionValidationError{
+			field:  "Value",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpressionValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpressionValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpressionValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DocumentDeleteResponseMultiError(errors)
+		return ExpressionMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by DocumentDeleteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DocumentDeleteResponseMultiError []error
+// ExpressionMultiError is an error wrapping multiple validation errors
+// returned by Expression.ValidateAll() if the designated constraints aren't met.
+type ExpressionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentDeleteResponseMultiError) Error() string {
+func (m ExpressionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1535,11 +1706,11 @@ func (m DocumentDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentDeleteResponseMultiError) AllErrors() []error { return m }
+func (m ExpressionMultiError) AllErrors() []error { return m }
 
-// DocumentDeleteResponseValidationError is the validation error returned by
-// DocumentDeleteResponse.Validate if the designated constraints aren't met.
-type DocumentDeleteResponseValidationError struct {
+// ExpressionValidationError is the validation error returned by
+// Expression.Validate if the designated constraints aren't met.
+type ExpressionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1547,24 +1718,22 @@ type DocumentDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentDeleteResponseValidationError) Field() string { return e.field }
+func (e ExpressionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentDeleteResponseValidationError) Reason() string { return e.reason }
+func (e ExpressionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentDeleteResponseValidationError) Cause() error { return e.cause }
+func (e ExpressionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentDeleteResponseValidationError) Key() bool { return e.key }
+func (e ExpressionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentDeleteResponseValidationError) ErrorName() string {
-	return "DocumentDeleteResponseValidationError"
-}
+func (e ExpressionValidationError) ErrorName() string { return "ExpressionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentDeleteResponseValidationError) Error() string {
+func (e ExpressionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1576,14 +1745,14 @@ func (e DocumentDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentDeleteResponse.%s: %s%s",
+		"invalid %sExpression.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentDeleteResponseValidationError{}
+var _ error = ExpressionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1591,33 +1760,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentDeleteResponseValidationError{}
+} = ExpressionValidationError{}
 
-// Validate checks the field values on DocumentQueryRequest with the rules
+var _Expression_Operator_InLookup = map[string]struct{}{
+	"==":                 {},
+	"<":                  {},
+	"<=":                 {},
+	">":                  {},
+	">=":                 {},
+	"startsWith":         {},
+	"in":                 {},
+	"not-in":             {},
+	"array-contains":     {},
+	"array-contains-any": {},
+	"within-radius":      {},
+	"within-box":         {},
+	"or":                 {},
+}
+
+// Validate checks the field values on DocumentGetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentQueryRequest) Validate() error {
+func (m *DocumentGetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentQueryRequest with the rules
+// ValidateAll checks the field values on DocumentGetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentQueryRequestMultiError, or nil if none found.
-func (m *DocumentQueryRequest) ValidateAll() error {
+// DocumentGetRequestMultiError, or nil if none found.
+func (m *DocumentGetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentQueryRequest) validate(all bool) error {
+func (m *DocumentGetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetCollection() == nil {
-		err := DocumentQueryRequestValidationError{
-			field:  "Collection",
+	if m.GetKey() == nil {
+		err := DocumentGetRequestValidationError{
+			field:  "Key",
 			reason: "value is required",
 		}
 		if !all {
@@ -1627,86 +1812,2598 @@ func (m *DocumentQueryRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCollection()).(type) {
+		switch v := interface{}(m.GetKey()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentQueryRequestValidationError{
-					field:  "Collection",
+				errors = append(errors, DocumentGetRequestValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentQueryRequestValidationError{
-					field:  "Collection",
+				errors = append(errors, DocumentGetRequestValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DocumentQueryRequestValidationError{
-				field:  "Collection",
+			return DocumentGetRequestValidationError{
+				field:  "Key",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetExpressions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DocumentQueryRequestValidationError{
-						field:  fmt.Sprintf("Expressions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DocumentQueryRequestValidationError{
-						field:  fmt.Sprintf("Expressions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DocumentQueryRequestValidationError{
-					field:  fmt.Sprintf("Expressions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+	if len(errors) > 0 {
+		return DocumentGetRequestMultiError(errors)
 	}
 
-	// no validation rules for Limit
+	return nil
+}
 
-	// no validation rules for PagingToken
+// DocumentGetRequestMultiError is an error wrapping multiple validation errors
+// returned by DocumentGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DocumentGetRequestMultiError []error
 
-	if len(errors) > 0 {
-		return DocumentQueryRequestMultiError(errors)
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	return nil
+	return strings.Join(msgs, "; ")
 }
 
-// DocumentQueryRequestMultiError is an error wrapping multiple validation
-// errors returned by DocumentQueryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DocumentQueryRequestMultiError []error
+// AllErrors returns a list of validation violation errors.
+func (m DocumentGetRequestMultiError) AllErrors() []error { return m }
+
+// DocumentGetRequestValidationError is the validation error returned by
+// DocumentGetRequest.Validate if the designated constraints aren't met.
+type DocumentGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentGetRequestValidationError) ErrorName() string {
+	return "DocumentGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentGetRequestValidationError{}
+
+// Validate checks the field values on DocumentGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentGetResponseMultiError, or nil if none found.
+func (m *DocumentGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentGetResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentGetResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentGetResponseValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentGetResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentGetResponseMultiError) AllErrors() []error { return m }
+
+// DocumentGetResponseValidationError is the validation error returned by
+// DocumentGetResponse.Validate if the designated constraints aren't met.
+type DocumentGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentGetResponseValidationError) ErrorName() string {
+	return "DocumentGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentGetResponseValidationError{}
+
+// Validate checks the field values on DocumentSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentSetRequestMultiError, or nil if none found.
+func (m *DocumentSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKey() == nil {
+		err := DocumentSetRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentSetRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentSetRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentSetRequestValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetContent() == nil {
+		err := DocumentSetRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentSetRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentSetRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentSetRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetOutbox() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentSetRequestValidationError{
+						field:  fmt.Sprintf("Outbox[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentSetRequestValidationError{
+						field:  fmt.Sprintf("Outbox[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentSetRequestValidationError{
+					field:  fmt.Sprintf("Outbox[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentSetRequestMultiError is an error wrapping multiple validation errors
+// returned by DocumentSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DocumentSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentSetRequestMultiError) AllErrors() []error { return m }
+
+// DocumentSetRequestValidationError is the validation error returned by
+// DocumentSetRequest.Validate if the designated constraints aren't met.
+type DocumentSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentSetRequestValidationError) ErrorName() string {
+	return "DocumentSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentSetRequestValidationError{}
+
+// Validate checks the field values on DocumentOutboxEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentOutboxEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentOutboxEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentOutboxEventMultiError, or nil if none found.
+func (m *DocumentOutboxEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentOutboxEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTopic()) > 256 {
+		err := DocumentOutboxEventValidationError{
+			field:  "Topic",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DocumentOutboxEvent_Topic_Pattern.MatchString(m.GetTopic()) {
+		err := DocumentOutboxEventValidationError{
+			field:  "Topic",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for PayloadType
+
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentOutboxEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentOutboxEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentOutboxEventValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentOutboxEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentOutboxEventMultiError is an error wrapping multiple validation
+// errors returned by DocumentOutboxEvent.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentOutboxEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentOutboxEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentOutboxEventMultiError) AllErrors() []error { return m }
+
+// DocumentOutboxEventValidationError is the validation error returned by
+// DocumentOutboxEvent.Validate if the designated constraints aren't met.
+type DocumentOutboxEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentOutboxEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentOutboxEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentOutboxEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentOutboxEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentOutboxEventValidationError) ErrorName() string {
+	return "DocumentOutboxEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentOutboxEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentOutboxEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentOutboxEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentOutboxEventValidationError{}
+
+var _DocumentOutboxEvent_Topic_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on DocumentSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentSetResponseMultiError, or nil if none found.
+func (m *DocumentSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DocumentSetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentSetResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentSetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentSetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentSetResponseMultiError) AllErrors() []error { return m }
+
+// DocumentSetResponseValidationError is the validation error returned by
+// DocumentSetResponse.Validate if the designated constraints aren't met.
+type DocumentSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentSetResponseValidationError) ErrorName() string {
+	return "DocumentSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentSetResponseValidationError{}
+
+// Validate checks the field values on DocumentDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentDeleteRequestMultiError, or nil if none found.
+func (m *DocumentDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKey() == nil {
+		err := DocumentDeleteRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentDeleteRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentDeleteRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentDeleteRequestValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by DocumentDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentDeleteRequestMultiError) AllErrors() []error { return m }
+
+// DocumentDeleteRequestValidationError is the validation error returned by
+// DocumentDeleteRequest.Validate if the designated constraints aren't met.
+type DocumentDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentDeleteRequestValidationError) ErrorName() string {
+	return "DocumentDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentDeleteRequestValidationError{}
+
+// Validate checks the field values on DocumentDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentDeleteResponseMultiError, or nil if none found.
+func (m *DocumentDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DocumentDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentDeleteResponseMultiError) AllErrors() []error { return m }
+
+// DocumentDeleteResponseValidationError is the validation error returned by
+// DocumentDeleteResponse.Validate if the designated constraints aren't met.
+type DocumentDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentDeleteResponseValidationError) ErrorName() string {
+	return "DocumentDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentDeleteResponseValidationError{}
+
+// Validate checks the field values on DocumentSetBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentSetBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentSetBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentSetBatchRequestMultiError, or nil if none found.
+func (m *DocumentSetBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentSetBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDocuments()) < 1 {
+		err := DocumentSetBatchRequestValidationError{
+			field:  "Documents",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentSetBatchRequestValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentSetBatchRequestValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentSetBatchRequestValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentSetBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentSetBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by DocumentSetBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentSetBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentSetBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentSetBatchRequestMultiError) AllErrors() []error { return m }
+
+// DocumentSetBatchRequestValidationError is the validation error returned by
+// DocumentSetBatchRequest.Validate if the designated constraints aren't met.
+type DocumentSetBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentSetBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentSetBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentSetBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentSetBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentSetBatchRequestValidationError) ErrorName() string {
+	return "DocumentSetBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentSetBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentSetBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentSetBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentSetBatchRequestValidationError{}
+
+// Validate checks the field values on DocumentSetBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentSetBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentSetBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentSetBatchResponseMultiError, or nil if none found.
+func (m *DocumentSetBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentSetBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFailedWrites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentSetBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedWrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentSetBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedWrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentSetBatchResponseValidationError{
+					field:  fmt.Sprintf("FailedWrites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentSetBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentSetBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentSetBatchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentSetBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentSetBatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentSetBatchResponseMultiError) AllErrors() []error { return m }
+
+// DocumentSetBatchResponseValidationError is the validation error returned by
+// DocumentSetBatchResponse.Validate if the designated constraints aren't met.
+type DocumentSetBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentSetBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentSetBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentSetBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentSetBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentSetBatchResponseValidationError) ErrorName() string {
+	return "DocumentSetBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentSetBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentSetBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentSetBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentSetBatchResponseValidationError{}
+
+// Validate checks the field values on DocumentDeleteBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentDeleteBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentDeleteBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentDeleteBatchRequestMultiError, or nil if none found.
+func (m *DocumentDeleteBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentDeleteBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetKeys()) < 1 {
+		err := DocumentDeleteBatchRequestValidationError{
+			field:  "Keys",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentDeleteBatchRequestValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentDeleteBatchRequestValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentDeleteBatchRequestValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentDeleteBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentDeleteBatchRequestMultiError is an error wrapping multiple
+// validation errors returned by DocumentDeleteBatchRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DocumentDeleteBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentDeleteBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentDeleteBatchRequestMultiError) AllErrors() []error { return m }
+
+// DocumentDeleteBatchRequestValidationError is the validation error returned
+// by DocumentDeleteBatchRequest.Validate if the designated constraints aren't met.
+type DocumentDeleteBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentDeleteBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentDeleteBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentDeleteBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentDeleteBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentDeleteBatchRequestValidationError) ErrorName() string {
+	return "DocumentDeleteBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentDeleteBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentDeleteBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentDeleteBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentDeleteBatchRequestValidationError{}
+
+// Validate checks the field values on DocumentDeleteBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentDeleteBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentDeleteBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentDeleteBatchResponseMultiError, or nil if none found.
+func (m *DocumentDeleteBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentDeleteBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFailedWrites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentDeleteBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedWrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentDeleteBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedWrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentDeleteBatchResponseValidationError{
+					field:  fmt.Sprintf("FailedWrites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentDeleteBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentDeleteBatchResponseMultiError is an error wrapping multiple
+// validation errors returned by DocumentDeleteBatchResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DocumentDeleteBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentDeleteBatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentDeleteBatchResponseMultiError) AllErrors() []error { return m }
+
+// DocumentDeleteBatchResponseValidationError is the validation error returned
+// by DocumentDeleteBatchResponse.Validate if the designated constraints
+// aren't met.
+type DocumentDeleteBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentDeleteBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentDeleteBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentDeleteBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentDeleteBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentDeleteBatchResponseValidationError) ErrorName() string {
+	return "DocumentDeleteBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentDeleteBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentDeleteBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentDeleteBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentDeleteBatchResponseValidationError{}
+
+// Validate checks the field values on FailedWrite with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FailedWrite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailedWrite with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FailedWriteMultiError, or
+// nil if none found.
+func (m *FailedWrite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailedWrite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FailedWriteValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FailedWriteValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FailedWriteValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return FailedWriteMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailedWriteMultiError is an error wrapping multiple validation errors
+// returned by FailedWrite.ValidateAll() if the designated constraints aren't met.
+type FailedWriteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailedWriteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailedWriteMultiError) AllErrors() []error { return m }
+
+// FailedWriteValidationError is the validation error returned by
+// FailedWrite.Validate if the designated constraints aren't met.
+type FailedWriteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailedWriteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailedWriteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailedWriteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailedWriteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailedWriteValidationError) ErrorName() string { return "FailedWriteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FailedWriteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailedWrite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailedWriteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailedWriteValidationError{}
+
+// Validate checks the field values on DocumentIncrementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentIncrementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentIncrementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentIncrementRequestMultiError, or nil if none found.
+func (m *DocumentIncrementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentIncrementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKey() == nil {
+		err := DocumentIncrementRequestValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentIncrementRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentIncrementRequestValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentIncrementRequestValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		err := DocumentIncrementRequestValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Delta
+
+	if len(errors) > 0 {
+		return DocumentIncrementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentIncrementRequestMultiError is an error wrapping multiple validation
+// errors returned by DocumentIncrementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentIncrementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentIncrementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentIncrementRequestMultiError) AllErrors() []error { return m }
+
+// DocumentIncrementRequestValidationError is the validation error returned by
+// DocumentIncrementRequest.Validate if the designated constraints aren't met.
+type DocumentIncrementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentIncrementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentIncrementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentIncrementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentIncrementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentIncrementRequestValidationError) ErrorName() string {
+	return "DocumentIncrementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentIncrementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentIncrementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentIncrementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentIncrementRequestValidationError{}
+
+// Validate checks the field values on DocumentIncrementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentIncrementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentIncrementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentIncrementResponseMultiError, or nil if none found.
+func (m *DocumentIncrementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentIncrementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return DocumentIncrementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentIncrementResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentIncrementResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DocumentIncrementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentIncrementResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentIncrementResponseMultiError) AllErrors() []error { return m }
+
+// DocumentIncrementResponseValidationError is the validation error returned by
+// DocumentIncrementResponse.Validate if the designated constraints aren't met.
+type DocumentIncrementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentIncrementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentIncrementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentIncrementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentIncrementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentIncrementResponseValidationError) ErrorName() string {
+	return "DocumentIncrementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentIncrementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentIncrementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentIncrementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentIncrementResponseValidationError{}
+
+// Validate checks the field values on DocumentQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentQueryRequestMultiError, or nil if none found.
+func (m *DocumentQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollection() == nil {
+		err := DocumentQueryRequestValidationError{
+			field:  "Collection",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentQueryRequestValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentQueryRequestValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentQueryRequestValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExpressions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentQueryRequestValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentQueryRequestValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentQueryRequestValidationError{
+					field:  fmt.Sprintf("Expressions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return DocumentQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by DocumentQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentQueryRequestMultiError) AllErrors() []error { return m }
+
+// DocumentQueryRequestValidationError is the validation error returned by
+// DocumentQueryRequest.Validate if the designated constraints aren't met.
+type DocumentQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentQueryRequestValidationError) ErrorName() string {
+	return "DocumentQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentQueryRequestValidationError{}
+
+// Validate checks the field values on DocumentQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentQueryResponseMultiError, or nil if none found.
+func (m *DocumentQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentQueryResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentQueryResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentQueryResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return DocumentQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentQueryResponseMultiError) AllErrors() []error { return m }
+
+// DocumentQueryResponseValidationError is the validation error returned by
+// DocumentQueryResponse.Validate if the designated constraints aren't met.
+type DocumentQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentQueryResponseValidationError) ErrorName() string {
+	return "DocumentQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentQueryResponseValidationError{}
+
+// Validate checks the field values on DocumentQueryStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentQueryStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentQueryStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentQueryStreamRequestMultiError, or nil if none found.
+func (m *DocumentQueryStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentQueryStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollection() == nil {
+		err := DocumentQueryStreamRequestValidationError{
+			field:  "Collection",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentQueryStreamRequestValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentQueryStreamRequestValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentQueryStreamRequestValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExpressions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentQueryStreamRequestValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentQueryStreamRequestValidationError{
+						field:  fmt.Sprintf("Expressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentQueryStreamRequestValidationError{
+					field:  fmt.Sprintf("Expressions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return DocumentQueryStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentQueryStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by DocumentQueryStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DocumentQueryStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentQueryStreamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentQueryStreamRequestMultiError) AllErrors() []error { return m }
+
+// DocumentQueryStreamRequestValidationError is the validation error returned
+// by DocumentQueryStreamRequest.Validate if the designated constraints aren't met.
+type DocumentQueryStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentQueryStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentQueryStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentQueryStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentQueryStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentQueryStreamRequestValidationError) ErrorName() string {
+	return "DocumentQueryStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentQueryStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentQueryStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentQueryStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentQueryStreamRequestValidationError{}
+
+// Validate checks the field values on DocumentQueryStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentQueryStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentQueryStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentQueryStreamResponseMultiError, or nil if none found.
+func (m *DocumentQueryStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentQueryStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentQueryStreamResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentQueryStreamResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentQueryStreamResponseValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentQueryStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentQueryStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by DocumentQueryStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DocumentQueryStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentQueryStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentQueryStreamResponseMultiError) AllErrors() []error { return m }
+
+// DocumentQueryStreamResponseValidationError is the validation error returned
+// by DocumentQueryStreamResponse.Validate if the designated constraints
+// aren't met.
+type DocumentQueryStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentQueryStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentQueryStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentQueryStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentQueryStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentQueryStreamResponseValidationError) ErrorName() string {
+	return "DocumentQueryStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentQueryStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentQueryStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentQueryStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentQueryStreamResponseValidationError{}
+
+// Validate checks the field values on Aggregation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Aggregation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AggregationMultiError, or
+// nil if none found.
+func (m *Aggregation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Aggregation_Function_InLookup[m.GetFunction()]; !ok {
+		err := AggregationValidationError{
+			field:  "Function",
+			reason: "value must be in list [count sum avg min max]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Field
+
+	if len(errors) > 0 {
+		return AggregationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregationMultiError is an error wrapping multiple validation errors
+// returned by Aggregation.ValidateAll() if the designated constraints aren't met.
+type AggregationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentQueryRequestMultiError) Error() string {
+func (m AggregationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1715,11 +4412,11 @@ func (m DocumentQueryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentQueryRequestMultiError) AllErrors() []error { return m }
+func (m AggregationMultiError) AllErrors() []error { return m }
 
-// DocumentQueryRequestValidationError is the validation error returned by
-// DocumentQueryRequest.Validate if the designated constraints aren't met.
-type DocumentQueryRequestValidationError struct {
+// AggregationValidationError is the validation error returned by
+// Aggregation.Validate if the designated constraints aren't met.
+type AggregationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1727,24 +4424,22 @@ type DocumentQueryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentQueryRequestValidationError) Field() string { return e.field }
+func (e AggregationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentQueryRequestValidationError) Reason() string { return e.reason }
+func (e AggregationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentQueryRequestValidationError) Cause() error { return e.cause }
+func (e AggregationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentQueryRequestValidationError) Key() bool { return e.key }
+func (e AggregationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentQueryRequestValidationError) ErrorName() string {
-	return "DocumentQueryRequestValidationError"
-}
+func (e AggregationValidationError) ErrorName() string { return "AggregationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentQueryRequestValidationError) Error() string {
+func (e AggregationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1756,14 +4451,14 @@ func (e DocumentQueryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentQueryRequest.%s: %s%s",
+		"invalid %sAggregation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentQueryRequestValidationError{}
+var _ error = AggregationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1771,80 +4466,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentQueryRequestValidationError{}
+} = AggregationValidationError{}
+
+var _Aggregation_Function_InLookup = map[string]struct{}{
+	"count": {},
+	"sum":   {},
+	"avg":   {},
+	"min":   {},
+	"max":   {},
+}
 
-// Validate checks the field values on DocumentQueryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentQueryResponse) Validate() error {
+// Validate checks the field values on AggregateResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AggregateResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentQueryResponse with the rules
+// ValidateAll checks the field values on AggregateResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentQueryResponseMultiError, or nil if none found.
-func (m *DocumentQueryResponse) ValidateAll() error {
+// AggregateResultMultiError, or nil if none found.
+func (m *AggregateResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentQueryResponse) validate(all bool) error {
+func (m *AggregateResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDocuments() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DocumentQueryResponseValidationError{
-						field:  fmt.Sprintf("Documents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DocumentQueryResponseValidationError{
-						field:  fmt.Sprintf("Documents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetAggregation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateResultValidationError{
+					field:  "Aggregation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return DocumentQueryResponseValidationError{
-					field:  fmt.Sprintf("Documents[%v]", idx),
+				errors = append(errors, AggregateResultValidationError{
+					field:  "Aggregation",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAggregation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateResultValidationError{
+				field:  "Aggregation",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for PagingToken
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateResultValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateResultValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateResultValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DocumentQueryResponseMultiError(errors)
+		return AggregateResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentQueryResponseMultiError is an error wrapping multiple validation
-// errors returned by DocumentQueryResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DocumentQueryResponseMultiError []error
+// AggregateResultMultiError is an error wrapping multiple validation errors
+// returned by AggregateResult.ValidateAll() if the designated constraints
+// aren't met.
+type AggregateResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentQueryResponseMultiError) Error() string {
+func (m AggregateResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1853,11 +4578,11 @@ func (m DocumentQueryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentQueryResponseMultiError) AllErrors() []error { return m }
+func (m AggregateResultMultiError) AllErrors() []error { return m }
 
-// DocumentQueryResponseValidationError is the validation error returned by
-// DocumentQueryResponse.Validate if the designated constraints aren't met.
-type DocumentQueryResponseValidationError struct {
+// AggregateResultValidationError is the validation error returned by
+// AggregateResult.Validate if the designated constraints aren't met.
+type AggregateResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1865,24 +4590,22 @@ type DocumentQueryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentQueryResponseValidationError) Field() string { return e.field }
+func (e AggregateResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentQueryResponseValidationError) Reason() string { return e.reason }
+func (e AggregateResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentQueryResponseValidationError) Cause() error { return e.cause }
+func (e AggregateResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentQueryResponseValidationError) Key() bool { return e.key }
+func (e AggregateResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentQueryResponseValidationError) ErrorName() string {
-	return "DocumentQueryResponseValidationError"
-}
+func (e AggregateResultValidationError) ErrorName() string { return "AggregateResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DocumentQueryResponseValidationError) Error() string {
+func (e AggregateResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1894,14 +4617,14 @@ func (e DocumentQueryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentQueryResponse.%s: %s%s",
+		"invalid %sAggregateResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentQueryResponseValidationError{}
+var _ error = AggregateResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1909,24 +4632,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentQueryResponseValidationError{}
+} = AggregateResultValidationError{}
 
-// Validate checks the field values on DocumentQueryStreamRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DocumentAggregateRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentQueryStreamRequest) Validate() error {
+func (m *DocumentAggregateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentQueryStreamRequest with the
+// ValidateAll checks the field values on DocumentAggregateRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentQueryStreamRequestMultiError, or nil if none found.
-func (m *DocumentQueryStreamRequest) ValidateAll() error {
+// DocumentAggregateRequestMultiError, or nil if none found.
+func (m *DocumentAggregateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentQueryStreamRequest) validate(all bool) error {
+func (m *DocumentAggregateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1934,7 +4657,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetCollection() == nil {
-		err := DocumentQueryStreamRequestValidationError{
+		err := DocumentAggregateRequestValidationError{
 			field:  "Collection",
 			reason: "value is required",
 		}
@@ -1948,7 +4671,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 		switch v := interface{}(m.GetCollection()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentQueryStreamRequestValidationError{
+				errors = append(errors, DocumentAggregateRequestValidationError{
 					field:  "Collection",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1956,7 +4679,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentQueryStreamRequestValidationError{
+				errors = append(errors, DocumentAggregateRequestValidationError{
 					field:  "Collection",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1965,7 +4688,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DocumentQueryStreamRequestValidationError{
+			return DocumentAggregateRequestValidationError{
 				field:  "Collection",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1980,7 +4703,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DocumentQueryStreamRequestValidationError{
+					errors = append(errors, DocumentAggregateRequestValidationError{
 						field:  fmt.Sprintf("Expressions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1988,7 +4711,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DocumentQueryStreamRequestValidationError{
+					errors = append(errors, DocumentAggregateRequestValidationError{
 						field:  fmt.Sprintf("Expressions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1997,7 +4720,7 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DocumentQueryStreamRequestValidationError{
+				return DocumentAggregateRequestValidationError{
 					field:  fmt.Sprintf("Expressions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2007,22 +4730,65 @@ func (m *DocumentQueryStreamRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for Limit
+	if len(m.GetAggregations()) < 1 {
+		err := DocumentAggregateRequestValidationError{
+			field:  "Aggregations",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAggregations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentAggregateRequestValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentAggregateRequestValidationError{
+						field:  fmt.Sprintf("Aggregations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentAggregateRequestValidationError{
+					field:  fmt.Sprintf("Aggregations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DocumentQueryStreamRequestMultiError(errors)
+		return DocumentAggregateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentQueryStreamRequestMultiError is an error wrapping multiple
-// validation errors returned by DocumentQueryStreamRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DocumentQueryStreamRequestMultiError []error
+// DocumentAggregateRequestMultiError is an error wrapping multiple validation
+// errors returned by DocumentAggregateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentAggregateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentQueryStreamRequestMultiError) Error() string {
+func (m DocumentAggregateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2031,11 +4797,11 @@ func (m DocumentQueryStreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentQueryStreamRequestMultiError) AllErrors() []error { return m }
+func (m DocumentAggregateRequestMultiError) AllErrors() []error { return m }
 
-// DocumentQueryStreamRequestValidationError is the validation error returned
-// by DocumentQueryStreamRequest.Validate if the designated constraints aren't met.
-type DocumentQueryStreamRequestValidationError struct {
+// DocumentAggregateRequestValidationError is the validation error returned by
+// DocumentAggregateRequest.Validate if the designated constraints aren't met.
+type DocumentAggregateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2043,24 +4809,24 @@ type DocumentQueryStreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentQueryStreamRequestValidationError) Field() string { return e.field }
+func (e DocumentAggregateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentQueryStreamRequestValidationError) Reason() string { return e.reason }
+func (e DocumentAggregateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentQueryStreamRequestValidationError) Cause() error { return e.cause }
+func (e DocumentAggregateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentQueryStreamRequestValidationError) Key() bool { return e.key }
+func (e DocumentAggregateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentQueryStreamRequestValidationError) ErrorName() string {
-	return "DocumentQueryStreamRequestValidationError"
+func (e DocumentAggregateRequestValidationError) ErrorName() string {
+	return "DocumentAggregateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DocumentQueryStreamRequestValidationError) Error() string {
+func (e DocumentAggregateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2072,14 +4838,14 @@ func (e DocumentQueryStreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentQueryStreamRequest.%s: %s%s",
+		"invalid %sDocumentAggregateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentQueryStreamRequestValidationError{}
+var _ error = DocumentAggregateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2087,73 +4853,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentQueryStreamRequestValidationError{}
+} = DocumentAggregateRequestValidationError{}
 
-// Validate checks the field values on DocumentQueryStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DocumentAggregateResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DocumentQueryStreamResponse) Validate() error {
+func (m *DocumentAggregateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DocumentQueryStreamResponse with the
+// ValidateAll checks the field values on DocumentAggregateResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DocumentQueryStreamResponseMultiError, or nil if none found.
-func (m *DocumentQueryStreamResponse) ValidateAll() error {
+// DocumentAggregateResponseMultiError, or nil if none found.
+func (m *DocumentAggregateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DocumentQueryStreamResponse) validate(all bool) error {
+func (m *DocumentAggregateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDocument()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DocumentQueryStreamResponseValidationError{
-					field:  "Document",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentAggregateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentAggregateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DocumentQueryStreamResponseValidationError{
-					field:  "Document",
+				return DocumentAggregateResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DocumentQueryStreamResponseValidationError{
-				field:  "Document",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return DocumentQueryStreamResponseMultiError(errors)
+		return DocumentAggregateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DocumentQueryStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by DocumentQueryStreamResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DocumentQueryStreamResponseMultiError []error
+// DocumentAggregateResponseMultiError is an error wrapping multiple validation
+// errors returned by DocumentAggregateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DocumentAggregateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DocumentQueryStreamResponseMultiError) Error() string {
+func (m DocumentAggregateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2162,12 +4933,11 @@ func (m DocumentQueryStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DocumentQueryStreamResponseMultiError) AllErrors() []error { return m }
+func (m DocumentAggregateResponseMultiError) AllErrors() []error { return m }
 
-// DocumentQueryStreamResponseValidationError is the validation error returned
-// by DocumentQueryStreamResponse.Validate if the designated constraints
-// aren't met.
-type DocumentQueryStreamResponseValidationError struct {
+// DocumentAggregateResponseValidationError is the validation error returned by
+// DocumentAggregateResponse.Validate if the designated constraints aren't met.
+type DocumentAggregateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2175,24 +4945,24 @@ type DocumentQueryStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DocumentQueryStreamResponseValidationError) Field() string { return e.field }
+func (e DocumentAggregateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DocumentQueryStreamResponseValidationError) Reason() string { return e.reason }
+func (e DocumentAggregateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DocumentQueryStreamResponseValidationError) Cause() error { return e.cause }
+func (e DocumentAggregateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DocumentQueryStreamResponseValidationError) Key() bool { return e.key }
+func (e DocumentAggregateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DocumentQueryStreamResponseValidationError) ErrorName() string {
-	return "DocumentQueryStreamResponseValidationError"
+func (e DocumentAggregateResponseValidationError) ErrorName() string {
+	return "DocumentAggregateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DocumentQueryStreamResponseValidationError) Error() string {
+func (e DocumentAggregateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2204,14 +4974,14 @@ func (e DocumentQueryStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDocumentQueryStreamResponse.%s: %s%s",
+		"invalid %sDocumentAggregateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DocumentQueryStreamResponseValidationError{}
+var _ error = DocumentAggregateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2219,4 +4989,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DocumentQueryStreamResponseValidationError{}
+} = DocumentAggregateResponseValidationError{}
@@ -28,10 +28,18 @@ type DocumentServiceClient interface {
 	Set(ctx context.Context, in *DocumentSetRequest, opts ...grpc.CallOption) (*DocumentSetResponse, error)
 	// Delete an existing document
 	Delete(ctx context.Context, in *DocumentDeleteRequest, opts ...grpc.CallOption) (*DocumentDeleteResponse, error)
+	// Create new or overwrite existing documents, in batches of the provider's limits
+	SetBatch(ctx context.Context, in *DocumentSetBatchRequest, opts ...grpc.CallOption) (*DocumentSetBatchResponse, error)
+	// Delete existing documents, in batches of the provider's limits
+	DeleteBatch(ctx context.Context, in *DocumentDeleteBatchRequest, opts ...grpc.CallOption) (*DocumentDeleteBatchResponse, error)
+	// Atomically add to an integer field of a document, creating the document and field if they don't exist
+	Increment(ctx context.Context, in *DocumentIncrementRequest, opts ...grpc.CallOption) (*DocumentIncrementResponse, error)
 	// Query the document collection (supports pagination)
 	Query(ctx context.Context, in *DocumentQueryRequest, opts ...grpc.CallOption) (*DocumentQueryResponse, error)
 	// Query the document collection (supports streaming)
 	QueryStream(ctx context.Context, in *DocumentQueryStreamRequest, opts ...grpc.CallOption) (DocumentService_QueryStreamClient, error)
+	// Aggregate the documents matching a query
+	Aggregate(ctx context.Context, in *DocumentAggregateRequest, opts ...grpc.CallOption) (*DocumentAggregateResponse, error)
 }
 
 type documentServiceClient struct {
@@ -69,6 +77,33 @@ func (c *documentServiceClient) Delete(ctx context.Context, in *DocumentDeleteRe
 	return out, nil
 }
 
+func (c *documentServiceClient) SetBatch(ctx context.Context, in *DocumentSetBatchRequest, opts ...grpc.CallOption) (*DocumentSetBatchResponse, error) {
+	out := new(DocumentSetBatchResponse)
+	err := c.cc.Invoke(ctx, "/nitric.document.v1.DocumentService/SetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) DeleteBatch(ctx context.Context, in *DocumentDeleteBatchRequest, opts ...grpc.CallOption) (*DocumentDeleteBatchResponse, error) {
+	out := new(DocumentDeleteBatchResponse)
+	err := c.cc.Invoke(ctx, "/nitric.document.v1.DocumentService/DeleteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) Increment(ctx context.Context, in *DocumentIncrementRequest, opts ...grpc.CallOption) (*DocumentIncrementResponse, error) {
+	out := new(DocumentIncrementResponse)
+	err := c.cc.Invoke(ctx, "/nitric.document.v1.DocumentService/Increment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) Query(ctx context.Context, in *DocumentQueryRequest, opts ...grpc.CallOption) (*DocumentQueryResponse, error) {
 	out := new(DocumentQueryResponse)
 	err := c.cc.Invoke(ctx, "/nitric.document.v1.DocumentService/Query", in, out, opts...)
@@ -110,6 +145,15 @@ func (x *documentServiceQueryStreamClient) Recv() (*DocumentQueryStreamResponse,
 	return m, nil
 }
 
+func (c *documentServiceClient) Aggregate(ctx context.Context, in *DocumentAggregateRequest, opts ...grpc.CallOption) (*DocumentAggregateResponse, error) {
+	out := new(DocumentAggregateResponse)
+	err := c.cc.Invoke(ctx, "/nitric.document.v1.DocumentService/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 // All implementations must embed UnimplementedDocumentServiceServer
 // for forward compatibility
@@ -120,10 +164,18 @@ type DocumentServiceServer interface {
 	Set(context.Context, *DocumentSetRequest) (*DocumentSetResponse, error)
 	// Delete an existing document
 	Delete(context.Context, *DocumentDeleteRequest) (*DocumentDeleteResponse, error)
+	// Create new or overwrite existing documents, in batches of the provider's limits
+	SetBatch(context.Context, *DocumentSetBatchRequest) (*DocumentSetBatchResponse, error)
+	// Delete existing documents, in batches of the provider's limits
+	DeleteBatch(context.Context, *DocumentDeleteBatchRequest) (*DocumentDeleteBatchResponse, error)
+	// Atomically add to an integer field of a document, creating the document and field if they don't exist
+	Increment(context.Context, *DocumentIncrementRequest) (*DocumentIncrementResponse, error)
 	// Query the document collection (supports pagination)
 	Query(context.Context, *DocumentQueryRequest) (*DocumentQueryResponse, error)
 	// Query the document collection (supports streaming)
 	QueryStream(*DocumentQueryStreamRequest, DocumentService_QueryStreamServer) error
+	// Aggregate the documents matching a query
+	Aggregate(context.Context, *DocumentAggregateRequest) (*DocumentAggregateResponse, error)
 	mustEmbedUnimplementedDocumentServiceServer()
 }
 
@@ -140,12 +192,24 @@ func (UnimplementedDocumentServiceServer) Set(context.Context, *DocumentSetReque
 func (UnimplementedDocumentServiceServer) Delete(context.Context, *DocumentDeleteRequest) (*DocumentDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedDocumentServiceServer) SetBatch(context.Context, *DocumentSetBatchRequest) (*DocumentSetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatch not implemented")
+}
+func (UnimplementedDocumentServiceServer) DeleteBatch(context.Context, *DocumentDeleteBatchRequest) (*DocumentDeleteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBatch not implemented")
+}
+func (UnimplementedDocumentServiceServer) Increment(context.Context, *DocumentIncrementRequest) (*DocumentIncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
 func (UnimplementedDocumentServiceServer) Query(context.Context, *DocumentQueryRequest) (*DocumentQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
 func (UnimplementedDocumentServiceServer) QueryStream(*DocumentQueryStreamRequest, DocumentService_QueryStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
 }
+func (UnimplementedDocumentServiceServer) Aggregate(context.Context, *DocumentAggregateRequest) (*DocumentAggregateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
 func (UnimplementedDocumentServiceServer) mustEmbedUnimplementedDocumentServiceServer() {}
 
 // UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +277,60 @@ func _DocumentService_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_SetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentSetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).SetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.document.v1.DocumentService/SetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).SetBatch(ctx, req.(*DocumentSetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_DeleteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentDeleteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).DeleteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.document.v1.DocumentService/DeleteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).DeleteBatch(ctx, req.(*DocumentDeleteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentIncrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.document.v1.DocumentService/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Increment(ctx, req.(*DocumentIncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DocumentQueryRequest)
 	if err := dec(in); err != nil {
@@ -252,6 +370,24 @@ func (x *documentServiceQueryStreamServer) Send(m *DocumentQueryStreamResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DocumentService_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentAggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.document.v1.DocumentService/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Aggregate(ctx, req.(*DocumentAggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,10 +407,26 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _DocumentService_Delete_Handler,
 		},
+		{
+			MethodName: "SetBatch",
+			Handler:    _DocumentService_SetBatch_Handler,
+		},
+		{
+			MethodName: "DeleteBatch",
+			Handler:    _DocumentService_DeleteBatch_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _DocumentService_Increment_Handler,
+		},
 		{
 			MethodName: "Query",
 			Handler:    _DocumentService_Query_Handler,
 		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _DocumentService_Aggregate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -195,6 +195,12 @@ func (m *ErrorDetails) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Retryable
+
+	// no validation rules for ProviderCode
+
+	// no validation rules for Resources
+
 	if len(errors) > 0 {
 		return ErrorDetailsMultiError(errors)
 	}
@@ -541,6 +541,274 @@ var _ interface {
 	ErrorName() string
 } = TopicListResponseValidationError{}
 
+// Validate checks the field values on TopicReplayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopicReplayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopicReplayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopicReplayRequestMultiError, or nil if none found.
+func (m *TopicReplayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopicReplayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTopic()) > 256 {
+		err := TopicReplayRequestValidationError{
+			field:  "Topic",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TopicReplayRequest_Topic_Pattern.MatchString(m.GetTopic()) {
+		err := TopicReplayRequestValidationError{
+			field:  "Topic",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFrom() == nil {
+		err := TopicReplayRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopicReplayRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopicReplayRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopicReplayRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TopicReplayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopicReplayRequestMultiError is an error wrapping multiple validation errors
+// returned by TopicReplayRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TopicReplayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopicReplayRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopicReplayRequestMultiError) AllErrors() []error { return m }
+
+// TopicReplayRequestValidationError is the validation error returned by
+// TopicReplayRequest.Validate if the designated constraints aren't met.
+type TopicReplayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopicReplayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopicReplayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopicReplayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopicReplayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopicReplayRequestValidationError) ErrorName() string {
+	return "TopicReplayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopicReplayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopicReplayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopicReplayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopicReplayRequestValidationError{}
+
+var _TopicReplayRequest_Topic_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on TopicReplayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopicReplayResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopicReplayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopicReplayResponseMultiError, or nil if none found.
+func (m *TopicReplayResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopicReplayResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TopicReplayResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopicReplayResponseMultiError is an error wrapping multiple validation
+// errors returned by TopicReplayResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TopicReplayResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopicReplayResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopicReplayResponseMultiError) AllErrors() []error { return m }
+
+// TopicReplayResponseValidationError is the validation error returned by
+// TopicReplayResponse.Validate if the designated constraints aren't met.
+type TopicReplayResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopicReplayResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopicReplayResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopicReplayResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopicReplayResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopicReplayResponseValidationError) ErrorName() string {
+	return "TopicReplayResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopicReplayResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopicReplayResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopicReplayResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopicReplayResponseValidationError{}
+
 // Validate checks the field values on NitricTopic with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -697,6 +965,8 @@ func (m *NitricEvent) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for OrderingKey
+
 	if len(errors) > 0 {
 		return NitricEventMultiError(errors)
 	}
@@ -112,6 +112,8 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 type TopicServiceClient interface {
 	// Return a list of existing topics in the provider environment
 	List(ctx context.Context, in *TopicListRequest, opts ...grpc.CallOption) (*TopicListResponse, error)
+	// Re-deliver events previously published to a topic within a time range
+	Replay(ctx context.Context, in *TopicReplayRequest, opts ...grpc.CallOption) (*TopicReplayResponse, error)
 }
 
 type topicServiceClient struct {
@@ -131,12 +133,23 @@ func (c *topicServiceClient) List(ctx context.Context, in *TopicListRequest, opt
 	return out, nil
 }
 
+func (c *topicServiceClient) Replay(ctx context.Context, in *TopicReplayRequest, opts ...grpc.CallOption) (*TopicReplayResponse, error) {
+	out := new(TopicReplayResponse)
+	err := c.cc.Invoke(ctx, "/nitric.event.v1.TopicService/Replay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TopicServiceServer is the server API for TopicService service.
 // All implementations must embed UnimplementedTopicServiceServer
 // for forward compatibility
 type TopicServiceServer interface {
 	// Return a list of existing topics in the provider environment
 	List(context.Context, *TopicListRequest) (*TopicListResponse, error)
+	// Re-deliver events previously published to a topic within a time range
+	Replay(context.Context, *TopicReplayRequest) (*TopicReplayResponse, error)
 	mustEmbedUnimplementedTopicServiceServer()
 }
 
@@ -147,6 +160,9 @@ type UnimplementedTopicServiceServer struct {
 func (UnimplementedTopicServiceServer) List(context.Context, *TopicListRequest) (*TopicListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedTopicServiceServer) Replay(context.Context, *TopicReplayRequest) (*TopicReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
 func (UnimplementedTopicServiceServer) mustEmbedUnimplementedTopicServiceServer() {}
 
 // UnsafeTopicServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -178,6 +194,24 @@ func _TopicService_List_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TopicService_Replay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopicServiceServer).Replay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.event.v1.TopicService/Replay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopicServiceServer).Replay(ctx, req.(*TopicReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TopicService_ServiceDesc is the grpc.ServiceDesc for TopicService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +223,10 @@ var TopicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _TopicService_List_Handler,
 		},
+		{
+			MethodName: "Replay",
+			Handler:    _TopicService_Replay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event/v1/event.proto",
@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: scaler/v1/externalscaler.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ScaledObjectRef with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScaledObjectRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScaledObjectRef with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScaledObjectRefMultiError, or nil if none found.
+func (m *ScaledObjectRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScaledObjectRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for ScalerMetadata
+
+	if len(errors) > 0 {
+		return ScaledObjectRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScaledObjectRefMultiError is an error wrapping multiple validation errors
+// returned by ScaledObjectRef.ValidateAll() if the designated constraints
+// aren't met.
+type ScaledObjectRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScaledObjectRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScaledObjectRefMultiError) AllErrors() []error { return m }
+
+// ScaledObjectRefValidationError is the validation error returned by
+// ScaledObjectRef.Validate if the designated constraints aren't met.
+type ScaledObjectRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScaledObjectRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScaledObjectRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScaledObjectRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScaledObjectRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScaledObjectRefValidationError) ErrorName() string { return "ScaledObjectRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScaledObjectRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScaledObjectRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScaledObjectRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScaledObjectRefValidationError{}
+
+// Validate checks the field values on IsActiveResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsActiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsActiveResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsActiveResponseMultiError, or nil if none found.
+func (m *IsActiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsActiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return IsActiveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsActiveResponseMultiError is an error wrapping multiple validation errors
+// returned by IsActiveResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IsActiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsActiveResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsActiveResponseMultiError) AllErrors() []error { return m }
+
+// IsActiveResponseValidationError is the validation error returned by
+// IsActiveResponse.Validate if the designated constraints aren't met.
+type IsActiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsActiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsActiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsActiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsActiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsActiveResponseValidationError) ErrorName() string { return "IsActiveResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsActiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsActiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsActiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsActiveResponseValidationError{}
+
+// Validate checks the field values on GetMetricSpecResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMetricSpecResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMetricSpecResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMetricSpecResponseMultiError, or nil if none found.
+func (m *GetMetricSpecResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMetricSpecResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMetricSpecs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMetricSpecResponseValidationError{
+						field:  fmt.Sprintf("MetricSpecs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMetricSpecResponseValidationError{
+						field:  fmt.Sprintf("MetricSpecs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMetricSpecResponseValidationError{
+					field:  fmt.Sprintf("MetricSpecs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMetricSpecResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMetricSpecResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMetricSpecResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMetricSpecResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMetricSpecResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMetricSpecResponseMultiError) AllErrors() []error { return m }
+
+// GetMetricSpecResponseValidationError is the validation error returned by
+// GetMetricSpecResponse.Validate if the designated constraints aren't met.
+type GetMetricSpecResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMetricSpecResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMetricSpecResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMetricSpecResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMetricSpecResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMetricSpecResponseValidationError) ErrorName() string {
+	return "GetMetricSpecResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMetricSpecResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMetricSpecResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMetricSpecResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMetricSpecResponseValidationError{}
+
+// Validate checks the field values on MetricSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricSpecMultiError, or
+// nil if none found.
+func (m *MetricSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MetricName
+
+	// no validation rules for TargetSize
+
+	if len(errors) > 0 {
+		return MetricSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricSpec.ValidateAll() if the designated constraints aren't met.
+type MetricSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricSpecMultiError) AllErrors() []error { return m }
+
+// MetricSpecValidationError is the validation error returned by
+// MetricSpec.Validate if the designated constraints aren't met.
+type MetricSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricSpecValidationError) ErrorName() string { return "MetricSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricSpecValidationError{}
+
+// Validate checks the field values on GetMetricsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMetricsRequestMultiError, or nil if none found.
+func (m *GetMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScaledObjectRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMetricsRequestValidationError{
+					field:  "ScaledObjectRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMetricsRequestValidationError{
+					field:  "ScaledObjectRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScaledObjectRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMetricsRequestValidationError{
+				field:  "ScaledObjectRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MetricName
+
+	if len(errors) > 0 {
+		return GetMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMetricsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMetricsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMetricsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMetricsRequestMultiError) AllErrors() []error { return m }
+
+// GetMetricsRequestValidationError is the validation error returned by
+// GetMetricsRequest.Validate if the designated constraints aren't met.
+type GetMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMetricsRequestValidationError) ErrorName() string {
+	return "GetMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMetricsRequestValidationError{}
+
+// Validate checks the field values on GetMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMetricsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMetricsResponseMultiError, or nil if none found.
+func (m *GetMetricsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMetricsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMetricValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMetricsResponseValidationError{
+						field:  fmt.Sprintf("MetricValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMetricsResponseValidationError{
+						field:  fmt.Sprintf("MetricValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMetricsResponseValidationError{
+					field:  fmt.Sprintf("MetricValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMetricsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMetricsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMetricsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMetricsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMetricsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMetricsResponseMultiError) AllErrors() []error { return m }
+
+// GetMetricsResponseValidationError is the validation error returned by
+// GetMetricsResponse.Validate if the designated constraints aren't met.
+type GetMetricsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMetricsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMetricsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMetricsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMetricsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMetricsResponseValidationError) ErrorName() string {
+	return "GetMetricsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMetricsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMetricsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMetricsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMetricsResponseValidationError{}
+
+// Validate checks the field values on MetricValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricValueMultiError, or
+// nil if none found.
+func (m *MetricValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MetricName
+
+	// no validation rules for MetricValue
+
+	if len(errors) > 0 {
+		return MetricValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricValueMultiError is an error wrapping multiple validation errors
+// returned by MetricValue.ValidateAll() if the designated constraints aren't met.
+type MetricValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricValueMultiError) AllErrors() []error { return m }
+
+// MetricValueValidationError is the validation error returned by
+// MetricValue.Validate if the designated constraints aren't met.
+type MetricValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricValueValidationError) ErrorName() string { return "MetricValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricValueValidationError{}
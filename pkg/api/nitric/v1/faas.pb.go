@@ -9,6 +9,10 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +24,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BucketNotificationType int32
+
+const (
+	// Items written to and deleted from the bucket
+	BucketNotificationType_All     BucketNotificationType = 0
+	BucketNotificationType_Created BucketNotificationType = 1
+	BucketNotificationType_Deleted BucketNotificationType = 2
+)
+
+// Enum value maps for BucketNotificationType.
+var (
+	BucketNotificationType_name = map[int32]string{
+		0: "All",
+		1: "Created",
+		2: "Deleted",
+	}
+	BucketNotificationType_value = map[string]int32{
+		"All":     0,
+		"Created": 1,
+		"Deleted": 2,
+	}
+)
+
+func (x BucketNotificationType) Enum() *BucketNotificationType {
+	p := new(BucketNotificationType)
+	*p = x
+	return p
+}
+
+func (x BucketNotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BucketNotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_faas_v1_faas_proto_enumTypes[0].Descriptor()
+}
+
+func (BucketNotificationType) Type() protoreflect.EnumType {
+	return &file_faas_v1_faas_proto_enumTypes[0]
+}
+
+func (x BucketNotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BucketNotificationType.Descriptor instead.
+func (BucketNotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{0}
+}
+
 // Messages the client is able to send to the server
 type ClientMessage struct {
 	state         protoimpl.MessageState
@@ -31,6 +85,8 @@ type ClientMessage struct {
 	// Types that are assignable to Content:
 	//	*ClientMessage_InitRequest
 	//	*ClientMessage_TriggerResponse
+	//	*ClientMessage_RuntimeRequest
+	//	*ClientMessage_ServerSentEvent
 	Content isClientMessage_Content `protobuf_oneof:"content"`
 }
 
@@ -94,6 +150,20 @@ func (x *ClientMessage) GetTriggerResponse() *TriggerResponse {
 	return nil
 }
 
+func (x *ClientMessage) GetRuntimeRequest() *RuntimeRequest {
+	if x, ok := x.GetContent().(*ClientMessage_RuntimeRequest); ok {
+		return x.RuntimeRequest
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetServerSentEvent() *ServerSentEvent {
+	if x, ok := x.GetContent().(*ClientMessage_ServerSentEvent); ok {
+		return x.ServerSentEvent
+	}
+	return nil
+}
+
 type isClientMessage_Content interface {
 	isClientMessage_Content()
 }
@@ -111,10 +181,27 @@ type ClientMessage_TriggerResponse struct {
 	TriggerResponse *TriggerResponse `protobuf:"bytes,3,opt,name=trigger_response,json=triggerResponse,proto3,oneof"`
 }
 
+type ClientMessage_RuntimeRequest struct {
+	// Client calling a runtime API method
+	// (e.g. storage, documents or secrets)
+	// the id is used to pair it with its response
+	RuntimeRequest *RuntimeRequest `protobuf:"bytes,4,opt,name=runtime_request,json=runtimeRequest,proto3,oneof"`
+}
+
+type ClientMessage_ServerSentEvent struct {
+	// Client sending an event on the event stream
+	// opened by its response to the trigger with this id
+	ServerSentEvent *ServerSentEvent `protobuf:"bytes,5,opt,name=server_sent_event,json=serverSentEvent,proto3,oneof"`
+}
+
 func (*ClientMessage_InitRequest) isClientMessage_Content() {}
 
 func (*ClientMessage_TriggerResponse) isClientMessage_Content() {}
 
+func (*ClientMessage_RuntimeRequest) isClientMessage_Content() {}
+
+func (*ClientMessage_ServerSentEvent) isClientMessage_Content() {}
+
 // Messages the server is able to send to the client
 type ServerMessage struct {
 	state         protoimpl.MessageState
@@ -126,6 +213,10 @@ type ServerMessage struct {
 	// Types that are assignable to Content:
 	//	*ServerMessage_InitResponse
 	//	*ServerMessage_TriggerRequest
+	//	*ServerMessage_RuntimeResponse
+	//	*ServerMessage_EventStreamClosed
+	//	*ServerMessage_DrainRequest
+	//	*ServerMessage_TriggerCancelled
 	Content isServerMessage_Content `protobuf_oneof:"content"`
 }
 
@@ -189,6 +280,34 @@ func (x *ServerMessage) GetTriggerRequest() *TriggerRequest {
 	return nil
 }
 
+func (x *ServerMessage) GetRuntimeResponse() *RuntimeResponse {
+	if x, ok := x.GetContent().(*ServerMessage_RuntimeResponse); ok {
+		return x.RuntimeResponse
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetEventStreamClosed() *EventStreamClosed {
+	if x, ok := x.GetContent().(*ServerMessage_EventStreamClosed); ok {
+		return x.EventStreamClosed
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetDrainRequest() *DrainRequest {
+	if x, ok := x.GetContent().(*ServerMessage_DrainRequest); ok {
+		return x.DrainRequest
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetTriggerCancelled() *TriggerCancelled {
+	if x, ok := x.GetContent().(*ServerMessage_TriggerCancelled); ok {
+		return x.TriggerCancelled
+	}
+	return nil
+}
+
 type isServerMessage_Content interface {
 	isServerMessage_Content()
 }
@@ -206,10 +325,43 @@ type ServerMessage_TriggerRequest struct {
 	TriggerRequest *TriggerRequest `protobuf:"bytes,3,opt,name=trigger_request,json=triggerRequest,proto3,oneof"`
 }
 
+type ServerMessage_RuntimeResponse struct {
+	// Server responding with the result
+	// of a runtime API call, with the id
+	// of the RuntimeRequest
+	RuntimeResponse *RuntimeResponse `protobuf:"bytes,4,opt,name=runtime_response,json=runtimeResponse,proto3,oneof"`
+}
+
+type ServerMessage_EventStreamClosed struct {
+	// Server closing the event stream opened for the trigger
+	// with this id, after its client has disconnected
+	EventStreamClosed *EventStreamClosed `protobuf:"bytes,5,opt,name=event_stream_closed,json=eventStreamClosed,proto3,oneof"`
+}
+
+type ServerMessage_DrainRequest struct {
+	// Server draining the client, after its traffic has
+	// moved to a newer generation of workers
+	DrainRequest *DrainRequest `protobuf:"bytes,6,opt,name=drain_request,json=drainRequest,proto3,oneof"`
+}
+
+type ServerMessage_TriggerCancelled struct {
+	// Server cancelling the trigger with this id,
+	// after it exceeded its deadline
+	TriggerCancelled *TriggerCancelled `protobuf:"bytes,7,opt,name=trigger_cancelled,json=triggerCancelled,proto3,oneof"`
+}
+
 func (*ServerMessage_InitResponse) isServerMessage_Content() {}
 
 func (*ServerMessage_TriggerRequest) isServerMessage_Content() {}
 
+func (*ServerMessage_RuntimeResponse) isServerMessage_Content() {}
+
+func (*ServerMessage_EventStreamClosed) isServerMessage_Content() {}
+
+func (*ServerMessage_DrainRequest) isServerMessage_Content() {}
+
+func (*ServerMessage_TriggerCancelled) isServerMessage_Content() {}
+
 type ApiWorkerScopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -531,6 +683,122 @@ func (*ScheduleWorker_Rate) isScheduleWorker_Cadence() {}
 
 func (*ScheduleWorker_Cron) isScheduleWorker_Cadence() {}
 
+// ConfigWorker - handles changes to the value of a configuration key.
+// Each change is sent as an event with a JSON payload of the key, its new value and version
+type ConfigWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ConfigWorker) Reset() {
+	*x = ConfigWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigWorker) ProtoMessage() {}
+
+func (x *ConfigWorker) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigWorker.ProtoReflect.Descriptor instead.
+func (*ConfigWorker) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfigWorker) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// BucketNotificationWorker - handles notifications of items written to or deleted from a bucket.
+// Each notification is sent as an event with a JSON payload of the bucket, the item's key and the
+// notification type, and the key of the item's transformed output when a bucket transform applies to it
+type BucketNotificationWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Type   BucketNotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=nitric.faas.v1.BucketNotificationType" json:"type,omitempty"`
+	// Only notifications for keys starting with the prefix are handled, every key if empty
+	KeyPrefix string `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+}
+
+func (x *BucketNotificationWorker) Reset() {
+	*x = BucketNotificationWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BucketNotificationWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketNotificationWorker) ProtoMessage() {}
+
+func (x *BucketNotificationWorker) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketNotificationWorker.ProtoReflect.Descriptor instead.
+func (*BucketNotificationWorker) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BucketNotificationWorker) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *BucketNotificationWorker) GetType() BucketNotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return BucketNotificationType_All
+}
+
+func (x *BucketNotificationWorker) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
 type ScheduleRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -542,7 +810,7 @@ type ScheduleRate struct {
 func (x *ScheduleRate) Reset() {
 	*x = ScheduleRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[7]
+		mi := &file_faas_v1_faas_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -555,7 +823,7 @@ func (x *ScheduleRate) String() string {
 func (*ScheduleRate) ProtoMessage() {}
 
 func (x *ScheduleRate) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[7]
+	mi := &file_faas_v1_faas_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +836,7 @@ func (x *ScheduleRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleRate.ProtoReflect.Descriptor instead.
 func (*ScheduleRate) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{7}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ScheduleRate) GetRate() string {
@@ -589,7 +857,7 @@ type ScheduleCron struct {
 func (x *ScheduleCron) Reset() {
 	*x = ScheduleCron{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[8]
+		mi := &file_faas_v1_faas_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +870,7 @@ func (x *ScheduleCron) String() string {
 func (*ScheduleCron) ProtoMessage() {}
 
 func (x *ScheduleCron) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[8]
+	mi := &file_faas_v1_faas_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +883,7 @@ func (x *ScheduleCron) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleCron.ProtoReflect.Descriptor instead.
 func (*ScheduleCron) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{8}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ScheduleCron) GetCron() string {
@@ -633,19 +901,29 @@ type InitRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The generation of the worker's deployment, e.g. its build or release.
+	// Traffic is progressively moved to workers of a new generation,
+	// then the workers of the previous generation are drained
+	Generation string `protobuf:"bytes,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// The encodings the worker can decompress trigger data with, e.g. zstd or gzip.
+	// Large trigger data is compressed with one of them, and the worker
+	// may compress its response data with any of them
+	AcceptEncodings []string `protobuf:"bytes,2,rep,name=accept_encodings,json=acceptEncodings,proto3" json:"accept_encodings,omitempty"`
 	// The type of worker we are registering
 	//
 	// Types that are assignable to Worker:
 	//	*InitRequest_Api
 	//	*InitRequest_Subscription
 	//	*InitRequest_Schedule
+	//	*InitRequest_BucketNotification
+	//	*InitRequest_Config
 	Worker isInitRequest_Worker `protobuf_oneof:"Worker"`
 }
 
 func (x *InitRequest) Reset() {
 	*x = InitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[9]
+		mi := &file_faas_v1_faas_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +936,7 @@ func (x *InitRequest) String() string {
 func (*InitRequest) ProtoMessage() {}
 
 func (x *InitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[9]
+	mi := &file_faas_v1_faas_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +949,21 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
 func (*InitRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{9}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InitRequest) GetGeneration() string {
+	if x != nil {
+		return x.Generation
+	}
+	return ""
+}
+
+func (x *InitRequest) GetAcceptEncodings() []string {
+	if x != nil {
+		return x.AcceptEncodings
+	}
+	return nil
 }
 
 func (m *InitRequest) GetWorker() isInitRequest_Worker {
@@ -702,6 +994,20 @@ func (x *InitRequest) GetSchedule() *ScheduleWorker {
 	return nil
 }
 
+func (x *InitRequest) GetBucketNotification() *BucketNotificationWorker {
+	if x, ok := x.GetWorker().(*InitRequest_BucketNotification); ok {
+		return x.BucketNotification
+	}
+	return nil
+}
+
+func (x *InitRequest) GetConfig() *ConfigWorker {
+	if x, ok := x.GetWorker().(*InitRequest_Config); ok {
+		return x.Config
+	}
+	return nil
+}
+
 type isInitRequest_Worker interface {
 	isInitRequest_Worker()
 }
@@ -718,12 +1024,24 @@ type InitRequest_Schedule struct {
 	Schedule *ScheduleWorker `protobuf:"bytes,12,opt,name=schedule,proto3,oneof"`
 }
 
+type InitRequest_BucketNotification struct {
+	BucketNotification *BucketNotificationWorker `protobuf:"bytes,13,opt,name=bucket_notification,json=bucketNotification,proto3,oneof"`
+}
+
+type InitRequest_Config struct {
+	Config *ConfigWorker `protobuf:"bytes,14,opt,name=config,proto3,oneof"`
+}
+
 func (*InitRequest_Api) isInitRequest_Worker() {}
 
 func (*InitRequest_Subscription) isInitRequest_Worker() {}
 
 func (*InitRequest_Schedule) isInitRequest_Worker() {}
 
+func (*InitRequest_BucketNotification) isInitRequest_Worker() {}
+
+func (*InitRequest_Config) isInitRequest_Worker() {}
+
 // Placeholder message
 type InitResponse struct {
 	state         protoimpl.MessageState
@@ -734,7 +1052,7 @@ type InitResponse struct {
 func (x *InitResponse) Reset() {
 	*x = InitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[10]
+		mi := &file_faas_v1_faas_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -747,7 +1065,7 @@ func (x *InitResponse) String() string {
 func (*InitResponse) ProtoMessage() {}
 
 func (x *InitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[10]
+	mi := &file_faas_v1_faas_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +1078,7 @@ func (x *InitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
 func (*InitResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{10}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{12}
 }
 
 // The server has a trigger for the client to handle
@@ -780,12 +1098,20 @@ type TriggerRequest struct {
 	//	*TriggerRequest_Http
 	//	*TriggerRequest_Topic
 	Context isTriggerRequest_Context `protobuf_oneof:"context"`
+	// When the trigger must be handled by, unset if there's no deadline.
+	// Work done after the deadline will be thrown away
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Where the trigger is being handled
+	Metadata *TriggerMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The encoding the data is compressed with, one of the worker's
+	// accept_encodings. Uncompressed if empty
+	DataEncoding string `protobuf:"bytes,7,opt,name=data_encoding,json=dataEncoding,proto3" json:"data_encoding,omitempty"`
 }
 
 func (x *TriggerRequest) Reset() {
 	*x = TriggerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[11]
+		mi := &file_faas_v1_faas_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -798,7 +1124,7 @@ func (x *TriggerRequest) String() string {
 func (*TriggerRequest) ProtoMessage() {}
 
 func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[11]
+	mi := &file_faas_v1_faas_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +1137,7 @@ func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{11}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TriggerRequest) GetData() []byte {
@@ -849,6 +1175,27 @@ func (x *TriggerRequest) GetTopic() *TopicTriggerContext {
 	return nil
 }
 
+func (x *TriggerRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetMetadata() *TriggerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetDataEncoding() string {
+	if x != nil {
+		return x.DataEncoding
+	}
+	return ""
+}
+
 type isTriggerRequest_Context interface {
 	isTriggerRequest_Context()
 }
@@ -865,31 +1212,47 @@ func (*TriggerRequest_Http) isTriggerRequest_Context() {}
 
 func (*TriggerRequest_Topic) isTriggerRequest_Context() {}
 
-type HeaderValue struct {
+// Metadata describing where a trigger is being handled
+type TriggerMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
-}
-
-func (x *HeaderValue) Reset() {
-	*x = HeaderValue{}
+	// The name of the stack handling the trigger, empty if it isn't set
+	Stack string `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	// The environment of the stack handling the trigger, e.g. dev, staging or prod. Empty if it isn't set
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// The provider resource the trigger was delivered from, e.g. a subscription,
+	// topic ARN or gateway. Empty if it isn't reported
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// The provider's ID for the delivery, e.g. a message or request ID.
+	// Redeliveries of the same message have the same ID. Empty if it isn't reported
+	ProviderId string `protobuf:"bytes,4,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// The delivery attempt of an event, starting at 1. Zero if it isn't reported
+	Attempt int32 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// When the trigger was published or received by the provider, unset if it isn't reported
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// The W3C trace context propagated with the trigger, keyed by traceparent and tracestate
+	TraceContext map[string]string `protobuf:"bytes,7,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TriggerMetadata) Reset() {
+	*x = TriggerMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[12]
+		mi := &file_faas_v1_faas_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeaderValue) String() string {
+func (x *TriggerMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeaderValue) ProtoMessage() {}
+func (*TriggerMetadata) ProtoMessage() {}
 
-func (x *HeaderValue) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[12]
+func (x *TriggerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,43 +1263,132 @@ func (x *HeaderValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeaderValue.ProtoReflect.Descriptor instead.
-func (*HeaderValue) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TriggerMetadata.ProtoReflect.Descriptor instead.
+func (*TriggerMetadata) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HeaderValue) GetValue() []string {
+func (x *TriggerMetadata) GetStack() string {
 	if x != nil {
-		return x.Value
+		return x.Stack
 	}
-	return nil
+	return ""
 }
 
-type QueryValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Value []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+func (x *TriggerMetadata) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
 }
 
-func (x *QueryValue) Reset() {
-	*x = QueryValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TriggerMetadata) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
+	return ""
 }
 
-func (x *QueryValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TriggerMetadata) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *TriggerMetadata) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *TriggerMetadata) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *TriggerMetadata) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+type HeaderValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HeaderValue) Reset() {
+	*x = HeaderValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderValue) ProtoMessage() {}
+
+func (x *HeaderValue) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderValue.ProtoReflect.Descriptor instead.
+func (*HeaderValue) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HeaderValue) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type QueryValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *QueryValue) Reset() {
+	*x = QueryValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*QueryValue) ProtoMessage() {}
 
 func (x *QueryValue) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[13]
+	mi := &file_faas_v1_faas_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1401,7 @@ func (x *QueryValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryValue.ProtoReflect.Descriptor instead.
 func (*QueryValue) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{13}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryValue) GetValue() []string {
@@ -984,12 +1436,15 @@ type HttpTriggerContext struct {
 	QueryParams map[string]*QueryValue `protobuf:"bytes,6,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// HTTP Path parameters
 	PathParams map[string]string `protobuf:"bytes,7,rep,name=path_params,json=pathParams,proto3" json:"path_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The GraphQL operation of a request to the gateway's GraphQL path,
+	// unset for other requests
+	Graphql *GraphqlOperation `protobuf:"bytes,8,opt,name=graphql,proto3" json:"graphql,omitempty"`
 }
 
 func (x *HttpTriggerContext) Reset() {
 	*x = HttpTriggerContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[14]
+		mi := &file_faas_v1_faas_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1002,7 +1457,7 @@ func (x *HttpTriggerContext) String() string {
 func (*HttpTriggerContext) ProtoMessage() {}
 
 func (x *HttpTriggerContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[14]
+	mi := &file_faas_v1_faas_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1470,7 @@ func (x *HttpTriggerContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpTriggerContext.ProtoReflect.Descriptor instead.
 func (*HttpTriggerContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{14}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HttpTriggerContext) GetMethod() string {
@@ -1069,6 +1524,82 @@ func (x *HttpTriggerContext) GetPathParams() map[string]string {
 	return nil
 }
 
+func (x *HttpTriggerContext) GetGraphql() *GraphqlOperation {
+	if x != nil {
+		return x.Graphql
+	}
+	return nil
+}
+
+// A GraphQL operation parsed from a request by the gateway, batched
+// operations are each sent as their own trigger
+type GraphqlOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The operation document, the persisted query is filled in
+	// for requests that only sent its hash
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The name of the operation to execute in the document
+	OperationName string `protobuf:"bytes,2,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
+	// The variables of the operation
+	Variables *structpb.Struct `protobuf:"bytes,3,opt,name=variables,proto3" json:"variables,omitempty"`
+}
+
+func (x *GraphqlOperation) Reset() {
+	*x = GraphqlOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphqlOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphqlOperation) ProtoMessage() {}
+
+func (x *GraphqlOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphqlOperation.ProtoReflect.Descriptor instead.
+func (*GraphqlOperation) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GraphqlOperation) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GraphqlOperation) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+func (x *GraphqlOperation) GetVariables() *structpb.Struct {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 type TopicTriggerContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1076,12 +1607,14 @@ type TopicTriggerContext struct {
 
 	// The topic the message was published for
 	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The ordering key the event was published with, if any
+	OrderingKey string `protobuf:"bytes,2,opt,name=ordering_key,json=orderingKey,proto3" json:"ordering_key,omitempty"`
 }
 
 func (x *TopicTriggerContext) Reset() {
 	*x = TopicTriggerContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[15]
+		mi := &file_faas_v1_faas_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1094,7 +1627,7 @@ func (x *TopicTriggerContext) String() string {
 func (*TopicTriggerContext) ProtoMessage() {}
 
 func (x *TopicTriggerContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[15]
+	mi := &file_faas_v1_faas_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1640,7 @@ func (x *TopicTriggerContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicTriggerContext.ProtoReflect.Descriptor instead.
 func (*TopicTriggerContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{15}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TopicTriggerContext) GetTopic() string {
@@ -1117,6 +1650,13 @@ func (x *TopicTriggerContext) GetTopic() string {
 	return ""
 }
 
+func (x *TopicTriggerContext) GetOrderingKey() string {
+	if x != nil {
+		return x.OrderingKey
+	}
+	return ""
+}
+
 // The worker has successfully processed a trigger
 type TriggerResponse struct {
 	state         protoimpl.MessageState
@@ -1125,6 +1665,9 @@ type TriggerResponse struct {
 
 	// The data returned in the response
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The encoding the data is compressed with, one of the worker's
+	// accept_encodings. Uncompressed if empty
+	DataEncoding string `protobuf:"bytes,2,opt,name=data_encoding,json=dataEncoding,proto3" json:"data_encoding,omitempty"`
 	// The context of the request response
 	// Typically this will be one to one with the Trigger Context
 	// i.e. if you receive http context you may return http context
@@ -1138,7 +1681,7 @@ type TriggerResponse struct {
 func (x *TriggerResponse) Reset() {
 	*x = TriggerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[16]
+		mi := &file_faas_v1_faas_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1148,10 +1691,384 @@ func (x *TriggerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerResponse) ProtoMessage() {}
+func (*TriggerResponse) ProtoMessage() {}
+
+func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerResponse.ProtoReflect.Descriptor instead.
+func (*TriggerResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TriggerResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetDataEncoding() string {
+	if x != nil {
+		return x.DataEncoding
+	}
+	return ""
+}
+
+func (m *TriggerResponse) GetContext() isTriggerResponse_Context {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetHttp() *HttpResponseContext {
+	if x, ok := x.GetContext().(*TriggerResponse_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetTopic() *TopicResponseContext {
+	if x, ok := x.GetContext().(*TriggerResponse_Topic); ok {
+		return x.Topic
+	}
+	return nil
+}
+
+type isTriggerResponse_Context interface {
+	isTriggerResponse_Context()
+}
+
+type TriggerResponse_Http struct {
+	// response to a http request
+	Http *HttpResponseContext `protobuf:"bytes,10,opt,name=http,proto3,oneof"`
+}
+
+type TriggerResponse_Topic struct {
+	// response to a topic trigger
+	Topic *TopicResponseContext `protobuf:"bytes,11,opt,name=topic,proto3,oneof"`
+}
+
+func (*TriggerResponse_Http) isTriggerResponse_Context() {}
+
+func (*TriggerResponse_Topic) isTriggerResponse_Context() {}
+
+// Specific HttpResponse message
+// Note this does not have to be handled by the
+// User at all but they will have the option of control
+// If they choose...
+type HttpResponseContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Old HTTP response headers (deprecated)
+	// TODO: Remove in 1.0
+	//
+	// Deprecated: Do not use.
+	HeadersOld map[string]string `protobuf:"bytes,1,rep,name=headers_old,json=headersOld,proto3" json:"headers_old,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The HTTP status of the request
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// HTTP response headers
+	Headers map[string]*HeaderValue `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The response is a text/event-stream, the connection is kept open
+	// and sent the ServerSentEvents that follow with the trigger's id,
+	// until one ends the stream. The response data is ignored
+	EventStream bool `protobuf:"varint,4,opt,name=event_stream,json=eventStream,proto3" json:"event_stream,omitempty"`
+}
+
+func (x *HttpResponseContext) Reset() {
+	*x = HttpResponseContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpResponseContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpResponseContext) ProtoMessage() {}
+
+func (x *HttpResponseContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpResponseContext.ProtoReflect.Descriptor instead.
+func (*HttpResponseContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{21}
+}
+
+// Deprecated: Do not use.
+func (x *HttpResponseContext) GetHeadersOld() map[string]string {
+	if x != nil {
+		return x.HeadersOld
+	}
+	return nil
+}
+
+func (x *HttpResponseContext) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *HttpResponseContext) GetHeaders() map[string]*HeaderValue {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpResponseContext) GetEventStream() bool {
+	if x != nil {
+		return x.EventStream
+	}
+	return false
+}
+
+// Specific event response message
+// We do not accept responses for events
+// only whether or not they were successfully processed
+type TopicResponseContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Success status of the handled event
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// How long to wait before redelivering an unsuccessful event.
+	// The provider's default delay is used if unset
+	RetryAfter *durationpb.Duration `protobuf:"bytes,2,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	// The unsuccessful event can never be processed, so it will be
+	// dead-lettered instead of redelivered
+	Permanent bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+}
+
+func (x *TopicResponseContext) Reset() {
+	*x = TopicResponseContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicResponseContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicResponseContext) ProtoMessage() {}
+
+func (x *TopicResponseContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicResponseContext.ProtoReflect.Descriptor instead.
+func (*TopicResponseContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TopicResponseContext) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TopicResponseContext) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *TopicResponseContext) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// An event sent to the client of an event stream response
+type ServerSentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id the client reconnects with in its Last-Event-ID header
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The event type, the client dispatches a message event if empty
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// The event data, sent as a data line for each of its lines
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// How long the client waits before reconnecting, unchanged if unset
+	Retry *durationpb.Duration `protobuf:"bytes,4,opt,name=retry,proto3" json:"retry,omitempty"`
+	// Ends the stream after this event is sent, the event
+	// is only used to end the stream if it has no other fields
+	End bool `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *ServerSentEvent) Reset() {
+	*x = ServerSentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSentEvent) ProtoMessage() {}
+
+func (x *ServerSentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSentEvent.ProtoReflect.Descriptor instead.
+func (*ServerSentEvent) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ServerSentEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerSentEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ServerSentEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ServerSentEvent) GetRetry() *durationpb.Duration {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *ServerSentEvent) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
+// The event stream's client has disconnected, its events are no longer sent
+type EventStreamClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EventStreamClosed) Reset() {
+	*x = EventStreamClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamClosed) ProtoMessage() {}
+
+func (x *EventStreamClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamClosed.ProtoReflect.Descriptor instead.
+func (*EventStreamClosed) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{24}
+}
+
+// The client won't receive any more triggers, it should finish
+// handling the triggers it has received and then close the stream
+type DrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
 
-func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[16]
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,94 +2079,84 @@ func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerResponse.ProtoReflect.Descriptor instead.
-func (*TriggerResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *TriggerResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{25}
 }
 
-func (m *TriggerResponse) GetContext() isTriggerResponse_Context {
-	if m != nil {
-		return m.Context
-	}
-	return nil
+// The trigger wasn't handled by its deadline and has failed,
+// the client should stop handling it, any response will be ignored
+type TriggerCancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *TriggerResponse) GetHttp() *HttpResponseContext {
-	if x, ok := x.GetContext().(*TriggerResponse_Http); ok {
-		return x.Http
+func (x *TriggerCancelled) Reset() {
+	*x = TriggerCancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *TriggerResponse) GetTopic() *TopicResponseContext {
-	if x, ok := x.GetContext().(*TriggerResponse_Topic); ok {
-		return x.Topic
-	}
-	return nil
+func (x *TriggerCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isTriggerResponse_Context interface {
-	isTriggerResponse_Context()
-}
+func (*TriggerCancelled) ProtoMessage() {}
 
-type TriggerResponse_Http struct {
-	// response to a http request
-	Http *HttpResponseContext `protobuf:"bytes,10,opt,name=http,proto3,oneof"`
+func (x *TriggerCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type TriggerResponse_Topic struct {
-	// response to a topic trigger
-	Topic *TopicResponseContext `protobuf:"bytes,11,opt,name=topic,proto3,oneof"`
+// Deprecated: Use TriggerCancelled.ProtoReflect.Descriptor instead.
+func (*TriggerCancelled) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{26}
 }
 
-func (*TriggerResponse_Http) isTriggerResponse_Context() {}
-
-func (*TriggerResponse_Topic) isTriggerResponse_Context() {}
-
-// Specific HttpResponse message
-// Note this does not have to be handled by the
-// User at all but they will have the option of control
-// If they choose...
-type HttpResponseContext struct {
+// A runtime API call made by a worker over its trigger stream,
+// saving SDKs from opening a separate connection to the membrane's services
+type RuntimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Old HTTP response headers (deprecated)
-	// TODO: Remove in 1.0
-	//
-	// Deprecated: Do not use.
-	HeadersOld map[string]string `protobuf:"bytes,1,rep,name=headers_old,json=headersOld,proto3" json:"headers_old,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The HTTP status of the request
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	// HTTP response headers
-	Headers map[string]*HeaderValue `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The full name of the unary method being called
+	// e.g. /nitric.storage.v1.StorageService/Read
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// The serialized request message for the method
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// gRPC metadata for the call, e.g. x-nitric-tenant
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *HttpResponseContext) Reset() {
-	*x = HttpResponseContext{}
+func (x *RuntimeRequest) Reset() {
+	*x = RuntimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[17]
+		mi := &file_faas_v1_faas_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HttpResponseContext) String() string {
+func (x *RuntimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpResponseContext) ProtoMessage() {}
+func (*RuntimeRequest) ProtoMessage() {}
 
-func (x *HttpResponseContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[17]
+func (x *RuntimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,62 +2167,66 @@ func (x *HttpResponseContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpResponseContext.ProtoReflect.Descriptor instead.
-func (*HttpResponseContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RuntimeRequest.ProtoReflect.Descriptor instead.
+func (*RuntimeRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{27}
 }
 
-// Deprecated: Do not use.
-func (x *HttpResponseContext) GetHeadersOld() map[string]string {
+func (x *RuntimeRequest) GetMethod() string {
 	if x != nil {
-		return x.HeadersOld
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *HttpResponseContext) GetStatus() int32 {
+func (x *RuntimeRequest) GetPayload() []byte {
 	if x != nil {
-		return x.Status
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *HttpResponseContext) GetHeaders() map[string]*HeaderValue {
+func (x *RuntimeRequest) GetMetadata() map[string]string {
 	if x != nil {
-		return x.Headers
+		return x.Metadata
 	}
 	return nil
 }
 
-// Specific event response message
-// We do not accept responses for events
-// only whether or not they were successfully processed
-type TopicResponseContext struct {
+// The result of a runtime API call
+type RuntimeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Success status of the handled event
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The serialized response message from the method,
+	// empty if the call failed
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The gRPC status code of the call, 0 if it succeeded
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Describes why the call failed
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Details of the failure, e.g. nitric.error.v1.ErrorDetails
+	Details []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 }
 
-func (x *TopicResponseContext) Reset() {
-	*x = TopicResponseContext{}
+func (x *RuntimeResponse) Reset() {
+	*x = RuntimeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[18]
+		mi := &file_faas_v1_faas_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopicResponseContext) String() string {
+func (x *RuntimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopicResponseContext) ProtoMessage() {}
+func (*RuntimeResponse) ProtoMessage() {}
 
-func (x *TopicResponseContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[18]
+func (x *RuntimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,16 +2237,37 @@ func (x *TopicResponseContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopicResponseContext.ProtoReflect.Descriptor instead.
-func (*TopicResponseContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RuntimeResponse.ProtoReflect.Descriptor instead.
+func (*RuntimeResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TopicResponseContext) GetSuccess() bool {
+func (x *RuntimeResponse) GetPayload() []byte {
 	if x != nil {
-		return x.Success
+		return x.Payload
 	}
-	return false
+	return nil
+}
+
+func (x *RuntimeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RuntimeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RuntimeResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
 }
 
 var File_faas_v1_faas_proto protoreflect.FileDescriptor
@@ -1343,106 +2275,201 @@ var File_faas_v1_faas_proto protoreflect.FileDescriptor
 var file_faas_v1_faas_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x66, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61,
-	0x73, 0x2e, 0x76, 0x31, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
-	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4,
+	0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xf3, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c,
+	0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
+	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x41,
+	0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x69, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x1a, 0x5c, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
+	0x70, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x12,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64,
+	0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x20, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x8d, 0x01, 0x0a, 0x18, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x22, 0xae, 0x03, 0x0a, 0x0b,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x29,
-	0x0a, 0x0f, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x10, 0x41, 0x70,
-	0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a,
-	0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x5c, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x4b, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a,
-	0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x32, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x61, 0x64,
-	0x65, 0x6e, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a,
-	0x0b, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03,
-	0x61, 0x70, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
-	0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x0e, 0x0a,
-	0x0c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01,
-	0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48,
-	0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x5b, 0x0a,
+	0x13, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x0e, 0x0a, 0x0c,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x02, 0x0a,
+	0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x38, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xf4, 0x02, 0x0a,
+	0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
 	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xed, 0x06, 0x0a,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa9, 0x07, 0x0a,
 	0x12, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
 	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
@@ -1474,57 +2501,76 @@ var file_faas_v1_faas_proto_rawDesc = []byte{
 	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67,
 	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
+	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a,
+	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x74,
+	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x5a, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
-	0x0f, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x13,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x39, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x22, 0x4e, 0x0a, 0x13, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65,
+	0x79, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x39,
+	0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x8e, 0x03, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3c, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x69,
-	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xeb, 0x02, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x58, 0x0a,
-	0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x4a, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x4f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x3d, 0x0a, 0x0f, 0x48,
 	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1534,23 +2580,66 @@ var file_faas_v1_faas_proto_rawDesc = []byte{
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69,
 	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
 	0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0x60, 0x0a, 0x0b, 0x46, 0x61, 0x61, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
-	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x63, 0x0a, 0x17, 0x69, 0x6f, 0x2e, 0x6e, 0x69,
-	0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x61, 0x73, 0x50, 0x01,
-	0x5a, 0x0c, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xaa, 0x02,
-	0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x5c, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x46, 0x61, 0x61, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x38, 0x01, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74,
+	0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2f,
+	0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x13, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x2a, 0x3b, 0x0a, 0x16, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x32,
+	0x60, 0x0a, 0x0b, 0x46, 0x61, 0x61, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51,
+	0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1d,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30,
+	0x01, 0x42, 0x63, 0x0a, 0x17, 0x69, 0x6f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x4e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x61, 0x73, 0x50, 0x01, 0x5a, 0x0c, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xaa, 0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0xca,
+	0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x5c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x46,
+	0x61, 0x61, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1565,70 +2654,107 @@ func file_faas_v1_faas_proto_rawDescGZIP() []byte {
 	return file_faas_v1_faas_proto_rawDescData
 }
 
-var file_faas_v1_faas_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_faas_v1_faas_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_faas_v1_faas_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_faas_v1_faas_proto_goTypes = []interface{}{
-	(*ClientMessage)(nil),        // 0: nitric.faas.v1.ClientMessage
-	(*ServerMessage)(nil),        // 1: nitric.faas.v1.ServerMessage
-	(*ApiWorkerScopes)(nil),      // 2: nitric.faas.v1.ApiWorkerScopes
-	(*ApiWorkerOptions)(nil),     // 3: nitric.faas.v1.ApiWorkerOptions
-	(*ApiWorker)(nil),            // 4: nitric.faas.v1.ApiWorker
-	(*SubscriptionWorker)(nil),   // 5: nitric.faas.v1.SubscriptionWorker
-	(*ScheduleWorker)(nil),       // 6: nitric.faas.v1.ScheduleWorker
-	(*ScheduleRate)(nil),         // 7: nitric.faas.v1.ScheduleRate
-	(*ScheduleCron)(nil),         // 8: nitric.faas.v1.ScheduleCron
-	(*InitRequest)(nil),          // 9: nitric.faas.v1.InitRequest
-	(*InitResponse)(nil),         // 10: nitric.faas.v1.InitResponse
-	(*TriggerRequest)(nil),       // 11: nitric.faas.v1.TriggerRequest
-	(*HeaderValue)(nil),          // 12: nitric.faas.v1.HeaderValue
-	(*QueryValue)(nil),           // 13: nitric.faas.v1.QueryValue
-	(*HttpTriggerContext)(nil),   // 14: nitric.faas.v1.HttpTriggerContext
-	(*TopicTriggerContext)(nil),  // 15: nitric.faas.v1.TopicTriggerContext
-	(*TriggerResponse)(nil),      // 16: nitric.faas.v1.TriggerResponse
-	(*HttpResponseContext)(nil),  // 17: nitric.faas.v1.HttpResponseContext
-	(*TopicResponseContext)(nil), // 18: nitric.faas.v1.TopicResponseContext
-	nil,                          // 19: nitric.faas.v1.ApiWorkerOptions.SecurityEntry
-	nil,                          // 20: nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
-	nil,                          // 21: nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
-	nil,                          // 22: nitric.faas.v1.HttpTriggerContext.HeadersEntry
-	nil,                          // 23: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
-	nil,                          // 24: nitric.faas.v1.HttpTriggerContext.PathParamsEntry
-	nil,                          // 25: nitric.faas.v1.HttpResponseContext.HeadersOldEntry
-	nil,                          // 26: nitric.faas.v1.HttpResponseContext.HeadersEntry
+	(BucketNotificationType)(0),      // 0: nitric.faas.v1.BucketNotificationType
+	(*ClientMessage)(nil),            // 1: nitric.faas.v1.ClientMessage
+	(*ServerMessage)(nil),            // 2: nitric.faas.v1.ServerMessage
+	(*ApiWorkerScopes)(nil),          // 3: nitric.faas.v1.ApiWorkerScopes
+	(*ApiWorkerOptions)(nil),         // 4: nitric.faas.v1.ApiWorkerOptions
+	(*ApiWorker)(nil),                // 5: nitric.faas.v1.ApiWorker
+	(*SubscriptionWorker)(nil),       // 6: nitric.faas.v1.SubscriptionWorker
+	(*ScheduleWorker)(nil),           // 7: nitric.faas.v1.ScheduleWorker
+	(*ConfigWorker)(nil),             // 8: nitric.faas.v1.ConfigWorker
+	(*BucketNotificationWorker)(nil), // 9: nitric.faas.v1.BucketNotificationWorker
+	(*ScheduleRate)(nil),             // 10: nitric.faas.v1.ScheduleRate
+	(*ScheduleCron)(nil),             // 11: nitric.faas.v1.ScheduleCron
+	(*InitRequest)(nil),              // 12: nitric.faas.v1.InitRequest
+	(*InitResponse)(nil),             // 13: nitric.faas.v1.InitResponse
+	(*TriggerRequest)(nil),           // 14: nitric.faas.v1.TriggerRequest
+	(*TriggerMetadata)(nil),          // 15: nitric.faas.v1.TriggerMetadata
+	(*HeaderValue)(nil),              // 16: nitric.faas.v1.HeaderValue
+	(*QueryValue)(nil),               // 17: nitric.faas.v1.QueryValue
+	(*HttpTriggerContext)(nil),       // 18: nitric.faas.v1.HttpTriggerContext
+	(*GraphqlOperation)(nil),         // 19: nitric.faas.v1.GraphqlOperation
+	(*TopicTriggerContext)(nil),      // 20: nitric.faas.v1.TopicTriggerContext
+	(*TriggerResponse)(nil),          // 21: nitric.faas.v1.TriggerResponse
+	(*HttpResponseContext)(nil),      // 22: nitric.faas.v1.HttpResponseContext
+	(*TopicResponseContext)(nil),     // 23: nitric.faas.v1.TopicResponseContext
+	(*ServerSentEvent)(nil),          // 24: nitric.faas.v1.ServerSentEvent
+	(*EventStreamClosed)(nil),        // 25: nitric.faas.v1.EventStreamClosed
+	(*DrainRequest)(nil),             // 26: nitric.faas.v1.DrainRequest
+	(*TriggerCancelled)(nil),         // 27: nitric.faas.v1.TriggerCancelled
+	(*RuntimeRequest)(nil),           // 28: nitric.faas.v1.RuntimeRequest
+	(*RuntimeResponse)(nil),          // 29: nitric.faas.v1.RuntimeResponse
+	nil,                              // 30: nitric.faas.v1.ApiWorkerOptions.SecurityEntry
+	nil,                              // 31: nitric.faas.v1.TriggerMetadata.TraceContextEntry
+	nil,                              // 32: nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
+	nil,                              // 33: nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
+	nil,                              // 34: nitric.faas.v1.HttpTriggerContext.HeadersEntry
+	nil,                              // 35: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
+	nil,                              // 36: nitric.faas.v1.HttpTriggerContext.PathParamsEntry
+	nil,                              // 37: nitric.faas.v1.HttpResponseContext.HeadersOldEntry
+	nil,                              // 38: nitric.faas.v1.HttpResponseContext.HeadersEntry
+	nil,                              // 39: nitric.faas.v1.RuntimeRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),    // 40: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),          // 41: google.protobuf.Struct
+	(*durationpb.Duration)(nil),      // 42: google.protobuf.Duration
+	(*anypb.Any)(nil),                // 43: google.protobuf.Any
 }
 var file_faas_v1_faas_proto_depIdxs = []int32{
-	9,  // 0: nitric.faas.v1.ClientMessage.init_request:type_name -> nitric.faas.v1.InitRequest
-	16, // 1: nitric.faas.v1.ClientMessage.trigger_response:type_name -> nitric.faas.v1.TriggerResponse
-	10, // 2: nitric.faas.v1.ServerMessage.init_response:type_name -> nitric.faas.v1.InitResponse
-	11, // 3: nitric.faas.v1.ServerMessage.trigger_request:type_name -> nitric.faas.v1.TriggerRequest
-	19, // 4: nitric.faas.v1.ApiWorkerOptions.security:type_name -> nitric.faas.v1.ApiWorkerOptions.SecurityEntry
-	3,  // 5: nitric.faas.v1.ApiWorker.options:type_name -> nitric.faas.v1.ApiWorkerOptions
-	7,  // 6: nitric.faas.v1.ScheduleWorker.rate:type_name -> nitric.faas.v1.ScheduleRate
-	8,  // 7: nitric.faas.v1.ScheduleWorker.cron:type_name -> nitric.faas.v1.ScheduleCron
-	4,  // 8: nitric.faas.v1.InitRequest.api:type_name -> nitric.faas.v1.ApiWorker
-	5,  // 9: nitric.faas.v1.InitRequest.subscription:type_name -> nitric.faas.v1.SubscriptionWorker
-	6,  // 10: nitric.faas.v1.InitRequest.schedule:type_name -> nitric.faas.v1.ScheduleWorker
-	14, // 11: nitric.faas.v1.TriggerRequest.http:type_name -> nitric.faas.v1.HttpTriggerContext
-	15, // 12: nitric.faas.v1.TriggerRequest.topic:type_name -> nitric.faas.v1.TopicTriggerContext
-	20, // 13: nitric.faas.v1.HttpTriggerContext.headers_old:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
-	21, // 14: nitric.faas.v1.HttpTriggerContext.query_params_old:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
-	22, // 15: nitric.faas.v1.HttpTriggerContext.headers:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersEntry
-	23, // 16: nitric.faas.v1.HttpTriggerContext.query_params:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
-	24, // 17: nitric.faas.v1.HttpTriggerContext.path_params:type_name -> nitric.faas.v1.HttpTriggerContext.PathParamsEntry
-	17, // 18: nitric.faas.v1.TriggerResponse.http:type_name -> nitric.faas.v1.HttpResponseContext
-	18, // 19: nitric.faas.v1.TriggerResponse.topic:type_name -> nitric.faas.v1.TopicResponseContext
-	25, // 20: nitric.faas.v1.HttpResponseContext.headers_old:type_name -> nitric.faas.v1.HttpResponseContext.HeadersOldEntry
-	26, // 21: nitric.faas.v1.HttpResponseContext.headers:type_name -> nitric.faas.v1.HttpResponseContext.HeadersEntry
-	2,  // 22: nitric.faas.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.faas.v1.ApiWorkerScopes
-	12, // 23: nitric.faas.v1.HttpTriggerContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
-	13, // 24: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry.value:type_name -> nitric.faas.v1.QueryValue
-	12, // 25: nitric.faas.v1.HttpResponseContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
-	0,  // 26: nitric.faas.v1.FaasService.TriggerStream:input_type -> nitric.faas.v1.ClientMessage
-	1,  // 27: nitric.faas.v1.FaasService.TriggerStream:output_type -> nitric.faas.v1.ServerMessage
-	27, // [27:28] is the sub-list for method output_type
-	26, // [26:27] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	12, // 0: nitric.faas.v1.ClientMessage.init_request:type_name -> nitric.faas.v1.InitRequest
+	21, // 1: nitric.faas.v1.ClientMessage.trigger_response:type_name -> nitric.faas.v1.TriggerResponse
+	28, // 2: nitric.faas.v1.ClientMessage.runtime_request:type_name -> nitric.faas.v1.RuntimeRequest
+	24, // 3: nitric.faas.v1.ClientMessage.server_sent_event:type_name -> nitric.faas.v1.ServerSentEvent
+	13, // 4: nitric.faas.v1.ServerMessage.init_response:type_name -> nitric.faas.v1.InitResponse
+	14, // 5: nitric.faas.v1.ServerMessage.trigger_request:type_name -> nitric.faas.v1.TriggerRequest
+	29, // 6: nitric.faas.v1.ServerMessage.runtime_response:type_name -> nitric.faas.v1.RuntimeResponse
+	25, // 7: nitric.faas.v1.ServerMessage.event_stream_closed:type_name -> nitric.faas.v1.EventStreamClosed
+	26, // 8: nitric.faas.v1.ServerMessage.drain_request:type_name -> nitric.faas.v1.DrainRequest
+	27, // 9: nitric.faas.v1.ServerMessage.trigger_cancelled:type_name -> nitric.faas.v1.TriggerCancelled
+	30, // 10: nitric.faas.v1.ApiWorkerOptions.security:type_name -> nitric.faas.v1.ApiWorkerOptions.SecurityEntry
+	4,  // 11: nitric.faas.v1.ApiWorker.options:type_name -> nitric.faas.v1.ApiWorkerOptions
+	10, // 12: nitric.faas.v1.ScheduleWorker.rate:type_name -> nitric.faas.v1.ScheduleRate
+	11, // 13: nitric.faas.v1.ScheduleWorker.cron:type_name -> nitric.faas.v1.ScheduleCron
+	0,  // 14: nitric.faas.v1.BucketNotificationWorker.type:type_name -> nitric.faas.v1.BucketNotificationType
+	5,  // 15: nitric.faas.v1.InitRequest.api:type_name -> nitric.faas.v1.ApiWorker
+	6,  // 16: nitric.faas.v1.InitRequest.subscription:type_name -> nitric.faas.v1.SubscriptionWorker
+	7,  // 17: nitric.faas.v1.InitRequest.schedule:type_name -> nitric.faas.v1.ScheduleWorker
+	9,  // 18: nitric.faas.v1.InitRequest.bucket_notification:type_name -> nitric.faas.v1.BucketNotificationWorker
+	8,  // 19: nitric.faas.v1.InitRequest.config:type_name -> nitric.faas.v1.ConfigWorker
+	18, // 20: nitric.faas.v1.TriggerRequest.http:type_name -> nitric.faas.v1.HttpTriggerContext
+	20, // 21: nitric.faas.v1.TriggerRequest.topic:type_name -> nitric.faas.v1.TopicTriggerContext
+	40, // 22: nitric.faas.v1.TriggerRequest.deadline:type_name -> google.protobuf.Timestamp
+	15, // 23: nitric.faas.v1.TriggerRequest.metadata:type_name -> nitric.faas.v1.TriggerMetadata
+	40, // 24: nitric.faas.v1.TriggerMetadata.publish_time:type_name -> google.protobuf.Timestamp
+	31, // 25: nitric.faas.v1.TriggerMetadata.trace_context:type_name -> nitric.faas.v1.TriggerMetadata.TraceContextEntry
+	32, // 26: nitric.faas.v1.HttpTriggerContext.headers_old:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
+	33, // 27: nitric.faas.v1.HttpTriggerContext.query_params_old:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
+	34, // 28: nitric.faas.v1.HttpTriggerContext.headers:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersEntry
+	35, // 29: nitric.faas.v1.HttpTriggerContext.query_params:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
+	36, // 30: nitric.faas.v1.HttpTriggerContext.path_params:type_name -> nitric.faas.v1.HttpTriggerContext.PathParamsEntry
+	19, // 31: nitric.faas.v1.HttpTriggerContext.graphql:type_name -> nitric.faas.v1.GraphqlOperation
+	41, // 32: nitric.faas.v1.GraphqlOperation.variables:type_name -> google.protobuf.Struct
+	22, // 33: nitric.faas.v1.TriggerResponse.http:type_name -> nitric.faas.v1.HttpResponseContext
+	23, // 34: nitric.faas.v1.TriggerResponse.topic:type_name -> nitric.faas.v1.TopicResponseContext
+	37, // 35: nitric.faas.v1.HttpResponseContext.headers_old:type_name -> nitric.faas.v1.HttpResponseContext.HeadersOldEntry
+	38, // 36: nitric.faas.v1.HttpResponseContext.headers:type_name -> nitric.faas.v1.HttpResponseContext.HeadersEntry
+	42, // 37: nitric.faas.v1.TopicResponseContext.retry_after:type_name -> google.protobuf.Duration
+	42, // 38: nitric.faas.v1.ServerSentEvent.retry:type_name -> google.protobuf.Duration
+	39, // 39: nitric.faas.v1.RuntimeRequest.metadata:type_name -> nitric.faas.v1.RuntimeRequest.MetadataEntry
+	43, // 40: nitric.faas.v1.RuntimeResponse.details:type_name -> google.protobuf.Any
+	3,  // 41: nitric.faas.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.faas.v1.ApiWorkerScopes
+	16, // 42: nitric.faas.v1.HttpTriggerContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
+	17, // 43: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry.value:type_name -> nitric.faas.v1.QueryValue
+	16, // 44: nitric.faas.v1.HttpResponseContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
+	1,  // 45: nitric.faas.v1.FaasService.TriggerStream:input_type -> nitric.faas.v1.ClientMessage
+	2,  // 46: nitric.faas.v1.FaasService.TriggerStream:output_type -> nitric.faas.v1.ServerMessage
+	46, // [46:47] is the sub-list for method output_type
+	45, // [45:46] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_faas_v1_faas_proto_init() }
@@ -1722,7 +2848,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleRate); i {
+			switch v := v.(*ConfigWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1734,7 +2860,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleCron); i {
+			switch v := v.(*BucketNotificationWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1746,7 +2872,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitRequest); i {
+			switch v := v.(*ScheduleRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1758,7 +2884,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitResponse); i {
+			switch v := v.(*ScheduleCron); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1770,7 +2896,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRequest); i {
+			switch v := v.(*InitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1782,7 +2908,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeaderValue); i {
+			switch v := v.(*InitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1794,7 +2920,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValue); i {
+			switch v := v.(*TriggerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1806,7 +2932,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpTriggerContext); i {
+			switch v := v.(*TriggerMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1818,7 +2944,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicTriggerContext); i {
+			switch v := v.(*HeaderValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1830,7 +2956,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerResponse); i {
+			switch v := v.(*QueryValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1842,7 +2968,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpResponseContext); i {
+			switch v := v.(*HttpTriggerContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1854,6 +2980,54 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphqlOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicTriggerContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpResponseContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicResponseContext); i {
 			case 0:
 				return &v.state
@@ -1865,29 +3039,109 @@ func file_faas_v1_faas_proto_init() {
 				return nil
 			}
 		}
+		file_faas_v1_faas_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSentEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerCancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_faas_v1_faas_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ClientMessage_InitRequest)(nil),
 		(*ClientMessage_TriggerResponse)(nil),
+		(*ClientMessage_RuntimeRequest)(nil),
+		(*ClientMessage_ServerSentEvent)(nil),
 	}
 	file_faas_v1_faas_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*ServerMessage_InitResponse)(nil),
 		(*ServerMessage_TriggerRequest)(nil),
+		(*ServerMessage_RuntimeResponse)(nil),
+		(*ServerMessage_EventStreamClosed)(nil),
+		(*ServerMessage_DrainRequest)(nil),
+		(*ServerMessage_TriggerCancelled)(nil),
 	}
 	file_faas_v1_faas_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*ScheduleWorker_Rate)(nil),
 		(*ScheduleWorker_Cron)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*InitRequest_Api)(nil),
 		(*InitRequest_Subscription)(nil),
 		(*InitRequest_Schedule)(nil),
+		(*InitRequest_BucketNotification)(nil),
+		(*InitRequest_Config)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*TriggerRequest_Http)(nil),
 		(*TriggerRequest_Topic)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*TriggerResponse_Http)(nil),
 		(*TriggerResponse_Topic)(nil),
 	}
@@ -1896,13 +3150,14 @@ func file_faas_v1_faas_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_faas_v1_faas_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
+			NumEnums:      1,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_faas_v1_faas_proto_goTypes,
 		DependencyIndexes: file_faas_v1_faas_proto_depIdxs,
+		EnumInfos:         file_faas_v1_faas_proto_enumTypes,
 		MessageInfos:      file_faas_v1_faas_proto_msgTypes,
 	}.Build()
 	File_faas_v1_faas_proto = out.File
@@ -123,6 +123,68 @@ func (m *ClientMessage) validate(all bool) error {
 			}
 		}
 
+	case *ClientMessage_RuntimeRequest:
+
+		if all {
+			switch v := interface{}(m.GetRuntimeRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "RuntimeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "RuntimeRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRuntimeRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "RuntimeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_ServerSentEvent:
+
+		if all {
+			switch v := interface{}(m.GetServerSentEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ServerSentEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ServerSentEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetServerSentEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "ServerSentEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -291,6 +353,130 @@ func (m *ServerMessage) validate(all bool) error {
 			}
 		}
 
+	case *ServerMessage_RuntimeResponse:
+
+		if all {
+			switch v := interface{}(m.GetRuntimeResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "RuntimeResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "RuntimeResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRuntimeResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "RuntimeResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_EventStreamClosed:
+
+		if all {
+			switch v := interface{}(m.GetEventStreamClosed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "EventStreamClosed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "EventStreamClosed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEventStreamClosed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "EventStreamClosed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_DrainRequest:
+
+		if all {
+			switch v := interface{}(m.GetDrainRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "DrainRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "DrainRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDrainRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "DrainRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_TriggerCancelled:
+
+		if all {
+			switch v := interface{}(m.GetTriggerCancelled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "TriggerCancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "TriggerCancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTriggerCancelled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "TriggerCancelled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -1025,43 +1211,43 @@ var _ interface {
 	ErrorName() string
 } = ScheduleWorkerValidationError{}
 
-// Validate checks the field values on ScheduleRate with the rules defined in
+// Validate checks the field values on ConfigWorker with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ScheduleRate) Validate() error {
+func (m *ConfigWorker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScheduleRate with the rules defined
+// ValidateAll checks the field values on ConfigWorker with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleRateMultiError, or
+// result is a list of violation errors wrapped in ConfigWorkerMultiError, or
 // nil if none found.
-func (m *ScheduleRate) ValidateAll() error {
+func (m *ConfigWorker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScheduleRate) validate(all bool) error {
+func (m *ConfigWorker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Rate
+	// no validation rules for Key
 
 	if len(errors) > 0 {
-		return ScheduleRateMultiError(errors)
+		return ConfigWorkerMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScheduleRateMultiError is an error wrapping multiple validation errors
-// returned by ScheduleRate.ValidateAll() if the designated constraints aren't met.
-type ScheduleRateMultiError []error
+// ConfigWorkerMultiError is an error wrapping multiple validation errors
+// returned by ConfigWorker.ValidateAll() if the designated constraints aren't met.
+type ConfigWorkerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleRateMultiError) Error() string {
+func (m ConfigWorkerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1070,11 +1256,11 @@ func (m ScheduleRateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleRateMultiError) AllErrors() []error { return m }
+func (m ConfigWorkerMultiError) AllErrors() []error { return m }
 
-// ScheduleRateValidationError is the validation error returned by
-// ScheduleRate.Validate if the designated constraints aren't met.
-type ScheduleRateValidationError struct {
+// ConfigWorkerValidationError is the validation error returned by
+// ConfigWorker.Validate if the designated constraints aren't met.
+type ConfigWorkerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1082,22 +1268,22 @@ type ScheduleRateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleRateValidationError) Field() string { return e.field }
+func (e ConfigWorkerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleRateValidationError) Reason() string { return e.reason }
+func (e ConfigWorkerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleRateValidationError) Cause() error { return e.cause }
+func (e ConfigWorkerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleRateValidationError) Key() bool { return e.key }
+func (e ConfigWorkerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleRateValidationError) ErrorName() string { return "ScheduleRateValidationError" }
+func (e ConfigWorkerValidationError) ErrorName() string { return "ConfigWorkerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ScheduleRateValidationError) Error() string {
+func (e ConfigWorkerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1109,14 +1295,14 @@ func (e ScheduleRateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScheduleRate.%s: %s%s",
+		"invalid %sConfigWorker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleRateValidationError{}
+var _ error = ConfigWorkerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1124,45 +1310,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleRateValidationError{}
+} = ConfigWorkerValidationError{}
 
-// Validate checks the field values on ScheduleCron with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ScheduleCron) Validate() error {
+// Validate checks the field values on BucketNotificationWorker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BucketNotificationWorker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScheduleCron with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleCronMultiError, or
-// nil if none found.
-func (m *ScheduleCron) ValidateAll() error {
+// ValidateAll checks the field values on BucketNotificationWorker with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BucketNotificationWorkerMultiError, or nil if none found.
+func (m *BucketNotificationWorker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScheduleCron) validate(all bool) error {
+func (m *BucketNotificationWorker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cron
+	// no validation rules for Bucket
+
+	// no validation rules for Type
+
+	// no validation rules for KeyPrefix
 
 	if len(errors) > 0 {
-		return ScheduleCronMultiError(errors)
+		return BucketNotificationWorkerMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScheduleCronMultiError is an error wrapping multiple validation errors
-// returned by ScheduleCron.ValidateAll() if the designated constraints aren't met.
-type ScheduleCronMultiError []error
+// BucketNotificationWorkerMultiError is an error wrapping multiple validation
+// errors returned by BucketNotificationWorker.ValidateAll() if the designated
+// constraints aren't met.
+type BucketNotificationWorkerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleCronMultiError) Error() string {
+func (m BucketNotificationWorkerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1171,11 +1362,11 @@ func (m ScheduleCronMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleCronMultiError) AllErrors() []error { return m }
+func (m BucketNotificationWorkerMultiError) AllErrors() []error { return m }
 
-// ScheduleCronValidationError is the validation error returned by
-// ScheduleCron.Validate if the designated constraints aren't met.
-type ScheduleCronValidationError struct {
+// BucketNotificationWorkerValidationError is the validation error returned by
+// BucketNotificationWorker.Validate if the designated constraints aren't met.
+type BucketNotificationWorkerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1183,22 +1374,24 @@ type ScheduleCronValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleCronValidationError) Field() string { return e.field }
+func (e BucketNotificationWorkerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleCronValidationError) Reason() string { return e.reason }
+func (e BucketNotificationWorkerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleCronValidationError) Cause() error { return e.cause }
+func (e BucketNotificationWorkerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleCronValidationError) Key() bool { return e.key }
+func (e BucketNotificationWorkerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleCronValidationError) ErrorName() string { return "ScheduleCronValidationError" }
+func (e BucketNotificationWorkerValidationError) ErrorName() string {
+	return "BucketNotificationWorkerValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ScheduleCronValidationError) Error() string {
+func (e BucketNotificationWorkerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1210,14 +1403,14 @@ func (e ScheduleCronValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScheduleCron.%s: %s%s",
+		"invalid %sBucketNotificationWorker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleCronValidationError{}
+var _ error = BucketNotificationWorkerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1225,140 +1418,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleCronValidationError{}
+} = BucketNotificationWorkerValidationError{}
 
-// Validate checks the field values on InitRequest with the rules defined in
+// Validate checks the field values on ScheduleRate with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *InitRequest) Validate() error {
+func (m *ScheduleRate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InitRequestMultiError, or
+// ValidateAll checks the field values on ScheduleRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleRateMultiError, or
 // nil if none found.
-func (m *InitRequest) ValidateAll() error {
+func (m *ScheduleRate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitRequest) validate(all bool) error {
+func (m *ScheduleRate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch m.Worker.(type) {
+	// no validation rules for Rate
 
-	case *InitRequest_Api:
-
-		if all {
-			switch v := interface{}(m.GetApi()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Api",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Api",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetApi()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Api",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *InitRequest_Subscription:
-
-		if all {
-			switch v := interface{}(m.GetSubscription()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Subscription",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Subscription",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Subscription",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *InitRequest_Schedule:
-
-		if all {
-			switch v := interface{}(m.GetSchedule()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Schedule",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Schedule",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Schedule",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return InitRequestMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return ScheduleRateMultiError(errors)
+	}
 
 	return nil
 }
 
-// InitRequestMultiError is an error wrapping multiple validation errors
-// returned by InitRequest.ValidateAll() if the designated constraints aren't met.
-type InitRequestMultiError []error
+// ScheduleRateMultiError is an error wrapping multiple validation errors
+// returned by ScheduleRate.ValidateAll() if the designated constraints aren't met.
+type ScheduleRateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitRequestMultiError) Error() string {
+func (m ScheduleRateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1367,11 +1465,11 @@ func (m InitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitRequestMultiError) AllErrors() []error { return m }
+func (m ScheduleRateMultiError) AllErrors() []error { return m }
 
-// InitRequestValidationError is the validation error returned by
-// InitRequest.Validate if the designated constraints aren't met.
-type InitRequestValidationError struct {
+// ScheduleRateValidationError is the validation error returned by
+// ScheduleRate.Validate if the designated constraints aren't met.
+type ScheduleRateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1379,22 +1477,22 @@ type InitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitRequestValidationError) Field() string { return e.field }
+func (e ScheduleRateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitRequestValidationError) Reason() string { return e.reason }
+func (e ScheduleRateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitRequestValidationError) Cause() error { return e.cause }
+func (e ScheduleRateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitRequestValidationError) Key() bool { return e.key }
+func (e ScheduleRateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitRequestValidationError) ErrorName() string { return "InitRequestValidationError" }
+func (e ScheduleRateValidationError) ErrorName() string { return "ScheduleRateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitRequestValidationError) Error() string {
+func (e ScheduleRateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1406,14 +1504,14 @@ func (e InitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitRequest.%s: %s%s",
+		"invalid %sScheduleRate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitRequestValidationError{}
+var _ error = ScheduleRateValidationError{}
 
 var _ interface {
 	Field() string
@@ -1421,43 +1519,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitRequestValidationError{}
+} = ScheduleRateValidationError{}
 
-// Validate checks the field values on InitResponse with the rules defined in
+// Validate checks the field values on ScheduleCron with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *InitResponse) Validate() error {
+func (m *ScheduleCron) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitResponse with the rules defined
+// ValidateAll checks the field values on ScheduleCron with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InitResponseMultiError, or
+// result is a list of violation errors wrapped in ScheduleCronMultiError, or
 // nil if none found.
-func (m *InitResponse) ValidateAll() error {
+func (m *ScheduleCron) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitResponse) validate(all bool) error {
+func (m *ScheduleCron) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Cron
+
 	if len(errors) > 0 {
-		return InitResponseMultiError(errors)
+		return ScheduleCronMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitResponseMultiError is an error wrapping multiple validation errors
-// returned by InitResponse.ValidateAll() if the designated constraints aren't met.
-type InitResponseMultiError []error
+// ScheduleCronMultiError is an error wrapping multiple validation errors
+// returned by ScheduleCron.ValidateAll() if the designated constraints aren't met.
+type ScheduleCronMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitResponseMultiError) Error() string {
+func (m ScheduleCronMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1466,11 +1566,11 @@ func (m InitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitResponseMultiError) AllErrors() []error { return m }
+func (m ScheduleCronMultiError) AllErrors() []error { return m }
 
-// InitResponseValidationError is the validation error returned by
-// InitResponse.Validate if the designated constraints aren't met.
-type InitResponseValidationError struct {
+// ScheduleCronValidationError is the validation error returned by
+// ScheduleCron.Validate if the designated constraints aren't met.
+type ScheduleCronValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1478,22 +1578,22 @@ type InitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitResponseValidationError) Field() string { return e.field }
+func (e ScheduleCronValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitResponseValidationError) Reason() string { return e.reason }
+func (e ScheduleCronValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitResponseValidationError) Cause() error { return e.cause }
+func (e ScheduleCronValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitResponseValidationError) Key() bool { return e.key }
+func (e ScheduleCronValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitResponseValidationError) ErrorName() string { return "InitResponseValidationError" }
+func (e ScheduleCronValidationError) ErrorName() string { return "ScheduleCronValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitResponseValidationError) Error() string {
+func (e ScheduleCronValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1505,14 +1605,14 @@ func (e InitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitResponse.%s: %s%s",
+		"invalid %sScheduleCron.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitResponseValidationError{}
+var _ error = ScheduleCronValidationError{}
 
 var _ interface {
 	Field() string
@@ -1520,114 +1620,204 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitResponseValidationError{}
+} = ScheduleCronValidationError{}
 
-// Validate checks the field values on TriggerRequest with the rules defined in
+// Validate checks the field values on InitRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TriggerRequest) Validate() error {
+func (m *InitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TriggerRequestMultiError,
-// or nil if none found.
-func (m *TriggerRequest) ValidateAll() error {
+// ValidateAll checks the field values on InitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InitRequestMultiError, or
+// nil if none found.
+func (m *InitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRequest) validate(all bool) error {
+func (m *InitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
-
-	// no validation rules for MimeType
+	// no validation rules for Generation
 
-	switch m.Context.(type) {
+	switch m.Worker.(type) {
 
-	case *TriggerRequest_Http:
+	case *InitRequest_Api:
 
 		if all {
-			switch v := interface{}(m.GetHttp()).(type) {
+			switch v := interface{}(m.GetApi()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Http",
+					errors = append(errors, InitRequestValidationError{
+						field:  "Api",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Http",
+					errors = append(errors, InitRequestValidationError{
+						field:  "Api",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetApi()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TriggerRequestValidationError{
-					field:  "Http",
+				return InitRequestValidationError{
+					field:  "Api",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *TriggerRequest_Topic:
+	case *InitRequest_Subscription:
 
 		if all {
-			switch v := interface{}(m.GetTopic()).(type) {
+			switch v := interface{}(m.GetSubscription()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Topic",
+					errors = append(errors, InitRequestValidationError{
+						field:  "Subscription",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Topic",
+					errors = append(errors, InitRequestValidationError{
+						field:  "Subscription",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TriggerRequestValidationError{
-					field:  "Topic",
+				return InitRequestValidationError{
+					field:  "Subscription",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	}
-
-	if len(errors) > 0 {
-		return TriggerRequestMultiError(errors)
-	}
+	case *InitRequest_Schedule:
 
-	return nil
-}
+		if all {
+			switch v := interface{}(m.GetSchedule()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// TriggerRequestMultiError is an error wrapping multiple validation errors
-// returned by TriggerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TriggerRequestMultiError []error
+	case *InitRequest_BucketNotification:
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRequestMultiError) Error() string {
+		if all {
+			switch v := interface{}(m.GetBucketNotification()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "BucketNotification",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "BucketNotification",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBucketNotification()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "BucketNotification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InitRequest_Config:
+
+		if all {
+			switch v := interface{}(m.GetConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitRequestMultiError is an error wrapping multiple validation errors
+// returned by InitRequest.ValidateAll() if the designated constraints aren't met.
+type InitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1636,11 +1826,11 @@ func (m TriggerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRequestMultiError) AllErrors() []error { return m }
+func (m InitRequestMultiError) AllErrors() []error { return m }
 
-// TriggerRequestValidationError is the validation error returned by
-// TriggerRequest.Validate if the designated constraints aren't met.
-type TriggerRequestValidationError struct {
+// InitRequestValidationError is the validation error returned by
+// InitRequest.Validate if the designated constraints aren't met.
+type InitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1648,22 +1838,22 @@ type TriggerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRequestValidationError) Field() string { return e.field }
+func (e InitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRequestValidationError) Reason() string { return e.reason }
+func (e InitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRequestValidationError) Cause() error { return e.cause }
+func (e InitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRequestValidationError) Key() bool { return e.key }
+func (e InitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRequestValidationError) ErrorName() string { return "TriggerRequestValidationError" }
+func (e InitRequestValidationError) ErrorName() string { return "InitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerRequestValidationError) Error() string {
+func (e InitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1675,14 +1865,14 @@ func (e TriggerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRequest.%s: %s%s",
+		"invalid %sInitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRequestValidationError{}
+var _ error = InitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1690,43 +1880,1541 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRequestValidationError{}
+} = InitRequestValidationError{}
 
-// Validate checks the field values on HeaderValue with the rules defined in
+// Validate checks the field values on InitResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *HeaderValue) Validate() error {
+func (m *InitResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HeaderValue with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in HeaderValueMultiError, or
+// ValidateAll checks the field values on InitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InitResponseMultiError, or
 // nil if none found.
-func (m *HeaderValue) ValidateAll() error {
+func (m *InitResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HeaderValue) validate(all bool) error {
+func (m *InitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitResponseMultiError is an error wrapping multiple validation errors
+// returned by InitResponse.ValidateAll() if the designated constraints aren't met.
+type InitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitResponseMultiError) AllErrors() []error { return m }
+
+// InitResponseValidationError is the validation error returned by
+// InitResponse.Validate if the designated constraints aren't met.
+type InitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitResponseValidationError) ErrorName() string { return "InitResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitResponseValidationError{}
+
+// Validate checks the field values on TriggerRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TriggerRequestMultiError,
+// or nil if none found.
+func (m *TriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for MimeType
+
+	if all {
+		switch v := interface{}(m.GetDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRequestValidationError{
+				field:  "Deadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DataEncoding
+
+	switch m.Context.(type) {
+
+	case *TriggerRequest_Http:
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerRequest_Topic:
+
+		if all {
+			switch v := interface{}(m.GetTopic()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Topic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerRequestMultiError) AllErrors() []error { return m }
+
+// TriggerRequestValidationError is the validation error returned by
+// TriggerRequest.Validate if the designated constraints aren't met.
+type TriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerRequestValidationError) ErrorName() string { return "TriggerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerRequestValidationError{}
+
+// Validate checks the field values on TriggerMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerMetadataMultiError, or nil if none found.
+func (m *TriggerMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Stack
+
+	// no validation rules for Environment
+
+	// no validation rules for Source
+
+	// no validation rules for ProviderId
+
+	// no validation rules for Attempt
+
+	if all {
+		switch v := interface{}(m.GetPublishTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerMetadataValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerMetadataValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerMetadataValidationError{
+				field:  "PublishTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TraceContext
+
+	if len(errors) > 0 {
+		return TriggerMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerMetadataMultiError is an error wrapping multiple validation errors
+// returned by TriggerMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerMetadataMultiError) AllErrors() []error { return m }
+
+// TriggerMetadataValidationError is the validation error returned by
+// TriggerMetadata.Validate if the designated constraints aren't met.
+type TriggerMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerMetadataValidationError) ErrorName() string { return "TriggerMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TriggerMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerMetadataValidationError{}
+
+// Validate checks the field values on HeaderValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeaderValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderValueMultiError, or
+// nil if none found.
+func (m *HeaderValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeaderValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return HeaderValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeaderValueMultiError is an error wrapping multiple validation errors
+// returned by HeaderValue.ValidateAll() if the designated constraints aren't met.
+type HeaderValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeaderValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeaderValueMultiError) AllErrors() []error { return m }
+
+// HeaderValueValidationError is the validation error returned by
+// HeaderValue.Validate if the designated constraints aren't met.
+type HeaderValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeaderValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeaderValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeaderValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeaderValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeaderValueValidationError) ErrorName() string { return "HeaderValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeaderValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaderValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeaderValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeaderValueValidationError{}
+
+// Validate checks the field values on QueryValue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryValueMultiError, or
+// nil if none found.
+func (m *QueryValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return QueryValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryValueMultiError is an error wrapping multiple validation errors
+// returned by QueryValue.ValidateAll() if the designated constraints aren't met.
+type QueryValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryValueMultiError) AllErrors() []error { return m }
+
+// QueryValueValidationError is the validation error returned by
+// QueryValue.Validate if the designated constraints aren't met.
+type QueryValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryValueValidationError) ErrorName() string { return "QueryValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryValueValidationError{}
+
+// Validate checks the field values on HttpTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HttpTriggerContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpTriggerContextMultiError, or nil if none found.
+func (m *HttpTriggerContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpTriggerContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Path
+
+	// no validation rules for HeadersOld
+
+	// no validation rules for QueryParamsOld
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpTriggerContextValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetQueryParams()))
+		i := 0
+		for key := range m.GetQueryParams() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetQueryParams()[key]
+			_ = val
+
+			// no validation rules for QueryParams[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("QueryParams[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("QueryParams[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpTriggerContextValidationError{
+						field:  fmt.Sprintf("QueryParams[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for PathParams
+
+	if all {
+		switch v := interface{}(m.GetGraphql()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Graphql",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Graphql",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGraphql()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpTriggerContextValidationError{
+				field:  "Graphql",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HttpTriggerContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpTriggerContextMultiError is an error wrapping multiple validation errors
+// returned by HttpTriggerContext.ValidateAll() if the designated constraints
+// aren't met.
+type HttpTriggerContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpTriggerContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpTriggerContextMultiError) AllErrors() []error { return m }
+
+// HttpTriggerContextValidationError is the validation error returned by
+// HttpTriggerContext.Validate if the designated constraints aren't met.
+type HttpTriggerContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpTriggerContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpTriggerContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpTriggerContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpTriggerContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpTriggerContextValidationError) ErrorName() string {
+	return "HttpTriggerContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HttpTriggerContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpTriggerContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpTriggerContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpTriggerContextValidationError{}
+
+// Validate checks the field values on GraphqlOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GraphqlOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GraphqlOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GraphqlOperationMultiError, or nil if none found.
+func (m *GraphqlOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GraphqlOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for OperationName
+
+	if all {
+		switch v := interface{}(m.GetVariables()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GraphqlOperationValidationError{
+					field:  "Variables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GraphqlOperationValidationError{
+					field:  "Variables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVariables()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GraphqlOperationValidationError{
+				field:  "Variables",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GraphqlOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GraphqlOperationMultiError is an error wrapping multiple validation errors
+// returned by GraphqlOperation.ValidateAll() if the designated constraints
+// aren't met.
+type GraphqlOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GraphqlOperationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GraphqlOperationMultiError) AllErrors() []error { return m }
+
+// GraphqlOperationValidationError is the validation error returned by
+// GraphqlOperation.Validate if the designated constraints aren't met.
+type GraphqlOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GraphqlOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GraphqlOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GraphqlOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GraphqlOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GraphqlOperationValidationError) ErrorName() string { return "GraphqlOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GraphqlOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGraphqlOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GraphqlOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GraphqlOperationValidationError{}
+
+// Validate checks the field values on TopicTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopicTriggerContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopicTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopicTriggerContextMultiError, or nil if none found.
+func (m *TopicTriggerContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopicTriggerContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for OrderingKey
+
+	if len(errors) > 0 {
+		return TopicTriggerContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopicTriggerContextMultiError is an error wrapping multiple validation
+// errors returned by TopicTriggerContext.ValidateAll() if the designated
+// constraints aren't met.
+type TopicTriggerContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopicTriggerContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopicTriggerContextMultiError) AllErrors() []error { return m }
+
+// TopicTriggerContextValidationError is the validation error returned by
+// TopicTriggerContext.Validate if the designated constraints aren't met.
+type TopicTriggerContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopicTriggerContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopicTriggerContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopicTriggerContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopicTriggerContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopicTriggerContextValidationError) ErrorName() string {
+	return "TopicTriggerContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopicTriggerContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopicTriggerContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopicTriggerContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopicTriggerContextValidationError{}
+
+// Validate checks the field values on TriggerResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerResponseMultiError, or nil if none found.
+func (m *TriggerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for DataEncoding
+
+	switch m.Context.(type) {
+
+	case *TriggerResponse_Http:
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerResponseValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerResponse_Topic:
+
+		if all {
+			switch v := interface{}(m.GetTopic()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerResponseValidationError{
+					field:  "Topic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerResponseMultiError is an error wrapping multiple validation errors
+// returned by TriggerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerResponseMultiError) AllErrors() []error { return m }
+
+// TriggerResponseValidationError is the validation error returned by
+// TriggerResponse.Validate if the designated constraints aren't met.
+type TriggerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerResponseValidationError) ErrorName() string { return "TriggerResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TriggerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerResponseValidationError{}
+
+// Validate checks the field values on HttpResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HttpResponseContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpResponseContextMultiError, or nil if none found.
+func (m *HttpResponseContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpResponseContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HeadersOld
+
+	// no validation rules for Status
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpResponseContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpResponseContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpResponseContextValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for EventStream
+
+	if len(errors) > 0 {
+		return HttpResponseContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpResponseContextMultiError is an error wrapping multiple validation
+// errors returned by HttpResponseContext.ValidateAll() if the designated
+// constraints aren't met.
+type HttpResponseContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpResponseContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpResponseContextMultiError) AllErrors() []error { return m }
+
+// HttpResponseContextValidationError is the validation error returned by
+// HttpResponseContext.Validate if the designated constraints aren't met.
+type HttpResponseContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpResponseContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpResponseContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpResponseContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpResponseContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpResponseContextValidationError) ErrorName() string {
+	return "HttpResponseContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HttpResponseContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpResponseContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpResponseContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpResponseContextValidationError{}
+
+// Validate checks the field values on TopicResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopicResponseContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopicResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopicResponseContextMultiError, or nil if none found.
+func (m *TopicResponseContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopicResponseContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetRetryAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopicResponseContextValidationError{
+					field:  "RetryAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopicResponseContextValidationError{
+					field:  "RetryAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopicResponseContextValidationError{
+				field:  "RetryAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Permanent
 
 	if len(errors) > 0 {
-		return HeaderValueMultiError(errors)
+		return TopicResponseContextMultiError(errors)
 	}
 
 	return nil
 }
 
-// HeaderValueMultiError is an error wrapping multiple validation errors
-// returned by HeaderValue.ValidateAll() if the designated constraints aren't met.
-type HeaderValueMultiError []error
+// TopicResponseContextMultiError is an error wrapping multiple validation
+// errors returned by TopicResponseContext.ValidateAll() if the designated
+// constraints aren't met.
+type TopicResponseContextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HeaderValueMultiError) Error() string {
+func (m TopicResponseContextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1735,11 +3423,11 @@ func (m HeaderValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HeaderValueMultiError) AllErrors() []error { return m }
+func (m TopicResponseContextMultiError) AllErrors() []error { return m }
 
-// HeaderValueValidationError is the validation error returned by
-// HeaderValue.Validate if the designated constraints aren't met.
-type HeaderValueValidationError struct {
+// TopicResponseContextValidationError is the validation error returned by
+// TopicResponseContext.Validate if the designated constraints aren't met.
+type TopicResponseContextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1747,22 +3435,24 @@ type HeaderValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e HeaderValueValidationError) Field() string { return e.field }
+func (e TopicResponseContextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HeaderValueValidationError) Reason() string { return e.reason }
+func (e TopicResponseContextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HeaderValueValidationError) Cause() error { return e.cause }
+func (e TopicResponseContextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HeaderValueValidationError) Key() bool { return e.key }
+func (e TopicResponseContextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HeaderValueValidationError) ErrorName() string { return "HeaderValueValidationError" }
+func (e TopicResponseContextValidationError) ErrorName() string {
+	return "TopicResponseContextValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e HeaderValueValidationError) Error() string {
+func (e TopicResponseContextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1774,14 +3464,14 @@ func (e HeaderValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHeaderValue.%s: %s%s",
+		"invalid %sTopicResponseContext.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HeaderValueValidationError{}
+var _ error = TopicResponseContextValidationError{}
 
 var _ interface {
 	Field() string
@@ -1789,43 +3479,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HeaderValueValidationError{}
+} = TopicResponseContextValidationError{}
 
-// Validate checks the field values on QueryValue with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *QueryValue) Validate() error {
+// Validate checks the field values on ServerSentEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ServerSentEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryValue with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in QueryValueMultiError, or
-// nil if none found.
-func (m *QueryValue) ValidateAll() error {
+// ValidateAll checks the field values on ServerSentEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServerSentEventMultiError, or nil if none found.
+func (m *ServerSentEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryValue) validate(all bool) error {
+func (m *ServerSentEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Event
+
+	// no validation rules for Data
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerSentEventValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerSentEventValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerSentEventValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for End
+
 	if len(errors) > 0 {
-		return QueryValueMultiError(errors)
+		return ServerSentEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryValueMultiError is an error wrapping multiple validation errors
-// returned by QueryValue.ValidateAll() if the designated constraints aren't met.
-type QueryValueMultiError []error
+// ServerSentEventMultiError is an error wrapping multiple validation errors
+// returned by ServerSentEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ServerSentEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryValueMultiError) Error() string {
+func (m ServerSentEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1834,11 +3562,11 @@ func (m QueryValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryValueMultiError) AllErrors() []error { return m }
+func (m ServerSentEventMultiError) AllErrors() []error { return m }
 
-// QueryValueValidationError is the validation error returned by
-// QueryValue.Validate if the designated constraints aren't met.
-type QueryValueValidationError struct {
+// ServerSentEventValidationError is the validation error returned by
+// ServerSentEvent.Validate if the designated constraints aren't met.
+type ServerSentEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1846,22 +3574,22 @@ type QueryValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryValueValidationError) Field() string { return e.field }
+func (e ServerSentEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryValueValidationError) Reason() string { return e.reason }
+func (e ServerSentEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryValueValidationError) Cause() error { return e.cause }
+func (e ServerSentEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryValueValidationError) Key() bool { return e.key }
+func (e ServerSentEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryValueValidationError) ErrorName() string { return "QueryValueValidationError" }
+func (e ServerSentEventValidationError) ErrorName() string { return "ServerSentEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryValueValidationError) Error() string {
+func (e ServerSentEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1873,14 +3601,14 @@ func (e QueryValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryValue.%s: %s%s",
+		"invalid %sServerSentEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryValueValidationError{}
+var _ error = ServerSentEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1888,146 +3616,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryValueValidationError{}
+} = ServerSentEventValidationError{}
 
-// Validate checks the field values on HttpTriggerContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *HttpTriggerContext) Validate() error {
+// Validate checks the field values on EventStreamClosed with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventStreamClosed) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HttpTriggerContext with the rules
+// ValidateAll checks the field values on EventStreamClosed with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// HttpTriggerContextMultiError, or nil if none found.
-func (m *HttpTriggerContext) ValidateAll() error {
+// EventStreamClosedMultiError, or nil if none found.
+func (m *EventStreamClosed) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HttpTriggerContext) validate(all bool) error {
+func (m *EventStreamClosed) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Method
-
-	// no validation rules for Path
-
-	// no validation rules for HeadersOld
-
-	// no validation rules for QueryParamsOld
-
-	{
-		sorted_keys := make([]string, len(m.GetHeaders()))
-		i := 0
-		for key := range m.GetHeaders() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetHeaders()[key]
-			_ = val
-
-			// no validation rules for Headers[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return HttpTriggerContextValidationError{
-						field:  fmt.Sprintf("Headers[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	{
-		sorted_keys := make([]string, len(m.GetQueryParams()))
-		i := 0
-		for key := range m.GetQueryParams() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetQueryParams()[key]
-			_ = val
-
-			// no validation rules for QueryParams[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("QueryParams[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("QueryParams[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return HttpTriggerContextValidationError{
-						field:  fmt.Sprintf("QueryParams[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	// no validation rules for PathParams
-
 	if len(errors) > 0 {
-		return HttpTriggerContextMultiError(errors)
+		return EventStreamClosedMultiError(errors)
 	}
 
 	return nil
 }
 
-// HttpTriggerContextMultiError is an error wrapping multiple validation errors
-// returned by HttpTriggerContext.ValidateAll() if the designated constraints
+// EventStreamClosedMultiError is an error wrapping multiple validation errors
+// returned by EventStreamClosed.ValidateAll() if the designated constraints
 // aren't met.
-type HttpTriggerContextMultiError []error
+type EventStreamClosedMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HttpTriggerContextMultiError) Error() string {
+func (m EventStreamClosedMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2036,11 +3662,11 @@ func (m HttpTriggerContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HttpTriggerContextMultiError) AllErrors() []error { return m }
+func (m EventStreamClosedMultiError) AllErrors() []error { return m }
 
-// HttpTriggerContextValidationError is the validation error returned by
-// HttpTriggerContext.Validate if the designated constraints aren't met.
-type HttpTriggerContextValidationError struct {
+// EventStreamClosedValidationError is the validation error returned by
+// EventStreamClosed.Validate if the designated constraints aren't met.
+type EventStreamClosedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2048,24 +3674,24 @@ type HttpTriggerContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e HttpTriggerContextValidationError) Field() string { return e.field }
+func (e EventStreamClosedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HttpTriggerContextValidationError) Reason() string { return e.reason }
+func (e EventStreamClosedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HttpTriggerContextValidationError) Cause() error { return e.cause }
+func (e EventStreamClosedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HttpTriggerContextValidationError) Key() bool { return e.key }
+func (e EventStreamClosedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HttpTriggerContextValidationError) ErrorName() string {
-	return "HttpTriggerContextValidationError"
+func (e EventStreamClosedValidationError) ErrorName() string {
+	return "EventStreamClosedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e HttpTriggerContextValidationError) Error() string {
+func (e EventStreamClosedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2077,14 +3703,14 @@ func (e HttpTriggerContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHttpTriggerContext.%s: %s%s",
+		"invalid %sEventStreamClosed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HttpTriggerContextValidationError{}
+var _ error = EventStreamClosedValidationError{}
 
 var _ interface {
 	Field() string
@@ -2092,46 +3718,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HttpTriggerContextValidationError{}
+} = EventStreamClosedValidationError{}
 
-// Validate checks the field values on TopicTriggerContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TopicTriggerContext) Validate() error {
+// Validate checks the field values on DrainRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DrainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TopicTriggerContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TopicTriggerContextMultiError, or nil if none found.
-func (m *TopicTriggerContext) ValidateAll() error {
+// ValidateAll checks the field values on DrainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DrainRequestMultiError, or
+// nil if none found.
+func (m *DrainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TopicTriggerContext) validate(all bool) error {
+func (m *DrainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Topic
-
 	if len(errors) > 0 {
-		return TopicTriggerContextMultiError(errors)
+		return DrainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TopicTriggerContextMultiError is an error wrapping multiple validation
-// errors returned by TopicTriggerContext.ValidateAll() if the designated
-// constraints aren't met.
-type TopicTriggerContextMultiError []error
+// DrainRequestMultiError is an error wrapping multiple validation errors
+// returned by DrainRequest.ValidateAll() if the designated constraints aren't met.
+type DrainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TopicTriggerContextMultiError) Error() string {
+func (m DrainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2140,11 +3763,11 @@ func (m TopicTriggerContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TopicTriggerContextMultiError) AllErrors() []error { return m }
+func (m DrainRequestMultiError) AllErrors() []error { return m }
 
-// TopicTriggerContextValidationError is the validation error returned by
-// TopicTriggerContext.Validate if the designated constraints aren't met.
-type TopicTriggerContextValidationError struct {
+// DrainRequestValidationError is the validation error returned by
+// DrainRequest.Validate if the designated constraints aren't met.
+type DrainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2152,24 +3775,22 @@ type TopicTriggerContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e TopicTriggerContextValidationError) Field() string { return e.field }
+func (e DrainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TopicTriggerContextValidationError) Reason() string { return e.reason }
+func (e DrainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TopicTriggerContextValidationError) Cause() error { return e.cause }
+func (e DrainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TopicTriggerContextValidationError) Key() bool { return e.key }
+func (e DrainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TopicTriggerContextValidationError) ErrorName() string {
-	return "TopicTriggerContextValidationError"
-}
+func (e DrainRequestValidationError) ErrorName() string { return "DrainRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TopicTriggerContextValidationError) Error() string {
+func (e DrainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2181,14 +3802,14 @@ func (e TopicTriggerContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTopicTriggerContext.%s: %s%s",
+		"invalid %sDrainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TopicTriggerContextValidationError{}
+var _ error = DrainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2196,112 +3817,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TopicTriggerContextValidationError{}
+} = DrainRequestValidationError{}
 
-// Validate checks the field values on TriggerResponse with the rules defined
+// Validate checks the field values on TriggerCancelled with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *TriggerResponse) Validate() error {
+func (m *TriggerCancelled) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerResponse with the rules
+// ValidateAll checks the field values on TriggerCancelled with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerResponseMultiError, or nil if none found.
-func (m *TriggerResponse) ValidateAll() error {
+// TriggerCancelledMultiError, or nil if none found.
+func (m *TriggerCancelled) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerResponse) validate(all bool) error {
+func (m *TriggerCancelled) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
-
-	switch m.Context.(type) {
-
-	case *TriggerResponse_Http:
-
-		if all {
-			switch v := interface{}(m.GetHttp()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerResponseValidationError{
-						field:  "Http",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerResponseValidationError{
-						field:  "Http",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TriggerResponseValidationError{
-					field:  "Http",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *TriggerResponse_Topic:
-
-		if all {
-			switch v := interface{}(m.GetTopic()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerResponseValidationError{
-						field:  "Topic",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerResponseValidationError{
-						field:  "Topic",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TriggerResponseValidationError{
-					field:  "Topic",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return TriggerResponseMultiError(errors)
+		return TriggerCancelledMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerResponseMultiError is an error wrapping multiple validation errors
-// returned by TriggerResponse.ValidateAll() if the designated constraints
+// TriggerCancelledMultiError is an error wrapping multiple validation errors
+// returned by TriggerCancelled.ValidateAll() if the designated constraints
 // aren't met.
-type TriggerResponseMultiError []error
+type TriggerCancelledMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerResponseMultiError) Error() string {
+func (m TriggerCancelledMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2310,11 +3863,11 @@ func (m TriggerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerResponseMultiError) AllErrors() []error { return m }
+func (m TriggerCancelledMultiError) AllErrors() []error { return m }
 
-// TriggerResponseValidationError is the validation error returned by
-// TriggerResponse.Validate if the designated constraints aren't met.
-type TriggerResponseValidationError struct {
+// TriggerCancelledValidationError is the validation error returned by
+// TriggerCancelled.Validate if the designated constraints aren't met.
+type TriggerCancelledValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2322,22 +3875,22 @@ type TriggerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerResponseValidationError) Field() string { return e.field }
+func (e TriggerCancelledValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerResponseValidationError) Reason() string { return e.reason }
+func (e TriggerCancelledValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerResponseValidationError) Cause() error { return e.cause }
+func (e TriggerCancelledValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerResponseValidationError) Key() bool { return e.key }
+func (e TriggerCancelledValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerResponseValidationError) ErrorName() string { return "TriggerResponseValidationError" }
+func (e TriggerCancelledValidationError) ErrorName() string { return "TriggerCancelledValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerResponseValidationError) Error() string {
+func (e TriggerCancelledValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2349,14 +3902,14 @@ func (e TriggerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerResponse.%s: %s%s",
+		"invalid %sTriggerCancelled.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerResponseValidationError{}
+var _ error = TriggerCancelledValidationError{}
 
 var _ interface {
 	Field() string
@@ -2364,94 +3917,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerResponseValidationError{}
+} = TriggerCancelledValidationError{}
 
-// Validate checks the field values on HttpResponseContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *HttpResponseContext) Validate() error {
+// Validate checks the field values on RuntimeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuntimeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HttpResponseContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// HttpResponseContextMultiError, or nil if none found.
-func (m *HttpResponseContext) ValidateAll() error {
+// ValidateAll checks the field values on RuntimeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuntimeRequestMultiError,
+// or nil if none found.
+func (m *RuntimeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HttpResponseContext) validate(all bool) error {
+func (m *RuntimeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HeadersOld
-
-	// no validation rules for Status
-
-	{
-		sorted_keys := make([]string, len(m.GetHeaders()))
-		i := 0
-		for key := range m.GetHeaders() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetHeaders()[key]
-			_ = val
-
-			// no validation rules for Headers[key]
+	// no validation rules for Method
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, HttpResponseContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, HttpResponseContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return HttpResponseContextValidationError{
-						field:  fmt.Sprintf("Headers[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Payload
 
-		}
-	}
+	// no validation rules for Metadata
 
 	if len(errors) > 0 {
-		return HttpResponseContextMultiError(errors)
+		return RuntimeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// HttpResponseContextMultiError is an error wrapping multiple validation
-// errors returned by HttpResponseContext.ValidateAll() if the designated
-// constraints aren't met.
-type HttpResponseContextMultiError []error
+// RuntimeRequestMultiError is an error wrapping multiple validation errors
+// returned by RuntimeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RuntimeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HttpResponseContextMultiError) Error() string {
+func (m RuntimeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2460,11 +3969,11 @@ func (m HttpResponseContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HttpResponseContextMultiError) AllErrors() []error { return m }
+func (m RuntimeRequestMultiError) AllErrors() []error { return m }
 
-// HttpResponseContextValidationError is the validation error returned by
-// HttpResponseContext.Validate if the designated constraints aren't met.
-type HttpResponseContextValidationError struct {
+// RuntimeRequestValidationError is the validation error returned by
+// RuntimeRequest.Validate if the designated constraints aren't met.
+type RuntimeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2472,24 +3981,22 @@ type HttpResponseContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e HttpResponseContextValidationError) Field() string { return e.field }
+func (e RuntimeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HttpResponseContextValidationError) Reason() string { return e.reason }
+func (e RuntimeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HttpResponseContextValidationError) Cause() error { return e.cause }
+func (e RuntimeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HttpResponseContextValidationError) Key() bool { return e.key }
+func (e RuntimeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HttpResponseContextValidationError) ErrorName() string {
-	return "HttpResponseContextValidationError"
-}
+func (e RuntimeRequestValidationError) ErrorName() string { return "RuntimeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e HttpResponseContextValidationError) Error() string {
+func (e RuntimeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2501,14 +4008,14 @@ func (e HttpResponseContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHttpResponseContext.%s: %s%s",
+		"invalid %sRuntimeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HttpResponseContextValidationError{}
+var _ error = RuntimeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2516,46 +4023,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HttpResponseContextValidationError{}
+} = RuntimeRequestValidationError{}
 
-// Validate checks the field values on TopicResponseContext with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TopicResponseContext) Validate() error {
+// Validate checks the field values on RuntimeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RuntimeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TopicResponseContext with the rules
+// ValidateAll checks the field values on RuntimeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TopicResponseContextMultiError, or nil if none found.
-func (m *TopicResponseContext) ValidateAll() error {
+// RuntimeResponseMultiError, or nil if none found.
+func (m *RuntimeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TopicResponseContext) validate(all bool) error {
+func (m *RuntimeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Payload
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuntimeResponseValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuntimeResponseValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuntimeResponseValidationError{
+					field:  fmt.Sprintf("Details[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return TopicResponseContextMultiError(errors)
+		return RuntimeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TopicResponseContextMultiError is an error wrapping multiple validation
-// errors returned by TopicResponseContext.ValidateAll() if the designated
-// constraints aren't met.
-type TopicResponseContextMultiError []error
+// RuntimeResponseMultiError is an error wrapping multiple validation errors
+// returned by RuntimeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RuntimeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TopicResponseContextMultiError) Error() string {
+func (m RuntimeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2564,11 +4109,11 @@ func (m TopicResponseContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TopicResponseContextMultiError) AllErrors() []error { return m }
+func (m RuntimeResponseMultiError) AllErrors() []error { return m }
 
-// TopicResponseContextValidationError is the validation error returned by
-// TopicResponseContext.Validate if the designated constraints aren't met.
-type TopicResponseContextValidationError struct {
+// RuntimeResponseValidationError is the validation error returned by
+// RuntimeResponse.Validate if the designated constraints aren't met.
+type RuntimeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2576,24 +4121,22 @@ type TopicResponseContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e TopicResponseContextValidationError) Field() string { return e.field }
+func (e RuntimeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TopicResponseContextValidationError) Reason() string { return e.reason }
+func (e RuntimeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TopicResponseContextValidationError) Cause() error { return e.cause }
+func (e RuntimeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TopicResponseContextValidationError) Key() bool { return e.key }
+func (e RuntimeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TopicResponseContextValidationError) ErrorName() string {
-	return "TopicResponseContextValidationError"
-}
+func (e RuntimeResponseValidationError) ErrorName() string { return "RuntimeResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TopicResponseContextValidationError) Error() string {
+func (e RuntimeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2605,14 +4148,14 @@ func (e TopicResponseContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTopicResponseContext.%s: %s%s",
+		"invalid %sRuntimeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TopicResponseContextValidationError{}
+var _ error = RuntimeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2620,4 +4163,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TopicResponseContextValidationError{}
+} = RuntimeResponseValidationError{}
@@ -0,0 +1,761 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: flags/v1/flags.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The user or entity a flag is evaluated for
+type FlagsContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the user or entity, so gradual rollouts serve it the same value each time
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Attributes flags can target, e.g. country or plan
+	Attributes *structpb.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *FlagsContext) Reset() {
+	*x = FlagsContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsContext) ProtoMessage() {}
+
+func (x *FlagsContext) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsContext.ProtoReflect.Descriptor instead.
+func (*FlagsContext) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlagsContext) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FlagsContext) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Why a flag evaluated to its value
+type FlagsEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason given by the provider, e.g. targeted, rollout or fallthrough,
+	// or default when the flag doesn't exist, has a different type or couldn't be evaluated
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The name of the variation served, if the provider names them
+	Variation string `protobuf:"bytes,2,opt,name=variation,proto3" json:"variation,omitempty"`
+}
+
+func (x *FlagsEvaluation) Reset() {
+	*x = FlagsEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsEvaluation) ProtoMessage() {}
+
+func (x *FlagsEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsEvaluation.ProtoReflect.Descriptor instead.
+func (*FlagsEvaluation) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FlagsEvaluation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlagsEvaluation) GetVariation() string {
+	if x != nil {
+		return x.Variation
+	}
+	return ""
+}
+
+type FlagsBoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the flag
+	Flag string `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	// The value returned if the flag can't be evaluated
+	DefaultValue bool          `protobuf:"varint,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Context      *FlagsContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *FlagsBoolRequest) Reset() {
+	*x = FlagsBoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsBoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsBoolRequest) ProtoMessage() {}
+
+func (x *FlagsBoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsBoolRequest.ProtoReflect.Descriptor instead.
+func (*FlagsBoolRequest) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlagsBoolRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *FlagsBoolRequest) GetDefaultValue() bool {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return false
+}
+
+func (x *FlagsBoolRequest) GetContext() *FlagsContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type FlagsBoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      bool             `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Evaluation *FlagsEvaluation `protobuf:"bytes,2,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *FlagsBoolResponse) Reset() {
+	*x = FlagsBoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsBoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsBoolResponse) ProtoMessage() {}
+
+func (x *FlagsBoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsBoolResponse.ProtoReflect.Descriptor instead.
+func (*FlagsBoolResponse) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FlagsBoolResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *FlagsBoolResponse) GetEvaluation() *FlagsEvaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+type FlagsStringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the flag
+	Flag string `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	// The value returned if the flag can't be evaluated
+	DefaultValue string        `protobuf:"bytes,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Context      *FlagsContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *FlagsStringRequest) Reset() {
+	*x = FlagsStringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsStringRequest) ProtoMessage() {}
+
+func (x *FlagsStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsStringRequest.ProtoReflect.Descriptor instead.
+func (*FlagsStringRequest) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlagsStringRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *FlagsStringRequest) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *FlagsStringRequest) GetContext() *FlagsContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type FlagsStringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      string           `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Evaluation *FlagsEvaluation `protobuf:"bytes,2,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *FlagsStringResponse) Reset() {
+	*x = FlagsStringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsStringResponse) ProtoMessage() {}
+
+func (x *FlagsStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsStringResponse.ProtoReflect.Descriptor instead.
+func (*FlagsStringResponse) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlagsStringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FlagsStringResponse) GetEvaluation() *FlagsEvaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+type FlagsNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the flag
+	Flag string `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	// The value returned if the flag can't be evaluated
+	DefaultValue float64       `protobuf:"fixed64,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Context      *FlagsContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *FlagsNumberRequest) Reset() {
+	*x = FlagsNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsNumberRequest) ProtoMessage() {}
+
+func (x *FlagsNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsNumberRequest.ProtoReflect.Descriptor instead.
+func (*FlagsNumberRequest) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FlagsNumberRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *FlagsNumberRequest) GetDefaultValue() float64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
+func (x *FlagsNumberRequest) GetContext() *FlagsContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type FlagsNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      float64          `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Evaluation *FlagsEvaluation `protobuf:"bytes,2,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *FlagsNumberResponse) Reset() {
+	*x = FlagsNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_v1_flags_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsNumberResponse) ProtoMessage() {}
+
+func (x *FlagsNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_v1_flags_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsNumberResponse.ProtoReflect.Descriptor instead.
+func (*FlagsNumberResponse) Descriptor() ([]byte, []int) {
+	return file_flags_v1_flags_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlagsNumberResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FlagsNumberResponse) GetEvaluation() *FlagsEvaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+var File_flags_v1_flags_proto protoreflect.FileDescriptor
+
+var file_flags_v1_flags_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59,
+	0x0a, 0x0c, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x66, 0x6c, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x6b, 0x0a, 0x11, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8f, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66,
+	0x6c, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x6d, 0x0a, 0x13, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x8f, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x66, 0x6c, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x6d, 0x0a, 0x13, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x40, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x32, 0x87, 0x02, 0x0a, 0x0c, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4d, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x0a, 0x18, 0x69,
+	0x6f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x50, 0x01,
+	0x5a, 0x0c, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xaa, 0x02,
+	0x15, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x15, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x5c,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_flags_v1_flags_proto_rawDescOnce sync.Once
+	file_flags_v1_flags_proto_rawDescData = file_flags_v1_flags_proto_rawDesc
+)
+
+func file_flags_v1_flags_proto_rawDescGZIP() []byte {
+	file_flags_v1_flags_proto_rawDescOnce.Do(func() {
+		file_flags_v1_flags_proto_rawDescData = protoimpl.X.CompressGZIP(file_flags_v1_flags_proto_rawDescData)
+	})
+	return file_flags_v1_flags_proto_rawDescData
+}
+
+var file_flags_v1_flags_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_flags_v1_flags_proto_goTypes = []interface{}{
+	(*FlagsContext)(nil),        // 0: nitric.flags.v1.FlagsContext
+	(*FlagsEvaluation)(nil),     // 1: nitric.flags.v1.FlagsEvaluation
+	(*FlagsBoolRequest)(nil),    // 2: nitric.flags.v1.FlagsBoolRequest
+	(*FlagsBoolResponse)(nil),   // 3: nitric.flags.v1.FlagsBoolResponse
+	(*FlagsStringRequest)(nil),  // 4: nitric.flags.v1.FlagsStringRequest
+	(*FlagsStringResponse)(nil), // 5: nitric.flags.v1.FlagsStringResponse
+	(*FlagsNumberRequest)(nil),  // 6: nitric.flags.v1.FlagsNumberRequest
+	(*FlagsNumberResponse)(nil), // 7: nitric.flags.v1.FlagsNumberResponse
+	(*structpb.Struct)(nil),     // 8: google.protobuf.Struct
+}
+var file_flags_v1_flags_proto_depIdxs = []int32{
+	8,  // 0: nitric.flags.v1.FlagsContext.attributes:type_name -> google.protobuf.Struct
+	0,  // 1: nitric.flags.v1.FlagsBoolRequest.context:type_name -> nitric.flags.v1.FlagsContext
+	1,  // 2: nitric.flags.v1.FlagsBoolResponse.evaluation:type_name -> nitric.flags.v1.FlagsEvaluation
+	0,  // 3: nitric.flags.v1.FlagsStringRequest.context:type_name -> nitric.flags.v1.FlagsContext
+	1,  // 4: nitric.flags.v1.FlagsStringResponse.evaluation:type_name -> nitric.flags.v1.FlagsEvaluation
+	0,  // 5: nitric.flags.v1.FlagsNumberRequest.context:type_name -> nitric.flags.v1.FlagsContext
+	1,  // 6: nitric.flags.v1.FlagsNumberResponse.evaluation:type_name -> nitric.flags.v1.FlagsEvaluation
+	2,  // 7: nitric.flags.v1.FlagsService.Bool:input_type -> nitric.flags.v1.FlagsBoolRequest
+	4,  // 8: nitric.flags.v1.FlagsService.String:input_type -> nitric.flags.v1.FlagsStringRequest
+	6,  // 9: nitric.flags.v1.FlagsService.Number:input_type -> nitric.flags.v1.FlagsNumberRequest
+	3,  // 10: nitric.flags.v1.FlagsService.Bool:output_type -> nitric.flags.v1.FlagsBoolResponse
+	5,  // 11: nitric.flags.v1.FlagsService.String:output_type -> nitric.flags.v1.FlagsStringResponse
+	7,  // 12: nitric.flags.v1.FlagsService.Number:output_type -> nitric.flags.v1.FlagsNumberResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_flags_v1_flags_proto_init() }
+func file_flags_v1_flags_proto_init() {
+	if File_flags_v1_flags_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_flags_v1_flags_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsBoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsBoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsStringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsStringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_v1_flags_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsNumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_flags_v1_flags_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_flags_v1_flags_proto_goTypes,
+		DependencyIndexes: file_flags_v1_flags_proto_depIdxs,
+		MessageInfos:      file_flags_v1_flags_proto_msgTypes,
+	}.Build()
+	File_flags_v1_flags_proto = out.File
+	file_flags_v1_flags_proto_rawDesc = nil
+	file_flags_v1_flags_proto_goTypes = nil
+	file_flags_v1_flags_proto_depIdxs = nil
+}
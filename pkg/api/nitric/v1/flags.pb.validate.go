@@ -0,0 +1,1099 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: flags/v1/flags.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FlagsContext with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FlagsContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsContext with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FlagsContextMultiError, or
+// nil if none found.
+func (m *FlagsContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsContextValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsContextValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsContextValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsContextMultiError is an error wrapping multiple validation errors
+// returned by FlagsContext.ValidateAll() if the designated constraints aren't met.
+type FlagsContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsContextMultiError) AllErrors() []error { return m }
+
+// FlagsContextValidationError is the validation error returned by
+// FlagsContext.Validate if the designated constraints aren't met.
+type FlagsContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsContextValidationError) ErrorName() string { return "FlagsContextValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlagsContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsContextValidationError{}
+
+// Validate checks the field values on FlagsEvaluation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlagsEvaluation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsEvaluation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsEvaluationMultiError, or nil if none found.
+func (m *FlagsEvaluation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsEvaluation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Variation
+
+	if len(errors) > 0 {
+		return FlagsEvaluationMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsEvaluationMultiError is an error wrapping multiple validation errors
+// returned by FlagsEvaluation.ValidateAll() if the designated constraints
+// aren't met.
+type FlagsEvaluationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsEvaluationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsEvaluationMultiError) AllErrors() []error { return m }
+
+// FlagsEvaluationValidationError is the validation error returned by
+// FlagsEvaluation.Validate if the designated constraints aren't met.
+type FlagsEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsEvaluationValidationError) ErrorName() string { return "FlagsEvaluationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlagsEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsEvaluationValidationError{}
+
+// Validate checks the field values on FlagsBoolRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlagsBoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsBoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsBoolRequestMultiError, or nil if none found.
+func (m *FlagsBoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsBoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFlag()) < 1 {
+		err := FlagsBoolRequestValidationError{
+			field:  "Flag",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DefaultValue
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsBoolRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsBoolRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsBoolRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsBoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsBoolRequestMultiError is an error wrapping multiple validation errors
+// returned by FlagsBoolRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlagsBoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsBoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsBoolRequestMultiError) AllErrors() []error { return m }
+
+// FlagsBoolRequestValidationError is the validation error returned by
+// FlagsBoolRequest.Validate if the designated constraints aren't met.
+type FlagsBoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsBoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsBoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsBoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsBoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsBoolRequestValidationError) ErrorName() string { return "FlagsBoolRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlagsBoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsBoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsBoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsBoolRequestValidationError{}
+
+// Validate checks the field values on FlagsBoolResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlagsBoolResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsBoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsBoolResponseMultiError, or nil if none found.
+func (m *FlagsBoolResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsBoolResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetEvaluation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsBoolResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsBoolResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsBoolResponseValidationError{
+				field:  "Evaluation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsBoolResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsBoolResponseMultiError is an error wrapping multiple validation errors
+// returned by FlagsBoolResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FlagsBoolResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsBoolResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsBoolResponseMultiError) AllErrors() []error { return m }
+
+// FlagsBoolResponseValidationError is the validation error returned by
+// FlagsBoolResponse.Validate if the designated constraints aren't met.
+type FlagsBoolResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsBoolResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsBoolResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsBoolResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsBoolResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsBoolResponseValidationError) ErrorName() string {
+	return "FlagsBoolResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlagsBoolResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsBoolResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsBoolResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsBoolResponseValidationError{}
+
+// Validate checks the field values on FlagsStringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlagsStringRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsStringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsStringRequestMultiError, or nil if none found.
+func (m *FlagsStringRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsStringRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFlag()) < 1 {
+		err := FlagsStringRequestValidationError{
+			field:  "Flag",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DefaultValue
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsStringRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsStringRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsStringRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsStringRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsStringRequestMultiError is an error wrapping multiple validation errors
+// returned by FlagsStringRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlagsStringRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsStringRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsStringRequestMultiError) AllErrors() []error { return m }
+
+// FlagsStringRequestValidationError is the validation error returned by
+// FlagsStringRequest.Validate if the designated constraints aren't met.
+type FlagsStringRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsStringRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsStringRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsStringRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsStringRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsStringRequestValidationError) ErrorName() string {
+	return "FlagsStringRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlagsStringRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsStringRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsStringRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsStringRequestValidationError{}
+
+// Validate checks the field values on FlagsStringResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlagsStringResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsStringResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsStringResponseMultiError, or nil if none found.
+func (m *FlagsStringResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsStringResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetEvaluation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsStringResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsStringResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsStringResponseValidationError{
+				field:  "Evaluation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsStringResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsStringResponseMultiError is an error wrapping multiple validation
+// errors returned by FlagsStringResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FlagsStringResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsStringResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsStringResponseMultiError) AllErrors() []error { return m }
+
+// FlagsStringResponseValidationError is the validation error returned by
+// FlagsStringResponse.Validate if the designated constraints aren't met.
+type FlagsStringResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsStringResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsStringResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsStringResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsStringResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsStringResponseValidationError) ErrorName() string {
+	return "FlagsStringResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlagsStringResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsStringResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsStringResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsStringResponseValidationError{}
+
+// Validate checks the field values on FlagsNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlagsNumberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsNumberRequestMultiError, or nil if none found.
+func (m *FlagsNumberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsNumberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFlag()) < 1 {
+		err := FlagsNumberRequestValidationError{
+			field:  "Flag",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DefaultValue
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsNumberRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsNumberRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsNumberRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsNumberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsNumberRequestMultiError is an error wrapping multiple validation errors
+// returned by FlagsNumberRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlagsNumberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsNumberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsNumberRequestMultiError) AllErrors() []error { return m }
+
+// FlagsNumberRequestValidationError is the validation error returned by
+// FlagsNumberRequest.Validate if the designated constraints aren't met.
+type FlagsNumberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsNumberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsNumberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsNumberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsNumberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsNumberRequestValidationError) ErrorName() string {
+	return "FlagsNumberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlagsNumberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsNumberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsNumberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsNumberRequestValidationError{}
+
+// Validate checks the field values on FlagsNumberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlagsNumberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagsNumberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagsNumberResponseMultiError, or nil if none found.
+func (m *FlagsNumberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagsNumberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetEvaluation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlagsNumberResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlagsNumberResponseValidationError{
+					field:  "Evaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlagsNumberResponseValidationError{
+				field:  "Evaluation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlagsNumberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagsNumberResponseMultiError is an error wrapping multiple validation
+// errors returned by FlagsNumberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FlagsNumberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagsNumberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagsNumberResponseMultiError) AllErrors() []error { return m }
+
+// FlagsNumberResponseValidationError is the validation error returned by
+// FlagsNumberResponse.Validate if the designated constraints aren't met.
+type FlagsNumberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagsNumberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagsNumberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagsNumberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagsNumberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagsNumberResponseValidationError) ErrorName() string {
+	return "FlagsNumberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlagsNumberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagsNumberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagsNumberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagsNumberResponseValidationError{}
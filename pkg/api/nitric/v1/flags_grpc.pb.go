@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: flags/v1/flags.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FlagsServiceClient is the client API for FlagsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FlagsServiceClient interface {
+	// Evaluate a boolean flag
+	Bool(ctx context.Context, in *FlagsBoolRequest, opts ...grpc.CallOption) (*FlagsBoolResponse, error)
+	// Evaluate a string flag
+	String(ctx context.Context, in *FlagsStringRequest, opts ...grpc.CallOption) (*FlagsStringResponse, error)
+	// Evaluate a number flag
+	Number(ctx context.Context, in *FlagsNumberRequest, opts ...grpc.CallOption) (*FlagsNumberResponse, error)
+}
+
+type flagsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlagsServiceClient(cc grpc.ClientConnInterface) FlagsServiceClient {
+	return &flagsServiceClient{cc}
+}
+
+func (c *flagsServiceClient) Bool(ctx context.Context, in *FlagsBoolRequest, opts ...grpc.CallOption) (*FlagsBoolResponse, error) {
+	out := new(FlagsBoolResponse)
+	err := c.cc.Invoke(ctx, "/nitric.flags.v1.FlagsService/Bool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flagsServiceClient) String(ctx context.Context, in *FlagsStringRequest, opts ...grpc.CallOption) (*FlagsStringResponse, error) {
+	out := new(FlagsStringResponse)
+	err := c.cc.Invoke(ctx, "/nitric.flags.v1.FlagsService/String", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flagsServiceClient) Number(ctx context.Context, in *FlagsNumberRequest, opts ...grpc.CallOption) (*FlagsNumberResponse, error) {
+	out := new(FlagsNumberResponse)
+	err := c.cc.Invoke(ctx, "/nitric.flags.v1.FlagsService/Number", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlagsServiceServer is the server API for FlagsService service.
+// All implementations must embed UnimplementedFlagsServiceServer
+// for forward compatibility
+type FlagsServiceServer interface {
+	// Evaluate a boolean flag
+	Bool(context.Context, *FlagsBoolRequest) (*FlagsBoolResponse, error)
+	// Evaluate a string flag
+	String(context.Context, *FlagsStringRequest) (*FlagsStringResponse, error)
+	// Evaluate a number flag
+	Number(context.Context, *FlagsNumberRequest) (*FlagsNumberResponse, error)
+	mustEmbedUnimplementedFlagsServiceServer()
+}
+
+// UnimplementedFlagsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFlagsServiceServer struct {
+}
+
+func (UnimplementedFlagsServiceServer) Bool(context.Context, *FlagsBoolRequest) (*FlagsBoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bool not implemented")
+}
+func (UnimplementedFlagsServiceServer) String(context.Context, *FlagsStringRequest) (*FlagsStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method String not implemented")
+}
+func (UnimplementedFlagsServiceServer) Number(context.Context, *FlagsNumberRequest) (*FlagsNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Number not implemented")
+}
+func (UnimplementedFlagsServiceServer) mustEmbedUnimplementedFlagsServiceServer() {}
+
+// UnsafeFlagsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlagsServiceServer will
+// result in compilation errors.
+type UnsafeFlagsServiceServer interface {
+	mustEmbedUnimplementedFlagsServiceServer()
+}
+
+func RegisterFlagsServiceServer(s grpc.ServiceRegistrar, srv FlagsServiceServer) {
+	s.RegisterService(&FlagsService_ServiceDesc, srv)
+}
+
+func _FlagsService_Bool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagsBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlagsServiceServer).Bool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.flags.v1.FlagsService/Bool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlagsServiceServer).Bool(ctx, req.(*FlagsBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlagsService_String_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagsStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlagsServiceServer).String(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.flags.v1.FlagsService/String",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlagsServiceServer).String(ctx, req.(*FlagsStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlagsService_Number_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagsNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlagsServiceServer).Number(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.flags.v1.FlagsService/Number",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlagsServiceServer).Number(ctx, req.(*FlagsNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FlagsService_ServiceDesc is the grpc.ServiceDesc for FlagsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FlagsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.flags.v1.FlagsService",
+	HandlerType: (*FlagsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bool",
+			Handler:    _FlagsService_Bool_Handler,
+		},
+		{
+			MethodName: "String",
+			Handler:    _FlagsService_String_Handler,
+		},
+		{
+			MethodName: "Number",
+			Handler:    _FlagsService_Number_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "flags/v1/flags.proto",
+}
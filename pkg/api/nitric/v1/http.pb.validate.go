@@ -0,0 +1,511 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: http/v1/http.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on HttpHeaderValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HttpHeaderValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpHeaderValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpHeaderValueMultiError, or nil if none found.
+func (m *HttpHeaderValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpHeaderValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return HttpHeaderValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpHeaderValueMultiError is an error wrapping multiple validation errors
+// returned by HttpHeaderValue.ValidateAll() if the designated constraints
+// aren't met.
+type HttpHeaderValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpHeaderValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpHeaderValueMultiError) AllErrors() []error { return m }
+
+// HttpHeaderValueValidationError is the validation error returned by
+// HttpHeaderValue.Validate if the designated constraints aren't met.
+type HttpHeaderValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpHeaderValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpHeaderValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpHeaderValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpHeaderValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpHeaderValueValidationError) ErrorName() string { return "HttpHeaderValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HttpHeaderValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpHeaderValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpHeaderValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpHeaderValueValidationError{}
+
+// Validate checks the field values on HttpSendRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HttpSendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpSendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpSendRequestMultiError, or nil if none found.
+func (m *HttpSendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpSendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _HttpSendRequest_Method_InLookup[m.GetMethod()]; !ok {
+		err := HttpSendRequestValidationError{
+			field:  "Method",
+			reason: "value must be in list [ GET HEAD POST PUT PATCH DELETE OPTIONS]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = HttpSendRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := HttpSendRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpSendRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpSendRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpSendRequestValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Body
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HttpSendRequestValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := HttpSendRequestValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for NoRetry
+
+	if len(errors) > 0 {
+		return HttpSendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpSendRequestMultiError is an error wrapping multiple validation errors
+// returned by HttpSendRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HttpSendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpSendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpSendRequestMultiError) AllErrors() []error { return m }
+
+// HttpSendRequestValidationError is the validation error returned by
+// HttpSendRequest.Validate if the designated constraints aren't met.
+type HttpSendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpSendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpSendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpSendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpSendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpSendRequestValidationError) ErrorName() string { return "HttpSendRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HttpSendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpSendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpSendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpSendRequestValidationError{}
+
+var _HttpSendRequest_Method_InLookup = map[string]struct{}{
+	"":        {},
+	"GET":     {},
+	"HEAD":    {},
+	"POST":    {},
+	"PUT":     {},
+	"PATCH":   {},
+	"DELETE":  {},
+	"OPTIONS": {},
+}
+
+// Validate checks the field values on HttpSendResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HttpSendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpSendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpSendResponseMultiError, or nil if none found.
+func (m *HttpSendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpSendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpSendResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpSendResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpSendResponseValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Body
+
+	// no validation rules for Attempts
+
+	if len(errors) > 0 {
+		return HttpSendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpSendResponseMultiError is an error wrapping multiple validation errors
+// returned by HttpSendResponse.ValidateAll() if the designated constraints
+// aren't met.
+type HttpSendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpSendResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpSendResponseMultiError) AllErrors() []error { return m }
+
+// HttpSendResponseValidationError is the validation error returned by
+// HttpSendResponse.Validate if the designated constraints aren't met.
+type HttpSendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpSendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpSendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpSendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpSendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpSendResponseValidationError) ErrorName() string { return "HttpSendResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HttpSendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpSendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpSendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpSendResponseValidationError{}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: http/v1/http.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HttpServiceClient is the client API for HttpService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HttpServiceClient interface {
+	// Send a request, returning the final response once any retries are exhausted
+	Send(ctx context.Context, in *HttpSendRequest, opts ...grpc.CallOption) (*HttpSendResponse, error)
+}
+
+type httpServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHttpServiceClient(cc grpc.ClientConnInterface) HttpServiceClient {
+	return &httpServiceClient{cc}
+}
+
+func (c *httpServiceClient) Send(ctx context.Context, in *HttpSendRequest, opts ...grpc.CallOption) (*HttpSendResponse, error) {
+	out := new(HttpSendResponse)
+	err := c.cc.Invoke(ctx, "/nitric.http.v1.HttpService/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HttpServiceServer is the server API for HttpService service.
+// All implementations must embed UnimplementedHttpServiceServer
+// for forward compatibility
+type HttpServiceServer interface {
+	// Send a request, returning the final response once any retries are exhausted
+	Send(context.Context, *HttpSendRequest) (*HttpSendResponse, error)
+	mustEmbedUnimplementedHttpServiceServer()
+}
+
+// UnimplementedHttpServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHttpServiceServer struct {
+}
+
+func (UnimplementedHttpServiceServer) Send(context.Context, *HttpSendRequest) (*HttpSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedHttpServiceServer) mustEmbedUnimplementedHttpServiceServer() {}
+
+// UnsafeHttpServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HttpServiceServer will
+// result in compilation errors.
+type UnsafeHttpServiceServer interface {
+	mustEmbedUnimplementedHttpServiceServer()
+}
+
+func RegisterHttpServiceServer(s grpc.ServiceRegistrar, srv HttpServiceServer) {
+	s.RegisterService(&HttpService_ServiceDesc, srv)
+}
+
+func _HttpService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HttpServiceServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.http.v1.HttpService/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HttpServiceServer).Send(ctx, req.(*HttpSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HttpService_ServiceDesc is the grpc.ServiceDesc for HttpService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HttpService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.http.v1.HttpService",
+	HandlerType: (*HttpServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _HttpService_Send_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "http/v1/http.proto",
+}
@@ -0,0 +1,1437 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: identity/v1/identity.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on IdentityUser with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IdentityUser) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityUser with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentityUserMultiError, or
+// nil if none found.
+func (m *IdentityUser) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityUser) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for Disabled
+
+	// no validation rules for Attributes
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityUserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityUserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityUserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IdentityUserMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityUserMultiError is an error wrapping multiple validation errors
+// returned by IdentityUser.ValidateAll() if the designated constraints aren't met.
+type IdentityUserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityUserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityUserMultiError) AllErrors() []error { return m }
+
+// IdentityUserValidationError is the validation error returned by
+// IdentityUser.Validate if the designated constraints aren't met.
+type IdentityUserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityUserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityUserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityUserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityUserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityUserValidationError) ErrorName() string { return "IdentityUserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdentityUserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityUserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityUserValidationError{}
+
+// Validate checks the field values on IdentityVerifyTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityVerifyTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityVerifyTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityVerifyTokenRequestMultiError, or nil if none found.
+func (m *IdentityVerifyTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityVerifyTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := IdentityVerifyTokenRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentityVerifyTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityVerifyTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IdentityVerifyTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IdentityVerifyTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityVerifyTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityVerifyTokenRequestMultiError) AllErrors() []error { return m }
+
+// IdentityVerifyTokenRequestValidationError is the validation error returned
+// by IdentityVerifyTokenRequest.Validate if the designated constraints aren't met.
+type IdentityVerifyTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityVerifyTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityVerifyTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityVerifyTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityVerifyTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityVerifyTokenRequestValidationError) ErrorName() string {
+	return "IdentityVerifyTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityVerifyTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityVerifyTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityVerifyTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityVerifyTokenRequestValidationError{}
+
+// Validate checks the field values on IdentityVerifyTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityVerifyTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityVerifyTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityVerifyTokenResponseMultiError, or nil if none found.
+func (m *IdentityVerifyTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityVerifyTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetClaims()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityVerifyTokenResponseValidationError{
+					field:  "Claims",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityVerifyTokenResponseValidationError{
+					field:  "Claims",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaims()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityVerifyTokenResponseValidationError{
+				field:  "Claims",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityVerifyTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityVerifyTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityVerifyTokenResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IdentityVerifyTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityVerifyTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IdentityVerifyTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IdentityVerifyTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityVerifyTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityVerifyTokenResponseMultiError) AllErrors() []error { return m }
+
+// IdentityVerifyTokenResponseValidationError is the validation error returned
+// by IdentityVerifyTokenResponse.Validate if the designated constraints
+// aren't met.
+type IdentityVerifyTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityVerifyTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityVerifyTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityVerifyTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityVerifyTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityVerifyTokenResponseValidationError) ErrorName() string {
+	return "IdentityVerifyTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityVerifyTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityVerifyTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityVerifyTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityVerifyTokenResponseValidationError{}
+
+// Validate checks the field values on IdentityGetUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityGetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityGetUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityGetUserRequestMultiError, or nil if none found.
+func (m *IdentityGetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityGetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := IdentityGetUserRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentityGetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityGetUserRequestMultiError is an error wrapping multiple validation
+// errors returned by IdentityGetUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityGetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityGetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityGetUserRequestMultiError) AllErrors() []error { return m }
+
+// IdentityGetUserRequestValidationError is the validation error returned by
+// IdentityGetUserRequest.Validate if the designated constraints aren't met.
+type IdentityGetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityGetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityGetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityGetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityGetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityGetUserRequestValidationError) ErrorName() string {
+	return "IdentityGetUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityGetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityGetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityGetUserRequestValidationError{}
+
+// Validate checks the field values on IdentityGetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityGetUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityGetUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityGetUserResponseMultiError, or nil if none found.
+func (m *IdentityGetUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityGetUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityGetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityGetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityGetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IdentityGetUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityGetUserResponseMultiError is an error wrapping multiple validation
+// errors returned by IdentityGetUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityGetUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityGetUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityGetUserResponseMultiError) AllErrors() []error { return m }
+
+// IdentityGetUserResponseValidationError is the validation error returned by
+// IdentityGetUserResponse.Validate if the designated constraints aren't met.
+type IdentityGetUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityGetUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityGetUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityGetUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityGetUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityGetUserResponseValidationError) ErrorName() string {
+	return "IdentityGetUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityGetUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityGetUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityGetUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityGetUserResponseValidationError{}
+
+// Validate checks the field values on IdentityCreateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityCreateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityCreateUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityCreateUserRequestMultiError, or nil if none found.
+func (m *IdentityCreateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityCreateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IdentityCreateUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return IdentityCreateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IdentityCreateUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *IdentityCreateUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// IdentityCreateUserRequestMultiError is an error wrapping multiple validation
+// errors returned by IdentityCreateUserRequest.ValidateAll() if the
+// designated constraints aren't met.
+type IdentityCreateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityCreateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityCreateUserRequestMultiError) AllErrors() []error { return m }
+
+// IdentityCreateUserRequestValidationError is the validation error returned by
+// IdentityCreateUserRequest.Validate if the designated constraints aren't met.
+type IdentityCreateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityCreateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityCreateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityCreateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityCreateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityCreateUserRequestValidationError) ErrorName() string {
+	return "IdentityCreateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityCreateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityCreateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityCreateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityCreateUserRequestValidationError{}
+
+// Validate checks the field values on IdentityCreateUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityCreateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityCreateUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityCreateUserResponseMultiError, or nil if none found.
+func (m *IdentityCreateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityCreateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityCreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityCreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityCreateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IdentityCreateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityCreateUserResponseMultiError is an error wrapping multiple
+// validation errors returned by IdentityCreateUserResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IdentityCreateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityCreateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityCreateUserResponseMultiError) AllErrors() []error { return m }
+
+// IdentityCreateUserResponseValidationError is the validation error returned
+// by IdentityCreateUserResponse.Validate if the designated constraints aren't met.
+type IdentityCreateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityCreateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityCreateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityCreateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityCreateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityCreateUserResponseValidationError) ErrorName() string {
+	return "IdentityCreateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityCreateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityCreateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityCreateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityCreateUserResponseValidationError{}
+
+// Validate checks the field values on IdentitySetDisabledRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentitySetDisabledRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentitySetDisabledRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentitySetDisabledRequestMultiError, or nil if none found.
+func (m *IdentitySetDisabledRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentitySetDisabledRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := IdentitySetDisabledRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Disabled
+
+	if len(errors) > 0 {
+		return IdentitySetDisabledRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentitySetDisabledRequestMultiError is an error wrapping multiple
+// validation errors returned by IdentitySetDisabledRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IdentitySetDisabledRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentitySetDisabledRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentitySetDisabledRequestMultiError) AllErrors() []error { return m }
+
+// IdentitySetDisabledRequestValidationError is the validation error returned
+// by IdentitySetDisabledRequest.Validate if the designated constraints aren't met.
+type IdentitySetDisabledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentitySetDisabledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentitySetDisabledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentitySetDisabledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentitySetDisabledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentitySetDisabledRequestValidationError) ErrorName() string {
+	return "IdentitySetDisabledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentitySetDisabledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentitySetDisabledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentitySetDisabledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentitySetDisabledRequestValidationError{}
+
+// Validate checks the field values on IdentitySetDisabledResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentitySetDisabledResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentitySetDisabledResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentitySetDisabledResponseMultiError, or nil if none found.
+func (m *IdentitySetDisabledResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentitySetDisabledResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return IdentitySetDisabledResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentitySetDisabledResponseMultiError is an error wrapping multiple
+// validation errors returned by IdentitySetDisabledResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IdentitySetDisabledResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentitySetDisabledResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentitySetDisabledResponseMultiError) AllErrors() []error { return m }
+
+// IdentitySetDisabledResponseValidationError is the validation error returned
+// by IdentitySetDisabledResponse.Validate if the designated constraints
+// aren't met.
+type IdentitySetDisabledResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentitySetDisabledResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentitySetDisabledResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentitySetDisabledResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentitySetDisabledResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentitySetDisabledResponseValidationError) ErrorName() string {
+	return "IdentitySetDisabledResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentitySetDisabledResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentitySetDisabledResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentitySetDisabledResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentitySetDisabledResponseValidationError{}
+
+// Validate checks the field values on IdentitySetAttributesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentitySetAttributesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentitySetAttributesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentitySetAttributesRequestMultiError, or nil if none found.
+func (m *IdentitySetAttributesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentitySetAttributesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := IdentitySetAttributesRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttributes()) < 1 {
+		err := IdentitySetAttributesRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain at least 1 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentitySetAttributesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentitySetAttributesRequestMultiError is an error wrapping multiple
+// validation errors returned by IdentitySetAttributesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IdentitySetAttributesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentitySetAttributesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentitySetAttributesRequestMultiError) AllErrors() []error { return m }
+
+// IdentitySetAttributesRequestValidationError is the validation error returned
+// by IdentitySetAttributesRequest.Validate if the designated constraints
+// aren't met.
+type IdentitySetAttributesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentitySetAttributesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentitySetAttributesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentitySetAttributesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentitySetAttributesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentitySetAttributesRequestValidationError) ErrorName() string {
+	return "IdentitySetAttributesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentitySetAttributesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentitySetAttributesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentitySetAttributesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentitySetAttributesRequestValidationError{}
+
+// Validate checks the field values on IdentitySetAttributesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentitySetAttributesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentitySetAttributesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IdentitySetAttributesResponseMultiError, or nil if none found.
+func (m *IdentitySetAttributesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentitySetAttributesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return IdentitySetAttributesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentitySetAttributesResponseMultiError is an error wrapping multiple
+// validation errors returned by IdentitySetAttributesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IdentitySetAttributesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentitySetAttributesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentitySetAttributesResponseMultiError) AllErrors() []error { return m }
+
+// IdentitySetAttributesResponseValidationError is the validation error
+// returned by IdentitySetAttributesResponse.Validate if the designated
+// constraints aren't met.
+type IdentitySetAttributesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentitySetAttributesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentitySetAttributesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentitySetAttributesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentitySetAttributesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentitySetAttributesResponseValidationError) ErrorName() string {
+	return "IdentitySetAttributesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentitySetAttributesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentitySetAttributesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentitySetAttributesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentitySetAttributesResponseValidationError{}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: identity/v1/identity.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// IdentityServiceClient is the client API for IdentityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdentityServiceClient interface {
+	// Verify a token issued to a user, returning its claims
+	VerifyToken(ctx context.Context, in *IdentityVerifyTokenRequest, opts ...grpc.CallOption) (*IdentityVerifyTokenResponse, error)
+	// Get a user
+	GetUser(ctx context.Context, in *IdentityGetUserRequest, opts ...grpc.CallOption) (*IdentityGetUserResponse, error)
+	// Create a user
+	CreateUser(ctx context.Context, in *IdentityCreateUserRequest, opts ...grpc.CallOption) (*IdentityCreateUserResponse, error)
+	// Disable or re-enable a user, disabled users can't sign in
+	SetDisabled(ctx context.Context, in *IdentitySetDisabledRequest, opts ...grpc.CallOption) (*IdentitySetDisabledResponse, error)
+	// Set attributes of a user, leaving its other attributes unchanged
+	SetAttributes(ctx context.Context, in *IdentitySetAttributesRequest, opts ...grpc.CallOption) (*IdentitySetAttributesResponse, error)
+}
+
+type identityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdentityServiceClient(cc grpc.ClientConnInterface) IdentityServiceClient {
+	return &identityServiceClient{cc}
+}
+
+func (c *identityServiceClient) VerifyToken(ctx context.Context, in *IdentityVerifyTokenRequest, opts ...grpc.CallOption) (*IdentityVerifyTokenResponse, error) {
+	out := new(IdentityVerifyTokenResponse)
+	err := c.cc.Invoke(ctx, "/nitric.identity.v1.IdentityService/VerifyToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) GetUser(ctx context.Context, in *IdentityGetUserRequest, opts ...grpc.CallOption) (*IdentityGetUserResponse, error) {
+	out := new(IdentityGetUserResponse)
+	err := c.cc.Invoke(ctx, "/nitric.identity.v1.IdentityService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) CreateUser(ctx context.Context, in *IdentityCreateUserRequest, opts ...grpc.CallOption) (*IdentityCreateUserResponse, error) {
+	out := new(IdentityCreateUserResponse)
+	err := c.cc.Invoke(ctx, "/nitric.identity.v1.IdentityService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) SetDisabled(ctx context.Context, in *IdentitySetDisabledRequest, opts ...grpc.CallOption) (*IdentitySetDisabledResponse, error) {
+	out := new(IdentitySetDisabledResponse)
+	err := c.cc.Invoke(ctx, "/nitric.identity.v1.IdentityService/SetDisabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) SetAttributes(ctx context.Context, in *IdentitySetAttributesRequest, opts ...grpc.CallOption) (*IdentitySetAttributesResponse, error) {
+	out := new(IdentitySetAttributesResponse)
+	err := c.cc.Invoke(ctx, "/nitric.identity.v1.IdentityService/SetAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdentityServiceServer is the server API for IdentityService service.
+// All implementations must embed UnimplementedIdentityServiceServer
+// for forward compatibility
+type IdentityServiceServer interface {
+	// Verify a token issued to a user, returning its claims
+	VerifyToken(context.Context, *IdentityVerifyTokenRequest) (*IdentityVerifyTokenResponse, error)
+	// Get a user
+	GetUser(context.Context, *IdentityGetUserRequest) (*IdentityGetUserResponse, error)
+	// Create a user
+	CreateUser(context.Context, *IdentityCreateUserRequest) (*IdentityCreateUserResponse, error)
+	// Disable or re-enable a user, disabled users can't sign in
+	SetDisabled(context.Context, *IdentitySetDisabledRequest) (*IdentitySetDisabledResponse, error)
+	// Set attributes of a user, leaving its other attributes unchanged
+	SetAttributes(context.Context, *IdentitySetAttributesRequest) (*IdentitySetAttributesResponse, error)
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+// UnimplementedIdentityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdentityServiceServer struct {
+}
+
+func (UnimplementedIdentityServiceServer) VerifyToken(context.Context, *IdentityVerifyTokenRequest) (*IdentityVerifyTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyToken not implemented")
+}
+func (UnimplementedIdentityServiceServer) GetUser(context.Context, *IdentityGetUserRequest) (*IdentityGetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedIdentityServiceServer) CreateUser(context.Context, *IdentityCreateUserRequest) (*IdentityCreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedIdentityServiceServer) SetDisabled(context.Context, *IdentitySetDisabledRequest) (*IdentitySetDisabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDisabled not implemented")
+}
+func (UnimplementedIdentityServiceServer) SetAttributes(context.Context, *IdentitySetAttributesRequest) (*IdentitySetAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributes not implemented")
+}
+func (UnimplementedIdentityServiceServer) mustEmbedUnimplementedIdentityServiceServer() {}
+
+// UnsafeIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdentityServiceServer will
+// result in compilation errors.
+type UnsafeIdentityServiceServer interface {
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+func RegisterIdentityServiceServer(s grpc.ServiceRegistrar, srv IdentityServiceServer) {
+	s.RegisterService(&IdentityService_ServiceDesc, srv)
+}
+
+func _IdentityService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityVerifyTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).VerifyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.identity.v1.IdentityService/VerifyToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).VerifyToken(ctx, req.(*IdentityVerifyTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityGetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.identity.v1.IdentityService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetUser(ctx, req.(*IdentityGetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityCreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.identity.v1.IdentityService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).CreateUser(ctx, req.(*IdentityCreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_SetDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentitySetDisabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).SetDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.identity.v1.IdentityService/SetDisabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).SetDisabled(ctx, req.(*IdentitySetDisabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_SetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentitySetAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).SetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.identity.v1.IdentityService/SetAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).SetAttributes(ctx, req.(*IdentitySetAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdentityService_ServiceDesc is the grpc.ServiceDesc for IdentityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.identity.v1.IdentityService",
+	HandlerType: (*IdentityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyToken",
+			Handler:    _IdentityService_VerifyToken_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _IdentityService_GetUser_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _IdentityService_CreateUser_Handler,
+		},
+		{
+			MethodName: "SetDisabled",
+			Handler:    _IdentityService_SetDisabled_Handler,
+		},
+		{
+			MethodName: "SetAttributes",
+			Handler:    _IdentityService_SetAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "identity/v1/identity.proto",
+}
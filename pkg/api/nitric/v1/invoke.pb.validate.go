@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: invoke/v1/invoke.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on InvokeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvokeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvokeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvokeRequestMultiError, or
+// nil if none found.
+func (m *InvokeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvokeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_InvokeRequest_Service_Pattern.MatchString(m.GetService()) {
+		err := InvokeRequestValidationError{
+			field:  "Service",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9][a-zA-Z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetPath(), "/") {
+		err := InvokeRequestValidationError{
+			field:  "Path",
+			reason: "value does not have prefix \"/\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _InvokeRequest_Method_InLookup[m.GetMethod()]; !ok {
+		err := InvokeRequestValidationError{
+			field:  "Method",
+			reason: "value must be in list [ GET HEAD POST PUT PATCH DELETE OPTIONS]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InvokeRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InvokeRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InvokeRequestValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Payload
+
+	if len(errors) > 0 {
+		return InvokeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvokeRequestMultiError is an error wrapping multiple validation errors
+// returned by InvokeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type InvokeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvokeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvokeRequestMultiError) AllErrors() []error { return m }
+
+// InvokeRequestValidationError is the validation error returned by
+// InvokeRequest.Validate if the designated constraints aren't met.
+type InvokeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvokeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvokeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvokeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvokeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvokeRequestValidationError) ErrorName() string { return "InvokeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvokeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvokeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvokeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvokeRequestValidationError{}
+
+var _InvokeRequest_Service_Pattern = regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]*$")
+
+var _InvokeRequest_Method_InLookup = map[string]struct{}{
+	"":        {},
+	"GET":     {},
+	"HEAD":    {},
+	"POST":    {},
+	"PUT":     {},
+	"PATCH":   {},
+	"DELETE":  {},
+	"OPTIONS": {},
+}
+
+// Validate checks the field values on InvokeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InvokeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvokeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvokeResponseMultiError,
+// or nil if none found.
+func (m *InvokeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvokeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InvokeResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InvokeResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InvokeResponseValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Payload
+
+	if len(errors) > 0 {
+		return InvokeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvokeResponseMultiError is an error wrapping multiple validation errors
+// returned by InvokeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type InvokeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvokeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvokeResponseMultiError) AllErrors() []error { return m }
+
+// InvokeResponseValidationError is the validation error returned by
+// InvokeResponse.Validate if the designated constraints aren't met.
+type InvokeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvokeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvokeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvokeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvokeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvokeResponseValidationError) ErrorName() string { return "InvokeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvokeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvokeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvokeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvokeResponseValidationError{}
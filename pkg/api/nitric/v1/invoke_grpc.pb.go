@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: invoke/v1/invoke.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// InvokeServiceClient is the client API for InvokeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InvokeServiceClient interface {
+	// Send a request to a service, resolved by the membrane and sent with the stack's credentials
+	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+}
+
+type invokeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInvokeServiceClient(cc grpc.ClientConnInterface) InvokeServiceClient {
+	return &invokeServiceClient{cc}
+}
+
+func (c *invokeServiceClient) Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error) {
+	out := new(InvokeResponse)
+	err := c.cc.Invoke(ctx, "/nitric.invoke.v1.InvokeService/Invoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InvokeServiceServer is the server API for InvokeService service.
+// All implementations must embed UnimplementedInvokeServiceServer
+// for forward compatibility
+type InvokeServiceServer interface {
+	// Send a request to a service, resolved by the membrane and sent with the stack's credentials
+	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
+	mustEmbedUnimplementedInvokeServiceServer()
+}
+
+// UnimplementedInvokeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInvokeServiceServer struct {
+}
+
+func (UnimplementedInvokeServiceServer) Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedInvokeServiceServer) mustEmbedUnimplementedInvokeServiceServer() {}
+
+// UnsafeInvokeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvokeServiceServer will
+// result in compilation errors.
+type UnsafeInvokeServiceServer interface {
+	mustEmbedUnimplementedInvokeServiceServer()
+}
+
+func RegisterInvokeServiceServer(s grpc.ServiceRegistrar, srv InvokeServiceServer) {
+	s.RegisterService(&InvokeService_ServiceDesc, srv)
+}
+
+func _InvokeService_Invoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvokeServiceServer).Invoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.invoke.v1.InvokeService/Invoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvokeServiceServer).Invoke(ctx, req.(*InvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InvokeService_ServiceDesc is the grpc.ServiceDesc for InvokeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InvokeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.invoke.v1.InvokeService",
+	HandlerType: (*InvokeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Invoke",
+			Handler:    _InvokeService_Invoke_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "invoke/v1/invoke.proto",
+}
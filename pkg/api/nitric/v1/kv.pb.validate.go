@@ -0,0 +1,969 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: kv/v1/kv.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on KeyValueGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueGetRequestMultiError, or nil if none found.
+func (m *KeyValueGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetKey()); l < 1 || l > 256 {
+		err := KeyValueGetRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KeyValueGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueGetRequestMultiError is an error wrapping multiple validation errors
+// returned by KeyValueGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type KeyValueGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueGetRequestMultiError) AllErrors() []error { return m }
+
+// KeyValueGetRequestValidationError is the validation error returned by
+// KeyValueGetRequest.Validate if the designated constraints aren't met.
+type KeyValueGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueGetRequestValidationError) ErrorName() string {
+	return "KeyValueGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueGetRequestValidationError{}
+
+// Validate checks the field values on KeyValueGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueGetResponseMultiError, or nil if none found.
+func (m *KeyValueGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KeyValueGetResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KeyValueGetResponseValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KeyValueGetResponseValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return KeyValueGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueGetResponseMultiError is an error wrapping multiple validation
+// errors returned by KeyValueGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type KeyValueGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueGetResponseMultiError) AllErrors() []error { return m }
+
+// KeyValueGetResponseValidationError is the validation error returned by
+// KeyValueGetResponse.Validate if the designated constraints aren't met.
+type KeyValueGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueGetResponseValidationError) ErrorName() string {
+	return "KeyValueGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueGetResponseValidationError{}
+
+// Validate checks the field values on KeyValueSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueSetRequestMultiError, or nil if none found.
+func (m *KeyValueSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetKey()); l < 1 || l > 256 {
+		err := KeyValueSetRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValue() == nil {
+		err := KeyValueSetRequestValidationError{
+			field:  "Value",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KeyValueSetRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KeyValueSetRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KeyValueSetRequestValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return KeyValueSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueSetRequestMultiError is an error wrapping multiple validation errors
+// returned by KeyValueSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type KeyValueSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueSetRequestMultiError) AllErrors() []error { return m }
+
+// KeyValueSetRequestValidationError is the validation error returned by
+// KeyValueSetRequest.Validate if the designated constraints aren't met.
+type KeyValueSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueSetRequestValidationError) ErrorName() string {
+	return "KeyValueSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueSetRequestValidationError{}
+
+// Validate checks the field values on KeyValueSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueSetResponseMultiError, or nil if none found.
+func (m *KeyValueSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return KeyValueSetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueSetResponseMultiError is an error wrapping multiple validation
+// errors returned by KeyValueSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type KeyValueSetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueSetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueSetResponseMultiError) AllErrors() []error { return m }
+
+// KeyValueSetResponseValidationError is the validation error returned by
+// KeyValueSetResponse.Validate if the designated constraints aren't met.
+type KeyValueSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueSetResponseValidationError) ErrorName() string {
+	return "KeyValueSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueSetResponseValidationError{}
+
+// Validate checks the field values on KeyValueDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueDeleteRequestMultiError, or nil if none found.
+func (m *KeyValueDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetKey()); l < 1 || l > 256 {
+		err := KeyValueDeleteRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KeyValueDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by KeyValueDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type KeyValueDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueDeleteRequestMultiError) AllErrors() []error { return m }
+
+// KeyValueDeleteRequestValidationError is the validation error returned by
+// KeyValueDeleteRequest.Validate if the designated constraints aren't met.
+type KeyValueDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueDeleteRequestValidationError) ErrorName() string {
+	return "KeyValueDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueDeleteRequestValidationError{}
+
+// Validate checks the field values on KeyValueDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueDeleteResponseMultiError, or nil if none found.
+func (m *KeyValueDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return KeyValueDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by KeyValueDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type KeyValueDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueDeleteResponseMultiError) AllErrors() []error { return m }
+
+// KeyValueDeleteResponseValidationError is the validation error returned by
+// KeyValueDeleteResponse.Validate if the designated constraints aren't met.
+type KeyValueDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueDeleteResponseValidationError) ErrorName() string {
+	return "KeyValueDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueDeleteResponseValidationError{}
+
+// Validate checks the field values on KeyValueIncrementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueIncrementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueIncrementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueIncrementRequestMultiError, or nil if none found.
+func (m *KeyValueIncrementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueIncrementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetKey()); l < 1 || l > 256 {
+		err := KeyValueIncrementRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Delta
+
+	if len(errors) > 0 {
+		return KeyValueIncrementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueIncrementRequestMultiError is an error wrapping multiple validation
+// errors returned by KeyValueIncrementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type KeyValueIncrementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueIncrementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueIncrementRequestMultiError) AllErrors() []error { return m }
+
+// KeyValueIncrementRequestValidationError is the validation error returned by
+// KeyValueIncrementRequest.Validate if the designated constraints aren't met.
+type KeyValueIncrementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueIncrementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueIncrementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueIncrementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueIncrementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueIncrementRequestValidationError) ErrorName() string {
+	return "KeyValueIncrementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueIncrementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueIncrementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueIncrementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueIncrementRequestValidationError{}
+
+// Validate checks the field values on KeyValueIncrementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KeyValueIncrementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValueIncrementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KeyValueIncrementResponseMultiError, or nil if none found.
+func (m *KeyValueIncrementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValueIncrementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return KeyValueIncrementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueIncrementResponseMultiError is an error wrapping multiple validation
+// errors returned by KeyValueIncrementResponse.ValidateAll() if the
+// designated constraints aren't met.
+type KeyValueIncrementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueIncrementResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueIncrementResponseMultiError) AllErrors() []error { return m }
+
+// KeyValueIncrementResponseValidationError is the validation error returned by
+// KeyValueIncrementResponse.Validate if the designated constraints aren't met.
+type KeyValueIncrementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueIncrementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueIncrementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueIncrementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueIncrementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueIncrementResponseValidationError) ErrorName() string {
+	return "KeyValueIncrementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KeyValueIncrementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValueIncrementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueIncrementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueIncrementResponseValidationError{}
@@ -654,6 +654,8 @@ func (m *QueueReceiveRequest) validate(all bool) error {
 
 	// no validation rules for Depth
 
+	// no validation rules for Attributes
+
 	if len(errors) > 0 {
 		return QueueReceiveRequestMultiError(errors)
 	}
@@ -1111,72 +1113,210 @@ var _ interface {
 	ErrorName() string
 } = QueueCompleteResponseValidationError{}
 
-// Validate checks the field values on FailedTask with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FailedTask) Validate() error {
+// Validate checks the field values on QueueReleaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueReleaseRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FailedTask with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FailedTaskMultiError, or
-// nil if none found.
-func (m *FailedTask) ValidateAll() error {
+// ValidateAll checks the field values on QueueReleaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueReleaseRequestMultiError, or nil if none found.
+func (m *QueueReleaseRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FailedTask) validate(all bool) error {
+func (m *QueueReleaseRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FailedTaskValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, FailedTaskValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetQueue()) > 256 {
+		err := QueueReleaseRequestValidationError{
+			field:  "Queue",
+			reason: "value length must be at most 256 bytes",
 		}
-	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FailedTaskValidationError{
-				field:  "Task",
-				reason: "embedded message failed validation",
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_QueueReleaseRequest_Queue_Pattern.MatchString(m.GetQueue()) {
+		err := QueueReleaseRequestValidationError{
+			field:  "Queue",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLeaseId()) < 1 {
+		err := QueueReleaseRequestValidationError{
+			field:  "LeaseId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDelay(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = QueueReleaseRequestValidationError{
+				field:  "Delay",
+				reason: "value is not a valid duration",
 				cause:  err,
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(43200*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := QueueReleaseRequestValidationError{
+					field:  "Delay",
+					reason: "value must be inside range [0s, 12h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
 		}
 	}
 
-	// no validation rules for Message
+	if len(errors) > 0 {
+		return QueueReleaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueReleaseRequestMultiError is an error wrapping multiple validation
+// errors returned by QueueReleaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueueReleaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueReleaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueReleaseRequestMultiError) AllErrors() []error { return m }
+
+// QueueReleaseRequestValidationError is the validation error returned by
+// QueueReleaseRequest.Validate if the designated constraints aren't met.
+type QueueReleaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueReleaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueReleaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueReleaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueReleaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueReleaseRequestValidationError) ErrorName() string {
+	return "QueueReleaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueReleaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueReleaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueReleaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueReleaseRequestValidationError{}
+
+var _QueueReleaseRequest_Queue_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on QueueReleaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueReleaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueReleaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueReleaseResponseMultiError, or nil if none found.
+func (m *QueueReleaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueReleaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if len(errors) > 0 {
-		return FailedTaskMultiError(errors)
+		return QueueReleaseResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FailedTaskMultiError is an error wrapping multiple validation errors
-// returned by FailedTask.ValidateAll() if the designated constraints aren't met.
-type FailedTaskMultiError []error
+// QueueReleaseResponseMultiError is an error wrapping multiple validation
+// errors returned by QueueReleaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueueReleaseResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FailedTaskMultiError) Error() string {
+func (m QueueReleaseResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1185,11 +1325,11 @@ func (m FailedTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FailedTaskMultiError) AllErrors() []error { return m }
+func (m QueueReleaseResponseMultiError) AllErrors() []error { return m }
 
-// FailedTaskValidationError is the validation error returned by
-// FailedTask.Validate if the designated constraints aren't met.
-type FailedTaskValidationError struct {
+// QueueReleaseResponseValidationError is the validation error returned by
+// QueueReleaseResponse.Validate if the designated constraints aren't met.
+type QueueReleaseResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1197,22 +1337,24 @@ type FailedTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e FailedTaskValidationError) Field() string { return e.field }
+func (e QueueReleaseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FailedTaskValidationError) Reason() string { return e.reason }
+func (e QueueReleaseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FailedTaskValidationError) Cause() error { return e.cause }
+func (e QueueReleaseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FailedTaskValidationError) Key() bool { return e.key }
+func (e QueueReleaseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FailedTaskValidationError) ErrorName() string { return "FailedTaskValidationError" }
+func (e QueueReleaseResponseValidationError) ErrorName() string {
+	return "QueueReleaseResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FailedTaskValidationError) Error() string {
+func (e QueueReleaseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1224,14 +1366,14 @@ func (e FailedTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFailedTask.%s: %s%s",
+		"invalid %sQueueReleaseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FailedTaskValidationError{}
+var _ error = QueueReleaseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1239,64 +1381,853 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FailedTaskValidationError{}
+} = QueueReleaseResponseValidationError{}
 
-// Validate checks the field values on NitricTask with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NitricTask) Validate() error {
+// Validate checks the field values on QueueCompleteBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueCompleteBatchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NitricTask with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NitricTaskMultiError, or
-// nil if none found.
-func (m *NitricTask) ValidateAll() error {
+// ValidateAll checks the field values on QueueCompleteBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueCompleteBatchRequestMultiError, or nil if none found.
+func (m *QueueCompleteBatchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NitricTask) validate(all bool) error {
+func (m *QueueCompleteBatchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if len(m.GetQueue()) > 256 {
+		err := QueueCompleteBatchRequestValidationError{
+			field:  "Queue",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for LeaseId
+	if !_QueueCompleteBatchRequest_Queue_Pattern.MatchString(m.GetQueue()) {
+		err := QueueCompleteBatchRequestValidationError{
+			field:  "Queue",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PayloadType
+	if len(m.GetLeaseIds()) < 1 {
+		err := QueueCompleteBatchRequestValidationError{
+			field:  "LeaseIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetPayload()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NitricTaskValidationError{
-					field:  "Payload",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NitricTaskValidationError{
-					field:  "Payload",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetLeaseIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := QueueCompleteBatchRequestValidationError{
+				field:  fmt.Sprintf("LeaseIds[%v]", idx),
+				reason: "value length must be at least 1 runes",
 			}
-		}
-	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NitricTaskValidationError{
-				field:  "Payload",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueueCompleteBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueCompleteBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by QueueCompleteBatchRequest.ValidateAll() if the
+// designated constraints aren't met.
+type QueueCompleteBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueCompleteBatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueCompleteBatchRequestMultiError) AllErrors() []error { return m }
+
+// QueueCompleteBatchRequestValidationError is the validation error returned by
+// QueueCompleteBatchRequest.Validate if the designated constraints aren't met.
+type QueueCompleteBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueCompleteBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueCompleteBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueCompleteBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueCompleteBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueCompleteBatchRequestValidationError) ErrorName() string {
+	return "QueueCompleteBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueCompleteBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueCompleteBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueCompleteBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueCompleteBatchRequestValidationError{}
+
+var _QueueCompleteBatchRequest_Queue_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on QueueCompleteBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueCompleteBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueCompleteBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueCompleteBatchResponseMultiError, or nil if none found.
+func (m *QueueCompleteBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueCompleteBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFailedLeases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueCompleteBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedLeases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueCompleteBatchResponseValidationError{
+						field:  fmt.Sprintf("FailedLeases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueCompleteBatchResponseValidationError{
+					field:  fmt.Sprintf("FailedLeases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueueCompleteBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueCompleteBatchResponseMultiError is an error wrapping multiple
+// validation errors returned by QueueCompleteBatchResponse.ValidateAll() if
+// the designated constraints aren't met.
+type QueueCompleteBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueCompleteBatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueCompleteBatchResponseMultiError) AllErrors() []error { return m }
+
+// QueueCompleteBatchResponseValidationError is the validation error returned
+// by QueueCompleteBatchResponse.Validate if the designated constraints aren't met.
+type QueueCompleteBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueCompleteBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueCompleteBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueCompleteBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueCompleteBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueCompleteBatchResponseValidationError) ErrorName() string {
+	return "QueueCompleteBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueCompleteBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueCompleteBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueCompleteBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueCompleteBatchResponseValidationError{}
+
+// Validate checks the field values on QueueGetStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueGetStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueGetStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueGetStatsRequestMultiError, or nil if none found.
+func (m *QueueGetStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueGetStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetQueue()) > 256 {
+		err := QueueGetStatsRequestValidationError{
+			field:  "Queue",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_QueueGetStatsRequest_Queue_Pattern.MatchString(m.GetQueue()) {
+		err := QueueGetStatsRequestValidationError{
+			field:  "Queue",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QueueGetStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueGetStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by QueueGetStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueueGetStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueGetStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueGetStatsRequestMultiError) AllErrors() []error { return m }
+
+// QueueGetStatsRequestValidationError is the validation error returned by
+// QueueGetStatsRequest.Validate if the designated constraints aren't met.
+type QueueGetStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueGetStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueGetStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueGetStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueGetStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueGetStatsRequestValidationError) ErrorName() string {
+	return "QueueGetStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueGetStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueGetStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueGetStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueGetStatsRequestValidationError{}
+
+var _QueueGetStatsRequest_Queue_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on QueueGetStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueueGetStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueGetStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueGetStatsResponseMultiError, or nil if none found.
+func (m *QueueGetStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueGetStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Depth
+
+	// no validation rules for InFlight
+
+	if all {
+		switch v := interface{}(m.GetOldestTaskAge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueueGetStatsResponseValidationError{
+					field:  "OldestTaskAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueueGetStatsResponseValidationError{
+					field:  "OldestTaskAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldestTaskAge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueueGetStatsResponseValidationError{
+				field:  "OldestTaskAge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QueueGetStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueGetStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by QueueGetStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueueGetStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueGetStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueGetStatsResponseMultiError) AllErrors() []error { return m }
+
+// QueueGetStatsResponseValidationError is the validation error returned by
+// QueueGetStatsResponse.Validate if the designated constraints aren't met.
+type QueueGetStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueGetStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueGetStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueGetStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueGetStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueGetStatsResponseValidationError) ErrorName() string {
+	return "QueueGetStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueGetStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueGetStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueGetStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueGetStatsResponseValidationError{}
+
+// Validate checks the field values on FailedLease with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FailedLease) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailedLease with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FailedLeaseMultiError, or
+// nil if none found.
+func (m *FailedLease) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailedLease) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaseId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return FailedLeaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailedLeaseMultiError is an error wrapping multiple validation errors
+// returned by FailedLease.ValidateAll() if the designated constraints aren't met.
+type FailedLeaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailedLeaseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailedLeaseMultiError) AllErrors() []error { return m }
+
+// FailedLeaseValidationError is the validation error returned by
+// FailedLease.Validate if the designated constraints aren't met.
+type FailedLeaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailedLeaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailedLeaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailedLeaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailedLeaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailedLeaseValidationError) ErrorName() string { return "FailedLeaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FailedLeaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailedLease.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailedLeaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailedLeaseValidationError{}
+
+// Validate checks the field values on FailedTask with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FailedTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailedTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FailedTaskMultiError, or
+// nil if none found.
+func (m *FailedTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailedTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FailedTaskValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FailedTaskValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FailedTaskValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return FailedTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailedTaskMultiError is an error wrapping multiple validation errors
+// returned by FailedTask.ValidateAll() if the designated constraints aren't met.
+type FailedTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailedTaskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailedTaskMultiError) AllErrors() []error { return m }
+
+// FailedTaskValidationError is the validation error returned by
+// FailedTask.Validate if the designated constraints aren't met.
+type FailedTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailedTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailedTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailedTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailedTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailedTaskValidationError) ErrorName() string { return "FailedTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FailedTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailedTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailedTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailedTaskValidationError{}
+
+// Validate checks the field values on NitricTask with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NitricTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NitricTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NitricTaskMultiError, or
+// nil if none found.
+func (m *NitricTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NitricTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LeaseId
+
+	// no validation rules for PayloadType
+
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NitricTaskValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NitricTaskValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NitricTaskValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Attributes
+
+	// no validation rules for ProviderId
+
+	// no validation rules for ReceiveCount
 
 	if len(errors) > 0 {
 		return NitricTaskMultiError(errors)
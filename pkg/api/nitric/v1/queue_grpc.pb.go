@@ -30,6 +30,12 @@ type QueueServiceClient interface {
 	Receive(ctx context.Context, in *QueueReceiveRequest, opts ...grpc.CallOption) (*QueueReceiveResponse, error)
 	// Complete an event previously popped from a queue
 	Complete(ctx context.Context, in *QueueCompleteRequest, opts ...grpc.CallOption) (*QueueCompleteResponse, error)
+	// Complete multiple events previously popped from a queue
+	CompleteBatch(ctx context.Context, in *QueueCompleteBatchRequest, opts ...grpc.CallOption) (*QueueCompleteBatchResponse, error)
+	// Return an event previously popped from a queue, to be redelivered after a delay
+	Release(ctx context.Context, in *QueueReleaseRequest, opts ...grpc.CallOption) (*QueueReleaseResponse, error)
+	// Get the approximate backlog of a queue
+	GetStats(ctx context.Context, in *QueueGetStatsRequest, opts ...grpc.CallOption) (*QueueGetStatsResponse, error)
 }
 
 type queueServiceClient struct {
@@ -76,6 +82,33 @@ func (c *queueServiceClient) Complete(ctx context.Context, in *QueueCompleteRequ
 	return out, nil
 }
 
+func (c *queueServiceClient) CompleteBatch(ctx context.Context, in *QueueCompleteBatchRequest, opts ...grpc.CallOption) (*QueueCompleteBatchResponse, error) {
+	out := new(QueueCompleteBatchResponse)
+	err := c.cc.Invoke(ctx, "/nitric.queue.v1.QueueService/CompleteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) Release(ctx context.Context, in *QueueReleaseRequest, opts ...grpc.CallOption) (*QueueReleaseResponse, error) {
+	out := new(QueueReleaseResponse)
+	err := c.cc.Invoke(ctx, "/nitric.queue.v1.QueueService/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) GetStats(ctx context.Context, in *QueueGetStatsRequest, opts ...grpc.CallOption) (*QueueGetStatsResponse, error) {
+	out := new(QueueGetStatsResponse)
+	err := c.cc.Invoke(ctx, "/nitric.queue.v1.QueueService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServiceServer is the server API for QueueService service.
 // All implementations must embed UnimplementedQueueServiceServer
 // for forward compatibility
@@ -88,6 +121,12 @@ type QueueServiceServer interface {
 	Receive(context.Context, *QueueReceiveRequest) (*QueueReceiveResponse, error)
 	// Complete an event previously popped from a queue
 	Complete(context.Context, *QueueCompleteRequest) (*QueueCompleteResponse, error)
+	// Complete multiple events previously popped from a queue
+	CompleteBatch(context.Context, *QueueCompleteBatchRequest) (*QueueCompleteBatchResponse, error)
+	// Return an event previously popped from a queue, to be redelivered after a delay
+	Release(context.Context, *QueueReleaseRequest) (*QueueReleaseResponse, error)
+	// Get the approximate backlog of a queue
+	GetStats(context.Context, *QueueGetStatsRequest) (*QueueGetStatsResponse, error)
 	mustEmbedUnimplementedQueueServiceServer()
 }
 
@@ -107,6 +146,15 @@ func (UnimplementedQueueServiceServer) Receive(context.Context, *QueueReceiveReq
 func (UnimplementedQueueServiceServer) Complete(context.Context, *QueueCompleteRequest) (*QueueCompleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Complete not implemented")
 }
+func (UnimplementedQueueServiceServer) CompleteBatch(context.Context, *QueueCompleteBatchRequest) (*QueueCompleteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteBatch not implemented")
+}
+func (UnimplementedQueueServiceServer) Release(context.Context, *QueueReleaseRequest) (*QueueReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedQueueServiceServer) GetStats(context.Context, *QueueGetStatsRequest) (*QueueGetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
 func (UnimplementedQueueServiceServer) mustEmbedUnimplementedQueueServiceServer() {}
 
 // UnsafeQueueServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +240,60 @@ func _QueueService_Complete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueueService_CompleteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueCompleteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).CompleteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.queue.v1.QueueService/CompleteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).CompleteBatch(ctx, req.(*QueueCompleteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.queue.v1.QueueService/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).Release(ctx, req.(*QueueReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueGetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.queue.v1.QueueService/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).GetStats(ctx, req.(*QueueGetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueueService_ServiceDesc is the grpc.ServiceDesc for QueueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +317,18 @@ var QueueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Complete",
 			Handler:    _QueueService_Complete_Handler,
 		},
+		{
+			MethodName: "CompleteBatch",
+			Handler:    _QueueService_CompleteBatch_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _QueueService_Release_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _QueueService_GetStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "queue/v1/queue.proto",
@@ -0,0 +1,1446 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: search/v1/search.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SearchIndexRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchIndexRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchIndexRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchIndexRequestMultiError, or nil if none found.
+func (m *SearchIndexRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchIndexRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIndex()) > 256 {
+		err := SearchIndexRequestValidationError{
+			field:  "Index",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchIndexRequest_Index_Pattern.MatchString(m.GetIndex()) {
+		err := SearchIndexRequestValidationError{
+			field:  "Index",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SearchIndexRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 512 {
+		err := SearchIndexRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 512 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := SearchIndexRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchIndexRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchIndexRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchIndexRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchIndexRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchIndexRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchIndexRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchIndexRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchIndexRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchIndexRequestMultiError) AllErrors() []error { return m }
+
+// SearchIndexRequestValidationError is the validation error returned by
+// SearchIndexRequest.Validate if the designated constraints aren't met.
+type SearchIndexRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchIndexRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchIndexRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchIndexRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchIndexRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchIndexRequestValidationError) ErrorName() string {
+	return "SearchIndexRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchIndexRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchIndexRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchIndexRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchIndexRequestValidationError{}
+
+var _SearchIndexRequest_Index_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SearchIndexResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchIndexResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchIndexResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchIndexResponseMultiError, or nil if none found.
+func (m *SearchIndexResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchIndexResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SearchIndexResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchIndexResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchIndexResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchIndexResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchIndexResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchIndexResponseMultiError) AllErrors() []error { return m }
+
+// SearchIndexResponseValidationError is the validation error returned by
+// SearchIndexResponse.Validate if the designated constraints aren't met.
+type SearchIndexResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchIndexResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchIndexResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchIndexResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchIndexResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchIndexResponseValidationError) ErrorName() string {
+	return "SearchIndexResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchIndexResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchIndexResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchIndexResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchIndexResponseValidationError{}
+
+// Validate checks the field values on SearchDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchDeleteRequestMultiError, or nil if none found.
+func (m *SearchDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIndex()) > 256 {
+		err := SearchDeleteRequestValidationError{
+			field:  "Index",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchDeleteRequest_Index_Pattern.MatchString(m.GetIndex()) {
+		err := SearchDeleteRequestValidationError{
+			field:  "Index",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SearchDeleteRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 512 {
+		err := SearchDeleteRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 512 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchDeleteRequestMultiError) AllErrors() []error { return m }
+
+// SearchDeleteRequestValidationError is the validation error returned by
+// SearchDeleteRequest.Validate if the designated constraints aren't met.
+type SearchDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchDeleteRequestValidationError) ErrorName() string {
+	return "SearchDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchDeleteRequestValidationError{}
+
+var _SearchDeleteRequest_Index_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SearchDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchDeleteResponseMultiError, or nil if none found.
+func (m *SearchDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SearchDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchDeleteResponseMultiError) AllErrors() []error { return m }
+
+// SearchDeleteResponseValidationError is the validation error returned by
+// SearchDeleteResponse.Validate if the designated constraints aren't met.
+type SearchDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchDeleteResponseValidationError) ErrorName() string {
+	return "SearchDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchDeleteResponseValidationError{}
+
+// Validate checks the field values on SearchFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchFilterMultiError, or
+// nil if none found.
+func (m *SearchFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		err := SearchFilterValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValue() == nil {
+		err := SearchFilterValidationError{
+			field:  "Value",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchFilterValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchFilterValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchFilterValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFilterMultiError is an error wrapping multiple validation errors
+// returned by SearchFilter.ValidateAll() if the designated constraints aren't met.
+type SearchFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFilterMultiError) AllErrors() []error { return m }
+
+// SearchFilterValidationError is the validation error returned by
+// SearchFilter.Validate if the designated constraints aren't met.
+type SearchFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFilterValidationError) ErrorName() string { return "SearchFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFilterValidationError{}
+
+// Validate checks the field values on SearchQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchQueryRequestMultiError, or nil if none found.
+func (m *SearchQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIndex()) > 256 {
+		err := SearchQueryRequestValidationError{
+			field:  "Index",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchQueryRequest_Index_Pattern.MatchString(m.GetIndex()) {
+		err := SearchQueryRequestValidationError{
+			field:  "Index",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Text
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchQueryRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchQueryRequestValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchQueryRequestValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := SearchQueryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return SearchQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchQueryRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchQueryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchQueryRequestMultiError) AllErrors() []error { return m }
+
+// SearchQueryRequestValidationError is the validation error returned by
+// SearchQueryRequest.Validate if the designated constraints aren't met.
+type SearchQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchQueryRequestValidationError) ErrorName() string {
+	return "SearchQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchQueryRequestValidationError{}
+
+var _SearchQueryRequest_Index_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Score
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on SearchFacetValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchFacetValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFacetValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchFacetValueMultiError, or nil if none found.
+func (m *SearchFacetValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFacetValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return SearchFacetValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFacetValueMultiError is an error wrapping multiple validation errors
+// returned by SearchFacetValue.ValidateAll() if the designated constraints
+// aren't met.
+type SearchFacetValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFacetValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFacetValueMultiError) AllErrors() []error { return m }
+
+// SearchFacetValueValidationError is the validation error returned by
+// SearchFacetValue.Validate if the designated constraints aren't met.
+type SearchFacetValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFacetValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFacetValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFacetValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFacetValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFacetValueValidationError) ErrorName() string { return "SearchFacetValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFacetValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFacetValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFacetValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFacetValueValidationError{}
+
+// Validate checks the field values on SearchFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchFacet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFacet with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchFacetMultiError, or
+// nil if none found.
+func (m *SearchFacet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFacet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchFacetMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFacetMultiError is an error wrapping multiple validation errors
+// returned by SearchFacet.ValidateAll() if the designated constraints aren't met.
+type SearchFacetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFacetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFacetMultiError) AllErrors() []error { return m }
+
+// SearchFacetValidationError is the validation error returned by
+// SearchFacet.Validate if the designated constraints aren't met.
+type SearchFacetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFacetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFacetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFacetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFacetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFacetValidationError) ErrorName() string { return "SearchFacetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFacetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFacet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFacetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFacetValidationError{}
+
+// Validate checks the field values on SearchQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchQueryResponseMultiError, or nil if none found.
+func (m *SearchQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchQueryResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchQueryResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchQueryResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetFacets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchQueryResponseValidationError{
+						field:  fmt.Sprintf("Facets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchQueryResponseValidationError{
+						field:  fmt.Sprintf("Facets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchQueryResponseValidationError{
+					field:  fmt.Sprintf("Facets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PagingToken
+
+	if len(errors) > 0 {
+		return SearchQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchQueryResponseMultiError) AllErrors() []error { return m }
+
+// SearchQueryResponseValidationError is the validation error returned by
+// SearchQueryResponse.Validate if the designated constraints aren't met.
+type SearchQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchQueryResponseValidationError) ErrorName() string {
+	return "SearchQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchQueryResponseValidationError{}
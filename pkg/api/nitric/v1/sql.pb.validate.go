@@ -0,0 +1,1268 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: sql/v1/sql.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SqlQueryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlQueryRequestMultiError, or nil if none found.
+func (m *SqlQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDatabase()) > 256 {
+		err := SqlQueryRequestValidationError{
+			field:  "Database",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SqlQueryRequest_Database_Pattern.MatchString(m.GetDatabase()) {
+		err := SqlQueryRequestValidationError{
+			field:  "Database",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) < 1 {
+		err := SqlQueryRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SqlQueryRequestValidationError{
+						field:  fmt.Sprintf("Params[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SqlQueryRequestValidationError{
+						field:  fmt.Sprintf("Params[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SqlQueryRequestValidationError{
+					field:  fmt.Sprintf("Params[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TransactionId
+
+	if len(errors) > 0 {
+		return SqlQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlQueryRequestMultiError is an error wrapping multiple validation errors
+// returned by SqlQueryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SqlQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlQueryRequestMultiError) AllErrors() []error { return m }
+
+// SqlQueryRequestValidationError is the validation error returned by
+// SqlQueryRequest.Validate if the designated constraints aren't met.
+type SqlQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlQueryRequestValidationError) ErrorName() string { return "SqlQueryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlQueryRequestValidationError{}
+
+var _SqlQueryRequest_Database_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SqlQueryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlQueryResponseMultiError, or nil if none found.
+func (m *SqlQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SqlQueryResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SqlQueryResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SqlQueryResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SqlQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlQueryResponseMultiError is an error wrapping multiple validation errors
+// returned by SqlQueryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SqlQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlQueryResponseMultiError) AllErrors() []error { return m }
+
+// SqlQueryResponseValidationError is the validation error returned by
+// SqlQueryResponse.Validate if the designated constraints aren't met.
+type SqlQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlQueryResponseValidationError) ErrorName() string { return "SqlQueryResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlQueryResponseValidationError{}
+
+// Validate checks the field values on SqlExecRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SqlExecRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlExecRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SqlExecRequestMultiError,
+// or nil if none found.
+func (m *SqlExecRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlExecRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDatabase()) > 256 {
+		err := SqlExecRequestValidationError{
+			field:  "Database",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SqlExecRequest_Database_Pattern.MatchString(m.GetDatabase()) {
+		err := SqlExecRequestValidationError{
+			field:  "Database",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStatement()) < 1 {
+		err := SqlExecRequestValidationError{
+			field:  "Statement",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SqlExecRequestValidationError{
+						field:  fmt.Sprintf("Params[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SqlExecRequestValidationError{
+						field:  fmt.Sprintf("Params[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SqlExecRequestValidationError{
+					field:  fmt.Sprintf("Params[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TransactionId
+
+	if len(errors) > 0 {
+		return SqlExecRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlExecRequestMultiError is an error wrapping multiple validation errors
+// returned by SqlExecRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SqlExecRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlExecRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlExecRequestMultiError) AllErrors() []error { return m }
+
+// SqlExecRequestValidationError is the validation error returned by
+// SqlExecRequest.Validate if the designated constraints aren't met.
+type SqlExecRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlExecRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlExecRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlExecRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlExecRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlExecRequestValidationError) ErrorName() string { return "SqlExecRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlExecRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlExecRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlExecRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlExecRequestValidationError{}
+
+var _SqlExecRequest_Database_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SqlExecResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlExecResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlExecResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlExecResponseMultiError, or nil if none found.
+func (m *SqlExecResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlExecResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowsAffected
+
+	if len(errors) > 0 {
+		return SqlExecResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlExecResponseMultiError is an error wrapping multiple validation errors
+// returned by SqlExecResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SqlExecResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlExecResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlExecResponseMultiError) AllErrors() []error { return m }
+
+// SqlExecResponseValidationError is the validation error returned by
+// SqlExecResponse.Validate if the designated constraints aren't met.
+type SqlExecResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlExecResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlExecResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlExecResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlExecResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlExecResponseValidationError) ErrorName() string { return "SqlExecResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlExecResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlExecResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlExecResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlExecResponseValidationError{}
+
+// Validate checks the field values on SqlBeginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlBeginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlBeginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlBeginRequestMultiError, or nil if none found.
+func (m *SqlBeginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlBeginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDatabase()) > 256 {
+		err := SqlBeginRequestValidationError{
+			field:  "Database",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SqlBeginRequest_Database_Pattern.MatchString(m.GetDatabase()) {
+		err := SqlBeginRequestValidationError{
+			field:  "Database",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SqlBeginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlBeginRequestMultiError is an error wrapping multiple validation errors
+// returned by SqlBeginRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SqlBeginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlBeginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlBeginRequestMultiError) AllErrors() []error { return m }
+
+// SqlBeginRequestValidationError is the validation error returned by
+// SqlBeginRequest.Validate if the designated constraints aren't met.
+type SqlBeginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlBeginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlBeginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlBeginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlBeginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlBeginRequestValidationError) ErrorName() string { return "SqlBeginRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlBeginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlBeginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlBeginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlBeginRequestValidationError{}
+
+var _SqlBeginRequest_Database_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on SqlBeginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlBeginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlBeginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlBeginResponseMultiError, or nil if none found.
+func (m *SqlBeginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlBeginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionId
+
+	if len(errors) > 0 {
+		return SqlBeginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlBeginResponseMultiError is an error wrapping multiple validation errors
+// returned by SqlBeginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SqlBeginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlBeginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlBeginResponseMultiError) AllErrors() []error { return m }
+
+// SqlBeginResponseValidationError is the validation error returned by
+// SqlBeginResponse.Validate if the designated constraints aren't met.
+type SqlBeginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlBeginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlBeginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlBeginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlBeginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlBeginResponseValidationError) ErrorName() string { return "SqlBeginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlBeginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlBeginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlBeginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlBeginResponseValidationError{}
+
+// Validate checks the field values on SqlCommitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlCommitRequestMultiError, or nil if none found.
+func (m *SqlCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTransactionId()) < 1 {
+		err := SqlCommitRequestValidationError{
+			field:  "TransactionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SqlCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlCommitRequestMultiError is an error wrapping multiple validation errors
+// returned by SqlCommitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SqlCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlCommitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlCommitRequestMultiError) AllErrors() []error { return m }
+
+// SqlCommitRequestValidationError is the validation error returned by
+// SqlCommitRequest.Validate if the designated constraints aren't met.
+type SqlCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlCommitRequestValidationError) ErrorName() string { return "SqlCommitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlCommitRequestValidationError{}
+
+// Validate checks the field values on SqlCommitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlCommitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlCommitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlCommitResponseMultiError, or nil if none found.
+func (m *SqlCommitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlCommitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SqlCommitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlCommitResponseMultiError is an error wrapping multiple validation errors
+// returned by SqlCommitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SqlCommitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlCommitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlCommitResponseMultiError) AllErrors() []error { return m }
+
+// SqlCommitResponseValidationError is the validation error returned by
+// SqlCommitResponse.Validate if the designated constraints aren't met.
+type SqlCommitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlCommitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlCommitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlCommitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlCommitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlCommitResponseValidationError) ErrorName() string {
+	return "SqlCommitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqlCommitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlCommitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlCommitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlCommitResponseValidationError{}
+
+// Validate checks the field values on SqlRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqlRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlRollbackRequestMultiError, or nil if none found.
+func (m *SqlRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTransactionId()) < 1 {
+		err := SqlRollbackRequestValidationError{
+			field:  "TransactionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SqlRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlRollbackRequestMultiError is an error wrapping multiple validation errors
+// returned by SqlRollbackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SqlRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlRollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlRollbackRequestMultiError) AllErrors() []error { return m }
+
+// SqlRollbackRequestValidationError is the validation error returned by
+// SqlRollbackRequest.Validate if the designated constraints aren't met.
+type SqlRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlRollbackRequestValidationError) ErrorName() string {
+	return "SqlRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqlRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlRollbackRequestValidationError{}
+
+// Validate checks the field values on SqlRollbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqlRollbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlRollbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlRollbackResponseMultiError, or nil if none found.
+func (m *SqlRollbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlRollbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SqlRollbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlRollbackResponseMultiError is an error wrapping multiple validation
+// errors returned by SqlRollbackResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SqlRollbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlRollbackResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlRollbackResponseMultiError) AllErrors() []error { return m }
+
+// SqlRollbackResponseValidationError is the validation error returned by
+// SqlRollbackResponse.Validate if the designated constraints aren't met.
+type SqlRollbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlRollbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlRollbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlRollbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlRollbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlRollbackResponseValidationError) ErrorName() string {
+	return "SqlRollbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqlRollbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlRollbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlRollbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlRollbackResponseValidationError{}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: sql/v1/sql.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SqlServiceClient is the client API for SqlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SqlServiceClient interface {
+	// Run a query that returns rows, e.g. SELECT
+	Query(ctx context.Context, in *SqlQueryRequest, opts ...grpc.CallOption) (*SqlQueryResponse, error)
+	// Run a statement that doesn't return rows, e.g. INSERT, UPDATE or DELETE
+	Exec(ctx context.Context, in *SqlExecRequest, opts ...grpc.CallOption) (*SqlExecResponse, error)
+	// Begin a transaction, queries and statements run in the transaction until it's committed or rolled back
+	Begin(ctx context.Context, in *SqlBeginRequest, opts ...grpc.CallOption) (*SqlBeginResponse, error)
+	// Commit a transaction
+	Commit(ctx context.Context, in *SqlCommitRequest, opts ...grpc.CallOption) (*SqlCommitResponse, error)
+	// Roll back a transaction
+	Rollback(ctx context.Context, in *SqlRollbackRequest, opts ...grpc.CallOption) (*SqlRollbackResponse, error)
+}
+
+type sqlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSqlServiceClient(cc grpc.ClientConnInterface) SqlServiceClient {
+	return &sqlServiceClient{cc}
+}
+
+func (c *sqlServiceClient) Query(ctx context.Context, in *SqlQueryRequest, opts ...grpc.CallOption) (*SqlQueryResponse, error) {
+	out := new(SqlQueryResponse)
+	err := c.cc.Invoke(ctx, "/nitric.sql.v1.SqlService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sqlServiceClient) Exec(ctx context.Context, in *SqlExecRequest, opts ...grpc.CallOption) (*SqlExecResponse, error) {
+	out := new(SqlExecResponse)
+	err := c.cc.Invoke(ctx, "/nitric.sql.v1.SqlService/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sqlServiceClient) Begin(ctx context.Context, in *SqlBeginRequest, opts ...grpc.CallOption) (*SqlBeginResponse, error) {
+	out := new(SqlBeginResponse)
+	err := c.cc.Invoke(ctx, "/nitric.sql.v1.SqlService/Begin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sqlServiceClient) Commit(ctx context.Context, in *SqlCommitRequest, opts ...grpc.CallOption) (*SqlCommitResponse, error) {
+	out := new(SqlCommitResponse)
+	err := c.cc.Invoke(ctx, "/nitric.sql.v1.SqlService/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sqlServiceClient) Rollback(ctx context.Context, in *SqlRollbackRequest, opts ...grpc.CallOption) (*SqlRollbackResponse, error) {
+	out := new(SqlRollbackResponse)
+	err := c.cc.Invoke(ctx, "/nitric.sql.v1.SqlService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SqlServiceServer is the server API for SqlService service.
+// All implementations must embed UnimplementedSqlServiceServer
+// for forward compatibility
+type SqlServiceServer interface {
+	// Run a query that returns rows, e.g. SELECT
+	Query(context.Context, *SqlQueryRequest) (*SqlQueryResponse, error)
+	// Run a statement that doesn't return rows, e.g. INSERT, UPDATE or DELETE
+	Exec(context.Context, *SqlExecRequest) (*SqlExecResponse, error)
+	// Begin a transaction, queries and statements run in the transaction until it's committed or rolled back
+	Begin(context.Context, *SqlBeginRequest) (*SqlBeginResponse, error)
+	// Commit a transaction
+	Commit(context.Context, *SqlCommitRequest) (*SqlCommitResponse, error)
+	// Roll back a transaction
+	Rollback(context.Context, *SqlRollbackRequest) (*SqlRollbackResponse, error)
+	mustEmbedUnimplementedSqlServiceServer()
+}
+
+// UnimplementedSqlServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSqlServiceServer struct {
+}
+
+func (UnimplementedSqlServiceServer) Query(context.Context, *SqlQueryRequest) (*SqlQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedSqlServiceServer) Exec(context.Context, *SqlExecRequest) (*SqlExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedSqlServiceServer) Begin(context.Context, *SqlBeginRequest) (*SqlBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Begin not implemented")
+}
+func (UnimplementedSqlServiceServer) Commit(context.Context, *SqlCommitRequest) (*SqlCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedSqlServiceServer) Rollback(context.Context, *SqlRollbackRequest) (*SqlRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedSqlServiceServer) mustEmbedUnimplementedSqlServiceServer() {}
+
+// UnsafeSqlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SqlServiceServer will
+// result in compilation errors.
+type UnsafeSqlServiceServer interface {
+	mustEmbedUnimplementedSqlServiceServer()
+}
+
+func RegisterSqlServiceServer(s grpc.ServiceRegistrar, srv SqlServiceServer) {
+	s.RegisterService(&SqlService_ServiceDesc, srv)
+}
+
+func _SqlService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqlQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SqlServiceServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.sql.v1.SqlService/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SqlServiceServer).Query(ctx, req.(*SqlQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SqlService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqlExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SqlServiceServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.sql.v1.SqlService/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SqlServiceServer).Exec(ctx, req.(*SqlExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SqlService_Begin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqlBeginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SqlServiceServer).Begin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.sql.v1.SqlService/Begin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SqlServiceServer).Begin(ctx, req.(*SqlBeginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SqlService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqlCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SqlServiceServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.sql.v1.SqlService/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SqlServiceServer).Commit(ctx, req.(*SqlCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SqlService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqlRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SqlServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.sql.v1.SqlService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SqlServiceServer).Rollback(ctx, req.(*SqlRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SqlService_ServiceDesc is the grpc.ServiceDesc for SqlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SqlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.sql.v1.SqlService",
+	HandlerType: (*SqlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Query",
+			Handler:    _SqlService_Query_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _SqlService_Exec_Handler,
+		},
+		{
+			MethodName: "Begin",
+			Handler:    _SqlService_Begin_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _SqlService_Commit_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _SqlService_Rollback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sql/v1/sql.proto",
+}
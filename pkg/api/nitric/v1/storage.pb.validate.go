@@ -92,6 +92,36 @@ func (m *StorageWriteRequest) validate(all bool) error {
 
 	// no validation rules for Body
 
+	// no validation rules for Compression
+
+	if _, ok := _StorageWriteRequest_StorageClass_InLookup[m.GetStorageClass()]; !ok {
+		err := StorageWriteRequestValidationError{
+			field:  "StorageClass",
+			reason: "value must be in list [ standard infrequent archive]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IfMatch
+
+	// no validation rules for IfNotExists
+
+	if _, ok := _StorageWriteRequest_ChecksumAlgorithm_InLookup[m.GetChecksumAlgorithm()]; !ok {
+		err := StorageWriteRequestValidationError{
+			field:  "ChecksumAlgorithm",
+			reason: "value must be in list [ md5 sha256]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Checksum
+
 	if len(errors) > 0 {
 		return StorageWriteRequestMultiError(errors)
 	}
@@ -174,6 +204,19 @@ var _ interface {
 
 var _StorageWriteRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
 
+var _StorageWriteRequest_StorageClass_InLookup = map[string]struct{}{
+	"":           {},
+	"standard":   {},
+	"infrequent": {},
+	"archive":    {},
+}
+
+var _StorageWriteRequest_ChecksumAlgorithm_InLookup = map[string]struct{}{
+	"":       {},
+	"md5":    {},
+	"sha256": {},
+}
+
 // Validate checks the field values on StorageWriteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -196,6 +239,12 @@ func (m *StorageWriteResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Version
+
+	// no validation rules for Checksum
+
+	// no validation rules for Etag
+
 	if len(errors) > 0 {
 		return StorageWriteResponseMultiError(errors)
 	}
@@ -276,6 +325,247 @@ var _ interface {
 	ErrorName() string
 } = StorageWriteResponseValidationError{}
 
+// Validate checks the field values on StorageAppendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAppendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAppendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAppendRequestMultiError, or nil if none found.
+func (m *StorageAppendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAppendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageAppendRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageAppendRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageAppendRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageAppendRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Body
+
+	if len(errors) > 0 {
+		return StorageAppendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAppendRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageAppendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StorageAppendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAppendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAppendRequestMultiError) AllErrors() []error { return m }
+
+// StorageAppendRequestValidationError is the validation error returned by
+// StorageAppendRequest.Validate if the designated constraints aren't met.
+type StorageAppendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAppendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAppendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAppendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAppendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAppendRequestValidationError) ErrorName() string {
+	return "StorageAppendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAppendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAppendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAppendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAppendRequestValidationError{}
+
+var _StorageAppendRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageAppendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAppendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAppendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAppendResponseMultiError, or nil if none found.
+func (m *StorageAppendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAppendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StorageAppendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAppendResponseMultiError is an error wrapping multiple validation
+// errors returned by StorageAppendResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StorageAppendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAppendResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAppendResponseMultiError) AllErrors() []error { return m }
+
+// StorageAppendResponseValidationError is the validation error returned by
+// StorageAppendResponse.Validate if the designated constraints aren't met.
+type StorageAppendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAppendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAppendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAppendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAppendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAppendResponseValidationError) ErrorName() string {
+	return "StorageAppendResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAppendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAppendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAppendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAppendResponseValidationError{}
+
 // Validate checks the field values on StorageReadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -331,6 +621,21 @@ func (m *StorageReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Version
+
+	if _, ok := _StorageReadRequest_ChecksumAlgorithm_InLookup[m.GetChecksumAlgorithm()]; !ok {
+		err := StorageReadRequestValidationError{
+			field:  "ChecksumAlgorithm",
+			reason: "value must be in list [ md5 sha256]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Checksum
+
 	if len(errors) > 0 {
 		return StorageReadRequestMultiError(errors)
 	}
@@ -413,6 +718,12 @@ var _ interface {
 
 var _StorageReadRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
 
+var _StorageReadRequest_ChecksumAlgorithm_InLookup = map[string]struct{}{
+	"":       {},
+	"md5":    {},
+	"sha256": {},
+}
+
 // Validate checks the field values on StorageReadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -437,6 +748,10 @@ func (m *StorageReadResponse) validate(all bool) error {
 
 	// no validation rules for Body
 
+	// no validation rules for Checksum
+
+	// no validation rules for Etag
+
 	if len(errors) > 0 {
 		return StorageReadResponseMultiError(errors)
 	}
@@ -572,6 +887,8 @@ func (m *StorageDeleteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IfMatch
+
 	if len(errors) > 0 {
 		return StorageDeleteRequestMultiError(errors)
 	}
@@ -1045,6 +1362,8 @@ func (m *StorageListFilesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Tags
+
 	if len(errors) > 0 {
 		return StorageListFilesRequestMultiError(errors)
 	}
@@ -1150,6 +1469,8 @@ func (m *File) validate(all bool) error {
 
 	// no validation rules for Key
 
+	// no validation rules for Etag
+
 	if len(errors) > 0 {
 		return FileMultiError(errors)
 	}
@@ -1362,3 +1683,3055 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = StorageListFilesResponseValidationError{}
+
+// Validate checks the field values on StorageListVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageListVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageListVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageListVersionsRequestMultiError, or nil if none found.
+func (m *StorageListVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageListVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageListVersionsRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageListVersionsRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageListVersionsRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageListVersionsRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageListVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageListVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by StorageListVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StorageListVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageListVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageListVersionsRequestMultiError) AllErrors() []error { return m }
+
+// StorageListVersionsRequestValidationError is the validation error returned
+// by StorageListVersionsRequest.Validate if the designated constraints aren't met.
+type StorageListVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageListVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageListVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageListVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageListVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageListVersionsRequestValidationError) ErrorName() string {
+	return "StorageListVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageListVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageListVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageListVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageListVersionsRequestValidationError{}
+
+var _StorageListVersionsRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on FileVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileVersionMultiError, or
+// nil if none found.
+func (m *FileVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Latest
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileVersionValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return FileVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileVersionMultiError is an error wrapping multiple validation errors
+// returned by FileVersion.ValidateAll() if the designated constraints aren't met.
+type FileVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileVersionMultiError) AllErrors() []error { return m }
+
+// FileVersionValidationError is the validation error returned by
+// FileVersion.Validate if the designated constraints aren't met.
+type FileVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileVersionValidationError) ErrorName() string { return "FileVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileVersionValidationError{}
+
+// Validate checks the field values on StorageListVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageListVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageListVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageListVersionsResponseMultiError, or nil if none found.
+func (m *StorageListVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageListVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageListVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StorageListVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageListVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageListVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageListVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageListVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageListVersionsResponseMultiError) AllErrors() []error { return m }
+
+// StorageListVersionsResponseValidationError is the validation error returned
+// by StorageListVersionsResponse.Validate if the designated constraints
+// aren't met.
+type StorageListVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageListVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageListVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageListVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageListVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageListVersionsResponseValidationError) ErrorName() string {
+	return "StorageListVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageListVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageListVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageListVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageListVersionsResponseValidationError{}
+
+// Validate checks the field values on StorageRestoreVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageRestoreVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageRestoreVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageRestoreVersionRequestMultiError, or nil if none found.
+func (m *StorageRestoreVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageRestoreVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageRestoreVersionRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageRestoreVersionRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageRestoreVersionRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageRestoreVersionRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetVersion()) < 1 {
+		err := StorageRestoreVersionRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageRestoreVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageRestoreVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by StorageRestoreVersionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StorageRestoreVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageRestoreVersionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageRestoreVersionRequestMultiError) AllErrors() []error { return m }
+
+// StorageRestoreVersionRequestValidationError is the validation error returned
+// by StorageRestoreVersionRequest.Validate if the designated constraints
+// aren't met.
+type StorageRestoreVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageRestoreVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageRestoreVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageRestoreVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageRestoreVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageRestoreVersionRequestValidationError) ErrorName() string {
+	return "StorageRestoreVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageRestoreVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageRestoreVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageRestoreVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageRestoreVersionRequestValidationError{}
+
+var _StorageRestoreVersionRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageRestoreVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageRestoreVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageRestoreVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StorageRestoreVersionResponseMultiError, or nil if none found.
+func (m *StorageRestoreVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageRestoreVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return StorageRestoreVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageRestoreVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageRestoreVersionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type StorageRestoreVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageRestoreVersionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageRestoreVersionResponseMultiError) AllErrors() []error { return m }
+
+// StorageRestoreVersionResponseValidationError is the validation error
+// returned by StorageRestoreVersionResponse.Validate if the designated
+// constraints aren't met.
+type StorageRestoreVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageRestoreVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageRestoreVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageRestoreVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageRestoreVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageRestoreVersionResponseValidationError) ErrorName() string {
+	return "StorageRestoreVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageRestoreVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageRestoreVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageRestoreVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageRestoreVersionResponseValidationError{}
+
+// Validate checks the field values on StorageSetTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageSetTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageSetTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageSetTagsRequestMultiError, or nil if none found.
+func (m *StorageSetTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageSetTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageSetTagsRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageSetTagsRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageSetTagsRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageSetTagsRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 10 {
+		err := StorageSetTagsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetTags()))
+		i := 0
+		for key := range m.GetTags() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTags()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 128 {
+				err := StorageSetTagsRequestValidationError{
+					field:  fmt.Sprintf("Tags[%v]", key),
+					reason: "value length must be between 1 and 128 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 256 {
+				err := StorageSetTagsRequestValidationError{
+					field:  fmt.Sprintf("Tags[%v]", key),
+					reason: "value length must be at most 256 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageSetTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageSetTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageSetTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StorageSetTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageSetTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageSetTagsRequestMultiError) AllErrors() []error { return m }
+
+// StorageSetTagsRequestValidationError is the validation error returned by
+// StorageSetTagsRequest.Validate if the designated constraints aren't met.
+type StorageSetTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageSetTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageSetTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageSetTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageSetTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageSetTagsRequestValidationError) ErrorName() string {
+	return "StorageSetTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageSetTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageSetTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageSetTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageSetTagsRequestValidationError{}
+
+var _StorageSetTagsRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageSetTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageSetTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageSetTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageSetTagsResponseMultiError, or nil if none found.
+func (m *StorageSetTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageSetTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StorageSetTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageSetTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by StorageSetTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StorageSetTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageSetTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageSetTagsResponseMultiError) AllErrors() []error { return m }
+
+// StorageSetTagsResponseValidationError is the validation error returned by
+// StorageSetTagsResponse.Validate if the designated constraints aren't met.
+type StorageSetTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageSetTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageSetTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageSetTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageSetTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageSetTagsResponseValidationError) ErrorName() string {
+	return "StorageSetTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageSetTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageSetTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageSetTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageSetTagsResponseValidationError{}
+
+// Validate checks the field values on StorageGetTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageGetTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageGetTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageGetTagsRequestMultiError, or nil if none found.
+func (m *StorageGetTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageGetTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageGetTagsRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageGetTagsRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageGetTagsRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageGetTagsRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageGetTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageGetTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageGetTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StorageGetTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageGetTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageGetTagsRequestMultiError) AllErrors() []error { return m }
+
+// StorageGetTagsRequestValidationError is the validation error returned by
+// StorageGetTagsRequest.Validate if the designated constraints aren't met.
+type StorageGetTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageGetTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageGetTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageGetTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageGetTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageGetTagsRequestValidationError) ErrorName() string {
+	return "StorageGetTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageGetTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageGetTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageGetTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageGetTagsRequestValidationError{}
+
+var _StorageGetTagsRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageGetTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageGetTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageGetTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageGetTagsResponseMultiError, or nil if none found.
+func (m *StorageGetTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageGetTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tags
+
+	if len(errors) > 0 {
+		return StorageGetTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageGetTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by StorageGetTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StorageGetTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageGetTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageGetTagsResponseMultiError) AllErrors() []error { return m }
+
+// StorageGetTagsResponseValidationError is the validation error returned by
+// StorageGetTagsResponse.Validate if the designated constraints aren't met.
+type StorageGetTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageGetTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageGetTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageGetTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageGetTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageGetTagsResponseValidationError) ErrorName() string {
+	return "StorageGetTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageGetTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageGetTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageGetTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageGetTagsResponseValidationError{}
+
+// Validate checks the field values on StorageBeginUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageBeginUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageBeginUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageBeginUploadRequestMultiError, or nil if none found.
+func (m *StorageBeginUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageBeginUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageBeginUploadRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageBeginUploadRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageBeginUploadRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := StorageBeginUploadRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageBeginUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageBeginUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageBeginUploadRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StorageBeginUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageBeginUploadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageBeginUploadRequestMultiError) AllErrors() []error { return m }
+
+// StorageBeginUploadRequestValidationError is the validation error returned by
+// StorageBeginUploadRequest.Validate if the designated constraints aren't met.
+type StorageBeginUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageBeginUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageBeginUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageBeginUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageBeginUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageBeginUploadRequestValidationError) ErrorName() string {
+	return "StorageBeginUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageBeginUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageBeginUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageBeginUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageBeginUploadRequestValidationError{}
+
+var _StorageBeginUploadRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageBeginUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageBeginUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageBeginUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageBeginUploadResponseMultiError, or nil if none found.
+func (m *StorageBeginUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageBeginUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UploadId
+
+	if len(errors) > 0 {
+		return StorageBeginUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageBeginUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageBeginUploadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageBeginUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageBeginUploadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageBeginUploadResponseMultiError) AllErrors() []error { return m }
+
+// StorageBeginUploadResponseValidationError is the validation error returned
+// by StorageBeginUploadResponse.Validate if the designated constraints aren't met.
+type StorageBeginUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageBeginUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageBeginUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageBeginUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageBeginUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageBeginUploadResponseValidationError) ErrorName() string {
+	return "StorageBeginUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageBeginUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageBeginUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageBeginUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageBeginUploadResponseValidationError{}
+
+// Validate checks the field values on StorageAppendUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAppendUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAppendUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAppendUploadRequestMultiError, or nil if none found.
+func (m *StorageAppendUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAppendUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUploadId()) < 1 {
+		err := StorageAppendUploadRequestValidationError{
+			field:  "UploadId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := StorageAppendUploadRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetBody()) < 1 {
+		err := StorageAppendUploadRequestValidationError{
+			field:  "Body",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageAppendUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAppendUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by StorageAppendUploadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StorageAppendUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAppendUploadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAppendUploadRequestMultiError) AllErrors() []error { return m }
+
+// StorageAppendUploadRequestValidationError is the validation error returned
+// by StorageAppendUploadRequest.Validate if the designated constraints aren't met.
+type StorageAppendUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAppendUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAppendUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAppendUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAppendUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAppendUploadRequestValidationError) ErrorName() string {
+	return "StorageAppendUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAppendUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAppendUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAppendUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAppendUploadRequestValidationError{}
+
+// Validate checks the field values on StorageAppendUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAppendUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAppendUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAppendUploadResponseMultiError, or nil if none found.
+func (m *StorageAppendUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAppendUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Complete
+
+	if len(errors) > 0 {
+		return StorageAppendUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAppendUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageAppendUploadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageAppendUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAppendUploadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAppendUploadResponseMultiError) AllErrors() []error { return m }
+
+// StorageAppendUploadResponseValidationError is the validation error returned
+// by StorageAppendUploadResponse.Validate if the designated constraints
+// aren't met.
+type StorageAppendUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAppendUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAppendUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAppendUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAppendUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAppendUploadResponseValidationError) ErrorName() string {
+	return "StorageAppendUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAppendUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAppendUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAppendUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAppendUploadResponseValidationError{}
+
+// Validate checks the field values on StorageUploadStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageUploadStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUploadStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageUploadStatusRequestMultiError, or nil if none found.
+func (m *StorageUploadStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUploadStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUploadId()) < 1 {
+		err := StorageUploadStatusRequestValidationError{
+			field:  "UploadId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageUploadStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUploadStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by StorageUploadStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StorageUploadStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUploadStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUploadStatusRequestMultiError) AllErrors() []error { return m }
+
+// StorageUploadStatusRequestValidationError is the validation error returned
+// by StorageUploadStatusRequest.Validate if the designated constraints aren't met.
+type StorageUploadStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUploadStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUploadStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUploadStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUploadStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUploadStatusRequestValidationError) ErrorName() string {
+	return "StorageUploadStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageUploadStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUploadStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUploadStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUploadStatusRequestValidationError{}
+
+// Validate checks the field values on StorageUploadStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageUploadStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUploadStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageUploadStatusResponseMultiError, or nil if none found.
+func (m *StorageUploadStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUploadStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for Key
+
+	// no validation rules for Offset
+
+	// no validation rules for Complete
+
+	if len(errors) > 0 {
+		return StorageUploadStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUploadStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageUploadStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageUploadStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUploadStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUploadStatusResponseMultiError) AllErrors() []error { return m }
+
+// StorageUploadStatusResponseValidationError is the validation error returned
+// by StorageUploadStatusResponse.Validate if the designated constraints
+// aren't met.
+type StorageUploadStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUploadStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUploadStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUploadStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUploadStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUploadStatusResponseValidationError) ErrorName() string {
+	return "StorageUploadStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageUploadStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUploadStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUploadStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUploadStatusResponseValidationError{}
+
+// Validate checks the field values on StorageCommitUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageCommitUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCommitUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageCommitUploadRequestMultiError, or nil if none found.
+func (m *StorageCommitUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCommitUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUploadId()) < 1 {
+		err := StorageCommitUploadRequestValidationError{
+			field:  "UploadId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageCommitUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCommitUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by StorageCommitUploadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StorageCommitUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCommitUploadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCommitUploadRequestMultiError) AllErrors() []error { return m }
+
+// StorageCommitUploadRequestValidationError is the validation error returned
+// by StorageCommitUploadRequest.Validate if the designated constraints aren't met.
+type StorageCommitUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCommitUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCommitUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCommitUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCommitUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCommitUploadRequestValidationError) ErrorName() string {
+	return "StorageCommitUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageCommitUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCommitUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCommitUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCommitUploadRequestValidationError{}
+
+// Validate checks the field values on StorageCommitUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageCommitUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageCommitUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageCommitUploadResponseMultiError, or nil if none found.
+func (m *StorageCommitUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageCommitUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return StorageCommitUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageCommitUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageCommitUploadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageCommitUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageCommitUploadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageCommitUploadResponseMultiError) AllErrors() []error { return m }
+
+// StorageCommitUploadResponseValidationError is the validation error returned
+// by StorageCommitUploadResponse.Validate if the designated constraints
+// aren't met.
+type StorageCommitUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageCommitUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageCommitUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageCommitUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageCommitUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageCommitUploadResponseValidationError) ErrorName() string {
+	return "StorageCommitUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageCommitUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageCommitUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageCommitUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageCommitUploadResponseValidationError{}
+
+// Validate checks the field values on StorageAbortUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAbortUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAbortUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAbortUploadRequestMultiError, or nil if none found.
+func (m *StorageAbortUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAbortUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUploadId()) < 1 {
+		err := StorageAbortUploadRequestValidationError{
+			field:  "UploadId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageAbortUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAbortUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageAbortUploadRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StorageAbortUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAbortUploadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAbortUploadRequestMultiError) AllErrors() []error { return m }
+
+// StorageAbortUploadRequestValidationError is the validation error returned by
+// StorageAbortUploadRequest.Validate if the designated constraints aren't met.
+type StorageAbortUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAbortUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAbortUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAbortUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAbortUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAbortUploadRequestValidationError) ErrorName() string {
+	return "StorageAbortUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAbortUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAbortUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAbortUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAbortUploadRequestValidationError{}
+
+// Validate checks the field values on StorageAbortUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageAbortUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageAbortUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageAbortUploadResponseMultiError, or nil if none found.
+func (m *StorageAbortUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageAbortUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StorageAbortUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageAbortUploadResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageAbortUploadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageAbortUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageAbortUploadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageAbortUploadResponseMultiError) AllErrors() []error { return m }
+
+// StorageAbortUploadResponseValidationError is the validation error returned
+// by StorageAbortUploadResponse.Validate if the designated constraints aren't met.
+type StorageAbortUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageAbortUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageAbortUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageAbortUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageAbortUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageAbortUploadResponseValidationError) ErrorName() string {
+	return "StorageAbortUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageAbortUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageAbortUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageAbortUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageAbortUploadResponseValidationError{}
+
+// Validate checks the field values on StorageGrantAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageGrantAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageGrantAccessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageGrantAccessRequestMultiError, or nil if none found.
+func (m *StorageGrantAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageGrantAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetBucketName()) > 256 {
+		err := StorageGrantAccessRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StorageGrantAccessRequest_BucketName_Pattern.MatchString(m.GetBucketName()) {
+		err := StorageGrantAccessRequestValidationError{
+			field:  "BucketName",
+			reason: "value does not match regex pattern \"^\\\\w+([.\\\\-]\\\\w+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prefix
+
+	if len(m.GetOperations()) < 1 {
+		err := StorageGrantAccessRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_StorageGrantAccessRequest_Operations_Unique := make(map[StoragePreSignUrlRequest_Operation]struct{}, len(m.GetOperations()))
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if _, exists := _StorageGrantAccessRequest_Operations_Unique[item]; exists {
+			err := StorageGrantAccessRequestValidationError{
+				field:  fmt.Sprintf("Operations[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_StorageGrantAccessRequest_Operations_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Operations[idx]
+	}
+
+	if m.GetExpiry() <= 0 {
+		err := StorageGrantAccessRequestValidationError{
+			field:  "Expiry",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StorageGrantAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageGrantAccessRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageGrantAccessRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StorageGrantAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageGrantAccessRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageGrantAccessRequestMultiError) AllErrors() []error { return m }
+
+// StorageGrantAccessRequestValidationError is the validation error returned by
+// StorageGrantAccessRequest.Validate if the designated constraints aren't met.
+type StorageGrantAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageGrantAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageGrantAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageGrantAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageGrantAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageGrantAccessRequestValidationError) ErrorName() string {
+	return "StorageGrantAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageGrantAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageGrantAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageGrantAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageGrantAccessRequestValidationError{}
+
+var _StorageGrantAccessRequest_BucketName_Pattern = regexp.MustCompile("^\\w+([.\\-]\\w+)*$")
+
+// Validate checks the field values on StorageTemporaryCredentials with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageTemporaryCredentials) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageTemporaryCredentials with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageTemporaryCredentialsMultiError, or nil if none found.
+func (m *StorageTemporaryCredentials) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageTemporaryCredentials) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessKeyId
+
+	// no validation rules for SecretAccessKey
+
+	// no validation rules for SessionToken
+
+	// no validation rules for Bucket
+
+	// no validation rules for Region
+
+	if len(errors) > 0 {
+		return StorageTemporaryCredentialsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageTemporaryCredentialsMultiError is an error wrapping multiple
+// validation errors returned by StorageTemporaryCredentials.ValidateAll() if
+// the designated constraints aren't met.
+type StorageTemporaryCredentialsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageTemporaryCredentialsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageTemporaryCredentialsMultiError) AllErrors() []error { return m }
+
+// StorageTemporaryCredentialsValidationError is the validation error returned
+// by StorageTemporaryCredentials.Validate if the designated constraints
+// aren't met.
+type StorageTemporaryCredentialsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageTemporaryCredentialsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageTemporaryCredentialsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageTemporaryCredentialsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageTemporaryCredentialsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageTemporaryCredentialsValidationError) ErrorName() string {
+	return "StorageTemporaryCredentialsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageTemporaryCredentialsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageTemporaryCredentials.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageTemporaryCredentialsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageTemporaryCredentialsValidationError{}
+
+// Validate checks the field values on StoragePostPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StoragePostPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoragePostPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoragePostPolicyMultiError, or nil if none found.
+func (m *StoragePostPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoragePostPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Fields
+
+	if len(errors) > 0 {
+		return StoragePostPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoragePostPolicyMultiError is an error wrapping multiple validation errors
+// returned by StoragePostPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type StoragePostPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoragePostPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoragePostPolicyMultiError) AllErrors() []error { return m }
+
+// StoragePostPolicyValidationError is the validation error returned by
+// StoragePostPolicy.Validate if the designated constraints aren't met.
+type StoragePostPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoragePostPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoragePostPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoragePostPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoragePostPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoragePostPolicyValidationError) ErrorName() string {
+	return "StoragePostPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoragePostPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoragePostPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoragePostPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoragePostPolicyValidationError{}
+
+// Validate checks the field values on StorageSasToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StorageSasToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageSasToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageSasTokenMultiError, or nil if none found.
+func (m *StorageSasToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageSasToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return StorageSasTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageSasTokenMultiError is an error wrapping multiple validation errors
+// returned by StorageSasToken.ValidateAll() if the designated constraints
+// aren't met.
+type StorageSasTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageSasTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageSasTokenMultiError) AllErrors() []error { return m }
+
+// StorageSasTokenValidationError is the validation error returned by
+// StorageSasToken.Validate if the designated constraints aren't met.
+type StorageSasTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageSasTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageSasTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageSasTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageSasTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageSasTokenValidationError) ErrorName() string { return "StorageSasTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageSasTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageSasToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageSasTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageSasTokenValidationError{}
+
+// Validate checks the field values on StorageGrantAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageGrantAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageGrantAccessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageGrantAccessResponseMultiError, or nil if none found.
+func (m *StorageGrantAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageGrantAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExpires()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageGrantAccessResponseValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageGrantAccessResponseValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpires()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageGrantAccessResponseValidationError{
+				field:  "Expires",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch m.Grant.(type) {
+
+	case *StorageGrantAccessResponse_Credentials:
+
+		if all {
+			switch v := interface{}(m.GetCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageGrantAccessResponseValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StorageGrantAccessResponse_PostPolicy:
+
+		if all {
+			switch v := interface{}(m.GetPostPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "PostPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "PostPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageGrantAccessResponseValidationError{
+					field:  "PostPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StorageGrantAccessResponse_Sas:
+
+		if all {
+			switch v := interface{}(m.GetSas()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "Sas",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageGrantAccessResponseValidationError{
+						field:  "Sas",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSas()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageGrantAccessResponseValidationError{
+					field:  "Sas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StorageGrantAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageGrantAccessResponseMultiError is an error wrapping multiple
+// validation errors returned by StorageGrantAccessResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StorageGrantAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageGrantAccessResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageGrantAccessResponseMultiError) AllErrors() []error { return m }
+
+// StorageGrantAccessResponseValidationError is the validation error returned
+// by StorageGrantAccessResponse.Validate if the designated constraints aren't met.
+type StorageGrantAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageGrantAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageGrantAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageGrantAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageGrantAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageGrantAccessResponseValidationError) ErrorName() string {
+	return "StorageGrantAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageGrantAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageGrantAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageGrantAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageGrantAccessResponseValidationError{}
@@ -26,12 +26,34 @@ type StorageServiceClient interface {
 	Read(ctx context.Context, in *StorageReadRequest, opts ...grpc.CallOption) (*StorageReadResponse, error)
 	// Store an item to a bucket
 	Write(ctx context.Context, in *StorageWriteRequest, opts ...grpc.CallOption) (*StorageWriteResponse, error)
+	// Append to an item in a bucket, creating it if it doesn't exist
+	Append(ctx context.Context, in *StorageAppendRequest, opts ...grpc.CallOption) (*StorageAppendResponse, error)
 	// Delete an item from a bucket
 	Delete(ctx context.Context, in *StorageDeleteRequest, opts ...grpc.CallOption) (*StorageDeleteResponse, error)
 	// Generate a pre-signed URL for direct operations on an item
 	PreSignUrl(ctx context.Context, in *StoragePreSignUrlRequest, opts ...grpc.CallOption) (*StoragePreSignUrlResponse, error)
 	// List files currently in the bucket
 	ListFiles(ctx context.Context, in *StorageListFilesRequest, opts ...grpc.CallOption) (*StorageListFilesResponse, error)
+	// List the stored versions of an item in a versioned bucket
+	ListVersions(ctx context.Context, in *StorageListVersionsRequest, opts ...grpc.CallOption) (*StorageListVersionsResponse, error)
+	// Make a previous version of an item its current version
+	RestoreVersion(ctx context.Context, in *StorageRestoreVersionRequest, opts ...grpc.CallOption) (*StorageRestoreVersionResponse, error)
+	// Replace the tags of an item
+	SetTags(ctx context.Context, in *StorageSetTagsRequest, opts ...grpc.CallOption) (*StorageSetTagsResponse, error)
+	// Retrieve the tags of an item
+	GetTags(ctx context.Context, in *StorageGetTagsRequest, opts ...grpc.CallOption) (*StorageGetTagsResponse, error)
+	// Start a resumable upload of an item in parts
+	BeginUpload(ctx context.Context, in *StorageBeginUploadRequest, opts ...grpc.CallOption) (*StorageBeginUploadResponse, error)
+	// Upload the next part of a resumable upload
+	AppendUpload(ctx context.Context, in *StorageAppendUploadRequest, opts ...grpc.CallOption) (*StorageAppendUploadResponse, error)
+	// Retrieve the progress of a resumable upload, to resume it from the next part
+	UploadStatus(ctx context.Context, in *StorageUploadStatusRequest, opts ...grpc.CallOption) (*StorageUploadStatusResponse, error)
+	// Write the item from the uploaded parts of a resumable upload
+	CommitUpload(ctx context.Context, in *StorageCommitUploadRequest, opts ...grpc.CallOption) (*StorageCommitUploadResponse, error)
+	// Discard a resumable upload and its uploaded parts
+	AbortUpload(ctx context.Context, in *StorageAbortUploadRequest, opts ...grpc.CallOption) (*StorageAbortUploadResponse, error)
+	// Issue short-lived credentials for clients to access the items of a bucket under a key prefix directly with the provider
+	GrantAccess(ctx context.Context, in *StorageGrantAccessRequest, opts ...grpc.CallOption) (*StorageGrantAccessResponse, error)
 }
 
 type storageServiceClient struct {
@@ -60,6 +82,15 @@ func (c *storageServiceClient) Write(ctx context.Context, in *StorageWriteReques
 	return out, nil
 }
 
+func (c *storageServiceClient) Append(ctx context.Context, in *StorageAppendRequest, opts ...grpc.CallOption) (*StorageAppendResponse, error) {
+	out := new(StorageAppendResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/Append", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) Delete(ctx context.Context, in *StorageDeleteRequest, opts ...grpc.CallOption) (*StorageDeleteResponse, error) {
 	out := new(StorageDeleteResponse)
 	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/Delete", in, out, opts...)
@@ -87,6 +118,96 @@ func (c *storageServiceClient) ListFiles(ctx context.Context, in *StorageListFil
 	return out, nil
 }
 
+func (c *storageServiceClient) ListVersions(ctx context.Context, in *StorageListVersionsRequest, opts ...grpc.CallOption) (*StorageListVersionsResponse, error) {
+	out := new(StorageListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) RestoreVersion(ctx context.Context, in *StorageRestoreVersionRequest, opts ...grpc.CallOption) (*StorageRestoreVersionResponse, error) {
+	out := new(StorageRestoreVersionResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/RestoreVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) SetTags(ctx context.Context, in *StorageSetTagsRequest, opts ...grpc.CallOption) (*StorageSetTagsResponse, error) {
+	out := new(StorageSetTagsResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/SetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetTags(ctx context.Context, in *StorageGetTagsRequest, opts ...grpc.CallOption) (*StorageGetTagsResponse, error) {
+	out := new(StorageGetTagsResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/GetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) BeginUpload(ctx context.Context, in *StorageBeginUploadRequest, opts ...grpc.CallOption) (*StorageBeginUploadResponse, error) {
+	out := new(StorageBeginUploadResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/BeginUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AppendUpload(ctx context.Context, in *StorageAppendUploadRequest, opts ...grpc.CallOption) (*StorageAppendUploadResponse, error) {
+	out := new(StorageAppendUploadResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/AppendUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UploadStatus(ctx context.Context, in *StorageUploadStatusRequest, opts ...grpc.CallOption) (*StorageUploadStatusResponse, error) {
+	out := new(StorageUploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/UploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) CommitUpload(ctx context.Context, in *StorageCommitUploadRequest, opts ...grpc.CallOption) (*StorageCommitUploadResponse, error) {
+	out := new(StorageCommitUploadResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/CommitUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AbortUpload(ctx context.Context, in *StorageAbortUploadRequest, opts ...grpc.CallOption) (*StorageAbortUploadResponse, error) {
+	out := new(StorageAbortUploadResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/AbortUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GrantAccess(ctx context.Context, in *StorageGrantAccessRequest, opts ...grpc.CallOption) (*StorageGrantAccessResponse, error) {
+	out := new(StorageGrantAccessResponse)
+	err := c.cc.Invoke(ctx, "/nitric.storage.v1.StorageService/GrantAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServiceServer is the server API for StorageService service.
 // All implementations must embed UnimplementedStorageServiceServer
 // for forward compatibility
@@ -95,12 +216,34 @@ type StorageServiceServer interface {
 	Read(context.Context, *StorageReadRequest) (*StorageReadResponse, error)
 	// Store an item to a bucket
 	Write(context.Context, *StorageWriteRequest) (*StorageWriteResponse, error)
+	// Append to an item in a bucket, creating it if it doesn't exist
+	Append(context.Context, *StorageAppendRequest) (*StorageAppendResponse, error)
 	// Delete an item from a bucket
 	Delete(context.Context, *StorageDeleteRequest) (*StorageDeleteResponse, error)
 	// Generate a pre-signed URL for direct operations on an item
 	PreSignUrl(context.Context, *StoragePreSignUrlRequest) (*StoragePreSignUrlResponse, error)
 	// List files currently in the bucket
 	ListFiles(context.Context, *StorageListFilesRequest) (*StorageListFilesResponse, error)
+	// List the stored versions of an item in a versioned bucket
+	ListVersions(context.Context, *StorageListVersionsRequest) (*StorageListVersionsResponse, error)
+	// Make a previous version of an item its current version
+	RestoreVersion(context.Context, *StorageRestoreVersionRequest) (*StorageRestoreVersionResponse, error)
+	// Replace the tags of an item
+	SetTags(context.Context, *StorageSetTagsRequest) (*StorageSetTagsResponse, error)
+	// Retrieve the tags of an item
+	GetTags(context.Context, *StorageGetTagsRequest) (*StorageGetTagsResponse, error)
+	// Start a resumable upload of an item in parts
+	BeginUpload(context.Context, *StorageBeginUploadRequest) (*StorageBeginUploadResponse, error)
+	// Upload the next part of a resumable upload
+	AppendUpload(context.Context, *StorageAppendUploadRequest) (*StorageAppendUploadResponse, error)
+	// Retrieve the progress of a resumable upload, to resume it from the next part
+	UploadStatus(context.Context, *StorageUploadStatusRequest) (*StorageUploadStatusResponse, error)
+	// Write the item from the uploaded parts of a resumable upload
+	CommitUpload(context.Context, *StorageCommitUploadRequest) (*StorageCommitUploadResponse, error)
+	// Discard a resumable upload and its uploaded parts
+	AbortUpload(context.Context, *StorageAbortUploadRequest) (*StorageAbortUploadResponse, error)
+	// Issue short-lived credentials for clients to access the items of a bucket under a key prefix directly with the provider
+	GrantAccess(context.Context, *StorageGrantAccessRequest) (*StorageGrantAccessResponse, error)
 	mustEmbedUnimplementedStorageServiceServer()
 }
 
@@ -114,6 +257,9 @@ func (UnimplementedStorageServiceServer) Read(context.Context, *StorageReadReque
 func (UnimplementedStorageServiceServer) Write(context.Context, *StorageWriteRequest) (*StorageWriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
+func (UnimplementedStorageServiceServer) Append(context.Context, *StorageAppendRequest) (*StorageAppendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
 func (UnimplementedStorageServiceServer) Delete(context.Context, *StorageDeleteRequest) (*StorageDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -123,6 +269,36 @@ func (UnimplementedStorageServiceServer) PreSignUrl(context.Context, *StoragePre
 func (UnimplementedStorageServiceServer) ListFiles(context.Context, *StorageListFilesRequest) (*StorageListFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
 }
+func (UnimplementedStorageServiceServer) ListVersions(context.Context, *StorageListVersionsRequest) (*StorageListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedStorageServiceServer) RestoreVersion(context.Context, *StorageRestoreVersionRequest) (*StorageRestoreVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVersion not implemented")
+}
+func (UnimplementedStorageServiceServer) SetTags(context.Context, *StorageSetTagsRequest) (*StorageSetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTags not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTags(context.Context, *StorageGetTagsRequest) (*StorageGetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedStorageServiceServer) BeginUpload(context.Context, *StorageBeginUploadRequest) (*StorageBeginUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) AppendUpload(context.Context, *StorageAppendUploadRequest) (*StorageAppendUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) UploadStatus(context.Context, *StorageUploadStatusRequest) (*StorageUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStatus not implemented")
+}
+func (UnimplementedStorageServiceServer) CommitUpload(context.Context, *StorageCommitUploadRequest) (*StorageCommitUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) AbortUpload(context.Context, *StorageAbortUploadRequest) (*StorageAbortUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) GrantAccess(context.Context, *StorageGrantAccessRequest) (*StorageGrantAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccess not implemented")
+}
 func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
 
 // UnsafeStorageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +348,24 @@ func _StorageService_Write_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageAppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/Append",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).Append(ctx, req.(*StorageAppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageDeleteRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +420,186 @@ func _StorageService_ListFiles_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ListVersions(ctx, req.(*StorageListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageRestoreVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/RestoreVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RestoreVersion(ctx, req.(*StorageRestoreVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_SetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageSetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).SetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/SetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).SetTags(ctx, req.(*StorageSetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageGetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetTags(ctx, req.(*StorageGetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_BeginUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageBeginUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).BeginUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/BeginUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).BeginUpload(ctx, req.(*StorageBeginUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AppendUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageAppendUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AppendUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/AppendUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AppendUpload(ctx, req.(*StorageAppendUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/UploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UploadStatus(ctx, req.(*StorageUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CommitUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageCommitUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CommitUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/CommitUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CommitUpload(ctx, req.(*StorageCommitUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AbortUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageAbortUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AbortUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/AbortUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AbortUpload(ctx, req.(*StorageAbortUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GrantAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageGrantAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GrantAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.storage.v1.StorageService/GrantAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GrantAccess(ctx, req.(*StorageGrantAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +615,10 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Write",
 			Handler:    _StorageService_Write_Handler,
 		},
+		{
+			MethodName: "Append",
+			Handler:    _StorageService_Append_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _StorageService_Delete_Handler,
@@ -253,6 +631,46 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFiles",
 			Handler:    _StorageService_ListFiles_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _StorageService_ListVersions_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _StorageService_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "SetTags",
+			Handler:    _StorageService_SetTags_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _StorageService_GetTags_Handler,
+		},
+		{
+			MethodName: "BeginUpload",
+			Handler:    _StorageService_BeginUpload_Handler,
+		},
+		{
+			MethodName: "AppendUpload",
+			Handler:    _StorageService_AppendUpload_Handler,
+		},
+		{
+			MethodName: "UploadStatus",
+			Handler:    _StorageService_UploadStatus_Handler,
+		},
+		{
+			MethodName: "CommitUpload",
+			Handler:    _StorageService_CommitUpload_Handler,
+		},
+		{
+			MethodName: "AbortUpload",
+			Handler:    _StorageService_AbortUpload_Handler,
+		},
+		{
+			MethodName: "GrantAccess",
+			Handler:    _StorageService_GrantAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storage/v1/storage.proto",
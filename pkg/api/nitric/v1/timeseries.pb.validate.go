@@ -0,0 +1,1179 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: timeseries/v1/timeseries.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TimeSeriesPoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesPointMultiError, or nil if none found.
+func (m *TimeSeriesPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTime() == nil {
+		err := TimeSeriesPointValidationError{
+			field:  "Time",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValues() == nil {
+		err := TimeSeriesPointValidationError{
+			field:  "Values",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValues()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesPointValidationError{
+					field:  "Values",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesPointValidationError{
+					field:  "Values",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValues()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesPointValidationError{
+				field:  "Values",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesPointMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesPoint.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesPointMultiError) AllErrors() []error { return m }
+
+// TimeSeriesPointValidationError is the validation error returned by
+// TimeSeriesPoint.Validate if the designated constraints aren't met.
+type TimeSeriesPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesPointValidationError) ErrorName() string { return "TimeSeriesPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesPointValidationError{}
+
+// Validate checks the field values on TimeSeriesAppendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesAppendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesAppendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesAppendRequestMultiError, or nil if none found.
+func (m *TimeSeriesAppendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesAppendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCollection()) < 1 {
+		err := TimeSeriesAppendRequestValidationError{
+			field:  "Collection",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPoints()) < 1 {
+		err := TimeSeriesAppendRequestValidationError{
+			field:  "Points",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesAppendRequestValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesAppendRequestValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesAppendRequestValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesAppendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesAppendRequestMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesAppendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesAppendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesAppendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesAppendRequestMultiError) AllErrors() []error { return m }
+
+// TimeSeriesAppendRequestValidationError is the validation error returned by
+// TimeSeriesAppendRequest.Validate if the designated constraints aren't met.
+type TimeSeriesAppendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesAppendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesAppendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesAppendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesAppendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesAppendRequestValidationError) ErrorName() string {
+	return "TimeSeriesAppendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesAppendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesAppendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesAppendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesAppendRequestValidationError{}
+
+// Validate checks the field values on TimeSeriesAppendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesAppendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesAppendResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesAppendResponseMultiError, or nil if none found.
+func (m *TimeSeriesAppendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesAppendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TimeSeriesAppendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesAppendResponseMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesAppendResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesAppendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesAppendResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesAppendResponseMultiError) AllErrors() []error { return m }
+
+// TimeSeriesAppendResponseValidationError is the validation error returned by
+// TimeSeriesAppendResponse.Validate if the designated constraints aren't met.
+type TimeSeriesAppendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesAppendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesAppendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesAppendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesAppendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesAppendResponseValidationError) ErrorName() string {
+	return "TimeSeriesAppendResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesAppendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesAppendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesAppendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesAppendResponseValidationError{}
+
+// Validate checks the field values on TimeSeriesRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesRangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesRangeRequestMultiError, or nil if none found.
+func (m *TimeSeriesRangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesRangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCollection()) < 1 {
+		err := TimeSeriesRangeRequestValidationError{
+			field:  "Collection",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStart() == nil {
+		err := TimeSeriesRangeRequestValidationError{
+			field:  "Start",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEnd() == nil {
+		err := TimeSeriesRangeRequestValidationError{
+			field:  "End",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := TimeSeriesRangeRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesRangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesRangeRequestMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesRangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesRangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesRangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesRangeRequestMultiError) AllErrors() []error { return m }
+
+// TimeSeriesRangeRequestValidationError is the validation error returned by
+// TimeSeriesRangeRequest.Validate if the designated constraints aren't met.
+type TimeSeriesRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesRangeRequestValidationError) ErrorName() string {
+	return "TimeSeriesRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesRangeRequestValidationError{}
+
+// Validate checks the field values on TimeSeriesRangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesRangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesRangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesRangeResponseMultiError, or nil if none found.
+func (m *TimeSeriesRangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesRangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesRangeResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesRangeResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesRangeResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesRangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesRangeResponseMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesRangeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesRangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesRangeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesRangeResponseMultiError) AllErrors() []error { return m }
+
+// TimeSeriesRangeResponseValidationError is the validation error returned by
+// TimeSeriesRangeResponse.Validate if the designated constraints aren't met.
+type TimeSeriesRangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesRangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesRangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesRangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesRangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesRangeResponseValidationError) ErrorName() string {
+	return "TimeSeriesRangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesRangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesRangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesRangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesRangeResponseValidationError{}
+
+// Validate checks the field values on TimeSeriesRollupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesRollupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesRollupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesRollupRequestMultiError, or nil if none found.
+func (m *TimeSeriesRollupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesRollupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCollection()) < 1 {
+		err := TimeSeriesRollupRequestValidationError{
+			field:  "Collection",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		err := TimeSeriesRollupRequestValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStart() == nil {
+		err := TimeSeriesRollupRequestValidationError{
+			field:  "Start",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEnd() == nil {
+		err := TimeSeriesRollupRequestValidationError{
+			field:  "End",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesRollupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesRollupRequestMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesRollupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesRollupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesRollupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesRollupRequestMultiError) AllErrors() []error { return m }
+
+// TimeSeriesRollupRequestValidationError is the validation error returned by
+// TimeSeriesRollupRequest.Validate if the designated constraints aren't met.
+type TimeSeriesRollupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesRollupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesRollupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesRollupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesRollupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesRollupRequestValidationError) ErrorName() string {
+	return "TimeSeriesRollupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesRollupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesRollupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesRollupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesRollupRequestValidationError{}
+
+// Validate checks the field values on TimeSeriesRollup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesRollup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesRollup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesRollupMultiError, or nil if none found.
+func (m *TimeSeriesRollup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesRollup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesRollupValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesRollupValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesRollupValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesRollupValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesRollupValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesRollupValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Count
+
+	// no validation rules for Sum
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Avg
+
+	if len(errors) > 0 {
+		return TimeSeriesRollupMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesRollupMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesRollup.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesRollupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesRollupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesRollupMultiError) AllErrors() []error { return m }
+
+// TimeSeriesRollupValidationError is the validation error returned by
+// TimeSeriesRollup.Validate if the designated constraints aren't met.
+type TimeSeriesRollupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesRollupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesRollupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesRollupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesRollupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesRollupValidationError) ErrorName() string { return "TimeSeriesRollupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesRollupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesRollup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesRollupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesRollupValidationError{}
+
+// Validate checks the field values on TimeSeriesRollupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesRollupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesRollupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesRollupResponseMultiError, or nil if none found.
+func (m *TimeSeriesRollupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesRollupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRollups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesRollupResponseValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesRollupResponseValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesRollupResponseValidationError{
+					field:  fmt.Sprintf("Rollups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesRollupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesRollupResponseMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesRollupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesRollupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesRollupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesRollupResponseMultiError) AllErrors() []error { return m }
+
+// TimeSeriesRollupResponseValidationError is the validation error returned by
+// TimeSeriesRollupResponse.Validate if the designated constraints aren't met.
+type TimeSeriesRollupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesRollupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesRollupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesRollupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesRollupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesRollupResponseValidationError) ErrorName() string {
+	return "TimeSeriesRollupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesRollupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesRollupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesRollupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesRollupResponseValidationError{}
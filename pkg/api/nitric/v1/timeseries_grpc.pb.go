@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: timeseries/v1/timeseries.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TimeSeriesServiceClient is the client API for TimeSeriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimeSeriesServiceClient interface {
+	// Append points to a series
+	Append(ctx context.Context, in *TimeSeriesAppendRequest, opts ...grpc.CallOption) (*TimeSeriesAppendResponse, error)
+	// Read the points of a series in a time range, in time order
+	Range(ctx context.Context, in *TimeSeriesRangeRequest, opts ...grpc.CallOption) (*TimeSeriesRangeResponse, error)
+	// Aggregate a field of the points of a series in a time range, for each window
+	Rollup(ctx context.Context, in *TimeSeriesRollupRequest, opts ...grpc.CallOption) (*TimeSeriesRollupResponse, error)
+}
+
+type timeSeriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeSeriesServiceClient(cc grpc.ClientConnInterface) TimeSeriesServiceClient {
+	return &timeSeriesServiceClient{cc}
+}
+
+func (c *timeSeriesServiceClient) Append(ctx context.Context, in *TimeSeriesAppendRequest, opts ...grpc.CallOption) (*TimeSeriesAppendResponse, error) {
+	out := new(TimeSeriesAppendResponse)
+	err := c.cc.Invoke(ctx, "/nitric.timeseries.v1.TimeSeriesService/Append", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSeriesServiceClient) Range(ctx context.Context, in *TimeSeriesRangeRequest, opts ...grpc.CallOption) (*TimeSeriesRangeResponse, error) {
+	out := new(TimeSeriesRangeResponse)
+	err := c.cc.Invoke(ctx, "/nitric.timeseries.v1.TimeSeriesService/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSeriesServiceClient) Rollup(ctx context.Context, in *TimeSeriesRollupRequest, opts ...grpc.CallOption) (*TimeSeriesRollupResponse, error) {
+	out := new(TimeSeriesRollupResponse)
+	err := c.cc.Invoke(ctx, "/nitric.timeseries.v1.TimeSeriesService/Rollup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimeSeriesServiceServer is the server API for TimeSeriesService service.
+// All implementations must embed UnimplementedTimeSeriesServiceServer
+// for forward compatibility
+type TimeSeriesServiceServer interface {
+	// Append points to a series
+	Append(context.Context, *TimeSeriesAppendRequest) (*TimeSeriesAppendResponse, error)
+	// Read the points of a series in a time range, in time order
+	Range(context.Context, *TimeSeriesRangeRequest) (*TimeSeriesRangeResponse, error)
+	// Aggregate a field of the points of a series in a time range, for each window
+	Rollup(context.Context, *TimeSeriesRollupRequest) (*TimeSeriesRollupResponse, error)
+	mustEmbedUnimplementedTimeSeriesServiceServer()
+}
+
+// UnimplementedTimeSeriesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTimeSeriesServiceServer struct {
+}
+
+func (UnimplementedTimeSeriesServiceServer) Append(context.Context, *TimeSeriesAppendRequest) (*TimeSeriesAppendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (UnimplementedTimeSeriesServiceServer) Range(context.Context, *TimeSeriesRangeRequest) (*TimeSeriesRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+}
+func (UnimplementedTimeSeriesServiceServer) Rollup(context.Context, *TimeSeriesRollupRequest) (*TimeSeriesRollupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollup not implemented")
+}
+func (UnimplementedTimeSeriesServiceServer) mustEmbedUnimplementedTimeSeriesServiceServer() {}
+
+// UnsafeTimeSeriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeSeriesServiceServer will
+// result in compilation errors.
+type UnsafeTimeSeriesServiceServer interface {
+	mustEmbedUnimplementedTimeSeriesServiceServer()
+}
+
+func RegisterTimeSeriesServiceServer(s grpc.ServiceRegistrar, srv TimeSeriesServiceServer) {
+	s.RegisterService(&TimeSeriesService_ServiceDesc, srv)
+}
+
+func _TimeSeriesService_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeriesAppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSeriesServiceServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.timeseries.v1.TimeSeriesService/Append",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSeriesServiceServer).Append(ctx, req.(*TimeSeriesAppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSeriesService_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeriesRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSeriesServiceServer).Range(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.timeseries.v1.TimeSeriesService/Range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSeriesServiceServer).Range(ctx, req.(*TimeSeriesRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSeriesService_Rollup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeriesRollupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSeriesServiceServer).Rollup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.timeseries.v1.TimeSeriesService/Rollup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSeriesServiceServer).Rollup(ctx, req.(*TimeSeriesRollupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimeSeriesService_ServiceDesc is the grpc.ServiceDesc for TimeSeriesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeSeriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.timeseries.v1.TimeSeriesService",
+	HandlerType: (*TimeSeriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Append",
+			Handler:    _TimeSeriesService_Append_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _TimeSeriesService_Range_Handler,
+		},
+		{
+			MethodName: "Rollup",
+			Handler:    _TimeSeriesService_Rollup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timeseries/v1/timeseries.proto",
+}
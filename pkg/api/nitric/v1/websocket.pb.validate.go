@@ -0,0 +1,1561 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: websocket/v1/websocket.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WebsocketConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketConnectionMultiError, or nil if none found.
+func (m *WebsocketConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Attributes
+
+	if all {
+		switch v := interface{}(m.GetConnected()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebsocketConnectionValidationError{
+					field:  "Connected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebsocketConnectionValidationError{
+					field:  "Connected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnected()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebsocketConnectionValidationError{
+				field:  "Connected",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebsocketConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketConnectionMultiError is an error wrapping multiple validation
+// errors returned by WebsocketConnection.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketConnectionMultiError) AllErrors() []error { return m }
+
+// WebsocketConnectionValidationError is the validation error returned by
+// WebsocketConnection.Validate if the designated constraints aren't met.
+type WebsocketConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketConnectionValidationError) ErrorName() string {
+	return "WebsocketConnectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketConnectionValidationError{}
+
+// Validate checks the field values on WebsocketRegisterConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *WebsocketRegisterConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketRegisterConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// WebsocketRegisterConnectionRequestMultiError, or nil if none found.
+func (m *WebsocketRegisterConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketRegisterConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetConnectionId()) < 1 {
+		err := WebsocketRegisterConnectionRequestValidationError{
+			field:  "ConnectionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return WebsocketRegisterConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketRegisterConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// WebsocketRegisterConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketRegisterConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketRegisterConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketRegisterConnectionRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketRegisterConnectionRequestValidationError is the validation error
+// returned by WebsocketRegisterConnectionRequest.Validate if the designated
+// constraints aren't met.
+type WebsocketRegisterConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketRegisterConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketRegisterConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketRegisterConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketRegisterConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketRegisterConnectionRequestValidationError) ErrorName() string {
+	return "WebsocketRegisterConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketRegisterConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketRegisterConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketRegisterConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketRegisterConnectionRequestValidationError{}
+
+// Validate checks the field values on WebsocketRegisterConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *WebsocketRegisterConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketRegisterConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// WebsocketRegisterConnectionResponseMultiError, or nil if none found.
+func (m *WebsocketRegisterConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketRegisterConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebsocketRegisterConnectionResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebsocketRegisterConnectionResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebsocketRegisterConnectionResponseValidationError{
+				field:  "Connection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebsocketRegisterConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketRegisterConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// WebsocketRegisterConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketRegisterConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketRegisterConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketRegisterConnectionResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketRegisterConnectionResponseValidationError is the validation error
+// returned by WebsocketRegisterConnectionResponse.Validate if the designated
+// constraints aren't met.
+type WebsocketRegisterConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketRegisterConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketRegisterConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketRegisterConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketRegisterConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketRegisterConnectionResponseValidationError) ErrorName() string {
+	return "WebsocketRegisterConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketRegisterConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketRegisterConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketRegisterConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketRegisterConnectionResponseValidationError{}
+
+// Validate checks the field values on WebsocketGetConnectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketGetConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketGetConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WebsocketGetConnectionRequestMultiError, or nil if none found.
+func (m *WebsocketGetConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketGetConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetConnectionId()) < 1 {
+		err := WebsocketGetConnectionRequestValidationError{
+			field:  "ConnectionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WebsocketGetConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketGetConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by WebsocketGetConnectionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type WebsocketGetConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketGetConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketGetConnectionRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketGetConnectionRequestValidationError is the validation error
+// returned by WebsocketGetConnectionRequest.Validate if the designated
+// constraints aren't met.
+type WebsocketGetConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketGetConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketGetConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketGetConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketGetConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketGetConnectionRequestValidationError) ErrorName() string {
+	return "WebsocketGetConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketGetConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketGetConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketGetConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketGetConnectionRequestValidationError{}
+
+// Validate checks the field values on WebsocketGetConnectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketGetConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketGetConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WebsocketGetConnectionResponseMultiError, or nil if none found.
+func (m *WebsocketGetConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketGetConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebsocketGetConnectionResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebsocketGetConnectionResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebsocketGetConnectionResponseValidationError{
+				field:  "Connection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebsocketGetConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketGetConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by WebsocketGetConnectionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type WebsocketGetConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketGetConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketGetConnectionResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketGetConnectionResponseValidationError is the validation error
+// returned by WebsocketGetConnectionResponse.Validate if the designated
+// constraints aren't met.
+type WebsocketGetConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketGetConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketGetConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketGetConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketGetConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketGetConnectionResponseValidationError) ErrorName() string {
+	return "WebsocketGetConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketGetConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketGetConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketGetConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketGetConnectionResponseValidationError{}
+
+// Validate checks the field values on WebsocketDeleteConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *WebsocketDeleteConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketDeleteConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WebsocketDeleteConnectionRequestMultiError, or nil if none found.
+func (m *WebsocketDeleteConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketDeleteConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetConnectionId()) < 1 {
+		err := WebsocketDeleteConnectionRequestValidationError{
+			field:  "ConnectionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WebsocketDeleteConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketDeleteConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// WebsocketDeleteConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketDeleteConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketDeleteConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketDeleteConnectionRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketDeleteConnectionRequestValidationError is the validation error
+// returned by WebsocketDeleteConnectionRequest.Validate if the designated
+// constraints aren't met.
+type WebsocketDeleteConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketDeleteConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketDeleteConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketDeleteConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketDeleteConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketDeleteConnectionRequestValidationError) ErrorName() string {
+	return "WebsocketDeleteConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketDeleteConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketDeleteConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketDeleteConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketDeleteConnectionRequestValidationError{}
+
+// Validate checks the field values on WebsocketDeleteConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *WebsocketDeleteConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketDeleteConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// WebsocketDeleteConnectionResponseMultiError, or nil if none found.
+func (m *WebsocketDeleteConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketDeleteConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WebsocketDeleteConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketDeleteConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// WebsocketDeleteConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketDeleteConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketDeleteConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketDeleteConnectionResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketDeleteConnectionResponseValidationError is the validation error
+// returned by WebsocketDeleteConnectionResponse.Validate if the designated
+// constraints aren't met.
+type WebsocketDeleteConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketDeleteConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketDeleteConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketDeleteConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketDeleteConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketDeleteConnectionResponseValidationError) ErrorName() string {
+	return "WebsocketDeleteConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketDeleteConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketDeleteConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketDeleteConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketDeleteConnectionResponseValidationError{}
+
+// Validate checks the field values on WebsocketListConnectionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketListConnectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketListConnectionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WebsocketListConnectionsRequestMultiError, or nil if none found.
+func (m *WebsocketListConnectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketListConnectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return WebsocketListConnectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketListConnectionsRequestMultiError is an error wrapping multiple
+// validation errors returned by WebsocketListConnectionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type WebsocketListConnectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketListConnectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketListConnectionsRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketListConnectionsRequestValidationError is the validation error
+// returned by WebsocketListConnectionsRequest.Validate if the designated
+// constraints aren't met.
+type WebsocketListConnectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketListConnectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketListConnectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketListConnectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketListConnectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketListConnectionsRequestValidationError) ErrorName() string {
+	return "WebsocketListConnectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketListConnectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketListConnectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketListConnectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketListConnectionsRequestValidationError{}
+
+// Validate checks the field values on WebsocketListConnectionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *WebsocketListConnectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketListConnectionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WebsocketListConnectionsResponseMultiError, or nil if none found.
+func (m *WebsocketListConnectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketListConnectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConnections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebsocketListConnectionsResponseValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebsocketListConnectionsResponseValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebsocketListConnectionsResponseValidationError{
+					field:  fmt.Sprintf("Connections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebsocketListConnectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketListConnectionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// WebsocketListConnectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketListConnectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketListConnectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketListConnectionsResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketListConnectionsResponseValidationError is the validation error
+// returned by WebsocketListConnectionsResponse.Validate if the designated
+// constraints aren't met.
+type WebsocketListConnectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketListConnectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketListConnectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketListConnectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketListConnectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketListConnectionsResponseValidationError) ErrorName() string {
+	return "WebsocketListConnectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketListConnectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketListConnectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketListConnectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketListConnectionsResponseValidationError{}
+
+// Validate checks the field values on WebsocketSendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketSendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketSendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketSendRequestMultiError, or nil if none found.
+func (m *WebsocketSendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketSendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetConnectionId()) < 1 {
+		err := WebsocketSendRequestValidationError{
+			field:  "ConnectionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return WebsocketSendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketSendRequestMultiError is an error wrapping multiple validation
+// errors returned by WebsocketSendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketSendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketSendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketSendRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketSendRequestValidationError is the validation error returned by
+// WebsocketSendRequest.Validate if the designated constraints aren't met.
+type WebsocketSendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketSendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketSendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketSendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketSendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketSendRequestValidationError) ErrorName() string {
+	return "WebsocketSendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketSendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketSendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketSendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketSendRequestValidationError{}
+
+// Validate checks the field values on WebsocketSendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketSendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketSendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketSendResponseMultiError, or nil if none found.
+func (m *WebsocketSendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketSendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WebsocketSendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketSendResponseMultiError is an error wrapping multiple validation
+// errors returned by WebsocketSendResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketSendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketSendResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketSendResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketSendResponseValidationError is the validation error returned by
+// WebsocketSendResponse.Validate if the designated constraints aren't met.
+type WebsocketSendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketSendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketSendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketSendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketSendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketSendResponseValidationError) ErrorName() string {
+	return "WebsocketSendResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketSendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketSendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketSendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketSendResponseValidationError{}
+
+// Validate checks the field values on WebsocketBroadcastRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketBroadcastRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketBroadcastRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketBroadcastRequestMultiError, or nil if none found.
+func (m *WebsocketBroadcastRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketBroadcastRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Attributes
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return WebsocketBroadcastRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketBroadcastRequestMultiError is an error wrapping multiple validation
+// errors returned by WebsocketBroadcastRequest.ValidateAll() if the
+// designated constraints aren't met.
+type WebsocketBroadcastRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketBroadcastRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketBroadcastRequestMultiError) AllErrors() []error { return m }
+
+// WebsocketBroadcastRequestValidationError is the validation error returned by
+// WebsocketBroadcastRequest.Validate if the designated constraints aren't met.
+type WebsocketBroadcastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketBroadcastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketBroadcastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketBroadcastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketBroadcastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketBroadcastRequestValidationError) ErrorName() string {
+	return "WebsocketBroadcastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketBroadcastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketBroadcastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketBroadcastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketBroadcastRequestValidationError{}
+
+// Validate checks the field values on WebsocketBroadcastResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketBroadcastResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketBroadcastResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketBroadcastResponseMultiError, or nil if none found.
+func (m *WebsocketBroadcastResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketBroadcastResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sent
+
+	if len(errors) > 0 {
+		return WebsocketBroadcastResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketBroadcastResponseMultiError is an error wrapping multiple
+// validation errors returned by WebsocketBroadcastResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WebsocketBroadcastResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketBroadcastResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketBroadcastResponseMultiError) AllErrors() []error { return m }
+
+// WebsocketBroadcastResponseValidationError is the validation error returned
+// by WebsocketBroadcastResponse.Validate if the designated constraints aren't met.
+type WebsocketBroadcastResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketBroadcastResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketBroadcastResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketBroadcastResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketBroadcastResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketBroadcastResponseValidationError) ErrorName() string {
+	return "WebsocketBroadcastResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketBroadcastResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketBroadcastResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketBroadcastResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketBroadcastResponseValidationError{}
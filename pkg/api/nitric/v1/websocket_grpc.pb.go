@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: websocket/v1/websocket.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WebsocketServiceClient is the client API for WebsocketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebsocketServiceClient interface {
+	// Record a connection and attributes of its client, e.g. when it connects
+	RegisterConnection(ctx context.Context, in *WebsocketRegisterConnectionRequest, opts ...grpc.CallOption) (*WebsocketRegisterConnectionResponse, error)
+	// Look up a recorded connection
+	GetConnection(ctx context.Context, in *WebsocketGetConnectionRequest, opts ...grpc.CallOption) (*WebsocketGetConnectionResponse, error)
+	// Remove a recorded connection, e.g. when it disconnects
+	DeleteConnection(ctx context.Context, in *WebsocketDeleteConnectionRequest, opts ...grpc.CallOption) (*WebsocketDeleteConnectionResponse, error)
+	// List the recorded connections with the given attributes
+	ListConnections(ctx context.Context, in *WebsocketListConnectionsRequest, opts ...grpc.CallOption) (*WebsocketListConnectionsResponse, error)
+	// Send a message to a connection
+	Send(ctx context.Context, in *WebsocketSendRequest, opts ...grpc.CallOption) (*WebsocketSendResponse, error)
+	// Send a message to every recorded connection with the given attributes
+	Broadcast(ctx context.Context, in *WebsocketBroadcastRequest, opts ...grpc.CallOption) (*WebsocketBroadcastResponse, error)
+}
+
+type websocketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebsocketServiceClient(cc grpc.ClientConnInterface) WebsocketServiceClient {
+	return &websocketServiceClient{cc}
+}
+
+func (c *websocketServiceClient) RegisterConnection(ctx context.Context, in *WebsocketRegisterConnectionRequest, opts ...grpc.CallOption) (*WebsocketRegisterConnectionResponse, error) {
+	out := new(WebsocketRegisterConnectionResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/RegisterConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) GetConnection(ctx context.Context, in *WebsocketGetConnectionRequest, opts ...grpc.CallOption) (*WebsocketGetConnectionResponse, error) {
+	out := new(WebsocketGetConnectionResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/GetConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) DeleteConnection(ctx context.Context, in *WebsocketDeleteConnectionRequest, opts ...grpc.CallOption) (*WebsocketDeleteConnectionResponse, error) {
+	out := new(WebsocketDeleteConnectionResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/DeleteConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) ListConnections(ctx context.Context, in *WebsocketListConnectionsRequest, opts ...grpc.CallOption) (*WebsocketListConnectionsResponse, error) {
+	out := new(WebsocketListConnectionsResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/ListConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) Send(ctx context.Context, in *WebsocketSendRequest, opts ...grpc.CallOption) (*WebsocketSendResponse, error) {
+	out := new(WebsocketSendResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) Broadcast(ctx context.Context, in *WebsocketBroadcastRequest, opts ...grpc.CallOption) (*WebsocketBroadcastResponse, error) {
+	out := new(WebsocketBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebsocketServiceServer is the server API for WebsocketService service.
+// All implementations must embed UnimplementedWebsocketServiceServer
+// for forward compatibility
+type WebsocketServiceServer interface {
+	// Record a connection and attributes of its client, e.g. when it connects
+	RegisterConnection(context.Context, *WebsocketRegisterConnectionRequest) (*WebsocketRegisterConnectionResponse, error)
+	// Look up a recorded connection
+	GetConnection(context.Context, *WebsocketGetConnectionRequest) (*WebsocketGetConnectionResponse, error)
+	// Remove a recorded connection, e.g. when it disconnects
+	DeleteConnection(context.Context, *WebsocketDeleteConnectionRequest) (*WebsocketDeleteConnectionResponse, error)
+	// List the recorded connections with the given attributes
+	ListConnections(context.Context, *WebsocketListConnectionsRequest) (*WebsocketListConnectionsResponse, error)
+	// Send a message to a connection
+	Send(context.Context, *WebsocketSendRequest) (*WebsocketSendResponse, error)
+	// Send a message to every recorded connection with the given attributes
+	Broadcast(context.Context, *WebsocketBroadcastRequest) (*WebsocketBroadcastResponse, error)
+	mustEmbedUnimplementedWebsocketServiceServer()
+}
+
+// UnimplementedWebsocketServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWebsocketServiceServer struct {
+}
+
+func (UnimplementedWebsocketServiceServer) RegisterConnection(context.Context, *WebsocketRegisterConnectionRequest) (*WebsocketRegisterConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterConnection not implemented")
+}
+func (UnimplementedWebsocketServiceServer) GetConnection(context.Context, *WebsocketGetConnectionRequest) (*WebsocketGetConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnection not implemented")
+}
+func (UnimplementedWebsocketServiceServer) DeleteConnection(context.Context, *WebsocketDeleteConnectionRequest) (*WebsocketDeleteConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
+}
+func (UnimplementedWebsocketServiceServer) ListConnections(context.Context, *WebsocketListConnectionsRequest) (*WebsocketListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedWebsocketServiceServer) Send(context.Context, *WebsocketSendRequest) (*WebsocketSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedWebsocketServiceServer) Broadcast(context.Context, *WebsocketBroadcastRequest) (*WebsocketBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (UnimplementedWebsocketServiceServer) mustEmbedUnimplementedWebsocketServiceServer() {}
+
+// UnsafeWebsocketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebsocketServiceServer will
+// result in compilation errors.
+type UnsafeWebsocketServiceServer interface {
+	mustEmbedUnimplementedWebsocketServiceServer()
+}
+
+func RegisterWebsocketServiceServer(s grpc.ServiceRegistrar, srv WebsocketServiceServer) {
+	s.RegisterService(&WebsocketService_ServiceDesc, srv)
+}
+
+func _WebsocketService_RegisterConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketRegisterConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).RegisterConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/RegisterConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).RegisterConnection(ctx, req.(*WebsocketRegisterConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_GetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).GetConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/GetConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).GetConnection(ctx, req.(*WebsocketGetConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_DeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketDeleteConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).DeleteConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/DeleteConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).DeleteConnection(ctx, req.(*WebsocketDeleteConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/ListConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).ListConnections(ctx, req.(*WebsocketListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).Send(ctx, req.(*WebsocketSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/Broadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).Broadcast(ctx, req.(*WebsocketBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebsocketService_ServiceDesc is the grpc.ServiceDesc for WebsocketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebsocketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.websocket.v1.WebsocketService",
+	HandlerType: (*WebsocketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterConnection",
+			Handler:    _WebsocketService_RegisterConnection_Handler,
+		},
+		{
+			MethodName: "GetConnection",
+			Handler:    _WebsocketService_GetConnection_Handler,
+		},
+		{
+			MethodName: "DeleteConnection",
+			Handler:    _WebsocketService_DeleteConnection_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _WebsocketService_ListConnections_Handler,
+		},
+		{
+			MethodName: "Send",
+			Handler:    _WebsocketService_Send_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _WebsocketService_Broadcast_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "websocket/v1/websocket.proto",
+}
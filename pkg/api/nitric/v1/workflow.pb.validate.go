@@ -0,0 +1,1148 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: workflow/v1/workflow.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WorkflowStartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowStartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowStartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowStartRequestMultiError, or nil if none found.
+func (m *WorkflowStartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowStartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetWorkflow()) < 1 {
+		err := WorkflowStartRequestValidationError{
+			field:  "Workflow",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) > 256 {
+		err := WorkflowStartRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInput()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowStartRequestValidationError{
+					field:  "Input",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowStartRequestValidationError{
+					field:  "Input",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInput()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowStartRequestValidationError{
+				field:  "Input",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkflowStartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowStartRequestMultiError is an error wrapping multiple validation
+// errors returned by WorkflowStartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowStartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowStartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowStartRequestMultiError) AllErrors() []error { return m }
+
+// WorkflowStartRequestValidationError is the validation error returned by
+// WorkflowStartRequest.Validate if the designated constraints aren't met.
+type WorkflowStartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowStartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowStartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowStartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowStartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowStartRequestValidationError) ErrorName() string {
+	return "WorkflowStartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowStartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowStartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowStartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowStartRequestValidationError{}
+
+// Validate checks the field values on WorkflowStartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowStartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowStartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowStartResponseMultiError, or nil if none found.
+func (m *WorkflowStartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowStartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WorkflowStartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowStartResponseMultiError is an error wrapping multiple validation
+// errors returned by WorkflowStartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowStartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowStartResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowStartResponseMultiError) AllErrors() []error { return m }
+
+// WorkflowStartResponseValidationError is the validation error returned by
+// WorkflowStartResponse.Validate if the designated constraints aren't met.
+type WorkflowStartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowStartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowStartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowStartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowStartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowStartResponseValidationError) ErrorName() string {
+	return "WorkflowStartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowStartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowStartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowStartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowStartResponseValidationError{}
+
+// Validate checks the field values on WorkflowGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowGetRequestMultiError, or nil if none found.
+func (m *WorkflowGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := WorkflowGetRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WorkflowGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowGetRequestMultiError is an error wrapping multiple validation errors
+// returned by WorkflowGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WorkflowGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowGetRequestMultiError) AllErrors() []error { return m }
+
+// WorkflowGetRequestValidationError is the validation error returned by
+// WorkflowGetRequest.Validate if the designated constraints aren't met.
+type WorkflowGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowGetRequestValidationError) ErrorName() string {
+	return "WorkflowGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowGetRequestValidationError{}
+
+// Validate checks the field values on WorkflowExecution with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowExecution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowExecution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowExecutionMultiError, or nil if none found.
+func (m *WorkflowExecution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowExecution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Workflow
+
+	// no validation rules for Status
+
+	// no validation rules for Step
+
+	if all {
+		switch v := interface{}(m.GetOutputs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowExecutionValidationError{
+					field:  "Outputs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowExecutionValidationError{
+					field:  "Outputs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutputs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowExecutionValidationError{
+				field:  "Outputs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return WorkflowExecutionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowExecutionMultiError is an error wrapping multiple validation errors
+// returned by WorkflowExecution.ValidateAll() if the designated constraints
+// aren't met.
+type WorkflowExecutionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowExecutionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowExecutionMultiError) AllErrors() []error { return m }
+
+// WorkflowExecutionValidationError is the validation error returned by
+// WorkflowExecution.Validate if the designated constraints aren't met.
+type WorkflowExecutionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowExecutionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowExecutionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowExecutionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowExecutionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowExecutionValidationError) ErrorName() string {
+	return "WorkflowExecutionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowExecutionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowExecution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowExecutionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowExecutionValidationError{}
+
+// Validate checks the field values on WorkflowGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowGetResponseMultiError, or nil if none found.
+func (m *WorkflowGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExecution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowGetResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowGetResponseValidationError{
+					field:  "Execution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowGetResponseValidationError{
+				field:  "Execution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkflowGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowGetResponseMultiError is an error wrapping multiple validation
+// errors returned by WorkflowGetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowGetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowGetResponseMultiError) AllErrors() []error { return m }
+
+// WorkflowGetResponseValidationError is the validation error returned by
+// WorkflowGetResponse.Validate if the designated constraints aren't met.
+type WorkflowGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowGetResponseValidationError) ErrorName() string {
+	return "WorkflowGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowGetResponseValidationError{}
+
+// Validate checks the field values on WorkflowSignalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowSignalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowSignalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowSignalRequestMultiError, or nil if none found.
+func (m *WorkflowSignalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowSignalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := WorkflowSignalRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSignal()) < 1 {
+		err := WorkflowSignalRequestValidationError{
+			field:  "Signal",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowSignalRequestValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowSignalRequestValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowSignalRequestValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkflowSignalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowSignalRequestMultiError is an error wrapping multiple validation
+// errors returned by WorkflowSignalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowSignalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowSignalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowSignalRequestMultiError) AllErrors() []error { return m }
+
+// WorkflowSignalRequestValidationError is the validation error returned by
+// WorkflowSignalRequest.Validate if the designated constraints aren't met.
+type WorkflowSignalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowSignalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowSignalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowSignalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowSignalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowSignalRequestValidationError) ErrorName() string {
+	return "WorkflowSignalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowSignalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowSignalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowSignalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowSignalRequestValidationError{}
+
+// Validate checks the field values on WorkflowSignalResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowSignalResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowSignalResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowSignalResponseMultiError, or nil if none found.
+func (m *WorkflowSignalResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowSignalResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WorkflowSignalResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowSignalResponseMultiError is an error wrapping multiple validation
+// errors returned by WorkflowSignalResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowSignalResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowSignalResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowSignalResponseMultiError) AllErrors() []error { return m }
+
+// WorkflowSignalResponseValidationError is the validation error returned by
+// WorkflowSignalResponse.Validate if the designated constraints aren't met.
+type WorkflowSignalResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowSignalResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowSignalResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowSignalResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowSignalResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowSignalResponseValidationError) ErrorName() string {
+	return "WorkflowSignalResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowSignalResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowSignalResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowSignalResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowSignalResponseValidationError{}
+
+// Validate checks the field values on WorkflowCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowCancelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowCancelRequestMultiError, or nil if none found.
+func (m *WorkflowCancelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowCancelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := WorkflowCancelRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WorkflowCancelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowCancelRequestMultiError is an error wrapping multiple validation
+// errors returned by WorkflowCancelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowCancelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowCancelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowCancelRequestMultiError) AllErrors() []error { return m }
+
+// WorkflowCancelRequestValidationError is the validation error returned by
+// WorkflowCancelRequest.Validate if the designated constraints aren't met.
+type WorkflowCancelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowCancelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowCancelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowCancelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowCancelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowCancelRequestValidationError) ErrorName() string {
+	return "WorkflowCancelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowCancelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowCancelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowCancelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowCancelRequestValidationError{}
+
+// Validate checks the field values on WorkflowCancelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowCancelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowCancelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowCancelResponseMultiError, or nil if none found.
+func (m *WorkflowCancelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowCancelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WorkflowCancelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowCancelResponseMultiError is an error wrapping multiple validation
+// errors returned by WorkflowCancelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WorkflowCancelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowCancelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowCancelResponseMultiError) AllErrors() []error { return m }
+
+// WorkflowCancelResponseValidationError is the validation error returned by
+// WorkflowCancelResponse.Validate if the designated constraints aren't met.
+type WorkflowCancelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowCancelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowCancelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowCancelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowCancelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowCancelResponseValidationError) ErrorName() string {
+	return "WorkflowCancelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkflowCancelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowCancelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowCancelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowCancelResponseValidationError{}
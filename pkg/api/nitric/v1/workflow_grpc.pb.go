@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: workflow/v1/workflow.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WorkflowServiceClient is the client API for WorkflowService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkflowServiceClient interface {
+	// Start a new execution of a workflow
+	Start(ctx context.Context, in *WorkflowStartRequest, opts ...grpc.CallOption) (*WorkflowStartResponse, error)
+	// Get the state of an execution
+	Get(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*WorkflowGetResponse, error)
+	// Send a signal to an execution waiting for it
+	Signal(ctx context.Context, in *WorkflowSignalRequest, opts ...grpc.CallOption) (*WorkflowSignalResponse, error)
+	// Cancel an execution, it won't run any further steps
+	Cancel(ctx context.Context, in *WorkflowCancelRequest, opts ...grpc.CallOption) (*WorkflowCancelResponse, error)
+}
+
+type workflowServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkflowServiceClient(cc grpc.ClientConnInterface) WorkflowServiceClient {
+	return &workflowServiceClient{cc}
+}
+
+func (c *workflowServiceClient) Start(ctx context.Context, in *WorkflowStartRequest, opts ...grpc.CallOption) (*WorkflowStartResponse, error) {
+	out := new(WorkflowStartResponse)
+	err := c.cc.Invoke(ctx, "/nitric.workflow.v1.WorkflowService/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) Get(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*WorkflowGetResponse, error) {
+	out := new(WorkflowGetResponse)
+	err := c.cc.Invoke(ctx, "/nitric.workflow.v1.WorkflowService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) Signal(ctx context.Context, in *WorkflowSignalRequest, opts ...grpc.CallOption) (*WorkflowSignalResponse, error) {
+	out := new(WorkflowSignalResponse)
+	err := c.cc.Invoke(ctx, "/nitric.workflow.v1.WorkflowService/Signal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) Cancel(ctx context.Context, in *WorkflowCancelRequest, opts ...grpc.CallOption) (*WorkflowCancelResponse, error) {
+	out := new(WorkflowCancelResponse)
+	err := c.cc.Invoke(ctx, "/nitric.workflow.v1.WorkflowService/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkflowServiceServer is the server API for WorkflowService service.
+// All implementations must embed UnimplementedWorkflowServiceServer
+// for forward compatibility
+type WorkflowServiceServer interface {
+	// Start a new execution of a workflow
+	Start(context.Context, *WorkflowStartRequest) (*WorkflowStartResponse, error)
+	// Get the state of an execution
+	Get(context.Context, *WorkflowGetRequest) (*WorkflowGetResponse, error)
+	// Send a signal to an execution waiting for it
+	Signal(context.Context, *WorkflowSignalRequest) (*WorkflowSignalResponse, error)
+	// Cancel an execution, it won't run any further steps
+	Cancel(context.Context, *WorkflowCancelRequest) (*WorkflowCancelResponse, error)
+	mustEmbedUnimplementedWorkflowServiceServer()
+}
+
+// UnimplementedWorkflowServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkflowServiceServer struct {
+}
+
+func (UnimplementedWorkflowServiceServer) Start(context.Context, *WorkflowStartRequest) (*WorkflowStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedWorkflowServiceServer) Get(context.Context, *WorkflowGetRequest) (*WorkflowGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedWorkflowServiceServer) Signal(context.Context, *WorkflowSignalRequest) (*WorkflowSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+func (UnimplementedWorkflowServiceServer) Cancel(context.Context, *WorkflowCancelRequest) (*WorkflowCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedWorkflowServiceServer) mustEmbedUnimplementedWorkflowServiceServer() {}
+
+// UnsafeWorkflowServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkflowServiceServer will
+// result in compilation errors.
+type UnsafeWorkflowServiceServer interface {
+	mustEmbedUnimplementedWorkflowServiceServer()
+}
+
+func RegisterWorkflowServiceServer(s grpc.ServiceRegistrar, srv WorkflowServiceServer) {
+	s.RegisterService(&WorkflowService_ServiceDesc, srv)
+}
+
+func _WorkflowService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.workflow.v1.WorkflowService/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).Start(ctx, req.(*WorkflowStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.workflow.v1.WorkflowService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).Get(ctx, req.(*WorkflowGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.workflow.v1.WorkflowService/Signal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).Signal(ctx, req.(*WorkflowSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.workflow.v1.WorkflowService/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).Cancel(ctx, req.(*WorkflowCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkflowService_ServiceDesc is the grpc.ServiceDesc for WorkflowService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkflowService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.workflow.v1.WorkflowService",
+	HandlerType: (*WorkflowServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _WorkflowService_Start_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _WorkflowService_Get_Handler,
+		},
+		{
+			MethodName: "Signal",
+			Handler:    _WorkflowService_Signal_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _WorkflowService_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workflow/v1/workflow.proto",
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// timestampWidth - unix nano timestamps are zero padded so archive keys sort chronologically
+const timestampWidth = 20
+
+// EventService - Archives published events to a storage bucket, so they can be replayed on providers without native replay support.
+//
+// Events are archived after they're successfully published, under the key <topic>/<unix nano publish time>-<event id>.json.
+// Replayed events are published again with their original ID, so consumers deduplicating by ID may skip them.
+type EventService struct {
+	events.EventService
+	storage storage.StorageService
+	bucket  string
+	now     func() time.Time
+}
+
+var _ events.EventService = (*EventService)(nil)
+
+func topicPrefix(topic string) string {
+	return topic + "/"
+}
+
+func archiveKey(topic string, published time.Time, id string) string {
+	return fmt.Sprintf("%s%0*d-%s.json", topicPrefix(topic), timestampWidth, published.UnixNano(), id)
+}
+
+// publishTime - returns the time an archived event was published, parsed from its key
+func publishTime(topic string, key string) (time.Time, error) {
+	name := strings.TrimPrefix(key, topicPrefix(topic))
+	if len(name) < timestampWidth {
+		return time.Time{}, fmt.Errorf("invalid archive key %s", key)
+	}
+
+	nanos, err := strconv.ParseInt(name[:timestampWidth], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid archive key %s: %v", key, err)
+	}
+
+	return time.Unix(0, nanos), nil
+}
+
+func (a *EventService) Publish(topic string, event *events.NitricEvent) error {
+	if err := a.EventService.Publish(topic, event); err != nil {
+		return err
+	}
+
+	// The event has already been delivered, so failing to archive it shouldn't fail the publish
+	if eventBytes, err := json.Marshal(event); err != nil {
+		log.Printf("error archiving event %s for topic %s: %v", event.ID, topic, err)
+	} else if _, err := a.storage.Write(a.bucket, archiveKey(topic, a.now(), event.ID), eventBytes); err != nil {
+		log.Printf("error archiving event %s for topic %s: %v", event.ID, topic, err)
+	}
+
+	return nil
+}
+
+// Replay - republishes archived events published to topic from the given time, in the order they were originally published
+func (a *EventService) Replay(topic string, from time.Time, to time.Time) error {
+	newErr := errors.ErrorsWithScope(
+		"ArchiveEventService.Replay",
+		map[string]interface{}{
+			"topic": topic,
+			"from":  from,
+			"to":    to,
+		},
+	)
+
+	files, err := a.storage.ListFiles(a.bucket)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"error listing archived events",
+			err,
+		)
+	}
+
+	keys := make([]string, 0)
+	for _, file := range files {
+		if !strings.HasPrefix(file.Key, topicPrefix(topic)) {
+			continue
+		}
+
+		published, err := publishTime(topic, file.Key)
+		if err != nil {
+			log.Printf("skipping unrecognized archive item: %v", err)
+			continue
+		}
+
+		if published.Before(from) || (!to.IsZero() && !published.Before(to)) {
+			continue
+		}
+
+		keys = append(keys, file.Key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		eventBytes, err := a.storage.Read(a.bucket, key)
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				fmt.Sprintf("error reading archived event %s", key),
+				err,
+			)
+		}
+
+		event := &events.NitricEvent{}
+		if err := json.Unmarshal(eventBytes, event); err != nil {
+			return newErr(
+				codes.Internal,
+				fmt.Sprintf("error unmarshalling archived event %s", key),
+				err,
+			)
+		}
+
+		// Publish directly to the wrapped service, the event is already archived
+		if err := a.EventService.Publish(topic, event); err != nil {
+			return newErr(
+				codes.Internal,
+				fmt.Sprintf("error replaying archived event %s", key),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// New - wraps an event service, archiving events published through it to the given storage bucket
+func New(eventService events.EventService, storageService storage.StorageService, bucket string) (*EventService, error) {
+	if eventService == nil {
+		return nil, fmt.Errorf("event archive requires an events plugin")
+	}
+
+	if storageService == nil {
+		return nil, fmt.Errorf("event archive requires a storage plugin")
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("event archive requires a bucket")
+	}
+
+	return &EventService{
+		EventService: eventService,
+		storage:      storageService,
+		bucket:       bucket,
+		now:          time.Now,
+	}, nil
+}
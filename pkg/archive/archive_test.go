@@ -0,0 +1,157 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/archive"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+type publishRecorder struct {
+	events.UnimplementedeventsPlugin
+	err       error
+	published []*events.NitricEvent
+}
+
+func (p *publishRecorder) Publish(topic string, event *events.NitricEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.published = append(p.published, event)
+	return nil
+}
+
+type memoryStorage struct {
+	storage.UnimplementedStoragePlugin
+	objects map[string][]byte
+}
+
+func (m *memoryStorage) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	m.objects[bucket+"/"+key] = object
+	return "", nil
+}
+
+func (m *memoryStorage) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	if object, ok := m.objects[bucket+"/"+key]; ok {
+		return object, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func (m *memoryStorage) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	files := make([]*storage.FileInfo, 0)
+	for k := range m.objects {
+		files = append(files, &storage.FileInfo{Key: k[len(bucket)+1:]})
+	}
+	return files, nil
+}
+
+var _ = Describe("Archive", func() {
+	Context("Publish", func() {
+		When("the event is published", func() {
+			publisher := &publishRecorder{}
+			store := &memoryStorage{objects: map[string][]byte{}}
+			a, _ := archive.New(publisher, store, "archive")
+
+			err := a.Publish("orders", &events.NitricEvent{ID: "1"})
+
+			It("should publish the event", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(publisher.published).To(HaveLen(1))
+			})
+
+			It("should archive the event", func() {
+				files, _ := store.ListFiles("archive")
+				Expect(files).To(HaveLen(1))
+				Expect(files[0].Key).To(HavePrefix("orders/"))
+				Expect(files[0].Key).To(HaveSuffix("-1.json"))
+			})
+		})
+
+		When("the event fails to publish", func() {
+			publisher := &publishRecorder{err: fmt.Errorf("mock error")}
+			store := &memoryStorage{objects: map[string][]byte{}}
+			a, _ := archive.New(publisher, store, "archive")
+
+			err := a.Publish("orders", &events.NitricEvent{ID: "1"})
+
+			It("should return the error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should not archive the event", func() {
+				Expect(store.objects).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Replay", func() {
+		publisher := &publishRecorder{}
+		store := &memoryStorage{objects: map[string][]byte{}}
+		a, _ := archive.New(publisher, store, "archive")
+
+		start := time.Now()
+		_ = a.Publish("orders", &events.NitricEvent{ID: "1"})
+		middle := time.Now()
+		_ = a.Publish("orders", &events.NitricEvent{ID: "2"})
+		_ = a.Publish("payments", &events.NitricEvent{ID: "3"})
+		end := time.Now()
+
+		When("replaying all events since a time", func() {
+			It("should republish the topic's events in order", func() {
+				publisher.published = nil
+				err := a.Replay("orders", start, time.Time{})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(publisher.published).To(HaveLen(2))
+				Expect(publisher.published[0].ID).To(Equal("1"))
+				Expect(publisher.published[1].ID).To(Equal("2"))
+			})
+
+			It("should not archive the replayed events again", func() {
+				Expect(store.objects).To(HaveLen(3))
+			})
+		})
+
+		When("replaying events within a time range", func() {
+			It("should only republish events published within the range", func() {
+				publisher.published = nil
+				err := a.Replay("orders", start, middle)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(publisher.published).To(HaveLen(1))
+				Expect(publisher.published[0].ID).To(Equal("1"))
+			})
+		})
+
+		When("replaying events after the last publish", func() {
+			It("should not republish any events", func() {
+				publisher.published = nil
+				err := a.Replay("orders", end, time.Time{})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(publisher.published).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backlog
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+)
+
+// Handler - Serves the backlog of each of the queues in the Prometheus text exposition format,
+// for autoscalers and dashboards that react to queued work.
+// Stats are read from the provider when scraped, queues whose stats can't be read are left out
+func Handler(plugin queue.QueueService, queues []string) http.Handler {
+	sorted := append([]string{}, queues...)
+	sort.Strings(sorted)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names := make([]string, 0, len(sorted))
+		stats := make(map[string]*queue.QueueStats, len(sorted))
+		for _, name := range sorted {
+			s, err := plugin.GetQueueStats(name)
+			if err != nil {
+				log.Default().Printf("error reading stats for queue %s: %v", name, err)
+				continue
+			}
+			names = append(names, name)
+			stats[name] = s
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_queue_depth Tasks waiting to be received from each queue.\n")
+		fmt.Fprintf(w, "# TYPE nitric_queue_depth gauge\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_queue_depth{queue=%q} %d\n", name, stats[name].Depth)
+		}
+		fmt.Fprintf(w, "# HELP nitric_queue_inflight Tasks received from each queue but not yet completed.\n")
+		fmt.Fprintf(w, "# TYPE nitric_queue_inflight gauge\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_queue_inflight{queue=%q} %d\n", name, stats[name].InFlight)
+		}
+		fmt.Fprintf(w, "# HELP nitric_queue_oldest_task_age_seconds Age of the oldest task waiting in each queue.\n")
+		fmt.Fprintf(w, "# TYPE nitric_queue_oldest_task_age_seconds gauge\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_queue_oldest_task_age_seconds{queue=%q} %g\n", name, stats[name].OldestTaskAge.Seconds())
+		}
+	})
+}
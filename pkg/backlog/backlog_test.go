@@ -0,0 +1,61 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backlog_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_queue "github.com/nitrictech/nitric/mocks/queue"
+	"github.com/nitrictech/nitric/pkg/backlog"
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+)
+
+var _ = Describe("Backlog", func() {
+	Context("Handler", func() {
+		When("the metrics are scraped", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			plugin := mock_queue.NewMockQueueService(ctrl)
+
+			plugin.EXPECT().GetQueueStats("jobs").Return(&queue.QueueStats{
+				Depth:         12,
+				InFlight:      3,
+				OldestTaskAge: 90 * time.Second,
+			}, nil)
+			plugin.EXPECT().GetQueueStats("missing").Return(nil, fmt.Errorf("queue not found"))
+
+			rec := httptest.NewRecorder()
+			backlog.Handler(plugin, []string{"missing", "jobs"}).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/queues", nil))
+			body, _ := ioutil.ReadAll(rec.Body)
+
+			It("should return the backlog of each queue in the prometheus text format", func() {
+				Expect(rec.Header().Get("Content-Type")).To(ContainSubstring("text/plain"))
+				Expect(string(body)).To(ContainSubstring("nitric_queue_depth{queue=\"jobs\"} 12\n"))
+				Expect(string(body)).To(ContainSubstring("nitric_queue_inflight{queue=\"jobs\"} 3\n"))
+				Expect(string(body)).To(ContainSubstring("nitric_queue_oldest_task_age_seconds{queue=\"jobs\"} 90\n"))
+			})
+
+			It("should leave out queues whose stats can't be read", func() {
+				Expect(string(body)).NotTo(ContainSubstring("missing"))
+			})
+		})
+	})
+})
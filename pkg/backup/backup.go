@@ -0,0 +1,745 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/schedule"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+const (
+	// snapshotPrefix - snapshots are stored under snapshots/{id}/ in the backup bucket
+	snapshotPrefix = "snapshots/"
+	// manifestName - written last, so snapshots without one are incomplete and ignored
+	manifestName = "manifest.json"
+	// idLayout - snapshot ids are their creation time, so they sort oldest first
+	idLayout = "20060102T150405Z"
+	// queryPageSize - number of documents read from a collection at a time
+	queryPageSize = 1000
+	// partSize - collections are exported in parts of about this size, so they're never held in memory whole
+	partSize = 8 * 1024 * 1024
+
+	// ScheduleKey - the schedule whose triggers take snapshots
+	ScheduleKey = "nitric-backups"
+	// DefaultCollection - the collection holding the lease of the snapshot being taken
+	DefaultCollection = "nitric-backups"
+	// leaseId - the id of the document holding the lease
+	leaseId = "lease"
+	// versionField - incremented by every write of the lease, which only applies if it hasn't been written since it was read
+	versionField = "version"
+	// lease - how long a snapshot is owned for without being renewed, after which another instance may take over
+	lease = 10 * time.Minute
+)
+
+// Source - a bucket, or the items in a bucket under a prefix, copied to each snapshot
+type Source struct {
+	Bucket string
+	Prefix string
+}
+
+func (s Source) String() string {
+	if s.Prefix == "" {
+		return s.Bucket
+	}
+
+	return s.Bucket + "/" + s.Prefix
+}
+
+// ParseSources - parses comma separated buckets, each optionally followed by /{prefix}, e.g. uploads,assets/images/
+func ParseSources(value string) ([]Source, error) {
+	sources := []Source{}
+
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		parts := strings.SplitN(s, "/", 2)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid bucket %s, expected {bucket} or {bucket}/{prefix}", s)
+		}
+
+		source := Source{Bucket: parts[0]}
+		if len(parts) == 2 {
+			source.Prefix = parts[1]
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// Config - what is backed up, where to, how often and for how long
+type Config struct {
+	// Bucket - the bucket snapshots are written to, which shouldn't be one of the sources
+	Bucket string
+	// Collections - document collections exported to each snapshot, sub-collections are given as paths
+	// e.g. orders/items to export the items of every order
+	Collections []string
+	// Sources - buckets copied to each snapshot
+	Sources []Source
+	// Schedule - the rate, e.g. "1 day", or cron expression snapshots are taken on
+	Schedule string
+	// Collection - the collection holding the lease of the snapshot being taken, DefaultCollection if empty
+	Collection string
+	// Retain - the number of snapshots kept, all are kept if zero
+	Retain int
+	// MaxAge - snapshots older than this are deleted, unless zero
+	MaxAge time.Duration
+}
+
+// Snapshot - a completed backup
+type Snapshot struct {
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Documents - the number of documents exported, by collection
+	Documents map[string]int `json:"documents"`
+	// Files - the number of items copied, by source
+	Files map[string]int `json:"files"`
+}
+
+// Manager - takes snapshots of document collections and buckets when the backup schedule fires, and restores them.
+//
+// Collections are exported as JSON lines of document ids and content, and items are copied as is,
+// so restoring a snapshot overwrites documents and items with their backed up content but leaves those created since in place.
+// Integer fields are restored as integers and other numbers as floats.
+//
+// Only one snapshot is taken at a time across every instance, by the instance holding the lease stored in the lease collection.
+type Manager struct {
+	documents document.DocumentService
+	storage   storage.StorageService
+	config    *Config
+	rate      string
+	cron      string
+	now       func() time.Time
+}
+
+var _ worker.Adapter = &Manager{}
+
+type exportedDocument struct {
+	Id string `json:"id"`
+	// Parents - the ids of the documents a sub-collection document belongs to, from the top level down
+	Parents []string               `json:"parents,omitempty"`
+	Content map[string]interface{} `json:"content"`
+}
+
+// held - a lease on taking snapshots
+type held struct {
+	version int64
+	// event - the id of the last schedule trigger a snapshot was taken for, so redelivered triggers are ignored
+	event string
+}
+
+func (m *Manager) key(id string, parts ...string) string {
+	return snapshotPrefix + id + "/" + strings.Join(parts, "/")
+}
+
+func (m *Manager) partKey(id string, path string, part int) string {
+	return m.key(id, "documents", path, fmt.Sprintf("part-%05d.jsonl", part))
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// collectionOf - returns the collection at a path such as orders/items, under the given parent documents,
+// or under every parent document if there are none
+func collectionOf(path string, parents []string) *document.Collection {
+	names := strings.Split(path, "/")
+
+	collection := &document.Collection{Name: names[0]}
+	for i, name := range names[1:] {
+		parent := &document.Key{Collection: collection}
+		if i < len(parents) {
+			parent.Id = parents[i]
+		}
+		collection = &document.Collection{Name: name, Parent: parent}
+	}
+
+	return collection
+}
+
+// parentsOf - returns the ids of the documents a document belongs to, from the top level down
+func parentsOf(key *document.Key) []string {
+	var parents []string
+	for c := key.Collection; c.Parent != nil; c = c.Parent.Collection {
+		parents = append([]string{c.Parent.Id}, parents...)
+	}
+
+	return parents
+}
+
+func (m *Manager) leaseKey() *document.Key {
+	return &document.Key{Collection: &document.Collection{Name: m.config.Collection}, Id: leaseId}
+}
+
+// claim - takes the lease unless another instance holds it, or the trigger was already handled
+func (m *Manager) claim(event string) (*held, bool, error) {
+	content := map[string]interface{}{}
+	doc, err := m.documents.Get(m.leaseKey())
+	if err != nil && errors.Code(err) != codes.NotFound {
+		return nil, false, err
+	}
+	if doc != nil && doc.Content != nil {
+		content = doc.Content
+	}
+
+	version, _ := document.IncrementedValue(content[versionField], 0)
+	leasedUntil, _ := document.IncrementedValue(content["leasedUntil"], 0)
+	last, _ := content["event"].(string)
+
+	if (event != "" && event == last) || leasedUntil > unixMillis(m.now()) {
+		return nil, false, nil
+	}
+
+	h := &held{version: version, event: last}
+	if err := m.renew(h); err != nil {
+		if errors.Code(err) == codes.FailedPrecondition {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return h, true, nil
+}
+
+// renew - extends the lease, failing if another instance has taken it over
+func (m *Manager) renew(h *held) error {
+	return m.write(h, unixMillis(m.now().Add(lease)))
+}
+
+// release - gives up the lease, recording the trigger the snapshot was taken for
+func (m *Manager) release(h *held, event string) {
+	if event != "" {
+		h.event = event
+	}
+
+	if err := m.write(h, 0); err != nil {
+		log.Default().Printf("error releasing backup lease: %v", err)
+	}
+}
+
+func (m *Manager) write(h *held, leasedUntil int64) error {
+	err := m.documents.CompareAndSet(m.leaseKey(), versionField, h.version, map[string]interface{}{
+		versionField:  h.version + 1,
+		"leasedUntil": leasedUntil,
+		"event":       h.event,
+	})
+	if err != nil {
+		return err
+	}
+
+	h.version++
+	return nil
+}
+
+// exportCollection - writes the documents of a collection in parts, renewing the lease as each is written
+func (m *Manager) exportCollection(id string, path string, h *held) (int, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	count := 0
+	part := 0
+
+	flush := func() error {
+		part++
+		if _, err := m.storage.Write(m.config.Bucket, m.partKey(id, path, part), buf.Bytes()); err != nil {
+			return err
+		}
+		buf.Reset()
+		return m.renew(h)
+	}
+
+	var pagingToken map[string]string
+	for {
+		result, err := m.documents.Query(collectionOf(path, nil), nil, queryPageSize, pagingToken)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, doc := range result.Documents {
+			if err := encoder.Encode(&exportedDocument{Id: doc.Key.Id, Parents: parentsOf(doc.Key), Content: doc.Content}); err != nil {
+				return 0, err
+			}
+			count++
+
+			if buf.Len() >= partSize {
+				if err := flush(); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		if len(result.PagingToken) == 0 {
+			break
+		}
+		pagingToken = result.PagingToken
+	}
+
+	if buf.Len() > 0 {
+		if err := flush(); err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+func (m *Manager) copySource(id string, source Source) (int, error) {
+	files, err := m.storage.ListFiles(source.Bucket)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, f := range files {
+		if !strings.HasPrefix(f.Key, source.Prefix) {
+			continue
+		}
+
+		object, err := m.storage.Read(source.Bucket, f.Key)
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err := m.storage.Write(m.config.Bucket, m.key(id, "files", source.Bucket, f.Key), object); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// Snapshot - backs up the configured collections and buckets, then deletes snapshots outside the retention policy.
+// Fails with Aborted if a snapshot is already being taken
+func (m *Manager) Snapshot() (*Snapshot, error) {
+	newErr := errors.ErrorsWithScope("Backup.Snapshot", nil)
+
+	h, claimed, err := m.claim("")
+	if err != nil {
+		return nil, newErr(codes.Internal, "error claiming backup lease", err)
+	}
+
+	if !claimed {
+		return nil, newErr(codes.Aborted, "a snapshot is already being taken", nil)
+	}
+
+	snapshot, err := m.snapshot(h)
+	m.release(h, "")
+	if err != nil {
+		return nil, newErr(codes.Internal, "error taking snapshot", err)
+	}
+
+	return snapshot, nil
+}
+
+// HandleEvent - takes a snapshot when the backup schedule fires, unless another instance is already taking one
+func (m *Manager) HandleEvent(trigger *triggers.Event) error {
+	h, claimed, err := m.claim(trigger.ID)
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	if _, err := m.snapshot(h); err != nil {
+		m.release(h, "")
+		return err
+	}
+	m.release(h, trigger.ID)
+
+	return nil
+}
+
+func (m *Manager) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("backups cannot handle HTTP requests")
+}
+
+// Worker - returns the schedule worker taking snapshots when the backup schedule fires
+func (m *Manager) Worker() *worker.ScheduleWorker {
+	return worker.NewScheduleWorker(m, &worker.ScheduleWorkerOptions{
+		Key:  ScheduleKey,
+		Rate: m.rate,
+		Cron: m.cron,
+	})
+}
+
+func (m *Manager) snapshot(h *held) (*Snapshot, error) {
+	now := m.now().UTC()
+	snapshot := &Snapshot{
+		Id:        now.Format(idLayout),
+		CreatedAt: now,
+		Documents: map[string]int{},
+		Files:     map[string]int{},
+	}
+
+	for _, collection := range m.config.Collections {
+		count, err := m.exportCollection(snapshot.Id, collection, h)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting collection %s: %v", collection, err)
+		}
+		snapshot.Documents[collection] = count
+	}
+
+	for _, source := range m.config.Sources {
+		count, err := m.copySource(snapshot.Id, source)
+		if err != nil {
+			return nil, fmt.Errorf("error copying bucket %s: %v", source, err)
+		}
+		snapshot.Files[source.String()] = count
+
+		if err := m.renew(h); err != nil {
+			return nil, fmt.Errorf("error renewing backup lease: %v", err)
+		}
+	}
+
+	manifest, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.storage.Write(m.config.Bucket, m.key(snapshot.Id, manifestName), manifest); err != nil {
+		return nil, fmt.Errorf("error writing snapshot manifest: %v", err)
+	}
+
+	if err := m.prune(); err != nil {
+		log.Default().Printf("error deleting expired snapshots: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+// List - returns the completed snapshots, newest first
+func (m *Manager) List() ([]*Snapshot, error) {
+	files, err := m.storage.ListFiles(m.config.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []*Snapshot{}
+	for _, f := range files {
+		if !strings.HasPrefix(f.Key, snapshotPrefix) || !strings.HasSuffix(f.Key, "/"+manifestName) {
+			continue
+		}
+
+		manifest, err := m.storage.Read(m.config.Bucket, f.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot := &Snapshot{}
+		if err := json.Unmarshal(manifest, snapshot); err != nil {
+			return nil, fmt.Errorf("invalid snapshot manifest %s: %v", f.Key, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Id > snapshots[j].Id
+	})
+
+	return snapshots, nil
+}
+
+func (m *Manager) get(id string) (*Snapshot, error) {
+	snapshots, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range snapshots {
+		if s.Id == id {
+			return s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// delete - removes the snapshot's manifest first, so a partially deleted snapshot is never listed
+func (m *Manager) delete(id string, files []*storage.FileInfo) error {
+	if err := m.storage.Delete(m.config.Bucket, m.key(id, manifestName)); err != nil {
+		return err
+	}
+
+	prefix := m.key(id)
+	for _, f := range files {
+		if strings.HasPrefix(f.Key, prefix) && f.Key != m.key(id, manifestName) {
+			if err := m.storage.Delete(m.config.Bucket, f.Key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// prune - deletes the snapshots beyond the number retained or older than the max age
+func (m *Manager) prune() error {
+	if m.config.Retain <= 0 && m.config.MaxAge <= 0 {
+		return nil
+	}
+
+	snapshots, err := m.List()
+	if err != nil {
+		return err
+	}
+
+	var files []*storage.FileInfo
+	for i, s := range snapshots {
+		expired := m.config.MaxAge > 0 && m.now().Sub(s.CreatedAt) > m.config.MaxAge
+		if !expired && (m.config.Retain <= 0 || i < m.config.Retain) {
+			continue
+		}
+
+		if files == nil {
+			if files, err = m.storage.ListFiles(m.config.Bucket); err != nil {
+				return err
+			}
+		}
+
+		if err := m.delete(s.Id, files); err != nil {
+			return fmt.Errorf("error deleting snapshot %s: %v", s.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreNumbers - decodes numbers as integers when they're whole and floats otherwise
+func restoreNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = restoreNumbers(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = restoreNumbers(child)
+		}
+	}
+
+	return value
+}
+
+func (m *Manager) restorePart(path string, object []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(object))
+	// Lines hold whole documents, which can be much longer than the scanner's default limit
+	scanner.Buffer(make([]byte, 0, 64*1024), len(object)+1)
+
+	for scanner.Scan() {
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.UseNumber()
+
+		doc := &exportedDocument{}
+		if err := decoder.Decode(doc); err != nil {
+			return err
+		}
+
+		key := &document.Key{Collection: collectionOf(path, doc.Parents), Id: doc.Id}
+		if err := m.documents.Set(key, restoreNumbers(doc.Content).(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// restoreCollection - restores the parts of an exported collection one at a time, in the order they were written
+func (m *Manager) restoreCollection(id string, path string) error {
+	files, err := m.storage.ListFiles(m.config.Bucket)
+	if err != nil {
+		return err
+	}
+
+	prefix := m.key(id, "documents", path, "part-")
+	parts := []string{}
+	for _, f := range files {
+		if strings.HasPrefix(f.Key, prefix) {
+			parts = append(parts, f.Key)
+		}
+	}
+	sort.Strings(parts)
+
+	for _, part := range parts {
+		object, err := m.storage.Read(m.config.Bucket, part)
+		if err != nil {
+			return err
+		}
+
+		if err := m.restorePart(path, object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) restoreFiles(id string, buckets map[string]bool) error {
+	files, err := m.storage.ListFiles(m.config.Bucket)
+	if err != nil {
+		return err
+	}
+
+	prefix := m.key(id, "files") + "/"
+	for _, f := range files {
+		if !strings.HasPrefix(f.Key, prefix) {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(f.Key, prefix), "/", 2)
+		if len(parts) != 2 || !buckets[parts[0]] {
+			continue
+		}
+
+		object, err := m.storage.Read(m.config.Bucket, f.Key)
+		if err != nil {
+			return err
+		}
+
+		if _, err := m.storage.Write(parts[0], parts[1], object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore - restores a snapshot's collections and buckets, or only those given
+func (m *Manager) Restore(id string, collections []string, buckets []string) error {
+	newErr := errors.ErrorsWithScope("Backup.Restore", map[string]interface{}{"id": id})
+
+	snapshot, err := m.get(id)
+	if err != nil {
+		return newErr(codes.Internal, "error listing snapshots", err)
+	}
+
+	if snapshot == nil {
+		return newErr(codes.NotFound, "snapshot not found", nil)
+	}
+
+	if len(collections) == 0 && len(buckets) == 0 {
+		for collection := range snapshot.Documents {
+			collections = append(collections, collection)
+		}
+		for source := range snapshot.Files {
+			buckets = append(buckets, strings.SplitN(source, "/", 2)[0])
+		}
+	}
+
+	for _, collection := range collections {
+		if _, ok := snapshot.Documents[collection]; !ok {
+			return newErr(codes.InvalidArgument, fmt.Sprintf("snapshot doesn't include collection %s", collection), nil)
+		}
+
+		if err := m.restoreCollection(id, collection); err != nil {
+			return newErr(codes.Internal, fmt.Sprintf("error restoring collection %s", collection), err)
+		}
+	}
+
+	if len(buckets) > 0 {
+		included := map[string]bool{}
+		for source := range snapshot.Files {
+			included[strings.SplitN(source, "/", 2)[0]] = true
+		}
+
+		restored := map[string]bool{}
+		for _, b := range buckets {
+			if !included[b] {
+				return newErr(codes.InvalidArgument, fmt.Sprintf("snapshot doesn't include bucket %s", b), nil)
+			}
+			restored[b] = true
+		}
+
+		if err := m.restoreFiles(id, restored); err != nil {
+			return newErr(codes.Internal, "error restoring buckets", err)
+		}
+	}
+
+	return nil
+}
+
+// New - Creates a backup manager for the given config
+func New(documents document.DocumentService, storagePlugin storage.StorageService, config *Config) (*Manager, error) {
+	if storagePlugin == nil {
+		return nil, fmt.Errorf("storage plugin is required for backups")
+	}
+
+	if documents == nil {
+		return nil, fmt.Errorf("document plugin is required for backups")
+	}
+
+	for _, path := range config.Collections {
+		for _, name := range strings.Split(path, "/") {
+			if name == "" {
+				return nil, fmt.Errorf("invalid collection %s, expected {collection} or a path to a sub-collection e.g. orders/items", path)
+			}
+		}
+	}
+
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("backup bucket is required")
+	}
+
+	for _, source := range config.Sources {
+		if source.Bucket == config.Bucket {
+			return nil, fmt.Errorf("bucket %s can't be backed up to itself", source.Bucket)
+		}
+	}
+
+	if config.Collection == "" {
+		config.Collection = DefaultCollection
+	}
+
+	m := &Manager{
+		documents: documents,
+		storage:   storagePlugin,
+		config:    config,
+		now:       time.Now,
+	}
+
+	if _, err := schedule.ParseRate(config.Schedule); err == nil {
+		m.rate = config.Schedule
+	} else if _, err := schedule.ParseCron(config.Schedule); err == nil {
+		m.cron = config.Schedule
+	} else {
+		return nil, fmt.Errorf("invalid backup schedule %q, expected a rate e.g. 1 day or a cron expression", config.Schedule)
+	}
+
+	return m, nil
+}
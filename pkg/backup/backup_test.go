@@ -0,0 +1,333 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// memoryDocuments - documents by the path of their key, e.g. orders/1/items/a
+type memoryDocuments struct {
+	document.UnimplementedDocumentPlugin
+	docs map[string]document.Document
+}
+
+func pathOf(key *document.Key) string {
+	if key.Collection.Parent == nil {
+		return key.Collection.Name + "/" + key.Id
+	}
+	return pathOf(key.Collection.Parent) + "/" + key.Collection.Name + "/" + key.Id
+}
+
+// inCollection - returns true if the key is in the collection, blank parent ids match every parent
+func inCollection(key *document.Key, collection *document.Collection) bool {
+	if key.Collection.Name != collection.Name || (key.Collection.Parent == nil) != (collection.Parent == nil) {
+		return false
+	}
+
+	if collection.Parent == nil {
+		return true
+	}
+
+	return (collection.Parent.Id == "" || collection.Parent.Id == key.Collection.Parent.Id) &&
+		inCollection(key.Collection.Parent, collection.Parent.Collection)
+}
+
+func (m *memoryDocuments) Get(key *document.Key) (*document.Document, error) {
+	doc, ok := m.docs[pathOf(key)]
+	if !ok {
+		return nil, errors.ErrorsWithScope("memoryDocuments.Get", nil)(codes.NotFound, "document not found", nil)
+	}
+	return &doc, nil
+}
+
+func (m *memoryDocuments) Set(key *document.Key, content map[string]interface{}) error {
+	m.docs[pathOf(key)] = document.Document{Key: key, Content: content}
+	return nil
+}
+
+func (m *memoryDocuments) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	var current interface{}
+	if doc, ok := m.docs[pathOf(key)]; ok {
+		current = doc.Content[field]
+	}
+
+	if !document.FieldEquals(current, expected) {
+		return errors.ErrorsWithScope("memoryDocuments.CompareAndSet", nil)(codes.FailedPrecondition, document.ConditionFailed, nil)
+	}
+	return m.Set(key, content)
+}
+
+// Query - returns a document per page, to exercise paging
+func (m *memoryDocuments) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	paths := []string{}
+	for path, doc := range m.docs {
+		if inCollection(doc.Key, collection) && (pagingToken == nil || path > pagingToken["after"]) {
+			paths = append(paths, path)
+		}
+	}
+
+	if len(paths) == 0 {
+		return &document.QueryResult{}, nil
+	}
+
+	sort.Strings(paths)
+
+	return &document.QueryResult{
+		Documents:   []document.Document{m.docs[paths[0]]},
+		PagingToken: map[string]string{"after": paths[0]},
+	}, nil
+}
+
+func orderKey(id string) *document.Key {
+	return &document.Key{Collection: &document.Collection{Name: "orders"}, Id: id}
+}
+
+func itemKey(order string, id string) *document.Key {
+	return &document.Key{Collection: &document.Collection{Name: "items", Parent: orderKey(order)}, Id: id}
+}
+
+type memoryStorage struct {
+	storage.UnimplementedStoragePlugin
+	objects map[string][]byte
+}
+
+func (m *memoryStorage) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	m.objects[bucket+"/"+key] = object
+	return "", nil
+}
+
+func (m *memoryStorage) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	if object, ok := m.objects[bucket+"/"+key]; ok {
+		return object, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func (m *memoryStorage) Delete(bucket string, key string, opts ...storage.DeleteOption) error {
+	delete(m.objects, bucket+"/"+key)
+	return nil
+}
+
+func (m *memoryStorage) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	files := make([]*storage.FileInfo, 0)
+	for k := range m.objects {
+		if strings.HasPrefix(k, bucket+"/") {
+			files = append(files, &storage.FileInfo{Key: k[len(bucket)+1:]})
+		}
+	}
+	return files, nil
+}
+
+var _ = Describe("Backup", func() {
+	var docs *memoryDocuments
+	var store *memoryStorage
+	var now time.Time
+	var manager *Manager
+
+	newManager := func(config *Config) *Manager {
+		config.Bucket = "backups"
+		config.Schedule = "1 day"
+		m, err := New(docs, store, config)
+		Expect(err).ShouldNot(HaveOccurred())
+		m.now = func() time.Time { return now }
+		return m
+	}
+
+	BeforeEach(func() {
+		docs = &memoryDocuments{docs: map[string]document.Document{}}
+		store = &memoryStorage{objects: map[string][]byte{}}
+		now = time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		_ = docs.Set(orderKey("1"), map[string]interface{}{"total": int64(10), "price": 2.5})
+		_ = docs.Set(orderKey("2"), map[string]interface{}{"total": int64(20)})
+		_ = docs.Set(itemKey("1", "a"), map[string]interface{}{"quantity": int64(3)})
+		_, _ = store.Write("uploads", "images/a.png", []byte("a"))
+		_, _ = store.Write("uploads", "tmp/b.png", []byte("b"))
+
+		manager = newManager(&Config{
+			Collections: []string{"orders", "orders/items"},
+			Sources:     []Source{{Bucket: "uploads", Prefix: "images/"}},
+		})
+	})
+
+	Context("Snapshot", func() {
+		It("should export collections and copy buckets under the prefix", func() {
+			snapshot, err := manager.Snapshot()
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snapshot.Id).To(Equal("20220301T000000Z"))
+			Expect(snapshot.Documents).To(Equal(map[string]int{"orders": 2, "orders/items": 1}))
+			Expect(snapshot.Files).To(Equal(map[string]int{"uploads/images/": 1}))
+
+			lines := strings.Split(strings.TrimSpace(string(store.objects["backups/snapshots/20220301T000000Z/documents/orders/part-00001.jsonl"])), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(string(store.objects["backups/snapshots/20220301T000000Z/documents/orders/items/part-00001.jsonl"])).To(ContainSubstring(`"parents":["1"]`))
+			Expect(store.objects).To(HaveKey("backups/snapshots/20220301T000000Z/files/uploads/images/a.png"))
+			Expect(store.objects).NotTo(HaveKey("backups/snapshots/20220301T000000Z/files/uploads/tmp/b.png"))
+		})
+
+		It("should refuse to take a snapshot while another instance holds the lease", func() {
+			_ = docs.Set(&document.Key{Collection: &document.Collection{Name: DefaultCollection}, Id: leaseId}, map[string]interface{}{
+				versionField:  int64(1),
+				"leasedUntil": unixMillis(now.Add(time.Minute)),
+			})
+
+			_, err := manager.Snapshot()
+
+			Expect(errors.Code(err)).To(Equal(codes.Aborted))
+			Expect(store.objects).NotTo(HaveKey("backups/snapshots/20220301T000000Z/manifest.json"))
+		})
+
+		It("should release the lease once the snapshot is taken", func() {
+			_, err := manager.Snapshot()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			now = now.Add(time.Hour)
+			_, err = manager.Snapshot()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should delete snapshots beyond the number retained", func() {
+			manager = newManager(&Config{Collections: []string{"orders"}, Retain: 2})
+
+			for i := 0; i < 3; i++ {
+				_, err := manager.Snapshot()
+				Expect(err).ShouldNot(HaveOccurred())
+				now = now.Add(time.Hour)
+			}
+
+			snapshots, err := manager.List()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snapshots).To(HaveLen(2))
+			Expect(snapshots[0].Id).To(Equal("20220301T020000Z"))
+			Expect(snapshots[1].Id).To(Equal("20220301T010000Z"))
+			Expect(store.objects).NotTo(HaveKey("backups/snapshots/20220301T000000Z/documents/orders/part-00001.jsonl"))
+		})
+
+		It("should delete snapshots older than the max age", func() {
+			manager = newManager(&Config{Collections: []string{"orders"}, MaxAge: 36 * time.Hour})
+
+			_, _ = manager.Snapshot()
+			now = now.Add(48 * time.Hour)
+			_, _ = manager.Snapshot()
+
+			snapshots, _ := manager.List()
+			Expect(snapshots).To(HaveLen(1))
+			Expect(snapshots[0].Id).To(Equal("20220303T000000Z"))
+		})
+	})
+
+	Context("HandleEvent", func() {
+		It("should take a snapshot once for each trigger", func() {
+			trigger := &triggers.Event{ID: "1", Topic: worker.ScheduleKeyToTopicName(ScheduleKey)}
+
+			Expect(manager.HandleEvent(trigger)).To(Succeed())
+			now = now.Add(time.Hour)
+			Expect(manager.HandleEvent(trigger)).To(Succeed())
+
+			snapshots, _ := manager.List()
+			Expect(snapshots).To(HaveLen(1))
+		})
+
+		It("should be delivered the backup schedule's triggers", func() {
+			Expect(manager.Worker().HandlesEvent(&triggers.Event{Topic: worker.ScheduleKeyToTopicName(ScheduleKey)})).To(BeTrue())
+			Expect(manager.Worker().Rate()).To(Equal("1 day"))
+		})
+	})
+
+	Context("List", func() {
+		It("should ignore incomplete snapshots", func() {
+			_, _ = store.Write("backups", "snapshots/20220101T000000Z/documents/orders/part-00001.jsonl", []byte{})
+
+			snapshots, err := manager.List()
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snapshots).To(BeEmpty())
+		})
+	})
+
+	Context("Restore", func() {
+		var snapshot *Snapshot
+
+		BeforeEach(func() {
+			var err error
+			snapshot, err = manager.Snapshot()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_ = docs.Set(orderKey("1"), map[string]interface{}{"total": int64(99)})
+			delete(docs.docs, "orders/1/items/a")
+			delete(store.objects, "uploads/images/a.png")
+		})
+
+		It("should restore documents and items", func() {
+			err := manager.Restore(snapshot.Id, nil, nil)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(docs.docs["orders/1"].Content).To(Equal(map[string]interface{}{"total": int64(10), "price": 2.5}))
+			Expect(docs.docs["orders/1/items/a"].Content).To(Equal(map[string]interface{}{"quantity": int64(3)}))
+			Expect(store.objects["uploads/images/a.png"]).To(Equal([]byte("a")))
+		})
+
+		It("should restore only the given collections", func() {
+			err := manager.Restore(snapshot.Id, []string{"orders"}, nil)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(docs.docs["orders/1"].Content["total"]).To(Equal(int64(10)))
+			Expect(docs.docs).NotTo(HaveKey("orders/1/items/a"))
+			Expect(store.objects).NotTo(HaveKey("uploads/images/a.png"))
+		})
+
+		It("should fail for a bucket the snapshot doesn't include", func() {
+			err := manager.Restore(snapshot.Id, nil, []string{"other"})
+
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail for an unknown snapshot", func() {
+			err := manager.Restore("20000101T000000Z", nil, nil)
+
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Context("ParseSources", func() {
+		It("should parse buckets and prefixes", func() {
+			sources, err := ParseSources("uploads, assets/images/")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sources).To(Equal([]Source{{Bucket: "uploads"}, {Bucket: "assets", Prefix: "images/"}}))
+		})
+
+		It("should reject a prefix without a bucket", func() {
+			_, err := ParseSources("/images")
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,167 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture records the triggers handled by workers, so they can be replayed to debug issues or build regression suites.
+package capture
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// Capture - A recorded trigger, either a http request or an event.
+// Schedules are captured as events for their schedule's topic
+type Capture struct {
+	ID      string                `json:"id"`
+	Time    time.Time             `json:"time"`
+	Request *triggers.HttpRequest `json:"request,omitempty"`
+	Event   *triggers.Event       `json:"event,omitempty"`
+}
+
+// Trigger - returns the captured trigger
+func (c *Capture) Trigger() triggers.Trigger {
+	if c.Request != nil {
+		return c.Request
+	}
+
+	return c.Event
+}
+
+// Store - Stores captured triggers
+type Store interface {
+	// Save - stores a capture, replacing any capture with the same ID
+	Save(*Capture) error
+	// Get - returns the capture with the given ID
+	Get(id string) (*Capture, error)
+	// List - returns all captures, oldest first
+	List() ([]*Capture, error)
+}
+
+// New - returns a capture of the given trigger, made now
+func New(trigger triggers.Trigger) *Capture {
+	c := &Capture{
+		ID:   uuid.New().String(),
+		Time: time.Now(),
+	}
+
+	switch t := trigger.(type) {
+	case *triggers.HttpRequest:
+		c.Request = t
+	case *triggers.Event:
+		c.Event = t
+	}
+
+	return c
+}
+
+// DirStore - A Store that keeps each capture in a JSON file in a local directory.
+//
+// Captures contain the full trigger, including headers such as Authorization, so the directory should be treated as sensitive
+type DirStore struct {
+	dir string
+}
+
+var _ Store = &DirStore{}
+
+func (s *DirStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+func (s *DirStore) Save(c *Capture) error {
+	if c.ID == "" || strings.ContainsAny(c.ID, `/\`) {
+		return fmt.Errorf("invalid capture id %s", c.ID)
+	}
+
+	captureBytes, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("error marshalling capture %s: %v", c.ID, err)
+	}
+
+	// Write to a temporary file first, so a capture is never partially written
+	tmp := s.path(c.ID) + ".tmp"
+	if err := os.WriteFile(tmp, captureBytes, 0o600); err != nil {
+		return fmt.Errorf("error writing capture %s: %v", c.ID, err)
+	}
+
+	return os.Rename(tmp, s.path(c.ID))
+}
+
+func (s *DirStore) Get(id string) (*Capture, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("invalid capture id %s", id)
+	}
+
+	captureBytes, err := os.ReadFile(s.path(id))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Capture{}
+	if err := json.Unmarshal(captureBytes, c); err != nil {
+		return nil, fmt.Errorf("error unmarshalling capture %s: %v", id, err)
+	}
+
+	return c, nil
+}
+
+func (s *DirStore) List() ([]*Capture, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	captures := make([]*Capture, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		c, err := s.Get(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return nil, err
+		}
+
+		captures = append(captures, c)
+	}
+
+	sort.SliceStable(captures, func(i, j int) bool {
+		return captures[i].Time.Before(captures[j].Time)
+	})
+
+	return captures, nil
+}
+
+// NewDirStore - returns a store keeping captures in the given directory, creating it if it doesn't exist
+func NewDirStore(dir string) (*DirStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("capture store requires a directory")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating capture directory %s: %v", dir, err)
+	}
+
+	return &DirStore{
+		dir: dir,
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/capture"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type recordingReplayer struct {
+	replayed []*capture.Capture
+}
+
+func (r *recordingReplayer) Replay(c *capture.Capture) (*triggers.HttpResponse, error) {
+	r.replayed = append(r.replayed, c)
+
+	if c.Event != nil {
+		return nil, fmt.Errorf("handler failed")
+	}
+
+	header := &fasthttp.ResponseHeader{}
+	header.Set("X-Test", "replayed")
+	return &triggers.HttpResponse{StatusCode: 201, Header: header, Body: []byte("created")}, nil
+}
+
+var _ = Describe("Capture", func() {
+	var dir string
+	var store *capture.DirStore
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "captures")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		store, err = capture.NewDirStore(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	Context("DirStore", func() {
+		When("saving captures", func() {
+			It("should list them oldest first", func() {
+				evt := capture.New(&triggers.Event{ID: "1234", Topic: "test", Payload: []byte("{}")})
+				req := capture.New(&triggers.HttpRequest{Method: "GET", Path: "/orders", Header: map[string][]string{"Accept": {"*/*"}}})
+				evt.Time = time.Now().Add(-time.Minute)
+
+				Expect(store.Save(req)).To(Succeed())
+				Expect(store.Save(evt)).To(Succeed())
+
+				captures, err := store.List()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(captures).To(HaveLen(2))
+				Expect(captures[0].ID).To(Equal(evt.ID))
+				Expect(captures[0].Event.Payload).To(Equal([]byte("{}")))
+				Expect(captures[1].Request.Path).To(Equal("/orders"))
+				Expect(captures[1].Request.Header["Accept"]).To(Equal([]string{"*/*"}))
+			})
+		})
+
+		When("getting a capture with an invalid id", func() {
+			It("should return an error", func() {
+				_, err := store.Get("../secrets")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Handler", func() {
+		When("replaying a captured http request", func() {
+			It("should return the response", func() {
+				req := capture.New(&triggers.HttpRequest{Method: "POST", Path: "/orders"})
+				Expect(store.Save(req)).To(Succeed())
+
+				replayer := &recordingReplayer{}
+				rec := httptest.NewRecorder()
+				capture.Handler(store, replayer).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/captures/"+req.ID+"/replay", nil))
+
+				Expect(rec.Code).To(Equal(http.StatusOK))
+				Expect(replayer.replayed).To(HaveLen(1))
+
+				result := map[string]interface{}{}
+				Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+				Expect(result["status"]).To(BeEquivalentTo(201))
+				Expect(result["header"]).To(HaveKeyWithValue("X-Test", []interface{}{"replayed"}))
+			})
+		})
+
+		When("a replayed event fails", func() {
+			It("should return the error", func() {
+				evt := capture.New(&triggers.Event{ID: "1234", Topic: "test"})
+				Expect(store.Save(evt)).To(Succeed())
+
+				rec := httptest.NewRecorder()
+				capture.Handler(store, &recordingReplayer{}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/captures/"+evt.ID+"/replay", nil))
+
+				Expect(rec.Code).To(Equal(http.StatusBadGateway))
+				Expect(rec.Body.String()).To(ContainSubstring("handler failed"))
+			})
+		})
+
+		When("listing captures", func() {
+			It("should return their summaries", func() {
+				evt := capture.New(&triggers.Event{ID: "1234", Topic: "test"})
+				Expect(store.Save(evt)).To(Succeed())
+
+				rec := httptest.NewRecorder()
+				capture.Handler(store, &recordingReplayer{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/captures", nil))
+
+				Expect(rec.Code).To(Equal(http.StatusOK))
+				summaries := []map[string]interface{}{}
+				Expect(json.Unmarshal(rec.Body.Bytes(), &summaries)).To(Succeed())
+				Expect(summaries).To(HaveLen(1))
+				Expect(summaries[0]).To(HaveKeyWithValue("id", evt.ID))
+				Expect(summaries[0]).To(HaveKeyWithValue("topic", "test"))
+			})
+		})
+
+		When("requesting an unknown capture", func() {
+			It("should return not found", func() {
+				rec := httptest.NewRecorder()
+				capture.Handler(store, &recordingReplayer{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/captures/unknown", nil))
+
+				Expect(rec.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
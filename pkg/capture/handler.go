@@ -0,0 +1,140 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// Replayer - Re-fires captured triggers against workers
+type Replayer interface {
+	// Replay - handles the captured trigger again, returning the response to a captured http request
+	Replay(*Capture) (*triggers.HttpResponse, error)
+}
+
+// summary - describes a capture in capture listings, without its payload
+type summary struct {
+	ID     string    `json:"id"`
+	Time   time.Time `json:"time"`
+	Method string    `json:"method,omitempty"`
+	Path   string    `json:"path,omitempty"`
+	Topic  string    `json:"topic,omitempty"`
+}
+
+// replayResult - the outcome of a replayed capture
+type replayResult struct {
+	Status int                 `json:"status,omitempty"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   []byte              `json:"body,omitempty"`
+	Error  string              `json:"error,omitempty"`
+}
+
+func writeJson(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// Handler - serves the captures in a store and replays them, with the routes
+//
+//	GET /captures - lists captures, oldest first
+//	GET /captures/<id> - returns a capture
+//	POST /captures/<id>/replay - replays a capture, returning the response to a captured http request
+func Handler(store Store, replayer Replayer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) == 0 || parts[0] != "captures" || len(parts) > 3 || (len(parts) == 3 && parts[2] != "replay") {
+			http.NotFound(w, r)
+			return
+		}
+
+		if len(parts) == 1 {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+
+			captures, err := store.List()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			summaries := make([]*summary, 0, len(captures))
+			for _, c := range captures {
+				s := &summary{ID: c.ID, Time: c.Time}
+				if c.Request != nil {
+					s.Method, s.Path = c.Request.Method, c.Request.Path
+				} else if c.Event != nil {
+					s.Topic = c.Event.Topic
+				}
+				summaries = append(summaries, s)
+			}
+
+			writeJson(w, http.StatusOK, summaries)
+			return
+		}
+
+		c, err := store.Get(parts[1])
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if len(parts) == 2 {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+
+			writeJson(w, http.StatusOK, c)
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := replayer.Replay(c)
+		if err != nil {
+			writeJson(w, http.StatusBadGateway, &replayResult{Error: err.Error()})
+			return
+		}
+
+		result := &replayResult{}
+		if resp != nil {
+			result.Status = resp.StatusCode
+			result.Body = resp.Body
+			if resp.Header != nil {
+				result.Header = make(map[string][]string)
+				resp.Header.VisitAll(func(key []byte, value []byte) {
+					result.Header[string(key)] = append(result.Header[string(key)], string(value))
+				})
+			}
+		}
+
+		writeJson(w, http.StatusOK, result)
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaos injects faults into plugin calls, so retry and fallback logic can be tested locally
+// against errors and latency a provider may return in production.
+package chaos
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// Names of the plugins faults can be configured for
+const (
+	Document = "document"
+	Events   = "events"
+	Storage  = "storage"
+	Queue    = "queue"
+	Secret   = "secret"
+	Sql      = "sql"
+	Search   = "search"
+	Batch    = "batch"
+	Cdn      = "cdn"
+	Flags    = "flags"
+	Config   = "config"
+	Identity = "identity"
+	// Any - faults for plugins without their own configuration
+	Any = "*"
+)
+
+var pluginNames = []string{Document, Events, Storage, Queue, Secret, Sql, Search, Batch, Cdn, Flags, Config, Identity, Any}
+
+// Fault - the faults injected into calls to a plugin
+type Fault struct {
+	// ErrorRate - the fraction of calls that fail, between 0 and 1
+	ErrorRate float64
+	// Codes - the codes of injected errors, one is chosen at random for each error
+	Codes []codes.Code
+	// MinLatency and MaxLatency - calls are delayed by a uniformly random duration in this range
+	MinLatency time.Duration
+	MaxLatency time.Duration
+}
+
+// parseCode - parses an error code by its name, e.g. Unavailable or DeadlineExceeded
+func parseCode(name string) (codes.Code, error) {
+	normalized := strings.ToLower(name)
+	for c := codes.Cancelled; c <= codes.Unauthenticated; c++ {
+		if strings.ToLower(strings.ReplaceAll(c.String(), " ", "")) == normalized {
+			return c, nil
+		}
+	}
+
+	return codes.OK, fmt.Errorf("unknown error code %s", name)
+}
+
+// parseLatency - parses a fixed latency e.g. 100ms, or a range of latencies e.g. 50ms-200ms
+func parseLatency(value string) (time.Duration, time.Duration, error) {
+	bounds := strings.SplitN(value, "-", 2)
+
+	min, err := time.ParseDuration(strings.TrimSpace(bounds[0]))
+	if err != nil || min < 0 {
+		return 0, 0, fmt.Errorf("expected duration or duration range e.g. 50ms-200ms, got %s", value)
+	}
+
+	if len(bounds) == 1 {
+		return min, min, nil
+	}
+
+	max, err := time.ParseDuration(strings.TrimSpace(bounds[1]))
+	if err != nil || max < min {
+		return 0, 0, fmt.Errorf("expected duration or duration range e.g. 50ms-200ms, got %s", value)
+	}
+
+	return min, max, nil
+}
+
+// ParseFaults - parses a fault configuration string, plugins are separated by commas
+// and followed by semicolon separated faults. Plugins that fail without codes fail with Unavailable.
+// e.g. "storage;error_rate=0.1;latency=50ms-200ms;codes=Unavailable|Internal,*;latency=10ms"
+func ParseFaults(config string) (map[string]*Fault, error) {
+	faults := make(map[string]*Fault)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ";")
+		plugin := strings.ToLower(strings.TrimSpace(parts[0]))
+
+		known := false
+		for _, name := range pluginNames {
+			known = known || name == plugin
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown plugin %s, expected one of %s", plugin, strings.Join(pluginNames, ", "))
+		}
+
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("no faults given for plugin %s", plugin)
+		}
+
+		f := &Fault{}
+		for _, attr := range parts[1:] {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid fault %s for plugin %s, expected key=value", attr, plugin)
+			}
+
+			key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+			var err error
+			switch key {
+			case "error_rate":
+				f.ErrorRate, err = strconv.ParseFloat(value, 64)
+				if err == nil && (f.ErrorRate < 0 || f.ErrorRate > 1) {
+					err = fmt.Errorf("expected a rate between 0 and 1, got %s", value)
+				}
+			case "latency":
+				f.MinLatency, f.MaxLatency, err = parseLatency(value)
+			case "codes":
+				for _, name := range strings.Split(value, "|") {
+					var code codes.Code
+					if code, err = parseCode(strings.TrimSpace(name)); err != nil {
+						break
+					}
+					f.Codes = append(f.Codes, code)
+				}
+			default:
+				err = fmt.Errorf("unknown fault")
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid fault %s for plugin %s: %v", key, plugin, err)
+			}
+		}
+
+		if len(f.Codes) == 0 {
+			f.Codes = []codes.Code{codes.Unavailable}
+		}
+
+		faults[plugin] = f
+	}
+
+	return faults, nil
+}
+
+// Injector - injects the configured faults into calls to the plugins it wraps
+type Injector struct {
+	faults map[string]*Fault
+	// guards rand, which isn't safe for concurrent use
+	lock  sync.Mutex
+	rand  *rand.Rand
+	sleep func(time.Duration)
+}
+
+func (i *Injector) fault(plugin string) *Fault {
+	if f, ok := i.faults[plugin]; ok {
+		return f
+	}
+
+	return i.faults[Any]
+}
+
+// inject - delays a call to the method of a plugin, returning an error if the call should fail
+func (i *Injector) inject(plugin string, method string) error {
+	f := i.fault(plugin)
+	if f == nil {
+		return nil
+	}
+
+	i.lock.Lock()
+	latency := f.MinLatency
+	if f.MaxLatency > f.MinLatency {
+		latency += time.Duration(i.rand.Int63n(int64(f.MaxLatency - f.MinLatency)))
+	}
+	failed := i.rand.Float64() < f.ErrorRate
+	code := f.Codes[i.rand.Intn(len(f.Codes))]
+	i.lock.Unlock()
+
+	if latency > 0 {
+		i.sleep(latency)
+	}
+
+	if !failed {
+		return nil
+	}
+
+	return errors.ErrorsWithScope(
+		fmt.Sprintf("Chaos.%s.%s", plugin, method),
+		map[string]interface{}{
+			"errorRate": f.ErrorRate,
+		},
+	)(
+		code,
+		"injected fault",
+		nil,
+	)
+}
+
+// NewInjector - returns an injector for the given faults, keyed by plugin name
+func NewInjector(faults map[string]*Fault, seed int64) *Injector {
+	return &Injector{
+		faults: faults,
+		rand:   rand.New(rand.NewSource(seed)),
+		sleep:  time.Sleep,
+	}
+}
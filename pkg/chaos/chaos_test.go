@@ -0,0 +1,137 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/chaos"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+type countingEvents struct {
+	events.UnimplementedeventsPlugin
+	published int
+}
+
+func (c *countingEvents) Publish(topic string, event *events.NitricEvent) error {
+	c.published++
+	return nil
+}
+
+type lifecycleStorage struct {
+	storage.UnimplementedStoragePlugin
+}
+
+func (l *lifecycleStorage) SetLifecycleRules(bucket string, rules []*storage.LifecycleRule) error {
+	return nil
+}
+
+var _ = Describe("Chaos", func() {
+	Context("ParseFaults", func() {
+		When("parsing faults for multiple plugins", func() {
+			It("should return the faults of each plugin", func() {
+				faults, err := chaos.ParseFaults("storage;error_rate=0.1;latency=50ms-200ms;codes=Unavailable|DeadlineExceeded, *;latency=10ms")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(faults).To(HaveLen(2))
+
+				Expect(faults[chaos.Storage]).To(Equal(&chaos.Fault{
+					ErrorRate:  0.1,
+					Codes:      []codes.Code{codes.Unavailable, codes.DeadlineExceeded},
+					MinLatency: 50 * time.Millisecond,
+					MaxLatency: 200 * time.Millisecond,
+				}))
+
+				Expect(faults[chaos.Any]).To(Equal(&chaos.Fault{
+					Codes:      []codes.Code{codes.Unavailable},
+					MinLatency: 10 * time.Millisecond,
+					MaxLatency: 10 * time.Millisecond,
+				}))
+			})
+		})
+
+		When("parsing invalid faults", func() {
+			It("should return an error", func() {
+				for _, config := range []string{
+					"cache;error_rate=0.1",
+					"storage",
+					"storage;error_rate",
+					"storage;error_rate=2",
+					"storage;latency=200ms-50ms",
+					"storage;codes=Broken",
+					"storage;jitter=1s",
+				} {
+					_, err := chaos.ParseFaults(config)
+					Expect(err).Should(HaveOccurred(), config)
+				}
+			})
+		})
+	})
+
+	Context("Injector", func() {
+		When("a plugin always fails", func() {
+			It("should return an injected error without calling the plugin", func() {
+				faults, _ := chaos.ParseFaults("events;error_rate=1;codes=ResourceExhausted")
+				plugin := &countingEvents{}
+
+				err := chaos.NewInjector(faults, 1).Events(plugin).Publish("test", &events.NitricEvent{})
+				Expect(err).Should(HaveOccurred())
+				Expect(errors.Code(err)).To(Equal(codes.ResourceExhausted))
+				Expect(plugin.published).To(Equal(0))
+			})
+		})
+
+		When("a plugin never fails", func() {
+			It("should delay calls to the plugin by the configured latency", func() {
+				faults, _ := chaos.ParseFaults("*;latency=20ms")
+				plugin := &countingEvents{}
+
+				start := time.Now()
+				err := chaos.NewInjector(faults, 1).Events(plugin).Publish("test", &events.NitricEvent{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+				Expect(plugin.published).To(Equal(1))
+			})
+		})
+
+		When("a plugin has no faults configured", func() {
+			It("should return the plugin unwrapped", func() {
+				faults, _ := chaos.ParseFaults("storage;error_rate=1")
+				plugin := &countingEvents{}
+
+				Expect(chaos.NewInjector(faults, 1).Events(plugin)).To(BeIdenticalTo(plugin))
+			})
+		})
+
+		When("a storage plugin supports lifecycle rules", func() {
+			It("should still support them when wrapped", func() {
+				faults, _ := chaos.ParseFaults("storage;error_rate=1")
+
+				wrapped := chaos.NewInjector(faults, 1).Storage(&lifecycleStorage{})
+				_, ok := wrapped.(storage.LifecycleService)
+				Expect(ok).To(BeTrue())
+
+				_, err := wrapped.Read("bucket", "key")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,619 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/sql"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+type documentService struct {
+	document.DocumentService
+	injector *Injector
+}
+
+func (d *documentService) Get(key *document.Key) (*document.Document, error) {
+	if err := d.injector.inject(Document, "Get"); err != nil {
+		return nil, err
+	}
+	return d.DocumentService.Get(key)
+}
+
+func (d *documentService) Set(key *document.Key, content map[string]interface{}) error {
+	if err := d.injector.inject(Document, "Set"); err != nil {
+		return err
+	}
+	return d.DocumentService.Set(key, content)
+}
+
+func (d *documentService) Delete(key *document.Key) error {
+	if err := d.injector.inject(Document, "Delete"); err != nil {
+		return err
+	}
+	return d.DocumentService.Delete(key)
+}
+
+func (d *documentService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	if err := d.injector.inject(Document, "SetBatch"); err != nil {
+		return nil, err
+	}
+	return d.DocumentService.SetBatch(docs)
+}
+
+func (d *documentService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	if err := d.injector.inject(Document, "DeleteBatch"); err != nil {
+		return nil, err
+	}
+	return d.DocumentService.DeleteBatch(keys)
+}
+
+func (d *documentService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	if err := d.injector.inject(Document, "Increment"); err != nil {
+		return 0, err
+	}
+	return d.DocumentService.Increment(key, field, delta)
+}
+
+func (d *documentService) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	if err := d.injector.inject(Document, "CompareAndSet"); err != nil {
+		return err
+	}
+	return d.DocumentService.CompareAndSet(key, field, expected, content)
+}
+
+func (d *documentService) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	if err := d.injector.inject(Document, "Query"); err != nil {
+		return nil, err
+	}
+	return d.DocumentService.Query(collection, expressions, limit, pagingToken)
+}
+
+func (d *documentService) QueryStream(collection *document.Collection, expressions []document.QueryExpression, limit int) document.DocumentIterator {
+	if err := d.injector.inject(Document, "QueryStream"); err != nil {
+		return func() (*document.Document, error) {
+			return nil, err
+		}
+	}
+	return d.DocumentService.QueryStream(collection, expressions, limit)
+}
+
+func (d *documentService) Aggregate(collection *document.Collection, expressions []document.QueryExpression, aggregations []document.Aggregation) ([]document.AggregateResult, error) {
+	if err := d.injector.inject(Document, "Aggregate"); err != nil {
+		return nil, err
+	}
+	return d.DocumentService.Aggregate(collection, expressions, aggregations)
+}
+
+// Document - wraps a document plugin, injecting faults into its calls
+func (i *Injector) Document(plugin document.DocumentService) document.DocumentService {
+	if plugin == nil || i.fault(Document) == nil {
+		return plugin
+	}
+
+	return &documentService{DocumentService: plugin, injector: i}
+}
+
+type eventService struct {
+	events.EventService
+	injector *Injector
+}
+
+func (e *eventService) Publish(topic string, event *events.NitricEvent) error {
+	if err := e.injector.inject(Events, "Publish"); err != nil {
+		return err
+	}
+	return e.EventService.Publish(topic, event)
+}
+
+func (e *eventService) ListTopics() ([]string, error) {
+	if err := e.injector.inject(Events, "ListTopics"); err != nil {
+		return nil, err
+	}
+	return e.EventService.ListTopics()
+}
+
+func (e *eventService) Replay(topic string, from time.Time, to time.Time) error {
+	if err := e.injector.inject(Events, "Replay"); err != nil {
+		return err
+	}
+	return e.EventService.Replay(topic, from, to)
+}
+
+func (e *eventService) Backlog(topic string) (int64, error) {
+	if err := e.injector.inject(Events, "Backlog"); err != nil {
+		return 0, err
+	}
+	return e.EventService.Backlog(topic)
+}
+
+// Events - wraps an events plugin, injecting faults into its calls
+func (i *Injector) Events(plugin events.EventService) events.EventService {
+	if plugin == nil || i.fault(Events) == nil {
+		return plugin
+	}
+
+	return &eventService{EventService: plugin, injector: i}
+}
+
+type storageService struct {
+	storage.StorageService
+	injector *Injector
+}
+
+func (s *storageService) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	if err := s.injector.inject(Storage, "Read"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.Read(bucket, key, opts...)
+}
+
+func (s *storageService) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	if err := s.injector.inject(Storage, "Write"); err != nil {
+		return "", err
+	}
+	return s.StorageService.Write(bucket, key, object, opts...)
+}
+
+func (s *storageService) Append(bucket string, key string, object []byte) error {
+	if err := s.injector.inject(Storage, "Append"); err != nil {
+		return err
+	}
+	return s.StorageService.Append(bucket, key, object)
+}
+
+func (s *storageService) Delete(bucket string, key string, opts ...storage.DeleteOption) error {
+	if err := s.injector.inject(Storage, "Delete"); err != nil {
+		return err
+	}
+	return s.StorageService.Delete(bucket, key, opts...)
+}
+
+func (s *storageService) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	if err := s.injector.inject(Storage, "ListFiles"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.ListFiles(bucket, opts...)
+}
+
+func (s *storageService) PreSignUrl(bucket string, key string, operation storage.Operation, expiry uint32) (string, error) {
+	if err := s.injector.inject(Storage, "PreSignUrl"); err != nil {
+		return "", err
+	}
+	return s.StorageService.PreSignUrl(bucket, key, operation, expiry)
+}
+
+func (s *storageService) ListVersions(bucket string, key string) ([]*storage.VersionInfo, error) {
+	if err := s.injector.inject(Storage, "ListVersions"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.ListVersions(bucket, key)
+}
+
+func (s *storageService) RestoreVersion(bucket string, key string, version string) (string, error) {
+	if err := s.injector.inject(Storage, "RestoreVersion"); err != nil {
+		return "", err
+	}
+	return s.StorageService.RestoreVersion(bucket, key, version)
+}
+
+func (s *storageService) SetTags(bucket string, key string, tags map[string]string) error {
+	if err := s.injector.inject(Storage, "SetTags"); err != nil {
+		return err
+	}
+	return s.StorageService.SetTags(bucket, key, tags)
+}
+
+func (s *storageService) GetTags(bucket string, key string) (map[string]string, error) {
+	if err := s.injector.inject(Storage, "GetTags"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.GetTags(bucket, key)
+}
+
+func (s *storageService) BeginUpload(bucket string, key string) (string, error) {
+	if err := s.injector.inject(Storage, "BeginUpload"); err != nil {
+		return "", err
+	}
+	return s.StorageService.BeginUpload(bucket, key)
+}
+
+func (s *storageService) UploadPart(bucket string, key string, upload string, part *storage.UploadPart, data []byte, final bool) (*storage.UploadPart, error) {
+	if err := s.injector.inject(Storage, "UploadPart"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.UploadPart(bucket, key, upload, part, data, final)
+}
+
+func (s *storageService) CompleteUpload(bucket string, key string, upload string, parts []*storage.UploadPart) error {
+	if err := s.injector.inject(Storage, "CompleteUpload"); err != nil {
+		return err
+	}
+	return s.StorageService.CompleteUpload(bucket, key, upload, parts)
+}
+
+func (s *storageService) AbortUpload(bucket string, key string, upload string) error {
+	if err := s.injector.inject(Storage, "AbortUpload"); err != nil {
+		return err
+	}
+	return s.StorageService.AbortUpload(bucket, key, upload)
+}
+
+func (s *storageService) GrantAccess(bucket string, prefix string, operations []storage.Operation, expiry uint32) (*storage.AccessGrant, error) {
+	if err := s.injector.inject(Storage, "GrantAccess"); err != nil {
+		return nil, err
+	}
+	return s.StorageService.GrantAccess(bucket, prefix, operations, expiry)
+}
+
+// lifecycleStorageService - keeps lifecycle rule support visible on wrapped storage plugins that have it
+type lifecycleStorageService struct {
+	*storageService
+	storage.LifecycleService
+}
+
+// Storage - wraps a storage plugin, injecting faults into its calls
+func (i *Injector) Storage(plugin storage.StorageService) storage.StorageService {
+	if plugin == nil || i.fault(Storage) == nil {
+		return plugin
+	}
+
+	wrapped := &storageService{StorageService: plugin, injector: i}
+	if lifecycle, ok := plugin.(storage.LifecycleService); ok {
+		return &lifecycleStorageService{storageService: wrapped, LifecycleService: lifecycle}
+	}
+
+	return wrapped
+}
+
+type queueService struct {
+	queue.QueueService
+	injector *Injector
+}
+
+func (q *queueService) Send(queueName string, task queue.NitricTask) error {
+	if err := q.injector.inject(Queue, "Send"); err != nil {
+		return err
+	}
+	return q.QueueService.Send(queueName, task)
+}
+
+func (q *queueService) SendBatch(queueName string, tasks []queue.NitricTask) (*queue.SendBatchResponse, error) {
+	if err := q.injector.inject(Queue, "SendBatch"); err != nil {
+		return nil, err
+	}
+	return q.QueueService.SendBatch(queueName, tasks)
+}
+
+func (q *queueService) Receive(options queue.ReceiveOptions) ([]queue.NitricTask, error) {
+	if err := q.injector.inject(Queue, "Receive"); err != nil {
+		return nil, err
+	}
+	return q.QueueService.Receive(options)
+}
+
+func (q *queueService) Complete(queueName string, leaseId string) error {
+	if err := q.injector.inject(Queue, "Complete"); err != nil {
+		return err
+	}
+	return q.QueueService.Complete(queueName, leaseId)
+}
+
+func (q *queueService) CompleteBatch(queueName string, leaseIds []string) (*queue.CompleteBatchResponse, error) {
+	if err := q.injector.inject(Queue, "CompleteBatch"); err != nil {
+		return nil, err
+	}
+	return q.QueueService.CompleteBatch(queueName, leaseIds)
+}
+
+func (q *queueService) Release(queueName string, leaseId string, delay time.Duration) error {
+	if err := q.injector.inject(Queue, "Release"); err != nil {
+		return err
+	}
+	return q.QueueService.Release(queueName, leaseId, delay)
+}
+
+func (q *queueService) GetQueueStats(queueName string) (*queue.QueueStats, error) {
+	if err := q.injector.inject(Queue, "GetQueueStats"); err != nil {
+		return nil, err
+	}
+	return q.QueueService.GetQueueStats(queueName)
+}
+
+// Queue - wraps a queue plugin, injecting faults into its calls
+func (i *Injector) Queue(plugin queue.QueueService) queue.QueueService {
+	if plugin == nil || i.fault(Queue) == nil {
+		return plugin
+	}
+
+	return &queueService{QueueService: plugin, injector: i}
+}
+
+type secretService struct {
+	secret.SecretService
+	injector *Injector
+}
+
+func (s *secretService) Put(sec *secret.Secret, value []byte) (*secret.SecretPutResponse, error) {
+	if err := s.injector.inject(Secret, "Put"); err != nil {
+		return nil, err
+	}
+	return s.SecretService.Put(sec, value)
+}
+
+func (s *secretService) Access(version *secret.SecretVersion) (*secret.SecretAccessResponse, error) {
+	if err := s.injector.inject(Secret, "Access"); err != nil {
+		return nil, err
+	}
+	return s.SecretService.Access(version)
+}
+
+// Secret - wraps a secret plugin, injecting faults into its calls
+func (i *Injector) Secret(plugin secret.SecretService) secret.SecretService {
+	if plugin == nil || i.fault(Secret) == nil {
+		return plugin
+	}
+
+	return &secretService{SecretService: plugin, injector: i}
+}
+
+type sqlService struct {
+	sql.SqlService
+	injector *Injector
+}
+
+func (s *sqlService) Query(database string, query string, params []interface{}, transactionId string) (*sql.QueryResult, error) {
+	if err := s.injector.inject(Sql, "Query"); err != nil {
+		return nil, err
+	}
+	return s.SqlService.Query(database, query, params, transactionId)
+}
+
+func (s *sqlService) Exec(database string, statement string, params []interface{}, transactionId string) (*sql.ExecResult, error) {
+	if err := s.injector.inject(Sql, "Exec"); err != nil {
+		return nil, err
+	}
+	return s.SqlService.Exec(database, statement, params, transactionId)
+}
+
+func (s *sqlService) Begin(database string) (string, error) {
+	if err := s.injector.inject(Sql, "Begin"); err != nil {
+		return "", err
+	}
+	return s.SqlService.Begin(database)
+}
+
+func (s *sqlService) Commit(transactionId string) error {
+	if err := s.injector.inject(Sql, "Commit"); err != nil {
+		return err
+	}
+	return s.SqlService.Commit(transactionId)
+}
+
+func (s *sqlService) Rollback(transactionId string) error {
+	if err := s.injector.inject(Sql, "Rollback"); err != nil {
+		return err
+	}
+	return s.SqlService.Rollback(transactionId)
+}
+
+// Sql - wraps a sql plugin, injecting faults into its calls
+func (i *Injector) Sql(plugin sql.SqlService) sql.SqlService {
+	if plugin == nil || i.fault(Sql) == nil {
+		return plugin
+	}
+
+	return &sqlService{SqlService: plugin, injector: i}
+}
+
+type searchService struct {
+	search.SearchService
+	injector *Injector
+}
+
+func (s *searchService) Index(index string, id string, content map[string]interface{}) error {
+	if err := s.injector.inject(Search, "Index"); err != nil {
+		return err
+	}
+	return s.SearchService.Index(index, id, content)
+}
+
+func (s *searchService) Delete(index string, id string) error {
+	if err := s.injector.inject(Search, "Delete"); err != nil {
+		return err
+	}
+	return s.SearchService.Delete(index, id)
+}
+
+func (s *searchService) Query(index string, query *search.Query) (*search.QueryResult, error) {
+	if err := s.injector.inject(Search, "Query"); err != nil {
+		return nil, err
+	}
+	return s.SearchService.Query(index, query)
+}
+
+// Search - wraps a search plugin, injecting faults into its calls
+func (i *Injector) Search(plugin search.SearchService) search.SearchService {
+	if plugin == nil || i.fault(Search) == nil {
+		return plugin
+	}
+
+	return &searchService{SearchService: plugin, injector: i}
+}
+
+type batchService struct {
+	batch.BatchService
+	injector *Injector
+}
+
+func (b *batchService) Submit(job *batch.JobSpec) (string, error) {
+	if err := b.injector.inject(Batch, "Submit"); err != nil {
+		return "", err
+	}
+	return b.BatchService.Submit(job)
+}
+
+func (b *batchService) Status(id string) (*batch.JobStatus, error) {
+	if err := b.injector.inject(Batch, "Status"); err != nil {
+		return nil, err
+	}
+	return b.BatchService.Status(id)
+}
+
+func (b *batchService) Logs(id string, pagingToken string) (*batch.LogResult, error) {
+	if err := b.injector.inject(Batch, "Logs"); err != nil {
+		return nil, err
+	}
+	return b.BatchService.Logs(id, pagingToken)
+}
+
+// Batch - wraps a batch plugin, injecting faults into its calls
+func (i *Injector) Batch(plugin batch.BatchService) batch.BatchService {
+	if plugin == nil || i.fault(Batch) == nil {
+		return plugin
+	}
+
+	return &batchService{BatchService: plugin, injector: i}
+}
+
+type cdnService struct {
+	cdn.CdnService
+	injector *Injector
+}
+
+func (c *cdnService) SignUrl(bucket string, key string, expiry time.Duration) (string, error) {
+	if err := c.injector.inject(Cdn, "SignUrl"); err != nil {
+		return "", err
+	}
+	return c.CdnService.SignUrl(bucket, key, expiry)
+}
+
+func (c *cdnService) SignCookies(bucket string, prefix string, expiry time.Duration) ([]*cdn.Cookie, error) {
+	if err := c.injector.inject(Cdn, "SignCookies"); err != nil {
+		return nil, err
+	}
+	return c.CdnService.SignCookies(bucket, prefix, expiry)
+}
+
+// Cdn - wraps a cdn plugin, injecting faults into its calls
+func (i *Injector) Cdn(plugin cdn.CdnService) cdn.CdnService {
+	if plugin == nil || i.fault(Cdn) == nil {
+		return plugin
+	}
+
+	return &cdnService{CdnService: plugin, injector: i}
+}
+
+type flagsService struct {
+	flags.FlagsService
+	injector *Injector
+}
+
+func (f *flagsService) Evaluate(flag string, ctx *flags.Context) (*flags.Evaluation, error) {
+	if err := f.injector.inject(Flags, "Evaluate"); err != nil {
+		return nil, err
+	}
+	return f.FlagsService.Evaluate(flag, ctx)
+}
+
+// Flags - wraps a flags plugin, injecting faults into its calls
+func (i *Injector) Flags(plugin flags.FlagsService) flags.FlagsService {
+	if plugin == nil || i.fault(Flags) == nil {
+		return plugin
+	}
+
+	return &flagsService{FlagsService: plugin, injector: i}
+}
+
+type configService struct {
+	config.ConfigService
+	injector *Injector
+}
+
+func (c *configService) Get(key string) (*config.Value, error) {
+	if err := c.injector.inject(Config, "Get"); err != nil {
+		return nil, err
+	}
+	return c.ConfigService.Get(key)
+}
+
+// Config - wraps a config plugin, injecting faults into its calls
+func (i *Injector) Config(plugin config.ConfigService) config.ConfigService {
+	if plugin == nil || i.fault(Config) == nil {
+		return plugin
+	}
+
+	return &configService{ConfigService: plugin, injector: i}
+}
+
+type identityService struct {
+	identity.IdentityService
+	injector *Injector
+}
+
+func (d *identityService) VerifyToken(token string) (*identity.Token, error) {
+	if err := d.injector.inject(Identity, "VerifyToken"); err != nil {
+		return nil, err
+	}
+	return d.IdentityService.VerifyToken(token)
+}
+
+func (d *identityService) GetUser(id string) (*identity.User, error) {
+	if err := d.injector.inject(Identity, "GetUser"); err != nil {
+		return nil, err
+	}
+	return d.IdentityService.GetUser(id)
+}
+
+func (d *identityService) CreateUser(user *identity.NewUser) (*identity.User, error) {
+	if err := d.injector.inject(Identity, "CreateUser"); err != nil {
+		return nil, err
+	}
+	return d.IdentityService.CreateUser(user)
+}
+
+func (d *identityService) SetDisabled(id string, disabled bool) error {
+	if err := d.injector.inject(Identity, "SetDisabled"); err != nil {
+		return err
+	}
+	return d.IdentityService.SetDisabled(id, disabled)
+}
+
+func (d *identityService) SetAttributes(id string, attributes map[string]string) error {
+	if err := d.injector.inject(Identity, "SetAttributes"); err != nil {
+		return err
+	}
+	return d.IdentityService.SetAttributes(id, attributes)
+}
+
+// Identity - wraps an identity plugin, injecting faults into its calls
+func (i *Injector) Identity(plugin identity.IdentityService) identity.IdentityService {
+	if plugin == nil || i.fault(Identity) == nil {
+		return plugin
+	}
+
+	return &identityService{IdentityService: plugin, injector: i}
+}
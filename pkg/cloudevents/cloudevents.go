@@ -0,0 +1,326 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudevents - wraps and unwraps nitric events in the CloudEvents 1.0 format,
+// so nitric topics can interoperate with other CloudEvents producers and consumers.
+//
+// See https://github.com/cloudevents/spec/blob/v1.0.1/spec.md
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	SpecVersion = "1.0"
+	// ContentType - the content type of events in structured mode
+	ContentType = "application/cloudevents+json"
+	// DefaultType - the type of events published without a payload type
+	DefaultType = "io.nitric.event"
+	// TopicExtension - the extension attribute carrying the name of the nitric topic an event was published to
+	TopicExtension = "nitrictopic"
+	// OrderingKeyExtension - the extension attribute carrying the ordering key of an event
+	OrderingKeyExtension = "nitricorderingkey"
+
+	// binaryPrefix - the prefix of attributes in binary mode, as headers or message attributes
+	binaryPrefix = "ce-"
+)
+
+// Mode - how events are encoded as CloudEvents
+type Mode string
+
+const (
+	// Disabled - events are published in the nitric event format
+	Disabled Mode = ""
+	// Structured - the event and its attributes are encoded together as a JSON document
+	Structured Mode = "structured"
+	// Binary - the event data is sent as is, with its attributes sent as headers or message attributes
+	Binary Mode = "binary"
+)
+
+// ModeFromString - returns the Mode with the given name
+func ModeFromString(mode string) (Mode, error) {
+	switch Mode(strings.ToLower(mode)) {
+	case Disabled:
+		return Disabled, nil
+	case Structured:
+		return Structured, nil
+	case Binary:
+		return Binary, nil
+	default:
+		return Disabled, fmt.Errorf("unknown CloudEvents mode %s, supported modes are structured and binary", mode)
+	}
+}
+
+// ModeFromEnv - returns the Mode configured by the CLOUDEVENTS_MODE env var
+func ModeFromEnv() (Mode, error) {
+	return ModeFromString(utils.GetEnv("CLOUDEVENTS_MODE", ""))
+}
+
+// Event - A CloudEvents 1.0 event
+type Event struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	DataContentType string `json:"datacontenttype,omitempty"`
+	Subject         string `json:"subject,omitempty"`
+	Time            string `json:"time,omitempty"`
+	Topic           string `json:"nitrictopic,omitempty"`
+	OrderingKey     string `json:"nitricorderingkey,omitempty"`
+	// TraceParent and TraceState - the distributed tracing extension, carrying the W3C trace context of the event
+	TraceParent string          `json:"traceparent,omitempty"`
+	TraceState  string          `json:"tracestate,omitempty"`
+	Data        json.RawMessage `json:"data,omitempty"`
+	DataBase64  string          `json:"data_base64,omitempty"`
+}
+
+// FromNitricEvent - wraps a nitric event published to the given topic
+func FromNitricEvent(topic string, event *events.NitricEvent) (*Event, error) {
+	data, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := event.PayloadType
+	if eventType == "" {
+		eventType = DefaultType
+	}
+
+	return &Event{
+		SpecVersion:     SpecVersion,
+		ID:              event.ID,
+		Source:          "/topics/" + topic,
+		Type:            eventType,
+		DataContentType: "application/json",
+		Time:            time.Now().UTC().Format(time.RFC3339),
+		Topic:           topic,
+		OrderingKey:     event.OrderingKey,
+		Data:            data,
+	}, nil
+}
+
+func isJson(contentType string) bool {
+	return contentType == "" || strings.HasPrefix(contentType, "application/json") || strings.HasSuffix(strings.Split(contentType, ";")[0], "+json")
+}
+
+// Payload - returns the event's data
+func (e *Event) Payload() ([]byte, error) {
+	if e.DataBase64 != "" {
+		return base64.StdEncoding.DecodeString(e.DataBase64)
+	}
+
+	// Non JSON data is encoded as a JSON string in structured mode
+	if !isJson(e.DataContentType) {
+		var text string
+		if err := json.Unmarshal(e.Data, &text); err == nil {
+			return []byte(text), nil
+		}
+	}
+
+	return e.Data, nil
+}
+
+// ToTrigger - unwraps the event as a nitric event trigger
+func (e *Event) ToTrigger() (*triggers.Event, error) {
+	payload, err := e.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	// The time is optional, so an unparseable one is treated as unknown rather than rejecting the event
+	publishTime, _ := time.Parse(time.RFC3339, e.Time)
+
+	return &triggers.Event{
+		ID:          e.ID,
+		Topic:       e.Topic,
+		Payload:     payload,
+		OrderingKey: e.OrderingKey,
+		Metadata: triggers.Metadata{
+			Source:      e.Source,
+			PublishTime: publishTime,
+			TraceContext: triggers.TraceContextFrom(map[string]string{
+				triggers.TraceParentKey: e.TraceParent,
+				triggers.TraceStateKey:  e.TraceState,
+			}),
+		},
+	}, nil
+}
+
+func (e *Event) validate() error {
+	if e.SpecVersion != SpecVersion {
+		return fmt.Errorf("unsupported CloudEvents specversion %s", e.SpecVersion)
+	}
+
+	if e.ID == "" || e.Source == "" || e.Type == "" {
+		return fmt.Errorf("CloudEvent is missing required attributes, id, source and type are required")
+	}
+
+	return nil
+}
+
+// MarshalStructured - encodes the event in structured mode
+func (e *Event) MarshalStructured() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// UnmarshalStructured - decodes an event in structured mode.
+//
+// Returns false if the data isn't a CloudEvent.
+func UnmarshalStructured(data []byte) (*Event, bool, error) {
+	evt := &Event{}
+	if err := json.Unmarshal(data, evt); err != nil || evt.SpecVersion == "" {
+		return nil, false, nil
+	}
+
+	if err := evt.validate(); err != nil {
+		return nil, true, err
+	}
+
+	return evt, true, nil
+}
+
+// MarshalBinary - encodes the event in binary mode,
+// returning the event's data and its attributes as ce- prefixed headers or message attributes
+func (e *Event) MarshalBinary() (map[string]string, []byte, error) {
+	data, err := e.Payload()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attributes := map[string]string{
+		binaryPrefix + "specversion": e.SpecVersion,
+		binaryPrefix + "id":          e.ID,
+		binaryPrefix + "source":      e.Source,
+		binaryPrefix + "type":        e.Type,
+	}
+
+	optional := map[string]string{
+		"content-type":                      e.DataContentType,
+		binaryPrefix + "subject":            e.Subject,
+		binaryPrefix + "time":               e.Time,
+		binaryPrefix + TopicExtension:       e.Topic,
+		binaryPrefix + OrderingKeyExtension: e.OrderingKey,
+		binaryPrefix + "traceparent":        e.TraceParent,
+		binaryPrefix + "tracestate":         e.TraceState,
+	}
+	for k, v := range optional {
+		if v != "" {
+			attributes[k] = v
+		}
+	}
+
+	return attributes, data, nil
+}
+
+// UnmarshalBinary - decodes an event in binary mode from its data and headers or message attributes.
+//
+// Attribute names are case insensitive. Returns false if the attributes don't describe a CloudEvent.
+func UnmarshalBinary(attributes map[string]string, data []byte) (*Event, bool, error) {
+	attrs := make(map[string]string, len(attributes))
+	for k, v := range attributes {
+		attrs[strings.ToLower(k)] = v
+	}
+
+	if _, ok := attrs[binaryPrefix+"specversion"]; !ok {
+		return nil, false, nil
+	}
+
+	evt := &Event{
+		SpecVersion:     attrs[binaryPrefix+"specversion"],
+		ID:              attrs[binaryPrefix+"id"],
+		Source:          attrs[binaryPrefix+"source"],
+		Type:            attrs[binaryPrefix+"type"],
+		DataContentType: attrs["content-type"],
+		Subject:         attrs[binaryPrefix+"subject"],
+		Time:            attrs[binaryPrefix+"time"],
+		Topic:           attrs[binaryPrefix+TopicExtension],
+		OrderingKey:     attrs[binaryPrefix+OrderingKeyExtension],
+		TraceParent:     firstOf(attrs[binaryPrefix+"traceparent"], attrs[triggers.TraceParentKey]),
+		TraceState:      firstOf(attrs[binaryPrefix+"tracestate"], attrs[triggers.TraceStateKey]),
+	}
+
+	if len(data) > 0 {
+		if isJson(evt.DataContentType) && json.Valid(data) {
+			evt.Data = data
+		} else {
+			evt.DataBase64 = base64.StdEncoding.EncodeToString(data)
+		}
+	}
+
+	if err := evt.validate(); err != nil {
+		return nil, true, err
+	}
+
+	return evt, true, nil
+}
+
+// firstOf - returns the first non empty value
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// EncodeMessage - encodes a nitric event published to the given topic as a message body and attributes, in the given mode.
+//
+// When CloudEvents are disabled the event is encoded in the nitric event format.
+// The event's own attributes are included in every mode, so they can be used to filter subscriptions.
+func EncodeMessage(mode Mode, topic string, event *events.NitricEvent) (map[string]string, []byte, error) {
+	attributes, data, err := encodeMessage(mode, topic, event)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range event.Attributes {
+		attributes[k] = v
+	}
+
+	return attributes, data, nil
+}
+
+func encodeMessage(mode Mode, topic string, event *events.NitricEvent) (map[string]string, []byte, error) {
+	if mode == Disabled {
+		data, err := json.Marshal(event)
+		return map[string]string{}, data, err
+	}
+
+	evt, err := FromNitricEvent(topic, event)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if mode == Binary {
+		return evt.MarshalBinary()
+	}
+
+	data, err := evt.MarshalStructured()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return map[string]string{"content-type": ContentType}, data, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudevents_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/cloudevents"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+)
+
+var _ = Describe("CloudEvents", func() {
+	testEvent := &events.NitricEvent{
+		ID:          "1234",
+		PayloadType: "order.created",
+		Payload: map[string]interface{}{
+			"id": "abc",
+		},
+	}
+
+	Context("ModeFromString", func() {
+		It("should parse the supported modes", func() {
+			for name, mode := range map[string]cloudevents.Mode{
+				"":           cloudevents.Disabled,
+				"structured": cloudevents.Structured,
+				"BINARY":     cloudevents.Binary,
+			} {
+				m, err := cloudevents.ModeFromString(name)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(m).To(Equal(mode))
+			}
+		})
+
+		It("should reject unknown modes", func() {
+			_, err := cloudevents.ModeFromString("batched")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("EncodeMessage", func() {
+		When("CloudEvents are disabled", func() {
+			It("should encode the nitric event", func() {
+				attrs, data, err := cloudevents.EncodeMessage(cloudevents.Disabled, "orders", testEvent)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(attrs).To(BeEmpty())
+
+				evt := &events.NitricEvent{}
+				Expect(json.Unmarshal(data, evt)).To(Succeed())
+				Expect(evt).To(Equal(testEvent))
+			})
+		})
+
+		When("encoding in structured mode", func() {
+			attrs, data, err := cloudevents.EncodeMessage(cloudevents.Structured, "orders", testEvent)
+
+			It("should set the content type", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(attrs).To(HaveKeyWithValue("content-type", cloudevents.ContentType))
+			})
+
+			It("should round trip the event", func() {
+				evt, ok, err := cloudevents.UnmarshalStructured(data)
+				Expect(ok).To(BeTrue())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(evt.ID).To(Equal("1234"))
+				Expect(evt.Type).To(Equal("order.created"))
+				Expect(evt.Source).To(Equal("/topics/orders"))
+				Expect(evt.Topic).To(Equal("orders"))
+
+				trigger, err := evt.ToTrigger()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(trigger.Topic).To(Equal("orders"))
+				Expect(trigger.Payload).To(MatchJSON(`{"id":"abc"}`))
+			})
+		})
+
+		When("encoding in binary mode", func() {
+			attrs, data, err := cloudevents.EncodeMessage(cloudevents.Binary, "orders", testEvent)
+
+			It("should encode the attributes separately from the data", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(attrs).To(HaveKeyWithValue("ce-specversion", "1.0"))
+				Expect(attrs).To(HaveKeyWithValue("ce-id", "1234"))
+				Expect(attrs).To(HaveKeyWithValue("ce-nitrictopic", "orders"))
+				Expect(attrs).To(HaveKeyWithValue("content-type", "application/json"))
+				Expect(data).To(MatchJSON(`{"id":"abc"}`))
+			})
+
+			It("should round trip the event", func() {
+				evt, ok, err := cloudevents.UnmarshalBinary(attrs, data)
+				Expect(ok).To(BeTrue())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(evt.ID).To(Equal("1234"))
+				Expect(evt.Topic).To(Equal("orders"))
+
+				payload, err := evt.Payload()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(payload).To(MatchJSON(`{"id":"abc"}`))
+			})
+		})
+
+		When("the event has no payload type", func() {
+			It("should use the default type", func() {
+				_, data, _ := cloudevents.EncodeMessage(cloudevents.Structured, "orders", &events.NitricEvent{ID: "1"})
+				evt, _, _ := cloudevents.UnmarshalStructured(data)
+				Expect(evt.Type).To(Equal(cloudevents.DefaultType))
+			})
+		})
+
+		When("the event has attributes", func() {
+			It("should include them in every mode", func() {
+				evt := &events.NitricEvent{ID: "1", Attributes: map[string]string{"x-nitric-tenant": "acme"}}
+
+				for _, mode := range []cloudevents.Mode{cloudevents.Disabled, cloudevents.Structured, cloudevents.Binary} {
+					attrs, _, err := cloudevents.EncodeMessage(mode, "orders", evt)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(attrs).To(HaveKeyWithValue("x-nitric-tenant", "acme"))
+				}
+			})
+		})
+
+		When("the event has an ordering key", func() {
+			It("should carry it to the trigger in binary mode", func() {
+				attrs, data, err := cloudevents.EncodeMessage(cloudevents.Binary, "orders", &events.NitricEvent{ID: "1", OrderingKey: "customer-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(attrs).To(HaveKeyWithValue("ce-nitricorderingkey", "customer-1"))
+
+				evt, _, _ := cloudevents.UnmarshalBinary(attrs, data)
+				trigger, err := evt.ToTrigger()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(trigger.OrderingKey).To(Equal("customer-1"))
+			})
+		})
+	})
+
+	Context("UnmarshalStructured", func() {
+		When("the data isn't a CloudEvent", func() {
+			It("should return false", func() {
+				_, ok, err := cloudevents.UnmarshalStructured([]byte(`{"id":"1234","payload":{}}`))
+				Expect(ok).To(BeFalse())
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		When("required attributes are missing", func() {
+			It("should return an error", func() {
+				_, ok, err := cloudevents.UnmarshalStructured([]byte(`{"specversion":"1.0","id":"1234"}`))
+				Expect(ok).To(BeTrue())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the spec version is unsupported", func() {
+			It("should return an error", func() {
+				_, _, err := cloudevents.UnmarshalStructured([]byte(`{"specversion":"0.3","id":"1","source":"s","type":"t"}`))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the event has text data", func() {
+			It("should return the unquoted text as the payload", func() {
+				evt, _, err := cloudevents.UnmarshalStructured([]byte(`{"specversion":"1.0","id":"1","source":"s","type":"t","datacontenttype":"text/plain","data":"hello"}`))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				payload, err := evt.Payload()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(payload)).To(Equal("hello"))
+			})
+		})
+
+		When("the event has base64 data", func() {
+			It("should return the decoded payload", func() {
+				evt, _, err := cloudevents.UnmarshalStructured([]byte(`{"specversion":"1.0","id":"1","source":"s","type":"t","data_base64":"aGVsbG8="}`))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				payload, err := evt.Payload()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(payload)).To(Equal("hello"))
+			})
+		})
+	})
+
+	Context("UnmarshalBinary", func() {
+		When("the attributes don't describe a CloudEvent", func() {
+			It("should return false", func() {
+				_, ok, _ := cloudevents.UnmarshalBinary(map[string]string{"x-nitric-topic": "orders"}, nil)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the event has time and trace context attributes", func() {
+			It("should carry them to the trigger's metadata", func() {
+				evt, ok, err := cloudevents.UnmarshalBinary(map[string]string{
+					"ce-specversion": "1.0",
+					"ce-id":          "1",
+					"ce-source":      "/topics/orders",
+					"ce-type":        "t",
+					"ce-time":        "2021-06-01T12:00:00Z",
+					"traceparent":    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				}, nil)
+				Expect(ok).To(BeTrue())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				trigger, err := evt.ToTrigger()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(trigger.Metadata.Source).To(Equal("/topics/orders"))
+				Expect(trigger.Metadata.PublishTime).To(Equal(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)))
+				Expect(trigger.Metadata.TraceContext).To(Equal(map[string]string{
+					"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				}))
+			})
+		})
+
+		When("the attributes are http headers", func() {
+			It("should match them case insensitively", func() {
+				evt, ok, err := cloudevents.UnmarshalBinary(map[string]string{
+					"Ce-Specversion": "1.0",
+					"Ce-Id":          "1",
+					"Ce-Source":      "s",
+					"Ce-Type":        "t",
+					"Content-Type":   "text/plain",
+				}, []byte("hello"))
+				Expect(ok).To(BeTrue())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("preserving non JSON data when re-encoded in structured mode")
+				data, err := evt.MarshalStructured()
+				Expect(err).ShouldNot(HaveOccurred())
+
+				decoded, _, _ := cloudevents.UnmarshalStructured(data)
+				payload, _ := decoded.Payload()
+				Expect(string(payload)).To(Equal("hello"))
+			})
+		})
+	})
+})
@@ -0,0 +1,59 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudevents
+
+import (
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+// FromHttpRequest - decodes a CloudEvent delivered over HTTP in either structured or binary mode.
+//
+// Returns false if the request isn't a CloudEvent.
+func FromHttpRequest(ctx *fasthttp.RequestCtx) (*Event, bool, error) {
+	if strings.HasPrefix(string(ctx.Request.Header.ContentType()), ContentType) {
+		return UnmarshalStructured(ctx.Request.Body())
+	}
+
+	if len(ctx.Request.Header.Peek(binaryPrefix+"specversion")) == 0 {
+		return nil, false, nil
+	}
+
+	headers := make(map[string]string)
+	ctx.Request.Header.VisitAll(func(key, value []byte) {
+		headers[string(key)] = string(value)
+	})
+
+	return UnmarshalBinary(headers, ctx.Request.Body())
+}
+
+// HandleWebhookValidation - responds to the CloudEvents webhook abuse protection handshake,
+// allowing the requesting origin to deliver events.
+//
+// Returns false if the request isn't a validation request.
+// See https://github.com/cloudevents/spec/blob/v1.0.1/http-webhook.md#4-abuse-protection
+func HandleWebhookValidation(ctx *fasthttp.RequestCtx) bool {
+	origin := ctx.Request.Header.Peek("WebHook-Request-Origin")
+	if !ctx.IsOptions() || len(origin) == 0 {
+		return false
+	}
+
+	ctx.Response.Header.SetBytesV("WebHook-Allowed-Origin", origin)
+	ctx.Response.Header.Set("WebHook-Allowed-Rate", "*")
+	ctx.SetStatusCode(fasthttp.StatusOK)
+
+	return true
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apigateway
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi/apigatewaymanagementapiiface"
+
+	"github.com/nitrictech/nitric/pkg/connections"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+)
+
+// ApiGatewaySender - sends messages to the connections of an API Gateway websocket API through its management API
+type ApiGatewaySender struct {
+	client apigatewaymanagementapiiface.ApiGatewayManagementApiAPI
+}
+
+var _ connections.Sender = &ApiGatewaySender{}
+
+func (a *ApiGatewaySender) Send(connectionId string, message []byte) error {
+	newErr := errors.ErrorsWithScope(
+		"ApiGatewaySender.Send",
+		map[string]interface{}{
+			"connection": connectionId,
+		},
+	)
+
+	_, err := a.client.PostToConnection(&apigatewaymanagementapi.PostToConnectionInput{
+		ConnectionId: aws.String(connectionId),
+		Data:         message,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == apigatewaymanagementapi.ErrCodeGoneException {
+			return newErr(
+				codes.NotFound,
+				"connection has closed",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"unable to post to connection",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// New - returns a sender for the websocket API stage with the given endpoint, e.g. https://{api-id}.execute-api.{region}.amazonaws.com/{stage}
+func New(endpoint string) (*ApiGatewaySender, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(apigatewaymanagementapi.New(sess, aws.NewConfig().WithEndpoint(endpoint))), nil
+}
+
+func NewWithClient(client apigatewaymanagementapiiface.ApiGatewayManagementApiAPI) *ApiGatewaySender {
+	return &ApiGatewaySender{
+		client: client,
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apigateway_test
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi/apigatewaymanagementapiiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/connections/apigateway"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+type fakeManagementApi struct {
+	apigatewaymanagementapiiface.ApiGatewayManagementApiAPI
+	posted map[string][]byte
+	err    error
+}
+
+func (f *fakeManagementApi) PostToConnection(in *apigatewaymanagementapi.PostToConnectionInput) (*apigatewaymanagementapi.PostToConnectionOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.posted[aws.StringValue(in.ConnectionId)] = in.Data
+	return &apigatewaymanagementapi.PostToConnectionOutput{}, nil
+}
+
+var _ = Describe("API Gateway Sender", func() {
+	It("should post the message to the connection", func() {
+		client := &fakeManagementApi{posted: map[string][]byte{}}
+
+		err := apigateway.NewWithClient(client).Send("conn-1", []byte("hello"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(client.posted).To(HaveKeyWithValue("conn-1", []byte("hello")))
+	})
+
+	It("should report closed connections as not found", func() {
+		client := &fakeManagementApi{err: awserr.New(apigatewaymanagementapi.ErrCodeGoneException, "gone", nil)}
+
+		err := apigateway.NewWithClient(client).Send("conn-1", []byte("hello"))
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should report other failures as internal errors", func() {
+		client := &fakeManagementApi{err: fmt.Errorf("mock-error")}
+
+		err := apigateway.NewWithClient(client).Send("conn-1", []byte("hello"))
+		Expect(errors.Code(err)).To(Equal(codes.Internal))
+	})
+})
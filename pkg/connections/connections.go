@@ -0,0 +1,412 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// DefaultCollection - the document collection connections are recorded in, unless configured otherwise
+const DefaultCollection = "nitric-connections"
+
+// Connection - a client connected to a websocket gateway, with attributes of the client such as its user
+type Connection struct {
+	ID string
+	// Tenant - the tenant that registered the connection, blank for shared connections
+	Tenant     string
+	Attributes map[string]string
+	Connected  time.Time
+}
+
+// matches - returns true if the connection has every one of the attributes
+func (c *Connection) matches(attributes map[string]string) bool {
+	for k, v := range attributes {
+		if c.Attributes[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sender - delivers messages to connections through the websocket gateway they're connected to.
+// Sending to a connection that has closed returns an error with the codes.NotFound code
+type Sender interface {
+	Send(connectionId string, message []byte) error
+}
+
+// BroadcastResult - the outcome of a broadcast
+type BroadcastResult struct {
+	// The number of connections the message was sent to
+	Sent int
+	// Connections that had closed, which are removed from the registry
+	Removed []string
+	// Connections the message couldn't be sent to
+	Failed []string
+}
+
+// Registry - records the connections of a websocket gateway in the document plugin, so every instance of a service
+// can look up, message and broadcast to them
+type Registry struct {
+	documents  document.DocumentService
+	collection *document.Collection
+	sender     Sender
+	now        func() time.Time
+}
+
+func (r *Registry) key(id string) *document.Key {
+	return &document.Key{
+		Collection: r.collection,
+		Id:         id,
+	}
+}
+
+// lookup - returns a recorded connection, or nil if it isn't recorded
+func (r *Registry) lookup(id string) (*Connection, error) {
+	doc, err := r.documents.Get(r.key(id))
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return fromContent(id, doc.Content), nil
+}
+
+// remove - removes a connection regardless of its tenant, ignoring connections that aren't recorded
+func (r *Registry) remove(id string) error {
+	if err := r.documents.Delete(r.key(id)); err != nil && errors.Code(err) != codes.NotFound {
+		return err
+	}
+
+	return nil
+}
+
+// Store - records a tenant's connection, replacing its attributes if it's already recorded. The tenant is blank for shared connections
+func (r *Registry) Store(tenant string, id string, attributes map[string]string) (*Connection, error) {
+	newErr := errors.ErrorsWithScope(
+		"Connections.Store",
+		map[string]interface{}{
+			"connection": id,
+			"tenant":     tenant,
+		},
+	)
+
+	if id == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide a connection id",
+			nil,
+		)
+	}
+
+	existing, err := r.lookup(id)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to read connection",
+			err,
+		)
+	}
+
+	if existing != nil && existing.Tenant != tenant {
+		return nil, newErr(
+			codes.PermissionDenied,
+			"connection is registered by another tenant",
+			nil,
+		)
+	}
+
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	conn := &Connection{
+		ID:         id,
+		Tenant:     tenant,
+		Attributes: attributes,
+		Connected:  r.now().UTC(),
+	}
+
+	attrs := make(map[string]interface{}, len(attributes))
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+
+	if err := r.documents.Set(r.key(id), map[string]interface{}{
+		"attributes": attrs,
+		"connected":  conn.Connected.Format(time.RFC3339Nano),
+		"tenant":     tenant,
+	}); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to record connection",
+			err,
+		)
+	}
+
+	return conn, nil
+}
+
+// Get - returns a tenant's recorded connection, the connections of other tenants aren't found
+func (r *Registry) Get(tenant string, id string) (*Connection, error) {
+	newErr := errors.ErrorsWithScope(
+		"Connections.Get",
+		map[string]interface{}{
+			"connection": id,
+			"tenant":     tenant,
+		},
+	)
+
+	if id == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide a connection id",
+			nil,
+		)
+	}
+
+	conn, err := r.lookup(id)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to read connection",
+			err,
+		)
+	}
+
+	if conn == nil || conn.Tenant != tenant {
+		return nil, newErr(
+			codes.NotFound,
+			"connection not found, it may have closed",
+			nil,
+		)
+	}
+
+	return conn, nil
+}
+
+// Delete - removes a tenant's connection, e.g. once it has closed
+func (r *Registry) Delete(tenant string, id string) error {
+	newErr := errors.ErrorsWithScope(
+		"Connections.Delete",
+		map[string]interface{}{
+			"connection": id,
+			"tenant":     tenant,
+		},
+	)
+
+	if id == "" {
+		return newErr(
+			codes.InvalidArgument,
+			"provide a connection id",
+			nil,
+		)
+	}
+
+	conn, err := r.lookup(id)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to read connection",
+			err,
+		)
+	}
+
+	// other tenants' connections are treated as already removed
+	if conn == nil || conn.Tenant != tenant {
+		return nil
+	}
+
+	if err := r.remove(id); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to remove connection",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// List - returns a tenant's recorded connections with every one of the attributes, or all of them if there are none.
+// Attributes are matched as the collection is streamed, as nested fields can't be queried by every document plugin
+func (r *Registry) List(tenant string, attributes map[string]string) ([]*Connection, error) {
+	newErr := errors.ErrorsWithScope(
+		"Connections.List",
+		map[string]interface{}{
+			"attributes": attributes,
+			"tenant":     tenant,
+		},
+	)
+
+	conns := make([]*Connection, 0)
+	next := r.documents.QueryStream(r.collection, []document.QueryExpression{}, 0)
+	for {
+		doc, err := next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return nil, newErr(
+				codes.Internal,
+				"unable to list connections",
+				err,
+			)
+		}
+
+		if conn := fromContent(doc.Key.Id, doc.Content); conn.Tenant == tenant && conn.matches(attributes) {
+			conns = append(conns, conn)
+		}
+	}
+
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].ID < conns[j].ID
+	})
+
+	return conns, nil
+}
+
+// Send - sends a message to a tenant's connection, removing it from the registry if it has closed
+func (r *Registry) Send(tenant string, id string, message []byte) error {
+	newErr := errors.ErrorsWithScope(
+		"Connections.Send",
+		map[string]interface{}{
+			"connection": id,
+			"tenant":     tenant,
+		},
+	)
+
+	if r.sender == nil {
+		return newErr(
+			codes.Unimplemented,
+			"no websocket gateway is configured to send messages through",
+			nil,
+		)
+	}
+
+	// the connection must be registered by the tenant, so tenants can't message each other's clients
+	if _, err := r.Get(tenant, id); err != nil {
+		return newErr(
+			errors.Code(err),
+			"unable to send message",
+			err,
+		)
+	}
+
+	if err := r.sender.Send(id, message); err != nil {
+		if errors.Code(err) == codes.NotFound {
+			_ = r.remove(id)
+		}
+
+		return newErr(
+			errors.Code(err),
+			"unable to send message",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Broadcast - sends a message to every one of a tenant's connections with all the attributes, removing connections that have closed
+func (r *Registry) Broadcast(tenant string, attributes map[string]string, message []byte) (*BroadcastResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"Connections.Broadcast",
+		map[string]interface{}{
+			"attributes": attributes,
+			"tenant":     tenant,
+		},
+	)
+
+	if r.sender == nil {
+		return nil, newErr(
+			codes.Unimplemented,
+			"no websocket gateway is configured to send messages through",
+			nil,
+		)
+	}
+
+	conns, err := r.List(tenant, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BroadcastResult{
+		Removed: []string{},
+		Failed:  []string{},
+	}
+	for _, conn := range conns {
+		err := r.sender.Send(conn.ID, message)
+		switch {
+		case err == nil:
+			result.Sent++
+		case errors.Code(err) == codes.NotFound:
+			_ = r.remove(conn.ID)
+			result.Removed = append(result.Removed, conn.ID)
+		default:
+			result.Failed = append(result.Failed, conn.ID)
+		}
+	}
+
+	return result, nil
+}
+
+func fromContent(id string, content map[string]interface{}) *Connection {
+	conn := &Connection{ID: id, Attributes: map[string]string{}}
+
+	if attrs, ok := content["attributes"].(map[string]interface{}); ok {
+		for k, v := range attrs {
+			conn.Attributes[k] = fmt.Sprint(v)
+		}
+	}
+
+	conn.Tenant, _ = content["tenant"].(string)
+
+	if connected, ok := content["connected"].(string); ok {
+		conn.Connected, _ = time.Parse(time.RFC3339Nano, connected)
+	}
+
+	return conn
+}
+
+// New - Creates a connection registry, recording connections in the given document collection.
+// Messages are sent with the sender, without one connections can only be recorded
+func New(documents document.DocumentService, sender Sender, collection string) (*Registry, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required to record connections")
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &Registry{
+		documents:  documents,
+		collection: &document.Collection{Name: collection},
+		sender:     sender,
+		now:        time.Now,
+	}, nil
+}
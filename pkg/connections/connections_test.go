@@ -0,0 +1,195 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// memorySender - records sent messages, failing sends to closed or broken connections
+type memorySender struct {
+	sent   map[string][]byte
+	closed map[string]bool
+	broken map[string]bool
+}
+
+func (m *memorySender) Send(connectionId string, message []byte) error {
+	if m.closed[connectionId] {
+		return errors.ErrorsWithScope("memorySender.Send", nil)(codes.NotFound, "connection has closed", nil)
+	}
+	if m.broken[connectionId] {
+		return fmt.Errorf("mock-error")
+	}
+
+	m.sent[connectionId] = message
+	return nil
+}
+
+var _ = Describe("Connections", func() {
+	var dir string
+	var sender *memorySender
+	var registry *Registry
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "connections")
+		Expect(err).ShouldNot(HaveOccurred())
+		os.Setenv("LOCAL_DB_DIR", dir)
+
+		docs, err := boltdb_service.New()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sender = &memorySender{sent: map[string][]byte{}, closed: map[string]bool{}, broken: map[string]bool{}}
+		registry, err = New(docs, sender, "")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("LOCAL_DB_DIR")
+		os.RemoveAll(dir)
+	})
+
+	It("Should look up stored connections with their attributes", func() {
+		_, err := registry.Store("", "conn-1", map[string]string{"user": "alice"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		conn, err := registry.Get("", "conn-1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(conn.Attributes).To(Equal(map[string]string{"user": "alice"}))
+		Expect(conn.Connected.IsZero()).To(BeFalse())
+	})
+
+	It("Should no longer find deleted connections", func() {
+		_, err := registry.Store("", "conn-1", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(registry.Delete("", "conn-1")).To(Succeed())
+
+		_, err = registry.Get("", "conn-1")
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("Should list the connections with all the attributes", func() {
+		_, _ = registry.Store("", "conn-1", map[string]string{"user": "alice", "room": "a"})
+		_, _ = registry.Store("", "conn-2", map[string]string{"user": "bob", "room": "a"})
+		_, _ = registry.Store("", "conn-3", map[string]string{"user": "alice", "room": "b"})
+
+		conns, err := registry.List("", map[string]string{"room": "a"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(conns).To(HaveLen(2))
+		Expect(conns[0].ID).To(Equal("conn-1"))
+		Expect(conns[1].ID).To(Equal("conn-2"))
+
+		conns, err = registry.List("", map[string]string{"room": "a", "user": "alice"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(conns).To(HaveLen(1))
+
+		conns, err = registry.List("", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(conns).To(HaveLen(3))
+	})
+
+	It("Should broadcast to matching connections, removing closed ones", func() {
+		_, _ = registry.Store("", "conn-1", map[string]string{"room": "a"})
+		_, _ = registry.Store("", "conn-2", map[string]string{"room": "a"})
+		_, _ = registry.Store("", "conn-3", map[string]string{"room": "a"})
+		_, _ = registry.Store("", "conn-4", map[string]string{"room": "b"})
+		sender.closed["conn-2"] = true
+		sender.broken["conn-3"] = true
+
+		result, err := registry.Broadcast("", map[string]string{"room": "a"}, []byte("hello"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Sent).To(Equal(1))
+		Expect(result.Removed).To(Equal([]string{"conn-2"}))
+		Expect(result.Failed).To(Equal([]string{"conn-3"}))
+		Expect(sender.sent).To(Equal(map[string][]byte{"conn-1": []byte("hello")}))
+
+		_, err = registry.Get("", "conn-2")
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("Should report sends to closed connections as not found", func() {
+		_, _ = registry.Store("", "conn-1", nil)
+		sender.closed["conn-1"] = true
+
+		err := registry.Send("", "conn-1", []byte("hello"))
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = registry.Get("", "conn-1")
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	When("connections are registered by tenants", func() {
+		It("Should only look up, list and message the tenant's connections", func() {
+			_, err := registry.Store("acme", "conn-1", map[string]string{"room": "a"})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = registry.Store("globex", "conn-2", map[string]string{"room": "a"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conn, err := registry.Get("acme", "conn-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(conn.Tenant).To(Equal("acme"))
+
+			_, err = registry.Get("globex", "conn-1")
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+
+			conns, err := registry.List("acme", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(conns).To(HaveLen(1))
+			Expect(conns[0].ID).To(Equal("conn-1"))
+
+			err = registry.Send("acme", "conn-2", []byte("hello"))
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			Expect(sender.sent).To(BeEmpty())
+
+			result, err := registry.Broadcast("globex", map[string]string{"room": "a"}, []byte("hello"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Sent).To(Equal(1))
+			Expect(sender.sent).To(HaveKey("conn-2"))
+		})
+
+		It("Should not let tenants replace or remove each other's connections", func() {
+			_, _ = registry.Store("acme", "conn-1", map[string]string{"user": "alice"})
+
+			_, err := registry.Store("globex", "conn-1", map[string]string{"user": "mallory"})
+			Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+
+			Expect(registry.Delete("globex", "conn-1")).To(Succeed())
+
+			conn, err := registry.Get("acme", "conn-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(conn.Attributes).To(Equal(map[string]string{"user": "alice"}))
+		})
+	})
+
+	When("there's no websocket gateway to send through", func() {
+		It("Should report sends as unimplemented", func() {
+			registry.sender = nil
+
+			err := registry.Send("", "conn-1", []byte("hello"))
+			Expect(errors.Code(err)).To(Equal(codes.Unimplemented))
+
+			_, err = registry.Broadcast("", nil, []byte("hello"))
+			Expect(errors.Code(err)).To(Equal(codes.Unimplemented))
+		})
+	})
+})
@@ -0,0 +1,138 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedupe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// DefaultCollection - the document collection used to record processed messages, unless configured otherwise
+const DefaultCollection = "nitric-dedupe"
+
+const (
+	// TtlAttribute - the record's expiry in epoch seconds, for the collection's DynamoDB TTL attribute
+	TtlAttribute = "ttl"
+	// ExpireAtField - the record's expiry as a timestamp, for the collection's Firestore TTL policy or MongoDB TTL index
+	ExpireAtField = "expireAt"
+)
+
+// Deduplicator - Records which messages have been processed, so redelivered messages can be skipped.
+//
+// Messages are identified by their scope (e.g. a topic or queue name) and an ID or idempotency key.
+// Deduplication is best-effort, a message redelivered while its first delivery is still processing may be processed twice.
+type Deduplicator interface {
+	// Seen - returns true if the message has been marked as processed and the record hasn't expired
+	Seen(scope string, id string) (bool, error)
+	// Mark - records the message as processed
+	Mark(scope string, id string) error
+}
+
+// DocumentDeduplicator - A Deduplicator that records processed messages using the document plugin
+type DocumentDeduplicator struct {
+	documents  document.DocumentService
+	collection *document.Collection
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+var _ Deduplicator = &DocumentDeduplicator{}
+
+// Record IDs are hashed, message IDs may contain characters some document providers don't allow in keys
+func (d *DocumentDeduplicator) key(scope string, id string) *document.Key {
+	hash := sha256.Sum256([]byte(scope + "/" + id))
+
+	return &document.Key{
+		Collection: d.collection,
+		Id:         hex.EncodeToString(hash[:]),
+	}
+}
+
+func (d *DocumentDeduplicator) Seen(scope string, id string) (bool, error) {
+	key := d.key(scope, id)
+	doc, err := d.documents.Get(key)
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	expiry, ok := doc.Content["expiry"].(string)
+	if !ok {
+		return false, fmt.Errorf("dedupe record for %s/%s is missing its expiry", scope, id)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return false, err
+	}
+
+	if d.now().Before(expiresAt) {
+		return true, nil
+	}
+
+	// Providers may not have removed the record yet, or have no TTL configured for the collection,
+	// so expired records are deleted here rather than left to accumulate
+	if err := d.documents.Delete(key); err != nil && errors.Code(err) != codes.NotFound {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// Mark - records the message as processed, replacing any existing record.
+// The expiry is also stored in the fields provider TTLs use, so records are removed even if the message isn't seen again
+func (d *DocumentDeduplicator) Mark(scope string, id string) error {
+	expiresAt := d.now().Add(d.ttl).UTC()
+
+	return d.documents.Set(d.key(scope, id), map[string]interface{}{
+		"scope":       scope,
+		"id":          id,
+		"expiry":      expiresAt.Format(time.RFC3339),
+		TtlAttribute:  expiresAt.Unix(),
+		ExpireAtField: expiresAt,
+	})
+}
+
+// NewDocumentDeduplicator - Creates a new Deduplicator that stores its records in the given document collection.
+// Records are considered expired after the ttl, allowing messages with reused IDs to be processed again.
+func NewDocumentDeduplicator(documents document.DocumentService, collection string, ttl time.Duration) (*DocumentDeduplicator, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required for deduplication")
+	}
+
+	if ttl <= 0 {
+		return nil, fmt.Errorf("dedupe ttl must be positive, got %s", ttl)
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &DocumentDeduplicator{
+		documents:  documents,
+		collection: &document.Collection{Name: collection},
+		ttl:        ttl,
+		now:        time.Now,
+	}, nil
+}
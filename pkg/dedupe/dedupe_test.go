@@ -0,0 +1,192 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedupe_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/dedupe"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("DocumentDeduplicator", func() {
+	Context("New", func() {
+		When("no document plugin is available", func() {
+			It("should return an error", func() {
+				_, err := dedupe.NewDocumentDeduplicator(nil, "", time.Hour)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the ttl is not positive", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+
+			It("should return an error", func() {
+				_, err := dedupe.NewDocumentDeduplicator(mockDocs, "", 0)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Seen", func() {
+		When("the message has no record", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			d, _ := dedupe.NewDocumentDeduplicator(mockDocs, "", time.Hour)
+
+			It("should return false", func() {
+				mockDocs.EXPECT().Get(gomock.Any()).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "not found", nil))
+
+				seen, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeFalse())
+			})
+		})
+
+		When("the message has an unexpired record", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			d, _ := dedupe.NewDocumentDeduplicator(mockDocs, "", time.Hour)
+
+			It("should return true", func() {
+				mockDocs.EXPECT().Get(gomock.Any()).Return(&document.Document{
+					Content: map[string]interface{}{
+						"expiry": time.Now().Add(time.Minute).UTC().Format(time.RFC3339),
+					},
+				}, nil)
+
+				seen, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeTrue())
+			})
+		})
+
+		When("the message has an expired record", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			d, _ := dedupe.NewDocumentDeduplicator(mockDocs, "", time.Hour)
+
+			It("should return false and delete the record", func() {
+				var expiredKey *document.Key
+				mockDocs.EXPECT().Get(gomock.Any()).DoAndReturn(func(key *document.Key) (*document.Document, error) {
+					expiredKey = key
+					return &document.Document{
+						Content: map[string]interface{}{
+							"expiry": time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+						},
+					}, nil
+				})
+				mockDocs.EXPECT().Delete(gomock.Any()).DoAndReturn(func(key *document.Key) error {
+					Expect(key).To(Equal(expiredKey))
+					return nil
+				})
+
+				seen, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeFalse())
+			})
+
+			It("should return false if the provider already removed the record", func() {
+				mockDocs.EXPECT().Get(gomock.Any()).Return(&document.Document{
+					Content: map[string]interface{}{
+						"expiry": time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+					},
+				}, nil)
+				mockDocs.EXPECT().Delete(gomock.Any()).Return(errors.ErrorsWithScope("test", nil)(codes.NotFound, "not found", nil))
+
+				seen, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeFalse())
+			})
+		})
+
+		When("an expired message is processed again", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			d, _ := dedupe.NewDocumentDeduplicator(mockDocs, "", time.Hour)
+
+			It("should replace the expired record with a new one", func() {
+				records := make(map[string]map[string]interface{})
+				mockDocs.EXPECT().Get(gomock.Any()).DoAndReturn(func(key *document.Key) (*document.Document, error) {
+					if content, ok := records[key.Id]; ok {
+						return &document.Document{Key: key, Content: content}, nil
+					}
+					return nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "not found", nil)
+				}).AnyTimes()
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(key *document.Key, content map[string]interface{}) error {
+					records[key.Id] = content
+					return nil
+				}).AnyTimes()
+				mockDocs.EXPECT().Delete(gomock.Any()).DoAndReturn(func(key *document.Key) error {
+					delete(records, key.Id)
+					return nil
+				}).AnyTimes()
+
+				Expect(d.Mark("topic", "1234")).To(Succeed())
+				for _, content := range records {
+					content["expiry"] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+				}
+
+				By("deleting the expired record when the message is seen again")
+				seen, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeFalse())
+				Expect(records).To(BeEmpty())
+
+				By("recording the message again")
+				Expect(d.Mark("topic", "1234")).To(Succeed())
+				seen, err = d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).To(BeTrue())
+			})
+		})
+	})
+
+	Context("Mark", func() {
+		When("marking a message as processed", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			d, _ := dedupe.NewDocumentDeduplicator(mockDocs, "processed", time.Hour)
+
+			It("should store a record in the configured collection", func() {
+				var markedKey *document.Key
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(key *document.Key, content map[string]interface{}) error {
+					markedKey = key
+					Expect(content["id"]).To(Equal("1234"))
+					Expect(content["expiry"]).ToNot(BeEmpty())
+					Expect(content[dedupe.TtlAttribute]).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 5))
+					Expect(content[dedupe.ExpireAtField]).To(BeTemporally("~", time.Now().Add(time.Hour), 5*time.Second))
+					return nil
+				})
+
+				Expect(d.Mark("topic", "1234")).To(Succeed())
+				Expect(markedKey.Collection.Name).To(Equal("processed"))
+
+				By("looking up the same record")
+				mockDocs.EXPECT().Get(markedKey).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "not found", nil))
+				_, err := d.Seen("topic", "1234")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,426 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package egress makes outbound http requests on behalf of the child process,
+// so retries, circuit breaking and trace propagation are handled once by the membrane rather than by each runtime SDK
+package egress
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// TraceHeaders - the trace context headers copied from the metadata of runtime API calls to the requests they make,
+// unless the request sets them itself
+var TraceHeaders = []string{
+	"traceparent",
+	"tracestate",
+	"baggage",
+	"x-cloud-trace-context",
+	"x-amzn-trace-id",
+	"b3",
+	"x-b3-traceid",
+	"x-b3-spanid",
+	"x-b3-parentspanid",
+	"x-b3-sampled",
+}
+
+// idempotentMethods - requests that are safe to send more than once
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// retryableStatus - responses that may succeed if the request is sent again
+var retryableStatus = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// Config - how outbound requests are retried, and when requests to a failing host are stopped
+type Config struct {
+	// MaxAttempts - the most times a request is sent, including the first
+	MaxAttempts int
+	// MinBackoff - the delay before the first retry, doubled after each retry
+	MinBackoff time.Duration
+	// MaxBackoff - the longest delay between retries, including delays requested by Retry-After headers
+	MaxBackoff time.Duration
+	// Timeout - how long each attempt may take, unless the request sets its own
+	Timeout time.Duration
+	// FailureThreshold - the consecutive failures of requests to a host that open its circuit, never opened if zero
+	FailureThreshold int
+	// OpenDuration - how long a host's circuit stays open before a request is sent to test it
+	OpenDuration time.Duration
+	// MaxResponseSize - the largest response body in bytes, unlimited if zero
+	MaxResponseSize int
+	// AllowedHosts - the hosts requests may be sent to, any host if empty
+	AllowedHosts []string
+}
+
+// DefaultConfig - retries twice and opens a host's circuit after 5 consecutive failures
+var DefaultConfig = Config{
+	MaxAttempts:      3,
+	MinBackoff:       100 * time.Millisecond,
+	MaxBackoff:       2 * time.Second,
+	Timeout:          30 * time.Second,
+	FailureThreshold: 5,
+	OpenDuration:     30 * time.Second,
+	// The default size limit of gRPC messages is 4MB
+	MaxResponseSize: 4*1024*1024 - 1024,
+}
+
+// Request - an outbound http request
+type Request struct {
+	Method string
+	Url    string
+	Header map[string][]string
+	Body   []byte
+	// How long each attempt may take, the client's default if zero
+	Timeout time.Duration
+	// Disables retries
+	NoRetry bool
+	// Returns redirect responses instead of following them
+	NoRedirects bool
+}
+
+// Response - the response to an outbound http request
+type Response struct {
+	Status int
+	Header map[string][]string
+	Body   []byte
+	// The number of attempts made, including the one that returned this response
+	Attempts int
+}
+
+// circuit - the state of requests to a host. The circuit opens after too many consecutive failures,
+// refusing requests until OpenDuration has passed, then lets one request through to test the host
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	testing   bool
+}
+
+// Client - sends outbound requests over pooled connections
+type Client struct {
+	config      Config
+	client      *http.Client
+	noRedirects *http.Client
+	now         func() time.Time
+	after       func(time.Duration) <-chan time.Time
+
+	lock     sync.Mutex
+	circuits map[string]*circuit
+}
+
+// allow - returns false if the host's circuit is open
+func (c *Client) allow(host string) bool {
+	if c.config.FailureThreshold <= 0 {
+		return true
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cb, ok := c.circuits[host]
+	if !ok || cb.failures < c.config.FailureThreshold {
+		return true
+	}
+
+	// Only one request tests the host once the circuit has been open long enough
+	if cb.testing || c.now().Before(cb.openUntil) {
+		return false
+	}
+	cb.testing = true
+
+	return true
+}
+
+// record - records the outcome of a request to a host, opening its circuit after too many consecutive failures
+func (c *Client) record(host string, failed bool) {
+	if c.config.FailureThreshold <= 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cb, ok := c.circuits[host]
+	if !ok {
+		cb = &circuit{}
+		c.circuits[host] = cb
+	}
+	cb.testing = false
+
+	if !failed {
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= c.config.FailureThreshold {
+		cb.openUntil = c.now().Add(c.config.OpenDuration)
+	}
+}
+
+func (c *Client) allowedHost(host string) bool {
+	if len(c.config.AllowedHosts) == 0 {
+		return true
+	}
+
+	for _, h := range c.config.AllowedHosts {
+		if strings.EqualFold(h, host) || (strings.HasPrefix(h, "*.") && strings.HasSuffix(strings.ToLower(host), strings.ToLower(h[1:]))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff - returns the delay before the given retry, or the delay requested by the response if it's shorter than MaxBackoff
+func (c *Client) backoff(retry int, resp *Response) time.Duration {
+	if resp != nil {
+		if values := headerValues(resp.Header, "Retry-After"); len(values) > 0 {
+			if seconds, err := strconv.Atoi(values[0]); err == nil && seconds >= 0 {
+				if d := time.Duration(seconds) * time.Second; d <= c.config.MaxBackoff {
+					return d
+				}
+			}
+		}
+	}
+
+	delay := c.config.MinBackoff
+	for i := 1; i < retry && delay < c.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > c.config.MaxBackoff {
+		delay = c.config.MaxBackoff
+	}
+
+	return delay
+}
+
+func headerValues(header map[string][]string, name string) []string {
+	for k, v := range header {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// send - makes a single attempt at the request
+func (c *Client) send(ctx context.Context, req *Request, header http.Header) (*Response, error) {
+	timeout := c.config.Timeout
+	if req.Timeout > 0 {
+		timeout = req.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.Url, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header = header.Clone()
+
+	client := c.client
+	if req.NoRedirects {
+		client = c.noRedirects
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if uerr, ok := err.(*url.Error); ok && uerr.Err == errRedirectDenied {
+		return nil, errRedirectDenied
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var reader io.Reader = httpResp.Body
+	if c.config.MaxResponseSize > 0 {
+		reader = io.LimitReader(httpResp.Body, int64(c.config.MaxResponseSize)+1)
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.MaxResponseSize > 0 && len(body) > c.config.MaxResponseSize {
+		return nil, errTooLarge
+	}
+
+	return &Response{
+		Status: httpResp.StatusCode,
+		Header: httpResp.Header,
+		Body:   body,
+	}, nil
+}
+
+var (
+	errTooLarge       = fmt.Errorf("response body too large")
+	errRedirectDenied = fmt.Errorf("redirect to a host that isn't allowed")
+)
+
+// maxRedirects - the most redirects followed by a request, as the default http client
+const maxRedirects = 10
+
+// checkRedirect - follows redirects only to allowed hosts, so an allowed host can't send requests anywhere else
+func (c *Client) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	if !c.allowedHost(req.URL.Hostname()) {
+		return errRedirectDenied
+	}
+
+	return nil
+}
+
+// Do - sends a request, retrying idempotent requests after network errors and responses that may succeed if they're retried.
+// Trace headers in the metadata of the runtime API call are added to the request
+func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
+	newErr := errors.ErrorsWithScope("Egress.Do", map[string]interface{}{
+		"method": req.Method,
+		"url":    req.Url,
+	})
+
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, newErr(codes.InvalidArgument, "expected an absolute http or https url", err)
+	}
+
+	if !c.allowedHost(u.Hostname()) {
+		return nil, newErr(codes.PermissionDenied, fmt.Sprintf("requests to %s aren't allowed", u.Hostname()), nil)
+	}
+
+	header := http.Header{}
+	for k, v := range req.Header {
+		header[http.CanonicalHeaderKey(k)] = v
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, name := range TraceHeaders {
+			if values := md.Get(name); len(values) > 0 && header.Get(name) == "" {
+				header.Set(name, values[0])
+			}
+		}
+	}
+
+	maxAttempts := c.config.MaxAttempts
+	retryable := idempotentMethods[req.Method] || header.Get("Idempotency-Key") != ""
+	if req.NoRetry || !retryable || maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	host := u.Host
+	var resp *Response
+	for attempt := 1; ; attempt++ {
+		if !c.allow(host) {
+			return nil, newErr(codes.Unavailable, fmt.Sprintf("requests to %s are paused after repeated failures", host), nil)
+		}
+
+		resp, err = c.send(ctx, req, header)
+		if err == errTooLarge {
+			c.record(host, false)
+			return nil, newErr(codes.ResourceExhausted, fmt.Sprintf("response exceeds the %d byte limit", c.config.MaxResponseSize), nil)
+		}
+
+		if err == errRedirectDenied {
+			c.record(host, false)
+			return nil, newErr(codes.PermissionDenied, "request was redirected to a host that isn't allowed", nil)
+		}
+
+		failed := err != nil || resp.Status >= 500 || resp.Status == http.StatusTooManyRequests
+		c.record(host, failed)
+
+		if err != nil && ctx.Err() != nil {
+			return nil, newErr(codes.DeadlineExceeded, "request cancelled", err)
+		}
+
+		if attempt >= maxAttempts || !(err != nil || retryableStatus[resp.Status]) {
+			if err != nil {
+				if ne, ok := err.(net.Error); ok && ne.Timeout() {
+					return nil, newErr(codes.DeadlineExceeded, fmt.Sprintf("request timed out after %d attempts", attempt), err)
+				}
+
+				return nil, newErr(codes.Unavailable, fmt.Sprintf("request failed after %d attempts", attempt), err)
+			}
+
+			resp.Attempts = attempt
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, newErr(codes.DeadlineExceeded, "request cancelled", ctx.Err())
+		case <-c.after(c.backoff(attempt, resp)):
+		}
+	}
+}
+
+// New - returns a client for outbound requests, with connections pooled per host
+func New(config Config) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = 256
+	transport.MaxIdleConnsPerHost = 32
+
+	c := &Client{
+		config: config,
+		client: &http.Client{
+			Transport: transport,
+		},
+		noRedirects: &http.Client{
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now:      time.Now,
+		after:    time.After,
+		circuits: map[string]*circuit{},
+	}
+	c.client.CheckRedirect = c.checkRedirect
+
+	return c
+}
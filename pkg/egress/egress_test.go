@@ -0,0 +1,253 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// newTestClient - returns a client that doesn't wait between retries
+func newTestClient(config Config) *Client {
+	c := New(config)
+	c.after = func(time.Duration) <-chan time.Time {
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+
+	return c
+}
+
+var _ = Describe("Client", func() {
+	var requests int
+	var statuses []int
+	var srv *httptest.Server
+	var lastHeader http.Header
+
+	BeforeEach(func() {
+		requests = 0
+		statuses = nil
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lastHeader = r.Header
+			status := http.StatusOK
+			if requests < len(statuses) {
+				status = statuses[requests]
+			}
+			requests++
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	Context("Retries", func() {
+		It("should retry idempotent requests that may succeed", func() {
+			statuses = []int{503, 502}
+
+			resp, err := newTestClient(DefaultConfig).Do(context.Background(), &Request{Url: srv.URL})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(200))
+			Expect(resp.Attempts).To(Equal(3))
+		})
+
+		It("should return the last response once attempts are exhausted", func() {
+			statuses = []int{503, 503, 503, 503}
+
+			resp, err := newTestClient(DefaultConfig).Do(context.Background(), &Request{Url: srv.URL})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(503))
+			Expect(requests).To(Equal(3))
+		})
+
+		It("should not retry requests that aren't idempotent", func() {
+			statuses = []int{503}
+
+			resp, err := newTestClient(DefaultConfig).Do(context.Background(), &Request{Method: "POST", Url: srv.URL})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(503))
+			Expect(requests).To(Equal(1))
+		})
+
+		It("should retry requests with an idempotency key", func() {
+			statuses = []int{503}
+
+			resp, err := newTestClient(DefaultConfig).Do(context.Background(), &Request{
+				Method: "POST",
+				Url:    srv.URL,
+				Header: map[string][]string{"idempotency-key": {"abc"}},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(200))
+		})
+
+		It("should not retry client errors", func() {
+			statuses = []int{404}
+
+			resp, _ := newTestClient(DefaultConfig).Do(context.Background(), &Request{Url: srv.URL})
+
+			Expect(resp.Status).To(Equal(404))
+			Expect(requests).To(Equal(1))
+		})
+	})
+
+	Context("Circuit breaking", func() {
+		It("should stop sending requests to a failing host until it has been open long enough", func() {
+			statuses = []int{500, 500, 500}
+			config := DefaultConfig
+			config.FailureThreshold = 2
+			config.MaxAttempts = 1
+
+			now := time.Now()
+			c := newTestClient(config)
+			c.now = func() time.Time { return now }
+
+			_, _ = c.Do(context.Background(), &Request{Url: srv.URL})
+			_, _ = c.Do(context.Background(), &Request{Url: srv.URL})
+
+			_, err := c.Do(context.Background(), &Request{Url: srv.URL})
+			Expect(errors.Code(err)).To(Equal(codes.Unavailable))
+			Expect(requests).To(Equal(2))
+
+			By("Testing the host once the circuit has been open long enough")
+			now = now.Add(config.OpenDuration)
+			resp, err := c.Do(context.Background(), &Request{Url: srv.URL})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(500))
+
+			_, err = c.Do(context.Background(), &Request{Url: srv.URL})
+			Expect(errors.Code(err)).To(Equal(codes.Unavailable))
+
+			By("Closing the circuit once a test succeeds")
+			now = now.Add(config.OpenDuration)
+			resp, err = c.Do(context.Background(), &Request{Url: srv.URL})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(200))
+
+			_, err = c.Do(context.Background(), &Request{Url: srv.URL})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("Trace propagation", func() {
+		It("should add trace headers from the call's metadata", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", "00-abc-def-01", "authorization", "secret"))
+
+			_, err := newTestClient(DefaultConfig).Do(ctx, &Request{Url: srv.URL})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(lastHeader.Get("traceparent")).To(Equal("00-abc-def-01"))
+			Expect(lastHeader.Get("authorization")).To(BeEmpty())
+		})
+
+		It("should keep trace headers set by the request", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", "00-abc-def-01"))
+
+			_, _ = newTestClient(DefaultConfig).Do(ctx, &Request{Url: srv.URL, Header: map[string][]string{"traceparent": {"00-own-def-01"}}})
+
+			Expect(lastHeader.Get("traceparent")).To(Equal("00-own-def-01"))
+		})
+	})
+
+	Context("Validation", func() {
+		It("should reject relative urls", func() {
+			_, err := New(DefaultConfig).Do(context.Background(), &Request{Url: "/orders"})
+
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should reject hosts that aren't allowed", func() {
+			config := DefaultConfig
+			config.AllowedHosts = []string{"*.example.com"}
+
+			_, err := New(config).Do(context.Background(), &Request{Url: srv.URL})
+			Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+
+			Expect(New(config).allowedHost("api.example.com")).To(BeTrue())
+		})
+
+		It("should reject responses over the size limit", func() {
+			config := DefaultConfig
+			config.MaxResponseSize = 4
+
+			big := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("too large"))
+			}))
+			defer big.Close()
+
+			_, err := New(config).Do(context.Background(), &Request{Url: big.URL})
+			Expect(errors.Code(err)).To(Equal(codes.ResourceExhausted))
+		})
+
+		It("should read whole responses without a size limit", func() {
+			config := DefaultConfig
+			config.MaxResponseSize = 0
+
+			big := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("any size"))
+			}))
+			defer big.Close()
+
+			resp, err := New(config).Do(context.Background(), &Request{Url: big.URL})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(resp.Body)).To(Equal("any size"))
+		})
+
+		It("should refuse redirects to hosts that aren't allowed", func() {
+			config := DefaultConfig
+			config.AllowedHosts = []string{"127.0.0.1"}
+
+			redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, strings.Replace(srv.URL, "127.0.0.1", "localhost", 1), http.StatusFound)
+			}))
+			defer redirect.Close()
+
+			_, err := New(config).Do(context.Background(), &Request{Url: redirect.URL})
+			Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(requests).To(Equal(0))
+		})
+
+		It("should follow redirects to allowed hosts", func() {
+			config := DefaultConfig
+			config.AllowedHosts = []string{"127.0.0.1"}
+
+			redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, srv.URL, http.StatusFound)
+			}))
+			defer redirect.Close()
+
+			resp, err := New(config).Do(context.Background(), &Request{Url: redirect.URL})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(http.StatusOK))
+			Expect(requests).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,450 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// prefix - marks field values encrypted by this package, followed by {key id}:{base64 nonce and ciphertext}
+const prefix = "nitric:enc:v1:"
+
+// Key - a 256-bit AES key and the id it's looked up by to decrypt the values it encrypted
+type Key struct {
+	// Id - stored with each encrypted value, so it must not contain ':'
+	Id       string
+	Material []byte
+}
+
+// Keyring - provides the key new values are encrypted with, and the keys previous values were encrypted with
+type Keyring interface {
+	// Current - returns the key to encrypt with
+	Current() (*Key, error)
+	// Get - returns the key with the given id
+	Get(id string) (*Key, error)
+}
+
+// ParseFields - parses semicolon separated collections, each followed by : and its comma separated encrypted fields,
+// e.g. "users:email,address.street;payments:card". Nested fields are separated by dots, and sub-collections are given
+// as paths from their top level collection, e.g. "orders/items:sku".
+func ParseFields(value string) (map[string][]string, error) {
+	fields := make(map[string][]string)
+
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid encrypted fields %s, expected <collection>:<field>[,<field>]", entry)
+		}
+
+		collection := strings.TrimSpace(parts[0])
+		for _, field := range strings.Split(parts[1], ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields[collection] = append(fields[collection], field)
+			}
+		}
+
+		if len(fields[collection]) == 0 {
+			return nil, fmt.Errorf("collection %s has no encrypted fields", collection)
+		}
+	}
+
+	return fields, nil
+}
+
+// DocumentService - Encrypts declared fields of documents before they're written, and decrypts them when they're read.
+//
+// Fields are declared by the logical path of their collection, so they're encrypted in every parent document's sub-collection
+// and every tenant's namespaced collection. Each field is encrypted with AES-GCM, bound to its document key and field so it
+// can't be moved to another document, and stored as a string holding the id of its key, so keys can be rotated without
+// re-encrypting existing documents.
+// Encrypted fields can't be filtered, ordered or aggregated on, since the datastore only sees their ciphertext.
+type DocumentService struct {
+	document.DocumentService
+	keyring Keyring
+	fields  map[string][]string
+}
+
+var _ document.DocumentService = (*DocumentService)(nil)
+
+// Encrypt - encrypts a value with the current key, binding it to the given context so it can't be decrypted elsewhere
+func Encrypt(keyring Keyring, value interface{}, context string) (string, error) {
+	key, err := keyring.Current()
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, plaintext, []byte(context))
+
+	return prefix + key.Id + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt - decrypts a value encrypted with Encrypt for the same context
+func Decrypt(keyring Keyring, value string, context string) (interface{}, error) {
+	parts := strings.SplitN(strings.TrimPrefix(value, prefix), ":", 2)
+	if !strings.HasPrefix(value, prefix) || len(parts) != 2 {
+		return nil, fmt.Errorf("value isn't encrypted")
+	}
+
+	key, err := keyring.Get(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted value is truncated")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(context))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(plaintext))
+	decoder.UseNumber()
+
+	var decrypted interface{}
+	if err := decoder.Decode(&decrypted); err != nil {
+		return nil, err
+	}
+
+	return restoreNumbers(decrypted), nil
+}
+
+// restoreNumbers - decodes numbers as integers when they're whole and floats otherwise
+func restoreNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = restoreNumbers(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = restoreNumbers(child)
+		}
+	}
+
+	return value
+}
+
+func newGCM(key *Key) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key.Material)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// parent - returns the map holding the last segment of a dotted field path, creating none, and the segment
+func parent(content map[string]interface{}, field string) (map[string]interface{}, string) {
+	segments := strings.Split(field, ".")
+
+	current := content
+	for _, s := range segments[:len(segments)-1] {
+		next, ok := current[s].(map[string]interface{})
+		if !ok {
+			return nil, ""
+		}
+		current = next
+	}
+
+	return current, segments[len(segments)-1]
+}
+
+// copyPath - copies the maps along a field path, so encrypting doesn't modify the caller's content
+func copyPath(content map[string]interface{}, field string) map[string]interface{} {
+	copied := make(map[string]interface{}, len(content))
+	for k, v := range content {
+		copied[k] = v
+	}
+
+	segments := strings.SplitN(field, ".", 2)
+	if len(segments) == 2 {
+		if child, ok := copied[segments[0]].(map[string]interface{}); ok {
+			copied[segments[0]] = copyPath(child, segments[1])
+		}
+	}
+
+	return copied
+}
+
+// collectionPath - the names of the collections from the top level collection down to the given collection, e.g. orders/items
+func collectionPath(collection *document.Collection) string {
+	if collection.Parent == nil || collection.Parent.Collection == nil {
+		return collection.Name
+	}
+
+	return collectionPath(collection.Parent.Collection) + "/" + collection.Name
+}
+
+// keyPath - the path of a document from its top level collection, e.g. orders/1/items/2
+func keyPath(key *document.Key) string {
+	if key.Collection.Parent == nil || key.Collection.Parent.Collection == nil {
+		return key.Collection.Name + "/" + key.Id
+	}
+
+	return keyPath(key.Collection.Parent) + "/" + key.Collection.Name + "/" + key.Id
+}
+
+// fieldsOf - returns the encrypted fields of a collection, declared by its path without any tenant namespace
+func (d *DocumentService) fieldsOf(collection *document.Collection) []string {
+	if collection == nil {
+		return nil
+	}
+
+	path := collectionPath(collection)
+	if fields, ok := d.fields[path]; ok {
+		return fields
+	}
+
+	// tenants' collections are namespaced by the name of their top level collection
+	if tenant, logical := tenancy.SplitName(path); tenant != "" {
+		return d.fields[logical]
+	}
+
+	return nil
+}
+
+// fieldContext - binds an encrypted value to the document and field it was written to
+func fieldContext(key *document.Key, field string) string {
+	return keyPath(key) + "#" + field
+}
+
+func (d *DocumentService) encrypt(key *document.Key, content map[string]interface{}) (map[string]interface{}, error) {
+	for _, field := range d.fieldsOf(key.Collection) {
+		content = copyPath(content, field)
+
+		holder, name := parent(content, field)
+		if holder == nil {
+			continue
+		}
+
+		value, ok := holder[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		encrypted, err := Encrypt(d.keyring, value, fieldContext(key, field))
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt field %s: %v", field, err)
+		}
+		holder[name] = encrypted
+	}
+
+	return content, nil
+}
+
+func (d *DocumentService) decrypt(key *document.Key, content map[string]interface{}) error {
+	for _, field := range d.fieldsOf(key.Collection) {
+		holder, name := parent(content, field)
+		if holder == nil {
+			continue
+		}
+
+		// Values written before the field was declared are left in plaintext
+		value, ok := holder[name].(string)
+		if !ok || !strings.HasPrefix(value, prefix) {
+			continue
+		}
+
+		decrypted, err := Decrypt(d.keyring, value, fieldContext(key, field))
+		if err != nil {
+			return fmt.Errorf("unable to decrypt field %s: %v", field, err)
+		}
+		holder[name] = decrypted
+	}
+
+	return nil
+}
+
+func (d *DocumentService) Get(key *document.Key) (*document.Document, error) {
+	doc, err := d.DocumentService.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.decrypt(key, doc.Content); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func (d *DocumentService) Set(key *document.Key, content map[string]interface{}) error {
+	// keys without a collection are left for the plugin to fail
+	if key == nil || key.Collection == nil {
+		return d.DocumentService.Set(key, content)
+	}
+
+	encrypted, err := d.encrypt(key, content)
+	if err != nil {
+		return err
+	}
+
+	return d.DocumentService.Set(key, encrypted)
+}
+
+func (d *DocumentService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	failed := make([]*document.FailedWrite, 0)
+	encrypted := make([]document.Document, 0, len(docs))
+	for _, doc := range docs {
+		// documents without a collection are left for the plugin to fail
+		if doc.Key != nil && doc.Key.Collection != nil {
+			content, err := d.encrypt(doc.Key, doc.Content)
+			if err != nil {
+				failed = append(failed, &document.FailedWrite{Key: doc.Key, Message: err.Error()})
+				continue
+			}
+			doc = document.Document{Key: doc.Key, Content: content}
+		}
+		encrypted = append(encrypted, doc)
+	}
+
+	resp, err := d.DocumentService.SetBatch(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	resp.FailedWrites = append(failed, resp.FailedWrites...)
+
+	return resp, nil
+}
+
+// Increment - increments fields that aren't encrypted, the datastore can't add to the ciphertext of encrypted fields
+func (d *DocumentService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	if key != nil && key.Collection != nil {
+		for _, f := range d.fieldsOf(key.Collection) {
+			if f == field {
+				return 0, fmt.Errorf("field %s is encrypted and can't be incremented", field)
+			}
+		}
+	}
+
+	return d.DocumentService.Increment(key, field, delta)
+}
+
+// CompareAndSet - compares fields that aren't encrypted, the datastore can't compare the ciphertext of encrypted fields
+func (d *DocumentService) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	if key == nil || key.Collection == nil {
+		return d.DocumentService.CompareAndSet(key, field, expected, content)
+	}
+
+	for _, f := range d.fieldsOf(key.Collection) {
+		if f == field {
+			return fmt.Errorf("field %s is encrypted and can't be compared", field)
+		}
+	}
+
+	encrypted, err := d.encrypt(key, content)
+	if err != nil {
+		return err
+	}
+
+	return d.DocumentService.CompareAndSet(key, field, expected, encrypted)
+}
+
+func (d *DocumentService) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	result, err := d.DocumentService.Query(collection, expressions, limit, pagingToken)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range result.Documents {
+		if err := d.decrypt(doc.Key, doc.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (d *DocumentService) QueryStream(collection *document.Collection, expressions []document.QueryExpression, limit int) document.DocumentIterator {
+	next := d.DocumentService.QueryStream(collection, expressions, limit)
+
+	return func() (*document.Document, error) {
+		doc, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := d.decrypt(doc.Key, doc.Content); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	}
+}
+
+// New - returns a document service encrypting the given fields, keyed by collection path, with keys from the keyring
+func New(documents document.DocumentService, keyring Keyring, fields map[string][]string) (*DocumentService, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required to encrypt documents")
+	}
+
+	if keyring == nil {
+		return nil, fmt.Errorf("a keyring is required to encrypt documents")
+	}
+
+	return &DocumentService{
+		DocumentService: documents,
+		keyring:         keyring,
+		fields:          fields,
+	}, nil
+}
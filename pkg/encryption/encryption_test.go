@@ -0,0 +1,260 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption_test
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/encryption"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+type memoryDocuments struct {
+	document.UnimplementedDocumentPlugin
+	docs map[string]map[string]interface{}
+}
+
+func (m *memoryDocuments) Get(key *document.Key) (*document.Document, error) {
+	return &document.Document{Key: key, Content: m.docs[key.Id]}, nil
+}
+
+func (m *memoryDocuments) Set(key *document.Key, content map[string]interface{}) error {
+	m.docs[key.Id] = content
+	return nil
+}
+
+func (m *memoryDocuments) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	result := &document.QueryResult{}
+	for id, content := range m.docs {
+		result.Documents = append(result.Documents, document.Document{
+			Key:     &document.Key{Collection: collection, Id: id},
+			Content: content,
+		})
+	}
+	return result, nil
+}
+
+func (m *memoryDocuments) QueryStream(collection *document.Collection, expressions []document.QueryExpression, limit int) document.DocumentIterator {
+	result, _ := m.Query(collection, expressions, limit, nil)
+	return func() (*document.Document, error) {
+		if len(result.Documents) == 0 {
+			return nil, io.EOF
+		}
+		doc := result.Documents[0]
+		result.Documents = result.Documents[1:]
+		return &doc, nil
+	}
+}
+
+// versionedSecrets - a secret whose latest version can be changed to rotate the key
+type versionedSecrets struct {
+	secret.UnimplementedSecretPlugin
+	versions map[string][]byte
+	latest   string
+	accesses int
+}
+
+func (v *versionedSecrets) Access(sv *secret.SecretVersion) (*secret.SecretAccessResponse, error) {
+	v.accesses++
+
+	version := sv.Version
+	if version == "latest" {
+		version = v.latest
+	}
+
+	value, ok := v.versions[version]
+	if !ok {
+		return nil, fmt.Errorf("version not found")
+	}
+
+	return &secret.SecretAccessResponse{
+		SecretVersion: &secret.SecretVersion{Secret: sv.Secret, Version: version},
+		Value:         value,
+	}, nil
+}
+
+var _ = Describe("Encryption", func() {
+	users := &document.Collection{Name: "users"}
+	key := &document.Key{Collection: users, Id: "1"}
+
+	var docs *memoryDocuments
+	var secrets *versionedSecrets
+	var encrypted *encryption.DocumentService
+
+	BeforeEach(func() {
+		docs = &memoryDocuments{docs: map[string]map[string]interface{}{}}
+		secrets = &versionedSecrets{versions: map[string][]byte{"1": []byte("first-key")}, latest: "1"}
+
+		keyring, err := encryption.NewSecretKeyring(secrets, "document-key")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		encrypted, err = encryption.New(docs, keyring, map[string][]string{
+			"users":       {"ssn", "address.street", "age"},
+			"users/notes": {"body"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Set", func() {
+		It("should store only ciphertext for declared fields", func() {
+			content := map[string]interface{}{
+				"name":    "Jane",
+				"ssn":     "123-45-6789",
+				"age":     int64(42),
+				"address": map[string]interface{}{"street": "1 Main St", "city": "Springfield"},
+			}
+
+			Expect(encrypted.Set(key, content)).To(Succeed())
+
+			stored := docs.docs["1"]
+			Expect(stored["name"]).To(Equal("Jane"))
+			Expect(stored["ssn"]).To(HavePrefix("nitric:enc:v1:1:"))
+			Expect(stored["address"].(map[string]interface{})["street"]).To(HavePrefix("nitric:enc:v1:1:"))
+			Expect(stored["address"].(map[string]interface{})["city"]).To(Equal("Springfield"))
+
+			By("Not modifying the caller's content")
+			Expect(content["ssn"]).To(Equal("123-45-6789"))
+			Expect(content["address"].(map[string]interface{})["street"]).To(Equal("1 Main St"))
+		})
+
+		It("should encrypt the declared fields of a tenant's collection", func() {
+			tenantKey := &document.Key{Collection: &document.Collection{Name: "acme-users"}, Id: "2"}
+
+			Expect(encrypted.Set(tenantKey, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+
+			Expect(docs.docs["2"]["ssn"]).To(HavePrefix("nitric:enc:v1:1:"))
+		})
+
+		It("should encrypt the declared fields of a sub-collection in every parent document", func() {
+			noteKey := &document.Key{Collection: &document.Collection{Name: "notes", Parent: key}, Id: "a"}
+
+			Expect(encrypted.Set(noteKey, map[string]interface{}{"body": "private"})).To(Succeed())
+
+			Expect(docs.docs["a"]["body"]).To(HavePrefix("nitric:enc:v1:1:"))
+		})
+	})
+
+	Context("Increment", func() {
+		It("should refuse to increment encrypted fields", func() {
+			_, err := encrypted.Increment(key, "age", 1)
+			Expect(err).To(MatchError(ContainSubstring("field age is encrypted")))
+		})
+	})
+
+	Context("Get", func() {
+		It("should decrypt declared fields to their original values", func() {
+			Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789", "age": int64(42)})).To(Succeed())
+
+			doc, err := encrypted.Get(key)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(map[string]interface{}{"ssn": "123-45-6789", "age": int64(42)}))
+		})
+
+		It("should decrypt values encrypted with a previous key after rotation", func() {
+			Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+
+			secrets.versions["2"] = []byte("second-key")
+			secrets.latest = "2"
+
+			doc, err := encrypted.Get(key)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(doc.Content["ssn"]).To(Equal("123-45-6789"))
+		})
+
+		It("should leave plaintext values written before the field was declared", func() {
+			docs.docs["1"] = map[string]interface{}{"ssn": "123-45-6789"}
+
+			doc, err := encrypted.Get(key)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(doc.Content["ssn"]).To(Equal("123-45-6789"))
+		})
+
+		It("should fail to decrypt a value moved to another document", func() {
+			Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+			docs.docs["2"] = map[string]interface{}{"ssn": docs.docs["1"]["ssn"]}
+
+			_, err := encrypted.Get(&document.Key{Collection: users, Id: "2"})
+
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should fail to decrypt a value moved to another field", func() {
+			Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+			docs.docs["1"]["age"] = docs.docs["1"]["ssn"]
+
+			_, err := encrypted.Get(key)
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Query", func() {
+		It("should decrypt the results", func() {
+			Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+
+			result, err := encrypted.Query(users, nil, 0, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Documents[0].Content["ssn"]).To(Equal("123-45-6789"))
+
+			next := encrypted.QueryStream(users, nil, 0)
+			doc, err := next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(doc.Content["ssn"]).To(Equal("123-45-6789"))
+		})
+	})
+
+	Context("SecretKeyring", func() {
+		It("should cache keys between writes", func() {
+			for i := 0; i < 3; i++ {
+				Expect(encrypted.Set(key, map[string]interface{}{"ssn": "123-45-6789"})).To(Succeed())
+				_, _ = encrypted.Get(key)
+			}
+
+			Expect(secrets.accesses).To(Equal(1))
+		})
+	})
+
+	Context("ParseFields", func() {
+		It("should parse collections and their fields", func() {
+			fields, err := encryption.ParseFields("users:ssn, address.street;payments:card")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fields).To(Equal(map[string][]string{
+				"users":    {"ssn", "address.street"},
+				"payments": {"card"},
+			}))
+		})
+
+		It("should reject a collection without fields", func() {
+			_, err := encryption.ParseFields("users:")
+
+			Expect(err).Should(MatchError(ContainSubstring("no encrypted fields")))
+		})
+
+		It("should reject an entry without a collection", func() {
+			_, err := encryption.ParseFields("ssn")
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,117 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	"github.com/nitrictech/nitric/pkg/encryption"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+)
+
+// dataKeyLifetime - how long a data key encrypts new values before another is generated
+const dataKeyLifetime = 24 * time.Hour
+
+// KmsKeyring - Envelope encryption with AWS KMS. Values are encrypted with data keys generated by a KMS key,
+// and the id of each data key is its encrypted form, so it's decrypted by KMS the first time it's needed.
+type KmsKeyring struct {
+	client kmsiface.KMSAPI
+	keyId  string
+	now    func() time.Time
+
+	lock      sync.Mutex
+	keys      map[string]*encryption.Key
+	current   *encryption.Key
+	generated time.Time
+}
+
+var _ encryption.Keyring = &KmsKeyring{}
+
+func (k *KmsKeyring) Current() (*encryption.Key, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.current == nil || k.now().Sub(k.generated) >= dataKeyLifetime {
+		out, err := k.client.GenerateDataKey(&kms.GenerateDataKeyInput{
+			KeyId:   aws.String(k.keyId),
+			KeySpec: aws.String(kms.DataKeySpecAes256),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate data key: %v", err)
+		}
+
+		key := &encryption.Key{
+			Id:       base64.RawURLEncoding.EncodeToString(out.CiphertextBlob),
+			Material: out.Plaintext,
+		}
+		k.keys[key.Id] = key
+		k.current = key
+		k.generated = k.now()
+	}
+
+	return k.current, nil
+}
+
+func (k *KmsKeyring) Get(id string) (*encryption.Key, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if key, ok := k.keys[id]; ok {
+		return key, nil
+	}
+
+	blob, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data key id: %v", err)
+	}
+
+	out, err := k.client.Decrypt(&kms.DecryptInput{
+		CiphertextBlob: blob,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt data key: %v", err)
+	}
+
+	key := &encryption.Key{Id: id, Material: out.Plaintext}
+	k.keys[id] = key
+
+	return key, nil
+}
+
+// New - returns a keyring generating data keys with the given KMS key id, ARN or alias
+func New(keyId string) (*KmsKeyring, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(kms.New(sess), keyId), nil
+}
+
+func NewWithClient(client kmsiface.KMSAPI, keyId string) *KmsKeyring {
+	return &KmsKeyring{
+		client: client,
+		keyId:  keyId,
+		now:    time.Now,
+		keys:   map[string]*encryption.Key{},
+	}
+}
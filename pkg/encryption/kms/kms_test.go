@@ -0,0 +1,91 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kms_keyring "github.com/nitrictech/nitric/pkg/encryption/kms"
+)
+
+// mockKms - "encrypts" data keys by prefixing them, which is enough to check the keyring round trips them through KMS
+type mockKms struct {
+	kmsiface.KMSAPI
+	generated int
+	decrypted int
+}
+
+func (m *mockKms) GenerateDataKey(in *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	m.generated++
+	plaintext := bytes.Repeat([]byte{byte(m.generated)}, 32)
+
+	return &kms.GenerateDataKeyOutput{
+		KeyId:          in.KeyId,
+		Plaintext:      plaintext,
+		CiphertextBlob: append([]byte("wrapped:"), plaintext...),
+	}, nil
+}
+
+func (m *mockKms) Decrypt(in *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	m.decrypted++
+	if !bytes.HasPrefix(in.CiphertextBlob, []byte("wrapped:")) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+
+	return &kms.DecryptOutput{Plaintext: bytes.TrimPrefix(in.CiphertextBlob, []byte("wrapped:"))}, nil
+}
+
+var _ = Describe("KmsKeyring", func() {
+	It("should reuse the current data key", func() {
+		client := &mockKms{}
+		keyring := kms_keyring.NewWithClient(client, "alias/documents")
+
+		first, err := keyring.Current()
+		Expect(err).ShouldNot(HaveOccurred())
+		second, err := keyring.Current()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(second).To(Equal(first))
+		Expect(client.generated).To(Equal(1))
+	})
+
+	It("should recover a data key from its id with KMS", func() {
+		client := &mockKms{}
+		current, err := kms_keyring.NewWithClient(client, "alias/documents").Current()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		By("Getting the key from a new keyring with nothing cached")
+		keyring := kms_keyring.NewWithClient(client, "alias/documents")
+		key, err := keyring.Get(current.Id)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key.Material).To(Equal(current.Material))
+
+		_, err = keyring.Get(current.Id)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(client.decrypted).To(Equal(1))
+	})
+
+	It("should reject an id that isn't a data key", func() {
+		_, err := kms_keyring.NewWithClient(&mockKms{}, "alias/documents").Get("bm90LWEta2V5")
+
+		Expect(err).Should(MatchError(ContainSubstring("unable to decrypt data key")))
+	})
+})
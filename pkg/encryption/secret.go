@@ -0,0 +1,105 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+// secretRefresh - how long the latest version of the key secret is used before checking for a newer one
+const secretRefresh = 5 * time.Minute
+
+// SecretKeyring - Keys stored in a secret, one per secret version. New values are encrypted with the latest version,
+// so the key is rotated by putting a new version of the secret. Keys are derived from the secret's value with SHA-256.
+type SecretKeyring struct {
+	secrets secret.SecretService
+	secret  *secret.Secret
+	now     func() time.Time
+
+	lock      sync.Mutex
+	keys      map[string]*Key
+	current   *Key
+	refreshed time.Time
+}
+
+var _ Keyring = (*SecretKeyring)(nil)
+
+func (s *SecretKeyring) access(version string) (*Key, error) {
+	resp, err := s.secrets.Access(&secret.SecretVersion{
+		Secret:  s.secret,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to access encryption key secret %s: %v", s.secret.Name, err)
+	}
+
+	id := resp.SecretVersion.Version
+	if id == "" || strings.Contains(id, ":") {
+		return nil, fmt.Errorf("encryption key secret %s has unusable version %q", s.secret.Name, id)
+	}
+
+	material := sha256.Sum256(resp.Value)
+	key := &Key{Id: id, Material: material[:]}
+	s.keys[id] = key
+
+	return key, nil
+}
+
+func (s *SecretKeyring) Current() (*Key, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.current == nil || s.now().Sub(s.refreshed) >= secretRefresh {
+		key, err := s.access("latest")
+		if err != nil {
+			return nil, err
+		}
+		s.current = key
+		s.refreshed = s.now()
+	}
+
+	return s.current, nil
+}
+
+func (s *SecretKeyring) Get(id string) (*Key, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if key, ok := s.keys[id]; ok {
+		return key, nil
+	}
+
+	return s.access(id)
+}
+
+// NewSecretKeyring - returns a keyring of the versions of the named secret
+func NewSecretKeyring(secrets secret.SecretService, name string) (*SecretKeyring, error) {
+	if secrets == nil {
+		return nil, fmt.Errorf("a secret plugin is required for encryption keys stored in secrets")
+	}
+
+	return &SecretKeyring{
+		secrets: secrets,
+		secret:  &secret.Secret{Name: name},
+		now:     time.Now,
+		keys:    map[string]*Key{},
+	}, nil
+}
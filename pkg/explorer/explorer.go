@@ -0,0 +1,202 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package explorer describes what a running membrane exposes, its routes, subscriptions, schedules and runtime APIs,
+// to help debug a service locally
+package explorer
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sort"
+
+	"google.golang.org/grpc"
+
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// Route - an http route registered by the child process
+type Route struct {
+	Api     string   `json:"api"`
+	Path    string   `json:"path"`
+	Methods []string `json:"methods"`
+}
+
+// Subscription - a topic subscription registered by the child process
+type Subscription struct {
+	Topic      string `json:"topic"`
+	DeadLetter string `json:"deadLetter,omitempty"`
+}
+
+// Schedule - a schedule registered by the child process
+type Schedule struct {
+	Key  string `json:"key"`
+	Rate string `json:"rate,omitempty"`
+	Cron string `json:"cron,omitempty"`
+}
+
+// BucketNotification - a bucket notification handler registered by the child process
+type BucketNotification struct {
+	Bucket    string `json:"bucket"`
+	Type      string `json:"type,omitempty"`
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+// Api - a runtime API served by the membrane
+type Api struct {
+	Service string   `json:"service"`
+	Methods []string `json:"methods"`
+}
+
+// Description - what the membrane exposes
+type Description struct {
+	Routes        []Route        `json:"routes"`
+	Subscriptions []Subscription `json:"subscriptions"`
+	Schedules     []Schedule     `json:"schedules"`
+	// Configuration keys whose changes are handled by the child process
+	ConfigKeys          []string             `json:"configKeys"`
+	BucketNotifications []BucketNotification `json:"bucketNotifications"`
+	// Workers that handle any trigger, such as FaaS and http proxy workers
+	CatchAll int   `json:"catchAll"`
+	Apis     []Api `json:"apis"`
+}
+
+// ServiceInfoProvider - a gRPC server, providing the services registered with it
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+// Describe - returns what the workers in the pool and the runtime APIs of the server expose
+func Describe(pool worker.WorkerPool, server ServiceInfoProvider) *Description {
+	d := &Description{
+		Routes:              []Route{},
+		Subscriptions:       []Subscription{},
+		Schedules:           []Schedule{},
+		ConfigKeys:          []string{},
+		BucketNotifications: []BucketNotification{},
+		Apis:                []Api{},
+	}
+
+	for _, w := range pool.GetWorkers(&worker.GetWorkerOptions{}) {
+		switch wrkr := w.(type) {
+		case *worker.RouteWorker:
+			d.Routes = append(d.Routes, Route{Api: wrkr.Api(), Path: wrkr.Path(), Methods: wrkr.Methods()})
+		case *worker.SubscriptionWorker:
+			d.Subscriptions = append(d.Subscriptions, Subscription{Topic: wrkr.Topic(), DeadLetter: wrkr.DeadLetter()})
+		case *worker.ScheduleWorker:
+			d.Schedules = append(d.Schedules, Schedule{Key: wrkr.Key(), Rate: wrkr.Rate(), Cron: wrkr.Cron()})
+		case *worker.ConfigWorker:
+			d.ConfigKeys = append(d.ConfigKeys, wrkr.Key())
+		case *worker.BucketNotificationWorker:
+			d.BucketNotifications = append(d.BucketNotifications, BucketNotification{
+				Bucket:    wrkr.Bucket(),
+				Type:      string(wrkr.NotificationType()),
+				KeyPrefix: wrkr.KeyPrefix(),
+			})
+		default:
+			d.CatchAll++
+		}
+	}
+
+	sort.Slice(d.Routes, func(i, j int) bool {
+		if d.Routes[i].Api != d.Routes[j].Api {
+			return d.Routes[i].Api < d.Routes[j].Api
+		}
+		return d.Routes[i].Path < d.Routes[j].Path
+	})
+	sort.Slice(d.Subscriptions, func(i, j int) bool { return d.Subscriptions[i].Topic < d.Subscriptions[j].Topic })
+	sort.Slice(d.Schedules, func(i, j int) bool { return d.Schedules[i].Key < d.Schedules[j].Key })
+	sort.Strings(d.ConfigKeys)
+	sort.Slice(d.BucketNotifications, func(i, j int) bool {
+		if d.BucketNotifications[i].Bucket != d.BucketNotifications[j].Bucket {
+			return d.BucketNotifications[i].Bucket < d.BucketNotifications[j].Bucket
+		}
+		return d.BucketNotifications[i].KeyPrefix < d.BucketNotifications[j].KeyPrefix
+	})
+
+	if server != nil {
+		for name, info := range server.GetServiceInfo() {
+			api := Api{Service: name, Methods: make([]string, 0, len(info.Methods))}
+			for _, m := range info.Methods {
+				api.Methods = append(api.Methods, m.Name)
+			}
+			sort.Strings(api.Methods)
+
+			d.Apis = append(d.Apis, api)
+		}
+		sort.Slice(d.Apis, func(i, j int) bool { return d.Apis[i].Service < d.Apis[j].Service })
+	}
+
+	return d
+}
+
+var page = template.Must(template.New("explorer").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Nitric Membrane</title>
+<style>body{font-family:sans-serif;margin:2em}table{border-collapse:collapse;margin-bottom:2em}td,th{border:1px solid #ccc;padding:4px 8px;text-align:left}</style>
+</head>
+<body>
+<h1>Nitric Membrane</h1>
+<p>As JSON at <a href="api">api</a></p>
+<h2>Routes</h2>
+<table><tr><th>API</th><th>Path</th><th>Methods</th></tr>
+{{range .Routes}}<tr><td>{{.Api}}</td><td>{{.Path}}</td><td>{{range $i, $m := .Methods}}{{if $i}}, {{end}}{{$m}}{{end}}</td></tr>
+{{else}}<tr><td colspan="3">None</td></tr>{{end}}</table>
+<h2>Subscriptions</h2>
+<table><tr><th>Topic</th><th>Dead letter topic</th></tr>
+{{range .Subscriptions}}<tr><td>{{.Topic}}</td><td>{{.DeadLetter}}</td></tr>
+{{else}}<tr><td colspan="2">None</td></tr>{{end}}</table>
+<h2>Schedules</h2>
+<table><tr><th>Key</th><th>Cadence</th></tr>
+{{range .Schedules}}<tr><td>{{.Key}}</td><td>{{if .Cron}}{{.Cron}}{{else}}every {{.Rate}}{{end}}</td></tr>
+{{else}}<tr><td colspan="2">None</td></tr>{{end}}</table>
+<h2>Configuration watches</h2>
+<table><tr><th>Key</th></tr>
+{{range .ConfigKeys}}<tr><td>{{.}}</td></tr>
+{{else}}<tr><td>None</td></tr>{{end}}</table>
+<h2>Bucket notifications</h2>
+<table><tr><th>Bucket</th><th>Type</th><th>Key prefix</th></tr>
+{{range .BucketNotifications}}<tr><td>{{.Bucket}}</td><td>{{if .Type}}{{.Type}}{{else}}all{{end}}</td><td>{{.KeyPrefix}}</td></tr>
+{{else}}<tr><td colspan="3">None</td></tr>{{end}}</table>
+{{if .CatchAll}}<p>{{.CatchAll}} worker(s) handle any trigger</p>{{end}}
+<h2>Runtime APIs</h2>
+<table><tr><th>Service</th><th>Methods</th></tr>
+{{range .Apis}}<tr><td>{{.Service}}</td><td>{{range $i, $m := .Methods}}{{if $i}}, {{end}}{{$m}}{{end}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// Handler - serves the description of the membrane, as a page at / and as JSON at /api
+func Handler(pool worker.WorkerPool, server ServiceInfoProvider) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Describe(pool, server))
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = page.Execute(w, Describe(pool, server))
+	})
+
+	return mux
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explorer_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	grpc_adapters "github.com/nitrictech/nitric/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/explorer"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+var _ = Describe("Explorer", func() {
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 10})
+	_ = pool.AddWorker(worker.NewRouteWorker(nil, &worker.RouteWorkerOptions{Api: "main", Path: "/orders/:id", Methods: []string{"GET", "PUT"}}))
+	_ = pool.AddWorker(worker.NewRouteWorker(nil, &worker.RouteWorkerOptions{Api: "main", Path: "/customers", Methods: []string{"POST"}}))
+	_ = pool.AddWorker(worker.NewSubscriptionWorker(nil, &worker.SubscriptionWorkerOptions{Topic: "orders", DeadLetter: "failed-orders"}))
+	_ = pool.AddWorker(worker.NewScheduleWorker(nil, &worker.ScheduleWorkerOptions{Key: "nightly-report", Cron: "0 2 * * *"}))
+	_ = pool.AddWorker(worker.NewConfigWorker(nil, &worker.ConfigWorkerOptions{Key: "pricing"}))
+	_ = pool.AddWorker(worker.NewBucketNotificationWorker(nil, &worker.BucketNotificationWorkerOptions{Bucket: "images", Type: worker.NotificationCreated, KeyPrefix: "uploads/"}))
+
+	server := grpc.NewServer()
+	v1.RegisterSecretServiceServer(server, grpc_adapters.NewSecretServer(nil))
+
+	Context("Describe", func() {
+		It("should describe the registered workers and runtime APIs", func() {
+			d := explorer.Describe(pool, server)
+
+			Expect(d.Routes).To(Equal([]explorer.Route{
+				{Api: "main", Path: "/customers", Methods: []string{"POST"}},
+				{Api: "main", Path: "/orders/:id", Methods: []string{"GET", "PUT"}},
+			}))
+			Expect(d.Subscriptions).To(Equal([]explorer.Subscription{{Topic: "orders", DeadLetter: "failed-orders"}}))
+			Expect(d.Schedules).To(Equal([]explorer.Schedule{{Key: "nightly-report", Cron: "0 2 * * *"}}))
+			Expect(d.ConfigKeys).To(Equal([]string{"pricing"}))
+			Expect(d.BucketNotifications).To(Equal([]explorer.BucketNotification{{Bucket: "images", Type: "created", KeyPrefix: "uploads/"}}))
+			Expect(d.CatchAll).To(Equal(0))
+			Expect(d.Apis).To(Equal([]explorer.Api{{Service: "nitric.secret.v1.SecretService", Methods: []string{"Access", "Put"}}}))
+		})
+	})
+
+	Context("Handler", func() {
+		It("should serve the description as JSON", func() {
+			rec := httptest.NewRecorder()
+			explorer.Handler(pool, server).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api", nil))
+
+			d := &explorer.Description{}
+			Expect(json.Unmarshal(rec.Body.Bytes(), d)).To(Succeed())
+			Expect(d.Routes).To(HaveLen(2))
+		})
+
+		It("should serve the description as a page", func() {
+			rec := httptest.NewRecorder()
+			explorer.Handler(pool, server).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			Expect(rec.Header().Get("Content-Type")).To(ContainSubstring("text/html"))
+			Expect(rec.Body.String()).To(ContainSubstring("/orders/:id"))
+			Expect(rec.Body.String()).To(ContainSubstring("nitric.secret.v1.SecretService"))
+		})
+	})
+})
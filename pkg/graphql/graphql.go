@@ -0,0 +1,308 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphql accepts GraphQL over HTTP at the gateway, sending each operation to workers as a HTTP trigger
+// with its parsed query, operation name and variables.
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+const jsonContentType = "application/json"
+
+// request - a GraphQL operation sent over HTTP
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	Extensions    map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// requestError - a request the gateway couldn't send to a worker, returned as a GraphQL error
+type requestError struct {
+	Message string
+	// The error code in the error's extensions, omitted if empty
+	Code string
+	// The HTTP status of the response, when it's the only operation in the request
+	Status int
+}
+
+func (e *requestError) Error() string {
+	return e.Message
+}
+
+// response - returns the GraphQL response of the error
+func (e *requestError) response() []byte {
+	gqlErr := map[string]interface{}{
+		"message": e.Message,
+	}
+	if e.Code != "" {
+		gqlErr["extensions"] = map[string]interface{}{"code": e.Code}
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"errors": []interface{}{gqlErr},
+	})
+
+	return body
+}
+
+func badRequest(format string, a ...interface{}) *requestError {
+	return &requestError{Message: fmt.Sprintf(format, a...), Code: "BAD_REQUEST", Status: 400}
+}
+
+// parseGet - parses an operation from the query parameters of a GET request
+func parseGet(query map[string][]string) (*request, *requestError) {
+	param := func(name string) string {
+		if len(query[name]) > 0 {
+			return query[name][0]
+		}
+		return ""
+	}
+
+	req := &request{
+		Query:         param("query"),
+		OperationName: param("operationName"),
+	}
+
+	if variables := param("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+			return nil, badRequest("variables are not a JSON object: %v", err)
+		}
+	}
+
+	if extensions := param("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &req.Extensions); err != nil {
+			return nil, badRequest("extensions are not a JSON object: %v", err)
+		}
+	}
+
+	return req, nil
+}
+
+// parseRequests - parses the operations of a GraphQL request, returning whether they were sent as a batch
+func parseRequests(trigger *triggers.HttpRequest) ([]*request, bool, *requestError) {
+	if trigger.Method == fasthttp.MethodGet {
+		req, err := parseGet(trigger.Query)
+		if err != nil {
+			return nil, false, err
+		}
+		return []*request{req}, false, nil
+	}
+
+	if strings.HasPrefix(contentType(trigger.Header), "application/graphql") {
+		return []*request{{Query: string(trigger.Body)}}, false, nil
+	}
+
+	body := bytes.TrimSpace(trigger.Body)
+	if len(body) > 0 && body[0] == '[' {
+		reqs := make([]*request, 0)
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return nil, true, badRequest("invalid batched GraphQL request: %v", err)
+		}
+		if len(reqs) == 0 {
+			return nil, true, badRequest("batched GraphQL request has no operations")
+		}
+		return reqs, true, nil
+	}
+
+	req := &request{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, false, badRequest("invalid GraphQL request: %v", err)
+	}
+
+	return []*request{req}, false, nil
+}
+
+func contentType(header map[string][]string) string {
+	for k, v := range header {
+		if strings.EqualFold(k, "Content-Type") && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// Gateway - handles GraphQL requests to a single path, sending their operations to workers
+type Gateway struct {
+	path      string
+	maxBatch  int
+	persisted *PersistedQueries
+}
+
+// Matches - returns true if the request is to the gateway's GraphQL path
+func (g *Gateway) Matches(path string) bool {
+	return path == g.path
+}
+
+// operationTrigger - creates the trigger an operation is sent to its worker with, a copy of the original request
+// with the operation as its JSON body, or query parameters for GET requests
+func operationTrigger(trigger *triggers.HttpRequest, req *request) *triggers.HttpRequest {
+	opTrigger := *trigger
+	opTrigger.Graphql = &triggers.GraphqlOperation{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	}
+
+	if trigger.Method == fasthttp.MethodGet {
+		query := make(map[string][]string, len(trigger.Query))
+		for k, v := range trigger.Query {
+			query[k] = v
+		}
+		query["query"] = []string{req.Query}
+		opTrigger.Query = query
+
+		return &opTrigger
+	}
+
+	header := make(map[string][]string, len(trigger.Header))
+	for k, v := range trigger.Header {
+		if !strings.EqualFold(k, "Content-Type") && !strings.EqualFold(k, "Content-Length") {
+			header[k] = v
+		}
+	}
+	header["Content-Type"] = []string{jsonContentType}
+	opTrigger.Header = header
+	opTrigger.Body, _ = json.Marshal(req)
+
+	return &opTrigger
+}
+
+// execute - sends an operation to a worker, returning its response
+func (g *Gateway) execute(pool worker.WorkerPool, trigger *triggers.HttpRequest, req *request) (*triggers.HttpResponse, *requestError) {
+	if err := g.persisted.resolve(req); err != nil {
+		return nil, err
+	}
+
+	if req.Query == "" {
+		return nil, badRequest("GraphQL request has no query")
+	}
+
+	opTrigger := operationTrigger(trigger, req)
+
+	wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
+		Http: opTrigger,
+	})
+	if err != nil {
+		return nil, &requestError{Message: "Unable to get worker to handle request", Status: 500}
+	}
+
+	response, err := wrkr.HandleHttpRequest(opTrigger)
+	if err != nil {
+		return nil, &requestError{Message: fmt.Sprintf("Error handling GraphQL request: %v", err), Status: 500}
+	}
+
+	return response, nil
+}
+
+// errorResponse - responds to a request with a GraphQL error
+func errorResponse(err *requestError) *triggers.HttpResponse {
+	header := &fasthttp.ResponseHeader{}
+	header.SetContentType(jsonContentType)
+
+	return &triggers.HttpResponse{
+		Header:     header,
+		StatusCode: err.Status,
+		Body:       err.response(),
+	}
+}
+
+// batchResult - returns the GraphQL response of an operation in a batch
+func batchResult(response *triggers.HttpResponse, err *requestError) json.RawMessage {
+	if err != nil {
+		return err.response()
+	}
+
+	if response.Events != nil {
+		response.Events.Close()
+		return badRequest("event streams can't be returned in a batch").response()
+	}
+
+	if !json.Valid(response.Body) {
+		return (&requestError{Message: fmt.Sprintf("worker responded with a %d status that isn't a GraphQL response", response.StatusCode)}).response()
+	}
+
+	return response.Body
+}
+
+// HandleHttpRequest - handles a GraphQL request, responding with the response of its operation, or an array of responses to a batch
+func (g *Gateway) HandleHttpRequest(pool worker.WorkerPool, trigger *triggers.HttpRequest) *triggers.HttpResponse {
+	if trigger.Method != fasthttp.MethodGet && trigger.Method != fasthttp.MethodPost {
+		err := &requestError{Message: "GraphQL requests must be GET or POST", Status: 405}
+
+		resp := errorResponse(err)
+		resp.Header.Set(fasthttp.HeaderAllow, "GET, POST")
+		return resp
+	}
+
+	reqs, batched, parseErr := parseRequests(trigger)
+	if parseErr != nil {
+		return errorResponse(parseErr)
+	}
+
+	if !batched {
+		response, err := g.execute(pool, trigger, reqs[0])
+		if err != nil {
+			return errorResponse(err)
+		}
+		return response
+	}
+
+	if len(reqs) > g.maxBatch {
+		return errorResponse(badRequest("batched GraphQL requests are limited to %d operations", g.maxBatch))
+	}
+
+	results := make([]json.RawMessage, len(reqs))
+	wg := sync.WaitGroup{}
+	for i, req := range reqs {
+		wg.Add(1)
+		go func(i int, req *request) {
+			defer wg.Done()
+			results[i] = batchResult(g.execute(pool, trigger, req))
+		}(i, req)
+	}
+	wg.Wait()
+
+	body, _ := json.Marshal(results)
+
+	header := &fasthttp.ResponseHeader{}
+	header.SetContentType(jsonContentType)
+
+	return &triggers.HttpResponse{
+		Header:     header,
+		StatusCode: 200,
+		Body:       body,
+	}
+}
+
+// New - creates a gateway for GraphQL requests to path, batching up to maxBatch operations per request
+// and caching up to persistedQueries persisted queries
+func New(path string, maxBatch int, persistedQueries int) *Gateway {
+	return &Gateway{
+		path:      path,
+		maxBatch:  maxBatch,
+		persisted: NewPersistedQueries(persistedQueries),
+	}
+}
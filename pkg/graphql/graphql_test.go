@@ -0,0 +1,200 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+func hashOf(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+var _ = Describe("Gateway", func() {
+	var ctrl *gomock.Controller
+	var mockWrkr *mock_worker.MockWorker
+	var pool worker.WorkerPool
+	var gateway *Gateway
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockWrkr = mock_worker.NewMockWorker(ctrl)
+		mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Return(true).AnyTimes()
+
+		pool = worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 1})
+		Expect(pool.AddWorker(mockWrkr)).To(Succeed())
+
+		gateway = New("/graphql", 2, 10)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("a single operation is posted", func() {
+		It("should send the parsed operation to a worker", func() {
+			var sent *triggers.HttpRequest
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				sent = trigger
+				return &triggers.HttpResponse{StatusCode: 200, Body: []byte(`{"data":{}}`)}, nil
+			})
+
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`{"query":"query Me { me { id } }","operationName":"Me","variables":{"id":1}}`),
+			})
+
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(string(resp.Body)).To(Equal(`{"data":{}}`))
+			Expect(sent.Graphql).To(Equal(&triggers.GraphqlOperation{
+				Query:         "query Me { me { id } }",
+				OperationName: "Me",
+				Variables:     map[string]interface{}{"id": float64(1)},
+			}))
+		})
+	})
+
+	When("a GET request is sent", func() {
+		It("should parse the operation from its query parameters", func() {
+			var sent *triggers.HttpRequest
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				sent = trigger
+				return &triggers.HttpResponse{StatusCode: 200, Body: []byte(`{"data":{}}`)}, nil
+			})
+
+			gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "GET",
+				Path:   "/graphql",
+				Query: map[string][]string{
+					"query":     {"{ me { id } }"},
+					"variables": {`{"first":10}`},
+				},
+			})
+
+			Expect(sent.Graphql.Query).To(Equal("{ me { id } }"))
+			Expect(sent.Graphql.Variables).To(Equal(map[string]interface{}{"first": float64(10)}))
+		})
+	})
+
+	When("operations are batched", func() {
+		It("should respond with the response of each operation in order", func() {
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				body, _ := json.Marshal(map[string]interface{}{"data": trigger.Graphql.OperationName})
+				return &triggers.HttpResponse{StatusCode: 200, Body: body}, nil
+			}).Times(2)
+
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`[{"query":"query A { a }","operationName":"A"},{"query":"query B { b }","operationName":"B"}]`),
+			})
+
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(string(resp.Body)).To(Equal(`[{"data":"A"},{"data":"B"}]`))
+		})
+
+		It("should refuse batches larger than the limit", func() {
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`[{"query":"{ a }"},{"query":"{ b }"},{"query":"{ c }"}]`),
+			})
+
+			Expect(resp.StatusCode).To(Equal(400))
+		})
+	})
+
+	When("a persisted query is used", func() {
+		query := "{ me { id } }"
+		extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + hashOf(query) + `"}}`
+
+		It("should ask for the query until it has been persisted", func() {
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`{"extensions":` + extensions + `}`),
+			})
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(string(resp.Body)).To(ContainSubstring("PERSISTED_QUERY_NOT_FOUND"))
+
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).Return(&triggers.HttpResponse{StatusCode: 200, Body: []byte(`{"data":{}}`)}, nil)
+			gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`{"query":"` + query + `","extensions":` + extensions + `}`),
+			})
+
+			var sent *triggers.HttpRequest
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				sent = trigger
+				return &triggers.HttpResponse{StatusCode: 200, Body: []byte(`{"data":{}}`)}, nil
+			})
+			gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "GET",
+				Path:   "/graphql",
+				Query:  map[string][]string{"extensions": {extensions}},
+			})
+			Expect(sent.Graphql.Query).To(Equal(query))
+		})
+
+		It("should refuse queries that don't match their hash", func() {
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`{"query":"{ other }","extensions":` + extensions + `}`),
+			})
+			Expect(resp.StatusCode).To(Equal(400))
+		})
+	})
+
+	When("the request isn't GraphQL", func() {
+		It("should refuse it", func() {
+			resp := gateway.HandleHttpRequest(pool, &triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/graphql",
+				Body:   []byte(`not json`),
+			})
+			Expect(resp.StatusCode).To(Equal(400))
+			Expect(string(resp.Body)).To(ContainSubstring("errors"))
+		})
+	})
+})
+
+var _ = Describe("PersistedQueries", func() {
+	It("should evict the least recently used query", func() {
+		p := NewPersistedQueries(2)
+		p.put("a", "{ a }")
+		p.put("b", "{ b }")
+		_, _ = p.get("a")
+		p.put("c", "{ c }")
+
+		_, ok := p.get("b")
+		Expect(ok).To(BeFalse())
+		_, ok = p.get("a")
+		Expect(ok).To(BeTrue())
+	})
+})
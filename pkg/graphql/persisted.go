@@ -0,0 +1,121 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// PersistedQueries - caches the documents of persisted queries by their SHA-256 hash, evicting the least recently used
+type PersistedQueries struct {
+	lock    sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type persistedQuery struct {
+	hash  string
+	query string
+}
+
+// persistedQueryHash - returns the hash of the persisted query extension of a request, empty if it has none
+func persistedQueryHash(req *request) string {
+	ext, ok := req.Extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	hash, _ := ext["sha256Hash"].(string)
+
+	return hash
+}
+
+// resolve - fills in the query of a request that only sent the hash of a persisted query,
+// and persists the queries of requests that sent both
+func (p *PersistedQueries) resolve(req *request) *requestError {
+	hash := persistedQueryHash(req)
+	if hash == "" {
+		return nil
+	}
+
+	if p == nil || p.size < 1 {
+		return &requestError{Message: "PersistedQueryNotSupported", Code: "PERSISTED_QUERY_NOT_SUPPORTED", Status: 400}
+	}
+
+	if req.Query == "" {
+		query, ok := p.get(hash)
+		if !ok {
+			// Clients send the query with its hash when it isn't found, so this isn't a failed request
+			return &requestError{Message: "PersistedQueryNotFound", Code: "PERSISTED_QUERY_NOT_FOUND", Status: 200}
+		}
+
+		req.Query = query
+		return nil
+	}
+
+	sum := sha256.Sum256([]byte(req.Query))
+	if hex.EncodeToString(sum[:]) != hash {
+		return &requestError{Message: "provided sha does not match query", Code: "BAD_REQUEST", Status: 400}
+	}
+
+	p.put(hash, req.Query)
+
+	return nil
+}
+
+func (p *PersistedQueries) get(hash string) (string, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	el, ok := p.entries[hash]
+	if !ok {
+		return "", false
+	}
+
+	p.order.MoveToFront(el)
+
+	return el.Value.(*persistedQuery).query, true
+}
+
+func (p *PersistedQueries) put(hash string, query string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if el, ok := p.entries[hash]; ok {
+		p.order.MoveToFront(el)
+		return
+	}
+
+	p.entries[hash] = p.order.PushFront(&persistedQuery{hash: hash, query: query})
+
+	for p.order.Len() > p.size {
+		oldest := p.order.Back()
+		p.order.Remove(oldest)
+		delete(p.entries, oldest.Value.(*persistedQuery).hash)
+	}
+}
+
+// NewPersistedQueries - creates a cache of up to size persisted queries, persisted queries aren't supported if size is 0
+func NewPersistedQueries(size int) *PersistedQueries {
+	return &PersistedQueries{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
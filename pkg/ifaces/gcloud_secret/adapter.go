@@ -19,15 +19,17 @@ import (
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/option"
 	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
 )
 
 type realClient struct {
 	*secretmanager.Client
 }
 
-func NewClient(ctx context.Context) (SecretManagerClient, error) {
-	c, err := secretmanager.NewClient(ctx)
+func NewClient(ctx context.Context, opts ...option.ClientOption) (SecretManagerClient, error) {
+	c, err := secretmanager.NewClient(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +44,14 @@ func (r *realClient) AddSecretVersion(ctx context.Context, req *secretmanagerpb.
 	return r.Client.AddSecretVersion(ctx, req, co...)
 }
 
+func (r *realClient) CreateSecret(ctx context.Context, req *secretmanagerpb.CreateSecretRequest, co ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+	return r.Client.CreateSecret(ctx, req, co...)
+}
+
+func (r *realClient) GetSecret(ctx context.Context, req *secretmanagerpb.GetSecretRequest, co ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+	return r.Client.GetSecret(ctx, req, co...)
+}
+
 func (r *realClient) UpdateSecret(ctx context.Context, req *secretmanagerpb.UpdateSecretRequest, co ...gax.CallOption) (*secretmanagerpb.Secret, error) {
 	return r.Client.UpdateSecret(ctx, req, co...)
 }
@@ -49,3 +59,7 @@ func (r *realClient) UpdateSecret(ctx context.Context, req *secretmanagerpb.Upda
 func (r *realClient) ListSecrets(ctx context.Context, req *secretmanagerpb.ListSecretsRequest, co ...gax.CallOption) SecretIterator {
 	return r.Client.ListSecrets(ctx, req, co...)
 }
+
+func (r *realClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, co ...gax.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	return r.Client.TestIamPermissions(ctx, req, co...)
+}
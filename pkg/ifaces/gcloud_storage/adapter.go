@@ -32,6 +32,7 @@ type (
 	bucketIterator struct{ *storage.BucketIterator }
 	writer         struct{ *storage.Writer }
 	reader         struct{ *storage.Reader }
+	composer       struct{ *storage.Composer }
 )
 
 // func (client) embedToIncludeNewMethods()         {}
@@ -60,6 +61,18 @@ func (b bucketHandle) SignedURL(object string, opts *storage.SignedURLOptions) (
 	return b.BucketHandle.SignedURL(object, opts)
 }
 
+func (b bucketHandle) GenerateSignedPostPolicyV4(object string, opts *storage.PostPolicyV4Options) (*storage.PostPolicyV4, error) {
+	return b.BucketHandle.GenerateSignedPostPolicyV4(object, opts)
+}
+
+func (b bucketHandle) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	return b.BucketHandle.Update(ctx, attrs)
+}
+
+func (b bucketHandle) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	return b.BucketHandle.IAM().TestPermissions(ctx, permissions)
+}
+
 func (o objectHandle) Key(encryptionKey []byte) ObjectHandle {
 	return objectHandle{o.ObjectHandle.Key(encryptionKey)}
 }
@@ -69,10 +82,56 @@ func (o objectHandle) NewWriter(ctx context.Context) Writer {
 }
 
 func (o objectHandle) NewReader(ctx context.Context) (Reader, error) {
-	newReader, err := o.ObjectHandle.NewReader(ctx)
+	// Read objects as stored, decompression is handled by the storage plugin
+	newReader, err := o.ObjectHandle.ReadCompressed(true).NewReader(ctx)
 	return reader{newReader}, err
 }
 
+func (w writer) ObjectAttrs() *storage.ObjectAttrs {
+	return &w.Writer.ObjectAttrs
+}
+
+func (r reader) ContentEncoding() string {
+	return r.Reader.Attrs.ContentEncoding
+}
+
+func (r reader) Generation() int64 {
+	return r.Reader.Attrs.Generation
+}
+
 func (o objectHandle) Delete(ctx context.Context) error {
 	return o.ObjectHandle.Delete(ctx)
 }
+
+func (o objectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	return o.ObjectHandle.Attrs(ctx)
+}
+
+func (o objectHandle) If(conds storage.Conditions) ObjectHandle {
+	return objectHandle{o.ObjectHandle.If(conds)}
+}
+
+func (o objectHandle) ComposerFrom(srcs ...ObjectHandle) Composer {
+	handles := make([]*storage.ObjectHandle, 0, len(srcs))
+	for _, src := range srcs {
+		handles = append(handles, src.(objectHandle).ObjectHandle)
+	}
+
+	return composer{o.ObjectHandle.ComposerFrom(handles...)}
+}
+
+func (c composer) ObjectAttrs() *storage.ObjectAttrs {
+	return &c.Composer.ObjectAttrs
+}
+
+func (o objectHandle) Generation(gen int64) ObjectHandle {
+	return objectHandle{o.ObjectHandle.Generation(gen)}
+}
+
+func (o objectHandle) CopierFrom(src ObjectHandle) Copier {
+	return o.ObjectHandle.CopierFrom(src.(objectHandle).ObjectHandle)
+}
+
+func (o objectHandle) Update(ctx context.Context, attrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	return o.ObjectHandle.Update(ctx, attrs)
+}
@@ -23,16 +23,40 @@ import (
 
 type Writer interface {
 	io.WriteCloser
+	// ObjectAttrs - attributes applied to the object when it is written
+	ObjectAttrs() *storage.ObjectAttrs
+	// Attrs - attributes of the written object, available once the writer is closed
+	Attrs() *storage.ObjectAttrs
 }
 
 type Reader interface {
 	io.ReadCloser
+	// ContentEncoding - the content encoding the object was stored with
+	ContentEncoding() string
+	// Generation - the generation of the object being read
+	Generation() int64
 }
 
 type ObjectHandle interface {
 	NewWriter(context.Context) Writer
 	NewReader(context.Context) (Reader, error)
 	Delete(ctx context.Context) error
+	Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+	If(storage.Conditions) ObjectHandle
+	ComposerFrom(...ObjectHandle) Composer
+	Generation(int64) ObjectHandle
+	CopierFrom(ObjectHandle) Copier
+	Update(context.Context, storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error)
+}
+
+type Composer interface {
+	// ObjectAttrs - attributes applied to the composed object, replacing those of the destination
+	ObjectAttrs() *storage.ObjectAttrs
+	Run(context.Context) (*storage.ObjectAttrs, error)
+}
+
+type Copier interface {
+	Run(context.Context) (*storage.ObjectAttrs, error)
 }
 
 type BucketIterator interface {
@@ -44,9 +68,14 @@ type ObjectIterator interface {
 }
 
 type BucketHandle interface {
+	Create(context.Context, string, *storage.BucketAttrs) error
 	Object(string) ObjectHandle
 	Objects(context.Context, *storage.Query) ObjectIterator
 	SignedURL(string, *storage.SignedURLOptions) (string, error)
+	GenerateSignedPostPolicyV4(string, *storage.PostPolicyV4Options) (*storage.PostPolicyV4, error)
+	Update(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error)
+	// TestPermissions - returns the subset of the IAM permissions the client's credentials are granted on the bucket
+	TestPermissions(context.Context, []string) ([]string, error)
 }
 
 type StorageClient interface {
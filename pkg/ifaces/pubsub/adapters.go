@@ -47,6 +47,14 @@ func (c pubsubClient) Topic(id string) Topic {
 	return topic{c.Client.Topic(id)}
 }
 
+func (c pubsubClient) CreateTopic(ctx context.Context, topicID string, labels map[string]string) (Topic, error) {
+	t, err := c.Client.CreateTopicWithConfig(ctx, topicID, &pubsub.TopicConfig{Labels: labels})
+	if err != nil {
+		return nil, err
+	}
+	return topic{t}, nil
+}
+
 func (c pubsubClient) Topics(ctx context.Context) TopicIterator {
 	return topicIterator{c.Client.Topics(ctx)}
 }
@@ -89,6 +97,27 @@ func (t topic) ID() string {
 	return t.Topic.ID()
 }
 
+func (t topic) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	return t.Topic.IAM().TestPermissions(ctx, permissions)
+}
+
+func (t topic) Labels(ctx context.Context) (map[string]string, error) {
+	cfg, err := t.Topic.Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Labels, nil
+}
+
+func (t topic) EnableMessageOrdering() {
+	t.Topic.EnableMessageOrdering = true
+}
+
+func (t topic) ResumePublish(orderingKey string) {
+	t.Topic.ResumePublish(orderingKey)
+}
+
 func (s subscription) ID() string {
 	return s.Subscription.ID()
 }
@@ -97,6 +126,14 @@ func (s subscription) String() string {
 	return s.Subscription.String()
 }
 
+func (s subscription) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	return s.Subscription.IAM().TestPermissions(ctx, permissions)
+}
+
+func (s subscription) SeekToTime(ctx context.Context, t time.Time) error {
+	return s.Subscription.SeekToTime(ctx, t)
+}
+
 func (m message) ID() string {
 	return m.Message.ID
 }
@@ -113,6 +150,10 @@ func (m message) PublishTime() time.Time {
 	return m.Message.PublishTime
 }
 
+func (m message) OrderingKey() string {
+	return m.Message.OrderingKey
+}
+
 func (r publishResult) Get(ctx context.Context) (serverID string, err error) {
 	return r.PublishResult.Get(ctx)
 }
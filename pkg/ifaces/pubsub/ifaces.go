@@ -23,7 +23,7 @@ import (
 )
 
 type PubsubClient interface {
-	// CreateTopic(ctx context.Context, topicID string) (Topic, error)
+	CreateTopic(ctx context.Context, topicID string, labels map[string]string) (Topic, error)
 	Topic(id string) Topic
 	Topics(ctx context.Context) TopicIterator
 	// CreateSubscription(ctx context.Context, id string, cfg SubscriptionConfig) (Subscription, error)
@@ -40,6 +40,14 @@ type Topic interface {
 	Exists(ctx context.Context) (bool, error)
 	Subscriptions(ctx context.Context) SubscriptionIterator
 	ID() string
+	// Labels - returns the labels the topic was created with
+	Labels(ctx context.Context) (map[string]string, error)
+	// TestPermissions - returns the subset of the IAM permissions the client's credentials are granted on the topic
+	TestPermissions(ctx context.Context, permissions []string) ([]string, error)
+	// EnableMessageOrdering - publishes messages sharing an ordering key in the order they're published
+	EnableMessageOrdering()
+	// ResumePublish - resumes publishing messages with an ordering key, after a failed publish paused it
+	ResumePublish(orderingKey string)
 }
 
 type SubscriptionIterator interface {
@@ -49,6 +57,9 @@ type SubscriptionIterator interface {
 type Subscription interface {
 	ID() string
 	String() string
+	SeekToTime(ctx context.Context, t time.Time) error
+	// TestPermissions - returns the subset of the IAM permissions the client's credentials are granted on the subscription
+	TestPermissions(ctx context.Context, permissions []string) ([]string, error)
 }
 
 type Message interface {
@@ -56,6 +67,7 @@ type Message interface {
 	Data() []byte
 	Attributes() map[string]string
 	PublishTime() time.Time
+	OrderingKey() string
 	Ack()
 	Nack()
 }
@@ -68,4 +80,5 @@ type SubscriberClient interface {
 	Close() error
 	Pull(ctx context.Context, req *pubsubpb.PullRequest, opts ...gax.CallOption) (*pubsubpb.PullResponse, error)
 	Acknowledge(ctx context.Context, req *pubsubpb.AcknowledgeRequest, opts ...gax.CallOption) error
+	ModifyAckDeadline(ctx context.Context, req *pubsubpb.ModifyAckDeadlineRequest, opts ...gax.CallOption) error
 }
@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifaces_pubsub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	monitoring "google.golang.org/api/monitoring/v3"
+)
+
+// SubscriptionMetrics - reads the metrics Pub/Sub reports for subscriptions
+type SubscriptionMetrics interface {
+	// Latest - returns the most recent value of the metric for the subscription, zero if none has been reported
+	Latest(ctx context.Context, subscriptionId string, metricType string) (int64, error)
+}
+
+const (
+	// NumUndeliveredMessages - the number of messages the subscription hasn't acknowledged
+	NumUndeliveredMessages = "pubsub.googleapis.com/subscription/num_undelivered_messages"
+	// OldestUnackedMessageAge - the age in seconds of the oldest message the subscription hasn't acknowledged
+	OldestUnackedMessageAge = "pubsub.googleapis.com/subscription/oldest_unacked_message_age"
+)
+
+// metricsWindow - how far back to look for metrics, Pub/Sub samples them every minute
+const metricsWindow = 5 * time.Minute
+
+// monitoringMetrics - reads subscription metrics from Cloud Monitoring
+type monitoringMetrics struct {
+	service   *monitoring.Service
+	projectId string
+}
+
+// NewMonitoringMetrics - reads the metrics of subscriptions in the project from Cloud Monitoring
+func NewMonitoringMetrics(service *monitoring.Service, projectId string) SubscriptionMetrics {
+	return &monitoringMetrics{
+		service:   service,
+		projectId: projectId,
+	}
+}
+
+func (m *monitoringMetrics) Latest(ctx context.Context, subscriptionId string, metricType string) (int64, error) {
+	now := time.Now()
+	resp, err := m.service.Projects.TimeSeries.List("projects/" + m.projectId).
+		Filter(fmt.Sprintf("metric.type = %q AND resource.labels.subscription_id = %q", metricType, subscriptionId)).
+		IntervalStartTime(now.Add(-metricsWindow).Format(time.RFC3339)).
+		IntervalEndTime(now.Format(time.RFC3339)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return 0, err
+	}
+
+	// Points are returned newest first
+	for _, ts := range resp.TimeSeries {
+		if len(ts.Points) > 0 && ts.Points[0].Value != nil && ts.Points[0].Value.Int64Value != nil {
+			return *ts.Points[0].Value.Int64Value, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,264 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexing
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+// idDelimiter - separates the ids of the documents a nested document's search id is made from
+const idDelimiter = "+"
+
+// DocumentService - Indexes documents written to configured collections with the search plugin, keeping indexes in step with the collections.
+//
+// Documents are indexed after they're successfully written and removed from their index after they're deleted.
+// Nested documents are indexed under the ids of the documents they're nested under joined with "+", e.g. <customer id>+<order id>.
+// Indexing failures are logged rather than failing the write, so indexes may miss writes until the document is next written.
+type DocumentService struct {
+	document.DocumentService
+	search  search.SearchService
+	indexes map[string]string
+	// tenants - true if root collections are namespaced by tenant, so documents are indexed in their tenant's index
+	tenants bool
+}
+
+type Option interface {
+	Apply(*DocumentService)
+}
+
+type withTenancy struct{}
+
+func (withTenancy) Apply(d *DocumentService) {
+	d.tenants = true
+}
+
+// WithTenancy - indexes the documents of a tenant's collections in the tenant's own index, namespaced like its collections.
+// Collections are indexed by the name they're declared with, without the tenant
+func WithTenancy() Option {
+	return withTenancy{}
+}
+
+var _ document.DocumentService = (*DocumentService)(nil)
+
+// SearchId - returns the id a document is indexed under
+func SearchId(key *document.Key) string {
+	path := document.KeyPath(key)
+
+	ids := make([]string, 0, len(path))
+	for _, k := range path {
+		ids = append(ids, k.Id)
+	}
+
+	return strings.Join(ids, idDelimiter)
+}
+
+// index - returns the index a document is indexed in, false if its collection isn't indexed
+func (d *DocumentService) index(key *document.Key) (string, bool) {
+	name := key.Collection.Name
+	tenant := ""
+
+	if d.tenants {
+		var root string
+		tenant, root = tenancy.SplitName(document.RootKey(key).Collection.Name)
+		if key.Collection.Parent == nil {
+			name = root
+		}
+	}
+
+	index, ok := d.indexes[name]
+	if !ok {
+		return "", false
+	}
+
+	return tenancy.Name(tenant, index), true
+}
+
+func (d *DocumentService) Set(key *document.Key, content map[string]interface{}) error {
+	if err := d.DocumentService.Set(key, content); err != nil {
+		return err
+	}
+
+	if index, ok := d.index(key); ok {
+		if err := d.search.Index(index, SearchId(key), content); err != nil {
+			log.Printf("error indexing document %s in collection %s: %v", key.Id, key.Collection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *DocumentService) Delete(key *document.Key) error {
+	if err := d.DocumentService.Delete(key); err != nil {
+		return err
+	}
+
+	// Nested documents deleted with the document are left in their indexes
+	if index, ok := d.index(key); ok {
+		if err := d.search.Delete(index, SearchId(key)); err != nil {
+			log.Printf("error removing document %s in collection %s from index: %v", key.Id, key.Collection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *DocumentService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	resp, err := d.DocumentService.SetBatch(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := failedKeys(resp)
+	for _, doc := range docs {
+		if failed[doc.Key] {
+			continue
+		}
+
+		if index, ok := d.index(doc.Key); ok {
+			if err := d.search.Index(index, SearchId(doc.Key), doc.Content); err != nil {
+				log.Printf("error indexing document %s in collection %s: %v", doc.Key.Id, doc.Key.Collection.Name, err)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func (d *DocumentService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	resp, err := d.DocumentService.DeleteBatch(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := failedKeys(resp)
+	for _, key := range keys {
+		if failed[key] {
+			continue
+		}
+
+		if index, ok := d.index(key); ok {
+			if err := d.search.Delete(index, SearchId(key)); err != nil {
+				log.Printf("error removing document %s in collection %s from index: %v", key.Id, key.Collection.Name, err)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// Increment - indexes the incremented document, which is read back since only the field's new value is known
+func (d *DocumentService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	value, err := d.DocumentService.Increment(key, field, delta)
+	if err != nil {
+		return 0, err
+	}
+
+	if index, ok := d.index(key); ok {
+		doc, err := d.DocumentService.Get(key)
+		if err != nil {
+			log.Printf("error reading incremented document %s in collection %s: %v", key.Id, key.Collection.Name, err)
+			return value, nil
+		}
+
+		if err := d.search.Index(index, SearchId(key), doc.Content); err != nil {
+			log.Printf("error indexing document %s in collection %s: %v", key.Id, key.Collection.Name, err)
+		}
+	}
+
+	return value, nil
+}
+
+func (d *DocumentService) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	if err := d.DocumentService.CompareAndSet(key, field, expected, content); err != nil {
+		return err
+	}
+
+	if index, ok := d.index(key); ok {
+		if err := d.search.Index(index, SearchId(key), content); err != nil {
+			log.Printf("error indexing document %s in collection %s: %v", key.Id, key.Collection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// failedKeys - the keys of the documents a batch couldn't write
+func failedKeys(resp *document.BatchResponse) map[*document.Key]bool {
+	failed := make(map[*document.Key]bool)
+	for _, f := range resp.FailedWrites {
+		failed[f.Key] = true
+	}
+
+	return failed
+}
+
+// ParseCollections - parses comma separated collections to index, each optionally followed by =<index>,
+// e.g. "products,orders=order-search". Collections are indexed in an index of the same name unless one is given.
+func ParseCollections(value string) (map[string]string, error) {
+	indexes := make(map[string]string)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		collection, index := entry, entry
+		if parts := strings.SplitN(entry, "=", 2); len(parts) == 2 {
+			collection, index = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		}
+
+		if collection == "" || index == "" {
+			return nil, fmt.Errorf("invalid indexed collection %s, expected <collection>[=<index>]", entry)
+		}
+
+		if _, ok := indexes[collection]; ok {
+			return nil, fmt.Errorf("collection %s is indexed more than once", collection)
+		}
+
+		indexes[collection] = index
+	}
+
+	return indexes, nil
+}
+
+// New - returns a document service indexing documents written to the given collections, keyed by collection name, in the mapped index
+func New(documents document.DocumentService, searchPlugin search.SearchService, indexes map[string]string, opts ...Option) (*DocumentService, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required to index documents")
+	}
+
+	if searchPlugin == nil {
+		return nil, fmt.Errorf("a search plugin is required to index documents")
+	}
+
+	d := &DocumentService{
+		DocumentService: documents,
+		search:          searchPlugin,
+		indexes:         indexes,
+	}
+
+	for _, o := range opts {
+		o.Apply(d)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexing_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	mock_search "github.com/nitrictech/nitric/mocks/search"
+	"github.com/nitrictech/nitric/pkg/indexing"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+var _ = Describe("Indexing", func() {
+	products := &document.Collection{Name: "products"}
+	orders := &document.Collection{Name: "orders"}
+
+	Context("ParseCollections", func() {
+		When("given collections with and without index names", func() {
+			It("should map collections to their indexes", func() {
+				indexes, err := indexing.ParseCollections("products, orders=order-search")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(indexes).To(Equal(map[string]string{
+					"products": "products",
+					"orders":   "order-search",
+				}))
+			})
+		})
+
+		When("given a blank index name", func() {
+			It("should return an error", func() {
+				_, err := indexing.ParseCollections("orders=")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("given a collection more than once", func() {
+			It("should return an error", func() {
+				_, err := indexing.ParseCollections("orders,orders=order-search")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("SearchId", func() {
+		It("should join the ids of nested documents", func() {
+			key := &document.Key{
+				Collection: &document.Collection{
+					Name:   "items",
+					Parent: &document.Key{Collection: orders, Id: "o1"},
+				},
+				Id: "i1",
+			}
+
+			Expect(indexing.SearchId(key)).To(Equal("o1+i1"))
+		})
+	})
+
+	Context("Set", func() {
+		var (
+			ctrl       *gomock.Controller
+			mockDocs   *mock_document.MockDocumentService
+			mockSearch *mock_search.MockSearchService
+			docs       *indexing.DocumentService
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockDocs = mock_document.NewMockDocumentService(ctrl)
+			mockSearch = mock_search.NewMockSearchService(ctrl)
+
+			var err error
+			docs, err = indexing.New(mockDocs, mockSearch, map[string]string{"products": "product-search"})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		When("the collection is indexed", func() {
+			It("should index the written document", func() {
+				key := &document.Key{Collection: products, Id: "p1"}
+				content := map[string]interface{}{"name": "Widget"}
+
+				mockDocs.EXPECT().Set(key, content).Return(nil)
+				mockSearch.EXPECT().Index("product-search", "p1", content).Return(nil)
+
+				Expect(docs.Set(key, content)).To(Succeed())
+			})
+		})
+
+		When("the collection isn't indexed", func() {
+			It("should only write the document", func() {
+				key := &document.Key{Collection: orders, Id: "o1"}
+				content := map[string]interface{}{"total": 10}
+
+				mockDocs.EXPECT().Set(key, content).Return(nil)
+
+				Expect(docs.Set(key, content)).To(Succeed())
+			})
+		})
+
+		When("the write fails", func() {
+			It("should return the error without indexing", func() {
+				key := &document.Key{Collection: products, Id: "p1"}
+
+				mockDocs.EXPECT().Set(key, gomock.Any()).Return(fmt.Errorf("write failed"))
+
+				Expect(docs.Set(key, map[string]interface{}{})).ShouldNot(Succeed())
+			})
+		})
+
+		When("indexing fails", func() {
+			It("should not fail the write", func() {
+				key := &document.Key{Collection: products, Id: "p1"}
+
+				mockDocs.EXPECT().Set(key, gomock.Any()).Return(nil)
+				mockSearch.EXPECT().Index("product-search", "p1", gomock.Any()).Return(fmt.Errorf("unavailable"))
+
+				Expect(docs.Set(key, map[string]interface{}{})).To(Succeed())
+			})
+		})
+
+		When("deleting a document in an indexed collection", func() {
+			It("should remove the document from the index", func() {
+				key := &document.Key{Collection: products, Id: "p1"}
+
+				mockDocs.EXPECT().Delete(key).Return(nil)
+				mockSearch.EXPECT().Delete("product-search", "p1").Return(nil)
+
+				Expect(docs.Delete(key)).To(Succeed())
+			})
+		})
+
+		When("the document belongs to a tenant", func() {
+			It("should index it in the tenant's index", func() {
+				tenantDocs, err := indexing.New(mockDocs, mockSearch, map[string]string{"products": "product-search", "reviews": "reviews"}, indexing.WithTenancy())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				product := &document.Key{Collection: &document.Collection{Name: "acme-products"}, Id: "p1"}
+				review := &document.Key{Collection: &document.Collection{Name: "reviews", Parent: product}, Id: "r1"}
+
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mockSearch.EXPECT().Index("acme-product-search", "p1", gomock.Any()).Return(nil)
+				mockSearch.EXPECT().Index("acme-reviews", "p1+r1", gomock.Any()).Return(nil)
+
+				Expect(tenantDocs.Set(product, map[string]interface{}{})).To(Succeed())
+				Expect(tenantDocs.Set(review, map[string]interface{}{})).To(Succeed())
+			})
+		})
+	})
+
+	Context("New", func() {
+		When("the search plugin is missing", func() {
+			It("should return an error", func() {
+				_, err := indexing.New(&document.UnimplementedDocumentPlugin{}, nil, map[string]string{})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,119 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudmap
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+
+	"github.com/nitrictech/nitric/pkg/invoke"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+)
+
+// cacheTtl - how long the instances of a service are cached before they're discovered again
+const cacheTtl = 30 * time.Second
+
+type discovered struct {
+	urls []string
+	at   time.Time
+}
+
+// CloudMapResolver - Resolves services to a healthy instance registered with an AWS Cloud Map namespace,
+// from its AWS_INSTANCE_IPV4 and AWS_INSTANCE_PORT attributes
+type CloudMapResolver struct {
+	client    servicediscoveryiface.ServiceDiscoveryAPI
+	namespace string
+	now       func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]*discovered
+}
+
+var _ invoke.Resolver = &CloudMapResolver{}
+
+func (c *CloudMapResolver) discover(service string) ([]string, error) {
+	out, err := c.client.DiscoverInstances(&servicediscovery.DiscoverInstancesInput{
+		NamespaceName: aws.String(c.namespace),
+		ServiceName:   aws.String(service),
+		HealthStatus:  aws.String(servicediscovery.HealthStatusFilterHealthy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover instances of %s: %v", service, err)
+	}
+
+	urls := make([]string, 0, len(out.Instances))
+	for _, instance := range out.Instances {
+		ip := aws.StringValue(instance.Attributes["AWS_INSTANCE_IPV4"])
+		if ip == "" {
+			continue
+		}
+
+		port := aws.StringValue(instance.Attributes["AWS_INSTANCE_PORT"])
+		if port == "" {
+			port = "80"
+		}
+		urls = append(urls, fmt.Sprintf("http://%s:%s", ip, port))
+	}
+
+	return urls, nil
+}
+
+// Resolve - returns the url of a random instance of the service, or an empty string if it has no healthy instances
+func (c *CloudMapResolver) Resolve(service string) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	d, ok := c.cache[service]
+	if !ok || c.now().Sub(d.at) >= cacheTtl {
+		urls, err := c.discover(service)
+		if err != nil {
+			return "", err
+		}
+
+		d = &discovered{urls: urls, at: c.now()}
+		c.cache[service] = d
+	}
+
+	if len(d.urls) == 0 {
+		return "", nil
+	}
+
+	return d.urls[rand.Intn(len(d.urls))], nil
+}
+
+// New - returns a resolver for services registered with the named Cloud Map namespace
+func New(namespace string) (*CloudMapResolver, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(servicediscovery.New(sess), namespace), nil
+}
+
+func NewWithClient(client servicediscoveryiface.ServiceDiscoveryAPI, namespace string) *CloudMapResolver {
+	return &CloudMapResolver{
+		client:    client,
+		namespace: namespace,
+		now:       time.Now,
+		cache:     map[string]*discovered{},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudmap_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/invoke/cloudmap"
+)
+
+type mockDiscovery struct {
+	servicediscoveryiface.ServiceDiscoveryAPI
+	instances map[string][]*servicediscovery.HttpInstanceSummary
+	calls     int
+}
+
+func (m *mockDiscovery) DiscoverInstances(in *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
+	m.calls++
+
+	return &servicediscovery.DiscoverInstancesOutput{Instances: m.instances[aws.StringValue(in.ServiceName)]}, nil
+}
+
+var _ = Describe("CloudMapResolver", func() {
+	It("should resolve a service to one of its instances", func() {
+		client := &mockDiscovery{instances: map[string][]*servicediscovery.HttpInstanceSummary{
+			"orders": {{Attributes: map[string]*string{
+				"AWS_INSTANCE_IPV4": aws.String("10.0.0.1"),
+				"AWS_INSTANCE_PORT": aws.String("8080"),
+			}}},
+		}}
+		resolver := cloudmap.NewWithClient(client, "shop")
+
+		url, err := resolver.Resolve("orders")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(url).To(Equal("http://10.0.0.1:8080"))
+
+		By("Caching the instances")
+		_, _ = resolver.Resolve("orders")
+		Expect(client.calls).To(Equal(1))
+	})
+
+	It("should resolve services without instances to nothing", func() {
+		url, err := cloudmap.NewWithClient(&mockDiscovery{}, "shop").Resolve("users")
+
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(url).To(BeEmpty())
+	})
+})
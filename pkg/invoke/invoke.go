@@ -0,0 +1,179 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package invoke calls the other services of a stack, resolving where they're deployed so functions can call them portably
+package invoke
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/egress"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/webhook"
+)
+
+// CallerHeader - the header naming the service that sent an invoked request
+const CallerHeader = webhook.CallerHeader
+
+// kubernetesNamespaceFile - where the namespace of a pod's service account is mounted
+const kubernetesNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Resolver - finds where a service is deployed
+type Resolver interface {
+	// Resolve - returns the base url of the service, or an empty string if the resolver doesn't know it
+	Resolve(service string) (string, error)
+}
+
+// EnvResolver - resolves services from their NITRIC_SERVICE_{NAME}_URL env vars, with the name upper cased and dashes replaced by underscores
+type EnvResolver struct{}
+
+func (EnvResolver) Resolve(service string) (string, error) {
+	name := strings.ToUpper(strings.ReplaceAll(service, "-", "_"))
+
+	return os.Getenv("NITRIC_SERVICE_" + name + "_URL"), nil
+}
+
+// TemplateResolver - resolves every service to a url template with {service} replaced by its name, e.g. http://{service}.internal:8080
+type TemplateResolver struct {
+	Template string
+}
+
+func (t *TemplateResolver) Resolve(service string) (string, error) {
+	return strings.ReplaceAll(t.Template, "{service}", service), nil
+}
+
+// NewKubernetesResolver - resolves services to Kubernetes services of the same name in the namespace,
+// the namespace of the membrane's pod if empty
+func NewKubernetesResolver(namespace string, port int) (*TemplateResolver, error) {
+	if namespace == "" {
+		ns, err := ioutil.ReadFile(kubernetesNamespaceFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the pod's namespace: %v", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	return &TemplateResolver{
+		Template: fmt.Sprintf("http://{service}.%s.svc.cluster.local:%d", namespace, port),
+	}, nil
+}
+
+// Resolvers - resolves services with the first resolver that knows them
+type Resolvers []Resolver
+
+func (r Resolvers) Resolve(service string) (string, error) {
+	for _, resolver := range r {
+		url, err := resolver.Resolve(service)
+		if err != nil || url != "" {
+			return url, err
+		}
+	}
+
+	return "", nil
+}
+
+// Request - a request to another service
+type Request struct {
+	Service string
+	Path    string
+	Method  string
+	Header  map[string][]string
+	Payload []byte
+}
+
+// Invoker - sends requests to the other services of a stack, with the membrane's retries, circuit breaking and trace propagation
+type Invoker struct {
+	resolver Resolver
+	client   *egress.Client
+	// the name of the calling service, sent with each request if it's set
+	caller string
+	// signs requests so the invoked service can verify they came from the stack, unsigned if nil
+	credentials *secret.Credentials
+	now         func() time.Time
+}
+
+// Invoke - sends a request to a service, signing it with the stack's shared secret when one is configured.
+// Requests are sent as given, so the invoked service's response is returned whatever its status
+func (i *Invoker) Invoke(ctx context.Context, req *Request) (*egress.Response, error) {
+	newErr := errors.ErrorsWithScope("Invoker.Invoke", map[string]interface{}{
+		"service": req.Service,
+		"path":    req.Path,
+	})
+
+	base, err := i.resolver.Resolve(req.Service)
+	if err != nil {
+		return nil, newErr(codes.Unavailable, "error resolving service", err)
+	}
+	if base == "" {
+		return nil, newErr(codes.NotFound, fmt.Sprintf("service %s not found", req.Service), nil)
+	}
+
+	method := req.Method
+	if method == "" {
+		method = "POST"
+	}
+
+	target := strings.TrimSuffix(base, "/") + req.Path
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid path", err)
+	}
+
+	// The caller and signature replace any given, so neither can be spoofed
+	header := make(map[string][]string, len(req.Header)+2)
+	for k, v := range req.Header {
+		if !strings.EqualFold(k, CallerHeader) && !strings.EqualFold(k, webhook.NitricHeader) {
+			header[k] = v
+		}
+	}
+	if i.caller != "" {
+		header[CallerHeader] = []string{i.caller}
+	}
+	if i.credentials != nil {
+		header[webhook.NitricHeader] = []string{webhook.SignNitric(i.credentials.Value(), i.now(), method, u.Path, req.Service, i.caller, req.Payload)}
+	}
+
+	// Redirects aren't followed, so signed requests are only sent to the invoked service
+	return i.client.Do(ctx, &egress.Request{
+		Method:      method,
+		Url:         target,
+		Header:      header,
+		Body:        req.Payload,
+		NoRedirects: true,
+	})
+}
+
+// New - returns an invoker sending requests with the client to services found by the resolver.
+// Requests are signed with the credentials and name the caller if they're given
+func New(resolver Resolver, client *egress.Client, caller string, credentials *secret.Credentials) (*Invoker, error) {
+	if resolver == nil || client == nil {
+		return nil, fmt.Errorf("a resolver and client are required to invoke services")
+	}
+
+	return &Invoker{
+		resolver:    resolver,
+		client:      client,
+		caller:      caller,
+		credentials: credentials,
+		now:         time.Now,
+	}, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoke_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/egress"
+	"github.com/nitrictech/nitric/pkg/invoke"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/webhook"
+)
+
+var _ = Describe("Invoke", func() {
+	Context("Resolvers", func() {
+		It("should resolve services from their env vars", func() {
+			os.Setenv("NITRIC_SERVICE_ORDER_API_URL", "http://orders:8080")
+			defer os.Unsetenv("NITRIC_SERVICE_ORDER_API_URL")
+
+			url, err := invoke.EnvResolver{}.Resolve("order-api")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(url).To(Equal("http://orders:8080"))
+		})
+
+		It("should use the first resolver that knows a service", func() {
+			resolvers := invoke.Resolvers{invoke.EnvResolver{}, &invoke.TemplateResolver{Template: "http://{service}.internal"}}
+
+			url, err := resolvers.Resolve("users")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(url).To(Equal("http://users.internal"))
+		})
+
+		It("should resolve Kubernetes services in the namespace", func() {
+			resolver, err := invoke.NewKubernetesResolver("shop", 8080)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			url, _ := resolver.Resolve("users")
+			Expect(url).To(Equal("http://users.shop.svc.cluster.local:8080"))
+		})
+	})
+
+	Context("Invoke", func() {
+		var received *http.Request
+		var receivedBody []byte
+		var srv *httptest.Server
+
+		BeforeEach(func() {
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				receivedBody, _ = ioutil.ReadAll(r.Body)
+				if r.URL.Path == "/moved" {
+					http.Redirect(w, r, "/orders", http.StatusFound)
+					return
+				}
+				_, _ = w.Write([]byte("ok"))
+			}))
+		})
+
+		AfterEach(func() {
+			srv.Close()
+		})
+
+		It("should send a signed request to the resolved service", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecrets := mock_secret.NewMockSecretService(ctrl)
+			mockSecrets.EXPECT().Access(gomock.Any()).Return(&secret.SecretAccessResponse{Value: []byte("shared")}, nil)
+			credentials, _ := secret.NewCredentials(mockSecrets, "invoke", 0)
+
+			invoker, _ := invoke.New(&invoke.TemplateResolver{Template: srv.URL}, egress.New(egress.DefaultConfig), "checkout", credentials)
+
+			resp, err := invoker.Invoke(context.Background(), &invoke.Request{
+				Service: "orders",
+				Path:    "/orders?status=new",
+				Payload: []byte(`{"id":1}`),
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Body).To(Equal([]byte("ok")))
+			Expect(received.Method).To(Equal("POST"))
+			Expect(received.URL.String()).To(Equal("/orders?status=new"))
+			Expect(received.Header.Get(invoke.CallerHeader)).To(Equal("checkout"))
+
+			By("Signing the request so the service can verify it")
+			err = (&webhook.Nitric{Tolerance: time.Minute, Service: "orders"}).Verify(&triggers.HttpRequest{
+				Method: received.Method,
+				Path:   received.URL.Path,
+				Header: received.Header,
+				Body:   receivedBody,
+			}, []byte("shared"), time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ctrl.Finish()
+		})
+
+		It("should not follow redirects", func() {
+			invoker, _ := invoke.New(&invoke.TemplateResolver{Template: srv.URL}, egress.New(egress.DefaultConfig), "checkout", nil)
+
+			resp, err := invoker.Invoke(context.Background(), &invoke.Request{Service: "orders", Path: "/moved"})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Status).To(Equal(http.StatusFound))
+			Expect(received.URL.Path).To(Equal("/moved"))
+		})
+
+		It("should return not found for unknown services", func() {
+			invoker, _ := invoke.New(invoke.EnvResolver{}, egress.New(egress.DefaultConfig), "", nil)
+
+			_, err := invoker.Invoke(context.Background(), &invoke.Request{Service: "missing", Path: "/"})
+
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package limits configures the request size and time limits applied to http requests handled by the membrane
+package limits
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// Limits - the limits applied to an http request, zero values are unlimited
+type Limits struct {
+	// MaxBodySize - the largest request body in bytes, larger requests are refused with a 413
+	MaxBodySize int
+	// Timeout - how long the application has to respond, slower requests are answered with a 504
+	Timeout time.Duration
+}
+
+// Route - limits applied to requests under a path prefix
+type Route struct {
+	Prefix string
+	Limits
+}
+
+// Config - the limits applied to http requests, by route
+type Config struct {
+	// Default - limits applied to requests that don't match a route
+	Default Limits
+	// Routes - sorted by descending prefix length, so the most specific route matches first
+	Routes []*Route
+}
+
+// Match - returns the limits for a request path, from its most specific route or the defaults
+func (c *Config) Match(path string) Limits {
+	for _, r := range c.Routes {
+		if utils.HasPathPrefix(path, r.Prefix) {
+			return r.Limits
+		}
+	}
+
+	return c.Default
+}
+
+var sizeUnits = map[string]int{
+	"":   1,
+	"B":  1,
+	"KB": 1024,
+	"MB": 1024 * 1024,
+	"GB": 1024 * 1024 * 1024,
+}
+
+// ParseSize - parses a size in bytes, optionally followed by a KB, MB or GB unit, e.g. "512KB"
+func ParseSize(size string) (int, error) {
+	size = strings.ToUpper(strings.TrimSpace(size))
+	digits := strings.TrimRight(size, "BKMG")
+
+	multiplier, ok := sizeUnits[size[len(digits):]]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %s, expected bytes optionally followed by KB, MB or GB", size)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(digits))
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %s, expected bytes optionally followed by KB, MB or GB", size)
+	}
+
+	return n * multiplier, nil
+}
+
+// ParseRoutes - parses a route limits configuration string, routes are separated by commas
+// and followed by semicolon separated limits.
+// e.g. "/uploads;max_body_size=50MB;timeout=5m,/health;timeout=1s"
+func ParseRoutes(config string) ([]*Route, error) {
+	routes := make([]*Route, 0)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ";")
+		r := &Route{
+			Prefix: "/" + strings.Trim(strings.TrimSpace(parts[0]), "/"),
+		}
+
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("no limits given for route %s", r.Prefix)
+		}
+
+		for _, attr := range parts[1:] {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid limit %s for route %s, expected key=value", attr, r.Prefix)
+			}
+
+			key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+			var err error
+			switch key {
+			case "max_body_size":
+				r.MaxBodySize, err = ParseSize(value)
+			case "timeout":
+				r.Timeout, err = time.ParseDuration(value)
+			default:
+				err = fmt.Errorf("unknown limit")
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid limit %s for route %s: %v", key, r.Prefix, err)
+			}
+		}
+
+		routes = append(routes, r)
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].Prefix) > len(routes[j].Prefix)
+	})
+
+	return routes, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limits_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/limits"
+)
+
+var _ = Describe("Limits", func() {
+	Context("ParseSize", func() {
+		It("should parse sizes with and without units", func() {
+			for size, expected := range map[string]int{
+				"512":  512,
+				"10B":  10,
+				"64KB": 64 * 1024,
+				"50mb": 50 * 1024 * 1024,
+				"1 GB": 1024 * 1024 * 1024,
+				"0":    0,
+			} {
+				Expect(limits.ParseSize(size)).To(Equal(expected), size)
+			}
+		})
+
+		It("should return an error for invalid sizes", func() {
+			for _, size := range []string{"", "MB", "1.5MB", "10TB", "-1"} {
+				_, err := limits.ParseSize(size)
+				Expect(err).Should(HaveOccurred(), size)
+			}
+		})
+	})
+
+	Context("ParseRoutes", func() {
+		When("Given routes with limits", func() {
+			routes, err := limits.ParseRoutes("/health;timeout=1s, /uploads/;max_body_size=50MB;timeout=5m, /uploads/images;max_body_size=10MB")
+
+			It("should parse each route's limits", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(routes).To(HaveLen(3))
+				Expect(routes[1]).To(Equal(&limits.Route{
+					Prefix: "/uploads",
+					Limits: limits.Limits{MaxBodySize: 50 * 1024 * 1024, Timeout: 5 * time.Minute},
+				}))
+			})
+
+			It("should sort the most specific routes first", func() {
+				Expect(routes[0].Prefix).To(Equal("/uploads/images"))
+				Expect(routes[2].Prefix).To(Equal("/health"))
+			})
+		})
+
+		When("Given an unknown limit", func() {
+			_, err := limits.ParseRoutes("/uploads;max_size=50MB")
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("Given a route without limits", func() {
+			_, err := limits.ParseRoutes("/uploads")
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Match", func() {
+		routes, _ := limits.ParseRoutes("/uploads;max_body_size=50MB,/uploads/images;max_body_size=10MB")
+		config := &limits.Config{
+			Default: limits.Limits{MaxBodySize: 1024, Timeout: time.Second},
+			Routes:  routes,
+		}
+
+		It("should match the most specific route", func() {
+			Expect(config.Match("/uploads/images/1").MaxBodySize).To(Equal(10 * 1024 * 1024))
+			Expect(config.Match("/uploads/docs/1").MaxBodySize).To(Equal(50 * 1024 * 1024))
+			Expect(config.Match("/uploads").MaxBodySize).To(Equal(50 * 1024 * 1024))
+		})
+
+		It("should match paths with doubled slashes or dot segments", func() {
+			Expect(config.Match("//uploads").MaxBodySize).To(Equal(50 * 1024 * 1024))
+			Expect(config.Match("/uploads//images/1").MaxBodySize).To(Equal(10 * 1024 * 1024))
+			Expect(config.Match("/./uploads/images").MaxBodySize).To(Equal(10 * 1024 * 1024))
+		})
+
+		It("should fall back to the defaults", func() {
+			Expect(config.Match("/uploadsx")).To(Equal(config.Default))
+			Expect(config.Match("/orders")).To(Equal(config.Default))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/limits"
+	"github.com/nitrictech/nitric/pkg/static"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// reloadableConfig - the env vars that can be set in the config file, and changed while the membrane is running
+var reloadableConfig = map[string]bool{
+	"HTTP_MAX_BODY_SIZE":   true,
+	"HTTP_TIMEOUT":         true,
+	"HTTP_ROUTE_LIMITS":    true,
+	"STATIC_CACHE_CONTROL": true,
+}
+
+// configGetter - returns the value of a configuration env var, or the default if it isn't set
+type configGetter = func(key string, defaultValue string) string
+
+// readConfigFile - reads the KEY=VALUE lines of a config file, ignoring blank lines and # comments
+func readConfigFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(text, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid line %d in %s, expected KEY=VALUE", line, path)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		if !reloadableConfig[key] {
+			return nil, fmt.Errorf("invalid line %d in %s, %s can't be set in the config file", line, path, key)
+		}
+
+		values[key] = strings.TrimSpace(parts[1])
+	}
+
+	return values, scanner.Err()
+}
+
+// configFrom - returns a getter for configuration that prefers the values from the config file to env vars
+func configFrom(values map[string]string) configGetter {
+	return func(key string, defaultValue string) string {
+		if value, ok := values[key]; ok {
+			return value
+		}
+
+		return utils.GetEnv(key, defaultValue)
+	}
+}
+
+// ReloadConfig - reads the config file again, replacing the http request limits and static asset caching of the running membrane.
+// The previous configuration is kept unless every setting is valid, and triggers already being handled aren't affected
+func (s *Membrane) ReloadConfig() error {
+	if s.configFile == "" {
+		return fmt.Errorf("no config file specified, there is no configuration to reload")
+	}
+
+	values, err := readConfigFile(s.configFile)
+	if err != nil {
+		return err
+	}
+	get := configFrom(values)
+
+	httpLimits, err := httpLimitsFrom(get)
+	if err != nil {
+		return err
+	}
+
+	if httpLimits == nil {
+		httpLimits = &limits.Config{}
+	}
+
+	s.limitPool.SetConfig(httpLimits)
+	if s.staticServer != nil {
+		s.staticServer.SetCacheControl(get("STATIC_CACHE_CONTROL", static.DefaultCacheControl))
+	}
+
+	return nil
+}
+
+// reloadConfig - reloads the config file, logging the outcome
+func (s *Membrane) reloadConfig() {
+	if err := s.ReloadConfig(); err != nil {
+		s.log(fmt.Sprintf("Configuration reload failed, keeping the previous configuration: %v", err))
+		return
+	}
+
+	s.log(fmt.Sprintf("Reloaded configuration from %s", s.configFile))
+}
+
+// watchConfig - reloads the config file whenever it's modified, checking every interval until stopped
+func (s *Membrane) watchConfig(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var modified time.Time
+	if info, err := os.Stat(s.configFile); err == nil {
+		modified = info.ModTime()
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(s.configFile)
+			if err != nil || info.ModTime().Equal(modified) {
+				continue
+			}
+
+			modified = info.ModTime()
+			s.reloadConfig()
+		}
+	}
+}
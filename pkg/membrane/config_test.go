@@ -0,0 +1,138 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/limits"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+	mock_worker "github.com/nitrictech/nitric/tests/mocks/worker"
+)
+
+var _ = Describe("Config", func() {
+	var dir string
+	var file string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "config")
+		Expect(err).ShouldNot(HaveOccurred())
+		file = filepath.Join(dir, "membrane.env")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeConfig := func(config string) {
+		Expect(ioutil.WriteFile(file, []byte(config), 0o600)).To(Succeed())
+	}
+
+	When("Reading a config file", func() {
+		It("should return its settings, ignoring comments and blank lines", func() {
+			writeConfig("# limits\nHTTP_TIMEOUT = 5s\n\nSTATIC_CACHE_CONTROL=public, max-age=60\n")
+
+			values, err := readConfigFile(file)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(values).To(Equal(map[string]string{
+				"HTTP_TIMEOUT":         "5s",
+				"STATIC_CACHE_CONTROL": "public, max-age=60",
+			}))
+		})
+
+		It("should reject settings that can't be reloaded", func() {
+			writeConfig("SERVICE_ADDRESS=0.0.0.0:50051\n")
+
+			_, err := readConfigFile(file)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should reject lines that aren't settings", func() {
+			writeConfig("HTTP_TIMEOUT\n")
+
+			_, err := readConfigFile(file)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("Reloading the config file", func() {
+		var m *Membrane
+		var pool *worker.LimitPool
+
+		BeforeEach(func() {
+			processPool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+			Expect(processPool.AddWorker(mock_worker.NewMockWorker(&mock_worker.MockWorkerOptions{}))).To(Succeed())
+
+			pool = worker.NewLimitPool(processPool, &limits.Config{})
+			m = &Membrane{
+				configFile:   file,
+				limitPool:    pool,
+				suppressLogs: true,
+			}
+		})
+
+		statusCode := func() int {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte("a large body")}
+			wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			if res == nil {
+				// handled by the worker
+				return 0
+			}
+			return res.StatusCode
+		}
+
+		It("should apply the new limits to requests", func() {
+			writeConfig("HTTP_MAX_BODY_SIZE=4B\n")
+
+			Expect(m.ReloadConfig()).To(Succeed())
+			Expect(statusCode()).To(Equal(413))
+		})
+
+		It("should keep the previous limits when a setting is invalid", func() {
+			writeConfig("HTTP_MAX_BODY_SIZE=4B\n")
+			Expect(m.ReloadConfig()).To(Succeed())
+
+			writeConfig("HTTP_MAX_BODY_SIZE=1KB\nHTTP_TIMEOUT=soon\n")
+			Expect(m.ReloadConfig()).ShouldNot(Succeed())
+			Expect(statusCode()).To(Equal(413))
+		})
+
+		It("should reload the file when it's modified", func() {
+			writeConfig("")
+			stop := make(chan struct{})
+			defer close(stop)
+			go m.watchConfig(10*time.Millisecond, stop)
+
+			// wait for the watcher to record the current modification time
+			time.Sleep(50 * time.Millisecond)
+			writeConfig("HTTP_MAX_BODY_SIZE=4B\n")
+			Expect(os.Chtimes(file, time.Now(), time.Now().Add(time.Second))).To(Succeed())
+
+			Eventually(statusCode).Should(Equal(413))
+		})
+	})
+})
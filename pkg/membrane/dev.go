@@ -0,0 +1,88 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/watch"
+)
+
+// workerSettleTime - how long after a restarted process last registered a worker it's considered to have registered them all
+const workerSettleTime = 250 * time.Millisecond
+
+// devWatcherFromEnv - returns a watcher for the child process's code in dir, configured by the DEV_WATCH env vars
+func devWatcherFromEnv(dir string) (*watch.Watcher, error) {
+	intervalEnv := utils.GetEnv("DEV_WATCH_INTERVAL", watch.DefaultInterval.String())
+	interval, err := time.ParseDuration(intervalEnv)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid DEV_WATCH_INTERVAL env var, expected duration e.g. 500ms, got %v", intervalEnv)
+	}
+
+	ignore := append([]string{}, watch.DefaultIgnore...)
+	for _, pattern := range strings.Split(utils.GetEnv("DEV_WATCH_IGNORE", ""), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			ignore = append(ignore, pattern)
+		}
+	}
+
+	watcher, err := watch.New(dir, &watch.Options{
+		Interval: interval,
+		Ignore:   ignore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid DEV_WATCH env var: %v", err)
+	}
+
+	return watcher, nil
+}
+
+// reloadChildProcesses - restarts the child processes so they run the changed code.
+// New triggers are held until the restarted processes have registered their workers again, instead of failing while there are none
+func (s *Membrane) reloadChildProcesses(changed []string) {
+	if len(changed) == 1 {
+		s.log(fmt.Sprintf("%s changed, restarting child processes", changed[0]))
+	} else {
+		s.log(fmt.Sprintf("%d files changed, restarting child processes", len(changed)))
+	}
+
+	s.holdPool.Hold()
+	defer s.holdPool.Release()
+
+	// The changed code may register different workers than before, so each restarted process is ready
+	// once it has registered at least one worker and stopped registering more
+	since := time.Now()
+	err := s.restartChildProcesses(func(waitUntil time.Time) error {
+		for {
+			if last := s.holdPool.LastAdded(); last.After(since) && time.Since(last) >= workerSettleTime {
+				since = time.Now()
+				return nil
+			}
+
+			if time.Now().After(waitUntil) {
+				return fmt.Errorf("timed out waiting for workers to register")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+	if err != nil {
+		s.log(fmt.Sprintf("Restart failed, waiting for further changes: %v", err))
+		return
+	}
+	s.log("Child processes restarted")
+}
@@ -15,26 +15,82 @@
 package membrane
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"net"
+	"net/http"
 	"os"
-	"os/exec"
+	"os/signal"
 	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	grpc2 "github.com/nitrictech/nitric/pkg/adapters/grpc"
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/archive"
+	"github.com/nitrictech/nitric/pkg/backlog"
+	"github.com/nitrictech/nitric/pkg/backup"
+	"github.com/nitrictech/nitric/pkg/capture"
+	"github.com/nitrictech/nitric/pkg/chaos"
+	"github.com/nitrictech/nitric/pkg/connections"
+	"github.com/nitrictech/nitric/pkg/dedupe"
+	"github.com/nitrictech/nitric/pkg/egress"
+	"github.com/nitrictech/nitric/pkg/encryption"
+	"github.com/nitrictech/nitric/pkg/explorer"
+	"github.com/nitrictech/nitric/pkg/indexing"
+	"github.com/nitrictech/nitric/pkg/invoke"
+	"github.com/nitrictech/nitric/pkg/limits"
+	"github.com/nitrictech/nitric/pkg/migrate"
+	"github.com/nitrictech/nitric/pkg/outbox"
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	config_plugin "github.com/nitrictech/nitric/pkg/plugins/config"
+	builtin_config_service "github.com/nitrictech/nitric/pkg/plugins/config/builtin"
 	"github.com/nitrictech/nitric/pkg/plugins/document"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	builtin_flags_service "github.com/nitrictech/nitric/pkg/plugins/flags/builtin"
+	flagsmith_service "github.com/nitrictech/nitric/pkg/plugins/flags/flagsmith"
+	launchdarkly_service "github.com/nitrictech/nitric/pkg/plugins/flags/launchdarkly"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/sql"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/redact"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/sandbox"
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/secretenv"
+	"github.com/nitrictech/nitric/pkg/shedding"
+	"github.com/nitrictech/nitric/pkg/stack"
+	"github.com/nitrictech/nitric/pkg/static"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/timeseries"
+	"github.com/nitrictech/nitric/pkg/traffic"
+	"github.com/nitrictech/nitric/pkg/transform"
+	"github.com/nitrictech/nitric/pkg/uploads"
+	"github.com/nitrictech/nitric/pkg/usage"
+	"github.com/nitrictech/nitric/pkg/utilization"
 	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/versioning"
+	"github.com/nitrictech/nitric/pkg/watch"
+	"github.com/nitrictech/nitric/pkg/webhook"
 	"github.com/nitrictech/nitric/pkg/worker"
+	"github.com/nitrictech/nitric/pkg/workflow"
 )
 
 type MembraneOptions struct {
@@ -45,6 +101,41 @@ type MembraneOptions struct {
 	ChildCommand []string
 	// The total time to wait for the child process to be available in seconds
 	ChildTimeoutSeconds int
+	// Resources available to the child process, unlimited if nil
+	ChildLimits *sandbox.Limits
+	// The number of child processes to run, triggers are distributed across them
+	ChildProcesses int
+	// How triggers are distributed across equivalent workers, WORKER_DISPATCH if nil, in turn if that isn't set either
+	Dispatch *worker.DispatchOptions
+	// The membrane's usage above which it sheds load, SHED_MEMORY_LIMIT and SHED_GOROUTINE_LIMIT if nil
+	LoadShedding *shedding.Thresholds
+	// When workers are removed from dispatch after failing too many times in a row, WORKER_BREAKER_THRESHOLD if nil
+	WorkerBreaker *worker.BreakerOptions
+	// How long workers may spend handling each type of trigger, TRIGGER_HTTP_TIMEOUT and TRIGGER_EVENT_TIMEOUT if nil
+	TriggerTimeouts *worker.TriggerTimeouts
+	// How long triggers take to be handed over to a new generation of workers, before the previous generation is drained.
+	// WORKER_HANDOVER_DURATION if zero
+	WorkerHandover time.Duration
+	// Additional environment variables for the child process with the given index, may be nil
+	ChildEnv func(index int) []string
+	// Secrets resolved on start and injected into the child process's environment, CHILD_SECRETS if nil
+	ChildSecrets *secretenv.Injector
+	// How often the child process's secrets are resolved again, restarting the child processes when they change.
+	// CHILD_SECRETS_REFRESH if zero, never refreshed if the env var isn't set either
+	ChildSecretsRefresh time.Duration
+
+	// The stack and environment the membrane belongs to, sent with each trigger. NITRIC_STACK and NITRIC_ENVIRONMENT if nil
+	Stack *stack.Identity
+
+	// Checks the resources declared by the child process exist once its workers are available,
+	// "warn" logs the resources that don't and "fail" stops the membrane. Disabled if empty
+	ResourceValidation string
+	// Simulates the cloud permissions needed by the policies the child process declares once its workers are available,
+	// "warn" logs a least-privilege report of them and "fail" also stops the membrane if any are missing. Disabled if empty
+	PermissionCheck string
+	// Creates the resources declared by the child process that don't exist, labelled with the stack and environment,
+	// for dev and test environments that aren't deployed
+	AutoProvision bool
 
 	DocumentPlugin document.DocumentService
 	EventsPlugin   events.EventService
@@ -52,6 +143,154 @@ type MembraneOptions struct {
 	QueuePlugin    queue.QueueService
 	GatewayPlugin  gateway.GatewayService
 	SecretPlugin   secret.SecretService
+	SqlPlugin      sql.SqlService
+	SearchPlugin   search.SearchService
+	BatchPlugin    batch.BatchService
+	CdnPlugin      cdn.CdnService
+	// Evaluates feature flags. LaunchDarkly or Flagsmith when they're configured, otherwise flags stored by the document plugin
+	FlagsPlugin flags.FlagsService
+	// Reads dynamic application configuration, configuration stored by the document plugin if nil
+	ConfigPlugin config_plugin.ConfigService
+	// Verifies user tokens and manages users, unavailable if nil
+	IdentityPlugin identity.IdentityService
+
+	// Compression applied to storage writes, per bucket
+	StorageCompression *storage.CompressionConfig
+
+	// Lifecycle rules applied to buckets when they're auto provisioned, keyed by nitric bucket name
+	StorageLifecycle map[string][]*storage.LifecycleRule
+
+	// Skips events and queue tasks that have already been processed, disabled if nil
+	Deduplicator dedupe.Deduplicator
+
+	// Transforms the items of bucket notifications before they're handled, disabled if nil
+	BucketTransforms *transform.Config
+
+	// The topic events are published to when workers permanently fail to handle them, unless their subscription names its own.
+	// Left to the provider if empty
+	DeadLetterTopic string
+
+	// Backs off redeliveries of events workers fail to handle, dead-lettering them after too many attempts. Disabled if nil
+	EventRetry *worker.RetryPolicy
+
+	// Publishes events tied to document writes, disabled if nil
+	Outbox *outbox.Outbox
+
+	// Runs durable multi-step workflows, disabled if nil
+	Workflows *workflow.Engine
+
+	// Sends config workers the changes to their configuration keys, disabled if nil
+	ConfigWatcher *config_plugin.Watcher
+
+	// Takes snapshots of document collections and buckets when the backup schedule fires, disabled if nil
+	Backups *backup.Manager
+
+	// Records the state of resumable uploads, created from the document and storage plugins if nil
+	Uploads *uploads.Manager
+
+	// Tracks websocket connections, created from the document plugin if nil
+	Connections *connections.Registry
+
+	// Delivers messages to websocket connections through the provider's gateway, sending is unavailable if nil
+	ConnectionSender connections.Sender
+
+	// Stores time series collections and deletes their expired buckets, disabled if nil
+	TimeSeries *timeseries.Store
+
+	// Copies collections and buckets from another provider on start, disabled if nil
+	Migrator *migrate.Migrator
+
+	// Serves the document and storage APIs for other membranes to migrate from on this address, disabled if empty
+	MigrateServeAddress string
+	// The certificate migrations are served over, required with MigrateServeAddress
+	MigrateServeTLS *tls.Config
+	// The token migrating membranes authenticate with, required to migrate or serve migrations
+	MigrateToken string
+
+	// Sends outbound http requests for the child process, configured by the EGRESS env vars if nil
+	Egress *egress.Client
+
+	// Finds the provider's deployments of the stack's other services, after their NITRIC_SERVICE_{NAME}_URL env vars
+	InvokeResolver invoke.Resolver
+	// Calls the stack's other services for the child process, configured by the INVOKE env vars if nil
+	Invoker *invoke.Invoker
+
+	// The keys document fields declared in DOCUMENT_ENCRYPTED_FIELDS are encrypted with,
+	// a key from the secret plugin named by DOCUMENT_ENCRYPTION_SECRET if nil
+	EncryptionKeyring encryption.Keyring
+
+	// Schemas that published event and queue task payloads must conform to, disabled if nil
+	Schemas *schema.Registry
+
+	// Schemas that the bodies, query parameters and headers of requests to routes must conform to, disabled if nil
+	RouteSchemas *schema.RouteSchemas
+
+	// The collection the key-value API stores values in
+	KeyValueCollection string
+
+	// Namespaces resources by the tenant of each runtime API call, disabled if nil
+	Tenancy *tenancy.Tenancy
+
+	// Versions of the API served by the child process, disabled if nil
+	ApiVersions *versioning.Config
+
+	// Shadows and canary routes http requests, disabled if nil
+	Traffic *traffic.Config
+
+	// Size and time limits applied to http requests, by route. Unlimited if nil
+	HttpLimits *limits.Config
+
+	// Verifies the signatures of webhooks to http routes before they trigger the child process, WEBHOOK_ROUTES if nil
+	Webhooks *webhook.Verifier
+
+	// Records the triggers handled by workers so they can be replayed, disabled if nil
+	CaptureStore capture.Store
+	// The address to serve the capture replay API on, disabled if empty
+	CaptureAddress string
+	// IDs of captures to replay once workers are available, or "all" to replay every capture
+	CaptureReplay []string
+
+	// The address to serve a description of the membrane's routes, subscriptions, schedules and runtime APIs on, disabled if empty
+	ExplorerAddress string
+	// Registers the gRPC reflection service, so tools like grpcurl can list and call the runtime APIs
+	GrpcReflection bool
+
+	// The directory of the child process's code, the child processes are restarted whenever its files change. Disabled if empty
+	DevWatchDir string
+
+	// Serves static assets ahead of the child process, disabled if nil
+	Static *static.Server
+
+	// A file of KEY=VALUE settings that override the http request limits and static asset caching env vars,
+	// and are reloaded while the membrane is running. Disabled if empty
+	ConfigFile string
+	// How often the config file is checked for changes, CONFIG_RELOAD_INTERVAL if zero
+	ConfigReloadInterval time.Duration
+
+	// Compresses http responses of at least this many bytes and decompresses compressed request bodies, disabled if zero
+	HttpCompressionMinSize int
+	// Compresses trigger data of at least this many bytes sent to FaaS workers that accept a compressed encoding, disabled if zero
+	FaasCompressionMinSize int
+
+	// The address to serve worker utilization metrics on, disabled if empty
+	MetricsAddress string
+	// Publishes worker utilization to the platform's autoscaler, disabled if nil
+	UtilizationPublisher utilization.Publisher
+	// Queues whose backlog is served with the metrics, METRICS_QUEUES if nil
+	MetricsQueues []string
+	// The address to serve the KEDA external scaler on, disabled if empty
+	ScalerAddress string
+
+	// Counts runtime API usage per calling function, trigger and tenant, disabled if nil
+	UsageMeter *usage.Meter
+	// Appends metered usage to a collection, disabled if nil
+	UsageLedger *usage.Ledger
+	// Prices of the provider's plugin operations, metered usage is served and recorded with its estimated cost if set
+	UsagePrices usage.PriceTable
+
+	// Removes sensitive values from the membrane's logs, the child process's output and runtime API errors,
+	// LOG_REDACT if nil
+	Redactor *redact.Redactor
 
 	SuppressLogs            bool
 	TolerateMissingServices bool
@@ -75,11 +314,32 @@ type Membrane struct {
 	// The URL (including protocol, the child process can be reached on)
 	childUrl string
 
-	// The command that will be used to invoke the child process
-	childCommand []string
+	// The child processes, each run within its resource limits. nil if there's no child command
+	childProcesses *sandbox.Group
+	// Secrets injected into the child processes, refreshed every childSecretsRefresh if it's not zero
+	childSecrets        *secretenv.Injector
+	childSecretsRefresh time.Duration
+	childSecretsStop    chan struct{}
+	// The addresses each child process listens on in HTTP proxy mode, by index
+	childAddresses []string
+	// Configuration reloads and rolling restarts of the child processes are requested with SIGHUP
+	hangupSignal chan os.Signal
 
 	childTimeoutSeconds int
 
+	// The stack and environment triggers are handled in
+	identity *stack.Identity
+	// Trigger data of at least this many bytes is compressed for FaaS workers, disabled if zero
+	faasCompressionMinSize int
+
+	// The resources declared by the child process, whether the membrane fails if they aren't valid,
+	// and whether they're created if they don't exist
+	resources            *resources.Registry
+	validateDeclared     bool
+	failInvalidResources bool
+	permissionCheck      string
+	autoProvision        bool
+
 	// Configured plugins
 	documentPlugin document.DocumentService
 	eventsPlugin   events.EventService
@@ -87,6 +347,76 @@ type Membrane struct {
 	gatewayPlugin  gateway.GatewayService
 	queuePlugin    queue.QueueService
 	secretPlugin   secret.SecretService
+	sqlPlugin      sql.SqlService
+	searchPlugin   search.SearchService
+	batchPlugin    batch.BatchService
+	cdnPlugin      cdn.CdnService
+	flagsPlugin    flags.FlagsService
+	configPlugin   config_plugin.ConfigService
+	identityPlugin identity.IdentityService
+
+	storageCompression *storage.CompressionConfig
+	storageLifecycle   map[string][]*storage.LifecycleRule
+
+	deduplicator dedupe.Deduplicator
+
+	outbox *outbox.Outbox
+
+	configWatcher *config_plugin.Watcher
+
+	workflows  *workflow.Engine
+	backups    *backup.Manager
+	uploads    *uploads.Manager
+	conns      *connections.Registry
+	timeSeries *timeseries.Store
+	migrator   *migrate.Migrator
+	egress     *egress.Client
+	invoker    *invoke.Invoker
+
+	migrateServeAddress string
+	migrateServeTLS     *tls.Config
+	migrateToken        string
+	migrateServer       *grpc.Server
+
+	schemas *schema.Registry
+
+	tenancy *tenancy.Tenancy
+
+	kvCollection string
+
+	metricsServer       *http.Server
+	utilizationReporter *utilization.Reporter
+	shedMonitor         *shedding.Monitor
+
+	scalerAddress string
+	scalerServer  *grpc.Server
+
+	usageMeter  *usage.Meter
+	usageLedger *usage.Ledger
+
+	// Redacts sensitive values from runtime API errors, disabled if nil
+	redactor *redact.Redactor
+
+	captureStore  capture.Store
+	capturePool   *worker.CapturePool
+	captureServer *http.Server
+
+	explorerAddress string
+	explorerServer  *http.Server
+	grpcReflection  bool
+	captureReplay   []string
+
+	// Restarts the child processes when their code changes, holding triggers in the hold pool until they're ready again
+	devWatcher   *watch.Watcher
+	devWatchStop chan struct{}
+	holdPool     *worker.HoldPool
+
+	// Reloadable configuration, applied to the limit pool and static server
+	configFile           string
+	configReloadInterval time.Duration
+	configWatchStop      chan struct{}
+	limitPool            *worker.LimitPool
+	staticServer         *static.Server
 
 	// Tolerate if provider specific plugins aren't available for some services.
 	// Not this does not include the gateway service
@@ -111,17 +441,71 @@ func (s *Membrane) log(msg string) {
 }
 
 func (s *Membrane) createSecretServer() v1.SecretServiceServer {
-	return grpc2.NewSecretServer(s.secretPlugin)
+	return grpc2.NewSecretServer(s.secretPlugin, grpc2.WithSecretTenancy(s.tenancy))
+}
+
+func (s *Membrane) createSqlServer() v1.SqlServiceServer {
+	return grpc2.NewSqlServer(s.sqlPlugin, grpc2.WithSqlTenancy(s.tenancy))
+}
+
+func (s *Membrane) createSearchServer() v1.SearchServiceServer {
+	return grpc2.NewSearchServer(s.searchPlugin, grpc2.WithSearchTenancy(s.tenancy))
+}
+
+func (s *Membrane) createBatchServer() v1.BatchServiceServer {
+	return grpc2.NewBatchServer(s.batchPlugin)
+}
+
+func (s *Membrane) createCdnServer() v1.CdnServiceServer {
+	return grpc2.NewCdnServer(s.cdnPlugin, grpc2.WithCdnTenancy(s.tenancy))
+}
+
+func (s *Membrane) createFlagsServer() v1.FlagsServiceServer {
+	return grpc2.NewFlagsServer(s.flagsPlugin)
+}
+
+func (s *Membrane) createConfigServer() v1.ConfigServiceServer {
+	return grpc2.NewConfigServer(s.configPlugin)
+}
+
+func (s *Membrane) createIdentityServer() v1.IdentityServiceServer {
+	return grpc2.NewIdentityServer(s.identityPlugin)
 }
 
 // Create a new Nitric Document Server
 func (s *Membrane) createDocumentServer() v1.DocumentServiceServer {
-	return grpc2.NewDocumentServer(s.documentPlugin)
+	opts := make([]grpc2.DocumentServiceServerOption, 0)
+	if s.outbox != nil {
+		opts = append(opts, grpc2.WithOutbox(s.outbox))
+	}
+	if s.tenancy != nil {
+		opts = append(opts, grpc2.WithDocumentTenancy(s.tenancy))
+	}
+
+	return grpc2.NewDocumentServer(s.documentPlugin, opts...)
+}
+
+// Create a new Nitric Key-Value Server, backed by the document plugin
+func (s *Membrane) createKeyValueServer() v1.KeyValueServiceServer {
+	opts := []grpc2.KeyValueServiceServerOption{grpc2.WithKeyValueCollection(s.kvCollection)}
+	if s.tenancy != nil {
+		opts = append(opts, grpc2.WithKeyValueTenancy(s.tenancy))
+	}
+
+	return grpc2.NewKeyValueServer(s.documentPlugin, opts...)
 }
 
 // Create a new Nitric events Server
 func (s *Membrane) createEventsServer() v1.EventServiceServer {
-	return grpc2.NewEventServiceServer(s.eventsPlugin)
+	opts := make([]grpc2.EventServiceServerOption, 0)
+	if s.schemas != nil {
+		opts = append(opts, grpc2.WithTopicSchemas(s.schemas))
+	}
+	if s.tenancy != nil {
+		opts = append(opts, grpc2.WithEventTenancy(s.tenancy))
+	}
+
+	return grpc2.NewEventServiceServer(s.eventsPlugin, opts...)
 }
 
 // Create a new Nitric Topic Server
@@ -131,11 +515,29 @@ func (s *Membrane) createTopicServer() v1.TopicServiceServer {
 
 // Create a new Nitric Storage Server
 func (s *Membrane) createStorageServer() v1.StorageServiceServer {
-	return grpc2.NewStorageServiceServer(s.storagePlugin)
+	opts := []grpc2.StorageServiceServerOption{grpc2.WithCompression(s.storageCompression), grpc2.WithStorageTenancy(s.tenancy)}
+	if s.uploads != nil {
+		opts = append(opts, grpc2.WithUploads(s.uploads))
+	}
+
+	return grpc2.NewStorageServiceServer(s.storagePlugin, opts...)
 }
 
 func (s *Membrane) createQueueServer() v1.QueueServiceServer {
-	return grpc2.NewQueueServiceServer(s.queuePlugin)
+	opts := make([]grpc2.QueueServiceServerOption, 0)
+	if s.deduplicator != nil {
+		opts = append(opts, grpc2.WithDeduplicator(s.deduplicator))
+	}
+
+	if s.schemas != nil {
+		opts = append(opts, grpc2.WithQueueSchemas(s.schemas))
+	}
+
+	if s.tenancy != nil {
+		opts = append(opts, grpc2.WithQueueTenancy(s.tenancy))
+	}
+
+	return grpc2.NewQueueServiceServer(s.queuePlugin, opts...)
 }
 
 func (s *Membrane) startChildProcess() error {
@@ -143,220 +545,2138 @@ func (s *Membrane) startChildProcess() error {
 	// so it will continue to run until even after the membrane dies
 
 	log.Default().Println("Starting Child Process")
-	childProcess := exec.Command(s.childCommand[0], s.childCommand[1:]...)
-	childProcess.Stdout = os.Stdout
-	childProcess.Stderr = os.Stderr
-	applicationError := childProcess.Start()
 
 	// Actual panic here, we don't want to start if our userland code cannot successfully start
-	if applicationError != nil {
-		return fmt.Errorf("there was an error starting the child process: %w", applicationError)
-	}
+	return s.childProcesses.Start()
+}
 
-	return nil
+// RestartChildProcesses - restarts the child processes one at a time, waiting for each to be ready to handle triggers again before restarting the next
+func (s *Membrane) RestartChildProcesses() error {
+	workers := s.pool.GetWorkerCount()
+
+	return s.restartChildProcesses(func(waitUntil time.Time) error {
+		// Restarted processes register their workers again once they're ready
+		for s.pool.GetWorkerCount() < workers {
+			if time.Now().After(waitUntil) {
+				return fmt.Errorf("timed out waiting for workers to register, %d of %d available", s.pool.GetWorkerCount(), workers)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		return nil
+	})
 }
 
-// Start the membrane
-func (s *Membrane) Start() error {
-	// Search for known plugins
+// restartChildProcesses - restarts the child processes one at a time, in FaaS mode registered is called to wait for each
+// restarted process's workers to register before restarting the next
+func (s *Membrane) restartChildProcesses(registered func(waitUntil time.Time) error) error {
+	if s.childProcesses == nil {
+		return fmt.Errorf("no child command specified, there are no child processes to restart")
+	}
 
-	var opts []grpc.ServerOption
-	s.grpcServer = grpc.NewServer(opts...)
+	timeout := time.Duration(s.childTimeoutSeconds) * time.Second
 
-	// Load & Register the GRPC service plugins
-	documentServer := s.createDocumentServer()
-	v1.RegisterDocumentServiceServer(s.grpcServer, documentServer)
+	return s.childProcesses.Restart(timeout, func(index int) error {
+		if s.mode == Mode_HttpProxy {
+			_, err := worker.NewHttpWorker(s.childAddresses[index], s.identity)
+			return err
+		}
 
-	eventsServer := s.createEventsServer()
-	v1.RegisterEventServiceServer(s.grpcServer, eventsServer)
+		return registered(time.Now().Add(timeout))
+	})
+}
 
-	topicServer := s.createTopicServer()
-	v1.RegisterTopicServiceServer(s.grpcServer, topicServer)
+// handleHangupSignal - reloads the config file, then performs a rolling restart of the child processes whenever the membrane receives SIGHUP
+func (s *Membrane) handleHangupSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	s.hangupSignal = signals
 
-	storageServer := s.createStorageServer()
-	v1.RegisterStorageServiceServer(s.grpcServer, storageServer)
+	go func() {
+		for range signals {
+			if s.configFile != "" {
+				s.reloadConfig()
+			}
 
-	queueServer := s.createQueueServer()
-	v1.RegisterQueueServiceServer(s.grpcServer, queueServer)
+			if s.childProcesses == nil {
+				continue
+			}
 
-	secretServer := s.createSecretServer()
-	v1.RegisterSecretServiceServer(s.grpcServer, secretServer)
+			s.log("Rolling restart of child processes requested")
+			if err := s.RestartChildProcesses(); err != nil {
+				s.log(fmt.Sprintf("Rolling restart failed: %v", err))
+				continue
+			}
+			s.log("Rolling restart of child processes complete")
+		}
+	}()
+}
 
-	// TODO: Implement based on resource resolution plugins
-	v1.RegisterResourceServiceServer(s.grpcServer, &grpc2.ResourcesServiceServer{})
+// childAddresses - returns the address each child process listens on in HTTP proxy mode.
+// A single child process listens on the child address, otherwise each listens on the next port after the previous process's.
+func childAddresses(address string, count int) ([]string, error) {
+	if count == 1 {
+		return []string{address}, nil
+	}
 
-	// FaaS server MUST start before the child process
-	if s.mode == Mode_Faas {
-		faasServer := grpc2.NewFaasServer(s.pool)
-		v1.RegisterFaasServiceServer(s.grpcServer, faasServer)
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid child address %s: %w", address, err)
 	}
-	lis, err := net.Listen("tcp", s.serviceAddress)
+
+	port, err := strconv.Atoi(portStr)
 	if err != nil {
-		return fmt.Errorf("could not listen on configured service address: %w", err)
+		return nil, fmt.Errorf("invalid child address %s, expected a numeric port", address)
 	}
 
-	s.log("Registered Gateway Plugin")
+	addresses := make([]string, count)
+	for i := range addresses {
+		addresses[i] = net.JoinHostPort(host, strconv.Itoa(port+i))
+	}
 
-	// Start the gRPC server
-	go (func() {
-		s.log(fmt.Sprintf("Services listening on: %s", s.serviceAddress))
-		err := s.grpcServer.Serve(lis)
-		if err != nil {
-			s.log(fmt.Sprintf("grpc serve %v", err))
-		}
-	})()
+	return addresses, nil
+}
 
-	// Start our child process
-	// This will block until our child process is ready to accept incoming connections
-	if len(s.childCommand) > 0 {
-		if err := s.startChildProcess(); err != nil {
-			// Return the error
-			return err
-		}
-	} else {
-		s.log("No Child Command Specified, Skipping...")
+// httpLimitsFrom - returns the http request limits configured by HTTP_MAX_BODY_SIZE, HTTP_TIMEOUT and HTTP_ROUTE_LIMITS, nil if none are set
+func httpLimitsFrom(get configGetter) (*limits.Config, error) {
+	maxBodySizeEnv := get("HTTP_MAX_BODY_SIZE", "")
+	timeoutEnv := get("HTTP_TIMEOUT", "")
+	routesEnv := get("HTTP_ROUTE_LIMITS", "")
+
+	if maxBodySizeEnv == "" && timeoutEnv == "" && routesEnv == "" {
+		return nil, nil
 	}
 
-	// If we aren't in FaaS mode
-	// We need to manually register our worker for now
-	if s.mode != Mode_Faas {
-		var wrkr worker.Worker
-		var workerErr error
-		if s.mode == Mode_HttpProxy {
-			wrkr, workerErr = worker.NewHttpWorker(s.childAddress)
+	config := &limits.Config{}
+
+	if maxBodySizeEnv != "" {
+		maxBodySize, err := limits.ParseSize(maxBodySizeEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP_MAX_BODY_SIZE env var: %v", err)
 		}
+		config.Default.MaxBodySize = maxBodySize
+	}
 
-		if workerErr == nil {
-			if err := s.pool.AddWorker(wrkr); err != nil {
-				return err
-			}
-		} else {
-			return workerErr
+	if timeoutEnv != "" {
+		timeout, err := time.ParseDuration(timeoutEnv)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid HTTP_TIMEOUT env var, expected duration, got %v", timeoutEnv)
 		}
+		config.Default.Timeout = timeout
 	}
 
-	// Wait for the minimum number of active workers to be available before beginning the gateway
-	// This ensures workers have registered and can handle triggers as soon the gateway is ready, if a minimum > 1 has been set
-	s.log("Waiting for active workers")
-	err = s.pool.WaitForMinimumWorkers(s.childTimeoutSeconds)
+	routes, err := limits.ParseRoutes(routesEnv)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("invalid HTTP_ROUTE_LIMITS env var: %v", err)
 	}
+	config.Routes = routes
 
-	gatewayErrchan := make(chan error)
-	poolErrchan := make(chan error)
+	return config, nil
+}
 
-	// Start the gateway
-	go func(errch chan error) {
-		s.log(fmt.Sprintf("Starting Gateway, %d workers currently available", s.pool.GetWorkerCount()))
-		errch <- s.gatewayPlugin.Start(s.pool)
-	}(gatewayErrchan)
+// egressClientFromEnv - returns a client for outbound requests, configured by the EGRESS env vars
+func egressClientFromEnv() (*egress.Client, error) {
+	config := egress.DefaultConfig
 
-	// Start the worker pool monitor
-	go func(errch chan error) {
-		s.log("Starting Worker Supervisor")
-		errch <- s.pool.Monitor()
-	}(poolErrchan)
+	attemptsEnv := utils.GetEnv("EGRESS_MAX_ATTEMPTS", strconv.Itoa(config.MaxAttempts))
+	attempts, err := strconv.Atoi(attemptsEnv)
+	if err != nil || attempts < 1 {
+		return nil, fmt.Errorf("invalid EGRESS_MAX_ATTEMPTS env var, expected positive integer, got %v", attemptsEnv)
+	}
+	config.MaxAttempts = attempts
 
-	var exitErr error
+	timeoutEnv := utils.GetEnv("EGRESS_TIMEOUT", config.Timeout.String())
+	if config.Timeout, err = time.ParseDuration(timeoutEnv); err != nil || config.Timeout < 0 {
+		return nil, fmt.Errorf("invalid EGRESS_TIMEOUT env var, expected duration e.g. 30s, got %v", timeoutEnv)
+	}
 
-	// Wait and fail on either
-	select {
-	case gatewayErr := <-gatewayErrchan:
-		if err == nil {
-			// Normal Gateway shutdown
-			// Allowing the membrane to exit
-			return nil
+	thresholdEnv := utils.GetEnv("EGRESS_BREAKER_THRESHOLD", strconv.Itoa(config.FailureThreshold))
+	if config.FailureThreshold, err = strconv.Atoi(thresholdEnv); err != nil || config.FailureThreshold < 0 {
+		return nil, fmt.Errorf("invalid EGRESS_BREAKER_THRESHOLD env var, expected non-negative integer, got %v", thresholdEnv)
+	}
+
+	openEnv := utils.GetEnv("EGRESS_BREAKER_OPEN", config.OpenDuration.String())
+	if config.OpenDuration, err = time.ParseDuration(openEnv); err != nil || config.OpenDuration < 0 {
+		return nil, fmt.Errorf("invalid EGRESS_BREAKER_OPEN env var, expected duration e.g. 30s, got %v", openEnv)
+	}
+
+	for _, host := range strings.Split(utils.GetEnv("EGRESS_ALLOWED_HOSTS", ""), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			config.AllowedHosts = append(config.AllowedHosts, host)
 		}
-		exitErr = fmt.Errorf(fmt.Sprintf("Gateway Error: %v, exiting", gatewayErr))
-	case poolErr := <-poolErrchan:
-		exitErr = fmt.Errorf(fmt.Sprintf("Supervisor error: %v, exiting", poolErr))
 	}
 
-	return exitErr
+	return egress.New(config), nil
 }
 
-func (s *Membrane) Stop() {
-	_ = s.gatewayPlugin.Stop()
-	s.grpcServer.Stop()
-}
+// staticServerFromEnv - returns a server for the static assets configured by STATIC_DIR or STATIC_BUCKET, nil if neither is set
+func staticServerFromEnv(storagePlugin storage.StorageService, get configGetter) (*static.Server, error) {
+	dir := utils.GetEnv("STATIC_DIR", "")
+	bucket := utils.GetEnv("STATIC_BUCKET", "")
+
+	var source static.Source
+	var err error
+	switch {
+	case dir != "" && bucket != "":
+		return nil, fmt.Errorf("only one of the STATIC_DIR and STATIC_BUCKET env vars can be set")
+	case dir != "":
+		if source, err = static.NewDirSource(dir); err != nil {
+			return nil, fmt.Errorf("invalid STATIC_DIR env var: %v", err)
+		}
+	case bucket != "":
+		prefix := ""
+		if parts := strings.SplitN(bucket, "/", 2); len(parts) == 2 {
+			bucket, prefix = parts[0], parts[1]
+		}
 
-// Create a new Membrane server
-func New(options *MembraneOptions) (*Membrane, error) {
-	// Get unset options from env or defaults
-	if options.ServiceAddress == "" {
-		options.ServiceAddress = utils.GetEnv("SERVICE_ADDRESS", "127.0.0.1:50051")
+		if source, err = static.NewBucketSource(storagePlugin, bucket, prefix); err != nil {
+			return nil, fmt.Errorf("invalid STATIC_BUCKET env var: %v", err)
+		}
+	default:
+		return nil, nil
 	}
 
-	if options.ChildAddress == "" {
-		options.ChildAddress = utils.GetEnv("CHILD_ADDRESS", "127.0.0.1:8080")
+	spaEnv := utils.GetEnv("STATIC_SPA", "false")
+	spa, err := strconv.ParseBool(spaEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid STATIC_SPA env var, expected boolean value, got %v", spaEnv)
 	}
 
-	if !options.TolerateMissingServices {
-		tolerateMissing, err := strconv.ParseBool(utils.GetEnv("TOLERATE_MISSING_SERVICES", "false"))
-		if err != nil {
-			return nil, err
+	return static.NewServer(source, &static.ServerOptions{
+		Prefix:       utils.GetEnv("STATIC_PATH", "/"),
+		Spa:          spa,
+		CacheControl: get("STATIC_CACHE_CONTROL", static.DefaultCacheControl),
+	}), nil
+}
+
+// replayCaptures - replays the configured captures in the order they were captured, logging their results
+func (s *Membrane) replayCaptures() {
+	var captures []*capture.Capture
+	if len(s.captureReplay) == 1 && s.captureReplay[0] == "all" {
+		var err error
+		if captures, err = s.captureStore.List(); err != nil {
+			s.log(fmt.Sprintf("error listing captures to replay: %v", err))
+			return
+		}
+	} else {
+		for _, id := range s.captureReplay {
+			c, err := s.captureStore.Get(id)
+			if err != nil {
+				s.log(fmt.Sprintf("error reading capture %s to replay: %v", id, err))
+				continue
+			}
+			captures = append(captures, c)
 		}
-		options.TolerateMissingServices = tolerateMissing
 	}
 
-	if options.Mode == nil {
-		mode, err := ModeFromString(utils.GetEnv("MEMBRANE_MODE", "FAAS"))
+	for _, c := range captures {
+		resp, err := s.capturePool.Replay(c)
 		if err != nil {
-			return nil, err
+			s.log(fmt.Sprintf("replayed capture %s: %v", c.ID, err))
+		} else if resp != nil {
+			s.log(fmt.Sprintf("replayed capture %s: status %d", c.ID, resp.StatusCode))
+		} else {
+			s.log(fmt.Sprintf("replayed capture %s: success", c.ID))
 		}
-		options.Mode = &mode
 	}
+}
 
-	if options.ChildTimeoutSeconds < 1 {
-		options.ChildTimeoutSeconds = 10
+// resourcePlugins - the plugins serving the resources the child process can declare
+func (s *Membrane) resourcePlugins() resources.Plugins {
+	return resources.Plugins{
+		Storage:  s.storagePlugin,
+		Events:   s.eventsPlugin,
+		Queue:    s.queuePlugin,
+		Document: s.documentPlugin,
+		Secret:   s.secretPlugin,
 	}
+}
 
-	if options.GatewayPlugin == nil {
-		return nil, errors.New("missing gateway plugin, Gateway plugin must not be nil")
+// validateResources - checks the resources declared by the child process exist,
+// returning the report of those that don't if the membrane fails on invalid resources
+func (s *Membrane) validateResources() error {
+	validator := &resources.Validator{Plugins: s.resourcePlugins()}
+
+	s.log(fmt.Sprintf("Validating %d declared resources", len(s.resources.Resources())))
+	err := validator.Validate(s.resources)
+	if err == nil {
+		return nil
 	}
 
-	if !options.TolerateMissingServices {
-		if options.EventsPlugin == nil || options.StoragePlugin == nil || options.DocumentPlugin == nil || options.QueuePlugin == nil {
-			return nil, errors.New("missing membrane plugins, if you meant to load with missing plugins set options.TolerateMissingServices to true")
-		}
+	if s.failInvalidResources {
+		return err
 	}
+	s.log(err.Error())
 
-	if options.Pool == nil {
-		// Create new pool with defaults
-		minWorkersEnv := utils.GetEnv("MIN_WORKERS", "1")
-		minWorkers, err := strconv.Atoi(minWorkersEnv)
-		if err != nil || minWorkers < 0 {
-			return nil, fmt.Errorf("invalid MIN_WORKERS env var, expected non-negative integer value, got %v", minWorkersEnv)
-		}
+	return nil
+}
 
-		maxWorkersEnv := utils.GetEnv("MAX_WORKERS", "100")
-		maxWorkers, err := strconv.Atoi(maxWorkersEnv)
-		if err != nil || minWorkers < 0 {
-			return nil, fmt.Errorf("invalid MAX_WORKERS env var, expected non-negative integer value, got %v", maxWorkersEnv)
-		}
+// checkPermissions - simulates the permissions needed by the policies declared by the child process,
+// logging the report and returning it as an error if any are missing and the membrane fails on them
+func (s *Membrane) checkPermissions() error {
+	validator := &resources.Validator{Plugins: s.resourcePlugins()}
 
-		options.Pool = worker.NewProcessPool(&worker.ProcessPoolOptions{
-			MinWorkers: minWorkers,
-			MaxWorkers: maxWorkers,
-		})
+	s.log("Checking permissions of declared policies")
+	report := validator.CheckPermissions(s.resources)
+	if report.Missing() > 0 && s.permissionCheck == "fail" {
+		return fmt.Errorf("%s", report)
 	}
+	s.log(report.String())
 
-	return &Membrane{
-		serviceAddress:          options.ServiceAddress,
-		childAddress:            options.ChildAddress,
-		childUrl:                fmt.Sprintf("http://%s", options.ChildAddress),
-		childCommand:            options.ChildCommand,
-		childTimeoutSeconds:     options.ChildTimeoutSeconds,
-		documentPlugin:          options.DocumentPlugin,
-		eventsPlugin:            options.EventsPlugin,
+	return nil
+}
+
+// Start the membrane
+func (s *Membrane) Start() error {
+	// Search for known plugins
+
+	var opts []grpc.ServerOption
+	var runtimeOpts []grpc2.RuntimeServerOption
+	// Errors are redacted last, after every other interceptor has seen them
+	if s.redactor != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(s.redactor.UnaryServerInterceptor()), grpc.ChainStreamInterceptor(s.redactor.StreamServerInterceptor()))
+		runtimeOpts = append(runtimeOpts, grpc2.WithRuntimeInterceptor(s.redactor.UnaryServerInterceptor()))
+	}
+	if s.usageMeter != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(s.usageMeter.UnaryServerInterceptor()), grpc.ChainStreamInterceptor(s.usageMeter.StreamServerInterceptor()))
+		runtimeOpts = append(runtimeOpts, grpc2.WithRuntimeInterceptor(s.usageMeter.UnaryServerInterceptor()))
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	if s.grpcReflection {
+		reflection.Register(s.grpcServer)
+	}
+
+	// Load & Register the GRPC service plugins
+	// Services are also registered with the runtime server, so FaaS workers can call them over their trigger streams
+	runtimeServer := grpc2.NewRuntimeServer(s.grpcServer, runtimeOpts...)
+
+	documentServer := s.createDocumentServer()
+	v1.RegisterDocumentServiceServer(runtimeServer, documentServer)
+
+	eventsServer := s.createEventsServer()
+	v1.RegisterEventServiceServer(runtimeServer, eventsServer)
+
+	topicServer := s.createTopicServer()
+	v1.RegisterTopicServiceServer(runtimeServer, topicServer)
+
+	storageServer := s.createStorageServer()
+	v1.RegisterStorageServiceServer(runtimeServer, storageServer)
+
+	queueServer := s.createQueueServer()
+	v1.RegisterQueueServiceServer(runtimeServer, queueServer)
+
+	secretServer := s.createSecretServer()
+	v1.RegisterSecretServiceServer(runtimeServer, secretServer)
+
+	sqlServer := s.createSqlServer()
+	v1.RegisterSqlServiceServer(runtimeServer, sqlServer)
+
+	searchServer := s.createSearchServer()
+	v1.RegisterSearchServiceServer(runtimeServer, searchServer)
+
+	batchServer := s.createBatchServer()
+	v1.RegisterBatchServiceServer(runtimeServer, batchServer)
+
+	cdnServer := s.createCdnServer()
+	v1.RegisterCdnServiceServer(runtimeServer, cdnServer)
+
+	flagsServer := s.createFlagsServer()
+	v1.RegisterFlagsServiceServer(runtimeServer, flagsServer)
+
+	configServer := s.createConfigServer()
+	v1.RegisterConfigServiceServer(runtimeServer, configServer)
+
+	identityServer := s.createIdentityServer()
+	v1.RegisterIdentityServiceServer(runtimeServer, identityServer)
+
+	kvServer := s.createKeyValueServer()
+	v1.RegisterKeyValueServiceServer(runtimeServer, kvServer)
+
+	v1.RegisterWorkflowServiceServer(runtimeServer, grpc2.NewWorkflowServer(s.workflows, grpc2.WithWorkflowTenancy(s.tenancy)))
+
+	v1.RegisterWebsocketServiceServer(runtimeServer, grpc2.NewWebsocketServer(s.conns, grpc2.WithWebsocketTenancy(s.tenancy)))
+
+	v1.RegisterBackupServiceServer(runtimeServer, grpc2.NewBackupServer(s.backups))
+
+	v1.RegisterTimeSeriesServiceServer(runtimeServer, grpc2.NewTimeSeriesServer(s.timeSeries, grpc2.WithTimeSeriesTenancy(s.tenancy)))
+
+	v1.RegisterHttpServiceServer(runtimeServer, grpc2.NewHttpServer(s.egress))
+
+	v1.RegisterInvokeServiceServer(runtimeServer, grpc2.NewInvokeServer(s.invoker))
+
+	// TODO: Implement based on resource resolution plugins
+	resourceOpts := []grpc2.ResourcesServiceServerOption{grpc2.WithResourceRegistry(s.resources)}
+	if s.autoProvision {
+		s.log("Auto provisioning declared resources")
+		resourceOpts = append(resourceOpts, grpc2.WithResourceProvisioner(&resources.AutoProvisioner{
+			Plugins:         s.resourcePlugins(),
+			Identity:        s.identity,
+			BucketLifecycle: s.storageLifecycle,
+		}))
+	}
+	v1.RegisterResourceServiceServer(runtimeServer, grpc2.NewResourcesServiceServer(resourceOpts...))
+
+	// FaaS server MUST start before the child process
+	if s.mode == Mode_Faas {
+		faasOpts := []grpc2.FaasServerOption{grpc2.WithRuntime(runtimeServer), grpc2.WithStack(s.identity)}
+		if s.faasCompressionMinSize > 0 {
+			faasOpts = append(faasOpts, grpc2.WithPayloadCompression(s.faasCompressionMinSize))
+		}
+		faasServer := grpc2.NewFaasServer(s.pool, faasOpts...)
+		v1.RegisterFaasServiceServer(s.grpcServer, faasServer)
+	}
+	if s.outbox != nil {
+		s.log("Starting Outbox Relay")
+		go s.outbox.Start()
+	}
+
+	if s.workflows != nil {
+		s.log("Starting Workflow Engine")
+		go s.workflows.Run()
+	}
+
+	if s.configWatcher != nil {
+		go s.configWatcher.Start()
+	}
+
+	if s.timeSeries != nil {
+		s.log("Starting Time Series Expiry")
+		go s.timeSeries.Start()
+	}
+
+	if s.migrator != nil {
+		go func() {
+			if err := s.migrator.Run(); err != nil {
+				s.log(fmt.Sprintf("migration stopped, it will resume when the membrane restarts: %v", err))
+			}
+		}()
+	}
+
+	if s.metricsServer != nil {
+		go (func() {
+			s.log(fmt.Sprintf("Metrics listening on: %s", s.metricsServer.Addr))
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.log(fmt.Sprintf("metrics serve %v", err))
+			}
+		})()
+	}
+
+	if s.utilizationReporter != nil {
+		go s.utilizationReporter.Start()
+	}
+
+	if s.shedMonitor != nil {
+		go s.shedMonitor.Start()
+	}
+
+	if s.usageLedger != nil {
+		go s.usageLedger.Start()
+	}
+
+	if s.captureServer != nil {
+		go (func() {
+			s.log(fmt.Sprintf("Capture replay API listening on: %s", s.captureServer.Addr))
+			if err := s.captureServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.log(fmt.Sprintf("capture serve %v", err))
+			}
+		})()
+	}
+
+	if s.explorerAddress != "" {
+		s.explorerServer = &http.Server{
+			Addr:    s.explorerAddress,
+			Handler: explorer.Handler(s.pool, s.grpcServer),
+		}
+
+		go (func() {
+			s.log(fmt.Sprintf("API explorer listening on: http://%s", s.explorerServer.Addr))
+			if err := s.explorerServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.log(fmt.Sprintf("explorer serve %v", err))
+			}
+		})()
+	}
+
+	if s.scalerServer != nil {
+		scalerLis, err := net.Listen("tcp", s.scalerAddress)
+		if err != nil {
+			return fmt.Errorf("could not listen on configured scaler address: %w", err)
+		}
+
+		go (func() {
+			s.log(fmt.Sprintf("External scaler listening on: %s", s.scalerAddress))
+			if err := s.scalerServer.Serve(scalerLis); err != nil {
+				s.log(fmt.Sprintf("scaler serve %v", err))
+			}
+		})()
+	}
+
+	if s.migrateServeAddress != "" {
+		var err error
+		s.migrateServer, err = migrate.NewSourceServer(documentServer, storageServer, s.migrateServeTLS, s.migrateToken)
+		if err != nil {
+			return err
+		}
+
+		migrateLis, err := net.Listen("tcp", s.migrateServeAddress)
+		if err != nil {
+			return fmt.Errorf("could not listen on configured migration address: %w", err)
+		}
+
+		go (func() {
+			s.log(fmt.Sprintf("Migrations served on: %s", s.migrateServeAddress))
+			if err := s.migrateServer.Serve(migrateLis); err != nil {
+				s.log(fmt.Sprintf("migration serve %v", err))
+			}
+		})()
+	}
+
+	lis, err := net.Listen("tcp", s.serviceAddress)
+	if err != nil {
+		return fmt.Errorf("could not listen on configured service address: %w", err)
+	}
+
+	s.log("Registered Gateway Plugin")
+
+	// Start the gRPC server
+	go (func() {
+		s.log(fmt.Sprintf("Services listening on: %s", s.serviceAddress))
+		err := s.grpcServer.Serve(lis)
+		if err != nil {
+			s.log(fmt.Sprintf("grpc serve %v", err))
+		}
+	})()
+
+	// Start our child process
+	// This will block until our child process is ready to accept incoming connections
+	if s.childProcesses != nil {
+		if s.childSecrets != nil {
+			if _, err := s.childSecrets.Resolve(); err != nil {
+				return fmt.Errorf("could not resolve the child process's secrets: %w", err)
+			}
+		}
+
+		if err := s.startChildProcess(); err != nil {
+			// Return the error
+			return err
+		}
+	} else {
+		s.log("No Child Command Specified, Skipping...")
+	}
+
+	if s.childProcesses != nil || s.configFile != "" {
+		s.handleHangupSignal()
+	}
+
+	if s.configFile != "" && s.configReloadInterval > 0 {
+		s.configWatchStop = make(chan struct{})
+		go s.watchConfig(s.configReloadInterval, s.configWatchStop)
+	}
+
+	if s.childProcesses != nil && s.childSecrets != nil && s.childSecretsRefresh > 0 {
+		s.childSecretsStop = make(chan struct{})
+		go s.refreshChildSecrets(s.childSecretsRefresh, s.childSecretsStop)
+	}
+
+	if s.devWatcher != nil {
+		s.log("Watching for changes, child processes will be restarted when files change")
+		s.devWatchStop = make(chan struct{})
+		go s.devWatcher.Run(s.devWatchStop, s.reloadChildProcesses)
+	}
+
+	// If we aren't in FaaS mode
+	// We need to manually register our worker for now
+	if s.mode != Mode_Faas {
+		for _, address := range s.childAddresses {
+			var wrkr worker.Worker
+			var workerErr error
+			if s.mode == Mode_HttpProxy {
+				wrkr, workerErr = worker.NewHttpWorker(address, s.identity)
+			}
+
+			if workerErr == nil {
+				if err := s.pool.AddWorker(wrkr); err != nil {
+					return err
+				}
+			} else {
+				return workerErr
+			}
+		}
+	}
+
+	// Wait for the minimum number of active workers to be available before beginning the gateway
+	// This ensures workers have registered and can handle triggers as soon the gateway is ready, if a minimum > 1 has been set
+	s.log("Waiting for active workers")
+	err = s.pool.WaitForMinimumWorkers(s.childTimeoutSeconds)
+	if err != nil {
+		return err
+	}
+
+	// Resources are declared before workers register, so they're all known by now
+	if s.resources != nil && s.validateDeclared {
+		if err := s.validateResources(); err != nil {
+			return err
+		}
+	}
+
+	if s.resources != nil && s.permissionCheck != "" {
+		if err := s.checkPermissions(); err != nil {
+			return err
+		}
+	}
+
+	if len(s.captureReplay) > 0 {
+		go s.replayCaptures()
+	}
+
+	gatewayErrchan := make(chan error)
+	poolErrchan := make(chan error)
+
+	// Start the gateway
+	go func(errch chan error) {
+		s.log(fmt.Sprintf("Starting Gateway, %d workers currently available", s.pool.GetWorkerCount()))
+		errch <- s.gatewayPlugin.Start(s.pool)
+	}(gatewayErrchan)
+
+	// Start the worker pool monitor
+	go func(errch chan error) {
+		s.log("Starting Worker Supervisor")
+		errch <- s.pool.Monitor()
+	}(poolErrchan)
+
+	var exitErr error
+
+	// Wait and fail on either
+	select {
+	case gatewayErr := <-gatewayErrchan:
+		if err == nil {
+			// Normal Gateway shutdown
+			// Allowing the membrane to exit
+			return nil
+		}
+		exitErr = fmt.Errorf(fmt.Sprintf("Gateway Error: %v, exiting", gatewayErr))
+	case poolErr := <-poolErrchan:
+		exitErr = fmt.Errorf(fmt.Sprintf("Supervisor error: %v, exiting", poolErr))
+	}
+
+	return exitErr
+}
+
+func (s *Membrane) Stop() {
+	_ = s.gatewayPlugin.Stop()
+	s.grpcServer.Stop()
+
+	if s.outbox != nil {
+		s.outbox.Stop()
+	}
+
+	if s.workflows != nil {
+		s.workflows.Stop()
+	}
+
+	if s.configWatcher != nil {
+		s.configWatcher.Stop()
+	}
+
+	if s.timeSeries != nil {
+		s.timeSeries.Stop()
+	}
+
+	if s.metricsServer != nil {
+		_ = s.metricsServer.Close()
+	}
+
+	if s.migrateServer != nil {
+		s.migrateServer.Stop()
+	}
+
+	if s.scalerServer != nil {
+		s.scalerServer.Stop()
+	}
+
+	if s.utilizationReporter != nil {
+		s.utilizationReporter.Stop()
+	}
+
+	if s.shedMonitor != nil {
+		s.shedMonitor.Stop()
+	}
+
+	if s.usageLedger != nil {
+		s.usageLedger.Stop()
+	}
+
+	if s.captureServer != nil {
+		_ = s.captureServer.Close()
+	}
+
+	if s.explorerServer != nil {
+		_ = s.explorerServer.Close()
+	}
+
+	if s.hangupSignal != nil {
+		signal.Stop(s.hangupSignal)
+		close(s.hangupSignal)
+		s.hangupSignal = nil
+	}
+
+	if s.configWatchStop != nil {
+		close(s.configWatchStop)
+		s.configWatchStop = nil
+	}
+
+	if s.childSecretsStop != nil {
+		close(s.childSecretsStop)
+		s.childSecretsStop = nil
+	}
+
+	if s.devWatchStop != nil {
+		close(s.devWatchStop)
+		s.devWatchStop = nil
+	}
+
+	if s.childProcesses != nil {
+		s.childProcesses.Stop()
+	}
+}
+
+// childLimitsFromEnv - reads the resource limits for the child process from the environment
+func childLimitsFromEnv() (*sandbox.Limits, error) {
+	limits := &sandbox.Limits{}
+
+	if cpuEnv := utils.GetEnv("CHILD_CPU_LIMIT", ""); cpuEnv != "" {
+		cpu, err := strconv.ParseFloat(cpuEnv, 64)
+		if err != nil || cpu <= 0 {
+			return nil, fmt.Errorf("invalid CHILD_CPU_LIMIT env var, expected a positive number of cores e.g. 0.5, got %v", cpuEnv)
+		}
+		limits.CPU = cpu
+	}
+
+	if memoryEnv := utils.GetEnv("CHILD_MEMORY_LIMIT", ""); memoryEnv != "" {
+		memory, err := sandbox.ParseMemory(memoryEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHILD_MEMORY_LIMIT env var: %v", err)
+		}
+		limits.MemoryBytes = memory
+	}
+
+	if timeoutEnv := utils.GetEnv("CHILD_INVOCATION_TIMEOUT", ""); timeoutEnv != "" {
+		timeout, err := time.ParseDuration(timeoutEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHILD_INVOCATION_TIMEOUT env var, expected duration e.g. 30s, got %v", timeoutEnv)
+		}
+		limits.InvocationTimeout = timeout
+	}
+
+	return limits, nil
+}
+
+// loadSheddingFromEnv - returns the usage above which load is shed, nil if neither SHED_MEMORY_LIMIT nor SHED_GOROUTINE_LIMIT is set
+func loadSheddingFromEnv() (*shedding.Thresholds, error) {
+	thresholds := &shedding.Thresholds{}
+
+	if memoryEnv := utils.GetEnv("SHED_MEMORY_LIMIT", ""); memoryEnv != "" {
+		memory, err := sandbox.ParseMemory(memoryEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHED_MEMORY_LIMIT env var: %v", err)
+		}
+		thresholds.MemoryBytes = memory
+	}
+
+	if goroutinesEnv := utils.GetEnv("SHED_GOROUTINE_LIMIT", ""); goroutinesEnv != "" {
+		goroutines, err := strconv.Atoi(goroutinesEnv)
+		if err != nil || goroutines < 0 {
+			return nil, fmt.Errorf("invalid SHED_GOROUTINE_LIMIT env var, expected non-negative integer, got %v", goroutinesEnv)
+		}
+		thresholds.Goroutines = goroutines
+	}
+
+	if thresholds.MemoryBytes == 0 && thresholds.Goroutines == 0 {
+		return nil, nil
+	}
+
+	return thresholds, nil
+}
+
+// workerBreakerFromEnv - returns when the circuits of workers open, nil if WORKER_BREAKER_THRESHOLD isn't set
+func workerBreakerFromEnv() (*worker.BreakerOptions, error) {
+	thresholdEnv := utils.GetEnv("WORKER_BREAKER_THRESHOLD", "0")
+	threshold, err := strconv.Atoi(thresholdEnv)
+	if err != nil || threshold < 0 {
+		return nil, fmt.Errorf("invalid WORKER_BREAKER_THRESHOLD env var, expected non-negative integer, got %v", thresholdEnv)
+	}
+
+	if threshold == 0 {
+		return nil, nil
+	}
+
+	openEnv := utils.GetEnv("WORKER_BREAKER_OPEN", "30s")
+	open, err := time.ParseDuration(openEnv)
+	if err != nil || open < 0 {
+		return nil, fmt.Errorf("invalid WORKER_BREAKER_OPEN env var, expected duration e.g. 30s, got %v", openEnv)
+	}
+
+	return &worker.BreakerOptions{
+		FailureThreshold: threshold,
+		OpenDuration:     open,
+	}, nil
+}
+
+// triggerTimeoutsFromEnv - returns the execution timeouts of each type of trigger, nil if none are set
+func triggerTimeoutsFromEnv() (*worker.TriggerTimeouts, error) {
+	timeouts := &worker.TriggerTimeouts{}
+
+	for env, timeout := range map[string]*time.Duration{
+		"TRIGGER_HTTP_TIMEOUT":  &timeouts.Http,
+		"TRIGGER_EVENT_TIMEOUT": &timeouts.Event,
+	} {
+		if timeoutEnv := utils.GetEnv(env, ""); timeoutEnv != "" {
+			t, err := time.ParseDuration(timeoutEnv)
+			if err != nil || t < 0 {
+				return nil, fmt.Errorf("invalid %s env var, expected duration e.g. 30s, got %v", env, timeoutEnv)
+			}
+			*timeout = t
+		}
+	}
+
+	if timeouts.Http == 0 && timeouts.Event == 0 {
+		return nil, nil
+	}
+
+	return timeouts, nil
+}
+
+// Create a new Membrane server
+func New(options *MembraneOptions) (*Membrane, error) {
+	// Get unset options from env or defaults
+	if options.ServiceAddress == "" {
+		options.ServiceAddress = utils.GetEnv("SERVICE_ADDRESS", "127.0.0.1:50051")
+	}
+
+	if options.ChildAddress == "" {
+		options.ChildAddress = utils.GetEnv("CHILD_ADDRESS", "127.0.0.1:8080")
+	}
+
+	if !options.TolerateMissingServices {
+		tolerateMissing, err := strconv.ParseBool(utils.GetEnv("TOLERATE_MISSING_SERVICES", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.TolerateMissingServices = tolerateMissing
+	}
+
+	if options.Redactor == nil {
+		if matchersEnv := utils.GetEnv("LOG_REDACT", ""); matchersEnv != "" {
+			matchers, err := redact.ParseMatchers(matchersEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid LOG_REDACT env var: %v", err)
+			}
+
+			options.Redactor = redact.New(matchers, strings.Split(utils.GetEnv("LOG_REDACT_ARGS", ""), ","))
+		}
+	}
+
+	// Redact logs before anything is logged
+	if options.Redactor != nil {
+		log.SetOutput(options.Redactor.Writer(log.Writer()))
+	}
+
+	if options.Mode == nil {
+		mode, err := ModeFromString(utils.GetEnv("MEMBRANE_MODE", "FAAS"))
+		if err != nil {
+			return nil, err
+		}
+		options.Mode = &mode
+	}
+
+	if options.StorageCompression == nil {
+		compression, err := storage.ParseCompressionConfig(utils.GetEnv("STORAGE_COMPRESSION", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid STORAGE_COMPRESSION env var: %v", err)
+		}
+		options.StorageCompression = compression
+	}
+
+	if options.StorageLifecycle == nil {
+		if lifecycleEnv := utils.GetEnv("STORAGE_LIFECYCLE", ""); lifecycleEnv != "" {
+			lifecycle, err := storage.ParseLifecycleConfig(lifecycleEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid STORAGE_LIFECYCLE env var: %v", err)
+			}
+			options.StorageLifecycle = lifecycle
+		}
+	}
+
+	if len(options.StorageLifecycle) > 0 {
+		if _, ok := options.StoragePlugin.(storage.LifecycleService); !ok {
+			return nil, fmt.Errorf("storage lifecycle rules are configured but the storage plugin doesn't support them")
+		}
+		if _, ok := options.StoragePlugin.(resources.Creator); !ok {
+			return nil, fmt.Errorf("storage lifecycle rules are configured but the storage plugin can't create buckets")
+		}
+	}
+
+	if options.FlagsPlugin == nil {
+		var err error
+		switch {
+		case utils.GetEnv("LAUNCHDARKLY_CLIENT_SIDE_ID", "") != "":
+			options.FlagsPlugin, err = launchdarkly_service.New()
+		case utils.GetEnv("FLAGSMITH_ENVIRONMENT_KEY", "") != "":
+			options.FlagsPlugin, err = flagsmith_service.New()
+		case options.DocumentPlugin != nil:
+			options.FlagsPlugin = builtin_flags_service.New(options.DocumentPlugin, utils.GetEnv("FLAGS_COLLECTION", builtin_flags_service.DefaultCollection))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not create flags plugin: %v", err)
+		}
+	}
+
+	if options.ConfigPlugin == nil && options.DocumentPlugin != nil {
+		options.ConfigPlugin = builtin_config_service.New(options.DocumentPlugin, utils.GetEnv("CONFIG_COLLECTION", builtin_config_service.DefaultCollection))
+	}
+
+	// Inject faults into the provider plugins before they're wrapped, so faults reach everything built on top of them
+	if faultsEnv := utils.GetEnv("PLUGIN_FAULTS", ""); faultsEnv != "" {
+		faults, err := chaos.ParseFaults(faultsEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PLUGIN_FAULTS env var: %v", err)
+		}
+
+		seed := time.Now().UnixNano()
+		if seedEnv := utils.GetEnv("PLUGIN_FAULTS_SEED", ""); seedEnv != "" {
+			if seed, err = strconv.ParseInt(seedEnv, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid PLUGIN_FAULTS_SEED env var, expected integer, got %v", seedEnv)
+			}
+		}
+
+		log.Default().Println("WARNING: injecting faults into plugin calls, PLUGIN_FAULTS should only be set for local development")
+
+		injector := chaos.NewInjector(faults, seed)
+		options.DocumentPlugin = injector.Document(options.DocumentPlugin)
+		options.EventsPlugin = injector.Events(options.EventsPlugin)
+		options.StoragePlugin = injector.Storage(options.StoragePlugin)
+		options.QueuePlugin = injector.Queue(options.QueuePlugin)
+		options.SecretPlugin = injector.Secret(options.SecretPlugin)
+		options.SqlPlugin = injector.Sql(options.SqlPlugin)
+		options.SearchPlugin = injector.Search(options.SearchPlugin)
+		options.BatchPlugin = injector.Batch(options.BatchPlugin)
+		options.CdnPlugin = injector.Cdn(options.CdnPlugin)
+		options.FlagsPlugin = injector.Flags(options.FlagsPlugin)
+		options.ConfigPlugin = injector.Config(options.ConfigPlugin)
+		options.IdentityPlugin = injector.Identity(options.IdentityPlugin)
+	}
+
+	if options.Tenancy == nil {
+		mode, err := tenancy.ParseMode(utils.GetEnv("TENANCY", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TENANCY env var, %v", err)
+		}
+
+		if mode != tenancy.Disabled {
+			options.Tenancy = tenancy.New(mode)
+		}
+	}
+
+	// Root collections of tenants are namespaced, their indexes are declared without the tenant
+	if options.Tenancy != nil {
+		document.SetDeclaredCollectionNames(func(name string) string {
+			_, declared := tenancy.SplitName(name)
+			return declared
+		})
+	}
+
+	if depthEnv := utils.GetEnv("DOCUMENT_MAX_DEPTH", ""); depthEnv != "" {
+		depth, err := strconv.Atoi(depthEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DOCUMENT_MAX_DEPTH env var, expected integer, got %v", depthEnv)
+		}
+
+		if err := document.SetMaxSubCollectionDepth(depth); err != nil {
+			return nil, fmt.Errorf("invalid DOCUMENT_MAX_DEPTH env var: %v", err)
+		}
+	}
+
+	// Index documents before the outbox is created so documents it writes are indexed too
+	if collectionsEnv := utils.GetEnv("SEARCH_INDEXED_COLLECTIONS", ""); collectionsEnv != "" {
+		indexes, err := indexing.ParseCollections(collectionsEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SEARCH_INDEXED_COLLECTIONS env var: %v", err)
+		}
+
+		indexOpts := make([]indexing.Option, 0)
+		if options.Tenancy != nil {
+			indexOpts = append(indexOpts, indexing.WithTenancy())
+		}
+
+		indexed, err := indexing.New(options.DocumentPlugin, options.SearchPlugin, indexes, indexOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SEARCH_INDEXED_COLLECTIONS env var: %v", err)
+		}
+		options.DocumentPlugin = indexed
+	}
+
+	// Encrypt fields after indexing so the search plugin never receives their plaintext
+	if fieldsEnv := utils.GetEnv("DOCUMENT_ENCRYPTED_FIELDS", ""); fieldsEnv != "" {
+		fields, err := encryption.ParseFields(fieldsEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DOCUMENT_ENCRYPTED_FIELDS env var: %v", err)
+		}
+
+		if options.EncryptionKeyring == nil {
+			secretName := utils.GetEnv("DOCUMENT_ENCRYPTION_SECRET", "")
+			if secretName == "" {
+				return nil, fmt.Errorf("DOCUMENT_ENCRYPTED_FIELDS requires a keyring, set DOCUMENT_ENCRYPTION_SECRET")
+			}
+
+			keyring, err := encryption.NewSecretKeyring(options.SecretPlugin, secretName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DOCUMENT_ENCRYPTION_SECRET env var: %v", err)
+			}
+			options.EncryptionKeyring = keyring
+		}
+
+		encrypted, err := encryption.New(options.DocumentPlugin, options.EncryptionKeyring, fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DOCUMENT_ENCRYPTED_FIELDS env var: %v", err)
+		}
+		options.DocumentPlugin = encrypted
+	}
+
+	if options.Deduplicator == nil {
+		if ttlEnv := utils.GetEnv("DEDUPE_TTL", ""); ttlEnv != "" {
+			ttl, err := time.ParseDuration(ttlEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DEDUPE_TTL env var, expected duration e.g. 24h, got %v", ttlEnv)
+			}
+
+			deduplicator, err := dedupe.NewDocumentDeduplicator(options.DocumentPlugin, utils.GetEnv("DEDUPE_COLLECTION", dedupe.DefaultCollection), ttl)
+			if err != nil {
+				return nil, err
+			}
+			options.Deduplicator = deduplicator
+		}
+	}
+
+	// Archive events before the outbox is created so events it publishes are archived too
+	if bucket := utils.GetEnv("EVENT_ARCHIVE_BUCKET", ""); bucket != "" {
+		archived, err := archive.New(options.EventsPlugin, options.StoragePlugin, bucket)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EVENT_ARCHIVE_BUCKET env var: %v", err)
+		}
+		options.EventsPlugin = archived
+	}
+
+	if options.Outbox == nil {
+		if collection := utils.GetEnv("OUTBOX_COLLECTION", ""); collection != "" {
+			intervalEnv := utils.GetEnv("OUTBOX_RELAY_INTERVAL", "10s")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid OUTBOX_RELAY_INTERVAL env var, expected duration e.g. 10s, got %v", intervalEnv)
+			}
+
+			ob, err := outbox.New(options.DocumentPlugin, options.EventsPlugin, collection, interval)
+			if err != nil {
+				return nil, err
+			}
+			options.Outbox = ob
+		}
+	}
+
+	if options.Schemas == nil {
+		if dir := utils.GetEnv("SCHEMA_DIR", ""); dir != "" {
+			schemas := schema.NewRegistry()
+			if err := schemas.LoadDir(dir); err != nil {
+				return nil, fmt.Errorf("unable to load schemas from SCHEMA_DIR: %v", err)
+			}
+			options.Schemas = schemas
+		}
+	}
+
+	if options.KeyValueCollection == "" {
+		options.KeyValueCollection = utils.GetEnv("KV_COLLECTION", grpc2.DefaultKeyValueCollection)
+	}
+
+	if options.ChildTimeoutSeconds < 1 {
+		options.ChildTimeoutSeconds = 10
+	}
+
+	if options.GatewayPlugin == nil {
+		return nil, errors.New("missing gateway plugin, Gateway plugin must not be nil")
+	}
+
+	if !options.TolerateMissingServices {
+		if options.EventsPlugin == nil || options.StoragePlugin == nil || options.DocumentPlugin == nil || options.QueuePlugin == nil {
+			return nil, errors.New("missing membrane plugins, if you meant to load with missing plugins set options.TolerateMissingServices to true")
+		}
+	}
+
+	if options.Stack == nil {
+		identity, err := stack.FromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.Stack = identity
+	}
+
+	if options.ResourceValidation == "" {
+		options.ResourceValidation = utils.GetEnv("RESOURCE_VALIDATION", "")
+	}
+
+	if options.PermissionCheck == "" {
+		options.PermissionCheck = utils.GetEnv("PERMISSION_CHECK", "")
+	}
+
+	if !options.AutoProvision {
+		autoProvision, err := strconv.ParseBool(utils.GetEnv("AUTO_PROVISION", "false"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTO_PROVISION env var, expected boolean, got %v", utils.GetEnv("AUTO_PROVISION", ""))
+		}
+		options.AutoProvision = autoProvision
+	}
+
+	// Lifecycle rules are applied once, when buckets are created, deployed buckets have their rules set by the deployment
+	if len(options.StorageLifecycle) > 0 && !options.AutoProvision {
+		return nil, fmt.Errorf("storage lifecycle rules are only applied to auto provisioned buckets, set AUTO_PROVISION to apply them")
+	}
+
+	var resourceRegistry *resources.Registry
+	switch options.ResourceValidation {
+	case "":
+	case "warn", "fail":
+		resourceRegistry = &resources.Registry{}
+	default:
+		return nil, fmt.Errorf("invalid RESOURCE_VALIDATION env var, expected warn or fail, got %s", options.ResourceValidation)
+	}
+
+	switch options.PermissionCheck {
+	case "":
+	case "warn", "fail":
+		resourceRegistry = &resources.Registry{}
+	default:
+		return nil, fmt.Errorf("invalid PERMISSION_CHECK env var, expected warn or fail, got %s", options.PermissionCheck)
+	}
+
+	if options.Pool == nil {
+		// Create new pool with defaults
+		minWorkersEnv := utils.GetEnv("MIN_WORKERS", "1")
+		minWorkers, err := strconv.Atoi(minWorkersEnv)
+		if err != nil || minWorkers < 0 {
+			return nil, fmt.Errorf("invalid MIN_WORKERS env var, expected non-negative integer value, got %v", minWorkersEnv)
+		}
+
+		maxWorkersEnv := utils.GetEnv("MAX_WORKERS", "100")
+		maxWorkers, err := strconv.Atoi(maxWorkersEnv)
+		if err != nil || minWorkers < 0 {
+			return nil, fmt.Errorf("invalid MAX_WORKERS env var, expected non-negative integer value, got %v", maxWorkersEnv)
+		}
+
+		options.Pool = worker.NewProcessPool(&worker.ProcessPoolOptions{
+			MinWorkers: minWorkers,
+			MaxWorkers: maxWorkers,
+		})
+	}
+
+	if options.ChildProcesses == 0 {
+		processesEnv := utils.GetEnv("CHILD_PROCESSES", "1")
+		processes, err := strconv.Atoi(processesEnv)
+		if err != nil || processes < 1 {
+			return nil, fmt.Errorf("invalid CHILD_PROCESSES env var, expected positive integer value, got %v", processesEnv)
+		}
+		options.ChildProcesses = processes
+	}
+
+	if options.Dispatch == nil {
+		if strategyEnv := utils.GetEnv("WORKER_DISPATCH", ""); strategyEnv != "" {
+			strategy, err := worker.ParseDispatchStrategy(strategyEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WORKER_DISPATCH env var: %v", err)
+			}
+
+			options.Dispatch = &worker.DispatchOptions{
+				Strategy:   strategy,
+				HashHeader: utils.GetEnv("WORKER_DISPATCH_HASH_HEADER", ""),
+			}
+		}
+	}
+
+	// Triggers are distributed across the workers registered by each child process.
+	// Wrapped first so the other pool wrappers apply to the chosen worker
+	if options.ChildProcesses > 1 || options.Dispatch != nil {
+		options.Pool = worker.NewBalancedPool(options.Pool, options.Dispatch)
+	}
+
+	// Events sharing an ordering key are pinned to one of the balanced workers and handled serially
+	options.Pool = worker.NewOrderedPool(options.Pool)
+
+	if options.WorkerHandover == 0 {
+		handoverEnv := utils.GetEnv("WORKER_HANDOVER_DURATION", "60s")
+		handover, err := time.ParseDuration(handoverEnv)
+		if err != nil || handover < 0 {
+			return nil, fmt.Errorf("invalid WORKER_HANDOVER_DURATION env var, expected duration e.g. 60s, got %v", handoverEnv)
+		}
+		options.WorkerHandover = handover
+	}
+
+	// Triggers move progressively to workers registering with a new generation, then the previous generation is drained
+	options.Pool = worker.NewGenerationPool(options.Pool, options.WorkerHandover)
+
+	if options.WorkerBreaker == nil {
+		breaker, err := workerBreakerFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.WorkerBreaker = breaker
+	}
+
+	// Started once the pool is configured, so failing workers can restart them
+	var childProcesses *sandbox.Group
+	// Set once the membrane is created, so failing workers can restart the child processes one at a time
+	var restartChildProcesses func() error
+
+	// Workers that fail too many times in a row are removed from dispatch for a while
+	var breakerPool *worker.BreakerPool
+	if options.WorkerBreaker != nil && options.WorkerBreaker.FailureThreshold > 0 {
+		restartEnv := utils.GetEnv("WORKER_BREAKER_RESTART", "0")
+		restart, err := strconv.Atoi(restartEnv)
+		if err != nil || restart < 0 {
+			return nil, fmt.Errorf("invalid WORKER_BREAKER_RESTART env var, expected non-negative integer, got %v", restartEnv)
+		}
+		breakerTopic := utils.GetEnv("WORKER_BREAKER_TOPIC", "")
+		eventsPlugin := options.EventsPlugin
+		// set while a restart is in progress, so circuits opening during it don't queue more
+		var restarting int32
+
+		onOpen := options.WorkerBreaker.OnOpen
+		options.WorkerBreaker.OnOpen = func(wrkr string, opens int) {
+			log.Default().Printf("the circuit of the %s opened, %d times since it last handled a trigger", wrkr, opens)
+
+			if onOpen != nil {
+				onOpen(wrkr, opens)
+			}
+
+			if breakerTopic != "" && eventsPlugin != nil {
+				if err := eventsPlugin.Publish(breakerTopic, &events.NitricEvent{
+					ID: uuid.New().String(),
+					Payload: map[string]interface{}{
+						"worker": wrkr,
+						"opens":  opens,
+					},
+				}); err != nil {
+					log.Default().Printf("error publishing circuit breaker event to topic %s: %v", breakerTopic, err)
+				}
+			}
+
+			// The failures may be caused by the state of the child processes, so they're restarted to recover.
+			// Workers aren't tied to the process that registered them, so each process is restarted in turn to keep handling triggers
+			if restart > 0 && opens >= restart && restartChildProcesses != nil && atomic.CompareAndSwapInt32(&restarting, 0, 1) {
+				log.Default().Printf("restarting the child processes, the circuit of the %s opened %d times in a row", wrkr, opens)
+
+				go func() {
+					defer atomic.StoreInt32(&restarting, 0)
+
+					if err := restartChildProcesses(); err != nil {
+						log.Default().Printf("error restarting the child processes: %v", err)
+					}
+				}()
+			}
+		}
+
+		breakerPool = worker.NewBreakerPool(options.Pool, options.WorkerBreaker)
+		options.Pool = breakerPool
+	}
+
+	if options.DevWatchDir == "" {
+		options.DevWatchDir = utils.GetEnv("DEV_WATCH", "")
+	}
+
+	var devWatcher *watch.Watcher
+	var holdPool *worker.HoldPool
+	if options.DevWatchDir != "" {
+		if len(options.ChildCommand) == 0 {
+			return nil, fmt.Errorf("invalid DEV_WATCH env var, a child command is required to restart when files change")
+		}
+
+		watcher, err := devWatcherFromEnv(options.DevWatchDir)
+		if err != nil {
+			return nil, err
+		}
+		devWatcher = watcher
+
+		// Triggers that arrive while the child processes restart wait for their workers to register again, instead of failing
+		holdPool = worker.NewHoldPool(options.Pool, time.Duration(options.ChildTimeoutSeconds)*time.Second)
+		options.Pool = holdPool
+	}
+
+	if options.DeadLetterTopic == "" {
+		options.DeadLetterTopic = utils.GetEnv("EVENT_DEAD_LETTER_TOPIC", "")
+	}
+
+	if options.EventRetry == nil {
+		if attemptsEnv := utils.GetEnv("EVENT_RETRY_ATTEMPTS", ""); attemptsEnv != "" {
+			attempts, err := strconv.Atoi(attemptsEnv)
+			if err != nil || attempts < 0 {
+				return nil, fmt.Errorf("invalid EVENT_RETRY_ATTEMPTS env var, expected non-negative integer value, got %v", attemptsEnv)
+			}
+
+			minBackoffEnv := utils.GetEnv("EVENT_RETRY_MIN_BACKOFF", "10s")
+			minBackoff, err := time.ParseDuration(minBackoffEnv)
+			if err != nil || minBackoff <= 0 {
+				return nil, fmt.Errorf("invalid EVENT_RETRY_MIN_BACKOFF env var, expected duration e.g. 10s, got %v", minBackoffEnv)
+			}
+
+			maxBackoffEnv := utils.GetEnv("EVENT_RETRY_MAX_BACKOFF", "10m")
+			maxBackoff, err := time.ParseDuration(maxBackoffEnv)
+			if err != nil || maxBackoff < minBackoff {
+				return nil, fmt.Errorf("invalid EVENT_RETRY_MAX_BACKOFF env var, expected duration no shorter than EVENT_RETRY_MIN_BACKOFF, got %v", maxBackoffEnv)
+			}
+
+			options.EventRetry = &worker.RetryPolicy{
+				MaxAttempts: attempts,
+				MinBackoff:  minBackoff,
+				MaxBackoff:  maxBackoff,
+			}
+		}
+	}
+
+	if options.BucketTransforms == nil {
+		if transforms := utils.GetEnv("BUCKET_TRANSFORMS", ""); transforms != "" {
+			config, err := transform.ParseConfig(transforms, utils.GetEnv("BUCKET_TRANSFORM_PREFIX", transform.DefaultOutputPrefix))
+			if err != nil {
+				return nil, fmt.Errorf("invalid BUCKET_TRANSFORMS env var: %v", err)
+			}
+			options.BucketTransforms = config
+		}
+	}
+
+	// Wrapped before retries, so items that fail to transform are retried with their notification
+	if options.BucketTransforms != nil {
+		if options.StoragePlugin == nil {
+			return nil, fmt.Errorf("bucket transforms require a storage plugin")
+		}
+		options.Pool = worker.NewTransformPool(options.Pool, options.BucketTransforms, options.StoragePlugin)
+	}
+
+	// Wrapped before dead-lettering, so events that run out of attempts are dead-lettered
+	if options.EventRetry != nil {
+		options.Pool = worker.NewRetryPool(options.Pool, options.EventRetry)
+	}
+
+	// Wrapped before deduplication, so dead-lettered events are recorded as handled
+	if options.EventsPlugin != nil {
+		options.Pool = worker.NewDeadLetterPool(options.Pool, options.EventsPlugin, options.DeadLetterTopic)
+	}
+
+	if options.Deduplicator != nil {
+		options.Pool = worker.NewDedupePool(options.Pool, options.Deduplicator)
+	}
+
+	if options.ApiVersions == nil {
+		if versionsEnv := utils.GetEnv("API_VERSIONS", ""); versionsEnv != "" {
+			versions, err := versioning.ParseConfig(versionsEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid API_VERSIONS env var: %v", err)
+			}
+			options.ApiVersions = versions
+		}
+	}
+
+	var versionMetrics *versioning.Metrics
+	if options.ApiVersions != nil {
+		versionMetrics = versioning.NewMetrics()
+		options.Pool = worker.NewVersionPool(options.Pool, options.ApiVersions, versionMetrics)
+	}
+
+	if options.Traffic == nil {
+		shadows, err := traffic.ParseRules(utils.GetEnv("TRAFFIC_SHADOW", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRAFFIC_SHADOW env var: %v", err)
+		}
+
+		canaries, err := traffic.ParseRules(utils.GetEnv("TRAFFIC_CANARY", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRAFFIC_CANARY env var: %v", err)
+		}
+
+		if len(shadows) > 0 || len(canaries) > 0 {
+			options.Traffic = &traffic.Config{
+				Shadows:  shadows,
+				Canaries: canaries,
+			}
+		}
+	}
+
+	// Requests are shadowed and canary routed before they're routed to their API version's target
+	if options.Traffic != nil {
+		maxShadowsEnv := utils.GetEnv("TRAFFIC_MAX_SHADOWS", "100")
+		maxShadows, err := strconv.Atoi(maxShadowsEnv)
+		if err != nil || maxShadows < 1 {
+			return nil, fmt.Errorf("invalid TRAFFIC_MAX_SHADOWS env var, expected positive integer, got %v", maxShadowsEnv)
+		}
+
+		options.Pool = worker.NewTrafficPool(options.Pool, options.Traffic, maxShadows)
+	}
+
+	if options.ChildLimits == nil {
+		limits, err := childLimitsFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.ChildLimits = limits
+	}
+
+	addresses, err := childAddresses(options.ChildAddress, options.ChildProcesses)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ChildSecrets == nil {
+		bindings, err := secretenv.ParseBindings(utils.GetEnv("CHILD_SECRETS", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHILD_SECRETS env var: %v", err)
+		}
+
+		if len(bindings) > 0 {
+			options.ChildSecrets, err = secretenv.New(options.SecretPlugin, bindings, utils.GetEnv("CHILD_SECRETS_DIR", ""))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if options.ChildSecrets != nil && options.ChildSecretsRefresh == 0 {
+		refreshEnv := utils.GetEnv("CHILD_SECRETS_REFRESH", "0")
+		refresh, err := time.ParseDuration(refreshEnv)
+		if err != nil || refresh < 0 {
+			return nil, fmt.Errorf("invalid CHILD_SECRETS_REFRESH env var, expected duration e.g. 5m, got %v", refreshEnv)
+		}
+		options.ChildSecretsRefresh = refresh
+	}
+
+	if len(options.ChildCommand) > 0 {
+		restartDelayEnv := utils.GetEnv("CHILD_RESTART_DELAY", sandbox.DefaultRestartDelay.String())
+		restartDelay, err := time.ParseDuration(restartDelayEnv)
+		if err != nil || restartDelay < 0 {
+			return nil, fmt.Errorf("invalid CHILD_RESTART_DELAY env var, expected duration e.g. 1s, got %v", restartDelayEnv)
+		}
+
+		childProcesses, err = sandbox.NewGroup(options.ChildCommand, options.ChildLimits, utils.GetEnv("CHILD_CGROUP_ROOT", sandbox.DefaultCgroupRoot), &sandbox.GroupOptions{
+			Size: options.ChildProcesses,
+			Env: func(index int) []string {
+				env := []string{
+					fmt.Sprintf("NITRIC_WORKER_INDEX=%d", index),
+					fmt.Sprintf("NITRIC_WORKER_COUNT=%d", options.ChildProcesses),
+				}
+
+				// Each process needs its own port when there's more than one
+				if *options.Mode == Mode_HttpProxy && options.ChildProcesses > 1 {
+					_, port, _ := net.SplitHostPort(addresses[index])
+					env = append(env, fmt.Sprintf("PORT=%s", port))
+				}
+
+				if options.ChildEnv != nil {
+					env = append(env, options.ChildEnv(index)...)
+				}
+
+				if options.ChildSecrets != nil {
+					env = append(env, options.ChildSecrets.Env()...)
+				}
+
+				return env
+			},
+			Output: func(index int) (io.Writer, io.Writer) {
+				if options.Redactor == nil {
+					return nil, nil
+				}
+
+				return options.Redactor.Writer(os.Stdout), options.Redactor.Writer(os.Stderr)
+			},
+			RestartDelay:    restartDelay,
+			MaxRestartDelay: sandbox.DefaultMaxRestartDelay,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.ChildLimits.InvocationTimeout > 0 {
+		options.Pool = worker.NewTimeoutPool(options.Pool, options.ChildLimits.InvocationTimeout, func(trigger string) {
+			if childProcesses == nil {
+				log.Default().Printf("%s exceeded the invocation timeout", trigger)
+				return
+			}
+
+			// The runaway handler can't be cancelled on its own, so the child process is restarted to reclaim its resources.
+			// The process handling the trigger isn't known, so with multiple child processes they're all restarted
+			childProcesses.Terminate(fmt.Sprintf("%s exceeded the invocation timeout", trigger))
+		})
+	}
+
+	if options.TriggerTimeouts == nil {
+		timeouts, err := triggerTimeoutsFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.TriggerTimeouts = timeouts
+	}
+
+	// Triggers that exceed their execution timeout are cancelled, instead of holding gateway connections
+	var timeoutMetrics *worker.TimeoutMetrics
+	if options.TriggerTimeouts != nil {
+		timeoutMetrics = worker.NewTimeoutMetrics()
+		options.Pool = worker.NewDeadlinePool(options.Pool, options.TriggerTimeouts, timeoutMetrics)
+	}
+
+	if options.MetricsAddress == "" {
+		options.MetricsAddress = utils.GetEnv("METRICS_ADDRESS", "")
+	}
+
+	if options.ScalerAddress == "" {
+		options.ScalerAddress = utils.GetEnv("SCALER_ADDRESS", "")
+	}
+
+	if options.MetricsQueues == nil {
+		for _, name := range strings.Split(utils.GetEnv("METRICS_QUEUES", ""), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				options.MetricsQueues = append(options.MetricsQueues, name)
+			}
+		}
+	}
+
+	if options.UsageMeter == nil {
+		usageEnv := utils.GetEnv("USAGE_ACCOUNTING", "false")
+		accounting, err := strconv.ParseBool(usageEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid USAGE_ACCOUNTING env var, expected boolean value, got %v", usageEnv)
+		}
+
+		if accounting {
+			options.UsageMeter = usage.NewMeter(utils.GetEnv("USAGE_FUNCTION", ""))
+		}
+	}
+
+	if options.UsageMeter != nil && options.UsagePrices != nil {
+		options.UsageMeter.EstimateCosts(options.UsagePrices)
+	}
+
+	if options.UsageLedger == nil && options.UsageMeter != nil {
+		if collection := utils.GetEnv("USAGE_LEDGER_COLLECTION", ""); collection != "" {
+			intervalEnv := utils.GetEnv("USAGE_LEDGER_INTERVAL", "60s")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid USAGE_LEDGER_INTERVAL env var, expected duration e.g. 60s, got %v", intervalEnv)
+			}
+
+			ledger, err := usage.NewLedger(options.UsageMeter, options.DocumentPlugin, collection, interval)
+			if err != nil {
+				return nil, err
+			}
+			options.UsageLedger = ledger
+		}
+	}
+
+	if options.LoadShedding == nil {
+		thresholds, err := loadSheddingFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.LoadShedding = thresholds
+	}
+
+	var shedMonitor *shedding.Monitor
+	if options.LoadShedding != nil {
+		intervalEnv := utils.GetEnv("SHED_INTERVAL", "1s")
+		interval, err := time.ParseDuration(intervalEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHED_INTERVAL env var, expected duration e.g. 1s, got %v", intervalEnv)
+		}
+
+		shedMonitor, err = shedding.NewMonitor(*options.LoadShedding, interval, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHED_INTERVAL env var: %v", err)
+		}
+	}
+
+	var metricsServer *http.Server
+	var utilizationReporter *utilization.Reporter
+	var scalerServer *grpc.Server
+	if options.MetricsAddress != "" || options.UtilizationPublisher != nil || options.ScalerAddress != "" {
+		concurrencyEnv := utils.GetEnv("WORKER_CONCURRENCY", "1")
+		concurrency, err := strconv.Atoi(concurrencyEnv)
+		if err != nil || concurrency < 1 {
+			return nil, fmt.Errorf("invalid WORKER_CONCURRENCY env var, expected positive integer value, got %v", concurrencyEnv)
+		}
+
+		utilizationPool := worker.NewUtilizationPool(options.Pool, concurrency)
+		options.Pool = utilizationPool
+
+		if options.MetricsAddress != "" {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", utilization.Handler(utilizationPool))
+			if versionMetrics != nil {
+				mux.Handle("/metrics/versions", versionMetrics.Handler())
+			}
+			if timeoutMetrics != nil {
+				mux.Handle("/metrics/timeouts", timeoutMetrics.Handler())
+			}
+			if breakerPool != nil {
+				mux.Handle("/metrics/breakers", breakerPool.Handler())
+			}
+			if shedMonitor != nil {
+				mux.Handle("/metrics/shedding", shedMonitor.Handler())
+			}
+			if options.UsageMeter != nil {
+				mux.Handle("/metrics/usage", options.UsageMeter.Handler())
+			}
+			if len(options.MetricsQueues) > 0 && options.QueuePlugin != nil {
+				mux.Handle("/metrics/queues", backlog.Handler(options.QueuePlugin, options.MetricsQueues))
+			}
+			if options.PermissionCheck != "" {
+				validator := &resources.Validator{Plugins: resources.Plugins{
+					Storage:  options.StoragePlugin,
+					Events:   options.EventsPlugin,
+					Queue:    options.QueuePlugin,
+					Document: options.DocumentPlugin,
+					Secret:   options.SecretPlugin,
+				}}
+				mux.Handle("/diagnostics/permissions", validator.PermissionsHandler(resourceRegistry))
+			}
+			metricsServer = &http.Server{
+				Addr:    options.MetricsAddress,
+				Handler: mux,
+			}
+		}
+
+		if options.UtilizationPublisher != nil {
+			intervalEnv := utils.GetEnv("UTILIZATION_INTERVAL", "60s")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid UTILIZATION_INTERVAL env var, expected duration e.g. 60s, got %v", intervalEnv)
+			}
+
+			utilizationReporter, err = utilization.NewReporter(utilizationPool, interval, options.UtilizationPublisher)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if options.ScalerAddress != "" {
+			scalerOpts := []grpc2.ExternalScalerServerOption{grpc2.WithScalerUtilization(utilizationPool)}
+			if options.QueuePlugin != nil {
+				scalerOpts = append(scalerOpts, grpc2.WithScalerQueues(options.QueuePlugin))
+			}
+			if options.EventsPlugin != nil {
+				scalerOpts = append(scalerOpts, grpc2.WithScalerTopics(options.EventsPlugin))
+			}
+
+			scalerServer = grpc.NewServer()
+			v1.RegisterExternalScalerServer(scalerServer, grpc2.NewExternalScalerServer(scalerOpts...))
+		}
+	}
+
+	if options.ConfigFile == "" {
+		options.ConfigFile = utils.GetEnv("CONFIG_FILE", "")
+	}
+
+	// settings in the config file take precedence over env vars
+	config := utils.GetEnv
+	if options.ConfigFile != "" {
+		values, err := readConfigFile(options.ConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CONFIG_FILE env var: %v", err)
+		}
+		config = configFrom(values)
+
+		if options.ConfigReloadInterval == 0 {
+			intervalEnv := utils.GetEnv("CONFIG_RELOAD_INTERVAL", "10s")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil || interval < 0 {
+				return nil, fmt.Errorf("invalid CONFIG_RELOAD_INTERVAL env var, expected duration e.g. 10s, got %v", intervalEnv)
+			}
+			options.ConfigReloadInterval = interval
+		}
+	}
+
+	if options.HttpLimits == nil {
+		httpLimits, err := httpLimitsFrom(config)
+		if err != nil {
+			return nil, err
+		}
+		options.HttpLimits = httpLimits
+	}
+
+	if options.RouteSchemas == nil {
+		if specFile := utils.GetEnv("SCHEMA_OPENAPI", ""); specFile != "" {
+			spec, err := os.ReadFile(specFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read SCHEMA_OPENAPI document: %v", err)
+			}
+
+			options.RouteSchemas, err = schema.LoadOpenApi(spec)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load SCHEMA_OPENAPI document: %v", err)
+			}
+		}
+	}
+
+	// Validate requests within the webhook pool, so webhooks are verified against the body they were signed with first
+	if options.RouteSchemas != nil {
+		options.Pool = worker.NewValidationPool(options.Pool, options.RouteSchemas)
+	}
+
+	if options.Webhooks == nil {
+		if routesEnv := utils.GetEnv("WEBHOOK_ROUTES", ""); routesEnv != "" {
+			routes, err := webhook.ParseRoutes(routesEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WEBHOOK_ROUTES env var: %v", err)
+			}
+
+			// Nitric routes verify requests were signed for this service unless they name another
+			for _, r := range routes {
+				if n, ok := r.Scheme.(*webhook.Nitric); ok && n.Service == "" {
+					n.Service = utils.GetEnv("NITRIC_SERVICE_NAME", "")
+					if n.Service == "" {
+						return nil, fmt.Errorf("invalid WEBHOOK_ROUTES env var: route %s uses the nitric scheme but has no service and NITRIC_SERVICE_NAME is not set", r.Prefix)
+					}
+				}
+			}
+
+			options.Webhooks, err = webhook.New(routes, options.SecretPlugin)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WEBHOOK_ROUTES env var: %v", err)
+			}
+		}
+	}
+
+	// Verify webhooks within the limit pool, so oversized requests are refused before their signatures are computed
+	if options.Webhooks != nil {
+		options.Pool = worker.NewWebhookPool(options.Pool, options.Webhooks)
+	}
+
+	var limitPool *worker.LimitPool
+	// limits can be added by reloading the config file, so requests pass through the limit pool even without any
+	if options.HttpLimits != nil || options.ConfigFile != "" {
+		if options.HttpLimits == nil {
+			options.HttpLimits = &limits.Config{}
+		}
+
+		limitPool = worker.NewLimitPool(options.Pool, options.HttpLimits)
+		options.Pool = limitPool
+	}
+
+	if options.CaptureStore == nil {
+		if dir := utils.GetEnv("CAPTURE_DIR", ""); dir != "" {
+			store, err := capture.NewDirStore(dir)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CAPTURE_DIR env var: %v", err)
+			}
+			options.CaptureStore = store
+		}
+	}
+
+	if options.CaptureAddress == "" {
+		options.CaptureAddress = utils.GetEnv("CAPTURE_ADDRESS", "")
+	}
+
+	if options.ExplorerAddress == "" {
+		options.ExplorerAddress = utils.GetEnv("EXPLORER_ADDRESS", "")
+	}
+
+	if !options.GrpcReflection {
+		reflectionEnabled, err := strconv.ParseBool(utils.GetEnv("GRPC_REFLECTION", "false"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid GRPC_REFLECTION env var, expected boolean, got %v", utils.GetEnv("GRPC_REFLECTION", ""))
+		}
+		options.GrpcReflection = reflectionEnabled
+	}
+
+	if options.CaptureReplay == nil {
+		for _, id := range strings.Split(utils.GetEnv("CAPTURE_REPLAY", ""), ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				options.CaptureReplay = append(options.CaptureReplay, id)
+			}
+		}
+	}
+
+	if options.CaptureStore == nil && (options.CaptureAddress != "" || len(options.CaptureReplay) > 0) {
+		return nil, fmt.Errorf("capture replay is configured but triggers aren't captured, CAPTURE_DIR must be set")
+	}
+
+	var capturePool *worker.CapturePool
+	var captureServer *http.Server
+	// Triggers are captured as they reach workers, so static assets served by the membrane aren't captured
+	if options.CaptureStore != nil {
+		capturePool = worker.NewCapturePool(options.Pool, options.CaptureStore)
+		options.Pool = capturePool
+
+		if options.CaptureAddress != "" {
+			captureServer = &http.Server{
+				Addr:    options.CaptureAddress,
+				Handler: capture.Handler(options.CaptureStore, capturePool),
+			}
+		}
+	}
+
+	if options.Static == nil {
+		server, err := staticServerFromEnv(options.StoragePlugin, config)
+		if err != nil {
+			return nil, err
+		}
+		options.Static = server
+	}
+
+	// Load is shed before triggers reach the other pool wrappers, so refusing them costs as little as possible
+	if shedMonitor != nil {
+		options.Pool = worker.NewShedPool(options.Pool, shedMonitor)
+	}
+
+	// Static assets are served by the membrane, so they're wrapped last to keep them out of worker metrics and timeouts
+	if options.Static != nil {
+		options.Pool = worker.NewStaticPool(options.Pool, options.Static)
+	}
+
+	if options.HttpCompressionMinSize == 0 {
+		compressionEnv := utils.GetEnv("HTTP_COMPRESSION", "false")
+		compression, err := strconv.ParseBool(compressionEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP_COMPRESSION env var, expected boolean value, got %v", compressionEnv)
+		}
+
+		if compression {
+			minSizeEnv := utils.GetEnv("HTTP_COMPRESSION_MIN_SIZE", strconv.Itoa(worker.DefaultCompressionMinSize))
+			minSize, err := strconv.Atoi(minSizeEnv)
+			if err != nil || minSize < 1 {
+				return nil, fmt.Errorf("invalid HTTP_COMPRESSION_MIN_SIZE env var, expected positive integer value, got %v", minSizeEnv)
+			}
+			options.HttpCompressionMinSize = minSize
+		}
+	}
+
+	if options.FaasCompressionMinSize == 0 {
+		compressionEnv := utils.GetEnv("FAAS_COMPRESSION", "false")
+		compression, err := strconv.ParseBool(compressionEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid FAAS_COMPRESSION env var, expected boolean value, got %v", compressionEnv)
+		}
+
+		if compression {
+			minSizeEnv := utils.GetEnv("FAAS_COMPRESSION_MIN_SIZE", strconv.Itoa(worker.DefaultPayloadCompressionMinSize))
+			minSize, err := strconv.Atoi(minSizeEnv)
+			if err != nil || minSize < 1 {
+				return nil, fmt.Errorf("invalid FAAS_COMPRESSION_MIN_SIZE env var, expected positive integer value, got %v", minSizeEnv)
+			}
+			options.FaasCompressionMinSize = minSize
+		}
+	}
+
+	// Compression is wrapped outermost so static assets and membrane generated responses are compressed too
+	if options.HttpCompressionMinSize > 0 {
+		// Decompressed bodies are held to the limit the gateway reads bodies with
+		maxBodySizeEnv := utils.GetEnv("GATEWAY_MAX_BODY_SIZE", "4MB")
+		maxBodySize, err := limits.ParseSize(maxBodySizeEnv)
+		if err != nil || maxBodySize < 1 {
+			return nil, fmt.Errorf("invalid GATEWAY_MAX_BODY_SIZE env var, expected positive size, got %v", maxBodySizeEnv)
+		}
+		options.Pool = worker.NewCompressionPool(options.Pool, options.HttpCompressionMinSize, maxBodySize)
+	}
+
+	// Created once the pool is fully wrapped, so workflow tasks are handled like any other trigger
+	if options.Workflows == nil {
+		if dir := utils.GetEnv("WORKFLOW_DIR", ""); dir != "" {
+			definitions, err := workflow.LoadDir(dir)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load workflows from WORKFLOW_DIR: %v", err)
+			}
+
+			intervalEnv := utils.GetEnv("WORKFLOW_INTERVAL", "1s")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WORKFLOW_INTERVAL env var, expected duration e.g. 1s, got %v", intervalEnv)
+			}
+
+			engine, err := workflow.New(options.DocumentPlugin, options.EventsPlugin, options.Pool, definitions, utils.GetEnv("WORKFLOW_COLLECTION", workflow.DefaultCollection), interval)
+			if err != nil {
+				return nil, err
+			}
+			options.Workflows = engine
+		}
+	}
+
+	if options.ConfigWatcher == nil && options.ConfigPlugin != nil {
+		intervalEnv := utils.GetEnv("CONFIG_WATCH_INTERVAL", config_plugin.DefaultWatchInterval.String())
+		interval, err := time.ParseDuration(intervalEnv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CONFIG_WATCH_INTERVAL env var, expected duration e.g. 30s, got %v", intervalEnv)
+		}
+
+		// Changes are checked for as long as the membrane runs, unless the interval is 0
+		if interval > 0 {
+			options.ConfigWatcher = config_plugin.NewWatcher(options.ConfigPlugin, options.Pool, interval)
+		}
+	}
+
+	if options.Backups == nil {
+		if bucket := utils.GetEnv("BACKUP_BUCKET", ""); bucket != "" {
+			config := &backup.Config{Bucket: bucket}
+
+			for _, name := range strings.Split(utils.GetEnv("BACKUP_COLLECTIONS", ""), ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					config.Collections = append(config.Collections, name)
+				}
+			}
+
+			sources, err := backup.ParseSources(utils.GetEnv("BACKUP_BUCKETS", ""))
+			if err != nil {
+				return nil, fmt.Errorf("invalid BACKUP_BUCKETS env var: %v", err)
+			}
+			config.Sources = sources
+
+			config.Schedule = utils.GetEnv("BACKUP_SCHEDULE", "1 day")
+			config.Collection = utils.GetEnv("BACKUP_COLLECTION", backup.DefaultCollection)
+
+			retainEnv := utils.GetEnv("BACKUP_RETAIN", "7")
+			if config.Retain, err = strconv.Atoi(retainEnv); err != nil || config.Retain < 0 {
+				return nil, fmt.Errorf("invalid BACKUP_RETAIN env var, expected non-negative integer, got %v", retainEnv)
+			}
+
+			if maxAgeEnv := utils.GetEnv("BACKUP_MAX_AGE", ""); maxAgeEnv != "" {
+				if config.MaxAge, err = time.ParseDuration(maxAgeEnv); err != nil || config.MaxAge < 0 {
+					return nil, fmt.Errorf("invalid BACKUP_MAX_AGE env var, expected duration e.g. 720h, got %v", maxAgeEnv)
+				}
+			}
+
+			manager, err := backup.New(options.DocumentPlugin, options.StoragePlugin, config)
+			if err != nil {
+				return nil, err
+			}
+			options.Backups = manager
+		}
+	}
+
+	// Snapshots are taken by whichever instance the schedule's trigger is delivered to, wrapped last so they aren't held to worker timeouts
+	if options.Backups != nil {
+		options.Pool = worker.NewSchedulePool(options.Pool, options.Backups.Worker())
+	}
+
+	if options.Uploads == nil && options.DocumentPlugin != nil && options.StoragePlugin != nil {
+		manager, err := uploads.New(options.DocumentPlugin, options.StoragePlugin, utils.GetEnv("UPLOADS_COLLECTION", uploads.DefaultCollection))
+		if err != nil {
+			return nil, err
+		}
+		options.Uploads = manager
+	}
+
+	if options.Connections == nil && options.DocumentPlugin != nil {
+		registry, err := connections.New(options.DocumentPlugin, options.ConnectionSender, utils.GetEnv("WEBSOCKET_COLLECTION", connections.DefaultCollection))
+		if err != nil {
+			return nil, err
+		}
+		options.Connections = registry
+	}
+
+	if options.TimeSeries == nil {
+		if seriesEnv := utils.GetEnv("TIMESERIES_COLLECTIONS", ""); seriesEnv != "" {
+			series, err := timeseries.ParseSeries(seriesEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TIMESERIES_COLLECTIONS env var: %v", err)
+			}
+
+			intervalEnv := utils.GetEnv("TIMESERIES_EXPIRY_INTERVAL", "1h")
+			interval, err := time.ParseDuration(intervalEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TIMESERIES_EXPIRY_INTERVAL env var, expected duration e.g. 1h, got %v", intervalEnv)
+			}
+
+			store, err := timeseries.New(options.DocumentPlugin, series, interval)
+			if err != nil {
+				return nil, err
+			}
+			options.TimeSeries = store
+		}
+	}
+
+	if options.MigrateToken == "" {
+		options.MigrateToken = utils.GetEnv("MIGRATE_TOKEN", "")
+	}
+
+	if options.MigrateServeAddress == "" {
+		options.MigrateServeAddress = utils.GetEnv("MIGRATE_SERVE_ADDRESS", "")
+	}
+
+	if options.MigrateServeAddress != "" && options.MigrateServeTLS == nil {
+		cert, err := tls.LoadX509KeyPair(utils.GetEnv("MIGRATE_SERVE_TLS_CERT", ""), utils.GetEnv("MIGRATE_SERVE_TLS_KEY", ""))
+		if err != nil {
+			return nil, fmt.Errorf("MIGRATE_SERVE_ADDRESS requires a valid MIGRATE_SERVE_TLS_CERT and MIGRATE_SERVE_TLS_KEY: %v", err)
+		}
+		options.MigrateServeTLS = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	if options.Migrator == nil {
+		if address := utils.GetEnv("MIGRATE_SOURCE_ADDRESS", ""); address != "" {
+			collections, err := migrate.ParseJobs(migrate.Collection, utils.GetEnv("MIGRATE_COLLECTIONS", ""))
+			if err != nil {
+				return nil, fmt.Errorf("invalid MIGRATE_COLLECTIONS env var: %v", err)
+			}
+
+			buckets, err := migrate.ParseJobs(migrate.Bucket, utils.GetEnv("MIGRATE_BUCKETS", ""))
+			if err != nil {
+				return nil, fmt.Errorf("invalid MIGRATE_BUCKETS env var: %v", err)
+			}
+
+			sourceTLS := &tls.Config{MinVersion: tls.VersionTLS12}
+			if caFile := utils.GetEnv("MIGRATE_SOURCE_CA", ""); caFile != "" {
+				ca, err := ioutil.ReadFile(caFile)
+				if err != nil {
+					return nil, fmt.Errorf("unable to read MIGRATE_SOURCE_CA: %v", err)
+				}
+
+				sourceTLS.RootCAs = x509.NewCertPool()
+				if !sourceTLS.RootCAs.AppendCertsFromPEM(ca) {
+					return nil, fmt.Errorf("invalid MIGRATE_SOURCE_CA, expected PEM encoded certificates")
+				}
+			}
+
+			// The source is read through the migration server of a membrane running on the provider being migrated from
+			conn, err := migrate.Dial(address, sourceTLS, options.MigrateToken)
+			if err != nil {
+				return nil, fmt.Errorf("unable to connect to MIGRATE_SOURCE_ADDRESS, MIGRATE_TOKEN is required: %v", err)
+			}
+			sourceDocuments, sourceStorage := migrate.NewRemoteSource(v1.NewDocumentServiceClient(conn), v1.NewStorageServiceClient(conn))
+
+			migrator, err := migrate.New(sourceDocuments, sourceStorage, options.DocumentPlugin, options.StoragePlugin, append(collections, buckets...), utils.GetEnv("MIGRATE_COLLECTION", migrate.DefaultCollection))
+			if err != nil {
+				return nil, err
+			}
+			options.Migrator = migrator
+		}
+	}
+
+	if options.Egress == nil {
+		egressClient, err := egressClientFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		options.Egress = egressClient
+	}
+
+	if options.Invoker == nil {
+		resolvers := invoke.Resolvers{invoke.EnvResolver{}}
+		if options.InvokeResolver != nil {
+			resolvers = append(resolvers, options.InvokeResolver)
+		}
+
+		if template := utils.GetEnv("INVOKE_URL_TEMPLATE", ""); template != "" {
+			resolvers = append(resolvers, &invoke.TemplateResolver{Template: template})
+		} else if utils.GetEnv("KUBERNETES_SERVICE_HOST", "") != "" {
+			// Services in the same namespace are reachable by their names with Kubernetes DNS
+			portEnv := utils.GetEnv("INVOKE_PORT", "80")
+			port, err := strconv.Atoi(portEnv)
+			if err != nil {
+				return nil, fmt.Errorf("invalid INVOKE_PORT env var, expected integer, got %v", portEnv)
+			}
+
+			kubernetes, err := invoke.NewKubernetesResolver(utils.GetEnv("INVOKE_NAMESPACE", ""), port)
+			if err != nil {
+				return nil, err
+			}
+			resolvers = append(resolvers, kubernetes)
+		}
+
+		var credentials *secret.Credentials
+		if secretName := utils.GetEnv("INVOKE_SECRET", ""); secretName != "" {
+			refresh, err := secret.CredentialsRefreshFromEnv()
+			if err != nil {
+				return nil, err
+			}
+
+			credentials, err = secret.NewCredentials(options.SecretPlugin, secretName, refresh)
+			if err != nil {
+				return nil, fmt.Errorf("invalid INVOKE_SECRET env var: %v", err)
+			}
+		}
+
+		invoker, err := invoke.New(resolvers, options.Egress, utils.GetEnv("NITRIC_SERVICE_NAME", ""), credentials)
+		if err != nil {
+			return nil, err
+		}
+		options.Invoker = invoker
+	}
+
+	m := &Membrane{
+		serviceAddress:          options.ServiceAddress,
+		childAddress:            options.ChildAddress,
+		childUrl:                fmt.Sprintf("http://%s", options.ChildAddress),
+		childProcesses:          childProcesses,
+		childSecrets:            options.ChildSecrets,
+		childSecretsRefresh:     options.ChildSecretsRefresh,
+		childAddresses:          addresses,
+		childTimeoutSeconds:     options.ChildTimeoutSeconds,
+		identity:                options.Stack,
+		faasCompressionMinSize:  options.FaasCompressionMinSize,
+		resources:               resourceRegistry,
+		validateDeclared:        options.ResourceValidation != "",
+		failInvalidResources:    options.ResourceValidation == "fail",
+		permissionCheck:         options.PermissionCheck,
+		autoProvision:           options.AutoProvision,
+		devWatcher:              devWatcher,
+		holdPool:                holdPool,
+		documentPlugin:          options.DocumentPlugin,
+		eventsPlugin:            options.EventsPlugin,
 		storagePlugin:           options.StoragePlugin,
 		queuePlugin:             options.QueuePlugin,
 		gatewayPlugin:           options.GatewayPlugin,
 		secretPlugin:            options.SecretPlugin,
+		sqlPlugin:               options.SqlPlugin,
+		searchPlugin:            options.SearchPlugin,
+		batchPlugin:             options.BatchPlugin,
+		cdnPlugin:               options.CdnPlugin,
+		flagsPlugin:             options.FlagsPlugin,
+		configPlugin:            options.ConfigPlugin,
+		identityPlugin:          options.IdentityPlugin,
+		storageCompression:      options.StorageCompression,
+		storageLifecycle:        options.StorageLifecycle,
+		deduplicator:            options.Deduplicator,
+		outbox:                  options.Outbox,
+		workflows:               options.Workflows,
+		configWatcher:           options.ConfigWatcher,
+		backups:                 options.Backups,
+		uploads:                 options.Uploads,
+		conns:                   options.Connections,
+		timeSeries:              options.TimeSeries,
+		migrator:                options.Migrator,
+		migrateServeAddress:     options.MigrateServeAddress,
+		migrateServeTLS:         options.MigrateServeTLS,
+		migrateToken:            options.MigrateToken,
+		egress:                  options.Egress,
+		invoker:                 options.Invoker,
+		schemas:                 options.Schemas,
+		tenancy:                 options.Tenancy,
+		kvCollection:            options.KeyValueCollection,
+		metricsServer:           metricsServer,
+		utilizationReporter:     utilizationReporter,
+		shedMonitor:             shedMonitor,
+		scalerAddress:           options.ScalerAddress,
+		scalerServer:            scalerServer,
+		captureStore:            options.CaptureStore,
+		capturePool:             capturePool,
+		captureServer:           captureServer,
+		explorerAddress:         options.ExplorerAddress,
+		grpcReflection:          options.GrpcReflection,
+		usageMeter:              options.UsageMeter,
+		redactor:                options.Redactor,
+		usageLedger:             options.UsageLedger,
+		captureReplay:           options.CaptureReplay,
+		configFile:              options.ConfigFile,
+		configReloadInterval:    options.ConfigReloadInterval,
+		limitPool:               limitPool,
+		staticServer:            options.Static,
 		suppressLogs:            options.SuppressLogs,
 		tolerateMissingServices: options.TolerateMissingServices,
 		mode:                    *options.Mode,
 		pool:                    options.Pool,
-	}, nil
+	}
+
+	if childProcesses != nil {
+		restartChildProcesses = m.RestartChildProcesses
+	}
+
+	return m, nil
 }
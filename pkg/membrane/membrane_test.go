@@ -180,6 +180,45 @@ var _ = Describe("Membrane", func() {
 				})
 			})
 		})
+		When("Watching for changes without a child command", func() {
+			It("Should fail to create", func() {
+				m, err := membrane.New(&membrane.MembraneOptions{
+					SuppressLogs:            true,
+					GatewayPlugin:           &MockGateway{},
+					TolerateMissingServices: true,
+					Pool:                    pool,
+					DevWatchDir:             os.TempDir(),
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(m).To(BeNil())
+			})
+		})
+		When("Validating resources with an unknown mode", func() {
+			It("Should fail to create", func() {
+				m, err := membrane.New(&membrane.MembraneOptions{
+					SuppressLogs:            true,
+					GatewayPlugin:           &MockGateway{},
+					TolerateMissingServices: true,
+					Pool:                    pool,
+					ResourceValidation:      "strict",
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(m).To(BeNil())
+			})
+		})
+		When("Checking permissions with an unknown mode", func() {
+			It("Should fail to create", func() {
+				m, err := membrane.New(&membrane.MembraneOptions{
+					SuppressLogs:            true,
+					GatewayPlugin:           &MockGateway{},
+					TolerateMissingServices: true,
+					Pool:                    pool,
+					PermissionCheck:         "strict",
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(m).To(BeNil())
+			})
+		})
 	})
 
 	Context("Starting the server", func() {
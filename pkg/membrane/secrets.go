@@ -0,0 +1,51 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"fmt"
+	"time"
+)
+
+// refreshChildSecrets - resolves the child process's secrets every interval, performing a rolling restart of the child processes
+// when they change so they start with the new values
+func (s *Membrane) refreshChildSecrets(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			changed, err := s.childSecrets.Resolve()
+			if err != nil {
+				s.log(fmt.Sprintf("Failed to refresh child process secrets, keeping the current values: %v", err))
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			s.log("Child process secrets changed, restarting child processes")
+			if err := s.RestartChildProcesses(); err != nil {
+				s.log(fmt.Sprintf("Rolling restart failed: %v", err))
+				continue
+			}
+			s.log("Child processes restarted with the new secrets")
+		}
+	}
+}
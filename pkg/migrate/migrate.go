@@ -0,0 +1,393 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// DefaultCollection - the document collection migration checkpoints are persisted to, unless configured otherwise
+const DefaultCollection = "nitric-migrations"
+
+const (
+	// pageSize - number of documents read from a source collection at a time
+	pageSize = 500
+	// checkpointEvery - number of items copied between bucket checkpoints
+	checkpointEvery = 100
+	// versionField - incremented by every write of a checkpoint, which only applies if it hasn't been written since it was read
+	versionField = "version"
+	// lease - how long a job is owned by the instance running it without a checkpoint, after which another instance may take it over
+	lease = 10 * time.Minute
+)
+
+type Kind string
+
+const (
+	Collection Kind = "collection"
+	Bucket     Kind = "bucket"
+)
+
+// Job - copies a collection or bucket from the source to a collection or bucket of the destination
+type Job struct {
+	Kind        Kind
+	Source      string
+	Destination string
+}
+
+func (j *Job) id() string {
+	return fmt.Sprintf("%s:%s:%s", j.Kind, j.Source, j.Destination)
+}
+
+// ParseJobs - parses comma separated collections or buckets, each optionally followed by =<destination>, e.g. orders,users=customers
+func ParseJobs(kind Kind, value string) ([]*Job, error) {
+	jobs := []*Job{}
+
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		parts := strings.SplitN(s, "=", 2)
+		job := &Job{Kind: kind, Source: parts[0], Destination: parts[0]}
+		if len(parts) == 2 {
+			job.Destination = parts[1]
+		}
+
+		if job.Source == "" || job.Destination == "" {
+			return nil, fmt.Errorf("invalid %s %s, expected <source> or <source>=<destination>", kind, s)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// Checkpoint - the progress of a job, persisted so an interrupted migration resumes where it stopped
+type Checkpoint struct {
+	// PagingToken - the source query page after the last documents copied
+	PagingToken map[string]string
+	// LastKey - the last item copied, items are copied in key order
+	LastKey   string
+	Copied    int64
+	Completed bool
+
+	version     int64
+	owner       string
+	leasedUntil time.Time
+}
+
+// Migrator - Copies collections and buckets from one provider's plugins to another's.
+//
+// Documents and items are copied with writes that overwrite the destination, so re-copying those written before an interruption is harmless.
+// Only top level documents are copied, and whole numbers are written as integers since the source's number types may not be known.
+//
+// Each job is run by a single instance, which leases it with every checkpoint it writes. Other instances skip leased jobs,
+// and take over jobs whose lease has expired.
+type Migrator struct {
+	sourceDocuments      document.DocumentService
+	sourceStorage        storage.StorageService
+	destinationDocuments document.DocumentService
+	destinationStorage   storage.StorageService
+	checkpoints          *document.Collection
+	jobs                 []*Job
+	// owner - identifies the migrator in the leases it holds
+	owner string
+	now   func() time.Time
+}
+
+func (m *Migrator) checkpointKey(job *Job) *document.Key {
+	return &document.Key{
+		Collection: m.checkpoints,
+		Id:         job.id(),
+	}
+}
+
+// Checkpoint - returns the progress of a job, nil if it hasn't started
+func (m *Migrator) Checkpoint(job *Job) (*Checkpoint, error) {
+	doc, err := m.destinationDocuments.Get(m.checkpointKey(job))
+	if errors.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{PagingToken: map[string]string{}}
+	if token, ok := doc.Content["pagingToken"].(map[string]interface{}); ok {
+		for k, v := range token {
+			checkpoint.PagingToken[k] = fmt.Sprint(v)
+		}
+	}
+	checkpoint.LastKey, _ = doc.Content["lastKey"].(string)
+	checkpoint.Completed, _ = doc.Content["completed"].(bool)
+	checkpoint.owner, _ = doc.Content["owner"].(string)
+	checkpoint.version, _ = document.IncrementedValue(doc.Content[versionField], 0)
+
+	if leasedUntil, _ := document.IncrementedValue(doc.Content["leasedUntil"], 0); leasedUntil > 0 {
+		checkpoint.leasedUntil = time.Unix(0, leasedUntil*int64(time.Millisecond))
+	}
+
+	switch copied := doc.Content["copied"].(type) {
+	case int64:
+		checkpoint.Copied = copied
+	case float64:
+		checkpoint.Copied = int64(copied)
+	}
+
+	return checkpoint, nil
+}
+
+// saveCheckpoint - writes the checkpoint and renews the lease on the job, until it completes.
+// Fails with FailedPrecondition if another instance has written the checkpoint since it was read
+func (m *Migrator) saveCheckpoint(job *Job, checkpoint *Checkpoint) error {
+	token := make(map[string]interface{}, len(checkpoint.PagingToken))
+	for k, v := range checkpoint.PagingToken {
+		token[k] = v
+	}
+
+	leasedUntil := int64(0)
+	if !checkpoint.Completed {
+		leasedUntil = m.now().Add(lease).UnixNano() / int64(time.Millisecond)
+	}
+
+	err := m.destinationDocuments.CompareAndSet(m.checkpointKey(job), versionField, checkpoint.version, map[string]interface{}{
+		"kind":        string(job.Kind),
+		"source":      job.Source,
+		"destination": job.Destination,
+		"pagingToken": token,
+		"lastKey":     checkpoint.LastKey,
+		"copied":      checkpoint.Copied,
+		"completed":   checkpoint.Completed,
+		"owner":       m.owner,
+		"leasedUntil": leasedUntil,
+		versionField:  checkpoint.version + 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	checkpoint.version++
+	checkpoint.owner = m.owner
+
+	return nil
+}
+
+// leasedElsewhere - returns true if another instance is running the job
+func (m *Migrator) leasedElsewhere(checkpoint *Checkpoint) bool {
+	return checkpoint.owner != m.owner && checkpoint.leasedUntil.After(m.now())
+}
+
+// wholeNumbers - converts whole floats to integers
+func wholeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = wholeNumbers(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = wholeNumbers(child)
+		}
+	}
+
+	return value
+}
+
+func (m *Migrator) copyCollection(job *Job, checkpoint *Checkpoint) error {
+	source := &document.Collection{Name: job.Source}
+	destination := &document.Collection{Name: job.Destination}
+
+	pagingToken := checkpoint.PagingToken
+	if len(pagingToken) == 0 {
+		pagingToken = nil
+	}
+
+	for {
+		result, err := m.sourceDocuments.Query(source, nil, pageSize, pagingToken)
+		if err != nil {
+			return err
+		}
+
+		if len(result.Documents) > 0 {
+			docs := make([]document.Document, 0, len(result.Documents))
+			for _, doc := range result.Documents {
+				docs = append(docs, document.Document{
+					Key:     &document.Key{Collection: destination, Id: doc.Key.Id},
+					Content: wholeNumbers(doc.Content).(map[string]interface{}),
+				})
+			}
+
+			resp, err := m.destinationDocuments.SetBatch(docs)
+			if err != nil {
+				return err
+			}
+
+			// the checkpoint isn't advanced past a page that wasn't fully copied
+			if len(resp.FailedWrites) > 0 {
+				failed := resp.FailedWrites[0]
+				return fmt.Errorf("failed to copy %d documents, first %s: %s", len(resp.FailedWrites), failed.Key.Id, failed.Message)
+			}
+		}
+
+		checkpoint.Copied += int64(len(result.Documents))
+		checkpoint.PagingToken = result.PagingToken
+		checkpoint.Completed = len(result.PagingToken) == 0
+
+		if err := m.saveCheckpoint(job, checkpoint); err != nil {
+			return err
+		}
+
+		if checkpoint.Completed {
+			return nil
+		}
+		pagingToken = result.PagingToken
+	}
+}
+
+func (m *Migrator) copyBucket(job *Job, checkpoint *Checkpoint) error {
+	files, err := m.sourceStorage.ListFiles(job.Source)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		if f.Key > checkpoint.LastKey {
+			keys = append(keys, f.Key)
+		}
+	}
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		object, err := m.sourceStorage.Read(job.Source, key)
+		if err != nil {
+			return err
+		}
+
+		if _, err := m.destinationStorage.Write(job.Destination, key, object); err != nil {
+			return err
+		}
+
+		checkpoint.LastKey = key
+		checkpoint.Copied++
+
+		if (i+1)%checkpointEvery == 0 {
+			if err := m.saveCheckpoint(job, checkpoint); err != nil {
+				return err
+			}
+		}
+	}
+
+	checkpoint.Completed = true
+
+	return m.saveCheckpoint(job, checkpoint)
+}
+
+// Run - runs each job that hasn't completed, resuming from its checkpoint
+func (m *Migrator) Run() error {
+	for _, job := range m.jobs {
+		checkpoint, err := m.Checkpoint(job)
+		if err != nil {
+			return fmt.Errorf("error reading checkpoint of %s %s: %v", job.Kind, job.Source, err)
+		}
+
+		if checkpoint == nil {
+			checkpoint = &Checkpoint{}
+		}
+
+		if checkpoint.Completed {
+			continue
+		}
+
+		if m.leasedElsewhere(checkpoint) {
+			log.Default().Printf("skipping %s %s, it is being migrated by another instance", job.Kind, job.Source)
+			continue
+		}
+
+		// Taking the lease fails if another instance took it since the checkpoint was read
+		if err := m.saveCheckpoint(job, checkpoint); err != nil {
+			if errors.Code(err) == codes.FailedPrecondition {
+				log.Default().Printf("skipping %s %s, it is being migrated by another instance", job.Kind, job.Source)
+				continue
+			}
+			return fmt.Errorf("error leasing %s %s: %v", job.Kind, job.Source, err)
+		}
+
+		log.Default().Printf("migrating %s %s to %s, %d copied so far", job.Kind, job.Source, job.Destination, checkpoint.Copied)
+
+		switch job.Kind {
+		case Collection:
+			err = m.copyCollection(job, checkpoint)
+		case Bucket:
+			err = m.copyBucket(job, checkpoint)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error migrating %s %s: %v", job.Kind, job.Source, err)
+		}
+
+		log.Default().Printf("migrated %s %s to %s, %d copied", job.Kind, job.Source, job.Destination, checkpoint.Copied)
+	}
+
+	return nil
+}
+
+// New - Creates a migrator copying from the source plugins to the destination plugins, checkpointing to the given destination collection
+func New(sourceDocuments document.DocumentService, sourceStorage storage.StorageService, destinationDocuments document.DocumentService, destinationStorage storage.StorageService, jobs []*Job, collection string) (*Migrator, error) {
+	if destinationDocuments == nil {
+		return nil, fmt.Errorf("document plugin is required for migration checkpoints")
+	}
+
+	for _, job := range jobs {
+		if job.Kind == Collection && sourceDocuments == nil {
+			return nil, fmt.Errorf("source document plugin is required to migrate collection %s", job.Source)
+		}
+
+		if job.Kind == Bucket && (sourceStorage == nil || destinationStorage == nil) {
+			return nil, fmt.Errorf("storage plugins are required to migrate bucket %s", job.Source)
+		}
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &Migrator{
+		sourceDocuments:      sourceDocuments,
+		sourceStorage:        sourceStorage,
+		destinationDocuments: destinationDocuments,
+		destinationStorage:   destinationStorage,
+		checkpoints:          &document.Collection{Name: collection},
+		jobs:                 jobs,
+		owner:                uuid.New().String(),
+		now:                  time.Now,
+	}, nil
+}
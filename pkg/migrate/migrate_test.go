@@ -0,0 +1,305 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+type memoryDocuments struct {
+	document.UnimplementedDocumentPlugin
+	docs map[string]map[string]map[string]interface{}
+	// failAfter - queries fail once this many pages have been read, unless negative
+	failAfter int
+	pages     int
+}
+
+func (m *memoryDocuments) Get(key *document.Key) (*document.Document, error) {
+	content, ok := m.docs[key.Collection.Name][key.Id]
+	if !ok {
+		return nil, errors.ErrorsWithScope("memoryDocuments.Get", nil)(codes.NotFound, "document not found", nil)
+	}
+
+	return &document.Document{Key: key, Content: content}, nil
+}
+
+func (m *memoryDocuments) Set(key *document.Key, content map[string]interface{}) error {
+	if m.docs[key.Collection.Name] == nil {
+		m.docs[key.Collection.Name] = map[string]map[string]interface{}{}
+	}
+	m.docs[key.Collection.Name][key.Id] = content
+	return nil
+}
+
+func (m *memoryDocuments) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	if !document.FieldEquals(m.docs[key.Collection.Name][key.Id][field], expected) {
+		return errors.ErrorsWithScope("memoryDocuments.CompareAndSet", nil)(codes.FailedPrecondition, document.ConditionFailed, nil)
+	}
+	return m.Set(key, content)
+}
+
+func (m *memoryDocuments) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	return document.SetEach(m.Set, docs), nil
+}
+
+// Query - returns pages of two documents in id order, with the offset of the next page as the paging token
+func (m *memoryDocuments) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	if m.failAfter >= 0 && m.pages >= m.failAfter {
+		return nil, fmt.Errorf("source unavailable")
+	}
+	m.pages++
+
+	ids := []string{}
+	for id := range m.docs[collection.Name] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	offset := 0
+	if pagingToken != nil {
+		offset, _ = strconv.Atoi(pagingToken["offset"])
+	}
+
+	result := &document.QueryResult{}
+	for i := offset; i < len(ids) && i < offset+2; i++ {
+		result.Documents = append(result.Documents, document.Document{
+			Key:     &document.Key{Collection: collection, Id: ids[i]},
+			Content: m.docs[collection.Name][ids[i]],
+		})
+	}
+
+	if offset+2 < len(ids) {
+		result.PagingToken = map[string]string{"offset": strconv.Itoa(offset + 2)}
+	}
+
+	return result, nil
+}
+
+type memoryStorage struct {
+	storage.UnimplementedStoragePlugin
+	objects map[string]map[string][]byte
+	// failOn - reading this key fails
+	failOn string
+}
+
+func (m *memoryStorage) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	if m.objects[bucket] == nil {
+		m.objects[bucket] = map[string][]byte{}
+	}
+	m.objects[bucket][key] = object
+	return "", nil
+}
+
+func (m *memoryStorage) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	if key == m.failOn {
+		return nil, fmt.Errorf("source unavailable")
+	}
+	return m.objects[bucket][key], nil
+}
+
+func (m *memoryStorage) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	files := make([]*storage.FileInfo, 0)
+	for k := range m.objects[bucket] {
+		files = append(files, &storage.FileInfo{Key: k})
+	}
+	return files, nil
+}
+
+var _ = Describe("Migrate", func() {
+	var sourceDocs, destinationDocs *memoryDocuments
+	var sourceStorage, destinationStorage *memoryStorage
+
+	BeforeEach(func() {
+		sourceDocs = &memoryDocuments{docs: map[string]map[string]map[string]interface{}{}, failAfter: -1}
+		destinationDocs = &memoryDocuments{docs: map[string]map[string]map[string]interface{}{}, failAfter: -1}
+		sourceStorage = &memoryStorage{objects: map[string]map[string][]byte{}}
+		destinationStorage = &memoryStorage{objects: map[string]map[string][]byte{}}
+
+		for i := 1; i <= 5; i++ {
+			_ = sourceDocs.Set(&document.Key{Collection: &document.Collection{Name: "users"}, Id: strconv.Itoa(i)}, map[string]interface{}{"age": float64(30 + i), "score": 1.5})
+			_, _ = sourceStorage.Write("uploads", fmt.Sprintf("file-%d", i), []byte(strconv.Itoa(i)))
+		}
+	})
+
+	newMigrator := func(jobs []*Job) *Migrator {
+		m, err := New(sourceDocs, sourceStorage, destinationDocs, destinationStorage, jobs, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		return m
+	}
+
+	Context("Collections", func() {
+		job := &Job{Kind: Collection, Source: "users", Destination: "customers"}
+
+		It("should copy every document to the destination collection", func() {
+			m := newMigrator([]*Job{job})
+
+			Expect(m.Run()).To(Succeed())
+			Expect(destinationDocs.docs["customers"]).To(HaveLen(5))
+			Expect(destinationDocs.docs["customers"]["1"]).To(Equal(map[string]interface{}{"age": int64(31), "score": 1.5}))
+
+			checkpoint, _ := m.Checkpoint(job)
+			Expect(checkpoint.Completed).To(BeTrue())
+			Expect(checkpoint.Copied).To(Equal(int64(5)))
+		})
+
+		It("should resume from the last checkpoint after an interruption", func() {
+			m := newMigrator([]*Job{job})
+
+			sourceDocs.failAfter = 1
+			Expect(m.Run()).NotTo(Succeed())
+			Expect(destinationDocs.docs["customers"]).To(HaveLen(2))
+
+			checkpoint, _ := m.Checkpoint(job)
+			Expect(checkpoint.Completed).To(BeFalse())
+			Expect(checkpoint.PagingToken).To(Equal(map[string]string{"offset": "2"}))
+
+			sourceDocs.failAfter = -1
+			sourceDocs.pages = 0
+			Expect(m.Run()).To(Succeed())
+			Expect(destinationDocs.docs["customers"]).To(HaveLen(5))
+			By("Not reading the pages already copied")
+			Expect(sourceDocs.pages).To(Equal(2))
+		})
+
+		It("should skip jobs being migrated by another instance", func() {
+			other := newMigrator([]*Job{job})
+			Expect(other.saveCheckpoint(job, &Checkpoint{})).To(Succeed())
+
+			m := newMigrator([]*Job{job})
+			Expect(m.Run()).To(Succeed())
+			Expect(sourceDocs.pages).To(Equal(0))
+		})
+
+		It("should take over jobs whose lease has expired", func() {
+			other := newMigrator([]*Job{job})
+			Expect(other.saveCheckpoint(job, &Checkpoint{})).To(Succeed())
+
+			m := newMigrator([]*Job{job})
+			m.now = func() time.Time { return time.Now().Add(2 * lease) }
+			Expect(m.Run()).To(Succeed())
+			Expect(destinationDocs.docs["customers"]).To(HaveLen(5))
+		})
+
+		It("should stop once another instance takes over the job", func() {
+			m := newMigrator([]*Job{job})
+			checkpoint := &Checkpoint{}
+			Expect(m.saveCheckpoint(job, checkpoint)).To(Succeed())
+
+			other := newMigrator([]*Job{job})
+			Expect(other.saveCheckpoint(job, &Checkpoint{version: checkpoint.version})).To(Succeed())
+
+			Expect(errors.Code(m.saveCheckpoint(job, checkpoint))).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("should skip completed jobs", func() {
+			m := newMigrator([]*Job{job})
+			Expect(m.Run()).To(Succeed())
+
+			sourceDocs.pages = 0
+			Expect(m.Run()).To(Succeed())
+			Expect(sourceDocs.pages).To(Equal(0))
+		})
+	})
+
+	Context("Buckets", func() {
+		job := &Job{Kind: Bucket, Source: "uploads", Destination: "uploads"}
+
+		It("should resume after the last item checkpointed", func() {
+			m := newMigrator([]*Job{job})
+
+			sourceStorage.failOn = "file-3"
+			Expect(m.Run()).NotTo(Succeed())
+			Expect(destinationStorage.objects["uploads"]).To(HaveLen(2))
+
+			// Items are only checkpointed every checkpointEvery items, so resuming copies the bucket again
+			sourceStorage.failOn = ""
+			Expect(m.Run()).To(Succeed())
+			Expect(destinationStorage.objects["uploads"]).To(HaveLen(5))
+
+			checkpoint, _ := m.Checkpoint(job)
+			Expect(checkpoint.LastKey).To(Equal("file-5"))
+			Expect(checkpoint.Completed).To(BeTrue())
+		})
+
+		It("should skip items before the checkpoint", func() {
+			m := newMigrator([]*Job{job})
+			Expect(m.saveCheckpoint(job, &Checkpoint{LastKey: "file-3", Copied: 3})).To(Succeed())
+
+			Expect(m.Run()).To(Succeed())
+			Expect(destinationStorage.objects["uploads"]).To(HaveLen(2))
+			Expect(destinationStorage.objects["uploads"]).To(HaveKey("file-4"))
+
+			checkpoint, _ := m.Checkpoint(job)
+			Expect(checkpoint.Copied).To(Equal(int64(5)))
+		})
+	})
+
+	Context("Source server", func() {
+		It("should require a token", func() {
+			_, err := NewSourceServer(nil, nil, &tls.Config{Certificates: []tls.Certificate{{}}}, "")
+
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should require a certificate", func() {
+			_, err := NewSourceServer(nil, nil, &tls.Config{}, "secret")
+
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should only authorize calls with the token", func() {
+			valid := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+			invalid := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer other"))
+
+			Expect(authorize(valid, "secret")).To(Succeed())
+			Expect(status.Code(authorize(invalid, "secret"))).To(Equal(grpccodes.Unauthenticated))
+			Expect(status.Code(authorize(context.Background(), "secret"))).To(Equal(grpccodes.Unauthenticated))
+		})
+	})
+
+	Context("ParseJobs", func() {
+		It("should parse sources and destinations", func() {
+			jobs, err := ParseJobs(Collection, "orders, users=customers")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(jobs).To(Equal([]*Job{
+				{Kind: Collection, Source: "orders", Destination: "orders"},
+				{Kind: Collection, Source: "users", Destination: "customers"},
+			}))
+		})
+
+		It("should reject a missing destination", func() {
+			_, err := ParseJobs(Bucket, "uploads=")
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
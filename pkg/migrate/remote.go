@@ -0,0 +1,173 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// remoteDocuments - reads collections through the document API of a membrane running on the source provider
+type remoteDocuments struct {
+	document.UnimplementedDocumentPlugin
+	client pb.DocumentServiceClient
+}
+
+func (r *remoteDocuments) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	resp, err := r.client.Query(context.Background(), &pb.DocumentQueryRequest{
+		Collection:  &pb.Collection{Name: collection.Name},
+		Limit:       int32(limit),
+		PagingToken: pagingToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &document.QueryResult{
+		Documents:   make([]document.Document, 0, len(resp.GetDocuments())),
+		PagingToken: resp.GetPagingToken(),
+	}
+
+	for _, doc := range resp.GetDocuments() {
+		result.Documents = append(result.Documents, document.Document{
+			Key:     &document.Key{Collection: collection, Id: doc.GetKey().GetId()},
+			Content: doc.GetContent().AsMap(),
+		})
+	}
+
+	return result, nil
+}
+
+// remoteStorage - reads buckets through the storage API of a membrane running on the source provider
+type remoteStorage struct {
+	storage.UnimplementedStoragePlugin
+	client pb.StorageServiceClient
+}
+
+func (r *remoteStorage) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	resp, err := r.client.ListFiles(context.Background(), &pb.StorageListFilesRequest{
+		BucketName: bucket,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*storage.FileInfo, 0, len(resp.GetFiles()))
+	for _, f := range resp.GetFiles() {
+		files = append(files, &storage.FileInfo{Key: f.GetKey(), ETag: f.GetEtag()})
+	}
+
+	return files, nil
+}
+
+func (r *remoteStorage) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	resp, err := r.client.Read(context.Background(), &pb.StorageReadRequest{
+		BucketName: bucket,
+		Key:        key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ro := storage.NewReadOptions(opts...); ro.ETag != nil {
+		*ro.ETag = resp.GetEtag()
+	}
+
+	return resp.GetBody(), nil
+}
+
+// NewRemoteSource - returns plugins reading from the membrane of the provider being migrated from, over its gRPC APIs
+func NewRemoteSource(documents pb.DocumentServiceClient, storageClient pb.StorageServiceClient) (document.DocumentService, storage.StorageService) {
+	return &remoteDocuments{client: documents}, &remoteStorage{client: storageClient}
+}
+
+// tokenCredentials - sends the migration token with every call, only over TLS
+type tokenCredentials struct {
+	token string
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// Dial - connects to the source server of a membrane running on the source provider, over TLS with the migration token
+func Dial(address string, tlsConfig *tls.Config, token string) (*grpc.ClientConn, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a token is required to read from the source")
+	}
+
+	return grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), grpc.WithPerRPCCredentials(&tokenCredentials{token: token}))
+}
+
+func authorize(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if strings.HasPrefix(value, "Bearer ") && subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(value, "Bearer ")), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid migration token")
+}
+
+// NewSourceServer - Creates a server for other membranes to migrate from, serving only the document and storage APIs,
+// over TLS to callers presenting the migration token
+func NewSourceServer(documents pb.DocumentServiceServer, storage pb.StorageServiceServer, tlsConfig *tls.Config, token string) (*grpc.Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a token is required to serve migrations")
+	}
+
+	if tlsConfig == nil || len(tlsConfig.Certificates) == 0 {
+		return nil, fmt.Errorf("a certificate is required to serve migrations")
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authorize(ctx, token); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorize(ss.Context(), token); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+
+	pb.RegisterDocumentServiceServer(server, documents)
+	pb.RegisterStorageServiceServer(server, storage)
+
+	return server, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package naming
+
+import "regexp"
+
+// The resource kinds of each provider, translating the names of the resources plugins create or find by name.
+// Resources found by their labels or tags, such as Event Grid topics, are given their logical name as a label, so only need a valid physical name
+var (
+	// Buckets - S3 and Cloud Storage buckets. Their names are unique across every account or project,
+	// so they're given a random suffix, which the 63 character limit leaves room for
+	Buckets = NewKind("bucket", Rules{
+		MaxLength: 54,
+		Invalid:   regexp.MustCompile(`[^a-z0-9-]+`),
+		Lowercase: true,
+		Prefix:    "nitric",
+	})
+
+	// SnsTopics - AWS SNS topics
+	SnsTopics = NewKind("sns topic", Rules{
+		MaxLength: 256,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9_-]+`),
+		Prefix:    "nitric",
+	})
+
+	// SqsQueues - AWS SQS queues
+	SqsQueues = NewKind("sqs queue", Rules{
+		MaxLength: 80,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9_-]+`),
+		Prefix:    "nitric",
+	})
+
+	// DynamoTables - AWS DynamoDB tables
+	DynamoTables = NewKind("dynamodb table", Rules{
+		MaxLength: 255,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9_.-]+`),
+		Prefix:    "nitric",
+	})
+
+	// AwsSecrets - AWS Secrets Manager secrets
+	AwsSecrets = NewKind("secrets manager secret", Rules{
+		MaxLength: 512,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9/_+=.@-]+`),
+		Prefix:    "nitric",
+	})
+
+	// AwsBatchJobs - AWS Batch jobs and job definitions
+	AwsBatchJobs = NewKind("batch job", Rules{
+		MaxLength: 128,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9_-]+`),
+		Prefix:    "nitric-job",
+	})
+
+	// PubsubTopics - GCP Pub/Sub topics
+	PubsubTopics = NewKind("pubsub topic", Rules{
+		MaxLength:   255,
+		Invalid:     regexp.MustCompile(`[^a-zA-Z0-9_.~+%-]+`),
+		StartLetter: true,
+		Prefix:      "nitric-",
+	})
+
+	// GcpSecrets - GCP Secret Manager secrets
+	GcpSecrets = NewKind("secret manager secret", Rules{
+		MaxLength: 255,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9_-]+`),
+		Prefix:    "nitric",
+	})
+
+	// KeyVaultSecrets - Azure Key Vault secrets
+	KeyVaultSecrets = NewKind("key vault secret", Rules{
+		MaxLength: 127,
+		Invalid:   regexp.MustCompile(`[^a-zA-Z0-9-]+`),
+		Prefix:    "nitric",
+	})
+
+	// StorageQueues - Azure Storage queues, whose names can't contain consecutive dashes
+	StorageQueues = NewKind("storage queue", Rules{
+		MaxLength: 63,
+		Invalid:   regexp.MustCompile(`[^a-z0-9]+`),
+		Lowercase: true,
+		Prefix:    "nitric",
+	})
+
+	// BlobContainers - Azure Storage blob containers, whose names can't contain consecutive dashes
+	BlobContainers = NewKind("blob container", Rules{
+		MaxLength: 63,
+		Invalid:   regexp.MustCompile(`[^a-z0-9]+`),
+		Lowercase: true,
+		Prefix:    "nitric",
+	})
+
+	// CloudRunJobs - GCP Cloud Run jobs. Each run is given a unique suffix, which the 63 character limit leaves room for
+	CloudRunJobs = NewKind("cloud run job", Rules{
+		MaxLength:   54,
+		Invalid:     regexp.MustCompile(`[^a-z0-9-]+`),
+		Lowercase:   true,
+		StartLetter: true,
+		Prefix:      "job-",
+	})
+)
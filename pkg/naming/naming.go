@@ -0,0 +1,131 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package naming - translates logical nitric resource names to physical names that are valid for each provider's resources.
+//
+// Names that are already valid are used as they are, so resources created before translation was introduced are still found.
+// Invalid characters are replaced, case is folded where the provider requires it, and names that are too long are shortened.
+// Names that are changed are given a hash of the logical name, so different logical names don't become the same physical name
+// by being cut short or by differing only in the characters replaced or their case
+package naming
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// hashLength - the length of the hash suffix given to names that are changed
+const hashLength = 8
+
+// Rules - the naming rules of a kind of provider resource
+type Rules struct {
+	// MaxLength - the longest a name may be, unlimited if zero
+	MaxLength int
+	// Invalid - matches runs of characters that can't be used in names, which are replaced with a dash
+	Invalid *regexp.Regexp
+	// Lowercase - names can't contain uppercase letters
+	Lowercase bool
+	// StartLetter - names must start with a letter
+	StartLetter bool
+	// Prefix - added to names that must start with a letter but don't, or would otherwise be empty
+	Prefix string
+}
+
+// Translate - returns the physical name for a logical name under the rules
+func (r Rules) Translate(logical string) string {
+	name := logical
+	if r.Lowercase {
+		name = strings.ToLower(name)
+	}
+
+	if r.Invalid != nil && r.Invalid.MatchString(name) {
+		name = strings.Trim(r.Invalid.ReplaceAllString(name, "-"), "-")
+	}
+
+	if name == "" || (r.StartLetter && !isLetter(name[0])) {
+		name = r.Prefix + name
+	}
+
+	if name == logical && (r.MaxLength == 0 || len(name) <= r.MaxLength) {
+		return name
+	}
+
+	if r.MaxLength > 0 && len(name) > r.MaxLength-hashLength-1 {
+		name = name[:r.MaxLength-hashLength-1]
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(logical))
+
+	return fmt.Sprintf("%s-%08x", strings.TrimRight(name, "-"), h.Sum32())
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// CollisionError - two logical names translate to the same physical name
+type CollisionError struct {
+	Kind     string
+	Logical  string
+	Existing string
+	Physical string
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("%s %s and %s both translate to the physical name %s", e.Kind, e.Logical, e.Existing, e.Physical)
+}
+
+// Kind - a kind of provider resource, whose names are translated with its rules.
+// The logical name each physical name was translated from is remembered, so collisions are detected
+type Kind struct {
+	Name  string
+	Rules Rules
+
+	lock sync.Mutex
+	// logical - the logical name each physical name was translated from
+	logical map[string]string
+}
+
+// Physical - returns the physical name for a logical name, or a CollisionError if a different logical name
+// has already been translated to it
+func (k *Kind) Physical(logical string) (string, error) {
+	physical := k.Rules.Translate(logical)
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.logical == nil {
+		k.logical = map[string]string{}
+	}
+
+	if existing, ok := k.logical[physical]; ok && existing != logical {
+		return "", &CollisionError{Kind: k.Name, Logical: logical, Existing: existing, Physical: physical}
+	}
+	k.logical[physical] = logical
+
+	return physical, nil
+}
+
+// NewKind - returns a kind of provider resource with the given naming rules
+func NewKind(name string, rules Rules) *Kind {
+	return &Kind{
+		Name:    name,
+		Rules:   rules,
+		logical: map[string]string{},
+	}
+}
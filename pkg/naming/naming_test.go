@@ -0,0 +1,133 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package naming_test
+
+import (
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/naming"
+)
+
+var _ = Describe("Naming", func() {
+	rules := naming.Rules{
+		MaxLength:   20,
+		Invalid:     regexp.MustCompile(`[^a-z0-9-]+`),
+		Lowercase:   true,
+		StartLetter: true,
+		Prefix:      "r-",
+	}
+
+	Context("Translate", func() {
+		When("the name is already valid", func() {
+			It("should use it as it is", func() {
+				Expect(rules.Translate("orders")).To(Equal("orders"))
+			})
+		})
+
+		When("the name has invalid characters", func() {
+			It("should replace them with dashes and add a hash suffix", func() {
+				Expect(rules.Translate("My_Orders.2")).To(MatchRegexp(`^my-orders-2-[0-9a-f]{8}$`))
+			})
+
+			It("should trim dashes from the ends", func() {
+				Expect(rules.Translate("__orders__")).To(MatchRegexp(`^orders-[0-9a-f]{8}$`))
+			})
+
+			It("should keep names that only differ in the characters replaced distinct", func() {
+				Expect(rules.Translate("a.b")).ToNot(Equal(rules.Translate("a_b")))
+			})
+		})
+
+		When("the name has uppercase letters that aren't allowed", func() {
+			It("should keep names that only differ in case distinct", func() {
+				Expect(rules.Translate("Orders")).ToNot(Equal(rules.Translate("ORDERS")))
+				Expect(rules.Translate("Orders")).ToNot(Equal("orders"))
+			})
+		})
+
+		When("the name must start with a letter", func() {
+			It("should prefix names that don't", func() {
+				Expect(rules.Translate("1-orders")).To(MatchRegexp(`^r-1-orders-[0-9a-f]{8}$`))
+			})
+
+			It("should prefix empty names", func() {
+				Expect(rules.Translate("")).To(MatchRegexp(`^r-[0-9a-f]{8}$`))
+			})
+		})
+
+		When("the name is too long", func() {
+			long := strings.Repeat("orders", 10)
+
+			It("should shorten it to the limit with a hash suffix", func() {
+				name := rules.Translate(long)
+				Expect(name).To(HaveLen(20))
+				Expect(name).To(HavePrefix("ordersorder-"))
+			})
+
+			It("should be consistent", func() {
+				Expect(rules.Translate(long)).To(Equal(rules.Translate(long)))
+			})
+
+			It("should keep names that only differ after the limit distinct", func() {
+				Expect(rules.Translate(long + "a")).ToNot(Equal(rules.Translate(long + "b")))
+			})
+		})
+	})
+
+	Context("Kind", func() {
+		When("different logical names translate to the same physical name", func() {
+			kind := naming.NewKind("test", rules)
+
+			It("should return a collision error", func() {
+				translated := rules.Translate("my_orders")
+
+				physical, err := kind.Physical("my_orders")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(physical).To(Equal(translated))
+
+				By("translating the same logical name again")
+				physical, err = kind.Physical("my_orders")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(physical).To(Equal(translated))
+
+				By("translating a valid logical name that's the same as the translated name")
+				_, err = kind.Physical(translated)
+				Expect(err).Should(HaveOccurred())
+
+				collision, ok := err.(*naming.CollisionError)
+				Expect(ok).To(BeTrue())
+				Expect(collision.Existing).To(Equal("my_orders"))
+				Expect(collision.Physical).To(Equal(translated))
+			})
+		})
+	})
+
+	Context("Kinds", func() {
+		It("should translate names to valid provider names", func() {
+			Expect(naming.SqsQueues.Rules.Translate("shop-prod-orders")).To(Equal("shop-prod-orders"))
+			Expect(naming.SqsQueues.Rules.Translate("shop prod orders")).To(MatchRegexp(`^shop-prod-orders-[0-9a-f]{8}$`))
+			Expect(naming.PubsubTopics.Rules.Translate("1-orders")).To(MatchRegexp(`^nitric-1-orders-[0-9a-f]{8}$`))
+			Expect(naming.KeyVaultSecrets.Rules.Translate("api_key")).To(MatchRegexp(`^api-key-[0-9a-f]{8}$`))
+			Expect(naming.CloudRunJobs.Rules.Translate("Nightly Report")).To(MatchRegexp(`^nightly-report-[0-9a-f]{8}$`))
+			Expect(naming.StorageQueues.Rules.Translate("image-jobs")).To(Equal("image-jobs"))
+			Expect(naming.StorageQueues.Rules.Translate("image--jobs")).To(MatchRegexp(`^image-jobs-[0-9a-f]{8}$`))
+			Expect(naming.BlobContainers.Rules.Translate("User_Uploads")).To(MatchRegexp(`^user-uploads-[0-9a-f]{8}$`))
+		})
+	})
+})
@@ -0,0 +1,289 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+)
+
+// DefaultCollection - the document collection pending events are persisted to, unless configured otherwise
+const DefaultCollection = "nitric-outbox"
+
+const (
+	// defaultGracePeriod - how long the relay leaves new entries for the writer that created them to publish
+	defaultGracePeriod = 30 * time.Second
+	// maxBackoff - the longest the relay will wait between attempts to publish an entry
+	maxBackoff = time.Hour
+	// drainPageSize - number of entries read from the outbox at a time
+	drainPageSize = 100
+)
+
+// PendingEvent - An event to be published to a topic once a document write succeeds
+type PendingEvent struct {
+	Topic string
+	Event *events.NitricEvent
+}
+
+// Outbox - Publishes events tied to document writes.
+//
+// Pending events are persisted to an outbox collection before the document is written and published once the write succeeds.
+// Events that fail to publish remain in the outbox and are retried by the relay, so an event is never lost once its document has been written.
+// Events may be published more than once, consumers should deduplicate using the event ID.
+type Outbox struct {
+	documents  document.DocumentService
+	events     events.EventService
+	collection *document.Collection
+	interval   time.Duration
+	grace      time.Duration
+	now        func() time.Time
+	stop       chan bool
+}
+
+func (o *Outbox) entryKey(id string) *document.Key {
+	return &document.Key{
+		Collection: o.collection,
+		Id:         id,
+	}
+}
+
+func (o *Outbox) writeEntry(id string, pending *PendingEvent, attempts int, nextAttempt time.Time) error {
+	evt := map[string]interface{}{
+		"id":          pending.Event.ID,
+		"payloadType": pending.Event.PayloadType,
+		"payload":     pending.Event.Payload,
+	}
+
+	if len(pending.Event.Attributes) > 0 {
+		attributes := make(map[string]interface{}, len(pending.Event.Attributes))
+		for k, v := range pending.Event.Attributes {
+			attributes[k] = v
+		}
+		evt["attributes"] = attributes
+	}
+
+	return o.documents.Set(o.entryKey(id), map[string]interface{}{
+		"topic":       pending.Topic,
+		"event":       evt,
+		"attempts":    attempts,
+		"nextAttempt": nextAttempt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (o *Outbox) deleteEntries(ids []string) {
+	for _, id := range ids {
+		if err := o.documents.Delete(o.entryKey(id)); err != nil {
+			log.Default().Printf("error removing outbox entry %s: %v", id, err)
+		}
+	}
+}
+
+// Set - Stores a document, publishing the pending events once it has been stored
+func (o *Outbox) Set(key *document.Key, content map[string]interface{}, pending []*PendingEvent) error {
+	ids := make([]string, 0, len(pending))
+
+	for _, p := range pending {
+		// IDs are assigned up front so retried publishes keep the same event ID
+		if p.Event.ID == "" {
+			p.Event.ID = uuid.New().String()
+		}
+
+		id := uuid.New().String()
+		if err := o.writeEntry(id, p, 0, o.now().Add(o.grace)); err != nil {
+			o.deleteEntries(ids)
+			return fmt.Errorf("unable to persist pending event: %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := o.documents.Set(key, content); err != nil {
+		o.deleteEntries(ids)
+		return err
+	}
+
+	for i, p := range pending {
+		if err := o.events.Publish(p.Topic, p.Event); err != nil {
+			// Left in the outbox for the relay to retry
+			log.Default().Printf("error publishing event %s, it will be retried: %v", p.Event.ID, err)
+			continue
+		}
+
+		o.deleteEntries(ids[i : i+1])
+	}
+
+	return nil
+}
+
+// Drain - Publishes all outbox entries that are due to be retried
+func (o *Outbox) Drain() error {
+	var pagingToken map[string]string
+
+	for {
+		result, err := o.documents.Query(o.collection, []document.QueryExpression{}, drainPageSize, pagingToken)
+		if err != nil {
+			return err
+		}
+
+		for _, doc := range result.Documents {
+			o.relay(doc)
+		}
+
+		if len(result.PagingToken) == 0 {
+			return nil
+		}
+		pagingToken = result.PagingToken
+	}
+}
+
+func (o *Outbox) relay(doc document.Document) {
+	pending, attempts, nextAttempt, err := entryFromContent(doc.Content)
+	if err != nil {
+		log.Default().Printf("skipping invalid outbox entry %s: %v", doc.Key.Id, err)
+		return
+	}
+
+	if o.now().Before(nextAttempt) {
+		return
+	}
+
+	if err := o.events.Publish(pending.Topic, pending.Event); err != nil {
+		attempts++
+		log.Default().Printf("error publishing event %s, attempt %d: %v", pending.Event.ID, attempts, err)
+
+		if err := o.writeEntry(doc.Key.Id, pending, attempts, o.now().Add(backoff(o.interval, attempts))); err != nil {
+			log.Default().Printf("error updating outbox entry %s: %v", doc.Key.Id, err)
+		}
+		return
+	}
+
+	o.deleteEntries([]string{doc.Key.Id})
+}
+
+// backoff - doubles the delay between each attempt, up to maxBackoff
+func backoff(interval time.Duration, attempts int) time.Duration {
+	delay := interval
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+
+	return delay
+}
+
+func entryFromContent(content map[string]interface{}) (*PendingEvent, int, time.Time, error) {
+	topic, _ := content["topic"].(string)
+	evt, _ := content["event"].(map[string]interface{})
+	nextAttemptStr, _ := content["nextAttempt"].(string)
+
+	if topic == "" || evt == nil {
+		return nil, 0, time.Time{}, fmt.Errorf("missing topic or event")
+	}
+
+	nextAttempt, err := time.Parse(time.RFC3339, nextAttemptStr)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	id, _ := evt["id"].(string)
+	payloadType, _ := evt["payloadType"].(string)
+	payload, _ := evt["payload"].(map[string]interface{})
+
+	var attributes map[string]string
+	if attrs, ok := evt["attributes"].(map[string]interface{}); ok {
+		attributes = make(map[string]string, len(attrs))
+		for k, v := range attrs {
+			attributes[k], _ = v.(string)
+		}
+	}
+
+	// Document plugins return numbers with differing types
+	attempts := 0
+	switch a := content["attempts"].(type) {
+	case int:
+		attempts = a
+	case int32:
+		attempts = int(a)
+	case int64:
+		attempts = int(a)
+	case float64:
+		attempts = int(a)
+	}
+
+	return &PendingEvent{
+		Topic: topic,
+		Event: &events.NitricEvent{
+			ID:          id,
+			PayloadType: payloadType,
+			Payload:     payload,
+			Attributes:  attributes,
+		},
+	}, attempts, nextAttempt, nil
+}
+
+// Start - Begins relaying outbox entries at the configured interval, until Stop is called
+func (o *Outbox) Start() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stop:
+			return
+		case <-ticker.C:
+			if err := o.Drain(); err != nil {
+				log.Default().Printf("error draining outbox: %v", err)
+			}
+		}
+	}
+}
+
+// Stop - Stops the relay
+func (o *Outbox) Stop() {
+	close(o.stop)
+}
+
+// New - Creates a new Outbox that persists pending events to the given collection, relaying them at the given interval
+func New(documents document.DocumentService, eventsPlugin events.EventService, collection string, interval time.Duration) (*Outbox, error) {
+	if documents == nil || eventsPlugin == nil {
+		return nil, fmt.Errorf("document and events plugins are required for the outbox")
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("outbox relay interval must be positive, got %s", interval)
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &Outbox{
+		documents:  documents,
+		events:     eventsPlugin,
+		collection: &document.Collection{Name: collection},
+		interval:   interval,
+		grace:      defaultGracePeriod,
+		now:        time.Now,
+		stop:       make(chan bool),
+	}, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/outbox"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+)
+
+type publishRecorder struct {
+	events.UnimplementedeventsPlugin
+	err       error
+	published []*events.NitricEvent
+}
+
+func (p *publishRecorder) Publish(topic string, event *events.NitricEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.published = append(p.published, event)
+	return nil
+}
+
+var _ = Describe("Outbox", func() {
+	key := &document.Key{
+		Collection: &document.Collection{Name: "orders"},
+		Id:         "1",
+	}
+
+	Context("Set", func() {
+		When("the document is written and the event published", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			publisher := &publishRecorder{}
+			o, _ := outbox.New(mockDocs, publisher, "", time.Second)
+
+			It("should remove the event from the outbox", func() {
+				var entryKey *document.Key
+				gomock.InOrder(
+					mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(k *document.Key, content map[string]interface{}) error {
+						entryKey = k
+						Expect(k.Collection.Name).To(Equal(outbox.DefaultCollection))
+						Expect(content["topic"]).To(Equal("created"))
+						return nil
+					}),
+					mockDocs.EXPECT().Set(key, map[string]interface{}{"test": "test"}).Return(nil),
+					mockDocs.EXPECT().Delete(gomock.Any()).DoAndReturn(func(k *document.Key) error {
+						Expect(k).To(Equal(entryKey))
+						return nil
+					}),
+				)
+
+				err := o.Set(key, map[string]interface{}{"test": "test"}, []*outbox.PendingEvent{
+					{Topic: "created", Event: &events.NitricEvent{PayloadType: "order"}},
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(publisher.published).To(HaveLen(1))
+				By("assigning the event an ID")
+				Expect(publisher.published[0].ID).ToNot(BeEmpty())
+			})
+		})
+
+		When("publishing the event fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			publisher := &publishRecorder{err: fmt.Errorf("mock error")}
+			o, _ := outbox.New(mockDocs, publisher, "", time.Second)
+
+			It("should leave the event in the outbox", func() {
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				mockDocs.EXPECT().Delete(gomock.Any()).Times(0)
+
+				err := o.Set(key, map[string]interface{}{"test": "test"}, []*outbox.PendingEvent{
+					{Topic: "created", Event: &events.NitricEvent{}},
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		When("writing the document fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			publisher := &publishRecorder{}
+			o, _ := outbox.New(mockDocs, publisher, "", time.Second)
+
+			It("should remove the event from the outbox without publishing it", func() {
+				gomock.InOrder(
+					mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil),
+					mockDocs.EXPECT().Set(key, gomock.Any()).Return(fmt.Errorf("mock error")),
+					mockDocs.EXPECT().Delete(gomock.Any()).Return(nil),
+				)
+
+				err := o.Set(key, map[string]interface{}{"test": "test"}, []*outbox.PendingEvent{
+					{Topic: "created", Event: &events.NitricEvent{}},
+				})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(publisher.published).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Drain", func() {
+		entry := func(id string, nextAttempt time.Time) document.Document {
+			return document.Document{
+				Key: &document.Key{
+					Collection: &document.Collection{Name: outbox.DefaultCollection},
+					Id:         id,
+				},
+				Content: map[string]interface{}{
+					"topic": "created",
+					"event": map[string]interface{}{
+						"id":         id,
+						"payload":    map[string]interface{}{"test": "test"},
+						"attributes": map[string]interface{}{"x-nitric-tenant": "acme"},
+					},
+					"attempts":    float64(1),
+					"nextAttempt": nextAttempt.UTC().Format(time.RFC3339),
+				},
+			}
+		}
+
+		When("entries are due", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			publisher := &publishRecorder{}
+			o, _ := outbox.New(mockDocs, publisher, "", time.Second)
+
+			It("should publish and remove only the due entries", func() {
+				mockDocs.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&document.QueryResult{
+					Documents: []document.Document{
+						entry("due", time.Now().Add(-time.Minute)),
+						entry("later", time.Now().Add(time.Minute)),
+					},
+				}, nil)
+				mockDocs.EXPECT().Delete(&document.Key{
+					Collection: &document.Collection{Name: outbox.DefaultCollection},
+					Id:         "due",
+				}).Return(nil)
+
+				Expect(o.Drain()).To(Succeed())
+				Expect(publisher.published).To(HaveLen(1))
+				Expect(publisher.published[0].ID).To(Equal("due"))
+				Expect(publisher.published[0].Payload).To(Equal(map[string]interface{}{"test": "test"}))
+				Expect(publisher.published[0].Attributes).To(HaveKeyWithValue("x-nitric-tenant", "acme"))
+			})
+		})
+
+		When("publishing a due entry fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			publisher := &publishRecorder{err: fmt.Errorf("mock error")}
+			o, _ := outbox.New(mockDocs, publisher, "", time.Second)
+
+			It("should reschedule the entry", func() {
+				mockDocs.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&document.QueryResult{
+					Documents: []document.Document{entry("due", time.Now().Add(-time.Minute))},
+				}, nil)
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(k *document.Key, content map[string]interface{}) error {
+					Expect(k.Id).To(Equal("due"))
+					Expect(content["attempts"]).To(Equal(2))
+
+					nextAttempt, err := time.Parse(time.RFC3339, content["nextAttempt"].(string))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(nextAttempt).To(BeTemporally(">", time.Now()))
+					return nil
+				})
+
+				Expect(o.Drain()).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,310 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_batch_service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbatch "github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+
+	"github.com/nitrictech/nitric/pkg/naming"
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	// logGroup - the log group AWS Batch writes job output to by default
+	logGroup = "/aws/batch/job"
+	// minTimeout - the shortest timeout AWS Batch accepts
+	minTimeout = 60 * time.Second
+	// Resources reserved by the definitions registered for images, unless the job overrides them
+	defaultVcpus     = 1
+	defaultMemoryMiB = 2048
+)
+
+// AwsBatchService - runs jobs with AWS Batch, submitting them to a single job queue
+type AwsBatchService struct {
+	batch.UnimplementedBatchPlugin
+	client   batchiface.BatchAPI
+	logs     cloudwatchlogsiface.CloudWatchLogsAPI
+	jobQueue string
+
+	lock sync.Mutex
+	// definitions - the ARNs of the job definitions registered for images, keyed by image
+	definitions map[string]string
+}
+
+func jobName(name string) (string, error) {
+	return naming.AwsBatchJobs.Physical(name)
+}
+
+func resourceRequirements(vcpus float64, memoryMiB int64) []*awsbatch.ResourceRequirement {
+	requirements := []*awsbatch.ResourceRequirement{}
+
+	if vcpus > 0 {
+		requirements = append(requirements, &awsbatch.ResourceRequirement{
+			Type:  aws.String(awsbatch.ResourceTypeVcpu),
+			Value: aws.String(fmt.Sprintf("%g", vcpus)),
+		})
+	}
+
+	if memoryMiB > 0 {
+		requirements = append(requirements, &awsbatch.ResourceRequirement{
+			Type:  aws.String(awsbatch.ResourceTypeMemory),
+			Value: aws.String(fmt.Sprintf("%d", memoryMiB)),
+		})
+	}
+
+	return requirements
+}
+
+// definitionFor - returns the job definition running the image, registering one the first time the image is run
+func (s *AwsBatchService) definitionFor(image string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if arn, ok := s.definitions[image]; ok {
+		return arn, nil
+	}
+
+	name, err := jobName("nitric-" + image)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := s.client.RegisterJobDefinition(&awsbatch.RegisterJobDefinitionInput{
+		JobDefinitionName: aws.String(name),
+		Type:              aws.String(awsbatch.JobDefinitionTypeContainer),
+		ContainerProperties: &awsbatch.ContainerProperties{
+			Image:                aws.String(image),
+			ResourceRequirements: resourceRequirements(defaultVcpus, defaultMemoryMiB),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.definitions[image] = aws.StringValue(out.JobDefinitionArn)
+
+	return s.definitions[image], nil
+}
+
+func (s *AwsBatchService) Submit(job *batch.JobSpec) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"AwsBatchService.Submit",
+		map[string]interface{}{
+			"name": job.Name,
+		},
+	)
+
+	if err := job.Validate(); err != nil {
+		return "", newErr(codes.InvalidArgument, "invalid job", err)
+	}
+
+	definition := job.Definition
+	if job.Image != "" {
+		arn, err := s.definitionFor(job.Image)
+		if err != nil {
+			return "", newErr(codes.Internal, "unable to register job definition for image", err)
+		}
+		definition = arn
+	}
+
+	name, err := jobName(job.Name)
+	if err != nil {
+		return "", newErr(codes.InvalidArgument, "invalid job name", err)
+	}
+
+	overrides := &awsbatch.ContainerOverrides{
+		Command:              aws.StringSlice(job.Command),
+		ResourceRequirements: resourceRequirements(job.Cpu, job.MemoryMiB),
+	}
+	for k, v := range job.Env {
+		overrides.Environment = append(overrides.Environment, &awsbatch.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	input := &awsbatch.SubmitJobInput{
+		JobName:            aws.String(name),
+		JobQueue:           aws.String(s.jobQueue),
+		JobDefinition:      aws.String(definition),
+		ContainerOverrides: overrides,
+	}
+
+	if job.Timeout > 0 {
+		timeout := job.Timeout
+		if timeout < minTimeout {
+			timeout = minTimeout
+		}
+		input.Timeout = &awsbatch.JobTimeout{
+			AttemptDurationSeconds: aws.Int64(int64(timeout.Seconds())),
+		}
+	}
+
+	out, err := s.client.SubmitJob(input)
+	if err != nil {
+		return "", newErr(codes.Internal, "unable to submit job", err)
+	}
+
+	return aws.StringValue(out.JobId), nil
+}
+
+func (s *AwsBatchService) describe(id string) (*awsbatch.JobDetail, error) {
+	out, err := s.client.DescribeJobs(&awsbatch.DescribeJobsInput{
+		Jobs: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Jobs) == 0 {
+		return nil, nil
+	}
+
+	return out.Jobs[0], nil
+}
+
+func millisToTime(millis *int64) time.Time {
+	if millis == nil || *millis == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, *millis*int64(time.Millisecond)).UTC()
+}
+
+func jobState(status string) batch.JobState {
+	switch status {
+	case awsbatch.JobStatusRunning:
+		return batch.Running
+	case awsbatch.JobStatusSucceeded:
+		return batch.Succeeded
+	case awsbatch.JobStatusFailed:
+		return batch.Failed
+	default:
+		// Submitted, pending, runnable and starting
+		return batch.Pending
+	}
+}
+
+func (s *AwsBatchService) Status(id string) (*batch.JobStatus, error) {
+	newErr := errors.ErrorsWithScope(
+		"AwsBatchService.Status",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	job, err := s.describe(id)
+	if err != nil {
+		return nil, newErr(codes.Internal, "unable to describe job", err)
+	}
+
+	if job == nil {
+		return nil, newErr(codes.NotFound, "job not found", nil)
+	}
+
+	return &batch.JobStatus{
+		Id:        id,
+		State:     jobState(aws.StringValue(job.Status)),
+		Reason:    aws.StringValue(job.StatusReason),
+		StartedAt: millisToTime(job.StartedAt),
+		StoppedAt: millisToTime(job.StoppedAt),
+	}, nil
+}
+
+func (s *AwsBatchService) Logs(id string, pagingToken string) (*batch.LogResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"AwsBatchService.Logs",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	job, err := s.describe(id)
+	if err != nil {
+		return nil, newErr(codes.Internal, "unable to describe job", err)
+	}
+
+	if job == nil {
+		return nil, newErr(codes.NotFound, "job not found", nil)
+	}
+
+	// The log stream is created once the job starts
+	if job.Container == nil || job.Container.LogStreamName == nil {
+		return &batch.LogResult{Entries: []*batch.LogEntry{}}, nil
+	}
+
+	input := &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(logGroup),
+		LogStreamName: job.Container.LogStreamName,
+		StartFromHead: aws.Bool(true),
+	}
+	if pagingToken != "" {
+		input.NextToken = aws.String(pagingToken)
+	}
+
+	out, err := s.logs.GetLogEvents(input)
+	if err != nil {
+		return nil, newErr(codes.Internal, "unable to get job logs", err)
+	}
+
+	entries := make([]*batch.LogEntry, 0, len(out.Events))
+	for _, e := range out.Events {
+		entries = append(entries, &batch.LogEntry{
+			Time:    millisToTime(e.Timestamp),
+			Message: aws.StringValue(e.Message),
+		})
+	}
+
+	return &batch.LogResult{
+		Entries:     entries,
+		PagingToken: aws.StringValue(out.NextForwardToken),
+	}, nil
+}
+
+// New - creates an AWS Batch plugin submitting jobs to the queue named by the BATCH_JOB_QUEUE env var
+func New(provider core.AwsProvider) (batch.BatchService, error) {
+	jobQueue := utils.GetEnv("BATCH_JOB_QUEUE", "")
+	if jobQueue == "" {
+		return nil, fmt.Errorf("BATCH_JOB_QUEUE env var is required for batch jobs")
+	}
+
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClients(awsbatch.New(sess), cloudwatchlogs.New(sess), jobQueue), nil
+}
+
+func NewWithClients(client batchiface.BatchAPI, logs cloudwatchlogsiface.CloudWatchLogsAPI, jobQueue string) batch.BatchService {
+	return &AwsBatchService{
+		client:      client,
+		logs:        logs,
+		jobQueue:    jobQueue,
+		definitions: map[string]string{},
+	}
+}
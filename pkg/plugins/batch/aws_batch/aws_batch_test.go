@@ -0,0 +1,200 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_batch_service
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbatch "github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("AwsBatch", func() {
+	var client *mockBatch
+	var logs *mockLogs
+	var plugin batch.BatchService
+
+	BeforeEach(func() {
+		client = &mockBatch{}
+		logs = &mockLogs{}
+		plugin = NewWithClients(client, logs, "test-queue")
+	})
+
+	Context("Submit", func() {
+		When("Submitting a job for a registered definition", func() {
+			It("Should submit the job with its overrides", func() {
+				id, err := plugin.Submit(&batch.JobSpec{
+					Name:       "nightly report",
+					Definition: "report:3",
+					Command:    []string{"report", "--all"},
+					Env:        map[string]string{"MODE": "full"},
+					Cpu:        0.5,
+					MemoryMiB:  1024,
+					Timeout:    10 * time.Second,
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).To(Equal("job-1"))
+
+				By("Not registering a definition")
+				Expect(client.registered).To(BeEmpty())
+
+				By("Submitting to the configured queue")
+				input := client.submitted[0]
+				Expect(*input.JobQueue).To(Equal("test-queue"))
+				Expect(*input.JobDefinition).To(Equal("report:3"))
+				Expect(*input.JobName).To(MatchRegexp(`^nightly-report-[0-9a-f]{8}$`))
+				Expect(aws.StringValueSlice(input.ContainerOverrides.Command)).To(Equal([]string{"report", "--all"}))
+				Expect(*input.ContainerOverrides.Environment[0].Name).To(Equal("MODE"))
+				Expect(*input.ContainerOverrides.ResourceRequirements[0].Value).To(Equal("0.5"))
+				Expect(*input.ContainerOverrides.ResourceRequirements[1].Value).To(Equal("1024"))
+
+				By("Raising the timeout to the minimum AWS Batch accepts")
+				Expect(*input.Timeout.AttemptDurationSeconds).To(Equal(int64(60)))
+			})
+		})
+
+		When("Submitting jobs for an image", func() {
+			It("Should register a definition for the image once", func() {
+				for i := 0; i < 2; i++ {
+					_, err := plugin.Submit(&batch.JobSpec{Image: "example/report:latest"})
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+
+				Expect(client.registered).To(HaveLen(1))
+				Expect(*client.registered[0].ContainerProperties.Image).To(Equal("example/report:latest"))
+				Expect(*client.submitted[0].JobDefinition).To(Equal("arn:definition"))
+				Expect(*client.submitted[1].JobDefinition).To(Equal("arn:definition"))
+			})
+		})
+
+		When("The job has neither a definition nor an image", func() {
+			It("Should return an invalid argument error", func() {
+				_, err := plugin.Submit(&batch.JobSpec{Name: "empty"})
+
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(client.submitted).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Status", func() {
+		When("The job exists", func() {
+			It("Should return its state", func() {
+				client.jobs = []*awsbatch.JobDetail{{
+					JobId:        aws.String("job-1"),
+					Status:       aws.String(awsbatch.JobStatusFailed),
+					StatusReason: aws.String("Essential container in task exited"),
+					StartedAt:    aws.Int64(1000),
+					StoppedAt:    aws.Int64(5000),
+				}}
+
+				status, err := plugin.Status("job-1")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(status.State).To(Equal(batch.Failed))
+				Expect(status.Reason).To(Equal("Essential container in task exited"))
+				Expect(status.StoppedAt.Sub(status.StartedAt)).To(Equal(4 * time.Second))
+			})
+		})
+
+		When("The job doesn't exist", func() {
+			It("Should return a not found error", func() {
+				_, err := plugin.Status("missing")
+
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("Logs", func() {
+		When("The job hasn't started", func() {
+			It("Should return no entries", func() {
+				client.jobs = []*awsbatch.JobDetail{{JobId: aws.String("job-1"), Status: aws.String(awsbatch.JobStatusRunnable)}}
+
+				result, err := plugin.Logs("job-1", "")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Entries).To(BeEmpty())
+				Expect(logs.input).To(BeNil())
+			})
+		})
+
+		When("The job has started", func() {
+			It("Should read the job's log stream from the token", func() {
+				client.jobs = []*awsbatch.JobDetail{{
+					JobId:     aws.String("job-1"),
+					Status:    aws.String(awsbatch.JobStatusRunning),
+					Container: &awsbatch.ContainerDetail{LogStreamName: aws.String("report/default/abc")},
+				}}
+				logs.events = []*cloudwatchlogs.OutputLogEvent{{Message: aws.String("started"), Timestamp: aws.Int64(1000)}}
+
+				result, err := plugin.Logs("job-1", "f/1")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(*logs.input.LogGroupName).To(Equal("/aws/batch/job"))
+				Expect(*logs.input.LogStreamName).To(Equal("report/default/abc"))
+				Expect(*logs.input.NextToken).To(Equal("f/1"))
+				Expect(result.Entries[0].Message).To(Equal("started"))
+				Expect(result.PagingToken).To(Equal("f/2"))
+			})
+		})
+	})
+})
+
+type mockBatch struct {
+	batchiface.BatchAPI
+	registered []*awsbatch.RegisterJobDefinitionInput
+	submitted  []*awsbatch.SubmitJobInput
+	jobs       []*awsbatch.JobDetail
+}
+
+func (m *mockBatch) RegisterJobDefinition(input *awsbatch.RegisterJobDefinitionInput) (*awsbatch.RegisterJobDefinitionOutput, error) {
+	m.registered = append(m.registered, input)
+	return &awsbatch.RegisterJobDefinitionOutput{JobDefinitionArn: aws.String("arn:definition")}, nil
+}
+
+func (m *mockBatch) SubmitJob(input *awsbatch.SubmitJobInput) (*awsbatch.SubmitJobOutput, error) {
+	m.submitted = append(m.submitted, input)
+	return &awsbatch.SubmitJobOutput{JobId: aws.String("job-1")}, nil
+}
+
+func (m *mockBatch) DescribeJobs(input *awsbatch.DescribeJobsInput) (*awsbatch.DescribeJobsOutput, error) {
+	return &awsbatch.DescribeJobsOutput{Jobs: m.jobs}, nil
+}
+
+type mockLogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	input  *cloudwatchlogs.GetLogEventsInput
+	events []*cloudwatchlogs.OutputLogEvent
+}
+
+func (m *mockLogs) GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error) {
+	m.input = input
+	return &cloudwatchlogs.GetLogEventsOutput{
+		Events:           m.events,
+		NextForwardToken: aws.String("f/2"),
+	}, nil
+}
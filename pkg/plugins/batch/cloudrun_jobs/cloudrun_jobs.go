@@ -0,0 +1,415 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun_jobs_service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/api/googleapi"
+	logging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v1"
+
+	"github.com/nitrictech/nitric/pkg/naming"
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// logPageSize - the most log entries returned by a single call to Logs
+const logPageSize = 1000
+
+const (
+	// oneOffLabel - labels the one-off jobs created to run jobs with overrides, so they can be deleted once they've finished
+	oneOffLabel = "nitric-one-off"
+	// oneOffRetention - how long one-off jobs are kept after their execution finishes, deleting a job deletes its executions
+	// so their status can't be read afterwards
+	oneOffRetention = 24 * time.Hour
+	// sweepInterval - the least time between deleting finished one-off jobs
+	sweepInterval = 10 * time.Minute
+)
+
+// CloudRunJobsService - runs jobs as Cloud Run Jobs executions, the id of a job is the name of its execution
+type CloudRunJobsService struct {
+	batch.UnimplementedBatchPlugin
+	run       *run.APIService
+	logging   *logging.Service
+	projectId string
+
+	sweepLock sync.Mutex
+	lastSweep time.Time
+}
+
+// jobName - returns a unique name for a one-off Cloud Run job, which must start with a letter and be at most 63 characters
+func jobName(name string) (string, error) {
+	physical, err := naming.CloudRunJobs.Physical(name)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", physical, uuid.NewString()[:8]), nil
+}
+
+func (s *CloudRunJobsService) jobPath(name string) string {
+	return fmt.Sprintf("namespaces/%s/jobs/%s", s.projectId, name)
+}
+
+func (s *CloudRunJobsService) executionPath(name string) string {
+	return fmt.Sprintf("namespaces/%s/executions/%s", s.projectId, name)
+}
+
+func hasOverrides(job *batch.JobSpec) bool {
+	return len(job.Command) > 0 || len(job.Env) > 0 || job.Cpu > 0 || job.MemoryMiB > 0 || job.Timeout > 0
+}
+
+func applyOverrides(task *run.TaskSpec, job *batch.JobSpec) {
+	container := task.Containers[0]
+
+	if len(job.Command) > 0 {
+		container.Command = job.Command
+	}
+
+	for k, v := range job.Env {
+		container.Env = append(container.Env, &run.EnvVar{Name: k, Value: v})
+	}
+
+	if job.Cpu > 0 || job.MemoryMiB > 0 {
+		if container.Resources == nil {
+			container.Resources = &run.ResourceRequirements{}
+		}
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = map[string]string{}
+		}
+		if job.Cpu > 0 {
+			container.Resources.Limits["cpu"] = fmt.Sprintf("%g", job.Cpu)
+		}
+		if job.MemoryMiB > 0 {
+			container.Resources.Limits["memory"] = fmt.Sprintf("%dMi", job.MemoryMiB)
+		}
+	}
+
+	if job.Timeout > 0 {
+		task.TimeoutSeconds = int64(job.Timeout.Seconds())
+	}
+}
+
+// template - returns the execution template of the job, copied from its definition when it has one
+func (s *CloudRunJobsService) template(job *batch.JobSpec) (*run.ExecutionTemplateSpec, error) {
+	if job.Definition != "" {
+		existing, err := s.run.Namespaces.Jobs.Get(s.jobPath(job.Definition)).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		return existing.Spec.Template, nil
+	}
+
+	return &run.ExecutionTemplateSpec{
+		Spec: &run.ExecutionSpec{
+			TaskCount: 1,
+			Template: &run.TaskTemplateSpec{
+				Spec: &run.TaskSpec{
+					Containers: []*run.Container{{Image: job.Image}},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *CloudRunJobsService) Submit(job *batch.JobSpec) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudRunJobsService.Submit",
+		map[string]interface{}{
+			"name": job.Name,
+		},
+	)
+
+	if err := job.Validate(); err != nil {
+		return "", newErr(codes.InvalidArgument, "invalid job", err)
+	}
+
+	// Executions can't override their job's template, so jobs with overrides run as a one-off copy of it
+	name := job.Definition
+	oneOff := job.Image != "" || hasOverrides(job)
+	if oneOff {
+		template, err := s.template(job)
+		if err != nil {
+			return "", newErr(codeFor(err), "unable to read job definition", err)
+		}
+		applyOverrides(template.Spec.Template.Spec, job)
+
+		jobNameBase := job.Name
+		if jobNameBase == "" {
+			jobNameBase = job.Definition
+		}
+
+		oneOffName, err := jobName(jobNameBase)
+		if err != nil {
+			return "", newErr(codes.InvalidArgument, "invalid job name", err)
+		}
+
+		created, err := s.run.Namespaces.Jobs.Create(fmt.Sprintf("namespaces/%s", s.projectId), &run.Job{
+			ApiVersion: "run.googleapis.com/v1",
+			Kind:       "Job",
+			Metadata: &run.ObjectMeta{
+				Name:      oneOffName,
+				Namespace: s.projectId,
+				Labels: map[string]string{
+					oneOffLabel: "true",
+				},
+				Annotations: map[string]string{
+					"run.googleapis.com/launch-stage": "BETA",
+				},
+			},
+			Spec: &run.JobSpec{Template: template},
+		}).Do()
+		if err != nil {
+			return "", newErr(codeFor(err), "unable to create job", err)
+		}
+		name = created.Metadata.Name
+
+		// One-off jobs run once, so each submission would otherwise leave a job behind
+		defer s.sweep()
+	}
+
+	execution, err := s.run.Namespaces.Jobs.Run(s.jobPath(name), &run.RunJobRequest{}).Do()
+	if err != nil {
+		if oneOff {
+			s.deleteJob(name)
+		}
+		return "", newErr(codeFor(err), "unable to run job", err)
+	}
+
+	return execution.Metadata.Name, nil
+}
+
+func (s *CloudRunJobsService) deleteJob(name string) {
+	if _, err := s.run.Namespaces.Jobs.Delete(s.jobPath(name)).PropagationPolicy("Background").Do(); err != nil {
+		log.Default().Printf("error deleting one-off cloud run job %s: %v", name, err)
+	}
+}
+
+// finishedBefore - returns true if the latest execution of the job completed before the given time
+func (s *CloudRunJobsService) finishedBefore(job *run.Job, before time.Time) bool {
+	if job.Status == nil || job.Status.LatestCreatedExecution == nil {
+		return false
+	}
+
+	execution, err := s.run.Namespaces.Executions.Get(s.executionPath(job.Status.LatestCreatedExecution.Name)).Do()
+	if err != nil || execution.Status == nil {
+		return false
+	}
+
+	completed := parseTime(execution.Status.CompletionTime)
+	return !completed.IsZero() && completed.Before(before)
+}
+
+// sweep - deletes the one-off jobs whose executions finished more than oneOffRetention ago, at most once per sweepInterval
+func (s *CloudRunJobsService) sweep() {
+	s.sweepLock.Lock()
+	if time.Since(s.lastSweep) < sweepInterval {
+		s.sweepLock.Unlock()
+		return
+	}
+	s.lastSweep = time.Now()
+	s.sweepLock.Unlock()
+
+	cutoff := time.Now().Add(-oneOffRetention)
+	call := s.run.Namespaces.Jobs.List(fmt.Sprintf("namespaces/%s", s.projectId)).LabelSelector(oneOffLabel + "=true")
+	for {
+		resp, err := call.Do()
+		if err != nil {
+			log.Default().Printf("error listing one-off cloud run jobs: %v", err)
+			return
+		}
+
+		for _, job := range resp.Items {
+			if job.Metadata != nil && s.finishedBefore(job, cutoff) {
+				s.deleteJob(job.Metadata.Name)
+			}
+		}
+
+		if resp.Metadata == nil || resp.Metadata.Continue == "" {
+			return
+		}
+		call = call.Continue(resp.Metadata.Continue)
+	}
+}
+
+func parseTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+func (s *CloudRunJobsService) Status(id string) (*batch.JobStatus, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudRunJobsService.Status",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	execution, err := s.run.Namespaces.Executions.Get(s.executionPath(id)).Do()
+	if err != nil {
+		return nil, newErr(codeFor(err), "unable to get execution", err)
+	}
+
+	status := &batch.JobStatus{
+		Id:    id,
+		State: batch.Pending,
+	}
+
+	if execution.Status == nil {
+		return status, nil
+	}
+
+	status.StartedAt = parseTime(execution.Status.StartTime)
+	status.StoppedAt = parseTime(execution.Status.CompletionTime)
+
+	if execution.Status.StartTime != "" {
+		status.State = batch.Running
+	}
+
+	for _, condition := range execution.Status.Conditions {
+		if condition.Type != "Completed" {
+			continue
+		}
+
+		switch condition.Status {
+		case "True":
+			status.State = batch.Succeeded
+		case "False":
+			status.State = batch.Failed
+			status.Reason = condition.Message
+		}
+	}
+
+	return status, nil
+}
+
+// Logs - returns the entries written by the execution's tasks, the paging token is the timestamp of the last entry returned
+func (s *CloudRunJobsService) Logs(id string, pagingToken string) (*batch.LogResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudRunJobsService.Logs",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	filter := fmt.Sprintf(`resource.type="cloud_run_job" AND labels."run.googleapis.com/execution_name"="%s"`, id)
+	if pagingToken != "" {
+		filter = fmt.Sprintf(`%s AND timestamp>"%s"`, filter, pagingToken)
+	}
+
+	resp, err := s.logging.Entries.List(&logging.ListLogEntriesRequest{
+		ResourceNames: []string{fmt.Sprintf("projects/%s", s.projectId)},
+		Filter:        filter,
+		OrderBy:       "timestamp asc",
+		PageSize:      logPageSize,
+	}).Do()
+	if err != nil {
+		return nil, newErr(codeFor(err), "unable to list log entries", err)
+	}
+
+	result := &batch.LogResult{
+		Entries:     make([]*batch.LogEntry, 0, len(resp.Entries)),
+		PagingToken: pagingToken,
+	}
+
+	for _, e := range resp.Entries {
+		message := e.TextPayload
+		if message == "" && len(e.JsonPayload) > 0 {
+			message = string(e.JsonPayload)
+		}
+
+		result.Entries = append(result.Entries, &batch.LogEntry{
+			Time:    parseTime(e.Timestamp),
+			Message: message,
+		})
+		result.PagingToken = e.Timestamp
+	}
+
+	return result, nil
+}
+
+func codeFor(err error) codes.Code {
+	if apiErr, ok := err.(*googleapi.Error); ok {
+		switch apiErr.Code {
+		case http.StatusNotFound:
+			return codes.NotFound
+		case http.StatusConflict:
+			return codes.AlreadyExists
+		case http.StatusForbidden:
+			return codes.PermissionDenied
+		case http.StatusBadRequest:
+			return codes.InvalidArgument
+		}
+	}
+
+	return codes.Internal
+}
+
+// New - creates a Cloud Run Jobs plugin running jobs in the region named by the BATCH_REGION env var
+func New(provider core.GcpProvider) (batch.BatchService, error) {
+	region := utils.GetEnv("BATCH_REGION", "")
+	if region == "" {
+		return nil, fmt.Errorf("BATCH_REGION env var is required for batch jobs")
+	}
+
+	credentials, err := provider.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	projectId, err := provider.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	// Jobs are only served by the regional endpoints
+	runService, err := run.NewService(ctx, option.WithCredentials(credentials), option.WithEndpoint(fmt.Sprintf("https://%s-run.googleapis.com/", region)))
+	if err != nil {
+		return nil, fmt.Errorf("cloud run client error: %v", err)
+	}
+
+	loggingService, err := logging.NewService(ctx, option.WithCredentials(credentials))
+	if err != nil {
+		return nil, fmt.Errorf("logging client error: %v", err)
+	}
+
+	return NewWithServices(runService, loggingService, projectId), nil
+}
+
+func NewWithServices(runService *run.APIService, loggingService *logging.Service, projectId string) batch.BatchService {
+	return &CloudRunJobsService{
+		run:       runService,
+		logging:   loggingService,
+		projectId: projectId,
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun_jobs_service
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	logging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   []byte
+}
+
+var _ = Describe("CloudRunJobs", func() {
+	var server *httptest.Server
+	var requests []recordedRequest
+	var responses map[string]interface{}
+	var plugin batch.BatchService
+
+	BeforeEach(func() {
+		requests = []recordedRequest{}
+		responses = map[string]interface{}{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests = append(requests, recordedRequest{method: r.Method, path: r.URL.Path, body: body})
+
+			resp, ok := responses[r.Method+" "+r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "not found"}}`))
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+
+		runService, err := run.NewService(context.TODO(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+		Expect(err).ShouldNot(HaveOccurred())
+		loggingService, err := logging.NewService(context.TODO(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		plugin = NewWithServices(runService, loggingService, "test-project")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("Submit", func() {
+		When("Running a job definition without overrides", func() {
+			It("Should run the existing job", func() {
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs/report:run"] = &run.Execution{
+					Metadata: &run.ObjectMeta{Name: "report-abc12"},
+				}
+
+				id, err := plugin.Submit(&batch.JobSpec{Definition: "report"})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).To(Equal("report-abc12"))
+				Expect(requests).To(HaveLen(1))
+			})
+		})
+
+		When("Running an image", func() {
+			It("Should create a one-off job for the image and run it", func() {
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs"] = &run.Job{
+					Metadata: &run.ObjectMeta{Name: "nightly-report-1234abcd"},
+				}
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-1234abcd:run"] = &run.Execution{
+					Metadata: &run.ObjectMeta{Name: "nightly-report-1234abcd-xyz"},
+				}
+
+				id, err := plugin.Submit(&batch.JobSpec{
+					Name:      "Nightly Report",
+					Image:     "gcr.io/test-project/report",
+					Env:       map[string]string{"MODE": "full"},
+					MemoryMiB: 512,
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).To(Equal("nightly-report-1234abcd-xyz"))
+
+				created := &run.Job{}
+				Expect(json.Unmarshal(requests[0].body, created)).To(Succeed())
+				Expect(created.Metadata.Name).To(HavePrefix("nightly-report-"))
+
+				container := created.Spec.Template.Spec.Template.Spec.Containers[0]
+				Expect(container.Image).To(Equal("gcr.io/test-project/report"))
+				Expect(container.Env[0].Name).To(Equal("MODE"))
+				Expect(container.Resources.Limits["memory"]).To(Equal("512Mi"))
+				Expect(created.Metadata.Labels).To(HaveKeyWithValue("nitric-one-off", "true"))
+			})
+
+			It("Should delete one-off jobs that finished running", func() {
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs"] = &run.Job{
+					Metadata: &run.ObjectMeta{Name: "nightly-report-1234abcd"},
+				}
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-1234abcd:run"] = &run.Execution{
+					Metadata: &run.ObjectMeta{Name: "nightly-report-1234abcd-xyz"},
+				}
+				responses["GET /apis/run.googleapis.com/v1/namespaces/test-project/jobs"] = &run.ListJobsResponse{
+					Items: []*run.Job{{
+						Metadata: &run.ObjectMeta{Name: "nightly-report-0000abcd"},
+						Status: &run.JobStatus{
+							LatestCreatedExecution: &run.ExecutionReference{Name: "nightly-report-0000abcd-xyz"},
+						},
+					}},
+				}
+				responses["GET /apis/run.googleapis.com/v1/namespaces/test-project/executions/nightly-report-0000abcd-xyz"] = &run.Execution{
+					Status: &run.ExecutionStatus{CompletionTime: "2022-01-01T00:01:00Z"},
+				}
+				responses["DELETE /apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-0000abcd"] = &run.Status{}
+
+				_, err := plugin.Submit(&batch.JobSpec{
+					Name:  "Nightly Report",
+					Image: "gcr.io/test-project/report",
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(requests[len(requests)-1].method).To(Equal("DELETE"))
+				Expect(requests[len(requests)-1].path).To(Equal("/apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-0000abcd"))
+			})
+
+			It("Should delete the one-off job if it can't be run", func() {
+				responses["POST /apis/run.googleapis.com/v1/namespaces/test-project/jobs"] = &run.Job{
+					Metadata: &run.ObjectMeta{Name: "nightly-report-1234abcd"},
+				}
+				responses["DELETE /apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-1234abcd"] = &run.Status{}
+
+				_, err := plugin.Submit(&batch.JobSpec{
+					Name:  "Nightly Report",
+					Image: "gcr.io/test-project/report",
+				})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(requests[2].method).To(Equal("DELETE"))
+				Expect(requests[2].path).To(Equal("/apis/run.googleapis.com/v1/namespaces/test-project/jobs/nightly-report-1234abcd"))
+			})
+		})
+
+		When("The job definition doesn't exist", func() {
+			It("Should return a not found error", func() {
+				_, err := plugin.Submit(&batch.JobSpec{Definition: "missing"})
+
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("Status", func() {
+		When("The execution failed", func() {
+			It("Should return the failure and its reason", func() {
+				responses["GET /apis/run.googleapis.com/v1/namespaces/test-project/executions/report-abc12"] = &run.Execution{
+					Status: &run.ExecutionStatus{
+						StartTime:      "2022-01-01T00:00:00Z",
+						CompletionTime: "2022-01-01T00:01:00Z",
+						Conditions: []*run.GoogleCloudRunV1Condition{
+							{Type: "Completed", Status: "False", Message: "Task report-abc12-0 failed"},
+						},
+					},
+				}
+
+				status, err := plugin.Status("report-abc12")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(status.State).To(Equal(batch.Failed))
+				Expect(status.Reason).To(Equal("Task report-abc12-0 failed"))
+				Expect(status.StoppedAt.Sub(status.StartedAt).Minutes()).To(Equal(1.0))
+			})
+		})
+
+		When("The execution has started", func() {
+			It("Should return running", func() {
+				responses["GET /apis/run.googleapis.com/v1/namespaces/test-project/executions/report-abc12"] = &run.Execution{
+					Status: &run.ExecutionStatus{
+						StartTime: "2022-01-01T00:00:00Z",
+						Conditions: []*run.GoogleCloudRunV1Condition{
+							{Type: "Completed", Status: "Unknown"},
+						},
+					},
+				}
+
+				status, err := plugin.Status("report-abc12")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(status.State).To(Equal(batch.Running))
+			})
+		})
+	})
+
+	Context("Logs", func() {
+		It("Should list the execution's entries after the paging token", func() {
+			responses["POST /v2/entries:list"] = &logging.ListLogEntriesResponse{
+				Entries: []*logging.LogEntry{
+					{TextPayload: "started", Timestamp: "2022-01-01T00:00:01Z"},
+					{TextPayload: "done", Timestamp: "2022-01-01T00:00:02Z"},
+				},
+			}
+
+			result, err := plugin.Logs("report-abc12", "2022-01-01T00:00:00Z")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Entries).To(HaveLen(2))
+			Expect(result.Entries[1].Message).To(Equal("done"))
+			Expect(result.PagingToken).To(Equal("2022-01-01T00:00:02Z"))
+
+			req := &logging.ListLogEntriesRequest{}
+			Expect(json.Unmarshal(requests[0].body, req)).To(Succeed())
+			Expect(req.Filter).To(ContainSubstring(`labels."run.googleapis.com/execution_name"="report-abc12"`))
+			Expect(req.Filter).To(ContainSubstring(`timestamp>"2022-01-01T00:00:00Z"`))
+		})
+	})
+})
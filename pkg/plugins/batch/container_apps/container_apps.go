@@ -0,0 +1,414 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container_apps_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	apiVersion         = "2023-05-01"
+	managementEndpoint = "https://management.azure.com"
+	logAnalytics       = "https://api.loganalytics.io"
+	// logPageSize - the most log entries returned by a single call to Logs
+	logPageSize = 1000
+)
+
+// ContainerAppsJobsService - runs jobs as Container Apps job executions, the id of a job is {job}/{execution}
+type ContainerAppsJobsService struct {
+	batch.UnimplementedBatchPlugin
+	client *http.Client
+
+	management           string
+	managementAuthorizer autorest.Authorizer
+	subscriptionId       string
+	resourceGroup        string
+	// defaultJob - the job started with its container replaced to run images
+	defaultJob string
+
+	logs           string
+	logsAuthorizer autorest.Authorizer
+	// workspace - the Log Analytics workspace of the Container Apps environment, logs are unavailable without it
+	workspace string
+}
+
+type environmentVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+type containerResources struct {
+	Cpu    float64 `json:"cpu,omitempty"`
+	Memory string  `json:"memory,omitempty"`
+}
+
+type container struct {
+	Name      string              `json:"name"`
+	Image     string              `json:"image"`
+	Command   []string            `json:"command,omitempty"`
+	Args      []string            `json:"args,omitempty"`
+	Env       []environmentVar    `json:"env,omitempty"`
+	Resources *containerResources `json:"resources,omitempty"`
+}
+
+type executionTemplate struct {
+	Containers []*container `json:"containers"`
+}
+
+type jobResource struct {
+	Properties struct {
+		Template executionTemplate `json:"template"`
+	} `json:"properties"`
+}
+
+type executionResource struct {
+	Name       string `json:"name"`
+	Properties struct {
+		Status    string `json:"status"`
+		StartTime string `json:"startTime"`
+		EndTime   string `json:"endTime"`
+	} `json:"properties"`
+}
+
+type queryResult struct {
+	Tables []struct {
+		Rows [][]interface{} `json:"rows"`
+	} `json:"tables"`
+}
+
+type statusError struct {
+	status int
+	body   string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%d: %s", e.status, e.body)
+}
+
+func codeFor(err error) codes.Code {
+	if statusErr, ok := err.(*statusError); ok {
+		switch statusErr.status {
+		case http.StatusNotFound:
+			return codes.NotFound
+		case http.StatusBadRequest:
+			return codes.InvalidArgument
+		case http.StatusForbidden, http.StatusUnauthorized:
+			return codes.PermissionDenied
+		}
+	}
+
+	return codes.Internal
+}
+
+func (s *ContainerAppsJobsService) do(authorizer autorest.Authorizer, method string, url string, body interface{}, out interface{}) error {
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader([]byte{})
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &statusError{status: resp.StatusCode, body: string(respBody)}
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(respBody, out)
+}
+
+func (s *ContainerAppsJobsService) jobUrl(job string, suffix string) string {
+	return fmt.Sprintf(
+		"%s/subscriptions/%s/resourceGroups/%s/providers/Microsoft.App/jobs/%s%s?api-version=%s",
+		s.management, s.subscriptionId, s.resourceGroup, job, suffix, apiVersion,
+	)
+}
+
+func hasOverrides(job *batch.JobSpec) bool {
+	return len(job.Command) > 0 || len(job.Env) > 0 || job.Cpu > 0 || job.MemoryMiB > 0
+}
+
+// template - returns the containers the execution runs, nil when the job's own template is used unchanged
+func (s *ContainerAppsJobsService) template(job *batch.JobSpec) (*executionTemplate, error) {
+	if job.Image == "" && !hasOverrides(job) {
+		return nil, nil
+	}
+
+	c := &container{Name: "main", Image: job.Image}
+
+	if job.Definition != "" {
+		existing := &jobResource{}
+		if err := s.do(s.managementAuthorizer, http.MethodGet, s.jobUrl(job.Definition, ""), nil, existing); err != nil {
+			return nil, err
+		}
+
+		if len(existing.Properties.Template.Containers) == 0 {
+			return nil, fmt.Errorf("job %s has no containers", job.Definition)
+		}
+		c = existing.Properties.Template.Containers[0]
+	}
+
+	if len(job.Command) > 0 {
+		c.Command = job.Command
+		c.Args = nil
+	}
+
+	for k, v := range job.Env {
+		c.Env = append(c.Env, environmentVar{Name: k, Value: v})
+	}
+
+	if job.Cpu > 0 || job.MemoryMiB > 0 {
+		if c.Resources == nil {
+			c.Resources = &containerResources{}
+		}
+		if job.Cpu > 0 {
+			c.Resources.Cpu = job.Cpu
+		}
+		if job.MemoryMiB > 0 {
+			c.Resources.Memory = fmt.Sprintf("%gGi", float64(job.MemoryMiB)/1024)
+		}
+	}
+
+	return &executionTemplate{Containers: []*container{c}}, nil
+}
+
+func (s *ContainerAppsJobsService) Submit(job *batch.JobSpec) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"ContainerAppsJobsService.Submit",
+		map[string]interface{}{
+			"name": job.Name,
+		},
+	)
+
+	if err := job.Validate(); err != nil {
+		return "", newErr(codes.InvalidArgument, "invalid job", err)
+	}
+
+	// Executions can override the containers of their job, but not its replica timeout
+	if job.Timeout > 0 {
+		return "", newErr(codes.InvalidArgument, "container apps jobs take their timeout from the job's replica timeout", nil)
+	}
+
+	jobName := job.Definition
+	if job.Image != "" {
+		if s.defaultJob == "" {
+			return "", newErr(codes.FailedPrecondition, "BATCH_DEFAULT_JOB env var is required to run images", nil)
+		}
+		jobName = s.defaultJob
+	}
+
+	template, err := s.template(job)
+	if err != nil {
+		return "", newErr(codeFor(err), "unable to read job definition", err)
+	}
+
+	var body interface{}
+	if template != nil {
+		body = template
+	}
+
+	execution := &executionResource{}
+	if err := s.do(s.managementAuthorizer, http.MethodPost, s.jobUrl(jobName, "/start"), body, execution); err != nil {
+		return "", newErr(codeFor(err), "unable to start job", err)
+	}
+
+	return fmt.Sprintf("%s/%s", jobName, execution.Name), nil
+}
+
+func splitId(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected id in the form {job}/{execution}, got %s", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func parseTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+func jobState(status string) batch.JobState {
+	switch status {
+	case "Running", "Processing":
+		return batch.Running
+	case "Succeeded":
+		return batch.Succeeded
+	case "Failed", "Stopped", "Degraded":
+		return batch.Failed
+	default:
+		return batch.Pending
+	}
+}
+
+func (s *ContainerAppsJobsService) Status(id string) (*batch.JobStatus, error) {
+	newErr := errors.ErrorsWithScope(
+		"ContainerAppsJobsService.Status",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	jobName, executionName, err := splitId(id)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid job id", err)
+	}
+
+	execution := &executionResource{}
+	if err := s.do(s.managementAuthorizer, http.MethodGet, s.jobUrl(jobName, "/executions/"+executionName), nil, execution); err != nil {
+		return nil, newErr(codeFor(err), "unable to get execution", err)
+	}
+
+	status := &batch.JobStatus{
+		Id:        id,
+		State:     jobState(execution.Properties.Status),
+		StartedAt: parseTime(execution.Properties.StartTime),
+		StoppedAt: parseTime(execution.Properties.EndTime),
+	}
+
+	if status.State == batch.Failed {
+		status.Reason = execution.Properties.Status
+	}
+
+	return status, nil
+}
+
+// Logs - queries the console logs of the execution's replicas, the paging token is the time of the last entry returned
+func (s *ContainerAppsJobsService) Logs(id string, pagingToken string) (*batch.LogResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"ContainerAppsJobsService.Logs",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	if s.workspace == "" {
+		return nil, newErr(codes.FailedPrecondition, "BATCH_LOG_WORKSPACE env var is required to read job logs", nil)
+	}
+
+	_, executionName, err := splitId(id)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid job id", err)
+	}
+
+	// Replicas of an execution are named after it
+	query := fmt.Sprintf("ContainerAppConsoleLogs_CL | where ContainerGroupName_s startswith '%s'", executionName)
+	if pagingToken != "" {
+		query = fmt.Sprintf("%s | where TimeGenerated > datetime(%s)", query, pagingToken)
+	}
+	query = fmt.Sprintf("%s | order by TimeGenerated asc | take %d | project TimeGenerated, Log_s", query, logPageSize)
+
+	result := &queryResult{}
+	url := fmt.Sprintf("%s/v1/workspaces/%s/query", s.logs, s.workspace)
+	if err := s.do(s.logsAuthorizer, http.MethodPost, url, map[string]string{"query": query}, result); err != nil {
+		return nil, newErr(codeFor(err), "unable to query job logs", err)
+	}
+
+	logs := &batch.LogResult{
+		Entries:     []*batch.LogEntry{},
+		PagingToken: pagingToken,
+	}
+
+	for _, table := range result.Tables {
+		for _, row := range table.Rows {
+			if len(row) != 2 {
+				continue
+			}
+
+			timestamp, _ := row[0].(string)
+			message, _ := row[1].(string)
+
+			logs.Entries = append(logs.Entries, &batch.LogEntry{
+				Time:    parseTime(timestamp),
+				Message: message,
+			})
+			logs.PagingToken = timestamp
+		}
+	}
+
+	return logs, nil
+}
+
+// New - creates a Container Apps jobs plugin, images are run by the job named by BATCH_DEFAULT_JOB and logs are read from the BATCH_LOG_WORKSPACE Log Analytics workspace
+func New(provider core.AzProvider) (batch.BatchService, error) {
+	managementToken, err := provider.ServicePrincipalToken(managementEndpoint + "/")
+	if err != nil {
+		return nil, fmt.Errorf("error authenticating container apps client: %v", err)
+	}
+
+	logsToken, err := provider.ServicePrincipalToken(logAnalytics)
+	if err != nil {
+		return nil, fmt.Errorf("error authenticating log analytics client: %v", err)
+	}
+
+	return &ContainerAppsJobsService{
+		client:               http.DefaultClient,
+		management:           managementEndpoint,
+		managementAuthorizer: autorest.NewBearerAuthorizer(managementToken),
+		subscriptionId:       provider.SubscriptionId(),
+		resourceGroup:        provider.ResourceGroupName(),
+		defaultJob:           utils.GetEnv("BATCH_DEFAULT_JOB", ""),
+		logs:                 logAnalytics,
+		logsAuthorizer:       autorest.NewBearerAuthorizer(logsToken),
+		workspace:            utils.GetEnv("BATCH_LOG_WORKSPACE", ""),
+	}, nil
+}
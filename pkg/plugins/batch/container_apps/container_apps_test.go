@@ -0,0 +1,181 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container_apps_service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/batch"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+const jobsPath = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.App/jobs/"
+
+var _ = Describe("ContainerApps", func() {
+	var server *httptest.Server
+	var bodies map[string][]byte
+	var responses map[string]string
+	var plugin *ContainerAppsJobsService
+
+	BeforeEach(func() {
+		bodies = map[string][]byte{}
+		responses = map[string]string{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Method + " " + r.URL.Path
+			bodies[key], _ = ioutil.ReadAll(r.Body)
+
+			resp, ok := responses[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write([]byte(resp))
+		}))
+
+		plugin = &ContainerAppsJobsService{
+			client:               server.Client(),
+			management:           server.URL,
+			managementAuthorizer: autorest.NullAuthorizer{},
+			subscriptionId:       "test-sub",
+			resourceGroup:        "test-rg",
+			defaultJob:           "runner",
+			logs:                 server.URL,
+			logsAuthorizer:       autorest.NullAuthorizer{},
+			workspace:            "test-workspace",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("Submit", func() {
+		When("Starting a job definition without overrides", func() {
+			It("Should start the job with its own template", func() {
+				responses["POST "+jobsPath+"report/start"] = `{"name": "report-x1y2"}`
+
+				id, err := plugin.Submit(&batch.JobSpec{Definition: "report"})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).To(Equal("report/report-x1y2"))
+				Expect(bodies["POST "+jobsPath+"report/start"]).To(BeEmpty())
+			})
+		})
+
+		When("Starting a job definition with overrides", func() {
+			It("Should start the job with its container overridden", func() {
+				responses["GET "+jobsPath+"report"] = `{"properties": {"template": {"containers": [{"name": "report", "image": "example/report"}]}}}`
+				responses["POST "+jobsPath+"report/start"] = `{"name": "report-x1y2"}`
+
+				_, err := plugin.Submit(&batch.JobSpec{
+					Definition: "report",
+					Command:    []string{"report", "--all"},
+					MemoryMiB:  512,
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+
+				template := &executionTemplate{}
+				Expect(json.Unmarshal(bodies["POST "+jobsPath+"report/start"], template)).To(Succeed())
+				Expect(template.Containers[0].Name).To(Equal("report"))
+				Expect(template.Containers[0].Image).To(Equal("example/report"))
+				Expect(template.Containers[0].Command).To(Equal([]string{"report", "--all"}))
+				Expect(template.Containers[0].Resources.Memory).To(Equal("0.5Gi"))
+			})
+		})
+
+		When("Running an image", func() {
+			It("Should start the default job with the image", func() {
+				responses["POST "+jobsPath+"runner/start"] = `{"name": "runner-a1b2"}`
+
+				id, err := plugin.Submit(&batch.JobSpec{Image: "example/report"})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).To(Equal("runner/runner-a1b2"))
+
+				template := &executionTemplate{}
+				Expect(json.Unmarshal(bodies["POST "+jobsPath+"runner/start"], template)).To(Succeed())
+				Expect(template.Containers[0].Image).To(Equal("example/report"))
+			})
+		})
+
+		When("The job has a timeout", func() {
+			It("Should return an invalid argument error", func() {
+				_, err := plugin.Submit(&batch.JobSpec{Definition: "report", Timeout: 60})
+
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Status", func() {
+		When("The execution exists", func() {
+			It("Should return its state", func() {
+				responses["GET "+jobsPath+"report/executions/report-x1y2"] = `{"properties": {"status": "Succeeded", "startTime": "2023-01-01T00:00:00Z", "endTime": "2023-01-01T00:00:30Z"}}`
+
+				status, err := plugin.Status("report/report-x1y2")
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(status.State).To(Equal(batch.Succeeded))
+				Expect(status.StoppedAt.Sub(status.StartedAt).Seconds()).To(Equal(30.0))
+			})
+		})
+
+		When("The execution doesn't exist", func() {
+			It("Should return a not found error", func() {
+				_, err := plugin.Status("report/missing")
+
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("The id isn't a job execution", func() {
+			It("Should return an invalid argument error", func() {
+				_, err := plugin.Status("report")
+
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("Logs", func() {
+		It("Should query the execution's console logs after the paging token", func() {
+			responses["POST /v1/workspaces/test-workspace/query"] = `{"tables": [{"rows": [["2023-01-01T00:00:01Z", "started"], ["2023-01-01T00:00:02Z", "done"]]}]}`
+
+			result, err := plugin.Logs("report/report-x1y2", "2023-01-01T00:00:00Z")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Entries).To(HaveLen(2))
+			Expect(result.Entries[0].Message).To(Equal("started"))
+			Expect(result.PagingToken).To(Equal("2023-01-01T00:00:02Z"))
+
+			query := map[string]string{}
+			Expect(json.Unmarshal(bodies["POST /v1/workspaces/test-workspace/query"], &query)).To(Succeed())
+			Expect(query["query"]).To(ContainSubstring("startswith 'report-x1y2'"))
+			Expect(query["query"]).To(ContainSubstring("TimeGenerated > datetime(2023-01-01T00:00:00Z)"))
+		})
+	})
+})
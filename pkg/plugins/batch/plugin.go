@@ -0,0 +1,111 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"fmt"
+	"time"
+)
+
+// JobSpec - a long-running job, running either a job definition registered with the provider or a container image
+type JobSpec struct {
+	// Name - identifies the job in the provider's console
+	Name string
+	// Definition - the name of a job registered with the provider, e.g. an AWS Batch job definition or a Cloud Run job
+	Definition string
+	// Image - the container image to run when no definition is given
+	Image string
+	// Command - overrides the container's command, if set
+	Command []string
+	Env     map[string]string
+	// Cpu - the vCPUs reserved for the job, the provider's default if zero
+	Cpu float64
+	// MemoryMiB - the memory reserved for the job, the provider's default if zero
+	MemoryMiB int64
+	// Timeout - the job is stopped if it runs longer than this, the provider's default if zero
+	Timeout time.Duration
+}
+
+func (j *JobSpec) Validate() error {
+	if (j.Definition == "") == (j.Image == "") {
+		return fmt.Errorf("jobs must run exactly one of a definition or an image")
+	}
+
+	if j.Cpu < 0 || j.MemoryMiB < 0 || j.Timeout < 0 {
+		return fmt.Errorf("job resources and timeout must not be negative")
+	}
+
+	return nil
+}
+
+type JobState string
+
+const (
+	// Pending - the job is queued or starting
+	Pending   JobState = "pending"
+	Running   JobState = "running"
+	Succeeded JobState = "succeeded"
+	Failed    JobState = "failed"
+)
+
+// JobStatus - the progress of a submitted job
+type JobStatus struct {
+	Id    string
+	State JobState
+	// Reason - why the job is in its state, if the provider reports it
+	Reason string
+	// StartedAt - zero until the job starts running
+	StartedAt time.Time
+	// StoppedAt - zero until the job stops
+	StoppedAt time.Time
+}
+
+type LogEntry struct {
+	Time    time.Time
+	Message string
+}
+
+type LogResult struct {
+	Entries []*LogEntry
+	// PagingToken - token for the entries after these, including those the job is yet to write, blank if the job hasn't started
+	PagingToken string
+}
+
+type BatchService interface {
+	// Submit - starts a job, returning its ID
+	Submit(job *JobSpec) (string, error)
+	// Status - returns the progress of a job
+	Status(id string) (*JobStatus, error)
+	// Logs - returns the output of a job, oldest first
+	Logs(id string, pagingToken string) (*LogResult, error)
+}
+
+type UnimplementedBatchPlugin struct {
+	BatchService
+}
+
+var _ BatchService = (*UnimplementedBatchPlugin)(nil)
+
+func (*UnimplementedBatchPlugin) Submit(job *JobSpec) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedBatchPlugin) Status(id string) (*JobStatus, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedBatchPlugin) Logs(id string, pagingToken string) (*LogResult, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure_cdn_service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// AzureCdnService - signs URLs for Azure CDN endpoints whose origin is the storage account's blob endpoint.
+// Azure CDN has no signing scheme of its own, so URLs carry a SAS token for the blob that the endpoint forwards to its origin,
+// which requires the endpoint to cache every unique URL
+type AzureCdnService struct {
+	cdn.UnimplementedCdnPlugin
+	config        *cdn.Config
+	storagePlugin storage.StorageService
+}
+
+func (a *AzureCdnService) SignUrl(bucket string, key string, expiry time.Duration) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzureCdnService.SignUrl",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+			"expiry": expiry.String(),
+		},
+	)
+
+	domain, ok := a.config.Domains[bucket]
+	if !ok {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate endpoint",
+			fmt.Errorf("no CDN domain is configured for bucket %s", bucket),
+		)
+	}
+
+	// SAS tokens expire on the second
+	seconds := uint32((expiry + time.Second - 1) / time.Second)
+
+	signed, err := a.storagePlugin.PreSignUrl(bucket, key, storage.READ, seconds)
+	if err != nil {
+		return "", newErr(
+			errors.Code(err),
+			"unable to sign blob url",
+			err,
+		)
+	}
+
+	u, err := url.Parse(signed)
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"invalid blob url",
+			err,
+		)
+	}
+
+	parts := strings.SplitN(domain, "/", 2)
+	u.Scheme = "https"
+	u.Host = parts[0]
+	if len(parts) == 2 {
+		u.Path = "/" + parts[1] + u.Path
+	}
+
+	return u.String(), nil
+}
+
+func (a *AzureCdnService) SignCookies(bucket string, prefix string, expiry time.Duration) ([]*cdn.Cookie, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzureCdnService.SignCookies",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+		},
+	)
+
+	return nil, newErr(
+		codes.Unimplemented,
+		"Azure CDN doesn't support signed cookies, sign URLs instead",
+		nil,
+	)
+}
+
+// New - Creates an Azure CDN plugin, signing URLs with SAS tokens from the storage plugin
+func New(storagePlugin storage.StorageService) (cdn.CdnService, error) {
+	if storagePlugin == nil {
+		return nil, fmt.Errorf("a storage plugin is required to sign CDN urls")
+	}
+
+	config, err := cdn.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithStorage(config, storagePlugin), nil
+}
+
+func NewWithStorage(config *cdn.Config, storagePlugin storage.StorageService) cdn.CdnService {
+	return &AzureCdnService{
+		config:        config,
+		storagePlugin: storagePlugin,
+	}
+}
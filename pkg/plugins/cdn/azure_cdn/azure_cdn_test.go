@@ -0,0 +1,73 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure_cdn_service
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_storage "github.com/nitrictech/nitric/mocks/storage"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("Azure CDN", func() {
+	config := &cdn.Config{
+		Domains: map[string]string{"images": "media.azureedge.net"},
+	}
+
+	Context("SignUrl", func() {
+		When("The bucket is served by an endpoint", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			plugin := NewWithStorage(config, mockStorage)
+
+			It("should serve the blob's SAS url from the endpoint", func() {
+				mockStorage.EXPECT().PreSignUrl("images", "cats/tabby.png", storage.READ, uint32(91)).Return(
+					"https://account.blob.core.windows.net/images-1234/cats/tabby.png?se=2021-01-01T00%3A00%3A00Z&sig=abc&sp=r&sv=2019-12-12", nil,
+				)
+
+				signed, err := plugin.SignUrl("images", "cats/tabby.png", 90*time.Second+time.Millisecond)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(signed).To(Equal("https://media.azureedge.net/images-1234/cats/tabby.png?se=2021-01-01T00%3A00%3A00Z&sig=abc&sp=r&sv=2019-12-12"))
+			})
+		})
+
+		When("The bucket isn't served by an endpoint", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			plugin := NewWithStorage(config, mockStorage)
+
+			It("should return a not found error", func() {
+				_, err := plugin.SignUrl("files", "report.pdf", time.Hour)
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("SignCookies", func() {
+		plugin := NewWithStorage(config, nil)
+
+		It("should be unimplemented", func() {
+			_, err := plugin.SignCookies("images", "", time.Hour)
+			Expect(errors.Code(err)).To(Equal(codes.Unimplemented))
+		})
+	})
+})
@@ -0,0 +1,163 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud_cdn_service
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+// CookieName - the cookie Cloud CDN reads signed cookie policies from
+const CookieName = "Cloud-CDN-Cookie"
+
+// CloudCdnService - signs URLs and cookies for Cloud CDN backend buckets with signed requests enabled,
+// with a signing key added to the backend bucket
+type CloudCdnService struct {
+	cdn.UnimplementedCdnPlugin
+	config *cdn.Config
+	key    cdn.KeySource
+}
+
+// sign - returns the base64url encoded HMAC-SHA1 of the value, with the base64url encoded signing key
+func (c *CloudCdnService) sign(value string) (string, error) {
+	encoded, err := c.key()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := base64.URLEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return "", fmt.Errorf("signing key isn't base64url encoded: %v", err)
+	}
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write([]byte(value))
+
+	return base64.URLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (c *CloudCdnService) SignUrl(bucket string, key string, expiry time.Duration) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudCdnService.SignUrl",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+			"expiry": expiry.String(),
+		},
+	)
+
+	u, err := c.config.Url(bucket, key)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate backend bucket",
+			err,
+		)
+	}
+
+	unsigned := fmt.Sprintf("%s?Expires=%d&KeyName=%s", u, time.Now().Add(expiry).Unix(), url.QueryEscape(c.config.KeyId))
+
+	signature, err := c.sign(unsigned)
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"unable to sign url",
+			err,
+		)
+	}
+
+	return unsigned + "&Signature=" + signature, nil
+}
+
+// SignCookies - returns a Cloud-CDN-Cookie granting access to the URLs under the prefix
+func (c *CloudCdnService) SignCookies(bucket string, prefix string, expiry time.Duration) ([]*cdn.Cookie, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudCdnService.SignCookies",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+			"expiry": expiry.String(),
+		},
+	)
+
+	u, err := c.config.Url(bucket, prefix)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate backend bucket",
+			err,
+		)
+	}
+
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid prefix",
+			err,
+		)
+	}
+
+	expires := time.Now().Add(expiry)
+	policy := fmt.Sprintf("URLPrefix=%s:Expires=%d:KeyName=%s", base64.URLEncoding.EncodeToString([]byte(u)), expires.Unix(), c.config.KeyId)
+
+	signature, err := c.sign(policy)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to sign cookie",
+			err,
+		)
+	}
+
+	return []*cdn.Cookie{{
+		Name:    CookieName,
+		Value:   policy + ":Signature=" + signature,
+		Domain:  parsed.Hostname(),
+		Path:    "/",
+		Expires: expires,
+	}}, nil
+}
+
+// New - Creates a Cloud CDN plugin, signing with the key in the CDN_KEY_SECRET secret
+func New(secretPlugin secret.SecretService) (cdn.CdnService, error) {
+	config, err := cdn.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.KeyId == "" {
+		return nil, fmt.Errorf("CDN_KEY_ID env var is required, the name of the backend bucket's signing key")
+	}
+
+	return NewWithKey(config, cdn.SecretKey(secretPlugin, config.KeySecret)), nil
+}
+
+func NewWithKey(config *cdn.Config, key cdn.KeySource) cdn.CdnService {
+	return &CloudCdnService{
+		config: config,
+		key:    key,
+	}
+}
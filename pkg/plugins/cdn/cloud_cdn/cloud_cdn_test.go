@@ -0,0 +1,89 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud_cdn_service
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("Cloud CDN", func() {
+	key := []byte("nitric-test-key!")
+	signature := func(value string) string {
+		mac := hmac.New(sha1.New, key)
+		mac.Write([]byte(value))
+		return base64.URLEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	config := &cdn.Config{
+		Domains: map[string]string{"images": "cdn.example.com"},
+		KeyId:   "images-key",
+	}
+	plugin := NewWithKey(config, func() ([]byte, error) {
+		return []byte(base64.URLEncoding.EncodeToString(key) + "\n"), nil
+	})
+
+	Context("SignUrl", func() {
+		When("The bucket is served by a backend bucket", func() {
+			It("should sign the item's url with the key", func() {
+				signed, err := plugin.SignUrl("images", "cats/tabby.png", time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				u, err := url.Parse(signed)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(u.Host).To(Equal("cdn.example.com"))
+				Expect(u.Path).To(Equal("/cats/tabby.png"))
+				Expect(u.Query().Get("KeyName")).To(Equal("images-key"))
+
+				unsigned := signed[:strings.Index(signed, "&Signature=")]
+				Expect(u.Query().Get("Signature")).To(Equal(signature(unsigned)))
+			})
+		})
+
+		When("The bucket isn't served by a backend bucket", func() {
+			It("should return a not found error", func() {
+				_, err := plugin.SignUrl("files", "report.pdf", time.Hour)
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("SignCookies", func() {
+		It("should grant access to the items under the prefix", func() {
+			cookies, err := plugin.SignCookies("images", "acme/", time.Hour)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cookies).To(HaveLen(1))
+			Expect(cookies[0].Name).To(Equal(CookieName))
+			Expect(cookies[0].Domain).To(Equal("cdn.example.com"))
+
+			parts := strings.Split(cookies[0].Value, ":Signature=")
+			Expect(parts).To(HaveLen(2))
+			Expect(parts[0]).To(HavePrefix("URLPrefix=" + base64.URLEncoding.EncodeToString([]byte("https://cdn.example.com/acme/")) + ":Expires="))
+			Expect(parts[0]).To(HaveSuffix(":KeyName=images-key"))
+			Expect(parts[1]).To(Equal(signature(parts[0])))
+		})
+	})
+})
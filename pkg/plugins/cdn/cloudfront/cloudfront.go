@@ -0,0 +1,198 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudfront_service
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront/sign"
+
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+// CloudFrontCdnService - signs URLs and cookies for CloudFront distributions serving private buckets,
+// with the private key of a public key in the distribution's trusted key group
+type CloudFrontCdnService struct {
+	cdn.UnimplementedCdnPlugin
+	config *cdn.Config
+	key    cdn.KeySource
+}
+
+// privateKey - parses the PEM encoded RSA signing key, in either PKCS #1 or PKCS #8 form
+func (c *CloudFrontCdnService) privateKey() (*rsa.PrivateKey, error) {
+	value, err := c.key()
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(value)
+	if block == nil {
+		return nil, fmt.Errorf("signing key isn't PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse signing key: %v", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing key isn't an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+func (c *CloudFrontCdnService) SignUrl(bucket string, key string, expiry time.Duration) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudFrontCdnService.SignUrl",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+			"expiry": expiry.String(),
+		},
+	)
+
+	u, err := c.config.Url(bucket, key)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate distribution",
+			err,
+		)
+	}
+
+	privateKey, err := c.privateKey()
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"unable to read signing key",
+			err,
+		)
+	}
+
+	signed, err := sign.NewURLSigner(c.config.KeyId, privateKey).Sign(u, time.Now().Add(expiry))
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"unable to sign url",
+			err,
+		)
+	}
+
+	return signed, nil
+}
+
+// SignCookies - returns CloudFront-Policy, CloudFront-Signature and CloudFront-Key-Pair-Id cookies
+// with a custom policy granting access to the URLs under the prefix
+func (c *CloudFrontCdnService) SignCookies(bucket string, prefix string, expiry time.Duration) ([]*cdn.Cookie, error) {
+	newErr := errors.ErrorsWithScope(
+		"CloudFrontCdnService.SignCookies",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+			"expiry": expiry.String(),
+		},
+	)
+
+	u, err := c.config.Url(bucket, prefix)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate distribution",
+			err,
+		)
+	}
+
+	privateKey, err := c.privateKey()
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to read signing key",
+			err,
+		)
+	}
+
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid prefix",
+			err,
+		)
+	}
+
+	expires := time.Now().Add(expiry)
+	signer := sign.NewCookieSigner(c.config.KeyId, privateKey, func(o *sign.CookieOptions) {
+		o.Domain = parsed.Hostname()
+		o.Path = "/"
+		o.Secure = true
+	})
+
+	signed, err := signer.Sign(u+"*", expires)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to sign cookies",
+			err,
+		)
+	}
+
+	cookies := make([]*cdn.Cookie, 0, len(signed))
+	for _, cookie := range signed {
+		cookies = append(cookies, &cdn.Cookie{
+			Name:    cookie.Name,
+			Value:   cookie.Value,
+			Domain:  cookie.Domain,
+			Path:    cookie.Path,
+			Expires: expires,
+		})
+	}
+
+	return cookies, nil
+}
+
+// New - Creates a CloudFront CDN plugin, signing with the key in the CDN_KEY_SECRET secret
+func New(secretPlugin secret.SecretService) (cdn.CdnService, error) {
+	config, err := cdn.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.KeyId == "" {
+		return nil, fmt.Errorf("CDN_KEY_ID env var is required, the ID of the CloudFront public key URLs are signed for")
+	}
+
+	return NewWithKey(config, cdn.SecretKey(secretPlugin, config.KeySecret)), nil
+}
+
+func NewWithKey(config *cdn.Config, key cdn.KeySource) cdn.CdnService {
+	return &CloudFrontCdnService{
+		config: config,
+		key:    key,
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudfront_service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("CloudFront", func() {
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(privateKey)
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+
+	config := &cdn.Config{
+		Domains: map[string]string{"images": "d111.cloudfront.net"},
+		KeyId:   "K2JCJMDEHXQW5F",
+	}
+	plugin := NewWithKey(config, func() ([]byte, error) {
+		return keyPem, nil
+	})
+
+	Context("SignUrl", func() {
+		When("The bucket is served by a distribution", func() {
+			It("should sign the item's url with a canned policy", func() {
+				signed, err := plugin.SignUrl("images", "cats/tabby.png", time.Hour)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				u, err := url.Parse(signed)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(u.Host).To(Equal("d111.cloudfront.net"))
+				Expect(u.Path).To(Equal("/cats/tabby.png"))
+				Expect(u.Query().Get("Key-Pair-Id")).To(Equal("K2JCJMDEHXQW5F"))
+				Expect(u.Query().Get("Signature")).ToNot(BeEmpty())
+				Expect(u.Query().Get("Expires")).ToNot(BeEmpty())
+			})
+		})
+
+		When("The bucket isn't served by a distribution", func() {
+			It("should return a not found error", func() {
+				_, err := plugin.SignUrl("files", "report.pdf", time.Hour)
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("The signing key isn't a PEM key", func() {
+			plugin := NewWithKey(config, func() ([]byte, error) {
+				return []byte("not a key"), nil
+			})
+
+			It("should return an error", func() {
+				_, err := plugin.SignUrl("images", "cats/tabby.png", time.Hour)
+				Expect(errors.Code(err)).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("SignCookies", func() {
+		It("should grant access to the items under the prefix", func() {
+			cookies, err := plugin.SignCookies("images", "acme/", time.Hour)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cookies).To(HaveLen(3))
+
+			values := map[string]string{}
+			for _, cookie := range cookies {
+				Expect(cookie.Domain).To(Equal("d111.cloudfront.net"))
+				Expect(cookie.Path).To(Equal("/"))
+				values[cookie.Name] = cookie.Value
+			}
+
+			Expect(values["CloudFront-Key-Pair-Id"]).To(Equal("K2JCJMDEHXQW5F"))
+			Expect(values["CloudFront-Signature"]).ToNot(BeEmpty())
+
+			policy, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(values["CloudFront-Policy"]))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(policy)).To(ContainSubstring(`"Resource":"https://d111.cloudfront.net/acme/*"`))
+		})
+	})
+})
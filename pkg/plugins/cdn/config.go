@@ -0,0 +1,138 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// keyCacheTTL - how long signing keys are cached before they're read from their secret again, so rotated keys are picked up
+const keyCacheTTL = 5 * time.Minute
+
+// Config - the CDN domains serving buckets and the key their content is signed with
+type Config struct {
+	// Domains - the CDN domain serving each bucket, optionally followed by the path the bucket is served under
+	Domains map[string]string
+	// KeyId - identifies the signing key to the CDN, e.g. a CloudFront public key ID or a Cloud CDN key name
+	KeyId string
+	// KeySecret - the secret whose latest version is the signing key
+	KeySecret string
+}
+
+// ParseDomains - parses comma separated <bucket>=<domain>[/<path>] CDN domains
+func ParseDomains(config string) (map[string]string, error) {
+	domains := map[string]string{}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || strings.Trim(parts[1], "/") == "" {
+			return nil, fmt.Errorf("invalid CDN domain %s, expected <bucket>=<domain>", entry)
+		}
+
+		if strings.Contains(parts[1], "://") {
+			return nil, fmt.Errorf("invalid CDN domain %s, domains are served over https and can't include a scheme", entry)
+		}
+
+		domains[parts[0]] = strings.Trim(parts[1], "/")
+	}
+
+	return domains, nil
+}
+
+// ConfigFromEnv - reads the CDN configuration from the CDN_DOMAINS, CDN_KEY_ID and CDN_KEY_SECRET env vars
+func ConfigFromEnv() (*Config, error) {
+	domainsEnv := utils.GetEnv("CDN_DOMAINS", "")
+	if domainsEnv == "" {
+		return nil, fmt.Errorf("CDN_DOMAINS env var is required for CDN signing")
+	}
+
+	domains, err := ParseDomains(domainsEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CDN_DOMAINS env var: %v", err)
+	}
+
+	return &Config{
+		Domains:   domains,
+		KeyId:     utils.GetEnv("CDN_KEY_ID", ""),
+		KeySecret: utils.GetEnv("CDN_KEY_SECRET", ""),
+	}, nil
+}
+
+// Url - returns the unsigned https URL of an item served by the bucket's CDN
+func (c *Config) Url(bucket string, key string) (string, error) {
+	domain, ok := c.Domains[bucket]
+	if !ok {
+		return "", fmt.Errorf("no CDN domain is configured for bucket %s", bucket)
+	}
+
+	parts := strings.SplitN(domain, "/", 2)
+	u := &url.URL{Scheme: "https", Host: parts[0], Path: "/" + key}
+	if len(parts) == 2 {
+		u.Path = "/" + parts[1] + u.Path
+	}
+
+	return u.String(), nil
+}
+
+// KeySource - returns the current signing key
+type KeySource func() ([]byte, error)
+
+// SecretKey - reads the signing key from the latest version of the named secret, caching it for a few minutes
+func SecretKey(secretPlugin secret.SecretService, name string) KeySource {
+	var lock sync.Mutex
+	var key []byte
+	var read time.Time
+
+	return func() ([]byte, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if key != nil && time.Since(read) < keyCacheTTL {
+			return key, nil
+		}
+
+		if secretPlugin == nil {
+			return nil, fmt.Errorf("a secret plugin is required to read CDN signing keys")
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("no secret is configured for the CDN signing key")
+		}
+
+		resp, err := secretPlugin.Access(&secret.SecretVersion{
+			Secret:  &secret.Secret{Name: name},
+			Version: "latest",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to access CDN signing key %s: %v", name, err)
+		}
+
+		key, read = resp.Value, time.Now()
+
+		return key, nil
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/cdn"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+var _ = Describe("Config", func() {
+	Context("ParseDomains", func() {
+		When("Given buckets and their domains", func() {
+			domains, err := cdn.ParseDomains("images=d111.cloudfront.net, media=cdn.example.com/media/")
+
+			It("should map each bucket to its domain", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(domains).To(Equal(map[string]string{
+					"images": "d111.cloudfront.net",
+					"media":  "cdn.example.com/media",
+				}))
+			})
+		})
+
+		When("Given a domain with a scheme", func() {
+			_, err := cdn.ParseDomains("images=https://d111.cloudfront.net")
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("Given a bucket without a domain", func() {
+			_, err := cdn.ParseDomains("images")
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Url", func() {
+		config := &cdn.Config{Domains: map[string]string{
+			"images": "d111.cloudfront.net",
+			"media":  "cdn.example.com/media",
+		}}
+
+		It("should serve the item from the bucket's domain", func() {
+			u, err := config.Url("images", "cats/tabby cat.png")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(u).To(Equal("https://d111.cloudfront.net/cats/tabby%20cat.png"))
+		})
+
+		It("should serve the item under the domain's path", func() {
+			u, err := config.Url("media", "intro.mp4")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(u).To(Equal("https://cdn.example.com/media/intro.mp4"))
+		})
+
+		It("should return an error for buckets without a domain", func() {
+			_, err := config.Url("files", "report.pdf")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("SecretKey", func() {
+		When("The key is read more than once", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			key := cdn.SecretKey(mockSecret, "cdn-key")
+
+			It("should only access the secret once", func() {
+				mockSecret.EXPECT().Access(&secret.SecretVersion{
+					Secret:  &secret.Secret{Name: "cdn-key"},
+					Version: "latest",
+				}).Return(&secret.SecretAccessResponse{Value: []byte("key")}, nil).Times(1)
+
+				for i := 0; i < 2; i++ {
+					value, err := key()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(value).To(Equal([]byte("key")))
+				}
+			})
+		})
+
+		When("The secret can't be accessed", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			key := cdn.SecretKey(mockSecret, "cdn-key")
+
+			It("should return an error", func() {
+				mockSecret.EXPECT().Access(gomock.Any()).Return(nil, fmt.Errorf("secret not found"))
+
+				_, err := key()
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
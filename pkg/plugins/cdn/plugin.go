@@ -0,0 +1,52 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"fmt"
+	"time"
+)
+
+// Cookie - a signed cookie granting access to private content served by a CDN
+type Cookie struct {
+	Name   string
+	Value  string
+	Domain string
+	Path   string
+	// Expires - when the CDN stops accepting the cookie
+	Expires time.Time
+}
+
+type CdnService interface {
+	// SignUrl - returns a URL serving an item of a bucket through the bucket's CDN until the expiry
+	SignUrl(bucket string, key string, expiry time.Duration) (string, error)
+	// SignCookies - returns cookies granting access to the items of a bucket under a key prefix through the bucket's CDN
+	// until the expiry, an empty prefix grants access to every item
+	SignCookies(bucket string, prefix string, expiry time.Duration) ([]*Cookie, error)
+}
+
+type UnimplementedCdnPlugin struct {
+	CdnService
+}
+
+var _ CdnService = (*UnimplementedCdnPlugin)(nil)
+
+func (*UnimplementedCdnPlugin) SignUrl(bucket string, key string, expiry time.Duration) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedCdnPlugin) SignCookies(bucket string, prefix string, expiry time.Duration) ([]*Cookie, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_configuration_service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const apiVersion = "1.0"
+
+// AppConfigurationService - config plugin for Azure App Configuration.
+//
+// Each key is a key-value of the store, with the configured label or no label. Values with a JSON content type are decoded,
+// including Key Vault references, which are returned as the reference rather than the secret it refers to.
+type AppConfigurationService struct {
+	config.UnimplementedConfigPlugin
+	endpoint string
+	id       string
+	secret   []byte
+	label    string
+	client   *http.Client
+}
+
+type keyValue struct {
+	Etag        string `json:"etag"`
+	Key         string `json:"key"`
+	ContentType string `json:"content_type"`
+	Value       string `json:"value"`
+}
+
+// sign - signs the request with the store's access key, see
+// https://docs.microsoft.com/en-us/azure/azure-app-configuration/rest-api-authentication-hmac
+func (s *AppConfigurationService) sign(req *http.Request, body []byte) {
+	date := time.Now().UTC().Format(http.TimeFormat)
+	sum := sha256.Sum256(body)
+	contentHash := base64.StdEncoding.EncodeToString(sum[:])
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.URL.RequestURI(),
+		strings.Join([]string{date, req.URL.Host, contentHash}, ";"),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, s.secret)
+	_, _ = mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	req.Header.Set("x-ms-date", date)
+	req.Header.Set("x-ms-content-sha256", contentHash)
+	req.Header.Set("Authorization", fmt.Sprintf("HMAC-SHA256 Credential=%s&SignedHeaders=x-ms-date;host;x-ms-content-sha256&Signature=%s", s.id, signature))
+}
+
+// do - sends a signed request to the store, returning the response body and status code
+func (s *AppConfigurationService) do(method string, reqUrl string) ([]byte, int, error) {
+	req, err := http.NewRequest(method, reqUrl, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.microsoft.appconfig.kv+json")
+	s.sign(req, nil)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+func (s *AppConfigurationService) Get(key string) (*config.Value, error) {
+	newErr := errors.ErrorsWithScope(
+		"AppConfigurationService.Get",
+		map[string]interface{}{
+			"key": key,
+		},
+	)
+
+	query := url.Values{"api-version": []string{apiVersion}}
+	if s.label != "" {
+		query.Set("label", s.label)
+	}
+
+	body, status, err := s.do(http.MethodGet, fmt.Sprintf("%s/kv/%s?%s", s.endpoint, url.PathEscape(key), query.Encode()))
+	if err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"error getting configuration",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return nil, newErr(
+			statusCode(status),
+			"error getting configuration",
+			fmt.Errorf("unexpected response status %d", status),
+		)
+	}
+
+	kv := &keyValue{}
+	if err := json.Unmarshal(body, kv); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading configuration",
+			err,
+		)
+	}
+
+	return &config.Value{
+		Content: config.Decode(kv.Value, kv.ContentType),
+		Version: kv.Etag,
+	}, nil
+}
+
+// parseConnectionString - returns the endpoint, access key id and secret of an App Configuration connection string
+func parseConnectionString(connectionString string) (string, string, []byte, error) {
+	parts := map[string]string{}
+	for _, part := range strings.Split(connectionString, ";") {
+		if kv := strings.SplitN(part, "=", 2); len(kv) == 2 {
+			parts[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+
+	if parts["Endpoint"] == "" || parts["Id"] == "" || parts["Secret"] == "" {
+		return "", "", nil, fmt.Errorf("connection string must have an Endpoint, Id and Secret")
+	}
+
+	secret, err := base64.StdEncoding.DecodeString(parts["Secret"])
+	if err != nil {
+		return "", "", nil, fmt.Errorf("connection string secret must be base64 encoded: %v", err)
+	}
+
+	return parts["Endpoint"], parts["Id"], secret, nil
+}
+
+// New - creates a new App Configuration config plugin for the store of AZURE_APP_CONFIGURATION_CONNECTION_STRING
+func New() (config.ConfigService, error) {
+	connectionString := utils.GetEnv("AZURE_APP_CONFIGURATION_CONNECTION_STRING", "")
+	if connectionString == "" {
+		return nil, fmt.Errorf("AZURE_APP_CONFIGURATION_CONNECTION_STRING env var is required to read configuration from App Configuration")
+	}
+
+	plugin, err := NewWithClient(connectionString, utils.GetEnv("CONFIG_LABEL", ""), &http.Client{Timeout: 10 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	return plugin, nil
+}
+
+// NewWithClient - creates a new App Configuration config plugin for the store of the connection string, using the given http client
+func NewWithClient(connectionString string, label string, client *http.Client) (*AppConfigurationService, error) {
+	endpoint, id, secret, err := parseConnectionString(connectionString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid App Configuration connection string: %v", err)
+	}
+
+	return &AppConfigurationService{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		id:       id,
+		secret:   secret,
+		label:    label,
+		client:   client,
+	}, nil
+}
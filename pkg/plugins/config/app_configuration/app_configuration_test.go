@@ -0,0 +1,113 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_configuration_service_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	app_configuration_service "github.com/nitrictech/nitric/pkg/plugins/config/app_configuration"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var secret = base64.StdEncoding.EncodeToString([]byte("store-secret"))
+
+// newStore - starts a fake App Configuration store responding to every request with status and body, recording the requests it receives
+func newStore(status int, body string) (*httptest.Server, *[]*http.Request) {
+	requests := make([]*http.Request, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("App Configuration", func() {
+	Context("Get", func() {
+		When("the key exists", func() {
+			It("should return its decoded value and etag", func() {
+				server, requests := newStore(http.StatusOK, `{"etag":"abc","key":"pricing","label":"prod","content_type":"application/json","value":"{\"discount\":0.1}"}`)
+				defer server.Close()
+
+				s, err := app_configuration_service.NewWithClient("Endpoint="+server.URL+";Id=key-id;Secret="+secret, "prod", server.Client())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				value, err := s.Get("pricing")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal(map[string]interface{}{"discount": 0.1}))
+				Expect(value.Version).To(Equal("abc"))
+
+				req := (*requests)[0]
+				Expect(req.URL.Path).To(Equal("/kv/pricing"))
+				Expect(req.URL.Query().Get("label")).To(Equal("prod"))
+				Expect(req.URL.Query().Get("api-version")).To(Equal("1.0"))
+			})
+
+			It("should sign the request with the store's access key", func() {
+				server, requests := newStore(http.StatusOK, `{"etag":"abc","key":"banner","value":"blue"}`)
+				defer server.Close()
+
+				s, _ := app_configuration_service.NewWithClient("Endpoint="+server.URL+";Id=key-id;Secret="+secret, "", server.Client())
+				value, err := s.Get("banner")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal("blue"))
+
+				req := (*requests)[0]
+				stringToSign := strings.Join([]string{
+					http.MethodGet,
+					req.URL.RequestURI(),
+					req.Header.Get("x-ms-date") + ";" + req.Host + ";" + req.Header.Get("x-ms-content-sha256"),
+				}, "\n")
+				mac := hmac.New(sha256.New, []byte("store-secret"))
+				_, _ = mac.Write([]byte(stringToSign))
+
+				Expect(req.Header.Get("Authorization")).To(Equal(
+					"HMAC-SHA256 Credential=key-id&SignedHeaders=x-ms-date;host;x-ms-content-sha256&Signature=" + base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+				))
+			})
+		})
+
+		When("the key doesn't exist", func() {
+			It("should return a not found error", func() {
+				server, _ := newStore(http.StatusNotFound, ``)
+				defer server.Close()
+
+				s, _ := app_configuration_service.NewWithClient("Endpoint="+server.URL+";Id=key-id;Secret="+secret, "", server.Client())
+				_, err := s.Get("missing")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("NewWithClient", func() {
+		When("the connection string has no secret", func() {
+			It("should return an error", func() {
+				_, err := app_configuration_service.NewWithClient("Endpoint=https://app.azconfig.io;Id=key-id", "", http.DefaultClient)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
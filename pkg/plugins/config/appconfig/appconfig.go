@@ -0,0 +1,170 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appconfig_service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/appconfigdata"
+	"github.com/aws/aws-sdk-go/service/appconfigdata/appconfigdataiface"
+
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// AppConfigService - config plugin for AWS AppConfig.
+//
+// Each key is a configuration profile of the application, read from its deployment to the environment.
+// A configuration session is started for each key, which only returns values that have changed,
+// so values are cached and only polled for again once the session's poll interval has passed.
+type AppConfigService struct {
+	config.UnimplementedConfigPlugin
+	client      appconfigdataiface.AppConfigDataAPI
+	application string
+	environment string
+	now         func() time.Time
+	lock        sync.Mutex
+	sessions    map[string]*session
+}
+
+// session - the configuration session of a key
+type session struct {
+	lock sync.Mutex
+	// token - the token of the next poll, empty if a session must be started
+	token string
+	value *config.Value
+	// next - when the configuration can next be polled
+	next time.Time
+}
+
+// code - returns the plugin's error code for an AppConfig error
+func code(err error) codes.Code {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case appconfigdata.ErrCodeResourceNotFoundException:
+			return codes.NotFound
+		case appconfigdata.ErrCodeBadRequestException:
+			return codes.InvalidArgument
+		case appconfigdata.ErrCodeThrottlingException:
+			return codes.ResourceExhausted
+		}
+	}
+
+	return codes.Internal
+}
+
+// version - returns a version for configuration content, sessions don't return the version of the configuration
+func version(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:8])
+}
+
+func (s *AppConfigService) Get(key string) (*config.Value, error) {
+	newErr := errors.ErrorsWithScope(
+		"AppConfigService.Get",
+		map[string]interface{}{
+			"key": key,
+		},
+	)
+
+	s.lock.Lock()
+	sess, ok := s.sessions[key]
+	if !ok {
+		sess = &session{}
+		s.sessions[key] = sess
+	}
+	s.lock.Unlock()
+
+	// poll tokens can only be used once, so a key's session is only polled by one caller at a time
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+
+	if sess.value != nil && s.now().Before(sess.next) {
+		return sess.value, nil
+	}
+
+	if sess.token == "" {
+		out, err := s.client.StartConfigurationSession(&appconfigdata.StartConfigurationSessionInput{
+			ApplicationIdentifier:          aws.String(s.application),
+			EnvironmentIdentifier:          aws.String(s.environment),
+			ConfigurationProfileIdentifier: aws.String(key),
+		})
+		if err != nil {
+			return nil, newErr(code(err), "error starting configuration session", err)
+		}
+
+		sess.token = aws.StringValue(out.InitialConfigurationToken)
+	}
+
+	out, err := s.client.GetLatestConfiguration(&appconfigdata.GetLatestConfigurationInput{
+		ConfigurationToken: aws.String(sess.token),
+	})
+	if err != nil {
+		// the token may have expired, so a new session is started on the next call
+		sess.token = ""
+		return nil, newErr(code(err), "error getting configuration", err)
+	}
+
+	sess.token = aws.StringValue(out.NextPollConfigurationToken)
+	sess.next = s.now().Add(time.Duration(aws.Int64Value(out.NextPollIntervalInSeconds)) * time.Second)
+
+	// sessions return no content when the configuration hasn't changed since it was last returned
+	if sess.value != nil && len(out.Configuration) == 0 {
+		return sess.value, nil
+	}
+
+	sess.value = &config.Value{
+		Content: config.Decode(string(out.Configuration), aws.StringValue(out.ContentType)),
+		Version: version(out.Configuration),
+	}
+
+	return sess.value, nil
+}
+
+// New - creates a new AppConfig config plugin for the CONFIG_APPCONFIG_APPLICATION application, deployed to CONFIG_APPCONFIG_ENVIRONMENT
+func New() (config.ConfigService, error) {
+	application := utils.GetEnv("CONFIG_APPCONFIG_APPLICATION", "")
+	environment := utils.GetEnv("CONFIG_APPCONFIG_ENVIRONMENT", "")
+	if application == "" || environment == "" {
+		return nil, fmt.Errorf("CONFIG_APPCONFIG_APPLICATION and CONFIG_APPCONFIG_ENVIRONMENT env vars are required to read configuration from AppConfig")
+	}
+
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(appconfigdata.New(sess), application, environment), nil
+}
+
+// NewWithClient - creates a new AppConfig config plugin using the given client
+func NewWithClient(client appconfigdataiface.AppConfigDataAPI, application string, environment string) *AppConfigService {
+	return &AppConfigService{
+		client:      client,
+		application: application,
+		environment: environment,
+		now:         time.Now,
+		sessions:    map[string]*session{},
+	}
+}
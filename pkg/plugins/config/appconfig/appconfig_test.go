@@ -0,0 +1,132 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appconfig_service_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/appconfigdata"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_appconfigdataiface "github.com/nitrictech/nitric/mocks/appconfigdata"
+	appconfig_service "github.com/nitrictech/nitric/pkg/plugins/config/appconfig"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("AppConfig", func() {
+	var (
+		ctrl       *gomock.Controller
+		mockClient *mock_appconfigdataiface.MockAppConfigDataAPI
+		plugin     *appconfig_service.AppConfigService
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClient = mock_appconfigdataiface.NewMockAppConfigDataAPI(ctrl)
+		plugin = appconfig_service.NewWithClient(mockClient, "shop", "prod")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("Get", func() {
+		When("the configuration is deployed", func() {
+			It("should start a session and return its decoded content", func() {
+				mockClient.EXPECT().StartConfigurationSession(gomock.Any()).DoAndReturn(func(in *appconfigdata.StartConfigurationSessionInput) (*appconfigdata.StartConfigurationSessionOutput, error) {
+					Expect(aws.StringValue(in.ApplicationIdentifier)).To(Equal("shop"))
+					Expect(aws.StringValue(in.EnvironmentIdentifier)).To(Equal("prod"))
+					Expect(aws.StringValue(in.ConfigurationProfileIdentifier)).To(Equal("pricing"))
+
+					return &appconfigdata.StartConfigurationSessionOutput{
+						InitialConfigurationToken: aws.String("token-1"),
+					}, nil
+				})
+				mockClient.EXPECT().GetLatestConfiguration(&appconfigdata.GetLatestConfigurationInput{
+					ConfigurationToken: aws.String("token-1"),
+				}).Return(&appconfigdata.GetLatestConfigurationOutput{
+					Configuration:              []byte(`{"discount": 0.1}`),
+					ContentType:                aws.String("application/json"),
+					NextPollConfigurationToken: aws.String("token-2"),
+				}, nil)
+
+				value, err := plugin.Get("pricing")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal(map[string]interface{}{"discount": 0.1}))
+				Expect(value.Version).NotTo(BeEmpty())
+			})
+		})
+
+		When("the configuration hasn't changed since it was last read", func() {
+			It("should poll with the next token and return the cached value", func() {
+				mockClient.EXPECT().StartConfigurationSession(gomock.Any()).Return(&appconfigdata.StartConfigurationSessionOutput{
+					InitialConfigurationToken: aws.String("token-1"),
+				}, nil).Times(1)
+				mockClient.EXPECT().GetLatestConfiguration(gomock.Any()).Return(&appconfigdata.GetLatestConfigurationOutput{
+					Configuration:              []byte(`blue`),
+					ContentType:                aws.String("text/plain"),
+					NextPollConfigurationToken: aws.String("token-2"),
+				}, nil)
+				first, err := plugin.Get("banner")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				mockClient.EXPECT().GetLatestConfiguration(&appconfigdata.GetLatestConfigurationInput{
+					ConfigurationToken: aws.String("token-2"),
+				}).Return(&appconfigdata.GetLatestConfigurationOutput{
+					ContentType:                aws.String("text/plain"),
+					NextPollConfigurationToken: aws.String("token-3"),
+				}, nil)
+
+				value, err := plugin.Get("banner")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal("blue"))
+				Expect(value.Version).To(Equal(first.Version))
+			})
+		})
+
+		When("the poll interval hasn't passed", func() {
+			It("should return the cached value without polling", func() {
+				mockClient.EXPECT().StartConfigurationSession(gomock.Any()).Return(&appconfigdata.StartConfigurationSessionOutput{
+					InitialConfigurationToken: aws.String("token-1"),
+				}, nil)
+				mockClient.EXPECT().GetLatestConfiguration(gomock.Any()).Return(&appconfigdata.GetLatestConfigurationOutput{
+					Configuration:              []byte(`blue`),
+					ContentType:                aws.String("text/plain"),
+					NextPollConfigurationToken: aws.String("token-2"),
+					NextPollIntervalInSeconds:  aws.Int64(60),
+				}, nil).Times(1)
+
+				_, err := plugin.Get("banner")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				value, err := plugin.Get("banner")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal("blue"))
+			})
+		})
+
+		When("the configuration doesn't exist", func() {
+			It("should return a not found error", func() {
+				mockClient.EXPECT().StartConfigurationSession(gomock.Any()).Return(nil, awserr.New(appconfigdata.ErrCodeResourceNotFoundException, "not found", nil))
+
+				_, err := plugin.Get("missing")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin_config_service
+
+import (
+	"encoding/json"
+
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// DefaultCollection - the collection configuration is stored in when none is configured
+const DefaultCollection = "nitric-config"
+
+// BuiltinConfigService - reads configuration stored by the document plugin, e.g. in Firestore on GCP,
+// one document per key with its value in the document's "value" property.
+//
+// Values are versioned by a hash of their content, unless their document has a "version" of its own.
+type BuiltinConfigService struct {
+	config.UnimplementedConfigPlugin
+	documentPlugin document.DocumentService
+	collection     string
+}
+
+func (s *BuiltinConfigService) Get(key string) (*config.Value, error) {
+	newErr := errors.ErrorsWithScope(
+		"BuiltinConfigService.Get",
+		map[string]interface{}{
+			"key": key,
+		},
+	)
+
+	doc, err := s.documentPlugin.Get(&document.Key{
+		Collection: &document.Collection{Name: s.collection},
+		Id:         key,
+	})
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			return nil, newErr(codes.NotFound, "configuration not found", err)
+		}
+
+		return nil, newErr(codes.Internal, "error getting configuration", err)
+	}
+
+	// Document plugins decode numbers as ints or floats, so values are normalized to their JSON types
+	b, err := json.Marshal(doc.Content["value"])
+	if err != nil {
+		return nil, newErr(codes.Internal, "error reading configuration", err)
+	}
+
+	var content interface{}
+	if err := json.Unmarshal(b, &content); err != nil {
+		return nil, newErr(codes.Internal, "error reading configuration", err)
+	}
+
+	version, _ := doc.Content["version"].(string)
+	if version == "" {
+		version = config.Version(content)
+	}
+
+	return &config.Value{
+		Content: content,
+		Version: version,
+	}, nil
+}
+
+// New - creates a config plugin reading configuration stored in the given collection by the document plugin
+func New(documentPlugin document.DocumentService, collection string) config.ConfigService {
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &BuiltinConfigService{
+		documentPlugin: documentPlugin,
+		collection:     collection,
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin_config_service_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	builtin_config_service "github.com/nitrictech/nitric/pkg/plugins/config/builtin"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("Builtin Config", func() {
+	var (
+		ctrl    *gomock.Controller
+		mockDoc *mock_document.MockDocumentService
+		plugin  config.ConfigService
+	)
+
+	key := &document.Key{
+		Collection: &document.Collection{Name: "app-config"},
+		Id:         "pricing",
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDoc = mock_document.NewMockDocumentService(ctrl)
+		plugin = builtin_config_service.New(mockDoc, "app-config")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("Get", func() {
+		When("the key exists", func() {
+			It("should return its value with JSON types", func() {
+				mockDoc.EXPECT().Get(key).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value": map[string]interface{}{"tiers": []interface{}{int64(10), int64(20)}},
+					},
+				}, nil)
+
+				value, err := plugin.Get("pricing")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Content).To(Equal(map[string]interface{}{"tiers": []interface{}{float64(10), float64(20)}}))
+				Expect(value.Version).To(Equal(config.Version(value.Content)))
+			})
+
+			It("should use the document's version when it has one", func() {
+				mockDoc.EXPECT().Get(key).Return(&document.Document{
+					Content: map[string]interface{}{"value": "v1", "version": "2022-03-01"},
+				}, nil)
+
+				value, err := plugin.Get("pricing")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value.Version).To(Equal("2022-03-01"))
+			})
+		})
+
+		When("the key doesn't exist", func() {
+			It("should return a not found error", func() {
+				mockDoc.EXPECT().Get(key).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "document not found", nil))
+
+				_, err := plugin.Get("pricing")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,169 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_config "github.com/nitrictech/nitric/mocks/config"
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/plugins/config"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+var _ = Describe("Config", func() {
+	Context("Decode", func() {
+		It("should decode JSON values", func() {
+			Expect(config.Decode(`{"limit": 10}`, "application/json")).To(Equal(map[string]interface{}{"limit": float64(10)}))
+			Expect(config.Decode(`[1, 2]`, "application/vnd.app+json; charset=utf-8")).To(Equal([]interface{}{float64(1), float64(2)}))
+		})
+
+		It("should decode JSON text without a content type", func() {
+			Expect(config.Decode(`true`, "")).To(Equal(true))
+			Expect(config.Decode(`blue`, "")).To(Equal("blue"))
+		})
+
+		It("should leave text of other content types as a string", func() {
+			Expect(config.Decode(`10`, "text/plain")).To(Equal("10"))
+		})
+	})
+
+	Context("Version", func() {
+		It("should change with the value", func() {
+			Expect(config.Version("blue")).To(Equal(config.Version("blue")))
+			Expect(config.Version("blue")).NotTo(Equal(config.Version("red")))
+		})
+	})
+
+	Context("Watcher", func() {
+		var (
+			ctrl        *gomock.Controller
+			mockConfig  *mock_config.MockConfigService
+			mockAdapter *mock_worker.MockAdapter
+			watcher     *config.Watcher
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockConfig = mock_config.NewMockConfigService(ctrl)
+			mockAdapter = mock_worker.NewMockAdapter(ctrl)
+
+			pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 10})
+			Expect(pool.AddWorker(worker.NewConfigWorker(mockAdapter, &worker.ConfigWorkerOptions{Key: "pricing"}))).To(Succeed())
+
+			watcher = config.NewWatcher(mockConfig, pool, 0)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		When("a watched key's value changes", func() {
+			It("should send the change to the key's workers", func() {
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v1", Version: "1"}, nil)
+				watcher.Poll()
+
+				events := make(chan *triggers.Event, 1)
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v2", Version: "2"}, nil)
+				mockAdapter.EXPECT().HandleEvent(gomock.Any()).DoAndReturn(func(e *triggers.Event) error {
+					events <- e
+					return nil
+				})
+				watcher.Poll()
+
+				var evt *triggers.Event
+				Eventually(events).Should(Receive(&evt))
+				Expect(evt.Topic).To(Equal(worker.ConfigTopic("pricing")))
+				change := &config.Change{}
+				Expect(json.Unmarshal(evt.Payload, change)).To(Succeed())
+				Expect(change).To(Equal(&config.Change{Key: "pricing", Value: "v2", Version: "2"}))
+			})
+		})
+
+		When("a watched key's value hasn't changed", func() {
+			It("should send nothing", func() {
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v1", Version: "1"}, nil).Times(2)
+				mockAdapter.EXPECT().HandleEvent(gomock.Any()).Times(0)
+
+				watcher.Poll()
+				watcher.Poll()
+			})
+		})
+
+		When("a watched key is deleted", func() {
+			It("should send a deleted change", func() {
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v1", Version: "1"}, nil)
+				watcher.Poll()
+
+				events := make(chan *triggers.Event, 1)
+				mockConfig.EXPECT().Get("pricing").Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "key not found", nil))
+				mockAdapter.EXPECT().HandleEvent(gomock.Any()).DoAndReturn(func(e *triggers.Event) error {
+					events <- e
+					return nil
+				})
+				watcher.Poll()
+
+				var evt *triggers.Event
+				Eventually(events).Should(Receive(&evt))
+				change := &config.Change{}
+				Expect(json.Unmarshal(evt.Payload, change)).To(Succeed())
+				Expect(change.Deleted).To(BeTrue())
+			})
+		})
+
+		When("a worker doesn't finish handling a change", func() {
+			It("should keep checking for changes", func() {
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v1", Version: "1"}, nil)
+				watcher.Poll()
+
+				release := make(chan bool)
+				defer close(release)
+
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v2", Version: "2"}, nil)
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v2", Version: "2"}, nil)
+				mockAdapter.EXPECT().HandleEvent(gomock.Any()).DoAndReturn(func(e *triggers.Event) error {
+					<-release
+					return nil
+				}).AnyTimes()
+
+				done := make(chan bool)
+				go func() {
+					watcher.Poll()
+					watcher.Poll()
+					close(done)
+				}()
+				Eventually(done).Should(BeClosed())
+			})
+		})
+
+		When("a watched key can't be read", func() {
+			It("should send nothing until it can be read again", func() {
+				mockConfig.EXPECT().Get("pricing").Return(&config.Value{Content: "v1", Version: "1"}, nil)
+				watcher.Poll()
+
+				mockConfig.EXPECT().Get("pricing").Return(nil, errors.ErrorsWithScope("test", nil)(codes.Unavailable, "unavailable", nil))
+				mockAdapter.EXPECT().HandleEvent(gomock.Any()).Times(0)
+				watcher.Poll()
+			})
+		})
+	})
+})
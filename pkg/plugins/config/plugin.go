@@ -0,0 +1,76 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Value - the value of a configuration key
+type Value struct {
+	// Content - the value, decoded like JSON to a string, float64, bool, nil, []interface{} or map[string]interface{}
+	Content interface{}
+	// Version - changes whenever the value does
+	Version string
+}
+
+type ConfigService interface {
+	// Get - returns the value of a configuration key, or a NotFound error if it doesn't exist
+	Get(key string) (*Value, error)
+}
+
+type UnimplementedConfigPlugin struct {
+	ConfigService
+}
+
+var _ ConfigService = (*UnimplementedConfigPlugin)(nil)
+
+func (*UnimplementedConfigPlugin) Get(key string) (*Value, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+// isJson - returns true for JSON media types, e.g. application/json or application/vnd.app+json;charset=utf-8
+func isJson(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// Decode - returns the content of a configuration value stored as text, decoding JSON and leaving other text as a string.
+// Text is decoded when its content type is JSON, or when it has no content type and is valid JSON
+func Decode(text string, contentType string) interface{} {
+	if contentType != "" && !isJson(contentType) {
+		return text
+	}
+
+	var content interface{}
+	if err := json.Unmarshal([]byte(text), &content); err != nil {
+		return text
+	}
+
+	return content
+}
+
+// Version - returns a version for a value from providers that don't version values, a hash of its JSON encoding
+func Version(content interface{}) string {
+	b, _ := json.Marshal(content)
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// DefaultWatchInterval - how often watched keys are checked for changes
+const DefaultWatchInterval = 30 * time.Second
+
+// Change - the payload of the events sent to config workers when the value of their key changes
+type Change struct {
+	Key     string      `json:"key"`
+	Value   interface{} `json:"value"`
+	Version string      `json:"version"`
+	// Deleted - the key no longer exists, its value is null
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// Watcher - checks the keys config workers are registered for at an interval, sending each of them an event when a key's value changes
+type Watcher struct {
+	plugin   ConfigService
+	pool     worker.WorkerPool
+	interval time.Duration
+	lock     sync.Mutex
+	// the last version seen of each watched key, empty if it didn't exist
+	versions map[string]string
+	stop     chan bool
+}
+
+func isConfigWorker(w worker.Worker) bool {
+	_, ok := w.(*worker.ConfigWorker)
+	return ok
+}
+
+// keys - returns the keys config workers are registered for
+func (w *Watcher) keys() map[string]bool {
+	keys := map[string]bool{}
+	for _, wrkr := range w.pool.GetWorkers(&worker.GetWorkerOptions{Filter: isConfigWorker}) {
+		keys[wrkr.(*worker.ConfigWorker).Key()] = true
+	}
+
+	return keys
+}
+
+// deliver - sends the event to the worker through the pool, so it's handled with the pool's timeouts and breakers.
+// The worker is given until the deadline to handle it, after which it's no longer waited for
+func (w *Watcher) deliver(target worker.Worker, evt *triggers.Event, key string, deadline time.Duration) {
+	wrkr, err := w.pool.GetWorker(&worker.GetWorkerOptions{
+		Event: evt,
+		Filter: func(wrkr worker.Worker) bool {
+			return wrkr == target
+		},
+	})
+	if err != nil {
+		log.Default().Printf("error getting worker for change to config key %s: %v", key, err)
+		return
+	}
+
+	handled := make(chan error, 1)
+	go func() {
+		handled <- wrkr.HandleEvent(evt)
+	}()
+
+	select {
+	case err := <-handled:
+		if err != nil {
+			log.Default().Printf("error handling change to config key %s: %v", key, err)
+		}
+	case <-time.After(deadline):
+		log.Default().Printf("change to config key %s wasn't handled within %s", key, deadline)
+	}
+}
+
+// notify - sends the change to every worker registered for its key, without waiting for them to handle it
+func (w *Watcher) notify(change *Change) {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		log.Default().Printf("error encoding change to config key %s: %v", change.Key, err)
+		return
+	}
+
+	evt := &triggers.Event{
+		ID:      uuid.New().String(),
+		Topic:   worker.ConfigTopic(change.Key),
+		Payload: payload,
+	}
+
+	for _, target := range w.pool.GetWorkers(&worker.GetWorkerOptions{Event: evt, Filter: isConfigWorker}) {
+		go w.deliver(target, evt, change.Key, w.interval)
+	}
+}
+
+// Poll - checks each watched key for changes, notifying the workers of keys that changed since the last poll.
+// Keys are first read when they're watched, so workers are only sent changes after they're registered.
+// Workers are notified once the keys have been checked, so a slow worker doesn't hold up the next poll
+func (w *Watcher) Poll() {
+	changes := w.changes()
+	for _, change := range changes {
+		w.notify(change)
+	}
+}
+
+// changes - checks each watched key for changes, returning the keys that changed since the last poll
+func (w *Watcher) changes() []*Change {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	changes := []*Change{}
+
+	keys := w.keys()
+	for key := range w.versions {
+		if !keys[key] {
+			delete(w.versions, key)
+		}
+	}
+
+	for key := range keys {
+		change := &Change{Key: key}
+
+		value, err := w.plugin.Get(key)
+		if err != nil {
+			if errors.Code(err) != codes.NotFound {
+				log.Default().Printf("error checking config key %s for changes: %v", key, err)
+				continue
+			}
+			change.Deleted = true
+		} else {
+			change.Value = value.Content
+			change.Version = value.Version
+		}
+
+		previous, seen := w.versions[key]
+		w.versions[key] = change.Version
+		if seen && previous != change.Version {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// Start - Begins checking watched keys for changes at the configured interval, until Stop is called
+func (w *Watcher) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.Poll()
+		}
+	}
+}
+
+// Stop - Stops checking for changes
+func (w *Watcher) Stop() {
+	close(w.stop)
+}
+
+// NewWatcher - creates a watcher checking the keys of the pool's config workers for changes at the given interval
+func NewWatcher(plugin ConfigService, pool worker.WorkerPool, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	return &Watcher{
+		plugin:   plugin,
+		pool:     pool,
+		interval: interval,
+		versions: map[string]string{},
+		stop:     make(chan bool),
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Supported aggregate functions
+const (
+	AggregateCount = "count"
+	AggregateSum   = "sum"
+	AggregateAvg   = "avg"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+)
+
+// Aggregation - an aggregate function applied to a field of the documents matching a query
+type Aggregation struct {
+	Function string
+	// Field - the numeric field to aggregate, ignored for count
+	Field string
+}
+
+// AggregateResult - the value calculated for an aggregation
+type AggregateResult struct {
+	Aggregation Aggregation
+	// Value - an int64 for count, otherwise a float64.
+	// nil for avg, min and max if no documents contain a numeric value for the field
+	Value interface{}
+}
+
+// ValidateAggregations - returns an error if any of the aggregations are unsupported
+func ValidateAggregations(aggregations []Aggregation) error {
+	if len(aggregations) == 0 {
+		return fmt.Errorf("at least one aggregation is required")
+	}
+
+	for _, a := range aggregations {
+		switch a.Function {
+		case AggregateCount:
+		case AggregateSum, AggregateAvg, AggregateMin, AggregateMax:
+			if a.Field == "" {
+				return fmt.Errorf("%s aggregation requires a field", a.Function)
+			}
+		default:
+			return fmt.Errorf("unsupported aggregate function %s", a.Function)
+		}
+	}
+
+	return nil
+}
+
+// FieldValue - returns the value of a field in the document content, nested fields are separated by a '.'
+func FieldValue(content map[string]interface{}, field string) (interface{}, bool) {
+	var value interface{} = content
+
+	for _, name := range strings.Split(field, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if value, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// numericValue - returns the value as a float64, if it's a number
+func numericValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+type accumulator struct {
+	count int64
+	sum   float64
+	min   float64
+	max   float64
+}
+
+func (a *accumulator) add(value float64) {
+	if a.count == 0 || value < a.min {
+		a.min = value
+	}
+	if a.count == 0 || value > a.max {
+		a.max = value
+	}
+	a.count++
+	a.sum += value
+}
+
+func (a *accumulator) result(function string) interface{} {
+	switch function {
+	case AggregateCount:
+		return a.count
+	case AggregateSum:
+		return a.sum
+	}
+
+	if a.count == 0 {
+		return nil
+	}
+
+	switch function {
+	case AggregateAvg:
+		return a.sum / float64(a.count)
+	case AggregateMin:
+		return a.min
+	default:
+		return a.max
+	}
+}
+
+// AggregateIterator - calculates aggregations by reading every document from the iterator.
+// For providers without native aggregation support, documents are aggregated as they're read
+// so the matching documents are never held in memory at once.
+func AggregateIterator(iter DocumentIterator, aggregations []Aggregation) ([]AggregateResult, error) {
+	accumulators := make([]*accumulator, len(aggregations))
+	for i := range accumulators {
+		accumulators[i] = &accumulator{}
+	}
+
+	for {
+		doc, err := iter()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, a := range aggregations {
+			if a.Function == AggregateCount {
+				accumulators[i].count++
+				continue
+			}
+
+			if value, ok := FieldValue(doc.Content, a.Field); ok {
+				if n, ok := numericValue(value); ok {
+					accumulators[i].add(n)
+				}
+			}
+		}
+	}
+
+	results := make([]AggregateResult, len(aggregations))
+	for i, a := range aggregations {
+		results[i] = AggregateResult{
+			Aggregation: a,
+			Value:       accumulators[i].result(a.Function),
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+// SetEach - sets each document in turn, for providers without batch writes
+func SetEach(set func(*Key, map[string]interface{}) error, docs []Document) *BatchResponse {
+	resp := &BatchResponse{
+		FailedWrites: make([]*FailedWrite, 0),
+	}
+
+	for _, doc := range docs {
+		if err := set(doc.Key, doc.Content); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, &FailedWrite{
+				Key:     doc.Key,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return resp
+}
+
+// DeleteEach - deletes each document in turn, for providers without batch writes
+func DeleteEach(del func(*Key) error, keys []*Key) *BatchResponse {
+	resp := &BatchResponse{
+		FailedWrites: make([]*FailedWrite, 0),
+	}
+
+	for _, key := range keys {
+		if err := del(key); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, &FailedWrite{
+				Key:     key,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return resp
+}
@@ -16,7 +16,6 @@ package boltdb_service
 
 import (
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -191,27 +190,221 @@ func (s *BoltDocService) Delete(key *document.Key) error {
 		)
 	}
 
-	// Delete sub collection documents
-	if key.Collection.Parent == nil {
-		childDocs, err := fetchChildDocs(key, db)
+	// Delete sub collection documents, stored in the partition of the top level document
+	childDocs, err := fetchChildDocs(document.RootKey(key), db)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"Child Doc fetch error",
+			err,
+		)
+	}
+
+	rootName := document.RootKey(key).Collection.Name
+	for _, childDoc := range childDocs {
+		if !document.IsDescendant(document.KeyFromSortKey(rootName, childDoc.PartitionKey, childDoc.SortKey), key) {
+			continue
+		}
+
+		err = db.DeleteStruct(&childDoc)
 		if err != nil {
 			return newErr(
 				codes.Internal,
-				"Child Doc fetch error",
+				"Child Doc deletion error",
 				err,
 			)
 		}
+	}
 
-		for _, childDoc := range childDocs {
-			err = db.DeleteStruct(&childDoc)
-			if err != nil {
-				return newErr(
-					codes.Internal,
-					"Child Doc deletion error",
-					err,
-				)
-			}
-		}
+	return nil
+}
+
+// SetBatch - sets each document in turn, BoltDB writes aren't batched
+func (s *BoltDocService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	return document.SetEach(s.Set, docs), nil
+}
+
+// DeleteBatch - deletes each document in turn, BoltDB writes aren't batched
+func (s *BoltDocService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	return document.DeleteEach(s.Delete, keys), nil
+}
+
+// Increment - reads and writes the field in a read-write transaction, which BoltDB runs one at a time
+func (s *BoltDocService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	newErr := errors.ErrorsWithScope(
+		"BoltDocService.Increment",
+		map[string]interface{}{
+			"key":   key,
+			"field": field,
+		},
+	)
+
+	if err := document.ValidateKey(key); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"Invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateIncrementField(field); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"Invalid field",
+			err,
+		)
+	}
+
+	db, err := s.createdDb(*key.Collection)
+	if err != nil {
+		return 0, newErr(
+			codes.FailedPrecondition,
+			"createDb error",
+			err,
+		)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin(true)
+	if err != nil {
+		return 0, newErr(
+			codes.Internal,
+			"Transaction error",
+			err,
+		)
+	}
+	defer tx.Rollback()
+
+	doc := createDoc(key)
+	if err := tx.One(idName, doc.Id, &doc); err != nil && err != storm.ErrNotFound {
+		return 0, newErr(
+			codes.Internal,
+			"DB Fetch error",
+			err,
+		)
+	}
+
+	value, err := document.IncrementedValue(doc.Value[field], delta)
+	if err != nil {
+		return 0, newErr(
+			codes.FailedPrecondition,
+			"Invalid field value",
+			err,
+		)
+	}
+
+	if doc.Value == nil {
+		doc.Value = map[string]interface{}{}
+	}
+	doc.Value[field] = value
+
+	if err := tx.Save(&doc); err != nil {
+		return 0, newErr(
+			codes.Internal,
+			"Document save error",
+			err,
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, newErr(
+			codes.Internal,
+			"Transaction commit error",
+			err,
+		)
+	}
+
+	return value, nil
+}
+
+// CompareAndSet - reads the field and writes the document in a read-write transaction, which BoltDB runs one at a time
+func (s *BoltDocService) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"BoltDocService.CompareAndSet",
+		map[string]interface{}{
+			"key":      key,
+			"field":    field,
+			"expected": expected,
+		},
+	)
+
+	if err := document.ValidateKey(key); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"Invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateCompareField(field); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"Invalid field",
+			err,
+		)
+	}
+
+	if content == nil {
+		return newErr(
+			codes.InvalidArgument,
+			"Invalid content",
+			nil,
+		)
+	}
+
+	db, err := s.createdDb(*key.Collection)
+	if err != nil {
+		return newErr(
+			codes.FailedPrecondition,
+			"createDb error",
+			err,
+		)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin(true)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"Transaction error",
+			err,
+		)
+	}
+	defer tx.Rollback()
+
+	doc := createDoc(key)
+	if err := tx.One(idName, doc.Id, &doc); err != nil && err != storm.ErrNotFound {
+		return newErr(
+			codes.Internal,
+			"DB Fetch error",
+			err,
+		)
+	}
+
+	if !document.FieldEquals(doc.Value[field], expected) {
+		return newErr(
+			codes.FailedPrecondition,
+			document.ConditionFailed,
+			nil,
+		)
+	}
+
+	doc.Value = content
+
+	if err := tx.Save(&doc); err != nil {
+		return newErr(
+			codes.Internal,
+			"Document save error",
+			err,
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return newErr(
+			codes.Internal,
+			"Transaction commit error",
+			err,
+		)
 	}
 
 	return nil
@@ -253,11 +446,12 @@ func (s *BoltDocService) query(collection *document.Collection, expressions []do
 	if parentKey == nil {
 		matchers = append(matchers, q.Eq(sortKeyName, collection.Name+"#"))
 	} else {
-		if parentKey.Id != "" {
-			matchers = append(matchers, q.Eq(partitionKeyName, parentKey.Id))
+		if rootKey := document.RootKey(parentKey); rootKey.Id != "" {
+			matchers = append(matchers, q.Eq(partitionKeyName, rootKey.Id))
 		}
-		matchers = append(matchers, q.Gte(sortKeyName, collection.Name+"#"))
-		matchers = append(matchers, q.Lt(sortKeyName, document.GetEndRangeValue(collection.Name+"#")))
+		sortKeyPrefix := document.SortKeyPrefix(collection)
+		matchers = append(matchers, q.Gte(sortKeyName, sortKeyPrefix))
+		matchers = append(matchers, q.Lt(sortKeyName, document.GetEndRangeValue(sortKeyPrefix)))
 	}
 
 	// Create query object
@@ -288,35 +482,13 @@ func (s *BoltDocService) query(collection *document.Collection, expressions []do
 		fmt.Println("query.Find: ", err)
 	}
 
-	// Create values map filter expression, for example : country == 'US' && age < '12'
-	expStr := strings.Builder{}
-	for _, exp := range expressions {
-		// TODO: test typing capabilities of library and rewrite expressions based on value type
-		expValue := fmt.Sprintf("%v", exp.Value)
-
-		if expStr.Len() > 0 {
-			expStr.WriteString(" && ")
-		}
-		if exp.Operator == "startsWith" {
-			expStr.WriteString(exp.Operand + " >= '" + expValue + "' && ")
-			expStr.WriteString(exp.Operand + " < '" + document.GetEndRangeValue(expValue) + "'")
-		} else {
-			if stringValue, ok := exp.Value.(string); ok {
-				expValue = fmt.Sprintf("'%s'", stringValue)
-			}
-			expStr.WriteString(exp.Operand + " " + exp.Operator + " " + expValue)
-		}
-	}
-	var filterExp *govaluate.EvaluableExpression
-	if expStr.Len() > 0 {
-		filterExp, err = govaluate.NewEvaluableExpression(expStr.String())
-		if err != nil {
-			return nil, newErr(
-				codes.InvalidArgument,
-				fmt.Sprintf("Unable to create filter expressions from: %s", expStr.String()),
-				err,
-			)
-		}
+	filter, err := newFilter(expressions)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"Unable to create filter expressions",
+			err,
+		)
 	}
 
 	// Process query results, applying value filter expressions and fetch limit
@@ -325,16 +497,16 @@ func (s *BoltDocService) query(collection *document.Collection, expressions []do
 	for _, doc := range docs {
 		scanCount += 1
 
-		if filterExp != nil {
-			include, err := filterExp.Evaluate(doc.Value)
-			if err != nil || !(include.(bool)) {
-				// TODO: determine if skipping failed evaluations is always appropriate.
-				// 	errors are usually a datatype mismatch or a missing key/prop on the doc, which is essentially a failed match.
-				// Treat a failed or false eval as a mismatch
-				continue
-			}
-		}
 		sdkDoc := toSdkDoc(collection, doc)
+
+		// The sort key prefix may match sub-collections of other parents when a parent id is blank
+		if !document.InCollection(sdkDoc.Key, collection) {
+			continue
+		}
+
+		if !filter.matches(doc.Value) {
+			continue
+		}
 		documents = append(documents, *sdkDoc)
 
 		// Break if greater than fetch limit
@@ -375,50 +547,31 @@ func (s *BoltDocService) QueryStream(collection *document.Collection, expression
 		},
 	)
 
-	tmpLimit := limit
-	var documents []document.Document
-	var pagingToken map[string]string
+	// Documents are read a page at a time as they're streamed
+	return document.PagedIterator(func(limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+		return s.query(collection, expressions, limit, pagingToken, newErr)
+	}, document.StreamPageSize, limit)
+}
 
-	// Initial fetch
-	res, fetchErr := s.query(collection, expressions, limit, nil, newErr)
+// Aggregate - aggregates the matching documents as they're streamed from the database
+func (s *BoltDocService) Aggregate(collection *document.Collection, expressions []document.QueryExpression, aggregations []document.Aggregation) ([]document.AggregateResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"BoltDocService.Aggregate",
+		map[string]interface{}{
+			"collection": collection,
+		},
+	)
 
-	if fetchErr != nil {
-		// Return an error only iterator if the initial fetch failed
-		return func() (*document.Document, error) {
-			return nil, fetchErr
-		}
+	if err := document.ValidateAggregations(aggregations); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid aggregations",
+			err,
+		)
 	}
 
-	documents = res.Documents
-	pagingToken = res.PagingToken
-
-	return func() (*document.Document, error) {
-		// check the iteration state
-		if tmpLimit == 0 && limit > 0 {
-			// we've reached the limit of reading
-			return nil, io.EOF
-		} else if pagingToken != nil && len(documents) == 0 {
-			// we've run out of documents and have more pages to read
-			res, fetchErr = s.query(collection, expressions, tmpLimit, pagingToken, newErr)
-			documents = res.Documents
-			pagingToken = res.PagingToken
-		} else if pagingToken == nil && len(documents) == 0 {
-			// we're all out of documents and pages before hitting the limit
-			return nil, io.EOF
-		}
-
-		// We received an error fetching the docs
-		if fetchErr != nil {
-			return nil, fetchErr
-		}
-
-		// pop the first element
-		var doc document.Document
-		doc, documents = documents[0], documents[1:]
-		tmpLimit = tmpLimit - 1
-
-		return &doc, nil
-	}
+	// errors returned by the stream are already scoped to the query
+	return document.AggregateIterator(s.QueryStream(collection, expressions, 0), aggregations)
 }
 
 // New - Create a new dev KV plugin
@@ -465,41 +618,168 @@ func createDoc(key *document.Key) BoltDoc {
 			SortKey:      key.Collection.Name + "#",
 		}
 	} else {
+		ids := make([]string, 0)
+		for _, k := range document.KeyPath(key) {
+			ids = append(ids, k.Id)
+		}
+
 		return BoltDoc{
-			Id:           parentKey.Id + document.SubcollectionDelimiter + key.Id,
-			PartitionKey: parentKey.Id,
-			SortKey:      key.Collection.Name + "#" + key.Id,
+			Id:           strings.Join(ids, document.SubcollectionDelimiter),
+			PartitionKey: ids[0],
+			SortKey:      document.SortKey(key),
+		}
+	}
+}
+
+// filter - matches document values against a group of expressions, each group of an or expression
+// is evaluated separately so a failed evaluation only fails its own group
+type filter struct {
+	expression *govaluate.EvaluableExpression
+	anyOf      [][]*filter
+	// geo expressions are matched against the location in their field, as every document is scanned
+	geo []document.QueryExpression
+}
+
+// newFilter - returns a filter for the expressions, e.g. country == 'US' && age < '12'
+func newFilter(expressions []document.QueryExpression) (*filter, error) {
+	f := &filter{}
+
+	// Create values map filter expression, for example : country == 'US' && age < '12'
+	expStr := strings.Builder{}
+	for _, exp := range expressions {
+		if groups, ok := exp.Groups(); ok {
+			groupFilters := make([]*filter, 0, len(groups))
+			for _, group := range groups {
+				groupFilter, err := newFilter(group)
+				if err != nil {
+					return nil, err
+				}
+				groupFilters = append(groupFilters, groupFilter)
+			}
+			f.anyOf = append(f.anyOf, groupFilters)
+			continue
+		}
+
+		if document.IsGeoOperator(exp.Operator) {
+			f.geo = append(f.geo, exp)
+			continue
+		}
+
+		// TODO: test typing capabilities of library and rewrite expressions based on value type
+		expValue := fmt.Sprintf("%v", exp.Value)
+
+		if expStr.Len() > 0 {
+			expStr.WriteString(" && ")
+		}
+		if exp.Operator == "startsWith" {
+			expStr.WriteString(exp.Operand + " >= '" + expValue + "' && ")
+			expStr.WriteString(exp.Operand + " < '" + document.GetEndRangeValue(expValue) + "'")
+		} else if exp.Operator == "in" || exp.Operator == "not-in" {
+			in := exp.Operand + " IN (" + strings.Join(listValues(exp.Value), ", ") + ")"
+			if exp.Operator == "not-in" {
+				in = "!(" + in + ")"
+			}
+			expStr.WriteString(in)
+		} else if exp.Operator == "array-contains" || exp.Operator == "array-contains-any" {
+			// evaluating IN against a value that isn't an array fails, so only arrays match
+			contains := make([]string, 0)
+			for _, v := range listValues(exp.Value) {
+				contains = append(contains, v+" IN "+exp.Operand)
+			}
+			expStr.WriteString("(" + strings.Join(contains, " || ") + ")")
+		} else {
+			if stringValue, ok := exp.Value.(string); ok {
+				expValue = fmt.Sprintf("'%s'", stringValue)
+			}
+			expStr.WriteString(exp.Operand + " " + exp.Operator + " " + expValue)
 		}
 	}
+
+	if expStr.Len() > 0 {
+		filterExp, err := govaluate.NewEvaluableExpression(expStr.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression %s: %v", expStr.String(), err)
+		}
+		f.expression = filterExp
+	}
+
+	return f, nil
+}
+
+// matches - returns true if the values match the expression and any group of each or expression
+func (f *filter) matches(values map[string]interface{}) bool {
+	if f.expression != nil {
+		include, err := f.expression.Evaluate(values)
+		if err != nil || !(include.(bool)) {
+			// TODO: determine if skipping failed evaluations is always appropriate.
+			// 	errors are usually a datatype mismatch or a missing key/prop on the doc, which is essentially a failed match.
+			// Treat a failed or false eval as a mismatch
+			return false
+		}
+	}
+
+	for _, exp := range f.geo {
+		if !document.GeoMatches(exp, values) {
+			return false
+		}
+	}
+
+	for _, groups := range f.anyOf {
+		matched := false
+		for _, group := range groups {
+			if group.matches(values) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listValues - formats the expression value, or each value of a list expression value, as govaluate literals.
+// A single literal in parentheses isn't a list to govaluate, so it's repeated
+func listValues(value interface{}) []string {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	literals := make([]string, 0, len(values)+1)
+	for _, v := range values {
+		if stringValue, ok := v.(string); ok {
+			literals = append(literals, fmt.Sprintf("'%s'", stringValue))
+		} else {
+			literals = append(literals, fmt.Sprintf("%v", v))
+		}
+	}
+
+	if len(literals) == 1 {
+		literals = append(literals, literals[0])
+	}
+
+	return literals
 }
 
 func toSdkDoc(col *document.Collection, doc BoltDoc) *document.Document {
-	keys := strings.Split(doc.Id, document.SubcollectionDelimiter)
+	// Translate the boltdb keys into a nitric document key
+	key := &document.Key{
+		Collection: col,
+		Id:         doc.Id,
+	}
 
-	// Translate the boltdb Id into a nitric document key Id
-	var id string
-	var c *document.Collection
-	if len(keys) > 1 {
+	if col.Parent != nil {
 		// sub document
-		id = keys[len(keys)-1]
-		c = &document.Collection{
-			Name: col.Name,
-			Parent: &document.Key{
-				Collection: col.Parent.Collection,
-				Id:         keys[0],
-			},
-		}
-	} else {
-		id = doc.Id
-		c = col
+		key = document.KeyFromSortKey(document.RootKey(col.Parent).Collection.Name, doc.PartitionKey, doc.SortKey)
 	}
 
 	return &document.Document{
 		Content: doc.Value,
-		Key: &document.Key{
-			Collection: c,
-			Id:         id,
-		},
+		Key:     key,
 	}
 }
 
@@ -30,6 +30,43 @@ var validOperators = map[string]bool{
 	">=":         true,
 	"<=":         true,
 	"startsWith": true,
+	// field is one of a list of values
+	"in":     true,
+	"not-in": true,
+	// field is an array containing a value, or any of a list of values
+	"array-contains":     true,
+	"array-contains-any": true,
+	// field is a location within an area
+	GeoRadiusOperator: true,
+	GeoBoxOperator:    true,
+}
+
+// listOperators - operators whose expression values are lists
+var listOperators = map[string]bool{
+	"in":                 true,
+	"not-in":             true,
+	"array-contains-any": true,
+}
+
+// inequalityOperators - operators that filter a range of values, or all but a list of values
+var inequalityOperators = map[string]bool{
+	">":          true,
+	"<":          true,
+	">=":         true,
+	"<=":         true,
+	"startsWith": true,
+	"not-in":     true,
+}
+
+// IsListOperator - returns true if the operator's expression value is a list of values
+func IsListOperator(operator string) bool {
+	return listOperators[operator]
+}
+
+// IsInequalityOperator - returns true if the operator filters a range of values, or all but a list of values.
+// Providers may order by the field of an inequality, e.g. to page through the results
+func IsInequalityOperator(operator string) bool {
+	return inequalityOperators[operator]
 }
 
 // ValidateKey - validates a document key, used for operations on a single document e.g. Get, Set, Delete
@@ -62,6 +99,9 @@ func ValidateCollection(collection *Collection) error {
 		return fmt.Errorf("provide non-blank collection.Name")
 	}
 	if collection.Parent != nil {
+		if strings.Contains(collection.Name, SubcollectionDelimiter) {
+			return fmt.Errorf("sub-collection.Name cannot contain %s", SubcollectionDelimiter)
+		}
 		if err := ValidateKey(collection.Parent); err != nil {
 			return fmt.Errorf("invalid parent for collection %s, %v", collection.Name, err)
 		}
@@ -97,6 +137,9 @@ func ValidateQueryCollection(collection *Collection) error {
 		return fmt.Errorf("provide non-blank collection.Name")
 	}
 	if collection.Parent != nil {
+		if strings.Contains(collection.Name, SubcollectionDelimiter) {
+			return fmt.Errorf("sub-collection.Name cannot contain %s", SubcollectionDelimiter)
+		}
 		if err := ValidateQueryKey(collection.Parent); err != nil {
 			return fmt.Errorf("invalid parent for collection %s, %v", collection.Name, err)
 		}
@@ -122,26 +165,86 @@ func ValidateExpressions(expressions []QueryExpression) error {
 		return fmt.Errorf("provide non-nil query expressions")
 	}
 
+	return validateExpressionGroup(expressions, map[string]string{}, 0)
+}
+
+// validateExpressionGroup - validates a group of expressions nested depth or expressions deep,
+// the inequality properties of the enclosing groups also apply to the group
+func validateExpressionGroup(expressions []QueryExpression, enclosingInequalities map[string]string, depth int) error {
 	inequalityProperties := make(map[string]string)
+	for prop, op := range enclosingInequalities {
+		inequalityProperties[prop] = op
+	}
+
+	comparisons := make([]QueryExpression, 0, len(expressions))
+	orExpressions := make([]QueryExpression, 0)
+	geoExpressions := 0
 
 	for _, exp := range expressions {
+		if exp.Operator == OrOperator {
+			if err := validateOrExpression(exp, depth); err != nil {
+				return err
+			}
+			orExpressions = append(orExpressions, exp)
+			continue
+		}
+
 		if exp.Operand == "" {
 			return fmt.Errorf("provide non-blank query expression operand: %v", exp)
 		}
 
 		if _, found := validOperators[exp.Operator]; !found {
-			return fmt.Errorf("provide valid query expression operator [==, <, >, <=, >=, startsWith]: %v", exp.Operator)
+			return fmt.Errorf("provide valid query expression operator [==, <, >, <=, >=, startsWith, in, not-in, array-contains, array-contains-any, within-radius, within-box, or]: %v", exp.Operator)
 		}
 		if exp.Value == "" {
 			return fmt.Errorf("provide non-blank query expression value: %v", exp)
 		}
 
-		if exp.Operator != "==" {
+		if IsGeoOperator(exp.Operator) {
+			// Providers without native geo queries translate the expression to an array-contains-any expression of geohash cells
+			if depth > 0 {
+				return fmt.Errorf("geo expressions are not supported in or expressions: %v", exp)
+			}
+			if err := validateGeoExpression(exp); err != nil {
+				return err
+			}
+			geoExpressions++
+			continue
+		}
+
+		values, isList := exp.Value.([]interface{})
+		if listOperators[exp.Operator] {
+			if !isList || len(values) == 0 {
+				return fmt.Errorf("provide a non-empty list value for the %s operator: %v", exp.Operator, exp)
+			}
+
+			for _, v := range values {
+				if _, nested := v.([]interface{}); nested || v == nil {
+					return fmt.Errorf("provide non-list values in the list value of the %s operator: %v", exp.Operator, exp)
+				}
+			}
+		} else if isList {
+			return fmt.Errorf("list values are only supported by the in, not-in and array-contains-any operators: %v", exp)
+		}
+
+		if inequalityOperators[exp.Operator] {
 			inequalityProperties[exp.Operand] = exp.Operator
 		}
+		comparisons = append(comparisons, exp)
 	}
 
-	// Firestore inequality compatibility check
+	if geoExpressions > 0 {
+		if geoExpressions > 1 {
+			return fmt.Errorf("only one geo expression is supported per query")
+		}
+		// Firestore supports one array-contains or array-contains-any expression per query, which can't be combined with not-in
+		if exp, found := findOperator(expressions, "array-contains", "array-contains-any", "not-in"); found {
+			return fmt.Errorf("geo expressions can't be combined with array-contains, array-contains-any or not-in expressions: %v", exp)
+		}
+	}
+
+	// Firestore inequality compatibility check, or expressions are split into a query per group
+	// so the check applies to each group combined with the groups enclosing it
 	if len(inequalityProperties) > 1 {
 		msg := ""
 		for prop, exp := range inequalityProperties {
@@ -156,13 +259,109 @@ func ValidateExpressions(expressions []QueryExpression) error {
 	}
 
 	// DynamoDB range expression compatibility check
-	if err := hasRangeError(expressions); err != nil {
+	if err := hasRangeError(comparisons); err != nil {
 		return err
 	}
 
+	for _, exp := range orExpressions {
+		groups, _ := exp.Groups()
+		for _, group := range groups {
+			if err := validateExpressionGroup(group, inequalityProperties, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// findOperator - returns the first expression using one of the operators, including those in or expressions
+func findOperator(expressions []QueryExpression, operators ...string) (QueryExpression, bool) {
+	for _, exp := range expressions {
+		if groups, isOr := exp.Groups(); isOr {
+			for _, group := range groups {
+				if found, ok := findOperator(group, operators...); ok {
+					return found, true
+				}
+			}
+			continue
+		}
+
+		for _, op := range operators {
+			if exp.Operator == op {
+				return exp, true
+			}
+		}
+	}
+
+	return QueryExpression{}, false
+}
+
+// validateOrExpression - validates the shape of an or expression, its groups are validated separately
+func validateOrExpression(exp QueryExpression, depth int) error {
+	if exp.Operand != "" {
+		return fmt.Errorf("provide a blank operand for the or operator: %v", exp)
+	}
+
+	if depth >= maxExpressionDepth {
+		return fmt.Errorf("or expressions only supported to a nesting depth of %d", maxExpressionDepth)
+	}
+
+	groups, ok := exp.Groups()
+	if !ok || len(groups) == 0 {
+		return fmt.Errorf("provide a non-empty list of expression groups for the or operator: %v", exp)
+	}
+
+	for _, group := range groups {
+		if len(group) == 0 {
+			return fmt.Errorf("provide non-empty expression groups for the or operator: %v", exp)
+		}
+	}
+
+	return nil
+}
+
+// Disjunctions - returns the expressions as a list of groups without or expressions, matching the same documents
+// when a document matches all the expressions of any of the groups. Used by providers that split or expressions
+// into a query per group, returns false if there would be more than max groups
+func Disjunctions(expressions []QueryExpression, max int) ([][]QueryExpression, bool) {
+	disjunctions := [][]QueryExpression{{}}
+
+	for _, exp := range expressions {
+		groups, isOr := exp.Groups()
+		if !isOr {
+			for i := range disjunctions {
+				disjunctions[i] = append(disjunctions[i], exp)
+			}
+			continue
+		}
+
+		expanded := make([][]QueryExpression, 0)
+		for _, group := range groups {
+			groupDisjunctions, ok := Disjunctions(group, max)
+			if !ok {
+				return nil, false
+			}
+
+			for _, d := range disjunctions {
+				for _, gd := range groupDisjunctions {
+					if len(expanded) == max {
+						return nil, false
+					}
+
+					combined := make([]QueryExpression, 0, len(d)+len(gd))
+					combined = append(combined, d...)
+					combined = append(combined, gd...)
+					expanded = append(expanded, combined)
+				}
+			}
+		}
+		disjunctions = expanded
+	}
+
+	return disjunctions, true
+}
+
 // QueryExpression sorting support with sort.Interface
 
 type ExpsSort []QueryExpression
@@ -203,10 +402,10 @@ func validateSubCollectionDepth(collection *Collection) error {
 		depth += 1
 		coll = coll.Parent.Collection
 	}
-	if depth > MaxSubCollectionDepth {
+	if depth > maxSubCollectionDepth {
 		return fmt.Errorf(
 			"sub-collections only supported to a depth of %d, found depth of %d for collection %s",
-			MaxSubCollectionDepth,
+			maxSubCollectionDepth,
 			depth,
 			collection.Name,
 		)
@@ -14,7 +14,12 @@
 package document_test
 
 import (
+	"fmt"
+	"math"
+	"io"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/nitrictech/nitric/pkg/plugins/document"
 
@@ -228,6 +233,54 @@ var _ = Describe("Document Plugin", func() {
 				Expect(err).ToNot(BeNil())
 			})
 		})
+		When("list operators have list values", func() {
+			It("should be valid", func() {
+				exps := []document.QueryExpression{
+					{Operand: "status", Operator: "in", Value: []interface{}{"open", "closed"}},
+					{Operand: "tags", Operator: "array-contains", Value: "urgent"},
+					{Operand: "owner", Operator: "not-in", Value: []interface{}{"bot"}},
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).To(BeNil())
+			})
+		})
+		When("list operator value is not a list", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					{Operand: "status", Operator: "in", Value: "open"},
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("list operator value is empty", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					{Operand: "tags", Operator: "array-contains-any", Value: []interface{}{}},
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("list value is used with a comparison operator", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					{Operand: "status", Operator: "==", Value: []interface{}{"open"}},
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("not-in is combined with an inequality on another field", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					{Operand: "owner", Operator: "not-in", Value: []interface{}{"bot"}},
+					{Operand: "age", Operator: ">", Value: 3},
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
 		When("operation is not valid", func() {
 			It("should return error", func() {
 				exps := []document.QueryExpression{
@@ -281,5 +334,603 @@ var _ = Describe("Document Plugin", func() {
 				Expect(err.Error()).To(HavePrefix("range expression combination not supported (use operators >= and <=) :"))
 			})
 		})
+		When("or expressions have nested groups", func() {
+			It("expression is valid", func() {
+				exps := []document.QueryExpression{
+					{Operand: "owner", Operator: "==", Value: "a"},
+					document.Or(
+						[]document.QueryExpression{{Operand: "status", Operator: "==", Value: "open"}},
+						[]document.QueryExpression{
+							{Operand: "age", Operator: ">", Value: 3},
+							document.Or(
+								[]document.QueryExpression{{Operand: "tag", Operator: "==", Value: "x"}},
+								[]document.QueryExpression{{Operand: "tag", Operator: "==", Value: "y"}},
+							),
+						},
+					),
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).To(BeNil())
+			})
+		})
+		When("or expression has an operand", func() {
+			It("should return error", func() {
+				exp := document.Or([]document.QueryExpression{{Operand: "status", Operator: "==", Value: "open"}})
+				exp.Operand = "status"
+				err := document.ValidateExpressions([]document.QueryExpression{exp})
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("or expression has an empty group", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					document.Or([]document.QueryExpression{{Operand: "status", Operator: "==", Value: "open"}}, []document.QueryExpression{}),
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("or expression group is invalid", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					document.Or([]document.QueryExpression{{Operand: "status", Operator: "startWith", Value: "o"}}),
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+		When("or expression group has an inequality on another field than its enclosing group", func() {
+			It("should return error", func() {
+				exps := []document.QueryExpression{
+					{Operand: "age", Operator: ">", Value: 3},
+					document.Or([]document.QueryExpression{{Operand: "score", Operator: "<", Value: 5}}),
+				}
+				err := document.ValidateExpressions(exps)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(HavePrefix("inequality expressions on multiple properties are not supported:"))
+			})
+		})
+		When("or expressions are nested too deeply", func() {
+			It("should return error", func() {
+				exp := document.QueryExpression{Operand: "status", Operator: "==", Value: "open"}
+				for i := 0; i < 4; i++ {
+					exp = document.Or([]document.QueryExpression{exp})
+				}
+				err := document.ValidateExpressions([]document.QueryExpression{exp})
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("nesting depth"))
+			})
+		})
+	})
+
+	When("Disjunctions", func() {
+		open := document.QueryExpression{Operand: "status", Operator: "==", Value: "open"}
+		closed := document.QueryExpression{Operand: "status", Operator: "==", Value: "closed"}
+		owner := document.QueryExpression{Operand: "owner", Operator: "==", Value: "a"}
+		tagX := document.QueryExpression{Operand: "tag", Operator: "==", Value: "x"}
+		tagY := document.QueryExpression{Operand: "tag", Operator: "==", Value: "y"}
+
+		It("should return a single group for expressions without or expressions", func() {
+			disjunctions, ok := document.Disjunctions([]document.QueryExpression{owner, open}, 10)
+			Expect(ok).To(BeTrue())
+			Expect(disjunctions).To(Equal([][]document.QueryExpression{{owner, open}}))
+		})
+
+		It("should combine each group with the enclosing expressions", func() {
+			disjunctions, ok := document.Disjunctions([]document.QueryExpression{
+				owner,
+				document.Or([]document.QueryExpression{open}, []document.QueryExpression{closed}),
+				document.Or([]document.QueryExpression{tagX}, []document.QueryExpression{tagY}),
+			}, 10)
+
+			Expect(ok).To(BeTrue())
+			Expect(disjunctions).To(ConsistOf(
+				[]document.QueryExpression{owner, open, tagX},
+				[]document.QueryExpression{owner, open, tagY},
+				[]document.QueryExpression{owner, closed, tagX},
+				[]document.QueryExpression{owner, closed, tagY},
+			))
+		})
+
+		It("should expand nested or expressions", func() {
+			disjunctions, ok := document.Disjunctions([]document.QueryExpression{
+				document.Or(
+					[]document.QueryExpression{open},
+					[]document.QueryExpression{closed, document.Or([]document.QueryExpression{tagX}, []document.QueryExpression{tagY})},
+				),
+			}, 10)
+
+			Expect(ok).To(BeTrue())
+			Expect(disjunctions).To(ConsistOf(
+				[]document.QueryExpression{open},
+				[]document.QueryExpression{closed, tagX},
+				[]document.QueryExpression{closed, tagY},
+			))
+		})
+
+		It("should return false when there would be too many groups", func() {
+			_, ok := document.Disjunctions([]document.QueryExpression{
+				document.Or([]document.QueryExpression{open}, []document.QueryExpression{closed}),
+				document.Or([]document.QueryExpression{tagX}, []document.QueryExpression{tagY}),
+			}, 3)
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("ValidateAggregations", func() {
+		When("a numeric aggregation has no field", func() {
+			It("should return error", func() {
+				err := document.ValidateAggregations([]document.Aggregation{{Function: document.AggregateSum}})
+				Expect(err.Error()).To(ContainSubstring("sum aggregation requires a field"))
+			})
+		})
+		When("the function is unsupported", func() {
+			It("should return error", func() {
+				err := document.ValidateAggregations([]document.Aggregation{{Function: "median", Field: "price"}})
+				Expect(err.Error()).To(ContainSubstring("unsupported aggregate function median"))
+			})
+		})
+	})
+
+	When("PagedIterator", func() {
+		// pages of ids from a collection of 5 documents, with an empty page between the first and second documents
+		pages := func(requested *[]int) document.PageQuery {
+			ids := []string{"1", "", "2", "3", "4", "5"}
+
+			return func(limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+				*requested = append(*requested, limit)
+
+				start := 0
+				if pagingToken != nil {
+					start, _ = strconv.Atoi(pagingToken["next"])
+				}
+
+				end := start + limit
+				if end > len(ids) {
+					end = len(ids)
+				}
+
+				result := &document.QueryResult{Documents: []document.Document{}}
+				for _, id := range ids[start:end] {
+					if id != "" {
+						result.Documents = append(result.Documents, document.Document{Key: &document.Key{Id: id}})
+					}
+				}
+
+				if end < len(ids) {
+					result.PagingToken = map[string]string{"next": strconv.Itoa(end)}
+				}
+
+				return result, nil
+			}
+		}
+
+		unwrap := func(iter document.DocumentIterator) []string {
+			ids := make([]string, 0)
+			for doc, err := iter(); err != io.EOF; doc, err = iter() {
+				Expect(err).To(BeNil())
+				ids = append(ids, doc.Key.Id)
+			}
+
+			return ids
+		}
+
+		It("should read every page, a page at a time", func() {
+			requested := make([]int, 0)
+
+			Expect(unwrap(document.PagedIterator(pages(&requested), 2, 0))).To(Equal([]string{"1", "2", "3", "4", "5"}))
+			Expect(requested).To(Equal([]int{2, 2, 2}))
+		})
+
+		It("should not read past the limit", func() {
+			requested := make([]int, 0)
+
+			Expect(unwrap(document.PagedIterator(pages(&requested), 2, 4))).To(Equal([]string{"1", "2", "3", "4"}))
+			Expect(requested).To(Equal([]int{2, 2, 1}))
+		})
+
+		It("should return query errors", func() {
+			iter := document.PagedIterator(func(limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+				return nil, fmt.Errorf("query failed")
+			}, 2, 0)
+
+			_, err := iter()
+			Expect(err).To(MatchError("query failed"))
+		})
+	})
+
+	When("AggregateIterator", func() {
+		docs := []*document.Document{
+			{Content: map[string]interface{}{"price": int64(10), "stock": map[string]interface{}{"count": 1.5}}},
+			{Content: map[string]interface{}{"price": 30.0}},
+			{Content: map[string]interface{}{"price": "free"}},
+		}
+
+		iter := func() document.DocumentIterator {
+			i := 0
+			return func() (*document.Document, error) {
+				if i >= len(docs) {
+					return nil, io.EOF
+				}
+				i++
+				return docs[i-1], nil
+			}
+		}
+
+		It("should aggregate the numeric values of each field", func() {
+			results, err := document.AggregateIterator(iter(), []document.Aggregation{
+				{Function: document.AggregateCount},
+				{Function: document.AggregateSum, Field: "price"},
+				{Function: document.AggregateAvg, Field: "price"},
+				{Function: document.AggregateMin, Field: "price"},
+				{Function: document.AggregateMax, Field: "stock.count"},
+			})
+
+			Expect(err).To(BeNil())
+			values := make([]interface{}, 0, len(results))
+			for _, r := range results {
+				values = append(values, r.Value)
+			}
+			Expect(values).To(Equal([]interface{}{int64(3), 40.0, 20.0, 10.0, 1.5}))
+		})
+
+		It("should return nil for averages of fields without numeric values", func() {
+			results, err := document.AggregateIterator(iter(), []document.Aggregation{
+				{Function: document.AggregateSum, Field: "missing"},
+				{Function: document.AggregateAvg, Field: "missing"},
+			})
+
+			Expect(err).To(BeNil())
+			Expect(results[0].Value).To(Equal(0.0))
+			Expect(results[1].Value).To(BeNil())
+		})
+	})
+
+	When("ParseIndexes", func() {
+		It("should parse each index declaration", func() {
+			indexes, err := document.ParseIndexes("orders.by-status=status+created, users.by-email=email")
+
+			Expect(err).To(BeNil())
+			Expect(indexes).To(Equal([]*document.Index{
+				{Collection: "orders", Name: "by-status", Fields: []string{"status", "created"}},
+				{Collection: "users", Name: "by-email", Fields: []string{"email"}},
+			}))
+		})
+
+		It("should return no indexes for a blank declaration", func() {
+			indexes, err := document.ParseIndexes("")
+
+			Expect(err).To(BeNil())
+			Expect(indexes).To(BeEmpty())
+		})
+
+		It("should return an error for invalid declarations", func() {
+			for _, config := range []string{"orders=status", "orders.by-status", ".by-status=status", "orders.by-status=status+"} {
+				_, err := document.ParseIndexes(config)
+				Expect(err).ToNot(BeNil(), config)
+			}
+		})
+	})
+
+	When("QueryPlanner.Plan", func() {
+		orders := &document.Collection{Name: "orders"}
+		byStatus := &document.Index{Collection: "orders", Name: "by-status", Fields: []string{"status"}}
+		byStatusCreated := &document.Index{Collection: "orders", Name: "by-status-created", Fields: []string{"status", "created"}}
+		planner := document.NewQueryPlanner([]*document.Index{byStatus, byStatusCreated}, true)
+
+		It("should use the index covering the most filtered fields", func() {
+			plan, err := planner.Plan(orders, []document.QueryExpression{
+				{Operand: "status", Operator: "==", Value: "paid"},
+				{Operand: "created", Operator: ">=", Value: 10},
+				{Operand: "created", Operator: "<=", Value: 20},
+			})
+
+			Expect(err).To(BeNil())
+			Expect(plan.Index).To(Equal(byStatusCreated))
+		})
+
+		It("should not plan unfiltered or sub-collection queries", func() {
+			plan, err := planner.Plan(orders, []document.QueryExpression{})
+			Expect(err).To(BeNil())
+			Expect(plan).To(Equal(&document.QueryPlan{}))
+
+			items := &document.Collection{Name: "items", Parent: &document.Key{Collection: orders, Id: "1"}}
+			plan, err = planner.Plan(items, []document.QueryExpression{{Operand: "sku", Operator: "==", Value: "a"}})
+			Expect(err).To(BeNil())
+			Expect(plan).To(Equal(&document.QueryPlan{}))
+		})
+
+		It("should suggest an index for queries no index can serve", func() {
+			_, err := planner.Plan(orders, []document.QueryExpression{
+				{Operand: "total", Operator: ">", Value: 10},
+				{Operand: "customer", Operator: "==", Value: "a"},
+			})
+
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("DOCUMENT_INDEXES=orders.by-customer-total=customer+total"))
+		})
+
+		It("should not use an index to filter its first field by range", func() {
+			_, err := planner.Plan(orders, []document.QueryExpression{{Operand: "status", Operator: ">", Value: "a"}})
+
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must filter at least one field by equality"))
+		})
+
+		It("should not serve or expressions with an index", func() {
+			_, err := planner.Plan(orders, []document.QueryExpression{
+				{Operand: "customer", Operator: "==", Value: "a"},
+				document.Or(
+					[]document.QueryExpression{{Operand: "status", Operator: "==", Value: "open"}},
+					[]document.QueryExpression{{Operand: "status", Operator: "==", Value: "held"}},
+				),
+			})
+
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("or expressions can't be served by an index"))
+		})
+
+		It("should scan when no index can serve the query and the planner isn't strict", func() {
+			plan, err := document.NewQueryPlanner(nil, false).Plan(orders, []document.QueryExpression{{Operand: "total", Operator: ">", Value: 10}})
+
+			Expect(err).To(BeNil())
+			Expect(plan.Scan).To(BeTrue())
+		})
+
+		It("should use the indexes declared for namespaced root collections", func() {
+			document.SetDeclaredCollectionNames(func(name string) string {
+				return strings.TrimPrefix(name, "acme-")
+			})
+			defer document.SetDeclaredCollectionNames(func(name string) string { return name })
+
+			plan, err := planner.Plan(&document.Collection{Name: "acme-orders"}, []document.QueryExpression{{Operand: "status", Operator: "==", Value: "paid"}})
+
+			Expect(err).To(BeNil())
+			Expect(plan.Index).To(Equal(byStatus))
+		})
+	})
+
+	When("Encoding keys for a single table", func() {
+		account := &document.Key{Collection: &document.Collection{Name: "accounts"}, Id: "a"}
+		project := &document.Key{Collection: &document.Collection{Name: "projects", Parent: account}, Id: "p"}
+		task := &document.Key{Collection: &document.Collection{Name: "tasks", Parent: project}, Id: "t"}
+
+		It("should encode the collections and ids below the top level document in the sort key", func() {
+			Expect(document.SortKey(account)).To(Equal("accounts#"))
+			Expect(document.SortKey(project)).To(Equal("projects#p"))
+			Expect(document.SortKey(task)).To(Equal("projects+tasks#p#t"))
+			Expect(document.RootKey(task)).To(Equal(account))
+		})
+
+		It("should decode keys from their partition and sort keys", func() {
+			for _, key := range []*document.Key{account, project, task} {
+				Expect(document.KeyFromSortKey("accounts", "a", document.SortKey(key))).To(Equal(key))
+			}
+		})
+
+		It("should return sort key prefixes up to the first blank parent id", func() {
+			Expect(document.SortKeyPrefix(project.Collection)).To(Equal("projects#"))
+			Expect(document.SortKeyPrefix(task.Collection)).To(Equal("projects+tasks#p#"))
+
+			wildcard := &document.Collection{
+				Name:   "tasks",
+				Parent: &document.Key{Collection: &document.Collection{Name: "projects", Parent: account}},
+			}
+			Expect(document.SortKeyPrefix(wildcard)).To(Equal("projects+tasks#"))
+		})
+
+		It("should match keys to collections with blank parent ids", func() {
+			other := &document.Key{Collection: &document.Collection{Name: "tasks", Parent: &document.Key{Collection: project.Collection, Id: "q"}}, Id: "t"}
+			wildcard := &document.Collection{
+				Name:   "tasks",
+				Parent: &document.Key{Collection: &document.Collection{Name: "projects", Parent: account}},
+			}
+
+			Expect(document.InCollection(task, task.Collection)).To(BeTrue())
+			Expect(document.InCollection(other, task.Collection)).To(BeFalse())
+			Expect(document.InCollection(other, wildcard)).To(BeTrue())
+			Expect(document.InCollection(project, wildcard)).To(BeFalse())
+		})
+
+		It("should only treat nested keys as descendants", func() {
+			Expect(document.IsDescendant(task, account)).To(BeTrue())
+			Expect(document.IsDescendant(task, project)).To(BeTrue())
+			Expect(document.IsDescendant(project, task)).To(BeFalse())
+			Expect(document.IsDescendant(account, account)).To(BeFalse())
+		})
+	})
+
+	When("IncrementedValue", func() {
+		It("should count missing fields from zero", func() {
+			Expect(document.IncrementedValue(nil, 3)).To(Equal(int64(3)))
+		})
+
+		It("should add to whole numbers decoded as floats", func() {
+			Expect(document.IncrementedValue(float64(10), -4)).To(Equal(int64(6)))
+		})
+
+		It("should reject values that aren't integers", func() {
+			_, err := document.IncrementedValue(1.5, 1)
+			Expect(err).To(HaveOccurred())
+
+			_, err = document.IncrementedValue("10", 1)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject increments that overflow", func() {
+			_, err := document.IncrementedValue(int64(math.MaxInt64), 1)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("FieldEquals", func() {
+		It("should treat missing fields as zero", func() {
+			Expect(document.FieldEquals(nil, 0)).To(BeTrue())
+			Expect(document.FieldEquals(nil, 1)).To(BeFalse())
+		})
+
+		It("should compare whole numbers decoded as floats", func() {
+			Expect(document.FieldEquals(float64(4), 4)).To(BeTrue())
+			Expect(document.FieldEquals(int64(4), 5)).To(BeFalse())
+		})
+
+		It("should not match values that aren't integers", func() {
+			Expect(document.FieldEquals("4", 4)).To(BeFalse())
+		})
+	})
+
+	When("ValidateIncrementField", func() {
+		It("should only allow top level fields", func() {
+			Expect(document.ValidateIncrementField("count")).To(Succeed())
+			Expect(document.ValidateIncrementField("")).ToNot(Succeed())
+			Expect(document.ValidateIncrementField("stats.count")).ToNot(Succeed())
+		})
+	})
+
+	When("Geo expressions", func() {
+		sydney := document.GeoPoint{Lat: -33.8688, Lng: 151.2093}
+		radius := document.QueryExpression{
+			Operand:  "location",
+			Operator: document.GeoRadiusOperator,
+			Value:    document.GeoRadius{Center: sydney, Meters: 1000},
+		}
+
+		It("should accept a radius or box on a single location field", func() {
+			box := document.QueryExpression{
+				Operand:  "location",
+				Operator: document.GeoBoxOperator,
+				Value: document.GeoBox{
+					SouthWest: document.GeoPoint{Lat: -34, Lng: 151},
+					NorthEast: document.GeoPoint{Lat: -33, Lng: 152},
+				},
+			}
+
+			Expect(document.ValidateExpressions([]document.QueryExpression{radius, {Operand: "type", Operator: "==", Value: "cafe"}})).To(Succeed())
+			Expect(document.ValidateExpressions([]document.QueryExpression{box})).To(Succeed())
+			Expect(document.ValidateExpressions([]document.QueryExpression{radius, box})).ToNot(Succeed())
+		})
+
+		It("should reject invalid areas", func() {
+			Expect(document.ValidateExpressions([]document.QueryExpression{
+				{Operand: "location", Operator: document.GeoRadiusOperator, Value: document.GeoRadius{Center: sydney}},
+			})).ToNot(Succeed())
+			Expect(document.ValidateExpressions([]document.QueryExpression{
+				{Operand: "location", Operator: document.GeoBoxOperator, Value: document.GeoBox{
+					SouthWest: document.GeoPoint{Lat: -33, Lng: 151},
+					NorthEast: document.GeoPoint{Lat: -34, Lng: 152},
+				}},
+			})).ToNot(Succeed())
+			Expect(document.ValidateExpressions([]document.QueryExpression{
+				{Operand: "location", Operator: document.GeoBoxOperator, Value: document.GeoRadius{Center: sydney, Meters: 1000}},
+			})).ToNot(Succeed())
+		})
+
+		It("should reject geo expressions in or expressions, or with array expressions", func() {
+			Expect(document.ValidateExpressions([]document.QueryExpression{
+				{Operator: document.OrOperator, Value: [][]document.QueryExpression{{radius}, {{Operand: "type", Operator: "==", Value: "cafe"}}}},
+			})).ToNot(Succeed())
+			Expect(document.ValidateExpressions([]document.QueryExpression{
+				radius,
+				{Operand: "tags", Operator: "array-contains", Value: "wifi"},
+			})).ToNot(Succeed())
+		})
+
+		It("should encode the geohash cells of locations", func() {
+			content := map[string]interface{}{
+				"name":     "Opera House",
+				"location": map[string]interface{}{"lat": -33.8568, "lng": 151.2153},
+			}
+
+			encoded := document.EncodeGeohashes(content)
+			Expect(encoded[document.GeohashField("location")]).To(Equal([]interface{}{
+				"r", "r3", "r3g", "r3gx", "r3gx2", "r3gx2u", "r3gx2ux", "r3gx2ux9", "r3gx2ux9g",
+			}))
+			Expect(content).ToNot(HaveKey(document.GeohashField("location")))
+
+			document.StripGeohashes(encoded)
+			Expect(encoded).To(Equal(content))
+		})
+
+		It("should only treat maps of a numeric lat and lng as locations", func() {
+			Expect(document.GeoPoints(map[string]interface{}{
+				"a": map[string]interface{}{"lat": 1, "lng": int64(2)},
+				"b": map[string]interface{}{"lat": 1, "lng": 2, "alt": 3},
+				"c": map[string]interface{}{"lat": "1", "lng": 2},
+				"d": map[string]interface{}{"lat": 91.0, "lng": 2.0},
+			})).To(Equal(map[string]document.GeoPoint{"a": {Lat: 1, Lng: 2}}))
+		})
+
+		It("should translate geo expressions to the geohash cells covering their area", func() {
+			exps, filter := document.GeohashExpressions([]document.QueryExpression{
+				radius,
+				{Operand: "type", Operator: "==", Value: "cafe"},
+			})
+
+			Expect(exps).To(HaveLen(2))
+			Expect(exps[0].Operand).To(Equal(document.GeohashField("location")))
+			Expect(exps[0].Operator).To(Equal("array-contains-any"))
+			Expect(exps[1].Operand).To(Equal("type"))
+
+			cells := exps[0].Value.([]interface{})
+			Expect(len(cells)).To(BeNumerically("<=", 9))
+			Expect(cells).To(ContainElement(document.Geohash(sydney, len(cells[0].(string)))))
+
+			near := &document.Document{Content: map[string]interface{}{"location": map[string]interface{}{"lat": -33.8650, "lng": 151.2094}}}
+			far := &document.Document{Content: map[string]interface{}{"location": map[string]interface{}{"lat": -33.8568, "lng": 151.2153}}}
+			Expect(filter.Matches(near)).To(BeTrue())
+			Expect(filter.Matches(far)).To(BeFalse())
+			Expect(filter.Filter([]document.Document{*near, *far})).To(HaveLen(1))
+		})
+
+		It("should match every location when an area is too large to cover", func() {
+			exps, filter := document.GeohashExpressions([]document.QueryExpression{
+				{Operand: "location", Operator: document.GeoRadiusOperator, Value: document.GeoRadius{Center: sydney, Meters: 20000000}},
+			})
+
+			Expect(exps).To(BeEmpty())
+			Expect(filter).ToNot(BeNil())
+		})
+
+		It("should leave queries without geo expressions unfiltered", func() {
+			exps, filter := document.GeohashExpressions([]document.QueryExpression{{Operand: "type", Operator: "==", Value: "cafe"}})
+
+			Expect(exps).To(HaveLen(1))
+			Expect(filter).To(BeNil())
+			Expect(filter.Matches(&document.Document{})).To(BeTrue())
+		})
+
+		It("should measure great circle distances", func() {
+			melbourne := document.GeoPoint{Lat: -37.8136, Lng: 144.9631}
+			Expect(document.Distance(sydney, melbourne)).To(BeNumerically("~", 713000, 2000))
+		})
+	})
+
+	When("SetMaxSubCollectionDepth", func() {
+		deep := &document.Collection{
+			Name: "tasks",
+			Parent: &document.Key{
+				Collection: &document.Collection{
+					Name:   "projects",
+					Parent: &document.Key{Collection: &document.Collection{Name: "accounts"}, Id: "a"},
+				},
+				Id: "p",
+			},
+		}
+
+		AfterEach(func() {
+			Expect(document.SetMaxSubCollectionDepth(document.MaxSubCollectionDepth)).To(Succeed())
+		})
+
+		It("should allow collections up to the configured depth", func() {
+			Expect(document.ValidateCollection(deep)).ToNot(Succeed())
+
+			Expect(document.SetMaxSubCollectionDepth(2)).To(Succeed())
+			Expect(document.ValidateCollection(deep)).To(Succeed())
+		})
+
+		It("should reject negative depths", func() {
+			Expect(document.SetMaxSubCollectionDepth(-1)).ToNot(Succeed())
+		})
 	})
 })
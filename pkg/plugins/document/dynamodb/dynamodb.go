@@ -15,19 +15,24 @@
 package dynamodb_service
 
 import (
+	"encoding/json"
 	"fmt"
-	"io"
+	"hash/fnv"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/document"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/utils"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
@@ -38,6 +43,31 @@ const (
 	AttribSk         = "_sk"
 	deleteQueryLimit = int64(1000)
 	maxBatchWrite    = 25
+	// maxBatchRetries - the most times items a batch write doesn't process are retried
+	maxBatchRetries = 5
+	// batchRetryDelay - the delay before the first retry of unprocessed items, doubling with each retry
+	batchRetryDelay = 50 * time.Millisecond
+	// maxIndexKeys - global secondary indexes are keyed by a partition key and an optional sort key
+	maxIndexKeys = 2
+	// indexPagingToken - paging token key for index queries, index keys may not be strings so the whole key is encoded
+	indexPagingToken = "_index"
+	// AttribCollection - the collection path of items in a single table, the names of the collections from the top level
+	// collection down to the document's joined with document.SubcollectionDelimiter, e.g. "customers+orders"
+	AttribCollection = "_coll"
+	// AttribCollectionShard - the collection path of items in a single table followed by one of collectionShards shards,
+	// e.g. "customers+orders#3", so the writes of a large collection are spread across partitions of its index
+	AttribCollectionShard = "_cshard"
+	// collectionShards - the number of shards of each collection in the collection index, items are assigned a shard
+	// by a hash of their key so it can't be changed once items are written
+	collectionShards = 16
+	// collectionIndex - the global secondary index of a single table, partitioned by collection path shard
+	collectionIndex = "nitric-collections"
+	// collectionShardToken - paging token key for collection index queries, the shard the query continues from
+	collectionShardToken = "_shard"
+	// MigratedTag - tags tables whose documents have been migrated to a single table with the single table's name
+	MigratedTag = "x-nitric-migrated-to"
+	// partitionKeyDelimiter - separates the collection and id of top level documents in the partition keys of a single table
+	partitionKeyDelimiter = "#"
 )
 
 // DynamoDocService - AWS DynamoDB AWS Nitric Document service
@@ -45,6 +75,13 @@ type DynamoDocService struct {
 	document.UnimplementedDocumentPlugin
 	client   dynamodbiface.DynamoDBAPI
 	provider core.AwsProvider
+	planner  *document.QueryPlanner
+	// singleTable - the nitric name of the table every collection is stored in, partitioned by top level document
+	// and indexed by collection path, so collections and sub-collections across parents are queried rather than scanned.
+	// Each top level collection has its own table if empty
+	singleTable string
+	// simulates the IAM actions of operations, to check they're allowed without performing them
+	simulator core.PermissionSimulator
 }
 
 func (s *DynamoDocService) Get(key *document.Key) (*document.Document, error) {
@@ -64,7 +101,7 @@ func (s *DynamoDocService) Get(key *document.Key) (*document.Document, error) {
 		)
 	}
 
-	keyMap := createKeyMap(key)
+	keyMap := s.createKeyMap(key)
 	attributeMap, err := dynamodbattribute.MarshalMap(keyMap)
 	if err != nil {
 		return nil, newErr(
@@ -113,6 +150,9 @@ func (s *DynamoDocService) Get(key *document.Key) (*document.Document, error) {
 
 	delete(itemMap, AttribPk)
 	delete(itemMap, AttribSk)
+	delete(itemMap, AttribCollection)
+	delete(itemMap, AttribCollectionShard)
+	document.StripGeohashes(itemMap)
 
 	return &document.Document{
 		Key:     key,
@@ -145,7 +185,7 @@ func (s *DynamoDocService) Set(key *document.Key, value map[string]interface{})
 	}
 
 	// Construct DynamoDB attribute value object
-	itemMap := createItemMap(value, key)
+	itemMap := s.createItemMap(value, key)
 	itemAttributeMap, err := dynamodbattribute.MarshalMap(itemMap)
 	if err != nil {
 		return fmt.Errorf("failed to marshal value")
@@ -193,7 +233,7 @@ func (s *DynamoDocService) Delete(key *document.Key) error {
 		)
 	}
 
-	keyMap := createKeyMap(key)
+	keyMap := s.createKeyMap(key)
 	attributeMap, err := dynamodbattribute.MarshalMap(keyMap)
 	if err != nil {
 		return newErr(
@@ -226,48 +266,541 @@ func (s *DynamoDocService) Delete(key *document.Key) error {
 		)
 	}
 
-	// Delete sub collection items
-	if key.Collection.Parent == nil {
+	// Delete sub collection items, stored in the partition of the top level document
+	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
+	for {
+		queryInput := createDeleteQuery(tableName, s.partitionKey(document.RootKey(key)), lastEvaluatedKey)
+		resp, err := s.client.Query(queryInput)
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				"error performing delete in table",
+				err,
+			)
+		}
+
+		lastEvaluatedKey = resp.LastEvaluatedKey
+
+		err = s.processDeleteQuery(*tableName, s.descendantItems(key, resp.Items))
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				"error performing delete",
+				err,
+			)
+		}
+
+		if len(lastEvaluatedKey) == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Increment - adds delta to the field with an ADD update, which creates the item and the field from zero if they don't exist
+func (s *DynamoDocService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	newErr := errors.ErrorsWithScope(
+		"DynamoDocService.Increment",
+		map[string]interface{}{
+			"key":   key,
+			"field": field,
+		},
+	)
+
+	if err := document.ValidateKey(key); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateIncrementField(field); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
+
+	keyMap := s.createKeyMap(key)
+	attributeMap, err := dynamodbattribute.MarshalMap(keyMap)
+	if err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			fmt.Sprintf("failed to marshal keys: %v", key),
+			err,
+		)
+	}
+
+	tableName, err := s.getTableName(*key.Collection)
+	if err != nil {
+		return 0, newErr(
+			codes.NotFound,
+			"unable to find table",
+			err,
+		)
+	}
+
+	input := &dynamodb.UpdateItemInput{
+		Key:              attributeMap,
+		TableName:        tableName,
+		UpdateExpression: aws.String("ADD #field :delta"),
+		ExpressionAttributeNames: map[string]*string{
+			"#field": aws.String(field),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":delta": {N: aws.String(fmt.Sprintf("%d", delta))},
+		},
+		ReturnValues: aws.String(dynamodb.ReturnValueUpdatedNew),
+	}
+
+	// items created by the update need their collection path to be found by collection queries
+	if s.singleTable != "" {
+		keyMap := s.createKeyMap(key)
+		input.UpdateExpression = aws.String("ADD #field :delta SET #coll = :coll, #cshard = :cshard")
+		input.ExpressionAttributeNames["#coll"] = aws.String(AttribCollection)
+		input.ExpressionAttributeNames["#cshard"] = aws.String(AttribCollectionShard)
+		input.ExpressionAttributeValues[":coll"] = &dynamodb.AttributeValue{S: aws.String(collectionPath(key.Collection))}
+		input.ExpressionAttributeValues[":cshard"] = &dynamodb.AttributeValue{S: aws.String(collectionShard(key.Collection, keyMap[AttribPk], keyMap[AttribSk]))}
+	}
+
+	result, err := s.client.UpdateItem(input)
+	if err != nil {
+		code := codes.Internal
+		// the field holds a value that isn't a number
+		if errors.ProviderCode(err) == "ValidationException" {
+			code = codes.FailedPrecondition
+		}
+
+		return 0, newErr(
+			code,
+			"error updating item",
+			err,
+		)
+	}
+
+	var updated interface{}
+	if err := dynamodbattribute.Unmarshal(result.Attributes[field], &updated); err != nil {
+		return 0, newErr(
+			codes.Internal,
+			"error unmarshalling item",
+			err,
+		)
+	}
+
+	// fields holding fractional numbers are still incremented by DynamoDB
+	value, err := document.IncrementedValue(updated, 0)
+	if err != nil {
+		return 0, newErr(
+			codes.FailedPrecondition,
+			"invalid field value",
+			err,
+		)
+	}
+
+	return value, nil
+}
+
+// CompareAndSet - puts the document's item with a condition on the field, missing items and fields match zero
+func (s *DynamoDocService) CompareAndSet(key *document.Key, field string, expected int64, value map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"DynamoDocService.CompareAndSet",
+		map[string]interface{}{
+			"key":      key,
+			"field":    field,
+			"expected": expected,
+		},
+	)
+
+	if err := document.ValidateKey(key); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateCompareField(field); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
+
+	if value == nil {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-nil value",
+			nil,
+		)
+	}
+
+	itemAttributeMap, err := dynamodbattribute.MarshalMap(s.createItemMap(value, key))
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"failed to marshal value",
+			err,
+		)
+	}
+
+	tableName, err := s.getTableName(*key.Collection)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to find table",
+			err,
+		)
+	}
+
+	condition := "#field = :expected"
+	if expected == 0 {
+		condition = "attribute_not_exists(#field) OR " + condition
+	}
+
+	input := &dynamodb.PutItemInput{
+		Item:                itemAttributeMap,
+		TableName:           tableName,
+		ConditionExpression: aws.String(condition),
+		ExpressionAttributeNames: map[string]*string{
+			"#field": aws.String(field),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":expected": {N: aws.String(fmt.Sprintf("%d", expected))},
+		},
+	}
+
+	if _, err := s.client.PutItem(input); err != nil {
+		if errors.ProviderCode(err) == dynamodb.ErrCodeConditionalCheckFailedException {
+			return newErr(
+				codes.FailedPrecondition,
+				document.ConditionFailed,
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"error putting item",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// SetBatch - puts the documents' items in batches of maxBatchWrite, retrying items DynamoDB doesn't process
+func (s *DynamoDocService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	resp := &document.BatchResponse{
+		FailedWrites: make([]*document.FailedWrite, 0),
+	}
+	batches := newWriteBatches()
+
+	for _, doc := range docs {
+		if err := document.ValidateKey(doc.Key); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "invalid key", err))
+			continue
+		}
+
+		if doc.Content == nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "provide non-nil value", nil))
+			continue
+		}
+
+		item, err := dynamodbattribute.MarshalMap(s.createItemMap(doc.Content, doc.Key))
+		if err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "failed to marshal value", err))
+			continue
+		}
+
+		tableName, err := s.getTableName(*doc.Key.Collection)
+		if err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "unable to find table", err))
+			continue
+		}
+
+		batches.add(*tableName, doc.Key, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item},
+		})
+	}
+
+	for _, table := range batches.tables {
+		resp.FailedWrites = append(resp.FailedWrites, s.batchWrite(table, batches.requests[table])...)
+	}
+
+	return resp, nil
+}
+
+// DeleteBatch - deletes the documents' items in batches of maxBatchWrite, retrying items DynamoDB doesn't process,
+// then the items of their sub-collections, querying each partition once for the documents in it
+func (s *DynamoDocService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	resp := &document.BatchResponse{
+		FailedWrites: make([]*document.FailedWrite, 0),
+	}
+	batches := newWriteBatches()
+
+	for _, key := range keys {
+		if err := document.ValidateKey(key); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(key, "invalid key", err))
+			continue
+		}
+
+		attributeMap, err := dynamodbattribute.MarshalMap(s.createKeyMap(key))
+		if err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(key, "failed to marshal keys", err))
+			continue
+		}
+
+		tableName, err := s.getTableName(*key.Collection)
+		if err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(key, "unable to find table", err))
+			continue
+		}
+
+		batches.add(*tableName, key, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: attributeMap},
+		})
+	}
+
+	for _, table := range batches.tables {
+		failed := s.batchWrite(table, batches.requests[table])
+		resp.FailedWrites = append(resp.FailedWrites, failed...)
+
+		deleted := make(map[*document.Key]bool)
+		for _, r := range batches.requests[table] {
+			deleted[r.key] = true
+		}
+		for _, f := range failed {
+			delete(deleted, f.Key)
+		}
+
+		resp.FailedWrites = append(resp.FailedWrites, s.deleteDescendants(table, batches.requests[table], deleted)...)
+	}
+
+	return resp, nil
+}
+
+// deleteDescendants - deletes the items of the sub-collections of the deleted documents, returning the documents
+// whose sub-collections couldn't be deleted
+func (s *DynamoDocService) deleteDescendants(table string, requests []*writeRequest, deleted map[*document.Key]bool) []*document.FailedWrite {
+	failed := make([]*document.FailedWrite, 0)
+
+	partitions := make(map[string][]*document.Key)
+	order := make([]string, 0)
+	for _, r := range requests {
+		if !deleted[r.key] {
+			continue
+		}
+
+		pk := s.partitionKey(document.RootKey(r.key))
+		if _, ok := partitions[pk]; !ok {
+			order = append(order, pk)
+		}
+		partitions[pk] = append(partitions[pk], r.key)
+	}
+
+	for _, pk := range order {
+		keys := partitions[pk]
+
+		descendants := newWriteBatches()
+		var queryErr error
 		var lastEvaluatedKey map[string]*dynamodb.AttributeValue
 		for {
-			queryInput := createDeleteQuery(tableName, key, lastEvaluatedKey)
-			resp, err := s.client.Query(queryInput)
+			resp, err := s.client.Query(createDeleteQuery(aws.String(table), pk, lastEvaluatedKey))
 			if err != nil {
-				return newErr(
-					codes.Internal,
-					"error performing delete in table",
-					err,
-				)
+				queryErr = err
+				break
+			}
+
+			for _, key := range keys {
+				for _, item := range s.descendantItems(key, resp.Items) {
+					descendants.add(table, key, &dynamodb.WriteRequest{
+						DeleteRequest: &dynamodb.DeleteRequest{
+							Key: map[string]*dynamodb.AttributeValue{
+								AttribPk: item[AttribPk],
+								AttribSk: item[AttribSk],
+							},
+						},
+					})
+				}
 			}
 
 			lastEvaluatedKey = resp.LastEvaluatedKey
+			if len(lastEvaluatedKey) == 0 {
+				break
+			}
+		}
 
-			err = s.processDeleteQuery(*tableName, resp)
-			if err != nil {
-				return newErr(
-					codes.Internal,
-					"error performing delete",
-					err,
-				)
+		if queryErr != nil {
+			for _, key := range keys {
+				failed = append(failed, failedWrite(key, "error querying sub-collections", queryErr))
 			}
+			continue
+		}
 
-			if len(lastEvaluatedKey) == 0 {
+		// a document is reported once, however many of its nested documents couldn't be deleted
+		reported := make(map[*document.Key]bool)
+		for _, f := range s.batchWrite(table, descendants.requests[table]) {
+			if !reported[f.Key] {
+				reported[f.Key] = true
+				failed = append(failed, &document.FailedWrite{
+					Key:     f.Key,
+					Message: "error deleting sub-collections: " + f.Message,
+				})
+			}
+		}
+	}
+
+	return failed
+}
+
+// writeRequest - a batched write of the item of a document, or of a document nested under it
+type writeRequest struct {
+	key     *document.Key
+	request *dynamodb.WriteRequest
+}
+
+// writeBatches - write requests by table, in the order the tables were first written to
+type writeBatches struct {
+	tables   []string
+	requests map[string][]*writeRequest
+	indexes  map[string]int
+}
+
+func newWriteBatches() *writeBatches {
+	return &writeBatches{
+		tables:   make([]string, 0),
+		requests: make(map[string][]*writeRequest),
+		indexes:  make(map[string]int),
+	}
+}
+
+// add - adds a write request for the key. A batch can't write an item more than once,
+// so a later request for the same item replaces the earlier one
+func (b *writeBatches) add(table string, key *document.Key, request *dynamodb.WriteRequest) {
+	if _, ok := b.requests[table]; !ok {
+		b.tables = append(b.tables, table)
+	}
+
+	itemKey := table + "|" + requestKey(request)
+	if i, ok := b.indexes[itemKey]; ok {
+		b.requests[table][i] = &writeRequest{key: key, request: request}
+		return
+	}
+
+	b.indexes[itemKey] = len(b.requests[table])
+	b.requests[table] = append(b.requests[table], &writeRequest{key: key, request: request})
+}
+
+// batchWrite - writes the requests in batches of maxBatchWrite, retrying requests DynamoDB doesn't process with backoff.
+// Returns the documents that couldn't be written
+func (s *DynamoDocService) batchWrite(table string, requests []*writeRequest) []*document.FailedWrite {
+	failed := make([]*document.FailedWrite, 0)
+
+	for start := 0; start < len(requests); start += maxBatchWrite {
+		end := start + maxBatchWrite
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		pending := requests[start:end]
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt > maxBatchRetries {
+				for _, r := range pending {
+					failed = append(failed, failedWrite(r.key, fmt.Sprintf("item not processed after %d retries", maxBatchRetries), nil))
+				}
 				break
 			}
+
+			if attempt > 0 {
+				time.Sleep(batchRetryDelay * time.Duration(1<<(attempt-1)))
+			}
+
+			writeRequests := make([]*dynamodb.WriteRequest, 0, len(pending))
+			for _, r := range pending {
+				writeRequests = append(writeRequests, r.request)
+			}
+
+			out, err := s.client.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]*dynamodb.WriteRequest{table: writeRequests},
+			})
+			if err != nil {
+				for _, r := range pending {
+					failed = append(failed, failedWrite(r.key, "error writing batch", err))
+				}
+				break
+			}
+
+			unprocessed := make(map[string]bool)
+			for _, r := range out.UnprocessedItems[table] {
+				unprocessed[requestKey(r)] = true
+			}
+
+			retries := make([]*writeRequest, 0, len(unprocessed))
+			for _, r := range pending {
+				if unprocessed[requestKey(r.request)] {
+					retries = append(retries, r)
+				}
+			}
+			pending = retries
 		}
 	}
 
-	return nil
+	return failed
 }
 
-func (s *DynamoDocService) query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+// requestKey - identifies the item a write request writes by its keys
+func requestKey(request *dynamodb.WriteRequest) string {
+	var item map[string]*dynamodb.AttributeValue
+	if request.PutRequest != nil {
+		item = request.PutRequest.Item
+	} else if request.DeleteRequest != nil {
+		item = request.DeleteRequest.Key
+	}
+
+	if item[AttribPk] == nil || item[AttribSk] == nil {
+		return ""
+	}
+
+	return aws.StringValue(item[AttribPk].S) + "|" + aws.StringValue(item[AttribSk].S)
+}
+
+// failedWrite - a document a batch couldn't write, with the reason and its cause if there is one
+func failedWrite(key *document.Key, msg string, err error) *document.FailedWrite {
+	if err != nil {
+		msg = fmt.Sprintf("%s: %v", msg, err)
+	}
+
+	return &document.FailedWrite{
+		Key:     key,
+		Message: msg,
+	}
+}
+
+func (s *DynamoDocService) query(plan *document.QueryPlan, collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
 	queryResult := &document.QueryResult{
 		Documents: make([]document.Document, 0),
 	}
 
+	if s.singleTable != "" {
+		// Every collection shares the table, so items are filtered by collection too
+		expressions = append(append(make([]document.QueryExpression, 0, len(expressions)+1), expressions...), collectionExpression(collection))
+	}
+
 	var resFunc resultRetriever = s.performQuery
-	if collection.Parent == nil || collection.Parent.Id == "" {
+	if plan.Index != nil {
+		resFunc = func(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+			return s.performIndexQuery(plan.Index, collection, expressions, limit, pagingToken)
+		}
+	} else if collection.Parent == nil || document.RootKey(collection.Parent).Id == "" {
 		resFunc = s.performScan
+		if s.singleTable != "" {
+			// Collections spanning partitions are queried by their collection path
+			resFunc = s.performCollectionQuery
+		}
 	}
 
 	if res, err := resFunc(collection, expressions, limit, pagingToken); err != nil {
@@ -304,7 +837,27 @@ func (s *DynamoDocService) Query(collection *document.Collection, expressions []
 		)
 	}
 
-	queryResult, err := s.query(collection, expressions, limit, pagingToken)
+	if err := unsupported(expressions); err != nil {
+		return nil, newErr(
+			codes.Unimplemented,
+			"query not supported by dynamodb",
+			err,
+		)
+	}
+
+	// geo expressions are queried by geohash cell, then the documents outside of their area are filtered out
+	expressions, geoFilter := document.GeohashExpressions(expressions)
+
+	plan, err := s.planner.Plan(collection, expressions)
+	if err != nil {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"query requires an index",
+			err,
+		)
+	}
+
+	queryResult, err := s.query(plan, collection, expressions, limit, pagingToken)
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -312,20 +865,21 @@ func (s *DynamoDocService) Query(collection *document.Collection, expressions []
 			err,
 		)
 	}
+	queryResult.Documents = geoFilter.Filter(queryResult.Documents)
 
 	remainingLimit := limit - len(queryResult.Documents)
 
 	// If more results available, perform additional queries
 	for remainingLimit > 0 &&
 		(queryResult.PagingToken != nil && len(queryResult.PagingToken) > 0) {
-		if res, err := s.query(collection, expressions, remainingLimit, queryResult.PagingToken); err != nil {
+		if res, err := s.query(plan, collection, expressions, remainingLimit, queryResult.PagingToken); err != nil {
 			return nil, newErr(
 				codes.Internal,
 				"query error",
 				err,
 			)
 		} else {
-			queryResult.Documents = append(queryResult.Documents, res.Documents...)
+			queryResult.Documents = append(queryResult.Documents, geoFilter.Filter(res.Documents)...)
 			queryResult.PagingToken = res.PagingToken
 		}
 
@@ -357,124 +911,422 @@ func (s *DynamoDocService) QueryStream(collection *document.Collection, expressi
 		}
 	}
 
-	tmpLimit := limit
-	var documents []document.Document
-	var pagingToken map[string]string
+	if err := unsupported(expressions); err != nil {
+		return func() (*document.Document, error) {
+			return nil, newErr(
+				codes.Unimplemented,
+				"query not supported by dynamodb",
+				err,
+			)
+		}
+	}
 
-	// Initial fetch
-	res, fetchErr := s.query(collection, expressions, tmpLimit, nil)
+	expressions, geoFilter := document.GeohashExpressions(expressions)
 
-	if fetchErr != nil {
-		// Return an error only iterator if the initial fetch failed
+	plan, planErr := s.planner.Plan(collection, expressions)
+	if planErr != nil {
 		return func() (*document.Document, error) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				"query requires an index",
+				planErr,
+			)
+		}
+	}
+
+	// Documents are read a page at a time as they're streamed
+	return document.PagedIterator(func(limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+		res, err := s.query(plan, collection, expressions, limit, pagingToken)
+		if err != nil {
 			return nil, newErr(
 				codes.Internal,
 				"query error",
-				fetchErr,
+				err,
 			)
 		}
+		res.Documents = geoFilter.Filter(res.Documents)
+
+		return res, nil
+	}, document.StreamPageSize, limit)
+}
+
+// Aggregate - DynamoDB has no aggregate queries, so the matching documents are aggregated as they're paged through
+func (s *DynamoDocService) Aggregate(collection *document.Collection, expressions []document.QueryExpression, aggregations []document.Aggregation) ([]document.AggregateResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"DynamoDocService.Aggregate",
+		map[string]interface{}{
+			"collection": collection,
+		},
+	)
+
+	if err := document.ValidateAggregations(aggregations); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid aggregations",
+			err,
+		)
+	}
+
+	// errors returned by the stream are already scoped to the query
+	return document.AggregateIterator(s.QueryStream(collection, expressions, 0), aggregations)
+}
+
+// Provision - creates the collection's table if it doesn't exist, tagged with its nitric name and the stack's labels,
+// or the single table with its collection index if every collection is stored in one.
+// Tables are only keyed by document, so indexes given by the query planner aren't created
+func (s *DynamoDocService) Provision(collection string, identity *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"DynamoDocService.Provision",
+		map[string]interface{}{
+			"collection": collection,
+		},
+	)
+
+	if s.singleTable != "" {
+		collection = s.singleTable
+	}
+
+	tables, err := s.provider.GetResources(core.AwsResource_Collection)
+	if err != nil {
+		return newErr(codes.Internal, "error retrieving the table list", err)
+	}
+
+	if _, ok := tables[collection]; ok {
+		return nil
+	}
+
+	tags := make([]*dynamodb.Tag, 0)
+	for k, v := range resources.Labels(collection, identity) {
+		tags = append(tags, &dynamodb.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return *tags[i].Key < *tags[j].Key
+	})
+
+	name, err := naming.DynamoTables.Physical(identity.Qualify(collection))
+	if err != nil {
+		return newErr(codes.AlreadyExists, "table name collides with another collection's table", err)
+	}
+
+	tableName := aws.String(name)
+	input := &dynamodb.CreateTableInput{
+		TableName:   tableName,
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String(AttribPk), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			{AttributeName: aws.String(AttribSk), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String(AttribPk), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String(AttribSk), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+		Tags: tags,
+	}
+	if s.singleTable != "" {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{
+			AttributeName: aws.String(AttribCollectionShard), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		})
+		input.GlobalSecondaryIndexes = []*dynamodb.GlobalSecondaryIndex{CollectionIndex()}
+	}
+
+	out, err := s.client.CreateTable(input)
+	if err != nil {
+		return newErr(codes.Internal, "unable to create table", err)
+	}
+
+	// Tables can't be written to until they're active
+	if err := s.client.WaitUntilTableExists(&dynamodb.DescribeTableInput{TableName: tableName}); err != nil {
+		return newErr(codes.Internal, "table was not created", err)
+	}
+
+	s.provider.AddResource(core.AwsResource_Collection, collection, *out.TableDescription.TableArn)
+
+	return nil
+}
+
+// MigrateToSingleTable - copies the documents in the tables of the given top level collections to the single table,
+// keyed and indexed for it. Documents are only copied if they aren't in the single table yet, so documents written to it
+// since aren't overwritten, and a migration that stops part way can be run again. Once a collection is copied its table
+// is tagged with the single table's name and skipped by later migrations, so documents deleted since aren't copied back.
+// The collections' tables are left as they are, to be removed once nothing reads from them
+func (s *DynamoDocService) MigrateToSingleTable(collections ...string) error {
+	if s.singleTable == "" {
+		return fmt.Errorf("collections can only be migrated to a single table")
+	}
+
+	tables, err := s.provider.GetResources(core.AwsResource_Collection)
+	if err != nil {
+		return fmt.Errorf("encountered an error retrieving the table list: %v", err)
+	}
+
+	target, err := s.getTableName(document.Collection{Name: s.singleTable})
+	if err != nil {
+		return err
+	}
+
+	for _, collection := range collections {
+		table, ok := tables[collection]
+		if !ok {
+			return fmt.Errorf("collection %s does not exist", collection)
+		}
+		source := aws.String(strings.Split(table, "/")[1])
+
+		tags, err := s.client.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{ResourceArn: aws.String(table)})
+		if err != nil {
+			return fmt.Errorf("error reading the tags of collection %s: %v", collection, err)
+		}
+		if migrated(tags.Tags, *target) {
+			continue
+		}
+
+		var startKey map[string]*dynamodb.AttributeValue
+		for {
+			resp, err := s.client.Scan(&dynamodb.ScanInput{
+				TableName:         source,
+				ExclusiveStartKey: startKey,
+			})
+			if err != nil {
+				return fmt.Errorf("error scanning collection %s: %v", collection, err)
+			}
+
+			for _, item := range resp.Items {
+				if item[AttribPk] == nil || item[AttribSk] == nil {
+					continue
+				}
+
+				pk := aws.StringValue(item[AttribPk].S)
+				key := document.KeyFromSortKey(collection, pk, aws.StringValue(item[AttribSk].S))
+				keyMap := s.createKeyMap(key)
+
+				item[AttribPk] = &dynamodb.AttributeValue{S: aws.String(keyMap[AttribPk])}
+				item[AttribCollection] = &dynamodb.AttributeValue{S: aws.String(collectionPath(key.Collection))}
+				item[AttribCollectionShard] = &dynamodb.AttributeValue{S: aws.String(collectionShard(key.Collection, keyMap[AttribPk], keyMap[AttribSk]))}
+
+				if err := s.putItemIfAbsent(*target, item); err != nil {
+					return fmt.Errorf("error copying collection %s: %v", collection, err)
+				}
+			}
+
+			if len(resp.LastEvaluatedKey) == 0 {
+				break
+			}
+			startKey = resp.LastEvaluatedKey
+		}
+
+		if _, err := s.client.TagResource(&dynamodb.TagResourceInput{
+			ResourceArn: aws.String(table),
+			Tags:        []*dynamodb.Tag{{Key: aws.String(MigratedTag), Value: target}},
+		}); err != nil {
+			return fmt.Errorf("error marking collection %s as migrated: %v", collection, err)
+		}
+	}
+
+	return nil
+}
+
+// migrated - returns true if a table's tags show it's been migrated to the single table
+func migrated(tags []*dynamodb.Tag, target string) bool {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == MigratedTag && aws.StringValue(t.Value) == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// putItemIfAbsent - writes the item unless an item with its key exists
+func (s *DynamoDocService) putItemIfAbsent(table string, item map[string]*dynamodb.AttributeValue) error {
+	_, err := s.client.PutItem(&dynamodb.PutItemInput{
+		TableName:                aws.String(table),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(AttribPk)},
+	})
+	if err != nil && errors.ProviderCode(err) != dynamodb.ErrCodeConditionalCheckFailedException {
+		return err
+	}
+
+	return nil
+}
+
+// CollectionIndex - the global secondary index single tables need, partitioned by collection path shard
+// and sorted by partition key so the documents of each shard are paged in a stable order
+func CollectionIndex() *dynamodb.GlobalSecondaryIndex {
+	return &dynamodb.GlobalSecondaryIndex{
+		IndexName: aws.String(collectionIndex),
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String(AttribCollectionShard), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String(AttribPk), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+		Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
 	}
+}
 
-	documents = res.Documents
-	pagingToken = res.PagingToken
+// tableActions - the IAM actions needed on a collection's table for nitric actions.
+// Documents may be written in batches or incremented in place, and are deleted with their sub collections, which are queried and deleted in batches
+var tableActions = map[resources.Action][]string{
+	resources.CollectionDocumentRead:   {"dynamodb:GetItem"},
+	resources.CollectionDocumentWrite:  {"dynamodb:PutItem", "dynamodb:BatchWriteItem", "dynamodb:UpdateItem"},
+	resources.CollectionDocumentDelete: {"dynamodb:DeleteItem", "dynamodb:Query", "dynamodb:BatchWriteItem"},
+	resources.CollectionQuery:          {"dynamodb:Query", "dynamodb:Scan"},
+	resources.CollectionList:           {"dynamodb:Query", "dynamodb:Scan"},
+}
 
-	return func() (*document.Document, error) {
-		// check the iteration state
-		if tmpLimit <= 0 && limit > 0 {
-			// we've reached the limit of reading
-			return nil, io.EOF
-		} else if pagingToken != nil && len(documents) == 0 {
-			// we've run out of documents and have more pages to read
-			res, fetchErr = s.query(collection, expressions, tmpLimit, pagingToken)
-			documents = res.Documents
-			pagingToken = res.PagingToken
-		} else if pagingToken == nil && len(documents) == 0 {
-			// we're all out of documents and pages before hitting the limit
-			return nil, io.EOF
-		}
+// CheckPermissions - simulates the IAM actions needed for the nitric actions on the collection's table
+func (s *DynamoDocService) CheckPermissions(collection string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.simulator == nil {
+		return nil, fmt.Errorf("permissions can't be simulated without an IAM client")
+	}
 
-		// We received an error fetching the docs
-		if fetchErr != nil {
-			return nil, newErr(
-				codes.Internal,
-				"query error",
-				fetchErr,
-			)
-		}
+	tables, err := s.provider.GetResources(core.AwsResource_Collection)
+	if err != nil {
+		return nil, err
+	}
 
-		if len(documents) == 0 {
-			return nil, io.EOF
-		}
+	if s.singleTable != "" {
+		collection = s.singleTable
+	}
 
-		// pop the first element
-		var doc document.Document
-		doc, documents = documents[0], documents[1:]
-		tmpLimit = tmpLimit - 1
+	tableArn, ok := tables[collection]
+	if !ok {
+		return nil, fmt.Errorf("collection %s does not exist", collection)
+	}
 
-		return &doc, nil
+	needed := map[string][]string{}
+	for _, a := range actions {
+		needed[tableArn] = append(needed[tableArn], tableActions[a]...)
+		// queries served by secondary indexes read the table's indexes, collections of a single table are queried by index
+		if (a == resources.CollectionQuery || a == resources.CollectionList) && (len(s.planner.Indexes()) > 0 || s.singleTable != "") {
+			needed[tableArn+"/index/*"] = append(needed[tableArn+"/index/*"], "dynamodb:Query")
+		}
 	}
+
+	return core.SimulatePermissions(s.simulator, needed)
 }
 
 // New - Create a new DynamoDB key value plugin implementation
 func New(provider core.AwsProvider) (document.DocumentService, error) {
-	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
 
-	// Create a new AWS session
-	sess, sessionError := session.NewSession(&aws.Config{
-		// FIXME: Use env config
-		Region: aws.String(awsRegion),
-	})
+	dynamoClient := dynamodb.New(sess)
 
-	if sessionError != nil {
-		return nil, fmt.Errorf("error creating new AWS session %v", sessionError)
+	planner, err := document.QueryPlannerFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
-	dynamoClient := dynamodb.New(sess)
+	for _, index := range planner.Indexes() {
+		if len(index.Fields) > maxIndexKeys {
+			return nil, fmt.Errorf("index %s has too many fields, DynamoDB indexes support at most %d", index, maxIndexKeys)
+		}
+	}
 
 	return &DynamoDocService{
-		client:   dynamoClient,
-		provider: provider,
+		client:      dynamoClient,
+		provider:    provider,
+		planner:     planner,
+		singleTable: utils.GetEnv("DOCUMENT_SINGLE_TABLE", ""),
+		simulator:   core.NewPermissionSimulator(sess),
 	}, nil
 }
 
 // NewWithClient - Mainly used for testing
-func NewWithClient(provider core.AwsProvider, client *dynamodb.DynamoDB) (document.DocumentService, error) {
-	return &DynamoDocService{
+func NewWithClient(provider core.AwsProvider, client *dynamodb.DynamoDB, opts ...DynamoDocServiceOption) (document.DocumentService, error) {
+	service := &DynamoDocService{
 		provider: provider,
 		client:   client,
-	}, nil
+	}
+
+	for _, o := range opts {
+		o.Apply(service)
+	}
+
+	return service, nil
 }
 
 // Private Functions ----------------------------------------------------------
 
-func createKeyMap(key *document.Key) map[string]string {
-	keyMap := make(map[string]string)
+// partitionKey - the partition key of the top level document, qualified by its collection in a single table
+func (s *DynamoDocService) partitionKey(root *document.Key) string {
+	if s.singleTable == "" {
+		return root.Id
+	}
+
+	return root.Collection.Name + partitionKeyDelimiter + root.Id
+}
 
-	parentKey := key.Collection.Parent
+// rootId - the id of the top level document of a partition key
+func (s *DynamoDocService) rootId(partitionKey string) string {
+	if s.singleTable == "" {
+		return partitionKey
+	}
 
-	if parentKey == nil {
-		keyMap[AttribPk] = key.Id
-		keyMap[AttribSk] = key.Collection.Name + "#"
-	} else {
-		keyMap[AttribPk] = parentKey.Id
-		keyMap[AttribSk] = key.Collection.Name + "#" + key.Id
+	parts := strings.SplitN(partitionKey, partitionKeyDelimiter, 2)
+
+	return parts[len(parts)-1]
+}
+
+// collectionPath - the names of the collections from the top level collection down to the given collection
+func collectionPath(collection *document.Collection) string {
+	names := []string{}
+	if collection.Parent != nil {
+		for _, k := range document.KeyPath(collection.Parent) {
+			names = append(names, k.Collection.Name)
+		}
+	}
+
+	return strings.Join(append(names, collection.Name), document.SubcollectionDelimiter)
+}
+
+// collectionShard - the collection index partition of an item, its collection path followed by a shard from the hash of its keys
+func collectionShard(collection *document.Collection, pk string, sk string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(pk + partitionKeyDelimiter + sk))
+
+	return collectionShardKey(collection, int(h.Sum32()%collectionShards))
+}
+
+func collectionShardKey(collection *document.Collection, shard int) string {
+	return collectionPath(collection) + partitionKeyDelimiter + strconv.Itoa(shard)
+}
+
+// collectionExpression - restricts the items of a single table to the collection's
+func collectionExpression(collection *document.Collection) document.QueryExpression {
+	return document.QueryExpression{
+		Operand:  AttribCollection,
+		Operator: "==",
+		Value:    collectionPath(collection),
 	}
+}
 
-	return keyMap
+// createKeyMap - documents are stored in the partition of their top level document, see document.SortKey
+func (s *DynamoDocService) createKeyMap(key *document.Key) map[string]string {
+	return map[string]string{
+		AttribPk: s.partitionKey(document.RootKey(key)),
+		AttribSk: document.SortKey(key),
+	}
 }
 
-func createItemMap(source map[string]interface{}, key *document.Key) map[string]interface{} {
-	// Copy map
+func (s *DynamoDocService) createItemMap(source map[string]interface{}, key *document.Key) map[string]interface{} {
+	// Copy map, with the geohash cells of its locations for geo queries
 	newMap := make(map[string]interface{})
-	for key, value := range source {
+	for key, value := range document.EncodeGeohashes(source) {
 		newMap[key] = value
 	}
 
-	keyMap := createKeyMap(key)
+	keyMap := s.createKeyMap(key)
 
 	// Add key attributes
 	newMap[AttribPk] = keyMap[AttribPk]
 	newMap[AttribSk] = keyMap[AttribSk]
+	if s.singleTable != "" {
+		newMap[AttribCollection] = collectionPath(key.Collection)
+		newMap[AttribCollectionShard] = collectionShard(key.Collection, keyMap[AttribPk], keyMap[AttribSk])
+	}
 
 	return newMap
 }
@@ -492,7 +1344,7 @@ func (s *DynamoDocService) performQuery(
 	limit int,
 	pagingToken map[string]string,
 ) (*document.QueryResult, error) {
-	if collection.Parent == nil {
+	if collection.Parent == nil || document.RootKey(collection.Parent).Id == "" {
 		// Should never occur
 		return nil, fmt.Errorf("cannot perform query without partion key defined")
 	}
@@ -523,25 +1375,18 @@ func (s *DynamoDocService) performQuery(
 	input.ExpressionAttributeNames = make(map[string]*string)
 	input.ExpressionAttributeNames["#pk"] = aws.String("_pk")
 	input.ExpressionAttributeNames["#sk"] = aws.String("_sk")
-	for _, exp := range expressions {
-		input.ExpressionAttributeNames["#"+exp.Operand] = aws.String(exp.Operand)
-	}
+	addExpressionNames(input.ExpressionAttributeNames, expressions)
 
 	// Configure ExpressionAttributeValues
 	input.ExpressionAttributeValues = make(map[string]*dynamodb.AttributeValue)
 	input.ExpressionAttributeValues[":pk"] = &dynamodb.AttributeValue{
-		S: aws.String(collection.Parent.Id),
+		S: aws.String(s.partitionKey(document.RootKey(collection.Parent))),
 	}
 	input.ExpressionAttributeValues[":sk"] = &dynamodb.AttributeValue{
-		S: aws.String(collection.Name + "#"),
+		S: aws.String(document.SortKeyPrefix(collection)),
 	}
-	for i, exp := range expressions {
-		expKey := fmt.Sprintf(":%v%v", exp.Operand, i)
-		valAttrib, err := dynamodbattribute.Marshal(exp.Value)
-		if err != nil {
-			return nil, fmt.Errorf("error marshalling %v: %v", exp.Operand, exp.Value)
-		}
-		input.ExpressionAttributeValues[expKey] = valAttrib
+	if err := addExpressionValues(input.ExpressionAttributeValues, expressions); err != nil {
+		return nil, err
 	}
 
 	// Configure fetch Limit
@@ -564,7 +1409,233 @@ func (s *DynamoDocService) performQuery(
 		return nil, fmt.Errorf("error performing query %v: %v", input, err)
 	}
 
-	return marshalQueryResult(collection, resp.Items, resp.LastEvaluatedKey)
+	return s.marshalQueryResult(collection, resp.Items, resp.LastEvaluatedKey)
+}
+
+// keyOperators - operators DynamoDB key conditions support
+var keyOperators = map[string]bool{
+	"==":         true,
+	"<":          true,
+	"<=":         true,
+	">":          true,
+	">=":         true,
+	"startsWith": true,
+}
+
+// indexKeyExpressions - splits the expressions into the key conditions and filters of an index query
+// returns false if the key conditions can't be expressed as a DynamoDB KeyConditionExpression
+func indexKeyExpressions(index *document.Index, expressions []document.QueryExpression) ([]document.QueryExpression, []document.QueryExpression, bool) {
+	keyExps := make([]document.QueryExpression, 0)
+	filterExps := make([]document.QueryExpression, 0)
+
+	keyFields := index.Fields
+	if len(keyFields) > maxIndexKeys {
+		keyFields = keyFields[:maxIndexKeys]
+	}
+
+	// Fields filtered by list or array operators can't be key conditions, so all of their conditions are filters
+	filtered := make(map[string]bool)
+	for _, exp := range expressions {
+		if !keyOperators[exp.Operator] {
+			filtered[exp.Operand] = true
+		}
+	}
+
+	for _, exp := range expressions {
+		isKey := false
+		for _, field := range keyFields {
+			if exp.Operand == field && !filtered[field] {
+				isKey = true
+			}
+		}
+
+		if isKey {
+			keyExps = append(keyExps, exp)
+		} else {
+			filterExps = append(filterExps, exp)
+		}
+	}
+
+	// Sort expressions to map "A >= %1 AND A <= %2" to "A BETWEEN %1 AND %2"
+	sort.Sort(document.ExpsSort(keyExps))
+
+	// The partition key must be matched exactly, and each key with a single condition
+	conditions := make(map[string]int)
+	for i, exp := range keyExps {
+		if exp.Operand == keyFields[0] && exp.Operator != "==" {
+			return nil, nil, false
+		}
+
+		if !isBetweenEnd(i, keyExps) {
+			conditions[exp.Operand]++
+		}
+	}
+
+	if conditions[keyFields[0]] != 1 {
+		return nil, nil, false
+	}
+
+	for _, count := range conditions {
+		if count > 1 {
+			return nil, nil, false
+		}
+	}
+
+	return keyExps, filterExps, true
+}
+
+func (s *DynamoDocService) performIndexQuery(
+	index *document.Index,
+	collection *document.Collection,
+	expressions []document.QueryExpression,
+	limit int,
+	pagingToken map[string]string,
+) (*document.QueryResult, error) {
+	keyExps, filterExps, ok := indexKeyExpressions(index, expressions)
+	if !ok {
+		// The index can't serve the query, filter the collection instead
+		return s.performScan(collection, expressions, limit, pagingToken)
+	}
+
+	sort.Sort(document.ExpsSort(filterExps))
+
+	tableName, err := s.getTableName(*collection)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName: tableName,
+		IndexName: aws.String(index.Name),
+	}
+
+	// Configure KeyConditionExpression
+	input.KeyConditionExpression = aws.String(createFilterExpression(keyExps))
+
+	// Configure FilterExpression, restricting results to the collection's documents
+	filterExp := "#sk = :sk"
+	if collection.Parent != nil {
+		filterExp = "begins_with(#sk, :sk)"
+	}
+
+	if expFilters := createFilterExpression(filterExps); expFilters != "" {
+		filterExp += " AND " + expFilters
+	}
+	input.FilterExpression = aws.String(filterExp)
+
+	// Configure ExpressionAttributeNames
+	input.ExpressionAttributeNames = make(map[string]*string)
+	input.ExpressionAttributeNames["#sk"] = aws.String(AttribSk)
+	addExpressionNames(input.ExpressionAttributeNames, expressions)
+
+	// Configure ExpressionAttributeValues
+	input.ExpressionAttributeValues = make(map[string]*dynamodb.AttributeValue)
+	input.ExpressionAttributeValues[":sk"] = &dynamodb.AttributeValue{
+		S: aws.String(document.SortKeyPrefix(collection)),
+	}
+	for _, exps := range [][]document.QueryExpression{keyExps, filterExps} {
+		if err := addExpressionValues(input.ExpressionAttributeValues, exps); err != nil {
+			return nil, err
+		}
+	}
+
+	// Configure fetch Limit
+	if limit > 0 {
+		limit64 := int64(limit)
+		input.Limit = &(limit64)
+
+		if token, ok := pagingToken[indexPagingToken]; ok {
+			startKey := make(map[string]*dynamodb.AttributeValue)
+			if err := json.Unmarshal([]byte(token), &startKey); err != nil {
+				return nil, fmt.Errorf("error performing index query %v: %v", input, err)
+			}
+			input.SetExclusiveStartKey(startKey)
+		}
+	}
+
+	resp, err := s.client.Query(input)
+	if err != nil {
+		return nil, fmt.Errorf("error performing index query %v: %v", input, err)
+	}
+
+	result, err := s.marshalQueryResult(collection, resp.Items, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.LastEvaluatedKey) > 0 {
+		token, err := json.Marshal(resp.LastEvaluatedKey)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling index query lastEvaluatedKey: %v", err)
+		}
+
+		result.PagingToken = map[string]string{
+			indexPagingToken: string(token),
+		}
+	}
+
+	return result, nil
+}
+
+// performCollectionQuery - queries each shard of the collection in the collection index of a single table in turn,
+// continuing from the shard and index key of the paging token
+func (s *DynamoDocService) performCollectionQuery(
+	collection *document.Collection,
+	expressions []document.QueryExpression,
+	limit int,
+	pagingToken map[string]string,
+) (*document.QueryResult, error) {
+	index := &document.Index{Name: collectionIndex, Fields: []string{AttribCollectionShard}}
+
+	shard := 0
+	var indexToken map[string]string
+	if limit > 0 && pagingToken != nil {
+		if token, ok := pagingToken[collectionShardToken]; ok {
+			var err error
+			if shard, err = strconv.Atoi(token); err != nil || shard < 0 || shard >= collectionShards {
+				return nil, fmt.Errorf("invalid paging token shard %s", token)
+			}
+		}
+		if token, ok := pagingToken[indexPagingToken]; ok {
+			indexToken = map[string]string{indexPagingToken: token}
+		}
+	}
+
+	result := &document.QueryResult{Documents: make([]document.Document, 0)}
+	for ; shard < collectionShards; shard++ {
+		shardExpressions := append(append(make([]document.QueryExpression, 0, len(expressions)+1), expressions...), document.QueryExpression{
+			Operand:  AttribCollectionShard,
+			Operator: "==",
+			Value:    collectionShardKey(collection, shard),
+		})
+
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - len(result.Documents)
+		}
+
+		res, err := s.performIndexQuery(index, collection, shardExpressions, remaining, indexToken)
+		if err != nil {
+			return nil, err
+		}
+		indexToken = nil
+
+		result.Documents = append(result.Documents, res.Documents...)
+
+		if len(res.PagingToken) > 0 {
+			// the shard has more documents
+			res.PagingToken[collectionShardToken] = strconv.Itoa(shard)
+			result.PagingToken = res.PagingToken
+			return result, nil
+		}
+
+		if limit > 0 && len(result.Documents) >= limit && shard+1 < collectionShards {
+			result.PagingToken = map[string]string{collectionShardToken: strconv.Itoa(shard + 1)}
+			return result, nil
+		}
+	}
+
+	return result, nil
 }
 
 func (s *DynamoDocService) performScan(
@@ -602,23 +1673,15 @@ func (s *DynamoDocService) performScan(
 	// Configure ExpressionAttributeNames
 	input.ExpressionAttributeNames = make(map[string]*string)
 	input.ExpressionAttributeNames["#sk"] = aws.String("_sk")
-
-	for _, exp := range expressions {
-		input.ExpressionAttributeNames["#"+exp.Operand] = aws.String(exp.Operand)
-	}
+	addExpressionNames(input.ExpressionAttributeNames, expressions)
 
 	// Configure ExpressionAttributeValues
 	input.ExpressionAttributeValues = make(map[string]*dynamodb.AttributeValue)
-	keyAttrib := &dynamodb.AttributeValue{S: aws.String(collection.Name + "#")}
+	keyAttrib := &dynamodb.AttributeValue{S: aws.String(document.SortKeyPrefix(collection))}
 
 	input.ExpressionAttributeValues[":sk"] = keyAttrib
-	for i, exp := range expressions {
-		expKey := fmt.Sprintf(":%v%v", exp.Operand, i)
-		valAttrib, err := dynamodbattribute.Marshal(exp.Value)
-		if err != nil {
-			return nil, fmt.Errorf("error marshalling %v: %v", exp.Operand, exp.Value)
-		}
-		input.ExpressionAttributeValues[expKey] = valAttrib
+	if err := addExpressionValues(input.ExpressionAttributeValues, expressions); err != nil {
+		return nil, err
 	}
 
 	// Configure fetch Limit
@@ -641,10 +1704,10 @@ func (s *DynamoDocService) performScan(
 		return nil, fmt.Errorf("error performing scan %v: %v", input, err)
 	}
 
-	return marshalQueryResult(collection, resp.Items, resp.LastEvaluatedKey)
+	return s.marshalQueryResult(collection, resp.Items, resp.LastEvaluatedKey)
 }
 
-func marshalQueryResult(collection *document.Collection, items []map[string]*dynamodb.AttributeValue, lastEvaluatedKey map[string]*dynamodb.AttributeValue) (*document.QueryResult, error) {
+func (s *DynamoDocService) marshalQueryResult(collection *document.Collection, items []map[string]*dynamodb.AttributeValue, lastEvaluatedKey map[string]*dynamodb.AttributeValue) (*document.QueryResult, error) {
 	// Unmarshal Dynamo response items
 	var pTkn map[string]string = nil
 	var valueMaps []map[string]interface{}
@@ -656,40 +1719,34 @@ func marshalQueryResult(collection *document.Collection, items []map[string]*dyn
 
 	// Strip keys & append results
 	for _, m := range valueMaps {
-		// Retrieve the original ID on the result
-		var id string
-		var c *document.Collection
-		if collection.Parent == nil {
-			// We know this is a root document so its key will be located in PK
-			pk, _ := m[AttribPk].(string)
-			id = pk
-			c = collection
-		} else {
+		// Retrieve the original key of the result
+		pk, _ := m[AttribPk].(string)
+		pk = s.rootId(pk)
+		key := &document.Key{
+			Collection: collection,
+			Id:         pk,
+		}
+
+		if collection.Parent != nil {
 			// We know this is a child document so its key will be located in the SK
-			pk, _ := m[AttribPk].(string)
 			sk, _ := m[AttribSk].(string)
-			idStr := strings.Split(sk, "#")
-			id = idStr[len(idStr)-1]
-			c = &document.Collection{
-				Name: collection.Name,
-				Parent: &document.Key{
-					Collection: &document.Collection{
-						Name: collection.Parent.Collection.Name,
-					},
-					Id: pk,
-				},
+			key = document.KeyFromSortKey(document.RootKey(collection.Parent).Collection.Name, pk, sk)
+
+			// The SK prefix may match sub-collections of other parents when a parent id is blank
+			if !document.InCollection(key, collection) {
+				continue
 			}
 		}
 
 		// Split out sort key value
 		delete(m, AttribPk)
 		delete(m, AttribSk)
+		delete(m, AttribCollection)
+		delete(m, AttribCollectionShard)
+		document.StripGeohashes(m)
 
 		sdkDoc := document.Document{
-			Key: &document.Key{
-				Collection: c,
-				Id:         id,
-			},
+			Key:     key,
 			Content: m,
 		}
 		docs = append(docs, sdkDoc)
@@ -711,33 +1768,174 @@ func marshalQueryResult(collection *document.Collection, items []map[string]*dyn
 }
 
 func createFilterExpression(expressions []document.QueryExpression) string {
+	return filterExpression(expressions, "")
+}
+
+// filterExpression - returns the condition matching all the expressions, their value keys are prefixed
+// after the operand by the position of their group, see addExpressionValues
+func filterExpression(expressions []document.QueryExpression, prefix string) string {
 	keyExp := ""
 	for i, exp := range expressions {
 		if keyExp != "" {
 			keyExp += " AND "
 		}
 
-		if isBetweenStart(i, expressions) {
+		if groups, ok := exp.Groups(); ok {
+			// ((group 0) OR (group 1) ...)
+			conditions := make([]string, 0, len(groups))
+			for j, group := range groups {
+				conditions = append(conditions, "("+filterExpression(sortedGroup(group), groupPrefix(prefix, i, j))+")")
+			}
+			keyExp += "(" + strings.Join(conditions, " OR ") + ")"
+		} else if isBetweenStart(i, expressions) {
 			// #{exp.operand} BETWEEN :{exp.operand}{exp.index})
-			keyExp += fmt.Sprintf("#%v BETWEEN :%s%d", exp.Operand, exp.Operand, i)
+			keyExp += fmt.Sprintf("#%v BETWEEN :%s%s%d", exp.Operand, exp.Operand, prefix, i)
 		} else if isBetweenEnd(i, expressions) {
 			// AND :{exp.operand}{exp.index})
-			keyExp += fmt.Sprintf(":%s%d", exp.Operand, i)
+			keyExp += fmt.Sprintf(":%s%s%d", exp.Operand, prefix, i)
 		} else if exp.Operator == "startsWith" {
 			// begins_with(#{exp.operand}, :{exp.operand}{exp.index})
-			keyExp += fmt.Sprintf("begins_with(#%s, :%s%d)", exp.Operand, exp.Operand, i)
+			keyExp += fmt.Sprintf("begins_with(#%s, :%s%s%d)", exp.Operand, exp.Operand, prefix, i)
+		} else if exp.Operator == "in" {
+			// #{exp.operand} IN (:{exp.operand}{exp.index}_0, ...)
+			keyExp += fmt.Sprintf("#%s IN (%s)", exp.Operand, strings.Join(listValueKeys(exp, prefix, i), ", "))
+		} else if exp.Operator == "not-in" {
+			// matching firestore, documents without the field aren't included
+			keyExp += fmt.Sprintf("attribute_exists(#%s) AND NOT (#%s IN (%s))", exp.Operand, exp.Operand, strings.Join(listValueKeys(exp, prefix, i), ", "))
+		} else if exp.Operator == "array-contains" {
+			// contains also matches substrings of strings, so the attribute must be a list
+			keyExp += fmt.Sprintf("attribute_type(#%s, %s) AND contains(#%s, :%s%s%d)", exp.Operand, listTypeKey, exp.Operand, exp.Operand, prefix, i)
+		} else if exp.Operator == "array-contains-any" {
+			contains := make([]string, 0)
+			for _, key := range listValueKeys(exp, prefix, i) {
+				contains = append(contains, fmt.Sprintf("contains(#%s, %s)", exp.Operand, key))
+			}
+			keyExp += fmt.Sprintf("attribute_type(#%s, %s) AND (%s)", exp.Operand, listTypeKey, strings.Join(contains, " OR "))
 		} else if exp.Operator == "==" {
 			// #{exp.operand} = :{exp.operand}{exp.index}
-			keyExp += fmt.Sprintf("#%s = :%s%d", exp.Operand, exp.Operand, i)
+			keyExp += fmt.Sprintf("#%s = :%s%s%d", exp.Operand, exp.Operand, prefix, i)
 		} else {
 			// #{exp.operand} {exp.operator} :{exp.operand}{exp.index}
-			keyExp += fmt.Sprintf("#%s %s :%s%d", exp.Operand, exp.Operator, exp.Operand, i)
+			keyExp += fmt.Sprintf("#%s %s :%s%s%d", exp.Operand, exp.Operator, exp.Operand, prefix, i)
 		}
 	}
 
 	return keyExp
 }
 
+// listTypeKey - the expression value of the DynamoDB list attribute type, see attribute_type
+const listTypeKey = ":_listType"
+
+// listValueKeys - the expression value keys of the elements of a list expression value, see addExpressionValues
+func listValueKeys(exp document.QueryExpression, prefix string, index int) []string {
+	values, _ := exp.Value.([]interface{})
+
+	keys := make([]string, 0, len(values))
+	for j := range values {
+		keys = append(keys, fmt.Sprintf(":%s%s%d_%d", exp.Operand, prefix, index, j))
+	}
+
+	return keys
+}
+
+// addExpressionValues - adds the values of the expressions to the expression attribute values,
+// as :{exp.operand}{exp.index}, or :{exp.operand}{exp.index}_{value index} for each value of a list operator.
+// The values of expressions in the groups of or expressions have the position of their group after the operand,
+// e.g. :{exp.operand}{or exp.index}g{group index}_{exp.index}
+func addExpressionValues(values map[string]*dynamodb.AttributeValue, expressions []document.QueryExpression) error {
+	return addGroupValues(values, expressions, "")
+}
+
+func addGroupValues(values map[string]*dynamodb.AttributeValue, expressions []document.QueryExpression, prefix string) error {
+	for i, exp := range expressions {
+		if groups, ok := exp.Groups(); ok {
+			for j, group := range groups {
+				if err := addGroupValues(values, sortedGroup(group), groupPrefix(prefix, i, j)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if exp.Operator == "array-contains" || exp.Operator == "array-contains-any" {
+			values[listTypeKey] = &dynamodb.AttributeValue{S: aws.String("L")}
+		}
+
+		if !document.IsListOperator(exp.Operator) {
+			valAttrib, err := dynamodbattribute.Marshal(exp.Value)
+			if err != nil {
+				return fmt.Errorf("error marshalling %v: %v", exp.Operand, exp.Value)
+			}
+			values[fmt.Sprintf(":%v%v%v", exp.Operand, prefix, i)] = valAttrib
+			continue
+		}
+
+		keys := listValueKeys(exp, prefix, i)
+		for j, v := range exp.Value.([]interface{}) {
+			valAttrib, err := dynamodbattribute.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("error marshalling %v: %v", exp.Operand, v)
+			}
+			values[keys[j]] = valAttrib
+		}
+	}
+
+	return nil
+}
+
+// addExpressionNames - adds the operands of the expressions, and of the expressions in the groups of or expressions,
+// to the expression attribute names as #{exp.operand}
+func addExpressionNames(names map[string]*string, expressions []document.QueryExpression) {
+	for _, exp := range expressions {
+		if groups, ok := exp.Groups(); ok {
+			for _, group := range groups {
+				addExpressionNames(names, group)
+			}
+			continue
+		}
+
+		names["#"+exp.Operand] = aws.String(exp.Operand)
+	}
+}
+
+// groupPrefix - the value key prefix of the expressions in group j of the or expression at index i
+func groupPrefix(prefix string, i int, j int) string {
+	return fmt.Sprintf("%s%dg%d_", prefix, i, j)
+}
+
+// sortedGroup - returns a sorted copy of the group of an or expression,
+// to map "A >= %1 AND A <= %2" to "A BETWEEN %1 AND %2" as the enclosing expressions are
+func sortedGroup(group []document.QueryExpression) []document.QueryExpression {
+	sorted := make([]document.QueryExpression, len(group))
+	copy(sorted, group)
+	sort.Sort(document.ExpsSort(sorted))
+
+	return sorted
+}
+
+// maxInValues - the most values a DynamoDB IN comparison can compare with
+const maxInValues = 100
+
+// unsupported - returns an error if the expressions can't be expressed as DynamoDB conditions
+func unsupported(expressions []document.QueryExpression) error {
+	for _, exp := range expressions {
+		if groups, ok := exp.Groups(); ok {
+			for _, group := range groups {
+				if err := unsupported(group); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if values, ok := exp.Value.([]interface{}); ok && (exp.Operator == "in" || exp.Operator == "not-in") && len(values) > maxInValues {
+			return fmt.Errorf("%s expressions compare at most %d values, found %d on %s", exp.Operator, maxInValues, len(values), exp.Operand)
+		}
+	}
+
+	return nil
+}
+
 func isBetweenStart(index int, exps []document.QueryExpression) bool {
 	if index < (len(exps) - 1) {
 		if exps[index].Operand == exps[index+1].Operand &&
@@ -771,6 +1969,10 @@ func (s *DynamoDocService) getTableName(collection document.Collection) (*string
 		coll = *coll.Parent.Collection
 	}
 
+	if s.singleTable != "" {
+		coll = document.Collection{Name: s.singleTable}
+	}
+
 	if table, ok := tables[coll.Name]; ok {
 		tableName := strings.Split(table, "/")[1]
 
@@ -781,7 +1983,7 @@ func (s *DynamoDocService) getTableName(collection document.Collection) (*string
 	return nil, fmt.Errorf("collection %s does not exist", coll.Name)
 }
 
-func createDeleteQuery(table *string, key *document.Key, startKey map[string]*dynamodb.AttributeValue) *dynamodb.QueryInput {
+func createDeleteQuery(table *string, partitionKey string, startKey map[string]*dynamodb.AttributeValue) *dynamodb.QueryInput {
 	limit := deleteQueryLimit
 
 	return &dynamodb.QueryInput{
@@ -796,23 +1998,42 @@ func createDeleteQuery(table *string, key *document.Key, startKey map[string]*dy
 		},
 		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
 			":pk": {
-				S: aws.String(key.Id),
+				S: aws.String(partitionKey),
 			},
 		},
 		ExclusiveStartKey: startKey,
 	}
 }
 
-func (s *DynamoDocService) processDeleteQuery(table string, resp *dynamodb.QueryOutput) error {
+// descendantItems - returns the items of documents nested under the key
+func (s *DynamoDocService) descendantItems(key *document.Key, items []map[string]*dynamodb.AttributeValue) []map[string]*dynamodb.AttributeValue {
+	rootName := document.RootKey(key).Collection.Name
+
+	descendants := make([]map[string]*dynamodb.AttributeValue, 0, len(items))
+	for _, item := range items {
+		if item[AttribPk] == nil || item[AttribSk] == nil {
+			continue
+		}
+
+		itemKey := document.KeyFromSortKey(rootName, s.rootId(aws.StringValue(item[AttribPk].S)), aws.StringValue(item[AttribSk].S))
+		if document.IsDescendant(itemKey, key) {
+			descendants = append(descendants, item)
+		}
+	}
+
+	return descendants
+}
+
+func (s *DynamoDocService) processDeleteQuery(table string, items []map[string]*dynamodb.AttributeValue) error {
 	itemIndex := 0
-	for itemIndex < len(resp.Items) {
+	for itemIndex < len(items) {
 		batchInput := &dynamodb.BatchWriteItemInput{}
 		batchInput.RequestItems = make(map[string][]*dynamodb.WriteRequest)
 		writeRequests := make([]*dynamodb.WriteRequest, 0, maxBatchWrite)
 
 		batchCount := 0
-		for batchCount < maxBatchWrite && itemIndex < len(resp.Items) {
-			item := resp.Items[itemIndex]
+		for batchCount < maxBatchWrite && itemIndex < len(items) {
+			item := items[itemIndex]
 			itemIndex += 1
 
 			writeRequest := dynamodb.WriteRequest{}
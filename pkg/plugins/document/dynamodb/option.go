@@ -0,0 +1,34 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamodb_service
+
+type DynamoDocServiceOption interface {
+	Apply(*DynamoDocService)
+}
+
+type withSingleTable struct {
+	table string
+}
+
+func (w *withSingleTable) Apply(service *DynamoDocService) {
+	service.singleTable = w.table
+}
+
+// WithSingleTable - stores every collection in the table with the given nitric name, see DynamoDocService.singleTable
+func WithSingleTable(table string) DynamoDocServiceOption {
+	return &withSingleTable{
+		table: table,
+	}
+}
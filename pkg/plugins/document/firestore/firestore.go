@@ -18,29 +18,61 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/nitrictech/nitric/pkg/plugins/document"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 
 	grpcCodes "google.golang.org/grpc/codes"
 
 	"cloud.google.com/go/firestore"
-	"cloud.google.com/go/pubsub"
-	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/status"
 )
 
-const pagingTokens = "pagingTokens"
+const (
+	pagingTokens = "pagingTokens"
+	// maxBatchSize - the most writes firestore commits in a batch
+	maxBatchSize = 500
+	// maxBatchRetries - the most times a batch that fails with a transient error is retried
+	maxBatchRetries = 3
+	// batchRetryDelay - the delay before the first retry of a batch, doubling with each retry
+	batchRetryDelay = 100 * time.Millisecond
+)
 
 type FirestoreDocService struct {
-	client  *firestore.Client
-	context context.Context
+	// provider - creates the client on first use, nil if it's already set
+	provider    core.GcpProvider
+	connectOnce sync.Once
+	connectErr  error
+	client      *firestore.Client
+	context     context.Context
+	planner     *document.QueryPlanner
+	// identity - qualifies the names of top level collections, so environments sharing a project don't share documents
+	identity *stack.Identity
 	document.UnimplementedDocumentPlugin
 }
 
+// connect - creates the client the first time it's needed, so unused plugins don't slow cold starts
+func (s *FirestoreDocService) connect() error {
+	s.connectOnce.Do(func() {
+		if s.provider == nil {
+			return
+		}
+
+		s.client, s.connectErr = s.provider.FirestoreClient()
+	})
+
+	return s.connectErr
+}
+
 func (s *FirestoreDocService) Get(key *document.Key) (*document.Document, error) {
 	newErr := errors.ErrorsWithScope(
 		"FirestoreDocService.Get",
@@ -49,6 +81,14 @@ func (s *FirestoreDocService) Get(key *document.Key) (*document.Document, error)
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
 	if err := document.ValidateKey(key); err != nil {
 		return nil, newErr(
 			codes.InvalidArgument,
@@ -73,9 +113,12 @@ func (s *FirestoreDocService) Get(key *document.Key) (*document.Document, error)
 		)
 	}
 
+	content := value.Data()
+	document.StripGeohashes(content)
+
 	return &document.Document{
 		Key:     key,
-		Content: value.Data(),
+		Content: content,
 	}, nil
 }
 
@@ -87,6 +130,14 @@ func (s *FirestoreDocService) Set(key *document.Key, value map[string]interface{
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
 	if err := document.ValidateKey(key); err != nil {
 		return newErr(
 			codes.InvalidArgument,
@@ -105,7 +156,8 @@ func (s *FirestoreDocService) Set(key *document.Key, value map[string]interface{
 
 	doc := s.getDocRef(key)
 
-	if _, err := doc.Set(s.context, value); err != nil {
+	// firestore has no geo queries, locations are queried by the geohash cells containing them
+	if _, err := doc.Set(s.context, document.EncodeGeohashes(value)); err != nil {
 		return newErr(
 			codes.Internal,
 			"error updating value",
@@ -124,6 +176,14 @@ func (s *FirestoreDocService) Delete(key *document.Key) error {
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
 	if err := document.ValidateKey(key); err != nil {
 		return newErr(
 			codes.InvalidArgument,
@@ -135,19 +195,363 @@ func (s *FirestoreDocService) Delete(key *document.Key) error {
 	doc := s.getDocRef(key)
 
 	// Delete any sub collection documents
-	collsIter := doc.Collections(s.context)
-	for subCol, err := collsIter.Next(); err != iterator.Done; subCol, err = collsIter.Next() {
+	if err := s.deleteCollections(doc); err != nil {
+		return newErr(
+			codes.Internal,
+			"error deleting value",
+			err,
+		)
+	}
+
+	// Delete document
+	if _, err := doc.Delete(s.context); err != nil {
+		return newErr(
+			codes.Internal,
+			"error deleting value",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Increment - writes a firestore.Increment of the field in a transaction, which reads the field to return its new value
+// and is retried by firestore if the document changes before it commits
+func (s *FirestoreDocService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	newErr := errors.ErrorsWithScope(
+		"FirestoreDocService.Increment",
+		map[string]interface{}{
+			"key":   key,
+			"field": field,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return 0, newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
+	if err := document.ValidateKey(key); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateIncrementField(field); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
+
+	doc := s.getDocRef(key)
+
+	var value int64
+	err := s.client.RunTransaction(s.context, func(ctx context.Context, tx *firestore.Transaction) error {
+		snp, err := tx.Get(doc)
+		if err != nil && status.Code(err) != grpcCodes.NotFound {
+			return err
+		}
+
+		var current interface{}
+		if snp != nil && snp.Exists() {
+			current = snp.Data()[field]
+		}
+
+		value, err = document.IncrementedValue(current, delta)
 		if err != nil {
 			return newErr(
-				codes.Internal,
-				"error deleting value",
+				codes.FailedPrecondition,
+				"invalid field value",
 				err,
 			)
 		}
 
+		return tx.Set(doc, map[string]interface{}{field: firestore.Increment(delta)}, firestore.MergeAll)
+	})
+	if err != nil {
+		if _, ok := errors.AsPluginError(err); ok {
+			return 0, err
+		}
+
+		return 0, newErr(
+			codes.Internal,
+			"error incrementing value",
+			err,
+		)
+	}
+
+	return value, nil
+}
+
+// CompareAndSet - reads the field and sets the document in a transaction, which firestore retries if the document changes before it commits
+func (s *FirestoreDocService) CompareAndSet(key *document.Key, field string, expected int64, value map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"FirestoreDocService.CompareAndSet",
+		map[string]interface{}{
+			"key":      key,
+			"field":    field,
+			"expected": expected,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
+	if err := document.ValidateKey(key); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateCompareField(field); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
+
+	if value == nil {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-nil value",
+			nil,
+		)
+	}
+
+	doc := s.getDocRef(key)
+
+	err := s.client.RunTransaction(s.context, func(ctx context.Context, tx *firestore.Transaction) error {
+		snp, err := tx.Get(doc)
+		if err != nil && status.Code(err) != grpcCodes.NotFound {
+			return err
+		}
+
+		var current interface{}
+		if snp != nil && snp.Exists() {
+			current = snp.Data()[field]
+		}
+
+		if !document.FieldEquals(current, expected) {
+			return newErr(
+				codes.FailedPrecondition,
+				document.ConditionFailed,
+				nil,
+			)
+		}
+
+		return tx.Set(doc, document.EncodeGeohashes(value))
+	})
+	if err != nil {
+		if _, ok := errors.AsPluginError(err); ok {
+			return err
+		}
+
+		return newErr(
+			codes.Internal,
+			"error updating value",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// SetBatch - sets the documents in batches of maxBatchSize. Firestore commits each batch atomically,
+// so every document in a batch that can't be committed fails
+func (s *FirestoreDocService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	if err := s.connect(); err != nil {
+		return nil, errors.ErrorsWithScope("FirestoreDocService.SetBatch", nil)(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
+	resp := &document.BatchResponse{
+		FailedWrites: make([]*document.FailedWrite, 0),
+	}
+	writes := newBatchWrites()
+
+	for _, doc := range docs {
+		if err := document.ValidateKey(doc.Key); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "invalid key", err))
+			continue
+		}
+
+		if doc.Content == nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(doc.Key, "provide non-nil value", nil))
+			continue
+		}
+
+		content := document.EncodeGeohashes(doc.Content)
+		writes.add(s.getDocRef(doc.Key), doc.Key, func(batch *firestore.WriteBatch, ref *firestore.DocumentRef) {
+			batch.Set(ref, content)
+		})
+	}
+
+	resp.FailedWrites = append(resp.FailedWrites, s.commitBatches(writes)...)
+
+	return resp, nil
+}
+
+// DeleteBatch - deletes the documents in batches of maxBatchSize, after deleting their sub-collections
+func (s *FirestoreDocService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	if err := s.connect(); err != nil {
+		return nil, errors.ErrorsWithScope("FirestoreDocService.DeleteBatch", nil)(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
+	resp := &document.BatchResponse{
+		FailedWrites: make([]*document.FailedWrite, 0),
+	}
+	writes := newBatchWrites()
+
+	for _, key := range keys {
+		if err := document.ValidateKey(key); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(key, "invalid key", err))
+			continue
+		}
+
+		ref := s.getDocRef(key)
+
+		// Delete any sub collection documents
+		if err := s.deleteCollections(ref); err != nil {
+			resp.FailedWrites = append(resp.FailedWrites, failedWrite(key, "error deleting sub-collections", err))
+			continue
+		}
+
+		writes.add(ref, key, func(batch *firestore.WriteBatch, ref *firestore.DocumentRef) {
+			batch.Delete(ref)
+		})
+	}
+
+	resp.FailedWrites = append(resp.FailedWrites, s.commitBatches(writes)...)
+
+	return resp, nil
+}
+
+// batchWrite - a write of a document in a batch
+type batchWrite struct {
+	key   *document.Key
+	ref   *firestore.DocumentRef
+	write func(*firestore.WriteBatch, *firestore.DocumentRef)
+}
+
+// batchWrites - the writes of a batch, in order. A batch can't write a document more than once,
+// so a later write of the same document replaces the earlier one
+type batchWrites struct {
+	writes  []*batchWrite
+	indexes map[string]int
+}
+
+func newBatchWrites() *batchWrites {
+	return &batchWrites{
+		writes:  make([]*batchWrite, 0),
+		indexes: make(map[string]int),
+	}
+}
+
+func (b *batchWrites) add(ref *firestore.DocumentRef, key *document.Key, write func(*firestore.WriteBatch, *firestore.DocumentRef)) {
+	w := &batchWrite{key: key, ref: ref, write: write}
+
+	if i, ok := b.indexes[ref.Path]; ok {
+		b.writes[i] = w
+		return
+	}
+
+	b.indexes[ref.Path] = len(b.writes)
+	b.writes = append(b.writes, w)
+}
+
+// commitBatches - commits the writes in batches of maxBatchSize, retrying batches that fail with a transient error.
+// Returns the documents that couldn't be written
+func (s *FirestoreDocService) commitBatches(writes *batchWrites) []*document.FailedWrite {
+	failed := make([]*document.FailedWrite, 0)
+
+	for start := 0; start < len(writes.writes); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(writes.writes) {
+			end = len(writes.writes)
+		}
+		chunk := writes.writes[start:end]
+
+		var err error
+		for attempt := 0; attempt <= maxBatchRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(batchRetryDelay * time.Duration(1<<(attempt-1)))
+			}
+
+			// a batch can only be committed once, so each attempt builds a new one
+			batch := s.client.Batch()
+			for _, w := range chunk {
+				w.write(batch, w.ref)
+			}
+
+			if _, err = batch.Commit(s.context); err == nil || !retryable(err) {
+				break
+			}
+		}
+
+		if err != nil {
+			for _, w := range chunk {
+				failed = append(failed, failedWrite(w.key, "error committing batch", err))
+			}
+		}
+	}
+
+	return failed
+}
+
+// retryable - returns true if a failed commit may succeed if it's retried
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case grpcCodes.Aborted, grpcCodes.Unavailable, grpcCodes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// failedWrite - a document a batch couldn't write, with the reason and its cause if there is one
+func failedWrite(key *document.Key, msg string, err error) *document.FailedWrite {
+	if err != nil {
+		msg = fmt.Sprintf("%s: %v", msg, err)
+	}
+
+	return &document.FailedWrite{
+		Key:     key,
+		Message: msg,
+	}
+}
+
+// deleteCollections - deletes the documents of the sub collections of a document, and of their sub collections
+func (s *FirestoreDocService) deleteCollections(doc *firestore.DocumentRef) error {
+	collsIter := doc.Collections(s.context)
+	for subCol, err := collsIter.Next(); err != iterator.Done; subCol, err = collsIter.Next() {
+		if err != nil {
+			return err
+		}
+
 		// Loop over sub collection documents, performing batch deletes
 		// up to Firestore's maximum batch size
-		const maxBatchSize = 500
 		for {
 			docsIter := subCol.Limit(maxBatchSize).Documents(s.context)
 			numDeleted := 0
@@ -158,6 +562,10 @@ func (s *FirestoreDocService) Delete(key *document.Key) error {
 					return err
 				}
 
+				if err := s.deleteCollections(subDoc.Ref); err != nil {
+					return err
+				}
+
 				batch.Delete(subDoc.Ref)
 				numDeleted++
 			}
@@ -174,19 +582,9 @@ func (s *FirestoreDocService) Delete(key *document.Key) error {
 		}
 	}
 
-	// Delete document
-	if _, err := doc.Delete(s.context); err != nil {
-		return newErr(
-			codes.Internal,
-			"error deleting value",
-			err,
-		)
-	}
-
 	return nil
 }
 
-//
 func (s *FirestoreDocService) buildQuery(collection *document.Collection, expressions []document.QueryExpression, limit int) (query firestore.Query, orderBy string) {
 	// Select correct root collection to perform query on
 	query = s.getQueryRoot(collection)
@@ -201,7 +599,7 @@ func (s *FirestoreDocService) buildQuery(collection *document.Collection, expres
 			query = query.Where(expOperand, exp.Operator, exp.Value)
 		}
 
-		if exp.Operator != "==" && limit > 0 && orderBy == "" {
+		if document.IsInequalityOperator(exp.Operator) && limit > 0 && orderBy == "" {
 			query = query.OrderBy(expOperand, firestore.Asc)
 			orderBy = expOperand
 		}
@@ -222,6 +620,14 @@ func (s *FirestoreDocService) Query(collection *document.Collection, expressions
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to firestore",
+			err,
+		)
+	}
+
 	if err := document.ValidateQueryCollection(collection); err != nil {
 		return nil, newErr(
 			codes.InvalidArgument,
@@ -238,6 +644,33 @@ func (s *FirestoreDocService) Query(collection *document.Collection, expressions
 		)
 	}
 
+	// geo expressions are queried by geohash cell, then the documents outside of their area are filtered out
+	expressions, geoFilter := document.GeohashExpressions(expressions)
+
+	queries, err := disjunctions(expressions)
+	if err != nil {
+		return nil, newErr(
+			codes.Unimplemented,
+			"query not supported by firestore",
+			err,
+		)
+	}
+
+	for _, exps := range queries {
+		if err := s.plan(collection, exps); err != nil {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				"query requires an index",
+				err,
+			)
+		}
+	}
+
+	if len(queries) > 1 {
+		return s.queryDisjunctions(collection, queries, geoFilter, limit, pagingToken, newErr)
+	}
+	expressions = queries[0]
+
 	queryResult := &document.QueryResult{
 		Documents: make([]document.Document, 0),
 	}
@@ -257,21 +690,27 @@ func (s *FirestoreDocService) Query(collection *document.Collection, expressions
 		}
 	}
 
+	// pages may have fewer documents than the limit once they're filtered, so tokens continue from the last document read
+	read := 0
 	itr := query.Documents(s.context)
 	for docSnp, err := itr.Next(); err != iterator.Done; docSnp, err = itr.Next() {
 		if err != nil {
+			code, msg := queryErrorCode(err)
 			return nil, newErr(
-				codes.Internal,
-				"error querying value",
+				code,
+				msg,
 				err,
 			)
 		}
+		read++
 
-		sdkDoc := docSnpToDocument(collection, docSnp)
-		queryResult.Documents = append(queryResult.Documents, sdkDoc)
+		sdkDoc := s.docSnpToDocument(collection, docSnp)
+		if document.InCollection(sdkDoc.Key, collection) && geoFilter.Matches(&sdkDoc) {
+			queryResult.Documents = append(queryResult.Documents, sdkDoc)
+		}
 
 		// If query limit configured determine continue tokens
-		if limit > 0 && len(queryResult.Documents) == limit {
+		if limit > 0 && read == limit {
 			tokens := ""
 			if orderBy != "" {
 				tokens = fmt.Sprintf("%v", docSnp.Data()[orderBy]) + "|"
@@ -295,6 +734,17 @@ func (s *FirestoreDocService) QueryStream(collection *document.Collection, expre
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		// Return an error only iterator
+		return func() (*document.Document, error) {
+			return nil, newErr(
+				codes.Unavailable,
+				"unable to connect to firestore",
+				err,
+			)
+		}
+	}
+
 	colErr := document.ValidateQueryCollection(collection)
 	expErr := document.ValidateExpressions(expressions)
 
@@ -309,71 +759,381 @@ func (s *FirestoreDocService) QueryStream(collection *document.Collection, expre
 		}
 	}
 
-	query, _ := s.buildQuery(collection, expressions, limit)
+	expressions, geoFilter := document.GeohashExpressions(expressions)
+
+	queries, err := disjunctions(expressions)
+	if err != nil {
+		return func() (*document.Document, error) {
+			return nil, newErr(
+				codes.Unimplemented,
+				"query not supported by firestore",
+				err,
+			)
+		}
+	}
+
+	for _, exps := range queries {
+		if planErr := s.plan(collection, exps); planErr != nil {
+			return func() (*document.Document, error) {
+				return nil, newErr(
+					codes.FailedPrecondition,
+					"query requires an index",
+					planErr,
+				)
+			}
+		}
+	}
+
+	// Documents matching several of the queries an or expression is split into are only returned once
+	var iter *firestore.DocumentIterator
+	next := 0
+	seen := make(map[string]bool)
+	returned := 0
 
-	iter := query.Documents(s.context)
+	// the documents of filtered queries are counted as they're returned, rather than limited as they're read
+	queryLimit := limit
+	if geoFilter != nil {
+		queryLimit = 0
+	}
 
 	return func() (*document.Document, error) {
-		docSnp, err := iter.Next()
-		if err != nil {
-			if err == iterator.Done {
+		for {
+			if limit > 0 && returned == limit {
 				return nil, io.EOF
 			}
 
+			if iter == nil {
+				if next == len(queries) {
+					return nil, io.EOF
+				}
+
+				query, _ := s.buildQuery(collection, queries[next], queryLimit)
+				iter = query.Documents(s.context)
+				next++
+			}
+
+			docSnp, err := iter.Next()
+			if err != nil {
+				if err == iterator.Done {
+					iter = nil
+					continue
+				}
+
+				code, msg := queryErrorCode(err)
+				return nil, newErr(
+					code,
+					msg,
+					err,
+				)
+			}
+
+			if len(queries) > 1 {
+				if seen[docSnp.Ref.Path] {
+					continue
+				}
+				seen[docSnp.Ref.Path] = true
+			}
+
+			sdkDoc := s.docSnpToDocument(collection, docSnp)
+			if !document.InCollection(sdkDoc.Key, collection) || !geoFilter.Matches(&sdkDoc) {
+				continue
+			}
+
+			returned++
+			return &sdkDoc, nil
+		}
+	}
+}
+
+// maxDisjunctions - the most queries or expressions can be split into, matching firestore's own limit on disjunctions
+const maxDisjunctions = 30
+
+// disjunctions - returns the queries serving the expressions. Firestore can't query or expressions,
+// so they're split into a query for each of their groups and the matching documents are merged
+func disjunctions(expressions []document.QueryExpression) ([][]document.QueryExpression, error) {
+	queries, ok := document.Disjunctions(expressions, maxDisjunctions)
+	if !ok {
+		return nil, fmt.Errorf("or expressions can be split into at most %d queries", maxDisjunctions)
+	}
+
+	for _, exps := range queries {
+		if err := unsupported(exps); err != nil {
+			return nil, err
+		}
+	}
+
+	return queries, nil
+}
+
+// queryDisjunctions - merges the documents matching any of the queries.
+// Paged queries are ordered by document id, so each page continues every query from the last document of the previous page
+func (s *FirestoreDocService) queryDisjunctions(collection *document.Collection, queries [][]document.QueryExpression, geoFilter *document.GeoFilter, limit int, pagingToken map[string]string, newErr errors.ErrorFactory) (*document.QueryResult, error) {
+	snapshots := make(map[string]*firestore.DocumentSnapshot)
+
+	// filtered documents don't count towards the limit of a page, so a page can only include documents up to the
+	// last one read by any query that read its limit, later documents of that query are yet to be read
+	var cutoff *firestore.DocumentSnapshot
+
+	for _, exps := range queries {
+		query, orderBy := s.buildQuery(collection, exps, limit)
+		if orderBy != "" {
 			return nil, newErr(
-				codes.Internal,
-				"error querying value",
-				err,
+				codes.Unimplemented,
+				"query not supported by firestore",
+				fmt.Errorf("paged queries can't have inequality expressions on %s and or expressions, the queries they're split into can't be merged in order", orderBy),
 			)
 		}
 
-		sdkDoc := docSnpToDocument(collection, docSnp)
+		if limit > 0 {
+			query = query.OrderBy(firestore.DocumentID, firestore.Asc)
 
-		return &sdkDoc, nil
+			if token, ok := pagingToken[pagingTokens]; ok {
+				query = query.StartAfter(token)
+			}
+		}
+
+		read := 0
+		itr := query.Documents(s.context)
+		for docSnp, err := itr.Next(); err != iterator.Done; docSnp, err = itr.Next() {
+			if err != nil {
+				code, msg := queryErrorCode(err)
+				return nil, newErr(
+					code,
+					msg,
+					err,
+				)
+			}
+			read++
+
+			if limit > 0 && read == limit && (cutoff == nil || docSnp.Ref.Path < cutoff.Ref.Path) {
+				cutoff = docSnp
+			}
+
+			sdkDoc := s.docSnpToDocument(collection, docSnp)
+			if geoFilter.Matches(&sdkDoc) {
+				snapshots[docSnp.Ref.Path] = docSnp
+			}
+		}
 	}
+
+	paths := make([]string, 0, len(snapshots))
+	for path := range snapshots {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	queryResult := &document.QueryResult{
+		Documents: make([]document.Document, 0),
+	}
+
+	for _, path := range paths {
+		if cutoff != nil && path > cutoff.Ref.Path {
+			break
+		}
+		docSnp := snapshots[path]
+
+		sdkDoc := s.docSnpToDocument(collection, docSnp)
+		if !document.InCollection(sdkDoc.Key, collection) {
+			continue
+		}
+		queryResult.Documents = append(queryResult.Documents, sdkDoc)
+
+		if limit > 0 && len(queryResult.Documents) == limit {
+			queryResult.PagingToken = map[string]string{
+				pagingTokens: docSnp.Ref.ID,
+			}
+			break
+		}
+	}
+
+	if queryResult.PagingToken == nil && cutoff != nil {
+		queryResult.PagingToken = map[string]string{
+			pagingTokens: cutoff.Ref.ID,
+		}
+	}
+
+	return queryResult, nil
+}
+
+// maxListValues - the most values in, not-in and array-contains-any expressions can compare with
+const maxListValues = 10
+
+// unsupported - returns an error if the expressions combine list and array operators in ways firestore can't query.
+// Queries can have one array-contains filter, and one in, not-in or array-contains-any filter unless they have both array filters
+func unsupported(expressions []document.QueryExpression) error {
+	operators := make(map[string]int)
+	for _, exp := range expressions {
+		operators[exp.Operator]++
+
+		if values, ok := exp.Value.([]interface{}); ok && len(values) > maxListValues {
+			return fmt.Errorf("%s expressions compare at most %d values, found %d on %s", exp.Operator, maxListValues, len(values), exp.Operand)
+		}
+	}
+
+	if operators["array-contains"] > 1 {
+		return fmt.Errorf("queries can have at most one array-contains expression")
+	}
+
+	if operators["in"]+operators["not-in"]+operators["array-contains-any"] > 1 {
+		return fmt.Errorf("queries can have at most one in, not-in or array-contains-any expression")
+	}
+
+	if operators["array-contains"] > 0 && operators["array-contains-any"] > 0 {
+		return fmt.Errorf("array-contains and array-contains-any expressions can't be combined")
+	}
+
+	return nil
+}
+
+// plan - checks a declared index can serve the query.
+// Firestore automatically indexes every field, so queries filtering a single field never need a declared index.
+func (s *FirestoreDocService) plan(collection *document.Collection, expressions []document.QueryExpression) error {
+	fields := make(map[string]bool)
+	for _, exp := range expressions {
+		fields[exp.Operand] = true
+	}
+
+	if len(fields) <= 1 {
+		return nil
+	}
+
+	_, err := s.planner.Plan(collection, expressions)
+
+	return err
 }
 
-func docSnpToDocument(col *document.Collection, snp *firestore.DocumentSnapshot) document.Document {
+// queryErrorCode - returns the error code and message for a failed query.
+// Firestore fails queries without a matching composite index, the error includes a link to create it.
+func queryErrorCode(err error) (codes.Code, string) {
+	if status.Code(err) == grpcCodes.FailedPrecondition {
+		return codes.FailedPrecondition, "query requires a composite index, create it or declare it with DOCUMENT_INDEXES"
+	}
+
+	return codes.Internal, "error querying value"
+}
+
+func (s *FirestoreDocService) docSnpToDocument(col *document.Collection, snp *firestore.DocumentSnapshot) document.Document {
+	content := snp.Data()
+	document.StripGeohashes(content)
+
 	sdkDoc := document.Document{
-		Content: snp.Data(),
+		Content: content,
 		Key: &document.Key{
 			Collection: col,
 			Id:         snp.Ref.ID,
 		},
 	}
 
-	if p := snp.Ref.Parent.Parent; p != nil {
-		sdkDoc.Key.Collection = &document.Collection{
-			Name: col.Name,
-			Parent: &document.Key{
-				Collection: col.Parent.Collection,
-				Id:         p.ID,
-			},
-		}
+	if col.Parent != nil {
+		sdkDoc.Key = s.refToKey(snp.Ref)
 	}
 
 	return sdkDoc
 }
 
-func New() (document.DocumentService, error) {
+// refToKey - returns the key of a document from its path of parent documents and collections
+func (s *FirestoreDocService) refToKey(ref *firestore.DocumentRef) *document.Key {
+	key := &document.Key{
+		Collection: &document.Collection{Name: ref.Parent.ID},
+		Id:         ref.ID,
+	}
+
+	if ref.Parent.Parent != nil {
+		key.Collection.Parent = s.refToKey(ref.Parent.Parent)
+	} else {
+		// Top level collections of other environments keep their qualified name, so they don't match the queried collection
+		key.Collection.Name = strings.TrimPrefix(ref.Parent.ID, s.identity.Qualify(""))
+	}
+
+	return key
+}
+
+// collectionName - returns the name of a top level collection, qualified with the stack and environment
+func (s *FirestoreDocService) collectionName(name string) string {
+	return s.identity.Qualify(name)
+}
+
+// Aggregate - the firestore client doesn't support aggregation queries, so the matching documents are aggregated as they're streamed
+func (s *FirestoreDocService) Aggregate(collection *document.Collection, expressions []document.QueryExpression, aggregations []document.Aggregation) ([]document.AggregateResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"FirestoreDocService.Aggregate",
+		map[string]interface{}{
+			"collection": collection,
+		},
+	)
+
+	if err := document.ValidateAggregations(aggregations); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid aggregations",
+			err,
+		)
+	}
+
+	// errors returned by the stream are already scoped to the query
+	return document.AggregateIterator(s.QueryStream(collection, expressions, 0), aggregations)
+}
+
+func New(provider core.GcpProvider) (document.DocumentService, error) {
 	ctx := context.Background()
 
-	credentials, credentialsError := google.FindDefaultCredentials(ctx, pubsub.ScopeCloudPlatform)
-	if credentialsError != nil {
-		return nil, fmt.Errorf("GCP credentials error: %v", credentialsError)
+	planner, err := document.QueryPlannerFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
-	client, clientError := firestore.NewClient(ctx, credentials.ProjectID)
-	if clientError != nil {
-		return nil, fmt.Errorf("firestore client error: %v", clientError)
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	return &FirestoreDocService{
-		client:  client,
-		context: ctx,
+		provider: provider,
+		context:  ctx,
+		planner:  planner,
+		identity: identity,
 	}, nil
 }
 
+// documentPermissions - the IAM permissions needed for nitric actions on a collection.
+// Firestore documents don't have their own IAM policies, so they're granted on the project
+var documentPermissions = map[resources.Action][]string{
+	resources.CollectionDocumentRead:   {"datastore.entities.get"},
+	resources.CollectionDocumentWrite:  {"datastore.entities.create", "datastore.entities.update"},
+	resources.CollectionDocumentDelete: {"datastore.entities.delete"},
+	resources.CollectionQuery:          {"datastore.entities.list"},
+	resources.CollectionList:           {"datastore.entities.list"},
+}
+
+// CheckPermissions - tests the IAM permissions needed for the nitric actions on the collection's project
+func (s *FirestoreDocService) CheckPermissions(collection string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("permissions can only be checked with a provider")
+	}
+
+	projectId, err := s.provider.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.provider.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := core.NewProjectPermissionTester(s.context, credentials, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, documentPermissions[a]...)
+	}
+
+	return core.TestPermissions(project, "projects/"+projectId, needed)
+}
+
 func NewWithClient(client *firestore.Client, ctx context.Context) (document.DocumentService, error) {
 	return &FirestoreDocService{
 		client:  client,
@@ -382,33 +1142,30 @@ func NewWithClient(client *firestore.Client, ctx context.Context) (document.Docu
 }
 
 func (s *FirestoreDocService) getDocRef(key *document.Key) *firestore.DocumentRef {
-	parentKey := key.Collection.Parent
+	path := document.KeyPath(key)
 
-	if parentKey == nil {
-		return s.client.Collection(key.Collection.Name).Doc(key.Id)
-	} else {
-		return s.client.Collection(parentKey.Collection.Name).
-			Doc(parentKey.Id).
-			Collection(key.Collection.Name).
-			Doc(key.Id)
+	doc := s.client.Collection(s.collectionName(path[0].Collection.Name)).Doc(path[0].Id)
+	for _, k := range path[1:] {
+		doc = doc.Collection(k.Collection.Name).Doc(k.Id)
 	}
+
+	return doc
 }
 
 func (s *FirestoreDocService) getQueryRoot(collection *document.Collection) firestore.Query {
 	parentKey := collection.Parent
 
 	if parentKey == nil {
-		return s.client.Collection(collection.Name).Offset(0)
-	} else {
-		if parentKey.Id != "" {
-			return s.client.Collection(parentKey.Collection.Name).
-				Doc(parentKey.Id).
-				Collection(collection.Name).
-				Offset(0)
-		} else {
-			// Note there is a risk of subcollection name collison
+		return s.client.Collection(s.collectionName(collection.Name)).Offset(0)
+	}
+
+	for _, k := range document.KeyPath(parentKey) {
+		if k.Id == "" {
+			// Note there is a risk of subcollection name collison, query results are filtered by their parent collections
 			// TODO: future YAML validation could help mitigate this
 			return s.client.CollectionGroup(collection.Name).Offset(0)
 		}
 	}
+
+	return s.getDocRef(parentKey).Collection(collection.Name).Offset(0)
 }
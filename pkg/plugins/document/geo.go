@@ -0,0 +1,362 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// GeoRadiusOperator - matches documents whose location field is within a distance of a point, its value is a GeoRadius
+	GeoRadiusOperator = "within-radius"
+	// GeoBoxOperator - matches documents whose location field is within a latitude and longitude box, its value is a GeoBox
+	GeoBoxOperator = "within-box"
+	// GeohashPrecision - the length of the longest geohash cell stored for a location, about 5m across
+	GeohashPrecision = 9
+	// maxGeohashCells - the most cells a geo expression is translated to, within firestore's limit on array-contains-any values
+	maxGeohashCells = 9
+	// EarthRadius - mean radius of the earth in meters, converts distances to the radians of spherical queries
+	EarthRadius = 6371008.8
+	// metersPerDegree - meters per degree of latitude, and of longitude at the equator
+	metersPerDegree = 111320.0
+	geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+// GeoPoint - a location, stored in documents as a map of its "lat" and "lng"
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// GeoRadius - the area within a distance of a point
+type GeoRadius struct {
+	Center GeoPoint
+	Meters float64
+}
+
+// GeoBox - the area between two latitudes and two longitudes, boxes can't cross the antimeridian
+type GeoBox struct {
+	SouthWest GeoPoint
+	NorthEast GeoPoint
+}
+
+// IsGeoOperator - returns true if the operator matches documents by the location in a field
+func IsGeoOperator(operator string) bool {
+	return operator == GeoRadiusOperator || operator == GeoBoxOperator
+}
+
+// GeohashField - the field the geohash cells of a location field are stored in, by providers that query locations by geohash
+func GeohashField(field string) string {
+	return field + "_geohashes"
+}
+
+// ParseGeoPoint - returns the location held by a document value, a map of exactly a numeric "lat" and "lng"
+func ParseGeoPoint(value interface{}) (GeoPoint, bool) {
+	m, ok := value.(map[string]interface{})
+	if !ok || len(m) != 2 {
+		return GeoPoint{}, false
+	}
+
+	lat, latOk := numericValue(m["lat"])
+	lng, lngOk := numericValue(m["lng"])
+	if !latOk || !lngOk || !validPoint(GeoPoint{Lat: lat, Lng: lng}) {
+		return GeoPoint{}, false
+	}
+
+	return GeoPoint{Lat: lat, Lng: lng}, true
+}
+
+// GeoPoints - returns the locations held by the top level fields of a document, by field
+func GeoPoints(content map[string]interface{}) map[string]GeoPoint {
+	points := make(map[string]GeoPoint)
+	for field, value := range content {
+		if p, ok := ParseGeoPoint(value); ok {
+			points[field] = p
+		}
+	}
+
+	return points
+}
+
+// EncodeGeohashes - returns the content with the geohash cells of each of its locations, from the largest cell to the smallest,
+// stored in the location's GeohashField. Content without locations is returned as is
+func EncodeGeohashes(content map[string]interface{}) map[string]interface{} {
+	points := GeoPoints(content)
+	if len(points) == 0 {
+		return content
+	}
+
+	encoded := make(map[string]interface{}, len(content)+len(points))
+	for k, v := range content {
+		encoded[k] = v
+	}
+
+	for field, p := range points {
+		hash := Geohash(p, GeohashPrecision)
+
+		cells := make([]interface{}, 0, len(hash))
+		for i := 1; i <= len(hash); i++ {
+			cells = append(cells, hash[:i])
+		}
+		encoded[GeohashField(field)] = cells
+	}
+
+	return encoded
+}
+
+// StripGeohashes - removes the geohash cells stored by EncodeGeohashes from the content
+func StripGeohashes(content map[string]interface{}) {
+	for field := range GeoPoints(content) {
+		delete(content, GeohashField(field))
+	}
+}
+
+// Geohash - returns the geohash of the cell of the given length containing the point
+func Geohash(p GeoPoint, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	hash := make([]byte, 0, precision)
+	bit, ch := 0, 0
+	even := true
+	for len(hash) < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if p.Lng >= mid {
+				ch |= 1 << (4 - bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if p.Lat >= mid {
+				ch |= 1 << (4 - bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			hash = append(hash, geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return string(hash)
+}
+
+// cellSize - the height and width in degrees of geohash cells of the given length
+func cellSize(precision int) (float64, float64) {
+	bits := 5 * precision
+	lngBits := (bits + 1) / 2
+	latBits := bits / 2
+
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lngBits))
+}
+
+// bounds - the box containing the area of a geo expression's value
+func bounds(value interface{}) GeoBox {
+	switch v := value.(type) {
+	case GeoRadius:
+		dLat := v.Meters / metersPerDegree
+		south := math.Max(v.Center.Lat-dLat, -90)
+		north := math.Min(v.Center.Lat+dLat, 90)
+
+		// circles reaching a pole, or too wide to bound by longitude, cover every longitude
+		west, east := -180.0, 180.0
+		if cos := math.Cos(math.Max(math.Abs(south), math.Abs(north)) * math.Pi / 180); north < 90 && south > -90 && cos > 0 {
+			if dLng := v.Meters / (metersPerDegree * cos); dLng < 180 {
+				west = math.Max(v.Center.Lng-dLng, -180)
+				east = math.Min(v.Center.Lng+dLng, 180)
+			}
+		}
+
+		return GeoBox{SouthWest: GeoPoint{Lat: south, Lng: west}, NorthEast: GeoPoint{Lat: north, Lng: east}}
+	case GeoBox:
+		return v
+	}
+
+	return GeoBox{}
+}
+
+// GeohashCells - returns the smallest geohash cells, at most maxGeohashCells of the same length, covering the area of a geo expression.
+// Returns false if the area is too large to cover with that many cells, in which case every location has to be matched
+func GeohashCells(value interface{}) ([]string, bool) {
+	box := bounds(value)
+
+	for precision := GeohashPrecision; precision > 0; precision-- {
+		height, width := cellSize(precision)
+
+		// cells are aligned to multiples of their size from the south west corner of the world
+		south := math.Floor((box.SouthWest.Lat + 90) / height)
+		north := math.Min(math.Floor((box.NorthEast.Lat+90)/height), 180/height-1)
+		west := math.Floor((box.SouthWest.Lng + 180) / width)
+		east := math.Min(math.Floor((box.NorthEast.Lng+180)/width), 360/width-1)
+
+		if (north-south+1)*(east-west+1) > maxGeohashCells {
+			continue
+		}
+
+		cells := make([]string, 0, maxGeohashCells)
+		for row := south; row <= north; row++ {
+			for col := west; col <= east; col++ {
+				// the center of the cell
+				cells = append(cells, Geohash(GeoPoint{
+					Lat: (row+0.5)*height - 90,
+					Lng: (col+0.5)*width - 180,
+				}, precision))
+			}
+		}
+
+		return cells, true
+	}
+
+	return nil, false
+}
+
+// Distance - returns the great circle distance between two points in meters
+func Distance(a GeoPoint, b GeoPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// GeoMatches - returns true if the location in the field of the content is within the area of a geo expression
+func GeoMatches(exp QueryExpression, content map[string]interface{}) bool {
+	p, ok := ParseGeoPoint(content[exp.Operand])
+	if !ok {
+		return false
+	}
+
+	switch v := exp.Value.(type) {
+	case GeoRadius:
+		return Distance(v.Center, p) <= v.Meters
+	case GeoBox:
+		return p.Lat >= v.SouthWest.Lat && p.Lat <= v.NorthEast.Lat && p.Lng >= v.SouthWest.Lng && p.Lng <= v.NorthEast.Lng
+	}
+
+	return false
+}
+
+// GeoFilter - matches documents against the geo expression of a query, for providers that query the geohash cells covering
+// the expression's area, which also hold locations outside of it. A nil filter matches every document
+type GeoFilter struct {
+	expression QueryExpression
+}
+
+// Matches - returns true if the document's location is within the area of the filter's expression
+func (f *GeoFilter) Matches(doc *Document) bool {
+	if f == nil {
+		return true
+	}
+
+	return GeoMatches(f.expression, doc.Content)
+}
+
+// Filter - returns the documents whose location is within the area of the filter's expression
+func (f *GeoFilter) Filter(docs []Document) []Document {
+	if f == nil {
+		return docs
+	}
+
+	filtered := make([]Document, 0, len(docs))
+	for _, doc := range docs {
+		if f.Matches(&doc) {
+			filtered = append(filtered, doc)
+		}
+	}
+
+	return filtered
+}
+
+// GeohashExpressions - translates a query's geo expression to an array-contains-any expression of the geohash cells covering
+// its area, returning a filter for the documents the cells hold outside of the area. Expressions should be validated first
+func GeohashExpressions(expressions []QueryExpression) ([]QueryExpression, *GeoFilter) {
+	translated := make([]QueryExpression, 0, len(expressions))
+	var filter *GeoFilter
+
+	for _, exp := range expressions {
+		if !IsGeoOperator(exp.Operator) {
+			translated = append(translated, exp)
+			continue
+		}
+
+		filter = &GeoFilter{expression: exp}
+
+		// areas too large to cover are matched against every document with a location in the field
+		cells, ok := GeohashCells(exp.Value)
+		if !ok {
+			continue
+		}
+
+		values := make([]interface{}, 0, len(cells))
+		for _, c := range cells {
+			values = append(values, c)
+		}
+
+		translated = append(translated, QueryExpression{
+			Operand:  GeohashField(exp.Operand),
+			Operator: "array-contains-any",
+			Value:    values,
+		})
+	}
+
+	return translated, filter
+}
+
+func validPoint(p GeoPoint) bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// validateGeoExpression - validates the area of a geo expression
+func validateGeoExpression(exp QueryExpression) error {
+	switch v := exp.Value.(type) {
+	case GeoRadius:
+		if exp.Operator != GeoRadiusOperator {
+			break
+		}
+		if !validPoint(v.Center) {
+			return fmt.Errorf("provide a center with a latitude between -90 and 90 and a longitude between -180 and 180: %v", exp)
+		}
+		if v.Meters <= 0 {
+			return fmt.Errorf("provide a positive radius: %v", exp)
+		}
+		return nil
+	case GeoBox:
+		if exp.Operator != GeoBoxOperator {
+			break
+		}
+		if !validPoint(v.SouthWest) || !validPoint(v.NorthEast) {
+			return fmt.Errorf("provide corners with latitudes between -90 and 90 and longitudes between -180 and 180: %v", exp)
+		}
+		if v.SouthWest.Lat > v.NorthEast.Lat || v.SouthWest.Lng > v.NorthEast.Lng {
+			return fmt.Errorf("provide a south west corner below and left of the north east corner, boxes can't cross the antimeridian: %v", exp)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("provide a radius value for the %s operator or a box value for the %s operator: %v", GeoRadiusOperator, GeoBoxOperator, exp)
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ConditionFailed - the message of the FailedPrecondition errors returned when a CompareAndSet isn't applied
+const ConditionFailed = "condition failed"
+
+func validateTopLevelField(field string, operation string) error {
+	if field == "" {
+		return fmt.Errorf("provide non-blank field")
+	}
+	if strings.Contains(field, ".") {
+		return fmt.Errorf("field cannot contain ., only top level fields can be %s", operation)
+	}
+	return nil
+}
+
+// ValidateIncrementField - validates the field of an increment, only top level fields of a document can be incremented
+func ValidateIncrementField(field string) error {
+	return validateTopLevelField(field, "incremented")
+}
+
+// ValidateCompareField - validates the field of a compare and set, only top level fields of a document can be compared
+func ValidateCompareField(field string) error {
+	return validateTopLevelField(field, "compared")
+}
+
+// FieldEquals - returns true if the value of a field is the expected integer, missing fields are zero.
+// Used by providers that read the field before writing the document
+func FieldEquals(current interface{}, expected int64) bool {
+	value, err := IncrementedValue(current, 0)
+	return err == nil && value == expected
+}
+
+// IncrementedValue - returns the value of a field after adding delta to it, missing fields count from zero.
+// Used by providers that read the field before writing it, and to read back the values providers return
+func IncrementedValue(current interface{}, delta int64) (int64, error) {
+	var value int64
+	switch v := current.(type) {
+	case nil:
+	case int:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	case float64:
+		// documents decoded from JSON hold every number as a float
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("field value %v is not an integer", v)
+		}
+		value = int64(v)
+	default:
+		return 0, fmt.Errorf("field value %v is not an integer", v)
+	}
+
+	if (delta > 0 && value > math.MaxInt64-delta) || (delta < 0 && value < math.MinInt64-delta) {
+		return 0, fmt.Errorf("incrementing %d by %d overflows", value, delta)
+	}
+
+	return value + delta, nil
+}
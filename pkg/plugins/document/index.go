@@ -0,0 +1,269 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// Index - a secondary index declared for a collection
+type Index struct {
+	Collection string
+	Name       string
+	// Fields - the indexed fields, in order.
+	// Queries served by the index filter on a prefix of these fields, by equality on all but the last.
+	// The first field partitions the index, so it must always be filtered by equality.
+	Fields []string
+}
+
+func (i *Index) String() string {
+	return fmt.Sprintf("%s.%s=%s", i.Collection, i.Name, strings.Join(i.Fields, "+"))
+}
+
+// coverage - returns the number of index fields used to serve the expressions, or zero if the index can't serve them
+func (i *Index) coverage(expressions []QueryExpression) int {
+	operators := make(map[string][]string)
+	for _, exp := range expressions {
+		operators[exp.Operand] = append(operators[exp.Operand], exp.Operator)
+	}
+
+	used := 0
+	for _, field := range i.Fields {
+		ops, ok := operators[field]
+		if !ok {
+			break
+		}
+
+		used++
+		delete(operators, field)
+
+		equality := true
+		for _, op := range ops {
+			if op != "==" {
+				equality = false
+			}
+		}
+
+		if !equality {
+			// The partition field can't be filtered by range
+			if used == 1 {
+				return 0
+			}
+			// Only the last field used by a query may be filtered by range
+			break
+		}
+	}
+
+	if len(operators) > 0 {
+		return 0
+	}
+
+	return used
+}
+
+// QueryPlan - how a query will be served
+type QueryPlan struct {
+	// Index - the secondary index that serves the query, nil if the query isn't served by a secondary index
+	Index *Index
+	// Scan - true if every document in the collection must be read to serve the query
+	Scan bool
+}
+
+// declaredName - returns the name a root collection's indexes are declared with, see SetDeclaredCollectionNames
+var declaredName = func(name string) string {
+	return name
+}
+
+// SetDeclaredCollectionNames - sets how the names root collections are stored with map to the names their indexes are declared with,
+// for root collections that are namespaced e.g. by tenant. Names are declared as they're stored by default
+func SetDeclaredCollectionNames(f func(name string) string) {
+	declaredName = f
+}
+
+// QueryPlanner - chooses the secondary index used to serve each query
+type QueryPlanner struct {
+	indexes []*Index
+	// strict planners refuse queries that can only be served by scanning the collection
+	strict bool
+}
+
+// Indexes - returns the declared secondary indexes
+func (p *QueryPlanner) Indexes() []*Index {
+	if p == nil {
+		return nil
+	}
+
+	return p.indexes
+}
+
+// Plan - returns how a query on the collection will be served.
+// Queries on a sub-collection of a known top level document are served by the document's key, otherwise filtered queries require a secondary index.
+// An error is returned if the planner is strict and no declared index can serve the query.
+func (p *QueryPlanner) Plan(collection *Collection, expressions []QueryExpression) (*QueryPlan, error) {
+	if len(expressions) == 0 || (collection.Parent != nil && RootKey(collection.Parent).Id != "") {
+		return &QueryPlan{}, nil
+	}
+
+	name := collection.Name
+	if collection.Parent == nil {
+		name = declaredName(name)
+	}
+
+	var best *Index
+	bestCoverage := 0
+	for _, index := range p.Indexes() {
+		if index.Collection != name {
+			continue
+		}
+
+		if coverage := index.coverage(expressions); coverage > bestCoverage {
+			best = index
+			bestCoverage = coverage
+		}
+	}
+
+	if best != nil {
+		return &QueryPlan{Index: best}, nil
+	}
+
+	if p != nil && p.strict {
+		if hasOr(expressions) {
+			return nil, fmt.Errorf("query on collection %s can't be served efficiently, or expressions can't be served by an index", collection.Name)
+		}
+
+		if !hasEquality(expressions) {
+			return nil, fmt.Errorf("query on collection %s can't be served efficiently, indexed queries must filter at least one field by equality", collection.Name)
+		}
+
+		return nil, fmt.Errorf("query on collection %s can't be served efficiently, declare an index for it e.g. DOCUMENT_INDEXES=%s", collection.Name, suggestIndex(collection.Name, expressions))
+	}
+
+	return &QueryPlan{Scan: true}, nil
+}
+
+func hasEquality(expressions []QueryExpression) bool {
+	for _, exp := range expressions {
+		if exp.Operator == "==" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasOr(expressions []QueryExpression) bool {
+	for _, exp := range expressions {
+		if exp.Operator == OrOperator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggestIndex - returns an index declaration able to serve the expressions, equality filters first
+func suggestIndex(collection string, expressions []QueryExpression) string {
+	fields := make([]string, 0)
+	rangeFields := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, exp := range expressions {
+		if exp.Operator != "==" && !seen[exp.Operand] {
+			rangeFields = append(rangeFields, exp.Operand)
+			seen[exp.Operand] = true
+		}
+	}
+
+	for _, exp := range expressions {
+		if !seen[exp.Operand] {
+			fields = append(fields, exp.Operand)
+			seen[exp.Operand] = true
+		}
+	}
+
+	fields = append(fields, rangeFields...)
+
+	return (&Index{
+		Collection: collection,
+		Name:       "by-" + strings.Join(fields, "-"),
+		Fields:     fields,
+	}).String()
+}
+
+// ParseIndexes - parses a comma separated list of secondary index declarations
+// in the format <collection>.<index name>=<field>[+<field>...]
+// e.g. "orders.by-status=status+created,users.by-email=email"
+func ParseIndexes(config string) ([]*Index, error) {
+	indexes := make([]*Index, 0)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		names := strings.SplitN(parts[0], ".", 2)
+		if len(parts) != 2 || len(names) != 2 || names[0] == "" || names[1] == "" {
+			return nil, fmt.Errorf("invalid index declaration %s, expected <collection>.<index name>=<field>[+<field>...]", entry)
+		}
+
+		index := &Index{
+			Collection: strings.TrimSpace(names[0]),
+			Name:       strings.TrimSpace(names[1]),
+			Fields:     make([]string, 0),
+		}
+
+		for _, field := range strings.Split(parts[1], "+") {
+			if field = strings.TrimSpace(field); field == "" {
+				return nil, fmt.Errorf("invalid index declaration %s, index fields must not be blank", entry)
+			}
+			index.Fields = append(index.Fields, field)
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
+// NewQueryPlanner - creates a query planner for the declared indexes.
+// A strict planner returns an error for filtered queries that no index can serve, rather than scanning the collection.
+func NewQueryPlanner(indexes []*Index, strict bool) *QueryPlanner {
+	return &QueryPlanner{
+		indexes: indexes,
+		strict:  strict,
+	}
+}
+
+// QueryPlannerFromEnv - creates a query planner for the indexes declared in the DOCUMENT_INDEXES env var
+func QueryPlannerFromEnv() (*QueryPlanner, error) {
+	indexes, err := ParseIndexes(utils.GetEnv("DOCUMENT_INDEXES", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DOCUMENT_INDEXES env var: %v", err)
+	}
+
+	strictEnv := utils.GetEnv("DOCUMENT_STRICT_QUERIES", "false")
+	strict, err := strconv.ParseBool(strictEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DOCUMENT_STRICT_QUERIES env var, expected true or false, got %v", strictEnv)
+	}
+
+	return NewQueryPlanner(indexes, strict), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import "io"
+
+// StreamPageSize - the most documents read from a provider at a time when streaming the pages of query results
+const StreamPageSize = 100
+
+// PageQuery - queries a page of at most limit documents, continuing from the paging token of the previous page
+type PageQuery = func(limit int, pagingToken map[string]string) (*QueryResult, error)
+
+// PagedIterator - returns an iterator over the documents of every page of query results, reading at most pageSize documents at a time
+// so memory use stays flat however many documents match. A limit above zero ends the iteration after that many documents
+func PagedIterator(query PageQuery, pageSize int, limit int) DocumentIterator {
+	var documents []Document
+	var pagingToken map[string]string
+	fetched := false
+	returned := 0
+
+	return func() (*Document, error) {
+		// pages may be empty but continue, e.g. when every document a page read was filtered out
+		for len(documents) == 0 {
+			if limit > 0 && returned >= limit {
+				return nil, io.EOF
+			}
+
+			if fetched && len(pagingToken) == 0 {
+				return nil, io.EOF
+			}
+
+			size := pageSize
+			if limit > 0 && limit-returned < size {
+				size = limit - returned
+			}
+
+			res, err := query(size, pagingToken)
+			if err != nil {
+				return nil, err
+			}
+
+			fetched = true
+			documents = res.Documents
+			pagingToken = res.PagingToken
+		}
+
+		if limit > 0 && returned >= limit {
+			return nil, io.EOF
+		}
+
+		var doc Document
+		doc, documents = documents[0], documents[1:]
+		returned++
+
+		return &doc, nil
+	}
+}
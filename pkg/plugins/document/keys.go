@@ -0,0 +1,160 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document
+
+import "strings"
+
+// sortKeyDelimiter - separates the collection names and ids encoded in a sort key
+const sortKeyDelimiter = "#"
+
+// KeyPath - returns the keys of the documents a key is nested under, from the top level document down, followed by the key itself
+func KeyPath(key *Key) []*Key {
+	path := make([]*Key, 0)
+	for k := key; k != nil; k = k.Collection.Parent {
+		path = append([]*Key{k}, path...)
+	}
+
+	return path
+}
+
+// RootKey - returns the key of the top level document a key is nested under, or the key itself if it's a top level document
+func RootKey(key *Key) *Key {
+	return KeyPath(key)[0]
+}
+
+// SortKey - returns the sort key of a document stored in a single table, partitioned by the id of its top level document.
+// Top level documents have the sort key "<collection>#", nested documents the names of the collections below the top level
+// joined with SubcollectionDelimiter followed by the ids of their documents, e.g. "items+parts#<item id>#<part id>"
+func SortKey(key *Key) string {
+	path := KeyPath(key)
+	if len(path) == 1 {
+		return key.Collection.Name + sortKeyDelimiter
+	}
+
+	names := make([]string, 0, len(path)-1)
+	ids := make([]string, 0, len(path)-1)
+	for _, k := range path[1:] {
+		names = append(names, k.Collection.Name)
+		ids = append(ids, k.Id)
+	}
+
+	return strings.Join(names, SubcollectionDelimiter) + sortKeyDelimiter + strings.Join(ids, sortKeyDelimiter)
+}
+
+// SortKeyPrefix - returns the prefix shared by the sort keys of the documents in a sub-collection.
+// The prefix includes the ids of the parents below the top level document until the first blank id,
+// so sub-collections with blank parent ids may match documents in other sub-collections, see InCollection.
+func SortKeyPrefix(collection *Collection) string {
+	if collection.Parent == nil {
+		return collection.Name + sortKeyDelimiter
+	}
+
+	parents := KeyPath(collection.Parent)[1:]
+
+	names := make([]string, 0, len(parents)+1)
+	for _, k := range parents {
+		names = append(names, k.Collection.Name)
+	}
+	names = append(names, collection.Name)
+
+	prefix := strings.Join(names, SubcollectionDelimiter) + sortKeyDelimiter
+	for _, k := range parents {
+		if k.Id == "" {
+			break
+		}
+		prefix += k.Id + sortKeyDelimiter
+	}
+
+	return prefix
+}
+
+// KeyFromSortKey - returns the key of a document stored in a single table from its partition and sort keys, see SortKey
+func KeyFromSortKey(rootCollection string, partitionKey string, sortKey string) *Key {
+	key := &Key{
+		Collection: &Collection{Name: rootCollection},
+		Id:         partitionKey,
+	}
+
+	parts := strings.SplitN(sortKey, sortKeyDelimiter, 2)
+	if len(parts) < 2 || parts[1] == "" {
+		// top level document
+		return key
+	}
+
+	names := strings.Split(parts[0], SubcollectionDelimiter)
+	ids := strings.SplitN(parts[1], sortKeyDelimiter, len(names))
+
+	for i, name := range names {
+		id := ""
+		if i < len(ids) {
+			id = ids[i]
+		}
+
+		key = &Key{
+			Collection: &Collection{
+				Name:   name,
+				Parent: key,
+			},
+			Id: id,
+		}
+	}
+
+	return key
+}
+
+// InCollection - returns true if the key is in the collection, blank parent ids of the collection match any parent document
+func InCollection(key *Key, collection *Collection) bool {
+	c := key.Collection
+	for c != nil && collection != nil {
+		if c.Name != collection.Name {
+			return false
+		}
+
+		if (c.Parent == nil) != (collection.Parent == nil) {
+			return false
+		}
+
+		if c.Parent == nil {
+			return true
+		}
+
+		if collection.Parent.Id != "" && c.Parent.Id != collection.Parent.Id {
+			return false
+		}
+
+		c = c.Parent.Collection
+		collection = collection.Parent.Collection
+	}
+
+	return c == nil && collection == nil
+}
+
+// IsDescendant - returns true if the key is nested under the ancestor key
+func IsDescendant(key *Key, ancestor *Key) bool {
+	path := KeyPath(key)
+	ancestors := KeyPath(ancestor)
+
+	if len(path) <= len(ancestors) {
+		return false
+	}
+
+	for i, a := range ancestors {
+		if path[i].Id != a.Id || path[i].Collection.Name != a.Collection.Name {
+			return false
+		}
+	}
+
+	return true
+}
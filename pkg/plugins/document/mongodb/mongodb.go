@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -37,10 +39,19 @@ const (
 	mongoDBConnectionStringEnvVarName = "MONGODB_CONNECTION_STRING"
 	mongoDBDatabaseEnvVarName         = "MONGODB_DATABASE"
 	mongoDBSetDirectEnvVarName        = "MONGODB_DIRECT"
+	mongoDBRequestChargeEnvVarName    = "MONGODB_REQUEST_CHARGE"
+
+	// paging token keys, of the key of the last document of a page and the value of its order by field
+	pagingTokens = "pagingTokens"
+	orderByToken = "orderBy"
 
 	primaryKeyAttr = "_id"
 	parentKeyAttr  = "_parent_id"
 	childrenAttr   = "_child_colls"
+	// ancestorsAttr - ids of the parents above a document's immediate parent, from the top level document down
+	ancestorsAttr = "_ancestor_ids"
+	// locationAttrPrefix - prefixes the field names of the legacy coordinate pairs, [lng, lat], of the locations in a document
+	locationAttrPrefix = "_lnglat_"
 )
 
 // Mapping to mongo operators, startsWith will be handled within the function
@@ -51,15 +62,36 @@ var mongoOperatorMap = map[string]string{
 	"!=": "$ne",
 	">=": "$gte",
 	">":  "$gt",
+	"in": "$in",
+	// applied to the elements of arrays
+	"array-contains":     "$eq",
+	"array-contains-any": "$in",
 }
 
 type MongoDocService struct {
 	client  *mongo.Client
 	db      *mongo.Database
 	context context.Context
+	// chargeDb - the database of a single connection client that queries are run with to report their request charges,
+	// nil if they're not reported
+	chargeDb   *mongo.Database
+	chargeLock sync.Mutex
 	document.UnimplementedDocumentPlugin
 }
 
+// lastRequestCharge - the request units Cosmos DB charged for the last request on the charge client's connection, 0 if unknown
+func (s *MongoDocService) lastRequestCharge() float64 {
+	var stats struct {
+		RequestCharge float64 `bson:"RequestCharge"`
+	}
+
+	if err := s.chargeDb.RunCommand(s.context, bson.D{{Key: "getLastRequestStatistics", Value: 1}}).Decode(&stats); err != nil {
+		return 0
+	}
+
+	return stats.RequestCharge
+}
+
 func (s *MongoDocService) Get(key *document.Key) (*document.Document, error) {
 	newErr := errors.ErrorsWithScope(
 		"MongoDocService.Get",
@@ -84,7 +116,7 @@ func (s *MongoDocService) Get(key *document.Key) (*document.Document, error) {
 	opts := options.FindOne()
 
 	// Remove meta data ids and child colls
-	opts.SetProjection(bson.M{primaryKeyAttr: 0, parentKeyAttr: 0, childrenAttr: 0})
+	opts.SetProjection(bson.M{primaryKeyAttr: 0, parentKeyAttr: 0, childrenAttr: 0, ancestorsAttr: 0})
 
 	err := col.FindOne(s.context, docRef, opts).Decode(&value)
 	if err != nil {
@@ -203,19 +235,12 @@ func (s *MongoDocService) Delete(key *document.Key) error {
 
 	// Delete all the child collection documents
 	if deletedDocument[childrenAttr] != nil {
-		children := deletedDocument[childrenAttr].(primitive.A)
-
-		for _, v := range children {
-			colName := v.(string)
-			childCol := s.db.Collection(colName)
-			_, err := childCol.DeleteMany(s.context, bson.D{{parentKeyAttr, key.Id}})
-			if err != nil {
-				return newErr(
-					codes.Internal,
-					"error deleting child collection value",
-					err,
-				)
-			}
+		if err := s.deleteChildren(key, deletedDocument[childrenAttr].(primitive.A)); err != nil {
+			return newErr(
+				codes.Internal,
+				"error deleting child collection value",
+				err,
+			)
 		}
 	}
 
@@ -234,37 +259,258 @@ func (s *MongoDocService) Delete(key *document.Key) error {
 	return nil
 }
 
-func (s *MongoDocService) getCursor(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (cursor *mongo.Cursor, orderBy string, err error) {
-	coll := s.getCollection(&document.Key{Collection: collection})
+// SetBatch - sets each document in turn, MongoDB writes aren't batched
+func (s *MongoDocService) SetBatch(docs []document.Document) (*document.BatchResponse, error) {
+	return document.SetEach(s.Set, docs), nil
+}
 
-	query := bson.M{}
+// DeleteBatch - deletes each document in turn, MongoDB writes aren't batched
+func (s *MongoDocService) DeleteBatch(keys []*document.Key) (*document.BatchResponse, error) {
+	return document.DeleteEach(s.Delete, keys), nil
+}
 
-	opts := options.Find()
+// Increment - increments the field with $inc, upserting the document
+func (s *MongoDocService) Increment(key *document.Key, field string, delta int64) (int64, error) {
+	newErr := errors.ErrorsWithScope(
+		"MongoDocService.Increment",
+		map[string]interface{}{
+			"key":   key,
+			"field": field,
+		},
+	)
 
-	opts.SetProjection(bson.M{childrenAttr: 0})
+	if err := document.ValidateKey(key); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
 
-	if limit > 0 {
-		opts.SetLimit(int64(limit))
+	if err := document.ValidateIncrementField(field); err != nil {
+		return 0, newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
 
-		if len(pagingToken) > 0 {
-			opts.SetSort(bson.D{{primaryKeyAttr, 1}})
+	coll := s.getCollection(key)
 
-			if tokens, ok := pagingToken["pagingTokens"]; ok {
-				var vals []interface{}
-				for _, v := range strings.Split(tokens, "|") {
-					vals = append(vals, v)
-				}
+	filter := bson.M{primaryKeyAttr: key.Id}
+
+	// the id is set from the filter when the document is inserted
+	keys := mapKeys(key, map[string]interface{}{})
+	delete(keys, primaryKeyAttr)
+
+	update := bson.M{"$inc": bson.M{field: delta}}
+	if len(keys) > 0 {
+		update["$setOnInsert"] = keys
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{field: 1})
+
+	var updated bson.M
+	if err := coll.FindOneAndUpdate(s.context, filter, update, opts).Decode(&updated); err != nil {
+		code := codes.Internal
+		// TypeMismatch, the field isn't a number
+		if cmdErr, ok := err.(mongo.CommandError); ok && cmdErr.HasErrorCode(14) {
+			code = codes.FailedPrecondition
+		}
+
+		return 0, newErr(
+			code,
+			"error incrementing value",
+			err,
+		)
+	}
+
+	value, err := document.IncrementedValue(updated[field], 0)
+	if err != nil {
+		return 0, newErr(
+			codes.FailedPrecondition,
+			"invalid field value",
+			err,
+		)
+	}
+
+	if key.Collection.Parent != nil {
+		err := s.updateChildReferences(key, coll.Name(), "$addToSet")
+		if err != nil {
+			return 0, newErr(
+				codes.Internal,
+				"error updating child references",
+				err,
+			)
+		}
+	}
+
+	return value, nil
+}
+
+// CompareAndSet - sets the document with an update filtered on the field. Missing documents are only upserted when zero is expected,
+// an existing document that doesn't match the filter then fails the upsert with a duplicate key error
+func (s *MongoDocService) CompareAndSet(key *document.Key, field string, expected int64, value map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"MongoDocService.CompareAndSet",
+		map[string]interface{}{
+			"key":      key,
+			"field":    field,
+			"expected": expected,
+		},
+	)
+
+	if err := document.ValidateKey(key); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid key",
+			err,
+		)
+	}
+
+	if err := document.ValidateCompareField(field); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid field",
+			err,
+		)
+	}
+
+	if value == nil {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-nil value",
+			nil,
+		)
+	}
+
+	coll := s.getCollection(key)
+
+	filter := bson.M{primaryKeyAttr: key.Id, field: expected}
+	if expected == 0 {
+		filter = bson.M{
+			primaryKeyAttr: key.Id,
+			"$or":          bson.A{bson.M{field: bson.M{"$exists": false}}, bson.M{field: 0}},
+		}
+	}
+
+	opts := options.Update().SetUpsert(expected == 0)
+
+	result, err := coll.UpdateOne(s.context, filter, bson.D{{"$set", mapKeys(key, value)}}, opts)
+	if mongo.IsDuplicateKeyError(err) || (err == nil && result.MatchedCount == 0 && result.UpsertedCount == 0) {
+		return newErr(
+			codes.FailedPrecondition,
+			document.ConditionFailed,
+			err,
+		)
+	}
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"error updating value",
+			err,
+		)
+	}
+
+	if key.Collection.Parent != nil {
+		err := s.updateChildReferences(key, coll.Name(), "$addToSet")
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				"error updating child references",
+				err,
+			)
+		}
+	}
 
-				query[primaryKeyAttr] = bson.D{{"$gt", vals[len(vals)-1]}}
+	return nil
+}
+
+// deleteChildren - deletes the documents in the child collections of a deleted document, and their children
+func (s *MongoDocService) deleteChildren(key *document.Key, children primitive.A) error {
+	for _, v := range children {
+		colName := v.(string)
+		childCol := s.db.Collection(colName)
+		filter := bson.D{{parentKeyAttr, key.Id}}
+
+		// Delete the children of child documents before the child documents
+		cursor, err := childCol.Find(s.context, append(filter, bson.E{Key: childrenAttr, Value: bson.M{"$exists": true}}), options.Find().SetProjection(bson.M{primaryKeyAttr: 1, childrenAttr: 1}))
+		if err != nil {
+			return err
+		}
+
+		for cursor.Next(s.context) {
+			var childDocument map[string]interface{}
+			if err := cursor.Decode(&childDocument); err != nil {
+				return err
 			}
+
+			childKey := &document.Key{
+				Collection: &document.Collection{
+					Name:   colName[strings.LastIndex(colName, ".")+1:],
+					Parent: key,
+				},
+				Id: childDocument[primaryKeyAttr].(string),
+			}
+
+			if err := s.deleteChildren(childKey, childDocument[childrenAttr].(primitive.A)); err != nil {
+				return err
+			}
+		}
+
+		if err := cursor.Err(); err != nil {
+			return err
+		}
+
+		if _, err := childCol.DeleteMany(s.context, filter); err != nil {
+			return err
 		}
 	}
 
-	if collection.Parent != nil && collection.Parent.Id != "" {
-		query[parentKeyAttr] = collection.Parent.Id
+	return nil
+}
+
+// queryFilter - returns a filter matching the documents in the collection that satisfy the expressions
+func (s *MongoDocService) queryFilter(collection *document.Collection, expressions []document.QueryExpression) bson.M {
+	query := bson.M{}
+
+	if collection.Parent != nil {
+		if collection.Parent.Id != "" {
+			query[parentKeyAttr] = collection.Parent.Id
+		}
+
+		for i, k := range document.KeyPath(collection.Parent.Collection.Parent) {
+			if k.Id != "" {
+				query[fmt.Sprintf("%s.%d", ancestorsAttr, i)] = k.Id
+			}
+		}
 	}
 
+	for k, v := range s.expressionsFilter(expressions) {
+		query[k] = v
+	}
+
+	return query
+}
+
+// expressionsFilter - returns the filter matching all the expressions, or expressions match any of their groups
+func (s *MongoDocService) expressionsFilter(expressions []document.QueryExpression) bson.M {
+	query := bson.M{}
+	anyOf := bson.A{}
+
 	for _, exp := range expressions {
+		if groups, ok := exp.Groups(); ok {
+			groupFilters := bson.A{}
+			for _, group := range groups {
+				groupFilters = append(groupFilters, s.expressionsFilter(group))
+			}
+			anyOf = append(anyOf, bson.M{"$or": groupFilters})
+			continue
+		}
+
 		expOperand := exp.Operand
 		if exp.Operator == "startsWith" {
 			expVal := fmt.Sprintf("%v", exp.Value)
@@ -276,16 +522,107 @@ func (s *MongoDocService) getCursor(collection *document.Collection, expressions
 			}
 
 			query[expOperand] = startsWith
+		} else if exp.Operator == "not-in" {
+			// matching firestore, documents without the field aren't included
+			query[expOperand] = bson.D{
+				{"$exists", true},
+				{"$nin", exp.Value},
+			}
+		} else if document.IsGeoOperator(exp.Operator) {
+			query[locationAttrPrefix+expOperand] = bson.D{
+				{"$geoWithin", geoShape(exp.Value)},
+			}
+		} else if exp.Operator == "array-contains" || exp.Operator == "array-contains-any" {
+			// $elemMatch only matches arrays, where a plain match would also match the value itself
+			query[expOperand] = bson.D{
+				{"$elemMatch", bson.D{{s.getOperator(exp.Operator), exp.Value}}},
+			}
 		} else {
 			query[expOperand] = bson.D{
 				{s.getOperator(exp.Operator), exp.Value},
 			}
 		}
+	}
+
+	// each or expression must match, so several are combined with $and
+	if len(anyOf) > 0 {
+		query["$and"] = anyOf
+	}
+
+	return query
+}
+
+// encodeOrderByToken - encodes the value of the order by field of the last document of a page, keeping its BSON type
+func encodeOrderByToken(doc bson.Raw, orderBy string) (string, error) {
+	value, err := doc.LookupErr(strings.Split(orderBy, ".")...)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := bson.MarshalExtJSON(bson.D{{Key: "v", Value: value}}, true, false)
+	if err != nil {
+		return "", err
+	}
+
+	return string(token), nil
+}
+
+func decodeOrderByToken(token string) (interface{}, error) {
+	var decoded struct {
+		V interface{} `bson:"v"`
+	}
+	if err := bson.UnmarshalExtJSON([]byte(token), true, &decoded); err != nil {
+		return nil, fmt.Errorf("invalid paging token: %v", err)
+	}
+
+	return decoded.V, nil
+}
+
+func (s *MongoDocService) getCursor(db *mongo.Database, collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (cursor *mongo.Cursor, orderBy string, err error) {
+	coll := db.Collection(collectionName(&document.Key{Collection: collection}))
+
+	query := s.queryFilter(collection, expressions)
+
+	opts := options.Find()
+
+	opts.SetProjection(bson.M{childrenAttr: 0})
+
+	for _, exp := range expressions {
+		if document.IsInequalityOperator(exp.Operator) && limit > 0 && orderBy == "" {
+			orderBy = exp.Operand
+		}
+	}
+
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+
+		if last, ok := pagingToken[pagingTokens]; ok {
+			if orderBy == "" {
+				query[primaryKeyAttr] = bson.D{{Key: "$gt", Value: last}}
+			} else {
+				// Pages are sorted by the order by field then the key, so they continue from documents after the last one's value,
+				// or with the same value and a later key
+				value, err := decodeOrderByToken(pagingToken[orderByToken])
+				if err != nil {
+					return nil, "", err
+				}
+
+				query = bson.M{"$and": bson.A{query, bson.M{"$or": bson.A{
+					bson.M{orderBy: bson.M{"$gt": value}},
+					bson.M{orderBy: value, primaryKeyAttr: bson.M{"$gt": last}},
+				}}}}
+			}
+		}
+	}
 
-		if exp.Operator != "==" && limit > 0 && orderBy == "" {
-			opts.SetSort(bson.D{{expOperand, 1}})
-			orderBy = expOperand
+	// Every page is sorted the same way, ending with the key its paging token continues from.
+	// Unsorted results would come back in a different order for each page, e.g. from each partition of a Cosmos DB collection
+	if limit > 0 {
+		order := bson.D{}
+		if orderBy != "" {
+			order = append(order, bson.E{Key: orderBy, Value: 1})
 		}
+		opts.SetSort(append(order, bson.E{Key: primaryKeyAttr, Value: 1}))
 	}
 
 	cursor, err = coll.Find(s.context, query, opts)
@@ -313,7 +650,15 @@ func (s *MongoDocService) Query(collection *document.Collection, expressions []d
 		Documents: make([]document.Document, 0),
 	}
 
-	cursor, orderBy, err := s.getCursor(collection, expressions, limit, pagingToken)
+	db := s.db
+	if s.chargeDb != nil {
+		// request statistics are those of the last request on a connection, so charged queries have the connection to themselves
+		s.chargeLock.Lock()
+		defer s.chargeLock.Unlock()
+		db = s.chargeDb
+	}
+
+	cursor, orderBy, err := s.getCursor(db, collection, expressions, limit, pagingToken)
 	if err != nil {
 		return nil, newErr(
 			codes.InvalidArgument,
@@ -321,9 +666,24 @@ func (s *MongoDocService) Query(collection *document.Collection, expressions []d
 			err,
 		)
 	}
-
 	defer cursor.Close(s.context)
-	for cursor.Next(s.context) {
+
+	if s.chargeDb != nil {
+		queryResult.RequestCharge = s.lastRequestCharge()
+	}
+
+	for {
+		// the next batch is fetched with a getMore once the current one is read, if the cursor is still open
+		fetching := s.chargeDb != nil && cursor.RemainingBatchLength() == 0 && cursor.ID() != 0
+
+		next := cursor.Next(s.context)
+		if fetching {
+			queryResult.RequestCharge += s.lastRequestCharge()
+		}
+		if !next {
+			break
+		}
+
 		sdkDoc, err := mongoDocToDocument(collection, cursor)
 		if err != nil {
 			return nil, newErr(
@@ -337,18 +697,32 @@ func (s *MongoDocService) Query(collection *document.Collection, expressions []d
 
 		// If query limit configured determine continue tokens
 		if limit > 0 && len(queryResult.Documents) == limit {
-			tokens := ""
-			if orderBy != "" {
-				tokens = fmt.Sprintf("%v", sdkDoc.Content[orderBy]) + "|"
+			queryResult.PagingToken = map[string]string{
+				pagingTokens: sdkDoc.Key.Id,
 			}
-			tokens += sdkDoc.Key.Id
 
-			queryResult.PagingToken = map[string]string{
-				"pagingTokens": tokens,
+			if orderBy != "" {
+				token, err := encodeOrderByToken(cursor.Current, orderBy)
+				if err != nil {
+					return nil, newErr(
+						codes.Internal,
+						"error encoding paging token",
+						err,
+					)
+				}
+				queryResult.PagingToken[orderByToken] = token
 			}
 		}
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading mongo documents",
+			err,
+		)
+	}
+
 	return queryResult, nil
 }
 
@@ -374,7 +748,7 @@ func (s *MongoDocService) QueryStream(collection *document.Collection, expressio
 		}
 	}
 
-	cursor, _, cursorErr := s.getCursor(collection, expressions, limit, nil)
+	cursor, _, cursorErr := s.getCursor(s.db, collection, expressions, limit, nil)
 
 	return func() (*document.Document, error) {
 		if cursorErr != nil {
@@ -411,6 +785,105 @@ func (s *MongoDocService) QueryStream(collection *document.Collection, expressio
 	}
 }
 
+// Aggregate - aggregates the matching documents within mongo using an aggregation pipeline
+func (s *MongoDocService) Aggregate(collection *document.Collection, expressions []document.QueryExpression, aggregations []document.Aggregation) ([]document.AggregateResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"MongoDocService.Aggregate",
+		map[string]interface{}{
+			"collection": collection,
+		},
+	)
+
+	if colErr, expErr := document.ValidateQueryCollection(collection), document.ValidateExpressions(expressions); colErr != nil || expErr != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid arguments",
+			fmt.Errorf("collection: %v, expressions%v", colErr, expErr),
+		)
+	}
+
+	if err := document.ValidateAggregations(aggregations); err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid aggregations",
+			err,
+		)
+	}
+
+	group := bson.M{"_id": nil}
+	for i, a := range aggregations {
+		name := fmt.Sprintf("a%d", i)
+
+		if a.Function == document.AggregateCount {
+			group[name] = bson.M{"$sum": 1}
+			continue
+		}
+
+		// Only numeric values are aggregated, consistent with providers that aggregate client side
+		field := "$" + a.Field
+		numeric := bson.M{"$cond": bson.A{bson.M{"$isNumber": field}, field, nil}}
+		group[name] = bson.M{"$" + a.Function: numeric}
+	}
+
+	coll := s.getCollection(&document.Key{Collection: collection})
+	cursor, err := coll.Aggregate(s.context, mongo.Pipeline{
+		{{"$match", s.queryFilter(collection, expressions)}},
+		{{"$group", group}},
+	})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error creating mongo aggregation",
+			err,
+		)
+	}
+	defer cursor.Close(s.context)
+
+	// no group is returned if no documents matched
+	var values bson.M
+	if cursor.Next(s.context) {
+		if err := cursor.Decode(&values); err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"error decoding mongo aggregation",
+				err,
+			)
+		}
+	} else if cursor.Err() != nil {
+		return nil, newErr(
+			codes.Internal,
+			"mongo cursor error",
+			cursor.Err(),
+		)
+	}
+
+	results := make([]document.AggregateResult, len(aggregations))
+	for i, a := range aggregations {
+		value := values[fmt.Sprintf("a%d", i)]
+
+		switch v := value.(type) {
+		case int32:
+			value = float64(v)
+		case int64:
+			value = float64(v)
+		}
+
+		if a.Function == document.AggregateCount {
+			count, _ := value.(float64)
+			value = int64(count)
+		} else if a.Function == document.AggregateSum && value == nil {
+			value = float64(0)
+		}
+
+		results[i] = document.AggregateResult{
+			Aggregation: a,
+			Value:       value,
+		}
+	}
+
+	return results, nil
+}
+
 func mongoDocToDocument(coll *document.Collection, cursor *mongo.Cursor) (*document.Document, error) {
 	var docSnap map[string]interface{}
 
@@ -420,8 +893,13 @@ func mongoDocToDocument(coll *document.Collection, cursor *mongo.Cursor) (*docum
 
 	id := docSnap[primaryKeyAttr].(string)
 
-	// remove id from content
+	// remove id and location coordinate pairs from content
 	delete(docSnap, primaryKeyAttr)
+	for k := range docSnap {
+		if strings.HasPrefix(k, locationAttrPrefix) {
+			delete(docSnap, k)
+		}
+	}
 
 	sdkDoc := document.Document{
 		Content: docSnap,
@@ -432,17 +910,42 @@ func mongoDocToDocument(coll *document.Collection, cursor *mongo.Cursor) (*docum
 	}
 
 	if docSnap[parentKeyAttr] != nil {
-		parentId := docSnap[parentKeyAttr].(string)
+		ids := make([]string, 0)
+		if ancestors, ok := docSnap[ancestorsAttr].(primitive.A); ok {
+			for _, id := range ancestors {
+				ids = append(ids, id.(string))
+			}
+		}
+		ids = append(ids, docSnap[parentKeyAttr].(string))
+
+		// Rebuild the parent keys from the names of the queried collection's parents
+		names := make([]string, 0, len(ids))
+		for _, k := range document.KeyPath(coll.Parent) {
+			names = append(names, k.Collection.Name)
+		}
+
+		var parentKey *document.Key
+		for i, name := range names {
+			parentKey = &document.Key{
+				Collection: &document.Collection{
+					Name:   name,
+					Parent: parentKey,
+				},
+			}
+
+			// align the ids with the innermost parents, in case the ancestor ids are missing
+			if offset := i - (len(names) - len(ids)); offset >= 0 {
+				parentKey.Id = ids[offset]
+			}
+		}
 
 		sdkDoc.Key.Collection = &document.Collection{
-			Name: coll.Name,
-			Parent: &document.Key{
-				Collection: coll.Parent.Collection,
-				Id:         parentId,
-			},
+			Name:   coll.Name,
+			Parent: parentKey,
 		}
 
 		delete(docSnap, parentKeyAttr)
+		delete(docSnap, ancestorsAttr)
 	}
 
 	return &sdkDoc, nil
@@ -482,11 +985,24 @@ func New() (document.DocumentService, error) {
 
 	db := client.Database(database)
 
-	return &MongoDocService{
+	svc := &MongoDocService{
 		client:  client,
 		db:      db,
 		context: context.Background(),
-	}, nil
+	}
+
+	// Cosmos DB reports request charges with the getLastRequestStatistics command, which plain MongoDB doesn't support
+	if reportCharge, err := strconv.ParseBool(utils.GetEnv(mongoDBRequestChargeEnvVarName, "false")); err != nil {
+		return nil, fmt.Errorf("invalid %s env var, expected boolean, got %v", mongoDBRequestChargeEnvVarName, utils.GetEnv(mongoDBRequestChargeEnvVarName, ""))
+	} else if reportCharge {
+		chargeClient, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoDBConnectionString).SetDirect(mongoDBSetDirect == "true").SetMaxPoolSize(1))
+		if err != nil {
+			return nil, fmt.Errorf("mongodb unable to initialize request charge connection: %v", err)
+		}
+		svc.chargeDb = chargeClient.Database(database)
+	}
+
+	return svc, nil
 }
 
 func NewWithClient(client *mongo.Client, database string, ctx context.Context) document.DocumentService {
@@ -511,13 +1027,41 @@ func mapKeys(key *document.Key, source map[string]interface{}) map[string]interf
 
 	newMap[primaryKeyAttr] = key.Id
 
+	// locations are stored as objects, whose field order isn't kept, so are also stored as coordinate pairs for geo queries
+	for field, p := range document.GeoPoints(source) {
+		newMap[locationAttrPrefix+field] = bson.A{p.Lng, p.Lat}
+	}
+
 	if parentKey != nil {
 		newMap[parentKeyAttr] = parentKey.Id
+
+		if parentKey.Collection.Parent != nil {
+			ancestors := make([]string, 0)
+			for _, k := range document.KeyPath(parentKey.Collection.Parent) {
+				ancestors = append(ancestors, k.Id)
+			}
+			newMap[ancestorsAttr] = ancestors
+		}
 	}
 
 	return newMap
 }
 
+// geoShape - returns the $geoWithin shape of the area of a geo expression
+func geoShape(value interface{}) bson.D {
+	switch v := value.(type) {
+	case document.GeoRadius:
+		return bson.D{{"$centerSphere", bson.A{bson.A{v.Center.Lng, v.Center.Lat}, v.Meters / document.EarthRadius}}}
+	case document.GeoBox:
+		return bson.D{{"$box", bson.A{
+			bson.A{v.SouthWest.Lng, v.SouthWest.Lat},
+			bson.A{v.NorthEast.Lng, v.NorthEast.Lat},
+		}}}
+	}
+
+	return nil
+}
+
 func (s *MongoDocService) updateChildReferences(key *document.Key, subCollectionName string, action string) error {
 	parentColl := s.getCollection(key.Collection.Parent)
 	filter := bson.M{primaryKeyAttr: key.Collection.Parent.Id}
@@ -533,18 +1077,19 @@ func (s *MongoDocService) updateChildReferences(key *document.Key, subCollection
 	return nil
 }
 
-func (s *MongoDocService) getCollection(key *document.Key) *mongo.Collection {
+// collectionName - the name of the mongo collection of a key, the names of its collection and their parent collections joined with dots
+func collectionName(key *document.Key) string {
 	collectionNames := []string{}
-	parentKey := key.Collection.Parent
 
-	for parentKey != nil {
-		collectionNames = append(collectionNames, parentKey.Collection.Name)
-		parentKey = parentKey.Collection.Parent
+	for _, k := range document.KeyPath(key) {
+		collectionNames = append(collectionNames, k.Collection.Name)
 	}
 
-	collectionNames = append(collectionNames, key.Collection.Name)
+	return strings.Join(collectionNames, ".")
+}
 
-	return s.db.Collection(strings.Join(collectionNames, "."))
+func (s *MongoDocService) getCollection(key *document.Key) *mongo.Collection {
+	return s.db.Collection(collectionName(key))
 }
 
 func (s *MongoDocService) getOperator(operator string) string {
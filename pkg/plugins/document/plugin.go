@@ -16,12 +16,29 @@ package document
 
 import "fmt"
 
-// MaxSubCollectionDepth - maximum number of parents a collection can support.
+// MaxSubCollectionDepth - default maximum number of parents a collection can support.
 // Depth is a count of the number of parents for a collection.
 // e.g. a collection with no parent has a depth of 0
 // a collection with a parent has a depth of 1
 const MaxSubCollectionDepth int = 1
 
+// maxExpressionDepth - maximum number of or expressions a query expression can be nested within
+const maxExpressionDepth = 3
+
+// maxSubCollectionDepth - the configured maximum depth, see SetMaxSubCollectionDepth
+var maxSubCollectionDepth = MaxSubCollectionDepth
+
+// SetMaxSubCollectionDepth - sets the maximum number of parents a collection can support, defaults to MaxSubCollectionDepth
+func SetMaxSubCollectionDepth(depth int) error {
+	if depth < 0 {
+		return fmt.Errorf("sub-collection depth must not be negative, got %d", depth)
+	}
+
+	maxSubCollectionDepth = depth
+
+	return nil
+}
+
 type Collection struct {
 	Name   string `log:"Name"`
 	Parent *Key   `log:"Parent"`
@@ -32,6 +49,24 @@ type Key struct {
 	Id         string      `log:"Id"`
 }
 
+// String - returns the path of the collection, including the keys of its parents e.g. customers/1234/orders
+func (c *Collection) String() string {
+	if c.Parent != nil {
+		return fmt.Sprintf("%s/%s", c.Parent, c.Name)
+	}
+
+	return c.Name
+}
+
+// String - returns the path of the document, including the keys of its parents e.g. customers/1234/orders/5678
+func (k *Key) String() string {
+	if k.Collection == nil {
+		return k.Id
+	}
+
+	return fmt.Sprintf("%s/%s", k.Collection, k.Id)
+}
+
 type Document struct {
 	Key     *Key
 	Content map[string]interface{}
@@ -43,13 +78,49 @@ type QueryExpression struct {
 	Value    interface{}
 }
 
+// OrOperator - the operator of an expression matching documents that match all the expressions of any of its groups.
+// Or expressions have a blank operand and their value is the list of groups, which may nest further or expressions
+const OrOperator = "or"
+
+// Or - returns an expression matching documents that match all the expressions of any of the given groups
+func Or(groups ...[]QueryExpression) QueryExpression {
+	return QueryExpression{
+		Operator: OrOperator,
+		Value:    groups,
+	}
+}
+
+// Groups - returns the groups of an or expression, or false if the expression isn't an or expression
+func (e QueryExpression) Groups() ([][]QueryExpression, bool) {
+	if e.Operator != OrOperator {
+		return nil, false
+	}
+
+	groups, ok := e.Value.([][]QueryExpression)
+
+	return groups, ok
+}
+
 type QueryResult struct {
 	Documents   []Document
 	PagingToken map[string]string
+	// RequestCharge - the request units the query consumed, for providers that report them e.g. Cosmos DB, 0 otherwise
+	RequestCharge float64
 }
 
 type DocumentIterator = func() (*Document, error)
 
+// FailedWrite - a document a batch couldn't write, and why
+type FailedWrite struct {
+	Key     *Key
+	Message string
+}
+
+// BatchResponse - the documents a batch couldn't write, every other document in the batch was written
+type BatchResponse struct {
+	FailedWrites []*FailedWrite
+}
+
 // The base Document Plugin interface
 // Use this over proto definitions to remove dependency on protobuf in the plugin internally
 // and open options to adding additional non-grpc interfaces
@@ -57,8 +128,19 @@ type DocumentService interface {
 	Get(*Key) (*Document, error)
 	Set(*Key, map[string]interface{}) error
 	Delete(*Key) error
+	// SetBatch - sets multiple documents, in batches of the provider's limits
+	SetBatch([]Document) (*BatchResponse, error)
+	// DeleteBatch - deletes multiple documents with their sub-collections, in batches of the provider's limits
+	DeleteBatch([]*Key) (*BatchResponse, error)
+	// Increment - atomically adds a delta to a top level integer field of a document and returns the field's new value,
+	// creating the document and the field from zero if they don't exist
+	Increment(*Key, string, int64) (int64, error)
+	// CompareAndSet - atomically sets a document if a top level integer field of the stored document has the expected value,
+	// where zero matches a missing document or field. Fails with FailedPrecondition and ConditionFailed if it doesn't
+	CompareAndSet(*Key, string, int64, map[string]interface{}) error
 	Query(*Collection, []QueryExpression, int, map[string]string) (*QueryResult, error)
 	QueryStream(*Collection, []QueryExpression, int) DocumentIterator
+	Aggregate(*Collection, []QueryExpression, []Aggregation) ([]AggregateResult, error)
 }
 
 type UnimplementedDocumentPlugin struct {
@@ -77,6 +159,22 @@ func (p *UnimplementedDocumentPlugin) Delete(key *Key) error {
 	return fmt.Errorf("UNIMPLEMENTED")
 }
 
+func (p *UnimplementedDocumentPlugin) SetBatch(docs []Document) (*BatchResponse, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (p *UnimplementedDocumentPlugin) DeleteBatch(keys []*Key) (*BatchResponse, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (p *UnimplementedDocumentPlugin) Increment(key *Key, field string, delta int64) (int64, error) {
+	return 0, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (p *UnimplementedDocumentPlugin) CompareAndSet(key *Key, field string, expected int64, content map[string]interface{}) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
 func (p *UnimplementedDocumentPlugin) Query(collection *Collection, expressions []QueryExpression, limit int, pagingToken map[string]string) (*QueryResult, error) {
 	return nil, fmt.Errorf("UNIMPLEMENTED")
 }
@@ -86,3 +184,7 @@ func (p *UnimplementedDocumentPlugin) QueryStream(collection *Collection, expres
 		return nil, fmt.Errorf("UNIMPLEMENTED")
 	}
 }
+
+func (p *UnimplementedDocumentPlugin) Aggregate(collection *Collection, expressions []QueryExpression, aggregations []Aggregation) ([]AggregateResult, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
@@ -78,3 +78,14 @@ func (c Code) String() string {
 		return fmt.Sprintf("Unknown error code: %d", c)
 	}
 }
+
+// Retryable - returns true if calls failing with the code may succeed if they're retried,
+// e.g. when a service is throttling requests or temporarily unavailable
+func (c Code) Retryable() bool {
+	switch c {
+	case DeadlineExceeded, ResourceExhausted, Aborted, Unavailable:
+		return true
+	default:
+		return false
+	}
+}
@@ -15,17 +15,31 @@
 package errors
 
 import (
+	goerrors "errors"
 	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc/status"
 
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 )
 
+// ResourceArgs - the names of plugin args that identify the resources an operation was applied to,
+// they're copied to the Resources of errors created with ErrorsWithScope
+var ResourceArgs = []string{"bucket", "key", "collection", "topic", "queue", "secret", "version", "index", "id", "database"}
+
 type PluginError struct {
 	Code   codes.Code
 	Msg    string
 	Cause  error
 	Plugin string
 	Args   map[string]interface{}
+	// Identifies the resources the error relates to, e.g. {"bucket": "images", "key": "cat.png"}
+	Resources map[string]string
+	// The operation may succeed if it's retried, e.g. after being throttled
+	Retryable bool
+	// The provider's own code for the cause of the error, e.g. an AWS error code such as ThrottlingException. Empty if unknown
+	ProviderCode string
 }
 
 func (p *PluginError) Unwrap() error {
@@ -41,26 +55,98 @@ func (p *PluginError) Error() string {
 	return fmt.Sprintf("%s", p.Msg)
 }
 
+// AsPluginError - returns the first plugin error in the chain of wrapped errors
+func AsPluginError(e error) (*PluginError, bool) {
+	var pe *PluginError
+	if goerrors.As(e, &pe) {
+		return pe, true
+	}
+
+	return nil, false
+}
+
 // Code - returns a nitric api error code from an error or Unknown if the error was not a nitric api error
 func Code(e error) codes.Code {
-	if pe, ok := e.(*PluginError); ok {
+	if pe, ok := AsPluginError(e); ok {
 		return pe.Code
 	}
 
 	return codes.Unknown
 }
 
+// Retryable - returns true if the error is a plugin error for an operation that may succeed if it's retried
+func Retryable(e error) bool {
+	if pe, ok := AsPluginError(e); ok {
+		return pe.Retryable
+	}
+
+	return false
+}
+
+// ProviderCode - returns the provider's own code for an error returned by a provider SDK, or an empty string if it has none
+func ProviderCode(e error) string {
+	// AWS SDK errors
+	var coded interface{ Code() string }
+	if goerrors.As(e, &coded) {
+		return coded.Code()
+	}
+
+	var errorCoded interface{ ErrorCode() string }
+	if goerrors.As(e, &errorCoded) {
+		return errorCoded.ErrorCode()
+	}
+
+	// GCP client library errors
+	if s, ok := status.FromError(e); ok && s.Code() != 0 {
+		return s.Code().String()
+	}
+
+	return ""
+}
+
+// resources - returns the args that identify resources, formatted as strings
+func resources(args map[string]interface{}) map[string]string {
+	res := make(map[string]string)
+
+	for _, name := range ResourceArgs {
+		switch v := args[name].(type) {
+		case string:
+			if v != "" {
+				res[name] = v
+			}
+		case fmt.Stringer:
+			if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || !rv.IsNil() {
+				res[name] = v.String()
+			}
+		}
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
 type ErrorFactory = func(c codes.Code, msg string, cause error) error
 
 // ErrorsWithScope - Returns a new reusable error factory with the given scope
 func ErrorsWithScope(scope string, args map[string]interface{}) ErrorFactory {
 	return func(code codes.Code, msg string, cause error) error {
-		return &PluginError{
-			Code:   code,
-			Msg:    msg,
-			Cause:  cause,
-			Plugin: scope,
-			Args:   args,
+		pe := &PluginError{
+			Code:      code,
+			Msg:       msg,
+			Cause:     cause,
+			Plugin:    scope,
+			Args:      args,
+			Resources: resources(args),
+			Retryable: code.Retryable(),
 		}
+
+		if cause != nil {
+			pe.ProviderCode = ProviderCode(cause)
+		}
+
+		return pe
 	}
 }
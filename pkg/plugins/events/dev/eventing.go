@@ -21,7 +21,9 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
@@ -33,6 +35,9 @@ type LocalEventService struct {
 	events.UnimplementedeventsPlugin
 	subscriptions map[string][]string
 	client        LocalHttpeventsClient
+	cloudEvents   cloudevents.Mode
+	// The application's own subscribers, nil if events are only delivered to LOCAL_SUBSCRIPTIONS
+	local LocalSubscribers
 }
 
 // Interface for methods utilised by
@@ -51,13 +56,7 @@ func (s *LocalEventService) Publish(topic string, event *events.NitricEvent) err
 		},
 	)
 
-	requestId := event.ID
-	payloadType := event.PayloadType
-	payload := event.Payload
-
-	marshaledPayload, err := json.Marshal(payload)
-	contentType := http.DetectContentType(marshaledPayload)
-
+	headers, body, err := s.encode(topic, event)
 	if err != nil {
 		return newErr(
 			codes.Internal,
@@ -66,15 +65,27 @@ func (s *LocalEventService) Publish(topic string, event *events.NitricEvent) err
 		)
 	}
 
-	if targets, ok := s.subscriptions[topic]; ok {
+	targets, subscribed := s.subscriptions[topic]
+	local := s.local != nil && s.local.Subscribed(topic)
+	if !subscribed && !local {
+		return newErr(
+			codes.NotFound,
+			"unable to find subscriber for topic",
+			nil,
+		)
+	}
+
+	if local {
+		s.deliverLocally(topic, event)
+	}
+
+	if subscribed {
 		for _, target := range targets {
-			httpRequest, _ := http.NewRequest("POST", target, bytes.NewReader(marshaledPayload))
+			httpRequest, _ := http.NewRequest("POST", target, bytes.NewReader(body))
 
-			httpRequest.Header.Add("Content-Type", contentType)
-			httpRequest.Header.Add("x-nitric-request-id", requestId)
-			httpRequest.Header.Add("x-nitric-source", topic)
-			httpRequest.Header.Add("x-nitric-source-type", triggers.TriggerType_Subscription.String())
-			httpRequest.Header.Add("x-nitric-payload-type", payloadType)
+			for k, v := range headers {
+				httpRequest.Header.Add(k, v)
+			}
 
 			// Call the target
 			res, err := s.client.Do(httpRequest)
@@ -95,17 +106,74 @@ func (s *LocalEventService) Publish(topic string, event *events.NitricEvent) err
 				log.Default().Println(fmt.Sprintf("Failed to publish event to %s\nStatus Code: %v\n%s", target, res.StatusCode, body))
 			}
 		}
-	} else {
-		return newErr(
-			codes.NotFound,
-			"unable to find subscriber for topic",
-			nil,
-		)
 	}
 
 	return nil
 }
 
+// deliverLocally - delivers the event to the application's own subscriber.
+// Like a push subscription the event is handled after it's published, except events with an ordering key, which are handled
+// before Publish returns so that events published in order are handled in order
+func (s *LocalEventService) deliverLocally(topic string, event *events.NitricEvent) {
+	published := time.Now()
+	deliver := func() {
+		payload, err := json.Marshal(event.Payload)
+		if err == nil {
+			err = s.local.Deliver(&triggers.Event{
+				ID:          event.ID,
+				Topic:       topic,
+				Payload:     payload,
+				OrderingKey: event.OrderingKey,
+				Metadata: triggers.Metadata{
+					Source:      topic,
+					ProviderID:  event.ID,
+					PublishTime: published,
+				},
+			})
+		}
+
+		if err != nil {
+			// As with LOCAL_SUBSCRIPTIONS, a failed delivery isn't an error for the publisher
+			log.Default().Println(fmt.Sprintf("Failed to deliver event %s to the subscriber for %s: %v", event.ID, topic, err))
+		}
+	}
+
+	if event.OrderingKey != "" {
+		deliver()
+		return
+	}
+
+	go deliver()
+}
+
+// encode - returns the headers and body of the request delivering the event to subscribers
+func (s *LocalEventService) encode(topic string, event *events.NitricEvent) (map[string]string, []byte, error) {
+	if s.cloudEvents != cloudevents.Disabled {
+		return cloudevents.EncodeMessage(s.cloudEvents, topic, event)
+	}
+
+	marshaledPayload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type":          http.DetectContentType(marshaledPayload),
+		"x-nitric-request-id":   event.ID,
+		"x-nitric-source":       topic,
+		"x-nitric-source-type":  triggers.TriggerType_Subscription.String(),
+		"x-nitric-payload-type": event.PayloadType,
+	}
+	if event.OrderingKey != "" {
+		headers["x-nitric-ordering-key"] = event.OrderingKey
+	}
+	if key := event.Attributes[triggers.IdempotencyKeyAttribute]; key != "" {
+		headers[triggers.IdempotencyKeyAttribute] = key
+	}
+
+	return headers, marshaledPayload, nil
+}
+
 // Get a list of available topics
 func (s *LocalEventService) ListTopics() ([]string, error) {
 	keys := []string{}
@@ -118,7 +186,7 @@ func (s *LocalEventService) ListTopics() ([]string, error) {
 }
 
 // Create new Dev EventService
-func New() (events.EventService, error) {
+func New(opts ...LocalEventServiceOption) (events.EventService, error) {
 	localSubscriptions := utils.GetEnv("LOCAL_SUBSCRIPTIONS", "{}")
 
 	tmpSubs := make(map[string][]string)
@@ -133,15 +201,33 @@ func New() (events.EventService, error) {
 		subs[strings.ToLower(key)] = val
 	}
 
-	return &LocalEventService{
+	mode, err := cloudevents.ModeFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	service := &LocalEventService{
 		subscriptions: subs,
 		client:        http.DefaultClient,
-	}, nil
+		cloudEvents:   mode,
+	}
+
+	for _, o := range opts {
+		o.Apply(service)
+	}
+
+	return service, nil
 }
 
-func NewWithClientAndSubs(client LocalHttpeventsClient, subs map[string][]string) (events.EventService, error) {
-	return &LocalEventService{
+func NewWithClientAndSubs(client LocalHttpeventsClient, subs map[string][]string, opts ...LocalEventServiceOption) (events.EventService, error) {
+	service := &LocalEventService{
 		subscriptions: subs,
 		client:        client,
-	}, nil
+	}
+
+	for _, o := range opts {
+		o.Apply(service)
+	}
+
+	return service, nil
 }
@@ -22,8 +22,10 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
 	events_service "github.com/nitrictech/nitric/pkg/plugins/events/dev"
+	"github.com/nitrictech/nitric/pkg/triggers"
 )
 
 type MockHttpClient struct {
@@ -51,6 +53,26 @@ func (m *MockHttpClient) Do(request *http.Request) (*http.Response, error) {
 	}, nil
 }
 
+type mockLocalSubscribers struct {
+	topics    []string
+	delivered chan *triggers.Event
+}
+
+func (m *mockLocalSubscribers) Subscribed(topic string) bool {
+	for _, t := range m.topics {
+		if t == topic {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *mockLocalSubscribers) Deliver(evt *triggers.Event) error {
+	m.delivered <- evt
+	return nil
+}
+
 var _ = Describe("events", func() {
 	mockHttpClient := &MockHttpClient{}
 
@@ -106,6 +128,39 @@ var _ = Describe("events", func() {
 			})
 		})
 
+		When("The application subscribes to the target topic", func() {
+			It("should deliver the event to the application", func() {
+				subscribers := &mockLocalSubscribers{topics: []string{"test"}, delivered: make(chan *triggers.Event, 1)}
+				pubsubClient, _ := events_service.NewWithClientAndSubs(mockHttpClient, map[string][]string{}, events_service.WithLocalSubscribers(subscribers))
+
+				Expect(pubsubClient.Publish("test", testEvent)).To(Succeed())
+
+				var evt *triggers.Event
+				Eventually(subscribers.delivered).Should(Receive(&evt))
+				Expect(evt.ID).To(Equal("1234"))
+				Expect(evt.Topic).To(Equal("test"))
+				Expect(evt.Payload).To(MatchJSON(`{"Test":"test"}`))
+
+				By("Not calling any LOCAL_SUBSCRIPTIONS endpoints")
+				Expect(mockHttpClient.capturedRequests).To(BeEmpty())
+			})
+
+			It("should deliver events with an ordering key before returning", func() {
+				subscribers := &mockLocalSubscribers{topics: []string{"test"}, delivered: make(chan *triggers.Event, 1)}
+				pubsubClient, _ := events_service.NewWithClientAndSubs(mockHttpClient, map[string][]string{}, events_service.WithLocalSubscribers(subscribers))
+
+				Expect(pubsubClient.Publish("test", &events.NitricEvent{ID: "1", Payload: testPayload, OrderingKey: "customer-1"})).To(Succeed())
+				Expect(subscribers.delivered).To(Receive())
+			})
+
+			It("should still return an error for topics without subscribers", func() {
+				subscribers := &mockLocalSubscribers{topics: []string{"other"}}
+				pubsubClient, _ := events_service.NewWithClientAndSubs(mockHttpClient, map[string][]string{}, events_service.WithLocalSubscribers(subscribers))
+
+				Expect(pubsubClient.Publish("test", testEvent)).ToNot(Succeed())
+			})
+		})
+
 		When("The target topic is available, with subscribers", func() {
 			subs := map[string][]string{
 				"test": {"http://test-endpoint/"},
@@ -148,6 +203,34 @@ var _ = Describe("events", func() {
 			})
 		})
 
+		When("CloudEvents are enabled in binary mode", func() {
+			subs := map[string][]string{
+				"test": {"http://test-endpoint/"},
+			}
+
+			eventPlugin, _ := events_service.NewWithClientAndSubs(mockHttpClient, subs, events_service.WithCloudEvents(cloudevents.Binary))
+
+			It("should deliver the event as a binary CloudEvent", func() {
+				Expect(eventPlugin.Publish("test", testEvent)).To(Succeed())
+				Expect(mockHttpClient.capturedRequests).To(HaveLen(1))
+
+				capturedRequest := mockHttpClient.capturedRequests[0]
+				By("Providing the event attributes in headers")
+				Expect(capturedRequest.Header.Get("ce-specversion")).To(Equal("1.0"))
+				Expect(capturedRequest.Header.Get("ce-id")).To(Equal("1234"))
+				Expect(capturedRequest.Header.Get("ce-type")).To(Equal("Test-Payload"))
+				Expect(capturedRequest.Header.Get("ce-nitrictopic")).To(Equal("test"))
+				Expect(capturedRequest.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				By("Providing the payload in the Body")
+				bodyBytes, err := ioutil.ReadAll(capturedRequest.Body)
+				Expect(err).NotTo(HaveOccurred())
+				bodyMap := make(map[string]interface{})
+				Expect(json.Unmarshal(bodyBytes, &bodyMap)).To(Succeed())
+				Expect(bodyMap).To(BeEquivalentTo(testPayload))
+			})
+		})
+
 		When("The target topic is available, with no subscribers", func() {
 			subs := map[string][]string{
 				"test": {},
@@ -0,0 +1,62 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_service
+
+import (
+	"github.com/nitrictech/nitric/pkg/cloudevents"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type LocalEventServiceOption interface {
+	Apply(*LocalEventService)
+}
+
+type withCloudEvents struct {
+	mode cloudevents.Mode
+}
+
+func (w *withCloudEvents) Apply(service *LocalEventService) {
+	service.cloudEvents = w.mode
+}
+
+// WithCloudEvents - publish events as CloudEvents, in the given mode
+func WithCloudEvents(mode cloudevents.Mode) LocalEventServiceOption {
+	return &withCloudEvents{
+		mode: mode,
+	}
+}
+
+// LocalSubscribers - the subscriptions registered by the application running locally, e.g. the dev gateway's
+type LocalSubscribers interface {
+	// Subscribed - returns true if the application subscribes to the topic
+	Subscribed(topic string) bool
+	// Deliver - delivers the event to the application's subscriber for its topic
+	Deliver(evt *triggers.Event) error
+}
+
+type withLocalSubscribers struct {
+	subscribers LocalSubscribers
+}
+
+func (w *withLocalSubscribers) Apply(service *LocalEventService) {
+	service.local = w.subscribers
+}
+
+// WithLocalSubscribers - deliver published events to the application's own subscribers, as well as LOCAL_SUBSCRIPTIONS
+func WithLocalSubscribers(subscribers LocalSubscribers) LocalEventServiceOption {
+	return &withLocalSubscribers{
+		subscribers: subscribers,
+	}
+}
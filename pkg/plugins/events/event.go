@@ -4,7 +4,7 @@
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//      http://www.apache.org/licenses/LICENSE-2.0
+//	http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
@@ -18,4 +18,8 @@ type NitricEvent struct {
 	ID          string                 `json:"id,omitempty" log:"ID"`
 	PayloadType string                 `json:"payloadType,omitempty" log:"PayloadType"`
 	Payload     map[string]interface{} `json:"payload,omitempty"`
+	// Attributes - metadata published with the event as message attributes, e.g. its tenant
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// OrderingKey - events sharing an ordering key are delivered to subscribers one at a time, in the order they're received
+	OrderingKey string `json:"orderingKey,omitempty" log:"OrderingKey"`
 }
@@ -28,12 +28,15 @@ import (
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
 	"github.com/nitrictech/nitric/pkg/providers/azure/core"
+	"github.com/nitrictech/nitric/pkg/resources"
 )
 
 type EventGridEventService struct {
 	events.UnimplementedeventsPlugin
 	client   eventgridapi.BaseClientAPI
 	provider core.AzProvider
+	// permissions - lists the effective permissions on topics, created from the environment when it's nil
+	permissions core.PermissionLister
 }
 
 func (s *EventGridEventService) ListTopics() ([]string, error) {
@@ -102,7 +105,6 @@ func (s *EventGridEventService) Publish(topic string, event *events.NitricEvent)
 
 	// TODO: Determine correctness of availability zone in endpoint hostname
 	topicHostName := fmt.Sprintf("%s.%s-1.eventgrid.azure.net", t.Name, t.Location)
-	fmt.Println("topic host name is", topicHostName)
 
 	eventToPublish, err := s.nitricEventsToAzureEvents(topicHostName, []*events.NitricEvent{event})
 	if err != nil {
@@ -133,6 +135,42 @@ func (s *EventGridEventService) Publish(topic string, event *events.NitricEvent)
 	return nil
 }
 
+// topicDataActions - the data actions needed on a topic for nitric actions
+var topicDataActions = map[resources.Action][]string{
+	resources.TopicEventPublish: {"Microsoft.EventGrid/events/send/action"},
+}
+
+// CheckPermissions - checks the data actions needed for the nitric actions against the effective permissions on the topic
+func (s *EventGridEventService) CheckPermissions(topic string, actions []resources.Action) ([]resources.Permission, error) {
+	topics, err := s.provider.GetResources(core.AzResource_Topic)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := topics[topic]
+	if !ok {
+		return nil, fmt.Errorf("topic %s does not exist", topic)
+	}
+
+	lister := s.permissions
+	if lister == nil {
+		if lister, err = core.NewPermissionLister(); err != nil {
+			return nil, err
+		}
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, topicDataActions[a]...)
+	}
+
+	return core.CheckDataActions(lister, core.AzResourceId{
+		Namespace: "Microsoft.EventGrid",
+		Type:      "topics",
+		Name:      t.Name,
+	}, needed)
+}
+
 func New(provider core.AzProvider) (events.EventService, error) {
 	// Get the event grid token, using the event grid resource endpoint
 	spt, err := provider.ServicePrincipalToken("https://eventgrid.azure.net")
@@ -14,11 +14,18 @@
 
 package events
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type EventService interface {
 	Publish(topic string, event *NitricEvent) error
 	ListTopics() ([]string, error)
+	// Replay - re-deliver events published to topic from the given time, a zero to time replays all events since from
+	Replay(topic string, from time.Time, to time.Time) error
+	// Backlog - returns the approximate number of events published to topic that haven't been delivered to its subscribers
+	Backlog(topic string) (int64, error)
 }
 
 type UnimplementedeventsPlugin struct {
@@ -32,3 +39,11 @@ func (*UnimplementedeventsPlugin) Publish(topic string, event *NitricEvent) erro
 func (*UnimplementedeventsPlugin) ListTopics() ([]string, error) {
 	return nil, fmt.Errorf("UNIMPLEMENTED")
 }
+
+func (*UnimplementedeventsPlugin) Replay(topic string, from time.Time, to time.Time) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedeventsPlugin) Backlog(topic string) (int64, error) {
+	return 0, fmt.Errorf("UNIMPLEMENTED")
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub_service
+
+import (
+	"github.com/nitrictech/nitric/pkg/cloudevents"
+	ifaces_pubsub "github.com/nitrictech/nitric/pkg/ifaces/pubsub"
+	"github.com/nitrictech/nitric/pkg/stack"
+)
+
+type PubsubEventServiceOption interface {
+	Apply(*PubsubEventService)
+}
+
+type withCloudEvents struct {
+	mode cloudevents.Mode
+}
+
+func (w *withCloudEvents) Apply(service *PubsubEventService) {
+	service.cloudEvents = w.mode
+}
+
+// WithCloudEvents - publish events as CloudEvents, in the given mode
+func WithCloudEvents(mode cloudevents.Mode) PubsubEventServiceOption {
+	return &withCloudEvents{
+		mode: mode,
+	}
+}
+
+type withSubscriptionMetrics struct {
+	metrics ifaces_pubsub.SubscriptionMetrics
+}
+
+func (w *withSubscriptionMetrics) Apply(service *PubsubEventService) {
+	service.metrics = w.metrics
+}
+
+// WithSubscriptionMetrics - read the backlog of topics from the metrics of their subscriptions
+func WithSubscriptionMetrics(metrics ifaces_pubsub.SubscriptionMetrics) PubsubEventServiceOption {
+	return &withSubscriptionMetrics{
+		metrics: metrics,
+	}
+}
+
+type withIdentity struct {
+	identity *stack.Identity
+}
+
+func (w *withIdentity) Apply(service *PubsubEventService) {
+	service.identity = w.identity
+}
+
+// WithIdentity - qualify topic ids with the stack and environment
+func WithIdentity(identity *stack.Identity) PubsubEventServiceOption {
+	return &withIdentity{
+		identity: identity,
+	}
+}
@@ -16,26 +16,85 @@ package pubsub_service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/pubsub"
-	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
 	ifaces_pubsub "github.com/nitrictech/nitric/pkg/ifaces/pubsub"
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type PubsubEventService struct {
 	events.UnimplementedeventsPlugin
-	client ifaces_pubsub.PubsubClient
+	// provider - creates the clients on first use, nil if they're already set
+	provider    core.GcpProvider
+	connectOnce sync.Once
+	connectErr  error
+	client      ifaces_pubsub.PubsubClient
+	cloudEvents cloudevents.Mode
+	metrics     ifaces_pubsub.SubscriptionMetrics
+	// identity - qualifies topic ids, so environments sharing a project don't share topics
+	identity *stack.Identity
+}
+
+// topicId - returns the id of a topic, qualified with the stack and environment
+func (s *PubsubEventService) topicId(topic string) (string, error) {
+	return naming.PubsubTopics.Physical(s.identity.Qualify(topic))
+}
+
+// connect - creates the clients the first time they're needed, so unused plugins don't slow cold starts
+func (s *PubsubEventService) connect() error {
+	s.connectOnce.Do(func() {
+		if s.provider == nil {
+			return
+		}
+
+		client, err := s.provider.PubsubClient()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		projectId, err := s.provider.ProjectID()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		monitoringService, err := s.provider.MonitoringService()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		s.client = ifaces_pubsub.AdaptPubsubClient(client)
+		s.metrics = ifaces_pubsub.NewMonitoringMetrics(monitoringService, projectId)
+	})
+
+	return s.connectErr
 }
 
 func (s *PubsubEventService) ListTopics() ([]string, error) {
 	newErr := errors.ErrorsWithScope("PubsubEventService.ListTopics", nil)
+
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
 	iter := s.client.Topics(context.TODO())
 
 	var topics []string
@@ -48,12 +107,64 @@ func (s *PubsubEventService) ListTopics() ([]string, error) {
 			)
 		}
 
-		topics = append(topics, topic.ID())
+		labels, err := topic.Labels(context.TODO())
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"error retrieving topic labels",
+				err,
+			)
+		}
+
+		// Topics of other stacks and environments sharing the project are skipped
+		if !s.identity.Matches(labels) {
+			continue
+		}
+
+		if name, ok := labels[stack.NameKey]; ok {
+			topics = append(topics, name)
+		} else {
+			topics = append(topics, topic.ID())
+		}
 	}
 
 	return topics, nil
 }
 
+// Provision - creates the topic if it doesn't exist, labelled with its nitric name and the stack's labels
+func (s *PubsubEventService) Provision(topic string, identity *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"PubsubEventService.Provision",
+		map[string]interface{}{
+			"topic": topic,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return newErr(codes.Unavailable, "unable to connect to pubsub", err)
+	}
+
+	id, err := naming.PubsubTopics.Physical(identity.Qualify(topic))
+	if err != nil {
+		return newErr(codes.AlreadyExists, "topic id collides with another topic", err)
+	}
+
+	exists, err := s.client.Topic(id).Exists(context.TODO())
+	if err != nil {
+		return newErr(codes.Internal, "error checking topic exists", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	if _, err := s.client.CreateTopic(context.TODO(), id, resources.Labels(topic, identity)); err != nil {
+		return newErr(codes.Internal, "unable to create topic", err)
+	}
+
+	return nil
+}
+
 func (s *PubsubEventService) Publish(topic string, event *events.NitricEvent) error {
 	newErr := errors.ErrorsWithScope(
 		"PubsubEventService.Publish",
@@ -63,9 +174,13 @@ func (s *PubsubEventService) Publish(topic string, event *events.NitricEvent) er
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return newErr(codes.Unavailable, "unable to connect to pubsub", err)
+	}
+
 	ctx := context.TODO()
 
-	eventBytes, err := json.Marshal(event)
+	attributes, eventBytes, err := cloudevents.EncodeMessage(s.cloudEvents, topic, event)
 	if err != nil {
 		return newErr(
 			codes.Internal,
@@ -73,17 +188,31 @@ func (s *PubsubEventService) Publish(topic string, event *events.NitricEvent) er
 			err,
 		)
 	}
+	attributes["x-nitric-topic"] = topic
 
-	pubsubTopic := s.client.Topic(topic)
+	id, err := s.topicId(topic)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "topic id collides with another topic", err)
+	}
+	pubsubTopic := s.client.Topic(id)
+
+	// Subscriptions with message ordering enabled deliver messages sharing an ordering key in the order they're published
+	if event.OrderingKey != "" {
+		pubsubTopic.EnableMessageOrdering()
+	}
 
 	msg := ifaces_pubsub.AdaptPubsubMessage(&pubsub.Message{
-		Attributes: map[string]string{
-			"x-nitric-topic": topic,
-		},
-		Data: eventBytes,
+		Attributes:  attributes,
+		Data:        eventBytes,
+		OrderingKey: event.OrderingKey,
 	})
 
 	if _, err := pubsubTopic.Publish(ctx, msg).Get(ctx); err != nil {
+		if event.OrderingKey != "" {
+			// A failed publish pauses publishing for its ordering key, so it's resumed for the event to be retried
+			pubsubTopic.ResumePublish(event.OrderingKey)
+		}
+
 		return newErr(
 			codes.Internal,
 			"topic publishing error",
@@ -94,26 +223,176 @@ func (s *PubsubEventService) Publish(topic string, event *events.NitricEvent) er
 	return nil
 }
 
-func New() (events.EventService, error) {
-	ctx := context.Background()
+// Replay - seeks each of the topic's subscriptions back to from, causing retained messages to be redelivered.
+// Seeking can't be bounded, so replaying up to a given time is not supported.
+func (s *PubsubEventService) Replay(topic string, from time.Time, to time.Time) error {
+	newErr := errors.ErrorsWithScope(
+		"PubsubEventService.Replay",
+		map[string]interface{}{
+			"topic": topic,
+			"from":  from,
+			"to":    to,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return newErr(codes.Unavailable, "unable to connect to pubsub", err)
+	}
+
+	if !to.IsZero() {
+		return newErr(
+			codes.InvalidArgument,
+			"pubsub replay does not support an end time",
+			nil,
+		)
+	}
+
+	id, err := s.topicId(topic)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "topic id collides with another topic", err)
+	}
+
+	ctx := context.TODO()
+
+	iter := s.client.Topic(id).Subscriptions(ctx)
+	for sub, err := iter.Next(); err != iterator.Done; sub, err = iter.Next() {
+		if err != nil {
+			return newErr(
+				codes.Internal,
+				"error retrieving topic subscriptions",
+				err,
+			)
+		}
+
+		if err := sub.SeekToTime(ctx, from); err != nil {
+			return newErr(
+				codes.Internal,
+				fmt.Sprintf("error seeking subscription %s", sub.ID()),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Backlog - Returns the number of undelivered messages summed across the topic's subscriptions
+func (s *PubsubEventService) Backlog(topic string) (int64, error) {
+	newErr := errors.ErrorsWithScope(
+		"PubsubEventService.Backlog",
+		map[string]interface{}{
+			"topic": topic,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return 0, newErr(codes.Unavailable, "unable to connect to pubsub", err)
+	}
+
+	if s.metrics == nil {
+		return 0, newErr(
+			codes.Unimplemented,
+			"subscription metrics are not available",
+			nil,
+		)
+	}
+
+	id, err := s.topicId(topic)
+	if err != nil {
+		return 0, newErr(codes.InvalidArgument, "topic id collides with another topic", err)
+	}
+
+	ctx := context.TODO()
+	pubsubTopic := s.client.Topic(id)
+
+	if exists, err := pubsubTopic.Exists(ctx); !exists || err != nil {
+		return 0, newErr(
+			codes.NotFound,
+			"topic not found",
+			err,
+		)
+	}
+
+	var backlog int64
+	subs := pubsubTopic.Subscriptions(ctx)
+	for {
+		sub, err := subs.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return 0, newErr(
+				codes.Internal,
+				"error retrieving subscriptions",
+				err,
+			)
+		}
+
+		undelivered, err := s.metrics.Latest(ctx, sub.ID(), ifaces_pubsub.NumUndeliveredMessages)
+		if err != nil {
+			return 0, newErr(
+				codes.Internal,
+				"failed to read undelivered messages",
+				err,
+			)
+		}
+		backlog += undelivered
+	}
+
+	return backlog, nil
+}
+
+// topicPermissions - the IAM permissions needed on a topic for nitric actions, topics are listed by their labels
+var topicPermissions = map[resources.Action][]string{
+	resources.TopicDetail:       {"pubsub.topics.get"},
+	resources.TopicEventPublish: {"pubsub.topics.publish"},
+}
+
+// CheckPermissions - tests the IAM permissions needed for the nitric actions on the topic
+func (s *PubsubEventService) CheckPermissions(topic string, actions []resources.Action) ([]resources.Permission, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+
+	id, err := s.topicId(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, topicPermissions[a]...)
+	}
+
+	return core.TestPermissions(s.client.Topic(id), "topics/"+id, needed)
+}
 
-	credentials, credentialsError := google.FindDefaultCredentials(ctx, pubsub.ScopeCloudPlatform)
-	if credentialsError != nil {
-		return nil, fmt.Errorf("GCP credentials error: %v", credentialsError)
+func New(provider core.GcpProvider) (events.EventService, error) {
+	mode, err := cloudevents.ModeFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
-	client, clientError := pubsub.NewClient(ctx, credentials.ProjectID)
-	if clientError != nil {
-		return nil, fmt.Errorf("pubsub client error: %v", clientError)
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	return &PubsubEventService{
-		client: ifaces_pubsub.AdaptPubsubClient(client),
+		provider:    provider,
+		cloudEvents: mode,
+		identity:    identity,
 	}, nil
 }
 
-func NewWithClient(client ifaces_pubsub.PubsubClient) (events.EventService, error) {
-	return &PubsubEventService{
+func NewWithClient(client ifaces_pubsub.PubsubClient, opts ...PubsubEventServiceOption) (events.EventService, error) {
+	service := &PubsubEventService{
 		client: client,
-	}, nil
+	}
+
+	for _, o := range opts {
+		o.Apply(service)
+	}
+
+	return service, nil
 }
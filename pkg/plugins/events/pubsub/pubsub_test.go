@@ -15,11 +15,16 @@
 package pubsub_service_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/nitrictech/nitric/pkg/plugins/events"
 	pubsub_service "github.com/nitrictech/nitric/pkg/plugins/events/pubsub"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 	mock_pubsub "github.com/nitrictech/nitric/tests/mocks/pubsub"
 )
 
@@ -85,5 +90,162 @@ var _ = Describe("Pubsub Plugin", func() {
 				Expect(pubsubClient.PublishedMessages["Test"]).To(HaveLen(1))
 			})
 		})
+
+		When("The event has an ordering key", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"Test"},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+			It("should publish the message with the ordering key", func() {
+				err := pubsubPlugin.Publish("Test", &events.NitricEvent{
+					ID:          "Test",
+					Payload:     map[string]interface{}{"Test": "Test"},
+					OrderingKey: "customer-1",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pubsubClient.PublishedMessages["Test"]).To(HaveLen(1))
+				Expect(pubsubClient.PublishedMessages["Test"][0].OrderingKey()).To(Equal("customer-1"))
+			})
+		})
+	})
+
+	When("Replaying Messages", func() {
+		from := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+		When("From a given time", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"Test"},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+			It("Should seek the topic's subscriptions to that time", func() {
+				err := pubsubPlugin.Replay("Test", from, time.Time{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pubsubClient.Seeks).To(HaveKeyWithValue("Test-nitricqueue", from))
+			})
+		})
+
+		When("Up to a given time", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"Test"},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+			It("Should return an error", func() {
+				err := pubsubPlugin.Replay("Test", from, from.Add(time.Hour))
+				Expect(err).Should(HaveOccurred())
+				Expect(pubsubClient.Seeks).To(BeEmpty())
+			})
+		})
+	})
+
+	When("Reading the backlog of a topic", func() {
+		When("The topic exists", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"Test"},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient, pubsub_service.WithSubscriptionMetrics(&staticMetrics{
+				values: map[string]int64{"Test-nitricqueue": 7},
+			}))
+
+			It("Should return the undelivered messages of its subscriptions", func() {
+				backlog, err := pubsubPlugin.Backlog("Test")
+				Expect(err).To(BeNil())
+				Expect(backlog).To(Equal(int64(7)))
+			})
+		})
+
+		When("The topic does not exist", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient, pubsub_service.WithSubscriptionMetrics(&staticMetrics{}))
+
+			It("Should return an error", func() {
+				_, err := pubsubPlugin.Backlog("Test")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	When("Provisioning a topic", func() {
+		When("The topic doesn't exist", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"orders"},
+			})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+			It("Should create it", func() {
+				err := pubsubPlugin.(resources.Provisioner).Provision("payments", &stack.Identity{Name: "shop"})
+				Expect(err).To(BeNil())
+
+				topics, _ := pubsubPlugin.ListTopics()
+				Expect(topics).To(ConsistOf("orders", "payments"))
+			})
+		})
+
+		When("Environments share the project", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{})
+			dev, _ := pubsub_service.NewWithClient(pubsubClient, pubsub_service.WithIdentity(&stack.Identity{Name: "shop", Environment: "dev"}))
+			prod, _ := pubsub_service.NewWithClient(pubsubClient, pubsub_service.WithIdentity(&stack.Identity{Name: "shop", Environment: "prod"}))
+
+			It("Should create and publish to a topic for each environment", func() {
+				Expect(dev.(resources.Provisioner).Provision("orders", &stack.Identity{Name: "shop", Environment: "dev"})).To(Succeed())
+				Expect(prod.(resources.Provisioner).Provision("orders", &stack.Identity{Name: "shop", Environment: "prod"})).To(Succeed())
+
+				Expect(dev.Publish("orders", &events.NitricEvent{ID: "1"})).To(Succeed())
+				Expect(pubsubClient.PublishedMessages["shop-dev-orders"]).To(HaveLen(1))
+				Expect(pubsubClient.PublishedMessages["shop-prod-orders"]).To(BeEmpty())
+
+				By("Listing only the environment's topics by their nitric name")
+				topics, err := prod.ListTopics()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(topics).To(ConsistOf("orders"))
+			})
+		})
+
+		When("The topic exists", func() {
+			pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{})
+			pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+			It("Should not create it again", func() {
+				Expect(pubsubPlugin.(resources.Provisioner).Provision("orders", &stack.Identity{Name: "shop"})).To(Succeed())
+
+				err := pubsubPlugin.(resources.Provisioner).Provision("orders", &stack.Identity{Name: "shop"})
+				Expect(err).To(BeNil())
+
+				topics, _ := pubsubPlugin.ListTopics()
+				Expect(topics).To(ConsistOf("orders"))
+			})
+		})
+	})
+
+	When("Checking permissions", func() {
+		pubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+			Topics:  []string{"orders"},
+			Granted: []string{"pubsub.topics.publish"},
+		})
+		pubsubPlugin, _ := pubsub_service.NewWithClient(pubsubClient)
+
+		It("Should test the permissions of the nitric actions on the topic", func() {
+			permissions, err := pubsubPlugin.(resources.PermissionChecker).CheckPermissions("orders", []resources.Action{
+				resources.TopicEventPublish, resources.TopicDetail,
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "pubsub.topics.get on topics/orders", Granted: false},
+				{Name: "pubsub.topics.publish on topics/orders", Granted: true},
+			}))
+		})
 	})
 })
+
+type staticMetrics struct {
+	values map[string]int64
+}
+
+func (m *staticMetrics) Latest(ctx context.Context, subscriptionId string, metricType string) (int64, error) {
+	return m.values[subscriptionId], nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sns_service
+
+import "github.com/nitrictech/nitric/pkg/cloudevents"
+
+type SnsEventServiceOption interface {
+	Apply(*SnsEventService)
+}
+
+type withCloudEvents struct {
+	mode cloudevents.Mode
+}
+
+func (w *withCloudEvents) Apply(service *SnsEventService) {
+	service.cloudEvents = w.mode
+}
+
+// WithCloudEvents - publish events as CloudEvents, in the given mode
+func WithCloudEvents(mode cloudevents.Mode) SnsEventServiceOption {
+	return &withCloudEvents{
+		mode: mode,
+	}
+}
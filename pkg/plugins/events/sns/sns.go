@@ -15,27 +15,36 @@
 package sns_service
 
 import (
-	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/events"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
-	utils2 "github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type SnsEventService struct {
 	events.UnimplementedeventsPlugin
-	client   snsiface.SNSAPI
-	provider core.AwsProvider
+	client      snsiface.SNSAPI
+	provider    core.AwsProvider
+	cloudEvents cloudevents.Mode
+	// simulates the IAM actions of operations, to check they're allowed without performing them
+	simulator core.PermissionSimulator
 }
 
+// fifoSuffix - the suffix of the names of FIFO topics
+const fifoSuffix = ".fifo"
+
 func (s *SnsEventService) getTopics() (map[string]string, error) {
 	return s.provider.GetResources(core.AwsResource_Topic)
 }
@@ -50,7 +59,7 @@ func (s *SnsEventService) Publish(topic string, event *events.NitricEvent) error
 		},
 	)
 
-	data, err := json.Marshal(event)
+	attributes, data, err := cloudevents.EncodeMessage(s.cloudEvents, topic, event)
 	if err != nil {
 		return newErr(
 			codes.Internal,
@@ -88,6 +97,28 @@ func (s *SnsEventService) Publish(topic string, event *events.NitricEvent) error
 		// MessageStructure: aws.String("json"),
 	}
 
+	// Only FIFO topics keep messages in order, they deliver messages in the same group in the order they're published.
+	// Every message published to a FIFO topic needs a group, so events without an ordering key are each in their own
+	if strings.HasSuffix(topicArn, fifoSuffix) {
+		group := event.OrderingKey
+		if group == "" {
+			group = event.ID
+		}
+
+		publishInput.MessageGroupId = aws.String(group)
+		publishInput.MessageDeduplicationId = aws.String(event.ID)
+	}
+
+	if len(attributes) > 0 {
+		publishInput.MessageAttributes = make(map[string]*sns.MessageAttributeValue, len(attributes))
+		for k, v := range attributes {
+			publishInput.MessageAttributes[k] = &sns.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(v),
+			}
+		}
+	}
+
 	_, err = s.client.Publish(publishInput)
 
 	if err != nil {
@@ -122,29 +153,111 @@ func (s *SnsEventService) ListTopics() ([]string, error) {
 	return topicNames, nil
 }
 
-// Create new SNS event service plugin
-func New(provider core.AwsProvider) (events.EventService, error) {
-	awsRegion := utils2.GetEnv("AWS_REGION", "us-east-1")
+// Provision - creates the topic if it doesn't exist, tagged with its nitric name and the stack's labels
+func (s *SnsEventService) Provision(topic string, identity *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"SnsEventService.Provision",
+		map[string]interface{}{
+			"topic": topic,
+		},
+	)
+
+	topics, err := s.getTopics()
+	if err != nil {
+		return newErr(codes.Internal, "error retrieving topics", err)
+	}
+
+	if _, ok := topics[topic]; ok {
+		return nil
+	}
 
-	sess, sessionError := session.NewSession(&aws.Config{
-		Region: aws.String(awsRegion),
+	tags := make([]*sns.Tag, 0)
+	for k, v := range resources.Labels(topic, identity) {
+		tags = append(tags, &sns.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return *tags[i].Key < *tags[j].Key
 	})
 
-	if sessionError != nil {
-		return nil, fmt.Errorf("error creating new AWS session %v", sessionError)
+	name, err := naming.SnsTopics.Physical(identity.Qualify(topic))
+	if err != nil {
+		return newErr(codes.AlreadyExists, "topic name collides with another topic", err)
+	}
+
+	out, err := s.client.CreateTopic(&sns.CreateTopicInput{
+		Name: aws.String(name),
+		Tags: tags,
+	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to create topic", err)
+	}
+
+	s.provider.AddResource(core.AwsResource_Topic, topic, *out.TopicArn)
+
+	return nil
+}
+
+// topicActions - the IAM actions needed on a topic for nitric actions, topics are listed by their tags
+var topicActions = map[resources.Action][]string{
+	resources.TopicDetail:       {"sns:GetTopicAttributes"},
+	resources.TopicEventPublish: {"sns:Publish"},
+}
+
+// CheckPermissions - simulates the IAM actions needed for the nitric actions on the topic
+func (s *SnsEventService) CheckPermissions(topic string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.simulator == nil {
+		return nil, fmt.Errorf("permissions can't be simulated without an IAM client")
+	}
+
+	topics, err := s.getTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	topicArn, ok := topics[topic]
+	if !ok {
+		return nil, fmt.Errorf("topic %s does not exist", topic)
+	}
+
+	needed := map[string][]string{}
+	for _, a := range actions {
+		needed[topicArn] = append(needed[topicArn], topicActions[a]...)
+	}
+
+	return core.SimulatePermissions(s.simulator, needed)
+}
+
+// Create new SNS event service plugin
+func New(provider core.AwsProvider) (events.EventService, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
 	}
 
 	snsClient := sns.New(sess)
 
+	mode, err := cloudevents.ModeFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	return &SnsEventService{
-		client:   snsClient,
-		provider: provider,
+		client:      snsClient,
+		provider:    provider,
+		cloudEvents: mode,
+		simulator:   core.NewPermissionSimulator(sess),
 	}, nil
 }
 
-func NewWithClient(provider core.AwsProvider, client snsiface.SNSAPI) (events.EventService, error) {
-	return &SnsEventService{
+func NewWithClient(provider core.AwsProvider, client snsiface.SNSAPI, opts ...SnsEventServiceOption) (events.EventService, error) {
+	service := &SnsEventService{
 		provider: provider,
 		client:   client,
-	}, nil
+	}
+
+	for _, o := range opts {
+		o.Apply(service)
+	}
+
+	return service, nil
 }
@@ -29,6 +29,8 @@ import (
 	"github.com/nitrictech/nitric/pkg/plugins/events"
 	sns_service "github.com/nitrictech/nitric/pkg/plugins/events/sns"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 var _ = Describe("Sns", func() {
@@ -91,6 +93,38 @@ var _ = Describe("Sns", func() {
 			})
 		})
 
+		When("Publishing an event with an ordering key to a FIFO topic", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsProvider(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock)
+			testEvent := &events.NitricEvent{
+				ID:          "testing",
+				Payload:     map[string]interface{}{"Test": "test"},
+				OrderingKey: "customer-1",
+			}
+
+			data, _ := json.Marshal(testEvent)
+
+			It("Should publish the message in the ordering key's group", func() {
+				awsMock.EXPECT().GetResources(core.AwsResource_Topic).Return(map[string]string{
+					"test": "arn:test.fifo",
+				}, nil)
+
+				snsMock.EXPECT().Publish(&sns.PublishInput{
+					TopicArn:               aws.String("arn:test.fifo"),
+					Message:                aws.String(string(data)),
+					MessageGroupId:         aws.String("customer-1"),
+					MessageDeduplicationId: aws.String("testing"),
+				})
+
+				err := eventsClient.Publish("test", testEvent)
+
+				Expect(err).To(BeNil())
+			})
+		})
+
 		When("Publishing to a non-existent topic", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			awsMock := provider_mocks.NewMockAwsProvider(ctrl)
@@ -114,4 +148,29 @@ var _ = Describe("Sns", func() {
 			})
 		})
 	})
+
+	Context("Provision", func() {
+		When("The topic doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsProvider(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock)
+
+			It("Should create the topic with the stack's tags", func() {
+				awsMock.EXPECT().GetResources(core.AwsResource_Topic).Return(map[string]string{}, nil)
+				snsMock.EXPECT().CreateTopic(&sns.CreateTopicInput{
+					Name: aws.String("shop-orders"),
+					Tags: []*sns.Tag{
+						{Key: aws.String("x-nitric-name"), Value: aws.String("orders")},
+						{Key: aws.String("x-nitric-stack"), Value: aws.String("shop")},
+					},
+				}).Return(&sns.CreateTopicOutput{TopicArn: aws.String("arn:aws:sns:us-east-1:123:shop-orders")}, nil)
+				awsMock.EXPECT().AddResource(core.AwsResource_Topic, "orders", "arn:aws:sns:us-east-1:123:shop-orders")
+
+				err := eventsClient.(resources.Provisioner).Provision("orders", &stack.Identity{Name: "shop"})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
 })
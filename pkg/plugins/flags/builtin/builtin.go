@@ -0,0 +1,201 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin_flags_service
+
+import (
+	"fmt"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+)
+
+// DefaultCollection - the collection flags are stored in when none is configured
+const DefaultCollection = "nitric-flags"
+
+// BuiltinFlagsService - evaluates flags stored as documents by the document plugin, one document per flag keyed by the flag.
+//
+// A flag's document has the value served to every context, and optionally targeting rules and a percentage rollout, e.g.
+//
+//	{
+//	  "value": false,
+//	  "rules": [{"attribute": "plan", "values": ["enterprise"], "value": true}],
+//	  "rollout": {"percentage": 25, "value": true}
+//	}
+//
+// Rules are checked in order, the first rule with one of the context attribute's values serves its value.
+// The context's key can be targeted with the "key" attribute. Contexts that match no rules are included in
+// the rollout by their key, so each context is served the same value until the percentage changes
+type BuiltinFlagsService struct {
+	flags.UnimplementedFlagsPlugin
+	documentPlugin document.DocumentService
+	collection     string
+}
+
+// flagRule - serves a value to contexts with one of the values of an attribute
+type flagRule struct {
+	attribute string
+	values    []interface{}
+	value     interface{}
+}
+
+// normalize - returns document numbers as float64, since document plugins decode them as either ints or floats
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	default:
+		return value
+	}
+}
+
+func (r *flagRule) matches(ctx *flags.Context) bool {
+	var attr interface{}
+	if r.attribute == "key" {
+		attr = ctx.Key
+	} else if a, ok := ctx.Attributes[r.attribute]; ok {
+		attr = normalize(a)
+	} else {
+		return false
+	}
+
+	for _, v := range r.values {
+		if normalize(v) == attr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRules - returns the targeting rules of a flag's document
+func parseRules(content map[string]interface{}) ([]*flagRule, error) {
+	raw, ok := content["rules"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("rules must be a list")
+	}
+
+	rules := make([]*flagRule, 0, len(list))
+	for i, r := range list {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("rule %d must be an object", i)
+		}
+
+		attribute, _ := rule["attribute"].(string)
+		values, _ := rule["values"].([]interface{})
+		if attribute == "" || len(values) == 0 {
+			return nil, fmt.Errorf("rule %d must have an attribute and values", i)
+		}
+
+		rules = append(rules, &flagRule{attribute: attribute, values: values, value: rule["value"]})
+	}
+
+	return rules, nil
+}
+
+// percentage - returns a rollout's percentage, as a number from 0 to 100
+func percentage(rollout map[string]interface{}) (float64, error) {
+	p, ok := normalize(rollout["percentage"]).(float64)
+	if !ok || p < 0 || p > 100 {
+		return 0, fmt.Errorf("rollout percentage must be a number from 0 to 100")
+	}
+
+	return p, nil
+}
+
+func (s *BuiltinFlagsService) Evaluate(flag string, ctx *flags.Context) (*flags.Evaluation, error) {
+	newErr := errors.ErrorsWithScope(
+		"BuiltinFlagsService.Evaluate",
+		map[string]interface{}{
+			"flag": flag,
+		},
+	)
+
+	if ctx == nil {
+		ctx = &flags.Context{}
+	}
+
+	doc, err := s.documentPlugin.Get(&document.Key{
+		Collection: &document.Collection{Name: s.collection},
+		Id:         flag,
+	})
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			return nil, newErr(codes.NotFound, "flag not found", err)
+		}
+
+		return nil, newErr(codes.Internal, "error retrieving flag", err)
+	}
+
+	rules, err := parseRules(doc.Content)
+	if err != nil {
+		return nil, newErr(codes.FailedPrecondition, "invalid flag", err)
+	}
+
+	for i, rule := range rules {
+		if rule.matches(ctx) {
+			return &flags.Evaluation{
+				Value:     normalize(rule.value),
+				Reason:    flags.ReasonTargeted,
+				Variation: fmt.Sprintf("rule-%d", i),
+			}, nil
+		}
+	}
+
+	if rollout, ok := doc.Content["rollout"].(map[string]interface{}); ok && ctx.Key != "" {
+		p, err := percentage(rollout)
+		if err != nil {
+			return nil, newErr(codes.FailedPrecondition, "invalid flag", err)
+		}
+
+		if float64(flags.Bucket(flag, ctx.Key)) < p {
+			return &flags.Evaluation{
+				Value:     normalize(rollout["value"]),
+				Reason:    flags.ReasonRollout,
+				Variation: "rollout",
+			}, nil
+		}
+	}
+
+	return &flags.Evaluation{
+		Value:  normalize(doc.Content["value"]),
+		Reason: flags.ReasonFallthrough,
+	}, nil
+}
+
+// New - creates a flags plugin evaluating flags stored in the given collection by the document plugin
+func New(documentPlugin document.DocumentService, collection string) flags.FlagsService {
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &BuiltinFlagsService{
+		documentPlugin: documentPlugin,
+		collection:     collection,
+	}
+}
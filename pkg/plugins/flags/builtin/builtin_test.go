@@ -0,0 +1,182 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin_flags_service_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	builtin_flags_service "github.com/nitrictech/nitric/pkg/plugins/flags/builtin"
+)
+
+// flagKey - the document key flags are read from
+func flagKey(flag string) *document.Key {
+	return &document.Key{
+		Collection: &document.Collection{Name: builtin_flags_service.DefaultCollection},
+		Id:         flag,
+	}
+}
+
+var _ = Describe("Builtin Flags", func() {
+	var (
+		ctrl    *gomock.Controller
+		mockDoc *mock_document.MockDocumentService
+		plugin  flags.FlagsService
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDoc = mock_document.NewMockDocumentService(ctrl)
+		plugin = builtin_flags_service.New(mockDoc, "")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("Evaluate", func() {
+		When("the flag doesn't exist", func() {
+			It("should return a not found error", func() {
+				mockDoc.EXPECT().Get(flagKey("missing")).Return(nil, errors.ErrorsWithScope("test", nil)(codes.NotFound, "document not found", nil))
+
+				_, err := plugin.Evaluate("missing", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("the context matches no rules", func() {
+			It("should serve the flag's value", func() {
+				mockDoc.EXPECT().Get(flagKey("page-size")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value": int64(20),
+						"rules": []interface{}{
+							map[string]interface{}{"attribute": "plan", "values": []interface{}{"enterprise"}, "value": int64(100)},
+						},
+					},
+				}, nil)
+
+				eval, err := plugin.Evaluate("page-size", &flags.Context{Key: "user-1", Attributes: map[string]interface{}{"plan": "free"}})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal(float64(20)))
+				Expect(eval.Reason).To(Equal(flags.ReasonFallthrough))
+			})
+		})
+
+		When("the context matches a rule", func() {
+			It("should serve the rule's value", func() {
+				mockDoc.EXPECT().Get(flagKey("new-checkout")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value": false,
+						"rules": []interface{}{
+							map[string]interface{}{"attribute": "key", "values": []interface{}{"admin"}, "value": true},
+							map[string]interface{}{"attribute": "plan", "values": []interface{}{"pro", "enterprise"}, "value": true},
+						},
+					},
+				}, nil)
+
+				eval, err := plugin.Evaluate("new-checkout", &flags.Context{Key: "user-1", Attributes: map[string]interface{}{"plan": "enterprise"}})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(BeTrue())
+				Expect(eval.Reason).To(Equal(flags.ReasonTargeted))
+				Expect(eval.Variation).To(Equal("rule-1"))
+			})
+
+			It("should target the context's key", func() {
+				mockDoc.EXPECT().Get(flagKey("new-checkout")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value": false,
+						"rules": []interface{}{
+							map[string]interface{}{"attribute": "key", "values": []interface{}{"admin"}, "value": true},
+						},
+					},
+				}, nil)
+
+				eval, err := plugin.Evaluate("new-checkout", &flags.Context{Key: "admin"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(BeTrue())
+				Expect(eval.Variation).To(Equal("rule-0"))
+			})
+		})
+
+		When("the flag has a rollout", func() {
+			It("should serve the rollout's value to every context at 100 percent", func() {
+				mockDoc.EXPECT().Get(flagKey("banner")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value":   "red",
+						"rollout": map[string]interface{}{"percentage": int64(100), "value": "blue"},
+					},
+				}, nil).Times(2)
+
+				for _, key := range []string{"user-1", "user-2"} {
+					eval, err := plugin.Evaluate("banner", &flags.Context{Key: key})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(eval.Value).To(Equal("blue"))
+					Expect(eval.Reason).To(Equal(flags.ReasonRollout))
+				}
+			})
+
+			It("should serve the flag's value at 0 percent", func() {
+				mockDoc.EXPECT().Get(flagKey("banner")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value":   "red",
+						"rollout": map[string]interface{}{"percentage": float64(0), "value": "blue"},
+					},
+				}, nil)
+
+				eval, err := plugin.Evaluate("banner", &flags.Context{Key: "user-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal("red"))
+			})
+
+			It("should serve the flag's value to contexts without a key", func() {
+				mockDoc.EXPECT().Get(flagKey("banner")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value":   "red",
+						"rollout": map[string]interface{}{"percentage": int64(100), "value": "blue"},
+					},
+				}, nil)
+
+				eval, err := plugin.Evaluate("banner", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal("red"))
+			})
+
+			It("should reject invalid percentages", func() {
+				mockDoc.EXPECT().Get(flagKey("banner")).Return(&document.Document{
+					Content: map[string]interface{}{
+						"value":   "red",
+						"rollout": map[string]interface{}{"percentage": int64(150), "value": "blue"},
+					},
+				}, nil)
+
+				_, err := plugin.Evaluate("banner", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+			})
+		})
+	})
+
+	Context("Bucket", func() {
+		It("should bucket a context the same way each time", func() {
+			Expect(flags.Bucket("banner", "user-1")).To(Equal(flags.Bucket("banner", "user-1")))
+			Expect(flags.Bucket("banner", "user-1")).To(BeNumerically("<", 100))
+		})
+	})
+})
@@ -0,0 +1,232 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flagsmith_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// DefaultApiUrl - the Flagsmith edge API
+const DefaultApiUrl = "https://edge.api.flagsmith.com/api/v1"
+
+// ReasonOff - the flag is disabled, Flagsmith serves no value for disabled flags with values
+const ReasonOff = "off"
+
+// FlagsmithService - flags plugin for Flagsmith.
+//
+// Contexts with a key are evaluated as identities with their attributes as traits, so segment and identity
+// overrides apply, contexts without a key get the environment's flags. Flags without a value evaluate to
+// whether they're enabled, flags with a value evaluate to their value while they're enabled.
+type FlagsmithService struct {
+	flags.UnimplementedFlagsPlugin
+	environmentKey string
+	apiUrl         string
+	client         *http.Client
+}
+
+type flagState struct {
+	Enabled bool        `json:"enabled"`
+	Value   interface{} `json:"feature_state_value"`
+	Feature struct {
+		Name string `json:"name"`
+	} `json:"feature"`
+}
+
+type trait struct {
+	Key   string      `json:"trait_key"`
+	Value interface{} `json:"trait_value"`
+}
+
+// do - sends a request to Flagsmith, returning the response body and status code
+func (s *FlagsmithService) do(method string, reqUrl string, body interface{}) ([]byte, int, error) {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, reqUrl, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req.Header.Set("X-Environment-Key", s.environmentKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// states - returns the flag states for a context
+func (s *FlagsmithService) states(ctx *flags.Context) ([]*flagState, int, error) {
+	if ctx.Key == "" {
+		body, status, err := s.do(http.MethodGet, s.apiUrl+"/flags/", nil)
+		if err != nil || status >= 300 {
+			return nil, status, err
+		}
+
+		states := make([]*flagState, 0)
+		return states, status, json.Unmarshal(body, &states)
+	}
+
+	traits := make([]trait, 0, len(ctx.Attributes))
+	for k, v := range ctx.Attributes {
+		traits = append(traits, trait{Key: k, Value: v})
+	}
+	sort.Slice(traits, func(i, j int) bool {
+		return traits[i].Key < traits[j].Key
+	})
+
+	body, status, err := s.do(http.MethodPost, s.apiUrl+"/identities/", map[string]interface{}{
+		"identifier": ctx.Key,
+		"traits":     traits,
+	})
+	if err != nil || status >= 300 {
+		return nil, status, err
+	}
+
+	identity := struct {
+		Flags []*flagState `json:"flags"`
+	}{}
+	return identity.Flags, status, json.Unmarshal(body, &identity)
+}
+
+func (s *FlagsmithService) Evaluate(flag string, ctx *flags.Context) (*flags.Evaluation, error) {
+	newErr := errors.ErrorsWithScope(
+		"FlagsmithService.Evaluate",
+		map[string]interface{}{
+			"flag": flag,
+		},
+	)
+
+	if ctx == nil {
+		ctx = &flags.Context{}
+	}
+
+	states, status, err := s.states(ctx)
+	if err != nil {
+		// Requests that got a response failed decoding it
+		code := codes.Unavailable
+		if status != 0 {
+			code = codes.Internal
+		}
+
+		return nil, newErr(
+			code,
+			"error evaluating flag",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return nil, newErr(
+			statusCode(status),
+			"error evaluating flag",
+			fmt.Errorf("unexpected response status %d", status),
+		)
+	}
+
+	for _, state := range states {
+		if state.Feature.Name != flag {
+			continue
+		}
+
+		if state.Value == nil || state.Value == "" {
+			return &flags.Evaluation{
+				Value:  state.Enabled,
+				Reason: flags.ReasonFallthrough,
+			}, nil
+		}
+
+		if !state.Enabled {
+			return &flags.Evaluation{
+				Reason: ReasonOff,
+			}, nil
+		}
+
+		return &flags.Evaluation{
+			Value:  state.Value,
+			Reason: flags.ReasonFallthrough,
+		}, nil
+	}
+
+	return nil, newErr(
+		codes.NotFound,
+		"flag not found",
+		nil,
+	)
+}
+
+// New - creates a new Flagsmith flags plugin for the environment FLAGSMITH_ENVIRONMENT_KEY
+func New() (flags.FlagsService, error) {
+	environmentKey := utils.GetEnv("FLAGSMITH_ENVIRONMENT_KEY", "")
+	if environmentKey == "" {
+		return nil, fmt.Errorf("FLAGSMITH_ENVIRONMENT_KEY env var is required to connect to Flagsmith")
+	}
+
+	return NewWithClient(environmentKey, utils.GetEnv("FLAGSMITH_API_URL", DefaultApiUrl), &http.Client{Timeout: 10 * time.Second}), nil
+}
+
+// NewWithClient - creates a new Flagsmith flags plugin sending requests to apiUrl with the given http client
+func NewWithClient(environmentKey string, apiUrl string, client *http.Client) *FlagsmithService {
+	return &FlagsmithService{
+		environmentKey: environmentKey,
+		apiUrl:         strings.TrimSuffix(apiUrl, "/"),
+		client:         client,
+	}
+}
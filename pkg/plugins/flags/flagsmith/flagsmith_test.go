@@ -0,0 +1,146 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flagsmith_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	flagsmith_service "github.com/nitrictech/nitric/pkg/plugins/flags/flagsmith"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+	header http.Header
+}
+
+// newFlagsmith - starts a fake Flagsmith API responding to every request with status and body, recording the requests it receives
+func newFlagsmith(status int, body string) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.Path, header: r.Header}
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+const identityFlags = `{
+	"flags": [
+		{"enabled": true, "feature_state_value": null, "feature": {"name": "new-checkout"}},
+		{"enabled": true, "feature_state_value": "blue", "feature": {"name": "banner"}},
+		{"enabled": false, "feature_state_value": 50, "feature": {"name": "page-size"}}
+	],
+	"traits": []
+}`
+
+var _ = Describe("Flagsmith", func() {
+	Context("Evaluate", func() {
+		When("the context has a key", func() {
+			It("should evaluate the flag for the identity and its traits", func() {
+				server, requests := newFlagsmith(http.StatusOK, identityFlags)
+				defer server.Close()
+
+				s := flagsmith_service.NewWithClient("env-key", server.URL+"/api/v1/", server.Client())
+				eval, err := s.Evaluate("banner", &flags.Context{
+					Key:        "user-1",
+					Attributes: map[string]interface{}{"plan": "enterprise"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal("blue"))
+
+				Expect((*requests)[0].method).To(Equal(http.MethodPost))
+				Expect((*requests)[0].path).To(Equal("/api/v1/identities/"))
+				Expect((*requests)[0].header.Get("X-Environment-Key")).To(Equal("env-key"))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"identifier": "user-1",
+					"traits": []interface{}{
+						map[string]interface{}{"trait_key": "plan", "trait_value": "enterprise"},
+					},
+				}))
+			})
+
+			It("should evaluate flags without values to whether they're enabled", func() {
+				server, _ := newFlagsmith(http.StatusOK, identityFlags)
+				defer server.Close()
+
+				eval, err := flagsmith_service.NewWithClient("env-key", server.URL, server.Client()).Evaluate("new-checkout", &flags.Context{Key: "user-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(BeTrue())
+			})
+
+			It("should serve no value for disabled flags with values", func() {
+				server, _ := newFlagsmith(http.StatusOK, identityFlags)
+				defer server.Close()
+
+				eval, err := flagsmith_service.NewWithClient("env-key", server.URL, server.Client()).Evaluate("page-size", &flags.Context{Key: "user-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(BeNil())
+				Expect(eval.Reason).To(Equal(flagsmith_service.ReasonOff))
+			})
+		})
+
+		When("the context has no key", func() {
+			It("should evaluate the environment's flags", func() {
+				server, requests := newFlagsmith(http.StatusOK, `[{"enabled": true, "feature_state_value": 50, "feature": {"name": "page-size"}}]`)
+				defer server.Close()
+
+				eval, err := flagsmith_service.NewWithClient("env-key", server.URL, server.Client()).Evaluate("page-size", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal(float64(50)))
+
+				Expect((*requests)[0].method).To(Equal(http.MethodGet))
+				Expect((*requests)[0].path).To(Equal("/flags/"))
+			})
+		})
+
+		When("the flag doesn't exist", func() {
+			It("should return a not found error", func() {
+				server, _ := newFlagsmith(http.StatusOK, identityFlags)
+				defer server.Close()
+
+				_, err := flagsmith_service.NewWithClient("env-key", server.URL, server.Client()).Evaluate("missing", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("the environment key is invalid", func() {
+			It("should return a permission denied error", func() {
+				server, _ := newFlagsmith(http.StatusUnauthorized, `{"detail": "Invalid environment key"}`)
+				defer server.Close()
+
+				_, err := flagsmith_service.NewWithClient("env-key", server.URL, server.Client()).Evaluate("banner", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+			})
+		})
+	})
+})
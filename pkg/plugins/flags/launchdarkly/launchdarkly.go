@@ -0,0 +1,282 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package launchdarkly_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// DefaultBaseUrl - the LaunchDarkly client-side SDK host
+const DefaultBaseUrl = "https://clientsdk.launchdarkly.com"
+
+// cacheTtl - how long the flags evaluated for a context are cached before they're evaluated again
+const cacheTtl = 10 * time.Second
+
+// maxCachedContexts - the number of contexts whose flags are cached, expired evaluations are removed once it's reached
+const maxCachedContexts = 1000
+
+type evaluated struct {
+	results map[string]*flagResult
+	at      time.Time
+}
+
+// LaunchDarklyService - flags plugin for LaunchDarkly.
+//
+// Flags are evaluated by LaunchDarkly's client-side evaluation endpoint, so only flags made available
+// to client-side SDKs in the environment can be evaluated. Contexts are evaluated as user contexts.
+// The endpoint evaluates every flag at once, so the flags evaluated for a context are cached briefly.
+type LaunchDarklyService struct {
+	flags.UnimplementedFlagsPlugin
+	clientSideId string
+	baseUrl      string
+	client       *http.Client
+	now          func() time.Time
+
+	lock sync.Mutex
+	// cache - the flags evaluated for each context, by its marshalled user context
+	cache map[string]*evaluated
+}
+
+type flagResult struct {
+	Value     interface{} `json:"value"`
+	Variation *int        `json:"variation"`
+	Reason    *struct {
+		Kind string `json:"kind"`
+	} `json:"reason"`
+}
+
+// reason - returns the plugin's reason for a LaunchDarkly evaluation reason kind
+func reason(kind string) string {
+	switch kind {
+	case "TARGET_MATCH", "RULE_MATCH":
+		return flags.ReasonTargeted
+	case "FALLTHROUGH":
+		return flags.ReasonFallthrough
+	default:
+		return strings.ToLower(kind)
+	}
+}
+
+// do - sends a request to LaunchDarkly, returning the response body and status code
+func (s *LaunchDarklyService) do(method string, reqUrl string, body interface{}) ([]byte, int, error) {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, reqUrl, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// userContext - returns the LaunchDarkly user context for a flags context.
+// Attributes that clash with the context's kind and key are dropped
+func userContext(ctx *flags.Context) map[string]interface{} {
+	c := make(map[string]interface{}, len(ctx.Attributes)+2)
+	for k, v := range ctx.Attributes {
+		c[k] = v
+	}
+
+	c["kind"] = "user"
+	c["key"] = ctx.Key
+	if ctx.Key == "" {
+		c["key"] = "anonymous"
+		c["anonymous"] = true
+	}
+
+	return c
+}
+
+// cached - returns the unexpired flags evaluated for a context
+func (s *LaunchDarklyService) cached(key string) (map[string]*flagResult, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	e, ok := s.cache[key]
+	if !ok || s.now().Sub(e.at) >= cacheTtl {
+		return nil, false
+	}
+
+	return e.results, true
+}
+
+// store - caches the flags evaluated for a context, removing expired evaluations if the cache is full
+func (s *LaunchDarklyService) store(key string, results map[string]*flagResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	if len(s.cache) >= maxCachedContexts {
+		for k, e := range s.cache {
+			if now.Sub(e.at) >= cacheTtl {
+				delete(s.cache, k)
+			}
+		}
+	}
+
+	if len(s.cache) < maxCachedContexts {
+		s.cache[key] = &evaluated{results: results, at: now}
+	}
+}
+
+func (s *LaunchDarklyService) Evaluate(flag string, ctx *flags.Context) (*flags.Evaluation, error) {
+	newErr := errors.ErrorsWithScope(
+		"LaunchDarklyService.Evaluate",
+		map[string]interface{}{
+			"flag": flag,
+		},
+	)
+
+	if ctx == nil {
+		ctx = &flags.Context{}
+	}
+
+	user := userContext(ctx)
+	key, err := json.Marshal(user)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"error marshalling context",
+			err,
+		)
+	}
+
+	results, ok := s.cached(string(key))
+	if !ok {
+		reqUrl := fmt.Sprintf("%s/sdk/evalx/%s/context?withReasons=true", s.baseUrl, url.PathEscape(s.clientSideId))
+		body, status, err := s.do("REPORT", reqUrl, user)
+		if err != nil {
+			return nil, newErr(
+				codes.Unavailable,
+				"error evaluating flag",
+				err,
+			)
+		}
+
+		if status >= 300 {
+			return nil, newErr(
+				statusCode(status),
+				"error evaluating flag",
+				fmt.Errorf("unexpected response status %d", status),
+			)
+		}
+
+		results = map[string]*flagResult{}
+		if err := json.Unmarshal(body, &results); err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"error reading flag evaluations",
+				err,
+			)
+		}
+
+		s.store(string(key), results)
+	}
+
+	result, ok := results[flag]
+	if !ok || result == nil {
+		return nil, newErr(
+			codes.NotFound,
+			"flag not found",
+			nil,
+		)
+	}
+
+	eval := &flags.Evaluation{
+		Value:  result.Value,
+		Reason: flags.ReasonFallthrough,
+	}
+	if result.Reason != nil && result.Reason.Kind != "" {
+		eval.Reason = reason(result.Reason.Kind)
+	}
+	if result.Variation != nil {
+		eval.Variation = strconv.Itoa(*result.Variation)
+	}
+
+	return eval, nil
+}
+
+// New - creates a new LaunchDarkly flags plugin for the environment LAUNCHDARKLY_CLIENT_SIDE_ID
+func New() (flags.FlagsService, error) {
+	clientSideId := utils.GetEnv("LAUNCHDARKLY_CLIENT_SIDE_ID", "")
+	if clientSideId == "" {
+		return nil, fmt.Errorf("LAUNCHDARKLY_CLIENT_SIDE_ID env var is required to connect to LaunchDarkly")
+	}
+
+	return NewWithClient(clientSideId, utils.GetEnv("LAUNCHDARKLY_BASE_URL", DefaultBaseUrl), &http.Client{Timeout: 10 * time.Second}), nil
+}
+
+// NewWithClient - creates a new LaunchDarkly flags plugin sending requests to baseUrl with the given http client
+func NewWithClient(clientSideId string, baseUrl string, client *http.Client) *LaunchDarklyService {
+	return &LaunchDarklyService{
+		clientSideId: clientSideId,
+		baseUrl:      strings.TrimSuffix(baseUrl, "/"),
+		client:       client,
+		now:          time.Now,
+		cache:        map[string]*evaluated{},
+	}
+}
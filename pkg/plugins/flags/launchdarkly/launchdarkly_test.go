@@ -0,0 +1,144 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package launchdarkly_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/flags"
+	launchdarkly_service "github.com/nitrictech/nitric/pkg/plugins/flags/launchdarkly"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	body   map[string]interface{}
+}
+
+// newLaunchDarkly - starts a fake LaunchDarkly host responding to every request with status and body, recording the requests it receives
+func newLaunchDarkly(status int, body string) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery}
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("LaunchDarkly", func() {
+	Context("Evaluate", func() {
+		When("the flag is evaluated", func() {
+			It("should return the flag's value for the user context", func() {
+				server, requests := newLaunchDarkly(http.StatusOK, `{
+					"new-checkout": {"value": true, "variation": 1, "version": 4, "reason": {"kind": "RULE_MATCH", "ruleIndex": 0}},
+					"banner": {"value": "blue", "variation": 0, "version": 2, "reason": {"kind": "FALLTHROUGH"}}
+				}`)
+				defer server.Close()
+
+				s := launchdarkly_service.NewWithClient("env-id", server.URL, server.Client())
+				eval, err := s.Evaluate("new-checkout", &flags.Context{
+					Key:        "user-1",
+					Attributes: map[string]interface{}{"plan": "enterprise"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(BeTrue())
+				Expect(eval.Reason).To(Equal(flags.ReasonTargeted))
+				Expect(eval.Variation).To(Equal("1"))
+
+				Expect((*requests)[0].method).To(Equal("REPORT"))
+				Expect((*requests)[0].path).To(Equal("/sdk/evalx/env-id/context"))
+				Expect((*requests)[0].query).To(Equal("withReasons=true"))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"kind": "user",
+					"key":  "user-1",
+					"plan": "enterprise",
+				}))
+			})
+		})
+
+		When("the context has no key", func() {
+			It("should evaluate an anonymous user", func() {
+				server, requests := newLaunchDarkly(http.StatusOK, `{"banner": {"value": "blue", "variation": 0}}`)
+				defer server.Close()
+
+				eval, err := launchdarkly_service.NewWithClient("env-id", server.URL, server.Client()).Evaluate("banner", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal("blue"))
+				Expect(eval.Reason).To(Equal(flags.ReasonFallthrough))
+				Expect((*requests)[0].body["anonymous"]).To(BeTrue())
+			})
+		})
+
+		When("flags are evaluated again for the same context", func() {
+			It("should use the cached evaluations", func() {
+				server, requests := newLaunchDarkly(http.StatusOK, `{
+					"new-checkout": {"value": true, "variation": 1},
+					"banner": {"value": "blue", "variation": 0}
+				}`)
+				defer server.Close()
+
+				s := launchdarkly_service.NewWithClient("env-id", server.URL, server.Client())
+				_, err := s.Evaluate("new-checkout", &flags.Context{Key: "user-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				eval, err := s.Evaluate("banner", &flags.Context{Key: "user-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(eval.Value).To(Equal("blue"))
+				Expect(*requests).To(HaveLen(1))
+
+				_, err = s.Evaluate("banner", &flags.Context{Key: "user-2"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(*requests).To(HaveLen(2))
+			})
+		})
+
+		When("the flag doesn't exist", func() {
+			It("should return a not found error", func() {
+				server, _ := newLaunchDarkly(http.StatusOK, `{}`)
+				defer server.Close()
+
+				_, err := launchdarkly_service.NewWithClient("env-id", server.URL, server.Client()).Evaluate("missing", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("the client-side id is invalid", func() {
+			It("should return a permission denied error", func() {
+				server, _ := newLaunchDarkly(http.StatusForbidden, ``)
+				defer server.Close()
+
+				_, err := launchdarkly_service.NewWithClient("env-id", server.URL, server.Client()).Evaluate("banner", &flags.Context{Key: "user-1"})
+				Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+			})
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Context - the user or entity a flag is evaluated for
+type Context struct {
+	// Key - identifies the user or entity, so gradual rollouts serve it the same value each time
+	Key string
+	// Attributes - attributes flags can target, e.g. country or plan
+	Attributes map[string]interface{}
+}
+
+// Reasons flags evaluate to their values. Providers may give reasons of their own
+const (
+	// ReasonTargeted - the context matched one of the flag's targeting rules
+	ReasonTargeted = "targeted"
+	// ReasonRollout - the context was included in the flag's percentage rollout
+	ReasonRollout = "rollout"
+	// ReasonFallthrough - the context matched no rules, so the flag's own value was served
+	ReasonFallthrough = "fallthrough"
+	// ReasonDefault - the flag doesn't exist, has a different type or couldn't be evaluated, so the caller's default was served
+	ReasonDefault = "default"
+)
+
+// Evaluation - the value of a flag for a context
+type Evaluation struct {
+	// Value - a bool, string or float64
+	Value interface{}
+	// Reason - why the flag evaluated to its value
+	Reason string
+	// Variation - the name of the variation served, if the provider names them
+	Variation string
+}
+
+type FlagsService interface {
+	// Evaluate - returns the value of the flag for the context, or a NotFound error if the flag doesn't exist
+	Evaluate(flag string, ctx *Context) (*Evaluation, error)
+}
+
+type UnimplementedFlagsPlugin struct {
+	FlagsService
+}
+
+var _ FlagsService = (*UnimplementedFlagsPlugin)(nil)
+
+func (*UnimplementedFlagsPlugin) Evaluate(flag string, ctx *Context) (*Evaluation, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+// Bucket - returns the percentile, from 0 to 99, a context falls in for a flag's rollout.
+// Contexts are bucketed by their key and the flag, so each context is in the same bucket every time,
+// while rollouts of different flags include different contexts
+func Bucket(flag string, key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flag + "/" + key))
+
+	return int(h.Sum32() % 100)
+}
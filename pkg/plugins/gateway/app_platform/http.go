@@ -22,6 +22,6 @@ import (
 
 // Create new HTTP gateway
 // XXX: No External Args for function atm (currently the plugin loader does not pass any argument information)
-func New() (gateway.GatewayService, error) {
-	return base_http.New(nil)
+func New(opts ...base_http.HttpGatewayOption) (gateway.GatewayService, error) {
+	return base_http.New(nil, opts...)
 }
@@ -16,7 +16,9 @@ package http_service
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/eventgrid"
@@ -34,10 +36,17 @@ type azMiddleware struct {
 	provider core.AzProvider
 }
 
+const subscriptionValidationEventType = "Microsoft.EventGrid.SubscriptionValidationEvent"
+
 func (a *azMiddleware) handleSubscriptionValidation(ctx *fasthttp.RequestCtx, events []eventgrid.Event) {
+	if len(events) == 0 || (events[0].EventType != nil && *events[0].EventType != subscriptionValidationEventType) {
+		ctx.Error("Missing subscription validation event", 400)
+		return
+	}
+
 	subPayload := events[0]
 	var validateData eventgrid.SubscriptionValidationEventData
-	if err := mapstructure.Decode(subPayload.Data, &validateData); err != nil {
+	if err := mapstructure.Decode(subPayload.Data, &validateData); err != nil || validateData.ValidationCode == nil {
 		ctx.Error("Invalid subscription event data", 400)
 		return
 	}
@@ -50,68 +59,163 @@ func (a *azMiddleware) handleSubscriptionValidation(ctx *fasthttp.RequestCtx, ev
 	ctx.Success("application/json", responseBody)
 }
 
-func (a *azMiddleware) handleNotifications(ctx *fasthttp.RequestCtx, events []eventgrid.Event, pool worker.WorkerPool) {
-	// TODO: As we are batch handling events
-	// how do we notify of failed event handling?
-	for _, event := range events {
-		// XXX: Assume we have a nitric event for now
-		// We have a valid nitric event
-		// Decode and pass to our function
-		var payloadBytes []byte
-		if stringData, ok := event.Data.(string); ok {
-			payloadBytes = []byte(stringData)
-		} else if byteData, ok := event.Data.([]byte); ok {
-			payloadBytes = byteData
-		} else {
-			// Assume a json serializable struct for now...
-			payloadBytes, _ = json.Marshal(event.Data)
+// topicName - returns the nitric name of the topic an event was published to, given the event's topic resource path,
+// e.g. /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.EventGrid/topics/<name>
+func topicName(topicPath string, topics map[string]core.AzGenericResource) string {
+	resourceName := topicPath[strings.LastIndex(topicPath, "/")+1:]
+
+	for name, t := range topics {
+		if strings.EqualFold(resourceName, t.Name) {
+			return name
 		}
+	}
 
-		var evt *triggers.Event
-		topics, err := a.provider.GetResources(core.AzResource_Topic)
-		if err != nil {
-			log.Default().Println("could not get topic resources")
+	return ""
+}
+
+const (
+	blobCreatedEventType = "Microsoft.Storage.BlobCreated"
+	blobDeletedEventType = "Microsoft.Storage.BlobDeleted"
+	// blobSubjectPrefix - prefixes the subjects of blob events, followed by <container>/blobs/<key>
+	blobSubjectPrefix = "/blobServices/default/containers/"
+)
+
+// blobNotification - returns the bucket notification a blob storage event is sent for, false if it isn't a blob storage event.
+// Containers are named after the buckets they store
+func blobNotification(event eventgrid.Event) (*worker.BucketNotification, bool) {
+	if event.EventType == nil || event.Subject == nil {
+		return nil, false
+	}
+
+	notification := &worker.BucketNotification{}
+	switch *event.EventType {
+	case blobCreatedEventType:
+		notification.Type = worker.NotificationCreated
+	case blobDeletedEventType:
+		notification.Type = worker.NotificationDeleted
+	default:
+		return nil, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(*event.Subject, blobSubjectPrefix), "/blobs/", 2)
+	if !strings.HasPrefix(*event.Subject, blobSubjectPrefix) || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, false
+	}
+
+	notification.Bucket = parts[0]
+	notification.Key = parts[1]
+
+	return notification, true
+}
+
+// eventPayload - returns the payload of an event, as it was published
+func eventPayload(data interface{}) []byte {
+	switch d := data.(type) {
+	case string:
+		return []byte(d)
+	case []byte:
+		return d
+	case nil:
+		return nil
+	default:
+		// Nitric event payloads are published as JSON objects
+		payload, _ := json.Marshal(d)
+		return payload
+	}
+}
+
+// handleNotifications - dispatches a batch of events to their subscribers.
+// Events that can't be dispatched are discarded, as redelivering them won't succeed,
+// while any subscriber failing fails the batch so Event Grid redelivers it.
+// Batches that only fail permanently are refused with a 400, which Event Grid dead-letters without retrying.
+func (a *azMiddleware) handleNotifications(ctx *fasthttp.RequestCtx, events []eventgrid.Event, pool worker.WorkerPool) {
+	topics, err := a.provider.GetResources(core.AzResource_Topic)
+	if err != nil {
+		log.Default().Println("could not get topic resources:", err)
+		ctx.Error("Unable to resolve event topics", 503)
+		return
+	}
+
+	deliveryCount := string(ctx.Request.Header.Peek("aeg-delivery-count"))
+	// The delivery count is zero on the first delivery
+	attempt := 0
+	if count, err := strconv.Atoi(deliveryCount); err == nil {
+		attempt = count + 1
+	}
+
+	failed := 0
+	permanent := 0
+	var retryErr error
+	for _, event := range events {
+		if event.ID == nil || event.Topic == nil {
+			log.Default().Println("discarding event without an id or topic")
 			continue
 		}
 
-		topicName := ""
-		for name, t := range topics {
-			if strings.HasSuffix(*event.Topic, t.Name) {
-				topicName = name
+		var evt *triggers.Event
+		if notification, ok := blobNotification(event); ok {
+			evt = notification.Event(*event.ID)
+		} else if name := topicName(*event.Topic, topics); name != "" {
+			evt = &triggers.Event{
+				ID:      *event.ID,
+				Topic:   name,
+				Payload: eventPayload(event.Data),
 			}
-		}
-
-		if topicName == "" {
-			log.Default().Println("could not resolve nitric name for topic", *event.Topic)
+		} else {
+			log.Default().Println("discarding event", *event.ID, "could not resolve nitric name for topic", *event.Topic)
 			continue
 		}
 
-		// Just extract the payload from the event type (payload from nitric event is directly mapped)
-		evt = &triggers.Event{
-			ID:      *event.ID,
-			Topic:   topicName,
-			Payload: payloadBytes,
+		evt.Attempt = attempt
+		evt.Metadata = triggers.Metadata{
+			Source:     *event.Topic,
+			ProviderID: *event.ID,
+		}
+		if event.EventTime != nil {
+			evt.Metadata.PublishTime = event.EventTime.Time
 		}
 
 		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
 			Event: evt,
 		})
 		if err != nil {
-			log.Default().Println("could not get worker for topic: ", topicName)
-			// TODO: Handle error
+			log.Default().Println("could not get worker for topic:", evt.Topic)
+			failed++
 			continue
 		}
 
-		err = wrkr.HandleEvent(evt)
-		if err != nil {
-			log.Default().Println("could not handle event: ", evt)
-			// TODO: Handle error
-			continue
+		if err := wrkr.HandleEvent(evt); err != nil {
+			log.Default().Printf("could not handle event %s for topic %s (delivery %s): %v", evt.ID, evt.Topic, deliveryCount, err)
+			if worker.IsPermanent(err) {
+				permanent++
+				continue
+			}
+
+			failed++
+			// The batch is redelivered once the longest requested delay has passed
+			if after, ok := worker.RetryAfter(err); ok {
+				if current, _ := worker.RetryAfter(retryErr); after > current {
+					retryErr = err
+				}
+			}
 		}
 	}
 
-	// Return 200 OK (TODO: Determine how we could mark individual events for failure)
-	// Or potentially requeue them here internally...
+	if failed > 0 {
+		if retryErr != nil {
+			base_http.EventError(ctx, retryErr)
+			return
+		}
+
+		ctx.Error(fmt.Sprintf("Failed to handle %d of %d events", failed, len(events)), 500)
+		return
+	}
+
+	if permanent > 0 {
+		ctx.Error(fmt.Sprintf("%d of %d events can't be handled", permanent, len(events)), 400)
+		return
+	}
+
 	ctx.SuccessString("text/plain", "success")
 }
 
@@ -122,32 +226,35 @@ func (a *azMiddleware) middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPo
 	if eventType != "" {
 		var eventgridEvents []eventgrid.Event
 		bytes := ctx.Request.Body()
-		// TODO: verify topic for validity
 		if err := json.Unmarshal(bytes, &eventgridEvents); err != nil {
 			ctx.Error("Invalid event grid types", 400)
 			return false
 		}
 
-		// Handle Eventgrid event
-		if eventType == "SubscriptionValidation" {
-			// Validate a subscription
+		switch eventType {
+		case "SubscriptionValidation":
 			a.handleSubscriptionValidation(ctx, eventgridEvents)
-			return false
-		} else if eventType == "Notification" {
-			// Handle notifications
+		case "Notification":
 			a.handleNotifications(ctx, eventgridEvents, pool)
-			return false
+		default:
+			// e.g. SubscriptionDeletion, acknowledged so it isn't redelivered
+			ctx.SuccessString("text/plain", "success")
 		}
+
+		return false
 	}
 
 	return true
 }
 
 // Create a new HTTP Gateway plugin
-func New(provider core.AzProvider) (gateway.GatewayService, error) {
+func New(provider core.AzProvider, opts ...base_http.HttpGatewayOption) (gateway.GatewayService, error) {
 	mw := &azMiddleware{
 		provider: provider,
 	}
 
-	return base_http.New(mw.middleware)
+	// App Service's front end replaces any request ID sent by clients
+	opts = append(opts, base_http.WithRequestIdHeader("X-ARR-LOG-ID"))
+
+	return base_http.New(mw.middleware, opts...)
 }
@@ -181,5 +181,94 @@ var _ = Describe("Http", func() {
 				Expect(event.Payload).To(BeEquivalentTo(payloadBytes))
 			})
 		})
+
+		When("With a batch of Notification events", func() {
+			It("Should dispatch each event published to a nitric topic", func() {
+				testTopic := "/subscriptions/1234/resourceGroups/test/providers/Microsoft.EventGrid/topics/test"
+				unknownTopic := "/subscriptions/1234/resourceGroups/test/providers/Microsoft.EventGrid/topics/other"
+				ids := []string{"1", "2", "3"}
+				evts := []eventgrid.Event{
+					{ID: &ids[0], Topic: &testTopic, Data: map[string]string{"n": "1"}},
+					{ID: &ids[1], Topic: &unknownTopic, Data: map[string]string{"n": "2"}},
+					{ID: &ids[2], Topic: &testTopic, Data: map[string]string{"n": "3"}},
+				}
+
+				requestBody, err := json.Marshal(evts)
+				Expect(err).To(BeNil())
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(requestBody))
+				Expect(err).To(BeNil())
+				request.Header.Add("aeg-event-type", "Notification")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				By("Acknowledging the batch")
+				Expect(resp.StatusCode).To(Equal(200))
+
+				By("Discarding the event published to an unknown topic")
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(2))
+				Expect(mockHandler.ReceivedEvents[0].ID).To(Equal("1"))
+				Expect(mockHandler.ReceivedEvents[1].ID).To(Equal("3"))
+				Expect(mockHandler.ReceivedEvents[1].Topic).To(Equal("test"))
+			})
+		})
+
+		When("With a blob storage Notification event", func() {
+			It("Should dispatch it as a bucket notification", func() {
+				storageAccount := "/subscriptions/1234/resourceGroups/test/providers/Microsoft.Storage/storageAccounts/test"
+				eventType := "Microsoft.Storage.BlobCreated"
+				subject := "/blobServices/default/containers/images/blobs/uploads/cat.png"
+				testID := "1234"
+				evts := []eventgrid.Event{
+					{ID: &testID, Topic: &storageAccount, EventType: &eventType, Subject: &subject, Data: map[string]string{}},
+				}
+
+				requestBody, err := json.Marshal(evts)
+				Expect(err).To(BeNil())
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(requestBody))
+				Expect(err).To(BeNil())
+				request.Header.Add("aeg-event-type", "Notification")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+				event := mockHandler.ReceivedEvents[0]
+
+				By("Sending it to the bucket named after the container")
+				Expect(event.Topic).To(Equal(worker.BucketTopic("images")))
+
+				notification, ok := worker.ParseBucketNotification(event)
+				Expect(ok).To(BeTrue())
+				Expect(notification.Key).To(Equal("uploads/cat.png"))
+				Expect(notification.Type).To(Equal(worker.NotificationCreated))
+				Expect(event.Metadata.Source).To(Equal(storageAccount))
+			})
+		})
+
+		When("With a SubscriptionValidation request without events", func() {
+			It("Should return a bad request", func() {
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader([]byte("[]")))
+				Expect(err).To(BeNil())
+				request.Header.Add("aeg-event-type", "SubscriptionValidation")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				Expect(resp.StatusCode).To(Equal(400))
+			})
+		})
+
+		When("With a SubscriptionDeletion event", func() {
+			It("Should acknowledge the event without invoking the nitric application", func() {
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader([]byte("[{}]")))
+				Expect(err).To(BeNil())
+				request.Header.Add("aeg-event-type", "SubscriptionDeletion")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				Expect(resp.StatusCode).To(Equal(200))
+				Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+				Expect(mockHandler.ReceivedRequests).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -0,0 +1,70 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// keepAliveComment - sent while an event stream is idle, so proxies and clients don't time out the connection
+var keepAliveComment = []byte(": keep-alive\n\n")
+
+// streamEvents - writes the events of a stream to its client as they're sent, with keep-alive comments while the stream is idle.
+// The stream is closed once the worker ends it or the client disconnects
+func streamEvents(w *bufio.Writer, stream *triggers.EventStream, keepAlive time.Duration) {
+	defer stream.Close()
+
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+
+	// Sends the response headers straight away, rather than with the first event
+	msg := keepAliveComment
+	for {
+		if _, err := w.Write(msg); err != nil {
+			return
+		}
+
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case evt, ok := <-stream.Events:
+			if !ok {
+				return
+			}
+			msg = evt.Encode()
+		case <-ticker.C:
+			msg = keepAliveComment
+		}
+	}
+}
+
+// writeEventStream - responds with an event stream, keeping the connection open until the stream ends
+func writeEventStream(ctx *fasthttp.RequestCtx, stream *triggers.EventStream, keepAlive time.Duration) {
+	ctx.Response.Header.SetContentType(triggers.EventStreamContentType)
+	ctx.Response.Header.Set("Cache-Control", "no-cache")
+	// Stops proxies such as nginx buffering the events
+	ctx.Response.Header.Set("X-Accel-Buffering", "no")
+
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		streamEvents(w, stream, keepAlive)
+	})
+}
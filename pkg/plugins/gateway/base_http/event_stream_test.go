@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// brokenWriter - fails every write, as though the client has disconnected
+type brokenWriter struct{}
+
+func (brokenWriter) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("broken pipe")
+}
+
+var _ = Describe("Event streams", func() {
+	When("the worker sends events then ends the stream", func() {
+		It("should write them in the event stream format", func() {
+			events := make(chan *triggers.ServerSentEvent, 2)
+			events <- &triggers.ServerSentEvent{ID: "1", Event: "greeting", Data: "hello\nworld"}
+			events <- &triggers.ServerSentEvent{Retry: 3 * time.Second}
+			close(events)
+
+			closed := false
+			out := &bytes.Buffer{}
+			streamEvents(bufio.NewWriter(out), triggers.NewEventStream(events, func() { closed = true }), time.Minute)
+
+			Expect(out.String()).To(Equal(": keep-alive\n\nid: 1\nevent: greeting\ndata: hello\ndata: world\n\nretry: 3000\n\n"))
+			Expect(closed).To(BeTrue())
+		})
+	})
+
+	When("the stream is idle", func() {
+		It("should send keep-alive comments", func() {
+			events := make(chan *triggers.ServerSentEvent)
+			time.AfterFunc(50*time.Millisecond, func() { close(events) })
+
+			out := &bytes.Buffer{}
+			streamEvents(bufio.NewWriter(out), triggers.NewEventStream(events, nil), 10*time.Millisecond)
+
+			Expect(bytes.Count(out.Bytes(), keepAliveComment)).To(BeNumerically(">", 1))
+		})
+	})
+
+	When("the client has disconnected", func() {
+		It("should close the stream", func() {
+			events := make(chan *triggers.ServerSentEvent)
+
+			closed := make(chan bool, 1)
+			streamEvents(bufio.NewWriter(brokenWriter{}), triggers.NewEventStream(events, func() { closed <- true }), time.Minute)
+
+			Expect(closed).To(Receive())
+		})
+	})
+})
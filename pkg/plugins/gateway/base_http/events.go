@@ -0,0 +1,42 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// EventError - responds to an event delivery that a worker failed to handle, so the provider redelivers or dead-letters it.
+// Permanent failures are refused with a 400, which providers don't retry, and requested delays are returned in a Retry-After header
+func EventError(ctx *fasthttp.RequestCtx, err error) {
+	if worker.IsPermanent(err) {
+		ctx.Error(fmt.Sprintf("Event can't be handled: %v", err), fasthttp.StatusBadRequest)
+		return
+	}
+
+	if after, ok := worker.RetryAfter(err); ok {
+		ctx.Error(fmt.Sprintf("Error handling event: %v", err), fasthttp.StatusServiceUnavailable)
+		ctx.Response.Header.Set(fasthttp.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(after.Seconds()))))
+		return
+	}
+
+	ctx.Error(fmt.Sprintf("Error handling event: %v", err), fasthttp.StatusInternalServerError)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+var _ = Describe("EventError", func() {
+	When("the event failed permanently", func() {
+		It("should refuse it so it isn't retried", func() {
+			ctx := &fasthttp.RequestCtx{}
+			EventError(ctx, &worker.PermanentError{Msg: "invalid event"})
+
+			Expect(ctx.Response.StatusCode()).To(Equal(400))
+		})
+	})
+
+	When("the worker requested a retry delay", func() {
+		It("should return the delay in a Retry-After header", func() {
+			ctx := &fasthttp.RequestCtx{}
+			EventError(ctx, &worker.RetryError{After: 1500 * time.Millisecond, Msg: "busy"})
+
+			Expect(ctx.Response.StatusCode()).To(Equal(503))
+			Expect(string(ctx.Response.Header.Peek("Retry-After"))).To(Equal("2"))
+		})
+	})
+
+	When("the event failed", func() {
+		It("should fail the delivery", func() {
+			ctx := &fasthttp.RequestCtx{}
+			EventError(ctx, fmt.Errorf("failed"))
+
+			Expect(ctx.Response.StatusCode()).To(Equal(500))
+			Expect(ctx.Response.Header.Peek("Retry-After")).To(BeEmpty())
+		})
+	})
+})
@@ -16,12 +16,20 @@
 package base_http
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/valyala/fasthttp"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
+	"github.com/nitrictech/nitric/pkg/graphql"
+	"github.com/nitrictech/nitric/pkg/limits"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
 	"github.com/nitrictech/nitric/pkg/triggers"
 	"github.com/nitrictech/nitric/pkg/utils"
 	"github.com/nitrictech/nitric/pkg/worker"
@@ -32,6 +40,20 @@ type HttpMiddleware func(*fasthttp.RequestCtx, worker.WorkerPool) bool
 type BaseHttpGateway struct {
 	address string
 	server  *fasthttp.Server
+	limits  *ServerLimits
+	tls     *TlsConfig
+	secrets secret.SecretService
+	certs   *certificates
+	// How often idle event streams are sent a keep-alive comment
+	keepAlive time.Duration
+	// Handles GraphQL requests, nil if GraphQL isn't enabled
+	graphql *graphql.Gateway
+	// The path CloudEvents are accepted at, empty if CloudEvents ingestion isn't enabled
+	cloudEventsPath string
+	// Verifies CloudEvent deliveries before they're handled, nil if they aren't verified
+	eventAuth RequestAuth
+	// The header the provider's front end identifies requests with, empty if requests aren't given a provider ID
+	requestIdHeader string
 	gateway.UnimplementedGatewayPlugin
 
 	// Middleware for handling events
@@ -40,8 +62,168 @@ type BaseHttpGateway struct {
 	mw HttpMiddleware
 }
 
+// RequestAuth - verifies a request, responding to it and returning false if it's rejected
+type RequestAuth func(*fasthttp.RequestCtx) bool
+
+// handleCloudEvent - dispatches CloudEvents published to nitric topics to their subscribers
+func (s *BaseHttpGateway) handleCloudEvent(ctx *fasthttp.RequestCtx, pool worker.WorkerPool, deadline time.Time) {
+	if cloudevents.HandleWebhookValidation(ctx) {
+		return
+	}
+
+	if s.eventAuth != nil && !s.eventAuth(ctx) {
+		return
+	}
+
+	ce, ok, err := cloudevents.FromHttpRequest(ctx)
+	if !ok {
+		ctx.Error("Request is not a CloudEvent", 400)
+		return
+	}
+
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Invalid CloudEvent: %v", err), 400)
+		return
+	}
+
+	if ce.Topic == "" {
+		ctx.Error("CloudEvent was not published to a nitric topic", 400)
+		return
+	}
+
+	evt, err := ce.ToTrigger()
+	if err != nil {
+		ctx.Error(fmt.Sprintf("Invalid CloudEvent data: %v", err), 400)
+		return
+	}
+	evt.Deadline = deadline
+
+	wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
+		Event: evt,
+	})
+	if err != nil {
+		ctx.Error("No worker available to handle event", 500)
+		return
+	}
+
+	if err := wrkr.HandleEvent(evt); err != nil {
+		EventError(ctx, err)
+	} else {
+		ctx.SuccessString("text/plain", "success")
+	}
+}
+
+// ServerLimits - limits applied to every request read by the gateway, before it's handled
+type ServerLimits struct {
+	// The largest request body in bytes, larger requests are refused with a 413
+	MaxBodySize int
+	// The largest request line and headers in bytes, larger requests are refused with a 431
+	MaxHeaderSize int
+	// How long clients have to send a request, slower requests are refused with a 408. Unlimited if zero
+	ReadTimeout time.Duration
+	// How long clients have to read a response. Unlimited if zero
+	WriteTimeout time.Duration
+}
+
+// serverLimitsFromEnv - returns the server limits configured by the GATEWAY_ env vars
+func serverLimitsFromEnv() (*ServerLimits, error) {
+	maxBodySizeEnv := utils.GetEnv("GATEWAY_MAX_BODY_SIZE", "4MB")
+	maxBodySize, err := limits.ParseSize(maxBodySizeEnv)
+	if err != nil || maxBodySize < 1 {
+		return nil, fmt.Errorf("invalid GATEWAY_MAX_BODY_SIZE env var, expected positive size, got %v", maxBodySizeEnv)
+	}
+
+	maxHeaderSizeEnv := utils.GetEnv("GATEWAY_MAX_HEADER_SIZE", "8KB")
+	maxHeaderSize, err := limits.ParseSize(maxHeaderSizeEnv)
+	if err != nil || maxHeaderSize < 1 {
+		return nil, fmt.Errorf("invalid GATEWAY_MAX_HEADER_SIZE env var, expected positive size, got %v", maxHeaderSizeEnv)
+	}
+
+	readTimeoutEnv := utils.GetEnv("GATEWAY_READ_TIMEOUT", "0s")
+	readTimeout, err := time.ParseDuration(readTimeoutEnv)
+	if err != nil || readTimeout < 0 {
+		return nil, fmt.Errorf("invalid GATEWAY_READ_TIMEOUT env var, expected duration, got %v", readTimeoutEnv)
+	}
+
+	writeTimeoutEnv := utils.GetEnv("GATEWAY_WRITE_TIMEOUT", "0s")
+	writeTimeout, err := time.ParseDuration(writeTimeoutEnv)
+	if err != nil || writeTimeout < 0 {
+		return nil, fmt.Errorf("invalid GATEWAY_WRITE_TIMEOUT env var, expected duration, got %v", writeTimeoutEnv)
+	}
+
+	return &ServerLimits{
+		MaxBodySize:   maxBodySize,
+		MaxHeaderSize: maxHeaderSize,
+		ReadTimeout:   readTimeout,
+		WriteTimeout:  writeTimeout,
+	}, nil
+}
+
+// graphqlFromEnv - returns the GraphQL gateway configured by the GATEWAY_GRAPHQL_ env vars, nil if GATEWAY_GRAPHQL_PATH isn't set
+func graphqlFromEnv() (*graphql.Gateway, error) {
+	path := utils.GetEnv("GATEWAY_GRAPHQL_PATH", "")
+	if path == "" {
+		return nil, nil
+	}
+
+	maxBatchEnv := utils.GetEnv("GATEWAY_GRAPHQL_MAX_BATCH", "10")
+	maxBatch, err := strconv.Atoi(maxBatchEnv)
+	if err != nil || maxBatch < 1 {
+		return nil, fmt.Errorf("invalid GATEWAY_GRAPHQL_MAX_BATCH env var, expected positive integer, got %v", maxBatchEnv)
+	}
+
+	persistedEnv := utils.GetEnv("GATEWAY_GRAPHQL_PERSISTED_QUERIES", "1000")
+	persisted, err := strconv.Atoi(persistedEnv)
+	if err != nil || persisted < 0 {
+		return nil, fmt.Errorf("invalid GATEWAY_GRAPHQL_PERSISTED_QUERIES env var, expected integer, got %v", persistedEnv)
+	}
+
+	return graphql.New(path, maxBatch, persisted), nil
+}
+
+// errorHandler - responds to requests the server was unable to read
+func errorHandler(ctx *fasthttp.RequestCtx, err error) {
+	if _, ok := err.(*fasthttp.ErrSmallBuffer); ok {
+		ctx.Error("Request headers too large", fasthttp.StatusRequestHeaderFieldsTooLarge)
+	} else if err == fasthttp.ErrBodyTooLarge {
+		ctx.Error("Request body too large", fasthttp.StatusRequestEntityTooLarge)
+	} else if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
+		ctx.Error("Request timeout", fasthttp.StatusRequestTimeout)
+	} else {
+		ctx.Error("Error when parsing request", fasthttp.StatusBadRequest)
+	}
+}
+
+// setClientCertHeader - tells handlers the subject of the client's verified certificate, replacing any value sent by the client
+func setClientCertHeader(ctx *fasthttp.RequestCtx, trigger *triggers.HttpRequest) {
+	for k := range trigger.Header {
+		if strings.EqualFold(k, ClientCertHeader) {
+			delete(trigger.Header, k)
+		}
+	}
+
+	if !ctx.IsTLS() {
+		return
+	}
+
+	state := ctx.TLSConnectionState()
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return
+	}
+
+	trigger.Header[ClientCertHeader] = []string{state.VerifiedChains[0][0].Subject.String()}
+}
+
 func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp.RequestCtx) {
 	return func(ctx *fasthttp.RequestCtx) {
+		// Responses written after the write timeout are lost, so it's the deadline for handling the request
+		deadline := triggers.EarliestDeadline(time.Time{}, s.limits.WriteTimeout)
+
+		if s.cloudEventsPath != "" && string(ctx.Path()) == s.cloudEventsPath {
+			s.handleCloudEvent(ctx, pool, deadline)
+			return
+		}
+
 		if s.mw != nil {
 			if !s.mw(ctx, pool) {
 				// middleware has indicated that is has processed the request
@@ -51,6 +233,20 @@ func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp
 		}
 
 		httpTrigger := triggers.FromHttpRequest(ctx)
+		httpTrigger.Deadline = deadline
+		// Only headers the provider sets are trusted, as clients can send any other, so redeliveries can't be given another ID
+		if s.requestIdHeader != "" {
+			httpTrigger.Metadata.ProviderID = string(ctx.Request.Header.Peek(s.requestIdHeader))
+		}
+		if s.certs != nil {
+			setClientCertHeader(ctx, httpTrigger)
+		}
+
+		if s.graphql != nil && s.graphql.Matches(httpTrigger.Path) {
+			s.writeResponse(ctx, s.graphql.HandleHttpRequest(pool, httpTrigger))
+			return
+		}
+
 		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
 			Http: httpTrigger,
 		})
@@ -65,29 +261,63 @@ func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp
 			return
 		}
 
-		if response.Header != nil {
-			response.Header.CopyTo(&ctx.Response.Header)
-		}
+		s.writeResponse(ctx, response)
+	}
+}
+
+// writeResponse - responds to a request with a worker's response
+func (s *BaseHttpGateway) writeResponse(ctx *fasthttp.RequestCtx, response *triggers.HttpResponse) {
+	if response.Header != nil {
+		response.Header.CopyTo(&ctx.Response.Header)
+	}
+
+	// Avoid content length header duplication
+	ctx.Response.Header.Del("Content-Length")
+	ctx.Response.SetStatusCode(response.StatusCode)
 
-		// Avoid content length header duplication
-		ctx.Response.Header.Del("Content-Length")
-		ctx.Response.SetStatusCode(response.StatusCode)
-		ctx.Response.SetBody(response.Body)
+	if response.Events != nil {
+		writeEventStream(ctx, response.Events, s.keepAlive)
+		return
 	}
+
+	ctx.Response.SetBody(response.Body)
 }
 
 func (s *BaseHttpGateway) Start(pool worker.WorkerPool) error {
 	s.server = &fasthttp.Server{
-		IdleTimeout:     time.Second * 1,
-		CloseOnShutdown: true,
-		Handler:         s.httpHandler(pool),
-		ReadBufferSize:  8192,
+		IdleTimeout:        time.Second * 1,
+		CloseOnShutdown:    true,
+		Handler:            s.httpHandler(pool),
+		ErrorHandler:       errorHandler,
+		ReadBufferSize:     s.limits.MaxHeaderSize,
+		MaxRequestBodySize: s.limits.MaxBodySize,
+		ReadTimeout:        s.limits.ReadTimeout,
+		WriteTimeout:       s.limits.WriteTimeout,
+	}
+
+	if s.tls == nil {
+		return s.server.ListenAndServe(s.address)
 	}
 
-	return s.server.ListenAndServe(s.address)
+	certs, err := newCertificates(s.tls, s.secrets)
+	if err != nil {
+		return fmt.Errorf("unable to load gateway certificates: %v", err)
+	}
+	s.certs = certs
+
+	ln, err := net.Listen("tcp4", s.address)
+	if err != nil {
+		return err
+	}
+
+	return s.server.Serve(tls.NewListener(ln, certs.tlsConfig()))
 }
 
 func (s *BaseHttpGateway) Stop() error {
+	if s.certs != nil {
+		s.certs.close()
+	}
+
 	if s.server != nil {
 		return s.server.Shutdown()
 	}
@@ -96,11 +326,43 @@ func (s *BaseHttpGateway) Stop() error {
 
 // Create new HTTP gateway
 // XXX: No External Args for function atm (currently the plugin loader does not pass any argument information)
-func New(mw HttpMiddleware) (gateway.GatewayService, error) {
+func New(mw HttpMiddleware, opts ...HttpGatewayOption) (gateway.GatewayService, error) {
 	address := utils.GetEnv("GATEWAY_ADDRESS", ":9001")
 
-	return &BaseHttpGateway{
-		address: address,
-		mw:      mw,
-	}, nil
+	serverLimits, err := serverLimitsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlsConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	keepAliveEnv := utils.GetEnv("GATEWAY_EVENT_STREAM_KEEPALIVE", "15s")
+	keepAlive, err := time.ParseDuration(keepAliveEnv)
+	if err != nil || keepAlive <= 0 {
+		return nil, fmt.Errorf("invalid GATEWAY_EVENT_STREAM_KEEPALIVE env var, expected positive duration, got %v", keepAliveEnv)
+	}
+
+	graphqlGateway, err := graphqlFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &BaseHttpGateway{
+		address:         address,
+		limits:          serverLimits,
+		tls:             tlsConfig,
+		keepAlive:       keepAlive,
+		graphql:         graphqlGateway,
+		cloudEventsPath: utils.GetEnv("GATEWAY_CLOUDEVENTS_PATH", ""),
+		mw:              mw,
+	}
+
+	for _, o := range opts {
+		o.Apply(g)
+	}
+
+	return g, nil
 }
@@ -0,0 +1,67 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import "github.com/nitrictech/nitric/pkg/plugins/secret"
+
+type HttpGatewayOption interface {
+	Apply(*BaseHttpGateway)
+}
+
+type withSecrets struct {
+	secrets secret.SecretService
+}
+
+func (w *withSecrets) Apply(gateway *BaseHttpGateway) {
+	gateway.secrets = w.secrets
+}
+
+// WithSecrets - load TLS certificates named by GATEWAY_TLS_SECRET from the secret plugin
+func WithSecrets(secrets secret.SecretService) HttpGatewayOption {
+	return &withSecrets{
+		secrets: secrets,
+	}
+}
+
+type withEventAuth struct {
+	auth RequestAuth
+}
+
+func (w *withEventAuth) Apply(gateway *BaseHttpGateway) {
+	gateway.eventAuth = w.auth
+}
+
+// WithEventAuth - verify CloudEvents delivered to GATEWAY_CLOUDEVENTS_PATH before they're handled
+func WithEventAuth(auth RequestAuth) HttpGatewayOption {
+	return &withEventAuth{
+		auth: auth,
+	}
+}
+
+type withRequestIdHeader struct {
+	header string
+}
+
+func (w *withRequestIdHeader) Apply(gateway *BaseHttpGateway) {
+	gateway.requestIdHeader = w.header
+}
+
+// WithRequestIdHeader - take the provider ID of http requests from a header set by the provider's front end,
+// which replaces any value sent by clients
+func WithRequestIdHeader(header string) HttpGatewayOption {
+	return &withRequestIdHeader{
+		header: header,
+	}
+}
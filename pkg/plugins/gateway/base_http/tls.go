@@ -0,0 +1,221 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// ClientCertHeader - the request header used to tell handlers the subject of a verified client certificate
+const ClientCertHeader = "X-Nitric-Client-Cert-Subject"
+
+// TlsConfig - where the gateway's TLS certificate and trusted client certificate authorities are loaded from
+type TlsConfig struct {
+	// PEM encoded certificate and key files
+	CertFile string
+	KeyFile  string
+	// The name of a secret holding the PEM encoded certificate and key, used instead of files
+	CertSecret string
+	// A PEM encoded file of the certificate authorities trusted to sign client certificates, client certificates aren't requested if empty
+	ClientCAFile string
+	// Whether a verified client certificate is required when ClientCAFile is set
+	ClientAuth tls.ClientAuthType
+	// How often the certificates are reloaded, never if zero
+	ReloadInterval time.Duration
+}
+
+// tlsConfigFromEnv - returns the TLS configuration set by the GATEWAY_TLS_ env vars, nil if TLS isn't enabled
+func tlsConfigFromEnv() (*TlsConfig, error) {
+	c := &TlsConfig{
+		CertFile:     utils.GetEnv("GATEWAY_TLS_CERT", ""),
+		KeyFile:      utils.GetEnv("GATEWAY_TLS_KEY", ""),
+		CertSecret:   utils.GetEnv("GATEWAY_TLS_SECRET", ""),
+		ClientCAFile: utils.GetEnv("GATEWAY_TLS_CLIENT_CA", ""),
+	}
+
+	if c.CertFile == "" && c.KeyFile == "" && c.CertSecret == "" {
+		if c.ClientCAFile != "" {
+			return nil, fmt.Errorf("GATEWAY_TLS_CLIENT_CA env var requires a certificate set by GATEWAY_TLS_CERT or GATEWAY_TLS_SECRET")
+		}
+		return nil, nil
+	}
+
+	if c.CertSecret != "" && (c.CertFile != "" || c.KeyFile != "") {
+		return nil, fmt.Errorf("only one of the GATEWAY_TLS_CERT and GATEWAY_TLS_SECRET env vars can be set")
+	}
+
+	if c.CertSecret == "" && (c.CertFile == "" || c.KeyFile == "") {
+		return nil, fmt.Errorf("GATEWAY_TLS_CERT and GATEWAY_TLS_KEY env vars must be set together")
+	}
+
+	clientAuthEnv := utils.GetEnv("GATEWAY_TLS_CLIENT_AUTH", "require")
+	switch strings.ToLower(clientAuthEnv) {
+	case "require":
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	case "optional":
+		c.ClientAuth = tls.VerifyClientCertIfGiven
+	default:
+		return nil, fmt.Errorf("invalid GATEWAY_TLS_CLIENT_AUTH env var, expected require or optional, got %v", clientAuthEnv)
+	}
+
+	reloadEnv := utils.GetEnv("GATEWAY_TLS_RELOAD_INTERVAL", "1m")
+	reload, err := time.ParseDuration(reloadEnv)
+	if err != nil || reload < 0 {
+		return nil, fmt.Errorf("invalid GATEWAY_TLS_RELOAD_INTERVAL env var, expected duration, got %v", reloadEnv)
+	}
+	c.ReloadInterval = reload
+
+	return c, nil
+}
+
+// certificates - serves the gateway's current certificate and trusted client certificate authorities, reloading them periodically
+type certificates struct {
+	config  *TlsConfig
+	secrets secret.SecretService
+
+	lock      sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+
+	stop chan bool
+}
+
+func (c *certificates) load() error {
+	var certPEM, keyPEM []byte
+	var err error
+
+	if c.config.CertSecret != "" {
+		if c.secrets == nil {
+			return fmt.Errorf("unable to load certificate secret %s, no secret plugin available", c.config.CertSecret)
+		}
+
+		resp, err := c.secrets.Access(&secret.SecretVersion{
+			Secret:  &secret.Secret{Name: c.config.CertSecret},
+			Version: "latest",
+		})
+		if err != nil {
+			return fmt.Errorf("unable to access certificate secret %s: %v", c.config.CertSecret, err)
+		}
+
+		// The secret holds both the certificate chain and key, X509KeyPair skips the blocks it isn't looking for
+		certPEM, keyPEM = resp.Value, resp.Value
+	} else {
+		if certPEM, err = ioutil.ReadFile(c.config.CertFile); err != nil {
+			return fmt.Errorf("unable to read certificate: %v", err)
+		}
+
+		if keyPEM, err = ioutil.ReadFile(c.config.KeyFile); err != nil {
+			return fmt.Errorf("unable to read certificate key: %v", err)
+		}
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %v", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if c.config.ClientCAFile != "" {
+		caPEM, err := ioutil.ReadFile(c.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("unable to read client certificate authorities: %v", err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("no client certificate authorities found in %s", c.config.ClientCAFile)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cert = &cert
+	c.clientCAs = clientCAs
+
+	return nil
+}
+
+// reload - reloads the certificates until stopped, keeping the current certificates when they fail to load
+func (c *certificates) reload() {
+	ticker := time.NewTicker(c.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.load(); err != nil {
+				log.Default().Printf("unable to reload gateway certificates, continuing to use the current certificates: %v", err)
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// tlsConfig - returns a server TLS configuration that uses the current certificates for each connection
+func (c *certificates) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c.lock.RLock()
+			defer c.lock.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*c.cert},
+			}
+
+			if c.clientCAs != nil {
+				config.ClientCAs = c.clientCAs
+				config.ClientAuth = c.config.ClientAuth
+			}
+
+			return config, nil
+		},
+	}
+}
+
+func (c *certificates) close() {
+	close(c.stop)
+}
+
+// newCertificates - loads the certificates described by the config, reloading them in the background if a reload interval is set
+func newCertificates(config *TlsConfig, secrets secret.SecretService) (*certificates, error) {
+	c := &certificates{
+		config:  config,
+		secrets: secrets,
+		stop:    make(chan bool),
+	}
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	if config.ReloadInterval > 0 {
+		go c.reload()
+	}
+
+	return c, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newTestCert - returns a certificate for the common name, self signed if parent is nil
+func newTestCert(commonName string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+type mockSecrets struct {
+	secret.UnimplementedSecretPlugin
+	values map[string][]byte
+}
+
+func (m *mockSecrets) Access(version *secret.SecretVersion) (*secret.SecretAccessResponse, error) {
+	return &secret.SecretAccessResponse{
+		SecretVersion: version,
+		Value:         m.values[version.Secret.Name],
+	}, nil
+}
+
+// handshake - connects a client to a server using the certificates, returning the server's view of the connection
+func handshake(certs *certificates, client *tls.Config) (tls.ConnectionState, error) {
+	serverConn, clientConn := net.Pipe()
+	server := tls.Server(serverConn, certs.tlsConfig())
+
+	go func() {
+		_ = tls.Client(clientConn, client).Handshake()
+		clientConn.Close()
+	}()
+
+	err := server.Handshake()
+	serverConn.Close()
+
+	return server.ConnectionState(), err
+}
+
+var _ = Describe("TLS", func() {
+	var dir string
+	ca := newTestCert("nitric-ca", nil)
+	serverCert := newTestCert("membrane.local", ca)
+	clientCert := newTestCert("orders-service", ca)
+
+	write := func(name string, content []byte) string {
+		file := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(file, content, 0o600)).To(Succeed())
+		return file
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "gateway-tls")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	When("loading certificates from files", func() {
+		It("should serve the certificate", func() {
+			certs, err := newCertificates(&TlsConfig{
+				CertFile: write("cert.pem", serverCert.certPEM),
+				KeyFile:  write("key.pem", serverCert.keyPEM),
+			}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer certs.close()
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.cert)
+
+			_, err = handshake(certs, &tls.Config{RootCAs: roots, ServerName: "membrane.local"})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should fail if the files are invalid", func() {
+			_, err := newCertificates(&TlsConfig{
+				CertFile: write("cert.pem", serverCert.certPEM),
+				KeyFile:  write("key.pem", clientCert.keyPEM),
+			}, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("loading certificates from a secret", func() {
+		It("should load the certificate and key from the same secret", func() {
+			certs, err := newCertificates(&TlsConfig{CertSecret: "gateway-cert"}, &mockSecrets{
+				values: map[string][]byte{"gateway-cert": append(serverCert.certPEM, serverCert.keyPEM...)},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer certs.close()
+
+			leaf, err := x509.ParseCertificate(certs.cert.Certificate[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(leaf.Subject.CommonName).To(Equal("membrane.local"))
+		})
+
+		It("should fail without a secret plugin", func() {
+			_, err := newCertificates(&TlsConfig{CertSecret: "gateway-cert"}, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("the certificate files change", func() {
+		It("should reload them", func() {
+			certFile := write("cert.pem", serverCert.certPEM)
+			keyFile := write("key.pem", serverCert.keyPEM)
+
+			certs, err := newCertificates(&TlsConfig{
+				CertFile:       certFile,
+				KeyFile:        keyFile,
+				ReloadInterval: 10 * time.Millisecond,
+			}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer certs.close()
+
+			renewed := newTestCert("membrane.local", ca)
+			write("key.pem", renewed.keyPEM)
+			write("cert.pem", renewed.certPEM)
+
+			Eventually(func() []byte {
+				certs.lock.RLock()
+				defer certs.lock.RUnlock()
+				return certs.cert.Certificate[0]
+			}).Should(Equal(renewed.cert.Raw))
+		})
+	})
+
+	When("client certificates are required", func() {
+		var certs *certificates
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.cert)
+
+		BeforeEach(func() {
+			var err error
+			certs, err = newCertificates(&TlsConfig{
+				CertFile:     write("cert.pem", serverCert.certPEM),
+				KeyFile:      write("key.pem", serverCert.keyPEM),
+				ClientCAFile: write("ca.pem", ca.certPEM),
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+			}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			certs.close()
+		})
+
+		It("should accept clients with a trusted certificate", func() {
+			keyPair, err := tls.X509KeyPair(clientCert.certPEM, clientCert.keyPEM)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, err := handshake(certs, &tls.Config{
+				RootCAs:      roots,
+				ServerName:   "membrane.local",
+				Certificates: []tls.Certificate{keyPair},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state.VerifiedChains[0][0].Subject.CommonName).To(Equal("orders-service"))
+		})
+
+		It("should refuse clients without a certificate", func() {
+			_, err := handshake(certs, &tls.Config{RootCAs: roots, ServerName: "membrane.local"})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should refuse clients with an untrusted certificate", func() {
+			untrusted := newTestCert("orders-service", nil)
+			keyPair, err := tls.X509KeyPair(untrusted.certPEM, untrusted.keyPEM)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = handshake(certs, &tls.Config{
+				RootCAs:      roots,
+				ServerName:   "membrane.local",
+				Certificates: []tls.Certificate{keyPair},
+			})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
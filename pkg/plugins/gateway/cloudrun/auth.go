@@ -0,0 +1,133 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun_plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/api/option"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// TokenValidator - validates Google signed OIDC tokens
+type TokenValidator interface {
+	Validate(ctx context.Context, token string, audience string) (*idtoken.Payload, error)
+}
+
+var _ TokenValidator = (*idtoken.Validator)(nil)
+
+// PushAuth - verifies the OIDC tokens Pub/Sub push subscriptions include with each delivery,
+// so events can't be spoofed by posting to the push endpoint
+type PushAuth struct {
+	validator TokenValidator
+	// The audience the token must be issued for, configured rather than taken from the request's host and forwarded headers
+	audience string
+	// The service accounts push subscriptions are allowed to authenticate as, every delivery is refused if empty
+	serviceAccounts []string
+}
+
+type pushAuthError struct {
+	status int
+	msg    string
+}
+
+func (e *pushAuthError) Error() string {
+	return e.msg
+}
+
+// verify - returns an error if the request doesn't include a valid token for an allowed service account
+func (a *PushAuth) verify(ctx *fasthttp.RequestCtx) *pushAuthError {
+	authorization := string(ctx.Request.Header.Peek("Authorization"))
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return &pushAuthError{status: 401, msg: "missing push subscription token"}
+	}
+
+	payload, err := a.validator.Validate(ctx, strings.TrimPrefix(authorization, "Bearer "), a.audience)
+	if err != nil {
+		return &pushAuthError{status: 401, msg: fmt.Sprintf("invalid push subscription token: %v", err)}
+	}
+
+	if payload.Issuer != "accounts.google.com" && payload.Issuer != "https://accounts.google.com" {
+		return &pushAuthError{status: 401, msg: fmt.Sprintf("push subscription token issued by unknown issuer %s", payload.Issuer)}
+	}
+
+	// Any Google account can be issued a token for the audience, so only the expected service accounts are trusted
+	email, _ := payload.Claims["email"].(string)
+	if email == "" {
+		return &pushAuthError{status: 403, msg: "push subscription token has no email"}
+	}
+
+	if verified, _ := payload.Claims["email_verified"].(bool); !verified {
+		return &pushAuthError{status: 403, msg: "push subscription token email isn't verified"}
+	}
+
+	for _, sa := range a.serviceAccounts {
+		if strings.EqualFold(sa, email) {
+			return nil
+		}
+	}
+
+	return &pushAuthError{status: 403, msg: fmt.Sprintf("service account %s isn't allowed to push events", email)}
+}
+
+// NewPushAuth - returns push authentication that verifies tokens with the validator
+func NewPushAuth(validator TokenValidator, audience string, serviceAccounts []string) *PushAuth {
+	return &PushAuth{
+		validator:       validator,
+		audience:        audience,
+		serviceAccounts: serviceAccounts,
+	}
+}
+
+// pushAuthFromEnv - returns the push authentication configured by PUBSUB_PUSH_AUDIENCE and PUBSUB_PUSH_SERVICE_ACCOUNTS, nil if neither is set
+func pushAuthFromEnv() (*PushAuth, error) {
+	audience := utils.GetEnv("PUBSUB_PUSH_AUDIENCE", "")
+	serviceAccountsEnv := utils.GetEnv("PUBSUB_PUSH_SERVICE_ACCOUNTS", "")
+
+	if audience == "" && serviceAccountsEnv == "" {
+		log.Default().Println("WARNING: PUBSUB_PUSH_AUDIENCE and PUBSUB_PUSH_SERVICE_ACCOUNTS aren't set, push deliveries won't be verified and events can be spoofed by posting to the service")
+		return nil, nil
+	}
+
+	// The audience can't be derived from the request, its host and forwarded headers are controlled by the caller
+	if audience == "" {
+		return nil, fmt.Errorf("PUBSUB_PUSH_AUDIENCE is required to verify push deliveries, set it to the audience of the push subscriptions or the service's URL")
+	}
+
+	serviceAccounts := make([]string, 0)
+	for _, sa := range strings.Split(serviceAccountsEnv, ",") {
+		if sa = strings.TrimSpace(sa); sa != "" {
+			serviceAccounts = append(serviceAccounts, sa)
+		}
+	}
+
+	if len(serviceAccounts) == 0 {
+		return nil, fmt.Errorf("PUBSUB_PUSH_SERVICE_ACCOUNTS is required to verify push deliveries, set it to the service accounts of the push subscriptions")
+	}
+
+	// Google's signing keys are public, so fetching them doesn't need credentials
+	validator, err := idtoken.NewValidator(context.Background(), option.WithoutAuthentication())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create push subscription token validator: %v", err)
+	}
+
+	return NewPushAuth(validator, audience, serviceAccounts), nil
+}
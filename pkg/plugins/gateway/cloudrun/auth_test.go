@@ -0,0 +1,225 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun_plugin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/idtoken"
+
+	"github.com/nitrictech/nitric/pkg/plugins/gateway"
+	cloudrun_plugin "github.com/nitrictech/nitric/pkg/plugins/gateway/cloudrun"
+	"github.com/nitrictech/nitric/pkg/worker"
+	mock_worker "github.com/nitrictech/nitric/tests/mocks/worker"
+)
+
+const AUTH_GATEWAY_ADDRESS = "127.0.0.1:9002"
+
+type mockValidator struct {
+	audience string
+	payloads map[string]*idtoken.Payload
+}
+
+func (v *mockValidator) Validate(ctx context.Context, token string, audience string) (*idtoken.Payload, error) {
+	v.audience = audience
+
+	if payload, ok := v.payloads[token]; ok {
+		return payload, nil
+	}
+
+	return nil, fmt.Errorf("invalid token")
+}
+
+var _ = Describe("PushAuth", func() {
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+	mockHandler := mock_worker.NewMockWorker(&mock_worker.MockWorkerOptions{})
+	Expect(pool.AddWorker(mockHandler)).To(Succeed())
+
+	validator := &mockValidator{
+		payloads: map[string]*idtoken.Payload{
+			"allowed": {
+				Issuer: "https://accounts.google.com",
+				Claims: map[string]interface{}{"email": "pusher@project.iam.gserviceaccount.com", "email_verified": true},
+			},
+			"other": {
+				Issuer: "https://accounts.google.com",
+				Claims: map[string]interface{}{"email": "other@project.iam.gserviceaccount.com", "email_verified": true},
+			},
+			"unverified": {
+				Issuer: "https://accounts.google.com",
+				Claims: map[string]interface{}{"email": "pusher@project.iam.gserviceaccount.com", "email_verified": false},
+			},
+			"anonymous": {
+				Issuer: "https://accounts.google.com",
+				Claims: map[string]interface{}{},
+			},
+			"untrusted": {
+				Issuer: "https://issuer.example.com",
+				Claims: map[string]interface{}{"email": "pusher@project.iam.gserviceaccount.com", "email_verified": true},
+			},
+		},
+	}
+
+	os.Setenv("GATEWAY_ADDRESS", AUTH_GATEWAY_ADDRESS)
+	os.Setenv("GATEWAY_CLOUDEVENTS_PATH", "/x-nitric-cloudevents")
+	httpPlugin, err := cloudrun_plugin.NewWithPushAuth(cloudrun_plugin.NewPushAuth(validator, "https://service.example.com", []string{"pusher@project.iam.gserviceaccount.com"}))
+	Expect(err).To(BeNil())
+	os.Unsetenv("GATEWAY_ADDRESS")
+	os.Unsetenv("GATEWAY_CLOUDEVENTS_PATH")
+
+	go func(gw gateway.GatewayService) {
+		_ = gw.Start(pool)
+	}(httpPlugin)
+
+	// Delay to allow the HTTP server to correctly start
+	time.Sleep(500 * time.Millisecond)
+
+	AfterEach(func() {
+		mockHandler.Reset()
+	})
+
+	payloadBytes, _ := json.Marshal(&map[string]interface{}{
+		"subscription": "test",
+		"message": map[string]interface{}{
+			"attributes": map[string]string{
+				"x-nitric-topic": "test",
+			},
+			"id":   "test",
+			"data": "",
+		},
+	})
+
+	push := func(token string, headers ...string) *http.Response {
+		request, err := http.NewRequest("POST", fmt.Sprintf("http://%s/push?topic=test", AUTH_GATEWAY_ADDRESS), bytes.NewReader(payloadBytes))
+		Expect(err).To(BeNil())
+		if token != "" {
+			request.Header.Add("Authorization", "Bearer "+token)
+		}
+		for i := 0; i+1 < len(headers); i += 2 {
+			request.Header.Add(headers[i], headers[i+1])
+		}
+
+		resp, err := http.DefaultClient.Do(request)
+		Expect(err).To(BeNil())
+
+		return resp
+	}
+
+	When("A push delivery has a token for an allowed service account", func() {
+		It("Should handle the event", func() {
+			resp := push("allowed")
+
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+
+			By("Validating the token for the configured audience")
+			Expect(validator.audience).To(Equal("https://service.example.com"))
+		})
+	})
+
+	When("A push delivery sets its own forwarded headers", func() {
+		It("Should validate the token for the configured audience", func() {
+			resp := push("allowed", "X-Forwarded-Proto", "http", "X-Forwarded-Host", "attacker.example.com")
+
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(validator.audience).To(Equal("https://service.example.com"))
+		})
+	})
+
+	When("A push delivery has no token", func() {
+		It("Should be rejected as unauthorized", func() {
+			resp := push("")
+
+			Expect(resp.StatusCode).To(Equal(401))
+			Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+		})
+	})
+
+	When("A push delivery has an invalid token", func() {
+		It("Should be rejected as unauthorized", func() {
+			Expect(push("invalid").StatusCode).To(Equal(401))
+			Expect(push("untrusted").StatusCode).To(Equal(401))
+			Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+		})
+	})
+
+	When("A push delivery has a token for another service account", func() {
+		It("Should be forbidden", func() {
+			resp := push("other")
+
+			Expect(resp.StatusCode).To(Equal(403))
+			Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+		})
+	})
+
+	When("A push delivery has a token without a verified email", func() {
+		It("Should be forbidden", func() {
+			Expect(push("unverified").StatusCode).To(Equal(403))
+			Expect(push("anonymous").StatusCode).To(Equal(403))
+			Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+		})
+	})
+
+	When("A CloudEvent is delivered", func() {
+		pushCloudEvent := func(token string) *http.Response {
+			request, err := http.NewRequest("POST", fmt.Sprintf("http://%s/x-nitric-cloudevents", AUTH_GATEWAY_ADDRESS), bytes.NewReader([]byte(`{"test":"test"}`)))
+			Expect(err).To(BeNil())
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add("ce-specversion", "1.0")
+			request.Header.Add("ce-id", "1234")
+			request.Header.Add("ce-source", "/topics/test")
+			request.Header.Add("ce-type", "test-payload")
+			request.Header.Add("ce-nitrictopic", "test")
+			if token != "" {
+				request.Header.Add("Authorization", "Bearer "+token)
+			}
+
+			resp, err := http.DefaultClient.Do(request)
+			Expect(err).To(BeNil())
+
+			return resp
+		}
+
+		It("Should verify it before it's handled", func() {
+			Expect(pushCloudEvent("").StatusCode).To(Equal(401))
+			Expect(pushCloudEvent("other").StatusCode).To(Equal(403))
+			Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+
+			Expect(pushCloudEvent("allowed").StatusCode).To(Equal(200))
+			Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+		})
+	})
+})
+
+var _ = Describe("Push auth configuration", func() {
+	When("Service accounts are set without an audience", func() {
+		It("Should refuse to create the gateway", func() {
+			os.Setenv("PUBSUB_PUSH_SERVICE_ACCOUNTS", "pusher@project.iam.gserviceaccount.com")
+			defer os.Unsetenv("PUBSUB_PUSH_SERVICE_ACCOUNTS")
+
+			_, err := cloudrun_plugin.New()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("PUBSUB_PUSH_AUDIENCE"))
+		})
+	})
+})
@@ -17,10 +17,12 @@ package cloudrun_plugin
 
 import (
 	"encoding/json"
-	"fmt"
+	"log"
+	"time"
 
 	"github.com/valyala/fasthttp"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
 	ep "github.com/nitrictech/nitric/pkg/plugins/events"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
@@ -33,11 +35,61 @@ type PubSubMessage struct {
 		Attributes map[string]string `json:"attributes"`
 		Data       []byte            `json:"data,omitempty"`
 		ID         string            `json:"id"`
+		// OrderingKey - set when the message was published with Pub/Sub message ordering
+		OrderingKey string `json:"orderingKey,omitempty"`
+		// PublishTime - when the message was published to the topic
+		PublishTime time.Time `json:"publishTime,omitempty"`
 	} `json:"message"`
 	Subscription string `json:"subscription"`
+	// DeliveryAttempt - only set for subscriptions with a dead letter policy
+	DeliveryAttempt int `json:"deliveryAttempt,omitempty"`
 }
 
-func middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
+// storageNotification - returns the bucket notification a Cloud Storage Pub/Sub notification is sent for, false if the message
+// isn't a Cloud Storage notification. The notification is nil for changes that aren't notified, such as metadata updates
+func storageNotification(attributes map[string]string) (*worker.BucketNotification, bool) {
+	if attributes["bucketId"] == "" || attributes["objectId"] == "" {
+		return nil, false
+	}
+
+	notification := &worker.BucketNotification{
+		// the nitric name of the bucket is set as a custom attribute of its notifications, the bucket's own name otherwise
+		Bucket: attributes["x-nitric-bucket"],
+		Key:    attributes["objectId"],
+	}
+	if notification.Bucket == "" {
+		notification.Bucket = attributes["bucketId"]
+	}
+
+	switch attributes["eventType"] {
+	case "OBJECT_FINALIZE":
+		notification.Type = worker.NotificationCreated
+	case "OBJECT_DELETE":
+		notification.Type = worker.NotificationDeleted
+	default:
+		return nil, true
+	}
+
+	return notification, true
+}
+
+type pushMiddleware struct {
+	// Verifies push deliveries, disabled if nil
+	auth *PushAuth
+}
+
+// authenticate - verifies a push delivery, responding to it and returning false if it's rejected
+func (m *pushMiddleware) authenticate(ctx *fasthttp.RequestCtx) bool {
+	if err := m.auth.verify(ctx); err != nil {
+		log.Default().Printf("rejecting push delivery to %s: %v", ctx.Path(), err)
+		ctx.Error(err.msg, err.status)
+		return false
+	}
+
+	return true
+}
+
+func (m *pushMiddleware) middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 	bodyBytes := ctx.Request.Body()
 
 	// Check if the payload contains a pubsub event
@@ -45,20 +97,54 @@ func middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 	// like reading off the request origin to ensure it is from pubsub
 	var pubsubEvent PubSubMessage
 	if err := json.Unmarshal(bodyBytes, &pubsubEvent); err == nil && pubsubEvent.Subscription != "" {
+		if m.auth != nil && !m.authenticate(ctx) {
+			return false
+		}
+
 		// We have an event from pubsub here...
 
 		// need to determine if the underlying data is a nitric event
 		var event *triggers.Event
 		messageJson := &ep.NitricEvent{}
-		// Check if it's a nitric event
-		if err := json.Unmarshal(pubsubEvent.Message.Data, messageJson); err == nil && messageJson.ID != "" {
+		// Check if it's a CloudEvent, in binary or structured mode
+		ce, ok, err := cloudevents.UnmarshalBinary(pubsubEvent.Message.Attributes, pubsubEvent.Message.Data)
+		if !ok {
+			ce, ok, err = cloudevents.UnmarshalStructured(pubsubEvent.Message.Data)
+		}
+
+		if notification, isNotification := storageNotification(pubsubEvent.Message.Attributes); isNotification {
+			if notification == nil {
+				// Redelivering a change that isn't notified won't find a worker, so acknowledge it
+				ctx.SuccessString("text/plain", "unsupported notification")
+				return false
+			}
+
+			event = notification.Event(pubsubEvent.Message.ID)
+		} else if ok {
+			if err == nil {
+				event, err = ce.ToTrigger()
+			}
+
+			if err != nil {
+				// Redelivering an invalid event won't succeed, so acknowledge it
+				log.Default().Printf("discarding invalid CloudEvent %s: %v", pubsubEvent.Message.ID, err)
+				ctx.SuccessString("text/plain", "invalid CloudEvent")
+				return false
+			}
+
+			if topic, ok := pubsubEvent.Message.Attributes["x-nitric-topic"]; ok {
+				event.Topic = topic
+			}
+		} else if err := json.Unmarshal(pubsubEvent.Message.Data, messageJson); err == nil && messageJson.ID != "" {
+			// Check if it's a nitric event
 			// reserialize the nitric event payload
 			payload, _ := json.Marshal(messageJson.Payload)
 
 			event = &triggers.Event{
-				ID:      messageJson.ID,
-				Topic:   pubsubEvent.Message.Attributes["x-nitric-topic"],
-				Payload: payload,
+				ID:          messageJson.ID,
+				Topic:       pubsubEvent.Message.Attributes["x-nitric-topic"],
+				Payload:     payload,
+				OrderingKey: messageJson.OrderingKey,
 			}
 		} else {
 			event = &triggers.Event{
@@ -70,6 +156,21 @@ func middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 			}
 		}
 
+		if event.OrderingKey == "" {
+			event.OrderingKey = pubsubEvent.Message.OrderingKey
+		}
+		event.Attempt = pubsubEvent.DeliveryAttempt
+		event.IdempotencyKey = pubsubEvent.Message.Attributes[triggers.IdempotencyKeyAttribute]
+		event.Metadata.Source = pubsubEvent.Subscription
+		event.Metadata.ProviderID = pubsubEvent.Message.ID
+		// A CloudEvent's own time is when it was produced, which may be earlier than when it was published
+		if event.Metadata.PublishTime.IsZero() {
+			event.Metadata.PublishTime = pubsubEvent.Message.PublishTime
+		}
+		if event.Metadata.TraceContext == nil {
+			event.Metadata.TraceContext = triggers.TraceContextFrom(pubsubEvent.Message.Attributes)
+		}
+
 		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
 			Event: event,
 		})
@@ -82,7 +183,9 @@ func middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 			// return a successful response
 			ctx.SuccessString("text/plain", "success")
 		} else {
-			ctx.Error(fmt.Sprintf("Error handling event %v", err), 500)
+			// Pub/Sub nacks any failure, redelivering it under the subscription's retry and dead letter policies.
+			// Push subscriptions don't honour Retry-After, the retry policy's backoff delays the redelivery instead
+			base_http.EventError(ctx, err)
 		}
 
 		// We've already handled the request
@@ -95,7 +198,26 @@ func middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 }
 
 // New - Create a New cloudrun gateway plugin
-func New() (gateway.GatewayService, error) {
+func New(opts ...base_http.HttpGatewayOption) (gateway.GatewayService, error) {
+	auth, err := pushAuthFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithPushAuth(auth, opts...)
+}
+
+// NewWithPushAuth - Create a New cloudrun gateway plugin that verifies Pub/Sub push deliveries with the given authentication, disabled if nil
+func NewWithPushAuth(auth *PushAuth, opts ...base_http.HttpGatewayOption) (gateway.GatewayService, error) {
+	mw := &pushMiddleware{
+		auth: auth,
+	}
+
+	// CloudEvents delivered by push subscriptions are verified the same way as Pub/Sub messages
+	if auth != nil {
+		opts = append(opts, base_http.WithEventAuth(mw.authenticate))
+	}
+
 	// plugin is derived from base http plugin
-	return base_http.New(middleware)
+	return base_http.New(mw.middleware, opts...)
 }
@@ -136,13 +136,16 @@ var _ = Describe("Http", func() {
 			b64Event := base64.StdEncoding.EncodeToString(eventBytes)
 
 			payloadBytes, _ := json.Marshal(&map[string]interface{}{
-				"subscription": "test",
+				"subscription":    "projects/my-project/subscriptions/test",
+				"deliveryAttempt": 2,
 				"message": map[string]interface{}{
 					"attributes": map[string]string{
 						"x-nitric-topic": "test",
+						"traceparent":    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
 					},
-					"id":   "test",
-					"data": b64Event,
+					"id":          "test",
+					"data":        b64Event,
+					"publishTime": "2021-06-01T12:00:00.123Z",
 				},
 			})
 
@@ -171,6 +174,15 @@ var _ = Describe("Http", func() {
 				By("Passing through the published message data")
 				Expect(handledEvent.Payload).To(BeEquivalentTo(pBytes))
 
+				By("Describing where the message was delivered from")
+				Expect(handledEvent.Attempt).To(Equal(2))
+				Expect(handledEvent.Metadata.Source).To(Equal("projects/my-project/subscriptions/test"))
+				Expect(handledEvent.Metadata.ProviderID).To(Equal("test"))
+				Expect(handledEvent.Metadata.PublishTime).To(BeTemporally("==", time.Date(2021, 6, 1, 12, 0, 0, 123000000, time.UTC)))
+				Expect(handledEvent.Metadata.TraceContext).To(Equal(map[string]string{
+					"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				}))
+
 				By("The request returns a successful status")
 				Expect(resp.StatusCode).To(Equal(200))
 
@@ -178,5 +190,101 @@ var _ = Describe("Http", func() {
 				Expect(string(responseBody)).To(Equal("success"))
 			})
 		})
+
+		When("From a subscription with a binary mode CloudEvent", func() {
+			eventPayload := []byte(`{"Test":"Test"}`)
+
+			payloadBytes, _ := json.Marshal(&map[string]interface{}{
+				"subscription": "test",
+				"message": map[string]interface{}{
+					"attributes": map[string]string{
+						"x-nitric-topic": "test",
+						"ce-specversion": "1.0",
+						"ce-id":          "1234",
+						"ce-source":      "/topics/test",
+						"ce-type":        "Test Payload",
+						"content-type":   "application/json",
+					},
+					"id":   "test",
+					"data": base64.StdEncoding.EncodeToString(eventPayload),
+				},
+			})
+
+			It("Should handle the unwrapped event", func() {
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(payloadBytes))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+				handledEvent := mockHandler.ReceivedEvents[0]
+
+				By("Passing through the CloudEvent ID")
+				Expect(handledEvent.ID).To(Equal("1234"))
+
+				By("Extracting the topic name from the subscription")
+				Expect(handledEvent.Topic).To(Equal("test"))
+
+				By("Passing through the event data")
+				Expect(handledEvent.Payload).To(BeEquivalentTo(eventPayload))
+
+				By("Taking the source from the subscription it was delivered from")
+				Expect(handledEvent.Metadata.Source).To(Equal("test"))
+				Expect(handledEvent.Metadata.ProviderID).To(Equal("test"))
+			})
+		})
+
+		When("From a subscription with a Cloud Storage notification", func() {
+			notificationPayload := func(eventType string) []byte {
+				payload, _ := json.Marshal(&map[string]interface{}{
+					"subscription": "projects/my-project/subscriptions/images",
+					"message": map[string]interface{}{
+						"attributes": map[string]string{
+							"bucketId":        "images-1234",
+							"objectId":        "uploads/cat.png",
+							"eventType":       eventType,
+							"x-nitric-bucket": "images",
+						},
+						"id":   "test",
+						"data": base64.StdEncoding.EncodeToString([]byte("{}")),
+					},
+				})
+				return payload
+			}
+
+			It("Should handle created items as bucket notifications", func() {
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(notificationPayload("OBJECT_FINALIZE")))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+				handledEvent := mockHandler.ReceivedEvents[0]
+
+				By("Taking the bucket from the notification's nitric bucket attribute")
+				Expect(handledEvent.Topic).To(Equal(worker.BucketTopic("images")))
+
+				notification, ok := worker.ParseBucketNotification(handledEvent)
+				Expect(ok).To(BeTrue())
+				Expect(notification.Key).To(Equal("uploads/cat.png"))
+				Expect(notification.Type).To(Equal(worker.NotificationCreated))
+				Expect(handledEvent.Metadata.ProviderID).To(Equal("test"))
+			})
+
+			It("Should acknowledge changes that aren't notified", func() {
+				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(notificationPayload("OBJECT_METADATA_UPDATE")))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -19,15 +19,22 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/valyala/fasthttp"
 
 	"github.com/nitrictech/nitric/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
+	"github.com/nitrictech/nitric/pkg/schedule"
 	"github.com/nitrictech/nitric/pkg/triggers"
 	"github.com/nitrictech/nitric/pkg/worker"
 )
 
+// scheduleInterval - how often the registered schedules are checked for any that are due
+const scheduleInterval = time.Second
+
 func middleware(ctx *fasthttp.RequestCtx, wrkr worker.WorkerPool) bool {
 	triggerTypeString := string(ctx.Request.Header.Peek("x-nitric-source-type"))
 
@@ -38,9 +45,20 @@ func middleware(ctx *fasthttp.RequestCtx, wrkr worker.WorkerPool) bool {
 		payload := ctx.Request.Body()
 
 		evt := &triggers.Event{
-			ID:      requestId,
-			Topic:   trigger,
-			Payload: payload,
+			ID:             requestId,
+			Topic:          trigger,
+			Payload:        payload,
+			OrderingKey:    string(ctx.Request.Header.Peek("x-nitric-ordering-key")),
+			IdempotencyKey: string(ctx.Request.Header.Peek(triggers.IdempotencyKeyAttribute)),
+			Metadata: triggers.Metadata{
+				Source:      trigger,
+				ProviderID:  requestId,
+				PublishTime: ctx.Time(),
+				TraceContext: triggers.TraceContextFrom(map[string]string{
+					triggers.TraceParentKey: string(ctx.Request.Header.Peek(triggers.TraceParentKey)),
+					triggers.TraceStateKey:  string(ctx.Request.Header.Peek(triggers.TraceStateKey)),
+				}),
+			},
 		}
 
 		wrkr, err := wrkr.GetWorker(&worker.GetWorkerOptions{
@@ -55,7 +73,7 @@ func middleware(ctx *fasthttp.RequestCtx, wrkr worker.WorkerPool) bool {
 
 		if err != nil {
 			log.Default().Println(err)
-			ctx.Error(fmt.Sprintf("Error processing event. Details: %s", err), 500)
+			base_http.EventError(ctx, err)
 		} else {
 			ctx.SuccessString("text/plain", "Successfully Handled the Event")
 		}
@@ -67,8 +85,175 @@ func middleware(ctx *fasthttp.RequestCtx, wrkr worker.WorkerPool) bool {
 	return true
 }
 
+// LocalGateway - the dev gateway. Alongside http requests, it delivers events published locally to the application's subscribers
+// and fires the application's schedules, as the provider's services would once it's deployed
+type LocalGateway struct {
+	gateway.GatewayService
+
+	lock sync.Mutex
+	pool worker.WorkerPool
+	stop chan struct{}
+	// when each registered schedule next fires, by its key and cadence
+	due map[string]time.Time
+}
+
+func (g *LocalGateway) getPool() worker.WorkerPool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.pool
+}
+
+// Subscribed - returns true if the application has registered a worker for events published to the topic
+func (g *LocalGateway) Subscribed(topic string) bool {
+	pool := g.getPool()
+	if pool == nil {
+		return false
+	}
+
+	return len(pool.GetWorkers(&worker.GetWorkerOptions{Event: &triggers.Event{Topic: topic}})) > 0
+}
+
+// Deliver - delivers a locally published event to the application's subscriber for its topic
+func (g *LocalGateway) Deliver(evt *triggers.Event) error {
+	pool := g.getPool()
+	if pool == nil {
+		return fmt.Errorf("the gateway hasn't started, unable to deliver event %s", evt.ID)
+	}
+
+	wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
+		Event: evt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return wrkr.HandleEvent(evt)
+}
+
+// scheduleOf - returns when the schedule worker's schedule fires
+func scheduleOf(wrkr *worker.ScheduleWorker) (schedule.Schedule, error) {
+	if wrkr.Cron() != "" {
+		return schedule.ParseCron(wrkr.Cron())
+	}
+
+	return schedule.ParseRate(wrkr.Rate())
+}
+
+// fireSchedules - fires the registered schedules that are due, and works out when newly registered schedules first fire
+func (g *LocalGateway) fireSchedules(now time.Time) {
+	pool := g.getPool()
+
+	registered := map[string]bool{}
+	for _, w := range pool.GetWorkers(&worker.GetWorkerOptions{}) {
+		wrkr, ok := w.(*worker.ScheduleWorker)
+		if !ok {
+			continue
+		}
+
+		id := fmt.Sprintf("%s|%s|%s", wrkr.Key(), wrkr.Rate(), wrkr.Cron())
+		if registered[id] {
+			// registered by more than one child process, it only fires once
+			continue
+		}
+		registered[id] = true
+
+		s, err := scheduleOf(wrkr)
+		if err != nil {
+			if _, logged := g.due[id]; !logged {
+				log.Default().Printf("schedule %s will not fire: %v", wrkr.Key(), err)
+				g.due[id] = time.Time{}
+			}
+			continue
+		}
+
+		due, ok := g.due[id]
+		if !ok {
+			g.due[id] = s.Next(now)
+			continue
+		}
+
+		if due.IsZero() || now.Before(due) {
+			continue
+		}
+		g.due[id] = s.Next(now)
+
+		eventID := uuid.New().String()
+		evt := &triggers.Event{
+			ID:    eventID,
+			Topic: worker.ScheduleKeyToTopicName(wrkr.Key()),
+			Metadata: triggers.Metadata{
+				Source:      wrkr.Key(),
+				ProviderID:  eventID,
+				PublishTime: now,
+			},
+		}
+		go func(key string) {
+			if err := g.Deliver(evt); err != nil {
+				log.Default().Printf("schedule %s failed: %v", key, err)
+			}
+		}(wrkr.Key())
+	}
+
+	// schedules that are no longer registered start again if they're registered again
+	for id := range g.due {
+		if !registered[id] {
+			delete(g.due, id)
+		}
+	}
+}
+
+// runSchedules - fires the registered schedules as they become due, until the gateway stops
+func (g *LocalGateway) runSchedules(stop chan struct{}) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			g.fireSchedules(now)
+		}
+	}
+}
+
+// Start - starts serving http requests, and firing the application's schedules
+func (g *LocalGateway) Start(pool worker.WorkerPool) error {
+	stop := make(chan struct{})
+
+	g.lock.Lock()
+	g.pool = pool
+	g.stop = stop
+	g.lock.Unlock()
+
+	go g.runSchedules(stop)
+
+	return g.GatewayService.Start(pool)
+}
+
+// Stop - stops the gateway and its schedules
+func (g *LocalGateway) Stop() error {
+	g.lock.Lock()
+	if g.stop != nil {
+		close(g.stop)
+		g.stop = nil
+	}
+	g.lock.Unlock()
+
+	return g.GatewayService.Stop()
+}
+
 // Create new HTTP gateway
 // XXX: No External Args for function atm (currently the plugin loader does not pass any argument information)
-func New() (gateway.GatewayService, error) {
-	return base_http.New(middleware)
+func New(opts ...base_http.HttpGatewayOption) (*LocalGateway, error) {
+	gw, err := base_http.New(middleware, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalGateway{
+		GatewayService: gw,
+		due:            map[string]time.Time{},
+	}, nil
 }
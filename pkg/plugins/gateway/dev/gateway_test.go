@@ -50,6 +50,8 @@ var _ = Describe("Gateway", func() {
 	Expect(err).To(BeNil())
 
 	gatewayUrl := fmt.Sprintf("http://%s", GATEWAY_ADDRESS)
+	cloudEventsUrl := gatewayUrl + "/x-nitric-cloudevents"
+	os.Setenv("GATEWAY_CLOUDEVENTS_PATH", "/x-nitric-cloudevents")
 	gws, err := gateway_plugin.New()
 	Expect(err).To(BeNil())
 
@@ -143,4 +145,114 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 	})
+
+	When("Delivering locally published events", func() {
+		It("should report the topic as subscribed", func() {
+			Expect(gws.Subscribed("test-topic")).To(BeTrue())
+		})
+
+		It("should pass on the event", func() {
+			Expect(gws.Deliver(&triggers.Event{
+				ID:      "1234",
+				Topic:   "test-topic",
+				Payload: []byte("Test"),
+			})).To(Succeed())
+
+			Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+			Expect(mockHandler.ReceivedEvents[0].Topic).To(Equal("test-topic"))
+		})
+	})
+
+	When("Receiving CloudEvents", func() {
+		When("The event is in binary mode", func() {
+			payload := []byte(`{"test":"test"}`)
+			request, _ := http.NewRequest("POST", cloudEventsUrl, bytes.NewReader(payload))
+
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add("ce-specversion", "1.0")
+			request.Header.Add("ce-id", "1234")
+			request.Header.Add("ce-source", "/topics/test-topic")
+			request.Header.Add("ce-type", "test-payload")
+			request.Header.Add("ce-nitrictopic", "test-topic")
+
+			It("should pass on the unwrapped event", func() {
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+				evt := mockHandler.ReceivedEvents[0]
+				Expect(evt.ID).To(Equal("1234"))
+				Expect(evt.Topic).To(Equal("test-topic"))
+				Expect(evt.Payload).To(BeEquivalentTo(payload))
+			})
+		})
+
+		When("The event is in structured mode", func() {
+			body := []byte(`{
+				"specversion": "1.0",
+				"id": "1234",
+				"source": "/topics/test-topic",
+				"type": "test-payload",
+				"nitrictopic": "test-topic",
+				"data": {"test":"test"}
+			}`)
+			request, _ := http.NewRequest("POST", cloudEventsUrl, bytes.NewReader(body))
+			request.Header.Add("Content-Type", "application/cloudevents+json")
+
+			It("should pass on the unwrapped event", func() {
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(HaveLen(1))
+				evt := mockHandler.ReceivedEvents[0]
+				Expect(evt.Topic).To(Equal("test-topic"))
+				Expect(evt.Payload).To(MatchJSON(`{"test":"test"}`))
+			})
+		})
+
+		When("The event is missing required attributes", func() {
+			request, _ := http.NewRequest("POST", cloudEventsUrl, bytes.NewReader([]byte("{}")))
+			request.Header.Add("ce-specversion", "1.0")
+
+			It("should reject the event", func() {
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(400))
+				Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+			})
+		})
+
+		When("Receiving a webhook validation request", func() {
+			request, _ := http.NewRequest("OPTIONS", cloudEventsUrl, nil)
+			request.Header.Add("WebHook-Request-Origin", "eventemitter.example.com")
+
+			It("should allow the origin", func() {
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+				Expect(resp.Header.Get("WebHook-Allowed-Origin")).To(Equal("eventemitter.example.com"))
+			})
+		})
+
+		When("The event is sent to another path", func() {
+			request, _ := http.NewRequest("POST", gatewayUrl+"/other", bytes.NewReader([]byte(`{"test":"test"}`)))
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add("ce-specversion", "1.0")
+			request.Header.Add("ce-id", "1234")
+			request.Header.Add("ce-source", "/topics/test-topic")
+			request.Header.Add("ce-type", "test-payload")
+			request.Header.Add("ce-nitrictopic", "test-topic")
+
+			It("should handle it as a regular http request", func() {
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+				Expect(resp.StatusCode).To(Equal(200))
+
+				Expect(mockHandler.ReceivedEvents).To(BeEmpty())
+				Expect(mockHandler.ReceivedRequests).To(HaveLen(1))
+			})
+		})
+	})
 })
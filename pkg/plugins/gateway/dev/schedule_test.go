@@ -0,0 +1,110 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway_plugin
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type scheduleAdapter struct {
+	events chan *triggers.Event
+}
+
+func (a *scheduleAdapter) HandleEvent(trigger *triggers.Event) error {
+	a.events <- trigger
+	return nil
+}
+
+func (a *scheduleAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, nil
+}
+
+var _ = Describe("Schedules", func() {
+	start := time.Date(2022, time.March, 2, 10, 7, 30, 0, time.UTC)
+
+	var adapter *scheduleAdapter
+	var pool worker.WorkerPool
+	var gw *LocalGateway
+
+	BeforeEach(func() {
+		adapter = &scheduleAdapter{events: make(chan *triggers.Event, 10)}
+		pool = worker.NewProcessPool(&worker.ProcessPoolOptions{})
+		gw = &LocalGateway{pool: pool, due: map[string]time.Time{}}
+	})
+
+	When("a schedule has a rate", func() {
+		It("should fire once each interval has passed", func() {
+			_ = pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{Key: "Prune Orders", Rate: "5 minutes"}))
+
+			gw.fireSchedules(start)
+			gw.fireSchedules(start.Add(4 * time.Minute))
+			Consistently(adapter.events, 50*time.Millisecond).ShouldNot(Receive())
+
+			gw.fireSchedules(start.Add(5 * time.Minute))
+
+			var evt *triggers.Event
+			Eventually(adapter.events).Should(Receive(&evt))
+			Expect(evt.Topic).To(Equal("prune-orders"))
+			Expect(evt.ID).ToNot(BeEmpty())
+
+			By("not firing again until the next interval")
+			gw.fireSchedules(start.Add(6 * time.Minute))
+			Consistently(adapter.events, 50*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	When("a schedule has a cron expression", func() {
+		It("should fire at the matching times", func() {
+			_ = pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{Key: "report", Cron: "0 11 * * *"}))
+
+			gw.fireSchedules(start)
+			gw.fireSchedules(time.Date(2022, time.March, 2, 10, 59, 59, 0, time.UTC))
+			Consistently(adapter.events, 50*time.Millisecond).ShouldNot(Receive())
+
+			gw.fireSchedules(time.Date(2022, time.March, 2, 11, 0, 0, 0, time.UTC))
+			Eventually(adapter.events).Should(Receive())
+		})
+	})
+
+	When("the same schedule is registered by several child processes", func() {
+		It("should only fire once", func() {
+			_ = pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{Key: "report", Rate: "1 minute"}))
+			_ = pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{Key: "report", Rate: "1 minute"}))
+
+			gw.fireSchedules(start)
+			gw.fireSchedules(start.Add(time.Minute))
+
+			Eventually(adapter.events).Should(Receive())
+			Consistently(adapter.events, 50*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	When("a schedule's cadence is invalid", func() {
+		It("should never fire", func() {
+			_ = pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{Key: "report", Cron: "not a cron"}))
+
+			gw.fireSchedules(start)
+			gw.fireSchedules(start.Add(time.Hour))
+
+			Consistently(adapter.events, 50*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,376 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda_service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-lambda-go/events"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// httpResponse - a response to a http event, before it's formatted for the service that sent the event
+type httpResponse struct {
+	statusCode int
+	headers    map[string][]string
+	body       string
+	// base64 - the body is base64 encoded
+	base64 bool
+}
+
+// textMediaTypes - media types outside of text/* whose bodies are text
+var textMediaTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-www-form-urlencoded",
+	"application/graphql",
+}
+
+// headerValue - returns the first value of a header, matching its name case insensitively
+func headerValue(headers map[string][]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	return ""
+}
+
+// mediaType - returns the media type of a Content-Type or Accept value, without its parameters
+func mediaType(value string) string {
+	return strings.ToLower(strings.TrimSpace(strings.Split(value, ";")[0]))
+}
+
+// isText - reports whether a response body can be returned as text without being corrupted.
+// Bodies with a content encoding, such as gzip, are binary whatever their content type
+func isText(headers map[string][]string, body []byte) bool {
+	if encoding := headerValue(headers, "Content-Encoding"); encoding != "" && !strings.EqualFold(encoding, "identity") {
+		return false
+	}
+
+	if !utf8.Valid(body) {
+		return false
+	}
+
+	contentType := headerValue(headers, "Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+
+	mt := mediaType(contentType)
+	if strings.HasPrefix(mt, "text/") || strings.HasSuffix(mt, "+json") || strings.HasSuffix(mt, "+xml") {
+		return true
+	}
+	for _, t := range textMediaTypes {
+		if mt == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newHttpResponse - returns the response to a http event, with binary bodies base64 encoded so they aren't corrupted
+func newHttpResponse(response *triggers.HttpResponse) *httpResponse {
+	headers := make(map[string][]string)
+
+	if response.Header != nil {
+		response.Header.VisitAll(func(key []byte, val []byte) {
+			headers[string(key)] = append(headers[string(key)], string(val))
+		})
+	}
+
+	if isText(headers, response.Body) {
+		return &httpResponse{
+			statusCode: response.StatusCode,
+			headers:    headers,
+			body:       string(response.Body),
+		}
+	}
+
+	return &httpResponse{
+		statusCode: response.StatusCode,
+		headers:    headers,
+		body:       base64.StdEncoding.EncodeToString(response.Body),
+		base64:     true,
+	}
+}
+
+// ParseBinaryMediaTypes - parses a comma separated list of binary media types, which may use wildcards such as image/* or */*
+func ParseBinaryMediaTypes(types string) []string {
+	parsed := make([]string, 0)
+	for _, t := range strings.Split(types, ",") {
+		if mt := mediaType(t); mt != "" {
+			parsed = append(parsed, mt)
+		}
+	}
+
+	return parsed
+}
+
+// matchesMediaType - reports whether a Content-Type or Accept value matches any of the given media types
+func matchesMediaType(types []string, value string) bool {
+	mt := mediaType(value)
+	if mt == "" {
+		return false
+	}
+
+	for _, t := range types {
+		if t == "*/*" || t == mt {
+			return true
+		}
+		if strings.HasSuffix(t, "/*") && strings.HasPrefix(mt, strings.TrimSuffix(t, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decodeBase64 - decodes a base64 encoded body, with or without padding
+func decodeBase64(body string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(body)
+	if err != nil {
+		if raw, rawErr := base64.RawStdEncoding.DecodeString(strings.TrimRight(body, "=")); rawErr == nil {
+			return raw, nil
+		}
+	}
+
+	return decoded, err
+}
+
+// newHttpTrigger - returns the http trigger for a request, in the form shared by each http event format
+func newHttpTrigger(method string, path string, headers map[string][]string, query url.Values, body string, isBase64Encoded bool) (*triggers.HttpRequest, error) {
+	// Copy the headers and re-write for the proxy
+	headerCopy := make(map[string][]string)
+
+	for key, val := range headers {
+		if strings.ToLower(key) == "host" {
+			headerCopy[xforwardHeader] = append(headerCopy[xforwardHeader], val...)
+		} else {
+			headerCopy[key] = append(headerCopy[key], val...)
+		}
+	}
+
+	// Binary bodies, such as multipart forms, are base64 encoded by API Gateway and load balancers
+	bodyBytes := []byte(body)
+	if isBase64Encoded {
+		var err error
+		bodyBytes, err = decodeBase64(body)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding base64 body for httpEvent: %v", err)
+		}
+	}
+
+	return &triggers.HttpRequest{
+		// FIXME: Translate to http.Header
+		Header: headerCopy,
+		Body:   bodyBytes,
+		Method: method,
+		Path:   path,
+		Query:  query,
+		Metadata: triggers.Metadata{
+			TraceContext: triggers.TraceContextFromHeader(headerCopy),
+		},
+	}, nil
+}
+
+// epochMillis - returns the time of a millisecond epoch timestamp, zero if it isn't set
+func epochMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// singleValues - returns single value headers or query parameters as multi value ones
+func singleValues(values map[string]string) map[string][]string {
+	multi := make(map[string][]string, len(values))
+	for k, v := range values {
+		multi[k] = []string{v}
+	}
+
+	return multi
+}
+
+// triggerFromHttpApi - API Gateway HTTP API (payload format 2.0) and Lambda function URL events
+func triggerFromHttpApi(evt *events.APIGatewayV2HTTPRequest) (*triggers.HttpRequest, error) {
+	// Parse the raw query string
+	qVals, err := url.ParseQuery(evt.RawQueryString)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query for httpEvent: %v", err)
+	}
+
+	// Multiple values for a header are joined with commas in this format
+	headers := make(map[string][]string, len(evt.Headers))
+	for k, v := range evt.Headers {
+		headers[k] = []string{v}
+	}
+
+	trigger, err := newHttpTrigger(evt.RequestContext.HTTP.Method, evt.RawPath, headers, qVals, evt.Body, evt.IsBase64Encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the cookies over
+	trigger.Header["Cookie"] = evt.Cookies
+
+	trigger.Metadata.Source = evt.RequestContext.APIID
+	trigger.Metadata.ProviderID = evt.RequestContext.RequestID
+	trigger.Metadata.PublishTime = epochMillis(evt.RequestContext.TimeEpoch)
+
+	return trigger, nil
+}
+
+// triggerFromRestApi - API Gateway REST API (payload format 1.0) events
+func triggerFromRestApi(evt *events.APIGatewayProxyRequest) (*triggers.HttpRequest, error) {
+	headers := evt.MultiValueHeaders
+	if len(headers) == 0 {
+		headers = singleValues(evt.Headers)
+	}
+
+	query := evt.MultiValueQueryStringParameters
+	if len(query) == 0 {
+		query = singleValues(evt.QueryStringParameters)
+	}
+
+	trigger, err := newHttpTrigger(evt.HTTPMethod, evt.Path, headers, query, evt.Body, evt.IsBase64Encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger.Metadata.Source = evt.RequestContext.APIID
+	trigger.Metadata.ProviderID = evt.RequestContext.RequestID
+	trigger.Metadata.PublishTime = epochMillis(evt.RequestContext.RequestTimeEpoch)
+
+	return trigger, nil
+}
+
+// triggerFromAlb - Application Load Balancer target group events
+func triggerFromAlb(evt *events.ALBTargetGroupRequest) (*triggers.HttpRequest, error) {
+	headers := evt.MultiValueHeaders
+	if len(headers) == 0 {
+		headers = singleValues(evt.Headers)
+	}
+
+	rawQuery := evt.MultiValueQueryStringParameters
+	if len(rawQuery) == 0 {
+		rawQuery = singleValues(evt.QueryStringParameters)
+	}
+
+	// Load balancers pass query parameters as they were sent, without decoding them
+	query := make(url.Values, len(rawQuery))
+	for k, vals := range rawQuery {
+		key, err := url.QueryUnescape(k)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing query for httpEvent: %v", err)
+		}
+
+		for _, v := range vals {
+			val, err := url.QueryUnescape(v)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing query for httpEvent: %v", err)
+			}
+			query[key] = append(query[key], val)
+		}
+	}
+
+	trigger, err := newHttpTrigger(evt.HTTPMethod, evt.Path, headers, query, evt.Body, evt.IsBase64Encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load balancers don't give requests an ID, the trace ID they add identifies the request instead
+	trigger.Metadata.Source = evt.RequestContext.ELB.TargetGroupArn
+	for k, v := range trigger.Header {
+		if strings.EqualFold(k, "X-Amzn-Trace-Id") && len(v) > 0 {
+			trigger.Metadata.ProviderID = v[0]
+		}
+	}
+
+	return trigger, nil
+}
+
+// lastValues - returns the last value of each header, for formats that can only return one value per header
+func lastValues(headers map[string][]string) map[string]string {
+	single := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if len(v) > 0 {
+			single[k] = v[len(v)-1]
+		}
+	}
+
+	return single
+}
+
+// formatHttpResponse - formats a response for the service that sent the http event it responds to
+func formatHttpResponse(evtType eventType, multiValueHeaders bool, response *httpResponse) interface{} {
+	switch evtType {
+	case restEvent:
+		return events.APIGatewayProxyResponse{
+			StatusCode:        response.statusCode,
+			Headers:           lastValues(response.headers),
+			MultiValueHeaders: response.headers,
+			Body:              response.body,
+			IsBase64Encoded:   response.base64,
+		}
+	case albEvent:
+		albResponse := events.ALBTargetGroupResponse{
+			StatusCode:        response.statusCode,
+			StatusDescription: fmt.Sprintf("%d %s", response.statusCode, http.StatusText(response.statusCode)),
+			Body:              response.body,
+			IsBase64Encoded:   response.base64,
+		}
+
+		// Load balancers return the headers in the form they were sent in
+		if multiValueHeaders {
+			albResponse.MultiValueHeaders = response.headers
+		} else {
+			albResponse.Headers = lastValues(response.headers)
+		}
+
+		return albResponse
+	default:
+		headers := make(map[string]string, len(response.headers))
+		cookies := make([]string, 0)
+
+		for k, v := range response.headers {
+			// Cookies can't be joined with commas, so they're returned separately
+			if strings.EqualFold(k, "Set-Cookie") {
+				cookies = append(cookies, v...)
+				continue
+			}
+			headers[k] = strings.Join(v, ",")
+		}
+
+		return events.APIGatewayV2HTTPResponse{
+			StatusCode:      response.statusCode,
+			Headers:         headers,
+			Cookies:         cookies,
+			Body:            response.body,
+			IsBase64Encoded: response.base64,
+		}
+	}
+}
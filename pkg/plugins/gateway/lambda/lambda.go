@@ -16,7 +16,6 @@ package lambda_service
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -26,8 +25,10 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 
+	"github.com/nitrictech/nitric/pkg/cloudevents"
 	ep "github.com/nitrictech/nitric/pkg/plugins/events"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
 	"github.com/nitrictech/nitric/pkg/triggers"
 	"github.com/nitrictech/nitric/pkg/worker"
@@ -38,7 +39,14 @@ type eventType int
 const (
 	unknown eventType = iota
 	sns
+	// S3 bucket notifications
+	s3
+	// API Gateway HTTP API and Lambda function URL events
 	httpEvent
+	// API Gateway REST API events
+	restEvent
+	// Application Load Balancer events
+	albEvent
 	xforwardHeader string = "x-forwarded-for"
 )
 
@@ -48,6 +56,13 @@ func getEventType(request map[string]interface{}) eventType {
 	// If our event is a HTTP request
 	if _, ok := request["rawPath"]; ok {
 		return httpEvent
+	} else if _, ok := request["httpMethod"]; ok {
+		if requestContext, ok := request["requestContext"].(map[string]interface{}); ok {
+			if _, ok := requestContext["elb"]; ok {
+				return albEvent
+			}
+		}
+		return restEvent
 	} else if records, ok := request["Records"]; ok {
 		recordsList, _ := records.([]interface{})
 		record, _ := recordsList[0].(map[string]interface{})
@@ -63,6 +78,8 @@ func getEventType(request map[string]interface{}) eventType {
 		switch eventSource {
 		case "aws:sns":
 			return sns
+		case "aws:s3":
+			return s3
 		}
 	}
 
@@ -84,6 +101,64 @@ func (s *LambdaGateway) getTopicNameForArn(topicArn string) (string, error) {
 	return "", fmt.Errorf("could not find topic for arn %s", topicArn)
 }
 
+func (s *LambdaGateway) getBucketNameForArn(bucketArn string) (string, error) {
+	buckets, err := s.provider.GetResources(core.AwsResource_Bucket)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving buckets: %v", err)
+	}
+
+	for name, arn := range buckets {
+		if arn == bucketArn {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find bucket for arn %s", bucketArn)
+}
+
+// s3NotificationType - returns the type of notification an S3 event is sent for, false if it isn't a notified change
+func s3NotificationType(eventName string) (worker.NotificationType, bool) {
+	switch {
+	case strings.HasPrefix(eventName, "ObjectCreated:"):
+		return worker.NotificationCreated, true
+	case strings.HasPrefix(eventName, "ObjectRemoved:"):
+		return worker.NotificationDeleted, true
+	default:
+		return worker.NotificationAll, false
+	}
+}
+
+// snsAttributes - returns the string values of a message's attributes
+func snsAttributes(msg events.SNSEntity) map[string]string {
+	attributes := make(map[string]string, len(msg.MessageAttributes))
+	for k, v := range msg.MessageAttributes {
+		if attr, ok := v.(map[string]interface{}); ok {
+			if value, ok := attr["Value"].(string); ok {
+				attributes[k] = value
+			}
+		}
+	}
+
+	return attributes
+}
+
+// cloudEventFromSns - decodes SNS messages published as CloudEvents in binary or structured mode
+func cloudEventFromSns(msg events.SNSEntity) (*cloudevents.Event, bool) {
+	attributes := snsAttributes(msg)
+
+	ce, ok, err := cloudevents.UnmarshalBinary(attributes, []byte(msg.Message))
+	if !ok {
+		ce, ok, err = cloudevents.UnmarshalStructured([]byte(msg.Message))
+	}
+
+	if ok && err != nil {
+		log.Default().Printf("invalid CloudEvent %s: %v", msg.MessageID, err)
+		return nil, false
+	}
+
+	return ce, ok
+}
+
 func (s *LambdaGateway) triggersFromRequest(data map[string]interface{}) ([]triggers.Trigger, error) {
 	bytes, _ := json.Marshal(data)
 	trigs := make([]triggers.Trigger, 0)
@@ -98,11 +173,32 @@ func (s *LambdaGateway) triggersFromRequest(data map[string]interface{}) ([]trig
 				messageJson := &ep.NitricEvent{}
 				var payloadBytes []byte
 				var id string
+				var orderingKey string
+				metadata := triggers.Metadata{
+					Source:       snsRecord.SNS.TopicArn,
+					ProviderID:   snsRecord.SNS.MessageID,
+					PublishTime:  snsRecord.SNS.Timestamp,
+					TraceContext: triggers.TraceContextFrom(snsAttributes(snsRecord.SNS)),
+				}
 
 				// Populate the JSON
-				if err := json.Unmarshal([]byte(messageString), messageJson); err == nil {
+				if ce, ok := cloudEventFromSns(snsRecord.SNS); ok {
+					id = ce.ID
+					orderingKey = ce.OrderingKey
+					if evt, err := ce.ToTrigger(); err == nil {
+						payloadBytes = evt.Payload
+						// A CloudEvent's own time is when it was produced, which may be earlier than when it was published
+						if !evt.Metadata.PublishTime.IsZero() {
+							metadata.PublishTime = evt.Metadata.PublishTime
+						}
+						if evt.Metadata.TraceContext != nil {
+							metadata.TraceContext = evt.Metadata.TraceContext
+						}
+					}
+				} else if err := json.Unmarshal([]byte(messageString), messageJson); err == nil {
 					payloadMap := messageJson.Payload
 					id = messageJson.ID
+					orderingKey = messageJson.OrderingKey
 					payloadBytes, _ = json.Marshal(&payloadMap)
 				} else {
 					// just try to capture the raw message
@@ -114,51 +210,90 @@ func (s *LambdaGateway) triggersFromRequest(data map[string]interface{}) ([]trig
 
 				if err == nil {
 					trigs = append(trigs, &triggers.Event{
-						ID:      id,
-						Topic:   tName,
-						Payload: payloadBytes,
+						ID:             id,
+						Topic:          tName,
+						Payload:        payloadBytes,
+						OrderingKey:    orderingKey,
+						Metadata:       metadata,
+						IdempotencyKey: snsAttributes(snsRecord.SNS)[triggers.IdempotencyKeyAttribute],
 					})
 				} else {
 					log.Default().Printf("unable to find nitric topic: %v", err)
 				}
 			}
 		}
+	case s3:
+		s3Event := &events.S3Event{}
+		if err := json.Unmarshal(bytes, s3Event); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal s3 event: %v", err)
+		}
+
+		for _, s3Record := range s3Event.Records {
+			notificationType, ok := s3NotificationType(s3Record.EventName)
+			if !ok {
+				log.Default().Printf("ignoring unsupported s3 event %s", s3Record.EventName)
+				continue
+			}
+
+			bName, err := s.getBucketNameForArn(s3Record.S3.Bucket.Arn)
+			if err != nil {
+				log.Default().Printf("unable to find nitric bucket: %v", err)
+				continue
+			}
+
+			// keys are URL encoded in notifications
+			key, err := url.QueryUnescape(s3Record.S3.Object.Key)
+			if err != nil {
+				key = s3Record.S3.Object.Key
+			}
+
+			// the sequencer orders the changes to a key, so together they identify the change across redeliveries
+			evt := (&worker.BucketNotification{
+				Bucket: bName,
+				Key:    key,
+				Type:   notificationType,
+			}).Event(key + ":" + s3Record.S3.Object.Sequencer)
+			evt.Metadata = triggers.Metadata{
+				Source:      s3Record.S3.Bucket.Arn,
+				ProviderID:  s3Record.ResponseElements["x-amz-request-id"],
+				PublishTime: s3Record.EventTime,
+			}
+
+			trigs = append(trigs, evt)
+		}
 	case httpEvent:
 		evt := &events.APIGatewayV2HTTPRequest{}
+		if err := json.Unmarshal(bytes, evt); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal httpEvent: %v", err)
+		}
 
-		err := json.Unmarshal(bytes, evt)
+		trigger, err := triggerFromHttpApi(evt)
 		if err != nil {
+			return nil, err
+		}
+		trigs = append(trigs, trigger)
+	case restEvent:
+		evt := &events.APIGatewayProxyRequest{}
+		if err := json.Unmarshal(bytes, evt); err != nil {
 			return nil, fmt.Errorf("unable to unmarshal httpEvent: %v", err)
 		}
 
-		// Copy the headers and re-write for the proxy
-		headerCopy := make(map[string][]string)
-
-		for key, val := range evt.Headers {
-			if strings.ToLower(key) == "host" {
-				headerCopy[xforwardHeader] = append(headerCopy[xforwardHeader], val)
-			} else {
-				headerCopy[key] = append(headerCopy[key], val)
-			}
+		trigger, err := triggerFromRestApi(evt)
+		if err != nil {
+			return nil, err
+		}
+		trigs = append(trigs, trigger)
+	case albEvent:
+		evt := &events.ALBTargetGroupRequest{}
+		if err := json.Unmarshal(bytes, evt); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal httpEvent: %v", err)
 		}
 
-		// Copy the cookies over
-		headerCopy["Cookie"] = evt.Cookies
-
-		// Parse the raw query string
-		qVals, err := url.ParseQuery(evt.RawQueryString)
+		trigger, err := triggerFromAlb(evt)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing query for httpEvent: %v", err)
+			return nil, err
 		}
-
-		trigs = append(trigs, &triggers.HttpRequest{
-			// FIXME: Translate to http.Header
-			Header: headerCopy,
-			Body:   []byte(evt.Body),
-			Method: evt.RequestContext.HTTP.Method,
-			Path:   evt.RawPath,
-			Query:  qVals,
-		})
+		trigs = append(trigs, trigger)
 	default:
 		return nil, fmt.Errorf("unhandled event type %v", data)
 	}
@@ -172,6 +307,24 @@ type LambdaGateway struct {
 	runtime  LambdaRuntimeHandler
 	gateway.UnimplementedGatewayPlugin
 	finished chan int
+	// Used to store response bodies too large to be returned from Lambda, disabled if nil
+	storage        storage.StorageService
+	overflowConfig *OverflowConfig
+	// The binary media types of the REST API invoking the function, all binary responses are returned if nil
+	binaryMediaTypes []string
+}
+
+// binaryAccepted - reports whether API Gateway will decode a base64 encoded response to the request, rather than returning it as text.
+// REST APIs only decode responses when the first media type the request accepts is one of the API's binary media types,
+// while other services always decode them
+func (s *LambdaGateway) binaryAccepted(evtType eventType, request *triggers.HttpRequest, response *httpResponse) bool {
+	if evtType != restEvent || !response.base64 || s.binaryMediaTypes == nil {
+		return true
+	}
+
+	accept := strings.Split(headerValue(request.Header, "Accept"), ",")[0]
+
+	return matchesMediaType(s.binaryMediaTypes, accept)
 }
 
 func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{}) (interface{}, error) {
@@ -180,6 +333,18 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 		return nil, err
 	}
 
+	// Lambda stops the invocation at its deadline, so workers are given it to bound their own work
+	if deadline, ok := ctx.Deadline(); ok {
+		for _, trig := range trigs {
+			switch t := trig.(type) {
+			case *triggers.HttpRequest:
+				t.Deadline = deadline
+			case *triggers.Event:
+				t.Deadline = deadline
+			}
+		}
+	}
+
 	for _, request := range trigs {
 		switch request.GetTriggerType() {
 		case triggers.TriggerType_Request:
@@ -190,34 +355,51 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 				if err != nil {
 					return nil, fmt.Errorf("unable to get worker to handle http trigger")
 				}
+				evtType := getEventType(data)
+				_, multiValueHeaders := data["multiValueHeaders"]
+
 				response, err := wrkr.HandleHttpRequest(httpEvent)
 				if err != nil {
-					return events.APIGatewayProxyResponse{
-						StatusCode: 500,
-						Body:       "Error processing lambda request",
-						// TODO: Need to determine best case when to use this...
-						IsBase64Encoded: true,
-					}, nil
+					return formatHttpResponse(evtType, multiValueHeaders, &httpResponse{
+						statusCode: 500,
+						body:       "Error processing lambda request",
+					}), nil
 				}
 
-				lambdaHTTPHeaders := make(map[string]string)
+				// Lambda responses are returned whole, so event streams can't be sent
+				if response.Events != nil {
+					response.Events.Close()
+					return formatHttpResponse(evtType, multiValueHeaders, &httpResponse{
+						statusCode: 501,
+						body:       "Event streams are not supported by lambda",
+					}), nil
+				}
 
-				if response.Header != nil {
-					response.Header.VisitAll(func(key []byte, val []byte) {
-						lambdaHTTPHeaders[string(key)] = string(val)
-					})
+				lambdaResponse := newHttpResponse(response)
+
+				if s.overflowConfig != nil {
+					if payload, _ := json.Marshal(formatHttpResponse(evtType, multiValueHeaders, lambdaResponse)); len(payload) > MaxResponseSize {
+						lambdaResponse, err = s.overflow(httpEvent.Path, lambdaResponse, response.Body)
+						if err != nil {
+							// Lambda rejects oversized responses, so respond with an error the caller can act on instead
+							log.Default().Printf("unable to store oversized response body: %v", err)
+							return formatHttpResponse(evtType, multiValueHeaders, &httpResponse{
+								statusCode: 502,
+								body:       "Response is too large to return and couldn't be stored",
+							}), nil
+						}
+					}
 				}
 
-				responseString := base64.StdEncoding.EncodeToString(response.Body)
+				if !s.binaryAccepted(evtType, httpEvent, lambdaResponse) {
+					log.Default().Printf("refusing binary response to %s, %s isn't one of the API's binary media types", httpEvent.Path, headerValue(lambdaResponse.headers, "Content-Type"))
+					return formatHttpResponse(evtType, multiValueHeaders, &httpResponse{
+						statusCode: 500,
+						body:       "Binary response can't be returned, its content type isn't one of the API's binary media types",
+					}), nil
+				}
 
-				// We want to sniff the content type of the body that we have here as lambda cannot gzip it...
-				return events.APIGatewayProxyResponse{
-					StatusCode: response.StatusCode,
-					Headers:    lambdaHTTPHeaders,
-					Body:       responseString,
-					// TODO: Need to determine best case when to use this...
-					IsBase64Encoded: true,
-				}, nil
+				return formatHttpResponse(evtType, multiValueHeaders, lambdaResponse), nil
 			} else {
 				return nil, fmt.Errorf("found non HttpRequest in event with trigger type: %s", triggers.TriggerType_Request.String())
 			}
@@ -229,6 +411,8 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 				if err != nil {
 					return nil, fmt.Errorf("unable to get worker to event trigger")
 				}
+				// Lambda retries failed events on its own schedule before sending them to the function's on-failure destination,
+				// so requested delays can't be applied, and permanent failures are only dead-lettered early by the membrane
 				if err := wrkr.HandleEvent(event); err != nil {
 					return nil, err
 				}
@@ -260,18 +444,20 @@ func (s *LambdaGateway) Stop() error {
 	return nil
 }
 
-func New(provider core.AwsProvider) (gateway.GatewayService, error) {
-	return &LambdaGateway{
-		provider: provider,
-		runtime:  lambda.Start,
-		finished: make(chan int),
-	}, nil
+func New(provider core.AwsProvider, opts ...LambdaGatewayOption) (gateway.GatewayService, error) {
+	return NewWithRuntime(provider, lambda.Start, opts...)
 }
 
-func NewWithRuntime(provider core.AwsProvider, runtime LambdaRuntimeHandler) (gateway.GatewayService, error) {
-	return &LambdaGateway{
+func NewWithRuntime(provider core.AwsProvider, runtime LambdaRuntimeHandler, opts ...LambdaGatewayOption) (gateway.GatewayService, error) {
+	lambdaGateway := &LambdaGateway{
 		provider: provider,
 		runtime:  runtime,
 		finished: make(chan int),
-	}, nil
+	}
+
+	for _, o := range opts {
+		o.Apply(lambdaGateway)
+	}
+
+	return lambdaGateway, nil
 }
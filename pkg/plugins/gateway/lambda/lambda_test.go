@@ -15,19 +15,26 @@
 package lambda_service_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/golang/mock/gomock"
+	"github.com/valyala/fasthttp"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	mock_provider "github.com/nitrictech/nitric/mocks/provider"
+	mock_storage "github.com/nitrictech/nitric/mocks/storage"
 	ep "github.com/nitrictech/nitric/pkg/plugins/events"
 	lambda_service "github.com/nitrictech/nitric/pkg/plugins/gateway/lambda"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
 	"github.com/nitrictech/nitric/pkg/triggers"
 	"github.com/nitrictech/nitric/pkg/worker"
@@ -38,6 +45,9 @@ type MockLambdaRuntime struct {
 	lambda_service.LambdaRuntimeHandler
 	// FIXME: Make this a union array of stuff to send....
 	eventQueue []interface{}
+	responses  []interface{}
+	// The deadline of each invocation, none if zero
+	deadline time.Time
 }
 
 func (m *MockLambdaRuntime) Start(handler interface{}) {
@@ -52,8 +62,16 @@ func (m *MockLambdaRuntime) Start(handler interface{}) {
 
 		// Unmarshal the thing into the event type we expect...
 		// TODO: Do something with out results here...
-		_, err = typedFunc(context.TODO(), evt)
+		ctx := context.TODO()
+		if !m.deadline.IsZero() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, m.deadline)
+			defer cancel()
+		}
+
+		resp, err := typedFunc(ctx, evt)
 		Expect(err).To(BeNil())
+		m.responses = append(m.responses, resp)
 	}
 }
 
@@ -134,6 +152,518 @@ var _ = Describe("Lambda", func() {
 		})
 	})
 
+	Context("Invocation deadlines", func() {
+		When("The invocation has a deadline", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			deadline := time.Now().Add(time.Minute)
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayV2HTTPRequest{
+					RawPath: "/test",
+					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+							Method: "GET",
+						},
+					},
+				}},
+				deadline: deadline,
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should give the trigger the invocation's deadline", func() {
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				Expect(len(mockHandler.ReceivedRequests)).To(Equal(1))
+				Expect(mockHandler.ReceivedRequests[0].Deadline).To(BeTemporally("==", deadline))
+			})
+		})
+	})
+
+	Context("Base64 Encoded Http Events", func() {
+		When("Sending a base64 encoded HTTP Event", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayV2HTTPRequest{
+					Headers: map[string]string{
+						"Content-Type": "multipart/form-data; boundary=test",
+					},
+					RawPath:         "/upload",
+					Body:            base64.StdEncoding.EncodeToString([]byte("--test\r\n")),
+					IsBase64Encoded: true,
+					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+							Method: "POST",
+						},
+					},
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should decode the body", func() {
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				Expect(len(mockHandler.ReceivedRequests)).To(Equal(1))
+				Expect(string(mockHandler.ReceivedRequests[0].Body)).To(Equal("--test\r\n"))
+			})
+		})
+	})
+
+	Context("REST API Events", func() {
+		When("Sending an API Gateway REST API event", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayProxyRequest{
+					HTTPMethod: "PUT",
+					Path:       "/orders/1",
+					MultiValueHeaders: map[string][]string{
+						"Accept": {"text/plain", "application/json"},
+					},
+					MultiValueQueryStringParameters: map[string][]string{
+						"key": {"a b", "c"},
+					},
+					Body: "Test Payload",
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should translate into a standard NitricRequest", func() {
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				Expect(mockHandler.ReceivedRequests).To(HaveLen(1))
+				request := mockHandler.ReceivedRequests[0]
+
+				Expect(request.Method).To(Equal("PUT"))
+				Expect(request.Path).To(Equal("/orders/1"))
+				Expect(request.Header["Accept"]).To(Equal([]string{"text/plain", "application/json"}))
+				Expect(request.Query["key"]).To(Equal([]string{"a b", "c"}))
+				Expect(string(request.Body)).To(Equal("Test Payload"))
+
+				By("Returning a REST API response")
+				response := runtime.responses[0].(events.APIGatewayProxyResponse)
+				Expect(response.StatusCode).To(Equal(200))
+				Expect(response.IsBase64Encoded).To(BeFalse())
+				Expect(response.Body).To(Equal("success"))
+			})
+		})
+	})
+
+	Context("Application Load Balancer Events", func() {
+		When("Sending an ALB event with multi value headers", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.ALBTargetGroupRequest{
+					HTTPMethod: "POST",
+					Path:       "/upload",
+					MultiValueHeaders: map[string][]string{
+						"content-type": {"application/octet-stream"},
+						"x-custom":     {"1", "2"},
+					},
+					MultiValueQueryStringParameters: map[string][]string{
+						"name": {"my%20file"},
+					},
+					RequestContext: events.ALBTargetGroupRequestContext{
+						ELB: events.ELBContext{TargetGroupArn: "arn:aws:elasticloadbalancing:target-group"},
+					},
+					Body:            base64.StdEncoding.EncodeToString([]byte{0, 1, 2}),
+					IsBase64Encoded: true,
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should translate into a standard NitricRequest", func() {
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				Expect(mockHandler.ReceivedRequests).To(HaveLen(1))
+				request := mockHandler.ReceivedRequests[0]
+
+				Expect(request.Method).To(Equal("POST"))
+				Expect(request.Path).To(Equal("/upload"))
+				Expect(request.Header["x-custom"]).To(Equal([]string{"1", "2"}))
+
+				By("Decoding the query parameters")
+				Expect(request.Query["name"]).To(Equal([]string{"my file"}))
+
+				By("Decoding the body")
+				Expect(request.Body).To(Equal([]byte{0, 1, 2}))
+
+				By("Returning a load balancer response with multi value headers")
+				response := runtime.responses[0].(events.ALBTargetGroupResponse)
+				Expect(response.StatusCode).To(Equal(200))
+				Expect(response.StatusDescription).To(Equal("200 OK"))
+				Expect(response.Headers).To(BeEmpty())
+				Expect(response.MultiValueHeaders).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("Binary Bodies", func() {
+		// A PNG signature and header chunk, which isn't valid UTF-8
+		png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0, 0, 0, 0x0d, 'I', 'H', 'D', 'R', 0xff}
+		// A protobuf message with a string field and a varint field
+		protobuf := []byte{0x0a, 0x05, 'h', 'e', 'l', 'l', 'o', 0x10, 0x96, 0x01}
+
+		// binaryPool - returns a pool with a worker that responds with the given content type, encoding and body
+		binaryPool := func(contentType string, contentEncoding string, body []byte) (worker.WorkerPool, *mock_worker.MockWorker) {
+			header := &fasthttp.ResponseHeader{}
+			header.SetContentType(contentType)
+			if contentEncoding != "" {
+				header.Set("Content-Encoding", contentEncoding)
+			}
+
+			wrkr := mock_worker.NewMockWorker(&mock_worker.MockWorkerOptions{
+				ReturnHttp: &triggers.HttpResponse{
+					Header:     header,
+					Body:       body,
+					StatusCode: 200,
+				},
+			})
+			p := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+			_ = p.AddWorker(wrkr)
+
+			return p, wrkr
+		}
+
+		When("An image is uploaded and returned through a HTTP API", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			imagePool, imageWorker := binaryPool("image/png", "", png)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayV2HTTPRequest{
+					Headers: map[string]string{
+						"Content-Type": "image/png",
+					},
+					RawPath:         "/images",
+					Body:            base64.StdEncoding.EncodeToString(png),
+					IsBase64Encoded: true,
+					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+							Method: "PUT",
+						},
+					},
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("Should pass the image through unchanged in both directions", func() {
+				err := client.Start(imagePool)
+				Expect(err).To(BeNil())
+
+				Expect(imageWorker.ReceivedRequests).To(HaveLen(1))
+				Expect(imageWorker.ReceivedRequests[0].Body).To(Equal(png))
+
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.IsBase64Encoded).To(BeTrue())
+				Expect(base64.StdEncoding.DecodeString(response.Body)).To(Equal(png))
+			})
+		})
+
+		When("A protobuf body is sent without base64 padding", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			protoPool, protoWorker := binaryPool("application/x-protobuf", "", protobuf)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.ALBTargetGroupRequest{
+					HTTPMethod: "POST",
+					Path:       "/rpc",
+					Headers: map[string]string{
+						"content-type": "application/x-protobuf",
+					},
+					Body:            base64.RawStdEncoding.EncodeToString(protobuf),
+					IsBase64Encoded: true,
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("Should decode the body and base64 encode the response", func() {
+				err := client.Start(protoPool)
+				Expect(err).To(BeNil())
+
+				Expect(protoWorker.ReceivedRequests).To(HaveLen(1))
+				Expect(protoWorker.ReceivedRequests[0].Body).To(Equal(protobuf))
+
+				response := runtime.responses[0].(events.ALBTargetGroupResponse)
+				Expect(response.IsBase64Encoded).To(BeTrue())
+				Expect(base64.StdEncoding.DecodeString(response.Body)).To(Equal(protobuf))
+			})
+		})
+
+		When("A text response is gzip compressed", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			var compressed bytes.Buffer
+			gz := gzip.NewWriter(&compressed)
+			_, _ = gz.Write([]byte("hello"))
+			_ = gz.Close()
+			gzipPool, _ := binaryPool("text/plain", "gzip", compressed.Bytes())
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayV2HTTPRequest{
+					Headers: map[string]string{
+						"Accept-Encoding": "gzip",
+					},
+					RawPath: "/greeting",
+					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+							Method: "GET",
+						},
+					},
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("Should base64 encode the compressed body", func() {
+				err := client.Start(gzipPool)
+				Expect(err).To(BeNil())
+
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.IsBase64Encoded).To(BeTrue())
+				Expect(response.Headers).To(HaveKeyWithValue("Content-Encoding", "gzip"))
+
+				body, err := base64.StdEncoding.DecodeString(response.Body)
+				Expect(err).To(BeNil())
+				Expect(body).To(Equal(compressed.Bytes()))
+			})
+		})
+
+		When("A REST API has binary media types", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			imagePool, _ := binaryPool("image/png", "", png)
+
+			request := func(accept string) *events.APIGatewayProxyRequest {
+				return &events.APIGatewayProxyRequest{
+					HTTPMethod: "GET",
+					Path:       "/images/1",
+					Headers: map[string]string{
+						"Accept": accept,
+					},
+				}
+			}
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{request("image/png, */*"), request("application/json, image/png")},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start, lambda_service.WithBinaryMediaTypes(lambda_service.ParseBinaryMediaTypes("image/*, application/x-protobuf")))
+			Expect(err).To(BeNil())
+
+			It("Should only return binary responses the API will decode", func() {
+				err := client.Start(imagePool)
+				Expect(err).To(BeNil())
+
+				Expect(runtime.responses).To(HaveLen(2))
+
+				By("Returning the image to a request that accepts it")
+				accepted := runtime.responses[0].(events.APIGatewayProxyResponse)
+				Expect(accepted.StatusCode).To(Equal(200))
+				Expect(accepted.IsBase64Encoded).To(BeTrue())
+				Expect(base64.StdEncoding.DecodeString(accepted.Body)).To(Equal(png))
+
+				By("Refusing the image when the first accepted media type isn't a binary media type")
+				refused := runtime.responses[1].(events.APIGatewayProxyResponse)
+				Expect(refused.StatusCode).To(Equal(500))
+				Expect(refused.IsBase64Encoded).To(BeFalse())
+			})
+		})
+	})
+
+	Context("Function URL Events", func() {
+		cookiePool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+		header := &fasthttp.ResponseHeader{}
+		header.Add("Set-Cookie", "a=1")
+		header.Add("Set-Cookie", "b=2")
+		header.Add("Cache-Control", "no-cache")
+		_ = cookiePool.AddWorker(mock_worker.NewMockWorker(&mock_worker.MockWorkerOptions{
+			ReturnHttp: &triggers.HttpResponse{
+				Header:     header,
+				Body:       []byte("success"),
+				StatusCode: 200,
+			},
+		}))
+
+		When("A response sets multiple cookies", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.APIGatewayV2HTTPRequest{
+					Version: "2.0",
+					RawPath: "/login",
+					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						DomainName: "abc123.lambda-url.us-east-1.on.aws",
+						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+							Method: "POST",
+						},
+					},
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("Should return each cookie", func() {
+				err := client.Start(cookiePool)
+				Expect(err).To(BeNil())
+
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.Cookies).To(ConsistOf("a=1", "b=2"))
+				Expect(response.Headers).ToNot(HaveKey("Set-Cookie"))
+				Expect(response.Headers["Cache-Control"]).To(Equal("no-cache"))
+			})
+		})
+	})
+
+	Context("Large Http Responses", func() {
+		largeBody := bytes.Repeat([]byte("a"), lambda_service.MaxResponseSize)
+
+		largePool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+		_ = largePool.AddWorker(mock_worker.NewMockWorker(&mock_worker.MockWorkerOptions{
+			ReturnHttp: &triggers.HttpResponse{
+				Body:       largeBody,
+				StatusCode: 200,
+			},
+		}))
+
+		request := &events.APIGatewayV2HTTPRequest{
+			RawPath: "/downloads/report",
+			RequestContext: events.APIGatewayV2HTTPRequestContext{
+				HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
+					Method: "GET",
+				},
+			},
+		}
+
+		When("The route is configured to redirect", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+
+			runtime := MockLambdaRuntime{eventQueue: []interface{}{request}}
+
+			config, _ := lambda_service.ParseOverflowConfig("overflow", 60, "none,/downloads=redirect")
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start, lambda_service.WithOverflow(mockStorage, config))
+			Expect(err).To(BeNil())
+
+			It("Should store the body and redirect to it", func() {
+				mockStorage.EXPECT().Write("overflow", gomock.Any(), largeBody).Return("", nil)
+				mockStorage.EXPECT().PreSignUrl("overflow", gomock.Any(), storage.READ, uint32(60)).Return("https://signed.url", nil)
+
+				err := client.Start(largePool)
+				Expect(err).To(BeNil())
+
+				Expect(runtime.responses).To(HaveLen(1))
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.StatusCode).To(Equal(303))
+				Expect(response.Headers["Location"]).To(Equal("https://signed.url"))
+				Expect(response.Body).To(BeEmpty())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The route is configured to return a url", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+
+			runtime := MockLambdaRuntime{eventQueue: []interface{}{request}}
+
+			config, _ := lambda_service.ParseOverflowConfig("overflow", 60, "url")
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start, lambda_service.WithOverflow(mockStorage, config))
+			Expect(err).To(BeNil())
+
+			It("Should store the body and return its url", func() {
+				mockStorage.EXPECT().Write("overflow", gomock.Any(), largeBody).Return("", nil)
+				mockStorage.EXPECT().PreSignUrl("overflow", gomock.Any(), storage.READ, uint32(60)).Return("https://signed.url", nil)
+
+				err := client.Start(largePool)
+				Expect(err).To(BeNil())
+
+				Expect(runtime.responses).To(HaveLen(1))
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.StatusCode).To(Equal(200))
+				Expect(response.Body).To(MatchJSON(`{"url": "https://signed.url"}`))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The body can't be stored", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+
+			runtime := MockLambdaRuntime{eventQueue: []interface{}{request}}
+
+			config, _ := lambda_service.ParseOverflowConfig("overflow", 60, "redirect")
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start, lambda_service.WithOverflow(mockStorage, config))
+			Expect(err).To(BeNil())
+
+			It("Should respond with a bad gateway error instead of the oversized response", func() {
+				mockStorage.EXPECT().Write("overflow", gomock.Any(), largeBody).Return("", fmt.Errorf("mock error"))
+
+				err := client.Start(largePool)
+				Expect(err).To(BeNil())
+
+				Expect(runtime.responses).To(HaveLen(1))
+				response := runtime.responses[0].(events.APIGatewayV2HTTPResponse)
+				Expect(response.StatusCode).To(Equal(502))
+				Expect(len(response.Body)).To(BeNumerically("<", lambda_service.MaxResponseSize))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("ParseOverflowConfig", func() {
+		When("Given a default and per route modes", func() {
+			config, err := lambda_service.ParseOverflowConfig("overflow", 60, "url, /downloads=redirect, /downloads/small=none")
+
+			It("Should use the longest matching route", func() {
+				Expect(err).To(BeNil())
+				Expect(config.ModeFor("/downloads/small/file")).To(Equal(lambda_service.OverflowNone))
+				Expect(config.ModeFor("/downloads/large")).To(Equal(lambda_service.OverflowRedirect))
+				Expect(config.ModeFor("/other")).To(Equal(lambda_service.OverflowUrl))
+			})
+		})
+
+		When("Given an unknown mode", func() {
+			_, err := lambda_service.ParseOverflowConfig("overflow", 60, "/downloads=stream")
+
+			It("Should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
 	Context("SNS Events", func() {
 		When("The Lambda Gateway receives SNS events", func() {
 			ctrl := gomock.NewController(GinkgoT())
@@ -163,8 +693,16 @@ var _ = Describe("Lambda", func() {
 							EventSource:          "aws:sns",
 							EventSubscriptionArn: "some:arbitrary:subscription:arn:MySubscription",
 							SNS: events.SNSEntity{
-								TopicArn: fmt.Sprintf("some:arbitrary:topic:arn:%s", topicName),
-								Message:  string(messageBytes),
+								TopicArn:  fmt.Sprintf("some:arbitrary:topic:arn:%s", topicName),
+								MessageID: "test-message-id",
+								Timestamp: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+								Message:   string(messageBytes),
+								MessageAttributes: map[string]interface{}{
+									"traceparent": map[string]interface{}{
+										"Type":  "String",
+										"Value": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+									},
+								},
 							},
 						},
 					},
@@ -193,6 +731,73 @@ var _ = Describe("Lambda", func() {
 
 				By("Containing the Source Topic")
 				Expect(request.Topic).To(Equal("MyTopic"))
+
+				By("Describing where the message was delivered from")
+				Expect(request.Metadata.Source).To(Equal("some:arbitrary:topic:arn:MyTopic"))
+				Expect(request.Metadata.ProviderID).To(Equal("test-message-id"))
+				Expect(request.Metadata.PublishTime).To(Equal(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)))
+				Expect(request.Metadata.TraceContext).To(HaveKeyWithValue("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			})
+		})
+	})
+
+	Context("S3 Events", func() {
+		When("The Lambda Gateway receives S3 events", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.S3Event{
+					Records: []events.S3EventRecord{
+						{
+							EventSource:      "aws:s3",
+							EventName:        "ObjectCreated:Put",
+							EventTime:        time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+							ResponseElements: map[string]string{"x-amz-request-id": "test-request-id"},
+							S3: events.S3Entity{
+								Bucket: events.S3Bucket{Arn: "arn:aws:s3:::images-1234"},
+								Object: events.S3Object{Key: "uploads/my+cat.png", Sequencer: "0055AED6DCD90281E5"},
+							},
+						},
+						{
+							EventSource: "aws:s3",
+							EventName:   "ObjectRestore:Completed",
+							S3: events.S3Entity{
+								Bucket: events.S3Bucket{Arn: "arn:aws:s3:::images-1234"},
+								Object: events.S3Object{Key: "uploads/dog.png"},
+							},
+						},
+					},
+				}},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should translate created items into bucket notifications", func() {
+				By("having the bucket available")
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"images": "arn:aws:s3:::images-1234",
+				}, nil)
+
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				By("Handling only the created item")
+				Expect(len(mockHandler.ReceivedEvents)).To(Equal(1))
+
+				request := mockHandler.ReceivedEvents[0]
+				Expect(request.Topic).To(Equal(worker.BucketTopic("images")))
+
+				notification, ok := worker.ParseBucketNotification(request)
+				Expect(ok).To(BeTrue())
+				Expect(notification.Key).To(Equal("uploads/my cat.png"))
+				Expect(notification.Type).To(Equal(worker.NotificationCreated))
+
+				By("Describing where the notification was delivered from")
+				Expect(request.Metadata.Source).To(Equal("arn:aws:s3:::images-1234"))
+				Expect(request.Metadata.ProviderID).To(Equal("test-request-id"))
+				Expect(request.Metadata.PublishTime).To(Equal(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)))
 			})
 		})
 	})
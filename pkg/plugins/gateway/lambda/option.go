@@ -0,0 +1,55 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda_service
+
+import "github.com/nitrictech/nitric/pkg/plugins/storage"
+
+type LambdaGatewayOption interface {
+	Apply(*LambdaGateway)
+}
+
+type withOverflow struct {
+	storage storage.StorageService
+	config  *OverflowConfig
+}
+
+func (w *withOverflow) Apply(gateway *LambdaGateway) {
+	gateway.storage = w.storage
+	gateway.overflowConfig = w.config
+}
+
+type withBinaryMediaTypes struct {
+	types []string
+}
+
+func (w *withBinaryMediaTypes) Apply(gateway *LambdaGateway) {
+	gateway.binaryMediaTypes = w.types
+}
+
+// WithBinaryMediaTypes - the binary media types of the REST API invoking the function.
+// API Gateway only returns binary bodies of these types, so responses with other binary bodies are refused rather than corrupted
+func WithBinaryMediaTypes(types []string) LambdaGatewayOption {
+	return &withBinaryMediaTypes{
+		types: types,
+	}
+}
+
+// WithOverflow - store response bodies too large for Lambda to return in a bucket, responding with a pre-signed URL to the stored body instead
+func WithOverflow(storage storage.StorageService, config *OverflowConfig) LambdaGatewayOption {
+	return &withOverflow{
+		storage: storage,
+		config:  config,
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// MaxResponseSize - the largest response payload a synchronously invoked Lambda function can return
+const MaxResponseSize = 6 * 1024 * 1024
+
+type OverflowMode = string
+
+const (
+	// OverflowRedirect - respond with a redirect to a pre-signed URL for the stored body
+	OverflowRedirect OverflowMode = "redirect"
+	// OverflowUrl - respond with a JSON document containing a pre-signed URL for the stored body
+	OverflowUrl OverflowMode = "url"
+	// OverflowNone - never offload response bodies, oversized responses will be rejected by Lambda
+	OverflowNone OverflowMode = "none"
+)
+
+// OverflowConfig - Determines how responses too large to be returned from Lambda are handled
+type OverflowConfig struct {
+	// Bucket - nitric name of the bucket oversized response bodies are stored in
+	Bucket string
+	// Expiry - the number of seconds pre-signed URLs for stored bodies remain valid
+	Expiry uint32
+	// Default - the mode used for routes without an explicit mode
+	Default OverflowMode
+	// Routes - per route modes, keyed by path prefix. The longest matching prefix is used.
+	Routes map[string]OverflowMode
+}
+
+// ModeFor - returns the overflow mode for the given request path
+func (c *OverflowConfig) ModeFor(path string) OverflowMode {
+	mode := c.Default
+	matched := -1
+
+	for prefix, m := range c.Routes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+			mode = m
+			matched = len(prefix)
+		}
+	}
+
+	return mode
+}
+
+// ParseOverflowConfig - parses a route overflow configuration string
+// e.g. "redirect" redirects all oversized responses
+// and "url,/downloads=redirect,/health=none" responds with a URL by default, redirects for paths under /downloads and never offloads /health responses
+func ParseOverflowConfig(bucket string, expiry uint32, config string) (*OverflowConfig, error) {
+	c := &OverflowConfig{
+		Bucket:  bucket,
+		Expiry:  expiry,
+		Default: OverflowRedirect,
+		Routes:  make(map[string]OverflowMode),
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route := ""
+		mode := entry
+		if parts := strings.SplitN(entry, "=", 2); len(parts) == 2 {
+			route = strings.TrimSpace(parts[0])
+			mode = strings.TrimSpace(parts[1])
+		}
+
+		switch mode {
+		case OverflowRedirect, OverflowUrl, OverflowNone:
+		default:
+			return nil, fmt.Errorf("unknown overflow mode %s, supported modes are redirect, url and none", mode)
+		}
+
+		if route == "" {
+			c.Default = mode
+		} else {
+			c.Routes[route] = mode
+		}
+	}
+
+	return c, nil
+}
+
+type overflowUrlResponse struct {
+	Url string `json:"url"`
+}
+
+// overflow - stores the body of an oversized response, returning a response that refers to it instead
+func (s *LambdaGateway) overflow(path string, response *httpResponse, body []byte) (*httpResponse, error) {
+	mode := s.overflowConfig.ModeFor(path)
+	if mode == OverflowNone {
+		return response, nil
+	}
+
+	key := fmt.Sprintf("gateway-overflow/%s", uuid.New().String())
+	if _, err := s.storage.Write(s.overflowConfig.Bucket, key, body); err != nil {
+		return response, err
+	}
+
+	url, err := s.storage.PreSignUrl(s.overflowConfig.Bucket, key, storage.READ, s.overflowConfig.Expiry)
+	if err != nil {
+		return response, err
+	}
+
+	headers := make(map[string][]string)
+	for k, v := range response.headers {
+		// Describe the original body, which is no longer part of the response
+		if strings.EqualFold(k, "Content-Length") || strings.EqualFold(k, "Content-Type") || strings.EqualFold(k, "Content-Encoding") {
+			continue
+		}
+		headers[k] = v
+	}
+
+	if mode == OverflowRedirect {
+		headers["Location"] = []string{url}
+
+		return &httpResponse{
+			statusCode: http.StatusSeeOther,
+			headers:    headers,
+		}, nil
+	}
+
+	urlBody, _ := json.Marshal(&overflowUrlResponse{Url: url})
+	headers["Content-Type"] = []string{"application/json"}
+
+	return &httpResponse{
+		statusCode: response.statusCode,
+		headers:    headers,
+		body:       string(urlBody),
+	}, nil
+}
@@ -0,0 +1,363 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ad_b2c_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// DefaultGraphUrl - the Microsoft Graph API, which manages the users of B2C tenants
+const DefaultGraphUrl = "https://graph.microsoft.com"
+
+// defaultSelect - the user properties always read from Graph
+var defaultSelect = []string{"id", "accountEnabled", "createdDateTime", "mail", "identities"}
+
+// AdB2CService - identity plugin for Azure AD B2C.
+//
+// Users are identified by their object ID and sign in with their email address. Attributes are Graph user properties,
+// e.g. givenName or a custom attribute's extension_<app id>_<name> property, and only the properties configured
+// to be selected are returned with users.
+type AdB2CService struct {
+	identity.UnimplementedIdentityPlugin
+	// domain - the tenant's initial domain, e.g. contoso.onmicrosoft.com
+	domain     string
+	graphUrl   string
+	attributes []string
+	client     *http.Client
+	verifier   *identity.Verifier
+}
+
+type graphError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// bearerTransport - authenticates requests with service principal tokens, refreshing them as they expire
+type bearerTransport struct {
+	spt *adal.ServicePrincipalToken
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.spt.EnsureFresh(); err != nil {
+		return nil, err
+	}
+
+	authed := req.Clone(req.Context())
+	authed.Header.Set("Authorization", "Bearer "+t.spt.OAuthToken())
+
+	return http.DefaultTransport.RoundTrip(authed)
+}
+
+// do - sends a request to Graph, returning the response body and status code
+func (s *AdB2CService) do(method string, path string, body interface{}) ([]byte, int, error) {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, s.graphUrl+"/v1.0/"+path, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// errorCode - returns the plugin's error code for a Graph error response
+func errorCode(status int, body []byte) codes.Code {
+	graphErr := &graphError{}
+	_ = json.Unmarshal(body, graphErr)
+
+	// conflicting users are rejected as bad requests, naming the property that already exists
+	if strings.Contains(graphErr.Error.Message, "already exists") {
+		return codes.AlreadyExists
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// call - sends a request to Graph, decoding its response into out if it's given
+func (s *AdB2CService) call(newErr errors.ErrorFactory, msg string, method string, path string, body interface{}, out interface{}) error {
+	respBody, status, err := s.do(method, path, body)
+	if err != nil {
+		return newErr(codes.Unavailable, msg, err)
+	}
+
+	if status >= 300 {
+		return newErr(errorCode(status, respBody), msg, fmt.Errorf("unexpected response status %d: %s", status, respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return newErr(codes.Internal, msg, err)
+		}
+	}
+
+	return nil
+}
+
+// toUser - returns the plugin's user for a Graph user, with the plugin's selected attributes.
+// The user's email is the email address they sign in with, or their mail if they don't sign in with one
+func (s *AdB2CService) toUser(props map[string]interface{}) *identity.User {
+	u := &identity.User{
+		Attributes: map[string]string{},
+	}
+
+	u.Id, _ = props["id"].(string)
+	u.Email, _ = props["mail"].(string)
+	if enabled, ok := props["accountEnabled"].(bool); ok {
+		u.Disabled = !enabled
+	}
+	if created, ok := props["createdDateTime"].(string); ok {
+		u.CreatedAt, _ = time.Parse(time.RFC3339, created)
+	}
+
+	if identities, ok := props["identities"].([]interface{}); ok {
+		for _, i := range identities {
+			if id, ok := i.(map[string]interface{}); ok && id["signInType"] == "emailAddress" {
+				u.Email, _ = id["issuerAssignedId"].(string)
+				break
+			}
+		}
+	}
+
+	for _, name := range s.attributes {
+		switch value := props[name].(type) {
+		case nil:
+		case string:
+			u.Attributes[name] = value
+		default:
+			b, _ := json.Marshal(value)
+			u.Attributes[name] = string(b)
+		}
+	}
+
+	return u
+}
+
+func (s *AdB2CService) VerifyToken(token string) (*identity.Token, error) {
+	claims, err := s.verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity.NewToken(claims, "oid", "sub"), nil
+}
+
+func (s *AdB2CService) GetUser(id string) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"AdB2CService.GetUser",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	query := url.Values{"$select": {strings.Join(append(defaultSelect, s.attributes...), ",")}}
+
+	props := map[string]interface{}{}
+	if err := s.call(newErr, "error getting user", http.MethodGet, "users/"+url.PathEscape(id)+"?"+query.Encode(), nil, &props); err != nil {
+		return nil, err
+	}
+
+	return s.toUser(props), nil
+}
+
+// CreateUser - creates the user with a password that doesn't expire. B2C requires users be created with a password
+func (s *AdB2CService) CreateUser(user *identity.NewUser) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"AdB2CService.CreateUser",
+		map[string]interface{}{
+			"email": user.Email,
+		},
+	)
+
+	if user.Password == "" {
+		return nil, newErr(codes.InvalidArgument, "a password is required to create B2C users", nil)
+	}
+
+	body := map[string]interface{}{}
+	for name, value := range user.Attributes {
+		body[name] = value
+	}
+	if _, ok := body["displayName"]; !ok {
+		body["displayName"] = user.Email
+	}
+	body["accountEnabled"] = true
+	body["identities"] = []map[string]string{{
+		"signInType":       "emailAddress",
+		"issuer":           s.domain,
+		"issuerAssignedId": user.Email,
+	}}
+	body["passwordProfile"] = map[string]interface{}{
+		"password":                      user.Password,
+		"forceChangePasswordNextSignIn": false,
+	}
+	body["passwordPolicies"] = "DisablePasswordExpiration"
+
+	// the created user is returned by Graph, as it may not be readable until it has replicated
+	props := map[string]interface{}{}
+	if err := s.call(newErr, "error creating user", http.MethodPost, "users", body, &props); err != nil {
+		return nil, err
+	}
+
+	for name, value := range user.Attributes {
+		if _, ok := props[name]; !ok {
+			props[name] = value
+		}
+	}
+
+	return s.toUser(props), nil
+}
+
+func (s *AdB2CService) SetDisabled(id string, disabled bool) error {
+	newErr := errors.ErrorsWithScope(
+		"AdB2CService.SetDisabled",
+		map[string]interface{}{
+			"id":       id,
+			"disabled": disabled,
+		},
+	)
+
+	return s.call(newErr, "error setting user disabled", http.MethodPatch, "users/"+url.PathEscape(id), map[string]interface{}{
+		"accountEnabled": !disabled,
+	}, nil)
+}
+
+func (s *AdB2CService) SetAttributes(id string, attrs map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"AdB2CService.SetAttributes",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	return s.call(newErr, "error setting user attributes", http.MethodPatch, "users/"+url.PathEscape(id), attrs, nil)
+}
+
+// Authority - returns the OpenID Connect authority of the tenant's user flow, e.g. B2C_1_signin
+func Authority(tenant string, userFlow string) string {
+	return fmt.Sprintf("https://%s.b2clogin.com/%s.onmicrosoft.com/%s/v2.0", tenant, tenant, userFlow)
+}
+
+// New - creates a new AD B2C identity plugin for the AZURE_B2C_TENANT tenant, e.g. contoso, verifying tokens issued
+// by its AZURE_B2C_USER_FLOW to one of the comma separated AZURE_B2C_CLIENT_IDS.
+//
+// Graph is called with the AZURE_B2C_GRAPH_CLIENT_ID and AZURE_B2C_GRAPH_CLIENT_SECRET app registered in the tenant,
+// or the provider's credentials if they're unset, which must then belong to the tenant
+func New(provider core.AzProvider) (identity.IdentityService, error) {
+	tenant := utils.GetEnv("AZURE_B2C_TENANT", "")
+	userFlow := utils.GetEnv("AZURE_B2C_USER_FLOW", "")
+	if tenant == "" || userFlow == "" {
+		return nil, fmt.Errorf("AZURE_B2C_TENANT and AZURE_B2C_USER_FLOW env vars are required to manage users with AD B2C")
+	}
+
+	var spt *adal.ServicePrincipalToken
+	var err error
+	if clientId := utils.GetEnv("AZURE_B2C_GRAPH_CLIENT_ID", ""); clientId != "" {
+		creds := auth.NewClientCredentialsConfig(clientId, utils.GetEnv("AZURE_B2C_GRAPH_CLIENT_SECRET", ""), tenant+".onmicrosoft.com")
+		creds.Resource = DefaultGraphUrl
+		spt, err = creds.ServicePrincipalToken()
+	} else {
+		spt, err = provider.ServicePrincipalToken(DefaultGraphUrl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens issued to any application of the tenant would otherwise be accepted
+	ids := utils.GetEnv("AZURE_B2C_CLIENT_IDS", "")
+	if ids == "" {
+		return nil, fmt.Errorf("AZURE_B2C_CLIENT_IDS env var is required to verify tokens issued by AD B2C")
+	}
+
+	var attributes []string
+	if attrs := utils.GetEnv("AZURE_B2C_ATTRIBUTES", ""); attrs != "" {
+		attributes = strings.Split(attrs, ",")
+	}
+
+	client := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &bearerTransport{spt: spt},
+	}
+
+	return NewWithClient(
+		tenant+".onmicrosoft.com",
+		DefaultGraphUrl,
+		attributes,
+		client,
+		identity.NewVerifier(Authority(tenant, userFlow), strings.Split(ids, ","), nil),
+	), nil
+}
+
+// NewWithClient - creates a new AD B2C identity plugin for the tenant with the initial domain, sending requests to graphUrl
+// with the given client, which must authenticate them, and verifying tokens with the given verifier
+func NewWithClient(domain string, graphUrl string, attributes []string, client *http.Client, verifier *identity.Verifier) *AdB2CService {
+	return &AdB2CService{
+		domain:     domain,
+		graphUrl:   strings.TrimSuffix(graphUrl, "/"),
+		attributes: attributes,
+		client:     client,
+		verifier:   verifier,
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ad_b2c_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	ad_b2c_service "github.com/nitrictech/nitric/pkg/plugins/identity/ad_b2c"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	body   map[string]interface{}
+}
+
+// newGraph - starts a fake Graph API responding to every request with status and body, recording the requests it receives
+func newGraph(status int, body string) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.Path, query: r.URL.Query().Get("$select")}
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("AD B2C", func() {
+	attributes := []string{"givenName", "extension_abc_seats"}
+
+	Context("GetUser", func() {
+		When("the user exists", func() {
+			It("should return the user with their sign in email and selected attributes", func() {
+				server, requests := newGraph(http.StatusOK, `{
+					"id": "oid-1",
+					"accountEnabled": false,
+					"createdDateTime": "2022-01-01T00:00:00Z",
+					"mail": null,
+					"identities": [
+						{"signInType": "userPrincipalName", "issuerAssignedId": "oid-1@contoso.onmicrosoft.com"},
+						{"signInType": "emailAddress", "issuerAssignedId": "jane@example.com"}
+					],
+					"givenName": "Jane",
+					"extension_abc_seats": 5
+				}`)
+				defer server.Close()
+
+				s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+				user, err := s.GetUser("oid-1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user).To(Equal(&identity.User{
+					Id:         "oid-1",
+					Email:      "jane@example.com",
+					Disabled:   true,
+					Attributes: map[string]string{"givenName": "Jane", "extension_abc_seats": "5"},
+					CreatedAt:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				}))
+
+				Expect((*requests)[0].path).To(Equal("/v1.0/users/oid-1"))
+				Expect((*requests)[0].query).To(Equal("id,accountEnabled,createdDateTime,mail,identities,givenName,extension_abc_seats"))
+			})
+		})
+
+		When("the user doesn't exist", func() {
+			It("should return a NotFound error", func() {
+				server, _ := newGraph(http.StatusNotFound, `{"error": {"code": "Request_ResourceNotFound", "message": "Resource 'oid-1' does not exist."}}`)
+				defer server.Close()
+
+				s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+				_, err := s.GetUser("oid-1")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("CreateUser", func() {
+		When("the user is created", func() {
+			It("should create a user signing in with their email", func() {
+				server, requests := newGraph(http.StatusCreated, `{"id": "oid-1", "accountEnabled": true, "identities": [{"signInType": "emailAddress", "issuerAssignedId": "jane@example.com"}]}`)
+				defer server.Close()
+
+				s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+				user, err := s.CreateUser(&identity.NewUser{
+					Email:      "jane@example.com",
+					Password:   "s3cret!!",
+					Attributes: map[string]string{"givenName": "Jane"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user.Id).To(Equal("oid-1"))
+				Expect(user.Email).To(Equal("jane@example.com"))
+				Expect(user.Attributes).To(Equal(map[string]string{"givenName": "Jane"}))
+
+				Expect((*requests)[0].method).To(Equal(http.MethodPost))
+				Expect((*requests)[0].path).To(Equal("/v1.0/users"))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"accountEnabled": true,
+					"displayName":    "jane@example.com",
+					"givenName":      "Jane",
+					"identities": []interface{}{map[string]interface{}{
+						"signInType":       "emailAddress",
+						"issuer":           "contoso.onmicrosoft.com",
+						"issuerAssignedId": "jane@example.com",
+					}},
+					"passwordProfile": map[string]interface{}{
+						"password":                      "s3cret!!",
+						"forceChangePasswordNextSignIn": false,
+					},
+					"passwordPolicies": "DisablePasswordExpiration",
+				}))
+			})
+		})
+
+		When("no password is given", func() {
+			It("should return an InvalidArgument error", func() {
+				s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", "http://localhost", attributes, nil, nil)
+				_, err := s.CreateUser(&identity.NewUser{Email: "jane@example.com"})
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("the email is taken", func() {
+			It("should return an AlreadyExists error", func() {
+				server, _ := newGraph(http.StatusBadRequest, `{"error": {"code": "Request_BadRequest", "message": "Another object with the same value for property identities already exists."}}`)
+				defer server.Close()
+
+				s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+				_, err := s.CreateUser(&identity.NewUser{Email: "jane@example.com", Password: "s3cret!!"})
+				Expect(errors.Code(err)).To(Equal(codes.AlreadyExists))
+			})
+		})
+	})
+
+	Context("SetDisabled", func() {
+		It("should patch the user's accountEnabled", func() {
+			server, requests := newGraph(http.StatusNoContent, ``)
+			defer server.Close()
+
+			s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+			Expect(s.SetDisabled("oid-1", true)).To(Succeed())
+			Expect((*requests)[0].method).To(Equal(http.MethodPatch))
+			Expect((*requests)[0].body).To(Equal(map[string]interface{}{"accountEnabled": false}))
+		})
+	})
+
+	Context("SetAttributes", func() {
+		It("should patch the user's properties", func() {
+			server, requests := newGraph(http.StatusNoContent, ``)
+			defer server.Close()
+
+			s := ad_b2c_service.NewWithClient("contoso.onmicrosoft.com", server.URL, attributes, server.Client(), nil)
+			Expect(s.SetAttributes("oid-1", map[string]string{"givenName": "Janet"})).To(Succeed())
+			Expect((*requests)[0].path).To(Equal("/v1.0/users/oid-1"))
+			Expect((*requests)[0].body).To(Equal(map[string]interface{}{"givenName": "Janet"}))
+		})
+	})
+})
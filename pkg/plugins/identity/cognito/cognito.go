@@ -0,0 +1,277 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cognito_service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// CognitoService - identity plugin for Amazon Cognito user pools.
+//
+// Users are identified by their username. Users are created with their email as their username,
+// so pools must either use plain usernames or allow signing in with an email as the username.
+type CognitoService struct {
+	identity.UnimplementedIdentityPlugin
+	client     cognitoidentityprovideriface.CognitoIdentityProviderAPI
+	userPoolId string
+	verifier   *identity.Verifier
+	// tokenUse - the kind of token accepted, id or access, so one can't be used in place of the other
+	tokenUse string
+}
+
+// code - returns the plugin's error code for a Cognito error
+func code(err error) codes.Code {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case cognitoidentityprovider.ErrCodeUserNotFoundException:
+			return codes.NotFound
+		case cognitoidentityprovider.ErrCodeUsernameExistsException, cognitoidentityprovider.ErrCodeAliasExistsException:
+			return codes.AlreadyExists
+		case cognitoidentityprovider.ErrCodeInvalidParameterException, cognitoidentityprovider.ErrCodeInvalidPasswordException:
+			return codes.InvalidArgument
+		case cognitoidentityprovider.ErrCodeTooManyRequestsException:
+			return codes.ResourceExhausted
+		}
+	}
+
+	return codes.Internal
+}
+
+// attributes - returns Cognito attributes for the plugin's attributes
+func attributes(attrs map[string]string) []*cognitoidentityprovider.AttributeType {
+	out := make([]*cognitoidentityprovider.AttributeType, 0, len(attrs))
+	for name, value := range attrs {
+		out = append(out, &cognitoidentityprovider.AttributeType{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+
+	return out
+}
+
+// toUser - returns the plugin's user for a Cognito user, their email is returned separately from their other attributes
+func toUser(user *cognitoidentityprovider.UserType) *identity.User {
+	u := &identity.User{
+		Id:         aws.StringValue(user.Username),
+		Disabled:   !aws.BoolValue(user.Enabled),
+		Attributes: map[string]string{},
+		CreatedAt:  aws.TimeValue(user.UserCreateDate),
+	}
+
+	for _, attr := range user.Attributes {
+		if aws.StringValue(attr.Name) == "email" {
+			u.Email = aws.StringValue(attr.Value)
+		} else {
+			u.Attributes[aws.StringValue(attr.Name)] = aws.StringValue(attr.Value)
+		}
+	}
+
+	return u
+}
+
+func (s *CognitoService) VerifyToken(token string) (*identity.Token, error) {
+	newErr := errors.ErrorsWithScope("CognitoService.VerifyToken", nil)
+
+	claims, err := s.verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if use, _ := claims["token_use"].(string); use != s.tokenUse {
+		return nil, newErr(codes.Unauthenticated, fmt.Sprintf("invalid token: expected an %s token, got %q", s.tokenUse, use), nil)
+	}
+
+	// ID tokens name the user in cognito:username and access tokens in username
+	return identity.NewToken(claims, "cognito:username", "username", "sub"), nil
+}
+
+func (s *CognitoService) GetUser(id string) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"CognitoService.GetUser",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	out, err := s.client.AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(s.userPoolId),
+		Username:   aws.String(id),
+	})
+	if err != nil {
+		return nil, newErr(code(err), "error getting user", err)
+	}
+
+	return toUser(&cognitoidentityprovider.UserType{
+		Username:       out.Username,
+		Attributes:     out.UserAttributes,
+		Enabled:        out.Enabled,
+		UserCreateDate: out.UserCreateDate,
+	}), nil
+}
+
+// CreateUser - creates the user. Users created without a password are sent an invitation with a temporary password by Cognito
+func (s *CognitoService) CreateUser(user *identity.NewUser) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"CognitoService.CreateUser",
+		map[string]interface{}{
+			"email": user.Email,
+		},
+	)
+
+	attrs := map[string]string{}
+	for name, value := range user.Attributes {
+		attrs[name] = value
+	}
+	attrs["email"] = user.Email
+
+	input := &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId:     aws.String(s.userPoolId),
+		Username:       aws.String(user.Email),
+		UserAttributes: attributes(attrs),
+	}
+	if user.Password != "" {
+		input.MessageAction = aws.String(cognitoidentityprovider.MessageActionTypeSuppress)
+	}
+
+	out, err := s.client.AdminCreateUser(input)
+	if err != nil {
+		return nil, newErr(code(err), "error creating user", err)
+	}
+
+	if user.Password != "" {
+		_, err = s.client.AdminSetUserPassword(&cognitoidentityprovider.AdminSetUserPasswordInput{
+			UserPoolId: aws.String(s.userPoolId),
+			Username:   out.User.Username,
+			Password:   aws.String(user.Password),
+			Permanent:  aws.Bool(true),
+		})
+		if err != nil {
+			return nil, newErr(code(err), "error setting password of created user", err)
+		}
+	}
+
+	return toUser(out.User), nil
+}
+
+func (s *CognitoService) SetDisabled(id string, disabled bool) error {
+	newErr := errors.ErrorsWithScope(
+		"CognitoService.SetDisabled",
+		map[string]interface{}{
+			"id":       id,
+			"disabled": disabled,
+		},
+	)
+
+	var err error
+	if disabled {
+		_, err = s.client.AdminDisableUser(&cognitoidentityprovider.AdminDisableUserInput{
+			UserPoolId: aws.String(s.userPoolId),
+			Username:   aws.String(id),
+		})
+	} else {
+		_, err = s.client.AdminEnableUser(&cognitoidentityprovider.AdminEnableUserInput{
+			UserPoolId: aws.String(s.userPoolId),
+			Username:   aws.String(id),
+		})
+	}
+
+	if err != nil {
+		return newErr(code(err), "error setting user disabled", err)
+	}
+
+	return nil
+}
+
+func (s *CognitoService) SetAttributes(id string, attrs map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"CognitoService.SetAttributes",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	_, err := s.client.AdminUpdateUserAttributes(&cognitoidentityprovider.AdminUpdateUserAttributesInput{
+		UserPoolId:     aws.String(s.userPoolId),
+		Username:       aws.String(id),
+		UserAttributes: attributes(attrs),
+	})
+	if err != nil {
+		return newErr(code(err), "error setting user attributes", err)
+	}
+
+	return nil
+}
+
+// Authority - returns the OpenID Connect authority of the user pool, which is in the region prefixing its ID
+func Authority(userPoolId string) string {
+	region := strings.SplitN(userPoolId, "_", 2)[0]
+
+	return fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", region, userPoolId)
+}
+
+// New - creates a new Cognito identity plugin for the COGNITO_USER_POOL_ID user pool.
+// Tokens of the COGNITO_TOKEN_USE kind are accepted if they were issued to one of the comma separated COGNITO_CLIENT_IDS
+func New() (identity.IdentityService, error) {
+	userPoolId := utils.GetEnv("COGNITO_USER_POOL_ID", "")
+	if userPoolId == "" {
+		return nil, fmt.Errorf("COGNITO_USER_POOL_ID env var is required to manage users with Cognito")
+	}
+
+	// tokens issued to any app client of the pool would otherwise be accepted
+	ids := utils.GetEnv("COGNITO_CLIENT_IDS", "")
+	if ids == "" {
+		return nil, fmt.Errorf("COGNITO_CLIENT_IDS env var is required to verify tokens issued by Cognito")
+	}
+
+	tokenUse := utils.GetEnv("COGNITO_TOKEN_USE", "access")
+	if tokenUse != "access" && tokenUse != "id" {
+		return nil, fmt.Errorf("invalid COGNITO_TOKEN_USE env var, expected access or id, got %s", tokenUse)
+	}
+
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(
+		cognitoidentityprovider.New(sess),
+		userPoolId,
+		identity.NewVerifier(Authority(userPoolId), strings.Split(ids, ","), nil),
+		tokenUse,
+	), nil
+}
+
+// NewWithClient - creates a new Cognito identity plugin using the given client and token verifier, accepting tokens of the tokenUse kind
+func NewWithClient(client cognitoidentityprovideriface.CognitoIdentityProviderAPI, userPoolId string, verifier *identity.Verifier, tokenUse string) *CognitoService {
+	return &CognitoService{
+		client:     client,
+		userPoolId: userPoolId,
+		verifier:   verifier,
+		tokenUse:   tokenUse,
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cognito_service_test
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_cognitoidentityprovideriface "github.com/nitrictech/nitric/mocks/cognito"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	cognito_service "github.com/nitrictech/nitric/pkg/plugins/identity/cognito"
+)
+
+var _ = Describe("Cognito", func() {
+	var (
+		ctrl       *gomock.Controller
+		mockClient *mock_cognitoidentityprovideriface.MockCognitoIdentityProviderAPI
+		plugin     *cognito_service.CognitoService
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClient = mock_cognitoidentityprovideriface.NewMockCognitoIdentityProviderAPI(ctrl)
+		plugin = cognito_service.NewWithClient(mockClient, "us-east-1_pool", nil, "access")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("GetUser", func() {
+		When("the user exists", func() {
+			It("should return the user", func() {
+				created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+				mockClient.EXPECT().AdminGetUser(&cognitoidentityprovider.AdminGetUserInput{
+					UserPoolId: aws.String("us-east-1_pool"),
+					Username:   aws.String("jane"),
+				}).Return(&cognitoidentityprovider.AdminGetUserOutput{
+					Username: aws.String("jane"),
+					Enabled:  aws.Bool(false),
+					UserAttributes: []*cognitoidentityprovider.AttributeType{
+						{Name: aws.String("email"), Value: aws.String("jane@example.com")},
+						{Name: aws.String("custom:plan"), Value: aws.String("pro")},
+					},
+					UserCreateDate: aws.Time(created),
+				}, nil)
+
+				user, err := plugin.GetUser("jane")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user).To(Equal(&identity.User{
+					Id:         "jane",
+					Email:      "jane@example.com",
+					Disabled:   true,
+					Attributes: map[string]string{"custom:plan": "pro"},
+					CreatedAt:  created,
+				}))
+			})
+		})
+
+		When("the user doesn't exist", func() {
+			It("should return a NotFound error", func() {
+				mockClient.EXPECT().AdminGetUser(gomock.Any()).Return(nil, awserr.New(cognitoidentityprovider.ErrCodeUserNotFoundException, "not found", nil))
+
+				_, err := plugin.GetUser("jane")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("CreateUser", func() {
+		When("a password is given", func() {
+			It("should suppress the invitation and set the password permanently", func() {
+				mockClient.EXPECT().AdminCreateUser(gomock.Any()).DoAndReturn(func(in *cognitoidentityprovider.AdminCreateUserInput) (*cognitoidentityprovider.AdminCreateUserOutput, error) {
+					Expect(aws.StringValue(in.Username)).To(Equal("jane@example.com"))
+					Expect(aws.StringValue(in.MessageAction)).To(Equal(cognitoidentityprovider.MessageActionTypeSuppress))
+					Expect(in.UserAttributes).To(ConsistOf(
+						&cognitoidentityprovider.AttributeType{Name: aws.String("email"), Value: aws.String("jane@example.com")},
+						&cognitoidentityprovider.AttributeType{Name: aws.String("custom:plan"), Value: aws.String("pro")},
+					))
+
+					return &cognitoidentityprovider.AdminCreateUserOutput{
+						User: &cognitoidentityprovider.UserType{
+							Username:   aws.String("jane@example.com"),
+							Enabled:    aws.Bool(true),
+							Attributes: in.UserAttributes,
+						},
+					}, nil
+				})
+				mockClient.EXPECT().AdminSetUserPassword(&cognitoidentityprovider.AdminSetUserPasswordInput{
+					UserPoolId: aws.String("us-east-1_pool"),
+					Username:   aws.String("jane@example.com"),
+					Password:   aws.String("s3cret!!"),
+					Permanent:  aws.Bool(true),
+				}).Return(&cognitoidentityprovider.AdminSetUserPasswordOutput{}, nil)
+
+				user, err := plugin.CreateUser(&identity.NewUser{
+					Email:      "jane@example.com",
+					Password:   "s3cret!!",
+					Attributes: map[string]string{"custom:plan": "pro"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user.Id).To(Equal("jane@example.com"))
+				Expect(user.Email).To(Equal("jane@example.com"))
+				Expect(user.Attributes).To(Equal(map[string]string{"custom:plan": "pro"}))
+			})
+		})
+
+		When("no password is given", func() {
+			It("should let Cognito send an invitation", func() {
+				mockClient.EXPECT().AdminCreateUser(gomock.Any()).DoAndReturn(func(in *cognitoidentityprovider.AdminCreateUserInput) (*cognitoidentityprovider.AdminCreateUserOutput, error) {
+					Expect(in.MessageAction).To(BeNil())
+
+					return &cognitoidentityprovider.AdminCreateUserOutput{
+						User: &cognitoidentityprovider.UserType{Username: aws.String("jane@example.com")},
+					}, nil
+				})
+
+				_, err := plugin.CreateUser(&identity.NewUser{Email: "jane@example.com"})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		When("the user already exists", func() {
+			It("should return an AlreadyExists error", func() {
+				mockClient.EXPECT().AdminCreateUser(gomock.Any()).Return(nil, awserr.New(cognitoidentityprovider.ErrCodeUsernameExistsException, "exists", nil))
+
+				_, err := plugin.CreateUser(&identity.NewUser{Email: "jane@example.com"})
+				Expect(errors.Code(err)).To(Equal(codes.AlreadyExists))
+			})
+		})
+	})
+
+	Context("SetDisabled", func() {
+		It("should disable the user", func() {
+			mockClient.EXPECT().AdminDisableUser(&cognitoidentityprovider.AdminDisableUserInput{
+				UserPoolId: aws.String("us-east-1_pool"),
+				Username:   aws.String("jane"),
+			}).Return(&cognitoidentityprovider.AdminDisableUserOutput{}, nil)
+
+			Expect(plugin.SetDisabled("jane", true)).To(Succeed())
+		})
+
+		It("should enable the user", func() {
+			mockClient.EXPECT().AdminEnableUser(&cognitoidentityprovider.AdminEnableUserInput{
+				UserPoolId: aws.String("us-east-1_pool"),
+				Username:   aws.String("jane"),
+			}).Return(&cognitoidentityprovider.AdminEnableUserOutput{}, nil)
+
+			Expect(plugin.SetDisabled("jane", false)).To(Succeed())
+		})
+	})
+
+	Context("SetAttributes", func() {
+		It("should update the user's attributes", func() {
+			mockClient.EXPECT().AdminUpdateUserAttributes(&cognitoidentityprovider.AdminUpdateUserAttributesInput{
+				UserPoolId: aws.String("us-east-1_pool"),
+				Username:   aws.String("jane"),
+				UserAttributes: []*cognitoidentityprovider.AttributeType{
+					{Name: aws.String("custom:plan"), Value: aws.String("free")},
+				},
+			}).Return(&cognitoidentityprovider.AdminUpdateUserAttributesOutput{}, nil)
+
+			Expect(plugin.SetAttributes("jane", map[string]string{"custom:plan": "free"})).To(Succeed())
+		})
+	})
+
+	Context("Authority", func() {
+		It("should be in the user pool's region", func() {
+			Expect(cognito_service.Authority("eu-west-2_abc")).To(Equal("https://cognito-idp.eu-west-2.amazonaws.com/eu-west-2_abc"))
+		})
+	})
+})
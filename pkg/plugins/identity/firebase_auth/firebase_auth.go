@@ -0,0 +1,345 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firebase_auth_service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// DefaultBaseUrl - the Identity Toolkit API, which manages Firebase Auth users
+const DefaultBaseUrl = "https://identitytoolkit.googleapis.com"
+
+// FirebaseAuthService - identity plugin for Firebase Authentication (and Identity Platform).
+//
+// Users are identified by their uid. A user's attributes are their custom claims, so they're included in the ID tokens
+// issued to them and limited to Firebase's 1000 byte custom claims size.
+type FirebaseAuthService struct {
+	identity.UnimplementedIdentityPlugin
+	projectId string
+	baseUrl   string
+	client    *http.Client
+	verifier  *identity.Verifier
+}
+
+type account struct {
+	LocalId          string `json:"localId"`
+	Email            string `json:"email"`
+	Disabled         bool   `json:"disabled"`
+	CustomAttributes string `json:"customAttributes"`
+	// CreatedAt - milliseconds since the epoch
+	CreatedAt string `json:"createdAt"`
+}
+
+type apiError struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// do - sends a request to the Identity Toolkit API, returning the response body and status code
+func (s *FirebaseAuthService) do(method string, path string, body interface{}) ([]byte, int, error) {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	reqUrl := fmt.Sprintf("%s/v1/projects/%s/%s", s.baseUrl, url.PathEscape(s.projectId), path)
+	req, err := http.NewRequest(method, reqUrl, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// errorCode - returns the plugin's error code for an Identity Toolkit error response.
+// Errors are named by the start of their message, e.g. "WEAK_PASSWORD : Password should be at least 6 characters"
+func errorCode(status int, body []byte) codes.Code {
+	apiErr := &apiError{}
+	_ = json.Unmarshal(body, apiErr)
+
+	name := strings.TrimSpace(strings.SplitN(apiErr.Error.Message, ":", 2)[0])
+	switch {
+	case name == "USER_NOT_FOUND":
+		return codes.NotFound
+	case name == "EMAIL_EXISTS" || name == "DUPLICATE_LOCAL_ID":
+		return codes.AlreadyExists
+	case strings.HasPrefix(name, "INVALID_") || name == "WEAK_PASSWORD" || name == "MISSING_EMAIL":
+		return codes.InvalidArgument
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// call - sends a request to the Identity Toolkit API, decoding its response into out if it's given
+func (s *FirebaseAuthService) call(newErr errors.ErrorFactory, msg string, path string, body interface{}, out interface{}) error {
+	respBody, status, err := s.do(http.MethodPost, path, body)
+	if err != nil {
+		return newErr(codes.Unavailable, msg, err)
+	}
+
+	if status >= 300 {
+		return newErr(errorCode(status, respBody), msg, fmt.Errorf("unexpected response status %d: %s", status, respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return newErr(codes.Internal, msg, err)
+		}
+	}
+
+	return nil
+}
+
+// toUser - returns the plugin's user for a Firebase account, custom claims that aren't strings are returned as JSON
+func toUser(acc *account) *identity.User {
+	u := &identity.User{
+		Id:         acc.LocalId,
+		Email:      acc.Email,
+		Disabled:   acc.Disabled,
+		Attributes: customAttributes(acc),
+	}
+
+	if ms, err := strconv.ParseInt(acc.CreatedAt, 10, 64); err == nil {
+		u.CreatedAt = time.Unix(0, ms*int64(time.Millisecond)).UTC()
+	}
+
+	return u
+}
+
+func customAttributes(acc *account) map[string]string {
+	attrs := map[string]string{}
+
+	claims := map[string]interface{}{}
+	if acc.CustomAttributes == "" || json.Unmarshal([]byte(acc.CustomAttributes), &claims) != nil {
+		return attrs
+	}
+
+	for name, value := range claims {
+		if s, ok := value.(string); ok {
+			attrs[name] = s
+		} else {
+			b, _ := json.Marshal(value)
+			attrs[name] = string(b)
+		}
+	}
+
+	return attrs
+}
+
+// lookup - returns the account of the user
+func (s *FirebaseAuthService) lookup(newErr errors.ErrorFactory, id string) (*account, error) {
+	out := &struct {
+		Users []*account `json:"users"`
+	}{}
+	if err := s.call(newErr, "error getting user", "accounts:lookup", map[string]interface{}{
+		"localId": []string{id},
+	}, out); err != nil {
+		return nil, err
+	}
+
+	if len(out.Users) == 0 {
+		return nil, newErr(codes.NotFound, "user not found", nil)
+	}
+
+	return out.Users[0], nil
+}
+
+// setCustomAttributes - replaces the custom claims of the user
+func (s *FirebaseAuthService) setCustomAttributes(newErr errors.ErrorFactory, id string, attrs map[string]string) error {
+	claims, err := json.Marshal(attrs)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "invalid attributes", err)
+	}
+
+	return s.call(newErr, "error setting user attributes", "accounts:update", map[string]interface{}{
+		"localId":          id,
+		"customAttributes": string(claims),
+	}, nil)
+}
+
+func (s *FirebaseAuthService) VerifyToken(token string) (*identity.Token, error) {
+	claims, err := s.verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity.NewToken(claims, "user_id", "sub"), nil
+}
+
+func (s *FirebaseAuthService) GetUser(id string) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"FirebaseAuthService.GetUser",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	acc, err := s.lookup(newErr, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(acc), nil
+}
+
+func (s *FirebaseAuthService) CreateUser(user *identity.NewUser) (*identity.User, error) {
+	newErr := errors.ErrorsWithScope(
+		"FirebaseAuthService.CreateUser",
+		map[string]interface{}{
+			"email": user.Email,
+		},
+	)
+
+	body := map[string]interface{}{
+		"email": user.Email,
+	}
+	if user.Password != "" {
+		body["password"] = user.Password
+	}
+
+	created := &account{}
+	if err := s.call(newErr, "error creating user", "accounts", body, created); err != nil {
+		return nil, err
+	}
+
+	if len(user.Attributes) > 0 {
+		if err := s.setCustomAttributes(newErr, created.LocalId, user.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetUser(created.LocalId)
+}
+
+func (s *FirebaseAuthService) SetDisabled(id string, disabled bool) error {
+	newErr := errors.ErrorsWithScope(
+		"FirebaseAuthService.SetDisabled",
+		map[string]interface{}{
+			"id":       id,
+			"disabled": disabled,
+		},
+	)
+
+	return s.call(newErr, "error setting user disabled", "accounts:update", map[string]interface{}{
+		"localId":     id,
+		"disableUser": disabled,
+	}, nil)
+}
+
+// SetAttributes - sets custom claims of the user. Firebase replaces all of a user's claims at once,
+// so the user's claims are read and merged with the attributes first
+func (s *FirebaseAuthService) SetAttributes(id string, attrs map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"FirebaseAuthService.SetAttributes",
+		map[string]interface{}{
+			"id": id,
+		},
+	)
+
+	acc, err := s.lookup(newErr, id)
+	if err != nil {
+		return err
+	}
+
+	merged := customAttributes(acc)
+	for name, value := range attrs {
+		merged[name] = value
+	}
+
+	return s.setCustomAttributes(newErr, id, merged)
+}
+
+// Authority - returns the OpenID Connect authority issuing ID tokens to the users of the project
+func Authority(projectId string) string {
+	return "https://securetoken.google.com/" + projectId
+}
+
+// New - creates a new Firebase Auth identity plugin for the FIREBASE_PROJECT_ID project, or the project of the provider's credentials
+func New(provider core.GcpProvider) (identity.IdentityService, error) {
+	credentials, err := provider.Credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	projectId := utils.GetEnv("FIREBASE_PROJECT_ID", credentials.ProjectID)
+	if projectId == "" {
+		return nil, fmt.Errorf("FIREBASE_PROJECT_ID env var is required when the credentials don't belong to a project")
+	}
+
+	// ID tokens are issued to the project itself
+	verifier := identity.NewVerifier(Authority(projectId), []string{projectId}, nil)
+
+	return NewWithClient(projectId, DefaultBaseUrl, oauth2.NewClient(context.Background(), credentials.TokenSource), verifier), nil
+}
+
+// NewWithClient - creates a new Firebase Auth identity plugin sending requests to baseUrl with the given client,
+// which must authenticate them, and verifying tokens with the given verifier
+func NewWithClient(projectId string, baseUrl string, client *http.Client, verifier *identity.Verifier) *FirebaseAuthService {
+	return &FirebaseAuthService{
+		projectId: projectId,
+		baseUrl:   strings.TrimSuffix(baseUrl, "/"),
+		client:    client,
+		verifier:  verifier,
+	}
+}
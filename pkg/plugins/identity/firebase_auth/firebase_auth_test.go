@@ -0,0 +1,201 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firebase_auth_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+	firebase_auth_service "github.com/nitrictech/nitric/pkg/plugins/identity/firebase_auth"
+)
+
+type response struct {
+	status int
+	body   string
+}
+
+type recordedRequest struct {
+	path string
+	body map[string]interface{}
+}
+
+// newIdentityToolkit - starts a fake Identity Toolkit API responding to requests by their path, recording the requests it receives
+func newIdentityToolkit(responses map[string]response) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{path: r.URL.Path}
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			resp = response{http.StatusNotFound, `{}`}
+		}
+
+		w.WriteHeader(resp.status)
+		_, _ = w.Write([]byte(resp.body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("Firebase Auth", func() {
+	Context("GetUser", func() {
+		When("the user exists", func() {
+			It("should return the user with their custom claims as attributes", func() {
+				server, requests := newIdentityToolkit(map[string]response{
+					"/v1/projects/shop/accounts:lookup": {http.StatusOK, `{"users": [{
+						"localId": "uid-1",
+						"email": "jane@example.com",
+						"disabled": true,
+						"customAttributes": "{\"plan\": \"pro\", \"seats\": 5}",
+						"createdAt": "1640995200000"
+					}]}`},
+				})
+				defer server.Close()
+
+				s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+				user, err := s.GetUser("uid-1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user).To(Equal(&identity.User{
+					Id:         "uid-1",
+					Email:      "jane@example.com",
+					Disabled:   true,
+					Attributes: map[string]string{"plan": "pro", "seats": "5"},
+					CreatedAt:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				}))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"localId": []interface{}{"uid-1"},
+				}))
+			})
+		})
+
+		When("the user doesn't exist", func() {
+			It("should return a NotFound error", func() {
+				server, _ := newIdentityToolkit(map[string]response{
+					"/v1/projects/shop/accounts:lookup": {http.StatusOK, `{"kind": "identitytoolkit#GetAccountInfoResponse"}`},
+				})
+				defer server.Close()
+
+				s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+				_, err := s.GetUser("uid-1")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Context("CreateUser", func() {
+		When("the user is created", func() {
+			It("should set their attributes as custom claims", func() {
+				server, requests := newIdentityToolkit(map[string]response{
+					"/v1/projects/shop/accounts":        {http.StatusOK, `{"localId": "uid-1", "email": "jane@example.com"}`},
+					"/v1/projects/shop/accounts:update": {http.StatusOK, `{"localId": "uid-1"}`},
+					"/v1/projects/shop/accounts:lookup": {http.StatusOK, `{"users": [{"localId": "uid-1", "email": "jane@example.com", "customAttributes": "{\"plan\": \"pro\"}"}]}`},
+				})
+				defer server.Close()
+
+				s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+				user, err := s.CreateUser(&identity.NewUser{
+					Email:      "jane@example.com",
+					Password:   "s3cret!!",
+					Attributes: map[string]string{"plan": "pro"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(user.Id).To(Equal("uid-1"))
+				Expect(user.Attributes).To(Equal(map[string]string{"plan": "pro"}))
+
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"email":    "jane@example.com",
+					"password": "s3cret!!",
+				}))
+				Expect((*requests)[1].body).To(Equal(map[string]interface{}{
+					"localId":          "uid-1",
+					"customAttributes": `{"plan":"pro"}`,
+				}))
+			})
+		})
+
+		When("the email is taken", func() {
+			It("should return an AlreadyExists error", func() {
+				server, _ := newIdentityToolkit(map[string]response{
+					"/v1/projects/shop/accounts": {http.StatusBadRequest, `{"error": {"code": 400, "message": "EMAIL_EXISTS"}}`},
+				})
+				defer server.Close()
+
+				s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+				_, err := s.CreateUser(&identity.NewUser{Email: "jane@example.com"})
+				Expect(errors.Code(err)).To(Equal(codes.AlreadyExists))
+			})
+		})
+
+		When("the password is too weak", func() {
+			It("should return an InvalidArgument error", func() {
+				server, _ := newIdentityToolkit(map[string]response{
+					"/v1/projects/shop/accounts": {http.StatusBadRequest, `{"error": {"code": 400, "message": "WEAK_PASSWORD : Password should be at least 6 characters"}}`},
+				})
+				defer server.Close()
+
+				s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+				_, err := s.CreateUser(&identity.NewUser{Email: "jane@example.com", Password: "a"})
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Context("SetDisabled", func() {
+		It("should update the user", func() {
+			server, requests := newIdentityToolkit(map[string]response{
+				"/v1/projects/shop/accounts:update": {http.StatusOK, `{"localId": "uid-1"}`},
+			})
+			defer server.Close()
+
+			s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+			Expect(s.SetDisabled("uid-1", true)).To(Succeed())
+			Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+				"localId":     "uid-1",
+				"disableUser": true,
+			}))
+		})
+	})
+
+	Context("SetAttributes", func() {
+		It("should merge the attributes with the user's custom claims", func() {
+			server, requests := newIdentityToolkit(map[string]response{
+				"/v1/projects/shop/accounts:lookup": {http.StatusOK, `{"users": [{"localId": "uid-1", "customAttributes": "{\"plan\": \"pro\", \"team\": \"a\"}"}]}`},
+				"/v1/projects/shop/accounts:update": {http.StatusOK, `{"localId": "uid-1"}`},
+			})
+			defer server.Close()
+
+			s := firebase_auth_service.NewWithClient("shop", server.URL, server.Client(), nil)
+			Expect(s.SetAttributes("uid-1", map[string]string{"plan": "free"})).To(Succeed())
+			Expect((*requests)[1].body).To(Equal(map[string]interface{}{
+				"localId":          "uid-1",
+				"customAttributes": `{"plan":"free","team":"a"}`,
+			}))
+		})
+	})
+})
@@ -0,0 +1,89 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"fmt"
+	"time"
+)
+
+// User - a user of the application, as held by the identity provider
+type User struct {
+	// Id - the provider's ID of the user, e.g. a Cognito username or Firebase uid
+	Id    string
+	Email string
+	// Disabled - disabled users can't sign in
+	Disabled bool
+	// Attributes - the user's attributes, named by the provider
+	Attributes map[string]string
+	CreatedAt  time.Time
+}
+
+// NewUser - a user to create
+type NewUser struct {
+	Email string
+	// Password - the user's initial password, providers that require one return an InvalidArgument error without it
+	Password   string
+	Attributes map[string]string
+}
+
+// Token - a verified token
+type Token struct {
+	// UserId - the ID of the user the token was issued to, which can be used to get the user
+	UserId string
+	// Claims - the token's claims
+	Claims    map[string]interface{}
+	ExpiresAt time.Time
+}
+
+type IdentityService interface {
+	// VerifyToken - verifies the token was issued by the provider to one of the application's clients and hasn't expired,
+	// returning an Unauthenticated error if it's invalid
+	VerifyToken(token string) (*Token, error)
+	// GetUser - returns the user, or a NotFound error if they don't exist
+	GetUser(id string) (*User, error)
+	// CreateUser - creates the user, returning an AlreadyExists error if their email is taken
+	CreateUser(user *NewUser) (*User, error)
+	// SetDisabled - disables or re-enables the user
+	SetDisabled(id string, disabled bool) error
+	// SetAttributes - sets the given attributes of the user, leaving their other attributes unchanged
+	SetAttributes(id string, attributes map[string]string) error
+}
+
+type UnimplementedIdentityPlugin struct {
+	IdentityService
+}
+
+var _ IdentityService = (*UnimplementedIdentityPlugin)(nil)
+
+func (*UnimplementedIdentityPlugin) VerifyToken(token string) (*Token, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedIdentityPlugin) GetUser(id string) (*User, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedIdentityPlugin) CreateUser(user *NewUser) (*User, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedIdentityPlugin) SetDisabled(id string, disabled bool) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedIdentityPlugin) SetAttributes(id string, attributes map[string]string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
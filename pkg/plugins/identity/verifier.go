@@ -0,0 +1,315 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+// Leeway - the clock skew allowed when checking when tokens expire and become valid
+const Leeway = time.Minute
+
+// refetchInterval - the minimum time between fetches of the signing keys, so tokens with unknown key IDs can't be used
+// to flood the provider with requests
+const refetchInterval = time.Minute
+
+// Verifier - verifies RS256 signed JSON Web Tokens issued by an OpenID Connect provider.
+//
+// The issuer and signing keys are discovered from the provider's OpenID configuration. Keys are cached,
+// and fetched again when a token is signed by a key that isn't cached, so rotated keys are picked up.
+type Verifier struct {
+	authority string
+	audiences []string
+	client    *http.Client
+	now       func() time.Time
+
+	lock    sync.Mutex
+	issuer  string
+	jwksUri string
+	keys    map[string]*rsa.PublicKey
+	// fetched - when the keys were last fetched, whether or not the fetch succeeded
+	fetched time.Time
+	// fetchErr - the error the keys were last fetched with
+	fetchErr error
+}
+
+type openIdConfiguration struct {
+	Issuer  string `json:"issuer"`
+	JwksUri string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// getJson - gets a JSON document from the provider
+func (v *Verifier) getJson(url string, out interface{}) error {
+	resp, err := v.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// fetchKeys - discovers the provider's configuration, if it hasn't been, and fetches its signing keys
+func (v *Verifier) fetchKeys() error {
+	if v.jwksUri == "" {
+		conf := &openIdConfiguration{}
+		if err := v.getJson(strings.TrimSuffix(v.authority, "/")+"/.well-known/openid-configuration", conf); err != nil {
+			return err
+		}
+
+		if conf.Issuer == "" || conf.JwksUri == "" {
+			return fmt.Errorf("OpenID configuration of %s has no issuer or jwks_uri", v.authority)
+		}
+
+		v.issuer = conf.Issuer
+		v.jwksUri = conf.JwksUri
+	}
+
+	jwks := &struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := v.getJson(v.jwksUri, jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	v.keys = keys
+
+	return nil
+}
+
+// key - returns the signing key with the given ID and the provider's issuer, fetching the keys if the key isn't cached.
+// Failed fetches are throttled like successful ones, returning the error they failed with until they're retried
+func (v *Verifier) key(kid string) (*rsa.PublicKey, string, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if k, ok := v.keys[kid]; ok {
+		return k, v.issuer, nil
+	}
+
+	if !v.fetched.IsZero() && v.now().Sub(v.fetched) < refetchInterval {
+		return nil, v.issuer, v.fetchErr
+	}
+
+	v.fetchErr = v.fetchKeys()
+	v.fetched = v.now()
+	if v.fetchErr != nil {
+		return nil, v.issuer, v.fetchErr
+	}
+
+	return v.keys[kid], v.issuer, nil
+}
+
+// hasAudience - returns true if the token was issued to one of the verifier's audiences
+func (v *Verifier) hasAudience(claims map[string]interface{}) bool {
+	if len(v.audiences) == 0 {
+		return true
+	}
+
+	var auds []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		auds = append(auds, aud)
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				auds = append(auds, s)
+			}
+		}
+	}
+
+	// access tokens, e.g. Cognito's, name the client they were issued to instead of an audience
+	if clientId, ok := claims["client_id"].(string); ok {
+		auds = append(auds, clientId)
+	}
+
+	for _, aud := range auds {
+		for _, expected := range v.audiences {
+			if aud == expected {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Verify - verifies the token's signature, issuer, audience and validity period, returning its claims.
+// Invalid tokens return an Unauthenticated error
+func (v *Verifier) Verify(token string) (map[string]interface{}, error) {
+	newErr := errors.ErrorsWithScope("Verifier.Verify", nil)
+
+	invalid := func(msg string) error {
+		return newErr(codes.Unauthenticated, "invalid token: "+msg, nil)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, invalid("malformed")
+	}
+
+	headerJson, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, invalid("malformed header")
+	}
+
+	header := &tokenHeader{}
+	if err := json.Unmarshal(headerJson, header); err != nil {
+		return nil, invalid("malformed header")
+	}
+
+	if header.Alg != "RS256" {
+		return nil, invalid(fmt.Sprintf("unsupported algorithm %s", header.Alg))
+	}
+
+	key, issuer, err := v.key(header.Kid)
+	if err != nil {
+		return nil, newErr(codes.Unavailable, "error fetching signing keys", err)
+	}
+
+	if key == nil {
+		return nil, invalid(fmt.Sprintf("unknown key %s", header.Kid))
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, invalid("malformed signature")
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, invalid("bad signature")
+	}
+
+	claimsJson, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, invalid("malformed claims")
+	}
+
+	claims := make(map[string]interface{})
+	if err := json.Unmarshal(claimsJson, &claims); err != nil {
+		return nil, invalid("malformed claims")
+	}
+
+	now := v.now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.Add(-Leeway).After(time.Unix(int64(exp), 0)) {
+		return nil, invalid("expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(Leeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, invalid("not yet valid")
+	}
+
+	if iss, _ := claims["iss"].(string); iss != issuer {
+		return nil, invalid(fmt.Sprintf("unexpected issuer %s", iss))
+	}
+
+	if !v.hasAudience(claims) {
+		return nil, invalid("unexpected audience")
+	}
+
+	return claims, nil
+}
+
+// NewToken - returns a token for verified claims, issued to the user named by the first of the given claims it has
+func NewToken(claims map[string]interface{}, userClaims ...string) *Token {
+	t := &Token{
+		Claims: claims,
+	}
+
+	for _, c := range userClaims {
+		if id, ok := claims[c].(string); ok && id != "" {
+			t.UserId = id
+			break
+		}
+	}
+
+	if exp, ok := claims["exp"].(float64); ok {
+		t.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+
+	return t
+}
+
+// NewVerifier - creates a verifier for tokens issued by the OpenID Connect provider at the authority,
+// e.g. https://cognito-idp.us-east-1.amazonaws.com/us-east-1_example, to any of the audiences.
+// Tokens issued to any audience are accepted if none are given
+func NewVerifier(authority string, audiences []string, client *http.Client) *Verifier {
+	if client == nil {
+		client = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+
+	return &Verifier{
+		authority: authority,
+		audiences: audiences,
+		client:    client,
+		now:       time.Now,
+	}
+}
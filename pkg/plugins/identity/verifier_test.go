@@ -0,0 +1,198 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/identity"
+)
+
+func encode(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func sign(key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	unsigned := encode(map[string]string{"alg": "RS256", "kid": kid}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+var _ = Describe("Verifier", func() {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	var server *httptest.Server
+	var jwksRequests int
+	var jwksStatus int
+	var verifier *identity.Verifier
+
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss": server.URL,
+			"aud": "client",
+			"sub": "user-1",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+
+		return c
+	}
+
+	BeforeEach(func() {
+		jwksRequests = 0
+		jwksStatus = http.StatusOK
+		mux := http.NewServeMux()
+		server = httptest.NewServer(mux)
+
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":   server.URL,
+				"jwks_uri": server.URL + "/keys",
+			})
+		})
+		mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+			jwksRequests++
+			if jwksStatus != http.StatusOK {
+				w.WriteHeader(jwksStatus)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kid": "key-1",
+					"kty": "RSA",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				}},
+			})
+		})
+
+		verifier = identity.NewVerifier(server.URL, []string{"client"}, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	When("the token is valid", func() {
+		It("should return its claims", func() {
+			c, err := verifier.Verify(sign(key, "key-1", claims(nil)))
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c["sub"]).To(Equal("user-1"))
+		})
+
+		It("should cache the signing keys", func() {
+			_, _ = verifier.Verify(sign(key, "key-1", claims(nil)))
+			_, _ = verifier.Verify(sign(key, "key-1", claims(nil)))
+
+			Expect(jwksRequests).To(Equal(1))
+		})
+	})
+
+	When("the token was issued to a client in its client_id claim", func() {
+		It("should accept it", func() {
+			_, err := verifier.Verify(sign(key, "key-1", claims(map[string]interface{}{
+				"aud":       nil,
+				"client_id": "client",
+			})))
+
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	When("the token is invalid", func() {
+		other, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+		It("should return an Unauthenticated error if it's malformed", func() {
+			_, err := verifier.Verify("not-a-token")
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if it's expired", func() {
+			_, err := verifier.Verify(sign(key, "key-1", claims(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if it isn't valid yet", func() {
+			_, err := verifier.Verify(sign(key, "key-1", claims(map[string]interface{}{"nbf": time.Now().Add(time.Hour).Unix()})))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if it was issued by another issuer", func() {
+			_, err := verifier.Verify(sign(key, "key-1", claims(map[string]interface{}{"iss": "https://example.com"})))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if it was issued to another audience", func() {
+			_, err := verifier.Verify(sign(key, "key-1", claims(map[string]interface{}{"aud": []string{"other"}})))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if it was signed by an unknown key", func() {
+			_, err := verifier.Verify(sign(key, "key-2", claims(nil)))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should return an Unauthenticated error if its signature is bad", func() {
+			_, err := verifier.Verify(sign(other, "key-1", claims(nil)))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	When("the provider is unavailable", func() {
+		It("should return an Unavailable error", func() {
+			server.Close()
+			_, err := verifier.Verify(sign(key, "key-1", claims(nil)))
+
+			Expect(errors.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		It("should not fetch the keys again until the refetch interval has passed", func() {
+			jwksStatus = http.StatusInternalServerError
+			_, err := verifier.Verify(sign(key, "key-1", claims(nil)))
+			Expect(errors.Code(err)).To(Equal(codes.Unavailable))
+
+			_, err = verifier.Verify(sign(key, "key-1", claims(nil)))
+			Expect(errors.Code(err)).To(Equal(codes.Unavailable))
+			Expect(jwksRequests).To(Equal(1))
+		})
+	})
+})
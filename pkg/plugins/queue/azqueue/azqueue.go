@@ -20,17 +20,21 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-storage-queue-go/azqueue"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
 	azqueueserviceiface "github.com/nitrictech/nitric/pkg/plugins/queue/azqueue/iface"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
 	azureutils "github.com/nitrictech/nitric/pkg/providers/azure/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
 	"github.com/nitrictech/nitric/pkg/utils"
 )
 
@@ -39,20 +43,40 @@ const defaultVisibilityTimeout = 30 * time.Second
 
 type AzqueueQueueService struct {
 	client azqueueserviceiface.AzqueueServiceUrlIface
+	// account - the name of the storage account, from its queue endpoint
+	account string
+	// permissions - lists the effective permissions on queues, created from the environment when it's nil
+	permissions core.PermissionLister
+}
+
+// Returns an adapted azqueue QueueUrl for the queue's physical name, which is a client for interacting with a specific queue
+func (s *AzqueueQueueService) getQueueUrl(queue string) (azqueueserviceiface.AzqueueQueueUrlIface, error) {
+	name, err := naming.StorageQueues.Physical(queue)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewQueueURL(name), nil
 }
 
 // Returns an adapted azqueue MessagesUrl, which is a client for interacting with messages in a specific queue
-func (s *AzqueueQueueService) getMessagesUrl(queue string) azqueueserviceiface.AzqueueMessageUrlIface {
-	qUrl := s.client.NewQueueURL(queue)
+func (s *AzqueueQueueService) getMessagesUrl(queue string) (azqueueserviceiface.AzqueueMessageUrlIface, error) {
+	qUrl, err := s.getQueueUrl(queue)
+	if err != nil {
+		return nil, err
+	}
 	// Get a new messages URL (used to interact with messages in the queue)
-	return qUrl.NewMessageURL()
+	return qUrl.NewMessageURL(), nil
 }
 
 // Returns an adapted azqueue MessageIdUrl, which is a client for interacting with a specific message (task) in a specific queue
-func (s *AzqueueQueueService) getMessageIdUrl(queue string, messageId azqueue.MessageID) azqueueserviceiface.AzqueueMessageIdUrlIface {
-	mUrl := s.getMessagesUrl(queue)
+func (s *AzqueueQueueService) getMessageIdUrl(queue string, messageId azqueue.MessageID) (azqueueserviceiface.AzqueueMessageIdUrlIface, error) {
+	mUrl, err := s.getMessagesUrl(queue)
+	if err != nil {
+		return nil, err
+	}
 
-	return mUrl.NewMessageIDURL(messageId)
+	return mUrl.NewMessageIDURL(messageId), nil
 }
 
 func (s *AzqueueQueueService) Send(queue string, task queue.NitricTask) error {
@@ -64,7 +88,14 @@ func (s *AzqueueQueueService) Send(queue string, task queue.NitricTask) error {
 		},
 	)
 
-	messages := s.getMessagesUrl(queue)
+	messages, err := s.getMessagesUrl(queue)
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"queue name collides with another queue",
+			err,
+		)
+	}
 
 	// Send the tasks to the queue
 	if taskBytes, err := json.Marshal(task); err == nil {
@@ -150,7 +181,14 @@ func (s *AzqueueQueueService) Receive(options queue.ReceiveOptions) ([]queue.Nit
 		)
 	}
 
-	messages := s.getMessagesUrl(options.QueueName)
+	messages, err := s.getMessagesUrl(options.QueueName)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"queue name collides with another queue",
+			err,
+		)
+	}
 
 	ctx := context.TODO()
 	dequeueResp, err := messages.Dequeue(ctx, int32(*options.Depth), defaultVisibilityTimeout)
@@ -177,6 +215,12 @@ func (s *AzqueueQueueService) Receive(options queue.ReceiveOptions) ([]queue.Nit
 			continue
 		}
 
+		// Storage Queues can't filter messages as they're received, so the attributes in their bodies are checked instead
+		if !nitricTask.HasAttributes(options.Attributes) {
+			s.discard(options.QueueName, m)
+			continue
+		}
+
 		lease := AzureQueueItemLease{
 			ID:         m.ID.String(),
 			PopReceipt: m.PopReceipt.String(),
@@ -192,16 +236,34 @@ func (s *AzqueueQueueService) Receive(options queue.ReceiveOptions) ([]queue.Nit
 		}
 
 		tasks = append(tasks, queue.NitricTask{
-			ID:          nitricTask.ID,
-			Payload:     nitricTask.Payload,
-			PayloadType: nitricTask.PayloadType,
-			LeaseID:     leaseID,
+			ID:           nitricTask.ID,
+			Payload:      nitricTask.Payload,
+			PayloadType:  nitricTask.PayloadType,
+			Attributes:   nitricTask.Attributes,
+			LeaseID:      leaseID,
+			ProviderID:   m.ID.String(),
+			ReceiveCount: int(m.DequeueCount),
 		})
 	}
 
 	return tasks, nil
 }
 
+// discard - deletes a dequeued message that was filtered out.
+// Releasing it would increase its dequeue count, so it'd be moved to the poison queue without ever being handled
+func (s *AzqueueQueueService) discard(queueName string, m *azqueue.DequeuedMessage) {
+	task, err := s.getMessageIdUrl(queueName, m.ID)
+	if err != nil {
+		log.Default().Printf("error discarding filtered message %s: %v", m.ID, err)
+		return
+	}
+
+	// Undeleted messages are redelivered once their visibility timeout expires
+	if _, err := task.Delete(context.TODO(), m.PopReceipt); err != nil {
+		log.Default().Printf("error discarding filtered message %s: %v", m.ID, err)
+	}
+}
+
 // Complete - Completes a previously popped queue item
 func (s *AzqueueQueueService) Complete(queue string, leaseId string) error {
 	newErr := errors.ErrorsWithScope(
@@ -222,7 +284,14 @@ func (s *AzqueueQueueService) Complete(queue string, leaseId string) error {
 	}
 
 	// Client for the specific message referenced by the lease
-	task := s.getMessageIdUrl(queue, azqueue.MessageID(lease.ID))
+	task, err := s.getMessageIdUrl(queue, azqueue.MessageID(lease.ID))
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"queue name collides with another queue",
+			err,
+		)
+	}
 	ctx := context.TODO()
 	_, err = task.Delete(ctx, azqueue.PopReceipt(lease.PopReceipt))
 	if err != nil {
@@ -236,6 +305,81 @@ func (s *AzqueueQueueService) Complete(queue string, leaseId string) error {
 	return nil
 }
 
+// CompleteBatch - Completes previously popped queue items, one at a time as Storage Queues has no batch delete
+func (s *AzqueueQueueService) CompleteBatch(q string, leaseIds []string) (*queue.CompleteBatchResponse, error) {
+	return queue.CompleteEach(leaseIds, func(leaseId string) error {
+		return s.Complete(q, leaseId)
+	}), nil
+}
+
+// Release - Unsupported, updating a message's visibility timeout requires its content which leases don't carry.
+// Tasks that aren't completed are redelivered once their visibility timeout expires
+func (s *AzqueueQueueService) Release(queue string, leaseId string, delay time.Duration) error {
+	newErr := errors.ErrorsWithScope(
+		"AzqueueQueueService.Release",
+		map[string]interface{}{
+			"queue":   queue,
+			"leaseId": leaseId,
+		},
+	)
+
+	return newErr(
+		codes.Unimplemented,
+		"releasing tasks is not supported by storage queues",
+		nil,
+	)
+}
+
+// GetQueueStats - Returns the approximate number of messages in a queue, and the age of the oldest.
+// Storage Queues counts messages that have been received but not completed as waiting, so none are reported as in flight
+func (s *AzqueueQueueService) GetQueueStats(q string) (*queue.QueueStats, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzqueueQueueService.GetQueueStats",
+		map[string]interface{}{
+			"queue": q,
+		},
+	)
+
+	ctx := context.TODO()
+	qUrl, err := s.getQueueUrl(q)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"queue name collides with another queue",
+			err,
+		)
+	}
+
+	props, err := qUrl.GetProperties(ctx)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to get queue properties",
+			err,
+		)
+	}
+
+	stats := &queue.QueueStats{
+		Depth: int64(props.ApproximateMessagesCount()),
+	}
+
+	// Peeking returns the message at the front of the queue without receiving it
+	peeked, err := qUrl.NewMessageURL().Peek(ctx, 1)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to peek the oldest message",
+			err,
+		)
+	}
+
+	if peeked.NumMessages() > 0 {
+		stats.OldestTaskAge = time.Since(peeked.Message(0).InsertionTime)
+	}
+
+	return stats, nil
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azqueue.TokenRefresher {
@@ -254,6 +398,47 @@ func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azqueue.TokenRefresh
 	}
 }
 
+// queueDataActions - the data actions needed on a queue for nitric actions
+var queueDataActions = map[resources.Action][]string{
+	resources.QueueSend: {"Microsoft.Storage/storageAccounts/queueServices/queues/messages/add/action"},
+	resources.QueueReceive: {
+		"Microsoft.Storage/storageAccounts/queueServices/queues/messages/process/action",
+		"Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete",
+	},
+}
+
+// CheckPermissions - checks the data actions needed for the nitric actions against the effective permissions on the queue
+func (s *AzqueueQueueService) CheckPermissions(q string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.account == "" {
+		return nil, fmt.Errorf("the storage account of queue %s is unknown", q)
+	}
+
+	lister := s.permissions
+	if lister == nil {
+		var err error
+		if lister, err = core.NewPermissionLister(); err != nil {
+			return nil, err
+		}
+	}
+
+	name, err := naming.StorageQueues.Physical(q)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, queueDataActions[a]...)
+	}
+
+	return core.CheckDataActions(lister, core.AzResourceId{
+		Namespace:  "Microsoft.Storage",
+		ParentPath: fmt.Sprintf("storageAccounts/%s/queueServices/default", s.account),
+		Type:       "queues",
+		Name:       name,
+	}, needed)
+}
+
 // New - Constructs a new Azure Storage Queues client with defaults
 func New() (queue.QueueService, error) {
 	queueUrl := utils.GetEnv(azureutils.AZURE_STORAGE_QUEUE_ENDPOINT, "")
@@ -277,7 +462,8 @@ func New() (queue.QueueService, error) {
 	client := azqueue.NewServiceURL(*accountURL, pipeline)
 
 	return &AzqueueQueueService{
-		client: azqueueserviceiface.AdaptServiceUrl(client),
+		client:  azqueueserviceiface.AdaptServiceUrl(client),
+		account: strings.Split(accountURL.Hostname(), ".")[0],
 	}, nil
 }
 
@@ -364,4 +364,43 @@ var _ = Describe("Azqueue", func() {
 			})
 		})
 	})
+
+	Context("GetQueueStats", func() {
+		When("the queue has messages", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockProps := mock_azqueue.NewMockQueueGetPropertiesResponseIface(crtl)
+			mockPeeked := mock_azqueue.NewMockPeekedMessagesResponseIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should return the message count and the age of the oldest message", func() {
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Reading the approximate message count from the queue properties")
+				mockQueue.EXPECT().GetProperties(gomock.Any()).Times(1).Return(mockProps, nil)
+				mockProps.EXPECT().ApproximateMessagesCount().Return(int32(7))
+
+				By("Peeking the oldest message")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+				mockMessages.EXPECT().Peek(gomock.Any(), int32(1)).Times(1).Return(mockPeeked, nil)
+				mockPeeked.EXPECT().NumMessages().Return(int32(1))
+				mockPeeked.EXPECT().Message(int32(0)).Return(&azqueue2.PeekedMessage{
+					InsertionTime: time.Now().Add(-time.Minute),
+				})
+
+				stats, err := queuePlugin.GetQueueStats("test-queue")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stats.Depth).To(Equal(int64(7)))
+				Expect(stats.InFlight).To(BeZero())
+				Expect(stats.OldestTaskAge).To(BeNumerically("~", time.Minute, time.Second))
+
+				crtl.Finish()
+			})
+		})
+	})
 })
@@ -41,6 +41,10 @@ func AdaptDequeueMessagesResponse(c azqueue.DequeuedMessagesResponse) DequeueMes
 	return dequeueMessagesResponse{c}
 }
 
+func AdaptPeekedMessagesResponse(c azqueue.PeekedMessagesResponse) PeekedMessagesResponseIface {
+	return peekedMessagesResponse{c}
+}
+
 type (
 	serviceUrl              struct{ c azqueue.ServiceURL }
 	queueUrl                struct{ c azqueue.QueueURL }
@@ -49,6 +53,9 @@ type (
 	dequeueMessagesResponse struct {
 		c azqueue.DequeuedMessagesResponse
 	}
+	peekedMessagesResponse struct {
+		c azqueue.PeekedMessagesResponse
+	}
 )
 
 func (c serviceUrl) NewQueueURL(queueName string) AzqueueQueueUrlIface {
@@ -59,6 +66,14 @@ func (c queueUrl) NewMessageURL() AzqueueMessageUrlIface {
 	return AdaptMessageUrl(c.c.NewMessagesURL())
 }
 
+func (c queueUrl) GetProperties(ctx context.Context) (QueueGetPropertiesResponseIface, error) {
+	resp, err := c.c.GetProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c messageUrl) Enqueue(ctx context.Context, messageText string, visibilityTimeout time.Duration, timeToLive time.Duration) (*azqueue.EnqueueMessageResponse, error) {
 	return c.c.Enqueue(ctx, messageText, visibilityTimeout, timeToLive)
 }
@@ -71,6 +86,14 @@ func (c messageUrl) Dequeue(ctx context.Context, maxMessages int32, visibilityTi
 	return AdaptDequeueMessagesResponse(*resp), nil
 }
 
+func (c messageUrl) Peek(ctx context.Context, maxMessages int32) (PeekedMessagesResponseIface, error) {
+	resp, err := c.c.Peek(ctx, maxMessages)
+	if err != nil {
+		return nil, err
+	}
+	return AdaptPeekedMessagesResponse(*resp), nil
+}
+
 func (c messageUrl) NewMessageIDURL(messageId azqueue.MessageID) AzqueueMessageIdUrlIface {
 	return AdaptMessageIdUrl(c.c.NewMessageIDURL(messageId))
 }
@@ -79,6 +102,10 @@ func (c messageIdUrl) Delete(ctx context.Context, popReceipt azqueue.PopReceipt)
 	return c.c.Delete(ctx, popReceipt)
 }
 
+func (c messageIdUrl) Update(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration, message string) (*azqueue.UpdatedMessageResponse, error) {
+	return c.c.Update(ctx, popReceipt, visibilityTimeout, message)
+}
+
 func (c dequeueMessagesResponse) NumMessages() int32 {
 	return c.c.NumMessages()
 }
@@ -86,3 +113,11 @@ func (c dequeueMessagesResponse) NumMessages() int32 {
 func (c dequeueMessagesResponse) Message(index int32) *azqueue.DequeuedMessage {
 	return c.c.Message(index)
 }
+
+func (c peekedMessagesResponse) NumMessages() int32 {
+	return c.c.NumMessages()
+}
+
+func (c peekedMessagesResponse) Message(index int32) *azqueue.PeekedMessage {
+	return c.c.Message(index)
+}
@@ -27,19 +27,31 @@ type AzqueueServiceUrlIface interface {
 
 type AzqueueQueueUrlIface interface {
 	NewMessageURL() AzqueueMessageUrlIface
+	GetProperties(ctx context.Context) (QueueGetPropertiesResponseIface, error)
 }
 
 type AzqueueMessageUrlIface interface {
 	Enqueue(ctx context.Context, messageText string, visibilityTimeout time.Duration, timeToLive time.Duration) (*azqueue.EnqueueMessageResponse, error)
 	Dequeue(ctx context.Context, maxMessages int32, visibilityTimeout time.Duration) (DequeueMessagesResponseIface, error)
 	NewMessageIDURL(messageId azqueue.MessageID) AzqueueMessageIdUrlIface
+	Peek(ctx context.Context, maxMessages int32) (PeekedMessagesResponseIface, error)
 }
 
 type AzqueueMessageIdUrlIface interface {
 	Delete(ctx context.Context, popReceipt azqueue.PopReceipt) (*azqueue.MessageIDDeleteResponse, error)
+	Update(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration, message string) (*azqueue.UpdatedMessageResponse, error)
 }
 
 type DequeueMessagesResponseIface interface {
 	NumMessages() int32
 	Message(index int32) *azqueue.DequeuedMessage
 }
+
+type PeekedMessagesResponseIface interface {
+	NumMessages() int32
+	Message(index int32) *azqueue.PeekedMessage
+}
+
+type QueueGetPropertiesResponseIface interface {
+	ApproximateMessagesCount() int32
+}
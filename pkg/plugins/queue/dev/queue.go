@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -42,6 +43,8 @@ type DevQueueService struct {
 type Item struct {
 	ID   int `storm:"id,increment"` // primary key with auto increment
 	Data []byte
+	// When the task was sent, used to report the age of the oldest task
+	Sent time.Time
 }
 
 func (s *DevQueueService) Send(queue string, task queue.NitricTask) error {
@@ -82,6 +85,7 @@ func (s *DevQueueService) Send(queue string, task queue.NitricTask) error {
 
 	item := Item{
 		Data: data,
+		Sent: time.Now(),
 	}
 
 	err = db.Save(&item)
@@ -142,6 +146,7 @@ func (s *DevQueueService) SendBatch(q string, tasks []queue.NitricTask) (*queue.
 
 		item := Item{
 			Data: data,
+			Sent: time.Now(),
 		}
 
 		err = db.Save(&item)
@@ -206,9 +211,6 @@ func (s *DevQueueService) Receive(options queue.ReceiveOptions) ([]queue.NitricT
 				err,
 			)
 		}
-		task.LeaseID = uuid.New().String()
-		poppedTasks = append(poppedTasks, task)
-
 		err = db.DeleteStruct(&item)
 		if err != nil {
 			return nil, newErr(
@@ -217,6 +219,15 @@ func (s *DevQueueService) Receive(options queue.ReceiveOptions) ([]queue.NitricT
 				err,
 			)
 		}
+
+		// Received tasks without the attributes are discarded, like they are by the cloud providers
+		if task.HasAttributes(options.Attributes) {
+			task.LeaseID = uuid.New().String()
+			// Tasks are removed as they're received, so they're only received once
+			task.ProviderID = strconv.Itoa(item.ID)
+			task.ReceiveCount = 1
+			poppedTasks = append(poppedTasks, task)
+		}
 	}
 
 	return poppedTasks, nil
@@ -249,6 +260,87 @@ func (s *DevQueueService) Complete(queue string, leaseId string) error {
 	return nil
 }
 
+// CompleteBatch - Completes previously popped queue items
+func (s *DevQueueService) CompleteBatch(q string, leaseIds []string) (*queue.CompleteBatchResponse, error) {
+	return queue.CompleteEach(leaseIds, func(leaseId string) error {
+		return s.Complete(q, leaseId)
+	}), nil
+}
+
+// Release - Unsupported, received tasks are removed so there's nothing to return to the queue
+func (s *DevQueueService) Release(queue string, leaseId string, delay time.Duration) error {
+	newErr := errors.ErrorsWithScope(
+		"DevQueueService.Release",
+		map[string]interface{}{
+			"queue":   queue,
+			"leaseId": leaseId,
+		},
+	)
+
+	return newErr(
+		codes.Unimplemented,
+		"releasing tasks is not supported by the dev queue",
+		nil,
+	)
+}
+
+// GetQueueStats - Returns the number of tasks in a queue, received tasks are removed so none are in flight
+func (s *DevQueueService) GetQueueStats(q string) (*queue.QueueStats, error) {
+	newErr := errors.ErrorsWithScope(
+		"DevQueueService.GetQueueStats",
+		map[string]interface{}{
+			"queue": q,
+		},
+	)
+
+	if q == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-blank queue",
+			nil,
+		)
+	}
+
+	db, err := s.createDb(q)
+	if err != nil {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"createDb error",
+			err,
+		)
+	}
+	defer db.Close()
+
+	depth, err := db.Count(&Item{})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error counting tasks",
+			err,
+		)
+	}
+
+	var oldest []Item
+	err = db.All(&oldest, storm.Limit(1))
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading tasks",
+			err,
+		)
+	}
+
+	stats := &queue.QueueStats{
+		Depth: int64(depth),
+	}
+	// Tasks sent before their send time was recorded don't have an age
+	if len(oldest) > 0 && !oldest[0].Sent.IsZero() {
+		stats.OldestTaskAge = time.Since(oldest[0].Sent)
+	}
+
+	return stats, nil
+}
+
 func New() (queue.QueueService, error) {
 	dbDir := utils.GetEnv("LOCAL_QUEUE_DIR", utils.GetRelativeDevPath(DEV_SUB_DIRECTORY))
 
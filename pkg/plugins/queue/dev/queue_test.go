@@ -245,6 +245,52 @@ var _ = Describe("Queue", func() {
 				Expect(storedTasks).To(HaveLen(5))
 			})
 		})
+
+		When("Filtering tasks by attribute", func() {
+			It("Should only receive the matching tasks", func() {
+				tasks := []queue.NitricTask{
+					{ID: "1", Attributes: map[string]string{"tenant": "a"}},
+					{ID: "2", Attributes: map[string]string{"tenant": "b"}},
+					{ID: "3", Attributes: map[string]string{"tenant": "a"}},
+					{ID: "4", Attributes: map[string]string{"tenant": "b"}},
+				}
+				_, err = queuePlugin.SendBatch("test", tasks)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				depth := uint32(3)
+				items, err := queuePlugin.Receive(queue.ReceiveOptions{
+					QueueName:  "test",
+					Depth:      &depth,
+					Attributes: map[string]string{"tenant": "a"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the matching tasks")
+				Expect(items).To(HaveLen(2))
+				Expect(items[0].ID).To(Equal("1"))
+				Expect(items[1].ID).To(Equal("3"))
+
+				By("Discarding the received tasks that didn't match")
+				storedTasks := GetAllTasks("test")
+				Expect(storedTasks).To(HaveLen(1))
+				Expect(storedTasks[0].ID).To(Equal("4"))
+			})
+		})
+	})
+
+	Context("GetQueueStats", func() {
+		When("The queue has tasks", func() {
+			It("Should return the number of tasks and the age of the oldest", func() {
+				_, err = queuePlugin.SendBatch("test", []queue.NitricTask{task1, task2})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				stats, err := queuePlugin.GetQueueStats("test")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stats.Depth).To(Equal(int64(2)))
+				Expect(stats.InFlight).To(BeZero())
+				Expect(stats.OldestTaskAge).To(BeNumerically(">", 0))
+			})
+		})
 	})
 
 	Context("Complete", func() {
@@ -17,12 +17,34 @@ package queue
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type SendBatchResponse struct {
 	FailedTasks []*FailedTask
 }
 
+// FailedLease - A received task that failed to be completed
+type FailedLease struct {
+	LeaseID string
+	Message string
+}
+
+type CompleteBatchResponse struct {
+	FailedLeases []*FailedLease
+}
+
+// QueueStats - The approximate backlog of a queue, as reported by its provider.
+// Figures a provider doesn't report are zero
+type QueueStats struct {
+	// Depth - the number of tasks waiting to be received
+	Depth int64
+	// InFlight - the number of tasks received but not yet completed
+	InFlight int64
+	// OldestTaskAge - how long the oldest waiting task has been queued
+	OldestTaskAge time.Duration
+}
+
 // QueueService - The Nitric plugin interface for cloud native queue adapters
 type QueueService interface {
 	// Send - Send a single task to a queue
@@ -33,6 +55,12 @@ type QueueService interface {
 	Receive(options ReceiveOptions) ([]NitricTask, error)
 	// Complete - Marks a received task as completed
 	Complete(queue string, leaseId string) error
+	// CompleteBatch - Marks a subset of received tasks as completed, the others are redelivered once their leases expire
+	CompleteBatch(queue string, leaseIds []string) (*CompleteBatchResponse, error)
+	// Release - Returns a received task to the queue, to be redelivered once the delay has passed
+	Release(queue string, leaseId string, delay time.Duration) error
+	// GetQueueStats - Returns the approximate backlog of a queue
+	GetQueueStats(queue string) (*QueueStats, error)
 }
 
 type ReceiveOptions struct {
@@ -45,6 +73,13 @@ type ReceiveOptions struct {
 	//
 	// If nil or 0, defaults to depth 1.
 	Depth *uint32 `type:"int" required:"false" log:"Depth"`
+
+	// Only receive tasks with all of these attributes.
+	//
+	// Tasks without them are discarded as they're received, releasing them would count towards
+	// their max receives and dead-letter them without them ever being handled.
+	// Fewer tasks than the requested depth may be received.
+	Attributes map[string]string `type:"map" required:"false" log:"Attributes"`
 }
 
 func (p *ReceiveOptions) Validate() error {
@@ -68,6 +103,24 @@ func (p *ReceiveOptions) Validate() error {
 	return nil
 }
 
+// CompleteEach - completes leases one at a time, for providers that can't complete them in batches
+func CompleteEach(leaseIds []string, complete func(leaseId string) error) *CompleteBatchResponse {
+	resp := &CompleteBatchResponse{
+		FailedLeases: make([]*FailedLease, 0),
+	}
+
+	for _, leaseId := range leaseIds {
+		if err := complete(leaseId); err != nil {
+			resp.FailedLeases = append(resp.FailedLeases, &FailedLease{
+				LeaseID: leaseId,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return resp
+}
+
 // UnimplementedQueuePlugin - A Default interface, that provide implementations of QueueService methods that
 // Flag the method as unimplemented
 type UnimplementedQueuePlugin struct {
@@ -94,3 +147,15 @@ func (*UnimplementedQueuePlugin) Receive(options ReceiveOptions) ([]NitricTask,
 func (*UnimplementedQueuePlugin) Complete(queue string, leaseId string) error {
 	return fmt.Errorf("UNIMPLEMENTED")
 }
+
+func (*UnimplementedQueuePlugin) CompleteBatch(queue string, leaseIds []string) (*CompleteBatchResponse, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedQueuePlugin) Release(queue string, leaseId string, delay time.Duration) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedQueuePlugin) GetQueueStats(queue string) (*QueueStats, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
@@ -18,25 +18,80 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	pubsubbase "cloud.google.com/go/pubsub/apiv1"
-	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
 
 	ifaces_pubsub "github.com/nitrictech/nitric/pkg/ifaces/pubsub"
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
+// maxAckDeadline - the longest Pub/Sub will wait for an acknowledgement before redelivering a message
+const maxAckDeadline = 10 * time.Minute
+
 type PubsubQueueService struct {
 	queue.UnimplementedQueuePlugin
+	// provider - creates the clients on first use, nil if they're already set
+	provider            core.GcpProvider
+	connectOnce         sync.Once
+	connectErr          error
 	client              ifaces_pubsub.PubsubClient
 	newSubscriberClient func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error)
+	metrics             ifaces_pubsub.SubscriptionMetrics
 	projectId           string
+	// identity - qualifies topic ids, so environments sharing a project don't share queues
+	identity *stack.Identity
+}
+
+// topicId - returns the id of a queue's topic, qualified with the stack and environment
+// Queue topics share their names with event topics, so they're translated as Pub/Sub topics
+func (s *PubsubQueueService) topicId(queue string) (string, error) {
+	return naming.PubsubTopics.Physical(s.identity.Qualify(queue))
+}
+
+// connect - creates the clients the first time they're needed, so unused plugins don't slow cold starts
+func (s *PubsubQueueService) connect() error {
+	s.connectOnce.Do(func() {
+		if s.provider == nil {
+			return
+		}
+
+		projectId, err := s.provider.ProjectID()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		client, err := s.provider.PubsubClient()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		monitoringService, err := s.provider.MonitoringService()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		s.client = ifaces_pubsub.AdaptPubsubClient(client)
+		s.metrics = ifaces_pubsub.NewMonitoringMetrics(monitoringService, projectId)
+		s.projectId = projectId
+	})
+
+	return s.connectErr
 }
 
 // TODO: clearly document the reason for this subscription.
@@ -53,9 +108,22 @@ func (s *PubsubQueueService) Send(queue string, task queue.NitricTask) error {
 			"task":  task,
 		},
 	)
+
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
 	// We'll be using pubsub with pull subscribers to facilitate queue functionality
 	ctx := context.TODO()
-	topic := s.client.Topic(queue)
+	id, err := s.topicId(queue)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "topic id collides with another topic", err)
+	}
+	topic := s.client.Topic(id)
 
 	if exists, err := topic.Exists(ctx); !exists || err != nil {
 		return newErr(
@@ -99,9 +167,21 @@ func (s *PubsubQueueService) SendBatch(q string, tasks []queue.NitricTask) (*que
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
 	// We'll be using pubsub with pull subscribers to facilitate queue functionality
 	ctx := context.TODO()
-	topic := s.client.Topic(q)
+	id, err := s.topicId(q)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "topic id collides with another topic", err)
+	}
+	topic := s.client.Topic(id)
 
 	if exists, err := topic.Exists(ctx); !exists || err != nil {
 		return nil, newErr(
@@ -158,7 +238,11 @@ func (s *PubsubQueueService) SendBatch(q string, tasks []queue.NitricTask) (*que
 func (s *PubsubQueueService) getQueueSubscription(q string) (ifaces_pubsub.Subscription, error) {
 	ctx := context.Background()
 
-	topic := s.client.Topic(q)
+	id, err := s.topicId(q)
+	if err != nil {
+		return nil, err
+	}
+	topic := s.client.Topic(id)
 	subsIt := topic.Subscriptions(ctx)
 
 	for {
@@ -169,7 +253,7 @@ func (s *PubsubQueueService) getQueueSubscription(q string) (ifaces_pubsub.Subsc
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve pull subscription for topic: %s\n%s", topic.ID(), err)
 		}
-		queueSubName := generateQueueSubscription(q)
+		queueSubName := generateQueueSubscription(topic.ID())
 		if sub.ID() == queueSubName {
 			return sub, nil
 		}
@@ -187,6 +271,14 @@ func (s *PubsubQueueService) Receive(options queue.ReceiveOptions) ([]queue.Nitr
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
 	if err := options.Validate(); err != nil {
 		return nil, newErr(
 			codes.InvalidArgument,
@@ -255,11 +347,33 @@ func (s *PubsubQueueService) Receive(options queue.ReceiveOptions) ([]queue.Nitr
 			ID:          nitricTask.ID,
 			Payload:     nitricTask.Payload,
 			PayloadType: nitricTask.PayloadType,
+			Attributes:  nitricTask.Attributes,
 			LeaseID:     m.AckId,
+			ProviderID:  m.Message.MessageId,
+			// Only reported for subscriptions with a dead letter policy
+			ReceiveCount: int(m.DeliveryAttempt),
 		})
 	}
 
-	return tasks, nil
+	// Pull subscriptions can't filter messages as they're received, so the attributes in their bodies are checked instead
+	matched, unmatched := queue.FilterTasks(tasks, options.Attributes)
+	if len(unmatched) > 0 {
+		ackIds := make([]string, 0, len(unmatched))
+		for _, t := range unmatched {
+			ackIds = append(ackIds, t.LeaseID)
+		}
+
+		// Filtered tasks are acknowledged rather than nacked, as every redelivery counts towards the
+		// subscription's max delivery attempts and they'd be dead-lettered without ever being handled
+		if err := client.Acknowledge(ctx, &pubsubpb.AcknowledgeRequest{
+			Subscription: queueSubscription.String(),
+			AckIds:       ackIds,
+		}); err != nil {
+			log.Default().Printf("error discarding %d filtered tasks: %v", len(ackIds), err)
+		}
+	}
+
+	return matched, nil
 }
 
 // Completes a previously popped queue item
@@ -272,6 +386,14 @@ func (s *PubsubQueueService) Complete(q string, leaseId string) error {
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
 	ctx := context.Background()
 
 	// Find the generic pull subscription for the provided topic (queue)
@@ -315,6 +437,223 @@ func (s *PubsubQueueService) Complete(q string, leaseId string) error {
 	return nil
 }
 
+// CompleteBatch - Completes previously popped queue items, acknowledging them in a single request
+func (s *PubsubQueueService) CompleteBatch(q string, leaseIds []string) (*queue.CompleteBatchResponse, error) {
+	newErr := errors.ErrorsWithScope(
+		"PubsubQueueService.CompleteBatch",
+		map[string]interface{}{
+			"queue":        q,
+			"leaseIds.len": len(leaseIds),
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
+	ctx := context.Background()
+
+	queueSubscription, err := s.getQueueSubscription(q)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"could not find queue subscription",
+			err,
+		)
+	}
+
+	client, err := s.newSubscriberClient(ctx)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to create subscriberclient",
+			err,
+		)
+	}
+	defer client.Close()
+
+	resp := &queue.CompleteBatchResponse{
+		FailedLeases: make([]*queue.FailedLease, 0),
+	}
+
+	err = client.Acknowledge(ctx, &pubsubpb.AcknowledgeRequest{
+		Subscription: queueSubscription.String(),
+		AckIds:       leaseIds,
+	})
+	if err != nil {
+		// Acknowledgements aren't reported per message, so every lease fails along with the request
+		for _, leaseId := range leaseIds {
+			resp.FailedLeases = append(resp.FailedLeases, &queue.FailedLease{
+				LeaseID: leaseId,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// Release - Extends the ack deadline of a previously popped queue item to the delay, so it's redelivered once it passes.
+// Pub/Sub caps ack deadlines at 10 minutes, longer delays are shortened to it
+func (s *PubsubQueueService) Release(q string, leaseId string, delay time.Duration) error {
+	newErr := errors.ErrorsWithScope(
+		"PubsubQueueService.Release",
+		map[string]interface{}{
+			"queue":   q,
+			"leaseId": leaseId,
+			"delay":   delay,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
+	ctx := context.Background()
+
+	queueSubscription, err := s.getQueueSubscription(q)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"could not find queue subscription",
+			err,
+		)
+	}
+
+	client, err := s.newSubscriberClient(ctx)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to create subscriberclient",
+			err,
+		)
+	}
+	defer client.Close()
+
+	if delay > maxAckDeadline {
+		delay = maxAckDeadline
+	}
+
+	err = client.ModifyAckDeadline(ctx, &pubsubpb.ModifyAckDeadlineRequest{
+		Subscription:       queueSubscription.String(),
+		AckIds:             []string{leaseId},
+		AckDeadlineSeconds: int32(delay.Seconds()),
+	})
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to release task",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// GetQueueStats - Returns the approximate backlog of a queue from the metrics of its subscription.
+// Pub/Sub doesn't report messages that have been pulled but not acknowledged separately, so none are reported as in flight
+func (s *PubsubQueueService) GetQueueStats(q string) (*queue.QueueStats, error) {
+	newErr := errors.ErrorsWithScope(
+		"PubsubQueueService.GetQueueStats",
+		map[string]interface{}{
+			"queue": q,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to pubsub",
+			err,
+		)
+	}
+
+	if s.metrics == nil {
+		return nil, newErr(
+			codes.Unimplemented,
+			"subscription metrics are not available",
+			nil,
+		)
+	}
+
+	ctx := context.Background()
+
+	queueSubscription, err := s.getQueueSubscription(q)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"could not find queue subscription",
+			err,
+		)
+	}
+
+	depth, err := s.metrics.Latest(ctx, queueSubscription.ID(), ifaces_pubsub.NumUndeliveredMessages)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to read undelivered messages",
+			err,
+		)
+	}
+
+	age, err := s.metrics.Latest(ctx, queueSubscription.ID(), ifaces_pubsub.OldestUnackedMessageAge)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to read oldest message age",
+			err,
+		)
+	}
+
+	return &queue.QueueStats{
+		Depth:         depth,
+		OldestTaskAge: time.Duration(age) * time.Second,
+	}, nil
+}
+
+// CheckPermissions - tests the IAM permissions needed to send tasks to the queue's topic and receive them from its pull subscription
+func (s *PubsubQueueService) CheckPermissions(q string, actions []resources.Action) ([]resources.Permission, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+
+	permissions := []resources.Permission{}
+	for _, a := range actions {
+		switch a {
+		case resources.QueueSend:
+			id, err := s.topicId(q)
+			if err != nil {
+				return nil, err
+			}
+			topicPermissions, err := core.TestPermissions(s.client.Topic(id), "topics/"+id, []string{"pubsub.topics.publish"})
+			if err != nil {
+				return nil, err
+			}
+			permissions = append(permissions, topicPermissions...)
+		case resources.QueueReceive:
+			sub, err := s.getQueueSubscription(q)
+			if err != nil {
+				return nil, err
+			}
+			subPermissions, err := core.TestPermissions(sub, "subscriptions/"+sub.ID(), []string{"pubsub.subscriptions.consume"})
+			if err != nil {
+				return nil, err
+			}
+			permissions = append(permissions, subPermissions...)
+		}
+	}
+
+	return permissions, nil
+}
+
 // adaptNewClient - Adapts the pubsubbase.NewSubscriberClient func to one that implements the SubscriberClient
 // interface. This is used to enable substitution of the base pubsub client, primarily for mocking support.
 func adaptNewClient(f func(context.Context, ...option.ClientOption) (*pubsubbase.SubscriberClient, error)) func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
@@ -323,24 +662,35 @@ func adaptNewClient(f func(context.Context, ...option.ClientOption) (*pubsubbase
 	}
 }
 
-// New - Constructs a new GCP pubsub client with defaults
-func New() (queue.QueueService, error) {
-	ctx := context.Background()
+// sharedSubscriberClient - a subscriber client shared by every call, which stays open when a call is done with it
+type sharedSubscriberClient struct {
+	ifaces_pubsub.SubscriberClient
+}
 
-	credentials, credentialsError := google.FindDefaultCredentials(ctx, pubsub.ScopeCloudPlatform)
-	if credentialsError != nil {
-		return nil, fmt.Errorf("GCP credentials error: %v", credentialsError)
-	}
-	client, clientError := pubsub.NewClient(ctx, credentials.ProjectID)
-	if clientError != nil {
-		return nil, fmt.Errorf("pubsub client error: %v", clientError)
+func (sharedSubscriberClient) Close() error {
+	return nil
+}
+
+// New - Constructs a new GCP pubsub client with defaults
+func New(provider core.GcpProvider) (queue.QueueService, error) {
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	return &PubsubQueueService{
-		client: ifaces_pubsub.AdaptPubsubClient(client),
+		provider: provider,
+		identity: identity,
 		// TODO: replace this with a better mechanism for mocking the client.
-		newSubscriberClient: adaptNewClient(pubsubbase.NewSubscriberClient),
-		projectId:           credentials.ProjectID,
+		// The subscriber client is created by the first call that needs it, then reused
+		newSubscriberClient: func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
+			subscriber, err := provider.SubscriberClient()
+			if err != nil {
+				return nil, err
+			}
+
+			return sharedSubscriberClient{subscriber}, nil
+		},
 	}, nil
 }
 
@@ -351,10 +701,26 @@ func NewWithClient(client ifaces_pubsub.PubsubClient) queue.QueueService {
 	}
 }
 
-//*pubsubbase.SubscriberClient
+// *pubsubbase.SubscriberClient
 func NewWithClients(client ifaces_pubsub.PubsubClient, subscriberClientGenerator func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error)) queue.QueueService {
 	return &PubsubQueueService{
 		client:              client,
 		newSubscriberClient: subscriberClientGenerator,
 	}
 }
+
+// NewWithIdentity - creates the plugin with the stack and environment its topic ids are qualified with
+func NewWithIdentity(client ifaces_pubsub.PubsubClient, identity *stack.Identity) queue.QueueService {
+	return &PubsubQueueService{
+		client:   client,
+		identity: identity,
+	}
+}
+
+// NewWithMetrics - creates the plugin with a reader for the metrics of queue subscriptions
+func NewWithMetrics(client ifaces_pubsub.PubsubClient, metrics ifaces_pubsub.SubscriptionMetrics) queue.QueueService {
+	return &PubsubQueueService{
+		client:  client,
+		metrics: metrics,
+	}
+}
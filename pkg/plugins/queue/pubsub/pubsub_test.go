@@ -17,6 +17,7 @@ package pubsub_queue_service_test
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -27,6 +28,8 @@ import (
 	ifaces_pubsub "github.com/nitrictech/nitric/pkg/ifaces/pubsub"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
 	pubsub_queue_service "github.com/nitrictech/nitric/pkg/plugins/queue/pubsub"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 	mock_pubsub "github.com/nitrictech/nitric/tests/mocks/pubsub"
 )
 
@@ -56,6 +59,21 @@ var _ = Describe("Pubsub", func() {
 			})
 		})
 
+		When("The stack has an environment", func() {
+			mockPubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"test", "shop-prod-test"},
+			})
+			queuePlugin := pubsub_queue_service.NewWithIdentity(mockPubsubClient, &stack.Identity{Name: "shop", Environment: "prod"})
+
+			It("Should publish to the environment's queue", func() {
+				err := queuePlugin.Send("test", queue.NitricTask{ID: "1234"})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(mockPubsubClient.PublishedMessages["shop-prod-test"]).To(HaveLen(1))
+				Expect(mockPubsubClient.PublishedMessages["test"]).To(BeEmpty())
+			})
+		})
+
 		When("Publishing to a queue that does not exist", func() {
 			mockPubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
 				Topics: []string{},
@@ -230,4 +248,72 @@ var _ = Describe("Pubsub", func() {
 			})
 		})
 	})
+
+	Context("CompleteBatch", func() {
+		When("Pubsub acknowledge request errors", func() {
+			mockPubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"mock-queue"},
+			})
+			queuePlugin := pubsub_queue_service.NewWithClients(mockPubsubClient, func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
+				return mock_pubsub.MockBaseClient{
+					CompleteError: fmt.Errorf("mock complete error"),
+				}, nil
+			})
+
+			It("Should fail every lease", func() {
+				resp, err := queuePlugin.CompleteBatch("mock-queue", []string{"1", "2"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedLeases).To(HaveLen(2))
+				Expect(resp.FailedLeases[0].Message).To(Equal("mock complete error"))
+			})
+		})
+	})
+
+	Context("GetQueueStats", func() {
+		When("the subscription has reported metrics", func() {
+			mockPubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+				Topics: []string{"mock-queue"},
+			})
+			metrics := &staticMetrics{values: map[string]int64{
+				"mock-queue-nitricqueue/pubsub.googleapis.com/subscription/num_undelivered_messages":   5,
+				"mock-queue-nitricqueue/pubsub.googleapis.com/subscription/oldest_unacked_message_age": 120,
+			}}
+			queuePlugin := pubsub_queue_service.NewWithMetrics(mockPubsubClient, metrics)
+
+			It("Should return the metrics of the queue's subscription", func() {
+				stats, err := queuePlugin.GetQueueStats("mock-queue")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stats.Depth).To(Equal(int64(5)))
+				Expect(stats.OldestTaskAge).To(Equal(2 * time.Minute))
+			})
+		})
+	})
+
+	Context("CheckPermissions", func() {
+		mockPubsubClient := mock_pubsub.NewMockPubsubClient(mock_pubsub.MockPubsubOptions{
+			Topics:  []string{"mock-queue"},
+			Granted: []string{"pubsub.subscriptions.consume"},
+		})
+		queuePlugin := pubsub_queue_service.NewWithClient(mockPubsubClient)
+
+		It("Should test the permissions of the queue's topic and subscription", func() {
+			permissions, err := queuePlugin.(resources.PermissionChecker).CheckPermissions("mock-queue", []resources.Action{
+				resources.QueueSend, resources.QueueReceive,
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "pubsub.topics.publish on topics/mock-queue", Granted: false},
+				{Name: "pubsub.subscriptions.consume on subscriptions/mock-queue-nitricqueue", Granted: true},
+			}))
+		})
+	})
 })
+
+type staticMetrics struct {
+	values map[string]int64
+}
+
+func (m *staticMetrics) Latest(ctx context.Context, subscriptionId string, metricType string) (int64, error) {
+	return m.values[subscriptionId+"/"+metricType], nil
+}
@@ -17,18 +17,24 @@ package sqs_service
 import (
 	"encoding/json"
 	"fmt"
+	"log"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
-	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 const (
@@ -37,10 +43,17 @@ const (
 	ErrCodeAccessDenied = "AccessDenied"
 )
 
+// maxBatchSize - the most messages SQS batch requests can contain
+const maxBatchSize = 10
+
 type SQSQueueService struct {
 	queue.UnimplementedQueuePlugin
 	provder core.AwsProvider
 	client  sqsiface.SQSAPI
+	// Reads the age of the oldest message in queues, which SQS doesn't report as a queue attribute
+	metrics cloudwatchiface.CloudWatchAPI
+	// simulates the IAM actions of operations, to check they're allowed without performing them
+	simulator core.PermissionSimulator
 }
 
 // Get the URL for a given queue name
@@ -180,6 +193,9 @@ func (s *SQSQueueService) Receive(options queue.ReceiveOptions) ([]queue.NitricT
 			MessageAttributeNames: []*string{
 				aws.String(sqs.QueueAttributeNameAll),
 			},
+			AttributeNames: []*string{
+				aws.String(sqs.MessageSystemAttributeNameApproximateReceiveCount),
+			},
 			QueueUrl: url,
 			// TODO: Consider explicit timeout values
 			// VisibilityTimeout:       nil,
@@ -212,15 +228,24 @@ func (s *SQSQueueService) Receive(options queue.ReceiveOptions) ([]queue.NitricT
 				)
 			}
 
+			receiveCount, _ := strconv.Atoi(aws.StringValue(m.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount]))
+
 			tasks = append(tasks, queue.NitricTask{
-				ID:          nitricTask.ID,
-				Payload:     nitricTask.Payload,
-				PayloadType: nitricTask.PayloadType,
-				LeaseID:     *m.ReceiptHandle,
+				ID:           nitricTask.ID,
+				Payload:      nitricTask.Payload,
+				PayloadType:  nitricTask.PayloadType,
+				Attributes:   nitricTask.Attributes,
+				LeaseID:      *m.ReceiptHandle,
+				ProviderID:   aws.StringValue(m.MessageId),
+				ReceiveCount: receiveCount,
 			})
 		}
 
-		return tasks, nil
+		// SQS can't filter messages as they're received, so the attributes in their bodies are checked instead
+		matched, unmatched := queue.FilterTasks(tasks, options.Attributes)
+		s.discard(url, unmatched)
+
+		return matched, nil
 	} else {
 		return nil, newErr(
 			codes.NotFound,
@@ -264,22 +289,320 @@ func (s *SQSQueueService) Complete(q string, leaseId string) error {
 	}
 }
 
-func New(provider core.AwsProvider) (queue.QueueService, error) {
-	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
+// Release - Makes a received task visible again once the delay has passed
+func (s *SQSQueueService) Release(q string, leaseId string, delay time.Duration) error {
+	newErr := errors.ErrorsWithScope(
+		"SQSQueueService.Release",
+		map[string]interface{}{
+			"queue":   q,
+			"leaseId": leaseId,
+			"delay":   delay,
+		},
+	)
+
+	url, err := s.getUrlForQueueName(q)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	if _, err := s.client.ChangeMessageVisibility(&sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          url,
+		ReceiptHandle:     aws.String(leaseId),
+		VisibilityTimeout: aws.Int64(int64(delay.Seconds())),
+	}); err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to release task",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// discard - deletes received tasks that were filtered out.
+// Releasing them would count as a receive, so they'd be dead-lettered without ever being handled
+func (s *SQSQueueService) discard(url *string, tasks []queue.NitricTask) {
+	if len(tasks) == 0 {
+		return
+	}
+
+	leaseIds := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		leaseIds = append(leaseIds, t.LeaseID)
+	}
+
+	// Undeleted tasks are redelivered once their visibility timeout expires
+	failed, err := s.deleteMessages(url, leaseIds)
+	if err != nil {
+		log.Default().Printf("error discarding %d filtered tasks: %v", len(leaseIds), err)
+		return
+	}
+
+	for _, f := range failed {
+		log.Default().Printf("error discarding filtered task: %s", f.Message)
+	}
+}
+
+// CompleteBatch - Completes previously popped queue items, deleting up to 10 messages per request
+func (s *SQSQueueService) CompleteBatch(q string, leaseIds []string) (*queue.CompleteBatchResponse, error) {
+	newErr := errors.ErrorsWithScope(
+		"SQSQueueService.CompleteBatch",
+		map[string]interface{}{
+			"queue":        q,
+			"leaseIds.len": len(leaseIds),
+		},
+	)
+
+	url, err := s.getUrlForQueueName(q)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	failed, err := s.deleteMessages(url, leaseIds)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to dequeue tasks",
+			err,
+		)
+	}
+
+	return &queue.CompleteBatchResponse{
+		FailedLeases: failed,
+	}, nil
+}
+
+// deleteMessages - deletes received messages by their receipt handles, up to 10 per request,
+// returning the leases of those that couldn't be deleted
+func (s *SQSQueueService) deleteMessages(url *string, leaseIds []string) ([]*queue.FailedLease, error) {
+	failedLeases := make([]*queue.FailedLease, 0)
+
+	for start := 0; start < len(leaseIds); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(leaseIds) {
+			end = len(leaseIds)
+		}
+
+		// Entry IDs only need to be unique within a request, so they're the index of the lease
+		entries := make([]*sqs.DeleteMessageBatchRequestEntry, 0, end-start)
+		for i, leaseId := range leaseIds[start:end] {
+			entries = append(entries, &sqs.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(strconv.Itoa(start + i)),
+				ReceiptHandle: aws.String(leaseId),
+			})
+		}
+
+		out, err := s.client.DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+			QueueUrl: url,
+			Entries:  entries,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, failed := range out.Failed {
+			i, _ := strconv.Atoi(aws.StringValue(failed.Id))
+			failedLeases = append(failedLeases, &queue.FailedLease{
+				LeaseID: leaseIds[i],
+				Message: aws.StringValue(failed.Message),
+			})
+		}
+	}
+
+	return failedLeases, nil
+}
+
+// GetQueueStats - Returns the approximate backlog of a queue from its attributes,
+// and the age of its oldest message from CloudWatch
+func (s *SQSQueueService) GetQueueStats(q string) (*queue.QueueStats, error) {
+	newErr := errors.ErrorsWithScope(
+		"SQSQueueService.GetQueueStats",
+		map[string]interface{}{
+			"queue": q,
+		},
+	)
+
+	url, err := s.getUrlForQueueName(q)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	out, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl: url,
+		AttributeNames: []*string{
+			aws.String(sqs.QueueAttributeNameApproximateNumberOfMessages),
+			aws.String(sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible),
+		},
+	})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to get queue attributes",
+			err,
+		)
+	}
+
+	stats := &queue.QueueStats{}
+	stats.Depth, _ = strconv.ParseInt(aws.StringValue(out.Attributes[sqs.QueueAttributeNameApproximateNumberOfMessages]), 10, 64)
+	stats.InFlight, _ = strconv.ParseInt(aws.StringValue(out.Attributes[sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible]), 10, 64)
+
+	if s.metrics != nil {
+		urlParts := strings.Split(aws.StringValue(url), "/")
+		age, err := s.oldestMessageAge(urlParts[len(urlParts)-1])
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"failed to get the age of the oldest message",
+				err,
+			)
+		}
+		stats.OldestTaskAge = age
+	}
+
+	return stats, nil
+}
+
+// oldestMessageAge - returns the latest ApproximateAgeOfOldestMessage reported for the queue, which SQS publishes every minute
+func (s *SQSQueueService) oldestMessageAge(queueName string) (time.Duration, error) {
+	now := time.Now()
+	out, err := s.metrics.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/SQS"),
+		MetricName: aws.String("ApproximateAgeOfOldestMessage"),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("QueueName"),
+				Value: aws.String(queueName),
+			},
+		},
+		StartTime:  aws.Time(now.Add(-5 * time.Minute)),
+		EndTime:    aws.Time(now),
+		Period:     aws.Int64(60),
+		Statistics: []*string{aws.String(cloudwatch.StatisticMaximum)},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var latest *cloudwatch.Datapoint
+	for _, dp := range out.Datapoints {
+		if latest == nil || dp.Timestamp.After(*latest.Timestamp) {
+			latest = dp
+		}
+	}
+
+	if latest == nil {
+		return 0, nil
+	}
+
+	return time.Duration(aws.Float64Value(latest.Maximum) * float64(time.Second)), nil
+}
+
+// Provision - creates the queue if it doesn't exist, tagged with its nitric name and the stack's labels
+func (s *SQSQueueService) Provision(q string, identity *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"SQSQueueService.Provision",
+		map[string]interface{}{
+			"queue": q,
+		},
+	)
+
+	queues, err := s.provder.GetResources(core.AwsResource_Queue)
+	if err != nil {
+		return newErr(codes.Internal, "error retrieving queue list", err)
+	}
+
+	if _, ok := queues[q]; ok {
+		return nil
+	}
+
+	tags := map[string]*string{}
+	for k, v := range resources.Labels(q, identity) {
+		tags[k] = aws.String(v)
+	}
+
+	name, err := naming.SqsQueues.Physical(identity.Qualify(q))
+	if err != nil {
+		return newErr(codes.AlreadyExists, "queue name collides with another queue", err)
+	}
+
+	out, err := s.client.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(name),
+		Tags:      tags,
+	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to create queue", err)
+	}
 
-	sess, sessionError := session.NewSession(&aws.Config{
-		Region: aws.String(awsRegion),
+	attrs, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       out.QueueUrl,
+		AttributeNames: []*string{aws.String(sqs.QueueAttributeNameQueueArn)},
 	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to get created queue arn", err)
+	}
+
+	s.provder.AddResource(core.AwsResource_Queue, q, aws.StringValue(attrs.Attributes[sqs.QueueAttributeNameQueueArn]))
+
+	return nil
+}
+
+// queueActions - the IAM actions needed on a queue for nitric actions, queues are listed by their tags
+var queueActions = map[resources.Action][]string{
+	resources.QueueSend:    {"sqs:GetQueueUrl", "sqs:SendMessage"},
+	resources.QueueReceive: {"sqs:GetQueueUrl", "sqs:ReceiveMessage", "sqs:DeleteMessage", "sqs:ChangeMessageVisibility"},
+	resources.QueueDetail:  {"sqs:GetQueueUrl", "sqs:GetQueueAttributes"},
+}
+
+// CheckPermissions - simulates the IAM actions needed for the nitric actions on the queue
+func (s *SQSQueueService) CheckPermissions(q string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.simulator == nil {
+		return nil, fmt.Errorf("permissions can't be simulated without an IAM client")
+	}
+
+	queues, err := s.provder.GetResources(core.AwsResource_Queue)
+	if err != nil {
+		return nil, err
+	}
+
+	queueArn, ok := queues[q]
+	if !ok {
+		return nil, fmt.Errorf("queue %s does not exist", q)
+	}
+
+	needed := map[string][]string{}
+	for _, a := range actions {
+		needed[queueArn] = append(needed[queueArn], queueActions[a]...)
+	}
+
+	return core.SimulatePermissions(s.simulator, needed)
+}
 
-	if sessionError != nil {
-		return nil, fmt.Errorf("Error creating new AWS session %v", sessionError)
+func New(provider core.AwsProvider) (queue.QueueService, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
 	}
 
 	client := sqs.New(sess)
 
 	return &SQSQueueService{
-		client:  client,
-		provder: provider,
+		client:    client,
+		provder:   provider,
+		metrics:   cloudwatch.New(sess),
+		simulator: core.NewPermissionSimulator(sess),
 	}, nil
 }
 
@@ -289,3 +612,12 @@ func NewWithClient(provider core.AwsProvider, client sqsiface.SQSAPI) queue.Queu
 		provder: provider,
 	}
 }
+
+// NewWithClients - creates the plugin with a CloudWatch client for reading the age of the oldest message in queues
+func NewWithClients(provider core.AwsProvider, client sqsiface.SQSAPI, metrics cloudwatchiface.CloudWatchAPI) queue.QueueService {
+	return &SQSQueueService{
+		client:  client,
+		provder: provider,
+		metrics: metrics,
+	}
+}
@@ -16,8 +16,11 @@ package sqs_service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/golang/mock/gomock"
 
@@ -28,6 +31,8 @@ import (
 	mocks_sqs "github.com/nitrictech/nitric/mocks/sqs"
 	"github.com/nitrictech/nitric/pkg/plugins/queue"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 var _ = Describe("Sqs", func() {
@@ -177,12 +182,19 @@ var _ = Describe("Sqs", func() {
 						MessageAttributeNames: []*string{
 							aws.String(sqs.QueueAttributeNameAll),
 						},
+						AttributeNames: []*string{
+							aws.String(sqs.MessageSystemAttributeNameApproximateReceiveCount),
+						},
 						QueueUrl: queueUrl,
 					}).Times(1).Return(&sqs.ReceiveMessageOutput{
 						Messages: []*sqs.Message{
 							{
 								ReceiptHandle: aws.String("mockreceipthandle"),
+								MessageId:     aws.String("mock-message-id"),
 								Body:          aws.String(`{"id":"1234","payloadType":"test-payload","payload":{"Test":"Test"}}`),
+								Attributes: map[string]*string{
+									sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String("2"),
+								},
 							},
 						},
 					}, nil)
@@ -197,9 +209,11 @@ var _ = Describe("Sqs", func() {
 
 					Expect(messages).To(HaveLen(1))
 					Expect(messages[0]).To(BeEquivalentTo(queue.NitricTask{
-						ID:          "1234",
-						PayloadType: "test-payload",
-						LeaseID:     "mockreceipthandle",
+						ID:           "1234",
+						PayloadType:  "test-payload",
+						LeaseID:      "mockreceipthandle",
+						ProviderID:   "mock-message-id",
+						ReceiveCount: 2,
 						Payload: map[string]interface{}{
 							"Test": "Test",
 						},
@@ -210,6 +224,62 @@ var _ = Describe("Sqs", func() {
 				})
 			})
 
+			When("Filtering messages by attribute", func() {
+				It("Should discard the messages without the attributes", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsProvider(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					providerMock.EXPECT().GetResources(core.AwsResource_Queue).Return(map[string]string{
+						"mock-queue": "arn:aws:sqs:us-east-2:444455556666:mock-queue",
+					}, nil)
+
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any()).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					sqsMock.EXPECT().ReceiveMessage(gomock.Any()).Times(1).Return(&sqs.ReceiveMessageOutput{
+						Messages: []*sqs.Message{
+							{
+								ReceiptHandle: aws.String("matched"),
+								Body:          aws.String(`{"id":"1","attributes":{"tenant":"a"}}`),
+							},
+							{
+								ReceiptHandle: aws.String("unmatched"),
+								Body:          aws.String(`{"id":"2","attributes":{"tenant":"b"}}`),
+							},
+						},
+					}, nil)
+
+					By("Deleting the unmatched message, so it isn't dead-lettered")
+					sqsMock.EXPECT().DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+						QueueUrl: queueUrl,
+						Entries: []*sqs.DeleteMessageBatchRequestEntry{
+							{Id: aws.String("0"), ReceiptHandle: aws.String("unmatched")},
+						},
+					}).Times(1).Return(&sqs.DeleteMessageBatchOutput{}, nil)
+
+					depth := uint32(10)
+					messages, err := plugin.Receive(queue.ReceiveOptions{
+						QueueName:  "mock-queue",
+						Depth:      &depth,
+						Attributes: map[string]string{"tenant": "a"},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("Returning only the matched message")
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].LeaseID).To(Equal("matched"))
+					Expect(messages[0].Attributes).To(Equal(map[string]string{"tenant": "a"}))
+
+					ctrl.Finish()
+				})
+			})
+
 			When("There are no messages on the queue", func() {
 				It("Should receive no messages", func() {
 					ctrl := gomock.NewController(GinkgoT())
@@ -235,6 +305,9 @@ var _ = Describe("Sqs", func() {
 						MessageAttributeNames: []*string{
 							aws.String(sqs.QueueAttributeNameAll),
 						},
+						AttributeNames: []*string{
+							aws.String(sqs.MessageSystemAttributeNameApproximateReceiveCount),
+						},
 						QueueUrl: queueUrl,
 					}).Times(1).Return(&sqs.ReceiveMessageOutput{
 						Messages: []*sqs.Message{},
@@ -333,5 +406,194 @@ var _ = Describe("Sqs", func() {
 				})
 			})
 		})
+
+		Context("Release", func() {
+			When("The message visibility is changed", func() {
+				It("Should make the task visible again after the delay", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsProvider(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					By("Calling GetResources to get the queue arn")
+					providerMock.EXPECT().GetResources(core.AwsResource_Queue).Return(map[string]string{
+						"test-queue": "arn:aws:sqs:us-east-2:444455556666:test-queue",
+					}, nil)
+
+					By("Calling GetQueueUrl to get the queueurl")
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Setting the visibility timeout to the delay")
+					sqsMock.EXPECT().ChangeMessageVisibility(&sqs.ChangeMessageVisibilityInput{
+						QueueUrl:          queueUrl,
+						ReceiptHandle:     aws.String("lease-id"),
+						VisibilityTimeout: aws.Int64(30),
+					}).Times(1).Return(&sqs.ChangeMessageVisibilityOutput{}, nil)
+
+					err := plugin.Release("test-queue", "lease-id", 30*time.Second)
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					ctrl.Finish()
+				})
+			})
+		})
+
+		Context("CompleteBatch", func() {
+			When("Completing more leases than fit in a single request", func() {
+				It("Should delete the messages in batches and return the failed leases", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsProvider(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					providerMock.EXPECT().GetResources(core.AwsResource_Queue).Return(map[string]string{
+						"test-queue": "arn:aws:sqs:us-east-2:444455556666:test-queue",
+					}, nil)
+
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					leaseIds := make([]string, 0, 12)
+					for i := 0; i < 12; i++ {
+						leaseIds = append(leaseIds, fmt.Sprintf("lease-%d", i))
+					}
+
+					By("Deleting the first 10 messages")
+					sqsMock.EXPECT().DeleteMessageBatch(gomock.Any()).DoAndReturn(func(in *sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error) {
+						Expect(in.Entries).To(HaveLen(10))
+						return &sqs.DeleteMessageBatchOutput{}, nil
+					})
+
+					By("Deleting the remaining messages")
+					sqsMock.EXPECT().DeleteMessageBatch(gomock.Any()).DoAndReturn(func(in *sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error) {
+						Expect(in.Entries).To(HaveLen(2))
+						Expect(*in.Entries[1].ReceiptHandle).To(Equal("lease-11"))
+						return &sqs.DeleteMessageBatchOutput{
+							Failed: []*sqs.BatchResultErrorEntry{
+								{
+									Id:      in.Entries[1].Id,
+									Message: aws.String("receipt handle is invalid"),
+								},
+							},
+						}, nil
+					})
+
+					resp, err := plugin.CompleteBatch("test-queue", leaseIds)
+
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("Returning the failed lease")
+					Expect(resp.FailedLeases).To(Equal([]*queue.FailedLease{
+						{
+							LeaseID: "lease-11",
+							Message: "receipt handle is invalid",
+						},
+					}))
+
+					ctrl.Finish()
+				})
+			})
+		})
+	})
+
+	Context("GetQueueStats", func() {
+		When("the queue exists", func() {
+			It("Should return the queue attributes and the age of the oldest message", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+				providerMock := mock_provider.NewMockAwsProvider(ctrl)
+				metrics := &mockCloudWatch{
+					datapoints: []*cloudwatch.Datapoint{
+						{Timestamp: aws.Time(time.Now().Add(-2 * time.Minute)), Maximum: aws.Float64(30)},
+						{Timestamp: aws.Time(time.Now().Add(-time.Minute)), Maximum: aws.Float64(45)},
+					},
+				}
+				plugin := NewWithClients(providerMock, sqsMock, metrics)
+
+				queueUrl := aws.String("https://sqs.us-east-2.amazonaws.com/444455556666/test-queue")
+
+				providerMock.EXPECT().GetResources(core.AwsResource_Queue).Return(map[string]string{
+					"test": "arn:aws:sqs:us-east-2:444455556666:test-queue",
+				}, nil)
+
+				sqsMock.EXPECT().GetQueueUrl(gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+					QueueUrl: queueUrl,
+				}, nil)
+
+				sqsMock.EXPECT().GetQueueAttributes(gomock.Any()).Times(1).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						sqs.QueueAttributeNameApproximateNumberOfMessages:           aws.String("12"),
+						sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: aws.String("3"),
+					},
+				}, nil)
+
+				stats, err := plugin.GetQueueStats("test")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the approximate message counts")
+				Expect(stats.Depth).To(Equal(int64(12)))
+				Expect(stats.InFlight).To(Equal(int64(3)))
+
+				By("Returning the latest age of the oldest message")
+				Expect(*metrics.input.Dimensions[0].Value).To(Equal("test-queue"))
+				Expect(stats.OldestTaskAge).To(Equal(45 * time.Second))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Provision", func() {
+		When("the queue doesn't exist", func() {
+			It("Should create the queue with the stack's tags", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+				providerMock := mock_provider.NewMockAwsProvider(ctrl)
+				plugin := NewWithClient(providerMock, sqsMock)
+
+				queueUrl := aws.String("https://sqs.us-east-2.amazonaws.com/444455556666/shop-test-jobs")
+
+				providerMock.EXPECT().GetResources(core.AwsResource_Queue).Return(map[string]string{}, nil)
+				sqsMock.EXPECT().CreateQueue(&sqs.CreateQueueInput{
+					QueueName: aws.String("shop-test-jobs"),
+					Tags: map[string]*string{
+						"x-nitric-name":        aws.String("jobs"),
+						"x-nitric-stack":       aws.String("shop"),
+						"x-nitric-environment": aws.String("test"),
+					},
+				}).Return(&sqs.CreateQueueOutput{QueueUrl: queueUrl}, nil)
+				sqsMock.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						sqs.QueueAttributeNameQueueArn: aws.String("arn:aws:sqs:us-east-2:444455556666:shop-test-jobs"),
+					},
+				}, nil)
+				providerMock.EXPECT().AddResource(core.AwsResource_Queue, "jobs", "arn:aws:sqs:us-east-2:444455556666:shop-test-jobs")
+
+				err := plugin.(resources.Provisioner).Provision("jobs", &stack.Identity{Name: "shop", Environment: "test"})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
 	})
 })
+
+type mockCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	input      *cloudwatch.GetMetricStatisticsInput
+	datapoints []*cloudwatch.Datapoint
+}
+
+func (m *mockCloudWatch) GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	m.input = input
+	return &cloudwatch.GetMetricStatisticsOutput{
+		Datapoints: m.datapoints,
+	}, nil
+}
@@ -26,4 +26,37 @@ type NitricTask struct {
 	LeaseID     string                 `json:"leaseId,omitempty" log:"LeaseID"`
 	PayloadType string                 `json:"payloadType,omitempty" log:"PayLoadType"`
 	Payload     map[string]interface{} `json:"payload,omitempty"`
+	// Attributes - metadata consumers can filter received tasks by, carried in the task body
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// ProviderID - the provider's ID for the message a received task was sent in, the same each time it's received
+	ProviderID string `json:"-"`
+	// ReceiveCount - the number of times a received task has been received, including this time. Zero if the provider doesn't report it
+	ReceiveCount int `json:"-"`
+}
+
+// HasAttributes - returns true if the task has every one of the attributes, empty attributes match any task
+func (t *NitricTask) HasAttributes(attributes map[string]string) bool {
+	for k, v := range attributes {
+		if tv, ok := t.Attributes[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterTasks - splits received tasks into those with every one of the attributes and those without
+func FilterTasks(tasks []NitricTask, attributes map[string]string) ([]NitricTask, []NitricTask) {
+	matched := make([]NitricTask, 0, len(tasks))
+	unmatched := make([]NitricTask, 0)
+
+	for _, t := range tasks {
+		if t.HasAttributes(attributes) {
+			matched = append(matched, t)
+		} else {
+			unmatched = append(unmatched, t)
+		}
+	}
+
+	return matched, unmatched
 }
@@ -0,0 +1,380 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algolia_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// defaultLimit - the number of results returned when a query doesn't set a limit
+const defaultLimit = 10
+
+// hitMetadata - attributes Algolia adds to hits that aren't part of the indexed document
+var hitMetadata = []string{"objectID", "_highlightResult", "_snippetResult", "_rankingInfo", "_distinctSeqID"}
+
+// AlgoliaService - search plugin for Algolia.
+//
+// Filtered and faceted attributes must be declared in the index's attributesForFaceting setting.
+// Algolia ranks hits without scoring them, so hits are returned in rank order with a zero score.
+type AlgoliaService struct {
+	search.UnimplementedSearchPlugin
+	appId     string
+	apiKey    string
+	prefix    string
+	readHost  string
+	writeHost string
+	client    *http.Client
+}
+
+func (s *AlgoliaService) objectUrl(host string, index string, path ...string) string {
+	segments := append([]string{host, "1", "indexes", url.PathEscape(s.prefix + index)}, path...)
+	return strings.Join(segments, "/")
+}
+
+// do - sends a request to Algolia, returning the response body and status code
+func (s *AlgoliaService) do(method string, reqUrl string, body interface{}) ([]byte, int, error) {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, reqUrl, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req.Header.Set("X-Algolia-Application-Id", s.appId)
+	req.Header.Set("X-Algolia-API-Key", s.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// statusError - returns an error describing an unsuccessful response from Algolia
+func statusError(status int, body []byte) error {
+	apiErr := struct {
+		Message string `json:"message"`
+	}{}
+
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		return fmt.Errorf("%d: %s", status, apiErr.Message)
+	}
+
+	return fmt.Errorf("unexpected response status %d", status)
+}
+
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+func (s *AlgoliaService) Index(index string, id string, content map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"AlgoliaService.Index",
+		map[string]interface{}{
+			"index": index,
+			"id":    id,
+		},
+	)
+
+	if index == "" || id == "" {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and id",
+			nil,
+		)
+	}
+
+	body, status, err := s.do(http.MethodPut, s.objectUrl(s.writeHost, index, url.PathEscape(id)), content)
+	if err != nil {
+		return newErr(
+			codes.Unavailable,
+			"error indexing document",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return newErr(
+			statusCode(status),
+			"error indexing document",
+			statusError(status, body),
+		)
+	}
+
+	return nil
+}
+
+func (s *AlgoliaService) Delete(index string, id string) error {
+	newErr := errors.ErrorsWithScope(
+		"AlgoliaService.Delete",
+		map[string]interface{}{
+			"index": index,
+			"id":    id,
+		},
+	)
+
+	if index == "" || id == "" {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and id",
+			nil,
+		)
+	}
+
+	body, status, err := s.do(http.MethodDelete, s.objectUrl(s.writeHost, index, url.PathEscape(id)), nil)
+	if err != nil {
+		return newErr(
+			codes.Unavailable,
+			"error deleting document",
+			err,
+		)
+	}
+
+	if status >= 300 && status != http.StatusNotFound {
+		return newErr(
+			statusCode(status),
+			"error deleting document",
+			statusError(status, body),
+		)
+	}
+
+	return nil
+}
+
+// filterExpression - returns the Algolia filters expression matching all of the given filters
+func filterExpression(filters []search.Filter) (string, error) {
+	clauses := make([]string, 0, len(filters))
+	for _, f := range filters {
+		switch v := f.Value.(type) {
+		case string:
+			clauses = append(clauses, fmt.Sprintf("%s:%s", strconv.Quote(f.Field), strconv.Quote(v)))
+		case bool:
+			clauses = append(clauses, fmt.Sprintf("%s:%t", strconv.Quote(f.Field), v))
+		case float64, float32, int, int32, int64:
+			clauses = append(clauses, fmt.Sprintf("%s = %v", strconv.Quote(f.Field), v))
+		default:
+			return "", fmt.Errorf("unsupported filter value %v for field %s, expected string, number or boolean", f.Value, f.Field)
+		}
+	}
+
+	return strings.Join(clauses, " AND "), nil
+}
+
+type queryResponse struct {
+	Hits   []map[string]interface{}    `json:"hits"`
+	NbHits int64                       `json:"nbHits"`
+	Page   int                         `json:"page"`
+	Pages  int                         `json:"nbPages"`
+	Facets map[string]map[string]int64 `json:"facets"`
+}
+
+func (s *AlgoliaService) Query(index string, query *search.Query) (*search.QueryResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"AlgoliaService.Query",
+		map[string]interface{}{
+			"index": index,
+			"query": query,
+		},
+	)
+
+	if index == "" || query == nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and query",
+			nil,
+		)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	page := 0
+	if query.PagingToken != "" {
+		var err error
+		if page, err = strconv.Atoi(query.PagingToken); err != nil || page < 0 {
+			return nil, newErr(
+				codes.InvalidArgument,
+				"invalid paging token",
+				err,
+			)
+		}
+	}
+
+	filters, err := filterExpression(query.Filters)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"invalid filter",
+			err,
+		)
+	}
+
+	req := map[string]interface{}{
+		"query":       query.Text,
+		"hitsPerPage": limit,
+		"page":        page,
+	}
+	if filters != "" {
+		req["filters"] = filters
+	}
+	if len(query.Facets) > 0 {
+		req["facets"] = query.Facets
+	}
+
+	body, status, err := s.do(http.MethodPost, s.objectUrl(s.readHost, index, "query"), req)
+	if err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"error querying index",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return nil, newErr(
+			statusCode(status),
+			"error querying index",
+			statusError(status, body),
+		)
+	}
+
+	resp := &queryResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading query results",
+			err,
+		)
+	}
+
+	result := &search.QueryResult{
+		Hits:   make([]*search.Hit, 0, len(resp.Hits)),
+		Total:  resp.NbHits,
+		Facets: make(map[string][]*search.FacetValue, len(query.Facets)),
+	}
+
+	for _, h := range resp.Hits {
+		id, _ := h["objectID"].(string)
+		for _, attr := range hitMetadata {
+			delete(h, attr)
+		}
+
+		result.Hits = append(result.Hits, &search.Hit{
+			Id:      id,
+			Content: h,
+		})
+	}
+
+	for _, facet := range query.Facets {
+		values := make([]*search.FacetValue, 0, len(resp.Facets[facet]))
+		for value, count := range resp.Facets[facet] {
+			values = append(values, &search.FacetValue{
+				Value: value,
+				Count: count,
+			})
+		}
+
+		// Match the most frequent first ordering of other providers
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].Count != values[j].Count {
+				return values[i].Count > values[j].Count
+			}
+			return values[i].Value < values[j].Value
+		})
+		result.Facets[facet] = values
+	}
+
+	if resp.Page+1 < resp.Pages {
+		result.PagingToken = strconv.Itoa(resp.Page + 1)
+	}
+
+	return result, nil
+}
+
+// New - creates a new Algolia search plugin for the application ALGOLIA_APP_ID
+func New() (search.SearchService, error) {
+	appId := utils.GetEnv("ALGOLIA_APP_ID", "")
+	apiKey := utils.GetEnv("ALGOLIA_API_KEY", "")
+	if appId == "" || apiKey == "" {
+		return nil, fmt.Errorf("ALGOLIA_APP_ID and ALGOLIA_API_KEY env vars are required to connect to Algolia")
+	}
+
+	return NewWithClient(appId, apiKey, utils.GetEnv("SEARCH_INDEX_PREFIX", ""), "", &http.Client{Timeout: 30 * time.Second}), nil
+}
+
+// NewWithClient - creates a new Algolia search plugin using the given http client.
+// Requests are sent to host when it's set, otherwise to the application's default Algolia hosts.
+func NewWithClient(appId string, apiKey string, prefix string, host string, client *http.Client) *AlgoliaService {
+	readHost := fmt.Sprintf("https://%s-dsn.algolia.net", appId)
+	writeHost := fmt.Sprintf("https://%s.algolia.net", appId)
+	if host != "" {
+		readHost = strings.TrimSuffix(host, "/")
+		writeHost = readHost
+	}
+
+	return &AlgoliaService{
+		appId:     appId,
+		apiKey:    apiKey,
+		prefix:    prefix,
+		readHost:  readHost,
+		writeHost: writeHost,
+		client:    client,
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algolia_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	algolia_service "github.com/nitrictech/nitric/pkg/plugins/search/algolia"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+	header http.Header
+}
+
+// newAlgolia - starts a fake Algolia host responding to every request with status and body, recording the requests it receives
+func newAlgolia(status int, body string) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.EscapedPath(), header: r.Header}
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("Algolia", func() {
+	Context("Index", func() {
+		When("the document is accepted", func() {
+			It("should save the object with its id", func() {
+				server, requests := newAlgolia(http.StatusOK, `{"objectID":"p1"}`)
+				defer server.Close()
+
+				s := algolia_service.NewWithClient("APP", "KEY", "app-", server.URL, server.Client())
+				err := s.Index("products", "p1", map[string]interface{}{"name": "Widget"})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect((*requests)[0].method).To(Equal(http.MethodPut))
+				Expect((*requests)[0].path).To(Equal("/1/indexes/app-products/p1"))
+				Expect((*requests)[0].header.Get("X-Algolia-Application-Id")).To(Equal("APP"))
+				Expect((*requests)[0].header.Get("X-Algolia-API-Key")).To(Equal("KEY"))
+			})
+		})
+
+		When("the api key is invalid", func() {
+			It("should return a permission denied error", func() {
+				server, _ := newAlgolia(http.StatusForbidden, `{"message":"Invalid Application-ID or API key","status":403}`)
+				defer server.Close()
+
+				err := algolia_service.NewWithClient("APP", "KEY", "", server.URL, server.Client()).Index("products", "p1", map[string]interface{}{})
+				Expect(errors.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(err.Error()).To(ContainSubstring("Invalid Application-ID"))
+			})
+		})
+	})
+
+	Context("Delete", func() {
+		It("should delete the object", func() {
+			server, requests := newAlgolia(http.StatusOK, `{}`)
+			defer server.Close()
+
+			err := algolia_service.NewWithClient("APP", "KEY", "", server.URL, server.Client()).Delete("products", "p1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect((*requests)[0].method).To(Equal(http.MethodDelete))
+			Expect((*requests)[0].path).To(Equal("/1/indexes/products/p1"))
+		})
+	})
+
+	Context("Query", func() {
+		When("querying with text, filters and facets", func() {
+			server, requests := newAlgolia(http.StatusOK, `{
+				"hits": [
+					{"objectID": "p1", "name": "Red Widget", "color": "red", "_highlightResult": {}}
+				],
+				"nbHits": 3,
+				"page": 0,
+				"nbPages": 3,
+				"facets": {"color": {"blue": 1, "red": 2}}
+			}`)
+
+			s := algolia_service.NewWithClient("APP", "KEY", "", server.URL, server.Client())
+			result, err := s.Query("products", &search.Query{
+				Text: "widget",
+				Filters: []search.Filter{
+					{Field: "color", Value: "red"},
+					{Field: "inStock", Value: true},
+					{Field: "price", Value: float64(10)},
+				},
+				Facets: []string{"color"},
+				Limit:  1,
+			})
+			server.Close()
+
+			It("should query the index", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect((*requests)[0].path).To(Equal("/1/indexes/products/query"))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{
+					"query":       "widget",
+					"hitsPerPage": float64(1),
+					"page":        float64(0),
+					"filters":     `"color":"red" AND "inStock":true AND "price" = 10`,
+					"facets":      []interface{}{"color"},
+				}))
+			})
+
+			It("should return the hits without Algolia metadata", func() {
+				Expect(result.Total).To(Equal(int64(3)))
+				Expect(result.Hits).To(HaveLen(1))
+				Expect(result.Hits[0].Id).To(Equal("p1"))
+				Expect(result.Hits[0].Content).To(Equal(map[string]interface{}{"name": "Red Widget", "color": "red"}))
+			})
+
+			It("should return the facets most frequent first", func() {
+				Expect(result.Facets["color"]).To(Equal([]*search.FacetValue{
+					{Value: "red", Count: 2},
+					{Value: "blue", Count: 1},
+				}))
+			})
+
+			It("should return a token for the next page", func() {
+				Expect(result.PagingToken).To(Equal("1"))
+			})
+		})
+
+		When("given an unsupported filter value", func() {
+			It("should return an invalid argument error", func() {
+				_, err := algolia_service.NewWithClient("APP", "KEY", "", "http://localhost", http.DefaultClient).Query("products", &search.Query{
+					Filters: []search.Filter{{Field: "tags", Value: []interface{}{"a"}}},
+				})
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+})
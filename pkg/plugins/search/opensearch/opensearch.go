@@ -0,0 +1,555 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearch_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	// defaultLimit - the number of results returned when a query doesn't set a limit
+	defaultLimit = 10
+	// maxFacetValues - the number of most frequent values returned for each facet
+	maxFacetValues = 100
+)
+
+// OpenSearchService - search plugin for OpenSearch and Elasticsearch clusters, using their common REST API.
+//
+// Nitric indexes are stored in cluster indices of the same name, prefixed with the configured index prefix.
+// Filters and facets on string fields should use keyword fields, e.g. <field>.keyword with the default dynamic mapping.
+type OpenSearchService struct {
+	search.UnimplementedSearchPlugin
+	endpoint string
+	prefix   string
+	client   *http.Client
+	username string
+	password string
+	// credentials secret, used instead of the username and password when set
+	credentials *secret.Credentials
+	signer      *v4.Signer
+	region      string
+}
+
+type OpenSearchOption interface {
+	Apply(*OpenSearchService)
+}
+
+type withBasicAuth struct {
+	username string
+	password string
+}
+
+func (w *withBasicAuth) Apply(s *OpenSearchService) {
+	s.username = w.username
+	s.password = w.password
+}
+
+// WithBasicAuth - authenticates requests to the cluster with a username and password
+func WithBasicAuth(username string, password string) OpenSearchOption {
+	return &withBasicAuth{
+		username: username,
+		password: password,
+	}
+}
+
+type withCredentials struct {
+	credentials *secret.Credentials
+}
+
+func (w *withCredentials) Apply(s *OpenSearchService) {
+	s.credentials = w.credentials
+}
+
+// WithCredentials - authenticates requests to the cluster with the username and password in a credentials secret,
+// a JSON object with username and password properties, using the latest value as it's refreshed
+func WithCredentials(credentials *secret.Credentials) OpenSearchOption {
+	return &withCredentials{
+		credentials: credentials,
+	}
+}
+
+// basicAuth - the credentials stored in a credentials secret
+type basicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// basicAuth - returns the username and password requests are authenticated with, if any
+func (s *OpenSearchService) basicAuth() (string, string, error) {
+	if s.credentials == nil {
+		return s.username, s.password, nil
+	}
+
+	auth := &basicAuth{}
+	if err := json.Unmarshal(s.credentials.Value(), auth); err != nil {
+		return "", "", fmt.Errorf("invalid search credentials, expected a JSON object with username and password: %v", err)
+	}
+
+	return auth.Username, auth.Password, nil
+}
+
+type withAwsSigning struct {
+	signer *v4.Signer
+	region string
+}
+
+func (w *withAwsSigning) Apply(s *OpenSearchService) {
+	s.signer = w.signer
+	s.region = w.region
+}
+
+// WithAwsSigning - signs requests to an Amazon OpenSearch Service domain with the given signer
+func WithAwsSigning(signer *v4.Signer, region string) OpenSearchOption {
+	return &withAwsSigning{
+		signer: signer,
+		region: region,
+	}
+}
+
+type withIndexPrefix string
+
+func (w withIndexPrefix) Apply(s *OpenSearchService) {
+	s.prefix = string(w)
+}
+
+// WithIndexPrefix - prefixes the names of cluster indices, so clusters can be shared by several applications
+func WithIndexPrefix(prefix string) OpenSearchOption {
+	return withIndexPrefix(prefix)
+}
+
+func (s *OpenSearchService) indexUrl(index string, path ...string) string {
+	segments := append([]string{url.PathEscape(s.prefix + index)}, path...)
+	return s.endpoint + "/" + strings.Join(segments, "/")
+}
+
+// do - sends a request to the cluster, returning the response body and status code
+func (s *OpenSearchService) do(method string, reqUrl string, body interface{}) ([]byte, int, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	req, err := http.NewRequest(method, reqUrl, bytes.NewReader(payload))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	username, password, err := s.basicAuth()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if username != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	if s.signer != nil {
+		if _, err := s.signer.Sign(req, bytes.NewReader(payload), "es", s.region, time.Now()); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// statusError - returns an error describing an unsuccessful response from the cluster
+func statusError(status int, body []byte) error {
+	apiErr := struct {
+		Error struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}{}
+
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Error.Reason != "" {
+		return fmt.Errorf("%d %s: %s", status, apiErr.Error.Type, apiErr.Error.Reason)
+	}
+
+	return fmt.Errorf("unexpected response status %d", status)
+}
+
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+func (s *OpenSearchService) Index(index string, id string, content map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope(
+		"OpenSearchService.Index",
+		map[string]interface{}{
+			"index": index,
+			"id":    id,
+		},
+	)
+
+	if index == "" || id == "" {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and id",
+			nil,
+		)
+	}
+
+	body, status, err := s.do(http.MethodPut, s.indexUrl(index, "_doc", url.PathEscape(id)), content)
+	if err != nil {
+		return newErr(
+			codes.Unavailable,
+			"error indexing document",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return newErr(
+			statusCode(status),
+			"error indexing document",
+			statusError(status, body),
+		)
+	}
+
+	return nil
+}
+
+func (s *OpenSearchService) Delete(index string, id string) error {
+	newErr := errors.ErrorsWithScope(
+		"OpenSearchService.Delete",
+		map[string]interface{}{
+			"index": index,
+			"id":    id,
+		},
+	)
+
+	if index == "" || id == "" {
+		return newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and id",
+			nil,
+		)
+	}
+
+	body, status, err := s.do(http.MethodDelete, s.indexUrl(index, "_doc", url.PathEscape(id)), nil)
+	if err != nil {
+		return newErr(
+			codes.Unavailable,
+			"error deleting document",
+			err,
+		)
+	}
+
+	// Deleting a document that was never indexed isn't an error
+	if status >= 300 && status != http.StatusNotFound {
+		return newErr(
+			statusCode(status),
+			"error deleting document",
+			statusError(status, body),
+		)
+	}
+
+	return nil
+}
+
+// searchRequest - builds the body of a search request for a query, starting from the offset
+func searchRequest(query *search.Query, offset int, limit int) map[string]interface{} {
+	var must interface{} = map[string]interface{}{"match_all": map[string]interface{}{}}
+	if query.Text != "" {
+		must = map[string]interface{}{
+			"simple_query_string": map[string]interface{}{
+				"query":            query.Text,
+				"default_operator": "and",
+			},
+		}
+	}
+
+	filters := make([]interface{}, 0, len(query.Filters))
+	for _, f := range query.Filters {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{
+				f.Field: f.Value,
+			},
+		})
+	}
+
+	req := map[string]interface{}{
+		"from":             offset,
+		"size":             limit,
+		"track_total_hits": true,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   must,
+				"filter": filters,
+			},
+		},
+	}
+
+	if len(query.Facets) > 0 {
+		aggs := make(map[string]interface{}, len(query.Facets))
+		for _, facet := range query.Facets {
+			aggs[facet] = map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": facet,
+					"size":  maxFacetValues,
+				},
+			}
+		}
+		req["aggs"] = aggs
+	}
+
+	return req
+}
+
+type searchResponse struct {
+	Hits struct {
+		// Total - an object on Elasticsearch 7+ and OpenSearch, a number on earlier versions
+		Total json.RawMessage `json:"total"`
+		Hits  []struct {
+			Id     string                 `json:"_id"`
+			Score  *float64               `json:"_score"`
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]struct {
+		Buckets []struct {
+			Key         interface{} `json:"key"`
+			KeyAsString string      `json:"key_as_string"`
+			DocCount    int64       `json:"doc_count"`
+		} `json:"buckets"`
+	} `json:"aggregations"`
+}
+
+func (r *searchResponse) total() (int64, error) {
+	total := struct {
+		Value int64 `json:"value"`
+	}{}
+	if err := json.Unmarshal(r.Hits.Total, &total); err == nil {
+		return total.Value, nil
+	}
+
+	var count int64
+	if err := json.Unmarshal(r.Hits.Total, &count); err != nil {
+		return 0, fmt.Errorf("unexpected hit total %s", string(r.Hits.Total))
+	}
+
+	return count, nil
+}
+
+func (s *OpenSearchService) Query(index string, query *search.Query) (*search.QueryResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"OpenSearchService.Query",
+		map[string]interface{}{
+			"index": index,
+			"query": query,
+		},
+	)
+
+	if index == "" || query == nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-blank index and query",
+			nil,
+		)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	offset := 0
+	if query.PagingToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(query.PagingToken); err != nil || offset < 0 {
+			return nil, newErr(
+				codes.InvalidArgument,
+				"invalid paging token",
+				err,
+			)
+		}
+	}
+
+	body, status, err := s.do(http.MethodPost, s.indexUrl(index, "_search"), searchRequest(query, offset, limit))
+	if err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"error querying index",
+			err,
+		)
+	}
+
+	if status >= 300 {
+		return nil, newErr(
+			statusCode(status),
+			"error querying index",
+			statusError(status, body),
+		)
+	}
+
+	resp := &searchResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading query results",
+			err,
+		)
+	}
+
+	total, err := resp.total()
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading query results",
+			err,
+		)
+	}
+
+	result := &search.QueryResult{
+		Hits:   make([]*search.Hit, 0, len(resp.Hits.Hits)),
+		Total:  total,
+		Facets: make(map[string][]*search.FacetValue, len(query.Facets)),
+	}
+
+	for _, h := range resp.Hits.Hits {
+		hit := &search.Hit{
+			Id:      h.Id,
+			Content: h.Source,
+		}
+		if h.Score != nil {
+			hit.Score = *h.Score
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+
+	for _, facet := range query.Facets {
+		values := make([]*search.FacetValue, 0)
+		for _, b := range resp.Aggregations[facet].Buckets {
+			value := b.KeyAsString
+			if value == "" {
+				value = fmt.Sprint(b.Key)
+			}
+			values = append(values, &search.FacetValue{
+				Value: value,
+				Count: b.DocCount,
+			})
+		}
+		result.Facets[facet] = values
+	}
+
+	if next := offset + len(resp.Hits.Hits); len(resp.Hits.Hits) > 0 && int64(next) < total {
+		result.PagingToken = strconv.Itoa(next)
+	}
+
+	return result, nil
+}
+
+// New - creates a new OpenSearch/Elasticsearch search plugin, connecting to the cluster at SEARCH_URL.
+// The secret plugin is used to access SEARCH_CREDENTIALS_SECRET, if it's set
+func New(secretPlugin secret.SecretService, opts ...OpenSearchOption) (search.SearchService, error) {
+	endpoint := utils.GetEnv("SEARCH_URL", "")
+	if endpoint == "" {
+		return nil, fmt.Errorf("SEARCH_URL env var is required to connect to a search cluster")
+	}
+
+	if name := utils.GetEnv("SEARCH_CREDENTIALS_SECRET", ""); name != "" {
+		refresh, err := secret.CredentialsRefreshFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		credentials, err := secret.NewCredentials(secretPlugin, name, refresh)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append([]OpenSearchOption{WithCredentials(credentials)}, opts...)
+	} else if username := utils.GetEnv("SEARCH_USERNAME", ""); username != "" {
+		opts = append([]OpenSearchOption{WithBasicAuth(username, utils.GetEnv("SEARCH_PASSWORD", ""))}, opts...)
+	}
+
+	if prefix := utils.GetEnv("SEARCH_INDEX_PREFIX", ""); prefix != "" {
+		opts = append([]OpenSearchOption{WithIndexPrefix(prefix)}, opts...)
+	}
+
+	return NewWithClient(endpoint, &http.Client{Timeout: 30 * time.Second}, opts...), nil
+}
+
+// NewAws - creates a new search plugin for an Amazon OpenSearch Service domain at SEARCH_URL, signing requests with the
+// credentials of the current session
+func NewAws(secretPlugin secret.SecretService) (search.SearchService, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(secretPlugin, WithAwsSigning(v4.NewSigner(sess.Config.Credentials), utils.GetEnv("AWS_REGION", "us-east-1")))
+}
+
+// NewWithClient - creates a new search plugin for the cluster at endpoint, using the given http client
+func NewWithClient(endpoint string, client *http.Client, opts ...OpenSearchOption) *OpenSearchService {
+	s := &OpenSearchService{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   client,
+	}
+
+	for _, o := range opts {
+		o.Apply(s)
+	}
+
+	return s
+}
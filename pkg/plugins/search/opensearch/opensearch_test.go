@@ -0,0 +1,237 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearch_service_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/search"
+	opensearch_service "github.com/nitrictech/nitric/pkg/plugins/search/opensearch"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+	user   string
+}
+
+// newCluster - starts a fake cluster responding to every request with status and body, recording the requests it receives
+func newCluster(status int, body string) (*httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.EscapedPath()}
+		rec.user, _, _ = r.BasicAuth()
+
+		if b, _ := ioutil.ReadAll(r.Body); len(b) > 0 {
+			_ = json.Unmarshal(b, &rec.body)
+		}
+		requests = append(requests, rec)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+var _ = Describe("OpenSearch", func() {
+	Context("Credentials", func() {
+		It("should authenticate with the latest credentials from the secret", func() {
+			server, requests := newCluster(http.StatusOK, `{"result":"deleted"}`)
+			defer server.Close()
+
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			gomock.InOrder(
+				mockSecret.EXPECT().Access(gomock.Any()).Return(&secret.SecretAccessResponse{
+					Value: []byte(`{"username":"first","password":"secret"}`),
+				}, nil),
+				mockSecret.EXPECT().Access(gomock.Any()).Return(&secret.SecretAccessResponse{
+					Value: []byte(`{"username":"second","password":"secret"}`),
+				}, nil),
+			)
+
+			creds, err := secret.NewCredentials(mockSecret, "search-credentials", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			s := opensearch_service.NewWithClient(server.URL, server.Client(), opensearch_service.WithCredentials(creds))
+			Expect(s.Delete("products", "p1")).To(Succeed())
+
+			Expect(creds.Refresh()).To(Succeed())
+			Expect(s.Delete("products", "p1")).To(Succeed())
+
+			Expect(*requests).To(HaveLen(2))
+			Expect((*requests)[0].user).To(Equal("first"))
+			Expect((*requests)[1].user).To(Equal("second"))
+			ctrl.Finish()
+		})
+	})
+
+	Context("Index", func() {
+		When("the cluster accepts the document", func() {
+			It("should put the document in the prefixed index", func() {
+				server, requests := newCluster(http.StatusCreated, `{"result":"created"}`)
+				defer server.Close()
+
+				s := opensearch_service.NewWithClient(server.URL, server.Client(),
+					opensearch_service.WithIndexPrefix("app-"),
+					opensearch_service.WithBasicAuth("admin", "secret"),
+				)
+
+				err := s.Index("products", "p/1", map[string]interface{}{"name": "Widget"})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(*requests).To(HaveLen(1))
+				Expect((*requests)[0].method).To(Equal(http.MethodPut))
+				Expect((*requests)[0].path).To(Equal("/app-products/_doc/p%2F1"))
+				Expect((*requests)[0].body).To(Equal(map[string]interface{}{"name": "Widget"}))
+				Expect((*requests)[0].user).To(Equal("admin"))
+			})
+		})
+
+		When("the cluster rejects the document", func() {
+			It("should return an invalid argument error", func() {
+				server, _ := newCluster(http.StatusBadRequest, `{"error":{"type":"mapper_parsing_exception","reason":"failed to parse field"}}`)
+				defer server.Close()
+
+				err := opensearch_service.NewWithClient(server.URL, server.Client()).Index("products", "p1", map[string]interface{}{})
+				Expect(err).Should(HaveOccurred())
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(err.Error()).To(ContainSubstring("failed to parse field"))
+			})
+		})
+	})
+
+	Context("Delete", func() {
+		When("the document was never indexed", func() {
+			It("should succeed", func() {
+				server, requests := newCluster(http.StatusNotFound, `{"result":"not_found"}`)
+				defer server.Close()
+
+				err := opensearch_service.NewWithClient(server.URL, server.Client()).Delete("products", "p1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect((*requests)[0].method).To(Equal(http.MethodDelete))
+			})
+		})
+	})
+
+	Context("Query", func() {
+		response := `{
+			"hits": {
+				"total": {"value": 3, "relation": "eq"},
+				"hits": [
+					{"_id": "p1", "_score": 1.5, "_source": {"name": "Red Widget", "color": "red"}},
+					{"_id": "p2", "_score": 0.5, "_source": {"name": "Blue Widget", "color": "blue"}}
+				]
+			},
+			"aggregations": {
+				"color.keyword": {"buckets": [{"key": "red", "doc_count": 2}, {"key": "blue", "doc_count": 1}]}
+			}
+		}`
+
+		When("querying with text, filters and facets", func() {
+			server, requests := newCluster(http.StatusOK, response)
+
+			s := opensearch_service.NewWithClient(server.URL, server.Client())
+			result, err := s.Query("products", &search.Query{
+				Text:    "widget",
+				Filters: []search.Filter{{Field: "inStock", Value: true}},
+				Facets:  []string{"color.keyword"},
+				Limit:   2,
+			})
+			server.Close()
+
+			It("should search the index", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect((*requests)[0].path).To(Equal("/products/_search"))
+
+				body := (*requests)[0].body
+				Expect(body["size"]).To(BeEquivalentTo(2))
+				Expect(body["from"]).To(BeEquivalentTo(0))
+				Expect(body["query"]).To(HaveKeyWithValue("bool", HaveKeyWithValue("filter", ConsistOf(
+					map[string]interface{}{"term": map[string]interface{}{"inStock": true}},
+				))))
+				Expect(body["aggs"]).To(HaveKey("color.keyword"))
+			})
+
+			It("should return the hits", func() {
+				Expect(result.Total).To(Equal(int64(3)))
+				Expect(result.Hits).To(HaveLen(2))
+				Expect(result.Hits[0].Id).To(Equal("p1"))
+				Expect(result.Hits[0].Score).To(Equal(1.5))
+				Expect(result.Hits[0].Content["name"]).To(Equal("Red Widget"))
+			})
+
+			It("should return the facets", func() {
+				Expect(result.Facets["color.keyword"]).To(Equal([]*search.FacetValue{
+					{Value: "red", Count: 2},
+					{Value: "blue", Count: 1},
+				}))
+			})
+
+			It("should return a token for the next page", func() {
+				Expect(result.PagingToken).To(Equal("2"))
+			})
+		})
+
+		When("querying the last page", func() {
+			It("should start from the paging token and return no further token", func() {
+				server, requests := newCluster(http.StatusOK, `{"hits":{"total":3,"hits":[{"_id":"p3","_score":0.1,"_source":{}}]}}`)
+				defer server.Close()
+
+				result, err := opensearch_service.NewWithClient(server.URL, server.Client()).Query("products", &search.Query{
+					Limit:       2,
+					PagingToken: "2",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect((*requests)[0].body["from"]).To(BeEquivalentTo(2))
+				Expect((*requests)[0].body["query"]).To(HaveKeyWithValue("bool", HaveKeyWithValue("must", HaveKey("match_all"))))
+				Expect(result.Total).To(Equal(int64(3)))
+				Expect(result.PagingToken).To(BeEmpty())
+			})
+		})
+
+		When("given an invalid paging token", func() {
+			It("should return an invalid argument error", func() {
+				_, err := opensearch_service.NewWithClient("http://localhost", http.DefaultClient).Query("products", &search.Query{
+					PagingToken: "abc",
+				})
+				Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("the index doesn't exist", func() {
+			It("should return a not found error", func() {
+				server, _ := newCluster(http.StatusNotFound, `{"error":{"type":"index_not_found_exception","reason":"no such index [products]"}}`)
+				defer server.Close()
+
+				_, err := opensearch_service.NewWithClient(server.URL, server.Client()).Query("products", &search.Query{})
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+})
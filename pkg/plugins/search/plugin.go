@@ -0,0 +1,79 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import "fmt"
+
+// Filter - restricts query results to documents where field equals value
+type Filter struct {
+	Field string
+	Value interface{}
+}
+
+// Query - a full-text query against an index
+type Query struct {
+	// Text - the text to search for, blank matches every document
+	Text    string
+	Filters []Filter
+	// Facets - fields to count the distinct values of across all matching documents
+	Facets      []string
+	Limit       int
+	PagingToken string
+}
+
+type Hit struct {
+	Id      string
+	Score   float64
+	Content map[string]interface{}
+}
+
+type FacetValue struct {
+	Value string
+	Count int64
+}
+
+type QueryResult struct {
+	Hits []*Hit
+	// Total - the number of documents matching the query, across all pages
+	Total  int64
+	Facets map[string][]*FacetValue
+	// PagingToken - token for the next page of results, blank when there are no more results
+	PagingToken string
+}
+
+type SearchService interface {
+	// Index - adds a document to an index, replacing any document with the same id
+	Index(index string, id string, content map[string]interface{}) error
+	Delete(index string, id string) error
+	Query(index string, query *Query) (*QueryResult, error)
+}
+
+type UnimplementedSearchPlugin struct {
+	SearchService
+}
+
+var _ SearchService = (*UnimplementedSearchPlugin)(nil)
+
+func (*UnimplementedSearchPlugin) Index(index string, id string, content map[string]interface{}) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSearchPlugin) Delete(index string, id string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSearchPlugin) Query(index string, query *Query) (*QueryResult, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
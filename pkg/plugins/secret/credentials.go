@@ -0,0 +1,139 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// Credentials - The value of a secret holding another plugin's credentials, e.g. a database connection or API key.
+// The latest version is accessed at startup and refreshed periodically, so the credentials can be rotated
+// without restarting the membrane
+type Credentials struct {
+	plugin SecretService
+	secret *Secret
+
+	lock     sync.RWMutex
+	value    []byte
+	onChange []func(value []byte)
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// Value - Returns the latest value of the credentials
+func (c *Credentials) Value() []byte {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.value
+}
+
+// OnChange - Registers a function called with the new value whenever a refresh changes the credentials
+func (c *Credentials) OnChange(fn func(value []byte)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.onChange = append(c.onChange, fn)
+}
+
+// Refresh - Accesses the latest version of the credentials, keeping the current value if it fails
+func (c *Credentials) Refresh() error {
+	resp, err := c.plugin.Access(&SecretVersion{
+		Secret:  c.secret,
+		Version: "latest",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to access credentials secret %s: %v", c.secret.Name, err)
+	}
+
+	c.lock.Lock()
+	changed := c.value != nil && !bytes.Equal(c.value, resp.Value)
+	c.value = resp.Value
+	onChange := c.onChange
+	c.lock.Unlock()
+
+	if changed {
+		for _, fn := range onChange {
+			fn(resp.Value)
+		}
+	}
+
+	return nil
+}
+
+func (c *Credentials) refreshEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			if err := c.Refresh(); err != nil {
+				log.Default().Printf("failed to refresh credentials, using the previous version: %v", err)
+			}
+		}
+	}
+}
+
+// Close - Stops refreshing the credentials
+func (c *Credentials) Close() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+// NewCredentials - Accesses the credentials in the named secret, refreshing them every interval if it's positive
+func NewCredentials(plugin SecretService, name string, interval time.Duration) (*Credentials, error) {
+	if plugin == nil {
+		return nil, fmt.Errorf("a secret plugin is required to access credentials secret %s", name)
+	}
+
+	c := &Credentials{
+		plugin: plugin,
+		secret: &Secret{Name: name},
+		stop:   make(chan struct{}),
+	}
+
+	if err := c.Refresh(); err != nil {
+		return nil, err
+	}
+
+	if interval > 0 {
+		go c.refreshEvery(interval)
+	}
+
+	return c, nil
+}
+
+// CredentialsRefreshFromEnv - Returns how often credentials are refreshed, set by SECRET_CREDENTIALS_REFRESH
+func CredentialsRefreshFromEnv() (time.Duration, error) {
+	refreshEnv := utils.GetEnv("SECRET_CREDENTIALS_REFRESH", "5m")
+
+	refresh, err := time.ParseDuration(refreshEnv)
+	if err != nil || refresh < 0 {
+		return 0, fmt.Errorf("invalid SECRET_CREDENTIALS_REFRESH env var, expected duration e.g. 5m, got %v", refreshEnv)
+	}
+
+	return refresh, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+var _ = Describe("Credentials", func() {
+	latest := &secret.SecretVersion{
+		Secret:  &secret.Secret{Name: "search-credentials"},
+		Version: "latest",
+	}
+
+	When("the secret plugin is missing", func() {
+		It("should return an error", func() {
+			_, err := secret.NewCredentials(nil, "search-credentials", 0)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("the secret can't be accessed", func() {
+		It("should return an error", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			mockSecret.EXPECT().Access(latest).Return(nil, fmt.Errorf("mock-error"))
+
+			_, err := secret.NewCredentials(mockSecret, "search-credentials", 0)
+			Expect(err).Should(HaveOccurred())
+			ctrl.Finish()
+		})
+	})
+
+	When("the secret is rotated", func() {
+		It("should notify listeners of the new value", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			gomock.InOrder(
+				mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("first")}, nil),
+				mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("first")}, nil),
+				mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("second")}, nil),
+			)
+
+			creds, err := secret.NewCredentials(mockSecret, "search-credentials", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(creds.Value()).To(Equal([]byte("first")))
+
+			changes := [][]byte{}
+			creds.OnChange(func(value []byte) {
+				changes = append(changes, value)
+			})
+
+			By("ignoring refreshes of the same value")
+			Expect(creds.Refresh()).To(Succeed())
+			Expect(changes).To(BeEmpty())
+
+			Expect(creds.Refresh()).To(Succeed())
+			Expect(creds.Value()).To(Equal([]byte("second")))
+			Expect(changes).To(Equal([][]byte{[]byte("second")}))
+			ctrl.Finish()
+		})
+	})
+
+	When("a refresh fails", func() {
+		It("should keep the previous value", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			gomock.InOrder(
+				mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("first")}, nil),
+				mockSecret.EXPECT().Access(latest).Return(nil, fmt.Errorf("mock-error")),
+			)
+
+			creds, err := secret.NewCredentials(mockSecret, "search-credentials", 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(creds.Refresh()).ShouldNot(Succeed())
+			Expect(creds.Value()).To(Equal([]byte("first")))
+			ctrl.Finish()
+		})
+	})
+
+	When("refreshing periodically", func() {
+		It("should pick up the latest value", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecret := mock_secret.NewMockSecretService(ctrl)
+			mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("first")}, nil)
+			mockSecret.EXPECT().Access(latest).Return(&secret.SecretAccessResponse{Value: []byte("second")}, nil).MinTimes(1)
+
+			creds, err := secret.NewCredentials(mockSecret, "search-credentials", 10*time.Millisecond)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer creds.Close()
+
+			Eventually(creds.Value).Should(Equal([]byte("second")))
+		})
+	})
+})
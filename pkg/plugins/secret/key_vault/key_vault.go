@@ -23,10 +23,13 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
 	azureutils "github.com/nitrictech/nitric/pkg/providers/azure/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
 	"github.com/nitrictech/nitric/pkg/utils"
 )
 
@@ -39,6 +42,8 @@ type KeyVaultSecretService struct {
 	secret.UnimplementedSecretPlugin
 	client    KeyVaultClient
 	vaultName string
+	// permissions - lists the effective permissions on secrets, created from the environment when it's nil
+	permissions core.PermissionLister
 }
 
 // versionIdFromUrl - Extracts a secret version ID from a full secret version URL
@@ -100,10 +105,19 @@ func (s *KeyVaultSecretService) Put(sec *secret.Secret, val []byte) (*secret.Sec
 	)
 	stringVal := string(val[:])
 
+	name, err := naming.KeyVaultSecrets.Physical(sec.Name)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"secret name collides with another secret",
+			err,
+		)
+	}
+
 	result, err := s.client.SetSecret(
 		context.Background(),
 		fmt.Sprintf("https://%s.vault.azure.net", s.vaultName), // https://myvault.vault.azure.net.
-		sec.Name,
+		name,
 		keyvault.SecretSetParameters{
 			Value: &stringVal,
 		},
@@ -152,10 +166,20 @@ func (s *KeyVaultSecretService) Access(sv *secret.SecretVersion) (*secret.Secret
 	if version == "latest" {
 		version = ""
 	}
+
+	name, err := naming.KeyVaultSecrets.Physical(sv.Secret.Name)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"secret name collides with another secret",
+			err,
+		)
+	}
+
 	result, err := s.client.GetSecret(
 		context.Background(),
 		fmt.Sprintf("https://%s.vault.azure.net", s.vaultName), // https://myvault.vault.azure.net.
-		sv.Secret.Name,
+		name,
 		version,
 	)
 	if err != nil {
@@ -179,6 +203,40 @@ func (s *KeyVaultSecretService) Access(sv *secret.SecretVersion) (*secret.Secret
 	}, nil
 }
 
+// secretDataActions - the data actions needed on a secret for nitric actions
+var secretDataActions = map[resources.Action][]string{
+	resources.SecretAccess: {"Microsoft.KeyVault/vaults/secrets/getSecret/action"},
+	resources.SecretPut:    {"Microsoft.KeyVault/vaults/secrets/setSecret/action"},
+}
+
+// CheckPermissions - checks the data actions needed for the nitric actions against the effective permissions on the secret,
+// which include those granted on its vault
+func (s *KeyVaultSecretService) CheckPermissions(name string, actions []resources.Action) ([]resources.Permission, error) {
+	secretName, err := naming.KeyVaultSecrets.Physical(name)
+	if err != nil {
+		return nil, err
+	}
+
+	lister := s.permissions
+	if lister == nil {
+		if lister, err = core.NewPermissionLister(); err != nil {
+			return nil, err
+		}
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, secretDataActions[a]...)
+	}
+
+	return core.CheckDataActions(lister, core.AzResourceId{
+		Namespace:  "Microsoft.KeyVault",
+		ParentPath: "vaults/" + s.vaultName,
+		Type:       "secrets",
+		Name:       secretName,
+	}, needed)
+}
+
 // New - Creates a new Nitric secret service with Azure Key Vault Provider
 func New() (secret.SecretService, error) {
 	vaultName := utils.GetEnv("KVAULT_NAME", "")
@@ -18,27 +18,70 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
-	secretmanager "cloud.google.com/go/secretmanager/apiv1"
-	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
 	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	ifaces_gcloud_secret "github.com/nitrictech/nitric/pkg/ifaces/gcloud_secret"
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
-	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type secretManagerSecretService struct {
 	secret.UnimplementedSecretPlugin
-	client    ifaces_gcloud_secret.SecretManagerClient
-	projectId string
-	stackName string
+	// provider - creates the client and finds the project on first use, nil if they're already set
+	provider    core.GcpProvider
+	connectOnce sync.Once
+	connectErr  error
+	client      ifaces_gcloud_secret.SecretManagerClient
+	projectId   string
+	stackName   string
+	// secrets are also labelled with their environment, when it's set
+	environment string
+	// resolved secret resource names, by nitric secret name. Guarded by cacheLock, as it's written from concurrent requests
 	cache     map[string]string
+	cacheLock sync.RWMutex
+}
+
+// connect - finds the project and creates the client the first time they're needed, so unused plugins don't slow cold starts
+func (s *secretManagerSecretService) connect() error {
+	s.connectOnce.Do(func() {
+		if s.provider == nil {
+			return
+		}
+
+		if s.projectId, s.connectErr = s.provider.ProjectID(); s.connectErr != nil {
+			return
+		}
+
+		s.client, s.connectErr = s.provider.SecretManagerClient()
+	})
+
+	return s.connectErr
+}
+
+func (s *secretManagerSecretService) cachedName(name string) (string, bool) {
+	s.cacheLock.RLock()
+	defer s.cacheLock.RUnlock()
+
+	resourceName, ok := s.cache[name]
+	return resourceName, ok
+}
+
+func (s *secretManagerSecretService) cacheName(name string, resourceName string) {
+	s.cacheLock.Lock()
+	defer s.cacheLock.Unlock()
+
+	s.cache[name] = resourceName
 }
 
 func validateNewSecret(sec *secret.Secret, val []byte) error {
@@ -68,7 +111,7 @@ func (s *secretManagerSecretService) buildSecretVersionName(sv *secret.SecretVer
 		return "", fmt.Errorf("provide non-blank version")
 	}
 
-	parent, inCache := s.cache[sv.Secret.Name]
+	parent, inCache := s.cachedName(sv.Secret.Name)
 	if !inCache {
 		realSec, err := s.getSecret(sv.Secret)
 		if err != nil {
@@ -81,14 +124,50 @@ func (s *secretManagerSecretService) buildSecretVersionName(sv *secret.SecretVer
 	return fmt.Sprintf("%s/versions/%s", parent, sv.Version), nil
 }
 
+// secretId - returns the ID secrets are expected to be created with, derived from the stack, environment and nitric name of the secret.
+// Secrets are checked by their labels when they're found by ID, so secrets whose IDs collide are never confused
+func (s *secretManagerSecretService) secretId(name string) (string, error) {
+	return naming.GcpSecrets.Physical((&stack.Identity{Name: s.stackName, Environment: s.environment}).Qualify(name))
+}
+
+// isNitricSecret - returns true if a secret is labelled as the secret with the given name in this service's stack and environment
+func (s *secretManagerSecretService) isNitricSecret(sec *secretmanagerpb.Secret, name string) bool {
+	return sec.Labels[stack.NameKey] == name && sec.Labels[stack.StackKey] == s.stackName &&
+		(s.environment == "" || sec.Labels[stack.EnvironmentKey] == s.environment)
+}
+
 // ensure a secret container exists for storing secret versions
 func (s *secretManagerSecretService) getSecret(sec *secret.Secret) (*secretmanagerpb.Secret, error) {
+	id, err := s.secretId(sec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the secret by its expected ID first, listing secrets is slower and consumes list quota
+	result, err := s.client.GetSecret(context.TODO(), &secretmanagerpb.GetSecretRequest{
+		Name: fmt.Sprintf("%s/secrets/%s", s.getParentName(), id),
+	})
+	if err == nil && s.isNitricSecret(result, sec.Name) {
+		s.cacheName(sec.Name, result.Name)
+		return result, nil
+	}
+
+	// Fall back to finding the secret by its labels, in case it was created with a different ID
+	if err != nil && status.Code(err) != grpcCodes.NotFound {
+		return nil, err
+	}
+
+	filter := "labels.x-nitric-name=" + sec.Name + " AND labels.x-nitric-stack=" + s.stackName
+	if s.environment != "" {
+		filter += " AND labels.x-nitric-environment=" + s.environment
+	}
+
 	iter := s.client.ListSecrets(context.TODO(), &secretmanagerpb.ListSecretsRequest{
 		Parent: s.getParentName(),
-		Filter: "labels.x-nitric-name=" + sec.Name + " AND labels.x-nitric-stack=" + s.stackName,
+		Filter: filter,
 	})
 
-	result, err := iter.Next()
+	result, err = iter.Next()
 	if err == iterator.Done {
 		return nil, status.Error(grpcCodes.NotFound, "secret not found")
 	}
@@ -97,11 +176,61 @@ func (s *secretManagerSecretService) getSecret(sec *secret.Secret) (*secretmanag
 		return nil, err
 	}
 
-	s.cache[sec.Name] = result.Name
+	s.cacheName(sec.Name, result.Name)
 
 	return result, nil
 }
 
+// Provision - creates the secret without a version if it doesn't exist, labelled with its nitric name and the stack's labels.
+// Secrets are found by the service's own stack and environment, so they're created with those rather than the given identity
+func (s *secretManagerSecretService) Provision(name string, _ *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"SecretManagerSecretService.Provision",
+		map[string]interface{}{
+			"secret": name,
+		},
+	)
+
+	if err := s.connect(); err != nil {
+		return newErr(codes.Unavailable, "unable to connect to secret manager", err)
+	}
+
+	_, err := s.getSecret(&secret.Secret{Name: name})
+	if err == nil {
+		return nil
+	}
+
+	if status.Code(err) != grpcCodes.NotFound {
+		return newErr(codes.Internal, "error finding secret", err)
+	}
+
+	identity := &stack.Identity{Name: s.stackName, Environment: s.environment}
+	id, err := s.secretId(name)
+	if err != nil {
+		return newErr(codes.AlreadyExists, "secret id collides with another secret", err)
+	}
+
+	result, err := s.client.CreateSecret(context.TODO(), &secretmanagerpb.CreateSecretRequest{
+		Parent:   s.getParentName(),
+		SecretId: id,
+		Secret: &secretmanagerpb.Secret{
+			Labels: resources.Labels(name, identity),
+			Replication: &secretmanagerpb.Replication{
+				Replication: &secretmanagerpb.Replication_Automatic_{
+					Automatic: &secretmanagerpb.Replication_Automatic{},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to create secret", err)
+	}
+
+	s.cacheName(name, result.Name)
+
+	return nil
+}
+
 // Put - Creates a new secret if one doesn't exist, or just adds a new secret version
 func (s *secretManagerSecretService) Put(sec *secret.Secret, val []byte) (*secret.SecretPutResponse, error) {
 	newErr := errors.ErrorsWithScope(
@@ -111,6 +240,14 @@ func (s *secretManagerSecretService) Put(sec *secret.Secret, val []byte) (*secre
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to secret manager",
+			err,
+		)
+	}
+
 	if err := validateNewSecret(sec, val); err != nil {
 		return nil, newErr(
 			codes.InvalidArgument,
@@ -165,6 +302,14 @@ func (s *secretManagerSecretService) Access(sv *secret.SecretVersion) (*secret.S
 		},
 	)
 
+	if err := s.connect(); err != nil {
+		return nil, newErr(
+			codes.Unavailable,
+			"unable to connect to secret manager",
+			err,
+		)
+	}
+
 	fullName, err := s.buildSecretVersionName(sv)
 	if err != nil {
 		return nil, newErr(
@@ -194,24 +339,60 @@ func (s *secretManagerSecretService) Access(sv *secret.SecretVersion) (*secret.S
 	}, nil
 }
 
-// New - Creates a new Nitric secret service with GCP Secret Manager provider
-func New() (secret.SecretService, error) {
-	ctx := context.Background()
+// secretPermissions - the IAM permissions needed on a secret for nitric actions
+var secretPermissions = map[resources.Action][]string{
+	resources.SecretAccess: {"secretmanager.versions.access"},
+	resources.SecretPut:    {"secretmanager.versions.add"},
+}
 
-	credentials, credentialsError := google.FindDefaultCredentials(ctx, secretmanager.DefaultAuthScopes()...)
-	if credentialsError != nil {
-		return nil, fmt.Errorf("GCP credentials error: %v", credentialsError)
+// secretPermissionTester - tests permissions on a single secret with the secret manager client
+type secretPermissionTester struct {
+	client ifaces_gcloud_secret.SecretManagerClient
+	name   string
+}
+
+func (t *secretPermissionTester) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	resp, err := t.client.TestIamPermissions(ctx, &iampb.TestIamPermissionsRequest{
+		Resource:    t.name,
+		Permissions: permissions,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	client, clientError := ifaces_gcloud_secret.NewClient(ctx)
-	if clientError != nil {
-		return nil, fmt.Errorf("secret manager client error: %v", clientError)
+	return resp.Permissions, nil
+}
+
+// CheckPermissions - tests the IAM permissions needed for the nitric actions on the secret
+func (s *secretManagerSecretService) CheckPermissions(name string, actions []resources.Action) ([]resources.Permission, error) {
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+
+	sec, err := s.getSecret(&secret.Secret{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, secretPermissions[a]...)
+	}
+
+	return core.TestPermissions(&secretPermissionTester{client: s.client, name: sec.Name}, sec.Name, needed)
+}
+
+// New - Creates a new Nitric secret service with GCP Secret Manager provider
+func New(provider core.GcpProvider) (secret.SecretService, error) {
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
 	}
 
 	return &secretManagerSecretService{
-		client:    client,
-		projectId: credentials.ProjectID,
-		stackName: utils.GetEnv("NITRIC_STACK", ""),
-		cache:     make(map[string]string),
+		provider:    provider,
+		stackName:   identity.Name,
+		environment: identity.Environment,
+		cache:       make(map[string]string),
 	}, nil
 }
@@ -15,15 +15,26 @@
 package secret_manager_secret_service
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
 
 	"github.com/golang/mock/gomock"
+	gax "github.com/googleapis/gax-go/v2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/api/iterator"
 	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	mocks "github.com/nitrictech/nitric/mocks/gcp_secret"
+	ifaces_gcloud_secret "github.com/nitrictech/nitric/pkg/ifaces/gcloud_secret"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/resources"
 )
 
 var _ = Describe("Secret Manager", func() {
@@ -51,7 +62,15 @@ var _ = Describe("Secret Manager", func() {
 					// Assert all methods are called at least their number of times
 					defer crtl.Finish()
 					// Mocking expects
-					By("calling SecretManagerService.ListSecrets with the expected request")
+					By("calling SecretManagerService.GetSecret with the expected secret name")
+					mockSecretClient.EXPECT().GetSecret(
+						gomock.Any(),
+						&secretmanagerpb.GetSecretRequest{
+							Name: "projects/my-project/secrets/Test",
+						},
+					).Return(nil, status.Error(grpcCodes.NotFound, "secret not found")).Times(1)
+
+					By("falling back to SecretManagerService.ListSecrets with the expected request")
 
 					si := mocks.NewMockSecretIterator(crtl)
 					si.EXPECT().Next().Return(mockSecret, nil)
@@ -86,6 +105,145 @@ var _ = Describe("Secret Manager", func() {
 				})
 			})
 
+			When("Putting a Secret to a secret with the expected ID", func() {
+				crtl := gomock.NewController(GinkgoT())
+				mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+				secretPlugin := &secretManagerSecretService{
+					client:    mockSecretClient,
+					projectId: "my-project",
+					stackName: "my-stack",
+					cache:     make(map[string]string),
+				}
+
+				It("Should get the secret without listing secrets", func() {
+					defer crtl.Finish()
+
+					By("calling SecretManagerService.GetSecret with the ID derived from the stack and secret name")
+					mockSecretClient.EXPECT().GetSecret(
+						gomock.Any(),
+						&secretmanagerpb.GetSecretRequest{
+							Name: "projects/my-project/secrets/my-stack-Test",
+						},
+					).Return(&secretmanagerpb.Secret{
+						Name:   "projects/my-project/secrets/my-stack-Test",
+						Labels: map[string]string{"x-nitric-name": "Test", "x-nitric-stack": "my-stack"},
+					}, nil).Times(1)
+
+					mockSecretClient.EXPECT().AddSecretVersion(
+						gomock.Any(),
+						&secretmanagerpb.AddSecretVersionRequest{
+							Parent: "projects/my-project/secrets/my-stack-Test",
+							Payload: &secretmanagerpb.SecretPayload{
+								Data: []byte("Super Secret Message"),
+							},
+						},
+					).Return(&secretmanagerpb.SecretVersion{
+						Name: "/projects/secrets/my-stack-Test/versions/2",
+					}, nil).Times(1)
+
+					response, err := secretPlugin.Put(&testSecret, testSecretVal)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.SecretVersion.Version).To(Equal("2"))
+					Expect(secretPlugin.cache).To(HaveKeyWithValue("Test", "projects/my-project/secrets/my-stack-Test"))
+				})
+			})
+
+			When("The secret with the expected ID belongs to another stack", func() {
+				crtl := gomock.NewController(GinkgoT())
+				mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+				secretPlugin := &secretManagerSecretService{
+					client:    mockSecretClient,
+					projectId: "my-project",
+					stackName: "my-stack",
+					cache:     make(map[string]string),
+				}
+
+				It("Should fall back to listing secrets by their labels", func() {
+					defer crtl.Finish()
+
+					mockSecretClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(&secretmanagerpb.Secret{
+						Name:   "projects/my-project/secrets/my-stack-Test",
+						Labels: map[string]string{"x-nitric-name": "Test", "x-nitric-stack": "other-stack"},
+					}, nil).Times(1)
+
+					si := mocks.NewMockSecretIterator(crtl)
+					si.EXPECT().Next().Return(&secretmanagerpb.Secret{Name: "projects/my-project/secrets/abc123"}, nil)
+
+					mockSecretClient.EXPECT().ListSecrets(
+						gomock.Any(),
+						&secretmanagerpb.ListSecretsRequest{
+							Parent: "projects/my-project",
+							Filter: "labels.x-nitric-name=Test AND labels.x-nitric-stack=my-stack",
+						},
+					).Return(si).Times(1)
+
+					sec, err := secretPlugin.getSecret(&testSecret)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(sec.Name).To(Equal("projects/my-project/secrets/abc123"))
+				})
+			})
+
+			When("The secret with the expected ID belongs to another environment", func() {
+				crtl := gomock.NewController(GinkgoT())
+				mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+				secretPlugin := &secretManagerSecretService{
+					client:      mockSecretClient,
+					projectId:   "my-project",
+					stackName:   "my-stack",
+					environment: "staging",
+					cache:       make(map[string]string),
+				}
+
+				It("Should fall back to listing the environment's secrets by their labels", func() {
+					defer crtl.Finish()
+
+					mockSecretClient.EXPECT().GetSecret(
+						gomock.Any(),
+						&secretmanagerpb.GetSecretRequest{
+							Name: "projects/my-project/secrets/my-stack-staging-Test",
+						},
+					).Return(&secretmanagerpb.Secret{
+						Name:   "projects/my-project/secrets/my-stack-staging-Test",
+						Labels: map[string]string{"x-nitric-name": "Test", "x-nitric-stack": "my-stack", "x-nitric-environment": "prod"},
+					}, nil).Times(1)
+
+					si := mocks.NewMockSecretIterator(crtl)
+					si.EXPECT().Next().Return(&secretmanagerpb.Secret{Name: "projects/my-project/secrets/abc123"}, nil)
+
+					mockSecretClient.EXPECT().ListSecrets(
+						gomock.Any(),
+						&secretmanagerpb.ListSecretsRequest{
+							Parent: "projects/my-project",
+							Filter: "labels.x-nitric-name=Test AND labels.x-nitric-stack=my-stack AND labels.x-nitric-environment=staging",
+						},
+					).Return(si).Times(1)
+
+					sec, err := secretPlugin.getSecret(&testSecret)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(sec.Name).To(Equal("projects/my-project/secrets/abc123"))
+				})
+			})
+
+			When("Getting the secret with the expected ID fails", func() {
+				crtl := gomock.NewController(GinkgoT())
+				mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+				secretPlugin := &secretManagerSecretService{
+					client:    mockSecretClient,
+					projectId: "my-project",
+					cache:     make(map[string]string),
+				}
+
+				It("Should return the error without listing secrets", func() {
+					defer crtl.Finish()
+
+					mockSecretClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(nil, status.Error(grpcCodes.PermissionDenied, "permission denied")).Times(1)
+
+					_, err := secretPlugin.Put(&testSecret, testSecretVal)
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("permission denied"))
+				})
+			})
+
 			When("Putting a nil secret", func() {
 				secretPlugin := &secretManagerSecretService{
 					projectId: "my-project",
@@ -130,6 +288,41 @@ var _ = Describe("Secret Manager", func() {
 		})
 	})
 
+	When("Putting and accessing secrets concurrently", func() {
+		client := newFakeClient(10, false)
+		secretPlugin := &secretManagerSecretService{
+			client:    client,
+			projectId: "my-project",
+			stackName: "my-stack",
+			cache:     make(map[string]string),
+		}
+
+		It("Should resolve each secret safely", func() {
+			wg := sync.WaitGroup{}
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					sec := &secret.Secret{Name: fmt.Sprintf("secret-%d", i%10)}
+					if i%2 == 0 {
+						_, err := secretPlugin.Put(sec, testSecretVal)
+						Expect(err).ShouldNot(HaveOccurred())
+						return
+					}
+
+					response, err := secretPlugin.Access(&secret.SecretVersion{Secret: sec, Version: "latest"})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.Value).To(HavePrefix("projects/my-project/secrets/"))
+				}(i)
+			}
+			wg.Wait()
+
+			Expect(secretPlugin.cache).To(HaveLen(10))
+		})
+	})
+
 	When("Get", func() {
 		When("Given the Secret Manager backend is available", func() {
 			When("The secret store exists", func() {
@@ -251,4 +444,183 @@ var _ = Describe("Secret Manager", func() {
 			})
 		})
 	})
+
+	When("Provisioning a secret that doesn't exist", func() {
+		crtl := gomock.NewController(GinkgoT())
+		mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+		secretPlugin := &secretManagerSecretService{
+			client:      mockSecretClient,
+			projectId:   "my-project",
+			stackName:   "my-stack",
+			environment: "test",
+			cache:       make(map[string]string),
+		}
+
+		It("Should create the secret with the stack's labels and its expected ID", func() {
+			defer crtl.Finish()
+
+			mockSecretClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(nil, status.Error(grpcCodes.NotFound, "secret not found"))
+			si := mocks.NewMockSecretIterator(crtl)
+			si.EXPECT().Next().Return(nil, iterator.Done)
+			mockSecretClient.EXPECT().ListSecrets(gomock.Any(), gomock.Any()).Return(si)
+
+			mockSecretClient.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *secretmanagerpb.CreateSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+				Expect(req.Parent).To(Equal("projects/my-project"))
+				Expect(req.SecretId).To(Equal("my-stack-test-Test"))
+				Expect(req.Secret.Labels).To(Equal(map[string]string{
+					"x-nitric-name":        "Test",
+					"x-nitric-stack":       "my-stack",
+					"x-nitric-environment": "test",
+				}))
+
+				return &secretmanagerpb.Secret{Name: "projects/my-project/secrets/my-stack-test-Test"}, nil
+			})
+
+			err := secretPlugin.Provision("Test", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			By("caching the created secret")
+			name, ok := secretPlugin.cachedName("Test")
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("projects/my-project/secrets/my-stack-test-Test"))
+		})
+	})
+
+	When("Checking permissions", func() {
+		crtl := gomock.NewController(GinkgoT())
+		mockSecretClient := mocks.NewMockSecretManagerClient(crtl)
+		secretPlugin := &secretManagerSecretService{
+			client:    mockSecretClient,
+			projectId: "my-project",
+			stackName: "my-stack",
+			cache:     make(map[string]string),
+		}
+
+		It("Should test the permissions of the nitric actions on the secret", func() {
+			defer crtl.Finish()
+
+			mockSecretClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(&secretmanagerpb.Secret{
+				Name:   "projects/my-project/secrets/my-stack-Test",
+				Labels: map[string]string{"x-nitric-name": "Test", "x-nitric-stack": "my-stack"},
+			}, nil)
+
+			mockSecretClient.EXPECT().TestIamPermissions(gomock.Any(), &iampb.TestIamPermissionsRequest{
+				Resource:    "projects/my-project/secrets/my-stack-Test",
+				Permissions: []string{"secretmanager.versions.access", "secretmanager.versions.add"},
+			}).Return(&iampb.TestIamPermissionsResponse{
+				Permissions: []string{"secretmanager.versions.access"},
+			}, nil)
+
+			permissions, err := secretPlugin.CheckPermissions("Test", []resources.Action{resources.SecretAccess, resources.SecretPut})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "secretmanager.versions.access on projects/my-project/secrets/my-stack-Test", Granted: true},
+				{Name: "secretmanager.versions.add on projects/my-project/secrets/my-stack-Test", Granted: false},
+			}))
+		})
+	})
 })
+
+// fakeClient - a secret manager client holding secrets, counting the calls made to it.
+// It's safe for concurrent use as long as its secrets aren't modified
+type fakeClient struct {
+	mocks.MockSecretManagerClient
+	secrets []*secretmanagerpb.Secret
+	gets    int64
+	lists   int64
+}
+
+func (c *fakeClient) AddSecretVersion(ctx context.Context, req *secretmanagerpb.AddSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.SecretVersion, error) {
+	return &secretmanagerpb.SecretVersion{Name: req.Parent + "/versions/1"}, nil
+}
+
+func (c *fakeClient) AccessSecretVersion(ctx context.Context, req *secretmanagerpb.AccessSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.AccessSecretVersionResponse, error) {
+	return &secretmanagerpb.AccessSecretVersionResponse{
+		Name:    req.Name,
+		Payload: &secretmanagerpb.SecretPayload{Data: []byte(req.Name)},
+	}, nil
+}
+
+func (c *fakeClient) GetSecret(ctx context.Context, req *secretmanagerpb.GetSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+	atomic.AddInt64(&c.gets, 1)
+	for _, sec := range c.secrets {
+		if sec.Name == req.Name {
+			return sec, nil
+		}
+	}
+	return nil, status.Error(grpcCodes.NotFound, "secret not found")
+}
+
+// ListSecrets - filters secrets by a linear scan, like the list filter does server side
+func (c *fakeClient) ListSecrets(ctx context.Context, req *secretmanagerpb.ListSecretsRequest, opts ...gax.CallOption) ifaces_gcloud_secret.SecretIterator {
+	atomic.AddInt64(&c.lists, 1)
+	matches := make([]*secretmanagerpb.Secret, 0)
+	for _, sec := range c.secrets {
+		if req.Filter == "labels.x-nitric-name="+sec.Labels["x-nitric-name"]+" AND labels.x-nitric-stack="+sec.Labels["x-nitric-stack"] {
+			matches = append(matches, sec)
+		}
+	}
+	return &fakeIterator{secrets: matches}
+}
+
+type fakeIterator struct {
+	secrets []*secretmanagerpb.Secret
+}
+
+func (i *fakeIterator) Next() (*secretmanagerpb.Secret, error) {
+	if len(i.secrets) == 0 {
+		return nil, iterator.Done
+	}
+	sec := i.secrets[0]
+	i.secrets = i.secrets[1:]
+	return sec, nil
+}
+
+// newFakeClient - returns a client holding n secrets named secret-<i> in the stack my-stack
+func newFakeClient(n int, expectedIds bool) *fakeClient {
+	client := &fakeClient{}
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("secret-%d", i)
+		id := "my-stack-" + name
+		if !expectedIds {
+			id = fmt.Sprintf("%x", i*7919)
+		}
+
+		client.secrets = append(client.secrets, &secretmanagerpb.Secret{
+			Name:   "projects/my-project/secrets/" + id,
+			Labels: map[string]string{"x-nitric-name": name, "x-nitric-stack": "my-stack"},
+		})
+	}
+
+	return client
+}
+
+// benchmarkGetSecret - resolves a secret on a cache miss, with secrets named by their expected ID or by a random ID as
+// secrets created outside of nitric may be
+func benchmarkGetSecret(b *testing.B, n int, expectedIds bool) {
+	client := newFakeClient(n, expectedIds)
+
+	secretPlugin := &secretManagerSecretService{
+		client:    client,
+		projectId: "my-project",
+		stackName: "my-stack",
+	}
+	// target the last secret, the worst case for a linear scan
+	sec := &secret.Secret{Name: fmt.Sprintf("secret-%d", n-1)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		secretPlugin.cache = make(map[string]string)
+		if _, err := secretPlugin.getSecret(sec); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(client.gets)/float64(b.N), "gets/op")
+	b.ReportMetric(float64(client.lists)/float64(b.N), "lists/op")
+}
+
+func BenchmarkGetSecretDirect100Secrets(b *testing.B)  { benchmarkGetSecret(b, 100, true) }
+func BenchmarkGetSecretDirect1000Secrets(b *testing.B) { benchmarkGetSecret(b, 1000, true) }
+func BenchmarkGetSecretListed100Secrets(b *testing.B)  { benchmarkGetSecret(b, 100, false) }
+func BenchmarkGetSecretListed1000Secrets(b *testing.B) { benchmarkGetSecret(b, 1000, false) }
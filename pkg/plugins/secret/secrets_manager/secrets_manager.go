@@ -16,24 +16,28 @@ package secrets_manager_secret_service
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	secretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
-	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type secretsManagerSecretService struct {
 	secret.UnimplementedSecretPlugin
 	client   secretsmanageriface.SecretsManagerAPI
 	provider core.AwsProvider
+	// simulates the IAM actions of operations, to check they're allowed without performing them
+	simulator core.PermissionSimulator
 }
 
 func (s *secretsManagerSecretService) validateNewSecret(sec *secret.Secret, val []byte) error {
@@ -162,22 +166,87 @@ func (s *secretsManagerSecretService) Access(sv *secret.SecretVersion) (*secret.
 	}, nil
 }
 
-// Gets a new Secrets Manager Client
-func New(provider core.AwsProvider) (secret.SecretService, error) {
-	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
+// Provision - creates the secret without a value if it doesn't exist, tagged with its nitric name and the stack's labels
+func (s *secretsManagerSecretService) Provision(sec string, identity *stack.Identity) error {
+	newErr := errors.ErrorsWithScope(
+		"SecretManagerSecretService.Provision",
+		map[string]interface{}{
+			"secret": sec,
+		},
+	)
+
+	secrets, err := s.provider.GetResources(core.AwsResource_Secret)
+	if err != nil {
+		return newErr(codes.Internal, "error retrieving secrets list", err)
+	}
+
+	if _, ok := secrets[sec]; ok {
+		return nil
+	}
 
-	sess, sessionError := session.NewSession(&aws.Config{
-		Region: aws.String(awsRegion),
+	tags := make([]*secretsmanager.Tag, 0)
+	for k, v := range resources.Labels(sec, identity) {
+		tags = append(tags, &secretsmanager.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return *tags[i].Key < *tags[j].Key
 	})
 
-	if sessionError != nil {
-		return nil, fmt.Errorf("error creating new AWS session %v", sessionError)
+	name, err := naming.AwsSecrets.Physical(identity.Qualify(sec))
+	if err != nil {
+		return newErr(codes.AlreadyExists, "secret name collides with another secret", err)
+	}
+
+	out, err := s.client.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name: aws.String(name),
+		Tags: tags,
+	})
+	if err != nil {
+		return newErr(codes.Internal, "unable to create secret", err)
+	}
+
+	s.provider.AddResource(core.AwsResource_Secret, sec, *out.ARN)
+
+	return nil
+}
+
+// secretActions - the IAM actions needed on a secret for nitric actions
+var secretActions = map[resources.Action][]string{
+	resources.SecretPut:    {"secretsmanager:PutSecretValue"},
+	resources.SecretAccess: {"secretsmanager:GetSecretValue"},
+}
+
+// CheckPermissions - simulates the IAM actions needed for the nitric actions on the secret
+func (s *secretsManagerSecretService) CheckPermissions(sec string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.simulator == nil {
+		return nil, fmt.Errorf("permissions can't be simulated without an IAM client")
+	}
+
+	secretArn, err := s.getSecretId(sec)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := map[string][]string{}
+	for _, a := range actions {
+		needed[secretArn] = append(needed[secretArn], secretActions[a]...)
+	}
+
+	return core.SimulatePermissions(s.simulator, needed)
+}
+
+// Gets a new Secrets Manager Client
+func New(provider core.AwsProvider) (secret.SecretService, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
 	}
 
 	client := secretsmanager.New(sess)
 
 	return &secretsManagerSecretService{
-		client:   client,
-		provider: provider,
+		client:    client,
+		provider:  provider,
+		simulator: core.NewPermissionSimulator(sess),
 	}, nil
 }
@@ -27,6 +27,7 @@ import (
 	mocks "github.com/nitrictech/nitric/mocks/secrets_manager"
 	"github.com/nitrictech/nitric/pkg/plugins/secret"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 var _ = Describe("Secrets Manager Plugin", func() {
@@ -304,4 +305,33 @@ var _ = Describe("Secrets Manager Plugin", func() {
 			})
 		})
 	})
+
+	When("Provision", func() {
+		When("The secret doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSecretClient := mocks.NewMockSecretsManagerAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			secretPlugin := &secretsManagerSecretService{
+				provider: mockProvider,
+				client:   mockSecretClient,
+			}
+
+			It("Should create the secret without a value", func() {
+				defer ctrl.Finish()
+
+				mockProvider.EXPECT().GetResources(core.AwsResource_Secret).Return(map[string]string{}, nil)
+				mockSecretClient.EXPECT().CreateSecret(&secretsmanager.CreateSecretInput{
+					Name: aws.String("shop-api-key"),
+					Tags: []*secretsmanager.Tag{
+						{Key: aws.String("x-nitric-name"), Value: aws.String("api-key")},
+						{Key: aws.String("x-nitric-stack"), Value: aws.String("shop")},
+					},
+				}).Return(&secretsmanager.CreateSecretOutput{ARN: aws.String(testARN)}, nil)
+				mockProvider.EXPECT().AddResource(core.AwsResource_Secret, "api-key", testARN)
+
+				err := secretPlugin.Provision("api-key", &stack.Identity{Name: "shop"})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
 })
@@ -0,0 +1,69 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "fmt"
+
+// QueryResult - rows returned by a query
+type QueryResult struct {
+	// Columns - the names of the returned columns, in order
+	Columns []string
+	// Rows - the returned rows, keyed by column name
+	Rows []map[string]interface{}
+}
+
+// ExecResult - the result of a statement that doesn't return rows
+type ExecResult struct {
+	RowsAffected int64
+}
+
+type SqlService interface {
+	// Query - runs a query that returns rows, in the transaction if transactionId isn't blank
+	Query(database string, query string, params []interface{}, transactionId string) (*QueryResult, error)
+	// Exec - runs a statement that doesn't return rows, in the transaction if transactionId isn't blank
+	Exec(database string, statement string, params []interface{}, transactionId string) (*ExecResult, error)
+	// Begin - begins a transaction on the database, returning its id
+	Begin(database string) (string, error)
+	// Commit - commits a transaction
+	Commit(transactionId string) error
+	// Rollback - rolls back a transaction
+	Rollback(transactionId string) error
+}
+
+type UnimplementedSqlPlugin struct {
+	SqlService
+}
+
+var _ SqlService = (*UnimplementedSqlPlugin)(nil)
+
+func (*UnimplementedSqlPlugin) Query(database string, query string, params []interface{}, transactionId string) (*QueryResult, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSqlPlugin) Exec(database string, statement string, params []interface{}, transactionId string) (*ExecResult, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSqlPlugin) Begin(database string) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSqlPlugin) Commit(transactionId string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedSqlPlugin) Rollback(transactionId string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
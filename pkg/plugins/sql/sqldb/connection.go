@@ -0,0 +1,159 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+const (
+	DriverPostgres = "postgres"
+	DriverMysql    = "mysql"
+)
+
+// Connection - the driver and data source name used to connect to a database
+type Connection struct {
+	Driver         string
+	DataSourceName string
+}
+
+// ConnectionResolver - returns the connection for a database
+type ConnectionResolver = func(database string) (*Connection, error)
+
+// rdsCredentials - the format of database credentials managed by AWS Secrets Manager for RDS and Aurora
+type rdsCredentials struct {
+	Engine   string      `json:"engine"`
+	Host     string      `json:"host"`
+	Port     json.Number `json:"port"`
+	Username string      `json:"username"`
+	Password string      `json:"password"`
+	DBName   string      `json:"dbname"`
+}
+
+func (c *rdsCredentials) connection() (*Connection, error) {
+	switch {
+	case strings.Contains(c.Engine, "postgres"):
+		port := c.Port.String()
+		if port == "" {
+			port = "5432"
+		}
+
+		dsn := url.URL{
+			Scheme: "postgres",
+			User:   url.UserPassword(c.Username, c.Password),
+			Host:   net.JoinHostPort(c.Host, port),
+			Path:   "/" + c.DBName,
+		}
+
+		return &Connection{Driver: DriverPostgres, DataSourceName: dsn.String()}, nil
+	case strings.Contains(c.Engine, "mysql"), strings.Contains(c.Engine, "mariadb"), c.Engine == "aurora":
+		port := c.Port.String()
+		if port == "" {
+			port = "3306"
+		}
+
+		config := mysql.NewConfig()
+		config.User = c.Username
+		config.Passwd = c.Password
+		config.Net = "tcp"
+		config.Addr = net.JoinHostPort(c.Host, port)
+		config.DBName = c.DBName
+
+		return &Connection{Driver: DriverMysql, DataSourceName: config.FormatDSN()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database engine %s", c.Engine)
+	}
+}
+
+// mysqlConnection - converts a mysql:// URL to a data source name, as the mysql driver's data source names aren't URLs
+func mysqlConnection(value string) (*Connection, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database url: %v", err)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "3306"
+	}
+
+	config := mysql.NewConfig()
+	config.User = u.User.Username()
+	config.Passwd, _ = u.User.Password()
+	config.Net = "tcp"
+	config.Addr = net.JoinHostPort(u.Hostname(), port)
+	config.DBName = strings.TrimPrefix(u.Path, "/")
+
+	if u.RawQuery != "" {
+		// Options such as tls and parseTime are parsed by the driver
+		config, err = mysql.ParseDSN(config.FormatDSN() + "?" + u.RawQuery)
+		if err != nil {
+			return nil, fmt.Errorf("invalid database url options: %v", err)
+		}
+	}
+
+	return &Connection{Driver: DriverMysql, DataSourceName: config.FormatDSN()}, nil
+}
+
+// ParseConnection - parses the connection for a database from a postgres:// or mysql:// URL, RDS credentials JSON
+// or a data source name for the default driver
+func ParseConnection(value string, defaultDriver string) (*Connection, error) {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, "{"):
+		credentials := &rdsCredentials{}
+		if err := json.Unmarshal([]byte(value), credentials); err != nil {
+			return nil, fmt.Errorf("invalid database credentials: %v", err)
+		}
+
+		return credentials.connection()
+	case strings.HasPrefix(value, "postgres://"), strings.HasPrefix(value, "postgresql://"):
+		return &Connection{Driver: DriverPostgres, DataSourceName: value}, nil
+	case strings.HasPrefix(value, "mysql://"):
+		return mysqlConnection(value)
+	case value == "":
+		return nil, fmt.Errorf("blank database connection")
+	default:
+		return &Connection{Driver: defaultDriver, DataSourceName: value}, nil
+	}
+}
+
+// SecretConnections - resolves the connection for a database from the latest version of the secret named <prefix><database>
+func SecretConnections(secretPlugin secret.SecretService, prefix string, defaultDriver string) ConnectionResolver {
+	return func(database string) (*Connection, error) {
+		if secretPlugin == nil {
+			return nil, fmt.Errorf("a secret plugin is required to connect to databases")
+		}
+
+		resp, err := secretPlugin.Access(&secret.SecretVersion{
+			Secret:  &secret.Secret{Name: prefix + database},
+			Version: "latest",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to access credentials for database %s: %v", database, err)
+		}
+
+		return ParseConnection(string(resp.Value), defaultDriver)
+	}
+}
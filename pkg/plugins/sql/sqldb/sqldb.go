@@ -0,0 +1,584 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb_service
+
+import (
+	dbsql "database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/sql"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// PoolOptions - limits of the connection pool opened for each database
+type PoolOptions struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// SqlDBService - Nitric SQL plugin for databases with a database/sql driver e.g. Postgres and MySQL,
+// covering RDS & Aurora, Cloud SQL, Azure Database and self-hosted databases
+type SqlDBService struct {
+	sql.UnimplementedSqlPlugin
+	resolve ConnectionResolver
+	pool    PoolOptions
+	// transactions are rolled back if they're idle for longer than this
+	transactionTimeout time.Duration
+
+	lock  sync.Mutex
+	dbs   map[string]*dbsql.DB
+	conns map[string]*Connection
+	// stops refreshing connections
+	stop         chan struct{}
+	stopOnce     sync.Once
+	transactions map[string]*transaction
+}
+
+type transaction struct {
+	// serializes the use of the transaction's connection
+	sync.Mutex
+	database string
+	tx       *dbsql.Tx
+	timer    *time.Timer
+}
+
+// db - returns the connection pool for a database, opening it if it isn't already open
+func (s *SqlDBService) db(database string) (*dbsql.DB, error) {
+	s.lock.Lock()
+	db, ok := s.dbs[database]
+	s.lock.Unlock()
+
+	if ok {
+		return db, nil
+	}
+
+	// Resolving may access the secret plugin, so it's done without holding the lock other databases need
+	conn, err := s.resolve(database)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Another request may have opened the database while its connection was being resolved
+	if db, ok := s.dbs[database]; ok {
+		return db, nil
+	}
+
+	db, err = s.open(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	s.dbs[database] = db
+	s.conns[database] = conn
+
+	return db, nil
+}
+
+// open - opens a connection pool for the connection
+func (s *SqlDBService) open(conn *Connection) (*dbsql.DB, error) {
+	db, err := dbsql.Open(conn.Driver, conn.DataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(s.pool.MaxOpenConns)
+	db.SetMaxIdleConns(s.pool.MaxIdleConns)
+	db.SetConnMaxLifetime(s.pool.ConnMaxLifetime)
+
+	return db, nil
+}
+
+// Refresh - resolves the connections of open databases again, replacing the connection pools of any that changed,
+// e.g. after their credentials were rotated. Transactions that have already begun finish on the previous pool
+func (s *SqlDBService) Refresh() error {
+	s.lock.Lock()
+	databases := make([]string, 0, len(s.conns))
+	for database := range s.conns {
+		databases = append(databases, database)
+	}
+	s.lock.Unlock()
+
+	var errs []string
+	for _, database := range databases {
+		conn, err := s.resolve(database)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		s.lock.Lock()
+		if current, ok := s.conns[database]; !ok || *current == *conn {
+			s.lock.Unlock()
+			continue
+		}
+
+		db, err := s.open(conn)
+		if err != nil {
+			s.lock.Unlock()
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		previous := s.dbs[database]
+		s.dbs[database] = db
+		s.conns[database] = conn
+		s.lock.Unlock()
+
+		// closing waits for queries that have already started
+		go previous.Close()
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to refresh database connections: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (s *SqlDBService) refreshEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.Refresh(); err != nil {
+				log.Default().Printf("%v, using the previous connections", err)
+			}
+		}
+	}
+}
+
+// transaction - returns a transaction on the database, locked for the caller and with its idle timeout reset
+func (s *SqlDBService) transaction(database string, transactionId string) (*transaction, error) {
+	s.lock.Lock()
+	t, ok := s.transactions[transactionId]
+	s.lock.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("transaction %s does not exist, it may have been committed, rolled back or timed out", transactionId)
+	}
+
+	if database != "" && t.database != database {
+		return nil, fmt.Errorf("transaction %s is on database %s, not %s", transactionId, t.database, database)
+	}
+
+	t.Lock()
+	t.timer.Reset(s.transactionTimeout)
+
+	return t, nil
+}
+
+// end - removes a transaction, returning nil if it has already ended
+func (s *SqlDBService) end(transactionId string) *transaction {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	t, ok := s.transactions[transactionId]
+	if !ok {
+		return nil
+	}
+
+	t.timer.Stop()
+	delete(s.transactions, transactionId)
+
+	return t
+}
+
+type queryer interface {
+	Query(query string, args ...interface{}) (*dbsql.Rows, error)
+	Exec(query string, args ...interface{}) (dbsql.Result, error)
+}
+
+// queryer - returns the transaction or database to run a query with, and a function to release it
+func (s *SqlDBService) queryer(database string, transactionId string) (queryer, func(), error) {
+	if transactionId != "" {
+		t, err := s.transaction(database, transactionId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return t.tx, t.Unlock, nil
+	}
+
+	db, err := s.db(database)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, func() {}, nil
+}
+
+func (s *SqlDBService) Query(database string, query string, params []interface{}, transactionId string) (*sql.QueryResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"SqlDBService.Query",
+		map[string]interface{}{
+			"database":    database,
+			"transaction": transactionId,
+		},
+	)
+
+	if database == "" || query == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-blank database and query",
+			nil,
+		)
+	}
+
+	q, release, err := s.queryer(database, transactionId)
+	if err != nil {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"unable to connect to database",
+			err,
+		)
+	}
+	defer release()
+
+	rows, err := q.Query(query, params...)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error running query",
+			err,
+		)
+	}
+	defer rows.Close()
+
+	result, err := scanRows(rows)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error reading rows",
+			err,
+		)
+	}
+
+	return result, nil
+}
+
+func (s *SqlDBService) Exec(database string, statement string, params []interface{}, transactionId string) (*sql.ExecResult, error) {
+	newErr := errors.ErrorsWithScope(
+		"SqlDBService.Exec",
+		map[string]interface{}{
+			"database":    database,
+			"transaction": transactionId,
+		},
+	)
+
+	if database == "" || statement == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-blank database and statement",
+			nil,
+		)
+	}
+
+	q, release, err := s.queryer(database, transactionId)
+	if err != nil {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"unable to connect to database",
+			err,
+		)
+	}
+	defer release()
+
+	res, err := q.Exec(statement, params...)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error running statement",
+			err,
+		)
+	}
+
+	// not all drivers report affected rows
+	rowsAffected, _ := res.RowsAffected()
+
+	return &sql.ExecResult{
+		RowsAffected: rowsAffected,
+	}, nil
+}
+
+func (s *SqlDBService) Begin(database string) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"SqlDBService.Begin",
+		map[string]interface{}{
+			"database": database,
+		},
+	)
+
+	if database == "" {
+		return "", newErr(
+			codes.InvalidArgument,
+			"provide non-blank database",
+			nil,
+		)
+	}
+
+	db, err := s.db(database)
+	if err != nil {
+		return "", newErr(
+			codes.FailedPrecondition,
+			"unable to connect to database",
+			err,
+		)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"error beginning transaction",
+			err,
+		)
+	}
+
+	transactionId := uuid.New().String()
+	t := &transaction{
+		database: database,
+		tx:       tx,
+	}
+
+	// Roll back abandoned transactions, releasing their connections
+	t.timer = time.AfterFunc(s.transactionTimeout, func() {
+		if t := s.end(transactionId); t != nil {
+			t.Lock()
+			defer t.Unlock()
+			_ = t.tx.Rollback()
+		}
+	})
+
+	s.lock.Lock()
+	s.transactions[transactionId] = t
+	s.lock.Unlock()
+
+	return transactionId, nil
+}
+
+func (s *SqlDBService) Commit(transactionId string) error {
+	newErr := errors.ErrorsWithScope(
+		"SqlDBService.Commit",
+		map[string]interface{}{
+			"transaction": transactionId,
+		},
+	)
+
+	t := s.end(transactionId)
+	if t == nil {
+		return newErr(
+			codes.NotFound,
+			"transaction does not exist, it may have been committed, rolled back or timed out",
+			nil,
+		)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if err := t.tx.Commit(); err != nil {
+		return newErr(
+			codes.Internal,
+			"error committing transaction",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s *SqlDBService) Rollback(transactionId string) error {
+	newErr := errors.ErrorsWithScope(
+		"SqlDBService.Rollback",
+		map[string]interface{}{
+			"transaction": transactionId,
+		},
+	)
+
+	t := s.end(transactionId)
+	if t == nil {
+		return newErr(
+			codes.NotFound,
+			"transaction does not exist, it may have been committed, rolled back or timed out",
+			nil,
+		)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if err := t.tx.Rollback(); err != nil {
+		return newErr(
+			codes.Internal,
+			"error rolling back transaction",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// scanRows - reads all rows, keyed by column name
+func scanRows(rows *dbsql.Rows) (*sql.QueryResult, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sql.QueryResult{
+		Columns: columns,
+		Rows:    make([]map[string]interface{}, 0),
+	}
+
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			row[column] = normalizeValue(values[i])
+		}
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, rows.Err()
+}
+
+// normalizeValue - converts driver values to values that can be returned over the membrane's API
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		// text columns are returned as bytes by some drivers
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+// Close - closes the connection pools of every database
+func (s *SqlDBService) Close() error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, db := range s.dbs {
+		_ = db.Close()
+	}
+
+	return nil
+}
+
+// NewWithResolver - creates a SQL plugin that connects to databases with the resolved connections
+func NewWithResolver(resolve ConnectionResolver, pool PoolOptions, transactionTimeout time.Duration) *SqlDBService {
+	return &SqlDBService{
+		resolve:            resolve,
+		pool:               pool,
+		transactionTimeout: transactionTimeout,
+		dbs:                make(map[string]*dbsql.DB),
+		conns:              make(map[string]*Connection),
+		stop:               make(chan struct{}),
+		transactions:       make(map[string]*transaction),
+	}
+}
+
+// NewWithRefresh - creates a SQL plugin that connects to databases with the resolved connections,
+// resolving the connections of open databases again every refresh interval if it's positive
+func NewWithRefresh(resolve ConnectionResolver, pool PoolOptions, transactionTimeout time.Duration, refresh time.Duration) *SqlDBService {
+	s := NewWithResolver(resolve, pool, transactionTimeout)
+
+	if refresh > 0 {
+		go s.refreshEvery(refresh)
+	}
+
+	return s
+}
+
+// New - creates a SQL plugin that connects to databases with credentials from the secret plugin,
+// stored in the secret named after the database with the SQL_SECRET_PREFIX prefix
+func New(secretPlugin secret.SecretService) (sql.SqlService, error) {
+	driver := utils.GetEnv("SQL_DRIVER", DriverPostgres)
+	if driver != DriverPostgres && driver != DriverMysql {
+		return nil, fmt.Errorf("invalid SQL_DRIVER env var, expected %s or %s, got %v", DriverPostgres, DriverMysql, driver)
+	}
+
+	maxOpenEnv := utils.GetEnv("SQL_MAX_OPEN_CONNS", "10")
+	maxOpen, err := strconv.Atoi(maxOpenEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SQL_MAX_OPEN_CONNS env var, expected integer, got %v", maxOpenEnv)
+	}
+
+	maxIdleEnv := utils.GetEnv("SQL_MAX_IDLE_CONNS", "2")
+	maxIdle, err := strconv.Atoi(maxIdleEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SQL_MAX_IDLE_CONNS env var, expected integer, got %v", maxIdleEnv)
+	}
+
+	lifetimeEnv := utils.GetEnv("SQL_CONN_MAX_LIFETIME", "30m")
+	lifetime, err := time.ParseDuration(lifetimeEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SQL_CONN_MAX_LIFETIME env var, expected duration e.g. 30m, got %v", lifetimeEnv)
+	}
+
+	timeoutEnv := utils.GetEnv("SQL_TRANSACTION_TIMEOUT", "30s")
+	timeout, err := time.ParseDuration(timeoutEnv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SQL_TRANSACTION_TIMEOUT env var, expected duration e.g. 30s, got %v", timeoutEnv)
+	}
+
+	refresh, err := secret.CredentialsRefreshFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := SecretConnections(secretPlugin, utils.GetEnv("SQL_SECRET_PREFIX", "sql-"), driver)
+
+	return NewWithRefresh(resolve, PoolOptions{
+		MaxOpenConns:    maxOpen,
+		MaxIdleConns:    maxIdle,
+		ConnMaxLifetime: lifetime,
+	}, timeout, refresh), nil
+}
@@ -17,48 +17,80 @@ package azblob_service
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 
+	"github.com/nitrictech/nitric/pkg/naming"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
 	azblob_service_iface "github.com/nitrictech/nitric/pkg/plugins/storage/azblob/iface"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
 	azureutils "github.com/nitrictech/nitric/pkg/providers/azure/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
 	"github.com/nitrictech/nitric/pkg/utils"
 )
 
 // AzblobStorageService - Nitric membrane storage plugin implementation for Azure Storage
 type AzblobStorageService struct {
 	client azblob_service_iface.AzblobServiceUrlIface
+	// account - the name of the storage account, from its blob endpoint
+	account string
+	// permissions - lists the effective permissions on containers, created from the environment when it's nil
+	permissions core.PermissionLister
 	storage.UnimplementedStoragePlugin
 }
 
-func (a *AzblobStorageService) getContainerUrl(bucket string) azblob_service_iface.AzblobContainerUrlIface {
-	return a.client.NewContainerURL(bucket)
+// getContainerUrl - returns a client for the container with the bucket's physical name
+func (a *AzblobStorageService) getContainerUrl(bucket string) (azblob_service_iface.AzblobContainerUrlIface, error) {
+	name, err := naming.BlobContainers.Physical(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.NewContainerURL(name), nil
 }
 
-func (a *AzblobStorageService) getBlobUrl(bucket string, key string) azblob_service_iface.AzblobBlockBlobUrlIface {
-	return a.getContainerUrl(bucket).NewBlockBlobURL(key)
+func (a *AzblobStorageService) getBlobUrl(bucket string, key string) (azblob_service_iface.AzblobBlockBlobUrlIface, error) {
+	cUrl, err := a.getContainerUrl(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return cUrl.NewBlockBlobURL(key), nil
 }
 
-func (a *AzblobStorageService) Read(bucket string, key string) ([]byte, error) {
+func (a *AzblobStorageService) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	ro := storage.NewReadOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"AzblobStorageService.Read",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":         bucket,
+			"key":            key,
+			"version":        ro.Version,
+			"integrityCheck": ro.IntegrityCheck,
 		},
 	)
 	// Get the bucket for this bucket name
-	blob := a.getBlobUrl(bucket, key)
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+	if ro.Version != "" {
+		blob = blob.WithVersionID(ro.Version)
+	}
 	//// download the blob
 	r, err := blob.Download(
 		context.TODO(),
@@ -79,57 +111,301 @@ func (a *AzblobStorageService) Read(bucket string, key string) ([]byte, error) {
 	// TODO: Configure retries
 	data := r.Body(azblob.RetryReaderOptions{MaxRetryRequests: 20})
 
-	return ioutil.ReadAll(data)
+	object, err := ioutil.ReadAll(data)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"Unable to read blob data",
+			err,
+		)
+	}
+
+	// Whole blob downloads return the Content-MD5 the blob was stored with, if it was stored with one
+	if ro.IntegrityCheck {
+		if digest := r.ContentMD5(); len(digest) > 0 {
+			if _, err := storage.VerifyChecksum(storage.ChecksumMD5, base64.StdEncoding.EncodeToString(digest), object); err != nil {
+				return nil, newErr(
+					codes.DataLoss,
+					storage.ChecksumMismatch,
+					err,
+				)
+			}
+		}
+	}
+
+	object, err = storage.Decompress(r.ContentEncoding(), object)
+	if err != nil {
+		return nil, newErr(
+			codes.DataLoss,
+			"Unable to decompress blob data",
+			err,
+		)
+	}
+
+	if ro.ETag != nil {
+		*ro.ETag = string(r.ETag())
+	}
+
+	return object, nil
+}
+
+// accessTiers - blob access tiers for nitric storage classes.
+// Archived blobs are offline, they must be rehydrated to another tier before they can be read.
+var accessTiers = map[string]azblob.AccessTierType{
+	"":                             azblob.DefaultAccessTier,
+	storage.StorageClassStandard:   azblob.AccessTierHot,
+	storage.StorageClassInfrequent: azblob.AccessTierCool,
+	storage.StorageClassArchive:    azblob.AccessTierArchive,
 }
 
-func (a *AzblobStorageService) Write(bucket string, key string, object []byte) error {
+func (a *AzblobStorageService) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	wo := storage.NewWriteOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"AzblobStorageService.Write",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":         bucket,
+			"key":            key,
+			"compression":    wo.Compression,
+			"class":          wo.StorageClass,
+			"ifMatch":        wo.IfMatch,
+			"ifNotExists":    wo.IfNotExists,
+			"integrityCheck": wo.IntegrityCheck,
 		},
 	)
 
-	blob := a.getBlobUrl(bucket, key)
+	if err := storage.ValidateStorageClass(wo.StorageClass); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid storage class",
+			err,
+		)
+	}
 
-	if _, err := blob.Upload(
+	if err := storage.ValidatePreconditions(wo); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid preconditions",
+			err,
+		)
+	}
+
+	body, encoding, err := storage.Compress(wo.Compression, object)
+	if err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"Unable to compress blob data",
+			err,
+		)
+	}
+
+	headers := azblob.BlobHTTPHeaders{}
+	if encoding != "" {
+		headers.ContentEncoding = encoding
+		headers.ContentType = http.DetectContentType(object)
+	}
+
+	if wo.IntegrityCheck {
+		// Azure rejects the upload if the bytes it receives don't match the MD5, which is stored as the blob's Content-MD5
+		digest := md5.Sum(body)
+		headers.ContentMD5 = digest[:]
+	}
+
+	conditions := azblob.ModifiedAccessConditions{}
+	if wo.IfMatch != "" {
+		conditions.IfMatch = azblob.ETag(wo.IfMatch)
+	}
+	if wo.IfNotExists {
+		conditions.IfNoneMatch = azblob.ETagAny
+	}
+
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return "", newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	resp, err := blob.Upload(
 		context.TODO(),
-		bytes.NewReader(object),
-		azblob.BlobHTTPHeaders{},
+		bytes.NewReader(body),
+		headers,
 		azblob.Metadata{},
-		azblob.BlobAccessConditions{},
-		azblob.DefaultAccessTier,
+		azblob.BlobAccessConditions{ModifiedAccessConditions: conditions},
+		accessTiers[wo.StorageClass],
 		nil,
 		azblob.ClientProvidedKeyOptions{},
-	); err != nil {
-		return newErr(
+	)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return "", newErr(
+				codes.FailedPrecondition,
+				storage.PreconditionFailed,
+				err,
+			)
+		}
+
+		if isServiceCode(err, azblob.ServiceCodeMd5Mismatch) {
+			return "", newErr(
+				codes.DataLoss,
+				storage.ChecksumMismatch,
+				err,
+			)
+		}
+
+		return "", newErr(
 			codes.Internal,
 			"Unable to write blob data",
 			err,
 		)
 	}
 
+	// The version ID and ETag are read from the response headers,
+	// the version ID is only set for storage accounts with blob versioning enabled
+	if resp.Response() == nil {
+		return "", nil
+	}
+
+	if wo.ETag != nil {
+		*wo.ETag = string(resp.ETag())
+	}
+
+	return resp.VersionID(), nil
+}
+
+// Append - Appends to an append blob, creating it if it doesn't exist.
+// Blobs created by Write are block blobs and can't be appended to.
+func (a *AzblobStorageService) Append(bucket string, key string, object []byte) error {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.Append",
+		map[string]interface{}{
+			"bucket":     bucket,
+			"key":        key,
+			"object.len": len(object),
+		},
+	)
+
+	cUrl, err := a.getContainerUrl(bucket)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+	blob := cUrl.NewAppendBlobURL(key)
+
+	if len(object) == 0 {
+		err = createAppendBlob(blob, object)
+	} else if err = appendBlocks(blob, object); isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+		if err = createAppendBlob(blob, object); err == nil {
+			err = appendBlocks(blob, object)
+		}
+	}
+
+	if err != nil {
+		if isServiceCode(err, azblob.ServiceCodeInvalidBlobType) {
+			return newErr(
+				codes.FailedPrecondition,
+				"Blob is not an append blob",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"Unable to append blob data",
+			err,
+		)
+	}
+
 	return nil
 }
 
-func (a *AzblobStorageService) Delete(bucket string, key string) error {
+// createAppendBlob - creates an empty append blob, unless another writer has already created it
+func createAppendBlob(blob azblob_service_iface.AzblobAppendBlobUrlIface, object []byte) error {
+	_, err := blob.Create(
+		context.TODO(),
+		azblob.BlobHTTPHeaders{ContentType: http.DetectContentType(object)},
+		azblob.Metadata{},
+		azblob.BlobAccessConditions{
+			ModifiedAccessConditions: azblob.ModifiedAccessConditions{IfNoneMatch: azblob.ETagAny},
+		},
+		nil,
+		azblob.ClientProvidedKeyOptions{},
+	)
+
+	if isServiceCode(err, azblob.ServiceCodeBlobAlreadyExists) || isServiceCode(err, azblob.ServiceCodeConditionNotMet) {
+		return nil
+	}
+
+	return err
+}
+
+// appendBlocks - appends the object to the blob, split into blocks of the maximum size Azure accepts
+func appendBlocks(blob azblob_service_iface.AzblobAppendBlobUrlIface, object []byte) error {
+	for start := 0; start < len(object); start += azblob.AppendBlobMaxAppendBlockBytes {
+		end := start + azblob.AppendBlobMaxAppendBlockBytes
+		if end > len(object) {
+			end = len(object)
+		}
+
+		if _, err := blob.AppendBlock(
+			context.TODO(),
+			bytes.NewReader(object[start:end]),
+			azblob.AppendBlobAccessConditions{},
+			nil,
+			azblob.ClientProvidedKeyOptions{},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isPreconditionFailed - returns true if a conditional request wasn't applied because its preconditions weren't met,
+// writes to existing blobs with If-None-Match: * fail with BlobAlreadyExists rather than ConditionNotMet
+func isPreconditionFailed(err error) bool {
+	return isServiceCode(err, azblob.ServiceCodeConditionNotMet) || isServiceCode(err, azblob.ServiceCodeBlobAlreadyExists)
+}
+
+func isServiceCode(err error, code azblob.ServiceCodeType) bool {
+	if sErr, ok := err.(azblob.StorageError); ok {
+		return sErr.ServiceCode() == code
+	}
+
+	return false
+}
+
+func (a *AzblobStorageService) Delete(bucket string, key string, opts ...storage.DeleteOption) error {
+	do := storage.NewDeleteOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"AzblobStorageService.Delete",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":  bucket,
+			"key":     key,
+			"ifMatch": do.IfMatch,
 		},
 	)
 
+	conditions := azblob.ModifiedAccessConditions{}
+	if do.IfMatch != "" {
+		conditions.IfMatch = azblob.ETag(do.IfMatch)
+	}
+
 	// Get the bucket for this bucket name
-	blob := a.getBlobUrl(bucket, key)
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
 
 	if _, err := blob.Delete(
 		context.TODO(),
 		azblob.DeleteSnapshotsOptionInclude,
-		azblob.BlobAccessConditions{},
+		azblob.BlobAccessConditions{ModifiedAccessConditions: conditions},
 	); err != nil {
+		if isPreconditionFailed(err) {
+			return newErr(
+				codes.FailedPrecondition,
+				storage.PreconditionFailed,
+				err,
+			)
+		}
+
 		return newErr(
 			codes.Internal,
 			"Unable to delete blob",
@@ -150,7 +426,12 @@ func (s *AzblobStorageService) PreSignUrl(bucket string, key string, operation s
 		},
 	)
 
-	blobUrlParts := azblob.NewBlobURLParts(s.getBlobUrl(bucket, key).Url())
+	blob, err := s.getBlobUrl(bucket, key)
+	if err != nil {
+		return "", newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	blobUrlParts := azblob.NewBlobURLParts(blob.Url())
 	currentTime := time.Now().UTC()
 	validDuration := currentTime.Add(time.Duration(expiry) * time.Second)
 	cred, err := s.client.GetUserDelegationCredential(context.TODO(), azblob.NewKeyInfo(currentTime, validDuration), nil, nil)
@@ -170,7 +451,7 @@ func (s *AzblobStorageService) PreSignUrl(bucket string, key string, operation s
 			Write: operation == storage.WRITE,
 		}.String(),
 		BlobName:      key,
-		ContainerName: bucket,
+		ContainerName: blobUrlParts.ContainerName,
 	}
 
 	queryParams, err := sigOpts.NewSASQueryParameters(cred)
@@ -188,21 +469,104 @@ func (s *AzblobStorageService) PreSignUrl(bucket string, key string, operation s
 	return url.String(), nil
 }
 
-func (s *AzblobStorageService) ListFiles(bucket string) ([]*storage.FileInfo, error) {
+// GrantAccess - issues a container SAS. Blob SAS tokens can't be restricted to a key prefix, so only whole buckets can be granted
+func (s *AzblobStorageService) GrantAccess(bucket string, prefix string, operations []storage.Operation, expiry uint32) (*storage.AccessGrant, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.GrantAccess",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+		},
+	)
+
+	if prefix != "" {
+		return nil, newErr(
+			codes.Unimplemented,
+			"Azure SAS tokens can't be restricted to a key prefix, only whole buckets can be granted",
+			nil,
+		)
+	}
+
+	permissions := azblob.ContainerSASPermissions{}
+	for _, op := range operations {
+		switch op {
+		case storage.READ:
+			permissions.Read = true
+			permissions.List = true
+		case storage.WRITE:
+			permissions.Create = true
+			permissions.Write = true
+		}
+	}
+
+	container, err := naming.BlobContainers.Physical(bucket)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	containerUrl := s.client.NewContainerURL(container).Url()
+	currentTime := time.Now().UTC()
+	validDuration := currentTime.Add(time.Duration(expiry) * time.Second)
+	cred, err := s.client.GetUserDelegationCredential(context.TODO(), azblob.NewKeyInfo(currentTime, validDuration), nil, nil)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"could not get user delegation credential",
+			err,
+		)
+	}
+
+	queryParams, err := azblob.BlobSASSignatureValues{
+		Protocol:      azblob.SASProtocolHTTPS,
+		ExpiryTime:    validDuration,
+		Permissions:   permissions.String(),
+		ContainerName: container,
+	}.NewSASQueryParameters(cred)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"error signing container SAS",
+			err,
+		)
+	}
+
+	token := queryParams.Encode()
+	containerUrl.RawQuery = token
+
+	return &storage.AccessGrant{
+		Sas: &storage.SasToken{
+			Url:   containerUrl.String(),
+			Token: token,
+		},
+		Expires: validDuration,
+	}, nil
+}
+
+func (s *AzblobStorageService) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	lo := storage.NewListOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"AzblobStorageService.ListFiles",
 		map[string]interface{}{
 			"bucket": bucket,
+			"tags":   lo.Tags,
 		},
 	)
 
-	cUrl := s.getContainerUrl(bucket)
+	listOpts := azblob.ListBlobsSegmentOptions{}
+	if len(lo.Tags) > 0 {
+		listOpts.Details.Tags = true
+	}
+
+	cUrl, err := s.getContainerUrl(bucket)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
 	files := make([]*storage.FileInfo, 0)
 
 	// List the blob(s) in our container; since a container may hold millions of blobs, this is done 1 segment at a time.
 	for marker := (azblob.Marker{}); marker.NotDone(); { // The parens around Marker{} are required to avoid compiler error.
 		// Get a result segment starting with the blob indicated by the current Marker.
-		listBlob, err := cUrl.ListBlobsFlatSegment(context.TODO(), marker, azblob.ListBlobsSegmentOptions{})
+		listBlob, err := cUrl.ListBlobsFlatSegment(context.TODO(), marker, listOpts)
 		if err != nil {
 			return nil, newErr(codes.Internal, "error listing files", err)
 		}
@@ -212,8 +576,13 @@ func (s *AzblobStorageService) ListFiles(bucket string) ([]*storage.FileInfo, er
 
 		// Process the blobs returned in this result segment (if the segment is empty, the loop body won't execute)
 		for _, blobInfo := range listBlob.Segment.BlobItems {
+			if len(lo.Tags) > 0 && !storage.MatchesTags(blobTags(blobInfo.BlobTags), lo.Tags) {
+				continue
+			}
+
 			files = append(files, &storage.FileInfo{
-				Key: blobInfo.Name,
+				Key:  blobInfo.Name,
+				ETag: string(blobInfo.Properties.Etag),
 			})
 		}
 	}
@@ -221,6 +590,211 @@ func (s *AzblobStorageService) ListFiles(bucket string) ([]*storage.FileInfo, er
 	return files, nil
 }
 
+// SetTags - replaces the index tags of a blob
+func (a *AzblobStorageService) SetTags(bucket string, key string, tags map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.SetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	if err := storage.ValidateTags(tags); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid tags",
+			err,
+		)
+	}
+
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	if _, err := blob.SetTags(context.TODO(), nil, nil, nil, nil, nil, nil, azblob.BlobTagsMap(tags)); err != nil {
+		code := codes.Internal
+		if isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			code = codes.NotFound
+		}
+
+		return newErr(
+			code,
+			"unable to set blob tags",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// GetTags - returns the index tags of a blob
+func (a *AzblobStorageService) GetTags(bucket string, key string) (map[string]string, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.GetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	resp, err := blob.GetTags(context.TODO(), nil, nil, nil, nil, nil)
+	if err != nil {
+		code := codes.Internal
+		if isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			code = codes.NotFound
+		}
+
+		return nil, newErr(
+			code,
+			"unable to get blob tags",
+			err,
+		)
+	}
+
+	return blobTags(resp), nil
+}
+
+// blobTags - converts a blob tag set to a map
+func blobTags(bt *azblob.BlobTags) map[string]string {
+	tags := make(map[string]string)
+	if bt == nil {
+		return tags
+	}
+
+	for _, t := range bt.BlobTagSet {
+		tags[t.Key] = t.Value
+	}
+
+	return tags
+}
+
+// ListVersions - lists the versions of a blob, newest first. Versions are only kept by storage accounts with blob versioning enabled
+func (a *AzblobStorageService) ListVersions(bucket string, key string) ([]*storage.VersionInfo, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.ListVersions",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	cUrl, err := a.getContainerUrl(bucket)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	versions := make([]*storage.VersionInfo, 0)
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		listBlob, err := cUrl.ListBlobsFlatSegment(context.TODO(), marker, azblob.ListBlobsSegmentOptions{
+			Prefix:  key,
+			Details: azblob.BlobListingDetails{Versions: true},
+		})
+		if err != nil {
+			return nil, newErr(codes.Internal, "error listing blob versions", err)
+		}
+		marker = listBlob.NextMarker
+
+		for _, blobInfo := range listBlob.Segment.BlobItems {
+			// The prefix also matches other blobs that start with the key
+			if blobInfo.Name != key || blobInfo.VersionID == nil {
+				continue
+			}
+
+			size := int64(0)
+			if blobInfo.Properties.ContentLength != nil {
+				size = *blobInfo.Properties.ContentLength
+			}
+
+			versions = append(versions, &storage.VersionInfo{
+				Version:      *blobInfo.VersionID,
+				Latest:       blobInfo.IsCurrentVersion != nil && *blobInfo.IsCurrentVersion,
+				LastModified: blobInfo.Properties.LastModified,
+				Size:         size,
+			})
+		}
+	}
+
+	// Version IDs are timestamps, so they sort in the order the versions were created
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+
+	return versions, nil
+}
+
+// RestoreVersion - makes a previous version of a blob its current version, by uploading the version's content as a new version
+func (a *AzblobStorageService) RestoreVersion(bucket string, key string, version string) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"AzblobStorageService.RestoreVersion",
+		map[string]interface{}{
+			"bucket":  bucket,
+			"key":     key,
+			"version": version,
+		},
+	)
+
+	blob, err := a.getBlobUrl(bucket, key)
+	if err != nil {
+		return "", newErr(codes.InvalidArgument, "bucket name collides with another bucket", err)
+	}
+
+	r, err := blob.WithVersionID(version).Download(
+		context.TODO(),
+		0,
+		azblob.CountToEnd,
+		azblob.BlobAccessConditions{},
+		false,
+		azblob.ClientProvidedKeyOptions{},
+	)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"Unable to download blob version",
+			err,
+		)
+	}
+
+	// The stored bytes are restored as is, keeping any compression
+	object, err := ioutil.ReadAll(r.Body(azblob.RetryReaderOptions{MaxRetryRequests: 20}))
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"Unable to read blob version data",
+			err,
+		)
+	}
+
+	resp, err := blob.Upload(
+		context.TODO(),
+		bytes.NewReader(object),
+		azblob.BlobHTTPHeaders{
+			ContentType:     r.ContentType(),
+			ContentEncoding: r.ContentEncoding(),
+		},
+		azblob.Metadata{},
+		azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier,
+		nil,
+		azblob.ClientProvidedKeyOptions{},
+	)
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"Unable to restore blob version",
+			err,
+		)
+	}
+
+	return resp.VersionID(), nil
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azblob.TokenRefresher {
@@ -239,6 +813,46 @@ func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azblob.TokenRefreshe
 	}
 }
 
+// blobDataActions - the data actions needed on a container for nitric actions
+var blobDataActions = map[resources.Action][]string{
+	resources.BucketFileList:   {"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+	resources.BucketFileGet:    {"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+	resources.BucketFilePut:    {"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write"},
+	resources.BucketFileDelete: {"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete"},
+}
+
+// CheckPermissions - checks the data actions needed for the nitric actions against the effective permissions on the bucket's container
+func (a *AzblobStorageService) CheckPermissions(bucket string, actions []resources.Action) ([]resources.Permission, error) {
+	if a.account == "" {
+		return nil, fmt.Errorf("the storage account of bucket %s is unknown", bucket)
+	}
+
+	lister := a.permissions
+	if lister == nil {
+		var err error
+		if lister, err = core.NewPermissionLister(); err != nil {
+			return nil, err
+		}
+	}
+
+	container, err := naming.BlobContainers.Physical(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, act := range actions {
+		needed = append(needed, blobDataActions[act]...)
+	}
+
+	return core.CheckDataActions(lister, core.AzResourceId{
+		Namespace:  "Microsoft.Storage",
+		ParentPath: fmt.Sprintf("storageAccounts/%s/blobServices/default", a.account),
+		Type:       "containers",
+		Name:       container,
+	}, needed)
+}
+
 // New - Creates a new instance of the AzblobStorageService
 func New() (storage.StorageService, error) {
 	// TODO: Create a default storage account for the stack???
@@ -265,6 +879,7 @@ func New() (storage.StorageService, error) {
 	client := azblob.NewServiceURL(*accountURL, pipeline)
 
 	return &AzblobStorageService{
-		client: azblob_service_iface.AdaptServiceUrl(client),
+		client:  azblob_service_iface.AdaptServiceUrl(client),
+		account: strings.Split(accountURL.Hostname(), ".")[0],
 	}, nil
 }
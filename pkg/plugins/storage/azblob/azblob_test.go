@@ -19,9 +19,11 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/golang/mock/gomock"
 
@@ -29,7 +31,11 @@ import (
 	. "github.com/onsi/gomega"
 
 	mock_azblob "github.com/nitrictech/nitric/mocks/azblob"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/providers/azure/core"
+	"github.com/nitrictech/nitric/pkg/resources"
 )
 
 var _ = Describe("Azblob", func() {
@@ -70,6 +76,7 @@ var _ = Describe("Azblob", func() {
 
 				By("Reading from the download response")
 				mockDown.EXPECT().Body(gomock.Any()).Times(1).Return(ioutil.NopCloser(strings.NewReader("file-contents")))
+				mockDown.EXPECT().ContentEncoding().Return("")
 
 				data, err := storagePlugin.Read("my-bucket", "my-blob")
 
@@ -83,6 +90,36 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 
+		When("The blob doesn't match its Content-MD5", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(crtl)
+			mockDown := mock_azblob.NewMockAzblobDownloadResponse(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should fail an integrity checked read", func() {
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("my-blob").Times(1).Return(mockBlob)
+				mockBlob.EXPECT().Download(gomock.Any(), int64(0), int64(0), gomock.Any(), false, gomock.Any()).Times(1).Return(mockDown, nil)
+
+				By("The downloaded bytes not matching the blob's Content-MD5")
+				mockDown.EXPECT().Body(gomock.Any()).Times(1).Return(ioutil.NopCloser(strings.NewReader("file-contents")))
+				mockDown.EXPECT().ContentMD5().Return([]byte{0x0c, 0xbc, 0x66, 0x11, 0xf5, 0x54, 0x0b, 0xd0, 0x80, 0x9a, 0x38, 0x8d, 0xc9, 0x5a, 0x61, 0x5b})
+
+				_, err := storagePlugin.Read("my-bucket", "my-blob", storage.WithReadIntegrityCheck())
+
+				By("Returning a checksum mismatch error")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(storage.ChecksumMismatch))
+
+				crtl.Finish()
+			})
+		})
+
 		When("Azure returns an error", func() {
 			crtl := gomock.NewController(GinkgoT())
 			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
@@ -148,7 +185,7 @@ var _ = Describe("Azblob", func() {
 					azblob.ClientProvidedKeyOptions{},
 				).Times(1).Return(&azblob.BlockBlobUploadResponse{}, nil)
 
-				err := storagePlugin.Write("my-bucket", "my-blob", []byte("test"))
+				_, err := storagePlugin.Write("my-bucket", "my-blob", []byte("test"))
 
 				By("Not returning an error")
 				Expect(err).ToNot(HaveOccurred())
@@ -186,10 +223,115 @@ var _ = Describe("Azblob", func() {
 					azblob.ClientProvidedKeyOptions{},
 				).Times(1).Return(nil, fmt.Errorf("mock-error"))
 
-				err := storagePlugin.Write("my-bucket", "my-blob", []byte("test"))
+				_, err := storagePlugin.Write("my-bucket", "my-blob", []byte("test"))
+
+				By("returning an error")
+				Expect(err).To(HaveOccurred())
+
+				crtl.Finish()
+			})
+		})
+	})
+
+	Context("Append", func() {
+		When("The append blob exists", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobAppendBlobUrlIface(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should append a block to the blob", func() {
+				By("Retrieving the append blob url of the requested object")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewAppendBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				By("Calling AppendBlock once with the appended data")
+				mockBlob.EXPECT().AppendBlock(
+					gomock.Any(),
+					bytes.NewReader([]byte("test")),
+					azblob.AppendBlobAccessConditions{},
+					nil,
+					azblob.ClientProvidedKeyOptions{},
+				).Times(1).Return(&azblob.AppendBlobAppendBlockResponse{}, nil)
+
+				err := storagePlugin.Append("my-bucket", "my-blob", []byte("test"))
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				crtl.Finish()
+			})
+		})
+
+		When("The append blob doesn't exist", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobAppendBlobUrlIface(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should create the blob and append to it", func() {
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewAppendBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				gomock.InOrder(
+					mockBlob.EXPECT().AppendBlock(
+						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					).Return(nil, &mockStorageError{code: azblob.ServiceCodeBlobNotFound}),
+					mockBlob.EXPECT().Create(
+						gomock.Any(),
+						gomock.Any(),
+						azblob.Metadata{},
+						azblob.BlobAccessConditions{
+							ModifiedAccessConditions: azblob.ModifiedAccessConditions{IfNoneMatch: azblob.ETagAny},
+						},
+						nil,
+						azblob.ClientProvidedKeyOptions{},
+					).Return(&azblob.AppendBlobCreateResponse{}, nil),
+					mockBlob.EXPECT().AppendBlock(
+						gomock.Any(), bytes.NewReader([]byte("test")), gomock.Any(), gomock.Any(), gomock.Any(),
+					).Return(&azblob.AppendBlobAppendBlockResponse{}, nil),
+				)
+
+				err := storagePlugin.Append("my-bucket", "my-blob", []byte("test"))
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				crtl.Finish()
+			})
+		})
+
+		When("The blob is not an append blob", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobAppendBlobUrlIface(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return an error", func() {
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewAppendBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				mockBlob.EXPECT().AppendBlock(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, &mockStorageError{code: azblob.ServiceCodeInvalidBlobType})
+
+				err := storagePlugin.Append("my-bucket", "my-blob", []byte("test"))
 
 				By("returning an error")
 				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("not an append blob"))
 
 				crtl.Finish()
 			})
@@ -414,4 +556,96 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 	})
+
+	Context("GrantAccess", func() {
+		When("a whole bucket is granted", func() {
+			It("should return a container SAS", func() {
+				crtl := gomock.NewController(GinkgoT())
+				mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+				mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+				storagePlugin := &AzblobStorageService{
+					client: mockAzblob,
+				}
+
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Return(mockContainer)
+				u, _ := url.Parse("https://fake-account.com/my-bucket")
+				mockContainer.EXPECT().Url().Return(*u)
+				mockAzblob.EXPECT().GetUserDelegationCredential(
+					context.TODO(), gomock.Any(), gomock.Any(), nil,
+				).Return(
+					azblob.NewUserDelegationCredential("mock-account-name", azblob.UserDelegationKey{}),
+					nil,
+				)
+
+				grant, err := storagePlugin.GrantAccess("my-bucket", "", []storage.Operation{storage.READ, storage.WRITE}, 3600)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(grant.Sas.Url).To(HavePrefix("https://fake-account.com/my-bucket?"))
+				Expect(grant.Sas.Url).To(HaveSuffix(grant.Sas.Token))
+
+				query, _ := url.ParseQuery(grant.Sas.Token)
+				Expect(query.Get("sr")).To(Equal("c"))
+				Expect(query.Get("sp")).To(Equal("rcwl"))
+			})
+		})
+
+		When("a prefix is granted", func() {
+			It("should report it as unimplemented", func() {
+				storagePlugin := &AzblobStorageService{}
+
+				_, err := storagePlugin.GrantAccess("my-bucket", "uploads/", []storage.Operation{storage.WRITE}, 3600)
+				Expect(errors.Code(err)).To(Equal(codes.Unimplemented))
+			})
+		})
+	})
+
+	Context("CheckPermissions", func() {
+		lister := &fakeLister{permissions: []authorization.Permission{{
+			DataActions: &[]string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"},
+		}}}
+		storagePlugin := &AzblobStorageService{
+			account:     "myaccount",
+			permissions: lister,
+		}
+
+		It("should check the data actions on the bucket's container", func() {
+			permissions, err := storagePlugin.CheckPermissions("my-bucket", []resources.Action{resources.BucketFileGet, resources.BucketFilePut})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read on Microsoft.Storage/storageAccounts/myaccount/blobServices/default/containers/my-bucket", Granted: true},
+				{Name: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write on Microsoft.Storage/storageAccounts/myaccount/blobServices/default/containers/my-bucket", Granted: false},
+			}))
+		})
+	})
 })
+
+type fakeLister struct {
+	permissions []authorization.Permission
+}
+
+func (f *fakeLister) ListPermissions(ctx context.Context, resource core.AzResourceId) ([]authorization.Permission, error) {
+	return f.permissions, nil
+}
+
+type mockStorageError struct {
+	code azblob.ServiceCodeType
+}
+
+func (e *mockStorageError) Error() string {
+	return string(e.code)
+}
+
+func (e *mockStorageError) Timeout() bool {
+	return false
+}
+
+func (e *mockStorageError) Temporary() bool {
+	return false
+}
+
+func (e *mockStorageError) Response() *http.Response {
+	return nil
+}
+
+func (e *mockStorageError) ServiceCode() azblob.ServiceCodeType {
+	return e.code
+}
@@ -36,10 +36,15 @@ func AdaptBlobUrl(c azblob.BlockBlobURL) AzblobBlockBlobUrlIface {
 	return blobUrl{c}
 }
 
+func AdaptAppendBlobUrl(c azblob.AppendBlobURL) AzblobAppendBlobUrlIface {
+	return appendBlobUrl{c}
+}
+
 type (
-	serviceUrl   struct{ c azblob.ServiceURL }
-	containerUrl struct{ c azblob.ContainerURL }
-	blobUrl      struct{ c azblob.BlockBlobURL }
+	serviceUrl    struct{ c azblob.ServiceURL }
+	containerUrl  struct{ c azblob.ContainerURL }
+	blobUrl       struct{ c azblob.BlockBlobURL }
+	appendBlobUrl struct{ c azblob.AppendBlobURL }
 )
 
 func (c serviceUrl) NewContainerURL(bucket string) AzblobContainerUrlIface {
@@ -50,10 +55,18 @@ func (c serviceUrl) GetUserDelegationCredential(ctx context.Context, info azblob
 	return c.c.GetUserDelegationCredential(ctx, info, timeout, requestID)
 }
 
+func (c containerUrl) Url() url.URL {
+	return c.c.URL()
+}
+
 func (c containerUrl) NewBlockBlobURL(blob string) AzblobBlockBlobUrlIface {
 	return AdaptBlobUrl(c.c.NewBlockBlobURL(blob))
 }
 
+func (c containerUrl) NewAppendBlobURL(blob string) AzblobAppendBlobUrlIface {
+	return AdaptAppendBlobUrl(c.c.NewAppendBlobURL(blob))
+}
+
 func (c containerUrl) ListBlobsFlatSegment(ctx context.Context, marker azblob.Marker, o azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsFlatSegmentResponse, error) {
 	return c.c.ListBlobsFlatSegment(ctx, marker, o)
 }
@@ -73,3 +86,23 @@ func (c blobUrl) Upload(ctx context.Context, r io.ReadSeeker, h azblob.BlobHTTPH
 func (c blobUrl) Delete(ctx context.Context, dot azblob.DeleteSnapshotsOptionType, bac azblob.BlobAccessConditions) (*azblob.BlobDeleteResponse, error) {
 	return c.c.Delete(ctx, dot, bac)
 }
+
+func (c blobUrl) WithVersionID(versionID string) AzblobBlockBlobUrlIface {
+	return AdaptBlobUrl(c.c.WithVersionID(versionID))
+}
+
+func (c blobUrl) SetTags(ctx context.Context, timeout *int32, versionID *string, md5 []byte, crc64 []byte, requestID *string, ifTags *string, btm azblob.BlobTagsMap) (*azblob.BlobSetTagsResponse, error) {
+	return c.c.SetTags(ctx, timeout, versionID, md5, crc64, requestID, ifTags, btm)
+}
+
+func (c blobUrl) GetTags(ctx context.Context, timeout *int32, requestID *string, snapshot *string, versionID *string, ifTags *string) (*azblob.BlobTags, error) {
+	return c.c.GetTags(ctx, timeout, requestID, snapshot, versionID, ifTags)
+}
+
+func (c appendBlobUrl) Create(ctx context.Context, h azblob.BlobHTTPHeaders, m azblob.Metadata, bac azblob.BlobAccessConditions, btm azblob.BlobTagsMap, cpk azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobCreateResponse, error) {
+	return c.c.Create(ctx, h, m, bac, btm, cpk)
+}
+
+func (c appendBlobUrl) AppendBlock(ctx context.Context, r io.ReadSeeker, ac azblob.AppendBlobAccessConditions, md5 []byte, cpk azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobAppendBlockResponse, error) {
+	return c.c.AppendBlock(ctx, r, ac, md5, cpk)
+}
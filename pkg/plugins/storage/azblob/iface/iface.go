@@ -32,8 +32,10 @@ type AzblobServiceUrlIface interface {
 // AzblobContainerUrlIface - Mockable client interface
 // for azblob.ContainerUrl
 type AzblobContainerUrlIface interface {
+	Url() url.URL
 	ListBlobsFlatSegment(ctx context.Context, marker azblob.Marker, o azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsFlatSegmentResponse, error)
 	NewBlockBlobURL(string) AzblobBlockBlobUrlIface
+	NewAppendBlobURL(string) AzblobAppendBlobUrlIface
 }
 
 // AzblobBlockBlobUrlIface - Mockable client interface
@@ -43,10 +45,24 @@ type AzblobBlockBlobUrlIface interface {
 	Download(context.Context, int64, int64, azblob.BlobAccessConditions, bool, azblob.ClientProvidedKeyOptions) (AzblobDownloadResponse, error)
 	Upload(context.Context, io.ReadSeeker, azblob.BlobHTTPHeaders, azblob.Metadata, azblob.BlobAccessConditions, azblob.AccessTierType, azblob.BlobTagsMap, azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobUploadResponse, error)
 	Delete(context.Context, azblob.DeleteSnapshotsOptionType, azblob.BlobAccessConditions) (*azblob.BlobDeleteResponse, error)
+	WithVersionID(string) AzblobBlockBlobUrlIface
+	SetTags(ctx context.Context, timeout *int32, versionID *string, transactionalContentMD5 []byte, transactionalContentCrc64 []byte, requestID *string, ifTags *string, blobTagsMap azblob.BlobTagsMap) (*azblob.BlobSetTagsResponse, error)
+	GetTags(ctx context.Context, timeout *int32, requestID *string, snapshot *string, versionID *string, ifTags *string) (*azblob.BlobTags, error)
+}
+
+// AzblobAppendBlobUrlIface - Mockable client interface
+// for azblob.AppendBlobUrl
+type AzblobAppendBlobUrlIface interface {
+	Create(context.Context, azblob.BlobHTTPHeaders, azblob.Metadata, azblob.BlobAccessConditions, azblob.BlobTagsMap, azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobCreateResponse, error)
+	AppendBlock(context.Context, io.ReadSeeker, azblob.AppendBlobAccessConditions, []byte, azblob.ClientProvidedKeyOptions) (*azblob.AppendBlobAppendBlockResponse, error)
 }
 
 // AzblobDownloadResponse - Mockable client interface
 // for azblob.DownloadResponse
 type AzblobDownloadResponse interface {
 	Body(azblob.RetryReaderOptions) io.ReadCloser
+	ContentEncoding() string
+	ContentType() string
+	ContentMD5() []byte
+	ETag() azblob.ETag
 }
@@ -0,0 +1,71 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+)
+
+const (
+	ChecksumMD5    = "md5"
+	ChecksumSHA256 = "sha256"
+)
+
+// ChecksumMismatch - the message of the DataLoss errors returned when an item's content doesn't match its checksum
+const ChecksumMismatch = "checksum mismatch"
+
+var checksumHashes = map[string]func() hash.Hash{
+	ChecksumMD5:    md5.New,
+	ChecksumSHA256: sha256.New,
+}
+
+// ValidateChecksumAlgorithm - checks the algorithm is a supported checksum algorithm
+func ValidateChecksumAlgorithm(algorithm string) error {
+	if _, ok := checksumHashes[algorithm]; !ok {
+		return fmt.Errorf("unsupported checksum algorithm %s, expected %s or %s", algorithm, ChecksumMD5, ChecksumSHA256)
+	}
+
+	return nil
+}
+
+// Checksum - returns the base64 encoded digest of the object, the encoding used by S3, Cloud Storage and Azure for their checksums
+func Checksum(algorithm string, object []byte) (string, error) {
+	if err := ValidateChecksumAlgorithm(algorithm); err != nil {
+		return "", err
+	}
+
+	h := checksumHashes[algorithm]()
+	h.Write(object)
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyChecksum - checks the object has the given checksum, returning the object's checksum
+func VerifyChecksum(algorithm string, checksum string, object []byte) (string, error) {
+	actual, err := Checksum(algorithm, object)
+	if err != nil {
+		return "", err
+	}
+
+	if checksum != "" && actual != checksum {
+		return actual, fmt.Errorf("%s: expected %s %s, got %s", ChecksumMismatch, algorithm, checksum, actual)
+	}
+
+	return actual, nil
+}
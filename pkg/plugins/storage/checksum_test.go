@@ -0,0 +1,87 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("Checksum", func() {
+	payload := []byte("nitric")
+
+	When("Calculating an MD5 checksum", func() {
+		checksum, err := storage.Checksum(storage.ChecksumMD5, payload)
+
+		It("should return the base64 encoded digest", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(checksum).To(Equal("7/WN2BWteF8YSZ3Cx6pp4w=="))
+		})
+	})
+
+	When("Calculating a SHA256 checksum", func() {
+		checksum, err := storage.Checksum(storage.ChecksumSHA256, payload)
+
+		It("should return the base64 encoded digest", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(checksum).To(Equal("11/Js1rSwKcTHoT6mdRVX+MTAIfkISeShHm0COEWGrk="))
+		})
+	})
+
+	When("Calculating a checksum with an unknown algorithm", func() {
+		_, err := storage.Checksum("crc32", payload)
+
+		It("should return an error", func() {
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("VerifyChecksum", func() {
+		When("The object matches the checksum", func() {
+			checksum, err := storage.VerifyChecksum(storage.ChecksumSHA256, "11/Js1rSwKcTHoT6mdRVX+MTAIfkISeShHm0COEWGrk=", payload)
+
+			It("should return the checksum", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checksum).To(Equal("11/Js1rSwKcTHoT6mdRVX+MTAIfkISeShHm0COEWGrk="))
+			})
+		})
+
+		When("The object doesn't match the checksum", func() {
+			checksum, err := storage.VerifyChecksum(storage.ChecksumMD5, "1B2M2Y8AsgTpgAmY7PhCfg==", payload)
+
+			It("should return a checksum mismatch error", func() {
+				Expect(err).Should(HaveOccurred())
+				Expect(strings.HasPrefix(err.Error(), storage.ChecksumMismatch)).To(BeTrue())
+			})
+
+			It("should return the object's checksum", func() {
+				Expect(checksum).To(Equal("7/WN2BWteF8YSZ3Cx6pp4w=="))
+			})
+		})
+
+		When("No checksum is expected", func() {
+			checksum, err := storage.VerifyChecksum(storage.ChecksumMD5, "", payload)
+
+			It("should return the object's checksum", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(checksum).To(Equal("7/WN2BWteF8YSZ3Cx6pp4w=="))
+			})
+		})
+	})
+})
@@ -0,0 +1,225 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionNone - explicitly disables compression, e.g. to override a bucket default for a single write
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// Codec - A compression codec that can be applied to stored objects
+type Codec interface {
+	// ContentEncoding - the HTTP Content-Encoding token recorded against objects compressed with this codec
+	ContentEncoding() string
+	Compress([]byte) ([]byte, error)
+	Decompress([]byte) ([]byte, error)
+}
+
+var (
+	codecsLock = sync.RWMutex{}
+	codecs     = map[string]Codec{
+		CompressionGzip: &gzipCodec{},
+		CompressionZstd: &zstdCodec{},
+	}
+)
+
+// RegisterCodec - Registers a codec, replacing any existing codec with the same content encoding
+func RegisterCodec(codec Codec) {
+	codecsLock.Lock()
+	defer codecsLock.Unlock()
+
+	codecs[codec.ContentEncoding()] = codec
+}
+
+// GetCodec - Returns the registered codec for the given content encoding
+func GetCodec(encoding string) (Codec, error) {
+	codecsLock.RLock()
+	defer codecsLock.RUnlock()
+
+	if c, ok := codecs[strings.ToLower(encoding)]; ok {
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("unsupported compression codec %s", encoding)
+}
+
+// IsCompressed - returns true if the given codec name results in the object being compressed
+func IsCompressed(codec string) bool {
+	return codec != "" && codec != CompressionNone
+}
+
+// Compress - compresses the object with the named codec, returning the compressed object and the content encoding to store it with.
+// The object is returned unchanged with an empty content encoding if no compression was requested
+func Compress(codec string, object []byte) ([]byte, string, error) {
+	if !IsCompressed(codec) {
+		return object, "", nil
+	}
+
+	c, err := GetCodec(codec)
+	if err != nil {
+		return nil, "", err
+	}
+
+	compressed, err := c.Compress(object)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return compressed, c.ContentEncoding(), nil
+}
+
+// Decompress - decompresses an object according to the content encoding it was stored with.
+// Objects with no content encoding or an encoding with no registered codec are returned unchanged.
+func Decompress(contentEncoding string, object []byte) ([]byte, error) {
+	if !IsCompressed(contentEncoding) {
+		return object, nil
+	}
+
+	c, err := GetCodec(contentEncoding)
+	if err != nil {
+		// Not something we know how to decode, leave it to the caller
+		return object, nil
+	}
+
+	return c.Decompress(object)
+}
+
+type gzipCodec struct{}
+
+func (*gzipCodec) ContentEncoding() string {
+	return CompressionGzip
+}
+
+func (*gzipCodec) Compress(object []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+
+	if _, err := w.Write(object); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (*gzipCodec) Decompress(object []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(object))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+type zstdCodec struct{}
+
+func (*zstdCodec) ContentEncoding() string {
+	return CompressionZstd
+}
+
+func (*zstdCodec) Compress(object []byte) ([]byte, error) {
+	w, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	return w.EncodeAll(object, nil), nil
+}
+
+func (*zstdCodec) Decompress(object []byte) ([]byte, error) {
+	r, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return r.DecodeAll(object, nil)
+}
+
+// CompressionConfig - Determines which codec is applied to writes for each bucket
+type CompressionConfig struct {
+	// Default - the codec applied to buckets without an explicit codec
+	Default string
+	// Buckets - per bucket codecs, keyed by nitric bucket name
+	Buckets map[string]string
+}
+
+// CodecFor - returns the codec configured for the given bucket
+func (c *CompressionConfig) CodecFor(bucket string) string {
+	if c == nil {
+		return ""
+	}
+
+	if codec, ok := c.Buckets[bucket]; ok {
+		return codec
+	}
+
+	return c.Default
+}
+
+// ParseCompressionConfig - parses a compression configuration string
+// e.g. "gzip" applies gzip to all buckets
+// and "logs=zstd,images=none" applies zstd to the logs bucket and disables compression for images
+func ParseCompressionConfig(config string) (*CompressionConfig, error) {
+	c := &CompressionConfig{
+		Buckets: make(map[string]string),
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		bucket := ""
+		codec := entry
+		if parts := strings.SplitN(entry, "=", 2); len(parts) == 2 {
+			bucket = strings.TrimSpace(parts[0])
+			codec = strings.TrimSpace(parts[1])
+		}
+
+		if IsCompressed(codec) {
+			if _, err := GetCodec(codec); err != nil {
+				return nil, err
+			}
+		}
+
+		if bucket == "" {
+			c.Default = codec
+		} else {
+			c.Buckets[bucket] = codec
+		}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("Compression", func() {
+	payload := bytes.Repeat([]byte("nitric compression test payload "), 64)
+
+	Context("Compress", func() {
+		for _, codec := range []string{storage.CompressionGzip, storage.CompressionZstd} {
+			codec := codec
+
+			When("Compressing with "+codec, func() {
+				compressed, encoding, err := storage.Compress(codec, payload)
+
+				It("should compress the payload", func() {
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(encoding).To(Equal(codec))
+					Expect(len(compressed)).To(BeNumerically("<", len(payload)))
+				})
+
+				It("should decompress to the original payload", func() {
+					decompressed, err := storage.Decompress(encoding, compressed)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(decompressed).To(Equal(payload))
+				})
+			})
+		}
+
+		When("No compression is requested", func() {
+			compressed, encoding, err := storage.Compress(storage.CompressionNone, payload)
+
+			It("should return the payload unchanged", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(encoding).To(BeEmpty())
+				Expect(compressed).To(Equal(payload))
+			})
+		})
+
+		When("An unknown codec is requested", func() {
+			_, _, err := storage.Compress("lz4", payload)
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Decompress", func() {
+		When("The content encoding is unknown", func() {
+			decompressed, err := storage.Decompress("br", payload)
+
+			It("should return the object unchanged", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(decompressed).To(Equal(payload))
+			})
+		})
+
+		When("The object is corrupt", func() {
+			_, err := storage.Decompress(storage.CompressionGzip, payload)
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("ParseCompressionConfig", func() {
+		When("Given a default and bucket overrides", func() {
+			config, err := storage.ParseCompressionConfig("gzip, logs=zstd, images=none")
+
+			It("should apply the bucket codec", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.CodecFor("logs")).To(Equal(storage.CompressionZstd))
+				Expect(config.CodecFor("images")).To(Equal(storage.CompressionNone))
+			})
+
+			It("should fall back to the default codec", func() {
+				Expect(config.CodecFor("other")).To(Equal(storage.CompressionGzip))
+			})
+		})
+
+		When("Given an unknown codec", func() {
+			_, err := storage.ParseCompressionConfig("logs=lz4")
+
+			It("should return an error", func() {
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,50 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "time"
+
+// TemporaryCredentials - short-lived credentials for the provider's storage API, only allowed the granted access
+type TemporaryCredentials struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	// The provider's name for the bucket, and its region
+	Bucket string
+	Region string
+}
+
+// PostPolicy - a signed policy allowing items to be uploaded with an HTML form POST to Url
+type PostPolicy struct {
+	Url string
+	// Fields to include in the form before the file
+	Fields map[string]string
+}
+
+// SasToken - a shared access signature granting access to the items of a container
+type SasToken struct {
+	// The container's URL, with the token as its query string
+	Url   string
+	Token string
+}
+
+// AccessGrant - short-lived access to the items of a bucket, in the form the provider issues it
+type AccessGrant struct {
+	Credentials *TemporaryCredentials
+	PostPolicy  *PostPolicy
+	Sas         *SasToken
+	// When the grant stops being accepted
+	Expires time.Time
+}
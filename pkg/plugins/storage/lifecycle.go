@@ -0,0 +1,157 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// StorageClassStandard - frequently accessed objects, the provider default
+	StorageClassStandard = "standard"
+	// StorageClassInfrequent - objects read less than about once a month, cheaper to store but charged per read
+	StorageClassInfrequent = "infrequent"
+	// StorageClassArchive - objects that are rarely read, the cheapest to store with the highest read costs
+	StorageClassArchive = "archive"
+)
+
+// expireAction - the lifecycle action deleting objects, rather than transitioning them to a storage class
+const expireAction = "expire"
+
+// ValidateStorageClass - returns an error if the storage class isn't a nitric storage class, blank is the bucket default
+func ValidateStorageClass(class string) error {
+	switch class {
+	case "", StorageClassStandard, StorageClassInfrequent, StorageClassArchive:
+		return nil
+	default:
+		return fmt.Errorf("unsupported storage class %s, expected one of %s, %s or %s", class, StorageClassStandard, StorageClassInfrequent, StorageClassArchive)
+	}
+}
+
+// Transition - moves objects to a storage class a number of days after they're created
+type Transition struct {
+	StorageClass string
+	AfterDays    int
+}
+
+// LifecycleRule - transitions and expiry applied to the objects in a bucket with a key prefix
+type LifecycleRule struct {
+	// Prefix - the key prefix of the objects the rule applies to, blank for every object
+	Prefix      string
+	Transitions []Transition
+	// ExpireAfterDays - deletes objects this many days after they're created, never when zero
+	ExpireAfterDays int
+}
+
+// LifecycleService - implemented by storage plugins that can apply lifecycle rules to their buckets
+type LifecycleService interface {
+	// SetLifecycleRules - replaces the lifecycle rules of a bucket
+	SetLifecycleRules(bucket string, rules []*LifecycleRule) error
+}
+
+// parseDays - parses a number of days, e.g. 30d
+func parseDays(value string) (int, error) {
+	days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+	if err != nil || !strings.HasSuffix(value, "d") || days < 1 {
+		return 0, fmt.Errorf("invalid number of days %s, expected a positive number of days e.g. 30d", value)
+	}
+
+	return days, nil
+}
+
+// parseLifecycleRule - parses actions joined with +, each <storage class or expire>@<days>d
+func parseLifecycleRule(prefix string, actions string) (*LifecycleRule, error) {
+	rule := &LifecycleRule{
+		Prefix:      prefix,
+		Transitions: make([]Transition, 0),
+	}
+
+	for _, action := range strings.Split(actions, "+") {
+		parts := strings.SplitN(strings.TrimSpace(action), "@", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid lifecycle action %s, expected <storage class or expire>@<days>d", action)
+		}
+
+		days, err := parseDays(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if parts[0] == expireAction {
+			rule.ExpireAfterDays = days
+			continue
+		}
+
+		// Objects are created in the standard class, so they can only be transitioned to colder classes
+		if parts[0] != StorageClassInfrequent && parts[0] != StorageClassArchive {
+			return nil, fmt.Errorf("invalid lifecycle action %s, expected %s, %s or %s", action, StorageClassInfrequent, StorageClassArchive, expireAction)
+		}
+
+		rule.Transitions = append(rule.Transitions, Transition{
+			StorageClass: parts[0],
+			AfterDays:    days,
+		})
+	}
+
+	sort.Slice(rule.Transitions, func(i, j int) bool {
+		return rule.Transitions[i].AfterDays < rule.Transitions[j].AfterDays
+	})
+
+	if rule.ExpireAfterDays > 0 && len(rule.Transitions) > 0 && rule.Transitions[len(rule.Transitions)-1].AfterDays >= rule.ExpireAfterDays {
+		return nil, fmt.Errorf("invalid lifecycle rule %s, objects must be transitioned before they expire", actions)
+	}
+
+	return rule, nil
+}
+
+// ParseLifecycleConfig - parses lifecycle rules for buckets, keyed by nitric bucket name.
+// Rules are comma separated <bucket>[/<prefix>]=<actions>, where actions are joined with + and each is
+// <storage class or expire>@<days>d e.g. "logs=infrequent@30d+archive@90d+expire@365d,uploads/tmp/=expire@1d"
+func ParseLifecycleConfig(config string) (map[string][]*LifecycleRule, error) {
+	rules := make(map[string][]*LifecycleRule)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid lifecycle rule %s, expected <bucket>[/<prefix>]=<actions>", entry)
+		}
+
+		bucket, prefix := strings.TrimSpace(parts[0]), ""
+		if i := strings.Index(bucket, "/"); i >= 0 {
+			bucket, prefix = bucket[:i], bucket[i+1:]
+		}
+
+		if bucket == "" {
+			return nil, fmt.Errorf("invalid lifecycle rule %s, expected <bucket>[/<prefix>]=<actions>", entry)
+		}
+
+		rule, err := parseLifecycleRule(prefix, parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		rules[bucket] = append(rules[bucket], rule)
+	}
+
+	return rules, nil
+}
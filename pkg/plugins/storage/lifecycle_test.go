@@ -0,0 +1,93 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("Lifecycle", func() {
+	Context("ParseLifecycleConfig", func() {
+		When("given rules for buckets and prefixes", func() {
+			rules, err := storage.ParseLifecycleConfig("logs=archive@90d+infrequent@30d+expire@365d, uploads/tmp/=expire@1d")
+
+			It("should parse the rules for each bucket", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(rules).To(HaveLen(2))
+			})
+
+			It("should order transitions by age", func() {
+				Expect(rules["logs"]).To(Equal([]*storage.LifecycleRule{{
+					Transitions: []storage.Transition{
+						{StorageClass: storage.StorageClassInfrequent, AfterDays: 30},
+						{StorageClass: storage.StorageClassArchive, AfterDays: 90},
+					},
+					ExpireAfterDays: 365,
+				}}))
+			})
+
+			It("should parse the key prefix", func() {
+				Expect(rules["uploads"]).To(Equal([]*storage.LifecycleRule{{
+					Prefix:          "tmp/",
+					Transitions:     []storage.Transition{},
+					ExpireAfterDays: 1,
+				}}))
+			})
+		})
+
+		When("given an unknown storage class", func() {
+			It("should return an error", func() {
+				_, err := storage.ParseLifecycleConfig("logs=glacier@30d")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("transitioning objects to the standard storage class", func() {
+			It("should return an error", func() {
+				_, err := storage.ParseLifecycleConfig("logs=standard@30d")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("given days without a unit", func() {
+			It("should return an error", func() {
+				_, err := storage.ParseLifecycleConfig("logs=expire@30")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("objects expire before they're transitioned", func() {
+			It("should return an error", func() {
+				_, err := storage.ParseLifecycleConfig("logs=archive@90d+expire@30d")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("ValidateStorageClass", func() {
+		It("should accept nitric storage classes and blank", func() {
+			for _, class := range []string{"", storage.StorageClassStandard, storage.StorageClassInfrequent, storage.StorageClassArchive} {
+				Expect(storage.ValidateStorageClass(class)).To(Succeed())
+			}
+		})
+
+		It("should reject provider storage classes", func() {
+			Expect(storage.ValidateStorageClass("GLACIER")).ShouldNot(Succeed())
+		})
+	})
+})
@@ -94,5 +94,6 @@ func New() (storage.StorageService, error) {
 
 	s3Client := s3.New(newSession)
 
-	return s3_service.NewWithClient(nil, s3Client, s3_service.WithSelector(nameSelector))
+	// MinIO only supports the STANDARD and REDUCED_REDUNDANCY storage classes
+	return s3_service.NewWithClient(nil, s3Client, s3_service.WithSelector(nameSelector), s3_service.WithoutStorageClasses())
 }
@@ -0,0 +1,195 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "fmt"
+
+// WriteOptions - Optional behaviour for a storage write
+type WriteOptions struct {
+	// Compression - codec used to compress the object before it's stored, empty for no compression
+	Compression string
+	// StorageClass - storage class the object is stored in, empty for the bucket default
+	StorageClass string
+	// IfMatch - only write if the item's current ETag matches, empty to write regardless
+	IfMatch string
+	// IfNotExists - only write if the item doesn't already exist
+	IfNotExists bool
+	// IntegrityCheck - have the provider verify the bytes it stores against their MD5
+	IntegrityCheck bool
+	// ETag - set to the ETag of the item written, if not nil
+	ETag *string
+}
+
+type WriteOption = func(*WriteOptions)
+
+// WithCompression - compress the written object using the named codec
+func WithCompression(codec string) WriteOption {
+	return func(o *WriteOptions) {
+		o.Compression = codec
+	}
+}
+
+// WithStorageClass - store the written object in the given storage class
+func WithStorageClass(class string) WriteOption {
+	return func(o *WriteOptions) {
+		o.StorageClass = class
+	}
+}
+
+// WithIfMatch - only write the object if the item's current ETag matches the given ETag,
+// so writers can't overwrite changes they haven't seen
+func WithIfMatch(etag string) WriteOption {
+	return func(o *WriteOptions) {
+		o.IfMatch = etag
+	}
+}
+
+// WithIfNotExists - only write the object if the item doesn't already exist
+func WithIfNotExists() WriteOption {
+	return func(o *WriteOptions) {
+		o.IfNotExists = true
+	}
+}
+
+// WithIntegrityCheck - have the provider reject the write if the bytes it receives don't match their MD5,
+// failing with a DataLoss error
+func WithIntegrityCheck() WriteOption {
+	return func(o *WriteOptions) {
+		o.IntegrityCheck = true
+	}
+}
+
+// WithWrittenETag - stores the ETag of the item written in etag, for use as the precondition of a later write or delete
+func WithWrittenETag(etag *string) WriteOption {
+	return func(o *WriteOptions) {
+		o.ETag = etag
+	}
+}
+
+// ValidatePreconditions - checks a write's preconditions can both be met
+func ValidatePreconditions(wo *WriteOptions) error {
+	if wo.IfMatch != "" && wo.IfNotExists {
+		return fmt.Errorf("an item can't match an ETag if it doesn't exist, use either if match or if not exists")
+	}
+
+	return nil
+}
+
+// NewWriteOptions - Creates write options from the given option functions
+func NewWriteOptions(opts ...WriteOption) *WriteOptions {
+	wo := &WriteOptions{}
+
+	for _, o := range opts {
+		o(wo)
+	}
+
+	return wo
+}
+
+// ReadOptions - Optional behaviour for a storage read
+type ReadOptions struct {
+	// Version - the version of the item to read, empty for the current version
+	Version string
+	// IntegrityCheck - verify the bytes read against the checksum the provider stored for the item, if it has one
+	IntegrityCheck bool
+	// ETag - set to the ETag of the item read, if not nil
+	ETag *string
+}
+
+type ReadOption = func(*ReadOptions)
+
+// WithVersion - read the given version of the item instead of the current version
+func WithVersion(version string) ReadOption {
+	return func(o *ReadOptions) {
+		o.Version = version
+	}
+}
+
+// WithReadIntegrityCheck - fail the read with a DataLoss error if the bytes read don't match the item's stored checksum
+func WithReadIntegrityCheck() ReadOption {
+	return func(o *ReadOptions) {
+		o.IntegrityCheck = true
+	}
+}
+
+// WithReadETag - stores the ETag of the item read in etag, for use as the precondition of a later write or delete
+func WithReadETag(etag *string) ReadOption {
+	return func(o *ReadOptions) {
+		o.ETag = etag
+	}
+}
+
+// NewReadOptions - Creates read options from the given option functions
+func NewReadOptions(opts ...ReadOption) *ReadOptions {
+	ro := &ReadOptions{}
+
+	for _, o := range opts {
+		o(ro)
+	}
+
+	return ro
+}
+
+// DeleteOptions - Optional behaviour for a storage delete
+type DeleteOptions struct {
+	// IfMatch - only delete if the item's current ETag matches, empty to delete regardless
+	IfMatch string
+}
+
+type DeleteOption = func(*DeleteOptions)
+
+// WithDeleteIfMatch - only delete the item if its current ETag matches the given ETag
+func WithDeleteIfMatch(etag string) DeleteOption {
+	return func(o *DeleteOptions) {
+		o.IfMatch = etag
+	}
+}
+
+// NewDeleteOptions - Creates delete options from the given option functions
+func NewDeleteOptions(opts ...DeleteOption) *DeleteOptions {
+	do := &DeleteOptions{}
+
+	for _, o := range opts {
+		o(do)
+	}
+
+	return do
+}
+
+// ListOptions - Optional behaviour for listing the items in a bucket
+type ListOptions struct {
+	// Tags - only list items with all of these tags, empty to list every item
+	Tags map[string]string
+}
+
+type ListOption = func(*ListOptions)
+
+// WithTagFilter - only list items that have all of the given tags
+func WithTagFilter(tags map[string]string) ListOption {
+	return func(o *ListOptions) {
+		o.Tags = tags
+	}
+}
+
+// NewListOptions - Creates list options from the given option functions
+func NewListOptions(opts ...ListOption) *ListOptions {
+	lo := &ListOptions{}
+
+	for _, o := range opts {
+		o(lo)
+	}
+
+	return lo
+}
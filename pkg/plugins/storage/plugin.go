@@ -14,7 +14,10 @@
 
 package storage
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type Operation int
 
@@ -30,36 +33,118 @@ func (op Operation) String() string {
 
 type FileInfo struct {
 	Key string
+	// ETag - identifies the current content of the item, for conditional writes and deletes.
+	// Cloud Storage uses the item's generation
+	ETag string
+}
+
+// PreconditionFailed - the message of the FailedPrecondition errors returned when a conditional write or delete isn't applied
+const PreconditionFailed = "precondition failed"
+
+// VersionInfo - a stored version of an item in a versioned bucket
+type VersionInfo struct {
+	// Version - the provider's ID for the version, e.g. an S3 version ID, Cloud Storage generation or Azure blob version ID
+	Version string
+	// Latest - whether this is the item's current version
+	Latest       bool
+	LastModified time.Time
+	Size         int64
 }
 
 type StorageService interface {
-	Read(bucket string, key string) ([]byte, error)
-	Write(bucket string, key string, object []byte) error
-	Delete(bucket string, key string) error
-	ListFiles(bucket string) ([]*FileInfo, error)
+	// Read - reads an item, or one of its previous versions in a versioned bucket
+	Read(bucket string, key string, opts ...ReadOption) ([]byte, error)
+	// Write - stores an item, returning the ID of the version written, empty if the provider doesn't track versions for the bucket
+	Write(bucket string, key string, object []byte, opts ...WriteOption) (string, error)
+	// Append - adds to the end of an item, creating it if it doesn't already exist
+	Append(bucket string, key string, object []byte) error
+	// Delete - deletes an item, optionally only if it hasn't changed
+	Delete(bucket string, key string, opts ...DeleteOption) error
+	// ListFiles - lists the items in a bucket, optionally only those with the given tags
+	ListFiles(bucket string, opts ...ListOption) ([]*FileInfo, error)
 	PreSignUrl(bucket string, key string, operation Operation, expiry uint32) (string, error)
+	// ListVersions - lists the stored versions of an item in a versioned bucket, newest first
+	ListVersions(bucket string, key string) ([]*VersionInfo, error)
+	// RestoreVersion - makes a previous version of an item its current version, returning the ID of the new current version
+	RestoreVersion(bucket string, key string, version string) (string, error)
+	// SetTags - replaces the tags of an item
+	SetTags(bucket string, key string, tags map[string]string) error
+	// GetTags - returns the tags of an item
+	GetTags(bucket string, key string) (map[string]string, error)
+	// BeginUpload - starts an upload of an item in parts, returning the provider's ID for the upload
+	BeginUpload(bucket string, key string) (string, error)
+	// UploadPart - stores a part of an upload. Parts are uploaded in order, the final part completes the item's size
+	// and is the only part that may be smaller than MinUploadPartSize or not a multiple of UploadPartAlignment
+	UploadPart(bucket string, key string, upload string, part *UploadPart, data []byte, final bool) (*UploadPart, error)
+	// CompleteUpload - writes the item from the stored parts of an upload
+	CompleteUpload(bucket string, key string, upload string, parts []*UploadPart) error
+	// AbortUpload - discards an upload and its stored parts
+	AbortUpload(bucket string, key string, upload string) error
+	// GrantAccess - returns short-lived credentials for clients to perform the operations on the items of a bucket under a key prefix
+	// directly with the provider, an empty prefix grants access to every item
+	GrantAccess(bucket string, prefix string, operations []Operation, expiry uint32) (*AccessGrant, error)
 }
 
 type UnimplementedStoragePlugin struct{}
 
 var _ StorageService = (*UnimplementedStoragePlugin)(nil)
 
-func (*UnimplementedStoragePlugin) Read(bucket string, key string) ([]byte, error) {
+func (*UnimplementedStoragePlugin) Read(bucket string, key string, opts ...ReadOption) ([]byte, error) {
 	return nil, fmt.Errorf("UNIMPLEMENTED")
 }
 
-func (*UnimplementedStoragePlugin) Write(bucket string, key string, object []byte) error {
+func (*UnimplementedStoragePlugin) Write(bucket string, key string, object []byte, opts ...WriteOption) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) Append(bucket string, key string, object []byte) error {
 	return fmt.Errorf("UNIMPLEMENTED")
 }
 
-func (*UnimplementedStoragePlugin) Delete(bucket string, key string) error {
+func (*UnimplementedStoragePlugin) Delete(bucket string, key string, opts ...DeleteOption) error {
 	return fmt.Errorf("UNIMPLEMENTED")
 }
 
-func (*UnimplementedStoragePlugin) ListFiles(bucket string) ([]*FileInfo, error) {
+func (*UnimplementedStoragePlugin) ListFiles(bucket string, opts ...ListOption) ([]*FileInfo, error) {
 	return nil, fmt.Errorf("UNIMPLEMENTED")
 }
 
 func (*UnimplementedStoragePlugin) PreSignUrl(bucket string, key string, operation Operation, expiry uint32) (string, error) {
 	return "", fmt.Errorf("UNIMPLEMENTED")
 }
+
+func (*UnimplementedStoragePlugin) ListVersions(bucket string, key string) ([]*VersionInfo, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) RestoreVersion(bucket string, key string, version string) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) SetTags(bucket string, key string, tags map[string]string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) GetTags(bucket string, key string) (map[string]string, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) BeginUpload(bucket string, key string) (string, error) {
+	return "", fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) UploadPart(bucket string, key string, upload string, part *UploadPart, data []byte, final bool) (*UploadPart, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) CompleteUpload(bucket string, key string, upload string, parts []*UploadPart) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) AbortUpload(bucket string, key string, upload string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedStoragePlugin) GrantAccess(bucket string, prefix string, operations []Operation, expiry uint32) (*AccessGrant, error) {
+	return nil, fmt.Errorf("UNIMPLEMENTED")
+}
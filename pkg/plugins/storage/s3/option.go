@@ -14,6 +14,12 @@
 
 package s3_service
 
+import (
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+)
+
 type S3StorageServiceOption interface {
 	Apply(*S3StorageService)
 }
@@ -31,3 +37,50 @@ func WithSelector(selector BucketSelector) S3StorageServiceOption {
 		selector: selector,
 	}
 }
+
+type withPermissionSimulator struct {
+	simulator core.PermissionSimulator
+}
+
+func (w *withPermissionSimulator) Apply(service *S3StorageService) {
+	service.simulator = w.simulator
+}
+
+// WithPermissionSimulator - checks the permissions of operations with the simulator
+func WithPermissionSimulator(simulator core.PermissionSimulator) S3StorageServiceOption {
+	return &withPermissionSimulator{
+		simulator: simulator,
+	}
+}
+
+type withoutStorageClasses struct{}
+
+func (w *withoutStorageClasses) Apply(service *S3StorageService) {
+	service.ignoreStorageClasses = true
+}
+
+// WithoutStorageClasses - stores every object in the default storage class, for S3 compatible stores that only support STANDARD
+func WithoutStorageClasses() S3StorageServiceOption {
+	return &withoutStorageClasses{}
+}
+
+type withGrants struct {
+	sts       stsiface.STSAPI
+	grantRole string
+	region    string
+}
+
+func (w *withGrants) Apply(service *S3StorageService) {
+	service.sts = w.sts
+	service.grantRole = w.grantRole
+	service.region = w.region
+}
+
+// WithGrants - vends credentials for access grants with the STS client, assuming grantRole if it's not empty
+func WithGrants(client stsiface.STSAPI, grantRole string, region string) S3StorageServiceOption {
+	return &withGrants{
+		sts:       client,
+		grantRole: grantRole,
+		region:    region,
+	}
+}
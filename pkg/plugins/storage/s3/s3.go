@@ -16,21 +16,31 @@ package s3_service
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/pkg/plugins/storage"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/utils"
 )
 
@@ -38,17 +48,52 @@ const (
 	// ErrCodeNoSuchTagSet - AWS API neglects to include a constant for this error code.
 	ErrCodeNoSuchTagSet = "NoSuchTagSet"
 	ErrCodeAccessDenied = "AccessDenied"
+	// ErrCodeNotFound - returned by HeadObject for missing objects, in place of s3.ErrCodeNoSuchKey
+	ErrCodeNotFound           = "NotFound"
+	ErrCodePreconditionFailed = "PreconditionFailed"
+	// ErrCodeConditionalRequestConflict - returned when a conditional write races another write of the same key
+	ErrCodeConditionalRequestConflict = "ConditionalRequestConflict"
+	// ErrCodeBadDigest - returned when the Content-MD5 of a write doesn't match the bytes S3 received
+	ErrCodeBadDigest = "BadDigest"
 )
 
+// minGrantExpiry - the shortest lived credentials STS issues
+const minGrantExpiry = 900
+
+// grantSessionName - identifies the sessions of granted credentials in CloudTrail
+const grantSessionName = "nitric-storage-grant"
+
+// appendCopyMinSize - S3 will only copy an existing object into a multipart upload as a (non-final) part once it reaches 5MiB.
+// Appends to smaller objects are merged in the membrane instead.
+const appendCopyMinSize = 5 * 1024 * 1024
+
 // S3StorageService - Is the concrete implementation of AWS S3 for the Nitric Storage Plugin
 type S3StorageService struct {
 	// storage.UnimplementedStoragePlugin
 	client   s3iface.S3API
 	provider core.AwsProvider
 	selector BucketSelector
+	// simulates the IAM actions of operations, to check they're allowed without performing them
+	simulator core.PermissionSimulator
+	// ignoreStorageClasses - stores every object in the default storage class
+	ignoreStorageClasses bool
+	// vends credentials for access grants, federation tokens for the membrane's own user unless a role to assume is given
+	sts       stsiface.STSAPI
+	grantRole string
+	region    string
 	storage.UnimplementedStoragePlugin
 }
 
+var _ storage.LifecycleService = (*S3StorageService)(nil)
+
+// storageClasses - S3 storage classes for nitric storage classes.
+// Glacier Instant Retrieval is used for archived objects so they can be read without being restored first.
+var storageClasses = map[string]string{
+	storage.StorageClassStandard:   s3.StorageClassStandard,
+	storage.StorageClassInfrequent: s3.StorageClassStandardIa,
+	storage.StorageClassArchive:    "GLACIER_IR",
+}
+
 type BucketSelector = func(nitricName string) (*string, error)
 
 func (s *S3StorageService) getBucketName(bucket string) (*string, error) {
@@ -71,20 +116,29 @@ func (s *S3StorageService) getBucketName(bucket string) (*string, error) {
 }
 
 // Read - Retrieves an item from a bucket
-func (s *S3StorageService) Read(bucket string, key string) ([]byte, error) {
+func (s *S3StorageService) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	ro := storage.NewReadOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"S3StorageService.Read",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":         bucket,
+			"key":            key,
+			"version":        ro.Version,
+			"integrityCheck": ro.IntegrityCheck,
 		},
 	)
 
 	if b, err := s.getBucketName(bucket); err == nil {
-		resp, err := s.client.GetObject(&s3.GetObjectInput{
+		input := &s3.GetObjectInput{
 			Bucket: b,
 			Key:    aws.String(key),
-		})
+		}
+
+		if ro.Version != "" {
+			input.VersionId = aws.String(ro.Version)
+		}
+
+		resp, err := s.client.GetObject(input)
 		if err != nil {
 			return nil, newErr(
 				codes.NotFound,
@@ -95,7 +149,36 @@ func (s *S3StorageService) Read(bucket string, key string) ([]byte, error) {
 
 		defer resp.Body.Close()
 		// TODO: Wrap the possible error from ReadAll
-		return ioutil.ReadAll(resp.Body)
+		object, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		// The ETag is the checksum of the stored bytes, so it's checked before they're decompressed
+		if checksum, ok := etagChecksum(resp); ok && ro.IntegrityCheck {
+			if _, err := storage.VerifyChecksum(storage.ChecksumMD5, checksum, object); err != nil {
+				return nil, newErr(
+					codes.DataLoss,
+					storage.ChecksumMismatch,
+					err,
+				)
+			}
+		}
+
+		object, err = storage.Decompress(aws.StringValue(resp.ContentEncoding), object)
+		if err != nil {
+			return nil, newErr(
+				codes.DataLoss,
+				"unable to decompress object",
+				err,
+			)
+		}
+
+		if ro.ETag != nil {
+			*ro.ETag = aws.StringValue(resp.ETag)
+		}
+
+		return object, nil
 	} else {
 		return nil, newErr(
 			codes.NotFound,
@@ -105,69 +188,212 @@ func (s *S3StorageService) Read(bucket string, key string) ([]byte, error) {
 	}
 }
 
-// Write - Writes an item to a bucket
-func (s *S3StorageService) Write(bucket string, key string, object []byte) error {
+// Write - Writes an item to a bucket, returning its version ID if versioning is enabled for the bucket
+func (s *S3StorageService) Write(bucket string, key string, object []byte, opts ...storage.WriteOption) (string, error) {
+	wo := storage.NewWriteOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"S3StorageService.Write",
 		map[string]interface{}{
-			"bucket":     bucket,
-			"key":        key,
-			"object.len": len(object),
+			"bucket":         bucket,
+			"key":            key,
+			"object.len":     len(object),
+			"compression":    wo.Compression,
+			"class":          wo.StorageClass,
+			"ifMatch":        wo.IfMatch,
+			"ifNotExists":    wo.IfNotExists,
+			"integrityCheck": wo.IntegrityCheck,
 		},
 	)
 
+	if err := storage.ValidateStorageClass(wo.StorageClass); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid storage class",
+			err,
+		)
+	}
+
+	if err := storage.ValidatePreconditions(wo); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid preconditions",
+			err,
+		)
+	}
+
 	if b, err := s.getBucketName(bucket); err == nil {
 		contentType := http.DetectContentType(object)
 
-		if _, err := s.client.PutObject(&s3.PutObjectInput{
+		body, encoding, err := storage.Compress(wo.Compression, object)
+		if err != nil {
+			return "", newErr(
+				codes.InvalidArgument,
+				"unable to compress object",
+				err,
+			)
+		}
+
+		input := &s3.PutObjectInput{
 			Bucket:      b,
-			Body:        bytes.NewReader(object),
+			Body:        bytes.NewReader(body),
 			ContentType: &contentType,
 			Key:         aws.String(key),
-		}); err != nil {
-			return newErr(
+		}
+
+		if encoding != "" {
+			input.ContentEncoding = aws.String(encoding)
+		}
+
+		if wo.StorageClass != "" && !s.ignoreStorageClasses {
+			input.StorageClass = aws.String(storageClasses[wo.StorageClass])
+		}
+
+		if wo.IntegrityCheck {
+			// S3 rejects the write if the bytes it receives don't match the Content-MD5
+			checksum, _ := storage.Checksum(storage.ChecksumMD5, body)
+			input.ContentMD5 = aws.String(checksum)
+		}
+
+		reqOpts := make([]request.Option, 0)
+		if wo.IfMatch != "" {
+			reqOpts = append(reqOpts, withHeader("If-Match", wo.IfMatch))
+		}
+		if wo.IfNotExists {
+			reqOpts = append(reqOpts, withHeader("If-None-Match", "*"))
+		}
+
+		out, err := s.client.PutObjectWithContext(aws.BackgroundContext(), input, reqOpts...)
+		if err != nil {
+			if isPreconditionFailed(err) {
+				return "", newErr(
+					codes.FailedPrecondition,
+					storage.PreconditionFailed,
+					err,
+				)
+			}
+
+			if isAwsErrCode(err, ErrCodeBadDigest) {
+				return "", newErr(
+					codes.DataLoss,
+					storage.ChecksumMismatch,
+					err,
+				)
+			}
+
+			return "", newErr(
 				codes.Internal,
 				"unable to put object",
 				err,
 			)
 		}
+
+		if wo.ETag != nil {
+			*wo.ETag = aws.StringValue(out.ETag)
+		}
+
+		return aws.StringValue(out.VersionId), nil
 	} else {
-		return newErr(
+		return "", newErr(
 			codes.NotFound,
 			"unable to locate bucket",
 			err,
 		)
 	}
-
-	return nil
 }
 
-// Delete - Deletes an item from a bucket
-func (s *S3StorageService) Delete(bucket string, key string) error {
+// Append - Appends to an item in a bucket, creating it if it doesn't exist
+//
+// S3 objects are immutable, so the appended data is merged with the existing object server side
+// using a multipart upload, with the existing object copied in as the first part.
+// The existing object's ETag is a precondition of reading it and of writing the result, and new objects are only created
+// if they still don't exist, so concurrent appends fail rather than silently dropping data.
+func (s *S3StorageService) Append(bucket string, key string, object []byte) error {
 	newErr := errors.ErrorsWithScope(
-		"S3StorageService.Delete",
+		"S3StorageService.Append",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":     bucket,
+			"key":        key,
+			"object.len": len(object),
 		},
 	)
 
-	if b, err := s.getBucketName(bucket); err == nil {
-		// TODO: should we handle delete markers, etc.?
-		if _, err := s.client.DeleteObject(&s3.DeleteObjectInput{
-			Bucket: b,
-			Key:    aws.String(key),
-		}); err != nil {
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	head, err := s.client.HeadObject(&s3.HeadObjectInput{
+		Bucket: b,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if !isAwsErrCode(err, ErrCodeNotFound) {
 			return newErr(
 				codes.Internal,
-				"unable to delete object",
+				"unable to retrieve object attributes",
+				err,
+			)
+		}
+
+		// Nothing to append to yet, so this is a regular write, unless another append created the object first
+		if _, err := s.client.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+			Bucket:      b,
+			Body:        bytes.NewReader(object),
+			ContentType: aws.String(http.DetectContentType(object)),
+			Key:         aws.String(key),
+		}, withHeader("If-None-Match", "*")); err != nil {
+			if isPreconditionFailed(err) {
+				return newErr(
+					codes.Aborted,
+					"object was modified during append",
+					err,
+				)
+			}
+
+			return newErr(
+				codes.Internal,
+				"unable to put object",
 				err,
 			)
 		}
+
+		return nil
+	}
+
+	if aws.StringValue(head.ContentEncoding) != "" {
+		return newErr(
+			codes.FailedPrecondition,
+			"unable to append to a compressed object",
+			fmt.Errorf("object has content encoding %s", aws.StringValue(head.ContentEncoding)),
+		)
+	}
+
+	if len(object) == 0 {
+		return nil
+	}
+
+	if aws.Int64Value(head.ContentLength) < appendCopyMinSize {
+		err = s.appendMerge(b, key, head, object)
 	} else {
+		err = s.appendMultipart(b, key, head, object)
+	}
+
+	if err != nil {
+		if isPreconditionFailed(err) {
+			return newErr(
+				codes.Aborted,
+				"object was modified during append",
+				err,
+			)
+		}
+
 		return newErr(
-			codes.NotFound,
-			"unable to locate bucket",
+			codes.Internal,
+			"unable to append to object",
 			err,
 		)
 	}
@@ -175,115 +401,1004 @@ func (s *S3StorageService) Delete(bucket string, key string) error {
 	return nil
 }
 
-// PreSignUrl - generates a signed URL which can be used to perform direct operations on a file
-// useful for large file uploads/downloads so they can bypass application code and work directly with S3
-func (s *S3StorageService) PreSignUrl(bucket string, key string, operation storage.Operation, expiry uint32) (string, error) {
+// appendMerge - appends to an object too small to be copied as a multipart upload part, by rewriting it with the appended data
+func (s *S3StorageService) appendMerge(bucket *string, key string, head *s3.HeadObjectOutput, object []byte) error {
+	resp, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket:  bucket,
+		Key:     aws.String(key),
+		IfMatch: head.ETag,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	existing, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+		Bucket:      bucket,
+		Body:        bytes.NewReader(append(existing, object...)),
+		ContentType: head.ContentType,
+		Key:         aws.String(key),
+	}, withHeader("If-Match", aws.StringValue(head.ETag)))
+
+	return err
+}
+
+// appendMultipart - appends to an object by copying it into a new multipart upload of the same key, followed by the appended data
+func (s *S3StorageService) appendMultipart(bucket *string, key string, head *s3.HeadObjectOutput, object []byte) error {
+	upload, err := s.client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket:      bucket,
+		Key:         aws.String(key),
+		ContentType: head.ContentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	parts, err := s.uploadAppendParts(bucket, key, upload.UploadId, head, object)
+	if err == nil {
+		// The copied part only proves the object was unchanged when it was copied, so completing is conditional too
+		_, err = s.client.CompleteMultipartUploadWithContext(aws.BackgroundContext(), &s3.CompleteMultipartUploadInput{
+			Bucket:   bucket,
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+			MultipartUpload: &s3.CompletedMultipartUpload{
+				Parts: parts,
+			},
+		}, withHeader("If-Match", aws.StringValue(head.ETag)))
+	}
+
+	if err != nil {
+		// Don't leave the incomplete upload behind, it's billed until it's aborted
+		_, _ = s.client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:   bucket,
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+		})
+	}
+
+	return err
+}
+
+func (s *S3StorageService) uploadAppendParts(bucket *string, key string, uploadId *string, head *s3.HeadObjectOutput, object []byte) ([]*s3.CompletedPart, error) {
+	source := url.URL{Path: aws.StringValue(bucket) + "/" + key}
+
+	existing, err := s.client.UploadPartCopy(&s3.UploadPartCopyInput{
+		Bucket:            bucket,
+		Key:               aws.String(key),
+		UploadId:          uploadId,
+		PartNumber:        aws.Int64(1),
+		CopySource:        aws.String(source.EscapedPath()),
+		CopySourceIfMatch: head.ETag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appended, err := s.client.UploadPart(&s3.UploadPartInput{
+		Bucket:     bucket,
+		Key:        aws.String(key),
+		UploadId:   uploadId,
+		PartNumber: aws.Int64(2),
+		Body:       bytes.NewReader(object),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*s3.CompletedPart{
+		{ETag: existing.CopyPartResult.ETag, PartNumber: aws.Int64(1)},
+		{ETag: appended.ETag, PartNumber: aws.Int64(2)},
+	}, nil
+}
+
+// BeginUpload - starts a multipart upload of an item, returning its upload ID
+func (s *S3StorageService) BeginUpload(bucket string, key string) (string, error) {
 	newErr := errors.ErrorsWithScope(
-		"S3StorageService.PreSignUrl",
+		"S3StorageService.BeginUpload",
 		map[string]interface{}{
-			"bucket":    bucket,
-			"key":       key,
-			"operation": operation.String(),
+			"bucket": bucket,
+			"key":    key,
 		},
 	)
 
-	if b, err := s.getBucketName(bucket); err == nil {
-		switch operation {
-		case storage.READ:
-			req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
-				Bucket: b,
-				Key:    aws.String(key),
-			})
-			url, err := req.Presign(time.Duration(expiry) * time.Second)
-			if err != nil {
-				return "", newErr(
-					codes.Internal,
-					"failed to generate pre-signed READ URL",
-					err,
-				)
-			}
-			return url, err
-		case storage.WRITE:
-			req, _ := s.client.PutObjectRequest(&s3.PutObjectInput{
-				Bucket: b,
-				Key:    aws.String(key),
-			})
-			url, err := req.Presign(time.Duration(expiry) * time.Second)
-			if err != nil {
-				return "", newErr(
-					codes.Internal,
-					"failed to generate pre-signed WRITE URL",
-					err,
-				)
-			}
-			return url, err
-		default:
-			return "", fmt.Errorf("requested operation not supported for pre-signed AWS S3 urls")
-		}
-	} else {
+	b, err := s.getBucketName(bucket)
+	if err != nil {
 		return "", newErr(
 			codes.NotFound,
 			"unable to locate bucket",
 			err,
 		)
 	}
+
+	upload, err := s.client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: b,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"unable to create multipart upload",
+			err,
+		)
+	}
+
+	return aws.StringValue(upload.UploadId), nil
 }
 
-func (s *S3StorageService) ListFiles(bucket string) ([]*storage.FileInfo, error) {
+// UploadPart - uploads a part of a multipart upload, S3 parts don't need to know whether they're the last
+func (s *S3StorageService) UploadPart(bucket string, key string, upload string, part *storage.UploadPart, data []byte, final bool) (*storage.UploadPart, error) {
 	newErr := errors.ErrorsWithScope(
-		"S3StorageService.ListFiles",
+		"S3StorageService.UploadPart",
 		map[string]interface{}{
-			"bucket": bucket,
+			"bucket":   bucket,
+			"key":      key,
+			"part":     part.Number,
+			"data.len": len(data),
 		},
 	)
 
-	if b, err := s.getBucketName(bucket); err == nil {
-		objects, err := s.client.ListObjects(&s3.ListObjectsInput{
-			Bucket: b,
-		})
-		if err != nil {
-			return nil, newErr(
-				codes.Internal,
-				"unable to fetch file list",
-				err,
-			)
-		}
-
-		files := make([]*storage.FileInfo, 0, len(objects.Contents))
-		for _, o := range objects.Contents {
-			files = append(files, &storage.FileInfo{
-				Key: *o.Key,
-			})
-		}
-
-		return files, nil
-	} else {
+	b, err := s.getBucketName(bucket)
+	if err != nil {
 		return nil, newErr(
 			codes.NotFound,
 			"unable to locate bucket",
 			err,
 		)
 	}
-}
 
-// New creates a new default S3 storage plugin
-func New(provider core.AwsProvider) (storage.StorageService, error) {
-	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
-
-	sess, sessionError := session.NewSession(&aws.Config{
-		// FIXME: Use ENV configuration
-		Region: aws.String(awsRegion),
+	resp, err := s.client.UploadPart(&s3.UploadPartInput{
+		Bucket:     b,
+		Key:        aws.String(key),
+		UploadId:   aws.String(upload),
+		PartNumber: aws.Int64(int64(part.Number)),
+		Body:       bytes.NewReader(data),
 	})
-
-	if sessionError != nil {
-		return nil, fmt.Errorf("error creating new AWS session %v", sessionError)
+	if err != nil {
+		return nil, newErr(
+			uploadErrorCode(err),
+			"unable to upload part",
+			err,
+		)
 	}
 
-	s3Client := s3.New(sess)
+	return &storage.UploadPart{
+		Number: part.Number,
+		Offset: part.Offset,
+		Size:   int64(len(data)),
+		ETag:   aws.StringValue(resp.ETag),
+	}, nil
+}
 
-	return &S3StorageService{
-		client:   s3Client,
-		provider: provider,
+// CompleteUpload - completes a multipart upload from its uploaded parts
+func (s *S3StorageService) CompleteUpload(bucket string, key string, upload string, parts []*storage.UploadPart) error {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.CompleteUpload",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+			"parts":  len(parts),
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	completed := make([]*s3.CompletedPart, 0, len(parts))
+	for _, p := range parts {
+		completed = append(completed, &s3.CompletedPart{
+			ETag:       aws.String(p.ETag),
+			PartNumber: aws.Int64(int64(p.Number)),
+		})
+	}
+
+	if _, err := s.client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:   b,
+		Key:      aws.String(key),
+		UploadId: aws.String(upload),
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: completed,
+		},
+	}); err != nil {
+		return newErr(
+			uploadErrorCode(err),
+			"unable to complete multipart upload",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// AbortUpload - aborts a multipart upload, its parts are billed until it is
+func (s *S3StorageService) AbortUpload(bucket string, key string, upload string) error {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.AbortUpload",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	if _, err := s.client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   b,
+		Key:      aws.String(key),
+		UploadId: aws.String(upload),
+	}); err != nil {
+		return newErr(
+			uploadErrorCode(err),
+			"unable to abort multipart upload",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// uploadErrorCode - uploads that have been completed or aborted are no longer found
+func uploadErrorCode(err error) codes.Code {
+	if isAwsErrCode(err, s3.ErrCodeNoSuchUpload) {
+		return codes.NotFound
+	}
+
+	return codes.Internal
+}
+
+func isAwsErrCode(err error, code string) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == code
+	}
+
+	return false
+}
+
+// isPreconditionFailed - returns true if a conditional request wasn't applied because its preconditions weren't met
+func isPreconditionFailed(err error) bool {
+	return isAwsErrCode(err, ErrCodePreconditionFailed) || isAwsErrCode(err, ErrCodeConditionalRequestConflict)
+}
+
+// etagChecksum - returns the MD5 checksum of an object from its ETag.
+// ETags are the hex encoded MD5 of the stored bytes, except for objects uploaded in parts
+// or encrypted with a KMS or customer provided key, which have no MD5 to check against
+func etagChecksum(out *s3.GetObjectOutput) (string, bool) {
+	etag := strings.Trim(aws.StringValue(out.ETag), "\"")
+	if aws.StringValue(out.ServerSideEncryption) == s3.ServerSideEncryptionAwsKms || out.SSECustomerAlgorithm != nil {
+		return "", false
+	}
+
+	digest, err := hex.DecodeString(etag)
+	if err != nil || len(digest) != 16 {
+		return "", false
+	}
+
+	return base64.StdEncoding.EncodeToString(digest), true
+}
+
+// withHeader - sets a request header, for S3 conditional requests that this version of the SDK doesn't model
+func withHeader(name string, value string) request.Option {
+	return func(r *request.Request) {
+		r.HTTPRequest.Header.Set(name, value)
+	}
+}
+
+// Delete - Deletes an item from a bucket
+func (s *S3StorageService) Delete(bucket string, key string, opts ...storage.DeleteOption) error {
+	do := storage.NewDeleteOptions(opts...)
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.Delete",
+		map[string]interface{}{
+			"bucket":  bucket,
+			"key":     key,
+			"ifMatch": do.IfMatch,
+		},
+	)
+
+	if b, err := s.getBucketName(bucket); err == nil {
+		reqOpts := make([]request.Option, 0)
+		if do.IfMatch != "" {
+			reqOpts = append(reqOpts, withHeader("If-Match", do.IfMatch))
+		}
+
+		// TODO: should we handle delete markers, etc.?
+		if _, err := s.client.DeleteObjectWithContext(aws.BackgroundContext(), &s3.DeleteObjectInput{
+			Bucket: b,
+			Key:    aws.String(key),
+		}, reqOpts...); err != nil {
+			if isPreconditionFailed(err) {
+				return newErr(
+					codes.FailedPrecondition,
+					storage.PreconditionFailed,
+					err,
+				)
+			}
+
+			return newErr(
+				codes.Internal,
+				"unable to delete object",
+				err,
+			)
+		}
+	} else {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// PreSignUrl - generates a signed URL which can be used to perform direct operations on a file
+// useful for large file uploads/downloads so they can bypass application code and work directly with S3
+func (s *S3StorageService) PreSignUrl(bucket string, key string, operation storage.Operation, expiry uint32) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.PreSignUrl",
+		map[string]interface{}{
+			"bucket":    bucket,
+			"key":       key,
+			"operation": operation.String(),
+		},
+	)
+
+	if b, err := s.getBucketName(bucket); err == nil {
+		switch operation {
+		case storage.READ:
+			req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
+				Bucket: b,
+				Key:    aws.String(key),
+			})
+			url, err := req.Presign(time.Duration(expiry) * time.Second)
+			if err != nil {
+				return "", newErr(
+					codes.Internal,
+					"failed to generate pre-signed READ URL",
+					err,
+				)
+			}
+			return url, err
+		case storage.WRITE:
+			req, _ := s.client.PutObjectRequest(&s3.PutObjectInput{
+				Bucket: b,
+				Key:    aws.String(key),
+			})
+			url, err := req.Presign(time.Duration(expiry) * time.Second)
+			if err != nil {
+				return "", newErr(
+					codes.Internal,
+					"failed to generate pre-signed WRITE URL",
+					err,
+				)
+			}
+			return url, err
+		default:
+			return "", fmt.Errorf("requested operation not supported for pre-signed AWS S3 urls")
+		}
+	} else {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+}
+
+// grantPolicy - returns a session policy allowing the operations on the objects of a bucket under a prefix
+func grantPolicy(bucket string, prefix string, operations []storage.Operation) (string, error) {
+	type statement struct {
+		Effect    string
+		Action    []string
+		Resource  string
+		Condition map[string]map[string]string `json:",omitempty"`
+	}
+
+	actions := []string{}
+	statements := []statement{}
+	for _, op := range operations {
+		switch op {
+		case storage.READ:
+			actions = append(actions, "s3:GetObject")
+			statements = append(statements, statement{
+				Effect:   "Allow",
+				Action:   []string{"s3:ListBucket"},
+				Resource: fmt.Sprintf("arn:aws:s3:::%s", bucket),
+				Condition: map[string]map[string]string{
+					"StringLike": {"s3:prefix": prefix + "*"},
+				},
+			})
+		case storage.WRITE:
+			actions = append(actions, "s3:PutObject", "s3:AbortMultipartUpload")
+		}
+	}
+
+	statements = append(statements, statement{
+		Effect:   "Allow",
+		Action:   actions,
+		Resource: fmt.Sprintf("arn:aws:s3:::%s/%s*", bucket, prefix),
+	})
+
+	policy, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+
+	return string(policy), err
+}
+
+func (s *S3StorageService) GrantAccess(bucket string, prefix string, operations []storage.Operation, expiry uint32) (*storage.AccessGrant, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.GrantAccess",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+		},
+	)
+
+	if s.sts == nil {
+		return nil, newErr(codes.Unimplemented, "access grants aren't supported by this store", nil)
+	}
+
+	if expiry < minGrantExpiry {
+		return nil, newErr(codes.InvalidArgument, fmt.Sprintf("AWS credentials are issued for at least %d seconds", minGrantExpiry), nil)
+	}
+
+	// the prefix is matched with policy wildcards, which it can't contain without widening the grant
+	if strings.ContainsAny(prefix, "*?") {
+		return nil, newErr(codes.InvalidArgument, "prefixes of access grants can't contain * or ?", nil)
+	}
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	policy, err := grantPolicy(*b, prefix, operations)
+	if err != nil {
+		return nil, newErr(codes.Internal, "unable to create session policy", err)
+	}
+
+	var creds *sts.Credentials
+	if s.grantRole != "" {
+		out, err := s.sts.AssumeRole(&sts.AssumeRoleInput{
+			RoleArn:         aws.String(s.grantRole),
+			RoleSessionName: aws.String(grantSessionName),
+			Policy:          aws.String(policy),
+			DurationSeconds: aws.Int64(int64(expiry)),
+		})
+		if err != nil {
+			return nil, newErr(codes.Internal, "unable to assume role for access grant", err)
+		}
+		creds = out.Credentials
+	} else {
+		out, err := s.sts.GetFederationToken(&sts.GetFederationTokenInput{
+			Name:            aws.String(grantSessionName),
+			Policy:          aws.String(policy),
+			DurationSeconds: aws.Int64(int64(expiry)),
+		})
+		if err != nil {
+			return nil, newErr(codes.Internal, "unable to get federation token for access grant", err)
+		}
+		creds = out.Credentials
+	}
+
+	return &storage.AccessGrant{
+		Credentials: &storage.TemporaryCredentials{
+			AccessKeyId:     aws.StringValue(creds.AccessKeyId),
+			SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+			SessionToken:    aws.StringValue(creds.SessionToken),
+			Bucket:          *b,
+			Region:          s.region,
+		},
+		Expires: aws.TimeValue(creds.Expiration),
+	}, nil
+}
+
+func (s *S3StorageService) ListFiles(bucket string, opts ...storage.ListOption) ([]*storage.FileInfo, error) {
+	lo := storage.NewListOptions(opts...)
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.ListFiles",
+		map[string]interface{}{
+			"bucket": bucket,
+			"tags":   lo.Tags,
+		},
+	)
+
+	if b, err := s.getBucketName(bucket); err == nil {
+		objects, err := s.client.ListObjects(&s3.ListObjectsInput{
+			Bucket: b,
+		})
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"unable to fetch file list",
+				err,
+			)
+		}
+
+		files := make([]*storage.FileInfo, 0, len(objects.Contents))
+		for _, o := range objects.Contents {
+			// S3 can't list objects by tag, so the tags of each object are checked instead
+			if len(lo.Tags) > 0 {
+				tags, err := s.getObjectTags(b, aws.StringValue(o.Key))
+				if err != nil {
+					return nil, newErr(
+						codes.Internal,
+						"unable to fetch file tags",
+						err,
+					)
+				}
+
+				if !storage.MatchesTags(tags, lo.Tags) {
+					continue
+				}
+			}
+
+			files = append(files, &storage.FileInfo{
+				Key:  *o.Key,
+				ETag: aws.StringValue(o.ETag),
+			})
+		}
+
+		return files, nil
+	} else {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+}
+
+// ListVersions - lists the versions of an item, newest first. Delete markers are omitted
+func (s *S3StorageService) ListVersions(bucket string, key string) ([]*storage.VersionInfo, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.ListVersions",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	versions := make([]*storage.VersionInfo, 0)
+	err = s.client.ListObjectVersionsPages(&s3.ListObjectVersionsInput{
+		Bucket: b,
+		Prefix: aws.String(key),
+	}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, v := range page.Versions {
+			// The prefix also matches other items that start with the key
+			if aws.StringValue(v.Key) != key {
+				continue
+			}
+
+			versions = append(versions, &storage.VersionInfo{
+				Version:      aws.StringValue(v.VersionId),
+				Latest:       aws.BoolValue(v.IsLatest),
+				LastModified: aws.TimeValue(v.LastModified),
+				Size:         aws.Int64Value(v.Size),
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to list object versions",
+			err,
+		)
+	}
+
+	return versions, nil
+}
+
+// RestoreVersion - copies a previous version of an item over the item, making it the current version
+func (s *S3StorageService) RestoreVersion(bucket string, key string, version string) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.RestoreVersion",
+		map[string]interface{}{
+			"bucket":  bucket,
+			"key":     key,
+			"version": version,
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	source := url.URL{Path: aws.StringValue(b) + "/" + key}
+	out, err := s.client.CopyObject(&s3.CopyObjectInput{
+		Bucket:     b,
+		Key:        aws.String(key),
+		CopySource: aws.String(source.EscapedPath() + "?versionId=" + url.QueryEscape(version)),
+	})
+	if err != nil {
+		code := codes.Internal
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NoSuchVersion") {
+			code = codes.NotFound
+		}
+
+		return "", newErr(
+			code,
+			"unable to restore object version",
+			err,
+		)
+	}
+
+	return aws.StringValue(out.VersionId), nil
+}
+
+// SetTags - replaces the tag set of an object
+func (s *S3StorageService) SetTags(bucket string, key string, tags map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.SetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	if err := storage.ValidateTags(tags); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid tags",
+			err,
+		)
+	}
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tagSet := make([]*s3.Tag, 0, len(tags))
+	for _, k := range keys {
+		tagSet = append(tagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	if _, err := s.client.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket: b,
+		Key:    aws.String(key),
+		Tagging: &s3.Tagging{
+			TagSet: tagSet,
+		},
+	}); err != nil {
+		code := codes.Internal
+		if isAwsErrCode(err, s3.ErrCodeNoSuchKey) {
+			code = codes.NotFound
+		}
+
+		return newErr(
+			code,
+			"unable to tag object",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// GetTags - returns the tag set of an object
+func (s *S3StorageService) GetTags(bucket string, key string) (map[string]string, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.GetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	tags, err := s.getObjectTags(b, key)
+	if err != nil {
+		code := codes.Internal
+		if isAwsErrCode(err, s3.ErrCodeNoSuchKey) {
+			code = codes.NotFound
+		}
+
+		return nil, newErr(
+			code,
+			"unable to fetch object tags",
+			err,
+		)
+	}
+
+	return tags, nil
+}
+
+func (s *S3StorageService) getObjectTags(bucket *string, key string) (map[string]string, error) {
+	out, err := s.client.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket: bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(out.TagSet))
+	for _, t := range out.TagSet {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags, nil
+}
+
+// SetLifecycleRules - replaces the lifecycle configuration of a bucket, including any rules not created by nitric
+func (s *S3StorageService) SetLifecycleRules(bucket string, rules []*storage.LifecycleRule) error {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.SetLifecycleRules",
+		map[string]interface{}{
+			"bucket": bucket,
+		},
+	)
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	s3Rules := make([]*s3.LifecycleRule, 0, len(rules))
+	for i, rule := range rules {
+		s3Rule := &s3.LifecycleRule{
+			ID:     aws.String(fmt.Sprintf("nitric-%d", i)),
+			Status: aws.String(s3.ExpirationStatusEnabled),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(rule.Prefix),
+			},
+		}
+
+		for _, t := range rule.Transitions {
+			s3Rule.Transitions = append(s3Rule.Transitions, &s3.Transition{
+				Days:         aws.Int64(int64(t.AfterDays)),
+				StorageClass: aws.String(storageClasses[t.StorageClass]),
+			})
+		}
+
+		if rule.ExpireAfterDays > 0 {
+			s3Rule.Expiration = &s3.LifecycleExpiration{
+				Days: aws.Int64(int64(rule.ExpireAfterDays)),
+			}
+		}
+
+		s3Rules = append(s3Rules, s3Rule)
+	}
+
+	if len(s3Rules) == 0 {
+		if _, err := s.client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: b}); err != nil {
+			return newErr(
+				codes.Internal,
+				"unable to remove bucket lifecycle rules",
+				err,
+			)
+		}
+
+		return nil
+	}
+
+	if _, err := s.client.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: b,
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: s3Rules,
+		},
+	}); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to set bucket lifecycle rules",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Provision - creates the bucket if it doesn't exist, tagged with its nitric name and the stack's labels
+func (s *S3StorageService) Provision(bucket string, identity *stack.Identity) error {
+	_, err := s.Create(bucket, identity)
+
+	return err
+}
+
+// Create - creates the bucket if it doesn't exist, tagged with its nitric name and the stack's labels,
+// returning true if it was created
+func (s *S3StorageService) Create(bucket string, identity *stack.Identity) (bool, error) {
+	newErr := errors.ErrorsWithScope(
+		"S3StorageService.Create",
+		map[string]interface{}{
+			"bucket": bucket,
+		},
+	)
+
+	// Selected buckets aren't found by their tags, so they can't be provisioned
+	if s.selector != nil {
+		return false, nil
+	}
+
+	buckets, err := s.provider.GetResources(core.AwsResource_Bucket)
+	if err != nil {
+		return false, newErr(codes.Internal, "unable to list buckets", err)
+	}
+
+	if _, ok := buckets[bucket]; ok {
+		return false, nil
+	}
+
+	if s.sts == nil {
+		return false, newErr(codes.Unimplemented, "buckets can't be named without an STS client", nil)
+	}
+
+	caller, err := s.sts.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return false, newErr(codes.Internal, "unable to read the account ID", err)
+	}
+
+	// Bucket names are derived from the account, so instances provisioning the bucket at the same time create the same one.
+	// The tagging API is eventually consistent, so the bucket may exist without being found by its tags yet
+	name, err := resources.StableName(bucket, identity, aws.StringValue(caller.Account))
+	if err != nil {
+		return false, newErr(codes.InvalidArgument, "invalid bucket name", err)
+	}
+	created := false
+
+	if _, err := s.client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(name)}); err != nil {
+		if !isAwsErrCode(err, ErrCodeNotFound) {
+			return false, newErr(codes.Internal, "unable to check the bucket exists", err)
+		}
+
+		input := &s3.CreateBucketInput{
+			Bucket: aws.String(name),
+		}
+		// Buckets are created in us-east-1 unless another region is given
+		if region := utils.GetEnv("AWS_REGION", "us-east-1"); region != "us-east-1" {
+			input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+				LocationConstraint: aws.String(region),
+			}
+		}
+
+		_, err := s.client.CreateBucket(input)
+		if err != nil && !isAwsErrCode(err, s3.ErrCodeBucketAlreadyOwnedByYou) {
+			return false, newErr(codes.Internal, "unable to create bucket", err)
+		}
+		created = err == nil
+	}
+
+	tagSet := make([]*s3.Tag, 0)
+	for k, v := range resources.Labels(bucket, identity) {
+		tagSet = append(tagSet, &s3.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(tagSet, func(i, j int) bool {
+		return *tagSet[i].Key < *tagSet[j].Key
+	})
+
+	if _, err := s.client.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket:  aws.String(name),
+		Tagging: &s3.Tagging{TagSet: tagSet},
+	}); err != nil {
+		// An untagged bucket would never be found, so one created here is deleted rather than orphaned
+		if created {
+			if _, derr := s.client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(name)}); derr != nil {
+				return false, newErr(codes.Internal, fmt.Sprintf("unable to tag bucket, and unable to delete it: %v", derr), err)
+			}
+		}
+
+		return false, newErr(codes.Internal, "unable to tag bucket", err)
+	}
+
+	s.provider.AddResource(core.AwsResource_Bucket, bucket, "arn:aws:s3:::"+name)
+
+	return created, nil
+}
+
+// bucketActions - the IAM actions needed on a bucket for nitric actions
+var bucketActions = map[resources.Action][]string{
+	resources.BucketFileList: {"s3:ListBucket"},
+}
+
+// objectActions - the IAM actions needed on a bucket's objects for nitric actions
+var objectActions = map[resources.Action][]string{
+	resources.BucketFileGet:    {"s3:GetObject"},
+	resources.BucketFilePut:    {"s3:PutObject"},
+	resources.BucketFileDelete: {"s3:DeleteObject"},
+}
+
+// CheckPermissions - simulates the IAM actions needed for the nitric actions on the bucket
+func (s *S3StorageService) CheckPermissions(bucket string, actions []resources.Action) ([]resources.Permission, error) {
+	if s.simulator == nil {
+		return nil, fmt.Errorf("permissions can't be simulated without an IAM client")
+	}
+
+	b, err := s.getBucketName(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketArn := "arn:aws:s3:::" + *b
+	needed := map[string][]string{}
+	for _, a := range actions {
+		needed[bucketArn] = append(needed[bucketArn], bucketActions[a]...)
+		needed[bucketArn+"/*"] = append(needed[bucketArn+"/*"], objectActions[a]...)
+	}
+
+	return core.SimulatePermissions(s.simulator, needed)
+}
+
+// New creates a new default S3 storage plugin
+func New(provider core.AwsProvider) (storage.StorageService, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	s3Client := s3.New(sess)
+
+	return &S3StorageService{
+		client:    s3Client,
+		provider:  provider,
+		simulator: core.NewPermissionSimulator(sess),
+		sts:       sts.New(sess),
+		grantRole: utils.GetEnv("STORAGE_GRANT_ROLE_ARN", ""),
+		region:    aws.StringValue(sess.Config.Region),
 	}, nil
 }
 
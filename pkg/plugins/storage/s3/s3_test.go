@@ -16,24 +16,70 @@ package s3_service_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	mock_provider "github.com/nitrictech/nitric/mocks/provider"
 	mock_s3iface "github.com/nitrictech/nitric/mocks/s3"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
 	s3_service "github.com/nitrictech/nitric/pkg/plugins/storage/s3"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
+type fakeSimulator struct {
+	simulated map[string][]string
+}
+
+func (f *fakeSimulator) Simulate(resourceArn string, actions []string) (map[string]bool, error) {
+	f.simulated[resourceArn] = actions
+	return map[string]bool{"s3:ListBucket": true}, nil
+}
+
+type fakeSts struct {
+	stsiface.STSAPI
+	federation *sts.GetFederationTokenInput
+	assume     *sts.AssumeRoleInput
+}
+
+var grantCredentials = &sts.Credentials{
+	AccessKeyId:     aws.String("ASIAEXAMPLE"),
+	SecretAccessKey: aws.String("secret"),
+	SessionToken:    aws.String("token"),
+	Expiration:      aws.Time(time.Unix(1700000000, 0)),
+}
+
+func (f *fakeSts) GetFederationToken(in *sts.GetFederationTokenInput) (*sts.GetFederationTokenOutput, error) {
+	f.federation = in
+	return &sts.GetFederationTokenOutput{Credentials: grantCredentials}, nil
+}
+
+func (f *fakeSts) AssumeRole(in *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	f.assume = in
+	return &sts.AssumeRoleOutput{Credentials: grantCredentials}, nil
+}
+
+func (f *fakeSts) GetCallerIdentity(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil
+}
+
 var _ = Describe("S3", func() {
 	When("Write", func() {
 		When("Given the S3 backend is available", func() {
@@ -51,14 +97,217 @@ var _ = Describe("S3", func() {
 					}, nil)
 
 					By("writing the item")
-					mockStorage.EXPECT().PutObject(gomock.Any()).Return(&s3.PutObjectOutput{}, nil)
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).Return(&s3.PutObjectOutput{
+						ETag: aws.String("\"etag\""),
+					}, nil)
+
+					etag := ""
+					_, err := storagePlugin.Write("my-bucket", "test-item", testPayload, storage.WithWrittenETag(&etag))
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the written item's etag")
+					Expect(etag).To(Equal("\"etag\""))
+				})
+			})
+
+			When("Creating a compressed object in an existing bucket", func() {
+				testPayload := []byte("Test")
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should store the compressed object with its content encoding", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("writing the compressed item")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						Expect(*in.ContentEncoding).To(Equal("gzip"))
+						Expect(*in.ContentType).To(Equal(http.DetectContentType(testPayload)))
+
+						body, _ := ioutil.ReadAll(in.Body)
+						decompressed, err := storage.Decompress("gzip", body)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(decompressed).To(Equal(testPayload))
+
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", testPayload, storage.WithCompression(storage.CompressionGzip))
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("Creating an object in a storage class", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should store the object in the matching S3 storage class", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						Expect(*in.StorageClass).To(Equal(s3.StorageClassStandardIa))
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithStorageClass(storage.StorageClassInfrequent))
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("Storage classes are disabled", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithoutStorageClasses())
+				It("Should store the object in the default storage class", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						Expect(in.StorageClass).To(BeNil())
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithStorageClass(storage.StorageClassArchive))
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("Creating an object only if it doesn't exist", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should send a conditional put", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("writing the item with an If-None-Match header")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+						req.ApplyOptions(opts...)
+						Expect(req.HTTPRequest.Header.Get("If-None-Match")).To(Equal("*"))
+						return &s3.PutObjectOutput{}, nil
+					})
 
-					err := storagePlugin.Write("my-bucket", "test-item", testPayload)
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithIfNotExists())
 					By("Not returning an error")
 					Expect(err).ShouldNot(HaveOccurred())
 				})
 			})
 
+			When("Overwriting an object that has changed", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should fail with a precondition error", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("writing the item with an If-Match header")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+						req.ApplyOptions(opts...)
+						Expect(req.HTTPRequest.Header.Get("If-Match")).To(Equal("\"abc\""))
+						return nil, awserr.New(s3_service.ErrCodePreconditionFailed, "At least one of the pre-conditions you specified did not hold", nil)
+					})
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithIfMatch("\"abc\""))
+					By("Returning a failed precondition error")
+					Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+					Expect(err.Error()).To(ContainSubstring(storage.PreconditionFailed))
+				})
+			})
+
+			When("Creating an object with an integrity check", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should send the Content-MD5 of the object", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("writing the item with its Content-MD5")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						Expect(aws.StringValue(in.ContentMD5)).To(Equal("DLxmEfVUC9CAmjiNyVphWw=="))
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithIntegrityCheck())
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("The object is corrupted in transit", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should fail with a checksum mismatch", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("S3 rejecting the Content-MD5")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New(s3_service.ErrCodeBadDigest, "The Content-MD5 you specified did not match what we received.", nil))
+
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithIntegrityCheck())
+					By("Returning a data loss error")
+					Expect(errors.Code(err)).To(Equal(codes.DataLoss))
+					Expect(err.Error()).To(ContainSubstring(storage.ChecksumMismatch))
+				})
+			})
+
+			When("Creating an object with conflicting preconditions", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should fail to store the item", func() {
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithIfMatch("\"abc\""), storage.WithIfNotExists())
+					Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+				})
+			})
+
+			When("Creating an object in an unknown storage class", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+				It("Should fail to store the item", func() {
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"), storage.WithStorageClass("GLACIER"))
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
 			When("Creating an object in a non-existent bucket", func() {
 				ctrl := gomock.NewController(GinkgoT())
 				mockStorage := mock_s3iface.NewMockS3API(ctrl)
@@ -68,13 +317,313 @@ var _ = Describe("S3", func() {
 					By("the bucket not existing")
 					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{}, nil)
 
-					err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"))
+					_, err := storagePlugin.Write("my-bucket", "test-item", []byte("Test"))
 					By("Returning an error")
 					Expect(err).Should(HaveOccurred())
 				})
 			})
 		})
 	})
+	When("SetLifecycleRules", func() {
+		When("The bucket exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+			It("Should replace the bucket lifecycle configuration", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"my-bucket": "arn:aws:s3:::my-bucket",
+				}, nil)
+
+				mockStorage.EXPECT().PutBucketLifecycleConfiguration(gomock.Any()).DoAndReturn(func(in *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+					Expect(*in.Bucket).To(Equal("my-bucket"))
+					Expect(in.LifecycleConfiguration.Rules).To(HaveLen(1))
+
+					rule := in.LifecycleConfiguration.Rules[0]
+					Expect(*rule.Filter.Prefix).To(Equal("logs/"))
+					Expect(*rule.Transitions[0].StorageClass).To(Equal(s3.StorageClassStandardIa))
+					Expect(*rule.Transitions[0].Days).To(Equal(int64(30)))
+					Expect(*rule.Expiration.Days).To(Equal(int64(365)))
+
+					return &s3.PutBucketLifecycleConfigurationOutput{}, nil
+				})
+
+				err := storagePlugin.(storage.LifecycleService).SetLifecycleRules("my-bucket", []*storage.LifecycleRule{{
+					Prefix: "logs/",
+					Transitions: []storage.Transition{
+						{StorageClass: storage.StorageClassInfrequent, AfterDays: 30},
+					},
+					ExpireAfterDays: 365,
+				}})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+	When("Append", func() {
+		When("The bucket exists", func() {
+			When("The item doesn't exist", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+				It("Should create the item", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					By("the item not existing")
+					mockStorage.EXPECT().HeadObject(gomock.Any()).Return(nil, awserr.New(s3_service.ErrCodeNotFound, "not found", nil))
+
+					By("writing the appended data as the item, if it still doesn't exist")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						body, _ := ioutil.ReadAll(in.Body)
+						Expect(body).To(Equal([]byte("Test")))
+
+						req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+						req.ApplyOptions(opts...)
+						Expect(req.HTTPRequest.Header.Get("If-None-Match")).To(Equal("*"))
+
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					err := storagePlugin.Append("my-bucket", "test-item", []byte("Test"))
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("The item is small", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+				It("Should rewrite the item with the appended data", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(5),
+						ETag:          aws.String("etag"),
+					}, nil)
+
+					By("reading the version of the item that was inspected")
+					mockStorage.EXPECT().GetObject(&s3.GetObjectInput{
+						Bucket:  aws.String("my-bucket"),
+						Key:     aws.String("test-item"),
+						IfMatch: aws.String("etag"),
+					}).Return(&s3.GetObjectOutput{
+						Body: ioutil.NopCloser(bytes.NewReader([]byte("Test "))),
+					}, nil)
+
+					By("writing the item if it's still the version that was read")
+					mockStorage.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+						body, _ := ioutil.ReadAll(in.Body)
+						Expect(body).To(Equal([]byte("Test Append")))
+
+						req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+						req.ApplyOptions(opts...)
+						Expect(req.HTTPRequest.Header.Get("If-Match")).To(Equal("etag"))
+
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					err := storagePlugin.Append("my-bucket", "test-item", []byte("Append"))
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("The item is large", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+				It("Should copy the item into a multipart upload with the appended data", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(10 * 1024 * 1024),
+						ETag:          aws.String("etag"),
+					}, nil)
+
+					mockStorage.EXPECT().CreateMultipartUpload(gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+						UploadId: aws.String("upload"),
+					}, nil)
+
+					By("copying the existing item as the first part")
+					mockStorage.EXPECT().UploadPartCopy(&s3.UploadPartCopyInput{
+						Bucket:            aws.String("my-bucket"),
+						Key:               aws.String("test-item"),
+						UploadId:          aws.String("upload"),
+						PartNumber:        aws.Int64(1),
+						CopySource:        aws.String("my-bucket/test-item"),
+						CopySourceIfMatch: aws.String("etag"),
+					}).Return(&s3.UploadPartCopyOutput{
+						CopyPartResult: &s3.CopyPartResult{ETag: aws.String("part-1")},
+					}, nil)
+
+					By("uploading the appended data as the second part")
+					mockStorage.EXPECT().UploadPart(gomock.Any()).Return(&s3.UploadPartOutput{
+						ETag: aws.String("part-2"),
+					}, nil)
+
+					By("completing the upload if the item is still the version that was copied")
+					mockStorage.EXPECT().CompleteMultipartUploadWithContext(gomock.Any(), &s3.CompleteMultipartUploadInput{
+						Bucket:   aws.String("my-bucket"),
+						Key:      aws.String("test-item"),
+						UploadId: aws.String("upload"),
+						MultipartUpload: &s3.CompletedMultipartUpload{
+							Parts: []*s3.CompletedPart{
+								{ETag: aws.String("part-1"), PartNumber: aws.Int64(1)},
+								{ETag: aws.String("part-2"), PartNumber: aws.Int64(2)},
+							},
+						},
+					}, gomock.Any()).DoAndReturn(func(ctx aws.Context, in *s3.CompleteMultipartUploadInput, opts ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+						req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+						req.ApplyOptions(opts...)
+						Expect(req.HTTPRequest.Header.Get("If-Match")).To(Equal("etag"))
+
+						return &s3.CompleteMultipartUploadOutput{}, nil
+					})
+
+					err := storagePlugin.Append("my-bucket", "test-item", []byte("Append"))
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("The item is modified during the append", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+				It("Should abort the upload and return an error", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(10 * 1024 * 1024),
+						ETag:          aws.String("etag"),
+					}, nil)
+
+					mockStorage.EXPECT().CreateMultipartUpload(gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+						UploadId: aws.String("upload"),
+					}, nil)
+
+					mockStorage.EXPECT().UploadPartCopy(gomock.Any()).Return(nil, awserr.New(s3_service.ErrCodePreconditionFailed, "precondition failed", nil))
+
+					mockStorage.EXPECT().AbortMultipartUpload(gomock.Any()).Return(&s3.AbortMultipartUploadOutput{}, nil)
+
+					err := storagePlugin.Append("my-bucket", "test-item", []byte("Append"))
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("modified during append"))
+				})
+			})
+
+			When("The item is modified after it's copied", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorage := mock_s3iface.NewMockS3API(ctrl)
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+				It("Should abort the upload and return an error", func() {
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"my-bucket": "arn:aws:s3:::my-bucket",
+					}, nil)
+
+					mockStorage.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(10 * 1024 * 1024),
+						ETag:          aws.String("etag"),
+					}, nil)
+
+					mockStorage.EXPECT().CreateMultipartUpload(gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+						UploadId: aws.String("upload"),
+					}, nil)
+
+					mockStorage.EXPECT().UploadPartCopy(gomock.Any()).Return(&s3.UploadPartCopyOutput{
+						CopyPartResult: &s3.CopyPartResult{ETag: aws.String("part-1")},
+					}, nil)
+
+					mockStorage.EXPECT().UploadPart(gomock.Any()).Return(&s3.UploadPartOutput{
+						ETag: aws.String("part-2"),
+					}, nil)
+
+					mockStorage.EXPECT().CompleteMultipartUploadWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, awserr.New(s3_service.ErrCodeConditionalRequestConflict, "conflict", nil))
+
+					mockStorage.EXPECT().AbortMultipartUpload(gomock.Any()).Return(&s3.AbortMultipartUploadOutput{}, nil)
+
+					err := storagePlugin.Append("my-bucket", "test-item", []byte("Append"))
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("modified during append"))
+				})
+			})
+		})
+	})
+
+	When("Uploading in parts", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockStorage := mock_s3iface.NewMockS3API(ctrl)
+		mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+		storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+		It("Should map the upload to a multipart upload", func() {
+			mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+				"my-bucket": "arn:aws:s3:::my-bucket",
+			}, nil).AnyTimes()
+
+			mockStorage.EXPECT().CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+				Bucket: aws.String("my-bucket"),
+				Key:    aws.String("test-item"),
+			}).Return(&s3.CreateMultipartUploadOutput{UploadId: aws.String("upload-id")}, nil)
+
+			upload, err := storagePlugin.BeginUpload("my-bucket", "test-item")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(upload).To(Equal("upload-id"))
+
+			mockStorage.EXPECT().UploadPart(gomock.Any()).DoAndReturn(func(in *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+				Expect(*in.UploadId).To(Equal("upload-id"))
+				Expect(*in.PartNumber).To(Equal(int64(1)))
+
+				body, _ := ioutil.ReadAll(in.Body)
+				Expect(body).To(Equal([]byte("Test")))
+
+				return &s3.UploadPartOutput{ETag: aws.String("part-etag")}, nil
+			})
+
+			part, err := storagePlugin.UploadPart("my-bucket", "test-item", upload, &storage.UploadPart{Number: 1}, []byte("Test"), true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(part).To(Equal(&storage.UploadPart{Number: 1, Size: 4, ETag: "part-etag"}))
+
+			mockStorage.EXPECT().CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+				Bucket:   aws.String("my-bucket"),
+				Key:      aws.String("test-item"),
+				UploadId: aws.String("upload-id"),
+				MultipartUpload: &s3.CompletedMultipartUpload{
+					Parts: []*s3.CompletedPart{{ETag: aws.String("part-etag"), PartNumber: aws.Int64(1)}},
+				},
+			}).Return(&s3.CompleteMultipartUploadOutput{}, nil)
+
+			Expect(storagePlugin.CompleteUpload("my-bucket", "test-item", upload, []*storage.UploadPart{part})).To(Succeed())
+		})
+
+		It("Should report completed or aborted uploads as not found", func() {
+			mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+				"my-bucket": "arn:aws:s3:::my-bucket",
+			}, nil)
+
+			mockStorage.EXPECT().AbortMultipartUpload(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchUpload, "no such upload", nil))
+
+			err := storagePlugin.AbortUpload("my-bucket", "test-item", "upload-id")
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
 	When("Read", func() {
 		When("The S3 backend is available", func() {
 			When("The bucket exists", func() {
@@ -106,6 +655,112 @@ var _ = Describe("S3", func() {
 						Expect(object).To(Equal([]byte("Test")))
 					})
 				})
+				When("The item is read with an integrity check", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorage := mock_s3iface.NewMockS3API(ctrl)
+					mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+					storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+					It("Should return the object when it matches its ETag", func() {
+						mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+							"test-bucket": "arn:aws:s3:::test-bucket",
+						}, nil)
+
+						mockStorage.EXPECT().GetObject(gomock.Any()).Return(&s3.GetObjectOutput{
+							Body: ioutil.NopCloser(bytes.NewReader([]byte("Test"))),
+							ETag: aws.String("\"0cbc6611f5540bd0809a388dc95a615b\""),
+						}, nil)
+
+						object, err := storagePlugin.Read("test-bucket", "test-key", storage.WithReadIntegrityCheck())
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(object).To(Equal([]byte("Test")))
+					})
+
+					It("Should fail with a checksum mismatch when it doesn't match its ETag", func() {
+						mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+							"test-bucket": "arn:aws:s3:::test-bucket",
+						}, nil)
+
+						mockStorage.EXPECT().GetObject(gomock.Any()).Return(&s3.GetObjectOutput{
+							Body: ioutil.NopCloser(bytes.NewReader([]byte("Tost"))),
+							ETag: aws.String("\"0cbc6611f5540bd0809a388dc95a615b\""),
+						}, nil)
+
+						_, err := storagePlugin.Read("test-bucket", "test-key", storage.WithReadIntegrityCheck())
+						Expect(errors.Code(err)).To(Equal(codes.DataLoss))
+						Expect(err.Error()).To(ContainSubstring(storage.ChecksumMismatch))
+					})
+
+					It("Should skip the check for objects uploaded in parts", func() {
+						mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+							"test-bucket": "arn:aws:s3:::test-bucket",
+						}, nil)
+
+						mockStorage.EXPECT().GetObject(gomock.Any()).Return(&s3.GetObjectOutput{
+							Body: ioutil.NopCloser(bytes.NewReader([]byte("Tost"))),
+							ETag: aws.String("\"0cbc6611f5540bd0809a388dc95a615b-2\""),
+						}, nil)
+
+						_, err := storagePlugin.Read("test-bucket", "test-key", storage.WithReadIntegrityCheck())
+						Expect(err).ShouldNot(HaveOccurred())
+					})
+				})
+				When("The item is compressed", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorage := mock_s3iface.NewMockS3API(ctrl)
+					mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+					storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+					It("Should return the decompressed object", func() {
+						By("the bucket existing")
+						mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+							"test-bucket": "arn:aws:s3:::test-bucket",
+						}, nil)
+
+						By("the object existing with a content encoding")
+						compressed, _, _ := storage.Compress(storage.CompressionZstd, []byte("Test"))
+						mockStorage.EXPECT().GetObject(gomock.Any()).Return(&s3.GetObjectOutput{
+							Body:            ioutil.NopCloser(bytes.NewReader(compressed)),
+							ContentEncoding: aws.String("zstd"),
+						}, nil)
+
+						object, err := storagePlugin.Read("test-bucket", "test-key")
+						By("Not returning an error")
+						Expect(err).ShouldNot(HaveOccurred())
+
+						By("Returning the decompressed item")
+						Expect(object).To(Equal([]byte("Test")))
+					})
+				})
+				When("A version of the item is requested", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorage := mock_s3iface.NewMockS3API(ctrl)
+					mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+					storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+					It("Should retrieve that version of the object", func() {
+						By("the bucket existing")
+						mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+							"test-bucket": "arn:aws:s3:::test-bucket",
+						}, nil)
+
+						By("the version existing")
+						mockStorage.EXPECT().GetObject(&s3.GetObjectInput{
+							Bucket:    aws.String("test-bucket"),
+							Key:       aws.String("test-key"),
+							VersionId: aws.String("v1"),
+						}).Return(&s3.GetObjectOutput{
+							Body: ioutil.NopCloser(bytes.NewReader([]byte("Old"))),
+						}, nil)
+
+						object, err := storagePlugin.Read("test-bucket", "test-key", storage.WithVersion("v1"))
+						By("Not returning an error")
+						Expect(err).ShouldNot(HaveOccurred())
+
+						By("Returning the version")
+						Expect(object).To(Equal([]byte("Old")))
+					})
+				})
 				When("The item doesn't exist", func() {
 				})
 			})
@@ -113,6 +768,78 @@ var _ = Describe("S3", func() {
 			})
 		})
 	})
+	When("ListVersions", func() {
+		When("The item has several versions", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+			It("Should return only the versions of the item", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"test-bucket": "arn:aws:s3:::test-bucket",
+				}, nil)
+
+				By("the bucket containing versions of the item and another item sharing its prefix")
+				mockStorage.EXPECT().ListObjectVersionsPages(&s3.ListObjectVersionsInput{
+					Bucket: aws.String("test-bucket"),
+					Prefix: aws.String("test-key"),
+				}, gomock.Any()).DoAndReturn(func(in *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool) error {
+					fn(&s3.ListObjectVersionsOutput{
+						Versions: []*s3.ObjectVersion{
+							{Key: aws.String("test-key"), VersionId: aws.String("v2"), IsLatest: aws.Bool(true), Size: aws.Int64(3)},
+							{Key: aws.String("test-key"), VersionId: aws.String("v1"), IsLatest: aws.Bool(false), Size: aws.Int64(4)},
+							{Key: aws.String("test-key-2"), VersionId: aws.String("v3"), IsLatest: aws.Bool(true)},
+						},
+					}, true)
+					return nil
+				})
+
+				versions, err := storagePlugin.ListVersions("test-bucket", "test-key")
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the versions of the item")
+				Expect(versions).To(HaveLen(2))
+				Expect(versions[0].Version).To(Equal("v2"))
+				Expect(versions[0].Latest).To(BeTrue())
+				Expect(versions[1].Version).To(Equal("v1"))
+				Expect(versions[1].Size).To(Equal(int64(4)))
+			})
+		})
+	})
+	When("RestoreVersion", func() {
+		When("The version exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+
+			It("Should copy the version over the item", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"test-bucket": "arn:aws:s3:::test-bucket",
+				}, nil)
+
+				By("copying from the requested version")
+				mockStorage.EXPECT().CopyObject(&s3.CopyObjectInput{
+					Bucket:     aws.String("test-bucket"),
+					Key:        aws.String("dir/test key"),
+					CopySource: aws.String("test-bucket/dir/test%20key?versionId=v1"),
+				}).Return(&s3.CopyObjectOutput{
+					VersionId: aws.String("v3"),
+				}, nil)
+
+				version, err := storagePlugin.RestoreVersion("test-bucket", "dir/test key", "v1")
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the new version of the item")
+				Expect(version).To(Equal("v3"))
+			})
+		})
+	})
 	When("Delete", func() {
 		When("The S3 backend is available", func() {
 			When("The bucket exists", func() {
@@ -129,7 +856,7 @@ var _ = Describe("S3", func() {
 						}, nil)
 
 						By("successfully deleting the object")
-						mockStorage.EXPECT().DeleteObject(gomock.Any()).Return(&s3.DeleteObjectOutput{}, nil)
+						mockStorage.EXPECT().DeleteObjectWithContext(gomock.Any(), gomock.Any()).Return(&s3.DeleteObjectOutput{}, nil)
 
 						err := storagePlugin.Delete("test-bucket", "test-key")
 						By("Not returning an error")
@@ -203,6 +930,80 @@ var _ = Describe("S3", func() {
 		})
 	})
 
+	When("GrantAccess", func() {
+		When("The bucket exists", func() {
+			It("should vend a federation token restricted to the prefix", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				stsClient := &fakeSts{}
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, s3_service.WithGrants(stsClient, "", "us-east-1"))
+
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"test-bucket": "arn:aws:s3:::test-bucket-aaa111",
+				}, nil)
+
+				grant, err := storagePlugin.GrantAccess("test-bucket", "uploads/user-1/", []storage.Operation{storage.READ, storage.WRITE}, 3600)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(grant.Credentials).To(Equal(&storage.TemporaryCredentials{
+					AccessKeyId:     "ASIAEXAMPLE",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Bucket:          "test-bucket-aaa111",
+					Region:          "us-east-1",
+				}))
+				Expect(grant.Expires).To(Equal(time.Unix(1700000000, 0)))
+
+				By("restricting the token to the prefix")
+				Expect(*stsClient.federation.DurationSeconds).To(Equal(int64(3600)))
+				policy := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(*stsClient.federation.Policy), &policy)).To(Succeed())
+				statements := policy["Statement"].([]interface{})
+				Expect(statements).To(HaveLen(2))
+				Expect(statements[0].(map[string]interface{})["Condition"]).To(Equal(map[string]interface{}{
+					"StringLike": map[string]interface{}{"s3:prefix": "uploads/user-1/*"},
+				}))
+				Expect(statements[1].(map[string]interface{})["Resource"]).To(Equal("arn:aws:s3:::test-bucket-aaa111/uploads/user-1/*"))
+				Expect(statements[1].(map[string]interface{})["Action"]).To(ConsistOf("s3:GetObject", "s3:PutObject", "s3:AbortMultipartUpload"))
+			})
+
+			It("should assume the grant role when one is configured", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				stsClient := &fakeSts{}
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, s3_service.WithGrants(stsClient, "arn:aws:iam::123456789012:role/uploads", ""))
+
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"test-bucket": "arn:aws:s3:::test-bucket-aaa111",
+				}, nil)
+
+				_, err := storagePlugin.GrantAccess("test-bucket", "", []storage.Operation{storage.WRITE}, 900)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stsClient.federation).To(BeNil())
+				Expect(*stsClient.assume.RoleArn).To(Equal("arn:aws:iam::123456789012:role/uploads"))
+			})
+		})
+
+		It("should reject credentials shorter lived than STS issues", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mock_s3iface.NewMockS3API(ctrl), s3_service.WithGrants(&fakeSts{}, "", ""))
+
+			_, err := storagePlugin.GrantAccess("test-bucket", "", []storage.Operation{storage.READ}, 60)
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should reject prefixes containing policy wildcards", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mock_s3iface.NewMockS3API(ctrl), s3_service.WithGrants(&fakeSts{}, "", ""))
+
+			_, err := storagePlugin.GrantAccess("test-bucket", "uploads/*", []storage.Operation{storage.READ}, 3600)
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
 	When("ListFiles", func() {
 		When("The bucket exists", func() {
 			When("The s3 backend is available", func() {
@@ -238,6 +1039,220 @@ var _ = Describe("S3", func() {
 					Expect(files[0].Key).To(Equal("test"))
 				})
 			})
+
+			When("Filtering by tag", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient)
+
+				It("should only list the files with the tags", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+						"test-bucket": "arn:aws:s3:::test-bucket-aaa111",
+					}, nil)
+
+					By("s3 returning files")
+					mockStorageClient.EXPECT().ListObjects(gomock.Any()).Return(&s3.ListObjectsOutput{
+						Contents: []*s3.Object{
+							{Key: aws.String("invoice")},
+							{Key: aws.String("receipt")},
+						},
+					}, nil)
+
+					By("s3 returning the tags of each file")
+					mockStorageClient.EXPECT().GetObjectTagging(&s3.GetObjectTaggingInput{
+						Bucket: aws.String("test-bucket-aaa111"),
+						Key:    aws.String("invoice"),
+					}).Return(&s3.GetObjectTaggingOutput{
+						TagSet: []*s3.Tag{{Key: aws.String("class"), Value: aws.String("invoice")}},
+					}, nil)
+					mockStorageClient.EXPECT().GetObjectTagging(&s3.GetObjectTaggingInput{
+						Bucket: aws.String("test-bucket-aaa111"),
+						Key:    aws.String("receipt"),
+					}).Return(&s3.GetObjectTaggingOutput{
+						TagSet: []*s3.Tag{{Key: aws.String("class"), Value: aws.String("receipt")}},
+					}, nil)
+
+					files, err := storagePlugin.ListFiles("test-bucket", storage.WithTagFilter(map[string]string{"class": "invoice"}))
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the tagged file")
+					Expect(files).To(HaveLen(1))
+					Expect(files[0].Key).To(Equal("invoice"))
+				})
+			})
+		})
+	})
+
+	When("SetTags", func() {
+		When("The object exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient)
+
+			It("should replace the object's tag set", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"test-bucket": "arn:aws:s3:::test-bucket-aaa111",
+				}, nil)
+
+				By("putting the tags in key order")
+				mockStorageClient.EXPECT().PutObjectTagging(&s3.PutObjectTaggingInput{
+					Bucket: aws.String("test-bucket-aaa111"),
+					Key:    aws.String("test"),
+					Tagging: &s3.Tagging{
+						TagSet: []*s3.Tag{
+							{Key: aws.String("class"), Value: aws.String("invoice")},
+							{Key: aws.String("year"), Value: aws.String("2021")},
+						},
+					},
+				}).Return(&s3.PutObjectTaggingOutput{}, nil)
+
+				err := storagePlugin.SetTags("test-bucket", "test", map[string]string{"year": "2021", "class": "invoice"})
+
+				By("not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	When("Provision", func() {
+		When("The bucket exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage)
+			It("Should not create it", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+					"my-bucket": "arn:aws:s3:::my-bucket",
+				}, nil)
+
+				err := storagePlugin.(resources.Provisioner).Provision("my-bucket", &stack.Identity{Name: "shop"})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		identity := &stack.Identity{Name: "shop", Environment: "test"}
+		name, _ := resources.StableName("my-bucket", identity, "123456789012")
+		notFound := awserr.New(s3_service.ErrCodeNotFound, "Not Found", nil)
+
+		When("The bucket doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithGrants(&fakeSts{}, "", ""))
+			It("Should create and tag a bucket named for the account", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{}, nil)
+				mockStorage.EXPECT().HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(name)}).Return(nil, notFound)
+
+				var created string
+				mockStorage.EXPECT().CreateBucket(gomock.Any()).DoAndReturn(func(in *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+					created = *in.Bucket
+					return &s3.CreateBucketOutput{}, nil
+				})
+				mockStorage.EXPECT().PutBucketTagging(gomock.Any()).DoAndReturn(func(in *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error) {
+					Expect(*in.Bucket).To(Equal(created))
+					Expect(in.Tagging.TagSet).To(ConsistOf(
+						&s3.Tag{Key: aws.String("x-nitric-name"), Value: aws.String("my-bucket")},
+						&s3.Tag{Key: aws.String("x-nitric-stack"), Value: aws.String("shop")},
+						&s3.Tag{Key: aws.String("x-nitric-environment"), Value: aws.String("test")},
+					))
+					return &s3.PutBucketTaggingOutput{}, nil
+				})
+				mockProvider.EXPECT().AddResource(core.AwsResource_Bucket, "my-bucket", gomock.Any())
+
+				isNew, err := storagePlugin.(resources.Creator).Create("my-bucket", identity)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(isNew).To(BeTrue())
+				Expect(created).To(Equal(name))
+			})
+		})
+
+		When("The bucket exists but isn't found by its tags yet", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithGrants(&fakeSts{}, "", ""))
+			It("Should tag it rather than create another", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{}, nil)
+				mockStorage.EXPECT().HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(name)}).Return(&s3.HeadBucketOutput{}, nil)
+				mockStorage.EXPECT().PutBucketTagging(gomock.Any()).Return(&s3.PutBucketTaggingOutput{}, nil)
+				mockProvider.EXPECT().AddResource(core.AwsResource_Bucket, "my-bucket", "arn:aws:s3:::"+name)
+
+				isNew, err := storagePlugin.(resources.Creator).Create("my-bucket", identity)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(isNew).To(BeFalse())
+			})
+		})
+
+		When("Another instance creates the bucket first", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithGrants(&fakeSts{}, "", ""))
+			It("Should use its bucket", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{}, nil)
+				mockStorage.EXPECT().HeadBucket(gomock.Any()).Return(nil, notFound)
+				mockStorage.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded", nil))
+				mockStorage.EXPECT().PutBucketTagging(gomock.Any()).Return(&s3.PutBucketTaggingOutput{}, nil)
+				mockProvider.EXPECT().AddResource(core.AwsResource_Bucket, "my-bucket", "arn:aws:s3:::"+name)
+
+				isNew, err := storagePlugin.(resources.Creator).Create("my-bucket", identity)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(isNew).To(BeFalse())
+			})
+		})
+
+		When("The new bucket can't be tagged", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorage := mock_s3iface.NewMockS3API(ctrl)
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithGrants(&fakeSts{}, "", ""))
+			It("Should delete it", func() {
+				mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{}, nil)
+				mockStorage.EXPECT().HeadBucket(gomock.Any()).Return(nil, notFound)
+				mockStorage.EXPECT().CreateBucket(gomock.Any()).Return(&s3.CreateBucketOutput{}, nil)
+				mockStorage.EXPECT().PutBucketTagging(gomock.Any()).Return(nil, awserr.New(s3_service.ErrCodeAccessDenied, "Access Denied", nil))
+				mockStorage.EXPECT().DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(name)}).Return(&s3.DeleteBucketOutput{}, nil)
+
+				err := storagePlugin.(resources.Provisioner).Provision("my-bucket", identity)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	When("CheckPermissions", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockStorage := mock_s3iface.NewMockS3API(ctrl)
+		mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+		simulator := &fakeSimulator{simulated: map[string][]string{}}
+
+		storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorage, s3_service.WithPermissionSimulator(simulator))
+		It("Should simulate the bucket and object actions of the nitric actions", func() {
+			mockProvider.EXPECT().GetResources(core.AwsResource_Bucket).Return(map[string]string{
+				"my-bucket": "arn:aws:s3:::my-bucket-a1b2",
+			}, nil)
+
+			permissions, err := storagePlugin.(resources.PermissionChecker).CheckPermissions("my-bucket", []resources.Action{
+				resources.BucketFileList, resources.BucketFilePut,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(simulator.simulated).To(Equal(map[string][]string{
+				"*":                             {"tag:GetResources"},
+				"arn:aws:s3:::my-bucket-a1b2":   {"s3:ListBucket"},
+				"arn:aws:s3:::my-bucket-a1b2/*": {"s3:PutObject"},
+			}))
+			Expect(permissions).To(ContainElement(resources.Permission{Name: "s3:PutObject on arn:aws:s3:::my-bucket-a1b2/*", Granted: false}))
+			Expect(permissions).To(ContainElement(resources.Permission{Name: "s3:ListBucket on arn:aws:s3:::my-bucket-a1b2", Granted: true}))
 		})
 	})
 })
@@ -0,0 +1,39 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_service
+
+import "net/http"
+
+type StorageStorageServiceOption interface {
+	Apply(*StorageStorageService)
+}
+
+type withUploadClient struct {
+	client   *http.Client
+	endpoint string
+}
+
+func (w *withUploadClient) Apply(service *StorageStorageService) {
+	service.uploadClient = w.client
+	service.uploadEndpoint = w.endpoint
+}
+
+// WithUploadClient - creates resumable upload sessions with the client at the JSON API upload endpoint
+func WithUploadClient(client *http.Client, endpoint string) StorageStorageServiceOption {
+	return &withUploadClient{
+		client:   client,
+		endpoint: endpoint,
+	}
+}
@@ -15,34 +15,102 @@
 package storage_service
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/storage"
-	"golang.org/x/oauth2/google"
-	"google.golang.org/api/iamcredentials/v1"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
-	"google.golang.org/api/option"
 
 	ifaces_gcloud_storage "github.com/nitrictech/nitric/pkg/ifaces/gcloud_storage"
 	"github.com/nitrictech/nitric/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	plugin "github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type StorageStorageService struct {
 	plugin.UnimplementedStoragePlugin
-	client    ifaces_gcloud_storage.StorageClient
-	projectID string
-	cache     map[string]ifaces_gcloud_storage.BucketHandle
+	// provider - creates the clients and finds the project on first use, nil if they're already set
+	provider    core.GcpProvider
+	connectOnce sync.Once
+	connectErr  error
+	client      ifaces_gcloud_storage.StorageClient
+	projectID   string
+	cache       map[string]ifaces_gcloud_storage.BucketHandle
+	// names - the Cloud Storage names of the cached buckets, by nitric name
+	names map[string]string
+	// uploadClient - an authorized client for the JSON API's resumable upload sessions, which the storage client doesn't expose
+	uploadClient   *http.Client
+	uploadEndpoint string
+	// Buckets are filtered to those of the stack's environment, all are found if nil
+	identity *stack.Identity
+}
+
+var _ plugin.LifecycleService = (*StorageStorageService)(nil)
+
+// storageClasses - Cloud Storage classes for nitric storage classes
+var storageClasses = map[string]string{
+	plugin.StorageClassStandard:   "STANDARD",
+	plugin.StorageClassInfrequent: "NEARLINE",
+	plugin.StorageClassArchive:    "ARCHIVE",
+}
+
+// errBucketNotFound - returned when no bucket of the stack's environment has the nitric name
+var errBucketNotFound = fmt.Errorf("bucket not found")
+
+// connect - finds the project and creates the clients the first time they're needed, so unused plugins don't slow cold starts
+func (s *StorageStorageService) connect() error {
+	s.connectOnce.Do(func() {
+		if s.provider == nil {
+			return
+		}
+
+		// The provider's credentials have the cloud platform scope, required for signing blob urls
+		credentials, err := s.provider.Credentials()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		client, err := s.provider.StorageClient()
+		if err != nil {
+			s.connectErr = err
+			return
+		}
+
+		s.client = ifaces_gcloud_storage.AdaptStorageClient(client)
+		s.projectID = credentials.ProjectID
+		s.uploadClient = oauth2.NewClient(context.Background(), credentials.TokenSource)
+	})
+
+	return s.connectErr
 }
 
 func (s *StorageStorageService) getBucketByName(bucket string) (ifaces_gcloud_storage.BucketHandle, error) {
+	if err := s.connect(); err != nil {
+		return nil, fmt.Errorf("unable to connect to cloud storage: %v", err)
+	}
+
 	if s.cache == nil {
 		buckets := s.client.Buckets(context.Background(), s.projectID)
 		s.cache = make(map[string]ifaces_gcloud_storage.BucketHandle)
+		s.names = make(map[string]string)
 		for {
 			b, err := buckets.Next()
 			if err == iterator.Done {
@@ -52,8 +120,13 @@ func (s *StorageStorageService) getBucketByName(bucket string) (ifaces_gcloud_st
 				return nil, fmt.Errorf("an error occurred finding bucket: %s; %v", bucket, err)
 			}
 
-			if name, ok := b.Labels["x-nitric-name"]; ok {
+			if !s.identity.InEnvironment(b.Labels) {
+				continue
+			}
+
+			if name, ok := b.Labels[stack.NameKey]; ok {
 				s.cache[name] = s.client.Bucket(b.Name)
+				s.names[name] = b.Name
 			}
 		}
 	}
@@ -62,18 +135,22 @@ func (s *StorageStorageService) getBucketByName(bucket string) (ifaces_gcloud_st
 		return b, nil
 	}
 
-	return nil, fmt.Errorf("bucket not found")
+	return nil, errBucketNotFound
 }
 
 /**
  * Retrieves a previously stored object from a Google Cloud Storage Bucket
+ *
+ * Versions are object generations, previous generations are only kept by buckets with object versioning enabled
  */
-func (s *StorageStorageService) Read(bucket string, key string) ([]byte, error) {
+func (s *StorageStorageService) Read(bucket string, key string, opts ...plugin.ReadOption) ([]byte, error) {
+	ro := plugin.NewReadOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"StorageStorageService.Read",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":  bucket,
+			"key":     key,
+			"version": ro.Version,
 		},
 	)
 
@@ -86,7 +163,21 @@ func (s *StorageStorageService) Read(bucket string, key string) ([]byte, error)
 		)
 	}
 
-	reader, err := bucketHandle.Object(key).NewReader(context.Background())
+	object := bucketHandle.Object(key)
+	if ro.Version != "" {
+		generation, err := parseGeneration(ro.Version)
+		if err != nil {
+			return nil, newErr(
+				codes.InvalidArgument,
+				"invalid object version",
+				err,
+			)
+		}
+		object = object.Generation(generation)
+	}
+
+	// Readers check the CRC32C of the whole object as it's read, so reads are always integrity checked
+	reader, err := object.NewReader(context.Background())
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -105,35 +196,112 @@ func (s *StorageStorageService) Read(bucket string, key string) ([]byte, error)
 		)
 	}
 
+	bytes, err = plugin.Decompress(reader.ContentEncoding(), bytes)
+	if err != nil {
+		return nil, newErr(
+			codes.DataLoss,
+			"unable to decompress object",
+			err,
+		)
+	}
+
+	if ro.ETag != nil {
+		*ro.ETag = strconv.FormatInt(reader.Generation(), 10)
+	}
+
 	return bytes, nil
 }
 
 /**
  * Stores a new Item in a Google Cloud Storage Bucket
  */
-func (s *StorageStorageService) Write(bucket string, key string, object []byte) error {
+func (s *StorageStorageService) Write(bucket string, key string, object []byte, opts ...plugin.WriteOption) (string, error) {
+	wo := plugin.NewWriteOptions(opts...)
 	newErr := errors.ErrorsWithScope(
 		"StorageStorageService.Write",
 		map[string]interface{}{
-			"bucket":     bucket,
-			"key":        key,
-			"object.len": len(object),
+			"bucket":         bucket,
+			"key":            key,
+			"object.len":     len(object),
+			"compression":    wo.Compression,
+			"class":          wo.StorageClass,
+			"ifMatch":        wo.IfMatch,
+			"ifNotExists":    wo.IfNotExists,
+			"integrityCheck": wo.IntegrityCheck,
 		},
 	)
 
+	if err := plugin.ValidateStorageClass(wo.StorageClass); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid storage class",
+			err,
+		)
+	}
+
+	if err := plugin.ValidatePreconditions(wo); err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid preconditions",
+			err,
+		)
+	}
+
 	bucketHandle, err := s.getBucketByName(bucket)
 	if err != nil {
-		return newErr(
+		return "", newErr(
 			codes.NotFound,
 			"unable to locate bucket",
 			err,
 		)
 	}
 
-	writer := bucketHandle.Object(key).NewWriter(context.Background())
+	body, encoding, err := plugin.Compress(wo.Compression, object)
+	if err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"unable to compress object",
+			err,
+		)
+	}
 
-	if _, err := writer.Write(object); err != nil {
-		return newErr(
+	obj := bucketHandle.Object(key)
+	if wo.IfMatch != "" {
+		generation, err := parseGeneration(wo.IfMatch)
+		if err != nil {
+			return "", newErr(
+				codes.InvalidArgument,
+				"invalid etag",
+				err,
+			)
+		}
+		obj = obj.If(storage.Conditions{GenerationMatch: generation})
+	}
+	if wo.IfNotExists {
+		obj = obj.If(storage.Conditions{DoesNotExist: true})
+	}
+
+	writer := obj.NewWriter(context.Background())
+
+	if encoding != "" {
+		attrs := writer.ObjectAttrs()
+		attrs.ContentEncoding = encoding
+		// Keep the content type of the original object, rather than sniffing the compressed bytes
+		attrs.ContentType = http.DetectContentType(object)
+	}
+
+	if wo.StorageClass != "" {
+		writer.ObjectAttrs().StorageClass = storageClasses[wo.StorageClass]
+	}
+
+	if wo.IntegrityCheck {
+		// Cloud Storage rejects the upload if the bytes it receives don't match the MD5
+		digest := md5.Sum(body)
+		writer.ObjectAttrs().MD5 = digest[:]
+	}
+
+	if _, err := writer.Write(body); err != nil {
+		return "", newErr(
 			codes.Internal,
 			"unable to write object",
 			err,
@@ -141,25 +309,56 @@ func (s *StorageStorageService) Write(bucket string, key string, object []byte)
 	}
 
 	if err := writer.Close(); err != nil {
-		return newErr(
+		// Preconditions are checked when the upload completes
+		if isPreconditionFailed(err) {
+			return "", newErr(
+				codes.FailedPrecondition,
+				plugin.PreconditionFailed,
+				err,
+			)
+		}
+
+		if isDigestMismatch(err) {
+			return "", newErr(
+				codes.DataLoss,
+				plugin.ChecksumMismatch,
+				err,
+			)
+		}
+
+		return "", newErr(
 			codes.Internal,
 			"error closing object write",
 			err,
 		)
 	}
 
-	return nil
+	generation := strconv.FormatInt(writer.Attrs().Generation, 10)
+	if wo.ETag != nil {
+		// Cloud Storage ETags are the object's generation, which is also its version
+		*wo.ETag = generation
+	}
+
+	return generation, nil
 }
 
 /**
- * Delete an Item in a Google Cloud Storage Bucket
+ * Appends to an Item in a Google Cloud Storage Bucket, creating it if it doesn't exist
+ *
+ * The appended data is uploaded as a temporary object and composed onto the end of the existing item,
+ * so the item is never downloaded. Composition is conditional on the generation of the existing item,
+ * concurrent appends will fail rather than overwrite one another.
+ *
+ * Composite objects are limited to 1024 components, so an item about to reach the limit is compacted instead,
+ * by rewriting it with the appended data as a single object.
  */
-func (s *StorageStorageService) Delete(bucket string, key string) error {
+func (s *StorageStorageService) Append(bucket string, key string, object []byte) error {
 	newErr := errors.ErrorsWithScope(
-		"StorageStorageService.Delete",
+		"StorageStorageService.Append",
 		map[string]interface{}{
-			"bucket": bucket,
-			"key":    key,
+			"bucket":     bucket,
+			"key":        key,
+			"object.len": len(object),
 		},
 	)
 
@@ -172,32 +371,193 @@ func (s *StorageStorageService) Delete(bucket string, key string) error {
 		)
 	}
 
-	if err := bucketHandle.Object(key).Delete(context.Background()); err != nil {
-		// ignore errors caused by the Object not existing.
-		// This is to unify delete behavior between providers.
-		if err != storage.ErrObjectNotExist {
+	ctx := context.Background()
+	obj := bucketHandle.Object(key)
+
+	attrs, err := obj.Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		// Nothing to append to yet, so create the item with the appended data
+		if err := writeObject(ctx, obj.If(storage.Conditions{DoesNotExist: true}), object); err != nil {
 			return newErr(
-				codes.NotFound,
-				"object does not exist",
+				appendErrorCode(err),
+				"unable to write object",
+				err,
+			)
+		}
+
+		return nil
+	} else if err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to retrieve object attributes",
+			err,
+		)
+	}
+
+	if attrs.ContentEncoding != "" {
+		return newErr(
+			codes.FailedPrecondition,
+			"unable to append to a compressed object",
+			fmt.Errorf("object has content encoding %s", attrs.ContentEncoding),
+		)
+	}
+
+	if len(object) == 0 {
+		return nil
+	}
+
+	if components(attrs) >= maxComposeComponents {
+		if err := compactAppend(ctx, obj, attrs, object); err != nil {
+			return newErr(
+				appendErrorCode(err),
+				"unable to compact object",
 				err,
 			)
 		}
+
+		return nil
+	}
+
+	chunk := bucketHandle.Object(fmt.Sprintf("%s.append-%s", key, uuid.New().String()))
+	if err := writeObject(ctx, chunk, object); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to write appended data",
+			err,
+		)
+	}
+	// The chunk is only needed until it's been composed into the item
+	defer func() {
+		_ = chunk.Delete(ctx)
+	}()
+
+	composer := obj.If(storage.Conditions{GenerationMatch: attrs.Generation}).ComposerFrom(obj, chunk)
+	composer.ObjectAttrs().ContentType = attrs.ContentType
+	composer.ObjectAttrs().Metadata = withComponents(attrs.Metadata, components(attrs)+1)
+
+	if _, err := composer.Run(ctx); err != nil {
+		return newErr(
+			appendErrorCode(err),
+			"unable to append to object",
+			err,
+		)
 	}
 
 	return nil
 }
 
-func (s *StorageStorageService) PreSignUrl(bucket string, key string, operation plugin.Operation, expiry uint32) (string, error) {
+// maxComposeComponents - the most components a composite object can have
+const maxComposeComponents = 1024
+
+// componentsMetadataKey - counts the components of items composed by appends, as the client doesn't report them
+const componentsMetadataKey = "x-nitric-components"
+
+// components - returns the number of components of an item, items that haven't been appended to have one
+func components(attrs *storage.ObjectAttrs) int64 {
+	n, err := strconv.ParseInt(attrs.Metadata[componentsMetadataKey], 10, 64)
+	if err != nil || n < 1 {
+		return 1
+	}
+
+	return n
+}
+
+// withComponents - returns a copy of an item's metadata recording its number of components
+func withComponents(metadata map[string]string, n int64) map[string]string {
+	m := make(map[string]string, len(metadata)+1)
+	for k, v := range metadata {
+		m[k] = v
+	}
+	m[componentsMetadataKey] = strconv.FormatInt(n, 10)
+
+	return m
+}
+
+// compactAppend - rewrites an item followed by the appended data as a new, non-composite generation of the item,
+// streaming the existing generation so the item isn't held in memory
+func compactAppend(ctx context.Context, obj ifaces_gcloud_storage.ObjectHandle, attrs *storage.ObjectAttrs, object []byte) error {
+	reader, err := obj.Generation(attrs.Generation).NewReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	// Canceling the write before closing it discards the partial object, rather than committing it
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := obj.If(storage.Conditions{GenerationMatch: attrs.Generation}).NewWriter(writeCtx)
+	writer.ObjectAttrs().ContentType = attrs.ContentType
+	writer.ObjectAttrs().Metadata = withComponents(attrs.Metadata, 1)
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		cancel()
+		_ = writer.Close()
+		return err
+	}
+
+	if _, err := writer.Write(object); err != nil {
+		cancel()
+		_ = writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+func writeObject(ctx context.Context, obj ifaces_gcloud_storage.ObjectHandle, object []byte) error {
+	writer := obj.NewWriter(ctx)
+
+	if _, err := writer.Write(object); err != nil {
+		_ = writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+// isPreconditionFailed - returns true if a conditional request wasn't applied because its preconditions weren't met
+func isPreconditionFailed(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	return ok && gErr.Code == http.StatusPreconditionFailed
+}
+
+// isDigestMismatch - returns true if an upload was rejected because the bytes received didn't match its MD5,
+// which Cloud Storage reports as an invalid request
+func isDigestMismatch(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	return ok && gErr.Code == http.StatusBadRequest && strings.Contains(gErr.Message, "MD5")
+}
+
+// appendErrorCode - precondition failures indicate the item was modified by a concurrent write
+func appendErrorCode(err error) codes.Code {
+	if isPreconditionFailed(err) {
+		return codes.Aborted
+	}
+
+	return codes.Internal
+}
+
+// defaultUploadEndpoint - the JSON API endpoint for uploads
+const defaultUploadEndpoint = "https://storage.googleapis.com/upload/storage/v1"
+
+// statusResumeIncomplete - returned for each uploaded chunk of a resumable session until its final chunk
+const statusResumeIncomplete = 308
+
+// statusClientClosedRequest - returned when a resumable session is cancelled
+const statusClientClosedRequest = 499
+
+// BeginUpload - starts a resumable upload session for an item, returning the session URI
+func (s *StorageStorageService) BeginUpload(bucket string, key string) (string, error) {
 	newErr := errors.ErrorsWithScope(
-		"StorageStorageService.PreSignedUrl",
+		"StorageStorageService.BeginUpload",
 		map[string]interface{}{
 			"bucket": bucket,
 			"key":    key,
 		},
 	)
 
-	bucketHandle, err := s.getBucketByName(bucket)
-	if err != nil {
+	if _, err := s.getBucketByName(bucket); err != nil {
 		return "", newErr(
 			codes.NotFound,
 			"unable to locate bucket",
@@ -205,98 +565,810 @@ func (s *StorageStorageService) PreSignUrl(bucket string, key string, operation
 		)
 	}
 
-	method := "GET"
-	if operation == plugin.WRITE {
-		method = "PUT"
+	if s.uploadClient == nil {
+		return "", newErr(
+			codes.Unimplemented,
+			"resumable uploads aren't configured",
+			nil,
+		)
 	}
 
-	opts := &storage.SignedURLOptions{
-		Scheme:  storage.SigningSchemeV4,
-		Method:  method,
-		Expires: time.Now().Add(time.Duration(expiry) * time.Second),
+	endpoint := fmt.Sprintf("%s/b/%s/o?uploadType=resumable&name=%s", s.uploadEndpoint, url.PathEscape(s.names[bucket]), url.QueryEscape(key))
+	req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"unable to create upload session request",
+			err,
+		)
 	}
 
-	signedUrl, err := bucketHandle.SignedURL(key, opts)
+	resp, err := s.uploadClient.Do(req)
 	if err != nil {
 		return "", newErr(
-			codes.Internal,
-			"failed to create signed url",
+			codes.Unavailable,
+			"unable to create upload session",
 			err,
 		)
 	}
+	defer resp.Body.Close()
 
-	return signedUrl, nil
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Location") == "" {
+		return "", newErr(
+			sessionErrorCode(resp.StatusCode),
+			"unable to create upload session",
+			fmt.Errorf("upload session request returned %s", resp.Status),
+		)
+	}
+
+	return resp.Header.Get("Location"), nil
 }
 
-func (s *StorageStorageService) ListFiles(bucket string) ([]*plugin.FileInfo, error) {
+// UploadPart - uploads a chunk of a resumable upload session, the final chunk sets the size of the item and creates it
+func (s *StorageStorageService) UploadPart(bucket string, key string, upload string, part *plugin.UploadPart, data []byte, final bool) (*plugin.UploadPart, error) {
 	newErr := errors.ErrorsWithScope(
-		"StorageStorageService.ListFiles",
+		"StorageStorageService.UploadPart",
 		map[string]interface{}{
-			"bucket": bucket,
+			"bucket":   bucket,
+			"key":      key,
+			"part":     part.Number,
+			"data.len": len(data),
 		},
 	)
 
-	bucketHandle, err := s.getBucketByName(bucket)
-	if err != nil {
+	size := int64(len(data))
+	total := "*"
+	if final {
+		total = strconv.FormatInt(part.Offset+size, 10)
+	}
+
+	contentRange := fmt.Sprintf("bytes */%s", total)
+	if size > 0 {
+		contentRange = fmt.Sprintf("bytes %d-%d/%s", part.Offset, part.Offset+size-1, total)
+	}
+
+	if code, err := s.putSession(upload, contentRange, data); err != nil {
 		return nil, newErr(
-			codes.NotFound,
-			"unable to locate bucket",
+			code,
+			"unable to upload chunk",
 			err,
 		)
 	}
 
-	iter := bucketHandle.Objects(context.TODO(), &storage.Query{
-		Projection: storage.ProjectionNoACL,
-	})
+	return &plugin.UploadPart{
+		Number: part.Number,
+		Offset: part.Offset,
+		Size:   size,
+	}, nil
+}
 
-	fis := make([]*plugin.FileInfo, 0)
-	for {
-		obj, err := iter.Next()
+// CompleteUpload - finalizes a resumable upload session, sessions whose final chunk has been uploaded are already complete
+func (s *StorageStorageService) CompleteUpload(bucket string, key string, upload string, parts []*plugin.UploadPart) error {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.CompleteUpload",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+			"parts":  len(parts),
+		},
+	)
 
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return nil, newErr(codes.Internal, "error occurred iterating objects", err)
-		}
+	size := int64(0)
+	for _, p := range parts {
+		size += p.Size
+	}
 
-		fis = append(fis, &plugin.FileInfo{
-			Key: obj.Name,
-		})
+	if code, err := s.putSession(upload, fmt.Sprintf("bytes */%d", size), nil); err != nil {
+		return newErr(
+			code,
+			"unable to complete upload",
+			err,
+		)
 	}
 
-	return fis, nil
+	return nil
 }
 
-/**
- * Creates a new Storage Plugin for use in GCP
- */
-func New() (plugin.StorageService, error) {
-	ctx := context.Background()
-
-	credentials, credentialsError := google.FindDefaultCredentials(ctx,
-		storage.ScopeReadWrite,
-		// required for signing blob urls
-		iamcredentials.CloudPlatformScope,
+// AbortUpload - cancels a resumable upload session
+func (s *StorageStorageService) AbortUpload(bucket string, key string, upload string) error {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.AbortUpload",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
 	)
-	if credentialsError != nil {
-		return nil, fmt.Errorf("GCP credentials error: %v", credentialsError)
+
+	if err := s.connect(); err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to connect to cloud storage",
+			err,
+		)
 	}
 
-	// Get the client credentials
-	client, err := storage.NewClient(ctx, option.WithCredentials(credentials))
+	if s.uploadClient == nil {
+		return newErr(
+			codes.Unimplemented,
+			"resumable uploads aren't configured",
+			nil,
+		)
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, upload, nil)
 	if err != nil {
-		return nil, fmt.Errorf("storage client error: %v", err)
+		return newErr(
+			codes.InvalidArgument,
+			"invalid upload session",
+			err,
+		)
 	}
 
-	return &StorageStorageService{
-		client:    ifaces_gcloud_storage.AdaptStorageClient(client),
-		projectID: credentials.ProjectID,
+	resp, err := s.uploadClient.Do(req)
+	if err != nil {
+		return newErr(
+			codes.Unavailable,
+			"unable to cancel upload session",
+			err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != statusClientClosedRequest && resp.StatusCode != http.StatusNoContent {
+		return newErr(
+			sessionErrorCode(resp.StatusCode),
+			"unable to cancel upload session",
+			fmt.Errorf("cancel request returned %s", resp.Status),
+		)
+	}
+
+	return nil
+}
+
+// putSession - sends a chunk, or a request without one to finalize the session, to a resumable upload session.
+// Returns the code of the error if the request failed
+func (s *StorageStorageService) putSession(upload string, contentRange string, data []byte) (codes.Code, error) {
+	if err := s.connect(); err != nil {
+		return codes.Unavailable, fmt.Errorf("unable to connect to cloud storage: %v", err)
+	}
+
+	if s.uploadClient == nil {
+		return codes.Unimplemented, fmt.Errorf("resumable uploads aren't configured")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, upload, bytes.NewReader(data))
+	if err != nil {
+		return codes.InvalidArgument, err
+	}
+	req.Header.Set("Content-Range", contentRange)
+
+	resp, err := s.uploadClient.Do(req)
+	if err != nil {
+		return codes.Unavailable, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, statusResumeIncomplete:
+		return codes.OK, nil
+	default:
+		return sessionErrorCode(resp.StatusCode), fmt.Errorf("upload session returned %s for range %s", resp.Status, contentRange)
+	}
+}
+
+// sessionErrorCode - sessions are no longer found once they're cancelled or have expired, a week after they're created
+func sessionErrorCode(status int) codes.Code {
+	switch status {
+	case http.StatusNotFound, http.StatusGone:
+		return codes.NotFound
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+/**
+ * Delete an Item in a Google Cloud Storage Bucket
+ */
+func (s *StorageStorageService) Delete(bucket string, key string, opts ...plugin.DeleteOption) error {
+	do := plugin.NewDeleteOptions(opts...)
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.Delete",
+		map[string]interface{}{
+			"bucket":  bucket,
+			"key":     key,
+			"ifMatch": do.IfMatch,
+		},
+	)
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	object := bucketHandle.Object(key)
+	if do.IfMatch != "" {
+		generation, err := parseGeneration(do.IfMatch)
+		if err != nil {
+			return newErr(
+				codes.InvalidArgument,
+				"invalid etag",
+				err,
+			)
+		}
+		object = object.If(storage.Conditions{GenerationMatch: generation})
+	}
+
+	if err := object.Delete(context.Background()); err != nil {
+		if isPreconditionFailed(err) {
+			return newErr(
+				codes.FailedPrecondition,
+				plugin.PreconditionFailed,
+				err,
+			)
+		}
+
+		// ignore errors caused by the Object not existing.
+		// This is to unify delete behavior between providers.
+		if err != storage.ErrObjectNotExist {
+			return newErr(
+				codes.NotFound,
+				"object does not exist",
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *StorageStorageService) PreSignUrl(bucket string, key string, operation plugin.Operation, expiry uint32) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.PreSignedUrl",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	method := "GET"
+	if operation == plugin.WRITE {
+		method = "PUT"
+	}
+
+	opts := &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  method,
+		Expires: time.Now().Add(time.Duration(expiry) * time.Second),
+	}
+
+	signedUrl, err := bucketHandle.SignedURL(key, opts)
+	if err != nil {
+		return "", newErr(
+			codes.Internal,
+			"failed to create signed url",
+			err,
+		)
+	}
+
+	return signedUrl, nil
+}
+
+// GrantAccess - Cloud Storage can only grant uploads this way, as a signed policy for HTML form POSTs.
+// Items are uploaded under the prefix with the name of the posted file
+func (s *StorageStorageService) GrantAccess(bucket string, prefix string, operations []plugin.Operation, expiry uint32) (*plugin.AccessGrant, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.GrantAccess",
+		map[string]interface{}{
+			"bucket": bucket,
+			"prefix": prefix,
+		},
+	)
+
+	for _, op := range operations {
+		if op != plugin.WRITE {
+			return nil, newErr(
+				codes.Unimplemented,
+				"Cloud Storage only grants uploads, items can be read with pre-signed urls instead",
+				nil,
+			)
+		}
+	}
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	expires := time.Now().Add(time.Duration(expiry) * time.Second)
+	policy, err := bucketHandle.GenerateSignedPostPolicyV4(prefix+"${filename}", &storage.PostPolicyV4Options{
+		Expires: expires,
+	})
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to sign post policy",
+			err,
+		)
+	}
+
+	return &plugin.AccessGrant{
+		PostPolicy: &plugin.PostPolicy{
+			Url:    policy.URL,
+			Fields: policy.Fields,
+		},
+		Expires: expires,
 	}, nil
 }
 
-func NewWithClient(client ifaces_gcloud_storage.StorageClient) (plugin.StorageService, error) {
+func (s *StorageStorageService) ListFiles(bucket string, opts ...plugin.ListOption) ([]*plugin.FileInfo, error) {
+	lo := plugin.NewListOptions(opts...)
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.ListFiles",
+		map[string]interface{}{
+			"bucket": bucket,
+			"tags":   lo.Tags,
+		},
+	)
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	iter := bucketHandle.Objects(context.TODO(), &storage.Query{
+		Projection: storage.ProjectionNoACL,
+	})
+
+	fis := make([]*plugin.FileInfo, 0)
+	for {
+		obj, err := iter.Next()
+
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, newErr(codes.Internal, "error occurred iterating objects", err)
+		}
+
+		if !plugin.MatchesTags(obj.Metadata, lo.Tags) {
+			continue
+		}
+
+		fis = append(fis, &plugin.FileInfo{
+			Key:  obj.Name,
+			ETag: strconv.FormatInt(obj.Generation, 10),
+		})
+	}
+
+	return fis, nil
+}
+
+// parseGeneration - parses an object version or etag, which are both its generation number
+func parseGeneration(version string) (int64, error) {
+	generation, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || generation <= 0 {
+		return 0, fmt.Errorf("expected an object generation, got %s", version)
+	}
+
+	return generation, nil
+}
+
+/**
+ * Lists the generations of an Item in a Google Cloud Storage Bucket, newest first
+ */
+func (s *StorageStorageService) ListVersions(bucket string, key string) ([]*plugin.VersionInfo, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.ListVersions",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	iter := bucketHandle.Objects(context.TODO(), &storage.Query{
+		Prefix:     key,
+		Versions:   true,
+		Projection: storage.ProjectionNoACL,
+	})
+
+	generations := make([]*storage.ObjectAttrs, 0)
+	for {
+		obj, err := iter.Next()
+
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, newErr(codes.Internal, "error occurred iterating object versions", err)
+		}
+
+		// The prefix also matches other items that start with the key
+		if obj.Name == key {
+			generations = append(generations, obj)
+		}
+	}
+
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i].Generation > generations[j].Generation
+	})
+
+	versions := make([]*plugin.VersionInfo, 0, len(generations))
+	for _, obj := range generations {
+		versions = append(versions, &plugin.VersionInfo{
+			Version: strconv.FormatInt(obj.Generation, 10),
+			// Noncurrent generations have the time they were replaced or deleted
+			Latest:       obj.Deleted.IsZero(),
+			LastModified: obj.Created,
+			Size:         obj.Size,
+		})
+	}
+
+	return versions, nil
+}
+
+/**
+ * Restores a generation of an Item in a Google Cloud Storage Bucket, by copying it over the current generation
+ */
+func (s *StorageStorageService) RestoreVersion(bucket string, key string, version string) (string, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.RestoreVersion",
+		map[string]interface{}{
+			"bucket":  bucket,
+			"key":     key,
+			"version": version,
+		},
+	)
+
+	generation, err := parseGeneration(version)
+	if err != nil {
+		return "", newErr(
+			codes.InvalidArgument,
+			"invalid object version",
+			err,
+		)
+	}
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return "", newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	object := bucketHandle.Object(key)
+	attrs, err := object.CopierFrom(object.Generation(generation)).Run(context.TODO())
+	if err != nil {
+		code := codes.Internal
+		if err == storage.ErrObjectNotExist {
+			code = codes.NotFound
+		}
+
+		return "", newErr(
+			code,
+			"unable to restore object generation",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(attrs.Generation, 10), nil
+}
+
+// SetTags - replaces the tags of an item, which are stored as its custom metadata
+func (s *StorageStorageService) SetTags(bucket string, key string, tags map[string]string) error {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.SetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	if err := plugin.ValidateTags(tags); err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid tags",
+			err,
+		)
+	}
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	object := bucketHandle.Object(key)
+	attrs, err := object.Attrs(context.TODO())
+	if err != nil {
+		code := codes.Internal
+		if err == storage.ErrObjectNotExist {
+			code = codes.NotFound
+		}
+
+		return newErr(
+			code,
+			"unable to read object metadata",
+			err,
+		)
+	}
+
+	// Metadata updates are merged with the existing metadata, blank values remove the tags that aren't being kept
+	metadata := make(map[string]string, len(tags)+len(attrs.Metadata))
+	for k := range attrs.Metadata {
+		metadata[k] = ""
+	}
+	for k, v := range tags {
+		metadata[k] = v
+	}
+
+	if _, err := object.Update(context.TODO(), storage.ObjectAttrsToUpdate{Metadata: metadata}); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to update object metadata",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// GetTags - returns the tags of an item, which are stored as its custom metadata
+func (s *StorageStorageService) GetTags(bucket string, key string) (map[string]string, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.GetTags",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	attrs, err := bucketHandle.Object(key).Attrs(context.TODO())
+	if err != nil {
+		code := codes.Internal
+		if err == storage.ErrObjectNotExist {
+			code = codes.NotFound
+		}
+
+		return nil, newErr(
+			code,
+			"unable to read object metadata",
+			err,
+		)
+	}
+
+	tags := make(map[string]string, len(attrs.Metadata))
+	for k, v := range attrs.Metadata {
+		tags[k] = v
+	}
+
+	return tags, nil
+}
+
+// SetLifecycleRules - replaces the lifecycle rules of a bucket, including any rules not created by nitric.
+// Cloud Storage lifecycle rules apply to every object in a bucket, so rules can't have key prefixes.
+func (s *StorageStorageService) SetLifecycleRules(bucket string, rules []*plugin.LifecycleRule) error {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.SetLifecycleRules",
+		map[string]interface{}{
+			"bucket": bucket,
+		},
+	)
+
+	gcsRules := make([]storage.LifecycleRule, 0)
+	for _, rule := range rules {
+		if rule.Prefix != "" {
+			return newErr(
+				codes.InvalidArgument,
+				"lifecycle rules with key prefixes aren't supported by Cloud Storage",
+				nil,
+			)
+		}
+
+		for _, t := range rule.Transitions {
+			gcsRules = append(gcsRules, storage.LifecycleRule{
+				Action: storage.LifecycleAction{
+					Type:         storage.SetStorageClassAction,
+					StorageClass: storageClasses[t.StorageClass],
+				},
+				Condition: storage.LifecycleCondition{
+					AgeInDays: int64(t.AfterDays),
+				},
+			})
+		}
+
+		if rule.ExpireAfterDays > 0 {
+			gcsRules = append(gcsRules, storage.LifecycleRule{
+				Action: storage.LifecycleAction{
+					Type: storage.DeleteAction,
+				},
+				Condition: storage.LifecycleCondition{
+					AgeInDays: int64(rule.ExpireAfterDays),
+				},
+			})
+		}
+	}
+
+	bucketHandle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	if _, err := bucketHandle.Update(context.Background(), storage.BucketAttrsToUpdate{
+		Lifecycle: &storage.Lifecycle{Rules: gcsRules},
+	}); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to set bucket lifecycle rules",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Provision - creates the bucket if it doesn't exist, labelled with its nitric name and the stack's labels
+func (s *StorageStorageService) Provision(bucket string, identity *stack.Identity) error {
+	_, err := s.Create(bucket, identity)
+
+	return err
+}
+
+// Create - creates the bucket if it doesn't exist, labelled with its nitric name and the stack's labels,
+// returning true if it was created
+func (s *StorageStorageService) Create(bucket string, identity *stack.Identity) (bool, error) {
+	newErr := errors.ErrorsWithScope(
+		"StorageStorageService.Create",
+		map[string]interface{}{
+			"bucket": bucket,
+		},
+	)
+
+	if _, err := s.getBucketByName(bucket); err == nil {
+		return false, nil
+	} else if err != errBucketNotFound {
+		return false, newErr(codes.Internal, "unable to list buckets", err)
+	}
+
+	name, err := resources.GlobalName(bucket, identity)
+	if err != nil {
+		return false, newErr(codes.InvalidArgument, "invalid bucket name", err)
+	}
+
+	handle := s.client.Bucket(name)
+	if err := handle.Create(context.Background(), s.projectID, &storage.BucketAttrs{
+		Labels: resources.Labels(bucket, identity),
+	}); err != nil {
+		return false, newErr(codes.Internal, "unable to create bucket", err)
+	}
+
+	s.cache[bucket] = handle
+	s.names[bucket] = name
+
+	return true, nil
+}
+
+// objectPermissions - the IAM permissions needed on a bucket for nitric actions, overwriting an object needs permission to delete it
+var objectPermissions = map[resources.Action][]string{
+	resources.BucketFileList:   {"storage.objects.list"},
+	resources.BucketFileGet:    {"storage.objects.get"},
+	resources.BucketFilePut:    {"storage.objects.create", "storage.objects.delete"},
+	resources.BucketFileDelete: {"storage.objects.delete"},
+}
+
+// CheckPermissions - tests the IAM permissions needed for the nitric actions on the bucket, and that buckets can be listed to find it
+func (s *StorageStorageService) CheckPermissions(bucket string, actions []resources.Action) ([]resources.Permission, error) {
+	handle, err := s.getBucketByName(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := []string{}
+	for _, a := range actions {
+		needed = append(needed, objectPermissions[a]...)
+	}
+
+	permissions := []resources.Permission{}
+	if s.provider != nil {
+		credentials, err := s.provider.Credentials()
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := core.NewProjectPermissionTester(context.TODO(), credentials, s.projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions, err = core.TestPermissions(project, "projects/"+s.projectID, []string{"storage.buckets.list"})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bucketPermissions, err := core.TestPermissions(handle, "projects/_/buckets/"+s.names[bucket], needed)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(permissions, bucketPermissions...), nil
+}
+
+/**
+ * Creates a new Storage Plugin for use in GCP
+ */
+func New(provider core.GcpProvider) (plugin.StorageService, error) {
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	return &StorageStorageService{
-		client: client,
+		provider:       provider,
+		identity:       identity,
+		uploadEndpoint: defaultUploadEndpoint,
 	}, nil
 }
+
+func NewWithClient(client ifaces_gcloud_storage.StorageClient, opts ...StorageStorageServiceOption) (plugin.StorageService, error) {
+	s := &StorageStorageService{
+		client: client,
+	}
+
+	for _, o := range opts {
+		o.Apply(s)
+	}
+
+	return s, nil
+}
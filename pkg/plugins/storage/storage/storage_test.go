@@ -17,19 +17,62 @@ package storage_service_test
 import (
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 
 	storage_mock "github.com/nitrictech/nitric/mocks/gcp_storage"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
 	plugin "github.com/nitrictech/nitric/pkg/plugins/storage"
 	storage_service "github.com/nitrictech/nitric/pkg/plugins/storage/storage"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
+// unavailableProvider - a provider without credentials, which counts the times they're requested
+type unavailableProvider struct {
+	core.GcpProvider
+	requests int
+}
+
+func (p *unavailableProvider) Credentials() (*google.Credentials, error) {
+	p.requests++
+	return nil, fmt.Errorf("no credentials")
+}
+
 var _ = Describe("Storage", func() {
+	Context("New", func() {
+		When("The plugin is created", func() {
+			provider := &unavailableProvider{}
+			storagePlugin, err := storage_service.New(provider)
+
+			It("Should only connect when the plugin is first used", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(provider.requests).To(Equal(0))
+
+				_, err := storagePlugin.Read("my-bucket", "test-item")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unable to connect to cloud storage"))
+
+				By("Sharing the connection error with later calls")
+				_, err = storagePlugin.Read("my-bucket", "test-item")
+				Expect(err).Should(HaveOccurred())
+				Expect(provider.requests).To(Equal(1))
+			})
+		})
+	})
+
 	Context("Write", func() {
 		When("GCloud Storage Backend is available", func() {
 			When("Writing to a bucket that exists", func() {
@@ -65,12 +108,62 @@ var _ = Describe("Storage", func() {
 					By("The bytes being written")
 					mockWriter.EXPECT().Write(testPayload).Times(1)
 					mockWriter.EXPECT().Close().Times(1)
+					mockWriter.EXPECT().Attrs().Return(&storage.ObjectAttrs{Generation: 42})
 
-					err := mockStorageServer.Write("my-bucket", "test-file", testPayload)
+					version, err := mockStorageServer.Write("my-bucket", "test-file", testPayload)
 
 					By("Not returning an error")
 					Expect(err).ShouldNot(HaveOccurred())
 
+					By("Returning the generation of the item as its version")
+					Expect(version).To(Equal("42"))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("Writing with an integrity check", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+				testPayload := []byte("Test")
+				attrs := &storage.ObjectAttrs{}
+
+				It("Should fail with a checksum mismatch if the bytes received don't match their MD5", func() {
+					By("The bucket existing")
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-name": "my-bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+					mockBucket.EXPECT().Object("test-file").Return(mockObject)
+					mockObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+
+					By("The MD5 of the bytes being sent")
+					mockWriter.EXPECT().ObjectAttrs().Return(attrs).AnyTimes()
+					mockWriter.EXPECT().Write(testPayload).Times(1)
+
+					By("Cloud Storage rejecting the upload")
+					mockWriter.EXPECT().Close().Return(&googleapi.Error{
+						Code:    http.StatusBadRequest,
+						Message: "Provided MD5 hash \"DLxmEfVUC9CAmjiNyVphWw==\" doesn't match calculated MD5 hash \"1B2M2Y8AsgTpgAmY7PhCfg==\".",
+					})
+
+					_, err := mockStorageServer.Write("my-bucket", "test-file", testPayload, plugin.WithIntegrityCheck())
+					Expect(attrs.MD5).To(Equal([]byte{0x0c, 0xbc, 0x66, 0x11, 0xf5, 0x54, 0x0b, 0xd0, 0x80, 0x9a, 0x38, 0x8d, 0xc9, 0x5a, 0x61, 0x5b}))
+					Expect(errors.Code(err)).To(Equal(codes.DataLoss))
+					Expect(err.Error()).To(ContainSubstring(plugin.ChecksumMismatch))
+
 					ctrl.Finish()
 				})
 			})
@@ -88,7 +181,7 @@ var _ = Describe("Storage", func() {
 					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done)
 					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
 
-					err := mockStorageServer.Write("my-bucket", "test-file", testPayload)
+					_, err := mockStorageServer.Write("my-bucket", "test-file", testPayload)
 
 					By("Returning an error")
 					Expect(err).Should(HaveOccurred())
@@ -98,6 +191,248 @@ var _ = Describe("Storage", func() {
 		})
 	})
 
+	Context("Append", func() {
+		When("The Google Cloud Storage Backend is available", func() {
+			When("The item exists", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockChunk := storage_mock.NewMockObjectHandle(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockComposer := storage_mock.NewMockComposer(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+				testPayload := []byte("Test")
+
+				It("Should compose the appended data onto the item", func() {
+					By("The bucket existing")
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-name": "my-bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+					By("The item existing")
+					mockBucket.EXPECT().Object("test-file").Return(mockObject)
+					mockObject.EXPECT().Attrs(gomock.Any()).Return(&storage.ObjectAttrs{
+						Generation: 42,
+						Metadata:   map[string]string{"x-nitric-components": "7"},
+					}, nil)
+
+					By("The appended data being written to a temporary object")
+					mockBucket.EXPECT().Object(gomock.Any()).Return(mockChunk)
+					mockChunk.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+					mockWriter.EXPECT().Write(testPayload).Times(1)
+					mockWriter.EXPECT().Close().Times(1)
+
+					By("Composing the item and temporary object over the inspected generation of the item")
+					mockObject.EXPECT().If(storage.Conditions{GenerationMatch: 42}).Return(mockConditionalObject)
+					mockConditionalObject.EXPECT().ComposerFrom(mockObject, mockChunk).Return(mockComposer)
+					composed := &storage.ObjectAttrs{}
+					mockComposer.EXPECT().ObjectAttrs().Return(composed).AnyTimes()
+					mockComposer.EXPECT().Run(gomock.Any()).Return(&storage.ObjectAttrs{}, nil)
+
+					By("Removing the temporary object")
+					mockChunk.EXPECT().Delete(gomock.Any()).Return(nil)
+
+					err := mockStorageServer.Append("my-bucket", "test-file", testPayload)
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("Counting the item's components")
+					Expect(composed.Metadata).To(Equal(map[string]string{"x-nitric-components": "8"}))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("The item has reached the composite object component limit", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockGeneration := storage_mock.NewMockObjectHandle(ctrl)
+				mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockReader := storage_mock.NewMockReader(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+				testPayload := []byte("Test")
+
+				It("Should compact the item by rewriting it with the appended data", func() {
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-name": "my-bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+					mockBucket.EXPECT().Object("test-file").Return(mockObject)
+					mockObject.EXPECT().Attrs(gomock.Any()).Return(&storage.ObjectAttrs{
+						Generation:  42,
+						ContentType: "text/plain",
+						Metadata:    map[string]string{"x-nitric-components": "1024"},
+					}, nil)
+
+					By("Reading the inspected generation of the item")
+					mockObject.EXPECT().Generation(int64(42)).Return(mockGeneration)
+					mockGeneration.EXPECT().NewReader(gomock.Any()).Return(mockReader, nil)
+					mockReader.EXPECT().Read(gomock.Any()).DoAndReturn(func(p []byte) (int, error) {
+						return copy(p, "Existing "), io.EOF
+					})
+					mockReader.EXPECT().Close()
+
+					By("Writing it followed by the appended data over the same generation")
+					attrs := &storage.ObjectAttrs{}
+					mockObject.EXPECT().If(storage.Conditions{GenerationMatch: 42}).Return(mockConditionalObject)
+					mockConditionalObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+					mockWriter.EXPECT().ObjectAttrs().Return(attrs).AnyTimes()
+					var written []byte
+					mockWriter.EXPECT().Write(gomock.Any()).DoAndReturn(func(p []byte) (int, error) {
+						written = append(written, p...)
+						return len(p), nil
+					}).Times(2)
+					mockWriter.EXPECT().Close().Return(nil)
+
+					err := mockStorageServer.Append("my-bucket", "test-file", testPayload)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(string(written)).To(Equal("Existing Test"))
+					Expect(attrs.ContentType).To(Equal("text/plain"))
+					Expect(attrs.Metadata).To(Equal(map[string]string{"x-nitric-components": "1"}))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("The item doesn't exist", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+				testPayload := []byte("Test")
+
+				It("Should create the item", func() {
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-name": "my-bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+					By("The item not existing")
+					mockBucket.EXPECT().Object("test-file").Return(mockObject)
+					mockObject.EXPECT().Attrs(gomock.Any()).Return(nil, storage.ErrObjectNotExist)
+
+					By("Writing the item only if it still doesn't exist")
+					mockObject.EXPECT().If(storage.Conditions{DoesNotExist: true}).Return(mockConditionalObject)
+					mockConditionalObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+					mockWriter.EXPECT().Write(testPayload).Times(1)
+					mockWriter.EXPECT().Close().Times(1)
+
+					err := mockStorageServer.Append("my-bucket", "test-file", testPayload)
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					ctrl.Finish()
+				})
+			})
+		})
+	})
+
+	Context("Uploading in parts", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+		mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+
+		// a resumable session recording the chunks it's sent
+		var ranges []string
+		var received []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				Expect(r.URL.Path).To(Equal("/b/my-bucket-1234/o"))
+				Expect(r.URL.Query().Get("uploadType")).To(Equal("resumable"))
+				Expect(r.URL.Query().Get("name")).To(Equal("dir/test-file"))
+
+				w.Header().Set("Location", "http://"+r.Host+"/session/1")
+				w.WriteHeader(http.StatusOK)
+			case http.MethodPut:
+				body, _ := io.ReadAll(r.Body)
+				received = append(received, body...)
+				ranges = append(ranges, r.Header.Get("Content-Range"))
+
+				if strings.HasSuffix(r.Header.Get("Content-Range"), "/*") {
+					w.WriteHeader(308)
+				} else {
+					w.WriteHeader(http.StatusOK)
+				}
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		storagePlugin, _ := storage_service.NewWithClient(mockStorageClient, storage_service.WithUploadClient(server.Client(), server.URL))
+
+		AfterEach(func() {
+			ranges = nil
+			received = nil
+		})
+
+		It("Should upload the parts as chunks of a resumable session", func() {
+			mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+				Labels: map[string]string{"x-nitric-name": "my-bucket"},
+				Name:   "my-bucket-1234",
+			}, nil)
+			mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done)
+			mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+			mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(storage_mock.NewMockBucketHandle(ctrl))
+
+			upload, err := storagePlugin.BeginUpload("my-bucket", "dir/test-file")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(upload).To(Equal(server.URL + "/session/1"))
+
+			first, err := storagePlugin.UploadPart("my-bucket", "dir/test-file", upload, &plugin.UploadPart{Number: 1}, []byte("Test "), false)
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := storagePlugin.UploadPart("my-bucket", "dir/test-file", upload, &plugin.UploadPart{Number: 2, Offset: 5}, []byte("Upload"), true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second).To(Equal(&plugin.UploadPart{Number: 2, Offset: 5, Size: 6}))
+
+			Expect(storagePlugin.CompleteUpload("my-bucket", "dir/test-file", upload, []*plugin.UploadPart{first, second})).To(Succeed())
+
+			Expect(received).To(Equal([]byte("Test Upload")))
+			Expect(ranges).To(Equal([]string{"bytes 0-4/*", "bytes 5-10/11", "bytes */11"}))
+		})
+
+		It("Should report cancelled or expired sessions as not found", func() {
+			err := storagePlugin.AbortUpload("my-bucket", "dir/test-file", server.URL+"/session/1")
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
 	Context("Read", func() {
 		When("The Google Cloud Storage Backend is available", func() {
 			When("The bucket exists", func() {
@@ -130,6 +465,7 @@ var _ = Describe("Storage", func() {
 
 						By("the object reader being called")
 						mockReader.EXPECT().Read(gomock.Any()).Return(0, io.EOF)
+						mockReader.EXPECT().ContentEncoding().Return("")
 						mockReader.EXPECT().Close().Times(1)
 
 						item, err := storagePlugin.Read("test-bucket", "test-key")
@@ -240,6 +576,41 @@ var _ = Describe("Storage", func() {
 					})
 				})
 
+				When("The item has changed since it was last seen", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+					mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+					mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+					mockObject := storage_mock.NewMockObjectHandle(ctrl)
+					mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+					storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+					It("Should return a failed precondition error", func() {
+						By("the bucket existing")
+						gomock.InOrder(
+							mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+								Labels: map[string]string{
+									"x-nitric-name": "test-bucket",
+								},
+								Name: "my-bucket-1234",
+							}, nil),
+							mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+						)
+						mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+						mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+						By("deleting the item only if it's still the expected generation")
+						mockBucket.EXPECT().Object("test-key").Return(mockObject)
+						mockObject.EXPECT().If(storage.Conditions{GenerationMatch: 42}).Return(mockConditionalObject)
+						mockConditionalObject.EXPECT().Delete(gomock.Any()).Return(&googleapi.Error{Code: http.StatusPreconditionFailed})
+
+						err := storagePlugin.Delete("test-bucket", "test-key", plugin.WithDeleteIfMatch("42"))
+
+						By("Returning a failed precondition error")
+						Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+					})
+				})
+
 				When("The item doesn't exist", func() {
 					ctrl := gomock.NewController(GinkgoT())
 					mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
@@ -426,6 +797,52 @@ var _ = Describe("Storage", func() {
 		})
 	})
 
+	Context("GrantAccess", func() {
+		When("uploads are granted", func() {
+			It("should sign a post policy for items under the prefix", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-name": "test-bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+				mockBucket.EXPECT().GenerateSignedPostPolicyV4("uploads/${filename}", gomock.Any()).Return(&storage.PostPolicyV4{
+					URL:    "https://storage.googleapis.com/my-bucket-1234/",
+					Fields: map[string]string{"key": "uploads/${filename}", "policy": "abc"},
+				}, nil)
+
+				grant, err := storagePlugin.GrantAccess("test-bucket", "uploads/", []plugin.Operation{plugin.WRITE}, 600)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(grant.PostPolicy.Url).To(Equal("https://storage.googleapis.com/my-bucket-1234/"))
+				Expect(grant.PostPolicy.Fields).To(HaveKeyWithValue("policy", "abc"))
+				Expect(grant.Expires).To(BeTemporally("~", time.Now().Add(600*time.Second), time.Second))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("reads are granted", func() {
+			It("should report them as unimplemented", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				storagePlugin, _ := storage_service.NewWithClient(storage_mock.NewMockStorageClient(ctrl))
+
+				_, err := storagePlugin.GrantAccess("test-bucket", "", []plugin.Operation{plugin.READ}, 600)
+				Expect(errors.Code(err)).To(Equal(codes.Unimplemented))
+			})
+		})
+	})
+
 	Context("ListFiles", func() {
 		When("The bucket exists", func() {
 			ctrl := gomock.NewController(GinkgoT())
@@ -492,4 +909,225 @@ var _ = Describe("Storage", func() {
 			})
 		})
 	})
+
+	Context("ListVersions", func() {
+		When("The item has several generations", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockObjectIterator := storage_mock.NewMockObjectIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should return the generations of the item, newest first", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-name": "test-bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the bucket containing generations of the item and another item sharing its prefix")
+				mockBucket.EXPECT().Objects(gomock.Any(), &storage.Query{
+					Prefix:     "test-file",
+					Versions:   true,
+					Projection: storage.ProjectionNoACL,
+				}).Return(mockObjectIterator)
+				gomock.InOrder(
+					mockObjectIterator.EXPECT().Next().Return(&storage.ObjectAttrs{
+						Name:       "test-file",
+						Generation: 1,
+						Deleted:    time.Unix(200, 0),
+					}, nil),
+					mockObjectIterator.EXPECT().Next().Return(&storage.ObjectAttrs{
+						Name:       "test-file",
+						Generation: 2,
+					}, nil),
+					mockObjectIterator.EXPECT().Next().Return(&storage.ObjectAttrs{
+						Name:       "test-file-2",
+						Generation: 3,
+					}, nil),
+					mockObjectIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+
+				versions, err := storagePlugin.ListVersions("test-bucket", "test-file")
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning only the generations of the item")
+				Expect(versions).To(HaveLen(2))
+				Expect(versions[0].Version).To(Equal("2"))
+				Expect(versions[0].Latest).To(BeTrue())
+				Expect(versions[1].Version).To(Equal("1"))
+				Expect(versions[1].Latest).To(BeFalse())
+			})
+		})
+	})
+
+	Context("RestoreVersion", func() {
+		When("The generation exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockGeneration := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should copy the generation over the item", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-name": "test-bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("copying from the requested generation")
+				mockBucket.EXPECT().Object("test-file").Return(mockObject)
+				mockObject.EXPECT().Generation(int64(1)).Return(mockGeneration)
+				mockObject.EXPECT().CopierFrom(mockGeneration).Return(mockCopier)
+				mockCopier.EXPECT().Run(gomock.Any()).Return(&storage.ObjectAttrs{Generation: 3}, nil)
+
+				version, err := storagePlugin.RestoreVersion("test-bucket", "test-file", "1")
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the new generation of the item")
+				Expect(version).To(Equal("3"))
+			})
+		})
+
+		When("The version isn't a generation number", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should return an error", func() {
+				_, err := storagePlugin.RestoreVersion("test-bucket", "test-file", "latest")
+
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid object version"))
+			})
+		})
+	})
+
+	Context("SetTags", func() {
+		When("The item exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should replace the item's metadata", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-name": "test-bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the item having existing metadata")
+				mockBucket.EXPECT().Object("test-file").Return(mockObject)
+				mockObject.EXPECT().Attrs(gomock.Any()).Return(&storage.ObjectAttrs{
+					Metadata: map[string]string{"class": "receipt", "owner": "finance"},
+				}, nil)
+
+				By("removing the tags that aren't kept")
+				mockObject.EXPECT().Update(gomock.Any(), storage.ObjectAttrsToUpdate{
+					Metadata: map[string]string{"class": "invoice", "owner": ""},
+				}).Return(&storage.ObjectAttrs{}, nil)
+
+				err := storagePlugin.SetTags("test-bucket", "test-file", map[string]string{"class": "invoice"})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Provision", func() {
+		When("The bucket doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should create a uniquely named bucket with the stack's labels", func() {
+				mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+
+				var created string
+				mockStorageClient.EXPECT().Bucket(gomock.Any()).DoAndReturn(func(name string) *storage_mock.MockBucketHandle {
+					created = name
+					return mockBucket
+				})
+				mockBucket.EXPECT().Create(gomock.Any(), gomock.Any(), &storage.BucketAttrs{
+					Labels: map[string]string{
+						"x-nitric-name":        "images",
+						"x-nitric-stack":       "shop",
+						"x-nitric-environment": "test",
+					},
+				}).Return(nil)
+
+				err := storagePlugin.(resources.Provisioner).Provision("images", &stack.Identity{Name: "shop", Environment: "test"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(created).To(HavePrefix("shop-test-images-"))
+			})
+		})
+	})
+
+	Context("CheckPermissions", func() {
+		When("The bucket exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should test the permissions of the nitric actions on the bucket", func() {
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+					Name:   "images-a1b2",
+					Labels: map[string]string{"x-nitric-name": "images"},
+				}, nil)
+				mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done)
+				mockStorageClient.EXPECT().Bucket("images-a1b2").Return(mockBucket)
+				mockBucket.EXPECT().TestPermissions(gomock.Any(), []string{"storage.objects.create", "storage.objects.delete", "storage.objects.get"}).Return([]string{"storage.objects.get"}, nil)
+
+				permissions, err := storagePlugin.(resources.PermissionChecker).CheckPermissions("images", []resources.Action{
+					resources.BucketFileGet, resources.BucketFilePut,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(permissions).To(Equal([]resources.Permission{
+					{Name: "storage.objects.create on projects/_/buckets/images-a1b2", Granted: false},
+					{Name: "storage.objects.delete on projects/_/buckets/images-a1b2", Granted: false},
+					{Name: "storage.objects.get on projects/_/buckets/images-a1b2", Granted: true},
+				}))
+			})
+		})
+	})
 })
@@ -0,0 +1,66 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// MaxTags - the most tags an item can have, the lowest limit of S3 object tagging and Azure blob index tags
+	MaxTags = 10
+	// MaxTagKeyLength - the longest tag key, in characters
+	MaxTagKeyLength = 128
+	// MaxTagValueLength - the longest tag value, in characters
+	MaxTagValueLength = 256
+)
+
+// ValidateTags - returns an error if the tags can't be stored by every provider
+func ValidateTags(tags map[string]string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("items can have at most %d tags, got %d", MaxTags, len(tags))
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	// Report the same invalid tag every time
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if k == "" || len(k) > MaxTagKeyLength {
+			return fmt.Errorf("tag keys must be between 1 and %d characters, got %q", MaxTagKeyLength, k)
+		}
+
+		if len(tags[k]) > MaxTagValueLength {
+			return fmt.Errorf("tag values must be at most %d characters, got %d for tag %q", MaxTagValueLength, len(tags[k]), k)
+		}
+	}
+
+	return nil
+}
+
+// MatchesTags - returns true if the tags include every tag in the filter, an empty filter matches any tags
+func MatchesTags(tags map[string]string, filter map[string]string) bool {
+	for k, v := range filter {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
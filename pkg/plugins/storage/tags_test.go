@@ -0,0 +1,71 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+var _ = Describe("Tags", func() {
+	Context("ValidateTags", func() {
+		When("given valid tags", func() {
+			It("should succeed", func() {
+				Expect(storage.ValidateTags(map[string]string{"class": "invoice", "reviewed": ""})).To(Succeed())
+			})
+		})
+
+		When("given too many tags", func() {
+			tags := map[string]string{}
+			for i := 0; i <= storage.MaxTags; i++ {
+				tags[fmt.Sprintf("tag-%d", i)] = "value"
+			}
+
+			It("should return an error", func() {
+				Expect(storage.ValidateTags(tags)).ToNot(Succeed())
+			})
+		})
+
+		When("given an empty key", func() {
+			It("should return an error", func() {
+				Expect(storage.ValidateTags(map[string]string{"": "value"})).ToNot(Succeed())
+			})
+		})
+	})
+
+	Context("MatchesTags", func() {
+		tags := map[string]string{"class": "invoice", "year": "2021"}
+
+		It("should match a subset of the tags", func() {
+			Expect(storage.MatchesTags(tags, map[string]string{"class": "invoice"})).To(BeTrue())
+		})
+
+		It("should match an empty filter", func() {
+			Expect(storage.MatchesTags(tags, nil)).To(BeTrue())
+		})
+
+		It("should not match a different value", func() {
+			Expect(storage.MatchesTags(tags, map[string]string{"class": "receipt"})).To(BeFalse())
+		})
+
+		It("should not match a missing tag", func() {
+			Expect(storage.MatchesTags(tags, map[string]string{"owner": "finance"})).To(BeFalse())
+		})
+	})
+})
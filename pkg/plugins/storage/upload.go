@@ -0,0 +1,61 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "fmt"
+
+const (
+	// MinUploadPartSize - the smallest part of an upload other than its last, S3 rejects smaller parts
+	MinUploadPartSize = 5 * 1024 * 1024
+	// UploadPartAlignment - parts of an upload other than its last must be a multiple of this size, as Cloud Storage
+	// resumable sessions only accept chunks of multiples of 256KiB before the final chunk
+	UploadPartAlignment = 256 * 1024
+	// MaxUploadParts - the most parts an upload can have, S3's limit
+	MaxUploadParts = 10000
+)
+
+// UploadPart - a part of an upload, stored by the provider until the upload is completed
+type UploadPart struct {
+	// Number - the position of the part in the upload, from 1
+	Number int
+	// Offset - the position of the part's first byte in the item
+	Offset int64
+	Size   int64
+	// ETag - the provider's ID for the stored part, e.g. an S3 part ETag, blank if the provider doesn't identify parts
+	ETag string
+}
+
+// IsFinalUploadPart - returns true if data of the size can only be the last part of an upload
+func IsFinalUploadPart(size int) bool {
+	return size < MinUploadPartSize || size%UploadPartAlignment != 0
+}
+
+// ValidateUploadParts - returns an error if the parts aren't consecutive from the start of the item, or a part other than the last is final
+func ValidateUploadParts(parts []*UploadPart) error {
+	offset := int64(0)
+	for i, p := range parts {
+		if p.Number != i+1 || p.Offset != offset {
+			return fmt.Errorf("upload part %d at offset %d doesn't follow the previous part, expected part %d at offset %d", p.Number, p.Offset, i+1, offset)
+		}
+
+		if i < len(parts)-1 && IsFinalUploadPart(int(p.Size)) {
+			return fmt.Errorf("upload part %d of %d bytes isn't the last part, so must be a multiple of %d bytes of at least %d bytes", p.Number, p.Size, UploadPartAlignment, MinUploadPartSize)
+		}
+
+		offset += p.Size
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+// PermissionSimulator - checks the membrane's IAM policies allow actions, without performing them
+type PermissionSimulator interface {
+	// Simulate - returns whether each of the IAM actions is allowed on the resource
+	Simulate(resourceArn string, actions []string) (map[string]bool, error)
+}
+
+type iamPermissionSimulator struct {
+	iam iamiface.IAMAPI
+	sts stsiface.STSAPI
+
+	lock      sync.Mutex
+	principal string
+}
+
+// principalArn - returns the ARN of the IAM user or role the membrane's credentials belong to.
+// Assumed role sessions are simulated as their role, which is assumed to have no path
+func (s *iamPermissionSimulator) principalArn() (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.principal != "" {
+		return s.principal, nil
+	}
+
+	out, err := s.sts.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+
+	principal, err := arn.Parse(aws.StringValue(out.Arn))
+	if err != nil {
+		return "", err
+	}
+
+	// e.g. arn:aws:sts::123456789012:assumed-role/my-role/my-session
+	if principal.Service == "sts" && strings.HasPrefix(principal.Resource, "assumed-role/") {
+		parts := strings.Split(principal.Resource, "/")
+		principal.Service = "iam"
+		principal.Resource = "role/" + parts[1]
+	}
+
+	s.principal = principal.String()
+
+	return s.principal, nil
+}
+
+func (s *iamPermissionSimulator) Simulate(resourceArn string, actions []string) (map[string]bool, error) {
+	principal, err := s.principalArn()
+	if err != nil {
+		return nil, fmt.Errorf("unable to identify the membrane's principal: %v", err)
+	}
+
+	allowed := make(map[string]bool, len(actions))
+	err = s.iam.SimulatePrincipalPolicyPages(&iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principal),
+		ActionNames:     aws.StringSlice(actions),
+		ResourceArns:    []*string{aws.String(resourceArn)},
+	}, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		for _, r := range page.EvaluationResults {
+			allowed[aws.StringValue(r.EvalActionName)] = aws.StringValue(r.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allowed, nil
+}
+
+// NewPermissionSimulator - simulates the policies of the principal the session's credentials belong to
+func NewPermissionSimulator(sess *session.Session) PermissionSimulator {
+	return NewPermissionSimulatorWithClients(iam.New(sess), sts.New(sess))
+}
+
+func NewPermissionSimulatorWithClients(iamClient iamiface.IAMAPI, stsClient stsiface.STSAPI) PermissionSimulator {
+	return &iamPermissionSimulator{
+		iam: iamClient,
+		sts: stsClient,
+	}
+}
+
+// resolveResourcesAction - resources are found by their tags, so every plugin needs to read them
+const resolveResourcesAction = "tag:GetResources"
+
+// SimulatePermissions - simulates the IAM actions needed on each resource ARN, along with the action resources are found by.
+// Permissions are named by their action and resource, sorted by resource then action
+func SimulatePermissions(simulator PermissionSimulator, needed map[string][]string) ([]resources.Permission, error) {
+	needed["*"] = append(needed["*"], resolveResourcesAction)
+
+	resourceArns := make([]string, 0, len(needed))
+	for r := range needed {
+		resourceArns = append(resourceArns, r)
+	}
+	sort.Strings(resourceArns)
+
+	permissions := []resources.Permission{}
+	for _, resourceArn := range resourceArns {
+		// several nitric actions may need the same IAM action
+		unique := map[string]bool{}
+		actions := []string{}
+		for _, a := range needed[resourceArn] {
+			if !unique[a] {
+				unique[a] = true
+				actions = append(actions, a)
+			}
+		}
+		sort.Strings(actions)
+
+		if len(actions) == 0 {
+			continue
+		}
+
+		allowed, err := simulator.Simulate(resourceArn, actions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range actions {
+			permissions = append(permissions, resources.Permission{
+				Name:    fmt.Sprintf("%s on %s", a, resourceArn),
+				Granted: allowed[a],
+			})
+		}
+	}
+
+	return permissions, nil
+}
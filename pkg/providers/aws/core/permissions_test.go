@@ -0,0 +1,94 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+type fakeSts struct {
+	stsiface.STSAPI
+	calls int
+}
+
+func (f *fakeSts) GetCallerIdentity(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	f.calls++
+	return &sts.GetCallerIdentityOutput{
+		Arn: aws.String("arn:aws:sts::123456789012:assumed-role/membrane/session-1"),
+	}, nil
+}
+
+type fakeIam struct {
+	iamiface.IAMAPI
+	inputs  []*iam.SimulatePrincipalPolicyInput
+	allowed map[string]bool
+}
+
+func (f *fakeIam) SimulatePrincipalPolicyPages(in *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error {
+	f.inputs = append(f.inputs, in)
+
+	results := []*iam.EvaluationResult{}
+	for _, a := range in.ActionNames {
+		decision := iam.PolicyEvaluationDecisionTypeImplicitDeny
+		if f.allowed[aws.StringValue(a)] {
+			decision = iam.PolicyEvaluationDecisionTypeAllowed
+		}
+		results = append(results, &iam.EvaluationResult{
+			EvalActionName: a,
+			EvalDecision:   aws.String(decision),
+		})
+	}
+	fn(&iam.SimulatePolicyResponse{EvaluationResults: results}, true)
+
+	return nil
+}
+
+var _ = Describe("Permissions", func() {
+	When("Simulating permissions of an assumed role", func() {
+		stsClient := &fakeSts{}
+		iamClient := &fakeIam{allowed: map[string]bool{
+			"tag:GetResources": true,
+			"s3:ListBucket":    true,
+		}}
+		simulator := NewPermissionSimulatorWithClients(iamClient, stsClient)
+
+		It("should simulate the role's policies for each resource", func() {
+			permissions, err := SimulatePermissions(simulator, map[string][]string{
+				"arn:aws:s3:::images": {"s3:PutObject", "s3:ListBucket", "s3:ListBucket"},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "tag:GetResources on *", Granted: true},
+				{Name: "s3:ListBucket on arn:aws:s3:::images", Granted: true},
+				{Name: "s3:PutObject on arn:aws:s3:::images", Granted: false},
+			}))
+
+			By("simulating the role rather than its session")
+			Expect(aws.StringValue(iamClient.inputs[0].PolicySourceArn)).To(Equal("arn:aws:iam::123456789012:role/membrane"))
+
+			By("identifying the principal once")
+			Expect(stsClient.calls).To(Equal(1))
+		})
+	})
+})
@@ -16,11 +16,10 @@ package core
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
 
-	"github.com/nitrictech/nitric/pkg/utils"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type AwsResource = string
@@ -37,13 +36,16 @@ type AwsProvider interface {
 	// GetResources API operation for AWS Provider.
 	// Returns requested aws resources for the given resource type
 	GetResources(AwsResource) (map[string]string, error)
+	// AddResource - records a resource created by the membrane, as the tagging API is eventually consistent it may not list it yet
+	AddResource(typ AwsResource, name string, arn string)
 }
 
 // Aws core utility provider
 type awsProviderImpl struct {
-	stack  string
-	client resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
-	cache  map[AwsResource]map[string]string
+	// Resources are filtered to those of the stack's environment, all are found if nil
+	identity *stack.Identity
+	client   resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	cache    map[AwsResource]map[string]string
 }
 
 var _ AwsProvider = &awsProviderImpl{}
@@ -52,14 +54,18 @@ func (a *awsProviderImpl) GetResources(typ AwsResource) (map[string]string, erro
 	if a.cache[typ] == nil {
 		resources := make(map[string]string)
 		tagFilters := []*resourcegroupstaggingapi.TagFilter{{
-			Key: aws.String("x-nitric-name"),
+			Key: aws.String(stack.NameKey),
 		}}
 
-		if a.stack != "" {
-			tagFilters = append(tagFilters, &resourcegroupstaggingapi.TagFilter{
-				Key:    aws.String("x-nitric-stack"),
-				Values: []*string{aws.String(a.stack)},
-			})
+		if a.identity != nil {
+			for _, key := range []string{stack.StackKey, stack.EnvironmentKey} {
+				if value, ok := a.identity.Labels()[key]; ok {
+					tagFilters = append(tagFilters, &resourcegroupstaggingapi.TagFilter{
+						Key:    aws.String(key),
+						Values: []*string{aws.String(value)},
+					})
+				}
+			}
 		}
 
 		out, err := a.client.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
@@ -72,7 +78,7 @@ func (a *awsProviderImpl) GetResources(typ AwsResource) (map[string]string, erro
 
 		for _, tm := range out.ResourceTagMappingList {
 			for _, t := range tm.Tags {
-				if *t.Key == "x-nitric-name" {
+				if *t.Key == stack.NameKey {
 					resources[*t.Value] = *tm.ResourceARN
 					break
 				}
@@ -85,13 +91,20 @@ func (a *awsProviderImpl) GetResources(typ AwsResource) (map[string]string, erro
 	return a.cache[typ], nil
 }
 
+func (a *awsProviderImpl) AddResource(typ AwsResource, name string, arn string) {
+	// Unfetched resources are left to be fetched, so those that already existed aren't missed
+	if a.cache[typ] != nil {
+		a.cache[typ][name] = arn
+	}
+}
+
 func New() (AwsProvider, error) {
-	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
-	stack := utils.GetEnv("NITRIC_STACK", "")
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
+	}
 
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(awsRegion),
-	})
+	sess, err := NewSession()
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +112,8 @@ func New() (AwsProvider, error) {
 	client := resourcegroupstaggingapi.New(sess)
 
 	return &awsProviderImpl{
-		stack:  stack,
-		client: client,
-		cache:  make(map[AwsResource]map[string]string),
+		identity: identity,
+		client:   client,
+		cache:    make(map[AwsResource]map[string]string),
 	}, nil
 }
@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	mocks "github.com/nitrictech/nitric/mocks/resourcetaggingapi"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 var _ = Describe("AwsProvider", func() {
@@ -73,14 +74,21 @@ var _ = Describe("AwsProvider", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			mockClient := mocks.NewMockResourceGroupsTaggingAPIAPI(ctrl)
 			provider := &awsProviderImpl{
-				cache:  make(map[string]map[string]string),
-				client: mockClient,
-				stack:  "test-stack",
+				cache:    make(map[string]map[string]string),
+				client:   mockClient,
+				identity: &stack.Identity{Name: "test-stack", Environment: "staging"},
 			}
 
 			It("should return available resources", func() {
-				By("failing to call GetResources")
-				mockClient.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+				By("filtering resources by the stack and environment")
+				mockClient.EXPECT().GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+					ResourceTypeFilters: []*string{aws.String(AwsResource_Topic)},
+					TagFilters: []*resourcegroupstaggingapi.TagFilter{
+						{Key: aws.String("x-nitric-name")},
+						{Key: aws.String("x-nitric-stack"), Values: []*string{aws.String("test-stack")}},
+						{Key: aws.String("x-nitric-environment"), Values: []*string{aws.String("staging")}},
+					},
+				}).Return(&resourcegroupstaggingapi.GetResourcesOutput{
 					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{{
 						ResourceARN: aws.String("arn:aws:::sns:test"),
 						Tags: []*resourcegroupstaggingapi.Tag{{
@@ -0,0 +1,102 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// AssumeRoleOptions - The role plugins assume to access resources, which may be in another account
+type AssumeRoleOptions struct {
+	RoleArn string
+	// ExternalID required by the role's trust policy, if any
+	ExternalID string
+	// SessionName identifies the membrane in the role's CloudTrail events
+	SessionName string
+	// Duration of each set of credentials, they're refreshed before they expire
+	Duration time.Duration
+}
+
+// assumeRoleOptionsFromEnv - Returns the role configured by NITRIC_AWS_ROLE_ARN, or nil if no role should be assumed
+func assumeRoleOptionsFromEnv() (*AssumeRoleOptions, error) {
+	roleArn := utils.GetEnv("NITRIC_AWS_ROLE_ARN", "")
+	if roleArn == "" {
+		return nil, nil
+	}
+
+	if parsed, err := arn.Parse(roleArn); err != nil || parsed.Service != "iam" {
+		return nil, fmt.Errorf("invalid NITRIC_AWS_ROLE_ARN env var, expected an IAM role ARN, got %v", roleArn)
+	}
+
+	duration, err := time.ParseDuration(utils.GetEnv("NITRIC_AWS_ROLE_DURATION", "15m"))
+	if err != nil || duration < 15*time.Minute {
+		return nil, fmt.Errorf("invalid NITRIC_AWS_ROLE_DURATION env var, expected a duration of at least 15m, got %v", utils.GetEnv("NITRIC_AWS_ROLE_DURATION", ""))
+	}
+
+	return &AssumeRoleOptions{
+		RoleArn:     roleArn,
+		ExternalID:  utils.GetEnv("NITRIC_AWS_EXTERNAL_ID", ""),
+		SessionName: utils.GetEnv("NITRIC_AWS_ROLE_SESSION_NAME", "nitric-membrane"),
+		Duration:    duration,
+	}, nil
+}
+
+// assumeRoleCredentials - Returns credentials for the role, assumed with the client and refreshed before they expire
+func assumeRoleCredentials(client stscreds.AssumeRoler, opts *AssumeRoleOptions) *credentials.Credentials {
+	return stscreds.NewCredentialsWithClient(client, opts.RoleArn, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = opts.SessionName
+		p.Duration = opts.Duration
+		p.ExpiryWindow = time.Minute
+
+		if opts.ExternalID != "" {
+			p.ExternalID = aws.String(opts.ExternalID)
+		}
+	})
+}
+
+// NewSession - Creates an AWS session in AWS_REGION for plugins to create their clients with.
+// When NITRIC_AWS_ROLE_ARN is set the session uses credentials for that role instead of the ambient credentials
+func NewSession() (*session.Session, error) {
+	awsRegion := utils.GetEnv("AWS_REGION", "us-east-1")
+
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(awsRegion),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS session %v", err)
+	}
+
+	opts, err := assumeRoleOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	} else if opts == nil {
+		return sess, nil
+	}
+
+	// the role is assumed with the ambient credentials, using the region's STS endpoint
+	return sess.Copy(&aws.Config{
+		Credentials: assumeRoleCredentials(sts.New(sess), opts),
+	}), nil
+}
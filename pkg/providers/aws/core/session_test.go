@@ -0,0 +1,124 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeAssumeRoler struct {
+	inputs  []*sts.AssumeRoleInput
+	expires time.Time
+}
+
+func (f *fakeAssumeRoler) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	f.inputs = append(f.inputs, input)
+
+	return &sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("access-key"),
+			SecretAccessKey: aws.String("secret-key"),
+			SessionToken:    aws.String("session-token"),
+			Expiration:      aws.Time(f.expires),
+		},
+	}, nil
+}
+
+var _ = Describe("Session", func() {
+	When("Reading the role to assume from the environment", func() {
+		AfterEach(func() {
+			os.Unsetenv("NITRIC_AWS_ROLE_ARN")
+			os.Unsetenv("NITRIC_AWS_EXTERNAL_ID")
+			os.Unsetenv("NITRIC_AWS_ROLE_DURATION")
+		})
+
+		It("should return nil without a role", func() {
+			opts, err := assumeRoleOptionsFromEnv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(opts).To(BeNil())
+		})
+
+		It("should return the configured role", func() {
+			os.Setenv("NITRIC_AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/nitric")
+			os.Setenv("NITRIC_AWS_EXTERNAL_ID", "external")
+			os.Setenv("NITRIC_AWS_ROLE_DURATION", "1h")
+
+			opts, err := assumeRoleOptionsFromEnv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(opts).To(Equal(&AssumeRoleOptions{
+				RoleArn:     "arn:aws:iam::123456789012:role/nitric",
+				ExternalID:  "external",
+				SessionName: "nitric-membrane",
+				Duration:    time.Hour,
+			}))
+		})
+
+		It("should return an error for an invalid role ARN", func() {
+			os.Setenv("NITRIC_AWS_ROLE_ARN", "arn:aws:sqs:us-east-1:123456789012:queue")
+
+			_, err := assumeRoleOptionsFromEnv()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should return an error for a duration STS won't accept", func() {
+			os.Setenv("NITRIC_AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/nitric")
+			os.Setenv("NITRIC_AWS_ROLE_DURATION", "5m")
+
+			_, err := assumeRoleOptionsFromEnv()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("Assuming a role", func() {
+		opts := &AssumeRoleOptions{
+			RoleArn:     "arn:aws:iam::123456789012:role/nitric",
+			ExternalID:  "external",
+			SessionName: "nitric-membrane",
+			Duration:    15 * time.Minute,
+		}
+
+		It("should assume the role with the external ID", func() {
+			client := &fakeAssumeRoler{expires: time.Now().Add(time.Hour)}
+
+			value, err := assumeRoleCredentials(client, opts).Get()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.AccessKeyID).To(Equal("access-key"))
+
+			Expect(client.inputs).To(HaveLen(1))
+			Expect(*client.inputs[0].RoleArn).To(Equal(opts.RoleArn))
+			Expect(*client.inputs[0].ExternalId).To(Equal("external"))
+			Expect(*client.inputs[0].RoleSessionName).To(Equal("nitric-membrane"))
+			Expect(*client.inputs[0].DurationSeconds).To(Equal(int64(900)))
+		})
+
+		It("should assume the role again before the credentials expire", func() {
+			client := &fakeAssumeRoler{expires: time.Now().Add(30 * time.Second)}
+			creds := assumeRoleCredentials(client, opts)
+
+			_, err := creds.Get()
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = creds.Get()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(client.inputs).To(HaveLen(2))
+		})
+	})
+})
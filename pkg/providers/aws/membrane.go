@@ -18,17 +18,31 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 
+	"github.com/nitrictech/nitric/pkg/connections/apigateway"
+	kms_keyring "github.com/nitrictech/nitric/pkg/encryption/kms"
+	"github.com/nitrictech/nitric/pkg/invoke/cloudmap"
 	"github.com/nitrictech/nitric/pkg/membrane"
+	aws_batch_service "github.com/nitrictech/nitric/pkg/plugins/batch/aws_batch"
+	cloudfront_service "github.com/nitrictech/nitric/pkg/plugins/cdn/cloudfront"
+	appconfig_service "github.com/nitrictech/nitric/pkg/plugins/config/appconfig"
 	dynamodb_service "github.com/nitrictech/nitric/pkg/plugins/document/dynamodb"
 	sns_service "github.com/nitrictech/nitric/pkg/plugins/events/sns"
 	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
 	lambda_service "github.com/nitrictech/nitric/pkg/plugins/gateway/lambda"
+	cognito_service "github.com/nitrictech/nitric/pkg/plugins/identity/cognito"
 	sqs_service "github.com/nitrictech/nitric/pkg/plugins/queue/sqs"
+	algolia_service "github.com/nitrictech/nitric/pkg/plugins/search/algolia"
+	opensearch_service "github.com/nitrictech/nitric/pkg/plugins/search/opensearch"
 	secrets_manager_secret_service "github.com/nitrictech/nitric/pkg/plugins/secret/secrets_manager"
+	sqldb_service "github.com/nitrictech/nitric/pkg/plugins/sql/sqldb"
 	s3_service "github.com/nitrictech/nitric/pkg/plugins/storage/s3"
 	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/usage"
+	"github.com/nitrictech/nitric/pkg/utilization/cloudwatch"
 	"github.com/nitrictech/nitric/pkg/utils"
 )
 
@@ -40,6 +54,8 @@ func main() {
 	gatewayEnv := utils.GetEnv("GATEWAY_ENVIRONMENT", "lambda")
 
 	membraneOpts := membrane.DefaultMembraneOptions()
+	// Metered usage is estimated with list prices of the provider's services
+	membraneOpts.UsagePrices = usage.AwsPrices
 
 	provider, err := core.New()
 	if err != nil {
@@ -47,19 +63,117 @@ func main() {
 		return
 	}
 
-	// Load the appropriate gateway based on the environment.
-	switch gatewayEnv {
-	case "lambda":
-		membraneOpts.GatewayPlugin, _ = lambda_service.New(provider)
-	default:
-		membraneOpts.GatewayPlugin, _ = base_http.New(nil)
-	}
-
 	membraneOpts.SecretPlugin, _ = secrets_manager_secret_service.New(provider)
 	membraneOpts.DocumentPlugin, _ = dynamodb_service.New(provider)
+	// Collections are copied from their own tables to the single table they're stored in before the membrane starts
+	if migrate := utils.GetEnv("DOCUMENT_SINGLE_TABLE_MIGRATE", ""); migrate != "" {
+		if docPlugin, ok := membraneOpts.DocumentPlugin.(*dynamodb_service.DynamoDocService); ok {
+			if err := docPlugin.MigrateToSingleTable(strings.Split(migrate, ",")...); err != nil {
+				log.Fatalf("could not migrate collections to the single table: %v", err)
+			}
+		}
+	}
 	membraneOpts.EventsPlugin, _ = sns_service.New(provider)
 	membraneOpts.QueuePlugin, _ = sqs_service.New(provider)
 	membraneOpts.StoragePlugin, _ = s3_service.New(provider)
+	// Connects to RDS and Aurora databases with credentials managed by Secrets Manager
+	membraneOpts.SqlPlugin, _ = sqldb_service.New(membraneOpts.SecretPlugin)
+	// Searches Amazon OpenSearch Service domains with signed requests, unless Algolia is configured
+	if utils.GetEnv("ALGOLIA_APP_ID", "") != "" {
+		membraneOpts.SearchPlugin, _ = algolia_service.New()
+	} else {
+		membraneOpts.SearchPlugin, _ = opensearch_service.NewAws(membraneOpts.SecretPlugin)
+	}
+	// Reads configuration deployed with AppConfig when it's configured, otherwise from DynamoDB documents
+	if utils.GetEnv("CONFIG_APPCONFIG_APPLICATION", "") != "" {
+		membraneOpts.ConfigPlugin, err = appconfig_service.New()
+		if err != nil {
+			log.Fatalf("could not create config plugin: %v", err)
+		}
+	}
+	// Verifies tokens and manages the users of the Cognito user pool COGNITO_USER_POOL_ID
+	if utils.GetEnv("COGNITO_USER_POOL_ID", "") != "" {
+		membraneOpts.IdentityPlugin, err = cognito_service.New()
+		if err != nil {
+			log.Fatalf("could not create identity plugin: %v", err)
+		}
+	}
+	// Submits jobs to the AWS Batch job queue named by BATCH_JOB_QUEUE
+	membraneOpts.BatchPlugin, _ = aws_batch_service.New(provider)
+	// Signs CloudFront URLs and cookies with the private key stored as the CDN_KEY_SECRET secret
+	if utils.GetEnv("CDN_DOMAINS", "") != "" {
+		membraneOpts.CdnPlugin, err = cloudfront_service.New(membraneOpts.SecretPlugin)
+		if err != nil {
+			log.Fatalf("could not create cdn plugin: %v", err)
+		}
+	}
+	// Messages are posted to websocket connections through the API Gateway management API at WEBSOCKET_ENDPOINT
+	if endpoint := utils.GetEnv("WEBSOCKET_ENDPOINT", ""); endpoint != "" {
+		membraneOpts.ConnectionSender, err = apigateway.New(endpoint)
+		if err != nil {
+			log.Fatalf("could not create websocket sender: %v", err)
+		}
+	}
+
+	// Worker utilization is published as a custom metric for ECS autoscaling when a namespace is configured
+	if namespace := utils.GetEnv("UTILIZATION_CLOUDWATCH_NAMESPACE", ""); namespace != "" {
+		dimensions, err := cloudwatch.ParseDimensions(utils.GetEnv("UTILIZATION_CLOUDWATCH_DIMENSIONS", ""))
+		if err != nil {
+			log.Fatalf("invalid UTILIZATION_CLOUDWATCH_DIMENSIONS env var: %v", err)
+		}
+
+		membraneOpts.UtilizationPublisher, err = cloudwatch.New(namespace, dimensions)
+		if err != nil {
+			log.Fatalf("could not create cloudwatch utilization publisher: %v", err)
+		}
+	}
+
+	// Encrypted document fields are keyed with data keys generated by a KMS key when one is configured
+	if keyId := utils.GetEnv("DOCUMENT_ENCRYPTION_KMS_KEY", ""); keyId != "" {
+		membraneOpts.EncryptionKeyring, err = kms_keyring.New(keyId)
+		if err != nil {
+			log.Fatalf("could not create kms keyring: %v", err)
+		}
+	}
+
+	// The stack's other services are discovered with Cloud Map when a namespace is configured
+	if namespace := utils.GetEnv("INVOKE_CLOUDMAP_NAMESPACE", ""); namespace != "" {
+		membraneOpts.InvokeResolver, err = cloudmap.New(namespace)
+		if err != nil {
+			log.Fatalf("could not create cloud map resolver: %v", err)
+		}
+	}
+
+	// Load the appropriate gateway based on the environment.
+	switch gatewayEnv {
+	case "lambda":
+		lambdaOpts := make([]lambda_service.LambdaGatewayOption, 0)
+
+		// Oversized responses are offloaded to a bucket when one is configured
+		if overflowBucket := utils.GetEnv("GATEWAY_OVERFLOW_BUCKET", ""); overflowBucket != "" {
+			expiryEnv := utils.GetEnv("GATEWAY_OVERFLOW_EXPIRY", "300")
+			expiry, err := strconv.ParseUint(expiryEnv, 10, 32)
+			if err != nil {
+				log.Fatalf("invalid GATEWAY_OVERFLOW_EXPIRY env var, expected number of seconds, got %v", expiryEnv)
+			}
+
+			overflowConfig, err := lambda_service.ParseOverflowConfig(overflowBucket, uint32(expiry), utils.GetEnv("GATEWAY_OVERFLOW_ROUTES", ""))
+			if err != nil {
+				log.Fatalf("invalid GATEWAY_OVERFLOW_ROUTES env var: %v", err)
+			}
+
+			lambdaOpts = append(lambdaOpts, lambda_service.WithOverflow(membraneOpts.StoragePlugin, overflowConfig))
+		}
+
+		// REST APIs only return binary responses of their binary media types
+		if binaryMediaTypes := utils.GetEnv("GATEWAY_BINARY_MEDIA_TYPES", ""); binaryMediaTypes != "" {
+			lambdaOpts = append(lambdaOpts, lambda_service.WithBinaryMediaTypes(lambda_service.ParseBinaryMediaTypes(binaryMediaTypes)))
+		}
+
+		membraneOpts.GatewayPlugin, _ = lambda_service.New(provider, lambdaOpts...)
+	default:
+		membraneOpts.GatewayPlugin, _ = base_http.New(nil, base_http.WithSecrets(membraneOpts.SecretPlugin))
+	}
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
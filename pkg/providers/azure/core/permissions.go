@@ -0,0 +1,173 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/nitrictech/nitric/pkg/providers/azure/utils"
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+// AzResourceId - identifies a resource in the membrane's resource group by its provider namespace and path
+type AzResourceId struct {
+	Namespace string
+	// ParentPath - the path of the resource's parent below the namespace, empty for top level resources
+	ParentPath string
+	Type       string
+	Name       string
+}
+
+func (r AzResourceId) String() string {
+	parts := []string{r.Namespace}
+	if r.ParentPath != "" {
+		parts = append(parts, r.ParentPath)
+	}
+
+	return strings.Join(append(parts, r.Type, r.Name), "/")
+}
+
+// PermissionLister - lists the effective permissions the membrane's credentials are granted on a resource by its role assignments
+type PermissionLister interface {
+	ListPermissions(ctx context.Context, resource AzResourceId) ([]authorization.Permission, error)
+}
+
+type permissionListerImpl struct {
+	client authorization.PermissionsClient
+	rgName string
+}
+
+func (p *permissionListerImpl) ListPermissions(ctx context.Context, resource AzResourceId) ([]authorization.Permission, error) {
+	results, err := p.client.ListForResourceComplete(ctx, p.rgName, resource.Namespace, resource.ParentPath, resource.Type, resource.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []authorization.Permission{}
+	for results.NotDone() {
+		permissions = append(permissions, results.Value())
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return permissions, nil
+}
+
+// NewPermissionLister - lists permissions on resources of the resource group and subscription set in the environment
+func NewPermissionLister() (PermissionLister, error) {
+	rgName := os.Getenv(AZURE_RESOURCE_GROUP)
+	if rgName == "" {
+		return nil, fmt.Errorf("envvar %s is not set", AZURE_RESOURCE_GROUP)
+	}
+
+	subId := os.Getenv(AZURE_SUBSCRIPTION_ID)
+	if subId == "" {
+		return nil, fmt.Errorf("envvar %s is not set", AZURE_SUBSCRIPTION_ID)
+	}
+
+	spt, err := utils.GetServicePrincipalToken("https://management.azure.com")
+	if err != nil {
+		return nil, err
+	}
+
+	client := authorization.NewPermissionsClient(subId)
+	client.Authorizer = autorest.NewBearerAuthorizer(spt)
+
+	return &permissionListerImpl{
+		client: client,
+		rgName: rgName,
+	}, nil
+}
+
+// matchesAction - returns true if the action matches an action pattern of a role, patterns may contain * wildcards.
+// Actions are case insensitive
+func matchesAction(pattern string, action string) bool {
+	expr := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+
+	matched, err := regexp.MatchString(expr, action)
+
+	return err == nil && matched
+}
+
+func matchesAny(patterns *[]string, action string) bool {
+	if patterns == nil {
+		return false
+	}
+
+	for _, p := range *patterns {
+		if matchesAction(p, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grantsDataAction - a permission grants a data action if it's one of its data actions and not one of its excluded data actions
+func grantsDataAction(p authorization.Permission, action string) bool {
+	return matchesAny(p.DataActions, action) && !matchesAny(p.NotDataActions, action)
+}
+
+// CheckDataActions - checks the data actions needed on a resource against the effective permissions listed for it.
+// Permissions are named by their action and resource, sorted by action
+func CheckDataActions(lister PermissionLister, resource AzResourceId, needed []string) ([]resources.Permission, error) {
+	// several nitric actions may need the same data action
+	unique := map[string]bool{}
+	actions := []string{}
+	for _, a := range needed {
+		if !unique[a] {
+			unique[a] = true
+			actions = append(actions, a)
+		}
+	}
+	sort.Strings(actions)
+
+	if len(actions) == 0 {
+		return []resources.Permission{}, nil
+	}
+
+	granted, err := lister.ListPermissions(context.TODO(), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]resources.Permission, 0, len(actions))
+	for _, a := range actions {
+		allowed := false
+		for _, p := range granted {
+			if grantsDataAction(p, a) {
+				allowed = true
+				break
+			}
+		}
+
+		result = append(result, resources.Permission{
+			Name:    fmt.Sprintf("%s on %s", a, resource),
+			Granted: allowed,
+		})
+	}
+
+	return result, nil
+}
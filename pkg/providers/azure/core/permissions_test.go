@@ -0,0 +1,82 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+type fakeLister struct {
+	permissions []authorization.Permission
+	listed      []AzResourceId
+}
+
+func (f *fakeLister) ListPermissions(ctx context.Context, resource AzResourceId) ([]authorization.Permission, error) {
+	f.listed = append(f.listed, resource)
+
+	return f.permissions, nil
+}
+
+var _ = Describe("Permissions", func() {
+	container := AzResourceId{
+		Namespace:  "Microsoft.Storage",
+		ParentPath: "storageAccounts/acct/blobServices/default",
+		Type:       "containers",
+		Name:       "images",
+	}
+
+	When("Checking the data actions needed on a resource", func() {
+		lister := &fakeLister{permissions: []authorization.Permission{{
+			DataActions:    &[]string{"microsoft.storage/storageAccounts/blobServices/containers/blobs/*"},
+			NotDataActions: &[]string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete"},
+		}}}
+
+		It("should grant actions matching a wildcard that aren't excluded", func() {
+			permissions, err := CheckDataActions(lister, container, []string{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete on Microsoft.Storage/storageAccounts/acct/blobServices/default/containers/images", Granted: false},
+				{Name: "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read on Microsoft.Storage/storageAccounts/acct/blobServices/default/containers/images", Granted: true},
+			}))
+			Expect(lister.listed).To(Equal([]AzResourceId{container}))
+		})
+	})
+
+	When("Only management actions are granted", func() {
+		lister := &fakeLister{permissions: []authorization.Permission{{
+			Actions: &[]string{"*"},
+		}}}
+
+		It("should not grant data actions", func() {
+			permissions, err := CheckDataActions(lister, container, []string{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions[0].Granted).To(BeFalse())
+		})
+	})
+})
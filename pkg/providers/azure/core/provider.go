@@ -23,6 +23,9 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
+
+	"github.com/nitrictech/nitric/pkg/providers/azure/utils"
+	"github.com/nitrictech/nitric/pkg/stack"
 )
 
 type AzProvider interface {
@@ -57,6 +60,8 @@ type azProviderImpl struct {
 	subId   string
 	rgName  string
 	cache   azResourceCache
+	// Resources are filtered to those of the stack's environment
+	identity *stack.Identity
 }
 
 func (p *azProviderImpl) GetResources(r AzResource) (map[string]AzGenericResource, error) {
@@ -78,7 +83,18 @@ func (p *azProviderImpl) GetResources(r AzResource) (map[string]AzGenericResourc
 			}
 
 			resource := results.Value()
-			if tagV, ok := resource.Tags["x-nitric-name"]; ok && tagV != nil {
+			tags := map[string]string{}
+			for key, value := range resource.Tags {
+				if value != nil {
+					tags[key] = *value
+				}
+			}
+
+			if !p.identity.InEnvironment(tags) {
+				continue
+			}
+
+			if tagV, ok := resource.Tags[stack.NameKey]; ok && tagV != nil {
 				// Add it to the cache
 				p.cache[r][*tagV] = AzGenericResource{
 					Name:     *resource.Name,
@@ -94,24 +110,7 @@ func (p *azProviderImpl) GetResources(r AzResource) (map[string]AzGenericResourc
 }
 
 func (p *azProviderImpl) ServicePrincipalToken(resource string) (*adal.ServicePrincipalToken, error) {
-	if fileCred, fileErr := auth.GetSettingsFromFile(); fileErr == nil {
-		return fileCred.ServicePrincipalTokenFromClientCredentialsWithResource(resource)
-	} else if clientCred, clientErr := p.env.GetClientCredentials(); clientErr == nil {
-		clientCred.Resource = resource
-		return clientCred.ServicePrincipalToken()
-	} else if clientCert, certErr := p.env.GetClientCertificate(); certErr == nil {
-		clientCert.Resource = resource
-		return clientCert.ServicePrincipalToken()
-	} else if userPass, userErr := p.env.GetUsernamePassword(); userErr == nil {
-		userPass.Resource = resource
-		return userPass.ServicePrincipalToken()
-	} else {
-		fmt.Printf("error retrieving credentials:\n -> %v\n -> %v\n -> %v\n -> %v\n", fileErr, clientErr, certErr, userErr)
-	}
-
-	msiConf := p.env.GetMSI()
-	msiConf.Resource = resource
-	return msiConf.ServicePrincipalToken()
+	return utils.ServicePrincipalTokenFromSettings(p.env, resource)
 }
 
 func (p *azProviderImpl) SubscriptionId() string {
@@ -140,11 +139,17 @@ func New() (*azProviderImpl, error) {
 		return nil, err
 	}
 
+	identity, err := stack.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	prov := &azProviderImpl{
-		rgName: rgName,
-		subId:  subId,
-		env:    config,
-		cache:  make(map[string]map[string]AzGenericResource),
+		rgName:   rgName,
+		subId:    subId,
+		env:      config,
+		cache:    make(map[string]map[string]AzGenericResource),
+		identity: identity,
 	}
 
 	rclient := resources.NewClient(subId)
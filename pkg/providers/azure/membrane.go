@@ -25,11 +25,21 @@ import (
 	"github.com/nitrictech/nitric/pkg/providers/azure/core"
 
 	"github.com/nitrictech/nitric/pkg/membrane"
+	container_apps_service "github.com/nitrictech/nitric/pkg/plugins/batch/container_apps"
+	azure_cdn_service "github.com/nitrictech/nitric/pkg/plugins/cdn/azure_cdn"
+	app_configuration_service "github.com/nitrictech/nitric/pkg/plugins/config/app_configuration"
 	mongodb_service "github.com/nitrictech/nitric/pkg/plugins/document/mongodb"
 	event_grid "github.com/nitrictech/nitric/pkg/plugins/events/eventgrid"
 	http_service "github.com/nitrictech/nitric/pkg/plugins/gateway/appservice"
+	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
+	ad_b2c_service "github.com/nitrictech/nitric/pkg/plugins/identity/ad_b2c"
+	algolia_service "github.com/nitrictech/nitric/pkg/plugins/search/algolia"
+	opensearch_service "github.com/nitrictech/nitric/pkg/plugins/search/opensearch"
 	key_vault "github.com/nitrictech/nitric/pkg/plugins/secret/key_vault"
+	sqldb_service "github.com/nitrictech/nitric/pkg/plugins/sql/sqldb"
 	azblob_service "github.com/nitrictech/nitric/pkg/plugins/storage/azblob"
+	"github.com/nitrictech/nitric/pkg/usage"
+	"github.com/nitrictech/nitric/pkg/utils"
 )
 
 func main() {
@@ -44,6 +54,8 @@ func main() {
 	}
 
 	membraneOpts := membrane.DefaultMembraneOptions()
+	// Metered usage is estimated with list prices of the provider's services
+	membraneOpts.UsagePrices = usage.AzurePrices
 
 	membraneOpts.DocumentPlugin, err = mongodb_service.New()
 	if err != nil {
@@ -54,13 +66,60 @@ func main() {
 	if err != nil {
 		log.Default().Println("Failed to load event plugin:", err.Error())
 	}
-	membraneOpts.GatewayPlugin, _ = http_service.New(provider)
 	membraneOpts.QueuePlugin, _ = azqueue_service.New()
 	membraneOpts.StoragePlugin, _ = azblob_service.New()
 	membraneOpts.SecretPlugin, err = key_vault.New()
 	if err != nil {
 		log.Default().Println("Failed to load secret plugin:", err.Error())
 	}
+	membraneOpts.GatewayPlugin, _ = http_service.New(provider, base_http.WithSecrets(membraneOpts.SecretPlugin))
+
+	// Connects to Azure Database for PostgreSQL or MySQL with connections stored in Key Vault
+	membraneOpts.SqlPlugin, err = sqldb_service.New(membraneOpts.SecretPlugin)
+	if err != nil {
+		log.Default().Println("Failed to load sql plugin:", err.Error())
+	}
+
+	// Searches Algolia when it's configured, otherwise an OpenSearch or Elasticsearch cluster
+	if utils.GetEnv("ALGOLIA_APP_ID", "") != "" {
+		membraneOpts.SearchPlugin, err = algolia_service.New()
+	} else {
+		membraneOpts.SearchPlugin, err = opensearch_service.New(membraneOpts.SecretPlugin)
+	}
+	if err != nil {
+		log.Default().Println("Failed to load search plugin:", err.Error())
+	}
+
+	// Starts Container Apps jobs in the resource group
+	membraneOpts.BatchPlugin, err = container_apps_service.New(provider)
+	if err != nil {
+		log.Default().Println("Failed to load batch plugin:", err.Error())
+	}
+
+	// Azure CDN has no URL signing of its own, URLs carry a SAS for the blob forwarded by the endpoint
+	if utils.GetEnv("CDN_DOMAINS", "") != "" {
+		membraneOpts.CdnPlugin, err = azure_cdn_service.New(membraneOpts.StoragePlugin)
+		if err != nil {
+			log.Default().Println("Failed to load cdn plugin:", err.Error())
+		}
+	}
+
+	// Reads configuration from App Configuration when it's configured, otherwise from documents of the document plugin.
+	// Falling back to documents would read the wrong configuration, so a configured plugin must be created
+	if utils.GetEnv("AZURE_APP_CONFIGURATION_CONNECTION_STRING", "") != "" {
+		membraneOpts.ConfigPlugin, err = app_configuration_service.New()
+		if err != nil {
+			log.Fatalf("could not create config plugin: %v", err)
+		}
+	}
+
+	// Verifies tokens and manages the users of the AD B2C tenant AZURE_B2C_TENANT
+	if utils.GetEnv("AZURE_B2C_TENANT", "") != "" {
+		membraneOpts.IdentityPlugin, err = ad_b2c_service.New(provider)
+		if err != nil {
+			log.Fatalf("could not create identity plugin: %v", err)
+		}
+	}
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
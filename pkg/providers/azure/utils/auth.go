@@ -16,34 +16,152 @@ package utils
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 )
 
-// GetServicePrincipalToken - Retrieves the service principal token from env
-func GetServicePrincipalToken(resource string) (*adal.ServicePrincipalToken, error) {
-	config, err := auth.GetSettingsFromEnvironment()
+// AuthMethod - A way of authenticating plugins with Azure AD
+type AuthMethod = string
+
+const (
+	// AuthMethodAuto selects the first method configured by the environment
+	AuthMethodAuto AuthMethod = ""
+	// AuthMethodFile uses the SDK auth file at AZURE_AUTH_LOCATION
+	AuthMethodFile AuthMethod = "file"
+	// AuthMethodClientSecret uses AZURE_CLIENT_ID, AZURE_CLIENT_SECRET and AZURE_TENANT_ID
+	AuthMethodClientSecret AuthMethod = "client_secret"
+	// AuthMethodClientCertificate uses AZURE_CLIENT_ID, AZURE_CERTIFICATE_PATH and AZURE_TENANT_ID
+	AuthMethodClientCertificate AuthMethod = "client_certificate"
+	// AuthMethodUsernamePassword uses AZURE_CLIENT_ID, AZURE_USERNAME, AZURE_PASSWORD and AZURE_TENANT_ID
+	AuthMethodUsernamePassword AuthMethod = "username_password"
+	// AuthMethodWorkloadIdentity exchanges the token at AZURE_FEDERATED_TOKEN_FILE for AZURE_CLIENT_ID in AZURE_TENANT_ID
+	AuthMethodWorkloadIdentity AuthMethod = "workload_identity"
+	// AuthMethodManagedIdentity uses the host's managed identity, or the user-assigned identity AZURE_CLIENT_ID
+	AuthMethodManagedIdentity AuthMethod = "managed_identity"
+)
+
+// clientAssertionType - The OAuth client assertion type of federated tokens
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// federatedTokenSecret - Authenticates a service principal with a federated token,
+// read from its file on every refresh as the token is rotated by the platform
+type federatedTokenSecret struct {
+	file string
+}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := os.ReadFile(s.file)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve azure auth settings: %v", err)
+		return fmt.Errorf("failed to read federated token: %v", err)
+	}
+
+	v.Set("client_assertion", strings.TrimSpace(string(token)))
+	v.Set("client_assertion_type", clientAssertionType)
+
+	return nil
+}
+
+// SelectAuthMethod - Returns the auth method configured by the environment, explicitly with AZURE_AUTH_METHOD
+// or otherwise the first of static credentials, workload identity and managed identity that are available
+func SelectAuthMethod(settings auth.EnvironmentSettings) (AuthMethod, error) {
+	switch method := strings.ToLower(os.Getenv(AZURE_AUTH_METHOD)); method {
+	case AuthMethodAuto:
+	case AuthMethodFile, AuthMethodClientSecret, AuthMethodClientCertificate, AuthMethodUsernamePassword, AuthMethodWorkloadIdentity, AuthMethodManagedIdentity:
+		return method, nil
+	default:
+		return "", fmt.Errorf("invalid %s env var, expected one of file, client_secret, client_certificate, username_password, workload_identity or managed_identity, got %v", AZURE_AUTH_METHOD, method)
+	}
+
+	if os.Getenv("AZURE_AUTH_LOCATION") != "" {
+		return AuthMethodFile, nil
+	} else if _, err := settings.GetClientCredentials(); err == nil {
+		return AuthMethodClientSecret, nil
+	} else if _, err := settings.GetClientCertificate(); err == nil {
+		return AuthMethodClientCertificate, nil
+	} else if _, err := settings.GetUsernamePassword(); err == nil {
+		return AuthMethodUsernamePassword, nil
+	} else if os.Getenv(AZURE_FEDERATED_TOKEN_FILE) != "" {
+		return AuthMethodWorkloadIdentity, nil
+	}
+
+	return AuthMethodManagedIdentity, nil
+}
+
+// ServicePrincipalTokenFromSettings - Retrieves a service principal token for the resource, authenticated by the method selected for the environment
+func ServicePrincipalTokenFromSettings(settings auth.EnvironmentSettings, resource string) (*adal.ServicePrincipalToken, error) {
+	method, err := SelectAuthMethod(settings)
+	if err != nil {
+		return nil, err
 	}
 
-	if fileCred, fileErr := auth.GetSettingsFromFile(); fileErr == nil {
+	switch method {
+	case AuthMethodFile:
+		fileCred, err := auth.GetSettingsFromFile()
+		if err != nil {
+			return nil, err
+		}
 		return fileCred.ServicePrincipalTokenFromClientCredentialsWithResource(resource)
-	} else if clientCred, clientErr := config.GetClientCredentials(); clientErr == nil {
+	case AuthMethodClientSecret:
+		clientCred, err := settings.GetClientCredentials()
+		if err != nil {
+			return nil, err
+		}
 		clientCred.Resource = resource
 		return clientCred.ServicePrincipalToken()
-	} else if clientCert, certErr := config.GetClientCertificate(); certErr == nil {
+	case AuthMethodClientCertificate:
+		clientCert, err := settings.GetClientCertificate()
+		if err != nil {
+			return nil, err
+		}
 		clientCert.Resource = resource
 		return clientCert.ServicePrincipalToken()
-	} else if userPass, userErr := config.GetUsernamePassword(); userErr == nil {
+	case AuthMethodUsernamePassword:
+		userPass, err := settings.GetUsernamePassword()
+		if err != nil {
+			return nil, err
+		}
 		userPass.Resource = resource
 		return userPass.ServicePrincipalToken()
-	} else {
-		fmt.Printf("error retrieving credentials:\n -> %v\n -> %v\n -> %v\n -> %v\n", fileErr, clientErr, certErr, userErr)
+	case AuthMethodWorkloadIdentity:
+		return workloadIdentityToken(settings, resource)
 	}
 
-	msiConf := config.GetMSI()
+	msiConf := settings.GetMSI()
 	msiConf.Resource = resource
 	return msiConf.ServicePrincipalToken()
 }
+
+func workloadIdentityToken(settings auth.EnvironmentSettings, resource string) (*adal.ServicePrincipalToken, error) {
+	tokenFile := os.Getenv(AZURE_FEDERATED_TOKEN_FILE)
+	clientID := settings.Values[auth.ClientID]
+	tenantID := settings.Values[auth.TenantID]
+	if tokenFile == "" || clientID == "" || tenantID == "" {
+		return nil, fmt.Errorf("workload identity requires %s, %s and %s to be set", AZURE_FEDERATED_TOKEN_FILE, auth.ClientID, auth.TenantID)
+	}
+
+	authority := settings.Environment.ActiveDirectoryEndpoint
+	if host := os.Getenv(AZURE_AUTHORITY_HOST); host != "" {
+		authority = host
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(authority, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return adal.NewServicePrincipalTokenWithSecret(*oauthConfig, clientID, resource, &federatedTokenSecret{file: tokenFile})
+}
+
+// GetServicePrincipalToken - Retrieves the service principal token from env
+func GetServicePrincipalToken(resource string) (*adal.ServicePrincipalToken, error) {
+	config, err := auth.GetSettingsFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve azure auth settings: %v", err)
+	}
+
+	return ServicePrincipalTokenFromSettings(config, resource)
+}
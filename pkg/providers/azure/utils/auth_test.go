@@ -0,0 +1,147 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Auth", func() {
+	settings := func(values map[string]string) auth.EnvironmentSettings {
+		return auth.EnvironmentSettings{
+			Values:      values,
+			Environment: azure.PublicCloud,
+		}
+	}
+
+	AfterEach(func() {
+		os.Unsetenv(AZURE_AUTH_METHOD)
+		os.Unsetenv(AZURE_FEDERATED_TOKEN_FILE)
+		os.Unsetenv(AZURE_AUTHORITY_HOST)
+	})
+
+	When("Selecting an auth method", func() {
+		It("should prefer static client credentials", func() {
+			os.Setenv(AZURE_FEDERATED_TOKEN_FILE, "/var/run/secrets/token")
+
+			method, err := SelectAuthMethod(settings(map[string]string{
+				auth.ClientID:     "client",
+				auth.ClientSecret: "secret",
+				auth.TenantID:     "tenant",
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(method).To(Equal(AuthMethodClientSecret))
+		})
+
+		It("should select workload identity when a federated token is projected", func() {
+			os.Setenv(AZURE_FEDERATED_TOKEN_FILE, "/var/run/secrets/token")
+
+			method, err := SelectAuthMethod(settings(map[string]string{
+				auth.ClientID: "client",
+				auth.TenantID: "tenant",
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(method).To(Equal(AuthMethodWorkloadIdentity))
+		})
+
+		It("should fall back to managed identity", func() {
+			method, err := SelectAuthMethod(settings(map[string]string{
+				auth.ClientID: "user-assigned",
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(method).To(Equal(AuthMethodManagedIdentity))
+		})
+
+		It("should use an explicitly configured method", func() {
+			os.Setenv(AZURE_AUTH_METHOD, "Managed_Identity")
+
+			method, err := SelectAuthMethod(settings(map[string]string{
+				auth.ClientID:     "client",
+				auth.ClientSecret: "secret",
+				auth.TenantID:     "tenant",
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(method).To(Equal(AuthMethodManagedIdentity))
+		})
+
+		It("should return an error for an unknown method", func() {
+			os.Setenv(AZURE_AUTH_METHOD, "password")
+
+			_, err := SelectAuthMethod(settings(map[string]string{}))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("Authenticating with workload identity", func() {
+		It("should return an error without a client and tenant", func() {
+			os.Setenv(AZURE_AUTH_METHOD, AuthMethodWorkloadIdentity)
+			os.Setenv(AZURE_FEDERATED_TOKEN_FILE, "/var/run/secrets/token")
+
+			_, err := ServicePrincipalTokenFromSettings(settings(map[string]string{}), "https://vault.azure.net")
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should exchange the current federated token on refresh", func() {
+			dir, err := ioutil.TempDir("", "workload-identity")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			tokenFile := filepath.Join(dir, "token")
+			Expect(os.WriteFile(tokenFile, []byte("first-token\n"), 0600)).To(Succeed())
+
+			var assertions []string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/tenant/oauth2/token"))
+				Expect(r.ParseForm()).To(Succeed())
+				Expect(r.PostForm.Get("client_id")).To(Equal("client"))
+				Expect(r.PostForm.Get("resource")).To(Equal("https://vault.azure.net"))
+				Expect(r.PostForm.Get("client_assertion_type")).To(Equal(clientAssertionType))
+				assertions = append(assertions, r.PostForm.Get("client_assertion"))
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"access","token_type":"Bearer","expires_in":"3600","expires_on":"4102444800","not_before":"0","resource":"https://vault.azure.net"}`))
+			}))
+			defer server.Close()
+
+			os.Setenv(AZURE_FEDERATED_TOKEN_FILE, tokenFile)
+			os.Setenv(AZURE_AUTHORITY_HOST, server.URL)
+
+			spt, err := ServicePrincipalTokenFromSettings(settings(map[string]string{
+				auth.ClientID: "client",
+				auth.TenantID: "tenant",
+			}), "https://vault.azure.net")
+			Expect(err).ShouldNot(HaveOccurred())
+			spt.SetSender(server.Client())
+
+			Expect(spt.Refresh()).To(Succeed())
+			Expect(spt.OAuthToken()).To(Equal("access"))
+
+			By("reading the rotated token")
+			Expect(os.WriteFile(tokenFile, []byte("second-token"), 0600)).To(Succeed())
+			Expect(spt.Refresh()).To(Succeed())
+
+			Expect(assertions).To(Equal([]string{"first-token", "second-token"}))
+		})
+	})
+})
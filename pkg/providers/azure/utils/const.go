@@ -19,3 +19,12 @@ const AZURE_STORAGE_BLOB_ENDPOINT = "AZURE_STORAGE_ACCOUNT_BLOB_ENDPOINT"
 
 // AZURE_STORAGE_BLOB_ENDPOINT - Endpoint for azqueue queue plugin
 const AZURE_STORAGE_QUEUE_ENDPOINT = "AZURE_STORAGE_ACCOUNT_QUEUE_ENDPOINT"
+
+// AZURE_AUTH_METHOD - Overrides the automatic selection of how plugins authenticate with Azure
+const AZURE_AUTH_METHOD = "AZURE_AUTH_METHOD"
+
+// AZURE_FEDERATED_TOKEN_FILE - Path of the projected service account token used for workload identity federation
+const AZURE_FEDERATED_TOKEN_FILE = "AZURE_FEDERATED_TOKEN_FILE"
+
+// AZURE_AUTHORITY_HOST - Azure AD authority used to exchange federated tokens, defaults to the cloud's endpoint
+const AZURE_AUTHORITY_HOST = "AZURE_AUTHORITY_HOST"
@@ -24,10 +24,15 @@ import (
 	"github.com/nitrictech/nitric/pkg/membrane"
 	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
 	events_service "github.com/nitrictech/nitric/pkg/plugins/events/dev"
+	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
 	gateway_plugin "github.com/nitrictech/nitric/pkg/plugins/gateway/dev"
 	queue_service "github.com/nitrictech/nitric/pkg/plugins/queue/dev"
+	algolia_service "github.com/nitrictech/nitric/pkg/plugins/search/algolia"
+	opensearch_service "github.com/nitrictech/nitric/pkg/plugins/search/opensearch"
 	secret_service "github.com/nitrictech/nitric/pkg/plugins/secret/dev"
+	sqldb_service "github.com/nitrictech/nitric/pkg/plugins/sql/sqldb"
 	minio_storage_service "github.com/nitrictech/nitric/pkg/plugins/storage/minio"
+	"github.com/nitrictech/nitric/pkg/utils"
 )
 
 func main() {
@@ -40,10 +45,21 @@ func main() {
 
 	membraneOpts.SecretPlugin, _ = secret_service.New()
 	membraneOpts.DocumentPlugin, _ = boltdb_service.New()
-	membraneOpts.EventsPlugin, _ = events_service.New()
-	membraneOpts.GatewayPlugin, _ = gateway_plugin.New()
+	gatewayPlugin, err := gateway_plugin.New(base_http.WithSecrets(membraneOpts.SecretPlugin))
+	if err != nil {
+		log.Fatalf("There was an error initialising the gateway plugin: %v", err)
+	}
+	membraneOpts.GatewayPlugin = gatewayPlugin
+	// Events published locally are delivered to the application's subscribers by the gateway, which also fires its schedules
+	membraneOpts.EventsPlugin, _ = events_service.New(events_service.WithLocalSubscribers(gatewayPlugin))
 	membraneOpts.QueuePlugin, _ = queue_service.New()
 	membraneOpts.StoragePlugin, _ = minio_storage_service.New()
+	membraneOpts.SqlPlugin, _ = sqldb_service.New(membraneOpts.SecretPlugin)
+	if utils.GetEnv("ALGOLIA_APP_ID", "") != "" {
+		membraneOpts.SearchPlugin, _ = algolia_service.New()
+	} else {
+		membraneOpts.SearchPlugin, _ = opensearch_service.New(membraneOpts.SecretPlugin)
+	}
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
@@ -0,0 +1,102 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+// PermissionTester - tests the IAM permissions the membrane's credentials are granted on a resource with testIamPermissions,
+// without performing the operations they allow. Implemented by the IAM handles of GCP clients
+type PermissionTester interface {
+	// TestPermissions - returns the subset of the permissions that are granted
+	TestPermissions(ctx context.Context, permissions []string) ([]string, error)
+}
+
+type projectPermissionTester struct {
+	service   *cloudresourcemanager.Service
+	projectId string
+}
+
+func (p *projectPermissionTester) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	out, err := p.service.Projects.TestIamPermissions(p.projectId, &cloudresourcemanager.TestIamPermissionsRequest{
+		Permissions: permissions,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Permissions, nil
+}
+
+// NewProjectPermissionTester - tests permissions granted on the project, for resources without their own IAM policies, e.g. Firestore collections
+func NewProjectPermissionTester(ctx context.Context, credentials *google.Credentials, projectId string) (PermissionTester, error) {
+	service, err := cloudresourcemanager.NewService(ctx, option.WithCredentials(credentials))
+	if err != nil {
+		return nil, fmt.Errorf("resource manager client error: %v", err)
+	}
+
+	return &projectPermissionTester{
+		service:   service,
+		projectId: projectId,
+	}, nil
+}
+
+// TestPermissions - tests the permissions needed on a resource with its tester.
+// Permissions are named by their permission and resource, sorted by permission
+func TestPermissions(tester PermissionTester, resource string, needed []string) ([]resources.Permission, error) {
+	// several nitric actions may need the same permission
+	unique := map[string]bool{}
+	permissions := []string{}
+	for _, p := range needed {
+		if !unique[p] {
+			unique[p] = true
+			permissions = append(permissions, p)
+		}
+	}
+	sort.Strings(permissions)
+
+	if len(permissions) == 0 {
+		return []resources.Permission{}, nil
+	}
+
+	granted, err := tester.TestPermissions(context.TODO(), permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		allowed[g] = true
+	}
+
+	result := make([]resources.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		result = append(result, resources.Permission{
+			Name:    fmt.Sprintf("%s on %s", p, resource),
+			Granted: allowed[p],
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/resources"
+)
+
+type fakeTester struct {
+	tested  [][]string
+	granted map[string]bool
+}
+
+func (f *fakeTester) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	f.tested = append(f.tested, permissions)
+
+	granted := []string{}
+	for _, p := range permissions {
+		if f.granted[p] {
+			granted = append(granted, p)
+		}
+	}
+
+	return granted, nil
+}
+
+var _ = Describe("Permissions", func() {
+	When("Testing the permissions needed on a resource", func() {
+		tester := &fakeTester{granted: map[string]bool{"storage.objects.get": true}}
+
+		It("should test each permission once", func() {
+			permissions, err := TestPermissions(tester, "projects/_/buckets/images", []string{
+				"storage.objects.list", "storage.objects.get", "storage.objects.list",
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(permissions).To(Equal([]resources.Permission{
+				{Name: "storage.objects.get on projects/_/buckets/images", Granted: true},
+				{Name: "storage.objects.list on projects/_/buckets/images", Granted: false},
+			}))
+			Expect(tester.tested).To(Equal([][]string{{"storage.objects.get", "storage.objects.list"}}))
+		})
+	})
+})
@@ -0,0 +1,306 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	pubsubbase "cloud.google.com/go/pubsub/apiv1"
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	ifaces_gcloud_secret "github.com/nitrictech/nitric/pkg/ifaces/gcloud_secret"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// ScopeCloudPlatform - the scope of the credentials shared by GCP clients, covering every service the plugins use
+const ScopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
+
+// GcpProvider - Provides the project and clients shared by GCP plugins.
+// Credentials and clients are created when they're first requested, and the same client is returned to every plugin requesting it
+type GcpProvider interface {
+	// ProjectID - returns the ID of the project the default credentials belong to
+	ProjectID() (string, error)
+	// Credentials - returns the default credentials, with the cloud platform scope
+	Credentials() (*google.Credentials, error)
+	SecretManagerClient() (ifaces_gcloud_secret.SecretManagerClient, error)
+	FirestoreClient() (*firestore.Client, error)
+	PubsubClient() (*pubsub.Client, error)
+	// SubscriberClient - returns a low level Pub/Sub client, for pulling and acknowledging individual messages
+	SubscriberClient() (*pubsubbase.SubscriberClient, error)
+	StorageClient() (*storage.Client, error)
+	// MonitoringService - returns a Cloud Monitoring client, for reading the metrics of resources
+	MonitoringService() (*monitoring.Service, error)
+}
+
+// ClientOptions - configures the gRPC connections of GCP clients
+type ClientOptions struct {
+	// How long a connection is idle before it's pinged to keep it alive, disabled if zero
+	KeepaliveTime time.Duration
+	// How long to wait for a keepalive ping to be acknowledged before closing the connection
+	KeepaliveTimeout time.Duration
+	// The number of connections each client opens, the client's default if zero
+	PoolSize int
+}
+
+// gcpProviderImpl - creates each client once, lock guards creation so concurrent requests share a client
+type gcpProviderImpl struct {
+	ctx     context.Context
+	options *ClientOptions
+
+	lock                sync.Mutex
+	credentials         *google.Credentials
+	secretManagerClient ifaces_gcloud_secret.SecretManagerClient
+	firestoreClient     *firestore.Client
+	pubsubClient        *pubsub.Client
+	subscriberClient    *pubsubbase.SubscriberClient
+	storageClient       *storage.Client
+	monitoringService   *monitoring.Service
+}
+
+var _ GcpProvider = &gcpProviderImpl{}
+
+// getCredentials - lock must be held
+func (g *gcpProviderImpl) getCredentials() (*google.Credentials, error) {
+	if g.credentials == nil {
+		credentials, err := google.FindDefaultCredentials(g.ctx, ScopeCloudPlatform)
+		if err != nil {
+			return nil, fmt.Errorf("GCP credentials error: %v", err)
+		}
+		g.credentials = credentials
+	}
+
+	return g.credentials, nil
+}
+
+// grpcOptions - returns the options for gRPC clients, lock must be held
+func (g *gcpProviderImpl) grpcOptions() ([]option.ClientOption, error) {
+	credentials, err := g.getCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []option.ClientOption{option.WithCredentials(credentials)}
+
+	if g.options.KeepaliveTime > 0 {
+		opts = append(opts, option.WithGRPCDialOption(grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                g.options.KeepaliveTime,
+			Timeout:             g.options.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		})))
+	}
+
+	if g.options.PoolSize > 0 {
+		opts = append(opts, option.WithGRPCConnectionPool(g.options.PoolSize))
+	}
+
+	return opts, nil
+}
+
+func (g *gcpProviderImpl) Credentials() (*google.Credentials, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.getCredentials()
+}
+
+func (g *gcpProviderImpl) ProjectID() (string, error) {
+	credentials, err := g.Credentials()
+	if err != nil {
+		return "", err
+	}
+
+	return credentials.ProjectID, nil
+}
+
+func (g *gcpProviderImpl) SecretManagerClient() (ifaces_gcloud_secret.SecretManagerClient, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.secretManagerClient == nil {
+		opts, err := g.grpcOptions()
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := ifaces_gcloud_secret.NewClient(g.ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("secret manager client error: %v", err)
+		}
+		g.secretManagerClient = client
+	}
+
+	return g.secretManagerClient, nil
+}
+
+func (g *gcpProviderImpl) FirestoreClient() (*firestore.Client, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.firestoreClient == nil {
+		opts, err := g.grpcOptions()
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := firestore.NewClient(g.ctx, g.credentials.ProjectID, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("firestore client error: %v", err)
+		}
+		g.firestoreClient = client
+	}
+
+	return g.firestoreClient, nil
+}
+
+func (g *gcpProviderImpl) PubsubClient() (*pubsub.Client, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.pubsubClient == nil {
+		opts, err := g.grpcOptions()
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := pubsub.NewClient(g.ctx, g.credentials.ProjectID, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("pubsub client error: %v", err)
+		}
+		g.pubsubClient = client
+	}
+
+	return g.pubsubClient, nil
+}
+
+func (g *gcpProviderImpl) SubscriberClient() (*pubsubbase.SubscriberClient, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.subscriberClient == nil {
+		opts, err := g.grpcOptions()
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := pubsubbase.NewSubscriberClient(g.ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("pubsub subscriber client error: %v", err)
+		}
+		g.subscriberClient = client
+	}
+
+	return g.subscriberClient, nil
+}
+
+func (g *gcpProviderImpl) StorageClient() (*storage.Client, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.storageClient == nil {
+		credentials, err := g.getCredentials()
+		if err != nil {
+			return nil, err
+		}
+
+		// The storage client uses HTTP, so gRPC options don't apply
+		client, err := storage.NewClient(g.ctx, option.WithCredentials(credentials))
+		if err != nil {
+			return nil, fmt.Errorf("storage client error: %v", err)
+		}
+		g.storageClient = client
+	}
+
+	return g.storageClient, nil
+}
+
+func (g *gcpProviderImpl) MonitoringService() (*monitoring.Service, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.monitoringService == nil {
+		credentials, err := g.getCredentials()
+		if err != nil {
+			return nil, err
+		}
+
+		// The monitoring client uses HTTP, so gRPC options don't apply
+		service, err := monitoring.NewService(g.ctx, option.WithCredentials(credentials))
+		if err != nil {
+			return nil, fmt.Errorf("monitoring client error: %v", err)
+		}
+		g.monitoringService = service
+	}
+
+	return g.monitoringService, nil
+}
+
+// clientOptionsFromEnv - reads the gRPC connection options of clients from GCP_KEEPALIVE_TIME, GCP_KEEPALIVE_TIMEOUT and GCP_CONNECTION_POOL_SIZE
+func clientOptionsFromEnv() (*ClientOptions, error) {
+	options := &ClientOptions{}
+
+	if timeEnv := utils.GetEnv("GCP_KEEPALIVE_TIME", ""); timeEnv != "" {
+		keepaliveTime, err := time.ParseDuration(timeEnv)
+		if err != nil || keepaliveTime < 0 {
+			return nil, fmt.Errorf("invalid GCP_KEEPALIVE_TIME env var, expected duration e.g. 30s, got %v", timeEnv)
+		}
+		options.KeepaliveTime = keepaliveTime
+	}
+
+	timeoutEnv := utils.GetEnv("GCP_KEEPALIVE_TIMEOUT", "20s")
+	keepaliveTimeout, err := time.ParseDuration(timeoutEnv)
+	if err != nil || keepaliveTimeout <= 0 {
+		return nil, fmt.Errorf("invalid GCP_KEEPALIVE_TIMEOUT env var, expected duration e.g. 20s, got %v", timeoutEnv)
+	}
+	options.KeepaliveTimeout = keepaliveTimeout
+
+	if poolEnv := utils.GetEnv("GCP_CONNECTION_POOL_SIZE", ""); poolEnv != "" {
+		poolSize, err := strconv.Atoi(poolEnv)
+		if err != nil || poolSize < 1 {
+			return nil, fmt.Errorf("invalid GCP_CONNECTION_POOL_SIZE env var, expected positive integer, got %v", poolEnv)
+		}
+		options.PoolSize = poolSize
+	}
+
+	return options, nil
+}
+
+// New - returns a provider sharing clients configured from the environment, no clients are created until they're requested
+func New() (GcpProvider, error) {
+	options, err := clientOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithOptions(context.Background(), options), nil
+}
+
+// NewWithOptions - returns a provider sharing clients with the given connection options
+func NewWithOptions(ctx context.Context, options *ClientOptions) GcpProvider {
+	return &gcpProviderImpl{
+		ctx:     ctx,
+		options: options,
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+var _ = Describe("GcpProvider", func() {
+	When("Reading client options from the environment", func() {
+		AfterEach(func() {
+			os.Unsetenv("GCP_KEEPALIVE_TIME")
+			os.Unsetenv("GCP_KEEPALIVE_TIMEOUT")
+			os.Unsetenv("GCP_CONNECTION_POOL_SIZE")
+		})
+
+		It("should return the configured options", func() {
+			os.Setenv("GCP_KEEPALIVE_TIME", "30s")
+			os.Setenv("GCP_CONNECTION_POOL_SIZE", "4")
+
+			options, err := clientOptionsFromEnv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(options).To(Equal(&ClientOptions{
+				KeepaliveTime:    30 * time.Second,
+				KeepaliveTimeout: 20 * time.Second,
+				PoolSize:         4,
+			}))
+		})
+
+		It("should return an error for an invalid pool size", func() {
+			os.Setenv("GCP_CONNECTION_POOL_SIZE", "0")
+
+			_, err := clientOptionsFromEnv()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("Requesting a client from multiple plugins", func() {
+		provider := &gcpProviderImpl{
+			ctx:     context.Background(),
+			options: &ClientOptions{KeepaliveTime: time.Minute, KeepaliveTimeout: time.Second, PoolSize: 2},
+			// Use static credentials, so no credentials are looked up
+			credentials: &google.Credentials{
+				ProjectID:   "my-project",
+				TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}),
+			},
+		}
+
+		It("should create the client once and share it", func() {
+			wg := sync.WaitGroup{}
+			clients := make(chan interface{}, 10)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					client, err := provider.PubsubClient()
+					Expect(err).ShouldNot(HaveOccurred())
+					clients <- client
+				}()
+			}
+			wg.Wait()
+			close(clients)
+
+			first := <-clients
+			for client := range clients {
+				Expect(client).To(BeIdenticalTo(first))
+			}
+
+			projectId, err := provider.ProjectID()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(projectId).To(Equal("my-project"))
+		})
+	})
+})
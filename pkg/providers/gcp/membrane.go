@@ -22,12 +22,22 @@ import (
 	"syscall"
 
 	"github.com/nitrictech/nitric/pkg/membrane"
+	cloudrun_jobs_service "github.com/nitrictech/nitric/pkg/plugins/batch/cloudrun_jobs"
+	cloud_cdn_service "github.com/nitrictech/nitric/pkg/plugins/cdn/cloud_cdn"
 	firestore_service "github.com/nitrictech/nitric/pkg/plugins/document/firestore"
 	pubsub_service "github.com/nitrictech/nitric/pkg/plugins/events/pubsub"
+	"github.com/nitrictech/nitric/pkg/plugins/gateway/base_http"
 	cloudrun_plugin "github.com/nitrictech/nitric/pkg/plugins/gateway/cloudrun"
+	firebase_auth_service "github.com/nitrictech/nitric/pkg/plugins/identity/firebase_auth"
 	pubsub_queue_service "github.com/nitrictech/nitric/pkg/plugins/queue/pubsub"
+	algolia_service "github.com/nitrictech/nitric/pkg/plugins/search/algolia"
+	opensearch_service "github.com/nitrictech/nitric/pkg/plugins/search/opensearch"
 	secret_manager_secret_service "github.com/nitrictech/nitric/pkg/plugins/secret/secret_manager"
+	sqldb_service "github.com/nitrictech/nitric/pkg/plugins/sql/sqldb"
 	storage_service "github.com/nitrictech/nitric/pkg/plugins/storage/storage"
+	"github.com/nitrictech/nitric/pkg/providers/gcp/core"
+	"github.com/nitrictech/nitric/pkg/usage"
+	"github.com/nitrictech/nitric/pkg/utils"
 )
 
 func main() {
@@ -38,37 +48,84 @@ func main() {
 	signal.Notify(term, os.Interrupt, syscall.SIGINT)
 
 	membraneOpts := membrane.DefaultMembraneOptions()
+	// Metered usage is estimated with list prices of the provider's services
+	membraneOpts.UsagePrices = usage.GcpPrices
 
-	membraneOpts.SecretPlugin, err = secret_manager_secret_service.New()
+	// Plugins share the provider's credentials and clients
+	provider, err := core.New()
+	if err != nil {
+		log.Fatalf("could not create gcp provider: %v", err)
+		return
+	}
+
+	membraneOpts.SecretPlugin, err = secret_manager_secret_service.New(provider)
 	if err != nil {
 		log.Default().Println("Failed to load secret plugin:", err.Error())
 	}
 
-	membraneOpts.DocumentPlugin, err = firestore_service.New()
+	membraneOpts.DocumentPlugin, err = firestore_service.New(provider)
 	if err != nil {
 		log.Default().Println("Failed to load document plugin:", err.Error())
 	}
 
-	membraneOpts.EventsPlugin, err = pubsub_service.New()
+	membraneOpts.EventsPlugin, err = pubsub_service.New(provider)
 	if err != nil {
 		log.Default().Println("Failed to load events plugin:", err.Error())
 	}
 
-	membraneOpts.StoragePlugin, err = storage_service.New()
+	membraneOpts.StoragePlugin, err = storage_service.New(provider)
 	if err != nil {
 		log.Default().Println("Failed to load storage plugin:", err.Error())
 	}
 
-	membraneOpts.GatewayPlugin, err = cloudrun_plugin.New()
+	membraneOpts.GatewayPlugin, err = cloudrun_plugin.New(base_http.WithSecrets(membraneOpts.SecretPlugin))
 	if err != nil {
 		log.Default().Println("Failed to load gateway plugin:", err.Error())
 	}
 
-	membraneOpts.QueuePlugin, err = pubsub_queue_service.New()
+	membraneOpts.QueuePlugin, err = pubsub_queue_service.New(provider)
 	if err != nil {
 		log.Default().Println("Failed to load queue plugin:", err.Error())
 	}
 
+	// Connects to Cloud SQL databases, e.g. with host=/cloudsql/<instance connection name> in the stored connection
+	membraneOpts.SqlPlugin, err = sqldb_service.New(membraneOpts.SecretPlugin)
+	if err != nil {
+		log.Default().Println("Failed to load sql plugin:", err.Error())
+	}
+
+	// Searches Algolia when it's configured, otherwise an OpenSearch or Elasticsearch cluster
+	if utils.GetEnv("ALGOLIA_APP_ID", "") != "" {
+		membraneOpts.SearchPlugin, err = algolia_service.New()
+	} else {
+		membraneOpts.SearchPlugin, err = opensearch_service.New(membraneOpts.SecretPlugin)
+	}
+	if err != nil {
+		log.Default().Println("Failed to load search plugin:", err.Error())
+	}
+
+	// Runs jobs as Cloud Run Jobs executions in BATCH_REGION
+	if utils.GetEnv("BATCH_REGION", "") != "" {
+		membraneOpts.BatchPlugin, err = cloudrun_jobs_service.New(provider)
+		if err != nil {
+			log.Default().Println("Failed to load batch plugin:", err.Error())
+		}
+	}
+
+	// Signs Cloud CDN URLs and cookies with the backend bucket key stored as the CDN_KEY_SECRET secret
+	if utils.GetEnv("CDN_DOMAINS", "") != "" {
+		membraneOpts.CdnPlugin, err = cloud_cdn_service.New(membraneOpts.SecretPlugin)
+		if err != nil {
+			log.Default().Println("Failed to load cdn plugin:", err.Error())
+		}
+	}
+
+	// Verifies tokens and manages the users of the project's Firebase Auth, or FIREBASE_PROJECT_ID's
+	membraneOpts.IdentityPlugin, err = firebase_auth_service.New(provider)
+	if err != nil {
+		log.Default().Println("Failed to load identity plugin:", err.Error())
+	}
+
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
 		log.Fatalf("There was an error initialising the membrane server: %v", err)
@@ -0,0 +1,292 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+)
+
+// maxLineSize - partial lines written to a redacting writer are buffered up to this size before they're redacted anyway
+const maxLineSize = 64 * 1024
+
+// Matcher - finds a kind of sensitive value in text
+type Matcher struct {
+	// Identifies the kind of value in its replacement, e.g. [REDACTED:email]
+	Name    string
+	Pattern *regexp.Regexp
+	// Checks a match is sensitive before it's redacted, every match is redacted if nil
+	Valid func(match string) bool
+}
+
+var (
+	// Emails - matches email addresses
+	Emails = []Matcher{{
+		Name:    "email",
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+	}}
+
+	// Tokens - matches bearer tokens, JWTs, AWS access key ids and the values of token, secret and password parameters.
+	// When a pattern has a group only the group is redacted, so the parameter names stay readable
+	Tokens = []Matcher{
+		{Name: "token", Pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`)},
+		{Name: "token", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)},
+		{Name: "token", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{Name: "token", Pattern: regexp.MustCompile(`(?i)\b(?:access_token|api_?key|auth_token|client_secret|password|secret|token)=([^\s&"',;]+)`)},
+	}
+
+	// CardNumbers - matches payment card numbers of 13 to 19 digits, optionally grouped by spaces or dashes, that pass the Luhn check
+	CardNumbers = []Matcher{{
+		Name:    "card",
+		Pattern: regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`),
+		Valid:   luhn,
+	}}
+)
+
+var builtin = map[string][]Matcher{
+	"email": Emails,
+	"token": Tokens,
+	"card":  CardNumbers,
+}
+
+// luhn - returns true if the digits of a match pass the Luhn checksum
+func luhn(match string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(match)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// ParseMatchers - parses comma separated names of built in matchers, email, token and card, or all of them with "all"
+func ParseMatchers(names string) ([]Matcher, error) {
+	matchers := make([]Matcher, 0)
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if name == "all" {
+			matchers = append(matchers, Emails...)
+			matchers = append(matchers, Tokens...)
+			matchers = append(matchers, CardNumbers...)
+			continue
+		}
+
+		m, ok := builtin[name]
+		if !ok {
+			known := make([]string, 0, len(builtin))
+			for k := range builtin {
+				known = append(known, k)
+			}
+			sort.Strings(known)
+
+			return nil, fmt.Errorf("unknown matcher %s, expected one of %s or all", name, strings.Join(known, ", "))
+		}
+		matchers = append(matchers, m...)
+	}
+
+	return matchers, nil
+}
+
+// Redactor - removes sensitive values from logs and the details of errors returned by the runtime APIs
+type Redactor struct {
+	matchers []Matcher
+	// error scope args and resources whose values are always redacted, e.g. secret names
+	args map[string]bool
+}
+
+// String - returns the text with every sensitive value replaced by [REDACTED:<matcher name>]
+func (r *Redactor) String(s string) string {
+	for _, m := range r.matchers {
+		s = r.replace(m, s)
+	}
+
+	return s
+}
+
+func (r *Redactor) replace(m Matcher, s string) string {
+	matches := m.Pattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		// Only redact the group, when the pattern has one and it matched
+		if len(match) > 2 && match[2] >= 0 {
+			start, end = match[2], match[3]
+		}
+
+		if m.Valid != nil && !m.Valid(s[start:end]) {
+			continue
+		}
+
+		b.WriteString(s[last:start])
+		b.WriteString("[REDACTED:" + m.Name + "]")
+		last = end
+	}
+	b.WriteString(s[last:])
+
+	return b.String()
+}
+
+// Arg - returns the redacted value of a named error scope arg or resource
+func (r *Redactor) Arg(name string, value string) string {
+	if r.args[name] {
+		return "[REDACTED]"
+	}
+
+	return r.String(value)
+}
+
+// Details - returns a copy of error details with their message, cause, args and resources redacted
+func (r *Redactor) Details(ed *v1.ErrorDetails) *v1.ErrorDetails {
+	redacted := proto.Clone(ed).(*v1.ErrorDetails)
+
+	redacted.Message = r.String(redacted.Message)
+	redacted.Cause = r.String(redacted.Cause)
+	for k, v := range redacted.Resources {
+		redacted.Resources[k] = r.Arg(k, v)
+	}
+	if redacted.Scope != nil {
+		for k, v := range redacted.Scope.Args {
+			redacted.Scope.Args[k] = r.Arg(k, v)
+		}
+	}
+
+	return redacted
+}
+
+// Error - returns a gRPC status error with its message and error details redacted, errors without a status are redacted as text
+func (r *Redactor) Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("%s", r.String(err.Error()))
+	}
+
+	redacted := status.New(s.Code(), r.String(s.Message()))
+	for _, d := range s.Details() {
+		if ed, ok := d.(*v1.ErrorDetails); ok {
+			redacted, _ = redacted.WithDetails(r.Details(ed))
+		}
+	}
+
+	return redacted.Err()
+}
+
+// UnaryServerInterceptor - redacts the errors returned by unary runtime API calls
+func (r *Redactor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		return resp, r.Error(err)
+	}
+}
+
+// StreamServerInterceptor - redacts the errors returned by streaming runtime API calls
+func (r *Redactor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return r.Error(handler(srv, ss))
+	}
+}
+
+// writer - redacts each line written before passing it on, so values split across writes are still found
+type writer struct {
+	redactor *Redactor
+	out      io.Writer
+
+	lock sync.Mutex
+	buf  []byte
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	end := bytes.LastIndexByte(w.buf, '\n') + 1
+	if end == 0 && len(w.buf) >= maxLineSize {
+		end = len(w.buf)
+	}
+
+	if end > 0 {
+		if _, err := io.WriteString(w.out, w.redactor.String(string(w.buf[:end]))); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[end:]...)
+	}
+
+	return len(p), nil
+}
+
+// Writer - returns a writer that redacts what's written to it before writing it to out.
+// Output is written a line at a time, so a trailing partial line is held until it's completed
+func (r *Redactor) Writer(out io.Writer) io.Writer {
+	return &writer{
+		redactor: r,
+		out:      out,
+	}
+}
+
+// New - returns a redactor for values found by the matchers, and the values of the named error scope args and resources
+func New(matchers []Matcher, args []string) *Redactor {
+	r := &Redactor{
+		matchers: matchers,
+		args:     map[string]bool{},
+	}
+
+	for _, a := range args {
+		if a = strings.TrimSpace(a); a != "" {
+			r.args[a] = true
+		}
+	}
+
+	return r
+}
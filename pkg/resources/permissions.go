@@ -0,0 +1,175 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Permission - a provider permission needed for an action on a resource, and whether the membrane's credentials are granted it
+type Permission struct {
+	Name    string `json:"name"`
+	Granted bool   `json:"granted"`
+}
+
+// PermissionChecker - an optional interface of plugins that can dry run the permissions of their operations,
+// e.g. with IAM policy simulation or testIamPermissions, without performing them
+type PermissionChecker interface {
+	// CheckPermissions - returns the permissions needed for the actions on the named resource, and whether each is granted.
+	// Actions on other types of resource are ignored
+	CheckPermissions(name string, actions []Action) ([]Permission, error)
+}
+
+// ResourcePermissions - the permissions needed for the actions allowed on a declared resource
+type ResourcePermissions struct {
+	Resource    Resource
+	Actions     []Action
+	Permissions []Permission
+	// Checked - false if the resource's plugin can't check its permissions
+	Checked bool
+	Err     error
+}
+
+// PermissionReport - the permissions needed for the actions allowed by the application's policies,
+// the least privileges the membrane's credentials need
+type PermissionReport struct {
+	Resources []ResourcePermissions
+}
+
+// Missing - the number of permissions that aren't granted or couldn't be checked because of an error
+func (r *PermissionReport) Missing() int {
+	missing := 0
+	for _, res := range r.Resources {
+		if res.Err != nil {
+			missing++
+		}
+		for _, p := range res.Permissions {
+			if !p.Granted {
+				missing++
+			}
+		}
+	}
+
+	return missing
+}
+
+func (r *PermissionReport) String() string {
+	lines := []string{}
+	for _, res := range r.Resources {
+		actions := make([]string, 0, len(res.Actions))
+		for _, a := range res.Actions {
+			actions = append(actions, string(a))
+		}
+		lines = append(lines, fmt.Sprintf("  %s (%s)", res.Resource, strings.Join(actions, ", ")))
+
+		switch {
+		case res.Err != nil:
+			lines = append(lines, fmt.Sprintf("    could not be checked: %v", res.Err))
+		case !res.Checked:
+			lines = append(lines, "    not checked by the provider")
+		}
+
+		for _, p := range res.Permissions {
+			status := "granted"
+			if !p.Granted {
+				status = "MISSING"
+			}
+			lines = append(lines, fmt.Sprintf("    %s: %s", p.Name, status))
+		}
+	}
+
+	return fmt.Sprintf("permissions needed by declared policies, %d missing:\n%s", r.Missing(), strings.Join(lines, "\n"))
+}
+
+type resourcePermissionsJSON struct {
+	Type        Type         `json:"type"`
+	Name        string       `json:"name"`
+	Actions     []Action     `json:"actions"`
+	Checked     bool         `json:"checked"`
+	Error       string       `json:"error,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// MarshalJSON - the report with the number of missing permissions, and the errors of resources that couldn't be checked as strings
+func (r *PermissionReport) MarshalJSON() ([]byte, error) {
+	res := make([]resourcePermissionsJSON, 0, len(r.Resources))
+	for _, rp := range r.Resources {
+		rj := resourcePermissionsJSON{
+			Type:        rp.Resource.Type,
+			Name:        rp.Resource.Name,
+			Actions:     rp.Actions,
+			Checked:     rp.Checked,
+			Permissions: rp.Permissions,
+		}
+		if rp.Err != nil {
+			rj.Error = rp.Err.Error()
+		}
+		if rj.Permissions == nil {
+			rj.Permissions = []Permission{}
+		}
+		res = append(res, rj)
+	}
+
+	return json.Marshal(struct {
+		Missing   int                       `json:"missing"`
+		Resources []resourcePermissionsJSON `json:"resources"`
+	}{
+		Missing:   r.Missing(),
+		Resources: res,
+	})
+}
+
+// PermissionsHandler - serves the permission report of the registry's resources, checked again on each request so
+// permissions granted after startup are seen. The report is JSON, or the text of the startup log if text/plain is accepted
+func (v *Validator) PermissionsHandler(registry *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := v.CheckPermissions(registry)
+
+		if strings.Contains(r.Header.Get("Accept"), "text/plain") {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			fmt.Fprintln(w, report)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// CheckPermissions - dry runs the permissions needed for the actions allowed on each resource of the registry.
+// Resources without allowed actions need no permissions, so they're left out of the report
+func (v *Validator) CheckPermissions(registry *Registry) *PermissionReport {
+	report := &PermissionReport{}
+
+	for _, res := range registry.Resources() {
+		actions := registry.Actions(res)
+		if len(actions) == 0 {
+			continue
+		}
+
+		rp := ResourcePermissions{Resource: res, Actions: actions}
+		if checker, ok := v.For(res.Type).(PermissionChecker); ok {
+			rp.Checked = true
+			rp.Permissions, rp.Err = checker.CheckPermissions(res.Name, actions)
+		}
+
+		report.Resources = append(report.Resources, rp)
+	}
+
+	return report
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/nitrictech/nitric/pkg/naming"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/stack"
+)
+
+// Provisioner - an optional interface of plugins that can create the resources they serve
+type Provisioner interface {
+	// Provision - creates the named resource if it doesn't exist, labelled with its name and the stack's labels
+	Provision(name string, identity *stack.Identity) error
+}
+
+// Creator - an optional interface of Provisioners that report whether they created the resource,
+// so setup only applied to new resources isn't repeated each time the resource is declared
+type Creator interface {
+	// Create - creates the named resource if it doesn't exist, returning true if it was created
+	Create(name string, identity *stack.Identity) (bool, error)
+}
+
+// Labels - the labels of a provisioned resource, so it's found by its name in the stack's environment
+func Labels(name string, identity *stack.Identity) map[string]string {
+	labels := identity.Labels()
+	labels[stack.NameKey] = name
+
+	return labels
+}
+
+// GlobalName - returns a unique name for a resource whose names must be unique across every account or project,
+// its name qualified by the stack and environment, with a random suffix. At most 63 lowercase letters, numbers and dashes.
+// Returns a naming.CollisionError if another name has already been translated to the same name
+func GlobalName(name string, identity *stack.Identity) (string, error) {
+	physical, err := naming.Buckets.Physical(identity.Qualify(name))
+	if err != nil {
+		return "", err
+	}
+
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+
+	return physical + "-" + hex.EncodeToString(suffix), nil
+}
+
+// StableName - returns the same name as GlobalName, with a suffix derived from the scope (e.g. an account ID) rather than a random one,
+// so every instance provisioning the resource agrees on its name
+func StableName(name string, identity *stack.Identity, scope string) (string, error) {
+	physical, err := naming.Buckets.Physical(identity.Qualify(name))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(scope + "/" + identity.Qualify(name)))
+
+	return physical + "-" + hex.EncodeToString(sum[:4]), nil
+}
+
+// AutoProvisioner - creates declared resources that don't exist, for dev and test environments that aren't deployed.
+// A resource is skipped if its plugin is nil or isn't a Provisioner
+type AutoProvisioner struct {
+	Plugins
+	Identity *stack.Identity
+	// BucketLifecycle - lifecycle rules applied to buckets when they're created, keyed by nitric bucket name
+	BucketLifecycle map[string][]*storage.LifecycleRule
+}
+
+// Provision - creates the resource if it doesn't exist and its plugin can create it
+func (a *AutoProvisioner) Provision(res Resource) error {
+	if rules := a.BucketLifecycle[res.Name]; res.Type == Bucket && len(rules) > 0 {
+		return a.provisionBucket(res.Name, rules)
+	}
+
+	provisioner, ok := a.For(res.Type).(Provisioner)
+	if !ok {
+		return nil
+	}
+
+	return provisioner.Provision(res.Name, a.Identity)
+}
+
+// provisionBucket - creates the bucket if it doesn't exist, applying its lifecycle rules if it's created
+func (a *AutoProvisioner) provisionBucket(name string, rules []*storage.LifecycleRule) error {
+	creator, ok := a.Storage.(Creator)
+	if !ok {
+		return fmt.Errorf("lifecycle rules are configured for bucket %s but the storage plugin can't create buckets", name)
+	}
+
+	lifecycle, ok := a.Storage.(storage.LifecycleService)
+	if !ok {
+		return fmt.Errorf("lifecycle rules are configured for bucket %s but the storage plugin doesn't support them", name)
+	}
+
+	created, err := creator.Create(name, a.Identity)
+	if err != nil || !created {
+		return err
+	}
+
+	return lifecycle.SetLifecycleRules(name, rules)
+}
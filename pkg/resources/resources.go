@@ -0,0 +1,288 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resources records the resources an application declares and checks they exist before they're first used
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/queue"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+type Type string
+
+const (
+	Bucket     Type = "bucket"
+	Topic      Type = "topic"
+	Queue      Type = "queue"
+	Collection Type = "collection"
+	Secret     Type = "secret"
+)
+
+type Resource struct {
+	Type Type
+	Name string
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %s", r.Type, r.Name)
+}
+
+// Action - an operation the application is allowed on a resource by its declared policies, named like the nitric api's actions
+type Action string
+
+const (
+	BucketFileList           Action = "BucketFileList"
+	BucketFileGet            Action = "BucketFileGet"
+	BucketFilePut            Action = "BucketFilePut"
+	BucketFileDelete         Action = "BucketFileDelete"
+	TopicList                Action = "TopicList"
+	TopicDetail              Action = "TopicDetail"
+	TopicEventPublish        Action = "TopicEventPublish"
+	QueueSend                Action = "QueueSend"
+	QueueReceive             Action = "QueueReceive"
+	QueueList                Action = "QueueList"
+	QueueDetail              Action = "QueueDetail"
+	CollectionDocumentRead   Action = "CollectionDocumentRead"
+	CollectionDocumentWrite  Action = "CollectionDocumentWrite"
+	CollectionDocumentDelete Action = "CollectionDocumentDelete"
+	CollectionQuery          Action = "CollectionQuery"
+	CollectionList           Action = "CollectionList"
+	SecretPut                Action = "SecretPut"
+	SecretAccess             Action = "SecretAccess"
+)
+
+// Registry - the resources declared by an application and the actions it's allowed on them, safe for concurrent use
+type Registry struct {
+	lock     sync.Mutex
+	declared map[Resource]bool
+	allowed  map[Resource]map[Action]bool
+}
+
+// Declare - records a resource declared by the application
+func (r *Registry) Declare(res Resource) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.declared == nil {
+		r.declared = map[Resource]bool{}
+	}
+	r.declared[res] = true
+}
+
+// Allow - records actions the application is allowed on a resource by a declared policy
+func (r *Registry) Allow(res Resource, actions ...Action) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.allowed == nil {
+		r.allowed = map[Resource]map[Action]bool{}
+	}
+	if r.allowed[res] == nil {
+		r.allowed[res] = map[Action]bool{}
+	}
+	for _, a := range actions {
+		r.allowed[res][a] = true
+	}
+}
+
+// Resources - the declared resources, sorted by type then name
+func (r *Registry) Resources() []Resource {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	res := make([]Resource, 0, len(r.declared))
+	for d := range r.declared {
+		res = append(res, d)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Type != res[j].Type {
+			return res[i].Type < res[j].Type
+		}
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// Allowed - returns true if the application is allowed the action on the resource
+func (r *Registry) Allowed(res Resource, action Action) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.allowed[res][action]
+}
+
+// Actions - the actions the application is allowed on the resource, sorted
+func (r *Registry) Actions(res Resource) []Action {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	actions := make([]Action, 0, len(r.allowed[res]))
+	for a := range r.allowed[res] {
+		actions = append(actions, a)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i] < actions[j]
+	})
+
+	return actions
+}
+
+// Problem - a declared resource that doesn't exist or can't be accessed
+type Problem struct {
+	Resource Resource
+	Code     codes.Code
+	Err      error
+}
+
+func (p Problem) String() string {
+	switch p.Code {
+	case codes.NotFound:
+		return fmt.Sprintf("%s: does not exist", p.Resource)
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return fmt.Sprintf("%s: is not accessible with the current credentials: %v", p.Resource, p.Err)
+	default:
+		return fmt.Sprintf("%s: %v", p.Resource, p.Err)
+	}
+}
+
+// Report - every problem found when validating declared resources
+type Report struct {
+	Problems []Problem
+}
+
+func (r *Report) Error() string {
+	lines := make([]string, 0, len(r.Problems))
+	for _, p := range r.Problems {
+		lines = append(lines, "  "+p.String())
+	}
+
+	return fmt.Sprintf("%d declared resource(s) failed validation:\n%s", len(r.Problems), strings.Join(lines, "\n"))
+}
+
+// Plugins - the plugins serving each type of resource, any may be nil
+type Plugins struct {
+	Storage  storage.StorageService
+	Events   events.EventService
+	Queue    queue.QueueService
+	Document document.DocumentService
+	Secret   secret.SecretService
+}
+
+// For - returns the plugin serving the type of resource, to check for its optional interfaces
+func (p *Plugins) For(t Type) interface{} {
+	switch t {
+	case Bucket:
+		return p.Storage
+	case Topic:
+		return p.Events
+	case Queue:
+		return p.Queue
+	case Collection:
+		return p.Document
+	case Secret:
+		return p.Secret
+	}
+
+	return nil
+}
+
+// Validator - checks declared resources exist using the plugins that serve them,
+// a resource is skipped if its plugin is nil or doesn't implement the check
+type Validator struct {
+	Plugins
+}
+
+// Validate - checks every resource of the registry, returning a *Report if any are missing or inaccessible
+func (v *Validator) Validate(registry *Registry) error {
+	report := &Report{}
+
+	var topics map[string]bool
+	var topicsErr error
+	for _, res := range registry.Resources() {
+		var err error
+		switch res.Type {
+		case Bucket:
+			if v.Storage != nil {
+				_, err = v.Storage.ListFiles(res.Name)
+			}
+		case Topic:
+			if v.Events != nil {
+				if topics == nil && topicsErr == nil {
+					topics, topicsErr = v.listTopics()
+				}
+				err = topicsErr
+				if err == nil && !topics[res.Name] {
+					err = errors.ErrorsWithScope("Validator.Validate", map[string]interface{}{"topic": res.Name})(codes.NotFound, "topic not found", nil)
+				}
+			}
+		case Queue:
+			if v.Queue != nil {
+				_, err = v.Queue.GetQueueStats(res.Name)
+			}
+		case Collection:
+			if v.Document != nil {
+				_, err = v.Document.Query(&document.Collection{Name: res.Name}, nil, 1, nil)
+			}
+		case Secret:
+			// A secret that's only written to may not have a version yet
+			if v.Secret != nil && registry.Allowed(res, SecretAccess) {
+				_, err = v.Secret.Access(&secret.SecretVersion{
+					Secret:  &secret.Secret{Name: res.Name},
+					Version: "latest",
+				})
+			}
+		}
+
+		if err != nil && !unimplemented(err) {
+			report.Problems = append(report.Problems, Problem{Resource: res, Code: errors.Code(err), Err: err})
+		}
+	}
+
+	if len(report.Problems) > 0 {
+		return report
+	}
+
+	return nil
+}
+
+func (v *Validator) listTopics() (map[string]bool, error) {
+	names, err := v.Events.ListTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make(map[string]bool, len(names))
+	for _, n := range names {
+		topics[n] = true
+	}
+
+	return topics, nil
+}
+
+// unimplemented - returns true if the plugin doesn't support the operation used to check a resource
+func unimplemented(err error) bool {
+	return errors.Code(err) == codes.Unimplemented || err.Error() == "UNIMPLEMENTED"
+}
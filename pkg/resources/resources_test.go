@@ -0,0 +1,364 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	mock_storage "github.com/nitrictech/nitric/mocks/storage"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/resources"
+	"github.com/nitrictech/nitric/pkg/stack"
+)
+
+type topicLister struct {
+	events.UnimplementedeventsPlugin
+	topics []string
+}
+
+func (t *topicLister) ListTopics() ([]string, error) {
+	return t.topics, nil
+}
+
+type provisionedStorage struct {
+	storage.UnimplementedStoragePlugin
+	provisioned []string
+	existing    map[string]bool
+	rules       map[string][]*storage.LifecycleRule
+}
+
+func (p *provisionedStorage) Create(name string, identity *stack.Identity) (bool, error) {
+	if p.existing[name] {
+		return false, nil
+	}
+
+	return true, p.Provision(name, identity)
+}
+
+func (p *provisionedStorage) SetLifecycleRules(bucket string, rules []*storage.LifecycleRule) error {
+	if p.rules == nil {
+		p.rules = map[string][]*storage.LifecycleRule{}
+	}
+	p.rules[bucket] = rules
+
+	return nil
+}
+
+func (p *provisionedStorage) Provision(name string, identity *stack.Identity) error {
+	p.provisioned = append(p.provisioned, identity.Qualify(name))
+	return nil
+}
+
+type checkedStorage struct {
+	storage.UnimplementedStoragePlugin
+}
+
+func (c *checkedStorage) CheckPermissions(name string, actions []resources.Action) ([]resources.Permission, error) {
+	if name == "broken" {
+		return nil, fmt.Errorf("simulation failed")
+	}
+
+	return []resources.Permission{
+		{Name: "storage.list on " + name, Granted: true},
+		{Name: "storage.write on " + name, Granted: name != "readonly"},
+	}, nil
+}
+
+var notFound = errors.ErrorsWithScope("test", nil)(codes.NotFound, "not found", nil)
+
+var _ = Describe("Resources", func() {
+	Context("Registry", func() {
+		It("should return declared resources once, sorted by type then name", func() {
+			registry := &resources.Registry{}
+			registry.Declare(resources.Resource{Type: resources.Topic, Name: "b"})
+			registry.Declare(resources.Resource{Type: resources.Bucket, Name: "z"})
+			registry.Declare(resources.Resource{Type: resources.Topic, Name: "a"})
+			registry.Declare(resources.Resource{Type: resources.Topic, Name: "b"})
+
+			Expect(registry.Resources()).To(Equal([]resources.Resource{
+				{Type: resources.Bucket, Name: "z"},
+				{Type: resources.Topic, Name: "a"},
+				{Type: resources.Topic, Name: "b"},
+			}))
+		})
+	})
+
+	Context("Validator", func() {
+		var ctrl *gomock.Controller
+		var registry *resources.Registry
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			registry = &resources.Registry{}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		When("every declared resource exists", func() {
+			It("should return nil", func() {
+				storage := mock_storage.NewMockStorageService(ctrl)
+				storage.EXPECT().ListFiles("images").Return(nil, nil)
+				docs := mock_document.NewMockDocumentService(ctrl)
+				docs.EXPECT().Query(gomock.Any(), nil, 1, nil).Return(nil, nil)
+
+				registry.Declare(resources.Resource{Type: resources.Bucket, Name: "images"})
+				registry.Declare(resources.Resource{Type: resources.Collection, Name: "users"})
+				registry.Declare(resources.Resource{Type: resources.Topic, Name: "orders"})
+
+				validator := &resources.Validator{Plugins: resources.Plugins{
+					Storage:  storage,
+					Document: docs,
+					Events:   &topicLister{topics: []string{"orders"}},
+				}}
+
+				Expect(validator.Validate(registry)).To(BeNil())
+			})
+		})
+
+		When("declared resources are missing or inaccessible", func() {
+			It("should report every problem", func() {
+				storage := mock_storage.NewMockStorageService(ctrl)
+				storage.EXPECT().ListFiles("images").Return(nil, notFound)
+				storage.EXPECT().ListFiles("private").Return(nil, errors.ErrorsWithScope("test", nil)(codes.PermissionDenied, "access denied", nil))
+
+				registry.Declare(resources.Resource{Type: resources.Bucket, Name: "images"})
+				registry.Declare(resources.Resource{Type: resources.Bucket, Name: "private"})
+				registry.Declare(resources.Resource{Type: resources.Topic, Name: "orders"})
+
+				validator := &resources.Validator{Plugins: resources.Plugins{
+					Storage: storage,
+					Events:  &topicLister{},
+				}}
+
+				err := validator.Validate(registry)
+				Expect(err).To(HaveOccurred())
+
+				report, ok := err.(*resources.Report)
+				Expect(ok).To(BeTrue())
+				Expect(report.Problems).To(HaveLen(3))
+				Expect(report.Problems[0].Code).To(Equal(codes.NotFound))
+				Expect(report.Problems[1].Code).To(Equal(codes.PermissionDenied))
+				Expect(report.Problems[2].Resource.Name).To(Equal("orders"))
+				Expect(err.Error()).To(ContainSubstring("3 declared resource(s) failed validation"))
+				Expect(err.Error()).To(ContainSubstring("bucket images: does not exist"))
+				Expect(err.Error()).To(ContainSubstring("topic orders: does not exist"))
+			})
+		})
+
+		When("a secret is declared", func() {
+			It("should only be checked if it's accessed", func() {
+				secrets := mock_secret.NewMockSecretService(ctrl)
+				secrets.EXPECT().Access(gomock.Any()).Return(nil, notFound)
+
+				registry.Declare(resources.Resource{Type: resources.Secret, Name: "api-key"})
+				registry.Declare(resources.Resource{Type: resources.Secret, Name: "written"})
+				registry.Allow(resources.Resource{Type: resources.Secret, Name: "api-key"}, resources.SecretAccess)
+
+				err := (&resources.Validator{Plugins: resources.Plugins{Secret: secrets}}).Validate(registry)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*resources.Report).Problems).To(HaveLen(1))
+				Expect(err.(*resources.Report).Problems[0].Resource.Name).To(Equal("api-key"))
+			})
+		})
+
+		When("a plugin doesn't support the check", func() {
+			It("should skip the resource", func() {
+				storage := mock_storage.NewMockStorageService(ctrl)
+				storage.EXPECT().ListFiles("images").Return(nil, fmt.Errorf("UNIMPLEMENTED"))
+
+				registry.Declare(resources.Resource{Type: resources.Bucket, Name: "images"})
+				registry.Declare(resources.Resource{Type: resources.Queue, Name: "jobs"})
+
+				Expect((&resources.Validator{Plugins: resources.Plugins{Storage: storage}}).Validate(registry)).To(BeNil())
+			})
+		})
+	})
+
+	Context("AutoProvisioner", func() {
+		It("should provision resources with plugins that can create them", func() {
+			storage := &provisionedStorage{}
+			provisioner := &resources.AutoProvisioner{
+				Plugins: resources.Plugins{
+					Storage: storage,
+					Events:  &topicLister{},
+				},
+				Identity: &stack.Identity{Name: "shop"},
+			}
+
+			Expect(provisioner.Provision(resources.Resource{Type: resources.Bucket, Name: "images"})).To(Succeed())
+			Expect(provisioner.Provision(resources.Resource{Type: resources.Topic, Name: "orders"})).To(Succeed())
+			Expect(provisioner.Provision(resources.Resource{Type: resources.Queue, Name: "jobs"})).To(Succeed())
+
+			Expect(storage.provisioned).To(Equal([]string{"shop-images"}))
+		})
+
+		It("should only apply lifecycle rules to buckets it creates", func() {
+			rules := []*storage.LifecycleRule{{ExpireAfterDays: 1}}
+			storagePlugin := &provisionedStorage{existing: map[string]bool{"logs": true}}
+			provisioner := &resources.AutoProvisioner{
+				Plugins:  resources.Plugins{Storage: storagePlugin},
+				Identity: &stack.Identity{Name: "shop"},
+				BucketLifecycle: map[string][]*storage.LifecycleRule{
+					"images": rules,
+					"logs":   rules,
+				},
+			}
+
+			Expect(provisioner.Provision(resources.Resource{Type: resources.Bucket, Name: "images"})).To(Succeed())
+			Expect(provisioner.Provision(resources.Resource{Type: resources.Bucket, Name: "logs"})).To(Succeed())
+
+			Expect(storagePlugin.rules).To(Equal(map[string][]*storage.LifecycleRule{"images": rules}))
+		})
+	})
+
+	Context("GlobalName", func() {
+		It("should qualify the name with a random suffix", func() {
+			name, err := resources.GlobalName("images", &stack.Identity{Name: "shop", Environment: "test"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(name).To(MatchRegexp(`^shop-test-images-[0-9a-f]{8}$`))
+
+			other, err := resources.GlobalName("images", &stack.Identity{Name: "shop", Environment: "test"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(other).ToNot(Equal(name))
+		})
+
+		It("should sanitize the name with a hash of it", func() {
+			name, err := resources.GlobalName("My_Images", &stack.Identity{Name: "shop", Environment: "test"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(name).To(MatchRegexp(`^shop-test-my-images-[0-9a-f]{8}-[0-9a-f]{8}$`))
+		})
+
+		It("should be at most 63 characters", func() {
+			name, err := resources.GlobalName(strings.Repeat("a", 100), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(name)).To(Equal(63))
+		})
+	})
+
+	Context("StableName", func() {
+		It("should derive the suffix from the scope", func() {
+			identity := &stack.Identity{Name: "shop", Environment: "test"}
+
+			name, err := resources.StableName("images", identity, "123456789012")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(name).To(MatchRegexp(`^shop-test-images-[0-9a-f]{8}$`))
+
+			same, _ := resources.StableName("images", identity, "123456789012")
+			Expect(same).To(Equal(name))
+
+			other, _ := resources.StableName("images", identity, "210987654321")
+			Expect(other).ToNot(Equal(name))
+		})
+	})
+
+	Context("CheckPermissions", func() {
+		var registry *resources.Registry
+		var validator *resources.Validator
+
+		BeforeEach(func() {
+			registry = &resources.Registry{}
+			validator = &resources.Validator{Plugins: resources.Plugins{
+				Storage: &checkedStorage{},
+				Events:  &topicLister{},
+			}}
+		})
+
+		It("should report the permissions of allowed actions", func() {
+			res := resources.Resource{Type: resources.Bucket, Name: "images"}
+			registry.Declare(res)
+			registry.Allow(res, resources.BucketFileList, resources.BucketFilePut)
+
+			report := validator.CheckPermissions(registry)
+			Expect(report.Missing()).To(Equal(0))
+			Expect(report.Resources).To(HaveLen(1))
+			Expect(report.Resources[0].Checked).To(BeTrue())
+			Expect(report.Resources[0].Actions).To(Equal([]resources.Action{resources.BucketFileList, resources.BucketFilePut}))
+		})
+
+		It("should count missing permissions and errors", func() {
+			for _, name := range []string{"readonly", "broken"} {
+				res := resources.Resource{Type: resources.Bucket, Name: name}
+				registry.Declare(res)
+				registry.Allow(res, resources.BucketFilePut)
+			}
+
+			report := validator.CheckPermissions(registry)
+			Expect(report.Missing()).To(Equal(2))
+			Expect(report.String()).To(ContainSubstring("storage.write on readonly: MISSING"))
+			Expect(report.String()).To(ContainSubstring("could not be checked: simulation failed"))
+		})
+
+		It("should leave out resources without allowed actions and mark those without a checker", func() {
+			registry.Declare(resources.Resource{Type: resources.Bucket, Name: "unused"})
+			topic := resources.Resource{Type: resources.Topic, Name: "orders"}
+			registry.Declare(topic)
+			registry.Allow(topic, resources.TopicEventPublish)
+
+			report := validator.CheckPermissions(registry)
+			Expect(report.Resources).To(HaveLen(1))
+			Expect(report.Resources[0].Checked).To(BeFalse())
+			Expect(report.String()).To(ContainSubstring("not checked by the provider"))
+		})
+
+		It("should serve the report as JSON", func() {
+			for _, name := range []string{"readonly", "broken"} {
+				res := resources.Resource{Type: resources.Bucket, Name: name}
+				registry.Declare(res)
+				registry.Allow(res, resources.BucketFilePut)
+			}
+
+			rec := httptest.NewRecorder()
+			validator.PermissionsHandler(registry).ServeHTTP(rec, httptest.NewRequest("GET", "/diagnostics/permissions", nil))
+
+			Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(rec.Body.String()).To(MatchJSON(`{
+				"missing": 2,
+				"resources": [
+					{"type": "bucket", "name": "broken", "actions": ["BucketFilePut"], "checked": true, "error": "simulation failed", "permissions": []},
+					{"type": "bucket", "name": "readonly", "actions": ["BucketFilePut"], "checked": true, "permissions": [
+						{"name": "storage.list on readonly", "granted": true},
+						{"name": "storage.write on readonly", "granted": false}
+					]}
+				]
+			}`))
+		})
+
+		It("should serve the report as text if it's accepted", func() {
+			res := resources.Resource{Type: resources.Bucket, Name: "readonly"}
+			registry.Declare(res)
+			registry.Allow(res, resources.BucketFilePut)
+
+			req := httptest.NewRequest("GET", "/diagnostics/permissions", nil)
+			req.Header.Set("Accept", "text/plain")
+			rec := httptest.NewRecorder()
+			validator.PermissionsHandler(registry).ServeHTTP(rec, req)
+
+			Expect(rec.Body.String()).To(ContainSubstring("storage.write on readonly: MISSING"))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cpuPeriod - the cgroup CPU accounting period in microseconds
+const cpuPeriod = 100000
+
+// Cgroup - a cgroup v2 group enforcing resource limits on the processes added to it
+type Cgroup struct {
+	path string
+}
+
+func (c *Cgroup) write(file string, value string) error {
+	return os.WriteFile(filepath.Join(c.path, file), []byte(value), 0o644)
+}
+
+// AddProcess - moves a process into the cgroup
+func (c *Cgroup) AddProcess(pid int) error {
+	return c.write("cgroup.procs", strconv.Itoa(pid))
+}
+
+// OOMKills - returns the number of processes in the cgroup killed for exceeding its memory limit
+func (c *Cgroup) OOMKills() (int, error) {
+	events, err := os.ReadFile(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return 0, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(events))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			return strconv.Atoi(fields[1])
+		}
+	}
+
+	return 0, nil
+}
+
+// Remove - deletes the cgroup, it must not contain any running processes
+func (c *Cgroup) Remove() error {
+	return os.Remove(c.path)
+}
+
+// NewCgroup - creates a cgroup named name beneath the cgroup v2 hierarchy mounted at root, applying the given limits
+func NewCgroup(root string, name string, limits *Limits) (*Cgroup, error) {
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return nil, fmt.Errorf("cgroup v2 is not available at %s: %v", root, err)
+	}
+
+	// Delegate the cpu and memory controllers to child groups, this fails if they're already enabled or unavailable
+	// in which case writing the limits below will report the problem
+	_ = os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("+cpu +memory"), 0o644)
+
+	cgroup := &Cgroup{
+		path: filepath.Join(root, name),
+	}
+
+	if err := os.Mkdir(cgroup.path, 0o755); err != nil && !os.IsExist(err) {
+		return nil, fmt.Errorf("unable to create cgroup %s: %v", cgroup.path, err)
+	}
+
+	if limits.CPU > 0 {
+		quota := int64(limits.CPU * cpuPeriod)
+		if err := cgroup.write("cpu.max", fmt.Sprintf("%d %d", quota, cpuPeriod)); err != nil {
+			return nil, fmt.Errorf("unable to apply cpu limit: %v", err)
+		}
+	}
+
+	if limits.MemoryBytes > 0 {
+		if err := cgroup.write("memory.max", strconv.FormatInt(limits.MemoryBytes, 10)); err != nil {
+			return nil, fmt.Errorf("unable to apply memory limit: %v", err)
+		}
+
+		// Without disabling swap the child process would be slowed rather than terminated when it exceeds the limit
+		if _, err := os.Stat(filepath.Join(cgroup.path, "memory.swap.max")); err == nil {
+			if err := cgroup.write("memory.swap.max", "0"); err != nil {
+				return nil, fmt.Errorf("unable to disable swap: %v", err)
+			}
+		}
+	}
+
+	return cgroup, nil
+}
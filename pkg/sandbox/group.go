@@ -0,0 +1,165 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRestartDelay - how long to wait before restarting a child process that exited on its own
+	DefaultRestartDelay = time.Second
+	// DefaultMaxRestartDelay - the longest a child process that keeps exiting waits to be restarted
+	DefaultMaxRestartDelay = 30 * time.Second
+)
+
+// GroupOptions - how a group of child processes is run
+type GroupOptions struct {
+	// The number of processes to run, at least 1
+	Size int
+	// Additional environment variables for the process with the given index, called each time it's started. May be nil
+	Env func(index int) []string
+	// Where the output of the process with the given index is written, the membrane's stdout and stderr if nil
+	Output func(index int) (stdout io.Writer, stderr io.Writer)
+	// How long to wait before restarting a process that exited on its own, doubling with each consecutive exit up to MaxRestartDelay.
+	// Processes that exit on their own aren't restarted if zero
+	RestartDelay    time.Duration
+	MaxRestartDelay time.Duration
+}
+
+// Group - runs several instances of the child process, each within its own resource limits
+type Group struct {
+	processes []*Process
+	// serializes rolling restarts
+	restartLock sync.Mutex
+}
+
+// Size - returns the number of processes in the group
+func (g *Group) Size() int {
+	return len(g.processes)
+}
+
+// Start - starts every process in the group, stopping any already started if one fails
+func (g *Group) Start() error {
+	for i, p := range g.processes {
+		if err := p.Start(); err != nil {
+			for _, started := range g.processes[:i] {
+				started.Stop()
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Terminate - kills every running process in the group for the given reason, they will be restarted once they exit
+func (g *Group) Terminate(reason string) {
+	for _, p := range g.processes {
+		p.Terminate(reason)
+	}
+}
+
+// Pids - returns the process IDs of the processes in the group, by index
+func (g *Group) Pids() []int {
+	pids := make([]int, len(g.processes))
+	for i, p := range g.processes {
+		pids[i] = p.Pid()
+	}
+
+	return pids
+}
+
+// Restart - restarts the processes in the group one at a time, so the others can keep handling triggers.
+// Each process must be running again within the timeout, and ready is called before the next process is restarted.
+func (g *Group) Restart(timeout time.Duration, ready func(index int) error) error {
+	g.restartLock.Lock()
+	defer g.restartLock.Unlock()
+
+	for i, p := range g.processes {
+		pid := p.Pid()
+		p.Restart("rolling restart requested")
+
+		waitUntil := time.Now().Add(timeout)
+		for current := p.Pid(); current == pid || current == 0; current = p.Pid() {
+			if time.Now().After(waitUntil) {
+				return fmt.Errorf("child process %d did not restart within %s", i, timeout)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if ready != nil {
+			if err := ready(i); err != nil {
+				return fmt.Errorf("child process %d is not ready after restarting: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Stop - kills every process in the group without restarting them and removes their cgroups
+func (g *Group) Stop() {
+	for _, p := range g.processes {
+		p.Stop()
+	}
+}
+
+// NewGroup - creates a sandbox for a group of instances of the given command, each with their own copy of the limits
+func NewGroup(command []string, limits *Limits, cgroupRoot string, opts *GroupOptions) (*Group, error) {
+	if opts.Size < 1 {
+		return nil, fmt.Errorf("a group requires at least 1 child process, got %d", opts.Size)
+	}
+
+	if opts.MaxRestartDelay < opts.RestartDelay {
+		opts.MaxRestartDelay = opts.RestartDelay
+	}
+
+	processes := make([]*Process, 0, opts.Size)
+	for i := 0; i < opts.Size; i++ {
+		p, err := NewProcess(command, limits, cgroupRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		p.restartDelay = opts.RestartDelay
+		p.maxRestartDelay = opts.MaxRestartDelay
+		if opts.Env != nil {
+			index := i
+			p.env = func() []string {
+				return opts.Env(index)
+			}
+		}
+		if opts.Output != nil {
+			p.stdout, p.stderr = opts.Output(i)
+		}
+
+		if opts.Size > 1 {
+			p.name = fmt.Sprintf("nitric-%d-%d", os.Getpid(), i)
+			p.logPrefix = fmt.Sprintf("[child %d] ", i)
+		}
+
+		processes = append(processes, p)
+	}
+
+	return &Group{
+		processes: processes,
+	}, nil
+}
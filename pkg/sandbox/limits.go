@@ -0,0 +1,64 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits - resources available to the child process
+type Limits struct {
+	// CPU - the number of CPU cores the child process may use, unlimited if zero
+	CPU float64
+	// MemoryBytes - the memory the child process may use before it's terminated, unlimited if zero
+	MemoryBytes int64
+	// InvocationTimeout - how long a single trigger may be handled before the child process is terminated, unlimited if zero
+	InvocationTimeout time.Duration
+}
+
+// requiresCgroup - returns true if the limits must be enforced by a cgroup
+func (l *Limits) requiresCgroup() bool {
+	return l != nil && (l.CPU > 0 || l.MemoryBytes > 0)
+}
+
+var memoryUnits = map[string]int64{
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+}
+
+// ParseMemory - parses a memory size in bytes, optionally suffixed with a K, M or G unit e.g. 512M
+func ParseMemory(value string) (int64, error) {
+	size := strings.ToUpper(strings.TrimSpace(value))
+	size = strings.TrimSuffix(strings.TrimSuffix(size, "B"), "I")
+
+	multiplier := int64(1)
+	if len(size) > 0 {
+		if m, ok := memoryUnits[size[len(size)-1:]]; ok {
+			multiplier = m
+			size = size[:len(size)-1]
+		}
+	}
+
+	bytes, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || bytes < 0 {
+		return 0, fmt.Errorf("invalid memory size %s, expected bytes or a value with a K, M or G unit e.g. 512M", value)
+	}
+
+	return bytes * multiplier, nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// DefaultCgroupRoot - where the cgroup v2 hierarchy is mounted on most linux systems
+const DefaultCgroupRoot = "/sys/fs/cgroup"
+
+// Process - runs the child process within its resource limits.
+//
+// When the sandbox terminates the child process, either because a trigger exceeded the invocation timeout
+// or because the process exceeded its memory limit, the reason is reported and the process is restarted.
+// Processes that exit on their own are not restarted, unless the process belongs to a Group that restarts them.
+type Process struct {
+	command    []string
+	limits     *Limits
+	cgroupRoot string
+	cgroup     *Cgroup
+	// name of the process's cgroup
+	name string
+	// prefixes log messages, to tell processes in a group apart
+	logPrefix string
+	// additional environment variables for the process, read each time it's started so restarts pick up changes
+	env func() []string
+	// where the output of the process is written, os.Stdout and os.Stderr if nil
+	stdout io.Writer
+	stderr io.Writer
+
+	// delay before restarting a process that exited on its own, not restarted if zero
+	restartDelay    time.Duration
+	maxRestartDelay time.Duration
+
+	lock       sync.Mutex
+	cmd        *exec.Cmd
+	started    time.Time
+	backoff    time.Duration
+	restart    *time.Timer
+	killReason string
+	oomKills   int
+	stopped    bool
+}
+
+func (p *Process) log(msg string) {
+	log.Default().Println(p.logPrefix + msg)
+}
+
+// spawn - starts a new instance of the command, the lock must be held
+func (p *Process) spawn() error {
+	cmd := exec.Command(p.command[0], p.command[1:]...)
+	cmd.Stdout = os.Stdout
+	if p.stdout != nil {
+		cmd.Stdout = p.stdout
+	}
+	cmd.Stderr = os.Stderr
+	if p.stderr != nil {
+		cmd.Stderr = p.stderr
+	}
+	if p.env != nil {
+		if env := p.env(); len(env) > 0 {
+			cmd.Env = append(os.Environ(), env...)
+		}
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	if p.cgroup != nil {
+		if err := p.cgroup.AddProcess(cmd.Process.Pid); err != nil {
+			_ = cmd.Process.Kill()
+			return fmt.Errorf("unable to apply resource limits to the child process: %v", err)
+		}
+	}
+
+	p.cmd = cmd
+	p.started = time.Now()
+	go p.supervise(cmd)
+
+	return nil
+}
+
+// exitReason - returns why the sandbox terminated the process, or an empty string if it exited on its own
+func (p *Process) exitReason() string {
+	if p.killReason != "" {
+		return p.killReason
+	}
+
+	if p.cgroup != nil {
+		if kills, err := p.cgroup.OOMKills(); err == nil && kills > p.oomKills {
+			p.oomKills = kills
+			return fmt.Sprintf("exceeded the %d byte memory limit", p.limits.MemoryBytes)
+		}
+	}
+
+	return ""
+}
+
+func (p *Process) supervise(cmd *exec.Cmd) {
+	err := cmd.Wait()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopped || p.cmd != cmd {
+		return
+	}
+
+	reason := p.exitReason()
+	p.killReason = ""
+
+	if reason == "" {
+		p.log(fmt.Sprintf("Child process exited: %v", err))
+		if p.restartDelay > 0 {
+			p.scheduleRestart(cmd)
+		}
+		return
+	}
+
+	p.log(fmt.Sprintf("Child process terminated, %s. Restarting", reason))
+	if err := p.spawn(); err != nil {
+		p.log(fmt.Sprintf("Unable to restart child process: %v", err))
+	}
+}
+
+// scheduleRestart - restarts a process that exited on its own once its restart delay has passed, the lock must be held
+func (p *Process) scheduleRestart(cmd *exec.Cmd) {
+	delay := p.nextRestartDelay()
+	p.log(fmt.Sprintf("Restarting child process in %s", delay))
+
+	p.restart = time.AfterFunc(delay, func() {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		if p.stopped || p.cmd != cmd {
+			return
+		}
+
+		p.restart = nil
+		if err := p.spawn(); err != nil {
+			p.log(fmt.Sprintf("Unable to restart child process: %v", err))
+			p.scheduleRestart(cmd)
+		}
+	})
+}
+
+// nextRestartDelay - returns how long to wait before restarting a process that exited on its own.
+// The delay doubles with each consecutive crash, and is reset once the process has run for longer than the maximum delay.
+func (p *Process) nextRestartDelay() time.Duration {
+	if p.backoff == 0 || time.Since(p.started) > p.maxRestartDelay {
+		p.backoff = p.restartDelay
+	} else {
+		p.backoff *= 2
+	}
+
+	if p.backoff > p.maxRestartDelay {
+		p.backoff = p.maxRestartDelay
+	}
+
+	return p.backoff
+}
+
+// Start - starts the child process, applying its resource limits
+func (p *Process) Start() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.limits.requiresCgroup() {
+		cgroup, err := NewCgroup(p.cgroupRoot, p.name, p.limits)
+		if err != nil {
+			return fmt.Errorf("unable to apply child process resource limits: %w", err)
+		}
+		p.cgroup = cgroup
+	}
+
+	if err := p.spawn(); err != nil {
+		return fmt.Errorf("there was an error starting the child process: %w", err)
+	}
+
+	return nil
+}
+
+// Terminate - kills the running child process for the given reason, it will be restarted once it exits
+func (p *Process) Terminate(reason string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopped || p.cmd == nil || p.restart != nil || p.killReason != "" {
+		return
+	}
+
+	p.killReason = reason
+	if err := p.cmd.Process.Kill(); err != nil {
+		p.log(fmt.Sprintf("Unable to terminate child process: %v", err))
+	}
+}
+
+// Restart - kills the running child process for the given reason so it's restarted, or starts it immediately if it exited on its own
+// and is waiting to be restarted
+func (p *Process) Restart(reason string) {
+	p.lock.Lock()
+	if p.stopped || p.cmd == nil || p.restart == nil {
+		p.lock.Unlock()
+		p.Terminate(reason)
+		return
+	}
+	defer p.lock.Unlock()
+
+	p.restart.Stop()
+	p.restart = nil
+	// the restart was requested, so the process shouldn't be penalised for its earlier exits
+	p.backoff = 0
+
+	p.log(fmt.Sprintf("Restarting child process, %s", reason))
+	if err := p.spawn(); err != nil {
+		p.log(fmt.Sprintf("Unable to restart child process: %v", err))
+		p.scheduleRestart(p.cmd)
+	}
+}
+
+// Pid - returns the process ID of the running child process
+func (p *Process) Pid() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.cmd == nil {
+		return 0
+	}
+
+	return p.cmd.Process.Pid
+}
+
+// Stop - kills the child process without restarting it and removes its cgroup
+func (p *Process) Stop() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.stopped = true
+	if p.restart != nil {
+		p.restart.Stop()
+	}
+
+	if p.cmd != nil {
+		_ = p.cmd.Process.Kill()
+	}
+
+	if p.cgroup != nil {
+		_ = p.cgroup.Remove()
+	}
+}
+
+// NewProcess - creates a sandbox for the given command, using the cgroup v2 hierarchy mounted at cgroupRoot to enforce CPU and memory limits
+func NewProcess(command []string, limits *Limits, cgroupRoot string) (*Process, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("a command is required to start the child process")
+	}
+
+	if limits == nil {
+		limits = &Limits{}
+	}
+
+	return &Process{
+		command:    command,
+		limits:     limits,
+		cgroupRoot: cgroupRoot,
+		name:       fmt.Sprintf("nitric-%d", os.Getpid()),
+	}, nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/sandbox"
+)
+
+var _ = Describe("Sandbox", func() {
+	Context("ParseMemory", func() {
+		It("should parse sizes with units", func() {
+			for size, expected := range map[string]int64{
+				"1024":  1024,
+				"512M":  512 << 20,
+				"512Mi": 512 << 20,
+				"1g":    1 << 30,
+				"64KB":  64 << 10,
+			} {
+				bytes, err := sandbox.ParseMemory(size)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bytes).To(Equal(expected), size)
+			}
+		})
+
+		It("should reject invalid sizes", func() {
+			_, err := sandbox.ParseMemory("lots")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("NewCgroup", func() {
+		When("cgroup v2 is not available", func() {
+			It("should return an error", func() {
+				root, _ := os.MkdirTemp("", "cgroup")
+				defer os.RemoveAll(root)
+
+				_, err := sandbox.NewCgroup(root, "test", &sandbox.Limits{CPU: 1})
+				Expect(err).To(MatchError(ContainSubstring("cgroup v2 is not available")))
+			})
+		})
+
+		When("cgroup v2 is available", func() {
+			It("should create a cgroup with the given limits", func() {
+				root, _ := os.MkdirTemp("", "cgroup")
+				defer os.RemoveAll(root)
+
+				Expect(os.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu memory"), 0o644)).To(Succeed())
+
+				cgroup, err := sandbox.NewCgroup(root, "test", &sandbox.Limits{CPU: 0.5, MemoryBytes: 1 << 20})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				cpu, _ := os.ReadFile(filepath.Join(root, "test", "cpu.max"))
+				Expect(string(cpu)).To(Equal("50000 100000"))
+
+				memory, _ := os.ReadFile(filepath.Join(root, "test", "memory.max"))
+				Expect(string(memory)).To(Equal("1048576"))
+
+				Expect(os.WriteFile(filepath.Join(root, "test", "memory.events"), []byte("low 0\noom 1\noom_kill 1\n"), 0o644)).To(Succeed())
+				Expect(cgroup.OOMKills()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("Process", func() {
+		When("the process is terminated by the sandbox", func() {
+			It("should restart the process", func() {
+				process, err := sandbox.NewProcess([]string{"sleep", "10"}, nil, sandbox.DefaultCgroupRoot)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(process.Start()).To(Succeed())
+				defer process.Stop()
+
+				pid := process.Pid()
+				process.Terminate("test timeout")
+
+				Eventually(process.Pid, time.Second).ShouldNot(Equal(pid))
+			})
+		})
+
+		When("the process exits on its own", func() {
+			It("should not restart the process", func() {
+				process, err := sandbox.NewProcess([]string{"true"}, nil, sandbox.DefaultCgroupRoot)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(process.Start()).To(Succeed())
+				defer process.Stop()
+
+				pid := process.Pid()
+				Consistently(process.Pid, 200*time.Millisecond).Should(Equal(pid))
+			})
+		})
+
+		When("the command does not exist", func() {
+			It("should return an error", func() {
+				process, _ := sandbox.NewProcess([]string{"fakecommand"}, nil, sandbox.DefaultCgroupRoot)
+				Expect(process.Start()).ShouldNot(Succeed())
+			})
+		})
+	})
+
+	Context("Group", func() {
+		When("a process exits on its own", func() {
+			It("should restart the process after the restart delay", func() {
+				group, err := sandbox.NewGroup([]string{"true"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{
+					Size:            2,
+					RestartDelay:    10 * time.Millisecond,
+					MaxRestartDelay: 50 * time.Millisecond,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(group.Start()).To(Succeed())
+				defer group.Stop()
+
+				pids := group.Pids()
+				Expect(pids).To(HaveLen(2))
+				Eventually(func() int { return group.Pids()[0] }, time.Second).ShouldNot(Equal(pids[0]))
+				Eventually(func() int { return group.Pids()[1] }, time.Second).ShouldNot(Equal(pids[1]))
+			})
+		})
+
+		When("a rolling restart is requested", func() {
+			It("should restart each process in turn", func() {
+				group, err := sandbox.NewGroup([]string{"sleep", "10"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{
+					Size: 3,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(group.Start()).To(Succeed())
+				defer group.Stop()
+
+				pids := group.Pids()
+				ready := []int{}
+				Expect(group.Restart(time.Second, func(index int) error {
+					// later processes are still running their original instance
+					Expect(group.Pids()[index+1:]).To(Equal(pids[index+1:]))
+					ready = append(ready, index)
+					return nil
+				})).To(Succeed())
+
+				Expect(ready).To(Equal([]int{0, 1, 2}))
+				for i, pid := range group.Pids() {
+					Expect(pid).ToNot(Equal(pids[i]))
+				}
+			})
+		})
+
+		When("a restart is requested while a process waits to be restarted", func() {
+			It("should restart the process immediately", func() {
+				group, err := sandbox.NewGroup([]string{"true"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{
+					Size:            1,
+					RestartDelay:    time.Minute,
+					MaxRestartDelay: time.Minute,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(group.Start()).To(Succeed())
+				defer group.Stop()
+
+				pid := group.Pids()[0]
+				// give the process time to exit, so its restart is scheduled
+				time.Sleep(100 * time.Millisecond)
+
+				Expect(group.Restart(time.Second, nil)).To(Succeed())
+				Expect(group.Pids()[0]).ToNot(Equal(pid))
+			})
+		})
+
+		When("processes are given their own environment", func() {
+			It("should pass it to each process", func() {
+				dir, _ := os.MkdirTemp("", "group")
+				defer os.RemoveAll(dir)
+
+				group, err := sandbox.NewGroup([]string{"sh", "-c", "echo $NITRIC_WORKER_INDEX > " + dir + "/$NITRIC_WORKER_INDEX"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{
+					Size: 2,
+					Env: func(index int) []string {
+						return []string{fmt.Sprintf("NITRIC_WORKER_INDEX=%d", index)}
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(group.Start()).To(Succeed())
+				defer group.Stop()
+
+				for _, index := range []string{"0", "1"} {
+					Eventually(func() string {
+						contents, _ := os.ReadFile(filepath.Join(dir, index))
+						return string(contents)
+					}, time.Second).Should(Equal(index + "\n"))
+				}
+			})
+		})
+
+		When("a process's environment changes before it's restarted", func() {
+			It("should start the restarted process with the new environment", func() {
+				dir, _ := os.MkdirTemp("", "group")
+				defer os.RemoveAll(dir)
+
+				generation := 1
+				group, err := sandbox.NewGroup([]string{"sh", "-c", "echo $GENERATION > " + dir + "/out; exec sleep 10"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{
+					Size: 1,
+					Env: func(index int) []string {
+						return []string{fmt.Sprintf("GENERATION=%d", generation)}
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(group.Start()).To(Succeed())
+				defer group.Stop()
+
+				Eventually(func() string {
+					contents, _ := os.ReadFile(filepath.Join(dir, "out"))
+					return string(contents)
+				}, time.Second).Should(Equal("1\n"))
+
+				generation = 2
+				Expect(group.Restart(time.Second, func(index int) error { return nil })).To(Succeed())
+
+				Eventually(func() string {
+					contents, _ := os.ReadFile(filepath.Join(dir, "out"))
+					return string(contents)
+				}, time.Second).Should(Equal("2\n"))
+			})
+		})
+
+		When("no processes are requested", func() {
+			It("should return an error", func() {
+				_, err := sandbox.NewGroup([]string{"true"}, nil, sandbox.DefaultCgroupRoot, &sandbox.GroupOptions{})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,190 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule - when a recurring trigger fires
+type Schedule interface {
+	// Next - returns the first time the schedule fires after the given time, zero if it never does
+	Next(after time.Time) time.Time
+}
+
+type rate struct {
+	every time.Duration
+}
+
+func (r *rate) Next(after time.Time) time.Time {
+	return after.Add(r.every)
+}
+
+// ParseRate - parses a rate such as "5 minutes", "1 hour" or "7 days"
+func ParseRate(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid rate %q, expected a number and a unit e.g. 5 minutes", expr)
+	}
+
+	count, err := strconv.Atoi(parts[0])
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid rate %q, expected a positive number of units", expr)
+	}
+
+	var unit time.Duration
+	switch strings.TrimSuffix(strings.ToLower(parts[1]), "s") {
+	case "minute":
+		unit = time.Minute
+	case "hour":
+		unit = time.Hour
+	case "day":
+		unit = 24 * time.Hour
+	default:
+		return nil, fmt.Errorf("invalid rate %q, expected minutes, hours or days", expr)
+	}
+
+	return &rate{every: time.Duration(count) * unit}, nil
+}
+
+// field - the values a cron field matches, indexed by value
+type field []bool
+
+type cron struct {
+	minutes field
+	hours   field
+	days    field
+	months  field
+	weekday field
+	// when both the day of the month and day of the week are restricted, either matching is enough
+	anyDay bool
+}
+
+// the longest a cron expression is searched for its next time, e.g. 30 February never occurs
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+func (c *cron) dayMatches(t time.Time) bool {
+	if c.anyDay {
+		return c.days[t.Day()] || c.weekday[t.Weekday()]
+	}
+
+	return c.days[t.Day()] && c.weekday[t.Weekday()]
+}
+
+func (c *cron) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.Add(maxSearch)
+
+	for t.Before(limit) {
+		switch {
+		case !c.months[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// parseField - parses a comma separated list of values, ranges and steps, e.g. "*/15" or "1-5,10", between min and max
+func parseField(expr string, min int, max int) (field, error) {
+	f := make(field, max+1)
+
+	for _, part := range strings.Split(expr, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = s
+			part = part[:i]
+		}
+
+		start, end := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+
+			var err error
+			if start, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+			} else if step > 1 {
+				// e.g. 5/15 runs from 5 to the maximum
+				end = max
+			}
+		}
+
+		if start < min || end > max || start > end {
+			return nil, fmt.Errorf("%q is outside the range %d-%d", part, min, max)
+		}
+
+		for v := start; v <= end; v += step {
+			f[v] = true
+		}
+	}
+
+	return f, nil
+}
+
+// ParseCron - parses a standard 5 field cron expression, e.g. "*/15 9-17 * * 1-5".
+// Days of the week are numbered from 0, Sunday, and 7 is also Sunday. Times are in the location of the time given to Next
+func ParseCron(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q, expected 5 fields", expr)
+	}
+
+	names := []string{"minute", "hour", "day of month", "month", "day of week"}
+	bounds := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+	parsed := make([]field, len(fields))
+	for i, expr := range fields {
+		f, err := parseField(expr, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron %s field: %v", names[i], err)
+		}
+		parsed[i] = f
+	}
+
+	weekday := parsed[4]
+	if weekday[7] {
+		weekday[0] = true
+	}
+
+	return &cron{
+		minutes: parsed[0],
+		hours:   parsed[1],
+		days:    parsed[2],
+		months:  parsed[3],
+		weekday: weekday[:7],
+		anyDay:  fields[2] != "*" && fields[4] != "*",
+	}, nil
+}
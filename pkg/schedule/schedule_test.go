@@ -0,0 +1,91 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/schedule"
+)
+
+var _ = Describe("Schedule", func() {
+	// a Wednesday
+	now := time.Date(2022, time.March, 2, 10, 7, 30, 0, time.UTC)
+
+	Context("ParseRate", func() {
+		It("should fire after each interval", func() {
+			for expr, expected := range map[string]time.Duration{
+				"1 minute":  time.Minute,
+				"5 minutes": 5 * time.Minute,
+				"2 hours":   2 * time.Hour,
+				"7 days":    7 * 24 * time.Hour,
+			} {
+				s, err := schedule.ParseRate(expr)
+				Expect(err).ShouldNot(HaveOccurred(), expr)
+				Expect(s.Next(now)).To(Equal(now.Add(expected)), expr)
+			}
+		})
+
+		It("should reject invalid rates", func() {
+			for _, expr := range []string{"", "5", "0 minutes", "five minutes", "3 weeks"} {
+				_, err := schedule.ParseRate(expr)
+				Expect(err).Should(HaveOccurred(), expr)
+			}
+		})
+	})
+
+	Context("ParseCron", func() {
+		It("should return the next matching minute", func() {
+			for expr, expected := range map[string]time.Time{
+				"* * * * *":       time.Date(2022, time.March, 2, 10, 8, 0, 0, time.UTC),
+				"*/15 * * * *":    time.Date(2022, time.March, 2, 10, 15, 0, 0, time.UTC),
+				"0 9 * * *":       time.Date(2022, time.March, 3, 9, 0, 0, 0, time.UTC),
+				"30 9-17 * * 1-5": time.Date(2022, time.March, 2, 10, 30, 0, 0, time.UTC),
+				"0 0 * * 0":       time.Date(2022, time.March, 6, 0, 0, 0, 0, time.UTC),
+				"0 0 * * 7":       time.Date(2022, time.March, 6, 0, 0, 0, 0, time.UTC),
+				"0 0 1 1 *":       time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+				"5,10 12 * * *":   time.Date(2022, time.March, 2, 12, 5, 0, 0, time.UTC),
+			} {
+				s, err := schedule.ParseCron(expr)
+				Expect(err).ShouldNot(HaveOccurred(), expr)
+				Expect(s.Next(now)).To(Equal(expected), expr)
+			}
+		})
+
+		It("should match either day when both the day of the month and week are restricted", func() {
+			s, err := schedule.ParseCron("0 0 15 * 5")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the next Friday comes before the 15th
+			Expect(s.Next(now)).To(Equal(time.Date(2022, time.March, 4, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should never fire for dates that don't exist", func() {
+			s, err := schedule.ParseCron("0 0 30 2 *")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(s.Next(now)).To(BeZero())
+		})
+
+		It("should reject invalid expressions", func() {
+			for _, expr := range []string{"* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+				_, err := schedule.ParseCron(expr)
+				Expect(err).Should(HaveOccurred(), expr)
+			}
+		})
+	})
+})
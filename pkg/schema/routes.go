@@ -0,0 +1,545 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+const openApiUrl = "openapi.json"
+
+// Violation - a part of a request that doesn't conform to the schema of its route
+type Violation struct {
+	// Where the violation is, body, query or header
+	In string `json:"in"`
+	// The query parameter or header, empty for the body
+	Name string `json:"name,omitempty"`
+	// The JSON pointer to the violation in the value
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// RequestValidationError - returned when a request doesn't conform to the schema of its route
+type RequestValidationError struct {
+	Method     string      `json:"-"`
+	Path       string      `json:"-"`
+	Message    string      `json:"message"`
+	Violations []Violation `json:"violations"`
+}
+
+func (e *RequestValidationError) Error() string {
+	v := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		v = append(v, fmt.Sprintf("%s %s%s: %s", violation.In, violation.Name, violation.Location, violation.Message))
+	}
+
+	return fmt.Sprintf("%s %s does not conform to its schema: %s", e.Method, e.Path, strings.Join(v, "; "))
+}
+
+// parameter - a query parameter or header of a route
+type parameter struct {
+	name     string
+	required bool
+	// The JSON type of the parameter's value, used to convert it from a string
+	valueType string
+	// The JSON type of the items of array parameters
+	itemType string
+	schema   *jsonschema.Schema
+}
+
+// routeSchema - the schemas of the requests to an operation of a route
+type routeSchema struct {
+	method string
+	// The path segments of the route, with an empty segment for each path parameter
+	segments []string
+	params   int
+	query    []*parameter
+	headers  []*parameter
+	// The schema of JSON request bodies, nil if the body isn't validated
+	body         *jsonschema.Schema
+	bodyRequired bool
+}
+
+func (r *routeSchema) matches(method string, segments []string) bool {
+	if r.method != method || len(r.segments) != len(segments) {
+		return false
+	}
+
+	for i, s := range r.segments {
+		if s != "" && s != segments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RouteSchemas - JSON Schemas that the bodies, query parameters and headers of requests to routes must conform to,
+// loaded from the operations of an OpenAPI 3 document.
+//
+// Requests to routes without an operation in the document aren't validated.
+type RouteSchemas struct {
+	// Ordered by the number of path parameters, so static segments are matched first
+	routes []*routeSchema
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// route - returns the schemas for the most specific route matching the request, nil if there's none
+func (r *RouteSchemas) route(method string, path string) *routeSchema {
+	segments := splitPath(path)
+	for _, route := range r.routes {
+		if route.matches(method, segments) {
+			return route
+		}
+	}
+
+	return nil
+}
+
+// convert - converts the string value of a parameter to its JSON type, returning the string if it isn't one
+func convert(value string, valueType string) interface{} {
+	switch valueType {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+
+	return value
+}
+
+// validateParameters - validates the query parameters or headers of a request against their schemas
+func validateParameters(in string, params []*parameter, values map[string][]string, caseInsensitive bool) []Violation {
+	violations := make([]Violation, 0)
+
+	for _, p := range params {
+		var vals []string
+		for k, v := range values {
+			if k == p.name || (caseInsensitive && strings.EqualFold(k, p.name)) {
+				vals = append(vals, v...)
+			}
+		}
+
+		if len(vals) == 0 {
+			if p.required {
+				violations = append(violations, Violation{In: in, Name: p.name, Location: "/", Message: "missing required value"})
+			}
+			continue
+		}
+
+		if p.schema == nil {
+			continue
+		}
+
+		var value interface{}
+		if p.valueType == "array" {
+			items := make([]interface{}, 0, len(vals))
+			for _, v := range vals {
+				for _, item := range strings.Split(v, ",") {
+					items = append(items, convert(item, p.itemType))
+				}
+			}
+			value = items
+		} else {
+			value = convert(vals[0], p.valueType)
+		}
+
+		if err := p.schema.Validate(value); err != nil {
+			violations = append(violations, validationViolations(in, p.name, err)...)
+		}
+	}
+
+	return violations
+}
+
+// validationViolations - returns the violations of a failed validation
+func validationViolations(in string, name string, err error) []Violation {
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []Violation{{In: in, Name: name, Location: "/", Message: err.Error()}}
+	}
+
+	violations := make([]Violation, 0)
+	for _, v := range leafErrors(ve) {
+		location := v.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+		violations = append(violations, Violation{In: in, Name: name, Location: location, Message: v.Message})
+	}
+
+	return violations
+}
+
+func leafErrors(ve *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(ve.Causes) == 0 {
+		return []*jsonschema.ValidationError{ve}
+	}
+
+	leaves := make([]*jsonschema.ValidationError, 0, len(ve.Causes))
+	for _, c := range ve.Causes {
+		leaves = append(leaves, leafErrors(c)...)
+	}
+
+	return leaves
+}
+
+func isJson(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	return mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// Validate - validates the body, query parameters and headers of a request against the schemas of its route.
+//
+// A nil RouteSchemas, or one without an operation for the request's route, accepts any request.
+// Only JSON bodies are validated.
+func (r *RouteSchemas) Validate(req *triggers.HttpRequest) error {
+	if r == nil {
+		return nil
+	}
+
+	route := r.route(req.Method, req.Path)
+	if route == nil {
+		return nil
+	}
+
+	violations := validateParameters("query", route.query, req.Query, false)
+	violations = append(violations, validateParameters("header", route.headers, req.Header, true)...)
+
+	contentType := ""
+	for k, v := range req.Header {
+		if strings.EqualFold(k, "Content-Type") && len(v) > 0 {
+			contentType = v[0]
+		}
+	}
+
+	if len(bytes.TrimSpace(req.Body)) == 0 {
+		if route.bodyRequired {
+			violations = append(violations, Violation{In: "body", Location: "/", Message: "missing required body"})
+		}
+	} else if route.body != nil && isJson(contentType) {
+		dec := json.NewDecoder(bytes.NewReader(req.Body))
+		dec.UseNumber()
+
+		var body interface{}
+		if err := dec.Decode(&body); err != nil {
+			violations = append(violations, Violation{In: "body", Location: "/", Message: fmt.Sprintf("invalid JSON: %v", err)})
+		} else if err := route.body.Validate(body); err != nil {
+			violations = append(violations, validationViolations("body", "", err)...)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &RequestValidationError{
+		Method:     req.Method,
+		Path:       req.Path,
+		Message:    "request does not conform to the schema of its route",
+		Violations: violations,
+	}
+}
+
+// openApiParameter - the parts of an OpenAPI parameter object used for validation
+type openApiParameter struct {
+	Ref      string                 `json:"$ref"`
+	Name     string                 `json:"name"`
+	In       string                 `json:"in"`
+	Required bool                   `json:"required"`
+	Schema   map[string]interface{} `json:"schema"`
+}
+
+// openApiRequestBody - the parts of an OpenAPI request body object used for validation
+type openApiRequestBody struct {
+	Ref      string `json:"$ref"`
+	Required bool   `json:"required"`
+	Content  map[string]struct {
+		Schema map[string]interface{} `json:"schema"`
+	} `json:"content"`
+}
+
+type openApiOperation struct {
+	Parameters  []*openApiParameter `json:"parameters"`
+	RequestBody *openApiRequestBody `json:"requestBody"`
+}
+
+// escapePointer - escapes a JSON pointer token
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// openApiLoader - compiles the schemas of an OpenAPI document, resolving references to its components
+type openApiLoader struct {
+	doc      map[string]interface{}
+	compiler *jsonschema.Compiler
+}
+
+// resolve - unmarshals the object at a local reference in the document into v
+func (l *openApiLoader) resolve(ref string, v interface{}) error {
+	if !strings.HasPrefix(ref, "#/") {
+		return fmt.Errorf("unsupported reference %s, only references within the document are supported", ref)
+	}
+
+	var node interface{} = l.doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unable to resolve reference %s", ref)
+		}
+		if node, ok = obj[token]; !ok {
+			return fmt.Errorf("unable to resolve reference %s", ref)
+		}
+	}
+
+	b, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// compile - compiles the schema at the JSON pointer in the document
+func (l *openApiLoader) compile(pointer string) (*jsonschema.Schema, error) {
+	return l.compiler.Compile(openApiUrl + "#" + pointer)
+}
+
+// schemaType - returns the type of a schema, following references
+func (l *openApiLoader) schemaType(schema map[string]interface{}) string {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved := map[string]interface{}{}
+		if err := l.resolve(ref, &resolved); err != nil {
+			return ""
+		}
+		return l.schemaType(resolved)
+	}
+
+	t, _ := schema["type"].(string)
+	return t
+}
+
+// parameters - compiles the query and header parameters of an operation, which override those of its path
+func (l *openApiLoader) parameters(pathPointer string, pathParams []*openApiParameter, opPointer string, opParams []*openApiParameter) ([]*parameter, []*parameter, error) {
+	byKey := map[string]*parameter{}
+	keys := []string{}
+
+	add := func(pointer string, params []*openApiParameter) error {
+		for i, p := range params {
+			paramPointer := fmt.Sprintf("%s/parameters/%d", pointer, i)
+			if p.Ref != "" {
+				paramPointer = strings.TrimPrefix(p.Ref, "#")
+				p = &openApiParameter{}
+				if err := l.resolve("#"+paramPointer, p); err != nil {
+					return err
+				}
+			}
+
+			if p.In != "query" && p.In != "header" {
+				continue
+			}
+
+			param := &parameter{
+				name:     p.Name,
+				required: p.Required,
+			}
+
+			if p.Schema != nil {
+				schema, err := l.compile(paramPointer + "/schema")
+				if err != nil {
+					return fmt.Errorf("invalid schema for %s parameter %s: %v", p.In, p.Name, err)
+				}
+				param.schema = schema
+				param.valueType = l.schemaType(p.Schema)
+				if items, ok := p.Schema["items"].(map[string]interface{}); ok {
+					param.itemType = l.schemaType(items)
+				}
+			}
+
+			key := p.In + ":" + strings.ToLower(p.Name)
+			if _, ok := byKey[key]; !ok {
+				keys = append(keys, key)
+			}
+			byKey[key] = param
+		}
+		return nil
+	}
+
+	if err := add(pathPointer, pathParams); err != nil {
+		return nil, nil, err
+	}
+	if err := add(opPointer, opParams); err != nil {
+		return nil, nil, err
+	}
+
+	query := make([]*parameter, 0)
+	headers := make([]*parameter, 0)
+	for _, key := range keys {
+		if strings.HasPrefix(key, "query:") {
+			query = append(query, byKey[key])
+		} else {
+			headers = append(headers, byKey[key])
+		}
+	}
+
+	return query, headers, nil
+}
+
+// body - compiles the JSON schema of an operation's request body, nil if it doesn't have one
+func (l *openApiLoader) body(opPointer string, body *openApiRequestBody) (*jsonschema.Schema, bool, error) {
+	if body == nil {
+		return nil, false, nil
+	}
+
+	bodyPointer := opPointer + "/requestBody"
+	if body.Ref != "" {
+		bodyPointer = strings.TrimPrefix(body.Ref, "#")
+		body = &openApiRequestBody{}
+		if err := l.resolve("#"+bodyPointer, body); err != nil {
+			return nil, false, err
+		}
+	}
+
+	mediaTypes := make([]string, 0, len(body.Content))
+	for mediaType := range body.Content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+
+	for _, mediaType := range mediaTypes {
+		if !isJson(mediaType) || body.Content[mediaType].Schema == nil {
+			continue
+		}
+
+		schema, err := l.compile(bodyPointer + "/content/" + escapePointer(mediaType) + "/schema")
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid request body schema: %v", err)
+		}
+
+		return schema, body.Required, nil
+	}
+
+	return nil, body.Required, nil
+}
+
+var openApiMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// LoadOpenApi - loads the schemas of the operations in an OpenAPI 3 JSON document.
+//
+// Paths are matched with their parameters, e.g. /orders/{id}, against the paths of requests.
+func LoadOpenApi(spec []byte) (*RouteSchemas, error) {
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %v", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(openApiUrl, bytes.NewReader(spec)); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %v", err)
+	}
+
+	l := &openApiLoader{doc: doc, compiler: compiler}
+
+	paths := map[string]map[string]json.RawMessage{}
+	if err := l.resolve("#/paths", &paths); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %v", err)
+	}
+
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	routes := make([]*routeSchema, 0)
+	for _, path := range pathNames {
+		item := paths[path]
+		pathPointer := "/paths/" + escapePointer(path)
+
+		pathParams := make([]*openApiParameter, 0)
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &pathParams); err != nil {
+				return nil, fmt.Errorf("invalid parameters for %s: %v", path, err)
+			}
+		}
+
+		segments := splitPath(path)
+		params := 0
+		for i, s := range segments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				segments[i] = ""
+				params++
+			}
+		}
+
+		for _, method := range openApiMethods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+
+			op := &openApiOperation{}
+			if err := json.Unmarshal(raw, op); err != nil {
+				return nil, fmt.Errorf("invalid operation %s %s: %v", method, path, err)
+			}
+
+			opPointer := pathPointer + "/" + method
+
+			query, headers, err := l.parameters(pathPointer, pathParams, opPointer, op.Parameters)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %v", method, path, err)
+			}
+
+			body, bodyRequired, err := l.body(opPointer, op.RequestBody)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %v", method, path, err)
+			}
+
+			routes = append(routes, &routeSchema{
+				method:       strings.ToUpper(method),
+				segments:     segments,
+				params:       params,
+				query:        query,
+				headers:      headers,
+				body:         body,
+				bodyRequired: bodyRequired,
+			})
+		}
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].params < routes[j].params
+	})
+
+	return &RouteSchemas{routes: routes}, nil
+}
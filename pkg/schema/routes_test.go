@@ -0,0 +1,168 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+const ordersApi = `{
+	"openapi": "3.0.0",
+	"paths": {
+		"/orders": {
+			"get": {
+				"parameters": [
+					{ "name": "limit", "in": "query", "schema": { "type": "integer", "maximum": 100 } },
+					{ "name": "status", "in": "query", "schema": { "type": "array", "items": { "type": "string", "enum": ["open", "closed"] } } }
+				]
+			},
+			"post": {
+				"parameters": [{ "$ref": "#/components/parameters/Tenant" }],
+				"requestBody": {
+					"required": true,
+					"content": { "application/json": { "schema": { "$ref": "#/components/schemas/Order" } } }
+				}
+			}
+		},
+		"/orders/{id}": {
+			"put": {
+				"requestBody": {
+					"content": { "application/json": { "schema": { "$ref": "#/components/schemas/Order" } } }
+				}
+			}
+		},
+		"/orders/latest": {
+			"put": {}
+		}
+	},
+	"components": {
+		"parameters": {
+			"Tenant": { "name": "X-Tenant", "in": "header", "required": true, "schema": { "type": "string" } }
+		},
+		"schemas": {
+			"Order": {
+				"type": "object",
+				"properties": {
+					"id": { "type": "string" },
+					"quantity": { "type": "integer", "minimum": 1 }
+				},
+				"required": ["id", "quantity"]
+			}
+		}
+	}
+}`
+
+var _ = Describe("RouteSchemas", func() {
+	routes, err := schema.LoadOpenApi([]byte(ordersApi))
+
+	It("should load the OpenAPI document", func() {
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	When("the request conforms to the schemas of its route", func() {
+		It("should accept it", func() {
+			Expect(routes.Validate(&triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/orders",
+				Header: map[string][]string{"x-tenant": {"acme"}, "Content-Type": {"application/json"}},
+				Body:   []byte(`{"id": "order-1", "quantity": 2}`),
+			})).To(Succeed())
+
+			Expect(routes.Validate(&triggers.HttpRequest{
+				Method: "GET",
+				Path:   "/orders",
+				Query:  map[string][]string{"limit": {"10"}, "status": {"open,closed"}},
+			})).To(Succeed())
+		})
+	})
+
+	When("the body doesn't conform", func() {
+		It("should return the violations", func() {
+			err := routes.Validate(&triggers.HttpRequest{
+				Method: "PUT",
+				Path:   "/orders/order-1",
+				Body:   []byte(`{"id": "order-1", "quantity": 0}`),
+			})
+
+			Expect(err).To(BeAssignableToTypeOf(&schema.RequestValidationError{}))
+			violations := err.(*schema.RequestValidationError).Violations
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].In).To(Equal("body"))
+			Expect(violations[0].Location).To(Equal("/quantity"))
+		})
+	})
+
+	When("a required body or header is missing", func() {
+		It("should return the violations", func() {
+			err := routes.Validate(&triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/orders",
+			})
+
+			Expect(err).To(BeAssignableToTypeOf(&schema.RequestValidationError{}))
+			Expect(err.(*schema.RequestValidationError).Violations).To(ConsistOf(
+				schema.Violation{In: "header", Name: "X-Tenant", Location: "/", Message: "missing required value"},
+				schema.Violation{In: "body", Location: "/", Message: "missing required body"},
+			))
+		})
+	})
+
+	When("query parameters don't conform", func() {
+		It("should return the violations", func() {
+			err := routes.Validate(&triggers.HttpRequest{
+				Method: "GET",
+				Path:   "/orders",
+				Query:  map[string][]string{"limit": {"500"}, "status": {"lost"}},
+			})
+
+			Expect(err).To(BeAssignableToTypeOf(&schema.RequestValidationError{}))
+			violations := err.(*schema.RequestValidationError).Violations
+			Expect(violations).To(HaveLen(2))
+			Expect(violations[0].Name).To(Equal("limit"))
+			Expect(violations[1].Name).To(Equal("status"))
+		})
+	})
+
+	When("a static path matches the request", func() {
+		It("should be preferred over paths with parameters", func() {
+			Expect(routes.Validate(&triggers.HttpRequest{
+				Method: "PUT",
+				Path:   "/orders/latest",
+				Body:   []byte(`{}`),
+			})).To(Succeed())
+		})
+	})
+
+	When("the route has no operation in the document", func() {
+		It("should accept any request", func() {
+			Expect(routes.Validate(&triggers.HttpRequest{
+				Method: "POST",
+				Path:   "/customers",
+				Body:   []byte(`not json`),
+			})).To(Succeed())
+		})
+	})
+
+	When("the schemas are nil", func() {
+		It("should accept any request", func() {
+			var nilRoutes *schema.RouteSchemas
+			Expect(nilRoutes.Validate(&triggers.HttpRequest{Method: "GET", Path: "/orders"})).To(Succeed())
+		})
+	})
+})
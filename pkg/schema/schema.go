@@ -0,0 +1,180 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Kind - the type of resource a schema is registered for
+type Kind string
+
+const (
+	Topic Kind = "topic"
+	Queue Kind = "queue"
+)
+
+// Registry - JSON Schemas that the payloads of messages published to topics and queues must conform to.
+//
+// Topics and queues without a registered schema accept any payload.
+type Registry struct {
+	lock    sync.RWMutex
+	schemas map[Kind]map[string]*jsonschema.Schema
+}
+
+// ValidationError - returned when a payload doesn't conform to the schema registered for its topic or queue
+type ValidationError struct {
+	Kind Kind
+	Name string
+	// Violations - descriptions of each part of the payload that failed validation
+	Violations []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("payload does not conform to the schema for %s %s: %s", e.Kind, e.Name, strings.Join(e.Violations, "; "))
+}
+
+// Register - compiles and registers the JSON Schema for the named topic or queue, replacing any existing schema
+func (r *Registry) Register(kind Kind, name string, schema []byte) error {
+	url := fmt.Sprintf("%s/%s.json", kind, name)
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, bytes.NewReader(schema)); err != nil {
+		return fmt.Errorf("invalid schema for %s %s: %v", kind, name, err)
+	}
+
+	compiled, err := compiler.Compile(url)
+	if err != nil {
+		return fmt.Errorf("invalid schema for %s %s: %v", kind, name, err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.schemas[kind] == nil {
+		r.schemas[kind] = make(map[string]*jsonschema.Schema)
+	}
+	r.schemas[kind][name] = compiled
+
+	return nil
+}
+
+// Validate - validates the payload against the schema registered for the named topic or queue.
+//
+// A nil registry, or one without a schema for the topic or queue, accepts any payload.
+func (r *Registry) Validate(kind Kind, name string, payload map[string]interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.RLock()
+	s, ok := r.schemas[kind][name]
+	r.lock.RUnlock()
+
+	if !ok {
+		return nil
+	}
+
+	// AsMap returns a nil map for empty payloads, validate these as an empty object
+	var value interface{} = payload
+	if payload == nil {
+		value = map[string]interface{}{}
+	}
+
+	err := s.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	if ve, ok := err.(*jsonschema.ValidationError); ok {
+		return &ValidationError{
+			Kind:       kind,
+			Name:       name,
+			Violations: violations(ve),
+		}
+	}
+
+	return err
+}
+
+// violations - flattens nested validation errors into a description of each failing keyword
+func violations(ve *jsonschema.ValidationError) []string {
+	if len(ve.Causes) == 0 {
+		location := ve.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+
+		return []string{fmt.Sprintf("%s: %s", location, ve.Message)}
+	}
+
+	v := make([]string, 0, len(ve.Causes))
+	for _, c := range ve.Causes {
+		v = append(v, violations(c)...)
+	}
+
+	return v
+}
+
+// LoadDir - registers the schemas found in the given directory.
+//
+// Schemas are read from the topics and queues subdirectories, named after the topic or queue they apply to,
+// e.g. <dir>/topics/orders.json applies to the orders topic.
+func (r *Registry) LoadDir(dir string) error {
+	for _, kind := range []Kind{Topic, Queue} {
+		kindDir := filepath.Join(dir, string(kind)+"s")
+
+		files, err := ioutil.ReadDir(kindDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return err
+		}
+
+		for _, f := range files {
+			if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+				continue
+			}
+
+			schema, err := ioutil.ReadFile(filepath.Join(kindDir, f.Name()))
+			if err != nil {
+				return err
+			}
+
+			if err := r.Register(kind, strings.TrimSuffix(f.Name(), ".json"), schema); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewRegistry - creates an empty schema registry
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: make(map[Kind]map[string]*jsonschema.Schema),
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/schema"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"properties": {
+		"id": { "type": "string" },
+		"quantity": { "type": "integer", "minimum": 1 }
+	},
+	"required": ["id", "quantity"]
+}`
+
+var _ = Describe("Registry", func() {
+	Context("Register", func() {
+		When("the schema is invalid", func() {
+			It("should return an error", func() {
+				err := schema.NewRegistry().Register(schema.Topic, "orders", []byte(`{"type": 1}`))
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid schema for topic orders"))
+			})
+		})
+
+		When("the schema isn't JSON", func() {
+			It("should return an error", func() {
+				Expect(schema.NewRegistry().Register(schema.Topic, "orders", []byte(`type: object`))).ShouldNot(Succeed())
+			})
+		})
+	})
+
+	Context("Validate", func() {
+		registry := schema.NewRegistry()
+		Expect(registry.Register(schema.Topic, "orders", []byte(orderSchema))).To(Succeed())
+
+		When("the payload conforms to the schema", func() {
+			It("should succeed", func() {
+				Expect(registry.Validate(schema.Topic, "orders", map[string]interface{}{
+					"id":       "abc",
+					"quantity": float64(2),
+				})).To(Succeed())
+			})
+		})
+
+		When("the payload doesn't conform to the schema", func() {
+			err := registry.Validate(schema.Topic, "orders", map[string]interface{}{
+				"quantity": float64(0),
+			})
+
+			It("should return a validation error", func() {
+				Expect(err).To(BeAssignableToTypeOf(&schema.ValidationError{}))
+			})
+
+			It("should describe each violation", func() {
+				ve := err.(*schema.ValidationError)
+				Expect(ve.Kind).To(Equal(schema.Topic))
+				Expect(ve.Name).To(Equal("orders"))
+				Expect(ve.Violations).To(HaveLen(2))
+				Expect(err.Error()).To(ContainSubstring("missing properties: 'id'"))
+				Expect(err.Error()).To(ContainSubstring("/quantity"))
+			})
+		})
+
+		When("the payload is empty", func() {
+			It("should be validated as an empty object", func() {
+				Expect(registry.Validate(schema.Topic, "orders", nil)).ShouldNot(Succeed())
+			})
+		})
+
+		When("no schema is registered", func() {
+			It("should accept any payload", func() {
+				Expect(registry.Validate(schema.Topic, "other", nil)).To(Succeed())
+				Expect(registry.Validate(schema.Queue, "orders", nil)).To(Succeed())
+			})
+		})
+
+		When("the registry is nil", func() {
+			It("should accept any payload", func() {
+				var r *schema.Registry
+				Expect(r.Validate(schema.Topic, "orders", nil)).To(Succeed())
+			})
+		})
+	})
+
+	Context("LoadDir", func() {
+		When("the directory contains topic and queue schemas", func() {
+			It("should register them by name", func() {
+				dir, err := ioutil.TempDir("", "schemas")
+				Expect(err).ShouldNot(HaveOccurred())
+				defer os.RemoveAll(dir)
+
+				Expect(os.Mkdir(filepath.Join(dir, "topics"), 0o755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "topics", "orders.json"), []byte(orderSchema), 0o644)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "topics", "README.md"), []byte("ignored"), 0o644)).To(Succeed())
+
+				registry := schema.NewRegistry()
+				Expect(registry.LoadDir(dir)).To(Succeed())
+
+				Expect(registry.Validate(schema.Topic, "orders", nil)).ShouldNot(Succeed())
+				Expect(registry.Validate(schema.Queue, "orders", nil)).To(Succeed())
+			})
+		})
+
+		When("a schema is invalid", func() {
+			It("should return an error", func() {
+				dir, err := ioutil.TempDir("", "schemas")
+				Expect(err).ShouldNot(HaveOccurred())
+				defer os.RemoveAll(dir)
+
+				Expect(os.Mkdir(filepath.Join(dir, "queues"), 0o755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "queues", "jobs.json"), []byte(`{`), 0o644)).To(Succeed())
+
+				Expect(schema.NewRegistry().LoadDir(dir)).ShouldNot(Succeed())
+			})
+		})
+	})
+})
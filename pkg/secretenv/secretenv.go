@@ -0,0 +1,199 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretenv
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+)
+
+// DirEnv - the environment variable telling the child process where its secrets are written, when they're injected as files
+const DirEnv = "NITRIC_SECRETS_DIR"
+
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Binding - a secret exposed to the child process as the environment variable, or file, Name
+type Binding struct {
+	Name   string
+	Secret string
+	// The version of the secret, "latest" if empty
+	Version string
+}
+
+// ParseBindings - parses comma separated <NAME>=<secret>[@<version>] bindings, e.g. DB_PASSWORD=db-password,API_KEY=api-key@3
+func ParseBindings(s string) ([]Binding, error) {
+	bindings := make([]Binding, 0)
+	names := map[string]bool{}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid secret binding %s, expected <NAME>=<secret>[@<version>]", entry)
+		}
+
+		name := strings.TrimSpace(parts[0])
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid secret binding %s, %q isn't a valid environment variable name", entry, name)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("invalid secret binding %s, %s is bound more than once", entry, name)
+		}
+		names[name] = true
+
+		b := Binding{Name: name, Secret: strings.TrimSpace(parts[1])}
+		if at := strings.LastIndex(b.Secret, "@"); at >= 0 {
+			b.Secret, b.Version = b.Secret[:at], b.Secret[at+1:]
+			if b.Secret == "" || b.Version == "" {
+				return nil, fmt.Errorf("invalid secret binding %s, expected <NAME>=<secret>[@<version>]", entry)
+			}
+		}
+
+		bindings = append(bindings, b)
+	}
+
+	return bindings, nil
+}
+
+// Injector - resolves the values of bound secrets and injects them into the child process's environment.
+//
+// When a directory is given, each secret is written to a file in it named after its binding instead,
+// and the child process is told where they are with NITRIC_SECRETS_DIR
+type Injector struct {
+	secretPlugin secret.SecretService
+	bindings     []Binding
+	dir          string
+
+	lock   sync.RWMutex
+	values map[string][]byte
+}
+
+// Resolve - accesses the bound secrets, returning true if any of their values changed since they were last resolved.
+// If any secret can't be accessed the previously resolved values are kept
+func (i *Injector) Resolve() (bool, error) {
+	values := make(map[string][]byte, len(i.bindings))
+
+	for _, b := range i.bindings {
+		version := b.Version
+		if version == "" {
+			version = "latest"
+		}
+
+		resp, err := i.secretPlugin.Access(&secret.SecretVersion{
+			Secret:  &secret.Secret{Name: b.Secret},
+			Version: version,
+		})
+		if err != nil {
+			return false, fmt.Errorf("unable to access secret %s for %s: %v", b.Secret, b.Name, err)
+		}
+
+		values[b.Name] = resp.Value
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	changed := i.values == nil
+	for name, value := range values {
+		if !bytes.Equal(i.values[name], value) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	if i.dir != "" {
+		if err := i.write(values); err != nil {
+			return false, err
+		}
+	}
+
+	i.values = values
+
+	return true, nil
+}
+
+// write - writes each secret to its own file, replacing them atomically so the child process never reads a partial value
+func (i *Injector) write(values map[string][]byte) error {
+	if err := os.MkdirAll(i.dir, 0o700); err != nil {
+		return fmt.Errorf("unable to create secrets directory %s: %v", i.dir, err)
+	}
+
+	for name, value := range values {
+		tmp, err := os.CreateTemp(i.dir, "."+name+"-*")
+		if err != nil {
+			return fmt.Errorf("unable to write secret %s: %v", name, err)
+		}
+
+		_, err = tmp.Write(value)
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), filepath.Join(i.dir, name))
+		}
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+			return fmt.Errorf("unable to write secret %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Env - returns the environment variables injecting the most recently resolved secrets into the child process
+func (i *Injector) Env() []string {
+	if i.dir != "" {
+		return []string{fmt.Sprintf("%s=%s", DirEnv, i.dir)}
+	}
+
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	env := make([]string, 0, len(i.values))
+	for name, value := range i.values {
+		env = append(env, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(env)
+
+	return env
+}
+
+// New - creates an injector for the bound secrets, injected as files in dir if it's not empty
+func New(secretPlugin secret.SecretService, bindings []Binding, dir string) (*Injector, error) {
+	if secretPlugin == nil {
+		return nil, fmt.Errorf("a secret plugin is required to inject secrets into the child process")
+	}
+
+	return &Injector{
+		secretPlugin: secretPlugin,
+		bindings:     bindings,
+		dir:          dir,
+	}, nil
+}
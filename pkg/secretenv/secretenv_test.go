@@ -0,0 +1,160 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretenv_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/secretenv"
+)
+
+func accessResponse(value string) *secret.SecretAccessResponse {
+	return &secret.SecretAccessResponse{Value: []byte(value)}
+}
+
+var _ = Describe("Secret Env", func() {
+	Context("ParseBindings", func() {
+		It("should parse bindings with and without versions", func() {
+			bindings, err := secretenv.ParseBindings("DB_PASSWORD=db-password, API_KEY=api-key@3")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bindings).To(Equal([]secretenv.Binding{
+				{Name: "DB_PASSWORD", Secret: "db-password"},
+				{Name: "API_KEY", Secret: "api-key", Version: "3"},
+			}))
+		})
+
+		It("should return no bindings for an empty string", func() {
+			bindings, err := secretenv.ParseBindings("")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("should reject invalid bindings", func() {
+			for _, s := range []string{"DB_PASSWORD", "DB_PASSWORD=", "1DB=db", "DB-PASSWORD=db", "A=a,A=b", "A=a@", "A=@3"} {
+				_, err := secretenv.ParseBindings(s)
+				Expect(err).Should(HaveOccurred(), s)
+			}
+		})
+	})
+
+	Context("New", func() {
+		It("should require a secret plugin", func() {
+			_, err := secretenv.New(nil, nil, "")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Injecting environment variables", func() {
+		var (
+			ctrl     *gomock.Controller
+			mockSS   *mock_secret.MockSecretService
+			injector *secretenv.Injector
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockSS = mock_secret.NewMockSecretService(ctrl)
+			injector, _ = secretenv.New(mockSS, []secretenv.Binding{
+				{Name: "DB_PASSWORD", Secret: "db-password"},
+				{Name: "API_KEY", Secret: "api-key", Version: "3"},
+			}, "")
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should inject the resolved values", func() {
+			mockSS.EXPECT().Access(&secret.SecretVersion{Secret: &secret.Secret{Name: "db-password"}, Version: "latest"}).Return(accessResponse("hunter2"), nil)
+			mockSS.EXPECT().Access(&secret.SecretVersion{Secret: &secret.Secret{Name: "api-key"}, Version: "3"}).Return(accessResponse("abc"), nil)
+
+			changed, err := injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(injector.Env()).To(Equal([]string{"API_KEY=abc", "DB_PASSWORD=hunter2"}))
+		})
+
+		It("should report whether the values changed", func() {
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("same"), nil).Times(4)
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("rotated"), nil)
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("same"), nil)
+
+			_, err := injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changed, err := injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			changed, err = injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(injector.Env()).To(ContainElement("DB_PASSWORD=rotated"))
+		})
+
+		It("should keep the previous values when a secret can't be accessed", func() {
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("hunter2"), nil).Times(2)
+			mockSS.EXPECT().Access(gomock.Any()).Return(nil, fmt.Errorf("mock-error"))
+
+			_, err := injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changed, err := injector.Resolve()
+			Expect(err).Should(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(injector.Env()).To(Equal([]string{"API_KEY=hunter2", "DB_PASSWORD=hunter2"}))
+		})
+	})
+
+	Context("Injecting files", func() {
+		It("should write each secret to a file and point the child process at them", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockSS := mock_secret.NewMockSecretService(ctrl)
+
+			dir, _ := os.MkdirTemp("", "secrets")
+			defer os.RemoveAll(dir)
+			secretsDir := filepath.Join(dir, "secrets")
+
+			injector, _ := secretenv.New(mockSS, []secretenv.Binding{{Name: "DB_PASSWORD", Secret: "db-password"}}, secretsDir)
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("hunter2"), nil)
+			mockSS.EXPECT().Access(gomock.Any()).Return(accessResponse("rotated"), nil)
+
+			_, err := injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(injector.Env()).To(Equal([]string{"NITRIC_SECRETS_DIR=" + secretsDir}))
+
+			contents, err := os.ReadFile(filepath.Join(secretsDir, "DB_PASSWORD"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("hunter2"))
+
+			_, err = injector.Resolve()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			contents, _ = os.ReadFile(filepath.Join(secretsDir, "DB_PASSWORD"))
+			Expect(string(contents)).To(Equal("rotated"))
+
+			entries, _ := os.ReadDir(secretsDir)
+			Expect(entries).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,169 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shedding
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Thresholds - the usage of the membrane process above which it sheds load, unlimited if zero
+type Thresholds struct {
+	// The resident memory of the membrane process, in bytes
+	MemoryBytes int64
+	// The number of goroutines running in the membrane process
+	Goroutines int
+}
+
+// Usage - a sample of the membrane process's resource usage
+type Usage struct {
+	MemoryBytes int64
+	Goroutines  int
+}
+
+// ReadUsage - samples the membrane process's resource usage.
+// Resident memory is read from /proc where it's available, otherwise the memory obtained by the Go runtime is used
+func ReadUsage() Usage {
+	return Usage{
+		MemoryBytes: residentMemory(),
+		Goroutines:  runtime.NumGoroutine(),
+	}
+}
+
+func residentMemory() int64 {
+	if statm, err := os.ReadFile("/proc/self/statm"); err == nil {
+		if fields := strings.Fields(string(statm)); len(fields) > 1 {
+			if pages, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				return pages * int64(os.Getpagesize())
+			}
+		}
+	}
+
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	return int64(stats.Sys)
+}
+
+// Monitor - Periodically samples the membrane process's resource usage, shedding load while it's over its thresholds
+type Monitor struct {
+	thresholds Thresholds
+	interval   time.Duration
+	sample     func() Usage
+	stop       chan bool
+
+	lock sync.Mutex
+	// why load is being shed, empty while it isn't
+	reason string
+	// the number of triggers refused while shedding load
+	shed uint64
+}
+
+// Check - samples usage, shedding load if it's over a threshold
+func (m *Monitor) Check() {
+	u := m.sample()
+
+	reason := ""
+	if m.thresholds.MemoryBytes > 0 && u.MemoryBytes > m.thresholds.MemoryBytes {
+		reason = fmt.Sprintf("memory usage of %d bytes is over the %d byte limit", u.MemoryBytes, m.thresholds.MemoryBytes)
+	} else if m.thresholds.Goroutines > 0 && u.Goroutines > m.thresholds.Goroutines {
+		reason = fmt.Sprintf("%d goroutines are over the limit of %d", u.Goroutines, m.thresholds.Goroutines)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.reason = reason
+}
+
+// Shedding - returns why load is being shed, and whether it is
+func (m *Monitor) Shedding() (string, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.reason, m.reason != ""
+}
+
+// Shed - records that a trigger was refused while shedding load
+func (m *Monitor) Shed() {
+	atomic.AddUint64(&m.shed, 1)
+}
+
+// RetryAfter - how long refused triggers should wait before they're retried
+func (m *Monitor) RetryAfter() time.Duration {
+	return m.interval
+}
+
+// Start - Begins sampling usage at the configured interval, until Stop is called
+func (m *Monitor) Start() {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.Check()
+		}
+	}
+}
+
+// Stop - Stops the monitor
+func (m *Monitor) Stop() {
+	close(m.stop)
+}
+
+// Handler - serves the load shedding metrics in the Prometheus text exposition format
+func (m *Monitor) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		shedding := 0
+		if _, ok := m.Shedding(); ok {
+			shedding = 1
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_load_shedding Whether the membrane is shedding load.\n")
+		fmt.Fprintf(w, "# TYPE nitric_load_shedding gauge\n")
+		fmt.Fprintf(w, "nitric_load_shedding %d\n", shedding)
+		fmt.Fprintf(w, "# HELP nitric_load_shed_total Triggers refused while shedding load.\n")
+		fmt.Fprintf(w, "# TYPE nitric_load_shed_total counter\n")
+		fmt.Fprintf(w, "nitric_load_shed_total %d\n", atomic.LoadUint64(&m.shed))
+	})
+}
+
+// NewMonitor - Creates a new Monitor that samples usage with sample at the given interval, ReadUsage if sample is nil
+func NewMonitor(thresholds Thresholds, interval time.Duration, sample func() Usage) (*Monitor, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("load shedding check interval must be positive, got %s", interval)
+	}
+
+	if sample == nil {
+		sample = ReadUsage
+	}
+
+	return &Monitor{
+		thresholds: thresholds,
+		interval:   interval,
+		sample:     sample,
+		stop:       make(chan bool),
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shedding_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/shedding"
+)
+
+var _ = Describe("Monitor", func() {
+	When("usage is under the thresholds", func() {
+		monitor, _ := shedding.NewMonitor(shedding.Thresholds{MemoryBytes: 1024, Goroutines: 10}, time.Second, func() shedding.Usage {
+			return shedding.Usage{MemoryBytes: 512, Goroutines: 5}
+		})
+
+		It("should not shed load", func() {
+			monitor.Check()
+
+			_, ok := monitor.Shedding()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("memory usage is over its threshold", func() {
+		usage := shedding.Usage{MemoryBytes: 2048, Goroutines: 5}
+		monitor, _ := shedding.NewMonitor(shedding.Thresholds{MemoryBytes: 1024}, time.Second, func() shedding.Usage {
+			return usage
+		})
+
+		It("should shed load until it recovers", func() {
+			monitor.Check()
+
+			reason, ok := monitor.Shedding()
+			Expect(ok).To(BeTrue())
+			Expect(reason).To(ContainSubstring("memory"))
+
+			usage.MemoryBytes = 512
+			monitor.Check()
+
+			_, ok = monitor.Shedding()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the goroutine count is over its threshold", func() {
+		monitor, _ := shedding.NewMonitor(shedding.Thresholds{Goroutines: 10}, time.Second, func() shedding.Usage {
+			return shedding.Usage{MemoryBytes: 1 << 40, Goroutines: 20}
+		})
+
+		It("should shed load, ignoring memory without a threshold", func() {
+			monitor.Check()
+
+			reason, ok := monitor.Shedding()
+			Expect(ok).To(BeTrue())
+			Expect(reason).To(ContainSubstring("goroutines"))
+		})
+
+		It("should serve the load shedding metrics", func() {
+			monitor.Shed()
+
+			rec := httptest.NewRecorder()
+			monitor.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/shedding", nil))
+			Expect(rec.Body.String()).To(ContainSubstring("nitric_load_shedding 1"))
+			Expect(rec.Body.String()).To(ContainSubstring("nitric_load_shed_total 1"))
+		})
+	})
+
+	When("reading the usage of the process", func() {
+		It("should return its memory and goroutines", func() {
+			u := shedding.ReadUsage()
+			Expect(u.MemoryBytes).To(BeNumerically(">", 0))
+			Expect(u.Goroutines).To(BeNumerically(">", 0))
+		})
+	})
+
+	When("the interval isn't positive", func() {
+		It("should return an error", func() {
+			_, err := shedding.NewMonitor(shedding.Thresholds{}, 0, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
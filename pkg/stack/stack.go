@@ -0,0 +1,114 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	// NameKey - the label resources are given their nitric name with
+	NameKey = "x-nitric-name"
+	// StackKey - the label resources are given their stack's name with, and the header triggers are given it with in HTTP proxy mode
+	StackKey = "x-nitric-stack"
+	// EnvironmentKey - the label resources are given their environment with, and the header triggers are given it with in HTTP proxy mode
+	EnvironmentKey = "x-nitric-environment"
+)
+
+// names must be valid label values on every provider, GCP being the strictest
+var validName = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]{0,61}[a-z0-9])?$`)
+
+// Identity - the stack the membrane belongs to, and its environment, e.g. dev, staging or prod.
+// Several environments of a stack can share a project or account, their resources are told apart by their labels
+type Identity struct {
+	Name        string
+	Environment string
+}
+
+// Labels - returns the labels of resources belonging to the stack and environment, omitting any that aren't set
+func (i *Identity) Labels() map[string]string {
+	labels := map[string]string{}
+	if i == nil {
+		return labels
+	}
+
+	if i.Name != "" {
+		labels[StackKey] = i.Name
+	}
+	if i.Environment != "" {
+		labels[EnvironmentKey] = i.Environment
+	}
+
+	return labels
+}
+
+// Matches - returns true if a resource with the given labels belongs to the stack and environment.
+// Resources of any stack or environment match if they aren't set
+func (i *Identity) Matches(labels map[string]string) bool {
+	for key, value := range i.Labels() {
+		if labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InEnvironment - returns true if a resource with the given labels belongs to the environment, or the environment isn't set.
+// For resources that have only ever been found by their name label, so may not be labelled with their stack
+func (i *Identity) InEnvironment(labels map[string]string) bool {
+	return i == nil || i.Environment == "" || labels[EnvironmentKey] == i.Environment
+}
+
+// Qualify - returns the name prefixed with the stack and environment, for resources found by name rather than by their labels
+func (i *Identity) Qualify(name string) string {
+	if i == nil {
+		return name
+	}
+
+	if i.Environment != "" {
+		name = fmt.Sprintf("%s-%s", i.Environment, name)
+	}
+	if i.Name != "" {
+		name = fmt.Sprintf("%s-%s", i.Name, name)
+	}
+
+	return name
+}
+
+// New - returns the identity of a stack's environment, either may be empty
+func New(name string, environment string) (*Identity, error) {
+	if environment != "" && !validName.MatchString(environment) {
+		return nil, fmt.Errorf("invalid environment %q, expected at most 63 lowercase letters, numbers, dashes and underscores", environment)
+	}
+
+	return &Identity{
+		Name:        name,
+		Environment: environment,
+	}, nil
+}
+
+// FromEnv - returns the identity set by the NITRIC_STACK and NITRIC_ENVIRONMENT env vars
+func FromEnv() (*Identity, error) {
+	identity, err := New(utils.GetEnv("NITRIC_STACK", ""), utils.GetEnv("NITRIC_ENVIRONMENT", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid NITRIC_ENVIRONMENT env var: %v", err)
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/stack"
+)
+
+var _ = Describe("Stack", func() {
+	Context("New", func() {
+		It("should accept environments that are valid labels", func() {
+			for _, env := range []string{"", "dev", "staging", "prod-eu_1"} {
+				_, err := stack.New("my-stack", env)
+				Expect(err).ShouldNot(HaveOccurred(), env)
+			}
+		})
+
+		It("should reject environments that aren't valid labels", func() {
+			for _, env := range []string{"Prod", "prod!", "-prod", "prod env"} {
+				_, err := stack.New("my-stack", env)
+				Expect(err).Should(HaveOccurred(), env)
+			}
+		})
+	})
+
+	Context("Labels", func() {
+		It("should only include the stack and environment when they're set", func() {
+			Expect((&stack.Identity{Name: "my-stack", Environment: "prod"}).Labels()).To(Equal(map[string]string{
+				"x-nitric-stack":       "my-stack",
+				"x-nitric-environment": "prod",
+			}))
+			Expect((&stack.Identity{Name: "my-stack"}).Labels()).To(Equal(map[string]string{
+				"x-nitric-stack": "my-stack",
+			}))
+		})
+	})
+
+	Context("Matches", func() {
+		identity := &stack.Identity{Name: "my-stack", Environment: "prod"}
+
+		It("should match resources of the same stack and environment", func() {
+			Expect(identity.Matches(map[string]string{"x-nitric-name": "orders", "x-nitric-stack": "my-stack", "x-nitric-environment": "prod"})).To(BeTrue())
+		})
+
+		It("should not match resources of another environment", func() {
+			Expect(identity.Matches(map[string]string{"x-nitric-stack": "my-stack", "x-nitric-environment": "staging"})).To(BeFalse())
+			Expect(identity.Matches(map[string]string{"x-nitric-stack": "my-stack"})).To(BeFalse())
+		})
+
+		It("should match any resource when neither is set", func() {
+			Expect((&stack.Identity{}).Matches(map[string]string{"x-nitric-environment": "staging"})).To(BeTrue())
+		})
+	})
+
+	Context("InEnvironment", func() {
+		It("should only check the environment", func() {
+			identity := &stack.Identity{Name: "my-stack", Environment: "prod"}
+			Expect(identity.InEnvironment(map[string]string{"x-nitric-environment": "prod"})).To(BeTrue())
+			Expect(identity.InEnvironment(map[string]string{"x-nitric-environment": "dev"})).To(BeFalse())
+		})
+
+		It("should match any resource when the environment isn't set", func() {
+			var identity *stack.Identity
+			Expect(identity.InEnvironment(map[string]string{"x-nitric-environment": "dev"})).To(BeTrue())
+		})
+	})
+
+	Context("Qualify", func() {
+		It("should prefix names with the stack and environment", func() {
+			Expect((&stack.Identity{Name: "my-stack", Environment: "prod"}).Qualify("orders")).To(Equal("my-stack-prod-orders"))
+			Expect((&stack.Identity{Name: "my-stack"}).Qualify("orders")).To(Equal("my-stack-orders"))
+			Expect((&stack.Identity{}).Qualify("orders")).To(Equal("orders"))
+		})
+	})
+})
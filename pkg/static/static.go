@@ -0,0 +1,287 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package static serves static assets, e.g. a website frontend, alongside the child process's routes
+package static
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+const (
+	// IndexFile - served for requests to directories and as the single page app fallback
+	IndexFile = "index.html"
+	// DefaultCacheControl - the Cache-Control header of assets other than index files
+	DefaultCacheControl = "public, max-age=300"
+	// indexCacheControl - index files are revalidated on every request, so new deployments are picked up immediately
+	indexCacheControl = "no-cache"
+)
+
+// ErrNotFound - returned by sources that don't contain an asset
+var ErrNotFound = fmt.Errorf("asset not found")
+
+// Source - a store of static assets
+type Source interface {
+	// Read - returns the content of the named asset, or ErrNotFound if it doesn't exist
+	Read(name string) ([]byte, error)
+}
+
+// dirSource - serves assets from a local directory
+type dirSource struct {
+	root string
+}
+
+func (d *dirSource) Read(name string) ([]byte, error) {
+	file := filepath.Join(d.root, filepath.FromSlash(name))
+	if isDir(file) {
+		return nil, ErrNotFound
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+
+	return content, err
+}
+
+func isDir(name string) bool {
+	info, err := os.Stat(name)
+	return err == nil && info.IsDir()
+}
+
+// NewDirSource - returns a source serving the assets in a local directory
+func NewDirSource(root string) (Source, error) {
+	if !isDir(root) {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	return &dirSource{root: root}, nil
+}
+
+// bucketSource - serves assets from a bucket key prefix
+type bucketSource struct {
+	storage storage.StorageService
+	bucket  string
+	prefix  string
+}
+
+func (b *bucketSource) Read(name string) ([]byte, error) {
+	content, err := b.storage.Read(b.bucket, b.prefix+name)
+	if errors.Code(err) == codes.NotFound {
+		return nil, ErrNotFound
+	}
+
+	return content, err
+}
+
+// NewBucketSource - returns a source serving the assets in a bucket, with keys beginning with prefix
+func NewBucketSource(storagePlugin storage.StorageService, bucket string, prefix string) (Source, error) {
+	if storagePlugin == nil {
+		return nil, fmt.Errorf("a storage plugin is required to serve assets from a bucket")
+	}
+
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return &bucketSource{
+		storage: storagePlugin,
+		bucket:  bucket,
+		prefix:  prefix,
+	}, nil
+}
+
+// Server - serves static assets under a path prefix.
+//
+// Requests for assets that don't exist are passed through to the child process, unless single page app fallback is
+// enabled and the request accepts html, in which case the root index file is served so the app can route the request.
+type Server struct {
+	source       Source
+	prefix       string
+	spa          bool
+	lock         sync.RWMutex
+	cacheControl string
+}
+
+// SetCacheControl - Replaces the Cache-Control header of assets other than index files, DefaultCacheControl if blank
+func (s *Server) SetCacheControl(cacheControl string) {
+	if cacheControl == "" {
+		cacheControl = DefaultCacheControl
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.cacheControl = cacheControl
+}
+
+// assetName - returns the name of the asset a request path refers to, false if the path isn't under the server's prefix
+func (s *Server) assetName(requestPath string) (string, bool) {
+	if s.prefix != "/" {
+		if requestPath != s.prefix && !strings.HasPrefix(requestPath, s.prefix+"/") {
+			return "", false
+		}
+		requestPath = strings.TrimPrefix(requestPath, s.prefix)
+	}
+
+	// Cleaning a rooted path removes any .. elements, so assets can't be read from outside the source
+	name := strings.TrimPrefix(path.Clean("/"+requestPath), "/")
+	if name == "" || strings.HasSuffix(requestPath, "/") {
+		name = path.Join(name, IndexFile)
+	}
+
+	return name, true
+}
+
+func headerValue(req *triggers.HttpRequest, name string) string {
+	for k, v := range req.Header {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	return ""
+}
+
+// Etag - returns the strong entity tag of an asset's content
+func Etag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches - returns true if an If-None-Match header value matches the entity tag
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) response(req *triggers.HttpRequest, name string, content []byte) *triggers.HttpResponse {
+	header := &fasthttp.ResponseHeader{}
+
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = http.DetectContentType(content)
+	}
+	header.Set("Content-Type", contentType)
+
+	if path.Base(name) == IndexFile {
+		header.Set("Cache-Control", indexCacheControl)
+	} else {
+		s.lock.RLock()
+		header.Set("Cache-Control", s.cacheControl)
+		s.lock.RUnlock()
+	}
+
+	etag := Etag(content)
+	header.Set("ETag", etag)
+
+	if inm := headerValue(req, "If-None-Match"); inm != "" && etagMatches(inm, etag) {
+		return &triggers.HttpResponse{
+			Header:     header,
+			StatusCode: http.StatusNotModified,
+		}
+	}
+
+	response := &triggers.HttpResponse{
+		Header:     header,
+		StatusCode: http.StatusOK,
+		Body:       content,
+	}
+
+	if req.Method == http.MethodHead {
+		header.Set("Content-Length", fmt.Sprint(len(content)))
+		response.Body = nil
+	}
+
+	return response
+}
+
+// Serve - returns the response to a request for a static asset, or nil if the request should be handled by the child process
+func (s *Server) Serve(req *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil, nil
+	}
+
+	name, ok := s.assetName(req.Path)
+	if !ok {
+		return nil, nil
+	}
+
+	content, err := s.source.Read(name)
+	if err == ErrNotFound && s.spa && strings.Contains(headerValue(req, "Accept"), "text/html") {
+		name = IndexFile
+		content, err = s.source.Read(name)
+	}
+
+	if err == ErrNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading asset %s: %v", name, err)
+	}
+
+	return s.response(req, name, content), nil
+}
+
+// ServerOptions - configures how assets are served
+type ServerOptions struct {
+	// Prefix - the path prefix assets are served under, / if blank
+	Prefix string
+	// Spa - serves the root index file for html requests to missing assets
+	Spa bool
+	// CacheControl - the Cache-Control header of assets other than index files, DefaultCacheControl if blank
+	CacheControl string
+}
+
+// NewServer - returns a server for the assets in source
+func NewServer(source Source, opts *ServerOptions) *Server {
+	prefix := "/" + strings.Trim(opts.Prefix, "/")
+
+	cacheControl := opts.CacheControl
+	if cacheControl == "" {
+		cacheControl = DefaultCacheControl
+	}
+
+	return &Server{
+		source:       source,
+		prefix:       prefix,
+		spa:          opts.Spa,
+		cacheControl: cacheControl,
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/static"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type memoryStorage struct {
+	storage.UnimplementedStoragePlugin
+	objects map[string][]byte
+}
+
+func (m *memoryStorage) Read(bucket string, key string, opts ...storage.ReadOption) ([]byte, error) {
+	if object, ok := m.objects[bucket+"/"+key]; ok {
+		return object, nil
+	}
+
+	return nil, errors.ErrorsWithScope("memoryStorage.Read", nil)(codes.NotFound, "not found", nil)
+}
+
+func get(path string, header map[string][]string) *triggers.HttpRequest {
+	return &triggers.HttpRequest{
+		Method: "GET",
+		Path:   path,
+		Header: header,
+	}
+}
+
+var _ = Describe("Static", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "static")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(dir, "assets"), 0o755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>app</html>"), 0o644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "assets", "app.js"), []byte("console.log('app')"), 0o644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	newServer := func(opts *static.ServerOptions) *static.Server {
+		source, err := static.NewDirSource(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+		return static.NewServer(source, opts)
+	}
+
+	When("requesting an asset", func() {
+		It("should serve it with its content type, etag and cache headers", func() {
+			res, err := newServer(&static.ServerOptions{}).Serve(get("/assets/app.js", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(200))
+			Expect(string(res.Body)).To(Equal("console.log('app')"))
+			Expect(string(res.Header.Peek("Content-Type"))).To(ContainSubstring("javascript"))
+			Expect(string(res.Header.Peek("Cache-Control"))).To(Equal(static.DefaultCacheControl))
+			Expect(string(res.Header.Peek("ETag"))).To(Equal(static.Etag([]byte("console.log('app')"))))
+		})
+	})
+
+	When("the cache control is replaced", func() {
+		It("should serve assets with the new header", func() {
+			server := newServer(&static.ServerOptions{})
+			server.SetCacheControl("public, max-age=60")
+
+			res, err := server.Serve(get("/assets/app.js", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Header.Peek("Cache-Control"))).To(Equal("public, max-age=60"))
+		})
+	})
+
+	When("requesting the root path", func() {
+		It("should serve the index file without caching it", func() {
+			res, err := newServer(&static.ServerOptions{}).Serve(get("/", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Body)).To(Equal("<html>app</html>"))
+			Expect(string(res.Header.Peek("Cache-Control"))).To(Equal("no-cache"))
+		})
+	})
+
+	When("the etag matches", func() {
+		It("should respond not modified without a body", func() {
+			etag := static.Etag([]byte("console.log('app')"))
+			res, err := newServer(&static.ServerOptions{}).Serve(get("/assets/app.js", map[string][]string{
+				"If-None-Match": {etag},
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(304))
+			Expect(res.Body).To(BeEmpty())
+		})
+	})
+
+	When("requesting a path outside the source", func() {
+		It("should not escape the directory", func() {
+			res, err := newServer(&static.ServerOptions{}).Serve(get("/../../etc/passwd", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("requesting a missing asset", func() {
+		It("should pass the request through", func() {
+			res, err := newServer(&static.ServerOptions{}).Serve(get("/api/orders", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("single page app fallback is enabled", func() {
+		It("should serve the index file to html requests for missing assets", func() {
+			res, err := newServer(&static.ServerOptions{Spa: true}).Serve(get("/orders/1", map[string][]string{
+				"accept": {"text/html,application/xhtml+xml"},
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Body)).To(Equal("<html>app</html>"))
+		})
+
+		It("should pass other requests through", func() {
+			res, err := newServer(&static.ServerOptions{Spa: true}).Serve(get("/api/orders", map[string][]string{
+				"Accept": {"application/json"},
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("assets are served under a prefix", func() {
+		server := func() *static.Server {
+			return newServer(&static.ServerOptions{Prefix: "/app/"})
+		}
+
+		It("should serve assets under the prefix", func() {
+			res, err := server().Serve(get("/app/assets/app.js", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(200))
+		})
+
+		It("should serve the index file for the prefix", func() {
+			res, err := server().Serve(get("/app", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Body)).To(Equal("<html>app</html>"))
+		})
+
+		It("should pass through requests outside the prefix", func() {
+			res, err := server().Serve(get("/application/assets/app.js", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("the request isn't a GET or HEAD", func() {
+		It("should pass the request through", func() {
+			req := get("/assets/app.js", nil)
+			req.Method = "POST"
+
+			res, err := newServer(&static.ServerOptions{}).Serve(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("serving assets from a bucket", func() {
+		It("should read assets under the key prefix", func() {
+			source, err := static.NewBucketSource(&memoryStorage{
+				objects: map[string][]byte{"site/web/index.html": []byte("<html>bucket</html>")},
+			}, "site", "web")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			server := static.NewServer(source, &static.ServerOptions{})
+
+			res, err := server.Serve(get("/", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Body)).To(Equal("<html>bucket</html>"))
+
+			res, err = server.Serve(get("/missing.js", nil))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+})
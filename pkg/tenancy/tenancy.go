@@ -0,0 +1,236 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+)
+
+// MetadataKey - the gRPC metadata key and event attribute identifying the tenant of a runtime API call
+const MetadataKey = "x-nitric-tenant"
+
+// Mode - whether runtime API calls are namespaced by tenant
+type Mode string
+
+const (
+	// Disabled - tenant metadata is ignored and all calls share the same resources
+	Disabled Mode = ""
+	// Optional - calls with tenant metadata use that tenant's resources, calls without it use shared resources
+	Optional Mode = "optional"
+	// Required - calls without tenant metadata are rejected
+	Required Mode = "required"
+)
+
+// separator - joins the tenant to the names of its resources. Tenants can't contain it,
+// so a namespaced name always splits at its first separator and tenants can't be confused
+const separator = "-"
+
+var tenantPattern = regexp.MustCompile(`^[a-z0-9]{1,63}$`)
+
+// Tenancy - identifies the tenant runtime API calls are made for, so their resources can be namespaced
+type Tenancy struct {
+	mode Mode
+}
+
+// ParseMode - parses a tenancy mode, as disabled, optional or required
+func ParseMode(mode string) (Mode, error) {
+	switch strings.ToLower(mode) {
+	case "", "disabled":
+		return Disabled, nil
+	case string(Optional):
+		return Optional, nil
+	case string(Required):
+		return Required, nil
+	default:
+		return Disabled, fmt.Errorf("unknown tenancy mode %s, expected disabled, optional or required", mode)
+	}
+}
+
+// ValidateTenant - returns an error if the tenant ID can't be used to namespace resources
+func ValidateTenant(tenant string) error {
+	if !tenantPattern.MatchString(tenant) {
+		return fmt.Errorf("invalid tenant %q, expected up to 63 lowercase letters or digits", tenant)
+	}
+
+	return nil
+}
+
+// Tenant - returns the tenant a runtime API call is made for, from its metadata.
+//
+// Returns a blank tenant for calls using shared resources, including all calls when tenancy is disabled or nil.
+func (t *Tenancy) Tenant(ctx context.Context) (string, error) {
+	if t == nil || t.mode == Disabled {
+		return "", nil
+	}
+
+	tenant := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 {
+			tenant = values[0]
+		}
+	}
+
+	if tenant == "" {
+		if t.mode == Required {
+			return "", fmt.Errorf("missing %s metadata, a tenant is required", MetadataKey)
+		}
+
+		return "", nil
+	}
+
+	if err := ValidateTenant(tenant); err != nil {
+		return "", err
+	}
+
+	return tenant, nil
+}
+
+// New - creates a tenancy layer in the given mode
+func New(mode Mode) *Tenancy {
+	return &Tenancy{
+		mode: mode,
+	}
+}
+
+// Name - namespaces a resource name for the tenant, e.g. a secret or root collection name
+func Name(tenant string, name string) string {
+	if tenant == "" {
+		return name
+	}
+
+	return tenant + separator + name
+}
+
+// SplitName - returns the tenant and resource name of a namespaced resource name, or a blank tenant if the name isn't namespaced
+func SplitName(name string) (string, string) {
+	parts := strings.SplitN(name, separator, 2)
+	if len(parts) == 2 && tenantPattern.MatchString(parts[0]) {
+		return parts[0], parts[1]
+	}
+
+	return "", name
+}
+
+// StripName - removes the tenant namespace from a resource name
+func StripName(tenant string, name string) string {
+	if tenant == "" {
+		return name
+	}
+
+	return strings.TrimPrefix(name, tenant+separator)
+}
+
+// ObjectKey - namespaces a storage object key for the tenant, as a key prefix
+func ObjectKey(tenant string, key string) string {
+	if tenant == "" {
+		return key
+	}
+
+	return tenant + "/" + key
+}
+
+// StripObjectKey - removes the tenant prefix from an object key, returning false if the object doesn't belong to the tenant
+func StripObjectKey(tenant string, key string) (string, bool) {
+	if tenant == "" {
+		return key, true
+	}
+
+	if !strings.HasPrefix(key, tenant+"/") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(key, tenant+"/"), true
+}
+
+// Collection - namespaces the root collection of a collection for the tenant, sub-collections are namespaced by their root
+func Collection(tenant string, col *document.Collection) *document.Collection {
+	if tenant == "" || col == nil {
+		return col
+	}
+
+	if col.Parent == nil {
+		return &document.Collection{
+			Name: Name(tenant, col.Name),
+		}
+	}
+
+	return &document.Collection{
+		Name:   col.Name,
+		Parent: Key(tenant, col.Parent),
+	}
+}
+
+// Key - namespaces the root collection of a document key for the tenant
+func Key(tenant string, key *document.Key) *document.Key {
+	if tenant == "" || key == nil {
+		return key
+	}
+
+	return &document.Key{
+		Collection: Collection(tenant, key.Collection),
+		Id:         key.Id,
+	}
+}
+
+// StripCollection - removes the tenant namespace from the root collection of a collection
+func StripCollection(tenant string, col *document.Collection) *document.Collection {
+	if tenant == "" || col == nil {
+		return col
+	}
+
+	if col.Parent == nil {
+		return &document.Collection{
+			Name: StripName(tenant, col.Name),
+		}
+	}
+
+	return &document.Collection{
+		Name:   col.Name,
+		Parent: StripKey(tenant, col.Parent),
+	}
+}
+
+// StripKey - removes the tenant namespace from the root collection of a document key
+func StripKey(tenant string, key *document.Key) *document.Key {
+	if tenant == "" || key == nil {
+		return key
+	}
+
+	return &document.Key{
+		Collection: StripCollection(tenant, key.Collection),
+		Id:         key.Id,
+	}
+}
+
+// TagEvent - adds the tenant to an event's attributes, so subscribers and topic filters can tell tenants apart
+func TagEvent(tenant string, event *events.NitricEvent) {
+	if tenant == "" {
+		return
+	}
+
+	if event.Attributes == nil {
+		event.Attributes = map[string]string{}
+	}
+
+	event.Attributes[MetadataKey] = tenant
+}
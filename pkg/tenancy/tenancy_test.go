@@ -0,0 +1,167 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+func tenantContext(tenant string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenancy.MetadataKey, tenant))
+}
+
+var _ = Describe("Tenancy", func() {
+	Context("ParseMode", func() {
+		It("should parse known modes", func() {
+			for value, mode := range map[string]tenancy.Mode{
+				"":         tenancy.Disabled,
+				"disabled": tenancy.Disabled,
+				"optional": tenancy.Optional,
+				"Required": tenancy.Required,
+			} {
+				m, err := tenancy.ParseMode(value)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(m).To(Equal(mode))
+			}
+		})
+
+		It("should reject unknown modes", func() {
+			_, err := tenancy.ParseMode("always")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Tenant", func() {
+		When("tenancy is disabled", func() {
+			It("should ignore tenant metadata", func() {
+				var t *tenancy.Tenancy
+				tenant, err := t.Tenant(tenantContext("acme"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tenant).To(BeEmpty())
+			})
+		})
+
+		When("tenancy is optional", func() {
+			t := tenancy.New(tenancy.Optional)
+
+			It("should return the tenant from the metadata", func() {
+				tenant, err := t.Tenant(tenantContext("acme"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tenant).To(Equal("acme"))
+			})
+
+			It("should return a blank tenant without metadata", func() {
+				tenant, err := t.Tenant(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tenant).To(BeEmpty())
+			})
+
+			It("should reject invalid tenants", func() {
+				_, err := t.Tenant(tenantContext("../acme"))
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should reject tenants containing the separator", func() {
+				_, err := t.Tenant(tenantContext("acme-corp"))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("tenancy is required", func() {
+			It("should reject calls without a tenant", func() {
+				_, err := tenancy.New(tenancy.Required).Tenant(context.Background())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Name", func() {
+		It("should give different tenants different names", func() {
+			Expect(tenancy.Name("acme", "corp-orders")).To(Equal("acme-corp-orders"))
+			Expect(tenancy.Name("acmecorp", "orders")).To(Equal("acmecorp-orders"))
+		})
+
+		It("should split names at the tenant", func() {
+			tenant, name := tenancy.SplitName(tenancy.Name("acme", "corp-orders"))
+			Expect(tenant).To(Equal("acme"))
+			Expect(name).To(Equal("corp-orders"))
+		})
+
+		It("should leave shared names whole", func() {
+			tenant, name := tenancy.SplitName("orders")
+			Expect(tenant).To(BeEmpty())
+			Expect(name).To(Equal("orders"))
+		})
+	})
+
+	Context("Key", func() {
+		key := &document.Key{
+			Collection: &document.Collection{
+				Name: "items",
+				Parent: &document.Key{
+					Collection: &document.Collection{Name: "orders"},
+					Id:         "1",
+				},
+			},
+			Id: "2",
+		}
+
+		It("should namespace the root collection", func() {
+			tk := tenancy.Key("acme", key)
+			Expect(tk.Collection.Name).To(Equal("items"))
+			Expect(tk.Collection.Parent.Collection.Name).To(Equal("acme-orders"))
+			Expect(key.Collection.Parent.Collection.Name).To(Equal("orders"))
+		})
+
+		It("should round trip", func() {
+			Expect(tenancy.StripKey("acme", tenancy.Key("acme", key))).To(Equal(key))
+		})
+
+		It("should leave shared keys alone", func() {
+			Expect(tenancy.Key("", key)).To(BeIdenticalTo(key))
+		})
+	})
+
+	Context("ObjectKey", func() {
+		It("should prefix keys with the tenant", func() {
+			Expect(tenancy.ObjectKey("acme", "reports/1.pdf")).To(Equal("acme/reports/1.pdf"))
+		})
+
+		It("should only strip the tenant's own keys", func() {
+			key, ok := tenancy.StripObjectKey("acme", "acme/reports/1.pdf")
+			Expect(ok).To(BeTrue())
+			Expect(key).To(Equal("reports/1.pdf"))
+
+			_, ok = tenancy.StripObjectKey("acme", "other/reports/1.pdf")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("TagEvent", func() {
+		It("should add the tenant attribute", func() {
+			evt := &events.NitricEvent{ID: "1"}
+			tenancy.TagEvent("acme", evt)
+			Expect(evt.Attributes).To(HaveKeyWithValue(tenancy.MetadataKey, "acme"))
+		})
+	})
+})
@@ -0,0 +1,598 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeseries
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+const (
+	// DefaultWindow - the window points are bucketed by when a series doesn't configure one
+	DefaultWindow = time.Hour
+	// TimeField - the field of each point's document holding the point's time
+	TimeField = "time"
+	// pointsCollection - the sub-collection of each bucket document its points are stored in
+	pointsCollection = "points"
+	startField       = "start"
+	endField         = "end"
+	// tenantField - the field of each bucket document holding the tenant it was written for, blank for shared buckets
+	tenantField = "tenant"
+	// timeLayout - fixed width UTC times, which order the same as strings as they do as times
+	timeLayout = "20060102T150405.000000000Z"
+	// bucketLayout - buckets are identified by the start of their window
+	bucketLayout = "20060102T150405Z"
+	// queryPageSize - number of buckets read from a series at a time
+	queryPageSize = 100
+)
+
+// Series - a collection of points bucketed into fixed windows of time, each bucket is deleted once it's older than the retention
+type Series struct {
+	Name string
+	// Window - the length of time covered by each bucket, a whole number of seconds
+	Window time.Duration
+	// Retention - buckets whose window ended longer ago than this are deleted, all are kept if zero
+	Retention time.Duration
+}
+
+// ParseSeries - parses comma separated collections, each optionally followed by =<window> and /<retention>,
+// e.g. "readings=1m/168h,metrics". Series are bucketed hourly and kept forever unless a window and retention are given
+func ParseSeries(value string) (map[string]*Series, error) {
+	series := make(map[string]*Series)
+
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		parts := strings.SplitN(s, "=", 2)
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			return nil, fmt.Errorf("invalid series %s, expected <collection>[=<window>[/<retention>]]", s)
+		}
+
+		ser := &Series{Name: name, Window: DefaultWindow}
+		if len(parts) == 2 {
+			durations := strings.SplitN(parts[1], "/", 2)
+
+			window, err := time.ParseDuration(strings.TrimSpace(durations[0]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid window for series %s: %v", name, err)
+			}
+			ser.Window = window
+
+			if len(durations) == 2 {
+				retention, err := time.ParseDuration(strings.TrimSpace(durations[1]))
+				if err != nil {
+					return nil, fmt.Errorf("invalid retention for series %s: %v", name, err)
+				}
+				ser.Retention = retention
+			}
+		}
+
+		if err := ser.validate(); err != nil {
+			return nil, err
+		}
+
+		series[name] = ser
+	}
+
+	return series, nil
+}
+
+func (s *Series) validate() error {
+	if s.Window < time.Second || s.Window%time.Second != 0 {
+		return fmt.Errorf("window of series %s must be a whole number of seconds, got %s", s.Name, s.Window)
+	}
+
+	if s.Retention < 0 {
+		return fmt.Errorf("retention of series %s must not be negative, got %s", s.Name, s.Retention)
+	}
+
+	return nil
+}
+
+// bucketStart - the start of the window of the bucket a time falls in
+func (s *Series) bucketStart(t time.Time) time.Time {
+	return t.UTC().Truncate(s.Window)
+}
+
+// bucketKey - the key of a tenant's bucket, tenants share each series' collection so expired buckets are deleted for every tenant
+func (s *Series) bucketKey(tenant string, start time.Time) *document.Key {
+	return &document.Key{
+		Collection: &document.Collection{Name: s.Name},
+		Id:         tenancy.Name(tenant, start.Format(bucketLayout)),
+	}
+}
+
+func (s *Series) points(tenant string, start time.Time) *document.Collection {
+	return &document.Collection{
+		Name:   pointsCollection,
+		Parent: s.bucketKey(tenant, start),
+	}
+}
+
+// Point - a set of values recorded at a time
+type Point struct {
+	Time   time.Time
+	Values map[string]interface{}
+}
+
+// Rollup - aggregates of a field over the points of a bucket
+type Rollup struct {
+	Start time.Time
+	End   time.Time
+	Count int64
+	Sum   float64
+	Min   float64
+	Max   float64
+	Avg   float64
+}
+
+// Store - writes and reads time series, stored by the document plugin as a document per bucket with the bucket's points in a sub-collection.
+//
+// Buckets are the top level documents of the series' collection, so each provider partitions points by their window:
+// a DynamoDB partition, a Firestore sub-collection or a MongoDB parent id per bucket. Range queries and rollups read only the buckets
+// whose windows overlap the range, and expired buckets are deleted with their points.
+type Store struct {
+	documents document.DocumentService
+	series    map[string]*Series
+	// interval - how often expired buckets are deleted by Start
+	interval time.Duration
+	now      func() time.Time
+	stop     chan bool
+
+	lock sync.Mutex
+	// buckets - the bucket documents written by the store, so each is only written once
+	buckets map[string]bool
+}
+
+func (s *Store) getSeries(name string, newErr errors.ErrorFactory) (*Series, error) {
+	ser, ok := s.series[name]
+	if !ok {
+		return nil, newErr(
+			codes.NotFound,
+			fmt.Sprintf("%s is not a time series collection", name),
+			nil,
+		)
+	}
+
+	return ser, nil
+}
+
+// expiry - the time buckets ending at or before are expired, zero if the series keeps every bucket
+func (s *Store) expiry(ser *Series) time.Time {
+	if ser.Retention == 0 {
+		return time.Time{}
+	}
+
+	return s.now().UTC().Add(-ser.Retention)
+}
+
+// ensureBucket - writes the document of a tenant's bucket, unless it has already been written by the store
+func (s *Store) ensureBucket(tenant string, ser *Series, start time.Time) error {
+	key := ser.bucketKey(tenant, start)
+
+	s.lock.Lock()
+	written := s.buckets[key.String()]
+	s.lock.Unlock()
+
+	if written {
+		return nil
+	}
+
+	if err := s.documents.Set(key, map[string]interface{}{
+		startField:  start.Format(bucketLayout),
+		endField:    start.Add(ser.Window).Format(bucketLayout),
+		tenantField: tenant,
+	}); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	s.buckets[key.String()] = true
+	s.lock.Unlock()
+
+	return nil
+}
+
+// Append - writes a tenant's points to a series, in the buckets of their windows. The tenant is blank for shared points
+func (s *Store) Append(tenant string, name string, points []Point) error {
+	newErr := errors.ErrorsWithScope(
+		"TimeSeries.Append",
+		map[string]interface{}{
+			"collection": name,
+			"tenant":     tenant,
+		},
+	)
+
+	ser, err := s.getSeries(name, newErr)
+	if err != nil {
+		return err
+	}
+
+	expiry := s.expiry(ser)
+	docs := make([]document.Document, 0, len(points))
+	for _, p := range points {
+		if p.Time.IsZero() {
+			return newErr(codes.InvalidArgument, "points must have a time", nil)
+		}
+
+		if _, ok := p.Values[TimeField]; ok {
+			return newErr(codes.InvalidArgument, fmt.Sprintf("point values must not contain the reserved field %s", TimeField), nil)
+		}
+
+		start := ser.bucketStart(p.Time)
+		if !expiry.IsZero() && !start.Add(ser.Window).After(expiry) {
+			return newErr(codes.InvalidArgument, fmt.Sprintf("point at %s is older than the series' retention", p.Time.UTC().Format(time.RFC3339)), nil)
+		}
+
+		if err := s.ensureBucket(tenant, ser, start); err != nil {
+			return newErr(codes.Internal, "error writing bucket", err)
+		}
+
+		content := make(map[string]interface{}, len(p.Values)+1)
+		for k, v := range p.Values {
+			content[k] = v
+		}
+		content[TimeField] = p.Time.UTC().Format(timeLayout)
+
+		docs = append(docs, document.Document{
+			Key: &document.Key{
+				Collection: ser.points(tenant, start),
+				// ids start with the point's time, with a random suffix so points recorded at the same time don't collide
+				Id: p.Time.UTC().Format(timeLayout) + "-" + uuid.NewString(),
+			},
+			Content: content,
+		})
+	}
+
+	if len(docs) == 0 {
+		return nil
+	}
+
+	resp, err := s.documents.SetBatch(docs)
+	if err != nil {
+		return newErr(codes.Internal, "error writing points", err)
+	}
+
+	if len(resp.FailedWrites) > 0 {
+		return newErr(
+			codes.Internal,
+			fmt.Sprintf("failed to write %d of %d points: %s", len(resp.FailedWrites), len(docs), resp.FailedWrites[0].Message),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// bucketStarts - the starts of the tenant's buckets of the series whose windows overlap start to end, in order
+func (s *Store) bucketStarts(tenant string, ser *Series, start time.Time, end time.Time) ([]time.Time, error) {
+	expressions := []document.QueryExpression{
+		{Operand: startField, Operator: ">=", Value: ser.bucketStart(start).Format(bucketLayout)},
+		{Operand: startField, Operator: "<=", Value: ser.bucketStart(end.Add(-time.Nanosecond)).Format(bucketLayout)},
+	}
+	// shared buckets written before tenancy have no tenant field, so they're told apart by their ids below instead
+	if tenant != "" {
+		expressions = append(expressions, document.QueryExpression{Operand: tenantField, Operator: "==", Value: tenant})
+	}
+
+	starts := make([]time.Time, 0)
+
+	var pagingToken map[string]string
+	for {
+		result, err := s.documents.Query(&document.Collection{Name: ser.Name}, expressions, queryPageSize, pagingToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, doc := range result.Documents {
+			bucketTenant, id := tenancy.SplitName(doc.Key.Id)
+			if bucketTenant != tenant {
+				continue
+			}
+
+			bucketStart, err := time.Parse(bucketLayout, id)
+			if err != nil {
+				continue
+			}
+			starts = append(starts, bucketStart)
+		}
+
+		if len(result.PagingToken) == 0 {
+			break
+		}
+		pagingToken = result.PagingToken
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	return starts, nil
+}
+
+// timeRange - expressions matching points from start up to but excluding end
+func timeRange(start time.Time, end time.Time) []document.QueryExpression {
+	return []document.QueryExpression{
+		{Operand: TimeField, Operator: ">=", Value: start.UTC().Format(timeLayout)},
+		{Operand: TimeField, Operator: "<=", Value: end.UTC().Add(-time.Nanosecond).Format(timeLayout)},
+	}
+}
+
+func validateRange(start time.Time, end time.Time, newErr errors.ErrorFactory) error {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return newErr(codes.InvalidArgument, "provide a start and an end after it", nil)
+	}
+
+	return nil
+}
+
+// Range - returns a tenant's points of a series from start up to but excluding end in time order, at most limit points unless limit is zero
+func (s *Store) Range(tenant string, name string, start time.Time, end time.Time, limit int) ([]Point, error) {
+	newErr := errors.ErrorsWithScope(
+		"TimeSeries.Range",
+		map[string]interface{}{
+			"collection": name,
+			"tenant":     tenant,
+		},
+	)
+
+	ser, err := s.getSeries(name, newErr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRange(start, end, newErr); err != nil {
+		return nil, err
+	}
+
+	starts, err := s.bucketStarts(tenant, ser, start, end)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error reading buckets", err)
+	}
+
+	points := make([]Point, 0)
+	for _, bucketStart := range starts {
+		bucketPoints := make([]Point, 0)
+
+		next := s.documents.QueryStream(ser.points(tenant, bucketStart), timeRange(start, end), 0)
+		for {
+			doc, err := next()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, newErr(codes.Internal, "error reading points", err)
+			}
+
+			p, err := toPoint(doc)
+			if err != nil {
+				return nil, newErr(codes.Internal, "invalid point", err)
+			}
+			bucketPoints = append(bucketPoints, p)
+		}
+
+		sort.SliceStable(bucketPoints, func(i, j int) bool {
+			return bucketPoints[i].Time.Before(bucketPoints[j].Time)
+		})
+
+		points = append(points, bucketPoints...)
+		if limit > 0 && len(points) >= limit {
+			return points[:limit], nil
+		}
+	}
+
+	return points, nil
+}
+
+func toPoint(doc *document.Document) (Point, error) {
+	formatted, _ := doc.Content[TimeField].(string)
+
+	t, err := time.Parse(timeLayout, formatted)
+	if err != nil {
+		return Point{}, fmt.Errorf("point %s has no valid time: %v", doc.Key.Id, err)
+	}
+
+	values := make(map[string]interface{}, len(doc.Content))
+	for k, v := range doc.Content {
+		if k != TimeField {
+			values[k] = v
+		}
+	}
+
+	return Point{Time: t, Values: values}, nil
+}
+
+// Rollup - returns aggregates of a numeric field over each of a tenant's buckets of a series with points from start up to but excluding end
+func (s *Store) Rollup(tenant string, name string, field string, start time.Time, end time.Time) ([]Rollup, error) {
+	newErr := errors.ErrorsWithScope(
+		"TimeSeries.Rollup",
+		map[string]interface{}{
+			"collection": name,
+			"field":      field,
+			"tenant":     tenant,
+		},
+	)
+
+	ser, err := s.getSeries(name, newErr)
+	if err != nil {
+		return nil, err
+	}
+
+	if field == "" || field == TimeField {
+		return nil, newErr(codes.InvalidArgument, "provide a field of the points to roll up", nil)
+	}
+
+	if err := validateRange(start, end, newErr); err != nil {
+		return nil, err
+	}
+
+	starts, err := s.bucketStarts(tenant, ser, start, end)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error reading buckets", err)
+	}
+
+	aggregations := []document.Aggregation{
+		{Function: document.AggregateCount},
+		{Function: document.AggregateSum, Field: field},
+		{Function: document.AggregateMin, Field: field},
+		{Function: document.AggregateMax, Field: field},
+		{Function: document.AggregateAvg, Field: field},
+	}
+
+	rollups := make([]Rollup, 0, len(starts))
+	for _, bucketStart := range starts {
+		results, err := s.documents.Aggregate(ser.points(tenant, bucketStart), timeRange(start, end), aggregations)
+		if err != nil {
+			return nil, newErr(codes.Internal, "error aggregating points", err)
+		}
+
+		rollup := Rollup{Start: bucketStart, End: bucketStart.Add(ser.Window)}
+		for _, r := range results {
+			switch v := r.Value.(type) {
+			case int64:
+				rollup.Count = v
+			case float64:
+				switch r.Aggregation.Function {
+				case document.AggregateSum:
+					rollup.Sum = v
+				case document.AggregateMin:
+					rollup.Min = v
+				case document.AggregateMax:
+					rollup.Max = v
+				case document.AggregateAvg:
+					rollup.Avg = v
+				}
+			}
+		}
+
+		if rollup.Count > 0 {
+			rollups = append(rollups, rollup)
+		}
+	}
+
+	return rollups, nil
+}
+
+// Expire - deletes the buckets of each series whose windows ended longer ago than the series' retention, with their points
+func (s *Store) Expire() error {
+	for _, ser := range s.series {
+		expiry := s.expiry(ser)
+		if expiry.IsZero() {
+			continue
+		}
+
+		expressions := []document.QueryExpression{
+			{Operand: endField, Operator: "<=", Value: expiry.Format(bucketLayout)},
+		}
+
+		for {
+			// deleted buckets no longer match, so the first page is read until none are left
+			result, err := s.documents.Query(&document.Collection{Name: ser.Name}, expressions, queryPageSize, nil)
+			if err != nil {
+				return fmt.Errorf("error reading expired buckets of series %s: %v", ser.Name, err)
+			}
+
+			if len(result.Documents) == 0 {
+				break
+			}
+
+			keys := make([]*document.Key, 0, len(result.Documents))
+			for _, doc := range result.Documents {
+				keys = append(keys, doc.Key)
+			}
+
+			resp, err := s.documents.DeleteBatch(keys)
+			if err != nil {
+				return fmt.Errorf("error deleting expired buckets of series %s: %v", ser.Name, err)
+			}
+
+			if len(resp.FailedWrites) > 0 {
+				return fmt.Errorf("failed to delete %d expired buckets of series %s: %s", len(resp.FailedWrites), ser.Name, resp.FailedWrites[0].Message)
+			}
+
+			s.lock.Lock()
+			for _, key := range keys {
+				delete(s.buckets, key.String())
+			}
+			s.lock.Unlock()
+		}
+	}
+
+	return nil
+}
+
+// Start - Deletes expired buckets every interval until stopped
+func (s *Store) Start() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.Expire(); err != nil {
+				log.Default().Printf("error expiring time series buckets: %v", err)
+			}
+		}
+	}
+}
+
+// Stop - Stops deleting expired buckets
+func (s *Store) Stop() {
+	close(s.stop)
+}
+
+// New - Creates a time series store for the given series, deleting expired buckets every interval once started
+func New(documents document.DocumentService, series map[string]*Series, interval time.Duration) (*Store, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("document plugin is required for time series")
+	}
+
+	for _, ser := range series {
+		if err := ser.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("time series expiry interval must be positive, got %s", interval)
+	}
+
+	return &Store{
+		documents: documents,
+		series:    series,
+		interval:  interval,
+		now:       time.Now,
+		stop:      make(chan bool),
+		buckets:   make(map[string]bool),
+	}, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeseries
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+)
+
+var _ = Describe("Time series", func() {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	var dir string
+	var store *Store
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "timeseries")
+		Expect(err).ShouldNot(HaveOccurred())
+		os.Setenv("LOCAL_DB_DIR", dir)
+
+		docs, err := boltdb_service.New()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		store, err = New(docs, map[string]*Series{
+			"readings": {Name: "readings", Window: time.Hour, Retention: 24 * time.Hour},
+		}, time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		store.now = func() time.Time { return base.Add(3 * time.Hour) }
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("LOCAL_DB_DIR")
+		os.RemoveAll(dir)
+	})
+
+	reading := func(offset time.Duration, value float64) Point {
+		return Point{Time: base.Add(offset), Values: map[string]interface{}{"temperature": value}}
+	}
+
+	Context("ParseSeries", func() {
+		It("should parse windows and retentions", func() {
+			series, err := ParseSeries("readings=1m/168h, metrics")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(series["readings"]).To(Equal(&Series{Name: "readings", Window: time.Minute, Retention: 168 * time.Hour}))
+			Expect(series["metrics"]).To(Equal(&Series{Name: "metrics", Window: DefaultWindow}))
+		})
+
+		It("should reject windows that aren't whole seconds", func() {
+			_, err := ParseSeries("readings=1500ms")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Range", func() {
+		BeforeEach(func() {
+			// written out of order, across three buckets
+			Expect(store.Append("", "readings", []Point{
+				reading(2*time.Hour+time.Minute, 23),
+				reading(time.Minute, 20),
+				reading(time.Hour+30*time.Minute, 22),
+				reading(time.Hour+time.Minute, 21),
+			})).To(Succeed())
+		})
+
+		It("should return the points in the range in time order", func() {
+			points, err := store.Range("", "readings", base.Add(time.Minute), base.Add(2*time.Hour+time.Minute), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			values := []interface{}{}
+			for _, p := range points {
+				values = append(values, p.Values["temperature"])
+			}
+			Expect(values).To(Equal([]interface{}{float64(20), float64(21), float64(22)}))
+			Expect(points[0].Time).To(Equal(base.Add(time.Minute)))
+		})
+
+		It("should stop at the limit", func() {
+			points, err := store.Range("", "readings", base, base.Add(3*time.Hour), 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(points).To(HaveLen(2))
+			Expect(points[1].Values["temperature"]).To(Equal(float64(21)))
+		})
+
+		It("should reject ranges that end before they start", func() {
+			_, err := store.Range("", "readings", base.Add(time.Hour), base, 0)
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("Append", func() {
+		It("should reject collections that aren't series", func() {
+			err := store.Append("", "orders", []Point{reading(0, 1)})
+			Expect(errors.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("should reject points older than the retention", func() {
+			err := store.Append("", "readings", []Point{reading(-48*time.Hour, 1)})
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should reject points using the reserved time field", func() {
+			err := store.Append("", "readings", []Point{{Time: base, Values: map[string]interface{}{TimeField: "now"}}})
+			Expect(errors.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("Rollup", func() {
+		It("should aggregate the field over each bucket", func() {
+			Expect(store.Append("", "readings", []Point{
+				reading(time.Minute, 20),
+				reading(2*time.Minute, 24),
+				reading(time.Hour+time.Minute, 30),
+			})).To(Succeed())
+
+			rollups, err := store.Rollup("", "readings", "temperature", base, base.Add(2*time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rollups).To(Equal([]Rollup{
+				{Start: base, End: base.Add(time.Hour), Count: 2, Sum: 44, Min: 20, Max: 24, Avg: 22},
+				{Start: base.Add(time.Hour), End: base.Add(2 * time.Hour), Count: 1, Sum: 30, Min: 30, Max: 30, Avg: 30},
+			}))
+		})
+	})
+
+	Context("Tenancy", func() {
+		It("should only read the points of the tenant", func() {
+			Expect(store.Append("acme", "readings", []Point{reading(time.Minute, 20)})).To(Succeed())
+			Expect(store.Append("globex", "readings", []Point{reading(2*time.Minute, 30)})).To(Succeed())
+			Expect(store.Append("", "readings", []Point{reading(3*time.Minute, 40)})).To(Succeed())
+
+			points, err := store.Range("acme", "readings", base, base.Add(time.Hour), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(points).To(HaveLen(1))
+			Expect(points[0].Values["temperature"]).To(Equal(float64(20)))
+
+			rollups, err := store.Rollup("", "readings", "temperature", base, base.Add(time.Hour))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rollups).To(HaveLen(1))
+			Expect(rollups[0].Count).To(Equal(int64(1)))
+			Expect(rollups[0].Sum).To(Equal(float64(40)))
+		})
+
+		It("should expire the buckets of every tenant", func() {
+			Expect(store.Append("acme", "readings", []Point{reading(time.Minute, 20)})).To(Succeed())
+
+			store.now = func() time.Time { return base.Add(25 * time.Hour) }
+			Expect(store.Expire()).To(Succeed())
+
+			store.now = func() time.Time { return base }
+			points, err := store.Range("acme", "readings", base, base.Add(time.Hour), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(points).To(BeEmpty())
+		})
+	})
+
+	Context("Expire", func() {
+		It("should delete buckets older than the retention with their points", func() {
+			Expect(store.Append("", "readings", []Point{reading(time.Minute, 20), reading(time.Hour+time.Minute, 21)})).To(Succeed())
+
+			store.now = func() time.Time { return base.Add(25 * time.Hour) }
+			Expect(store.Expire()).To(Succeed())
+
+			store.now = func() time.Time { return base }
+			points, err := store.Range("", "readings", base, base.Add(2*time.Hour), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(points).To(HaveLen(1))
+			Expect(points[0].Values["temperature"]).To(Equal(float64(21)))
+		})
+	})
+})
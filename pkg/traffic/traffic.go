@@ -0,0 +1,186 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package traffic splits the requests made to routes between their handlers and other targets,
+// mirroring a share of them to shadow targets and routing a weighted share to canary targets
+package traffic
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	// ShadowHeader - tells handlers a request is a mirrored copy, whose response is discarded
+	ShadowHeader = "X-Nitric-Shadow"
+	// CanaryHeader - tells handlers a request was routed to them as a canary
+	CanaryHeader = "X-Nitric-Canary"
+)
+
+// Target - where a share of the requests made to a route is sent
+type Target struct {
+	// The path prefix of the handlers requests are routed to, empty if they're sent to an endpoint
+	Path string
+	// The endpoint requests are sent to, with its path replacing the rule's prefix. Nil if they're routed to handlers
+	Endpoint *url.URL
+}
+
+// rewrite - replaces the prefix of the cleaned path with the prefix of the target
+func rewrite(targetPrefix string, prefix string, path string) string {
+	rewritten := strings.TrimSuffix(targetPrefix, "/") + strings.TrimPrefix(utils.CleanPath(path), strings.TrimSuffix(utils.CleanPath(prefix), "/"))
+	if rewritten == "" {
+		return "/"
+	}
+
+	return rewritten
+}
+
+// Rule - sends a percentage of the requests made under a path prefix to a target
+type Rule struct {
+	Prefix  string
+	Target  *Target
+	Percent float64
+	// The request header whose value consistently selects requests, e.g. a user id header so each user sees
+	// a single version. Requests are selected at random if it's empty, or the request doesn't have the header
+	Sticky string
+}
+
+// Matches - returns true if the path is under the rule's prefix
+func (r *Rule) Matches(path string) bool {
+	return utils.HasPathPrefix(path, r.Prefix)
+}
+
+// Rewrite - returns the path a request is routed to by the rule's target
+func (r *Rule) Rewrite(path string) string {
+	return rewrite(r.Target.Path, r.Prefix, path)
+}
+
+// Url - returns the URL a request is sent to by the rule's endpoint target
+func (r *Rule) Url(path string, query string) string {
+	u := *r.Target.Endpoint
+	u.Path = rewrite(u.Path, r.Prefix, path)
+	u.RawPath = ""
+	u.RawQuery = query
+
+	return u.String()
+}
+
+// Selects - returns true if the request is one of the percentage sent to the target.
+// roll returns a random number in [0, 100)
+func (r *Rule) Selects(header map[string][]string, roll func() float64) bool {
+	if r.Sticky != "" {
+		for k, v := range header {
+			if strings.EqualFold(k, r.Sticky) && len(v) > 0 {
+				h := fnv.New32a()
+				_, _ = h.Write([]byte(v[0]))
+				return float64(h.Sum32()%10000)/100 < r.Percent
+			}
+		}
+	}
+
+	return roll() < r.Percent
+}
+
+// Match - returns the rule with the longest prefix matching the path, or nil if none match
+func Match(rules []*Rule, path string) *Rule {
+	var match *Rule
+	for _, r := range rules {
+		if r.Matches(path) && (match == nil || len(r.Prefix) > len(match.Prefix)) {
+			match = r
+		}
+	}
+
+	return match
+}
+
+// Config - the shadow and canary rules applied to requests
+type Config struct {
+	// Mirror a percentage of requests to their targets, discarding the responses
+	Shadows []*Rule
+	// Route a percentage of requests to their targets instead of their handlers
+	Canaries []*Rule
+}
+
+func parseTarget(value string) (*Target, error) {
+	if strings.HasPrefix(value, "/") {
+		return &Target{Path: "/" + strings.Trim(value, "/")}, nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("expected a path or http(s) URL, got %s", value)
+	}
+
+	return &Target{Endpoint: u}, nil
+}
+
+// ParseRules - parses a traffic rule configuration string, rules are separated by commas
+// and followed by semicolon separated attributes.
+// e.g. "/orders;target=/orders-v2;percent=5;sticky=X-User-Id" routes 5% of users' requests to /orders to the /orders-v2 handlers
+func ParseRules(config string) ([]*Rule, error) {
+	rules := make([]*Rule, 0)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ";")
+		r := &Rule{
+			Prefix: "/" + strings.Trim(strings.TrimSpace(parts[0]), "/"),
+		}
+
+		for _, attr := range parts[1:] {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid attribute %s for route %s, expected key=value", attr, r.Prefix)
+			}
+
+			key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+			var err error
+			switch key {
+			case "target":
+				r.Target, err = parseTarget(value)
+			case "percent":
+				r.Percent, err = strconv.ParseFloat(value, 64)
+				if err == nil && (r.Percent < 0 || r.Percent > 100) {
+					err = fmt.Errorf("expected a percentage between 0 and 100")
+				}
+			case "sticky":
+				r.Sticky = value
+			default:
+				err = fmt.Errorf("unknown attribute")
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute %s for route %s: %v", key, r.Prefix, err)
+			}
+		}
+
+		if r.Target == nil {
+			return nil, fmt.Errorf("route %s has no target", r.Prefix)
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
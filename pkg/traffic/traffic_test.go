@@ -0,0 +1,97 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/traffic"
+)
+
+var _ = Describe("Traffic", func() {
+	Context("ParseRules", func() {
+		It("should parse path and endpoint targets", func() {
+			rules, err := traffic.ParseRules("/orders/;target=/orders-v2;percent=5;sticky=X-User-Id, /;target=https://shadow.example.com/api;percent=100")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rules).To(HaveLen(2))
+
+			Expect(rules[0].Prefix).To(Equal("/orders"))
+			Expect(rules[0].Target.Path).To(Equal("/orders-v2"))
+			Expect(rules[0].Percent).To(Equal(5.0))
+			Expect(rules[0].Sticky).To(Equal("X-User-Id"))
+
+			Expect(rules[1].Prefix).To(Equal("/"))
+			Expect(rules[1].Target.Endpoint.Host).To(Equal("shadow.example.com"))
+		})
+
+		It("should refuse rules without a target", func() {
+			_, err := traffic.ParseRules("/orders;percent=5")
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should refuse invalid percentages", func() {
+			_, err := traffic.ParseRules("/orders;target=/v2;percent=150")
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should refuse targets that aren't paths or http URLs", func() {
+			_, err := traffic.ParseRules("/orders;target=ftp://example.com")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Rule", func() {
+		rules, _ := traffic.ParseRules("/orders;target=/orders-v2;percent=50;sticky=X-User-Id,/;target=https://shadow.example.com/api;percent=10")
+
+		It("should match the longest prefix", func() {
+			Expect(traffic.Match(rules, "/orders/1")).To(Equal(rules[0]))
+			Expect(traffic.Match(rules, "/ordersx")).To(Equal(rules[1]))
+		})
+
+		It("should match and rewrite paths with doubled slashes or dot segments", func() {
+			Expect(traffic.Match(rules, "//orders/1")).To(Equal(rules[0]))
+			Expect(traffic.Match(rules, "/./orders")).To(Equal(rules[0]))
+			Expect(rules[0].Rewrite("//orders//1")).To(Equal("/orders-v2/1"))
+			Expect(rules[1].Url("/./orders/1", "")).To(Equal("https://shadow.example.com/api/orders/1"))
+		})
+
+		It("should rewrite paths to the target", func() {
+			Expect(rules[0].Rewrite("/orders/1")).To(Equal("/orders-v2/1"))
+			Expect(rules[1].Url("/orders/1", "page=2")).To(Equal("https://shadow.example.com/api/orders/1?page=2"))
+		})
+
+		It("should select requests at random without a sticky header", func() {
+			Expect(rules[1].Selects(nil, func() float64 { return 9.9 })).To(BeTrue())
+			Expect(rules[1].Selects(nil, func() float64 { return 10 })).To(BeFalse())
+		})
+
+		It("should consistently select requests with the same sticky header", func() {
+			selected := 0
+			for i := 0; i < 1000; i++ {
+				header := map[string][]string{"x-user-id": {fmt.Sprintf("user-%d", i)}}
+				first := rules[0].Selects(header, func() float64 { return 0 })
+				Expect(rules[0].Selects(header, func() float64 { return 99 })).To(Equal(first))
+				if first {
+					selected++
+				}
+			}
+
+			Expect(selected).To(BeNumerically("~", 500, 75))
+		})
+	})
+})
@@ -0,0 +1,289 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transform runs the items written to buckets through built-in image transformers, such as resizing and
+// format conversion, declared per bucket and key prefix
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// DefaultOutputPrefix - the prefix transformed items are written under, unless configured otherwise
+	DefaultOutputPrefix = "transformed/"
+	// MaxPixels - items with more pixels than this aren't transformed, so a single item can't exhaust the membrane's memory
+	MaxPixels = 50000000
+	// jpegQuality - the quality items are encoded as JPEGs with
+	jpegQuality = 90
+)
+
+// ErrNotImage - returned when an item can't be transformed, as it isn't an image in a supported format or is too large
+var ErrNotImage = errors.New("item is not a supported image")
+
+var encoders = map[string]func(io.Writer, image.Image) error{
+	"jpeg": func(w io.Writer, img image.Image) error {
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	},
+	"png":  png.Encode,
+	"gif":  func(w io.Writer, img image.Image) error { return gif.Encode(w, img, nil) },
+	"bmp":  bmp.Encode,
+	"tiff": func(w io.Writer, img image.Image) error { return tiff.Encode(w, img, nil) },
+}
+
+// extensions - the formats of the extensions of item keys
+var extensions = map[string]string{
+	".jpg":  "jpeg",
+	".jpeg": "jpeg",
+	".png":  "png",
+	".gif":  "gif",
+	".bmp":  "bmp",
+	".tif":  "tiff",
+	".tiff": "tiff",
+}
+
+// Transformer - a built-in transformation of an image
+type Transformer interface {
+	Transform(img image.Image) image.Image
+}
+
+// resizer - scales images down to fit within a width and height, keeping their aspect ratio. Zero leaves a dimension unbounded
+type resizer struct {
+	width  int
+	height int
+}
+
+func (r *resizer) Transform(img image.Image) image.Image {
+	bounds := img.Bounds()
+	scale := 1.0
+	if r.width > 0 && bounds.Dx() > r.width {
+		scale = float64(r.width) / float64(bounds.Dx())
+	}
+	if r.height > 0 && bounds.Dy() > r.height {
+		if s := float64(r.height) / float64(bounds.Dy()); s < scale {
+			scale = s
+		}
+	}
+
+	// images are never scaled up
+	if scale == 1.0 {
+		return img
+	}
+
+	width, height := int(float64(bounds.Dx())*scale), int(float64(bounds.Dy())*scale)
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+// Rule - transforms the items written to a bucket under a key prefix
+type Rule struct {
+	Bucket string
+	// Prefix - the prefix of the keys of the items transformed, every item in the bucket if empty
+	Prefix       string
+	Transformers []Transformer
+	// Format - the format transformed items are converted to, the format of their key's extension if empty
+	Format string
+}
+
+// Matches - returns true if the rule transforms the item
+func (r *Rule) Matches(bucket string, key string) bool {
+	return r.Bucket == bucket && strings.HasPrefix(key, r.Prefix)
+}
+
+// OutputFormat - the format the item is written in once transformed, its key's format unless the rule converts it.
+// Items whose key doesn't have the extension of an encodable format are written as PNGs
+func (r *Rule) OutputFormat(key string) string {
+	if r.Format != "" {
+		return r.Format
+	}
+
+	if format, ok := extensions[strings.ToLower(path.Ext(key))]; ok {
+		return format
+	}
+
+	return "png"
+}
+
+// Apply - transforms the content of an item, returning it encoded in the output format of its key.
+// Returns ErrNotImage if the content isn't an image that can be transformed
+func (r *Rule) Apply(key string, content []byte) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrNotImage
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, ErrNotImage
+	}
+
+	for _, t := range r.Transformers {
+		img = t.Transform(img)
+	}
+
+	out := &bytes.Buffer{}
+	if err := encoders[r.OutputFormat(key)](out, img); err != nil {
+		return nil, fmt.Errorf("error encoding transformed item: %v", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// Config - the bucket transform rules, and where transformed items are written
+type Config struct {
+	Rules []*Rule
+	// OutputPrefix - transformed items are written to the same bucket, with their key under this prefix
+	OutputPrefix string
+}
+
+// Match - returns the rule with the longest prefix that transforms the item, or nil if none do.
+// Transformed items aren't transformed again
+func (c *Config) Match(bucket string, key string) *Rule {
+	if strings.HasPrefix(key, c.OutputPrefix) {
+		return nil
+	}
+
+	var match *Rule
+	for _, r := range c.Rules {
+		if r.Matches(bucket, key) && (match == nil || len(r.Prefix) > len(match.Prefix)) {
+			match = r
+		}
+	}
+
+	return match
+}
+
+// Output - returns the key an item is written to once transformed by the rule,
+// with its extension replaced if the rule converts it to another format
+func (c *Config) Output(rule *Rule, key string) string {
+	format := rule.OutputFormat(key)
+
+	ext := path.Ext(key)
+	if extensions[strings.ToLower(ext)] != format {
+		key = strings.TrimSuffix(key, ext) + "." + format
+	}
+
+	return c.OutputPrefix + key
+}
+
+func parseTransformer(value string, rule *Rule) error {
+	name, arg := value, ""
+	if i := strings.Index(value, ":"); i >= 0 {
+		name, arg = value[:i], value[i+1:]
+	}
+
+	switch strings.TrimSpace(name) {
+	case "resize":
+		dims := strings.Split(strings.TrimSpace(arg), "x")
+		if len(dims) != 2 {
+			return fmt.Errorf("invalid resize %s, expected resize:<width>x<height>", value)
+		}
+
+		width, werr := strconv.Atoi(dims[0])
+		height, herr := strconv.Atoi(dims[1])
+		if werr != nil || herr != nil || width < 0 || height < 0 || width+height == 0 {
+			return fmt.Errorf("invalid resize %s, expected a width and height of at least one, or zero for either to keep its aspect", value)
+		}
+
+		rule.Transformers = append(rule.Transformers, &resizer{width: width, height: height})
+	case "format":
+		format := strings.ToLower(strings.TrimSpace(arg))
+		if format == "jpg" {
+			format = "jpeg"
+		}
+
+		if _, ok := encoders[format]; !ok {
+			return fmt.Errorf("invalid format %s, expected jpeg, png, gif, bmp or tiff", value)
+		}
+
+		rule.Format = format
+	default:
+		return fmt.Errorf("unknown transformer %s, expected resize or format", value)
+	}
+
+	return nil
+}
+
+// ParseConfig - parses semicolon separated rules, each a bucket optionally followed by /<key prefix>, then = and
+// comma separated transformers, e.g. "images/uploads/=resize:1024x0,format:jpeg;avatars=resize:128x128".
+// Transformed items are written under the output prefix, DefaultOutputPrefix if it's empty
+func ParseConfig(value string, outputPrefix string) (*Config, error) {
+	if outputPrefix == "" {
+		outputPrefix = DefaultOutputPrefix
+	}
+
+	config := &Config{
+		Rules:        []*Rule{},
+		OutputPrefix: outputPrefix,
+	}
+
+	for _, r := range strings.Split(value, ";") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid transform %s, expected <bucket>[/<prefix>]=<transformer>[,<transformer>...]", r)
+		}
+
+		target := strings.SplitN(strings.TrimSpace(parts[0]), "/", 2)
+		rule := &Rule{Bucket: target[0], Transformers: []Transformer{}}
+		if rule.Bucket == "" {
+			return nil, fmt.Errorf("invalid transform %s, a bucket is required", r)
+		}
+		if len(target) == 2 {
+			rule.Prefix = target[1]
+		}
+
+		if strings.HasPrefix(rule.Prefix, outputPrefix) {
+			return nil, fmt.Errorf("invalid transform %s, transformed items are written under %s so they can't be transformed again", r, outputPrefix)
+		}
+
+		for _, t := range strings.Split(parts[1], ",") {
+			if err := parseTransformer(t, rule); err != nil {
+				return nil, err
+			}
+		}
+
+		config.Rules = append(config.Rules, rule)
+	}
+
+	return config, nil
+}
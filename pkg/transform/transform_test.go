@@ -0,0 +1,126 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform_test
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/transform"
+)
+
+func pngImage(width int, height int) []byte {
+	out := &bytes.Buffer{}
+	_ = png.Encode(out, image.NewRGBA(image.Rect(0, 0, width, height)))
+	return out.Bytes()
+}
+
+var _ = Describe("Transform", func() {
+	Context("ParseConfig", func() {
+		It("should parse rules for buckets and key prefixes", func() {
+			config, err := transform.ParseConfig("images/uploads/=resize:1024x0,format:jpg; avatars=resize:128x128", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(config.OutputPrefix).To(Equal(transform.DefaultOutputPrefix))
+			Expect(config.Rules).To(HaveLen(2))
+
+			Expect(config.Rules[0].Bucket).To(Equal("images"))
+			Expect(config.Rules[0].Prefix).To(Equal("uploads/"))
+			Expect(config.Rules[0].Format).To(Equal("jpeg"))
+			Expect(config.Rules[0].Transformers).To(HaveLen(1))
+
+			Expect(config.Rules[1].Bucket).To(Equal("avatars"))
+			Expect(config.Rules[1].Prefix).To(Equal(""))
+		})
+
+		It("should reject unknown transformers and formats", func() {
+			_, err := transform.ParseConfig("images=blur:5", "")
+			Expect(err).To(HaveOccurred())
+
+			_, err = transform.ParseConfig("images=format:webp", "")
+			Expect(err).To(HaveOccurred())
+
+			_, err = transform.ParseConfig("images=resize:0x0", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject rules for transformed items", func() {
+			_, err := transform.ParseConfig("images/thumbs/=resize:64x64", "thumbs/")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Match", func() {
+		config, _ := transform.ParseConfig("images=resize:512x512;images/uploads/=format:png", "")
+
+		It("should match the rule with the longest prefix", func() {
+			Expect(config.Match("images", "uploads/cat.jpg")).To(Equal(config.Rules[1]))
+			Expect(config.Match("images", "cat.jpg")).To(Equal(config.Rules[0]))
+			Expect(config.Match("documents", "cat.jpg")).To(BeNil())
+		})
+
+		It("should not match transformed items", func() {
+			Expect(config.Match("images", "transformed/cat.jpg")).To(BeNil())
+		})
+	})
+
+	Context("Output", func() {
+		config, _ := transform.ParseConfig("images=resize:512x512;images/uploads/=format:png", "")
+
+		It("should write items under the output prefix", func() {
+			Expect(config.Output(config.Rules[0], "cat.jpg")).To(Equal("transformed/cat.jpg"))
+		})
+
+		It("should replace the extension of converted items", func() {
+			Expect(config.Output(config.Rules[1], "uploads/cat.jpg")).To(Equal("transformed/uploads/cat.png"))
+		})
+
+		It("should write items without an encodable extension as PNGs", func() {
+			Expect(config.Output(config.Rules[0], "cat.webp")).To(Equal("transformed/cat.png"))
+		})
+	})
+
+	Context("Apply", func() {
+		config, _ := transform.ParseConfig("images=resize:100x0,format:jpeg", "")
+
+		It("should resize and convert images", func() {
+			out, err := config.Rules[0].Apply("cat.png", pngImage(400, 200))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			img, err := jpeg.Decode(bytes.NewReader(out))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(img.Bounds().Dx()).To(Equal(100))
+			Expect(img.Bounds().Dy()).To(Equal(50))
+		})
+
+		It("should not scale images up", func() {
+			out, err := config.Rules[0].Apply("cat.png", pngImage(40, 20))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			img, err := jpeg.Decode(bytes.NewReader(out))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(img.Bounds().Dx()).To(Equal(40))
+		})
+
+		It("should report items that aren't images", func() {
+			_, err := config.Rules[0].Apply("notes.txt", []byte("not an image"))
+			Expect(err).To(Equal(transform.ErrNotImage))
+		})
+	})
+})
@@ -14,11 +14,32 @@
 
 package triggers
 
+import "time"
+
 // Event - A nitric event that has come from a trigger source
 type Event struct {
 	ID      string
 	Topic   string
 	Payload []byte
+	// When the event must be handled by, zero if there's no deadline
+	Deadline time.Time
+	// OrderingKey - events with the same ordering key are handled serially by the same worker
+	OrderingKey string
+	// Attempt - the delivery attempt reported by the provider, starting at 1, zero if it isn't reported
+	Attempt int
+	// Metadata - where the event was delivered from
+	Metadata Metadata
+	// IdempotencyKey - the key the publisher identified the event with, empty if none was given
+	IdempotencyKey string
+}
+
+// DedupeKey - returns the key duplicates of the event share, its idempotency key if the publisher gave one, otherwise its ID
+func (e *Event) DedupeKey() string {
+	if e.IdempotencyKey != "" {
+		return e.IdempotencyKey
+	}
+
+	return e.ID
 }
 
 func (*Event) GetTriggerType() TriggerType {
@@ -0,0 +1,92 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventStreamContentType - the content type of responses that stream server-sent events
+const EventStreamContentType = "text/event-stream"
+
+// ServerSentEvent - an event sent to the client of an event stream response
+type ServerSentEvent struct {
+	// The id the client reconnects with in its Last-Event-ID header
+	ID string
+	// The event type, the client dispatches a message event if empty
+	Event string
+	// The event data, may span multiple lines
+	Data string
+	// How long the client waits before reconnecting, unchanged if zero
+	Retry time.Duration
+}
+
+// Encode - returns the event in the text/event-stream format
+func (e *ServerSentEvent) Encode() []byte {
+	buf := &bytes.Buffer{}
+
+	if e.ID != "" {
+		buf.WriteString("id: " + strings.ReplaceAll(e.ID, "\n", "") + "\n")
+	}
+
+	if e.Event != "" {
+		buf.WriteString("event: " + strings.ReplaceAll(e.Event, "\n", "") + "\n")
+	}
+
+	if e.Retry > 0 {
+		buf.WriteString("retry: " + strconv.FormatInt(e.Retry.Milliseconds(), 10) + "\n")
+	}
+
+	if e.Data != "" {
+		for _, line := range strings.Split(strings.ReplaceAll(e.Data, "\r\n", "\n"), "\n") {
+			buf.WriteString("data: " + line + "\n")
+		}
+	}
+
+	buf.WriteString("\n")
+
+	return buf.Bytes()
+}
+
+// EventStream - the events of an event stream response, sent to its client until the worker ends the stream
+type EventStream struct {
+	// Receives the events of the stream, closed when the worker ends the stream
+	Events <-chan *ServerSentEvent
+
+	closeOnce sync.Once
+	close     func()
+}
+
+// Close - releases the stream once its client is done with it, telling the worker to stop sending events
+// if the client disconnected before the stream ended. It must be called whether or not the stream has ended
+func (s *EventStream) Close() {
+	s.closeOnce.Do(func() {
+		if s.close != nil {
+			s.close()
+		}
+	})
+}
+
+// NewEventStream - creates an event stream receiving events, close is called once when the stream is closed
+func NewEventStream(events <-chan *ServerSentEvent, close func()) *EventStream {
+	return &EventStream{
+		Events: events,
+		close:  close,
+	}
+}
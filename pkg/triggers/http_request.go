@@ -16,6 +16,7 @@ package triggers
 
 import (
 	"strings"
+	"time"
 
 	"github.com/valyala/fasthttp"
 )
@@ -33,6 +34,22 @@ type HttpRequest struct {
 	Query map[string][]string
 	// Path parameters
 	Params map[string]string
+	// When the request must be handled by, zero if there's no deadline
+	Deadline time.Time
+	// The GraphQL operation of a request to the gateway's GraphQL path, nil for other requests
+	Graphql *GraphqlOperation
+	// Metadata - where the request was delivered from
+	Metadata Metadata
+}
+
+// GraphqlOperation - a GraphQL operation parsed from a HTTP request
+type GraphqlOperation struct {
+	// The operation document
+	Query string
+	// The name of the operation to execute in the document
+	OperationName string
+	// The variables of the operation
+	Variables map[string]interface{}
 }
 
 func (*HttpRequest) GetTriggerType() TriggerType {
@@ -71,5 +88,9 @@ func FromHttpRequest(ctx *fasthttp.RequestCtx) *HttpRequest {
 		Method: string(ctx.Method()),
 		Path:   string(ctx.URI().PathOriginal()),
 		Query:  queryArgs,
+		Metadata: Metadata{
+			PublishTime:  ctx.Time(),
+			TraceContext: TraceContextFromHeader(headerCopy),
+		},
 	}
 }
@@ -30,6 +30,8 @@ type HttpResponse struct {
 	Body []byte
 	// The original method
 	StatusCode int
+	// The events of a text/event-stream response, nil if the response isn't streamed
+	Events *EventStream
 }
 
 // FromHttpRequest (constructs a HttpRequest source type from a HttpRequest)
@@ -0,0 +1,98 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// TraceParentKey - the W3C trace context header or attribute identifying the trace a trigger is part of
+	TraceParentKey = "traceparent"
+	// TraceStateKey - the W3C trace context header or attribute carrying vendor specific trace state
+	TraceStateKey = "tracestate"
+
+	// SourceHeader - the header the source of a trigger is given to HTTP workers in
+	SourceHeader = "X-Nitric-Delivery-Source"
+	// ProviderIDHeader - the header the provider ID of a trigger is given to HTTP workers in
+	ProviderIDHeader = "X-Nitric-Provider-Id"
+	// AttemptHeader - the header the delivery attempt of an event is given to HTTP workers in
+	AttemptHeader = "X-Nitric-Delivery-Attempt"
+	// PublishTimeHeader - the header the publish time of a trigger is given to HTTP workers in, as an RFC 3339 timestamp
+	PublishTimeHeader = "X-Nitric-Publish-Time"
+
+	// IdempotencyKeyAttribute - the event or task attribute publishers can set to identify duplicates,
+	// used for deduplication in place of the event or task ID
+	IdempotencyKeyAttribute = "x-nitric-idempotency-key"
+)
+
+// Metadata - where a trigger came from, as reported by the gateway or queue that delivered it.
+//
+// Fields a provider doesn't report are left empty, handlers should treat them as unknown
+type Metadata struct {
+	// Source - the provider resource the trigger was delivered from, e.g. a subscription, topic ARN or gateway
+	Source string
+	// ProviderID - the provider's ID for the delivery, e.g. a message or request ID.
+	// Redeliveries of the same message have the same ID, so it can be used to detect duplicates
+	ProviderID string
+	// PublishTime - when the trigger was published or received by the provider, zero if it isn't reported
+	PublishTime time.Time
+	// TraceContext - the W3C trace context propagated with the trigger, keyed by traceparent and tracestate
+	TraceContext map[string]string
+}
+
+// Age - how long ago the trigger was published, zero if the publish time isn't known
+func (m Metadata) Age(now time.Time) time.Duration {
+	if m.PublishTime.IsZero() {
+		return 0
+	}
+
+	return now.Sub(m.PublishTime)
+}
+
+// TraceContextFrom - returns the W3C trace context in the given headers or attributes, nil if there is none.
+//
+// Keys are matched case insensitively, as they are HTTP headers on some providers and message attributes on others
+func TraceContextFrom(values map[string]string) map[string]string {
+	var traceContext map[string]string
+
+	for k, v := range values {
+		key := strings.ToLower(k)
+		if v == "" || (key != TraceParentKey && key != TraceStateKey) {
+			continue
+		}
+
+		if traceContext == nil {
+			traceContext = map[string]string{}
+		}
+		traceContext[key] = v
+	}
+
+	return traceContext
+}
+
+// TraceContextFromHeader - returns the W3C trace context in the given HTTP headers, nil if there is none
+func TraceContextFromHeader(header map[string][]string) map[string]string {
+	values := map[string]string{}
+
+	for k, v := range header {
+		if len(v) > 0 {
+			values[k] = v[0]
+		}
+	}
+
+	return TraceContextFrom(values)
+}
@@ -14,6 +14,25 @@
 
 package triggers
 
+import "time"
+
 type Trigger interface {
 	GetTriggerType() TriggerType
 }
+
+// DeadlineHeader - the header the deadline of a trigger is given to HTTP workers in, as an RFC 3339 timestamp
+const DeadlineHeader = "X-Nitric-Deadline"
+
+// EarliestDeadline - returns the earlier of a deadline and the time the timeout elapses from now.
+// A zero deadline or timeout means there is no limit
+func EarliestDeadline(deadline time.Time, timeout time.Duration) time.Time {
+	if timeout <= 0 {
+		return deadline
+	}
+
+	if timeoutDeadline := time.Now().Add(timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+		return timeoutDeadline
+	}
+
+	return deadline
+}
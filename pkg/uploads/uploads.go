@@ -0,0 +1,408 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uploads
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// DefaultCollection - the document collection uploads are recorded in, unless configured otherwise
+const DefaultCollection = "nitric-uploads"
+
+// Upload - an item being uploaded in parts. Uploads are recorded in the document plugin,
+// so they can be resumed by clients after a dropped connection or a restart of the membrane
+type Upload struct {
+	ID     string
+	Bucket string
+	Key    string
+	// Session - the storage provider's ID for the upload, e.g. an S3 upload ID or Cloud Storage session URI
+	Session string
+	Parts   []*storage.UploadPart
+	// Final - the final part has been uploaded, so the upload can only be committed
+	Final   bool
+	Created time.Time
+}
+
+// Size - the number of bytes uploaded, the offset of the next part
+func (u *Upload) Size() int64 {
+	size := int64(0)
+	for _, p := range u.Parts {
+		size += p.Size
+	}
+
+	return size
+}
+
+// Manager - Uploads items in parts, appending each part in order then committing the item once every part is uploaded.
+//
+// Parts other than the last must be at least storage.MinUploadPartSize and a multiple of storage.UploadPartAlignment,
+// a smaller or unaligned part is the final part. Parts of an upload must be appended one at a time.
+type Manager struct {
+	documents  document.DocumentService
+	storage    storage.StorageService
+	collection *document.Collection
+	now        func() time.Time
+}
+
+func (m *Manager) key(id string) *document.Key {
+	return &document.Key{
+		Collection: m.collection,
+		Id:         id,
+	}
+}
+
+// Begin - starts an upload of the item
+func (m *Manager) Begin(bucket string, key string) (*Upload, error) {
+	newErr := errors.ErrorsWithScope(
+		"Uploads.Begin",
+		map[string]interface{}{
+			"bucket": bucket,
+			"key":    key,
+		},
+	)
+
+	session, err := m.storage.BeginUpload(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &Upload{
+		ID:      uuid.New().String(),
+		Bucket:  bucket,
+		Key:     key,
+		Session: session,
+		Parts:   []*storage.UploadPart{},
+		Created: m.now().UTC(),
+	}
+
+	if err := m.save(upload); err != nil {
+		// the upload can't be resumed without its record
+		_ = m.storage.AbortUpload(bucket, key, session)
+
+		return nil, newErr(
+			codes.Internal,
+			"unable to record upload",
+			err,
+		)
+	}
+
+	return upload, nil
+}
+
+// Get - returns an upload that hasn't been committed or aborted
+func (m *Manager) Get(id string) (*Upload, error) {
+	newErr := errors.ErrorsWithScope(
+		"Uploads.Get",
+		map[string]interface{}{
+			"upload": id,
+		},
+	)
+
+	if id == "" {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide an upload id",
+			nil,
+		)
+	}
+
+	doc, err := m.documents.Get(m.key(id))
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			return nil, newErr(
+				codes.NotFound,
+				"upload not found, it may have been committed or aborted",
+				err,
+			)
+		}
+
+		return nil, newErr(
+			codes.Internal,
+			"unable to read upload",
+			err,
+		)
+	}
+
+	upload, err := fromContent(id, doc.Content)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"invalid upload record",
+			err,
+		)
+	}
+
+	return upload, nil
+}
+
+// Append - uploads the next part of an upload, starting at offset bytes into the item.
+// Appending a part that's already been uploaded, e.g. when retrying after its response was lost, returns the upload unchanged
+func (m *Manager) Append(id string, offset int64, data []byte) (*Upload, error) {
+	newErr := errors.ErrorsWithScope(
+		"Uploads.Append",
+		map[string]interface{}{
+			"upload":   id,
+			"offset":   offset,
+			"data.len": len(data),
+		},
+	)
+
+	if len(data) == 0 {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"provide non-empty data",
+			nil,
+		)
+	}
+
+	upload, err := m.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	size := upload.Size()
+	if offset < size && len(upload.Parts) > 0 {
+		last := upload.Parts[len(upload.Parts)-1]
+		if last.Offset == offset && last.Size == int64(len(data)) {
+			return upload, nil
+		}
+	}
+
+	if offset != size {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			fmt.Sprintf("parts must be appended in order, expected offset %d", size),
+			nil,
+		)
+	}
+
+	if upload.Final {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"the final part has been uploaded, commit the upload",
+			nil,
+		)
+	}
+
+	if len(upload.Parts) == storage.MaxUploadParts {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			fmt.Sprintf("uploads can have at most %d parts", storage.MaxUploadParts),
+			nil,
+		)
+	}
+
+	final := storage.IsFinalUploadPart(len(data))
+	part, err := m.storage.UploadPart(upload.Bucket, upload.Key, upload.Session, &storage.UploadPart{
+		Number: len(upload.Parts) + 1,
+		Offset: offset,
+	}, data, final)
+	if err != nil {
+		return nil, err
+	}
+
+	upload.Parts = append(upload.Parts, part)
+	upload.Final = final
+
+	if err := m.save(upload); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to record uploaded part",
+			err,
+		)
+	}
+
+	return upload, nil
+}
+
+// Commit - writes the item from the uploaded parts, completing the upload
+func (m *Manager) Commit(id string) (*Upload, error) {
+	newErr := errors.ErrorsWithScope(
+		"Uploads.Commit",
+		map[string]interface{}{
+			"upload": id,
+		},
+	)
+
+	upload, err := m.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(upload.Parts) == 0 {
+		return nil, newErr(
+			codes.FailedPrecondition,
+			"append at least one part before committing the upload",
+			nil,
+		)
+	}
+
+	if err := storage.ValidateUploadParts(upload.Parts); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"invalid upload record",
+			err,
+		)
+	}
+
+	if err := m.storage.CompleteUpload(upload.Bucket, upload.Key, upload.Session, upload.Parts); err != nil {
+		return nil, err
+	}
+
+	if err := m.documents.Delete(m.key(id)); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"unable to remove completed upload",
+			err,
+		)
+	}
+
+	return upload, nil
+}
+
+// Abort - discards an upload and its uploaded parts
+func (m *Manager) Abort(id string) error {
+	newErr := errors.ErrorsWithScope(
+		"Uploads.Abort",
+		map[string]interface{}{
+			"upload": id,
+		},
+	)
+
+	upload, err := m.Get(id)
+	if err != nil {
+		return err
+	}
+
+	// the provider may have already discarded the upload, e.g. expired sessions
+	if err := m.storage.AbortUpload(upload.Bucket, upload.Key, upload.Session); err != nil && errors.Code(err) != codes.NotFound {
+		return err
+	}
+
+	if err := m.documents.Delete(m.key(id)); err != nil {
+		return newErr(
+			codes.Internal,
+			"unable to remove aborted upload",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (m *Manager) save(upload *Upload) error {
+	parts := make([]interface{}, 0, len(upload.Parts))
+	for _, p := range upload.Parts {
+		parts = append(parts, map[string]interface{}{
+			"number": p.Number,
+			"offset": p.Offset,
+			"size":   p.Size,
+			"etag":   p.ETag,
+		})
+	}
+
+	return m.documents.Set(m.key(upload.ID), map[string]interface{}{
+		"bucket":  upload.Bucket,
+		"key":     upload.Key,
+		"session": upload.Session,
+		"parts":   parts,
+		"final":   upload.Final,
+		"created": upload.Created.Format(time.RFC3339),
+	})
+}
+
+func fromContent(id string, content map[string]interface{}) (*Upload, error) {
+	upload := &Upload{ID: id, Parts: []*storage.UploadPart{}}
+
+	var ok bool
+	if upload.Bucket, ok = content["bucket"].(string); !ok {
+		return nil, fmt.Errorf("upload %s is missing its bucket", id)
+	}
+	if upload.Key, ok = content["key"].(string); !ok {
+		return nil, fmt.Errorf("upload %s is missing its key", id)
+	}
+	if upload.Session, ok = content["session"].(string); !ok {
+		return nil, fmt.Errorf("upload %s is missing its session", id)
+	}
+	upload.Final, _ = content["final"].(bool)
+
+	if created, ok := content["created"].(string); ok {
+		upload.Created, _ = time.Parse(time.RFC3339, created)
+	}
+
+	parts, _ := content["parts"].([]interface{})
+	for _, p := range parts {
+		m, ok := p.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("upload %s has an invalid part", id)
+		}
+
+		etag, _ := m["etag"].(string)
+		upload.Parts = append(upload.Parts, &storage.UploadPart{
+			Number: int(int64Value(m["number"])),
+			Offset: int64Value(m["offset"]),
+			Size:   int64Value(m["size"]),
+			ETag:   etag,
+		})
+	}
+
+	return upload, nil
+}
+
+// int64Value - document providers return numbers as different types
+func int64Value(value interface{}) int64 {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+// New - Creates an upload manager, recording uploads in the given document collection
+func New(documents document.DocumentService, storagePlugin storage.StorageService, collection string) (*Manager, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required to record uploads")
+	}
+
+	if storagePlugin == nil {
+		return nil, fmt.Errorf("a storage plugin is required for uploads")
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &Manager{
+		documents:  documents,
+		storage:    storagePlugin,
+		collection: &document.Collection{Name: collection},
+		now:        time.Now,
+	}, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uploads
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	boltdb_service "github.com/nitrictech/nitric/pkg/plugins/document/boltdb"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+)
+
+// memoryUploads - stores the parts of uploads in memory, writing the item when an upload is completed
+type memoryUploads struct {
+	storage.UnimplementedStoragePlugin
+	sessions map[string][][]byte
+	items    map[string][]byte
+	aborted  []string
+}
+
+func (m *memoryUploads) BeginUpload(bucket string, key string) (string, error) {
+	session := fmt.Sprintf("session-%d", len(m.sessions)+1)
+	m.sessions[session] = [][]byte{}
+	return session, nil
+}
+
+func (m *memoryUploads) UploadPart(bucket string, key string, upload string, part *storage.UploadPart, data []byte, final bool) (*storage.UploadPart, error) {
+	m.sessions[upload] = append(m.sessions[upload], append([]byte{}, data...))
+	return &storage.UploadPart{Number: part.Number, Offset: part.Offset, Size: int64(len(data)), ETag: fmt.Sprintf("etag-%d", part.Number)}, nil
+}
+
+func (m *memoryUploads) CompleteUpload(bucket string, key string, upload string, parts []*storage.UploadPart) error {
+	m.items[bucket+"/"+key] = bytes.Join(m.sessions[upload], nil)
+	delete(m.sessions, upload)
+	return nil
+}
+
+func (m *memoryUploads) AbortUpload(bucket string, key string, upload string) error {
+	m.aborted = append(m.aborted, upload)
+	delete(m.sessions, upload)
+	return nil
+}
+
+var _ = Describe("Uploads", func() {
+	var dir string
+	var store *memoryUploads
+	var manager *Manager
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "uploads")
+		Expect(err).ShouldNot(HaveOccurred())
+		os.Setenv("LOCAL_DB_DIR", dir)
+
+		docs, err := boltdb_service.New()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		store = &memoryUploads{sessions: map[string][][]byte{}, items: map[string][]byte{}}
+		manager, err = New(docs, store, "")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("LOCAL_DB_DIR")
+		os.RemoveAll(dir)
+	})
+
+	part := bytes.Repeat([]byte("a"), storage.MinUploadPartSize)
+
+	It("Should write the item from the appended parts when committed", func() {
+		upload, err := manager.Begin("images", "cat.png")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		upload, err = manager.Append(upload.ID, 0, part)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(upload.Final).To(BeFalse())
+
+		upload, err = manager.Append(upload.ID, upload.Size(), []byte("end"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(upload.Final).To(BeTrue())
+		Expect(upload.Size()).To(Equal(int64(storage.MinUploadPartSize + 3)))
+
+		_, err = manager.Commit(upload.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(store.items["images/cat.png"]).To(Equal(append(append([]byte{}, part...), []byte("end")...)))
+
+		_, err = manager.Get(upload.ID)
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("Should resume uploads from their recorded state", func() {
+		upload, err := manager.Begin("images", "cat.png")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = manager.Append(upload.ID, 0, part)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// a new manager, as after a restart, reads the upload from the document plugin
+		docs, err := boltdb_service.New()
+		Expect(err).ShouldNot(HaveOccurred())
+		resumed, err := New(docs, store, "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		upload, err = resumed.Get(upload.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(upload.Size()).To(Equal(int64(storage.MinUploadPartSize)))
+		Expect(upload.Parts).To(Equal([]*storage.UploadPart{{Number: 1, Size: storage.MinUploadPartSize, ETag: "etag-1"}}))
+	})
+
+	It("Should ignore a retried part and reject parts out of order", func() {
+		upload, err := manager.Begin("images", "cat.png")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = manager.Append(upload.ID, 0, part)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		upload, err = manager.Append(upload.ID, 0, part)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(upload.Parts).To(HaveLen(1))
+
+		_, err = manager.Append(upload.ID, 10, []byte("skipped"))
+		Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	It("Should only commit after the final part", func() {
+		upload, err := manager.Begin("images", "cat.png")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = manager.Commit(upload.ID)
+		Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		upload, err = manager.Append(upload.ID, 0, []byte("small"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = manager.Append(upload.ID, upload.Size(), []byte("more"))
+		Expect(errors.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	It("Should discard aborted uploads", func() {
+		upload, err := manager.Begin("images", "cat.png")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(manager.Abort(upload.ID)).To(Succeed())
+		Expect(store.aborted).To(Equal([]string{upload.Session}))
+
+		_, err = manager.Append(upload.ID, 0, part)
+		Expect(errors.Code(err)).To(Equal(codes.NotFound))
+	})
+})
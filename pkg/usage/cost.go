@@ -0,0 +1,97 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+// bytesPerGB - providers bill data in binary gigabytes
+const bytesPerGB = 1 << 30
+
+// Price - the estimated cost of a runtime API method in US dollars, per call and per gigabyte of its payloads
+type Price struct {
+	PerCall float64
+	// PerRequestGB - e.g. a month of storage for the bytes written, or throughput for the bytes published
+	PerRequestGB  float64
+	PerResponseGB float64
+}
+
+// PriceTable - prices of the plugin operations behind runtime API methods, by the method's full gRPC name.
+// Methods that aren't in the table aren't estimated
+type PriceTable map[string]Price
+
+// Estimate - the estimated cost of the usage of a method, false if the method has no price
+func (t PriceTable) Estimate(method string, counts Counts) (float64, bool) {
+	price, ok := t[method]
+	if !ok {
+		return 0, false
+	}
+
+	return float64(counts.Calls)*price.PerCall +
+		float64(counts.RequestBytes)/bytesPerGB*price.PerRequestGB +
+		float64(counts.ResponseBytes)/bytesPerGB*price.PerResponseGB, true
+}
+
+// The price tables below are list prices in the providers' default regions when they were written. They're for estimating
+// which functions drive spend, not for reconciling bills: free tiers, volume discounts and each provider's billing units
+// (e.g. DynamoDB and Firestore bill per item read or written, batches of up to 10 SQS messages are billed as one request)
+// aren't modelled, so each call is estimated as a single billed request.
+
+// AwsPrices - Secrets Manager, SQS, SNS, S3 Standard and on-demand DynamoDB
+var AwsPrices = PriceTable{
+	"/nitric.secret.v1.SecretService/Access":      {PerCall: 0.05 / 10_000},
+	"/nitric.secret.v1.SecretService/Put":         {PerCall: 0.05 / 10_000},
+	"/nitric.queue.v1.QueueService/Send":          {PerCall: 0.40 / 1_000_000},
+	"/nitric.queue.v1.QueueService/SendBatch":     {PerCall: 0.40 / 1_000_000},
+	"/nitric.queue.v1.QueueService/Receive":       {PerCall: 0.40 / 1_000_000},
+	"/nitric.queue.v1.QueueService/Complete":      {PerCall: 0.40 / 1_000_000},
+	"/nitric.queue.v1.QueueService/CompleteBatch": {PerCall: 0.40 / 1_000_000},
+	"/nitric.event.v1.EventService/Publish":       {PerCall: 0.50 / 1_000_000},
+	"/nitric.storage.v1.StorageService/Write":     {PerCall: 0.005 / 1_000, PerRequestGB: 0.023},
+	"/nitric.storage.v1.StorageService/Read":      {PerCall: 0.0004 / 1_000},
+	"/nitric.storage.v1.StorageService/ListFiles": {PerCall: 0.005 / 1_000},
+	"/nitric.document.v1.DocumentService/Get":     {PerCall: 0.25 / 1_000_000},
+	"/nitric.document.v1.DocumentService/Query":   {PerCall: 0.25 / 1_000_000},
+	"/nitric.document.v1.DocumentService/Set":     {PerCall: 1.25 / 1_000_000},
+	"/nitric.document.v1.DocumentService/Delete":  {PerCall: 1.25 / 1_000_000},
+}
+
+// GcpPrices - Secret Manager, Pub/Sub, Cloud Storage Standard and Firestore
+var GcpPrices = PriceTable{
+	"/nitric.secret.v1.SecretService/Access":      {PerCall: 0.03 / 10_000},
+	"/nitric.queue.v1.QueueService/Send":          {PerRequestGB: 40.0 / 1024},
+	"/nitric.queue.v1.QueueService/SendBatch":     {PerRequestGB: 40.0 / 1024},
+	"/nitric.queue.v1.QueueService/Receive":       {PerResponseGB: 40.0 / 1024},
+	"/nitric.event.v1.EventService/Publish":       {PerRequestGB: 40.0 / 1024},
+	"/nitric.storage.v1.StorageService/Write":     {PerCall: 0.005 / 1_000, PerRequestGB: 0.020},
+	"/nitric.storage.v1.StorageService/Read":      {PerCall: 0.0004 / 1_000},
+	"/nitric.storage.v1.StorageService/ListFiles": {PerCall: 0.005 / 1_000},
+	"/nitric.document.v1.DocumentService/Get":     {PerCall: 0.06 / 100_000},
+	"/nitric.document.v1.DocumentService/Query":   {PerCall: 0.06 / 100_000},
+	"/nitric.document.v1.DocumentService/Set":     {PerCall: 0.18 / 100_000},
+	"/nitric.document.v1.DocumentService/Delete":  {PerCall: 0.02 / 100_000},
+}
+
+// AzurePrices - Key Vault, Storage queues, Event Grid and hot Blob Storage. Cosmos DB is billed by provisioned throughput,
+// so documents aren't estimated
+var AzurePrices = PriceTable{
+	"/nitric.secret.v1.SecretService/Access":      {PerCall: 0.03 / 10_000},
+	"/nitric.secret.v1.SecretService/Put":         {PerCall: 0.03 / 10_000},
+	"/nitric.queue.v1.QueueService/Send":          {PerCall: 0.004 / 10_000},
+	"/nitric.queue.v1.QueueService/SendBatch":     {PerCall: 0.004 / 10_000},
+	"/nitric.queue.v1.QueueService/Receive":       {PerCall: 0.004 / 10_000},
+	"/nitric.queue.v1.QueueService/Complete":      {PerCall: 0.004 / 10_000},
+	"/nitric.event.v1.EventService/Publish":       {PerCall: 0.60 / 1_000_000},
+	"/nitric.storage.v1.StorageService/Write":     {PerCall: 0.05 / 10_000, PerRequestGB: 0.0184},
+	"/nitric.storage.v1.StorageService/Read":      {PerCall: 0.004 / 10_000},
+	"/nitric.storage.v1.StorageService/ListFiles": {PerCall: 0.05 / 10_000},
+}
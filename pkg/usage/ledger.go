@@ -0,0 +1,141 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+// DefaultLedgerCollection - the collection usage is recorded in when none is configured
+const DefaultLedgerCollection = "nitric-usage"
+
+// Ledger - periodically appends the usage recorded by a meter to a collection, for chargeback.
+//
+// Each flush writes a new document per caller covering the usage since the previous flush,
+// so ledgers written by multiple membranes never conflict.
+type Ledger struct {
+	meter      *Meter
+	documents  document.DocumentService
+	collection *document.Collection
+	interval   time.Duration
+	now        func() time.Time
+	since      time.Time
+	stop       chan bool
+}
+
+// Flush - writes the usage recorded since the last flush to the ledger
+func (l *Ledger) Flush() error {
+	drained := l.meter.Drain()
+	now := l.now()
+
+	var failed map[Labels]Counts
+	var flushErr error
+	for labels, counts := range drained {
+		entry := map[string]interface{}{
+			"function":      labels.Function,
+			"trigger":       labels.Trigger,
+			"tenant":        labels.Tenant,
+			"operation":     labels.Operation,
+			"calls":         int64(counts.Calls),
+			"errors":        int64(counts.Errors),
+			"requestBytes":  int64(counts.RequestBytes),
+			"responseBytes": int64(counts.ResponseBytes),
+			"start":         l.since.UTC().Format(time.RFC3339),
+			"end":           now.UTC().Format(time.RFC3339),
+		}
+		if cost, ok := l.meter.Cost(labels, counts); ok {
+			entry["estimatedCost"] = cost
+		}
+
+		err := l.documents.Set(&document.Key{
+			Collection: l.collection,
+			Id:         uuid.New().String(),
+		}, entry)
+		if err != nil {
+			if failed == nil {
+				failed = make(map[Labels]Counts)
+			}
+			failed[labels] = counts
+			flushErr = err
+		}
+	}
+
+	// Usage that couldn't be written is carried into the next flush rather than lost
+	if failed != nil {
+		l.meter.restore(failed)
+		return fmt.Errorf("unable to write %d usage ledger entries: %v", len(failed), flushErr)
+	}
+
+	l.since = now
+
+	return nil
+}
+
+// Start - Begins flushing usage at the configured interval, until Stop is called
+func (l *Ledger) Start() {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			if err := l.Flush(); err != nil {
+				log.Default().Printf("error flushing usage ledger: %v", err)
+			}
+		}
+	}
+}
+
+// Stop - Stops the ledger, flushing any outstanding usage
+func (l *Ledger) Stop() {
+	close(l.stop)
+
+	if err := l.Flush(); err != nil {
+		log.Default().Printf("error flushing usage ledger: %v", err)
+	}
+}
+
+// NewLedger - Creates a new Ledger that appends the meter's usage to the given collection at the given interval
+func NewLedger(meter *Meter, documents document.DocumentService, collection string, interval time.Duration) (*Ledger, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("a document plugin is required for the usage ledger")
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("usage ledger flush interval must be positive, got %s", interval)
+	}
+
+	if collection == "" {
+		collection = DefaultLedgerCollection
+	}
+
+	return &Ledger{
+		meter:      meter,
+		documents:  documents,
+		collection: &document.Collection{Name: collection},
+		interval:   interval,
+		now:        time.Now,
+		since:      time.Now(),
+		stop:       make(chan bool),
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_document "github.com/nitrictech/nitric/mocks/document"
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/usage"
+)
+
+var _ = Describe("Ledger", func() {
+	labels := usage.Labels{Function: "checkout", Tenant: "acme", Operation: "/op"}
+
+	Context("Flush", func() {
+		When("usage has been recorded", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			meter := usage.NewMeter("")
+			ledger, _ := usage.NewLedger(meter, mockDocs, "", time.Minute)
+
+			It("should append an entry for each caller", func() {
+				meter.Record(labels, usage.Counts{Calls: 3, ResponseBytes: 100})
+
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(k *document.Key, content map[string]interface{}) error {
+					Expect(k.Collection.Name).To(Equal(usage.DefaultLedgerCollection))
+					Expect(content["function"]).To(Equal("checkout"))
+					Expect(content["tenant"]).To(Equal("acme"))
+					Expect(content["calls"]).To(Equal(int64(3)))
+					Expect(content["responseBytes"]).To(Equal(int64(100)))
+					return nil
+				})
+
+				Expect(ledger.Flush()).To(Succeed())
+
+				By("not writing the same usage twice")
+				Expect(ledger.Flush()).To(Succeed())
+			})
+		})
+
+		When("writing an entry fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDocs := mock_document.NewMockDocumentService(ctrl)
+			meter := usage.NewMeter("")
+			ledger, _ := usage.NewLedger(meter, mockDocs, "usage", time.Minute)
+
+			It("should carry the usage into the next flush", func() {
+				meter.Record(labels, usage.Counts{Calls: 1})
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).Return(fmt.Errorf("mock error"))
+				Expect(ledger.Flush()).ShouldNot(Succeed())
+
+				meter.Record(labels, usage.Counts{Calls: 1})
+				mockDocs.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(func(k *document.Key, content map[string]interface{}) error {
+					Expect(content["calls"]).To(Equal(int64(2)))
+					return nil
+				})
+				Expect(ledger.Flush()).To(Succeed())
+			})
+		})
+	})
+
+	Context("NewLedger", func() {
+		It("should require a document plugin", func() {
+			_, err := usage.NewLedger(usage.NewMeter(""), nil, "", time.Minute)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,312 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nitrictech/nitric/pkg/tenancy"
+)
+
+const (
+	// FunctionMetadataKey - the gRPC metadata key SDKs use to identify the function making a runtime API call
+	FunctionMetadataKey = "x-nitric-function"
+	// TriggerMetadataKey - the gRPC metadata key SDKs use to identify the trigger being handled when making a runtime API call
+	TriggerMetadataKey = "x-nitric-trigger"
+)
+
+// Labels - identifies who made a plugin operation
+type Labels struct {
+	Function  string
+	Trigger   string
+	Tenant    string
+	Operation string
+}
+
+// Counts - usage accumulated for a set of labels
+type Counts struct {
+	Calls         uint64
+	Errors        uint64
+	RequestBytes  uint64
+	ResponseBytes uint64
+}
+
+func (c *Counts) add(o Counts) {
+	c.Calls += o.Calls
+	c.Errors += o.Errors
+	c.RequestBytes += o.RequestBytes
+	c.ResponseBytes += o.ResponseBytes
+}
+
+// Meter - counts the plugin operations made through the runtime API and their payload bytes,
+// per calling function, trigger and tenant
+type Meter struct {
+	function string
+	lock     sync.Mutex
+	totals   map[Labels]*Counts
+	pending  map[Labels]*Counts
+	// estimates the cost of recorded usage, disabled if nil
+	prices PriceTable
+}
+
+// EstimateCosts - estimates the cost of the recorded usage with the prices of the provider's plugins
+func (m *Meter) EstimateCosts(prices PriceTable) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.prices = prices
+}
+
+// Cost - the estimated cost of usage, false if costs aren't estimated or the operation has no price
+func (m *Meter) Cost(labels Labels, counts Counts) (float64, bool) {
+	m.lock.Lock()
+	prices := m.prices
+	m.lock.Unlock()
+
+	return prices.Estimate(labels.Operation, counts)
+}
+
+// Record - records usage for the given labels
+func (m *Meter) Record(labels Labels, counts Counts) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, all := range []map[Labels]*Counts{m.totals, m.pending} {
+		c, ok := all[labels]
+		if !ok {
+			c = &Counts{}
+			all[labels] = c
+		}
+		c.add(counts)
+	}
+}
+
+// Totals - returns the usage recorded since the meter was created
+func (m *Meter) Totals() map[Labels]Counts {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	totals := make(map[Labels]Counts, len(m.totals))
+	for l, c := range m.totals {
+		totals[l] = *c
+	}
+
+	return totals
+}
+
+// Drain - returns the usage recorded since the last drain
+func (m *Meter) Drain() map[Labels]Counts {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	drained := make(map[Labels]Counts, len(m.pending))
+	for l, c := range m.pending {
+		drained[l] = *c
+	}
+	m.pending = make(map[Labels]*Counts)
+
+	return drained
+}
+
+// restore - returns drained usage that couldn't be persisted, so it's included in the next drain
+func (m *Meter) restore(drained map[Labels]Counts) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for l, counts := range drained {
+		c, ok := m.pending[l]
+		if !ok {
+			c = &Counts{}
+			m.pending[l] = c
+		}
+		c.add(counts)
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// labels - identifies the caller of a runtime API method from its metadata
+func (m *Meter) labels(ctx context.Context, method string) Labels {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	labels := Labels{
+		Function:  firstValue(md, FunctionMetadataKey),
+		Trigger:   firstValue(md, TriggerMetadataKey),
+		Tenant:    firstValue(md, tenancy.MetadataKey),
+		Operation: method,
+	}
+
+	if labels.Function == "" {
+		labels.Function = m.function
+	}
+
+	// Unvalidated tenants would let callers create unbounded label sets
+	if labels.Tenant != "" && tenancy.ValidateTenant(labels.Tenant) != nil {
+		labels.Tenant = ""
+	}
+
+	return labels
+}
+
+func size(msg interface{}) uint64 {
+	if m, ok := msg.(proto.Message); ok {
+		return uint64(proto.Size(m))
+	}
+
+	return 0
+}
+
+// UnaryServerInterceptor - meters unary runtime API calls
+func (m *Meter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		counts := Counts{
+			Calls:        1,
+			RequestBytes: size(req),
+		}
+		if err != nil {
+			counts.Errors = 1
+		} else {
+			counts.ResponseBytes = size(resp)
+		}
+
+		m.Record(m.labels(ctx, info.FullMethod), counts)
+
+		return resp, err
+	}
+}
+
+// meteredStream - counts the bytes of the messages sent and received over a stream
+type meteredStream struct {
+	grpc.ServerStream
+	counts Counts
+}
+
+func (s *meteredStream) SendMsg(msg interface{}) error {
+	err := s.ServerStream.SendMsg(msg)
+	if err == nil {
+		s.counts.ResponseBytes += size(msg)
+	}
+
+	return err
+}
+
+func (s *meteredStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.counts.RequestBytes += size(msg)
+	}
+
+	return err
+}
+
+// StreamServerInterceptor - meters streaming runtime API calls.
+//
+// Trigger streams carry triggers rather than plugin operations, the runtime requests made over them are metered individually instead.
+func (m *Meter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, "/nitric.faas.") {
+			return handler(srv, ss)
+		}
+
+		stream := &meteredStream{ServerStream: ss, counts: Counts{Calls: 1}}
+		err := handler(srv, stream)
+		if err != nil {
+			stream.counts.Errors = 1
+		}
+
+		m.Record(m.labels(ss.Context(), info.FullMethod), stream.counts)
+
+		return err
+	}
+}
+
+// Handler - serves the recorded usage in the Prometheus text exposition format
+func (m *Meter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		totals := m.Totals()
+
+		labels := make([]Labels, 0, len(totals))
+		for l := range totals {
+			labels = append(labels, l)
+		}
+		sort.Slice(labels, func(i, j int) bool {
+			return labels[i].String() < labels[j].String()
+		})
+
+		metrics := []struct {
+			name  string
+			help  string
+			value func(Counts) uint64
+		}{
+			{"nitric_usage_calls_total", "Runtime API calls made by each caller.", func(c Counts) uint64 { return c.Calls }},
+			{"nitric_usage_errors_total", "Failed runtime API calls made by each caller.", func(c Counts) uint64 { return c.Errors }},
+			{"nitric_usage_request_bytes_total", "Bytes of runtime API request payloads sent by each caller.", func(c Counts) uint64 { return c.RequestBytes }},
+			{"nitric_usage_response_bytes_total", "Bytes of runtime API response payloads returned to each caller.", func(c Counts) uint64 { return c.ResponseBytes }},
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		for _, metric := range metrics {
+			fmt.Fprintf(w, "# HELP %s %s\n", metric.name, metric.help)
+			fmt.Fprintf(w, "# TYPE %s counter\n", metric.name)
+			for _, l := range labels {
+				fmt.Fprintf(w, "%s{%s} %d\n", metric.name, l, metric.value(totals[l]))
+			}
+		}
+
+		// Costs are linear in the counts, so estimating them from the totals gives their running total
+		costs := []string{}
+		for _, l := range labels {
+			if cost, ok := m.Cost(l, totals[l]); ok {
+				costs = append(costs, fmt.Sprintf("nitric_usage_estimated_cost_dollars_total{%s} %g\n", l, cost))
+			}
+		}
+		if len(costs) > 0 {
+			fmt.Fprint(w, "# HELP nitric_usage_estimated_cost_dollars_total Estimated cost in US dollars of the runtime API calls made by each caller.\n")
+			fmt.Fprint(w, "# TYPE nitric_usage_estimated_cost_dollars_total counter\n")
+			fmt.Fprint(w, strings.Join(costs, ""))
+		}
+	})
+}
+
+// String - formats the labels as Prometheus labels
+func (l Labels) String() string {
+	return fmt.Sprintf("function=%q,trigger=%q,tenant=%q,operation=%q", l.Function, l.Trigger, l.Tenant, l.Operation)
+}
+
+// NewMeter - creates a meter that attributes calls without function metadata to the given function
+func NewMeter(function string) *Meter {
+	return &Meter{
+		function: function,
+		totals:   make(map[Labels]*Counts),
+		pending:  make(map[Labels]*Counts),
+	}
+}
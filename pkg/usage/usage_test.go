@@ -0,0 +1,148 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/usage"
+)
+
+func mustMarshal(m proto.Message) []byte {
+	b, err := proto.Marshal(m)
+	Expect(err).ShouldNot(HaveOccurred())
+	return b
+}
+
+var _ = Describe("Meter", func() {
+	info := &grpc.UnaryServerInfo{FullMethod: "/nitric.secret.v1.SecretService/Access"}
+	req := &v1.SecretAccessRequest{SecretVersion: &v1.SecretVersion{Secret: &v1.Secret{Name: "foo"}, Version: "latest"}}
+	resp := &v1.SecretAccessResponse{Value: []byte("the value")}
+
+	Context("UnaryServerInterceptor", func() {
+		When("the caller identifies itself", func() {
+			meter := usage.NewMeter("default")
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				usage.FunctionMetadataKey, "checkout",
+				usage.TriggerMetadataKey, "POST /orders",
+				"x-nitric-tenant", "acme",
+			))
+
+			_, err := meter.UnaryServerInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return resp, nil
+			})
+
+			It("should count the call and its payload bytes against the caller", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(meter.Totals()).To(Equal(map[usage.Labels]usage.Counts{
+					{Function: "checkout", Trigger: "POST /orders", Tenant: "acme", Operation: info.FullMethod}: {
+						Calls:         1,
+						RequestBytes:  uint64(len(mustMarshal(req))),
+						ResponseBytes: uint64(len(mustMarshal(resp))),
+					},
+				}))
+			})
+		})
+
+		When("the call fails", func() {
+			meter := usage.NewMeter("default")
+
+			_, _ = meter.UnaryServerInterceptor()(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, fmt.Errorf("mock error")
+			})
+
+			It("should count the error against the default function", func() {
+				totals := meter.Totals()
+				Expect(totals).To(HaveLen(1))
+				Expect(totals[usage.Labels{Function: "default", Operation: info.FullMethod}].Errors).To(Equal(uint64(1)))
+			})
+		})
+	})
+
+	Context("Drain", func() {
+		It("should only return usage recorded since the last drain", func() {
+			meter := usage.NewMeter("")
+			labels := usage.Labels{Function: "checkout"}
+
+			meter.Record(labels, usage.Counts{Calls: 1})
+			Expect(meter.Drain()).To(HaveKeyWithValue(labels, usage.Counts{Calls: 1}))
+
+			meter.Record(labels, usage.Counts{Calls: 2})
+			Expect(meter.Drain()).To(HaveKeyWithValue(labels, usage.Counts{Calls: 2}))
+			Expect(meter.Totals()).To(HaveKeyWithValue(labels, usage.Counts{Calls: 3}))
+		})
+	})
+
+	Context("Handler", func() {
+		It("should serve the totals as Prometheus counters", func() {
+			meter := usage.NewMeter("")
+			meter.Record(usage.Labels{Function: "checkout", Tenant: "acme", Operation: "/op"}, usage.Counts{Calls: 2, RequestBytes: 10})
+
+			rec := httptest.NewRecorder()
+			meter.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/usage", nil))
+
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_usage_calls_total{function="checkout",trigger="",tenant="acme",operation="/op"} 2`))
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_usage_request_bytes_total{function="checkout",trigger="",tenant="acme",operation="/op"} 10`))
+		})
+	})
+
+	Context("Costs", func() {
+		prices := usage.PriceTable{
+			"/storage/write": {PerCall: 0.5, PerRequestGB: 2},
+		}
+
+		It("should estimate the cost of calls and payload bytes", func() {
+			cost, ok := prices.Estimate("/storage/write", usage.Counts{Calls: 2, RequestBytes: 1 << 29})
+			Expect(ok).To(BeTrue())
+			Expect(cost).To(Equal(2.0))
+
+			_, ok = prices.Estimate("/unpriced", usage.Counts{Calls: 2})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should serve the running total of estimated costs of priced operations", func() {
+			meter := usage.NewMeter("")
+			meter.EstimateCosts(prices)
+			meter.Record(usage.Labels{Function: "upload", Operation: "/storage/write"}, usage.Counts{Calls: 1})
+			meter.Record(usage.Labels{Function: "upload", Operation: "/storage/write"}, usage.Counts{Calls: 2})
+			meter.Record(usage.Labels{Function: "upload", Operation: "/unpriced"}, usage.Counts{Calls: 1})
+
+			rec := httptest.NewRecorder()
+			meter.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/usage", nil))
+
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_usage_estimated_cost_dollars_total{function="upload",trigger="",tenant="",operation="/storage/write"} 1.5`))
+			Expect(rec.Body.String()).ToNot(ContainSubstring(`nitric_usage_estimated_cost_dollars_total{function="upload",trigger="",tenant="",operation="/unpriced"}`))
+		})
+
+		It("should not serve costs if they aren't estimated", func() {
+			meter := usage.NewMeter("")
+			meter.Record(usage.Labels{Function: "upload", Operation: "/storage/write"}, usage.Counts{Calls: 1})
+
+			rec := httptest.NewRecorder()
+			meter.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/usage", nil))
+
+			Expect(rec.Body.String()).ToNot(ContainSubstring("nitric_usage_estimated_cost_dollars_total"))
+		})
+	})
+})
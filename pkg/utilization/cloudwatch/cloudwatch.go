@@ -0,0 +1,120 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+
+	"github.com/nitrictech/nitric/pkg/providers/aws/core"
+	"github.com/nitrictech/nitric/pkg/utilization"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// CloudWatchPublisher - Publishes worker utilization as custom CloudWatch metrics,
+// which ECS services can target with target tracking scaling policies
+type CloudWatchPublisher struct {
+	client     cloudwatchiface.CloudWatchAPI
+	namespace  string
+	dimensions []*cloudwatch.Dimension
+}
+
+var _ utilization.Publisher = &CloudWatchPublisher{}
+
+func (c *CloudWatchPublisher) datum(name string, value float64, unit string) *cloudwatch.MetricDatum {
+	return &cloudwatch.MetricDatum{
+		MetricName: aws.String(name),
+		Dimensions: c.dimensions,
+		Value:      aws.Float64(value),
+		Unit:       aws.String(unit),
+	}
+}
+
+func (c *CloudWatchPublisher) Publish(u worker.Utilization) error {
+	_, err := c.client.PutMetricData(&cloudwatch.PutMetricDataInput{
+		Namespace: aws.String(c.namespace),
+		MetricData: []*cloudwatch.MetricDatum{
+			c.datum("WorkerUtilization", u.Ratio()*100, cloudwatch.StandardUnitPercent),
+			c.datum("WorkerInFlight", float64(u.InFlight), cloudwatch.StandardUnitCount),
+			c.datum("WorkerCapacity", float64(u.Capacity), cloudwatch.StandardUnitCount),
+		},
+	})
+
+	return err
+}
+
+// ParseDimensions - parses metric dimensions from a comma separated list of name=value pairs,
+// e.g. ServiceName=orders,Stack=prod
+func ParseDimensions(dimensions string) (map[string]string, error) {
+	dims := make(map[string]string)
+
+	for _, d := range strings.Split(dimensions, ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+
+		parts := strings.SplitN(d, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid dimension %s, expected name=value", d)
+		}
+
+		dims[parts[0]] = parts[1]
+	}
+
+	return dims, nil
+}
+
+// New - Creates a new CloudWatch utilization publisher
+func New(namespace string, dimensions map[string]string) (*CloudWatchPublisher, error) {
+	sess, err := core.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(cloudwatch.New(sess), namespace, dimensions)
+}
+
+// NewWithClient - Creates a new CloudWatch utilization publisher using the given client
+func NewWithClient(client cloudwatchiface.CloudWatchAPI, namespace string, dimensions map[string]string) (*CloudWatchPublisher, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("a CloudWatch metric namespace is required")
+	}
+
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dims := make([]*cloudwatch.Dimension, 0, len(dimensions))
+	for _, name := range names {
+		dims = append(dims, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(dimensions[name]),
+		})
+	}
+
+	return &CloudWatchPublisher{
+		client:     client,
+		namespace:  namespace,
+		dimensions: dims,
+	}, nil
+}
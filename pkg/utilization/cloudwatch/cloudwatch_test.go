@@ -0,0 +1,94 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/utilization/cloudwatch"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type mockCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	input *awscloudwatch.PutMetricDataInput
+}
+
+func (m *mockCloudWatch) PutMetricData(input *awscloudwatch.PutMetricDataInput) (*awscloudwatch.PutMetricDataOutput, error) {
+	m.input = input
+	return &awscloudwatch.PutMetricDataOutput{}, nil
+}
+
+var _ = Describe("CloudWatchPublisher", func() {
+	Context("Publish", func() {
+		When("publishing utilization", func() {
+			client := &mockCloudWatch{}
+			publisher, _ := cloudwatch.NewWithClient(client, "nitric", map[string]string{
+				"ServiceName": "orders",
+				"Stack":       "prod",
+			})
+
+			err := publisher.Publish(worker.Utilization{InFlight: 1, Capacity: 4})
+
+			It("should put the utilization metrics in the namespace", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(*client.input.Namespace).To(Equal("nitric"))
+				Expect(client.input.MetricData).To(HaveLen(3))
+
+				utilization := client.input.MetricData[0]
+				Expect(*utilization.MetricName).To(Equal("WorkerUtilization"))
+				Expect(*utilization.Value).To(Equal(25.0))
+				Expect(*utilization.Unit).To(Equal(awscloudwatch.StandardUnitPercent))
+			})
+
+			It("should include the dimensions", func() {
+				Expect(client.input.MetricData[0].Dimensions).To(Equal([]*awscloudwatch.Dimension{
+					{Name: aws.String("ServiceName"), Value: aws.String("orders")},
+					{Name: aws.String("Stack"), Value: aws.String("prod")},
+				}))
+			})
+		})
+	})
+
+	Context("NewWithClient", func() {
+		When("no namespace is provided", func() {
+			It("should return an error", func() {
+				_, err := cloudwatch.NewWithClient(&mockCloudWatch{}, "", nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("ParseDimensions", func() {
+		When("parsing name value pairs", func() {
+			It("should return the dimensions", func() {
+				dims, err := cloudwatch.ParseDimensions("ServiceName=orders, Stack=prod")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(dims).To(Equal(map[string]string{"ServiceName": "orders", "Stack": "prod"}))
+			})
+		})
+
+		When("a pair is malformed", func() {
+			It("should return an error", func() {
+				_, err := cloudwatch.ParseDimensions("ServiceName")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
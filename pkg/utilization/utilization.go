@@ -0,0 +1,101 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utilization
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// Publisher - Sends worker pool utilization to a platform's autoscaler, e.g. as a custom metric
+type Publisher interface {
+	Publish(u worker.Utilization) error
+}
+
+// Reporter - Periodically publishes the utilization of a worker pool
+type Reporter struct {
+	source     worker.UtilizationSource
+	publishers []Publisher
+	interval   time.Duration
+	stop       chan bool
+}
+
+// Report - publishes the current utilization to each publisher
+func (r *Reporter) Report() {
+	u := r.source.Utilization()
+
+	for _, p := range r.publishers {
+		if err := p.Publish(u); err != nil {
+			log.Default().Printf("error publishing worker utilization: %v", err)
+		}
+	}
+}
+
+// Start - Begins publishing utilization at the configured interval, until Stop is called
+func (r *Reporter) Start() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.Report()
+		}
+	}
+}
+
+// Stop - Stops the reporter
+func (r *Reporter) Stop() {
+	close(r.stop)
+}
+
+// NewReporter - Creates a new Reporter that publishes the source's utilization at the given interval
+func NewReporter(source worker.UtilizationSource, interval time.Duration, publishers ...Publisher) (*Reporter, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("utilization report interval must be positive, got %s", interval)
+	}
+
+	return &Reporter{
+		source:     source,
+		publishers: publishers,
+		interval:   interval,
+		stop:       make(chan bool),
+	}, nil
+}
+
+// Handler - Serves the source's utilization in the Prometheus text exposition format,
+// for platforms that scrape autoscaling metrics
+func Handler(source worker.UtilizationSource) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u := source.Utilization()
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_worker_inflight Triggers currently being handled by workers.\n")
+		fmt.Fprintf(w, "# TYPE nitric_worker_inflight gauge\n")
+		fmt.Fprintf(w, "nitric_worker_inflight %d\n", u.InFlight)
+		fmt.Fprintf(w, "# HELP nitric_worker_capacity Triggers workers can handle concurrently.\n")
+		fmt.Fprintf(w, "# TYPE nitric_worker_capacity gauge\n")
+		fmt.Fprintf(w, "nitric_worker_capacity %d\n", u.Capacity)
+		fmt.Fprintf(w, "# HELP nitric_worker_utilization Fraction of worker capacity in use.\n")
+		fmt.Fprintf(w, "# TYPE nitric_worker_utilization gauge\n")
+		fmt.Fprintf(w, "nitric_worker_utilization %g\n", u.Ratio())
+	})
+}
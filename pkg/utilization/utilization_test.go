@@ -0,0 +1,104 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utilization_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/utilization"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type staticSource struct {
+	utilization worker.Utilization
+}
+
+func (s *staticSource) Utilization() worker.Utilization {
+	return s.utilization
+}
+
+type recordingPublisher struct {
+	published []worker.Utilization
+	err       error
+}
+
+func (p *recordingPublisher) Publish(u worker.Utilization) error {
+	p.published = append(p.published, u)
+	return p.err
+}
+
+var _ = Describe("Utilization", func() {
+	source := &staticSource{utilization: worker.Utilization{InFlight: 3, Capacity: 4}}
+
+	Context("Handler", func() {
+		When("the metrics are scraped", func() {
+			rec := httptest.NewRecorder()
+			utilization.Handler(source).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+			body, _ := ioutil.ReadAll(rec.Body)
+
+			It("should return the utilization in the prometheus text format", func() {
+				Expect(rec.Header().Get("Content-Type")).To(ContainSubstring("text/plain"))
+				Expect(string(body)).To(ContainSubstring("nitric_worker_inflight 3\n"))
+				Expect(string(body)).To(ContainSubstring("nitric_worker_capacity 4\n"))
+				Expect(string(body)).To(ContainSubstring("nitric_worker_utilization 0.75\n"))
+			})
+		})
+	})
+
+	Context("Reporter", func() {
+		When("the interval isn't positive", func() {
+			It("should return an error", func() {
+				_, err := utilization.NewReporter(source, 0)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("reporting", func() {
+			failing := &recordingPublisher{err: fmt.Errorf("mock error")}
+			publisher := &recordingPublisher{}
+			reporter, _ := utilization.NewReporter(source, time.Minute, failing, publisher)
+			reporter.Report()
+
+			It("should publish to every publisher, regardless of failures", func() {
+				Expect(failing.published).To(HaveLen(1))
+				Expect(publisher.published).To(ConsistOf(worker.Utilization{InFlight: 3, Capacity: 4}))
+			})
+		})
+
+		When("started", func() {
+			It("should publish at the configured interval until stopped", func() {
+				publisher := &recordingPublisher{}
+				reporter, _ := utilization.NewReporter(source, 5*time.Millisecond, publisher)
+
+				done := make(chan bool)
+				go func() {
+					reporter.Start()
+					close(done)
+				}()
+
+				time.Sleep(30 * time.Millisecond)
+				reporter.Stop()
+				Eventually(done).Should(BeClosed())
+				Expect(len(publisher.published)).To(BeNumerically(">", 0))
+			})
+		})
+	})
+})
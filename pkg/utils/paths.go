@@ -14,7 +14,10 @@
 
 package utils
 
-import "strings"
+import (
+	"path"
+	"strings"
+)
 
 // slashSplitter - used to split strings, with the same output regardless of leading or trailing slashes
 // e.g - strings.FieldsFunc("/one/two/three/", f) == strings.FieldsFunc("/one/two/three", f) == strings.FieldsFunc("one/two/three", f) == ["one" "two" "three"]
@@ -27,3 +30,28 @@ func slashSplitter(c rune) bool {
 func SplitPath(p string) []string {
 	return strings.FieldsFunc(p, slashSplitter)
 }
+
+// CleanPath - returns the path as it's routed, with dot segments resolved and empty segments removed.
+// e.g - CleanPath("//one/./two/") == CleanPath("/one/two") == "/one/two"
+func CleanPath(p string) string {
+	return "/" + strings.Join(SplitPath(path.Clean("/"+p)), "/")
+}
+
+// HasPathPrefix - returns true if the path is the prefix or under it, comparing their cleaned segments.
+// e.g - HasPathPrefix("//payments/x", "/payments") == true, HasPathPrefix("/paymentsx", "/payments") == false
+func HasPathPrefix(p string, prefix string) bool {
+	parts := SplitPath(CleanPath(p))
+	prefixParts := SplitPath(CleanPath(prefix))
+
+	if len(parts) < len(prefixParts) {
+		return false
+	}
+
+	for i, part := range prefixParts {
+		if parts[i] != part {
+			return false
+		}
+	}
+
+	return true
+}
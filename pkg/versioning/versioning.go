@@ -0,0 +1,239 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versioning manages the lifecycle of versioned APIs served by the membrane
+package versioning
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// VersionHeader - the request header used to tell handlers which API version a request was made to
+const VersionHeader = "X-Nitric-Api-Version"
+
+// Version - a version of the API, served under the /<name> path prefix
+type Version struct {
+	Name string
+	// Target - the path prefix requests to this version are routed to, defaults to the version's own prefix
+	Target string
+	// Deprecation - when the version was, or will be, deprecated. Not deprecated if zero
+	Deprecation time.Time
+	// Sunset - when the version stops being served. Served indefinitely if zero
+	Sunset time.Time
+	// Successor - the name of the version that replaces this one
+	Successor string
+}
+
+// Prefix - the path prefix requests to this version are made to
+func (v *Version) Prefix() string {
+	return "/" + v.Name
+}
+
+// Rewrite - returns the path a request to this version is routed to
+func (v *Version) Rewrite(path string) string {
+	if v.Target == "" {
+		return path
+	}
+
+	rewritten := strings.TrimSuffix(v.Target, "/") + strings.TrimPrefix(utils.CleanPath(path), v.Prefix())
+	if rewritten == "" {
+		return "/"
+	}
+
+	return rewritten
+}
+
+// IsSunset - returns true if the version is no longer served at the given time
+func (v *Version) IsSunset(now time.Time) bool {
+	return !v.Sunset.IsZero() && !now.Before(v.Sunset)
+}
+
+// Headers - returns the lifecycle headers included in responses to requests made to this version
+func (v *Version) Headers() map[string]string {
+	headers := make(map[string]string)
+
+	if !v.Deprecation.IsZero() {
+		headers["Deprecation"] = v.Deprecation.UTC().Format(http.TimeFormat)
+	}
+
+	if !v.Sunset.IsZero() {
+		headers["Sunset"] = v.Sunset.UTC().Format(http.TimeFormat)
+	}
+
+	if v.Successor != "" {
+		headers["Link"] = fmt.Sprintf("</%s>; rel=\"successor-version\"", v.Successor)
+	}
+
+	return headers
+}
+
+// Config - the versions of the API served by the membrane
+type Config struct {
+	Versions []*Version
+}
+
+// Match - returns the version a request path was made to, or nil if it isn't versioned
+func (c *Config) Match(path string) *Version {
+	if c == nil {
+		return nil
+	}
+
+	for _, v := range c.Versions {
+		if utils.HasPathPrefix(path, v.Prefix()) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// ParseConfig - parses a version configuration string, versions are separated by commas
+// and optionally followed by semicolon separated attributes.
+// e.g. "v1;target=/;deprecation=2021-12-01;sunset=2022-06-01;successor=v2,v2" serves v1 from the unversioned routes until it's sunset
+func ParseConfig(config string) (*Config, error) {
+	c := &Config{
+		Versions: make([]*Version, 0),
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ";")
+		v := &Version{
+			Name: strings.Trim(strings.TrimSpace(parts[0]), "/"),
+		}
+
+		if v.Name == "" || strings.Contains(v.Name, "/") {
+			return nil, fmt.Errorf("invalid api version name %s", parts[0])
+		}
+
+		for _, attr := range parts[1:] {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid attribute %s for api version %s, expected key=value", attr, v.Name)
+			}
+
+			key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+			var err error
+			switch key {
+			case "target":
+				v.Target = "/" + strings.Trim(value, "/")
+			case "deprecation":
+				v.Deprecation, err = parseTime(value)
+			case "sunset":
+				v.Sunset, err = parseTime(value)
+			case "successor":
+				v.Successor = strings.Trim(value, "/")
+			default:
+				err = fmt.Errorf("unknown attribute")
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute %s for api version %s: %v", key, v.Name, err)
+			}
+		}
+
+		c.Versions = append(c.Versions, v)
+	}
+
+	return c, nil
+}
+
+// versionStats - request counts for a single version
+type versionStats struct {
+	requests uint64
+	errors   uint64
+	seconds  float64
+}
+
+// Metrics - counts the requests made to each API version
+type Metrics struct {
+	lock     sync.Mutex
+	versions map[string]*versionStats
+}
+
+// Record - records a request to the named version, which took the given duration and may have failed
+func (m *Metrics) Record(version string, duration time.Duration, failed bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stats, ok := m.versions[version]
+	if !ok {
+		stats = &versionStats{}
+		m.versions[version] = stats
+	}
+
+	stats.requests++
+	stats.seconds += duration.Seconds()
+	if failed {
+		stats.errors++
+	}
+}
+
+// Handler - serves the per version request metrics in the Prometheus text exposition format
+func (m *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		names := make([]string, 0, len(m.versions))
+		for name := range m.versions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_api_requests_total Requests made to each API version.\n")
+		fmt.Fprintf(w, "# TYPE nitric_api_requests_total counter\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_api_requests_total{version=%q} %d\n", name, m.versions[name].requests)
+		}
+		fmt.Fprintf(w, "# HELP nitric_api_errors_total Failed requests made to each API version.\n")
+		fmt.Fprintf(w, "# TYPE nitric_api_errors_total counter\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_api_errors_total{version=%q} %d\n", name, m.versions[name].errors)
+		}
+		fmt.Fprintf(w, "# HELP nitric_api_request_seconds_total Time spent handling requests made to each API version.\n")
+		fmt.Fprintf(w, "# TYPE nitric_api_request_seconds_total counter\n")
+		for _, name := range names {
+			fmt.Fprintf(w, "nitric_api_request_seconds_total{version=%q} %g\n", name, m.versions[name].seconds)
+		}
+	})
+}
+
+// NewMetrics - creates an empty set of per version request metrics
+func NewMetrics() *Metrics {
+	return &Metrics{
+		versions: make(map[string]*versionStats),
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/versioning"
+)
+
+var _ = Describe("Versioning", func() {
+	Context("ParseConfig", func() {
+		When("parsing versions with attributes", func() {
+			config, err := versioning.ParseConfig("v1;target=/;deprecation=2021-12-01;sunset=2022-06-01T12:00:00Z;successor=v2, v2")
+
+			It("should parse each version", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.Versions).To(HaveLen(2))
+				Expect(config.Versions[1].Name).To(Equal("v2"))
+			})
+
+			It("should parse the version attributes", func() {
+				v1 := config.Versions[0]
+				Expect(v1.Name).To(Equal("v1"))
+				Expect(v1.Target).To(Equal("/"))
+				Expect(v1.Deprecation).To(Equal(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)))
+				Expect(v1.Sunset).To(Equal(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)))
+				Expect(v1.Successor).To(Equal("v2"))
+			})
+		})
+
+		When("parsing an unknown attribute", func() {
+			It("should return an error", func() {
+				_, err := versioning.ParseConfig("v1;owner=me")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("parsing an invalid date", func() {
+			It("should return an error", func() {
+				_, err := versioning.ParseConfig("v1;sunset=soon")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Match", func() {
+		config, _ := versioning.ParseConfig("v1,v2")
+
+		It("should match paths under a version prefix", func() {
+			Expect(config.Match("/v1/orders").Name).To(Equal("v1"))
+			Expect(config.Match("/v2").Name).To(Equal("v2"))
+		})
+
+		It("should not match unversioned paths", func() {
+			Expect(config.Match("/v10/orders")).To(BeNil())
+			Expect(config.Match("/orders")).To(BeNil())
+		})
+
+		It("should match paths with doubled slashes or dot segments", func() {
+			Expect(config.Match("//v1/orders").Name).To(Equal("v1"))
+			Expect(config.Match("/./v2").Name).To(Equal("v2"))
+			Expect(config.Match("/orders")).To(BeNil())
+		})
+	})
+
+	Context("Rewrite", func() {
+		It("should leave paths unchanged without a target", func() {
+			v := &versioning.Version{Name: "v2"}
+			Expect(v.Rewrite("/v2/orders")).To(Equal("/v2/orders"))
+		})
+
+		It("should replace the version prefix with the target", func() {
+			Expect((&versioning.Version{Name: "v1", Target: "/"}).Rewrite("/v1/orders")).To(Equal("/orders"))
+			Expect((&versioning.Version{Name: "v1", Target: "/"}).Rewrite("/v1")).To(Equal("/"))
+			Expect((&versioning.Version{Name: "v1", Target: "/legacy"}).Rewrite("/v1/orders")).To(Equal("/legacy/orders"))
+			Expect((&versioning.Version{Name: "v1", Target: "/legacy"}).Rewrite("//v1/orders")).To(Equal("/legacy/orders"))
+		})
+	})
+
+	Context("Headers", func() {
+		It("should include the lifecycle headers", func() {
+			v := &versioning.Version{
+				Name:        "v1",
+				Deprecation: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+				Sunset:      time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				Successor:   "v2",
+			}
+
+			Expect(v.Headers()).To(Equal(map[string]string{
+				"Deprecation": "Wed, 01 Dec 2021 00:00:00 GMT",
+				"Sunset":      "Wed, 01 Jun 2022 00:00:00 GMT",
+				"Link":        "</v2>; rel=\"successor-version\"",
+			}))
+		})
+
+		It("should not include headers for a current version", func() {
+			Expect((&versioning.Version{Name: "v2"}).Headers()).To(BeEmpty())
+		})
+	})
+
+	Context("Metrics", func() {
+		It("should serve the requests recorded for each version", func() {
+			metrics := versioning.NewMetrics()
+			metrics.Record("v1", time.Second, false)
+			metrics.Record("v1", time.Second, true)
+
+			rec := httptest.NewRecorder()
+			metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/versions", nil))
+
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_api_requests_total{version="v1"} 2`))
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_api_errors_total{version="v1"} 1`))
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_api_request_seconds_total{version="v1"} 2`))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultInterval - how often the directory is scanned for changes
+	DefaultInterval = 500 * time.Millisecond
+)
+
+// DefaultIgnore - names of files and directories that don't affect the application, or change too often to watch
+var DefaultIgnore = []string{".git", "node_modules", "__pycache__", ".nitric", "*.swp", "*~"}
+
+type fileState struct {
+	modified time.Time
+	size     int64
+}
+
+// Options - how a directory is watched
+type Options struct {
+	// How often the directory is scanned for changes, DefaultInterval if zero
+	Interval time.Duration
+	// Glob patterns matched against the name of each file and directory, matching files are ignored and matching directories aren't entered.
+	// DefaultIgnore if nil
+	Ignore []string
+}
+
+// Watcher - detects changes to the files within a directory by periodically scanning it
+type Watcher struct {
+	dir      string
+	interval time.Duration
+	ignore   []string
+	files    map[string]fileState
+}
+
+func (w *Watcher) ignored(name string) bool {
+	for _, pattern := range w.ignore {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *Watcher) scan() (map[string]fileState, error) {
+	files := map[string]fileState{}
+
+	err := filepath.WalkDir(w.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// files can be removed while they're being walked
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if path != w.dir && w.ignored(entry.Name()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		files[path] = fileState{modified: info.ModTime(), size: info.Size()}
+		return nil
+	})
+
+	return files, err
+}
+
+// Changes - scans the directory, returning the files created, modified or removed since the previous scan
+func (w *Watcher) Changes() ([]string, error) {
+	files, err := w.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []string{}
+	for path, state := range files {
+		if previous, ok := w.files[path]; !ok || previous != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.files {
+		if _, ok := files[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+
+	w.files = files
+	return changed, nil
+}
+
+// Run - calls onChange with the changed files each time the directory changes, until stop is closed.
+// Changes are collected until a scan finds no more, so saving several files at once only calls onChange once
+func (w *Watcher) Run(stop <-chan struct{}, onChange func(changed []string)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	pending := map[string]bool{}
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			changed, err := w.Changes()
+			if err != nil {
+				continue
+			}
+
+			for _, path := range changed {
+				pending[path] = true
+			}
+
+			if len(changed) > 0 || len(pending) == 0 {
+				continue
+			}
+
+			paths := make([]string, 0, len(pending))
+			for path := range pending {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			pending = map[string]bool{}
+
+			onChange(paths)
+		}
+	}
+}
+
+// New - creates a watcher for the files within dir, changes are detected relative to its contents when created
+func New(dir string, opts *Options) (*Watcher, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	w := &Watcher{
+		dir:      dir,
+		interval: opts.Interval,
+		ignore:   opts.Ignore,
+	}
+	if w.interval <= 0 {
+		w.interval = DefaultInterval
+	}
+	if w.ignore == nil {
+		w.ignore = DefaultIgnore
+	}
+
+	if w.files, err = w.scan(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
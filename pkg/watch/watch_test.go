@@ -0,0 +1,110 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/watch"
+)
+
+var _ = Describe("Watcher", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "watch")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(os.WriteFile(filepath.Join(dir, "main.py"), []byte("v1"), 0o644)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(dir, "node_modules"), 0o755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	Context("New", func() {
+		When("the directory doesn't exist", func() {
+			It("should return an error", func() {
+				_, err := watch.New(filepath.Join(dir, "missing"), nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Changes", func() {
+		It("should return nothing when no files have changed", func() {
+			w, err := watch.New(dir, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(w.Changes()).To(BeEmpty())
+		})
+
+		It("should return created, modified and removed files", func() {
+			Expect(os.WriteFile(filepath.Join(dir, "old.py"), []byte("old"), 0o644)).To(Succeed())
+
+			w, err := watch.New(dir, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(os.WriteFile(filepath.Join(dir, "main.py"), []byte("version 2"), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "new.py"), []byte("new"), 0o644)).To(Succeed())
+			Expect(os.Remove(filepath.Join(dir, "old.py"))).To(Succeed())
+
+			Expect(w.Changes()).To(Equal([]string{
+				filepath.Join(dir, "main.py"),
+				filepath.Join(dir, "new.py"),
+				filepath.Join(dir, "old.py"),
+			}))
+
+			By("only returning each change once")
+			Expect(w.Changes()).To(BeEmpty())
+		})
+
+		It("should ignore files matching the ignore patterns", func() {
+			w, err := watch.New(dir, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(os.WriteFile(filepath.Join(dir, "node_modules", "lib.js"), []byte("lib"), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "main.py.swp"), []byte("swap"), 0o644)).To(Succeed())
+
+			Expect(w.Changes()).To(BeEmpty())
+		})
+	})
+
+	Context("Run", func() {
+		It("should call onChange once the changes have settled", func() {
+			w, err := watch.New(dir, &watch.Options{Interval: 10 * time.Millisecond})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			changes := make(chan []string, 10)
+			stop := make(chan struct{})
+			defer close(stop)
+			go w.Run(stop, func(changed []string) {
+				changes <- changed
+			})
+
+			Expect(os.WriteFile(filepath.Join(dir, "main.py"), []byte("version 2"), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "util.py"), []byte("util"), 0o644)).To(Succeed())
+
+			Eventually(changes).Should(Receive(ContainElement(filepath.Join(dir, "util.py"))))
+		})
+	})
+})
@@ -0,0 +1,409 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook verifies the signatures of webhooks sent to the membrane's http routes, before they trigger the application
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	goerrors "errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+const (
+	// DefaultTolerance - how old the timestamp of a signed webhook may be, for schemes that sign one
+	DefaultTolerance = 5 * time.Minute
+	// DefaultHeader - the header holding the signature of generic HMAC webhooks
+	DefaultHeader = "X-Signature"
+	// secretRefreshInterval - how long a webhook secret is cached before the latest version is read again
+	secretRefreshInterval = 5 * time.Minute
+)
+
+// ErrInvalidSignature - returned when a webhook's signature is missing or doesn't match its body
+var ErrInvalidSignature = goerrors.New("invalid webhook signature")
+
+// Scheme - how a webhook provider signs its requests
+type Scheme interface {
+	// Verify - returns ErrInvalidSignature if the request wasn't signed with the secret
+	Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error
+}
+
+func invalid(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSignature, reason)
+}
+
+func headerValue(req *triggers.HttpRequest, name string) string {
+	for k, v := range req.Header {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	return ""
+}
+
+func sign(secret []byte, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	for _, p := range parts {
+		mac.Write(p)
+	}
+
+	return mac.Sum(nil)
+}
+
+// matchesHex - compares a hex encoded signature to the expected mac in constant time
+func matchesHex(signature string, expected []byte) bool {
+	decoded, err := hex.DecodeString(signature)
+
+	return err == nil && hmac.Equal(decoded, expected)
+}
+
+// checkTimestamp - rejects timestamps, in unix seconds, further than the tolerance from now to prevent replays
+func checkTimestamp(timestamp string, now time.Time, tolerance time.Duration) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return invalid("invalid timestamp")
+	}
+
+	if age := now.Sub(time.Unix(ts, 0)); age > tolerance || age < -tolerance {
+		return invalid("timestamp outside the tolerance")
+	}
+
+	return nil
+}
+
+// parseTimestamped - parses a header of the form t={unix seconds},v1={hex signature}[,v1=...]
+func parseTimestamped(header string) (string, []string) {
+	timestamp := ""
+	signatures := []string{}
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			signatures = append(signatures, kv[1])
+		}
+	}
+
+	return timestamp, signatures
+}
+
+// Stripe - verifies the Stripe-Signature header, an HMAC-SHA256 of the timestamp and body
+type Stripe struct {
+	Tolerance time.Duration
+}
+
+func (s *Stripe) Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error {
+	header := headerValue(req, "Stripe-Signature")
+	if header == "" {
+		return invalid("missing Stripe-Signature header")
+	}
+
+	timestamp, signatures := parseTimestamped(header)
+	if err := checkTimestamp(timestamp, now, s.Tolerance); err != nil {
+		return err
+	}
+
+	expected := sign(secret, []byte(timestamp), []byte("."), req.Body)
+	// Stripe sends a signature for each of the endpoint's secrets while one is being rolled
+	for _, sig := range signatures {
+		if matchesHex(sig, expected) {
+			return nil
+		}
+	}
+
+	return invalid("no matching v1 signature")
+}
+
+// GitHub - verifies the X-Hub-Signature-256 header, an HMAC-SHA256 of the body
+type GitHub struct{}
+
+func (g *GitHub) Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error {
+	header := headerValue(req, "X-Hub-Signature-256")
+	if !strings.HasPrefix(header, "sha256=") {
+		return invalid("missing X-Hub-Signature-256 header")
+	}
+
+	if !matchesHex(strings.TrimPrefix(header, "sha256="), sign(secret, req.Body)) {
+		return invalid("signature doesn't match")
+	}
+
+	return nil
+}
+
+// Slack - verifies the X-Slack-Signature header, an HMAC-SHA256 of the version, X-Slack-Request-Timestamp and body
+type Slack struct {
+	Tolerance time.Duration
+}
+
+func (s *Slack) Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error {
+	header := headerValue(req, "X-Slack-Signature")
+	if !strings.HasPrefix(header, "v0=") {
+		return invalid("missing X-Slack-Signature header")
+	}
+
+	timestamp := headerValue(req, "X-Slack-Request-Timestamp")
+	if err := checkTimestamp(timestamp, now, s.Tolerance); err != nil {
+		return err
+	}
+
+	if !matchesHex(strings.TrimPrefix(header, "v0="), sign(secret, []byte("v0:"+timestamp+":"), req.Body)) {
+		return invalid("signature doesn't match")
+	}
+
+	return nil
+}
+
+// Hmac - verifies a header holding an HMAC-SHA256 of the body, hex or base64 encoded and optionally prefixed by sha256=
+type Hmac struct {
+	Header string
+}
+
+func (h *Hmac) Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error {
+	header := strings.TrimPrefix(headerValue(req, h.Header), "sha256=")
+	if header == "" {
+		return invalid("missing " + h.Header + " header")
+	}
+
+	expected := sign(secret, req.Body)
+	if matchesHex(header, expected) {
+		return nil
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(header); err == nil && hmac.Equal(decoded, expected) {
+		return nil
+	}
+
+	return invalid("signature doesn't match")
+}
+
+const (
+	// NitricHeader - the header holding the signature of requests made by other services with the invoke API
+	NitricHeader = "X-Nitric-Signature"
+	// CallerHeader - the header naming the service that sent a request with the invoke API
+	CallerHeader = "X-Nitric-Caller"
+)
+
+// Nitric - verifies the X-Nitric-Signature header of requests made by other services with the invoke API,
+// an HMAC-SHA256 of the timestamp, method, path, invoked service, caller and body in the same form as Stripe's
+type Nitric struct {
+	Tolerance time.Duration
+	// Service - the name of the service verifying requests, so requests signed for other services are refused
+	Service string
+}
+
+func signNitric(secret []byte, timestamp string, method string, path string, service string, caller string, body []byte) []byte {
+	return sign(secret, []byte(timestamp), []byte("."), []byte(strings.Join([]string{method, path, service, caller}, "\n")), []byte("\n"), body)
+}
+
+func (n *Nitric) Verify(req *triggers.HttpRequest, secret []byte, now time.Time) error {
+	header := headerValue(req, NitricHeader)
+	if header == "" {
+		return invalid("missing " + NitricHeader + " header")
+	}
+
+	timestamp, signatures := parseTimestamped(header)
+	if err := checkTimestamp(timestamp, now, n.Tolerance); err != nil {
+		return err
+	}
+
+	expected := signNitric(secret, timestamp, req.Method, req.Path, n.Service, headerValue(req, CallerHeader), req.Body)
+	for _, sig := range signatures {
+		if matchesHex(sig, expected) {
+			return nil
+		}
+	}
+
+	return invalid("signature doesn't match")
+}
+
+// SignNitric - returns the X-Nitric-Signature header of a request from the caller to a service, verified by the Nitric scheme
+func SignNitric(secret []byte, now time.Time, method string, path string, service string, caller string, body []byte) string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(signNitric(secret, timestamp, method, path, service, caller, body))
+}
+
+// Route - webhooks under a path prefix, signed with a secret from the secret plugin
+type Route struct {
+	Prefix string
+	Scheme Scheme
+	// The name of the secret holding the signing secret
+	Secret string
+}
+
+// ParseRoutes - parses a webhook routes configuration string, routes are separated by commas
+// and followed by semicolon separated settings.
+// e.g. "/payments;scheme=stripe;secret=stripe-webhook,/hooks;scheme=hmac;secret=hooks;header=X-Hook-Signature"
+func ParseRoutes(config string) ([]*Route, error) {
+	routes := make([]*Route, 0)
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ";")
+		r := &Route{
+			Prefix: "/" + strings.Trim(strings.TrimSpace(parts[0]), "/"),
+		}
+
+		settings := map[string]string{}
+		for _, attr := range parts[1:] {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid setting %s for route %s, expected key=value", attr, r.Prefix)
+			}
+			settings[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+
+		tolerance := DefaultTolerance
+		if t, ok := settings["tolerance"]; ok {
+			var err error
+			if tolerance, err = time.ParseDuration(t); err != nil || tolerance <= 0 {
+				return nil, fmt.Errorf("invalid tolerance %s for route %s, expected duration e.g. 5m", t, r.Prefix)
+			}
+		}
+
+		switch settings["scheme"] {
+		case "stripe":
+			r.Scheme = &Stripe{Tolerance: tolerance}
+		case "github":
+			r.Scheme = &GitHub{}
+		case "slack":
+			r.Scheme = &Slack{Tolerance: tolerance}
+		case "nitric":
+			r.Scheme = &Nitric{Tolerance: tolerance, Service: settings["service"]}
+		case "hmac":
+			header := settings["header"]
+			if header == "" {
+				header = DefaultHeader
+			}
+			r.Scheme = &Hmac{Header: header}
+		case "":
+			return nil, fmt.Errorf("no scheme given for route %s", r.Prefix)
+		default:
+			return nil, fmt.Errorf("unknown scheme %s for route %s, expected stripe, github, slack, hmac or nitric", settings["scheme"], r.Prefix)
+		}
+
+		if r.Secret = settings["secret"]; r.Secret == "" {
+			return nil, fmt.Errorf("no secret given for route %s", r.Prefix)
+		}
+
+		routes = append(routes, r)
+	}
+
+	// Most specific routes first
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].Prefix) > len(routes[j].Prefix)
+	})
+
+	return routes, nil
+}
+
+type cachedSecret struct {
+	value []byte
+	read  time.Time
+}
+
+// Verifier - verifies requests to webhook routes, with their secrets read from the secret plugin
+type Verifier struct {
+	routes  []*Route
+	secrets secret.SecretService
+	now     func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]*cachedSecret
+}
+
+// match - returns the most specific route for a request path, nil if the path isn't a webhook route
+func (v *Verifier) match(path string) *Route {
+	for _, r := range v.routes {
+		if utils.HasPathPrefix(path, r.Prefix) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// secret - returns the latest version of a secret, reading it again once the cached value is old
+func (v *Verifier) secret(name string) ([]byte, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if c, ok := v.cache[name]; ok && v.now().Sub(c.read) < secretRefreshInterval {
+		return c.value, nil
+	}
+
+	resp, err := v.secrets.Access(&secret.SecretVersion{
+		Secret:  &secret.Secret{Name: name},
+		Version: "latest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read webhook secret %s: %v", name, err)
+	}
+
+	v.cache[name] = &cachedSecret{value: resp.Value, read: v.now()}
+
+	return resp.Value, nil
+}
+
+// Verify - returns an error wrapping ErrInvalidSignature if a request to a webhook route isn't correctly signed,
+// other errors if its secret can't be read. Requests to other routes aren't verified
+func (v *Verifier) Verify(req *triggers.HttpRequest) error {
+	r := v.match(req.Path)
+	if r == nil {
+		return nil
+	}
+
+	key, err := v.secret(r.Secret)
+	if err != nil {
+		return err
+	}
+
+	return r.Scheme.Verify(req, key, v.now())
+}
+
+// New - returns a verifier for the webhook routes
+func New(routes []*Route, secrets secret.SecretService) (*Verifier, error) {
+	if secrets == nil {
+		return nil, fmt.Errorf("a secret plugin is required to verify webhooks")
+	}
+
+	return &Verifier{
+		routes:  routes,
+		secrets: secrets,
+		now:     time.Now,
+		cache:   map[string]*cachedSecret{},
+	}, nil
+}
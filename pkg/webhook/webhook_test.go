@@ -0,0 +1,228 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/webhook"
+)
+
+func mac(secret string, payload string) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(payload))
+	return m.Sum(nil)
+}
+
+type staticSecrets struct {
+	secret.UnimplementedSecretPlugin
+	values   map[string]string
+	accesses int
+}
+
+func (s *staticSecrets) Access(sv *secret.SecretVersion) (*secret.SecretAccessResponse, error) {
+	s.accesses++
+
+	value, ok := s.values[sv.Secret.Name]
+	if !ok {
+		return nil, fmt.Errorf("secret not found")
+	}
+
+	return &secret.SecretAccessResponse{SecretVersion: sv, Value: []byte(value)}, nil
+}
+
+var _ = Describe("Webhook", func() {
+	body := []byte(`{"id":"evt_1"}`)
+	now := time.Now()
+	ts := fmt.Sprint(now.Unix())
+
+	Context("Stripe", func() {
+		scheme := &webhook.Stripe{Tolerance: webhook.DefaultTolerance}
+
+		It("should accept a matching v1 signature", func() {
+			req := &triggers.HttpRequest{Body: body, Header: map[string][]string{
+				"Stripe-Signature": {"t=" + ts + ",v1=deadbeef,v1=" + hex.EncodeToString(mac("whsec", ts+"."+string(body)))},
+			}}
+
+			Expect(scheme.Verify(req, []byte("whsec"), now)).To(Succeed())
+		})
+
+		It("should reject an old timestamp", func() {
+			old := fmt.Sprint(now.Add(-time.Hour).Unix())
+			req := &triggers.HttpRequest{Body: body, Header: map[string][]string{
+				"Stripe-Signature": {"t=" + old + ",v1=" + hex.EncodeToString(mac("whsec", old+"."+string(body)))},
+			}}
+
+			Expect(errors.Is(scheme.Verify(req, []byte("whsec"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+		})
+	})
+
+	Context("GitHub", func() {
+		scheme := &webhook.GitHub{}
+
+		It("should accept a matching signature, whatever the header's case", func() {
+			req := &triggers.HttpRequest{Body: body, Header: map[string][]string{
+				"x-hub-signature-256": {"sha256=" + hex.EncodeToString(mac("gh", string(body)))},
+			}}
+
+			Expect(scheme.Verify(req, []byte("gh"), now)).To(Succeed())
+		})
+
+		It("should reject a signature made with another secret", func() {
+			req := &triggers.HttpRequest{Body: body, Header: map[string][]string{
+				"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(mac("other", string(body)))},
+			}}
+
+			Expect(errors.Is(scheme.Verify(req, []byte("gh"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+		})
+	})
+
+	Context("Slack", func() {
+		It("should accept a matching signature", func() {
+			req := &triggers.HttpRequest{Body: body, Header: map[string][]string{
+				"X-Slack-Request-Timestamp": {ts},
+				"X-Slack-Signature":         {"v0=" + hex.EncodeToString(mac("slack", "v0:"+ts+":"+string(body)))},
+			}}
+
+			Expect((&webhook.Slack{Tolerance: webhook.DefaultTolerance}).Verify(req, []byte("slack"), now)).To(Succeed())
+		})
+	})
+
+	Context("Hmac", func() {
+		scheme := &webhook.Hmac{Header: "X-Hook-Signature"}
+
+		It("should accept hex and base64 signatures", func() {
+			hexReq := &triggers.HttpRequest{Body: body, Header: map[string][]string{"X-Hook-Signature": {hex.EncodeToString(mac("s", string(body)))}}}
+			b64Req := &triggers.HttpRequest{Body: body, Header: map[string][]string{"X-Hook-Signature": {base64.StdEncoding.EncodeToString(mac("s", string(body)))}}}
+
+			Expect(scheme.Verify(hexReq, []byte("s"), now)).To(Succeed())
+			Expect(scheme.Verify(b64Req, []byte("s"), now)).To(Succeed())
+		})
+
+		It("should reject a missing signature", func() {
+			err := scheme.Verify(&triggers.HttpRequest{Body: body}, []byte("s"), now)
+
+			Expect(err).To(MatchError("invalid webhook signature: missing X-Hook-Signature header"))
+		})
+	})
+
+	Context("Nitric", func() {
+		signed := func(method string, path string, caller string) *triggers.HttpRequest {
+			return &triggers.HttpRequest{Method: method, Path: path, Body: body, Header: map[string][]string{
+				webhook.CallerHeader: {caller},
+				webhook.NitricHeader: {webhook.SignNitric([]byte("shared"), now, "POST", "/orders", "orders", "checkout", body)},
+			}}
+		}
+		scheme := &webhook.Nitric{Tolerance: webhook.DefaultTolerance, Service: "orders"}
+
+		It("should verify signatures made by SignNitric", func() {
+			req := signed("POST", "/orders", "checkout")
+
+			Expect(scheme.Verify(req, []byte("shared"), now)).To(Succeed())
+			Expect(errors.Is(scheme.Verify(req, []byte("other"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+		})
+
+		It("should reject signatures replayed to another method, path or service", func() {
+			Expect(errors.Is(scheme.Verify(signed("DELETE", "/orders", "checkout"), []byte("shared"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+			Expect(errors.Is(scheme.Verify(signed("POST", "/refunds", "checkout"), []byte("shared"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+
+			other := &webhook.Nitric{Tolerance: webhook.DefaultTolerance, Service: "payments"}
+			Expect(errors.Is(other.Verify(signed("POST", "/orders", "checkout"), []byte("shared"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+		})
+
+		It("should reject a spoofed caller", func() {
+			Expect(errors.Is(scheme.Verify(signed("POST", "/orders", "admin"), []byte("shared"), now), webhook.ErrInvalidSignature)).To(BeTrue())
+		})
+	})
+
+	Context("Verifier", func() {
+		routes, _ := webhook.ParseRoutes("/hooks/github;scheme=github;secret=gh")
+
+		It("should verify requests to webhook routes with the route's secret", func() {
+			secrets := &staticSecrets{values: map[string]string{"gh": "gh"}}
+			verifier, _ := webhook.New(routes, secrets)
+
+			signed := &triggers.HttpRequest{Path: "/hooks/github/push", Body: body, Header: map[string][]string{
+				"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(mac("gh", string(body)))},
+			}}
+			unsigned := &triggers.HttpRequest{Path: "/hooks/github", Body: body}
+
+			Expect(verifier.Verify(signed)).To(Succeed())
+			Expect(errors.Is(verifier.Verify(unsigned), webhook.ErrInvalidSignature)).To(BeTrue())
+
+			By("Caching the secret")
+			Expect(secrets.accesses).To(Equal(1))
+		})
+
+		It("should verify requests to webhook routes with doubled slashes or dot segments", func() {
+			verifier, _ := webhook.New(routes, &staticSecrets{values: map[string]string{"gh": "gh"}})
+
+			for _, path := range []string{"//hooks/github", "/hooks//github/push", "/./hooks/github", "/hooks/x/../github/"} {
+				err := verifier.Verify(&triggers.HttpRequest{Path: path, Body: body})
+				Expect(errors.Is(err, webhook.ErrInvalidSignature)).To(BeTrue(), path)
+			}
+		})
+
+		It("should not verify other routes", func() {
+			verifier, _ := webhook.New(routes, &staticSecrets{})
+
+			Expect(verifier.Verify(&triggers.HttpRequest{Path: "/hooks/githubber"})).To(Succeed())
+		})
+
+		It("should return an error when the secret can't be read", func() {
+			verifier, _ := webhook.New(routes, &staticSecrets{})
+
+			err := verifier.Verify(&triggers.HttpRequest{Path: "/hooks/github"})
+
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, webhook.ErrInvalidSignature)).To(BeFalse())
+		})
+	})
+
+	Context("ParseRoutes", func() {
+		It("should parse routes most specific first", func() {
+			routes, err := webhook.ParseRoutes("/hooks;scheme=hmac;secret=hooks, /hooks/stripe/;scheme=stripe;secret=stripe;tolerance=1m")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(routes).To(Equal([]*webhook.Route{
+				{Prefix: "/hooks/stripe", Scheme: &webhook.Stripe{Tolerance: time.Minute}, Secret: "stripe"},
+				{Prefix: "/hooks", Scheme: &webhook.Hmac{Header: webhook.DefaultHeader}, Secret: "hooks"},
+			}))
+		})
+
+		It("should reject unknown schemes", func() {
+			_, err := webhook.ParseRoutes("/hooks;scheme=paypal;secret=s")
+
+			Expect(err).Should(MatchError("unknown scheme paypal for route /hooks, expected stripe, github, slack, hmac or nitric"))
+		})
+
+		It("should require a secret", func() {
+			_, err := webhook.ParseRoutes("/hooks;scheme=github")
+
+			Expect(err).Should(MatchError("no secret given for route /hooks"))
+		})
+	})
+})
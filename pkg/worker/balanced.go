@@ -0,0 +1,256 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// DispatchStrategy - how a BalancedPool chooses between equivalent workers
+type DispatchStrategy string
+
+const (
+	// DispatchRoundRobin - equivalent workers are used in turn
+	DispatchRoundRobin DispatchStrategy = "round-robin"
+	// DispatchLeastInflight - the equivalent worker handling the fewest triggers is used
+	DispatchLeastInflight DispatchStrategy = "least-inflight"
+	// DispatchConsistentHash - triggers with the same key are handled by the same worker, while it's available.
+	// Http requests are keyed by the configured header and events by their ordering key, triggers without a key are used in turn
+	DispatchConsistentHash DispatchStrategy = "consistent-hash"
+)
+
+// ParseDispatchStrategy - returns the named dispatch strategy, round robin if the name is empty
+func ParseDispatchStrategy(name string) (DispatchStrategy, error) {
+	switch s := DispatchStrategy(name); s {
+	case "":
+		return DispatchRoundRobin, nil
+	case DispatchRoundRobin, DispatchLeastInflight, DispatchConsistentHash:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown dispatch strategy %s, expected one of %s, %s or %s", name, DispatchRoundRobin, DispatchLeastInflight, DispatchConsistentHash)
+	}
+}
+
+type DispatchOptions struct {
+	Strategy DispatchStrategy
+	// The header http requests are keyed by for consistent hashing
+	HashHeader string
+}
+
+// BalancedPool - A WorkerPool that distributes triggers across equivalent workers, e.g. the same route registered by several child processes.
+// Equivalent workers are chosen between using the pool's dispatch strategy, instead of always using the first one registered.
+type BalancedPool struct {
+	WorkerPool
+	strategy   DispatchStrategy
+	hashHeader string
+	next       uint64
+
+	lock sync.Mutex
+	// the number of triggers each worker is handling, for the least inflight strategy
+	inflight map[Worker]int
+}
+
+// equivalent - returns true if both workers handle the same triggers
+func equivalent(a, b Worker) bool {
+	a, b = unwrap(a), unwrap(b)
+
+	switch aw := a.(type) {
+	case *RouteWorker:
+		bw, ok := b.(*RouteWorker)
+		return ok && aw.api == bw.api && aw.path == bw.path && strings.Join(aw.methods, ",") == strings.Join(bw.methods, ",")
+	case *SubscriptionWorker:
+		bw, ok := b.(*SubscriptionWorker)
+		return ok && aw.topic == bw.topic
+	case *ScheduleWorker:
+		bw, ok := b.(*ScheduleWorker)
+		return ok && aw.key == bw.key
+	case *ConfigWorker:
+		bw, ok := b.(*ConfigWorker)
+		return ok && aw.key == bw.key
+	case *BucketNotificationWorker:
+		bw, ok := b.(*BucketNotificationWorker)
+		return ok && aw.bucket == bw.bucket && aw.notificationType == bw.notificationType && aw.keyPrefix == bw.keyPrefix
+	default:
+		return reflect.TypeOf(a) == reflect.TypeOf(b)
+	}
+}
+
+// wrapper - a worker wrapping one of the pool's workers
+type wrapper interface {
+	unwrap() Worker
+}
+
+// unwrap - returns the pool's worker a worker wraps, or the worker itself
+func unwrap(w Worker) Worker {
+	for {
+		ww, ok := w.(wrapper)
+		if !ok {
+			return w
+		}
+		w = ww.unwrap()
+	}
+}
+
+// rendezvous - returns the worker with the highest hash of the key and its identity,
+// so only the keys of a worker that's added or removed move to another worker
+func rendezvous(key string, ws []Worker) Worker {
+	var chosen Worker
+	var highest uint64
+
+	for _, w := range ws {
+		h := fnv.New64a()
+		_, _ = fmt.Fprintf(h, "%s/%p", key, unwrap(w))
+		if score := h.Sum64(); chosen == nil || score > highest {
+			chosen, highest = w, score
+		}
+	}
+
+	return chosen
+}
+
+// hashKey - returns the key of the trigger for consistent hashing, empty if it has none
+func (p *BalancedPool) hashKey(opts *GetWorkerOptions) string {
+	if opts.Http != nil && p.hashHeader != "" {
+		if v := headerValue(opts.Http.Header, p.hashHeader); len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	if opts.Event != nil {
+		return opts.Event.OrderingKey
+	}
+
+	return ""
+}
+
+// leastInflight - returns the worker handling the fewest triggers, the first of them if several are
+func (p *BalancedPool) leastInflight(ws []Worker) Worker {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	chosen := ws[0]
+	for _, w := range ws[1:] {
+		if p.inflight[w] < p.inflight[chosen] {
+			chosen = w
+		}
+	}
+
+	return chosen
+}
+
+func (p *BalancedPool) started(w Worker) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.inflight[w]++
+}
+
+func (p *BalancedPool) finished(w Worker) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.inflight[w]--; p.inflight[w] <= 0 {
+		delete(p.inflight, w)
+	}
+}
+
+// GetWorker - Retrieves the worker the pool's dispatch strategy chooses,
+// from the workers equivalent to the one the underlying pool would use
+func (p *BalancedPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := p.WorkerPool.GetWorkers(&GetWorkerOptions{
+		Filter: func(w Worker) bool {
+			return equivalent(wrkr, w) && (opts.Filter == nil || opts.Filter(w))
+		},
+	})
+
+	key := ""
+	if p.strategy == DispatchConsistentHash {
+		key = p.hashKey(opts)
+	}
+
+	switch {
+	case len(ws) < 2:
+	case p.strategy == DispatchLeastInflight:
+		wrkr = p.leastInflight(ws)
+	case key != "":
+		wrkr = rendezvous(key, ws)
+	default:
+		wrkr = ws[atomic.AddUint64(&p.next, 1)%uint64(len(ws))]
+	}
+
+	if p.strategy == DispatchLeastInflight {
+		return &inflightWorker{
+			Worker: wrkr,
+			pool:   p,
+		}, nil
+	}
+
+	return wrkr, nil
+}
+
+// inflightWorker - counts the triggers a worker is handling, for the least inflight strategy
+type inflightWorker struct {
+	Worker
+	pool *BalancedPool
+}
+
+func (w *inflightWorker) unwrap() Worker {
+	return w.Worker
+}
+
+func (w *inflightWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	w.pool.started(w.Worker)
+	defer w.pool.finished(w.Worker)
+
+	return w.Worker.HandleHttpRequest(trigger)
+}
+
+func (w *inflightWorker) HandleEvent(trigger *triggers.Event) error {
+	w.pool.started(w.Worker)
+	defer w.pool.finished(w.Worker)
+
+	return w.Worker.HandleEvent(trigger)
+}
+
+// NewBalancedPool - Wraps a worker pool, distributing triggers across its equivalent workers using the given dispatch strategy,
+// in turn if opts is nil
+func NewBalancedPool(pool WorkerPool, opts *DispatchOptions) WorkerPool {
+	p := &BalancedPool{
+		WorkerPool: pool,
+		strategy:   DispatchRoundRobin,
+		inflight:   make(map[Worker]int),
+	}
+
+	if opts != nil {
+		if opts.Strategy != "" {
+			p.strategy = opts.Strategy
+		}
+		p.hashHeader = opts.HashHeader
+	}
+
+	return p
+}
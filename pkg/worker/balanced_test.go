@@ -0,0 +1,193 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("BalancedPool", func() {
+	When("several workers handle the same route", func() {
+		pool := NewBalancedPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), nil)
+
+		first := NewRouteWorker(nil, &RouteWorkerOptions{Api: "main", Path: "/test", Methods: []string{"GET"}})
+		second := NewRouteWorker(nil, &RouteWorkerOptions{Api: "main", Path: "/test", Methods: []string{"GET"}})
+		other := NewRouteWorker(nil, &RouteWorkerOptions{Api: "main", Path: "/other", Methods: []string{"GET"}})
+		_ = pool.AddWorker(first)
+		_ = pool.AddWorker(other)
+		_ = pool.AddWorker(second)
+
+		It("should use each of them in turn", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+
+			used := map[Worker]int{}
+			for i := 0; i < 4; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+				Expect(err).ShouldNot(HaveOccurred())
+				used[wrkr]++
+			}
+
+			Expect(used).To(Equal(map[Worker]int{first: 2, second: 2}))
+		})
+	})
+
+	When("several workers subscribe to the same topic", func() {
+		pool := NewBalancedPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), nil)
+
+		first := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "test"})
+		second := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "test"})
+		other := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "other"})
+		_ = pool.AddWorker(first)
+		_ = pool.AddWorker(second)
+		_ = pool.AddWorker(other)
+
+		It("should only use workers subscribed to the event's topic", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+			used := map[Worker]int{}
+			for i := 0; i < 4; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				used[wrkr]++
+			}
+
+			Expect(used).To(Equal(map[Worker]int{first: 2, second: 2}))
+		})
+	})
+
+	When("dispatching to the least inflight worker", func() {
+		pool := NewBalancedPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), &DispatchOptions{Strategy: DispatchLeastInflight})
+
+		first := NewSubscriptionWorker(&recordingAdapter{handleTime: 100 * time.Millisecond}, &SubscriptionWorkerOptions{Topic: "test"})
+		second := NewSubscriptionWorker(&recordingAdapter{handleTime: 100 * time.Millisecond}, &SubscriptionWorkerOptions{Topic: "test"})
+		_ = pool.AddWorker(first)
+		_ = pool.AddWorker(second)
+
+		It("should use the worker handling the fewest events", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+			busy, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			done := make(chan error)
+			go func() {
+				done <- busy.HandleEvent(evt)
+			}()
+
+			Eventually(func() int {
+				bp := pool.(*BalancedPool)
+				bp.lock.Lock()
+				defer bp.lock.Unlock()
+				return bp.inflight[unwrap(busy)]
+			}).Should(Equal(1))
+
+			idle, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(idle)).ToNot(Equal(unwrap(busy)))
+
+			Expect(<-done).To(Succeed())
+			Expect(pool.(*BalancedPool).inflight).To(BeEmpty())
+		})
+	})
+
+	When("dispatching by consistent hash", func() {
+		pool := NewBalancedPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), &DispatchOptions{
+			Strategy:   DispatchConsistentHash,
+			HashHeader: "X-Session-Id",
+		})
+
+		workers := []Worker{}
+		for i := 0; i < 4; i++ {
+			w := NewRouteWorker(nil, &RouteWorkerOptions{Api: "main", Path: "/test", Methods: []string{"GET"}})
+			workers = append(workers, w)
+			_ = pool.AddWorker(w)
+		}
+
+		It("should use the same worker for requests with the same key", func() {
+			for _, session := range []string{"a", "b", "c", "d", "e"} {
+				req := &triggers.HttpRequest{Method: "GET", Path: "/test", Header: map[string][]string{"x-session-id": {session}}}
+
+				first, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				for i := 0; i < 3; i++ {
+					wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(wrkr).To(Equal(first))
+				}
+			}
+		})
+
+		It("should only move the keys of a removed worker", func() {
+			before := map[string]Worker{}
+			keys := []string{}
+			for i := 0; i < 50; i++ {
+				keys = append(keys, string(rune('a'+i%26))+string(rune('A'+i/26)))
+			}
+
+			get := func(key string) Worker {
+				req := &triggers.HttpRequest{Method: "GET", Path: "/test", Header: map[string][]string{"X-Session-Id": {key}}}
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+				Expect(err).ShouldNot(HaveOccurred())
+				return wrkr
+			}
+
+			for _, k := range keys {
+				before[k] = get(k)
+			}
+
+			Expect(pool.RemoveWorker(workers[0])).To(Succeed())
+
+			for _, k := range keys {
+				if before[k] != workers[0] {
+					Expect(get(k)).To(Equal(before[k]))
+				}
+			}
+		})
+
+		It("should use workers in turn for requests without a key", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+
+			used := map[Worker]int{}
+			for i := 0; i < 6; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+				Expect(err).ShouldNot(HaveOccurred())
+				used[wrkr]++
+			}
+
+			Expect(used).To(HaveLen(3))
+		})
+	})
+
+	When("parsing a dispatch strategy", func() {
+		It("should default to round robin", func() {
+			Expect(ParseDispatchStrategy("")).To(Equal(DispatchRoundRobin))
+		})
+
+		It("should accept known strategies", func() {
+			Expect(ParseDispatchStrategy("consistent-hash")).To(Equal(DispatchConsistentHash))
+		})
+
+		It("should refuse unknown strategies", func() {
+			_, err := ParseDispatchStrategy("random")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
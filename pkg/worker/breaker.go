@@ -0,0 +1,240 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// BreakerOptions - when the circuits of a BreakerPool's workers open
+type BreakerOptions struct {
+	// The consecutive failures of a worker that open its circuit
+	FailureThreshold int
+	// How long a worker's circuit stays open before a trigger is sent to test it
+	OpenDuration time.Duration
+	// Called with a description of the worker when its circuit opens, and the number of times it has opened
+	// since the worker last handled a trigger. May be nil
+	OnOpen func(worker string, opens int)
+}
+
+// workerCircuit - the state of triggers to a worker. The circuit opens after too many consecutive failures,
+// removing the worker from dispatch until OpenDuration has passed, then lets one trigger through to test the worker
+type workerCircuit struct {
+	failures  int
+	opens     int
+	openUntil time.Time
+	testing   bool
+}
+
+// BreakerPool - A WorkerPool that isolates failing workers.
+// Each worker has a circuit breaker, which removes the worker from dispatch after too many consecutive failures,
+// so one bad worker doesn't fail the triggers that equivalent workers could handle.
+type BreakerPool struct {
+	WorkerPool
+	opts *BreakerOptions
+	now  func() time.Time
+
+	lock     sync.Mutex
+	circuits map[Worker]*workerCircuit
+	// the number of times circuits have opened
+	opened uint64
+}
+
+// describeWorker - describes the triggers a worker handles, for logs and events
+func describeWorker(w Worker) string {
+	switch ww := unwrap(w).(type) {
+	case *RouteWorker:
+		return fmt.Sprintf("route worker for %s %s", ww.api, ww.path)
+	case *SubscriptionWorker:
+		return fmt.Sprintf("subscription worker for topic %s", ww.topic)
+	case *ScheduleWorker:
+		return fmt.Sprintf("schedule worker for %s", ww.key)
+	case *ConfigWorker:
+		return fmt.Sprintf("config worker for %s", ww.key)
+	case *BucketNotificationWorker:
+		return fmt.Sprintf("bucket notification worker for %s", ww.bucket)
+	default:
+		return fmt.Sprintf("%T worker", ww)
+	}
+}
+
+// closed - returns true if triggers can be sent to the worker
+func (p *BreakerPool) closed(w Worker) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	cb, ok := p.circuits[w]
+	if !ok || cb.failures < p.opts.FailureThreshold {
+		return true
+	}
+
+	return !cb.testing && !p.now().Before(cb.openUntil)
+}
+
+// claim - marks an open circuit whose open duration has passed as being tested by a trigger
+func (p *BreakerPool) claim(w Worker) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if cb, ok := p.circuits[w]; ok && cb.failures >= p.opts.FailureThreshold {
+		cb.testing = true
+	}
+}
+
+// record - records the outcome of a trigger handled by a worker, opening its circuit after too many consecutive failures
+func (p *BreakerPool) record(w Worker, failed bool) {
+	p.lock.Lock()
+
+	cb, ok := p.circuits[w]
+	if !ok {
+		cb = &workerCircuit{}
+		p.circuits[w] = cb
+	}
+	cb.testing = false
+
+	if !failed {
+		cb.failures = 0
+		cb.opens = 0
+		p.lock.Unlock()
+		return
+	}
+
+	cb.failures++
+	if cb.failures < p.opts.FailureThreshold {
+		p.lock.Unlock()
+		return
+	}
+
+	cb.openUntil = p.now().Add(p.opts.OpenDuration)
+	cb.opens++
+	p.opened++
+	opens := cb.opens
+	p.lock.Unlock()
+
+	if p.opts.OnOpen != nil {
+		p.opts.OnOpen(describeWorker(w), opens)
+	}
+}
+
+// GetWorker - Retrieves a worker whose circuit is closed from the underlying pool
+func (p *BreakerPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(&GetWorkerOptions{
+		Http:  opts.Http,
+		Event: opts.Event,
+		Filter: func(w Worker) bool {
+			return p.closed(w) && (opts.Filter == nil || opts.Filter(w))
+		},
+	})
+	if err != nil {
+		if _, openErr := p.WorkerPool.GetWorker(opts); openErr == nil {
+			return nil, fmt.Errorf("the circuits of the workers for this trigger are open, after they failed too many times")
+		}
+
+		return nil, err
+	}
+
+	p.claim(unwrap(wrkr))
+
+	return &breakerWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+// RemoveWorker - Removes a worker from the underlying pool, forgetting its circuit
+func (p *BreakerPool) RemoveWorker(wrkr Worker) error {
+	if err := p.WorkerPool.RemoveWorker(wrkr); err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.circuits, wrkr)
+
+	return nil
+}
+
+// Handler - serves the circuit breaker metrics in the Prometheus text exposition format
+func (p *BreakerPool) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		open := 0
+		for _, cb := range p.circuits {
+			if cb.failures >= p.opts.FailureThreshold {
+				open++
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_worker_circuit_opens_total Times the circuit of a worker has opened.\n")
+		fmt.Fprintf(w, "# TYPE nitric_worker_circuit_opens_total counter\n")
+		fmt.Fprintf(w, "nitric_worker_circuit_opens_total %d\n", p.opened)
+		fmt.Fprintf(w, "# HELP nitric_worker_circuits_open Workers whose circuits are open.\n")
+		fmt.Fprintf(w, "# TYPE nitric_worker_circuits_open gauge\n")
+		fmt.Fprintf(w, "nitric_worker_circuits_open %d\n", open)
+	})
+}
+
+// failed - returns true if a trigger failed with err because of the worker,
+// rather than the worker asking for it to be redelivered later or dead-lettered
+func failed(err error) bool {
+	if err == nil || IsPermanent(err) {
+		return false
+	}
+
+	_, delayed := RetryAfter(err)
+
+	return !delayed
+}
+
+type breakerWorker struct {
+	Worker
+	pool *BreakerPool
+}
+
+func (w *breakerWorker) unwrap() Worker {
+	return w.Worker
+}
+
+func (w *breakerWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	response, err := w.Worker.HandleHttpRequest(trigger)
+	w.pool.record(unwrap(w.Worker), failed(err))
+
+	return response, err
+}
+
+func (w *breakerWorker) HandleEvent(trigger *triggers.Event) error {
+	err := w.Worker.HandleEvent(trigger)
+	w.pool.record(unwrap(w.Worker), failed(err))
+
+	return err
+}
+
+// NewBreakerPool - Wraps a worker pool, removing workers from dispatch while their circuits are open
+func NewBreakerPool(pool WorkerPool, opts *BreakerOptions) *BreakerPool {
+	return &BreakerPool{
+		WorkerPool: pool,
+		opts:       opts,
+		now:        time.Now,
+		circuits:   make(map[Worker]*workerCircuit),
+	}
+}
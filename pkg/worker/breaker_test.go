@@ -0,0 +1,146 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// failingAdapter - fails events while fail is set
+type failingAdapter struct {
+	fail bool
+}
+
+func (a *failingAdapter) HandleEvent(trigger *triggers.Event) error {
+	if a.fail {
+		return fmt.Errorf("worker crashed")
+	}
+
+	return nil
+}
+
+func (a *failingAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+var _ = Describe("BreakerPool", func() {
+	evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+	When("a worker fails too many times in a row", func() {
+		now := time.Now()
+		opened := []int{}
+
+		bad := &failingAdapter{fail: true}
+		badWrkr := NewSubscriptionWorker(bad, &SubscriptionWorkerOptions{Topic: "test"})
+		goodWrkr := NewSubscriptionWorker(&failingAdapter{}, &SubscriptionWorkerOptions{Topic: "test"})
+
+		pool := NewBreakerPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), &BreakerOptions{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+			OnOpen: func(wrkr string, opens int) {
+				opened = append(opened, opens)
+			},
+		})
+		pool.now = func() time.Time { return now }
+		// subscription workers registered later are preferred
+		_ = pool.AddWorker(goodWrkr)
+		_ = pool.AddWorker(badWrkr)
+
+		It("should open its circuit and dispatch to the other workers", func() {
+			for i := 0; i < 2; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(unwrap(wrkr)).To(Equal(badWrkr))
+				Expect(wrkr.HandleEvent(evt)).ToNot(Succeed())
+			}
+
+			Expect(opened).To(Equal([]int{1}))
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(wrkr)).To(Equal(goodWrkr))
+		})
+
+		It("should serve the number of open circuits", func() {
+			rec := httptest.NewRecorder()
+			pool.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/breakers", nil))
+			Expect(rec.Body.String()).To(ContainSubstring("nitric_worker_circuits_open 1"))
+			Expect(rec.Body.String()).To(ContainSubstring("nitric_worker_circuit_opens_total 1"))
+		})
+
+		It("should reopen the circuit if the test trigger fails", func() {
+			now = now.Add(2 * time.Minute)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(wrkr)).To(Equal(badWrkr))
+
+			By("only sending one trigger to test the worker")
+			other, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(other)).To(Equal(goodWrkr))
+
+			Expect(wrkr.HandleEvent(evt)).ToNot(Succeed())
+			Expect(opened).To(Equal([]int{1, 2}))
+		})
+
+		It("should close the circuit once the worker recovers", func() {
+			now = now.Add(2 * time.Minute)
+			bad.fail = false
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(wrkr)).To(Equal(badWrkr))
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+			wrkr, err = pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(unwrap(wrkr)).To(Equal(badWrkr))
+		})
+	})
+
+	When("the circuits of every worker for a trigger are open", func() {
+		wrkr := NewSubscriptionWorker(&failingAdapter{fail: true}, &SubscriptionWorkerOptions{Topic: "test"})
+		pool := NewBreakerPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), &BreakerOptions{
+			FailureThreshold: 1,
+			OpenDuration:     time.Minute,
+		})
+		_ = pool.AddWorker(wrkr)
+
+		It("should fail the trigger without sending it to a worker", func() {
+			w, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.HandleEvent(evt)).ToNot(Succeed())
+
+			_, err = pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).To(MatchError(ContainSubstring("circuits")))
+		})
+	})
+
+	When("the worker asks for an event to be redelivered later", func() {
+		It("should not count it as a failure", func() {
+			Expect(failed(&RetryError{After: time.Second})).To(BeFalse())
+			Expect(failed(&PermanentError{})).To(BeFalse())
+			Expect(failed(fmt.Errorf("crashed"))).To(BeTrue())
+		})
+	})
+})
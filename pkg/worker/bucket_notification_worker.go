@@ -0,0 +1,140 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// BucketTopicPrefix - prefixes the topics of bucket notification events, so they can't be mistaken for events published to a topic
+const BucketTopicPrefix = "bucket:"
+
+// BucketTopic - returns the topic of the events sent when items are written to or deleted from a bucket
+func BucketTopic(bucket string) string {
+	return BucketTopicPrefix + bucket
+}
+
+// NotificationType - the change to an item a bucket notification is sent for
+type NotificationType string
+
+const (
+	// NotificationAll - matches every notification type, it isn't sent for any change
+	NotificationAll     NotificationType = ""
+	NotificationCreated NotificationType = "created"
+	NotificationDeleted NotificationType = "deleted"
+)
+
+// BucketNotification - the payload of a bucket notification event
+type BucketNotification struct {
+	Bucket string           `json:"bucket"`
+	Key    string           `json:"key"`
+	Type   NotificationType `json:"type"`
+	// Output - the key the item's transformed output is written to, empty if no bucket transform applies to it
+	Output string `json:"output,omitempty"`
+}
+
+// Event - returns the event sent for the notification, with the provider's ID for it
+func (n *BucketNotification) Event(id string) *triggers.Event {
+	payload, _ := json.Marshal(n)
+
+	return &triggers.Event{
+		ID:      id,
+		Topic:   BucketTopic(n.Bucket),
+		Payload: payload,
+	}
+}
+
+// ParseBucketNotification - returns the notification an event was sent for, false if it isn't a bucket notification event
+func ParseBucketNotification(trigger *triggers.Event) (*BucketNotification, bool) {
+	if !strings.HasPrefix(trigger.Topic, BucketTopicPrefix) {
+		return nil, false
+	}
+
+	notification := &BucketNotification{}
+	if err := json.Unmarshal(trigger.Payload, notification); err != nil || notification.Key == "" {
+		return nil, false
+	}
+
+	return notification, true
+}
+
+// BucketNotificationWorker - Worker representation for a handler of bucket notifications
+type BucketNotificationWorker struct {
+	bucket           string
+	notificationType NotificationType
+	keyPrefix        string
+	Adapter
+}
+
+var _ Worker = &BucketNotificationWorker{}
+
+// Bucket - the bucket whose notifications the worker handles
+func (b *BucketNotificationWorker) Bucket() string {
+	return b.bucket
+}
+
+// NotificationType - the type of notifications the worker handles, NotificationAll if it handles every type
+func (b *BucketNotificationWorker) NotificationType() NotificationType {
+	return b.notificationType
+}
+
+// KeyPrefix - the prefix of the keys whose notifications the worker handles, every key if empty
+func (b *BucketNotificationWorker) KeyPrefix() string {
+	return b.keyPrefix
+}
+
+func (b *BucketNotificationWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return false
+}
+
+func (b *BucketNotificationWorker) HandlesEvent(trigger *triggers.Event) bool {
+	if trigger.Topic != BucketTopic(b.bucket) {
+		return false
+	}
+
+	notification, ok := ParseBucketNotification(trigger)
+	if !ok {
+		return false
+	}
+
+	if b.notificationType != NotificationAll && notification.Type != b.notificationType {
+		return false
+	}
+
+	return strings.HasPrefix(notification.Key, b.keyPrefix)
+}
+
+func (b *BucketNotificationWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("bucket notification workers cannot handle HTTP requests")
+}
+
+type BucketNotificationWorkerOptions struct {
+	Bucket    string
+	Type      NotificationType
+	KeyPrefix string
+}
+
+func NewBucketNotificationWorker(adapter Adapter, opts *BucketNotificationWorkerOptions) *BucketNotificationWorker {
+	return &BucketNotificationWorker{
+		bucket:           opts.Bucket,
+		notificationType: opts.Type,
+		keyPrefix:        opts.KeyPrefix,
+		Adapter:          adapter,
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("BucketNotificationWorker", func() {
+	wrkr := NewBucketNotificationWorker(nil, &BucketNotificationWorkerOptions{
+		Bucket:    "images",
+		Type:      NotificationCreated,
+		KeyPrefix: "uploads/",
+	})
+
+	Context("Http", func() {
+		When("calling HandlesHttpRequest", func() {
+			It("should return false", func() {
+				Expect(wrkr.HandlesHttpRequest(&triggers.HttpRequest{})).To(BeFalse())
+			})
+		})
+	})
+
+	Context("Event", func() {
+		When("calling HandlesEvent with a matching notification", func() {
+			It("should return true", func() {
+				evt := (&BucketNotification{Bucket: "images", Key: "uploads/cat.png", Type: NotificationCreated}).Event("1")
+				Expect(wrkr.HandlesEvent(evt)).To(BeTrue())
+			})
+		})
+
+		When("calling HandlesEvent with a notification of another type", func() {
+			It("should return false", func() {
+				evt := (&BucketNotification{Bucket: "images", Key: "uploads/cat.png", Type: NotificationDeleted}).Event("1")
+				Expect(wrkr.HandlesEvent(evt)).To(BeFalse())
+			})
+		})
+
+		When("calling HandlesEvent with a key outside the prefix", func() {
+			It("should return false", func() {
+				evt := (&BucketNotification{Bucket: "images", Key: "thumbnails/cat.png", Type: NotificationCreated}).Event("1")
+				Expect(wrkr.HandlesEvent(evt)).To(BeFalse())
+			})
+		})
+
+		When("calling HandlesEvent with a topic named after the bucket", func() {
+			It("should return false", func() {
+				Expect(wrkr.HandlesEvent(&triggers.Event{Topic: "images"})).To(BeFalse())
+			})
+		})
+	})
+})
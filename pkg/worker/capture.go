@@ -0,0 +1,111 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/capture"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// CapturePool - A WorkerPool that records the triggers handled by its workers to a capture store, so they can be replayed.
+// Triggers are captured before they're handled, so triggers that crash or hang the worker are captured too.
+type CapturePool struct {
+	WorkerPool
+	store capture.Store
+}
+
+var _ capture.Replayer = &CapturePool{}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will capture the triggers it handles
+func (p *CapturePool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureWorker{
+		Worker: wrkr,
+		store:  p.store,
+	}, nil
+}
+
+// Replay - handles a captured trigger again with a worker from the underlying pool, so the replay isn't captured itself.
+// The captured deadline is dropped, replayed triggers have the deadlines of the pools they're replayed through
+func (p *CapturePool) Replay(c *capture.Capture) (*triggers.HttpResponse, error) {
+	if c.Request != nil {
+		request := *c.Request
+		request.Deadline = time.Time{}
+
+		wrkr, err := p.WorkerPool.GetWorker(&GetWorkerOptions{
+			Http: &request,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to replay capture %s: %v", c.ID, err)
+		}
+
+		return wrkr.HandleHttpRequest(&request)
+	}
+
+	if c.Event != nil {
+		event := *c.Event
+		event.Deadline = time.Time{}
+
+		wrkr, err := p.WorkerPool.GetWorker(&GetWorkerOptions{
+			Event: &event,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to replay capture %s: %v", c.ID, err)
+		}
+
+		return nil, wrkr.HandleEvent(&event)
+	}
+
+	return nil, fmt.Errorf("capture %s has no trigger to replay", c.ID)
+}
+
+type captureWorker struct {
+	Worker
+	store capture.Store
+}
+
+// save - failing to capture a trigger shouldn't fail the trigger
+func (w *captureWorker) save(trigger triggers.Trigger) {
+	c := capture.New(trigger)
+	if err := w.store.Save(c); err != nil {
+		log.Default().Printf("error capturing trigger %s: %v", c.ID, err)
+	}
+}
+
+func (w *captureWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	w.save(trigger)
+	return w.Worker.HandleHttpRequest(trigger)
+}
+
+func (w *captureWorker) HandleEvent(trigger *triggers.Event) error {
+	w.save(trigger)
+	return w.Worker.HandleEvent(trigger)
+}
+
+// NewCapturePool - Wraps a worker pool, capturing the triggers handled by its workers to the given store
+func NewCapturePool(pool WorkerPool, store capture.Store) *CapturePool {
+	return &CapturePool{
+		WorkerPool: pool,
+		store:      store,
+	}
+}
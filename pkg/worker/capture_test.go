@@ -0,0 +1,120 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/capture"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type memoryCaptures struct {
+	captures []*capture.Capture
+}
+
+func (m *memoryCaptures) Save(c *capture.Capture) error {
+	m.captures = append(m.captures, c)
+	return nil
+}
+
+func (m *memoryCaptures) Get(id string) (*capture.Capture, error) {
+	for _, c := range m.captures {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("capture %s not found", id)
+}
+
+func (m *memoryCaptures) List() ([]*capture.Capture, error) {
+	return m.captures, nil
+}
+
+var _ = Describe("CapturePool", func() {
+	When("a worker handles an event", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		store := &memoryCaptures{}
+
+		pool := NewCapturePool(NewProcessPool(&ProcessPoolOptions{}), store)
+		_ = pool.AddWorker(mockWrkr)
+
+		evt := &triggers.Event{
+			ID:       "1234",
+			Topic:    "test",
+			Payload:  []byte(`{"value":"test"}`),
+			Deadline: time.Now().Add(time.Minute),
+		}
+
+		It("should capture the event", func() {
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+			Expect(store.captures).To(HaveLen(1))
+			Expect(store.captures[0].Event).To(Equal(evt))
+
+			ctrl.Finish()
+		})
+
+		It("should replay the captured event without capturing it again", func() {
+			mockWrkr.EXPECT().HandlesEvent(gomock.Any()).Return(true)
+			mockWrkr.EXPECT().HandleEvent(gomock.Any()).DoAndReturn(func(replayed *triggers.Event) error {
+				Expect(replayed.ID).To(Equal("1234"))
+				Expect(replayed.Payload).To(Equal(evt.Payload))
+				Expect(replayed.Deadline.IsZero()).To(BeTrue())
+				return nil
+			})
+
+			resp, err := pool.Replay(store.captures[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp).To(BeNil())
+			Expect(store.captures).To(HaveLen(1))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("replaying a captured http request", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		store := &memoryCaptures{}
+
+		pool := NewCapturePool(NewProcessPool(&ProcessPoolOptions{}), store)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return the worker's response", func() {
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).Return(&triggers.HttpResponse{StatusCode: 201}, nil)
+
+			resp, err := pool.Replay(capture.New(&triggers.HttpRequest{Method: "POST", Path: "/orders"}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(201))
+			Expect(store.captures).To(BeEmpty())
+
+			ctrl.Finish()
+		})
+	})
+})
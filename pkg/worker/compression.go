@@ -0,0 +1,276 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+const (
+	EncodingGzip    = "gzip"
+	EncodingBrotli  = "br"
+	EncodingDeflate = "deflate"
+
+	// DefaultCompressionMinSize - responses smaller than this many bytes aren't worth compressing
+	DefaultCompressionMinSize = 1024
+)
+
+// errBodyTooLarge - returned when a request body decompresses to more than the maximum body size
+var errBodyTooLarge = fmt.Errorf("decompressed request body is too large")
+
+// CompressionPool - A WorkerPool that decompresses compressed http request bodies before they're handled
+// and compresses http responses for clients that accept a compressed encoding.
+type CompressionPool struct {
+	WorkerPool
+	minSize int
+	// the largest a request body can decompress to, unlimited if zero
+	maxBodySize int
+}
+
+// headerValue - returns the values of a request header, matching its name case insensitively
+func headerValue(header map[string][]string, name string) []string {
+	for k, v := range header {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func deleteHeader(header map[string][]string, name string) {
+	for k := range header {
+		if strings.EqualFold(k, name) {
+			delete(header, k)
+		}
+	}
+}
+
+// decompress - returns the body decoded from its content encodings, which are listed in the order they were applied.
+// Returns errBodyTooLarge if it decodes to more than maxSize bytes, unless maxSize is zero
+func decompress(encodings []string, body []byte, maxSize int) ([]byte, error) {
+	codings := make([]string, 0)
+	for _, encoding := range encodings {
+		for _, coding := range strings.Split(encoding, ",") {
+			if coding = strings.ToLower(strings.TrimSpace(coding)); coding != "" && coding != "identity" {
+				codings = append(codings, coding)
+			}
+		}
+	}
+
+	for i := len(codings) - 1; i >= 0; i-- {
+		var r io.Reader
+		var err error
+		switch codings[i] {
+		case EncodingGzip, "x-gzip":
+			r, err = gzip.NewReader(bytes.NewReader(body))
+		case EncodingBrotli:
+			r = brotli.NewReader(bytes.NewReader(body))
+		case EncodingDeflate:
+			r, err = zlib.NewReader(bytes.NewReader(body))
+		default:
+			return nil, fmt.Errorf("unsupported content encoding %s", codings[i])
+		}
+
+		if err == nil {
+			if maxSize > 0 {
+				// Read a byte past the limit, so bodies that exceed it can be told apart from those that reach it
+				r = io.LimitReader(r, int64(maxSize)+1)
+			}
+			body, err = io.ReadAll(r)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s request body: %v", codings[i], err)
+		}
+
+		if maxSize > 0 && len(body) > maxSize {
+			return nil, errBodyTooLarge
+		}
+	}
+
+	return body, nil
+}
+
+// acceptedEncoding - returns the preferred compressed encoding listed in an Accept-Encoding header, empty if none are accepted.
+// Brotli is preferred over gzip when the client weights them equally.
+func acceptedEncoding(acceptEncodings []string) string {
+	weights := map[string]float64{}
+	for _, acceptEncoding := range acceptEncodings {
+		for _, coding := range strings.Split(acceptEncoding, ",") {
+			params := strings.Split(coding, ";")
+			name := strings.ToLower(strings.TrimSpace(params[0]))
+			weight := 1.0
+
+			for _, param := range params[1:] {
+				param = strings.TrimSpace(param)
+				if strings.HasPrefix(param, "q=") {
+					if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+						weight = q
+					}
+				}
+			}
+
+			weights[name] = weight
+		}
+	}
+
+	best, bestWeight := "", 0.0
+	for _, encoding := range []string{EncodingBrotli, EncodingGzip} {
+		weight, ok := weights[encoding]
+		if !ok {
+			weight, ok = weights["*"]
+		}
+
+		if ok && weight > bestWeight {
+			best, bestWeight = encoding, weight
+		}
+	}
+
+	return best
+}
+
+// compressible - returns true if a content type isn't already compressed
+func compressible(contentType string) bool {
+	contentType = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+
+	switch {
+	case contentType == "image/svg+xml":
+		return true
+	case strings.HasPrefix(contentType, "image/"),
+		strings.HasPrefix(contentType, "video/"),
+		strings.HasPrefix(contentType, "audio/"),
+		strings.HasPrefix(contentType, "font/woff"):
+		return false
+	}
+
+	switch contentType {
+	case "application/zip", "application/gzip", "application/x-gzip", "application/zstd",
+		"application/x-7z-compressed", "application/x-bzip2", "application/x-rar-compressed",
+		"application/pdf", "application/octet-stream":
+		return false
+	}
+
+	return true
+}
+
+// GetWorker - Retrieves a worker from the underlying pool.
+// Compressed http request bodies are decompressed before a worker is selected to handle them.
+func (p *CompressionPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	if encodings := headerValue(opts.Http.Header, "Content-Encoding"); len(encodings) > 0 && len(opts.Http.Body) > 0 {
+		body, err := decompress(encodings, opts.Http.Body, p.maxBodySize)
+		if err == errBodyTooLarge {
+			return &responseWorker{
+				response: &triggers.HttpResponse{
+					StatusCode: 413,
+					Body:       []byte(fmt.Sprintf("Request body exceeds the %d byte limit once decompressed", p.maxBodySize)),
+				},
+			}, nil
+		} else if err != nil {
+			return &responseWorker{
+				response: &triggers.HttpResponse{
+					StatusCode: 415,
+					Body:       []byte(err.Error()),
+				},
+			}, nil
+		}
+
+		opts.Http.Body = body
+		deleteHeader(opts.Http.Header, "Content-Encoding")
+		deleteHeader(opts.Http.Header, "Content-Length")
+		opts.Http.Header["Content-Length"] = []string{strconv.Itoa(len(body))}
+	}
+
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compressionWorker{
+		Worker:   wrkr,
+		encoding: acceptedEncoding(headerValue(opts.Http.Header, "Accept-Encoding")),
+		minSize:  p.minSize,
+	}, nil
+}
+
+type compressionWorker struct {
+	Worker
+	encoding string
+	minSize  int
+}
+
+func (w *compressionWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	response, err := w.Worker.HandleHttpRequest(trigger)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if response.Header == nil {
+		response.Header = &fasthttp.ResponseHeader{}
+	}
+
+	// Event streams are sent as they're written, so they can't be compressed as a whole
+	if response.Events != nil || response.StatusCode == 204 || response.StatusCode == 304 || len(response.Header.Peek("Content-Encoding")) > 0 {
+		return response, nil
+	}
+
+	if !compressible(string(response.Header.Peek("Content-Type"))) {
+		return response, nil
+	}
+
+	// The response varies by Accept-Encoding whether or not this client was sent a compressed response
+	response.Header.Add("Vary", "Accept-Encoding")
+
+	if w.encoding == "" || len(response.Body) < w.minSize {
+		return response, nil
+	}
+
+	switch w.encoding {
+	case EncodingBrotli:
+		response.Body = fasthttp.AppendBrotliBytes(nil, response.Body)
+	case EncodingGzip:
+		response.Body = fasthttp.AppendGzipBytes(nil, response.Body)
+	}
+
+	response.Header.Set("Content-Encoding", w.encoding)
+	response.Header.Del("Content-Length")
+
+	return response, nil
+}
+
+// NewCompressionPool - Wraps a worker pool, compressing http responses of at least minSize bytes
+// and refusing request bodies that decompress to more than maxBodySize bytes, unlimited if zero
+func NewCompressionPool(pool WorkerPool, minSize int, maxBodySize int) WorkerPool {
+	return &CompressionPool{
+		WorkerPool:  pool,
+		minSize:     minSize,
+		maxBodySize: maxBodySize,
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("CompressionPool", func() {
+	body := bytes.Repeat([]byte("nitric compression test payload "), 64)
+
+	When("a client accepts compressed responses", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewCompressionPool(NewProcessPool(&ProcessPoolOptions{}), 1024, 0)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should compress large responses with the preferred encoding", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/", Header: map[string][]string{
+				"accept-encoding": {"gzip;q=1.0, br;q=0.5"},
+			}}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(req).Return(&triggers.HttpResponse{StatusCode: 200, Body: body}, nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(res.Header.Peek("Content-Encoding"))).To(Equal("gzip"))
+			Expect(string(res.Header.Peek("Vary"))).To(Equal("Accept-Encoding"))
+
+			decompressed, err := fasthttp.AppendGunzipBytes(nil, res.Body)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(decompressed).To(Equal(body))
+
+			ctrl.Finish()
+		})
+
+		It("should prefer brotli when encodings are weighted equally", func() {
+			Expect(acceptedEncoding([]string{"gzip, deflate, br"})).To(Equal(EncodingBrotli))
+			Expect(acceptedEncoding([]string{"*"})).To(Equal(EncodingBrotli))
+			Expect(acceptedEncoding([]string{"br;q=0, gzip"})).To(Equal(EncodingGzip))
+			Expect(acceptedEncoding([]string{"identity"})).To(BeEmpty())
+		})
+	})
+
+	When("a response is small or already compressed", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewCompressionPool(NewProcessPool(&ProcessPoolOptions{}), 1024, 0)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return the response unchanged", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/", Header: map[string][]string{
+				"Accept-Encoding": {"br"},
+			}}
+
+			image := &fasthttp.ResponseHeader{}
+			image.Set("Content-Type", "image/png")
+
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true).Times(2)
+			gomock.InOrder(
+				mockWrkr.EXPECT().HandleHttpRequest(req).Return(&triggers.HttpResponse{StatusCode: 200, Body: []byte("small")}, nil),
+				mockWrkr.EXPECT().HandleHttpRequest(req).Return(&triggers.HttpResponse{StatusCode: 200, Header: image, Body: body}, nil),
+			)
+
+			for _, expected := range [][]byte{[]byte("small"), body} {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				res, err := wrkr.HandleHttpRequest(req)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Header.Peek("Content-Encoding")).To(BeEmpty())
+				Expect(res.Body).To(Equal(expected))
+			}
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request body is compressed", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewCompressionPool(NewProcessPool(&ProcessPoolOptions{}), 1024, 0)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should decompress it before it's handled", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/", Body: fasthttp.AppendBrotliBytes(nil, body), Header: map[string][]string{
+				"Content-Encoding": {"br"},
+			}}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) bool {
+				Expect(trigger.Body).To(Equal(body))
+				Expect(trigger.Header).ToNot(HaveKey("Content-Encoding"))
+				return true
+			})
+
+			_, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request body decompresses to more than the maximum body size", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewCompressionPool(NewProcessPool(&ProcessPoolOptions{}), 1024, len(body)-1)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request without a worker", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/", Body: fasthttp.AppendGzipBytes(nil, body), Header: map[string][]string{
+				"Content-Encoding": {"gzip"},
+			}}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(413))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request body has an unsupported encoding", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewCompressionPool(NewProcessPool(&ProcessPoolOptions{}), 1024, 0)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request without a worker", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/", Body: body, Header: map[string][]string{
+				"Content-Encoding": {"compress"},
+			}}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(415))
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,65 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// ConfigTopicPrefix - prefixes the topics of configuration change events, so they can't be mistaken for events published to a topic
+const ConfigTopicPrefix = "config:"
+
+// ConfigTopic - returns the topic of the events sent when the value of a configuration key changes
+func ConfigTopic(key string) string {
+	return ConfigTopicPrefix + key
+}
+
+// ConfigWorker - Worker representation for a handler of changes to a configuration key
+type ConfigWorker struct {
+	key string
+	Adapter
+}
+
+var _ Worker = &ConfigWorker{}
+
+// Key - the configuration key whose changes the worker handles
+func (c *ConfigWorker) Key() string {
+	return c.key
+}
+
+func (c *ConfigWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return false
+}
+
+func (c *ConfigWorker) HandlesEvent(trigger *triggers.Event) bool {
+	return trigger.Topic == ConfigTopic(c.key)
+}
+
+func (c *ConfigWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("config workers cannot handle HTTP requests")
+}
+
+type ConfigWorkerOptions struct {
+	Key string
+}
+
+func NewConfigWorker(adapter Adapter, opts *ConfigWorkerOptions) *ConfigWorker {
+	return &ConfigWorker{
+		key:     opts.Key,
+		Adapter: adapter,
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("ConfigWorker", func() {
+	cfgWrkr := NewConfigWorker(nil, &ConfigWorkerOptions{Key: "pricing"})
+
+	Context("Http", func() {
+		When("calling HandlesHttpRequest", func() {
+			It("should return false", func() {
+				Expect(cfgWrkr.HandlesHttpRequest(&triggers.HttpRequest{})).To(BeFalse())
+			})
+		})
+
+		When("calling HandleHttpRequest", func() {
+			It("should return an error", func() {
+				_, err := cfgWrkr.HandleHttpRequest(&triggers.HttpRequest{})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Event", func() {
+		When("calling HandlesEvent with the key's config topic", func() {
+			It("should return true", func() {
+				Expect(cfgWrkr.HandlesEvent(&triggers.Event{Topic: ConfigTopic("pricing")})).To(BeTrue())
+			})
+		})
+
+		When("calling HandlesEvent with a topic named after the key", func() {
+			It("should return false", func() {
+				Expect(cfgWrkr.HandlesEvent(&triggers.Event{Topic: "pricing"})).To(BeFalse())
+			})
+		})
+	})
+})
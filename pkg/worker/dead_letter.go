@@ -0,0 +1,96 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// DeadLetterPool - A WorkerPool that publishes events its workers permanently fail to handle to a dead letter topic.
+// Subscriptions may name their own dead letter topic, otherwise the pool's default topic is used.
+type DeadLetterPool struct {
+	WorkerPool
+	events       events.EventService
+	defaultTopic string
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will dead-letter events it permanently fails to handle
+func (p *DeadLetterPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := p.defaultTopic
+	if sw, ok := unwrap(wrkr).(*SubscriptionWorker); ok && sw.deadLetter != "" {
+		topic = sw.deadLetter
+	}
+
+	if topic == "" {
+		return wrkr, nil
+	}
+
+	return &deadLetterWorker{
+		Worker: wrkr,
+		events: p.events,
+		topic:  topic,
+	}, nil
+}
+
+type deadLetterWorker struct {
+	Worker
+	events events.EventService
+	topic  string
+}
+
+func (w *deadLetterWorker) HandleEvent(trigger *triggers.Event) error {
+	err := w.Worker.HandleEvent(trigger)
+	if err == nil || !IsPermanent(err) {
+		return err
+	}
+
+	// Events are published with JSON object payloads, anything else is kept as a string
+	payload := map[string]interface{}{}
+	if json.Unmarshal(trigger.Payload, &payload) != nil {
+		payload = map[string]interface{}{"data": string(trigger.Payload)}
+	}
+
+	if pubErr := w.events.Publish(w.topic, &events.NitricEvent{
+		ID:      trigger.ID,
+		Payload: payload,
+	}); pubErr != nil {
+		// The event is left to the provider to redeliver or dead-letter, rather than being lost
+		return fmt.Errorf("unable to dead-letter event %s to topic %s: %v: %w", trigger.ID, w.topic, pubErr, err)
+	}
+
+	log.Default().Printf("dead-lettered event %s for topic %s to topic %s: %v", trigger.ID, trigger.Topic, w.topic, err)
+
+	return nil
+}
+
+// NewDeadLetterPool - Wraps a worker pool, publishing events its workers permanently fail to handle to a dead letter topic.
+// defaultTopic is used for workers without a dead letter topic of their own, and may be empty
+func NewDeadLetterPool(pool WorkerPool, events events.EventService, defaultTopic string) WorkerPool {
+	return &DeadLetterPool{
+		WorkerPool:   pool,
+		events:       events,
+		defaultTopic: defaultTopic,
+	}
+}
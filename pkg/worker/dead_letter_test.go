@@ -0,0 +1,125 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type publishedEvent struct {
+	topic string
+	event *events.NitricEvent
+}
+
+type memoryEvents struct {
+	events.UnimplementedeventsPlugin
+	published []publishedEvent
+}
+
+func (e *memoryEvents) Publish(topic string, event *events.NitricEvent) error {
+	e.published = append(e.published, publishedEvent{topic: topic, event: event})
+	return nil
+}
+
+var _ = Describe("DeadLetterPool", func() {
+	evt := &triggers.Event{
+		ID:      "1234",
+		Topic:   "test",
+		Payload: []byte(`{"value":"test"}`),
+	}
+
+	When("a worker permanently fails to handle an event", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		evts := &memoryEvents{}
+
+		pool := NewDeadLetterPool(NewProcessPool(&ProcessPoolOptions{}), evts, "dead-letters")
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should publish the event to the dead letter topic", func() {
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(&PermanentError{Msg: "invalid event"})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+			Expect(evts.published).To(HaveLen(1))
+			Expect(evts.published[0].topic).To(Equal("dead-letters"))
+			Expect(evts.published[0].event.ID).To(Equal("1234"))
+			Expect(evts.published[0].event.Payload).To(Equal(map[string]interface{}{"value": "test"}))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a worker fails to handle an event", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		evts := &memoryEvents{}
+
+		pool := NewDeadLetterPool(NewProcessPool(&ProcessPoolOptions{}), evts, "dead-letters")
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return the error so the event is redelivered", func() {
+			retryErr := &RetryError{After: time.Minute, Msg: "try again"}
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(retryErr)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wrkr.HandleEvent(evt)
+			Expect(err).To(Equal(retryErr))
+			after, ok := RetryAfter(fmt.Errorf("wrapped: %w", err))
+			Expect(ok).To(BeTrue())
+			Expect(after).To(Equal(time.Minute))
+			Expect(evts.published).To(BeEmpty())
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a subscription has its own dead letter topic", func() {
+		evts := &memoryEvents{}
+		pool := NewDeadLetterPool(NewProcessPool(&ProcessPoolOptions{}), evts, "")
+
+		It("should publish events it permanently fails to handle to that topic", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			adapter := mock_worker.NewMockAdapter(ctrl)
+			_ = pool.AddWorker(NewSubscriptionWorker(adapter, &SubscriptionWorkerOptions{Topic: "test", DeadLetter: "test-dead-letters"}))
+
+			adapter.EXPECT().HandleEvent(evt).Return(&PermanentError{Msg: "invalid event"})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+			Expect(evts.published).To(HaveLen(1))
+			Expect(evts.published[0].topic).To(Equal("test-dead-letters"))
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,151 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// TriggerTimeouts - how long workers may spend handling each type of trigger, unlimited if zero
+type TriggerTimeouts struct {
+	Http  time.Duration
+	Event time.Duration
+}
+
+// TimeoutMetrics - counts the triggers that exceeded their execution timeout, by trigger type
+type TimeoutMetrics struct {
+	http  uint64
+	event uint64
+}
+
+// Handler - serves the trigger timeout counts in the Prometheus text exposition format
+func (m *TimeoutMetrics) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintf(w, "# HELP nitric_trigger_timeouts_total Triggers cancelled for exceeding their execution timeout.\n")
+		fmt.Fprintf(w, "# TYPE nitric_trigger_timeouts_total counter\n")
+		fmt.Fprintf(w, "nitric_trigger_timeouts_total{trigger=%q} %d\n", "http", atomic.LoadUint64(&m.http))
+		fmt.Fprintf(w, "nitric_trigger_timeouts_total{trigger=%q} %d\n", "event", atomic.LoadUint64(&m.event))
+	})
+}
+
+// NewTimeoutMetrics - creates empty trigger timeout metrics
+func NewTimeoutMetrics() *TimeoutMetrics {
+	return &TimeoutMetrics{}
+}
+
+// DeadlinePool - A WorkerPool that applies an execution timeout to each type of trigger.
+// The timeout is given to workers as the trigger's deadline, so FaaS workers are told to cancel triggers that exceed it.
+// Http requests that exceed it are answered with a 504 and events fail, so they're redelivered.
+type DeadlinePool struct {
+	WorkerPool
+	timeouts *TriggerTimeouts
+	metrics  *TimeoutMetrics
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will cancel triggers that exceed their timeout
+func (p *DeadlinePool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadlineWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+type deadlineWorker struct {
+	Worker
+	pool *DeadlinePool
+}
+
+func (w *deadlineWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	if w.pool.timeouts.Http <= 0 {
+		return w.Worker.HandleHttpRequest(trigger)
+	}
+
+	trigger.Deadline = triggers.EarliestDeadline(trigger.Deadline, w.pool.timeouts.Http)
+
+	// buffered so the handler can complete after a timeout without blocking
+	result := make(chan httpResult, 1)
+	go func() {
+		response, err := w.Worker.HandleHttpRequest(trigger)
+		result <- httpResult{response: response, err: err}
+	}()
+
+	timer := time.NewTimer(time.Until(trigger.Deadline))
+	defer timer.Stop()
+
+	select {
+	case r := <-result:
+		if !IsTimeout(r.err) {
+			return r.response, r.err
+		}
+	case <-timer.C:
+	}
+
+	atomic.AddUint64(&w.pool.metrics.http, 1)
+
+	return &triggers.HttpResponse{
+		StatusCode: 504,
+		Body:       []byte("Request exceeded its execution timeout"),
+	}, nil
+}
+
+func (w *deadlineWorker) HandleEvent(trigger *triggers.Event) error {
+	if w.pool.timeouts.Event <= 0 {
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	trigger.Deadline = triggers.EarliestDeadline(trigger.Deadline, w.pool.timeouts.Event)
+
+	// buffered so the handler can complete after a timeout without blocking
+	result := make(chan error, 1)
+	go func() {
+		result <- w.Worker.HandleEvent(trigger)
+	}()
+
+	timer := time.NewTimer(time.Until(trigger.Deadline))
+	defer timer.Stop()
+
+	select {
+	case err := <-result:
+		if !IsTimeout(err) {
+			return err
+		}
+	case <-timer.C:
+	}
+
+	atomic.AddUint64(&w.pool.metrics.event, 1)
+
+	return &TimeoutError{Msg: fmt.Sprintf("event %s for topic %s exceeded its execution timeout", trigger.ID, trigger.Topic)}
+}
+
+// NewDeadlinePool - Wraps a worker pool, applying the execution timeout of each type of trigger.
+// Triggers that exceed their timeout are counted by the metrics
+func NewDeadlinePool(pool WorkerPool, timeouts *TriggerTimeouts, metrics *TimeoutMetrics) WorkerPool {
+	return &DeadlinePool{
+		WorkerPool: pool,
+		timeouts:   timeouts,
+		metrics:    metrics,
+	}
+}
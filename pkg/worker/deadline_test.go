@@ -0,0 +1,134 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("DeadlinePool", func() {
+	When("an http request is handled within its timeout", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		metrics := NewTimeoutMetrics()
+
+		pool := NewDeadlinePool(NewProcessPool(&ProcessPoolOptions{}), &TriggerTimeouts{Http: time.Second}, metrics)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return the worker's response", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(req).Return(&triggers.HttpResponse{StatusCode: 200}, nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(200))
+
+			By("giving the request a deadline at its timeout")
+			Expect(req.Deadline).To(BeTemporally("~", time.Now().Add(time.Second), 100*time.Millisecond))
+			Expect(metrics.http).To(BeZero())
+
+			ctrl.Finish()
+		})
+	})
+
+	When("an http request exceeds its timeout", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		metrics := NewTimeoutMetrics()
+
+		pool := NewDeadlinePool(NewProcessPool(&ProcessPoolOptions{}), &TriggerTimeouts{Http: 50 * time.Millisecond}, metrics)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should answer it with a 504", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(req).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				time.Sleep(time.Second)
+				return &triggers.HttpResponse{StatusCode: 200}, nil
+			})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(504))
+			Expect(metrics.http).To(Equal(uint64(1)))
+		})
+	})
+
+	When("the worker cancels an event at its deadline", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		metrics := NewTimeoutMetrics()
+
+		pool := NewDeadlinePool(NewProcessPool(&ProcessPoolOptions{}), &TriggerTimeouts{Event: time.Minute}, metrics)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should fail the event, so it's redelivered", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(&TimeoutError{Msg: "cancelled"})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wrkr.HandleEvent(evt)
+			Expect(IsTimeout(err)).To(BeTrue())
+			Expect(metrics.event).To(Equal(uint64(1)))
+
+			By("serving the timeout count")
+			rec := httptest.NewRecorder()
+			metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics/timeouts", nil))
+			Expect(rec.Body.String()).To(ContainSubstring(`nitric_trigger_timeouts_total{trigger="event"} 1`))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("the trigger type has no timeout", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewDeadlinePool(NewProcessPool(&ProcessPoolOptions{}), &TriggerTimeouts{Http: time.Second}, NewTimeoutMetrics())
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should not give it a deadline", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+			Expect(evt.Deadline.IsZero()).To(BeTrue())
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"log"
+
+	"github.com/nitrictech/nitric/pkg/dedupe"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// DedupePool - A WorkerPool that skips events that have already been handled successfully.
+// Events are identified by their topic and idempotency key, or ID if they weren't published with a key.
+type DedupePool struct {
+	WorkerPool
+	deduplicator dedupe.Deduplicator
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will skip previously handled events
+func (p *DedupePool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dedupeWorker{
+		Worker:       wrkr,
+		deduplicator: p.deduplicator,
+	}, nil
+}
+
+type dedupeWorker struct {
+	Worker
+	deduplicator dedupe.Deduplicator
+}
+
+func (w *dedupeWorker) HandleEvent(trigger *triggers.Event) error {
+	key := trigger.DedupeKey()
+	if key == "" {
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	// If the dedupe records can't be read the event is still handled, duplicates are preferable to losing events
+	if seen, err := w.deduplicator.Seen(trigger.Topic, key); err != nil {
+		log.Default().Printf("error checking for duplicate event %s: %v", trigger.ID, err)
+	} else if seen {
+		return nil
+	}
+
+	if err := w.Worker.HandleEvent(trigger); err != nil {
+		return err
+	}
+
+	if err := w.deduplicator.Mark(trigger.Topic, key); err != nil {
+		log.Default().Printf("error recording handled event %s: %v", trigger.ID, err)
+	}
+
+	return nil
+}
+
+// NewDedupePool - Wraps a worker pool, deduplicating the events handled by its workers
+func NewDedupePool(pool WorkerPool, deduplicator dedupe.Deduplicator) WorkerPool {
+	return &DedupePool{
+		WorkerPool:   pool,
+		deduplicator: deduplicator,
+	}
+}
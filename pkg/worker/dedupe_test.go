@@ -0,0 +1,142 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+type memoryDeduplicator struct {
+	seen map[string]bool
+}
+
+func (d *memoryDeduplicator) Seen(scope string, id string) (bool, error) {
+	return d.seen[scope+"/"+id], nil
+}
+
+func (d *memoryDeduplicator) Mark(scope string, id string) error {
+	d.seen[scope+"/"+id] = true
+	return nil
+}
+
+var _ = Describe("DedupePool", func() {
+	Context("HandleEvent", func() {
+		evt := &triggers.Event{
+			ID:    "1234",
+			Topic: "test",
+		}
+
+		When("the event has not been handled", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{}}
+
+			pool := NewDedupePool(NewProcessPool(&ProcessPoolOptions{}), deduplicator)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should handle the event and mark it as handled", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+				Expect(deduplicator.seen).To(HaveKey("test/1234"))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the event has already been handled", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{"test/1234": true}}
+
+			pool := NewDedupePool(NewProcessPool(&ProcessPoolOptions{}), deduplicator)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should skip the event", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(gomock.Any()).Times(0)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the event was published with an idempotency key", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{}}
+
+			pool := NewDedupePool(NewProcessPool(&ProcessPoolOptions{}), deduplicator)
+			_ = pool.AddWorker(mockWrkr)
+
+			keyedEvt := &triggers.Event{
+				ID:             "5678",
+				Topic:          "test",
+				IdempotencyKey: "order-1",
+			}
+
+			It("should mark the event as handled by its key", func() {
+				mockWrkr.EXPECT().HandlesEvent(keyedEvt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(keyedEvt).Return(nil)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: keyedEvt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(wrkr.HandleEvent(keyedEvt)).To(Succeed())
+				Expect(deduplicator.seen).To(HaveKey("test/order-1"))
+				Expect(deduplicator.seen).ToNot(HaveKey("test/5678"))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the event fails to be handled", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			deduplicator := &memoryDeduplicator{seen: map[string]bool{}}
+
+			pool := NewDedupePool(NewProcessPool(&ProcessPoolOptions{}), deduplicator)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should not mark the event as handled", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(fmt.Errorf("mock error"))
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(wrkr.HandleEvent(evt)).ToNot(Succeed())
+				Expect(deduplicator.seen).ToNot(HaveKey("test/1234"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+})
@@ -0,0 +1,76 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RetryError - an event wasn't handled, and should be redelivered once the delay has passed
+type RetryError struct {
+	// How long to wait before redelivering the event, the provider's default if zero
+	After time.Duration
+	Msg   string
+}
+
+func (e *RetryError) Error() string {
+	if e.After > 0 {
+		return fmt.Sprintf("%s, retry after %s", e.Msg, e.After)
+	}
+
+	return e.Msg
+}
+
+// PermanentError - an event can never be handled, so it should be dead-lettered instead of redelivered
+type PermanentError struct {
+	Msg string
+}
+
+func (e *PermanentError) Error() string {
+	return e.Msg
+}
+
+// TimeoutError - a trigger wasn't handled by its deadline, so it was cancelled
+type TimeoutError struct {
+	Msg string
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Msg
+}
+
+// RetryAfter - returns how long to wait before redelivering the event that failed with err, and whether the worker asked for a delay
+func RetryAfter(err error) (time.Duration, bool) {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) && retryErr.After > 0 {
+		return retryErr.After, true
+	}
+
+	return 0, false
+}
+
+// IsPermanent - returns true if the event that failed with err should not be redelivered
+func IsPermanent(err error) bool {
+	var permanentErr *PermanentError
+	return errors.As(err, &permanentErr)
+}
+
+// IsTimeout - returns true if the trigger that failed with err was cancelled for exceeding its deadline
+func IsTimeout(err error) bool {
+	var timeoutErr *TimeoutError
+	return errors.As(err, &timeoutErr)
+}
@@ -0,0 +1,323 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Generational - A worker belonging to a generation of a deployment, e.g. its build or release.
+// Workers that don't implement it belong to the empty generation
+type Generational interface {
+	Generation() string
+}
+
+// Drainable - A worker that can be asked to finish handling the triggers it has received, then close
+type Drainable interface {
+	Drain() error
+}
+
+// adapterOf - returns the adapter of the worker, nil if it isn't known
+func adapterOf(w Worker) Adapter {
+	switch ww := w.(type) {
+	case *RouteWorker:
+		return ww.Adapter
+	case *SubscriptionWorker:
+		return ww.Adapter
+	case *ScheduleWorker:
+		return ww.Adapter
+	case *ConfigWorker:
+		return ww.Adapter
+	case *BucketNotificationWorker:
+		return ww.Adapter
+	case *FaasWorker:
+		return ww.Adapter
+	default:
+		return nil
+	}
+}
+
+// generationOf - returns the generation of the worker, or of its adapter
+func generationOf(w Worker) string {
+	if g, ok := w.(Generational); ok {
+		return g.Generation()
+	}
+
+	if g, ok := adapterOf(w).(Generational); ok {
+		return g.Generation()
+	}
+
+	return ""
+}
+
+// drain - asks the worker, or its adapter, to drain. Returns false if neither can be drained
+func drain(w Worker) (bool, error) {
+	if d, ok := w.(Drainable); ok {
+		return true, d.Drain()
+	}
+
+	if d, ok := adapterOf(w).(Drainable); ok {
+		return true, d.Drain()
+	}
+
+	return false, nil
+}
+
+// GenerationPool - A WorkerPool that hands triggers over from one generation of workers to the next,
+// so the workers of a long-lived membrane can be replaced without downtime.
+// Once workers of a new generation register, their share of triggers grows over the handover duration,
+// then the workers of the previous generation are drained.
+type GenerationPool struct {
+	WorkerPool
+	duration time.Duration
+	roll     func() float64
+
+	lock sync.Mutex
+	// the number of workers registered for each generation, excluding draining workers
+	counts map[string]int
+	// the generation receiving triggers, the generation triggers are moving from during a handover
+	current string
+	// the generation triggers are moving to during a handover
+	next      string
+	handover  bool
+	started   time.Time
+	timer     *time.Timer
+	handovers uint64
+	// workers of previous generations that no longer receive triggers, replaced rather than modified
+	// so it can be read without holding the lock
+	draining map[Worker]bool
+	// generations triggers have been handed over from, whose workers can't register again
+	drained map[string]bool
+}
+
+// startHandover - starts moving triggers to the given generation, the lock must be held
+func (p *GenerationPool) startHandover(generation string) {
+	p.next = generation
+	p.handover = true
+	p.started = time.Now()
+	p.handovers++
+
+	handovers := p.handovers
+	p.timer = time.AfterFunc(p.duration, func() {
+		p.completeHandover(handovers)
+	})
+
+	log.Default().Printf("handing triggers over from worker generation %q to %q over %s", p.current, p.next, p.duration)
+}
+
+// stopHandover - stops moving triggers to the next generation, the lock must be held
+func (p *GenerationPool) stopHandover() {
+	p.timer.Stop()
+	p.handover = false
+	p.next = ""
+}
+
+// completeHandover - moves all triggers to the next generation and drains the workers of the previous one
+func (p *GenerationPool) completeHandover(handovers uint64) {
+	p.lock.Lock()
+	if !p.handover || p.handovers != handovers {
+		// the handover was already completed or stopped
+		p.lock.Unlock()
+		return
+	}
+
+	previous := p.current
+	p.current = p.next
+	p.stopHandover()
+	delete(p.counts, previous)
+	p.drained[previous] = true
+
+	draining := p.draining
+	ws := p.WorkerPool.GetWorkers(&GetWorkerOptions{
+		Filter: func(w Worker) bool {
+			return generationOf(w) == previous && !draining[w]
+		},
+	})
+
+	p.draining = make(map[Worker]bool, len(draining)+len(ws))
+	for w := range draining {
+		p.draining[w] = true
+	}
+	for _, w := range ws {
+		p.draining[w] = true
+	}
+	p.lock.Unlock()
+
+	log.Default().Printf("handed triggers over to worker generation %q, draining %d workers of generation %q", p.current, len(ws), previous)
+
+	for _, w := range ws {
+		if ok, err := drain(w); err != nil {
+			log.Default().Printf("error draining worker of generation %q: %v", previous, err)
+		} else if !ok {
+			log.Default().Printf("worker of generation %q cannot be drained, it will no longer receive triggers", previous)
+		}
+	}
+}
+
+// AddWorker - Adds a worker to the underlying pool,
+// starting a handover if it's the first worker of a new generation.
+// Workers of generations that have been handed over from are refused, so reconnecting workers can't start a handover back
+func (p *GenerationPool) AddWorker(wrkr Worker) error {
+	generation := generationOf(wrkr)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.drained[generation] {
+		return fmt.Errorf("workers of generation %q cannot register, triggers have been handed over to generation %q", generation, p.current)
+	}
+
+	if p.handover && generation != p.current && generation != p.next {
+		return fmt.Errorf("workers of generation %q cannot register while triggers are handed over from generation %q to %q", generation, p.current, p.next)
+	}
+
+	if err := p.WorkerPool.AddWorker(wrkr); err != nil {
+		return err
+	}
+
+	if len(p.counts) == 0 {
+		p.current = generation
+	} else if !p.handover && generation != p.current {
+		p.startHandover(generation)
+	}
+	p.counts[generation]++
+
+	return nil
+}
+
+// RemoveWorker - Removes a worker from the underlying pool.
+// A handover is stopped if all the workers of the next generation are removed,
+// and completed if all the workers of the current generation are
+func (p *GenerationPool) RemoveWorker(wrkr Worker) error {
+	if err := p.WorkerPool.RemoveWorker(wrkr); err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+
+	if p.draining[wrkr] {
+		draining := make(map[Worker]bool, len(p.draining))
+		for w := range p.draining {
+			if w != wrkr {
+				draining[w] = true
+			}
+		}
+		p.draining = draining
+		p.lock.Unlock()
+
+		return nil
+	}
+
+	generation := generationOf(wrkr)
+	if p.counts[generation]--; p.counts[generation] <= 0 {
+		delete(p.counts, generation)
+	}
+
+	if p.handover && p.counts[p.next] == 0 {
+		log.Default().Printf("workers of generation %q were removed, stopping the handover from generation %q", p.next, p.current)
+		p.stopHandover()
+	} else if p.handover && p.counts[p.current] == 0 {
+		handovers := p.handovers
+		p.lock.Unlock()
+		p.completeHandover(handovers)
+
+		return nil
+	}
+
+	p.lock.Unlock()
+
+	return nil
+}
+
+// generations - returns the generation a trigger should be handled by, followed by the generation to fall back to, if any.
+// No generations are returned if the pool has no workers
+func (p *GenerationPool) generations() ([]string, map[Worker]bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.counts) == 0 {
+		return nil, p.draining
+	}
+
+	if !p.handover {
+		return []string{p.current}, p.draining
+	}
+
+	share := float64(time.Since(p.started)) / float64(p.duration)
+	if p.roll() < share {
+		return []string{p.next, p.current}, p.draining
+	}
+
+	return []string{p.current, p.next}, p.draining
+}
+
+// GetWorker - Retrieves a worker of the generation the trigger was handed to,
+// or the other generation during a handover if that generation has no worker for the trigger yet
+func (p *GenerationPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	generations, draining := p.generations()
+	if len(generations) == 0 {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	var err error
+	for _, generation := range generations {
+		var wrkr Worker
+
+		g := generation
+		wrkr, err = p.WorkerPool.GetWorker(&GetWorkerOptions{
+			Http:  opts.Http,
+			Event: opts.Event,
+			Filter: func(w Worker) bool {
+				return generationOf(w) == g && !draining[w] && (opts.Filter == nil || opts.Filter(w))
+			},
+		})
+		if err == nil {
+			return wrkr, nil
+		}
+	}
+
+	return nil, err
+}
+
+// GetWorkers - return the workers matching the input options, excluding draining workers
+func (p *GenerationPool) GetWorkers(opts *GetWorkerOptions) []Worker {
+	p.lock.Lock()
+	draining := p.draining
+	p.lock.Unlock()
+
+	return p.WorkerPool.GetWorkers(&GetWorkerOptions{
+		Http:  opts.Http,
+		Event: opts.Event,
+		Filter: func(w Worker) bool {
+			return !draining[w] && (opts.Filter == nil || opts.Filter(w))
+		},
+	})
+}
+
+// NewGenerationPool - Wraps a worker pool, handing triggers over to new generations of workers over the given duration
+func NewGenerationPool(pool WorkerPool, duration time.Duration) *GenerationPool {
+	return &GenerationPool{
+		WorkerPool: pool,
+		duration:   duration,
+		roll:       rand.Float64,
+		counts:     make(map[string]int),
+		draining:   make(map[Worker]bool),
+		drained:    make(map[string]bool),
+	}
+}
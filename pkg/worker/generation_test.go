@@ -0,0 +1,176 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// generationAdapter - an adapter belonging to a generation, recording when it's drained
+type generationAdapter struct {
+	generation string
+	lock       sync.Mutex
+	drained    bool
+}
+
+func (a *generationAdapter) Generation() string {
+	return a.generation
+}
+
+func (a *generationAdapter) Drain() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.drained = true
+	return nil
+}
+
+func (a *generationAdapter) isDrained() bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.drained
+}
+
+func (a *generationAdapter) HandleEvent(trigger *triggers.Event) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (a *generationAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func generationRouteWorker(adapter *generationAdapter) *RouteWorker {
+	return NewRouteWorker(adapter, &RouteWorkerOptions{Api: "main", Path: "/test", Methods: []string{"GET"}})
+}
+
+var _ = Describe("GenerationPool", func() {
+	req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+
+	When("workers of a single generation are registered", func() {
+		pool := NewGenerationPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), time.Hour)
+		first := generationRouteWorker(&generationAdapter{generation: "v1"})
+		_ = pool.AddWorker(first)
+
+		It("should use them without a handover", func() {
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(first))
+			Expect(pool.handover).To(BeFalse())
+		})
+	})
+
+	When("workers of a new generation register", func() {
+		pool := NewGenerationPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), time.Hour)
+		oldAdapter := &generationAdapter{generation: "v1"}
+		newAdapter := &generationAdapter{generation: "v2"}
+		old := generationRouteWorker(oldAdapter)
+		next := generationRouteWorker(newAdapter)
+		_ = pool.AddWorker(old)
+		_ = pool.AddWorker(next)
+
+		It("should give them the rolled share of triggers", func() {
+			Expect(pool.handover).To(BeTrue())
+
+			pool.roll = func() float64 { return 0.99 }
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(old))
+
+			time.Sleep(time.Millisecond)
+			pool.roll = func() float64 { return 0 }
+			wrkr, err = pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(next))
+		})
+
+		It("should refuse workers of a third generation until the handover is complete", func() {
+			err := pool.AddWorker(generationRouteWorker(&generationAdapter{generation: "v3"}))
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should drain the previous generation once the handover is complete", func() {
+			pool.completeHandover(pool.handovers)
+
+			Expect(oldAdapter.isDrained()).To(BeTrue())
+			Expect(newAdapter.isDrained()).To(BeFalse())
+
+			pool.roll = func() float64 { return 0.99 }
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(next))
+			Expect(pool.GetWorkers(&GetWorkerOptions{})).To(Equal([]Worker{next}))
+		})
+
+		It("should forget draining workers once they're removed", func() {
+			Expect(pool.RemoveWorker(old)).To(Succeed())
+			Expect(pool.draining).To(BeEmpty())
+		})
+
+		It("should refuse workers of the drained generation when they reconnect", func() {
+			err := pool.AddWorker(generationRouteWorker(&generationAdapter{generation: "v1"}))
+			Expect(err).Should(HaveOccurred())
+			Expect(pool.handover).To(BeFalse())
+			Expect(pool.current).To(Equal("v2"))
+		})
+	})
+
+	When("the new generation has no worker for a trigger yet", func() {
+		pool := NewGenerationPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), time.Hour)
+		old := generationRouteWorker(&generationAdapter{generation: "v1"})
+		other := NewRouteWorker(&generationAdapter{generation: "v2"}, &RouteWorkerOptions{Api: "main", Path: "/other", Methods: []string{"GET"}})
+		_ = pool.AddWorker(old)
+		_ = pool.AddWorker(other)
+		pool.roll = func() float64 { return -1 }
+
+		It("should fall back to the current generation", func() {
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(old))
+		})
+	})
+
+	When("the workers of the new generation are removed during a handover", func() {
+		pool := NewGenerationPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), time.Hour)
+		oldAdapter := &generationAdapter{generation: "v1"}
+		old := generationRouteWorker(oldAdapter)
+		next := generationRouteWorker(&generationAdapter{generation: "v2"})
+		_ = pool.AddWorker(old)
+		_ = pool.AddWorker(next)
+
+		It("should stop the handover", func() {
+			Expect(pool.RemoveWorker(next)).To(Succeed())
+			Expect(pool.handover).To(BeFalse())
+			Expect(pool.current).To(Equal("v1"))
+			Expect(oldAdapter.isDrained()).To(BeFalse())
+		})
+	})
+
+	When("the handover duration is zero", func() {
+		pool := NewGenerationPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), 0)
+		oldAdapter := &generationAdapter{generation: "v1"}
+		_ = pool.AddWorker(generationRouteWorker(oldAdapter))
+		_ = pool.AddWorker(generationRouteWorker(&generationAdapter{generation: "v2"}))
+
+		It("should drain the previous generation immediately", func() {
+			Eventually(oldAdapter.isDrained).Should(BeTrue())
+		})
+	})
+})
@@ -15,25 +15,70 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/triggers"
 )
 
+// RuntimeHandler - handles the runtime API calls workers make over their trigger streams
+type RuntimeHandler interface {
+	HandleRuntimeRequest(ctx context.Context, req *v1.RuntimeRequest) *v1.RuntimeResponse
+}
+
+// The number of events buffered for an event stream's client, before it's too slow to keep up and is disconnected
+const eventStreamBuffer = 64
+
+// eventStream - the events sent to an event stream response's client
+type eventStream struct {
+	events chan *triggers.ServerSentEvent
+	// The worker has ended the stream, or its client has gone
+	ended bool
+}
+
+func (e *eventStream) end() {
+	if !e.ended {
+		e.ended = true
+		close(e.events)
+	}
+}
+
 type GrpcAdapter struct {
 	stream v1.FaasService_TriggerStreamServer
+	// Serializes sends, as triggers and runtime responses are sent concurrently
+	sendLock sync.Mutex
 	// Response channels for this worker
 	responseQueueLock sync.Locker
 	responseQueue     map[string]chan *v1.TriggerResponse
+	// Triggers cancelled after their deadline, so their late responses can be discarded
+	cancelled map[string]bool
+	// Event channels of the open event stream responses, by the id of their trigger
+	eventStreamsLock sync.Mutex
+	eventStreams     map[string]*eventStream
+	// Handles runtime API calls from this worker, calls are refused if nil
+	runtime RuntimeHandler
+	// Sent with each trigger, nil if the stack isn't known
+	metadata *v1.TriggerMetadata
+	// The generation of the worker's deployment, empty if it didn't give one
+	generation string
+	// The encoding trigger data is compressed with, not compressed if empty
+	payloadEncoding string
+	// Trigger data smaller than this many bytes isn't compressed
+	payloadMinSize int
 }
 
 var _ Adapter = &GrpcAdapter{}
@@ -68,10 +113,221 @@ func (s *GrpcAdapter) resolveTicket(ID string) (chan *v1.TriggerResponse, error)
 	return s.responseQueue[ID], nil
 }
 
+// cancelTicket - removes the ticket of a trigger that exceeded its deadline, so its late response is discarded.
+// Returns false if the response has already been received
+func (s *GrpcAdapter) cancelTicket(ID string) bool {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	if s.responseQueue[ID] == nil {
+		return false
+	}
+
+	delete(s.responseQueue, ID)
+	s.cancelled[ID] = true
+
+	return true
+}
+
+// wasCancelled - returns true, once, if the trigger with the given ID was cancelled
+func (s *GrpcAdapter) wasCancelled(ID string) bool {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	if !s.cancelled[ID] {
+		return false
+	}
+
+	delete(s.cancelled, ID)
+
+	return true
+}
+
+// awaitResponse - waits for the response to the trigger with the given ID.
+// The trigger is cancelled if its deadline passes first, telling the worker to stop handling it
+func (s *GrpcAdapter) awaitResponse(ID string, responses chan *v1.TriggerResponse, deadline time.Time) (*v1.TriggerResponse, error) {
+	if deadline.IsZero() {
+		return <-responses, nil
+	}
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case response := <-responses:
+		return response, nil
+	case <-timer.C:
+	}
+
+	if !s.cancelTicket(ID) {
+		// the response was received as the deadline passed
+		return <-responses, nil
+	}
+
+	err := s.send(&v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_TriggerCancelled{
+			TriggerCancelled: &v1.TriggerCancelled{},
+		},
+	})
+	if err != nil {
+		log.Default().Printf("send error %v", err)
+	}
+
+	return nil, &TimeoutError{Msg: fmt.Sprintf("trigger %s was cancelled, it wasn't handled by its deadline", ID)}
+}
+
+// openEventStream - creates the event channel of an event stream response, before the response is returned
+// so events the worker sends straight after it aren't lost
+func (s *GrpcAdapter) openEventStream(ID string) {
+	s.eventStreamsLock.Lock()
+	defer s.eventStreamsLock.Unlock()
+
+	if s.eventStreams == nil {
+		s.eventStreams = make(map[string]*eventStream)
+	}
+
+	s.eventStreams[ID] = &eventStream{
+		events: make(chan *triggers.ServerSentEvent, eventStreamBuffer),
+	}
+}
+
+// sendEventStreamClosed - tells the worker the client of its event stream has gone
+func (s *GrpcAdapter) sendEventStreamClosed(ID string) {
+	err := s.send(&v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_EventStreamClosed{
+			EventStreamClosed: &v1.EventStreamClosed{},
+		},
+	})
+	if err != nil {
+		log.Default().Printf("send error %v", err)
+	}
+}
+
+// eventStream - returns the event stream opened for the trigger with ID, which is forgotten once it's closed
+func (s *GrpcAdapter) eventStream(ID string) *triggers.EventStream {
+	s.eventStreamsLock.Lock()
+	defer s.eventStreamsLock.Unlock()
+
+	stream := s.eventStreams[ID]
+
+	return triggers.NewEventStream(stream.events, func() {
+		s.eventStreamsLock.Lock()
+		delete(s.eventStreams, ID)
+		ended := stream.ended
+		stream.end()
+		s.eventStreamsLock.Unlock()
+
+		if !ended {
+			s.sendEventStreamClosed(ID)
+		}
+	})
+}
+
+// handleServerSentEvent - passes an event to its stream, dropping events for streams that have ended.
+// Clients too slow to keep up with their stream are disconnected, rather than holding up the worker's other responses
+func (s *GrpcAdapter) handleServerSentEvent(ID string, evt *v1.ServerSentEvent) {
+	s.eventStreamsLock.Lock()
+	defer s.eventStreamsLock.Unlock()
+
+	stream, ok := s.eventStreams[ID]
+	if !ok || stream.ended {
+		return
+	}
+
+	// Events that only end the stream aren't sent
+	if evt.GetId() != "" || evt.GetEvent() != "" || evt.GetData() != "" || evt.GetRetry() != nil || !evt.GetEnd() {
+		select {
+		case stream.events <- &triggers.ServerSentEvent{
+			ID:    evt.GetId(),
+			Event: evt.GetEvent(),
+			Data:  evt.GetData(),
+			Retry: evt.GetRetry().AsDuration(),
+		}:
+		default:
+			log.Default().Printf("client of event stream %s is too slow, closing it", ID)
+			stream.end()
+			s.sendEventStreamClosed(ID)
+			return
+		}
+	}
+
+	if evt.GetEnd() {
+		stream.end()
+	}
+}
+
 func (gwb *GrpcAdapter) send(msg *v1.ServerMessage) error {
+	gwb.sendLock.Lock()
+	defer gwb.sendLock.Unlock()
+
 	return gwb.stream.Send(msg)
 }
 
+// Generation - returns the generation of the worker's deployment, empty if it didn't give one
+func (gwb *GrpcAdapter) Generation() string {
+	return gwb.generation
+}
+
+// SetGeneration - sets the generation of the worker's deployment, from its init request
+func (gwb *GrpcAdapter) SetGeneration(generation string) {
+	gwb.generation = generation
+}
+
+// NegotiateCompression - chooses the encoding trigger data is compressed with, from the encodings the worker accepts.
+// Data smaller than minSize isn't compressed
+func (gwb *GrpcAdapter) NegotiateCompression(accepted []string, minSize int) {
+	gwb.payloadEncoding = negotiatePayloadEncoding(accepted)
+	gwb.payloadMinSize = minSize
+}
+
+// encodePayload - returns the trigger data to send to the worker, and the encoding it's compressed with
+func (gwb *GrpcAdapter) encodePayload(data []byte) ([]byte, string, error) {
+	if gwb.payloadEncoding == "" || len(data) < gwb.payloadMinSize {
+		return data, "", nil
+	}
+
+	compressed, err := compressPayload(gwb.payloadEncoding, data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return compressed, gwb.payloadEncoding, nil
+}
+
+// Drain - asks the worker to finish handling the triggers it has received, then close its stream
+func (gwb *GrpcAdapter) Drain() error {
+	return gwb.send(&v1.ServerMessage{
+		Content: &v1.ServerMessage_DrainRequest{
+			DrainRequest: &v1.DrainRequest{},
+		},
+	})
+}
+
+// handleRuntimeRequest - calls a runtime API method for the worker, responding with the ID of its request
+func (gwb *GrpcAdapter) handleRuntimeRequest(ID string, req *v1.RuntimeRequest) {
+	var resp *v1.RuntimeResponse
+	if gwb.runtime != nil {
+		resp = gwb.runtime.HandleRuntimeRequest(gwb.stream.Context(), req)
+	} else {
+		resp = &v1.RuntimeResponse{
+			Code:    int32(codes.Unimplemented),
+			Message: "runtime API calls are not supported by this membrane",
+		}
+	}
+
+	err := gwb.send(&v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_RuntimeResponse{
+			RuntimeResponse: resp,
+		},
+	})
+	if err != nil {
+		log.Default().Printf("send error %v", err)
+	}
+}
+
 func (gwb *GrpcAdapter) Start(errchan chan error) {
 	for {
 		msg, err := gwb.stream.Recv()
@@ -88,7 +344,7 @@ func (gwb *GrpcAdapter) Start(errchan chan error) {
 
 		if msg.GetInitRequest() != nil {
 			log.Default().Println("Received init request from worker")
-			err = gwb.stream.Send(&v1.ServerMessage{
+			err = gwb.send(&v1.ServerMessage{
 				Content: &v1.ServerMessage_InitResponse{
 					InitResponse: &v1.InitResponse{},
 				},
@@ -99,9 +355,23 @@ func (gwb *GrpcAdapter) Start(errchan chan error) {
 			continue
 		}
 
+		// Runtime calls are handled concurrently, so slow calls don't hold up trigger responses
+		if req := msg.GetRuntimeRequest(); req != nil {
+			go gwb.handleRuntimeRequest(msg.GetId(), req)
+			continue
+		}
+
+		if evt := msg.GetServerSentEvent(); evt != nil {
+			gwb.handleServerSentEvent(msg.GetId(), evt)
+			continue
+		}
+
 		// Load the response channel and delete its map key reference
 		val, err := gwb.resolveTicket(msg.GetId())
-		if err != nil {
+		if err != nil && gwb.wasCancelled(msg.GetId()) {
+			// a late response to a cancelled trigger
+			continue
+		} else if err != nil {
 			err = errors.WithMessage(err, "Fatal: FaaS Worker in bad state closing stream: "+msg.GetId())
 			log.Default().Println(err.Error())
 			errchan <- err
@@ -109,12 +379,65 @@ func (gwb *GrpcAdapter) Start(errchan chan error) {
 		}
 		// For now assume this is a trigger response...
 		response := msg.GetTriggerResponse()
+		if response.GetHttp().GetEventStream() {
+			gwb.openEventStream(msg.GetId())
+		}
 		// Write the response the the waiting recipient
 		val <- response
 	}
 }
 
+// deadline - returns the deadline of a trigger for its trigger request, nil if there's no deadline
+func deadline(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// triggerMetadata - returns the metadata sent with a trigger, where it's being handled and where it was delivered from.
+// nil if there's nothing to describe
+func (s *GrpcAdapter) triggerMetadata(md triggers.Metadata, attempt int) *v1.TriggerMetadata {
+	if s.metadata == nil && md.Source == "" && md.ProviderID == "" && attempt == 0 && md.PublishTime.IsZero() && len(md.TraceContext) == 0 {
+		return nil
+	}
+
+	metadata := &v1.TriggerMetadata{
+		Source:       md.Source,
+		ProviderId:   md.ProviderID,
+		Attempt:      int32(attempt),
+		PublishTime:  deadline(md.PublishTime),
+		TraceContext: md.TraceContext,
+	}
+	if s.metadata != nil {
+		metadata.Stack = s.metadata.Stack
+		metadata.Environment = s.metadata.Environment
+	}
+
+	return metadata
+}
+
 func (s *GrpcAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	var graphql *v1.GraphqlOperation
+	if trigger.Graphql != nil {
+		variables, err := structpb.NewStruct(trigger.Graphql.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("invalid graphql variables: %v", err)
+		}
+
+		graphql = &v1.GraphqlOperation{
+			Query:         trigger.Graphql.Query,
+			OperationName: trigger.Graphql.OperationName,
+			Variables:     variables,
+		}
+	}
+
+	data, dataEncoding, err := s.encodePayload(trigger.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate an ID here
 	ID, returnChan := s.newTicket()
 
@@ -154,8 +477,9 @@ func (s *GrpcAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*trigger
 	}
 
 	triggerRequest := &v1.TriggerRequest{
-		Data:     trigger.Body,
-		MimeType: mimeType,
+		Data:         data,
+		DataEncoding: dataEncoding,
+		MimeType:     mimeType,
 		Context: &v1.TriggerRequest_Http{
 			Http: &v1.HttpTriggerContext{
 				Path:           trigger.Path,
@@ -165,8 +489,11 @@ func (s *GrpcAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*trigger
 				Headers:        headers,
 				HeadersOld:     headersOld,
 				PathParams:     trigger.Params,
+				Graphql:        graphql,
 			},
 		},
+		Deadline: deadline(trigger.Deadline),
+		Metadata: s.triggerMetadata(trigger.Metadata, 0),
 	}
 
 	// construct the message
@@ -178,14 +505,17 @@ func (s *GrpcAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*trigger
 	}
 
 	// send the message
-	err := s.send(message)
+	err = s.send(message)
 	if err != nil {
 		// There was an error enqueuing the message
 		return nil, err
 	}
 
 	// wait for the response
-	triggerResponse := <-returnChan
+	triggerResponse, err := s.awaitResponse(ID, returnChan, trigger.Deadline)
+	if err != nil {
+		return nil, err
+	}
 
 	httpResponse := triggerResponse.GetHttp()
 
@@ -206,29 +536,48 @@ func (s *GrpcAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*trigger
 		}
 	}
 
+	body, err := decompressPayload(triggerResponse.GetDataEncoding(), triggerResponse.Data)
+	if err != nil {
+		return nil, fmt.Errorf("fatal: Error handling event, invalid response data received from function: %v", err)
+	}
+
 	response := &triggers.HttpResponse{
-		Body: triggerResponse.Data,
+		Body: body,
 		// No need to worry about integer truncation
 		// as this should be a HTTP status code...
 		StatusCode: int(httpResponse.Status),
 		Header:     fasthttpHeader,
 	}
 
+	if httpResponse.GetEventStream() {
+		response.Body = nil
+		response.Events = s.eventStream(ID)
+	}
+
 	return response, nil
 }
 
 func (s *GrpcAdapter) HandleEvent(trigger *triggers.Event) error {
+	data, dataEncoding, err := s.encodePayload(trigger.Payload)
+	if err != nil {
+		return err
+	}
+
 	// Generate an ID here
 	ID, returnChan := s.newTicket()
 	triggerRequest := &v1.TriggerRequest{
-		Data:     trigger.Payload,
-		MimeType: http.DetectContentType(trigger.Payload),
+		Data:         data,
+		DataEncoding: dataEncoding,
+		MimeType:     http.DetectContentType(trigger.Payload),
 		Context: &v1.TriggerRequest_Topic{
 			Topic: &v1.TopicTriggerContext{
-				Topic: trigger.Topic,
+				Topic:       trigger.Topic,
+				OrderingKey: trigger.OrderingKey,
 				// FIXME: Add missing fields here...
 			},
 		},
+		Deadline: deadline(trigger.Deadline),
+		Metadata: s.triggerMetadata(trigger.Metadata, trigger.Attempt),
 	}
 
 	// construct the message
@@ -240,15 +589,17 @@ func (s *GrpcAdapter) HandleEvent(trigger *triggers.Event) error {
 	}
 
 	// send the message
-	err := s.send(message)
+	err = s.send(message)
 	if err != nil {
 		// There was an error enqueuing the message
 		return err
 	}
 
 	// wait for the response
-	// FIXME: Need to handle timeouts here...
-	response := <-returnChan
+	response, err := s.awaitResponse(ID, returnChan, trigger.Deadline)
+	if err != nil {
+		return err
+	}
 
 	topic := response.GetTopic()
 
@@ -262,13 +613,34 @@ func (s *GrpcAdapter) HandleEvent(trigger *triggers.Event) error {
 		return nil
 	}
 
+	if topic.GetPermanent() {
+		return &PermanentError{Msg: "Permanent error occurred handling the event"}
+	}
+
+	if topic.GetRetryAfter() != nil {
+		return &RetryError{After: topic.GetRetryAfter().AsDuration(), Msg: "Error occurred handling the event"}
+	}
+
 	return fmt.Errorf("Error occurred handling the event")
 }
 
-func NewGrpcAdapter(stream v1.FaasService_TriggerStreamServer) *GrpcAdapter {
+// NewGrpcAdapter - creates an adapter for a worker's trigger stream, runtime API calls made by the worker are handled by runtime, which may be nil
+func NewGrpcAdapter(stream v1.FaasService_TriggerStreamServer, runtime RuntimeHandler, identity *stack.Identity) *GrpcAdapter {
+	var metadata *v1.TriggerMetadata
+	if identity != nil {
+		metadata = &v1.TriggerMetadata{
+			Stack:       identity.Name,
+			Environment: identity.Environment,
+		}
+	}
+
 	return &GrpcAdapter{
 		stream:            stream,
 		responseQueueLock: &sync.Mutex{},
 		responseQueue:     make(map[string]chan *v1.TriggerResponse),
+		cancelled:         make(map[string]bool),
+		eventStreams:      make(map[string]*eventStream),
+		runtime:           runtime,
+		metadata:          metadata,
 	}
 }
@@ -15,18 +15,23 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
 
 	mock_nitric "github.com/nitrictech/nitric/mocks/nitric"
 	mock_sync "github.com/nitrictech/nitric/mocks/sync"
 	v1 "github.com/nitrictech/nitric/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/triggers"
 )
 
@@ -173,6 +178,48 @@ var _ = Describe("grpcAdapter", func() {
 			})
 		})
 
+		When("receiving runtime requests", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			errChan := make(chan error)
+			wkr := &GrpcAdapter{
+				responseQueueLock: &sync.Mutex{},
+				responseQueue:     map[string]chan *v1.TriggerResponse{},
+				stream:            stream,
+			}
+
+			It("should respond with the id of the request", func() {
+				sent := make(chan *v1.ServerMessage, 1)
+				stream.EXPECT().Context().Return(context.Background()).AnyTimes()
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent <- msg
+					return nil
+				})
+				gomock.InOrder(
+					stream.EXPECT().Recv().Return(&v1.ClientMessage{
+						Id: "runtime-call",
+						Content: &v1.ClientMessage_RuntimeRequest{
+							RuntimeRequest: &v1.RuntimeRequest{Method: "/nitric.secret.v1.SecretService/Access"},
+						},
+					}, nil),
+					stream.EXPECT().Recv().Return(nil, io.EOF),
+				)
+
+				go func() {
+					wkr.Start(errChan)
+				}()
+
+				Expect(<-errChan).To(Equal(io.EOF))
+
+				msg := <-sent
+				By("refusing the call without a runtime handler")
+				Expect(msg.GetId()).To(Equal("runtime-call"))
+				Expect(msg.GetRuntimeResponse().GetCode()).To(Equal(int32(codes.Unimplemented)))
+
+				ctrl.Finish()
+			})
+		})
+
 		When("receiving invalid trigger responses", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
@@ -294,6 +341,96 @@ var _ = Describe("grpcAdapter", func() {
 		PWhen("the worker successfully responds", func() {
 			// TODO
 		})
+
+		When("the worker responds with an event stream", func() {
+			var stream *mock_nitric.MockFaasService_TriggerStreamServer
+			var wkr *GrpcAdapter
+			var triggerIds chan string
+			var closed chan string
+			var recv chan *v1.ClientMessage
+			var errChan chan error
+
+			BeforeEach(func() {
+				ctrl := gomock.NewController(GinkgoT())
+				stream = mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+				wkr = NewGrpcAdapter(stream, nil, nil)
+				triggerIds = make(chan string, 1)
+				closed = make(chan string, 1)
+				recv = make(chan *v1.ClientMessage, 3)
+				errChan = make(chan error)
+
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					if msg.GetTriggerRequest() != nil {
+						triggerIds <- msg.GetId()
+					}
+					if msg.GetEventStreamClosed() != nil {
+						closed <- msg.GetId()
+					}
+					return nil
+				}).AnyTimes()
+				stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+					msg, ok := <-recv
+					if !ok {
+						return nil, io.EOF
+					}
+					return msg, nil
+				}).AnyTimes()
+
+				go wkr.Start(errChan)
+			})
+
+			respond := func(events ...*v1.ServerSentEvent) {
+				id := <-triggerIds
+				recv <- &v1.ClientMessage{
+					Id: id,
+					Content: &v1.ClientMessage_TriggerResponse{
+						TriggerResponse: &v1.TriggerResponse{
+							Context: &v1.TriggerResponse_Http{
+								Http: &v1.HttpResponseContext{Status: 200, EventStream: true},
+							},
+						},
+					},
+				}
+				for _, evt := range events {
+					recv <- &v1.ClientMessage{
+						Id:      id,
+						Content: &v1.ClientMessage_ServerSentEvent{ServerSentEvent: evt},
+					}
+				}
+			}
+
+			It("should pass its events to the gateway until the worker ends it", func() {
+				go respond(&v1.ServerSentEvent{Id: "1", Data: "hello"}, &v1.ServerSentEvent{End: true})
+
+				resp, err := wkr.HandleHttpRequest(&triggers.HttpRequest{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Events).ToNot(BeNil())
+
+				Expect(<-resp.Events.Events).To(Equal(&triggers.ServerSentEvent{ID: "1", Data: "hello"}))
+				Eventually(resp.Events.Events).Should(BeClosed())
+
+				By("not telling the worker its stream was closed")
+				resp.Events.Close()
+				Consistently(closed).ShouldNot(Receive())
+
+				close(recv)
+				Expect(<-errChan).To(Equal(io.EOF))
+			})
+
+			It("should tell the worker when its client disconnects", func() {
+				go respond()
+
+				resp, err := wkr.HandleHttpRequest(&triggers.HttpRequest{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp.Events.Close()
+				Expect(<-closed).ToNot(BeEmpty())
+				Expect(resp.Events.Events).Should(BeClosed())
+
+				close(recv)
+				Expect(<-errChan).To(Equal(io.EOF))
+			})
+		})
 	})
 
 	Context("HandleEvent", func() {
@@ -320,5 +457,230 @@ var _ = Describe("grpcAdapter", func() {
 		PWhen("the worker successfully responds", func() {
 			// TODO
 		})
+
+		When("the stack is known", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil, &stack.Identity{Name: "my-stack", Environment: "staging"})
+
+			It("should send the stack and environment with the trigger", func() {
+				var sent *v1.ServerMessage
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent = msg
+					return fmt.Errorf("mock error")
+				})
+
+				_ = wkr.HandleEvent(&triggers.Event{Topic: "test"})
+
+				Expect(sent.GetTriggerRequest().GetMetadata().GetStack()).To(Equal("my-stack"))
+				Expect(sent.GetTriggerRequest().GetMetadata().GetEnvironment()).To(Equal("staging"))
+			})
+
+			It("should send where the event was delivered from with the trigger", func() {
+				var sent *v1.ServerMessage
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent = msg
+					return fmt.Errorf("mock error")
+				})
+
+				published := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+				_ = wkr.HandleEvent(&triggers.Event{
+					Topic:   "test",
+					Attempt: 3,
+					Metadata: triggers.Metadata{
+						Source:       "projects/my-project/subscriptions/test",
+						ProviderID:   "1234",
+						PublishTime:  published,
+						TraceContext: map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+					},
+				})
+
+				metadata := sent.GetTriggerRequest().GetMetadata()
+				Expect(metadata.GetStack()).To(Equal("my-stack"))
+				Expect(metadata.GetSource()).To(Equal("projects/my-project/subscriptions/test"))
+				Expect(metadata.GetProviderId()).To(Equal("1234"))
+				Expect(metadata.GetAttempt()).To(Equal(int32(3)))
+				Expect(metadata.GetPublishTime().AsTime()).To(Equal(published))
+				Expect(metadata.GetTraceContext()).To(HaveKeyWithValue("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			})
+		})
+
+		When("the stack isn't known and the event has no metadata", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil, nil)
+
+			It("should send the trigger without metadata", func() {
+				var sent *v1.ServerMessage
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent = msg
+					return fmt.Errorf("mock error")
+				})
+
+				_ = wkr.HandleEvent(&triggers.Event{Topic: "test"})
+
+				Expect(sent.GetTriggerRequest().GetMetadata()).To(BeNil())
+			})
+		})
+	})
+
+	Context("Deadlines", func() {
+		When("the worker doesn't respond by the trigger's deadline", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil, nil)
+
+			It("should cancel the trigger and ignore its late response", func() {
+				cancelled := make(chan string, 1)
+				triggerIds := make(chan string, 1)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					if msg.GetTriggerRequest() != nil {
+						triggerIds <- msg.GetId()
+					}
+					if msg.GetTriggerCancelled() != nil {
+						cancelled <- msg.GetId()
+					}
+					return nil
+				}).AnyTimes()
+
+				recv := make(chan *v1.ClientMessage, 1)
+				stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+					msg, ok := <-recv
+					if !ok {
+						return nil, io.EOF
+					}
+					return msg, nil
+				}).AnyTimes()
+
+				errChan := make(chan error)
+				go wkr.Start(errChan)
+
+				err := wkr.HandleEvent(&triggers.Event{ID: "1234", Topic: "test", Deadline: time.Now().Add(50 * time.Millisecond)})
+				Expect(IsTimeout(err)).To(BeTrue())
+
+				id := <-triggerIds
+				Expect(<-cancelled).To(Equal(id))
+
+				By("not closing the stream when the late response arrives")
+				recv <- &v1.ClientMessage{
+					Id: id,
+					Content: &v1.ClientMessage_TriggerResponse{
+						TriggerResponse: &v1.TriggerResponse{
+							Context: &v1.TriggerResponse_Topic{Topic: &v1.TopicResponseContext{Success: true}},
+						},
+					},
+				}
+				Consistently(errChan).ShouldNot(Receive())
+
+				close(recv)
+				Expect(<-errChan).To(Equal(io.EOF))
+			})
+		})
+	})
+
+	Context("Payload compression", func() {
+		When("the worker accepts a compressed encoding", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil, nil)
+			wkr.NegotiateCompression([]string{"gzip", "zstd"}, 10)
+
+			It("should compress large trigger data and decompress the response", func() {
+				body := []byte(strings.Repeat("large document ", 100))
+
+				sent := make(chan *v1.ServerMessage, 1)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent <- msg
+					return nil
+				})
+
+				recv := make(chan *v1.ClientMessage, 1)
+				stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+					msg, ok := <-recv
+					if !ok {
+						return nil, io.EOF
+					}
+					return msg, nil
+				}).AnyTimes()
+
+				errChan := make(chan error)
+				go wkr.Start(errChan)
+
+				go func() {
+					defer GinkgoRecover()
+					msg := <-sent
+					req := msg.GetTriggerRequest()
+
+					Expect(req.GetDataEncoding()).To(Equal("zstd"))
+					Expect(len(req.GetData())).To(BeNumerically("<", len(body)))
+					data, err := decompressPayload(req.GetDataEncoding(), req.GetData())
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(data).To(Equal(body))
+
+					compressed, _ := compressPayload("gzip", []byte("response"))
+					recv <- &v1.ClientMessage{
+						Id: msg.GetId(),
+						Content: &v1.ClientMessage_TriggerResponse{
+							TriggerResponse: &v1.TriggerResponse{
+								Data:         compressed,
+								DataEncoding: "gzip",
+								Context: &v1.TriggerResponse_Http{
+									Http: &v1.HttpResponseContext{Status: 200},
+								},
+							},
+						},
+					}
+				}()
+
+				resp, err := wkr.HandleHttpRequest(&triggers.HttpRequest{Method: "POST", Path: "/test", Body: body})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.Body).To(Equal([]byte("response")))
+
+				close(recv)
+				Expect(<-errChan).To(Equal(io.EOF))
+			})
+		})
+
+		When("the trigger data is smaller than the minimum size", func() {
+			wkr := NewGrpcAdapter(nil, nil, nil)
+			wkr.NegotiateCompression([]string{"gzip"}, 1024)
+
+			It("should not compress it", func() {
+				data, encoding, err := wkr.encodePayload([]byte("small"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(encoding).To(BeEmpty())
+				Expect(data).To(Equal([]byte("small")))
+			})
+		})
+
+		When("the worker doesn't accept a supported encoding", func() {
+			wkr := NewGrpcAdapter(nil, nil, nil)
+			wkr.NegotiateCompression([]string{"br"}, 1)
+
+			It("should not compress trigger data", func() {
+				_, encoding, err := wkr.encodePayload([]byte("uncompressed"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(encoding).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Drain", func() {
+		When("the worker is drained", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil, nil)
+
+			It("should send the worker a drain request", func() {
+				var sent *v1.ServerMessage
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent = msg
+					return nil
+				})
+
+				Expect(wkr.Drain()).To(Succeed())
+				Expect(sent.GetDrainRequest()).ToNot(BeNil())
+			})
+		})
 	})
 })
@@ -0,0 +1,105 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HoldPool - A WorkerPool that can be held while its workers are replaced, e.g. while the child process restarts.
+// Triggers that arrive while the pool is held wait for it to be released, up to the pool's timeout, instead of failing.
+type HoldPool struct {
+	WorkerPool
+	timeout time.Duration
+
+	lock sync.Mutex
+	// closed when the pool is released, nil while it isn't held
+	released chan struct{}
+	// when a worker was last added, so it's known when replacement workers have finished registering
+	lastAdded time.Time
+}
+
+// AddWorker - Adds a worker to the underlying pool, recording when it was added
+func (p *HoldPool) AddWorker(wrkr Worker) error {
+	if err := p.WorkerPool.AddWorker(wrkr); err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.lastAdded = time.Now()
+
+	return nil
+}
+
+// LastAdded - returns when a worker was last added to the pool, zero if none have been
+func (p *HoldPool) LastAdded() time.Time {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.lastAdded
+}
+
+// Hold - holds new triggers until Release is called
+func (p *HoldPool) Hold() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.released == nil {
+		p.released = make(chan struct{})
+	}
+}
+
+// Release - releases the held triggers to the pool's workers
+func (p *HoldPool) Release() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.released != nil {
+		close(p.released)
+		p.released = nil
+	}
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, waiting for the pool to be released if it's held
+func (p *HoldPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	p.lock.Lock()
+	released := p.released
+	p.lock.Unlock()
+
+	if released != nil {
+		timer := time.NewTimer(p.timeout)
+		defer timer.Stop()
+
+		select {
+		case <-released:
+		case <-timer.C:
+			return nil, fmt.Errorf("no workers available, workers were still being replaced after %s", p.timeout)
+		}
+	}
+
+	return p.WorkerPool.GetWorker(opts)
+}
+
+// NewHoldPool - Wraps a worker pool, so triggers can be held while its workers are replaced.
+// Held triggers fail once they've waited for the timeout.
+func NewHoldPool(pool WorkerPool, timeout time.Duration) *HoldPool {
+	return &HoldPool{
+		WorkerPool: pool,
+		timeout:    timeout,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("HoldPool", func() {
+	evt := &triggers.Event{
+		ID:    "1234",
+		Topic: "test",
+	}
+
+	When("the pool isn't held", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewHoldPool(NewProcessPool(&ProcessPoolOptions{}), time.Second)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return a worker immediately", func() {
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("the pool is held while its workers are replaced", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		oldWrkr := mock_worker.NewMockWorker(ctrl)
+		newWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewHoldPool(NewProcessPool(&ProcessPoolOptions{}), time.Second)
+		_ = pool.AddWorker(oldWrkr)
+
+		It("should wait for the replacement worker", func() {
+			newWrkr.EXPECT().HandlesEvent(evt).Return(true)
+
+			pool.Hold()
+			_ = pool.RemoveWorker(oldWrkr)
+
+			result := make(chan Worker, 1)
+			go func() {
+				wrkr, _ := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				result <- wrkr
+			}()
+
+			Consistently(result, 50*time.Millisecond).ShouldNot(Receive())
+
+			_ = pool.AddWorker(newWrkr)
+			pool.Release()
+
+			Eventually(result).Should(Receive(Equal(newWrkr)))
+
+			By("recording when the replacement worker was added")
+			Expect(pool.LastAdded()).To(BeTemporally("~", time.Now(), time.Second))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("the pool is held for longer than the timeout", func() {
+		pool := NewHoldPool(NewProcessPool(&ProcessPoolOptions{}), 10*time.Millisecond)
+
+		It("should fail the trigger", func() {
+			pool.Hold()
+			defer pool.Release()
+
+			_, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("still being replaced"))
+		})
+	})
+})
@@ -17,17 +17,21 @@ package worker
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
 
+	"github.com/nitrictech/nitric/pkg/stack"
 	"github.com/nitrictech/nitric/pkg/triggers"
 )
 
 // A Nitric HTTP worker
 type HttpWorker struct {
 	address string
+	// The stack and environment triggers are handled in, sent as headers with each trigger. Not sent if nil
+	identity *stack.Identity
 }
 
 func (s *HttpWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
@@ -38,6 +42,68 @@ func (s *HttpWorker) HandlesEvent(trigger *triggers.Event) bool {
 	return true
 }
 
+// setDeadlineHeader - tells the child process when a trigger must be handled by, if it has a deadline
+func setDeadlineHeader(req *fasthttp.Request, deadline time.Time) {
+	if !deadline.IsZero() {
+		req.Header.Set(triggers.DeadlineHeader, deadline.UTC().Format(time.RFC3339Nano))
+	}
+}
+
+// setStackHeaders - tells the child process the stack and environment it's handling the trigger in
+func (h *HttpWorker) setStackHeaders(req *fasthttp.Request) {
+	// Headers sent by the client can't be trusted, so only the membrane's are passed on
+	req.Header.Del(stack.StackKey)
+	req.Header.Del(stack.EnvironmentKey)
+
+	for key, value := range h.identity.Labels() {
+		req.Header.Set(key, value)
+	}
+}
+
+// setMetadataHeaders - tells the child process where a trigger was delivered from.
+// Headers sent by the client can't be trusted, so they're replaced with the membrane's, apart from the trace context
+func setMetadataHeaders(req *fasthttp.Request, md triggers.Metadata, attempt int) {
+	headers := map[string]string{
+		triggers.SourceHeader:     md.Source,
+		triggers.ProviderIDHeader: md.ProviderID,
+	}
+	if attempt > 0 {
+		headers[triggers.AttemptHeader] = strconv.Itoa(attempt)
+	}
+	if !md.PublishTime.IsZero() {
+		headers[triggers.PublishTimeHeader] = md.PublishTime.UTC().Format(time.RFC3339Nano)
+	}
+	for key, value := range md.TraceContext {
+		headers[key] = value
+	}
+
+	req.Header.Del(triggers.AttemptHeader)
+	req.Header.Del(triggers.PublishTimeHeader)
+	for key, value := range headers {
+		req.Header.Del(key)
+		if value != "" {
+			req.Header.Set(key, value)
+		}
+	}
+}
+
+// retryAfter - parses a Retry-After header, given in seconds or as an HTTP date
+func retryAfter(header []byte) (time.Duration, bool) {
+	if len(header) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(string(header)); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := fasthttp.ParseHTTPDate(header); err == nil && date.After(time.Now()) {
+		return time.Until(date), true
+	}
+
+	return 0, false
+}
+
 // HandleEvent - Handles an event from a subscription by converting it to an HTTP request.
 func (h *HttpWorker) HandleEvent(trigger *triggers.Event) error {
 	address := fmt.Sprintf("http://%s/subscriptions/%s", h.address, trigger.Topic)
@@ -47,6 +113,9 @@ func (h *HttpWorker) HandleEvent(trigger *triggers.Event) error {
 	httpRequest.Header.Add("x-nitric-request-id", trigger.ID)
 	httpRequest.Header.Add("x-nitric-source-type", triggers.TriggerType_Subscription.String())
 	httpRequest.Header.Add("x-nitric-source", trigger.Topic)
+	setDeadlineHeader(httpRequest, trigger.Deadline)
+	setMetadataHeaders(httpRequest, trigger.Metadata, trigger.Attempt)
+	h.setStackHeaders(httpRequest)
 
 	var resp fasthttp.Response
 
@@ -61,7 +130,17 @@ func (h *HttpWorker) HandleEvent(trigger *triggers.Event) error {
 	if err != nil {
 		return errors.Wrapf(err, "Error processing event (%d): %s", resp.StatusCode(), string(resp.Body()))
 	}
-	return errors.Errorf("Error processing event (%d): %s", resp.StatusCode(), string(resp.Body()))
+
+	msg := fmt.Sprintf("Error processing event (%d): %s", resp.StatusCode(), string(resp.Body()))
+	if resp.StatusCode() == fasthttp.StatusUnprocessableEntity {
+		return &PermanentError{Msg: msg}
+	}
+
+	if after, ok := retryAfter(resp.Header.Peek(fasthttp.HeaderRetryAfter)); ok {
+		return &RetryError{After: after, Msg: msg}
+	}
+
+	return errors.New(msg)
 }
 
 // HandleHttpRequest - Handles an HTTP request by forwarding it as an HTTP request.
@@ -83,6 +162,12 @@ func (h *HttpWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers
 		}
 	}
 
+	// A deadline sent by the client can't be trusted, so only the membrane's is passed on
+	httpRequest.Header.Del(triggers.DeadlineHeader)
+	setDeadlineHeader(httpRequest, trigger.Deadline)
+	setMetadataHeaders(httpRequest, trigger.Metadata, 0)
+	h.setStackHeaders(httpRequest)
+
 	httpRequest.Header.Del("Content-Length")
 	httpRequest.SetBody(trigger.Body)
 	httpRequest.Header.SetContentLength(len(trigger.Body))
@@ -98,8 +183,8 @@ func (h *HttpWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers
 
 // Creates a new HttpWorker
 // Will wait to ensure that the provided address is dialable
-// before proceeding
-func NewHttpWorker(address string) (*HttpWorker, error) {
+// before proceeding. The stack and environment are sent with each trigger, unless identity is nil
+func NewHttpWorker(address string, identity *stack.Identity) (*HttpWorker, error) {
 	// Dial the child port to see if it's open and ready...
 	maxWaitTime := time.Duration(5) * time.Second
 	// Longer poll times, e.g. 200 milliseconds results in slow lambda cold starts (15s+)
@@ -123,6 +208,7 @@ func NewHttpWorker(address string) (*HttpWorker, error) {
 
 	// Dial the provided address to ensure its availability
 	return &HttpWorker{
-		address: address,
+		address:  address,
+		identity: identity,
 	}, nil
 }
@@ -0,0 +1,118 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/limits"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// LimitPool - A WorkerPool that applies request size and time limits to http requests, by route.
+// Requests with bodies over the limit are refused with a 413, and requests the application is too slow to respond to are answered with a 504.
+type LimitPool struct {
+	WorkerPool
+	lock   sync.RWMutex
+	config *limits.Config
+}
+
+// SetConfig - Replaces the limits applied to requests, triggers already being handled keep their previous limits
+func (p *LimitPool) SetConfig(config *limits.Config) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.config = config
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will answer http requests that exceed their route's time limit with a 504
+func (p *LimitPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	p.lock.RLock()
+	l := p.config.Match(opts.Http.Path)
+	p.lock.RUnlock()
+
+	if l.MaxBodySize > 0 && len(opts.Http.Body) > l.MaxBodySize {
+		return &responseWorker{
+			response: &triggers.HttpResponse{
+				StatusCode: 413,
+				Body:       []byte(fmt.Sprintf("Request body exceeds the %d byte limit", l.MaxBodySize)),
+			},
+		}, nil
+	}
+
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Timeout <= 0 {
+		return wrkr, nil
+	}
+
+	return &limitWorker{
+		Worker:  wrkr,
+		timeout: l.Timeout,
+	}, nil
+}
+
+type limitWorker struct {
+	Worker
+	timeout time.Duration
+}
+
+func (w *limitWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	trigger.Deadline = triggers.EarliestDeadline(trigger.Deadline, w.timeout)
+
+	// buffered so the handler can complete after a timeout without blocking
+	result := make(chan httpResult, 1)
+	go func() {
+		response, err := w.Worker.HandleHttpRequest(trigger)
+		result <- httpResult{response: response, err: err}
+	}()
+
+	timer := time.NewTimer(w.timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-result:
+		if !IsTimeout(r.err) {
+			return r.response, r.err
+		}
+		// the worker cancelled the request at its deadline
+		return &triggers.HttpResponse{
+			StatusCode: 504,
+			Body:       []byte(fmt.Sprintf("Request exceeded the %s time limit", w.timeout)),
+		}, nil
+	case <-timer.C:
+		return &triggers.HttpResponse{
+			StatusCode: 504,
+			Body:       []byte(fmt.Sprintf("Request exceeded the %s time limit", w.timeout)),
+		}, nil
+	}
+}
+
+// NewLimitPool - Wraps a worker pool, applying the configured limits to http requests
+func NewLimitPool(pool WorkerPool, config *limits.Config) *LimitPool {
+	return &LimitPool{
+		WorkerPool: pool,
+		config:     config,
+	}
+}
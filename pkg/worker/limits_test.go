@@ -0,0 +1,105 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/limits"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("LimitPool", func() {
+	config := &limits.Config{
+		Default: limits.Limits{MaxBodySize: 8},
+		Routes: []*limits.Route{{
+			Prefix: "/slow",
+			Limits: limits.Limits{Timeout: 10 * time.Millisecond},
+		}},
+	}
+
+	When("a request body exceeds its route's limit", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewLimitPool(NewProcessPool(&ProcessPoolOptions{}), config)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request without a worker", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte("too large body")}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(413))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request exceeds its route's time limit", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewLimitPool(NewProcessPool(&ProcessPoolOptions{}), config)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should respond with a gateway timeout", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/slow/report"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(req).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				time.Sleep(100 * time.Millisecond)
+				return &triggers.HttpResponse{StatusCode: 200}, nil
+			})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(504))
+
+			time.Sleep(100 * time.Millisecond)
+			ctrl.Finish()
+		})
+	})
+
+	When("a request is within its limits", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewLimitPool(NewProcessPool(&ProcessPoolOptions{}), config)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should get a worker from the underlying pool", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte("body")}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+})
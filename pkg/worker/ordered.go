@@ -0,0 +1,130 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// OrderedPool - A WorkerPool that preserves the order of events sharing an ordering key.
+// Events with the same key are always handled by the same worker, one at a time and in the order they arrive,
+// while events with different keys, or without a key, are handled in parallel.
+type OrderedPool struct {
+	WorkerPool
+	lock sync.Mutex
+	keys map[string]*orderingKey
+}
+
+// orderingKey - the events waiting to be handled for a single key, in arrival order
+type orderingKey struct {
+	busy    bool
+	waiting []chan struct{}
+}
+
+// GetWorker - Retrieves the worker events with the given ordering key are pinned to,
+// choosing from the workers equivalent to the one the underlying pool would use
+func (p *OrderedPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Event == nil || opts.Event.OrderingKey == "" {
+		return wrkr, nil
+	}
+
+	ws := p.WorkerPool.GetWorkers(&GetWorkerOptions{
+		Filter: func(w Worker) bool {
+			return equivalent(wrkr, w) && (opts.Filter == nil || opts.Filter(w))
+		},
+	})
+	if len(ws) > 1 {
+		wrkr = rendezvous(opts.Event.OrderingKey, ws)
+	}
+
+	return &orderedWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+// acquire - blocks until every event received earlier with the same key has been handled
+func (p *OrderedPool) acquire(key string) {
+	p.lock.Lock()
+	k, ok := p.keys[key]
+	if !ok {
+		k = &orderingKey{}
+		p.keys[key] = k
+	}
+
+	if !k.busy {
+		k.busy = true
+		p.lock.Unlock()
+		return
+	}
+
+	ready := make(chan struct{})
+	k.waiting = append(k.waiting, ready)
+	p.lock.Unlock()
+
+	<-ready
+}
+
+// release - hands the key to the next waiting event, forgetting the key once none are left
+func (p *OrderedPool) release(key string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	k := p.keys[key]
+	if len(k.waiting) == 0 {
+		delete(p.keys, key)
+		return
+	}
+
+	next := k.waiting[0]
+	k.waiting = k.waiting[1:]
+	close(next)
+}
+
+type orderedWorker struct {
+	Worker
+	pool *OrderedPool
+}
+
+func (w *orderedWorker) unwrap() Worker {
+	return w.Worker
+}
+
+func (w *orderedWorker) HandleEvent(trigger *triggers.Event) error {
+	if trigger.OrderingKey == "" {
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	key := trigger.Topic + "/" + trigger.OrderingKey
+	w.pool.acquire(key)
+	defer w.pool.release(key)
+
+	return w.Worker.HandleEvent(trigger)
+}
+
+// NewOrderedPool - Wraps a worker pool, delivering events that share an ordering key serially to the same worker
+func NewOrderedPool(pool WorkerPool) WorkerPool {
+	return &OrderedPool{
+		WorkerPool: pool,
+		keys:       map[string]*orderingKey{},
+	}
+}
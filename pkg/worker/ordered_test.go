@@ -0,0 +1,144 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// recordingAdapter - records the events it handles and the most it handled at once
+type recordingAdapter struct {
+	lock       sync.Mutex
+	active     int
+	maxActive  int
+	handled    []string
+	handleTime time.Duration
+}
+
+func (a *recordingAdapter) HandleEvent(trigger *triggers.Event) error {
+	a.lock.Lock()
+	a.active++
+	if a.active > a.maxActive {
+		a.maxActive = a.active
+	}
+	a.lock.Unlock()
+
+	time.Sleep(a.handleTime)
+
+	a.lock.Lock()
+	a.active--
+	a.handled = append(a.handled, trigger.ID)
+	a.lock.Unlock()
+
+	return nil
+}
+
+func (a *recordingAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, nil
+}
+
+var _ = Describe("OrderedPool", func() {
+	When("several workers subscribe to the same topic", func() {
+		pool := NewOrderedPool(NewBalancedPool(NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10}), nil))
+
+		first := NewSubscriptionWorker(&recordingAdapter{}, &SubscriptionWorkerOptions{Topic: "test"})
+		second := NewSubscriptionWorker(&recordingAdapter{}, &SubscriptionWorkerOptions{Topic: "test"})
+		_ = pool.AddWorker(first)
+		_ = pool.AddWorker(second)
+
+		It("should use the same worker for events with the same ordering key", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test", OrderingKey: "customer-1"}
+
+			used := map[Worker]int{}
+			for i := 0; i < 4; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				used[wrkr.(*orderedWorker).Worker]++
+			}
+
+			Expect(used).To(HaveLen(1))
+		})
+
+		It("should keep balancing events without an ordering key", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+			used := map[Worker]int{}
+			for i := 0; i < 4; i++ {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				used[wrkr]++
+			}
+
+			Expect(used).To(Equal(map[Worker]int{first: 2, second: 2}))
+		})
+	})
+
+	Context("HandleEvent", func() {
+		handle := func(pool WorkerPool, evts ...*triggers.Event) {
+			wg := sync.WaitGroup{}
+			for _, evt := range evts {
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				wg.Add(1)
+				go func(evt *triggers.Event) {
+					defer wg.Done()
+					_ = wrkr.HandleEvent(evt)
+				}(evt)
+				// Give each event time to arrive before the next
+				time.Sleep(5 * time.Millisecond)
+			}
+			wg.Wait()
+		}
+
+		When("events share an ordering key", func() {
+			adapter := &recordingAdapter{handleTime: 20 * time.Millisecond}
+			pool := NewOrderedPool(NewProcessPool(&ProcessPoolOptions{}))
+			_ = pool.AddWorker(NewSubscriptionWorker(adapter, &SubscriptionWorkerOptions{Topic: "test"}))
+
+			It("should handle them one at a time, in the order they arrived", func() {
+				handle(pool,
+					&triggers.Event{ID: "1", Topic: "test", OrderingKey: "customer-1"},
+					&triggers.Event{ID: "2", Topic: "test", OrderingKey: "customer-1"},
+					&triggers.Event{ID: "3", Topic: "test", OrderingKey: "customer-1"},
+				)
+
+				Expect(adapter.maxActive).To(Equal(1))
+				Expect(adapter.handled).To(Equal([]string{"1", "2", "3"}))
+			})
+		})
+
+		When("events have different ordering keys", func() {
+			adapter := &recordingAdapter{handleTime: 50 * time.Millisecond}
+			pool := NewOrderedPool(NewProcessPool(&ProcessPoolOptions{}))
+			_ = pool.AddWorker(NewSubscriptionWorker(adapter, &SubscriptionWorkerOptions{Topic: "test"}))
+
+			It("should handle them in parallel", func() {
+				handle(pool,
+					&triggers.Event{ID: "1", Topic: "test", OrderingKey: "customer-1"},
+					&triggers.Event{ID: "2", Topic: "test", OrderingKey: "customer-2"},
+				)
+
+				Expect(adapter.maxActive).To(Equal(2))
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// EncodingZstd - trigger data compressed with zstd, preferred over gzip for payloads sent to workers
+	EncodingZstd = "zstd"
+
+	// DefaultPayloadCompressionMinSize - trigger data smaller than this many bytes isn't worth compressing for workers
+	DefaultPayloadCompressionMinSize = 64 * 1024
+)
+
+// payloadEncodings - the encodings trigger data can be compressed with, in order of preference
+var payloadEncodings = []string{EncodingZstd, EncodingGzip}
+
+var (
+	// safe for concurrent use with EncodeAll and DecodeAll
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// negotiatePayloadEncoding - returns the preferred encoding that the worker accepts, empty if it accepts none of them
+func negotiatePayloadEncoding(accepted []string) string {
+	for _, encoding := range payloadEncodings {
+		for _, a := range accepted {
+			if a == encoding {
+				return encoding
+			}
+		}
+	}
+
+	return ""
+}
+
+// compressPayload - compresses trigger data with the given encoding
+func compressPayload(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case EncodingZstd:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	case EncodingGzip:
+		return fasthttp.AppendGzipBytes(nil, data), nil
+	default:
+		return nil, fmt.Errorf("unsupported payload encoding %s", encoding)
+	}
+}
+
+// decompressPayload - decompresses data sent by a worker, which is returned as is if its encoding is empty
+func decompressPayload(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case "":
+		return data, nil
+	case EncodingZstd:
+		return zstdDecoder.DecodeAll(data, nil)
+	case EncodingGzip:
+		return fasthttp.AppendGunzipBytes(nil, data)
+	default:
+		return nil, fmt.Errorf("unsupported payload encoding %s", encoding)
+	}
+}
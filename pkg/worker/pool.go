@@ -44,6 +44,7 @@ type ProcessPool struct {
 	maxWorkers int
 	workerLock sync.Locker
 	workers    []Worker
+	routes     *routeTree
 	poolErr    chan error
 }
 
@@ -67,6 +68,10 @@ func (p *ProcessPool) getHttpWorkers() []Worker {
 			break
 		case *SubscriptionWorker:
 			break
+		case *ConfigWorker:
+			break
+		case *BucketNotificationWorker:
+			break
 		case *RouteWorker:
 			// Prioritise Route Workers
 			hws = prepend(hws, w)
@@ -92,6 +97,10 @@ func (p *ProcessPool) getEventWorkers() []Worker {
 			hws = prepend(hws, w)
 		case *SubscriptionWorker:
 			hws = prepend(hws, w)
+		case *ConfigWorker:
+			hws = prepend(hws, w)
+		case *BucketNotificationWorker:
+			hws = prepend(hws, w)
 		default:
 			hws = append(hws, w)
 		}
@@ -193,6 +202,11 @@ func (p *ProcessPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
 	defer p.workerLock.Unlock()
 
 	if opts.Http != nil {
+		// Route workers are prioritised, so consult the route tree first
+		if rw := p.routes.match(opts.Http, opts.Filter); rw != nil {
+			return rw, nil
+		}
+
 		ws := p.getHttpWorkers()
 
 		if opts.Filter != nil {
@@ -200,6 +214,11 @@ func (p *ProcessPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
 		}
 
 		for _, w := range ws {
+			if _, ok := w.(*RouteWorker); ok && p.routes != nil {
+				// already matched against the route tree
+				continue
+			}
+
 			if w.HandlesHttpRequest(opts.Http) {
 				return w, nil
 			}
@@ -231,6 +250,9 @@ func (p *ProcessPool) RemoveWorker(wrkr Worker) error {
 	for i, w := range p.workers {
 		if wrkr == w {
 			p.workers = append(p.workers[:i], p.workers[i+1:]...)
+			if rw, ok := wrkr.(*RouteWorker); ok && p.routes != nil {
+				p.routes.remove(rw)
+			}
 			if len(p.workers) < p.minWorkers {
 				p.poolErr <- fmt.Errorf("insufficient workers in pool, need minimum of %d, %d available", p.minWorkers, len(p.workers))
 			}
@@ -256,6 +278,17 @@ func (p *ProcessPool) AddWorker(wrkr Worker) error {
 
 	p.workers = append(p.workers, wrkr)
 
+	if p.routes == nil {
+		p.routes = newRouteTree()
+		for _, w := range p.workers {
+			if rw, ok := w.(*RouteWorker); ok {
+				p.routes.add(rw)
+			}
+		}
+	} else if rw, ok := wrkr.(*RouteWorker); ok {
+		p.routes.add(rw)
+	}
+
 	return nil
 }
 
@@ -270,6 +303,7 @@ func NewProcessPool(opts *ProcessPoolOptions) WorkerPool {
 		maxWorkers: opts.MaxWorkers,
 		workerLock: &sync.Mutex{},
 		workers:    make([]Worker, 0),
+		routes:     newRouteTree(),
 		poolErr:    make(chan error),
 	}
 }
@@ -0,0 +1,150 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// RetryPolicy - how events that workers fail to handle are redelivered
+type RetryPolicy struct {
+	// MaxAttempts - the deliveries of an event before it's treated as permanently failed and dead-lettered, unlimited if zero
+	MaxAttempts int
+	// MinBackoff - the delay before redelivering an event after its first failure, doubled after each failure since
+	MinBackoff time.Duration
+	// MaxBackoff - the longest delay before redelivering an event
+	MaxBackoff time.Duration
+}
+
+// Backoff - returns the delay before redelivering an event after the given failed attempt
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.MinBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return delay
+}
+
+// RetryPool - A WorkerPool that asks providers to redeliver failed events with an exponential backoff,
+// failing events permanently once they've been attempted too many times so they're dead-lettered.
+//
+// Attempts are counted by the provider where it reports them, otherwise by the pool, in which case
+// redeliveries to other instances aren't counted.
+type RetryPool struct {
+	WorkerPool
+	policy   *RetryPolicy
+	lock     sync.Mutex
+	attempts map[string]*eventAttempts
+}
+
+type eventAttempts struct {
+	count  int
+	failed time.Time
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will back off redeliveries of events it fails to handle
+func (p *RetryPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &retryWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+// failed - records a failed attempt to handle the event, returning the number of attempts so far
+func (p *RetryPool) failed(key string, now time.Time) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// Events that haven't been redelivered here since their longest backoff are assumed to be handled elsewhere
+	for k, a := range p.attempts {
+		if p.policy.MaxBackoff > 0 && now.Sub(a.failed) > 2*p.policy.MaxBackoff {
+			delete(p.attempts, k)
+		}
+	}
+
+	a, ok := p.attempts[key]
+	if !ok {
+		a = &eventAttempts{}
+		p.attempts[key] = a
+	}
+	a.count++
+	a.failed = now
+
+	return a.count
+}
+
+func (p *RetryPool) forget(key string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.attempts, key)
+}
+
+type retryWorker struct {
+	Worker
+	pool *RetryPool
+}
+
+func (w *retryWorker) HandleEvent(trigger *triggers.Event) error {
+	key := trigger.Topic + "/" + trigger.ID
+
+	err := w.Worker.HandleEvent(trigger)
+	if err == nil || IsPermanent(err) {
+		if trigger.Attempt == 0 {
+			w.pool.forget(key)
+		}
+		return err
+	}
+
+	attempt := trigger.Attempt
+	if attempt == 0 {
+		attempt = w.pool.failed(key, time.Now())
+	}
+
+	if w.pool.policy.MaxAttempts > 0 && attempt >= w.pool.policy.MaxAttempts {
+		w.pool.forget(key)
+		return &PermanentError{Msg: fmt.Sprintf("event failed %d attempts, last error: %v", attempt, err)}
+	}
+
+	// Delays requested by the worker take precedence
+	if _, ok := RetryAfter(err); ok {
+		return err
+	}
+
+	return &RetryError{After: w.pool.policy.Backoff(attempt), Msg: err.Error()}
+}
+
+// NewRetryPool - Wraps a worker pool, backing off redeliveries of the events its workers fail to handle
+func NewRetryPool(pool WorkerPool, policy *RetryPolicy) WorkerPool {
+	return &RetryPool{
+		WorkerPool: pool,
+		policy:     policy,
+		attempts:   map[string]*eventAttempts{},
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("RetryPool", func() {
+	policy := &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  10 * time.Second,
+		MaxBackoff:  15 * time.Second,
+	}
+
+	Context("Backoff", func() {
+		It("should double the delay after each attempt, up to the maximum", func() {
+			Expect(policy.Backoff(1)).To(Equal(10 * time.Second))
+			Expect(policy.Backoff(2)).To(Equal(15 * time.Second))
+			Expect(policy.Backoff(5)).To(Equal(15 * time.Second))
+		})
+	})
+
+	Context("HandleEvent", func() {
+		When("the provider doesn't report attempts", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+			pool := NewRetryPool(NewProcessPool(&ProcessPoolOptions{}), policy)
+			_ = pool.AddWorker(mockWrkr)
+
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+			It("should back off each attempt, then fail permanently", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true).Times(3)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(fmt.Errorf("failed")).Times(3)
+
+				var errs []error
+				for i := 0; i < 3; i++ {
+					wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+					Expect(err).ShouldNot(HaveOccurred())
+					errs = append(errs, wrkr.HandleEvent(evt))
+				}
+
+				after, ok := RetryAfter(errs[0])
+				Expect(ok).To(BeTrue())
+				Expect(after).To(Equal(10 * time.Second))
+
+				after, _ = RetryAfter(errs[1])
+				Expect(after).To(Equal(15 * time.Second))
+
+				Expect(IsPermanent(errs[2])).To(BeTrue())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the provider reports the attempt", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+			pool := NewRetryPool(NewProcessPool(&ProcessPoolOptions{}), policy)
+			_ = pool.AddWorker(mockWrkr)
+
+			evt := &triggers.Event{ID: "1234", Topic: "test", Attempt: 3}
+
+			It("should use it", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(fmt.Errorf("failed"))
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(IsPermanent(wrkr.HandleEvent(evt))).To(BeTrue())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the worker asks for its own delay", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+			pool := NewRetryPool(NewProcessPool(&ProcessPoolOptions{}), policy)
+			_ = pool.AddWorker(mockWrkr)
+
+			evt := &triggers.Event{ID: "1234", Topic: "test", Attempt: 1}
+
+			It("should keep it", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(&RetryError{After: time.Minute, Msg: "busy"})
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				after, _ := RetryAfter(wrkr.HandleEvent(evt))
+				Expect(after).To(Equal(time.Minute))
+
+				ctrl.Finish()
+			})
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"strings"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/utils"
+)
+
+// routeNode - a single path segment in a routeTree
+type routeNode struct {
+	// static child segments, keyed by their literal value
+	static map[string]*routeNode
+	// param child, matching any single segment (e.g. /:id)
+	param *routeNode
+	// wildcard child, matching the rest of the path (e.g. /files/*)
+	wildcard *routeNode
+	// workers with a path template ending at this node, keyed by the methods they handle
+	methods map[string][]*RouteWorker
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		static:  make(map[string]*routeNode),
+		methods: make(map[string][]*RouteWorker),
+	}
+}
+
+func (n *routeNode) child(segment string, last bool) *routeNode {
+	if last && segment == wildcardSegment {
+		if n.wildcard == nil {
+			n.wildcard = newRouteNode()
+		}
+		return n.wildcard
+	}
+
+	if strings.HasPrefix(segment, ":") {
+		if n.param == nil {
+			n.param = newRouteNode()
+		}
+		return n.param
+	}
+
+	c, ok := n.static[segment]
+	if !ok {
+		c = newRouteNode()
+		n.static[segment] = c
+	}
+	return c
+}
+
+func (n *routeNode) isEmpty() bool {
+	return len(n.static) == 0 && n.param == nil && n.wildcard == nil && len(n.methods) == 0
+}
+
+// routeTree - an index of route workers by their path template segments and methods,
+// used to find the worker handling a request without testing every registered route.
+//
+// Of the matching workers the most recently registered is returned, as it is when scanning the pool's route workers.
+type routeTree struct {
+	root *routeNode
+	// the order workers were registered in, later registrations take precedence
+	order map[*RouteWorker]uint64
+	next  uint64
+}
+
+func newRouteTree() *routeTree {
+	return &routeTree{
+		root:  newRouteNode(),
+		order: make(map[*RouteWorker]uint64),
+	}
+}
+
+// add - registers the route worker
+func (t *routeTree) add(w *RouteWorker) {
+	n := t.root
+	segments := utils.SplitPath(w.path)
+	for i, s := range segments {
+		n = n.child(s, i == len(segments)-1)
+	}
+
+	t.next++
+	t.order[w] = t.next
+	for _, m := range w.methods {
+		n.methods[m] = append(n.methods[m], w)
+	}
+}
+
+// remove - deregisters the route worker, pruning any branches left empty
+func (t *routeTree) remove(w *RouteWorker) {
+	delete(t.order, w)
+	t.removeFrom(t.root, utils.SplitPath(w.path), w)
+}
+
+func (t *routeTree) removeFrom(n *routeNode, segments []string, w *RouteWorker) {
+	if len(segments) == 0 {
+		for _, m := range w.methods {
+			for i, rw := range n.methods[m] {
+				if rw == w {
+					n.methods[m] = append(n.methods[m][:i:i], n.methods[m][i+1:]...)
+					break
+				}
+			}
+
+			if len(n.methods[m]) == 0 {
+				delete(n.methods, m)
+			}
+		}
+
+		return
+	}
+
+	s := segments[0]
+	if len(segments) == 1 && s == wildcardSegment {
+		if n.wildcard != nil {
+			t.removeFrom(n.wildcard, nil, w)
+			if n.wildcard.isEmpty() {
+				n.wildcard = nil
+			}
+		}
+	} else if strings.HasPrefix(s, ":") {
+		if n.param != nil {
+			t.removeFrom(n.param, segments[1:], w)
+			if n.param.isEmpty() {
+				n.param = nil
+			}
+		}
+	} else if c, ok := n.static[s]; ok {
+		t.removeFrom(c, segments[1:], w)
+		if c.isEmpty() {
+			delete(n.static, s)
+		}
+	}
+}
+
+// match - returns the most recently registered route worker handling the request that passes the filter,
+// or nil if there isn't one
+func (t *routeTree) match(trigger *triggers.HttpRequest, filter func(w Worker) bool) *RouteWorker {
+	if t == nil {
+		return nil
+	}
+
+	var matched *RouteWorker
+	t.candidates(t.root, utils.SplitPath(trigger.Path), trigger.Method, func(ws []*RouteWorker) {
+		// workers are appended as they're registered, so the last to pass the filter is the most recent
+		for i := len(ws) - 1; i >= 0; i-- {
+			if matched != nil && t.order[ws[i]] < t.order[matched] {
+				return
+			}
+
+			if filter == nil || filter(ws[i]) {
+				matched = ws[i]
+				return
+			}
+		}
+	})
+
+	return matched
+}
+
+// candidates - calls fn with the workers handling the method at each node whose path template matches the path
+func (t *routeTree) candidates(n *routeNode, segments []string, method string, fn func(ws []*RouteWorker)) {
+	if len(segments) == 0 {
+		if ws, ok := n.methods[method]; ok {
+			fn(ws)
+		}
+
+		return
+	}
+
+	if c, ok := n.static[segments[0]]; ok {
+		t.candidates(c, segments[1:], method, fn)
+	}
+
+	if n.param != nil {
+		t.candidates(n.param, segments[1:], method, fn)
+	}
+
+	if n.wildcard != nil {
+		t.candidates(n.wildcard, nil, method, fn)
+	}
+}
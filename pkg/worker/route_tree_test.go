@@ -0,0 +1,240 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("routeTree", func() {
+	newRoute := func(path string, methods ...string) *RouteWorker {
+		return NewRouteWorker(nil, &RouteWorkerOptions{
+			Api:     "test",
+			Path:    path,
+			Methods: methods,
+		})
+	}
+
+	Context("match", func() {
+		staticWrkr := newRoute("/customers/me", "GET")
+		paramWrkr := newRoute("/customers/:id", "GET", "PUT")
+		nestedWrkr := newRoute("/customers/:id/orders/:order", "GET")
+		wildcardWrkr := newRoute("/files/*", "GET")
+		nestedWildcardWrkr := newRoute("/files/:id/*", "GET")
+		midWildcardWrkr := newRoute("/assets/*/meta", "GET")
+		putWrkr := newRoute("/customers/me", "PUT")
+
+		tree := newRouteTree()
+		for _, w := range []*RouteWorker{staticWrkr, paramWrkr, nestedWrkr, wildcardWrkr, nestedWildcardWrkr, midWildcardWrkr, putWrkr} {
+			tree.add(w)
+		}
+
+		When("several routes match", func() {
+			It("should return the most recently registered route", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/me"}, nil)).To(Equal(paramWrkr))
+			})
+		})
+
+		When("only one route matches", func() {
+			It("should return that route", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/123"}, nil)).To(Equal(paramWrkr))
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/123/orders/456"}, nil)).To(Equal(nestedWrkr))
+			})
+		})
+
+		When("the filter rejects the most recently registered route", func() {
+			It("should return the next matching route", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/me"}, func(w Worker) bool {
+					return w != paramWrkr
+				})).To(Equal(staticWrkr))
+			})
+		})
+
+		When("a route's path ends with a *", func() {
+			It("should match the rest of the path", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/files/a"}, nil)).To(Equal(wildcardWrkr))
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/files/a/b/c"}, nil)).To(Equal(nestedWildcardWrkr))
+			})
+
+			It("should not match the path without a remaining segment", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/files"}, nil)).To(BeNil())
+			})
+		})
+
+		When("a route's path contains a * before its last segment", func() {
+			It("should only match the literal segment", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/assets/*/meta"}, nil)).To(Equal(midWildcardWrkr))
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/assets/a/meta"}, nil)).To(BeNil())
+			})
+		})
+
+		When("routes for the same path handle different methods", func() {
+			It("should return the route for the request's method", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "PUT", Path: "/customers/me"}, nil)).To(Equal(putWrkr))
+				Expect(tree.match(&triggers.HttpRequest{Method: "PUT", Path: "/customers/123"}, nil)).To(Equal(paramWrkr))
+			})
+
+			It("should index the routes by method at the leaf", func() {
+				leaf := tree.root.static["customers"].static["me"]
+				Expect(leaf.methods["GET"]).To(Equal([]*RouteWorker{staticWrkr}))
+				Expect(leaf.methods["PUT"]).To(Equal([]*RouteWorker{putWrkr}))
+			})
+		})
+
+		When("no route matches", func() {
+			It("should return nil", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "DELETE", Path: "/customers/123"}, nil)).To(BeNil())
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/unknown"}, nil)).To(BeNil())
+			})
+		})
+
+		When("the tree is nil", func() {
+			It("should return nil", func() {
+				var t *routeTree
+				Expect(t.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/me"}, nil)).To(BeNil())
+			})
+		})
+	})
+
+	Context("remove", func() {
+		When("removing a registered route", func() {
+			wrkr := newRoute("/customers/:id", "GET")
+			tree := newRouteTree()
+			tree.add(wrkr)
+			tree.remove(wrkr)
+
+			It("should no longer match the route", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/123"}, nil)).To(BeNil())
+			})
+
+			It("should prune the empty branches", func() {
+				Expect(tree.root.isEmpty()).To(BeTrue())
+			})
+		})
+
+		When("removing a wildcard route", func() {
+			wrkr := newRoute("/files/*", "GET", "PUT")
+			tree := newRouteTree()
+			tree.add(wrkr)
+			tree.remove(wrkr)
+
+			It("should no longer match the route", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/files/a"}, nil)).To(BeNil())
+			})
+
+			It("should prune the empty branches", func() {
+				Expect(tree.root.isEmpty()).To(BeTrue())
+			})
+		})
+
+		When("removing one of several workers for a route", func() {
+			first := newRoute("/customers/:id", "GET")
+			second := newRoute("/customers/:id", "GET")
+			tree := newRouteTree()
+			tree.add(first)
+			tree.add(second)
+			tree.remove(second)
+
+			It("should match the remaining worker", func() {
+				Expect(tree.match(&triggers.HttpRequest{Method: "GET", Path: "/customers/123"}, nil)).To(Equal(first))
+			})
+		})
+	})
+
+	Context("ProcessPool", func() {
+		When("several route workers handle a request", func() {
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+			staticWrkr := newRoute("/customers/me", "GET")
+			paramWrkr := newRoute("/customers/:id", "GET")
+			_ = pp.AddWorker(paramWrkr)
+			_ = pp.AddWorker(staticWrkr)
+
+			It("should dispatch to the most recently added worker, as the pool's route workers are prioritised", func() {
+				w, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/customers/me"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(w).To(Equal(staticWrkr))
+			})
+		})
+
+		When("route workers are added to the pool", func() {
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+			wrkr := newRoute("/customers/:id", "GET")
+			_ = pp.AddWorker(wrkr)
+
+			It("should dispatch to the route worker", func() {
+				w, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/customers/123"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(w).To(Equal(wrkr))
+			})
+
+			It("should no longer dispatch to it once removed", func() {
+				Expect(pp.RemoveWorker(wrkr)).To(Succeed())
+
+				_, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/customers/123"},
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
+
+// benchmarkPool - returns a pool containing n route workers,
+// each with a static prefix and a path parameter
+func benchmarkPool(n int) WorkerPool {
+	pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: n})
+
+	for i := 0; i < n; i++ {
+		_ = pp.AddWorker(NewRouteWorker(nil, &RouteWorkerOptions{
+			Api:     "bench",
+			Path:    fmt.Sprintf("/resource-%d/:id/items", i),
+			Methods: []string{"GET", "POST"},
+		}))
+	}
+
+	return pp
+}
+
+func benchmarkGetWorker(b *testing.B, routes int) {
+	pp := benchmarkPool(routes)
+	// target the last registered route, the worst case for a linear scan
+	opts := &GetWorkerOptions{
+		Http: &triggers.HttpRequest{
+			Method: "POST",
+			Path:   fmt.Sprintf("/resource-%d/abc/items", routes-1),
+		},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pp.GetWorker(opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetWorker10Routes(b *testing.B)   { benchmarkGetWorker(b, 10) }
+func BenchmarkGetWorker100Routes(b *testing.B)  { benchmarkGetWorker(b, 100) }
+func BenchmarkGetWorker1000Routes(b *testing.B) { benchmarkGetWorker(b, 1000) }
@@ -33,18 +33,41 @@ type RouteWorker struct {
 
 var _ Worker = &RouteWorker{}
 
+// wildcardSegment - as the last segment of a path template, matches the rest of the request path (e.g. /files/*)
+const wildcardSegment = "*"
+
 // Api - Retrieve the name of the API this
 // route worker was registered for
 func (s *RouteWorker) Api() string {
 	return s.api
 }
 
+// Path - Retrieve the path template this route worker handles
+func (s *RouteWorker) Path() string {
+	return s.path
+}
+
+// Methods - Retrieve the http methods this route worker handles
+func (s *RouteWorker) Methods() []string {
+	return s.methods
+}
+
 func (s *RouteWorker) extractPathParams(trigger *triggers.HttpRequest) (map[string]string, error) {
 	requestPathSegments := utils.SplitPath(trigger.Path)
 	pathSegments := utils.SplitPath(s.path)
 	params := make(map[string]string)
 
 	// TODO: Filter for trailing/leading slashes
+	if n := len(pathSegments); n > 0 && pathSegments[n-1] == wildcardSegment {
+		if len(requestPathSegments) < n {
+			return nil, fmt.Errorf("path template mismatch")
+		}
+
+		params[wildcardSegment] = strings.Join(requestPathSegments[n-1:], "/")
+		requestPathSegments = requestPathSegments[:n-1]
+		pathSegments = pathSegments[:n-1]
+	}
+
 	if len(requestPathSegments) != len(pathSegments) {
 		return nil, fmt.Errorf("path template mismatch")
 	}
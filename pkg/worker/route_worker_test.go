@@ -57,6 +57,28 @@ var _ = Describe("RouteWorker", func() {
 			})
 		})
 
+		When("calling HandlesHttpRequest for a wildcard path", func() {
+			wildcardWrkr := &RouteWorker{
+				methods: []string{"GET"},
+				path:    "/files/*",
+			}
+
+			It("should match the rest of the path", func() {
+				Expect(wildcardWrkr.HandlesHttpRequest(&triggers.HttpRequest{Method: "GET", Path: "/files/a"})).To(BeTrue())
+				Expect(wildcardWrkr.HandlesHttpRequest(&triggers.HttpRequest{Method: "GET", Path: "/files/a/b/c"})).To(BeTrue())
+			})
+
+			It("should not match the path without a remaining segment", func() {
+				Expect(wildcardWrkr.HandlesHttpRequest(&triggers.HttpRequest{Method: "GET", Path: "/files"})).To(BeFalse())
+			})
+
+			It("should capture the rest of the path as the * param", func() {
+				params, err := wildcardWrkr.extractPathParams(&triggers.HttpRequest{Method: "GET", Path: "/files/a/b/c"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(params).To(Equal(map[string]string{"*": "a/b/c"}))
+			})
+		})
+
 		When("calling HandleHttpRequest", func() {
 			It("should call the base grpc workers HandleEvent with augmented trigger", func() {
 				ctrl := gomock.NewController(GinkgoT())
@@ -23,7 +23,9 @@ import (
 
 // RouteWorker - Worker representation for an http api route handler
 type ScheduleWorker struct {
-	key string
+	key  string
+	rate string
+	cron string
 	Adapter
 }
 
@@ -43,6 +45,16 @@ func (s *ScheduleWorker) Key() string {
 	return s.key
 }
 
+// Rate - returns how often the schedule fires, e.g. "5 minutes", empty if it has a cron expression instead
+func (s *ScheduleWorker) Rate() string {
+	return s.rate
+}
+
+// Cron - returns the cron expression the schedule fires on, empty if it has a rate instead
+func (s *ScheduleWorker) Cron() string {
+	return s.cron
+}
+
 func (s *ScheduleWorker) HandlesEvent(trigger *triggers.Event) bool {
 	return ScheduleKeyToTopicName(s.key) == trigger.Topic
 }
@@ -54,6 +66,10 @@ func (s *ScheduleWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*trig
 
 type ScheduleWorkerOptions struct {
 	Key string
+	// How often the schedule fires, e.g. "5 minutes", only one of Rate and Cron is set
+	Rate string
+	// The cron expression the schedule fires on
+	Cron string
 }
 
 // Package private method
@@ -61,6 +77,8 @@ type ScheduleWorkerOptions struct {
 func NewScheduleWorker(adapter Adapter, opts *ScheduleWorkerOptions) *ScheduleWorker {
 	return &ScheduleWorker{
 		key:     opts.Key,
+		rate:    opts.Rate,
+		cron:    opts.Cron,
 		Adapter: adapter,
 	}
 }
@@ -0,0 +1,64 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+// SchedulePool - A WorkerPool that adds schedules handled by the membrane itself to the workers of the underlying pool,
+// so they're fired and their triggers delivered like those of any other schedule without counting as registered workers
+type SchedulePool struct {
+	WorkerPool
+	schedules []*ScheduleWorker
+}
+
+func (p *SchedulePool) matches(w Worker, opts *GetWorkerOptions) bool {
+	return opts.Filter == nil || opts.Filter(w)
+}
+
+// GetWorker - Returns the membrane's schedule worker for the event if there is one, otherwise a worker from the underlying pool
+func (p *SchedulePool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Event != nil {
+		for _, s := range p.schedules {
+			if s.HandlesEvent(opts.Event) && p.matches(s, opts) {
+				return s, nil
+			}
+		}
+	}
+
+	return p.WorkerPool.GetWorker(opts)
+}
+
+// GetWorkers - Returns the workers of the underlying pool and the membrane's schedule workers
+func (p *SchedulePool) GetWorkers(opts *GetWorkerOptions) []Worker {
+	workers := p.WorkerPool.GetWorkers(opts)
+
+	for _, s := range p.schedules {
+		if opts.Event != nil && !s.HandlesEvent(opts.Event) {
+			continue
+		}
+
+		if opts.Http == nil && p.matches(s, opts) {
+			workers = append(workers, s)
+		}
+	}
+
+	return workers
+}
+
+// NewSchedulePool - Wraps a worker pool, delivering the triggers of the given schedules to them ahead of the underlying pool's workers
+func NewSchedulePool(pool WorkerPool, schedules ...*ScheduleWorker) WorkerPool {
+	return &SchedulePool{
+		WorkerPool: pool,
+		schedules:  schedules,
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("SchedulePool", func() {
+	When("the membrane's schedule fires", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockAdapter := mock_worker.NewMockAdapter(ctrl)
+		schedule := NewScheduleWorker(mockAdapter, &ScheduleWorkerOptions{Key: "nitric-backups", Rate: "1 day"})
+
+		pool := NewSchedulePool(NewProcessPool(&ProcessPoolOptions{}), schedule)
+
+		It("should deliver the trigger to the membrane's worker", func() {
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: &triggers.Event{Topic: ScheduleKeyToTopicName("nitric-backups")}})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(schedule))
+		})
+
+		It("should list the schedule without counting it as a worker", func() {
+			Expect(pool.GetWorkers(&GetWorkerOptions{})).To(ConsistOf(schedule))
+			Expect(pool.GetWorkerCount()).To(Equal(0))
+		})
+	})
+
+	When("another event is delivered", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		schedule := NewScheduleWorker(mock_worker.NewMockAdapter(ctrl), &ScheduleWorkerOptions{Key: "nitric-backups", Rate: "1 day"})
+
+		pool := NewSchedulePool(NewProcessPool(&ProcessPoolOptions{}), schedule)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should get a worker from the underlying pool", func() {
+			evt := &triggers.Event{Topic: "orders"}
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,83 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/shedding"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// ShedPool - A WorkerPool that refuses new triggers while the membrane is shedding load,
+// protecting the co-located workers from being killed when the membrane runs out of memory.
+// Http requests are answered with a 503 and events fail, so they're redelivered later.
+type ShedPool struct {
+	WorkerPool
+	monitor *shedding.Monitor
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, or one that refuses the trigger while load is being shed
+func (p *ShedPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	reason, shedding := p.monitor.Shedding()
+	if !shedding {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	p.monitor.Shed()
+
+	return &shedWorker{
+		reason:     reason,
+		retryAfter: p.monitor.RetryAfter(),
+	}, nil
+}
+
+// shedWorker - refuses triggers while load is being shed
+type shedWorker struct {
+	UnimplementedWorker
+	reason     string
+	retryAfter time.Duration
+}
+
+func (w *shedWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	header := &fasthttp.ResponseHeader{}
+	header.Set(fasthttp.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(w.retryAfter.Seconds()))))
+
+	return &triggers.HttpResponse{
+		StatusCode: 503,
+		Header:     header,
+		Body:       []byte("The service is overloaded, try again later"),
+	}, nil
+}
+
+func (w *shedWorker) HandleEvent(trigger *triggers.Event) error {
+	return &RetryError{
+		After: w.retryAfter,
+		Msg:   fmt.Sprintf("event %s for topic %s was refused while shedding load, %s", trigger.ID, trigger.Topic, w.reason),
+	}
+}
+
+// NewShedPool - Wraps a worker pool, refusing triggers while the monitor is shedding load
+func NewShedPool(pool WorkerPool, monitor *shedding.Monitor) WorkerPool {
+	return &ShedPool{
+		WorkerPool: pool,
+		monitor:    monitor,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/shedding"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("ShedPool", func() {
+	usage := shedding.Usage{Goroutines: 1}
+	monitor, _ := shedding.NewMonitor(shedding.Thresholds{Goroutines: 10}, 2*time.Second, func() shedding.Usage {
+		return usage
+	})
+
+	ctrl := gomock.NewController(GinkgoT())
+	mockWrkr := mock_worker.NewMockWorker(ctrl)
+	pool := NewShedPool(NewProcessPool(&ProcessPoolOptions{}), monitor)
+	_ = pool.AddWorker(mockWrkr)
+
+	When("load isn't being shed", func() {
+		It("should return the underlying pool's worker", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			monitor.Check()
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+		})
+	})
+
+	When("load is being shed", func() {
+		It("should refuse http requests with a 503", func() {
+			usage.Goroutines = 20
+			monitor.Check()
+
+			req := &triggers.HttpRequest{Method: "GET", Path: "/test"}
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(503))
+			Expect(string(resp.Header.Peek("Retry-After"))).To(Equal("2"))
+		})
+
+		It("should fail events, so they're redelivered later", func() {
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			after, ok := RetryAfter(wrkr.HandleEvent(evt))
+			Expect(ok).To(BeTrue())
+			Expect(after).To(Equal(2 * time.Second))
+		})
+	})
+})
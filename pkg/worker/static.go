@@ -0,0 +1,68 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/nitrictech/nitric/pkg/static"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// StaticPool - A WorkerPool that serves static assets, passing requests for anything else to the underlying pool
+type StaticPool struct {
+	WorkerPool
+	server *static.Server
+}
+
+// GetWorker - Returns a worker responding with the requested static asset if it exists, otherwise a worker from the underlying pool
+func (p *StaticPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	response, err := p.server.Serve(opts.Http)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	return &responseWorker{
+		response: response,
+	}, nil
+}
+
+// responseWorker - responds to a request without passing it to the child process
+type responseWorker struct {
+	UnimplementedWorker
+	response *triggers.HttpResponse
+}
+
+func (w *responseWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return true
+}
+
+func (w *responseWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return w.response, nil
+}
+
+// NewStaticPool - Wraps a worker pool, serving static assets ahead of the underlying pool's workers
+func NewStaticPool(pool WorkerPool, server *static.Server) WorkerPool {
+	return &StaticPool{
+		WorkerPool: pool,
+		server:     server,
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/static"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("StaticPool", func() {
+	dir, _ := ioutil.TempDir("", "static-pool")
+	_ = ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0o644)
+	source, _ := static.NewDirSource(dir)
+
+	AfterSuite(func() {
+		os.RemoveAll(dir)
+	})
+
+	When("a request is made for an asset", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewStaticPool(NewProcessPool(&ProcessPoolOptions{}), static.NewServer(source, &static.ServerOptions{}))
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should serve the asset without a child worker", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/"}
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(200))
+			Expect(string(res.Body)).To(Equal("<html></html>"))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request is made for anything else", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewStaticPool(NewProcessPool(&ProcessPoolOptions{}), static.NewServer(source, &static.ServerOptions{}))
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should get a worker from the underlying pool", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -23,6 +23,8 @@ import (
 // RouteWorker - Worker representation for an http api route handler
 type SubscriptionWorker struct {
 	topic string
+	// The topic events this worker permanently fails to handle are published to, none if empty
+	deadLetter string
 	Delegate
 	Adapter
 }
@@ -33,6 +35,11 @@ func (s *SubscriptionWorker) Topic() string {
 	return s.topic
 }
 
+// DeadLetter - the topic events this worker permanently fails to handle are published to, the pool's default if empty
+func (s *SubscriptionWorker) DeadLetter() string {
+	return s.deadLetter
+}
+
 func (s *SubscriptionWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
 	return false
 }
@@ -47,14 +54,16 @@ func (s *SubscriptionWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*
 }
 
 type SubscriptionWorkerOptions struct {
-	Topic string
+	Topic      string
+	DeadLetter string
 }
 
 // Package private method
 // Only a pool may create a new faas worker
 func NewSubscriptionWorker(adapter Adapter, opts *SubscriptionWorkerOptions) *SubscriptionWorker {
 	return &SubscriptionWorker{
-		topic:   opts.Topic,
-		Adapter: adapter,
+		topic:      opts.Topic,
+		deadLetter: opts.DeadLetter,
+		Adapter:    adapter,
 	}
 }
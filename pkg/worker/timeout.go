@@ -0,0 +1,121 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// TimeoutHandler - called with a description of the trigger when a worker exceeds its time limit handling it
+type TimeoutHandler = func(trigger string)
+
+// TimeoutPool - A WorkerPool that limits how long its workers may spend handling a single trigger.
+// Triggers that exceed the limit fail, and the timeout handler is notified so the runaway handler can be terminated.
+type TimeoutPool struct {
+	WorkerPool
+	timeout   time.Duration
+	onTimeout TimeoutHandler
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will fail triggers that exceed the pool's time limit
+func (p *TimeoutPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &timeoutWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+type timeoutWorker struct {
+	Worker
+	pool *TimeoutPool
+}
+
+type httpResult struct {
+	response *triggers.HttpResponse
+	err      error
+}
+
+func (w *timeoutWorker) timedOut(trigger string) error {
+	if w.pool.onTimeout != nil {
+		w.pool.onTimeout(trigger)
+	}
+
+	return fmt.Errorf("%s exceeded the %s time limit", trigger, w.pool.timeout)
+}
+
+func (w *timeoutWorker) HandleEvent(trigger *triggers.Event) error {
+	trigger.Deadline = triggers.EarliestDeadline(trigger.Deadline, w.pool.timeout)
+
+	// buffered so the handler can complete after a timeout without blocking
+	result := make(chan error, 1)
+	go func() {
+		result <- w.Worker.HandleEvent(trigger)
+	}()
+
+	timer := time.NewTimer(w.pool.timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-result:
+		if !IsTimeout(err) {
+			return err
+		}
+	case <-timer.C:
+	}
+
+	return w.timedOut(fmt.Sprintf("event %s for topic %s", trigger.ID, trigger.Topic))
+}
+
+func (w *timeoutWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	trigger.Deadline = triggers.EarliestDeadline(trigger.Deadline, w.pool.timeout)
+
+	// buffered so the handler can complete after a timeout without blocking
+	result := make(chan httpResult, 1)
+	go func() {
+		response, err := w.Worker.HandleHttpRequest(trigger)
+		result <- httpResult{response: response, err: err}
+	}()
+
+	timer := time.NewTimer(w.pool.timeout)
+	defer timer.Stop()
+
+	select {
+	case res := <-result:
+		if !IsTimeout(res.err) {
+			return res.response, res.err
+		}
+	case <-timer.C:
+	}
+
+	return nil, w.timedOut(fmt.Sprintf("http request %s %s", trigger.Method, trigger.Path))
+}
+
+// NewTimeoutPool - Wraps a worker pool, limiting how long its workers may spend handling each trigger.
+// onTimeout is called whenever a trigger exceeds the limit, and may be nil.
+func NewTimeoutPool(pool WorkerPool, timeout time.Duration, onTimeout TimeoutHandler) WorkerPool {
+	return &TimeoutPool{
+		WorkerPool: pool,
+		timeout:    timeout,
+		onTimeout:  onTimeout,
+	}
+}
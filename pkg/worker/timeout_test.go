@@ -0,0 +1,92 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("TimeoutPool", func() {
+	evt := &triggers.Event{
+		ID:    "1234",
+		Topic: "test",
+	}
+
+	When("the trigger is handled within the time limit", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		timedOut := make([]string, 0)
+
+		pool := NewTimeoutPool(NewProcessPool(&ProcessPoolOptions{}), time.Second, func(trigger string) {
+			timedOut = append(timedOut, trigger)
+		})
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should return the worker's result", func() {
+			mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+			mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wrkr.HandleEvent(evt)).To(Succeed())
+			Expect(timedOut).To(BeEmpty())
+
+			By("giving the trigger a deadline at the time limit")
+			Expect(evt.Deadline).To(BeTemporally("~", time.Now().Add(time.Second), 100*time.Millisecond))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("the trigger exceeds the time limit", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		timedOut := make(chan string, 1)
+
+		pool := NewTimeoutPool(NewProcessPool(&ProcessPoolOptions{}), 10*time.Millisecond, func(trigger string) {
+			timedOut <- trigger
+		})
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should fail the trigger and notify the timeout handler", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/slow"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+			mockWrkr.EXPECT().HandleHttpRequest(req).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				time.Sleep(100 * time.Millisecond)
+				return &triggers.HttpResponse{StatusCode: 200}, nil
+			})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(res).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("exceeded the 10ms time limit")))
+			Expect(<-timedOut).To(Equal("http request GET /slow"))
+
+			// wait for the handler to complete before verifying the mock
+			time.Sleep(150 * time.Millisecond)
+			ctrl.Finish()
+		})
+	})
+})
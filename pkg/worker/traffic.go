@@ -0,0 +1,181 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"log"
+	"math/rand"
+	"net/url"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/traffic"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// How long requests sent to endpoints may take, when they don't have a deadline of their own
+const endpointTimeout = 30 * time.Second
+
+// TrafficPool - A WorkerPool that mirrors a share of http requests to shadow targets and routes a weighted share to canary targets.
+// Shadow requests are sent in the background and their responses discarded, they're dropped when too many are in flight.
+type TrafficPool struct {
+	WorkerPool
+	config *traffic.Config
+	// Bounds the shadow requests in flight
+	shadows chan struct{}
+	client  *fasthttp.Client
+	roll    func() float64
+}
+
+// sendToEndpoint - forwards a request to the endpoint of a rule's target
+func (p *TrafficPool) sendToEndpoint(rule *traffic.Rule, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	query := url.Values(trigger.Query).Encode()
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+
+	req.SetRequestURI(rule.Url(trigger.Path, query))
+	req.Header.SetMethod(trigger.Method)
+	for key, val := range trigger.Header {
+		for _, v := range val {
+			req.Header.Add(key, v)
+		}
+	}
+	req.Header.Del("Content-Length")
+	req.SetBody(trigger.Body)
+
+	deadline := triggers.EarliestDeadline(trigger.Deadline, endpointTimeout)
+
+	resp := &fasthttp.Response{}
+	if err := p.client.DoDeadline(req, resp, deadline); err != nil {
+		return nil, err
+	}
+
+	return triggers.FromHttpResponse(resp), nil
+}
+
+// shadow - sends a copy of the request to the rule's target in the background, discarding the response
+func (p *TrafficPool) shadow(rule *traffic.Rule, trigger *triggers.HttpRequest) {
+	select {
+	case p.shadows <- struct{}{}:
+	default:
+		log.Default().Printf("dropping shadow of request to %s, too many shadow requests are in flight", trigger.Path)
+		return
+	}
+
+	header := make(map[string][]string, len(trigger.Header)+1)
+	for k, v := range trigger.Header {
+		header[k] = v
+	}
+	header[traffic.ShadowHeader] = []string{"true"}
+
+	// The body is copied, as the gateway may reuse its buffer once the original request has been handled
+	shadow := *trigger
+	shadow.Header = header
+	shadow.Body = append([]byte(nil), trigger.Body...)
+
+	go func() {
+		defer func() { <-p.shadows }()
+
+		var response *triggers.HttpResponse
+		var err error
+		if rule.Target.Endpoint != nil {
+			response, err = p.sendToEndpoint(rule, &shadow)
+		} else {
+			shadow.Path = rule.Rewrite(shadow.Path)
+
+			var wrkr Worker
+			if wrkr, err = p.WorkerPool.GetWorker(&GetWorkerOptions{Http: &shadow}); err == nil {
+				response, err = wrkr.HandleHttpRequest(&shadow)
+			}
+		}
+
+		if err != nil {
+			log.Default().Printf("error shadowing request to %s: %v", trigger.Path, err)
+			return
+		}
+
+		if response.Events != nil {
+			response.Events.Close()
+		}
+	}()
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, after mirroring shadowed requests.
+// Canary requests are rewritten to their target's path, or handled by forwarding them to its endpoint
+func (p *TrafficPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	// Headers sent by the client can't be trusted, so only the membrane's are passed on
+	if opts.Http.Header == nil {
+		opts.Http.Header = make(map[string][]string)
+	}
+	delete(opts.Http.Header, traffic.ShadowHeader)
+	delete(opts.Http.Header, traffic.CanaryHeader)
+
+	if rule := traffic.Match(p.config.Shadows, opts.Http.Path); rule != nil && rule.Selects(opts.Http.Header, p.roll) {
+		p.shadow(rule, opts.Http)
+	}
+
+	rule := traffic.Match(p.config.Canaries, opts.Http.Path)
+	if rule == nil || !rule.Selects(opts.Http.Header, p.roll) {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	opts.Http.Header[traffic.CanaryHeader] = []string{"true"}
+
+	if rule.Target.Endpoint != nil {
+		return &endpointWorker{
+			pool: p,
+			rule: rule,
+		}, nil
+	}
+
+	opts.Http.Path = rule.Rewrite(opts.Http.Path)
+
+	return p.WorkerPool.GetWorker(opts)
+}
+
+// endpointWorker - handles canary requests by forwarding them to the endpoint of their rule's target
+type endpointWorker struct {
+	UnimplementedWorker
+	pool *TrafficPool
+	rule *traffic.Rule
+}
+
+func (w *endpointWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return true
+}
+
+func (w *endpointWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return w.pool.sendToEndpoint(w.rule, trigger)
+}
+
+// NewTrafficPool - Wraps a worker pool, shadowing and canary routing http requests.
+// At most maxShadows shadow requests are in flight at once
+func NewTrafficPool(pool WorkerPool, config *traffic.Config, maxShadows int) *TrafficPool {
+	return &TrafficPool{
+		WorkerPool: pool,
+		config:     config,
+		shadows:    make(chan struct{}, maxShadows),
+		client:     &fasthttp.Client{},
+		roll: func() float64 {
+			return rand.Float64() * 100
+		},
+	}
+}
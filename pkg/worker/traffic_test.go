@@ -0,0 +1,135 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/traffic"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("TrafficPool", func() {
+	When("a request is selected for a canary handler", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		canaries, _ := traffic.ParseRules("/orders;target=/orders-v2;percent=10")
+		pool := NewTrafficPool(NewProcessPool(&ProcessPoolOptions{}), &traffic.Config{Canaries: canaries}, 1)
+		pool.roll = func() float64 { return 5 }
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should be routed to the canary path", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/orders/1", Header: map[string][]string{traffic.CanaryHeader: {"forged"}}}
+
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+			Expect(req.Path).To(Equal("/orders-v2/1"))
+			Expect(req.Header[traffic.CanaryHeader]).To(Equal([]string{"true"}))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request isn't selected for a canary", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		canaries, _ := traffic.ParseRules("/orders;target=/orders-v2;percent=10")
+		pool := NewTrafficPool(NewProcessPool(&ProcessPoolOptions{}), &traffic.Config{Canaries: canaries}, 1)
+		pool.roll = func() float64 { return 50 }
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should be routed to its own handler", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/orders/1"}
+
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			_, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(req.Path).To(Equal("/orders/1"))
+			Expect(req.Header).ToNot(HaveKey(traffic.CanaryHeader))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request is selected for a canary endpoint", func() {
+		It("should be forwarded to the endpoint", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				w.WriteHeader(201)
+				_, _ = w.Write([]byte(r.Method + " " + r.URL.String() + " " + r.Header.Get(traffic.CanaryHeader) + " " + string(body)))
+			}))
+			defer server.Close()
+
+			canaries, _ := traffic.ParseRules("/orders;target=" + server.URL + "/v2;percent=100")
+			pool := NewTrafficPool(NewProcessPool(&ProcessPoolOptions{}), &traffic.Config{Canaries: canaries}, 1)
+
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders/1", Query: map[string][]string{"a": {"b"}}, Body: []byte("hello")}
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(201))
+			Expect(string(resp.Body)).To(Equal("POST /v2/1?a=b true hello"))
+		})
+	})
+
+	When("a request is shadowed", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		shadows, _ := traffic.ParseRules("/orders;target=/orders-next;percent=100")
+		pool := NewTrafficPool(NewProcessPool(&ProcessPoolOptions{}), &traffic.Config{Shadows: shadows}, 1)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should send a copy to the shadow path, and the original to its handler", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte("hello")}
+
+			shadowed := make(chan *triggers.HttpRequest, 1)
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Return(true).Times(2)
+			mockWrkr.EXPECT().HandleHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+				shadowed <- trigger
+				return &triggers.HttpResponse{StatusCode: 500}, nil
+			})
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+			Expect(req.Path).To(Equal("/orders"))
+			Expect(req.Header).ToNot(HaveKey(traffic.ShadowHeader))
+
+			var shadow *triggers.HttpRequest
+			Eventually(shadowed).Should(Receive(&shadow))
+			Expect(shadow.Path).To(Equal("/orders-next"))
+			Expect(shadow.Header[traffic.ShadowHeader]).To(Equal([]string{"true"}))
+			Expect(shadow.Body).To(Equal([]byte("hello")))
+
+			ctrl.Finish()
+		})
+	})
+})
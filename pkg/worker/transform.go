@@ -0,0 +1,135 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/transform"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// TransformPool - A WorkerPool that runs the items of bucket notifications through the bucket transforms that apply to them,
+// before their workers handle the notifications. Transformed items are written to their output key, which is given to workers
+// in the notification, and deleted with the item they were transformed from.
+type TransformPool struct {
+	WorkerPool
+	config  *transform.Config
+	storage storage.StorageService
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will transform the items of notifications before handling them
+func (p *TransformPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transformWorker{
+		Worker:  wrkr,
+		config:  p.config,
+		storage: p.storage,
+	}, nil
+}
+
+type transformWorker struct {
+	Worker
+	config  *transform.Config
+	storage storage.StorageService
+}
+
+// transform - applies the rule to the notification's item, returning false if the item can't be transformed
+func (w *transformWorker) transform(rule *transform.Rule, notification *BucketNotification, output string) (bool, error) {
+	if notification.Type == NotificationDeleted {
+		if err := w.storage.Delete(notification.Bucket, output); err != nil && errors.Code(err) != codes.NotFound {
+			return false, fmt.Errorf("error deleting transformed item %s: %v", output, err)
+		}
+
+		return true, nil
+	}
+
+	content, err := w.storage.Read(notification.Bucket, notification.Key)
+	if err != nil {
+		// the item was deleted after the notification was sent, its deletion is notified separately
+		if errors.Code(err) == codes.NotFound {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error reading item %s to transform: %v", notification.Key, err)
+	}
+
+	transformed, err := rule.Apply(notification.Key, content)
+	if err == transform.ErrNotImage {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if _, err := w.storage.Write(notification.Bucket, output, transformed); err != nil {
+		return false, fmt.Errorf("error writing transformed item %s: %v", output, err)
+	}
+
+	return true, nil
+}
+
+func (w *transformWorker) HandleEvent(trigger *triggers.Event) error {
+	notification, ok := ParseBucketNotification(trigger)
+	if !ok {
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	rule := w.config.Match(notification.Bucket, notification.Key)
+	if rule == nil {
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	output := w.config.Output(rule, notification.Key)
+
+	// failures are retried with the notification, items that can't be transformed are handled as they are
+	transformed, err := w.transform(rule, notification, output)
+	if err != nil {
+		return err
+	}
+
+	if !transformed {
+		log.Default().Printf("not transforming item %s of bucket %s, it isn't a supported image or no longer exists", notification.Key, notification.Bucket)
+		return w.Worker.HandleEvent(trigger)
+	}
+
+	notification.Output = output
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	evt := *trigger
+	evt.Payload = payload
+
+	return w.Worker.HandleEvent(&evt)
+}
+
+// NewTransformPool - Wraps a worker pool, transforming the items of bucket notifications before its workers handle them
+func NewTransformPool(pool WorkerPool, config *transform.Config, storage storage.StorageService) WorkerPool {
+	return &TransformPool{
+		WorkerPool: pool,
+		config:     config,
+		storage:    storage,
+	}
+}
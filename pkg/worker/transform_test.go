@@ -0,0 +1,150 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_storage "github.com/nitrictech/nitric/mocks/storage"
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/pkg/transform"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+func pngOf(width int, height int) []byte {
+	out := &bytes.Buffer{}
+	_ = png.Encode(out, image.NewRGBA(image.Rect(0, 0, width, height)))
+	return out.Bytes()
+}
+
+var _ = Describe("TransformPool", func() {
+	config, _ := transform.ParseConfig("images/uploads/=resize:10x10", "")
+
+	Context("HandleEvent", func() {
+		When("the item is an image matching a rule", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			evt := (&BucketNotification{Bucket: "images", Key: "uploads/cat.png", Type: NotificationCreated}).Event("1")
+
+			pool := NewTransformPool(NewProcessPool(&ProcessPoolOptions{}), config, mockStorage)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should write the transformed item and give its key to the worker", func() {
+				var handled *triggers.Event
+
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockStorage.EXPECT().Read("images", "uploads/cat.png").Return(pngOf(100, 50), nil)
+				mockStorage.EXPECT().Write("images", "transformed/uploads/cat.png", gomock.Any()).DoAndReturn(
+					func(bucket string, key string, content []byte, opts ...func(*storage.WriteOptions)) (string, error) {
+						img, err := png.Decode(bytes.NewReader(content))
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(img.Bounds().Dx()).To(Equal(10))
+						Expect(img.Bounds().Dy()).To(Equal(5))
+						return "", nil
+					})
+				mockWrkr.EXPECT().HandleEvent(gomock.Any()).DoAndReturn(func(e *triggers.Event) error {
+					handled = e
+					return nil
+				})
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				notification, ok := ParseBucketNotification(handled)
+				Expect(ok).To(BeTrue())
+				Expect(notification.Output).To(Equal("transformed/uploads/cat.png"))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the item isn't an image", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			evt := (&BucketNotification{Bucket: "images", Key: "uploads/notes.txt", Type: NotificationCreated}).Event("1")
+
+			pool := NewTransformPool(NewProcessPool(&ProcessPoolOptions{}), config, mockStorage)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should give the notification to the worker unchanged", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockStorage.EXPECT().Read("images", "uploads/notes.txt").Return([]byte("notes"), nil)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the item has been deleted", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			evt := (&BucketNotification{Bucket: "images", Key: "uploads/cat.png", Type: NotificationDeleted}).Event("1")
+
+			pool := NewTransformPool(NewProcessPool(&ProcessPoolOptions{}), config, mockStorage)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should delete the transformed item", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockStorage.EXPECT().Delete("images", "transformed/uploads/cat.png").Return(
+					errors.ErrorsWithScope("memoryStorage.Delete", nil)(codes.NotFound, "not found", nil))
+				mockWrkr.EXPECT().HandleEvent(gomock.Any()).Return(nil)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the item isn't matched by a rule", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			mockStorage := mock_storage.NewMockStorageService(ctrl)
+			evt := (&BucketNotification{Bucket: "documents", Key: "uploads/cat.png", Type: NotificationCreated}).Event("1")
+
+			pool := NewTransformPool(NewProcessPool(&ProcessPoolOptions{}), config, mockStorage)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should give the notification to the worker without reading the item", func() {
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).Return(nil)
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				ctrl.Finish()
+			})
+		})
+	})
+})
@@ -0,0 +1,112 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync/atomic"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// Utilization - A snapshot of how saturated a pool's workers are
+type Utilization struct {
+	// InFlight - the number of triggers currently being handled
+	InFlight int64
+	// Capacity - the number of triggers the pool's workers can handle concurrently
+	Capacity int64
+}
+
+// Ratio - the fraction of capacity in use, values above 1 indicate triggers are queueing.
+// A pool without capacity is fully utilized if it has triggers in flight.
+func (u Utilization) Ratio() float64 {
+	if u.Capacity <= 0 {
+		if u.InFlight > 0 {
+			return 1
+		}
+		return 0
+	}
+
+	return float64(u.InFlight) / float64(u.Capacity)
+}
+
+// UtilizationSource - Reports the current utilization of a pool
+type UtilizationSource interface {
+	Utilization() Utilization
+}
+
+// UtilizationPool - A WorkerPool that tracks the number of triggers being handled by its workers,
+// as a signal for autoscaling on handler saturation rather than CPU
+type UtilizationPool struct {
+	// accessed atomically, kept first for 64-bit alignment
+	inFlight int64
+	WorkerPool
+	// the number of triggers each worker can handle concurrently
+	workerConcurrency int64
+}
+
+var _ UtilizationSource = &UtilizationPool{}
+
+// Utilization - returns the number of triggers in flight and the capacity of the pool's current workers
+func (p *UtilizationPool) Utilization() Utilization {
+	return Utilization{
+		InFlight: atomic.LoadInt64(&p.inFlight),
+		Capacity: int64(p.GetWorkerCount()) * p.workerConcurrency,
+	}
+}
+
+// GetWorker - Retrieves a worker from the underlying pool, which will be counted as busy while handling triggers
+func (p *UtilizationPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &utilizationWorker{
+		Worker: wrkr,
+		pool:   p,
+	}, nil
+}
+
+type utilizationWorker struct {
+	Worker
+	pool *UtilizationPool
+}
+
+func (w *utilizationWorker) HandleEvent(trigger *triggers.Event) error {
+	atomic.AddInt64(&w.pool.inFlight, 1)
+	defer atomic.AddInt64(&w.pool.inFlight, -1)
+
+	return w.Worker.HandleEvent(trigger)
+}
+
+func (w *utilizationWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	atomic.AddInt64(&w.pool.inFlight, 1)
+	defer atomic.AddInt64(&w.pool.inFlight, -1)
+
+	return w.Worker.HandleHttpRequest(trigger)
+}
+
+// NewUtilizationPool - Wraps a worker pool, tracking the utilization of its workers.
+// workerConcurrency is the number of triggers each worker is expected to handle concurrently.
+func NewUtilizationPool(pool WorkerPool, workerConcurrency int) *UtilizationPool {
+	if workerConcurrency < 1 {
+		workerConcurrency = 1
+	}
+
+	return &UtilizationPool{
+		WorkerPool:        pool,
+		workerConcurrency: int64(workerConcurrency),
+	}
+}
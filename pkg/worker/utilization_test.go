@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("UtilizationPool", func() {
+	Context("Utilization", func() {
+		When("triggers are being handled", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockWrkr := mock_worker.NewMockWorker(ctrl)
+			evt := &triggers.Event{ID: "1234", Topic: "test"}
+
+			pool := NewUtilizationPool(NewProcessPool(&ProcessPoolOptions{}), 4)
+			_ = pool.AddWorker(mockWrkr)
+
+			It("should count the in flight triggers against the pool's capacity", func() {
+				var during Utilization
+
+				mockWrkr.EXPECT().HandlesEvent(evt).Return(true)
+				mockWrkr.EXPECT().HandleEvent(evt).DoAndReturn(func(*triggers.Event) error {
+					during = pool.Utilization()
+					return nil
+				})
+
+				wrkr, err := pool.GetWorker(&GetWorkerOptions{Event: evt})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr.HandleEvent(evt)).To(Succeed())
+
+				By("counting the trigger while it's handled")
+				Expect(during).To(Equal(Utilization{InFlight: 1, Capacity: 4}))
+				Expect(during.Ratio()).To(Equal(0.25))
+
+				By("releasing it once handled")
+				Expect(pool.Utilization().InFlight).To(BeEquivalentTo(0))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Ratio", func() {
+		When("the pool has no capacity", func() {
+			It("should be fully utilized with triggers in flight", func() {
+				Expect(Utilization{InFlight: 2}.Ratio()).To(Equal(1.0))
+				Expect(Utilization{}.Ratio()).To(Equal(0.0))
+			})
+		})
+
+		When("triggers exceed capacity", func() {
+			It("should be greater than 1", func() {
+				Expect(Utilization{InFlight: 3, Capacity: 2}.Ratio()).To(Equal(1.5))
+			})
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+// ValidationPool - A WorkerPool that validates requests against the schemas of their routes before they reach a worker.
+// Requests that don't conform are refused with a 400, describing each violation as JSON.
+type ValidationPool struct {
+	WorkerPool
+	schemas *schema.RouteSchemas
+}
+
+// GetWorker - Retrieves a worker from the underlying pool for requests that conform to their route's schemas
+func (p *ValidationPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	err := p.schemas.Validate(opts.Http)
+
+	var validationErr *schema.RequestValidationError
+	if errors.As(err, &validationErr) {
+		body, err := json.Marshal(validationErr)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &fasthttp.ResponseHeader{}
+		header.SetContentType("application/json")
+
+		return &responseWorker{
+			response: &triggers.HttpResponse{
+				Header:     header,
+				StatusCode: 400,
+				Body:       body,
+			},
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return p.WorkerPool.GetWorker(opts)
+}
+
+// NewValidationPool - Wraps a worker pool, validating requests against the schemas of their routes
+func NewValidationPool(pool WorkerPool, schemas *schema.RouteSchemas) *ValidationPool {
+	return &ValidationPool{
+		WorkerPool: pool,
+		schemas:    schemas,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/schema"
+	"github.com/nitrictech/nitric/pkg/triggers"
+)
+
+var _ = Describe("ValidationPool", func() {
+	schemas, _ := schema.LoadOpenApi([]byte(`{
+		"paths": {
+			"/orders": {
+				"post": {
+					"requestBody": {
+						"content": { "application/json": { "schema": { "type": "object", "required": ["id"] } } }
+					}
+				}
+			}
+		}
+	}`))
+
+	When("a request conforms to its route's schema", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewValidationPool(NewProcessPool(&ProcessPoolOptions{}), schemas)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should get a worker", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte(`{"id": "order-1"}`)}
+
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request doesn't conform to its route's schema", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewValidationPool(NewProcessPool(&ProcessPoolOptions{}), schemas)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request with its violations", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/orders", Body: []byte(`{}`)}
+
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(400))
+			Expect(string(res.Header.ContentType())).To(Equal("application/json"))
+
+			body := &schema.RequestValidationError{}
+			Expect(json.Unmarshal(res.Body, body)).To(Succeed())
+			Expect(body.Violations).To(HaveLen(1))
+			Expect(body.Violations[0].In).To(Equal("body"))
+
+			ctrl.Finish()
+		})
+	})
+})
@@ -0,0 +1,135 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/versioning"
+)
+
+// VersionPool - A WorkerPool that manages the lifecycle of versioned APIs.
+// Requests made to a version are routed to the version's target, include its deprecation headers in their responses
+// and are refused once the version is sunset.
+type VersionPool struct {
+	WorkerPool
+	config  *versioning.Config
+	metrics *versioning.Metrics
+	now     func() time.Time
+}
+
+// GetWorker - Retrieves a worker from the underlying pool.
+// Versioned http requests are rewritten to their version's target before a worker is selected to handle them.
+func (p *VersionPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	version := p.config.Match(opts.Http.Path)
+	if version == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	if version.IsSunset(p.now()) {
+		return &sunsetWorker{
+			version: version,
+			metrics: p.metrics,
+		}, nil
+	}
+
+	opts.Http.Path = version.Rewrite(opts.Http.Path)
+	if opts.Http.Header == nil {
+		opts.Http.Header = make(map[string][]string)
+	}
+	opts.Http.Header[versioning.VersionHeader] = []string{version.Name}
+
+	wrkr, err := p.WorkerPool.GetWorker(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &versionWorker{
+		Worker:  wrkr,
+		version: version,
+		metrics: p.metrics,
+	}, nil
+}
+
+func setVersionHeaders(response *triggers.HttpResponse, version *versioning.Version) {
+	if response.Header == nil {
+		response.Header = &fasthttp.ResponseHeader{}
+	}
+
+	for k, v := range version.Headers() {
+		response.Header.Set(k, v)
+	}
+}
+
+type versionWorker struct {
+	Worker
+	version *versioning.Version
+	metrics *versioning.Metrics
+}
+
+func (w *versionWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	start := time.Now()
+	response, err := w.Worker.HandleHttpRequest(trigger)
+	w.metrics.Record(w.version.Name, time.Since(start), err != nil || response.StatusCode >= 500)
+
+	if err != nil {
+		return nil, err
+	}
+
+	setVersionHeaders(response, w.version)
+
+	return response, nil
+}
+
+// sunsetWorker - refuses requests made to a version after it's been sunset
+type sunsetWorker struct {
+	UnimplementedWorker
+	version *versioning.Version
+	metrics *versioning.Metrics
+}
+
+func (w *sunsetWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return true
+}
+
+func (w *sunsetWorker) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	w.metrics.Record(w.version.Name, 0, false)
+
+	response := &triggers.HttpResponse{
+		StatusCode: 410,
+		Body:       []byte(fmt.Sprintf("API version %s is no longer available", w.version.Name)),
+	}
+	setVersionHeaders(response, w.version)
+
+	return response, nil
+}
+
+// NewVersionPool - Wraps a worker pool, routing versioned http requests and recording per version metrics
+func NewVersionPool(pool WorkerPool, config *versioning.Config, metrics *versioning.Metrics) WorkerPool {
+	return &VersionPool{
+		WorkerPool: pool,
+		config:     config,
+		metrics:    metrics,
+		now:        time.Now,
+	}
+}
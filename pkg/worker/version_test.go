@@ -0,0 +1,104 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/versioning"
+)
+
+var _ = Describe("VersionPool", func() {
+	config, _ := versioning.ParseConfig("v1;target=/;deprecation=2021-12-01;successor=v2,v2,v0;sunset=2021-01-01")
+
+	When("a request is made to a deprecated version", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		metrics := versioning.NewMetrics()
+
+		pool := NewVersionPool(NewProcessPool(&ProcessPoolOptions{}), config, metrics)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should route the request to the version's target and include its deprecation headers", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/v1/orders"}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).DoAndReturn(func(trigger *triggers.HttpRequest) bool {
+				Expect(trigger.Path).To(Equal("/orders"))
+				Expect(trigger.Header[versioning.VersionHeader]).To(Equal([]string{"v1"}))
+				return true
+			})
+			mockWrkr.EXPECT().HandleHttpRequest(req).Return(&triggers.HttpResponse{StatusCode: 200}, nil)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(200))
+			Expect(string(res.Header.Peek("Deprecation"))).To(Equal("Wed, 01 Dec 2021 00:00:00 GMT"))
+			Expect(string(res.Header.Peek("Link"))).To(Equal("</v2>; rel=\"successor-version\""))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request is made to a sunset version", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewVersionPool(NewProcessPool(&ProcessPoolOptions{}), config, versioning.NewMetrics())
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request without a worker", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/v0/orders"}
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(410))
+			Expect(string(res.Header.Peek("Sunset"))).To(Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Format("Mon, 02 Jan 2006 15:04:05 GMT")))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a request is made to an unversioned path", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+
+		pool := NewVersionPool(NewProcessPool(&ProcessPoolOptions{}), config, versioning.NewMetrics())
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should pass the request through unchanged", func() {
+			req := &triggers.HttpRequest{Method: "GET", Path: "/health"}
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+			Expect(req.Path).To(Equal("/health"))
+
+			ctrl.Finish()
+		})
+	})
+})
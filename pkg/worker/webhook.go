@@ -0,0 +1,66 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"log"
+
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/webhook"
+)
+
+// WebhookPool - A WorkerPool that verifies the signatures of requests to webhook routes before they reach a worker.
+// Requests with invalid signatures are refused with a 401, and requests whose secret can't be read with a 503.
+type WebhookPool struct {
+	WorkerPool
+	verifier *webhook.Verifier
+}
+
+// GetWorker - Retrieves a worker from the underlying pool for correctly signed webhooks and other requests
+func (p *WebhookPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
+	if opts.Http == nil {
+		return p.WorkerPool.GetWorker(opts)
+	}
+
+	if err := p.verifier.Verify(opts.Http); errors.Is(err, webhook.ErrInvalidSignature) {
+		return &responseWorker{
+			response: &triggers.HttpResponse{
+				StatusCode: 401,
+				Body:       []byte(err.Error()),
+			},
+		}, nil
+	} else if err != nil {
+		// The cause isn't returned to the caller, as it may name the secret
+		log.Default().Printf("error verifying webhook to %s: %v", opts.Http.Path, err)
+
+		return &responseWorker{
+			response: &triggers.HttpResponse{
+				StatusCode: 503,
+				Body:       []byte("Unable to verify webhook signature"),
+			},
+		}, nil
+	}
+
+	return p.WorkerPool.GetWorker(opts)
+}
+
+// NewWebhookPool - Wraps a worker pool, verifying the signatures of webhooks
+func NewWebhookPool(pool WorkerPool, verifier *webhook.Verifier) *WebhookPool {
+	return &WebhookPool{
+		WorkerPool: pool,
+		verifier:   verifier,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_secret "github.com/nitrictech/nitric/mocks/secret"
+	mock_worker "github.com/nitrictech/nitric/mocks/worker"
+	"github.com/nitrictech/nitric/pkg/plugins/secret"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/webhook"
+)
+
+var _ = Describe("WebhookPool", func() {
+	routes, _ := webhook.ParseRoutes("/github;scheme=github;secret=gh")
+
+	When("a webhook is correctly signed", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		mockSecrets := mock_secret.NewMockSecretService(ctrl)
+
+		verifier, _ := webhook.New(routes, mockSecrets)
+		pool := NewWebhookPool(NewProcessPool(&ProcessPoolOptions{}), verifier)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should get a worker", func() {
+			mac := hmac.New(sha256.New, []byte("gh"))
+			mac.Write([]byte("{}"))
+			req := &triggers.HttpRequest{Method: "POST", Path: "/github", Body: []byte("{}"), Header: map[string][]string{
+				"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(mac.Sum(nil))},
+			}}
+
+			mockSecrets.EXPECT().Access(gomock.Any()).Return(&secret.SecretAccessResponse{Value: []byte("gh")}, nil)
+			mockWrkr.EXPECT().HandlesHttpRequest(req).Return(true)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(mockWrkr))
+
+			ctrl.Finish()
+		})
+	})
+
+	When("a webhook's signature is invalid", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockWrkr := mock_worker.NewMockWorker(ctrl)
+		mockSecrets := mock_secret.NewMockSecretService(ctrl)
+
+		verifier, _ := webhook.New(routes, mockSecrets)
+		pool := NewWebhookPool(NewProcessPool(&ProcessPoolOptions{}), verifier)
+		_ = pool.AddWorker(mockWrkr)
+
+		It("should refuse the request without a worker", func() {
+			req := &triggers.HttpRequest{Method: "POST", Path: "/github", Body: []byte("{}"), Header: map[string][]string{
+				"X-Hub-Signature-256": {"sha256=00"},
+			}}
+
+			mockSecrets.EXPECT().Access(gomock.Any()).Return(&secret.SecretAccessResponse{Value: []byte("gh")}, nil)
+			mockWrkr.EXPECT().HandlesHttpRequest(gomock.Any()).Times(0)
+
+			wrkr, err := pool.GetWorker(&GetWorkerOptions{Http: req})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := wrkr.HandleHttpRequest(req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(401))
+
+			ctrl.Finish()
+		})
+	})
+})
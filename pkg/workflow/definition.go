@@ -0,0 +1,269 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Definition - A workflow, a sequence of steps run in order
+type Definition struct {
+	Steps []*Step `json:"steps"`
+}
+
+// Step - A single step of a workflow.
+//
+// Each step is exactly one of a task, parallel tasks, a sleep or a wait for a signal.
+type Step struct {
+	Name string `json:"name"`
+	// Task - invokes a function, the step's output is the function's response
+	Task *Task `json:"task,omitempty"`
+	// Parallel - invokes several functions at once, the step's output is their responses keyed by task name
+	Parallel []*Task `json:"parallel,omitempty"`
+	// Sleep - pauses the workflow for a duration e.g. 1h
+	Sleep string `json:"sleep,omitempty"`
+	// WaitFor - pauses the workflow until the named signal is sent, the step's output is the signal's payload
+	WaitFor string `json:"waitFor,omitempty"`
+	// Timeout - fails the workflow if the signal it's waiting for isn't sent within this duration, waits indefinitely if empty
+	Timeout string `json:"timeout,omitempty"`
+	// Retry - how failed tasks are retried, tasks aren't retried if nil
+	Retry *Retry `json:"retry,omitempty"`
+	// Compensate - undoes the step if the workflow later fails or is cancelled
+	Compensate *Compensation `json:"compensate,omitempty"`
+
+	sleep   time.Duration
+	timeout time.Duration
+}
+
+// Task - A function invoked by a workflow step, through the subscription to a topic or an HTTP route
+type Task struct {
+	// Name - identifies the task's output within a parallel step
+	Name string `json:"name,omitempty"`
+	// Topic - delivers the task as an event to the topic's subscriber, which has no output
+	Topic string `json:"topic,omitempty"`
+	// Path - delivers the task as a POST request to the route, the JSON response body is its output
+	Path string `json:"path,omitempty"`
+}
+
+// Compensation - An event published to undo a completed step, making the workflow a saga.
+//
+// When a workflow fails or is cancelled, the compensations of its completed steps are published in reverse order.
+type Compensation struct {
+	// Topic - the topic the compensation event is published to
+	Topic string `json:"topic"`
+	// Retry - how failed publishes are retried, 5 attempts with a 10s backoff if nil
+	Retry *Retry `json:"retry,omitempty"`
+}
+
+// defaultCompensationRetry - how compensations are retried unless they configure their own retry
+var defaultCompensationRetry = &Retry{MaxAttempts: 5, Backoff: "10s", backoff: 10 * time.Second}
+
+func (c *Compensation) retry() *Retry {
+	if c.Retry == nil {
+		return defaultCompensationRetry
+	}
+
+	return c.Retry
+}
+
+// Retry - How the tasks of a step are retried when they fail
+type Retry struct {
+	// MaxAttempts - attempts before the workflow fails, including the first
+	MaxAttempts int `json:"maxAttempts"`
+	// Backoff - the delay before the first retry e.g. 10s, doubled for each retry after
+	Backoff string `json:"backoff,omitempty"`
+
+	backoff time.Duration
+}
+
+func (r *Retry) delay(attempt int) time.Duration {
+	delay := r.backoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+	}
+
+	return delay
+}
+
+func parseDuration(step string, field string, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("step %s has an invalid %s, expected duration e.g. 10s, got %s", step, field, value)
+	}
+
+	return d, nil
+}
+
+func (t *Task) validate(step string) error {
+	if (t.Topic == "") == (t.Path == "") {
+		return fmt.Errorf("step %s has a task without exactly one of a topic or path", step)
+	}
+
+	return nil
+}
+
+func (s *Step) validate() error {
+	kinds := 0
+	for _, set := range []bool{s.Task != nil, len(s.Parallel) > 0, s.Sleep != "", s.WaitFor != ""} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("step %s must be exactly one of a task, parallel tasks, a sleep or a wait for a signal", s.Name)
+	}
+
+	if s.Task != nil {
+		if err := s.Task.validate(s.Name); err != nil {
+			return err
+		}
+	}
+
+	names := map[string]bool{}
+	for _, t := range s.Parallel {
+		if t.Name == "" || names[t.Name] {
+			return fmt.Errorf("step %s has parallel tasks without unique names", s.Name)
+		}
+		names[t.Name] = true
+
+		if err := t.validate(s.Name); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if s.sleep, err = parseDuration(s.Name, "sleep", s.Sleep); err != nil {
+		return err
+	}
+
+	if s.timeout, err = parseDuration(s.Name, "timeout", s.Timeout); err != nil {
+		return err
+	}
+
+	if err := s.Retry.validate(s.Name); err != nil {
+		return err
+	}
+
+	if s.Compensate != nil {
+		if s.Compensate.Topic == "" {
+			return fmt.Errorf("step %s compensation must have a topic", s.Name)
+		}
+
+		if err := s.Compensate.Retry.validate(s.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Retry) validate(step string) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("step %s retry must allow at least one attempt", step)
+	}
+
+	var err error
+	r.backoff, err = parseDuration(step, "retry backoff", r.Backoff)
+
+	return err
+}
+
+// maxAttempts - the attempts at the step's tasks before the workflow fails
+func (s *Step) maxAttempts() int {
+	if s.Retry == nil {
+		return 1
+	}
+
+	return s.Retry.MaxAttempts
+}
+
+// compensated - returns true if any of the workflow's steps can be compensated
+func (d *Definition) compensated() bool {
+	for _, s := range d.Steps {
+		if s.Compensate != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parse - parses and validates a JSON workflow definition
+func Parse(data []byte) (*Definition, error) {
+	def := &Definition{}
+	if err := json.Unmarshal(data, def); err != nil {
+		return nil, fmt.Errorf("invalid workflow definition: %v", err)
+	}
+
+	if len(def.Steps) == 0 {
+		return nil, fmt.Errorf("workflows must have at least one step")
+	}
+
+	names := map[string]bool{}
+	for _, s := range def.Steps {
+		if s.Name == "" || names[s.Name] {
+			return nil, fmt.Errorf("workflow steps must have unique names")
+		}
+		names[s.Name] = true
+
+		if err := s.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return def, nil
+}
+
+// LoadDir - loads the workflow definitions from the JSON files in a directory, named for their file
+func LoadDir(dir string) (map[string]*Definition, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := map[string]*Definition{}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(f.Name(), ".json")
+		def, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("workflow %s: %v", name, err)
+		}
+		defs[name] = def
+	}
+
+	return defs, nil
+}
@@ -0,0 +1,786 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workflow - durable multi-step executions, whose state persists in the document plugin
+// and whose steps invoke functions through the worker pool.
+//
+// Workflows whose steps declare compensations are sagas, undoing their completed steps with compensation events if they fail.
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/tenancy"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+// DefaultCollection - the document collection executions are persisted to, unless configured otherwise
+const DefaultCollection = "nitric-workflows"
+
+// drainPageSize - number of executions read at a time
+const drainPageSize = 100
+
+// DefaultLease - how long an engine running an execution holds it, unless configured otherwise.
+// It's renewed whenever the execution's progress is persisted, if it expires first another engine takes the execution over
+const DefaultLease = 5 * time.Minute
+
+const (
+	// versionField - incremented by every write of an execution, which only applies if it hasn't been written since it was read
+	versionField = "version"
+	// dueField - when an execution is next due to be run in unix milliseconds, zero if it isn't, so only runnable executions are queried
+	dueField = "dueAt"
+)
+
+// Status - the state of a workflow execution
+type Status string
+
+const (
+	Running   Status = "running"
+	Waiting   Status = "waiting"
+	Completed Status = "completed"
+	Failed    Status = "failed"
+	Cancelled Status = "cancelled"
+	// Compensating - the execution failed or was cancelled, and the compensations of its completed steps are being published
+	Compensating Status = "compensating"
+	// Compensated - every completed step of a failed or cancelled execution has been compensated
+	Compensated Status = "compensated"
+)
+
+func (s Status) finished() bool {
+	return s == Completed || s == Failed || s == Cancelled || s == Compensated
+}
+
+// Execution - A single run of a workflow
+type Execution struct {
+	ID string
+	// Tenant - the tenant that started the execution, blank if it was started without one
+	Tenant   string
+	Workflow string
+	Status   Status
+	// Step - the index of the step being run, equal to the number of steps once completed
+	Step  int
+	Input map[string]interface{}
+	// Outputs - the output of each completed step, keyed by step name
+	Outputs map[string]interface{}
+	// Attempts - the failed attempts at the current step's tasks
+	Attempts int
+	// StepStarted - when the current step was first run
+	StepStarted time.Time
+	// WakeAt - when the execution is next due to be run, zero if it's waiting for a signal without a timeout
+	WakeAt time.Time
+	// Error - why the execution failed
+	Error string
+	// Compensation - the index of the next step to compensate while compensating
+	Compensation int
+
+	// version - the version of the execution that was read, writes fail if it has been written since
+	version int64
+	// leasedUntil - when the lease of the engine running the execution expires, zero if it isn't being run
+	leasedUntil time.Time
+}
+
+// Engine - Runs workflow executions.
+//
+// Executions are persisted after each step and resumed by the engine's relay, so they survive restarts.
+// Steps may be run more than once if an instance stops between running a step and persisting its result,
+// tasks should be idempotent, using the execution and step they're invoked for.
+type Engine struct {
+	documents   document.DocumentService
+	events      events.EventService
+	pool        worker.WorkerPool
+	definitions map[string]*Definition
+	collection  *document.Collection
+	interval    time.Duration
+	lease       time.Duration
+	now         func() time.Time
+	wake        chan bool
+	stop        chan bool
+}
+
+// executionKey - the key of an execution's document, namespaced by its tenant so tenants can't reach each other's executions
+func (e *Engine) executionKey(tenant string, id string) *document.Key {
+	return &document.Key{
+		Collection: e.collection,
+		Id:         tenancy.Name(tenant, id),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func parseTime(v interface{}) time.Time {
+	s, _ := v.(string)
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
+
+func intValue(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// dueAt - when the execution is next due to be run, leased executions aren't due until their lease expires
+func (e *Engine) dueAt(exec *Execution) int64 {
+	if !exec.leasedUntil.IsZero() {
+		return unixMillis(exec.leasedUntil)
+	}
+
+	switch exec.Status {
+	case Running, Compensating:
+		if exec.WakeAt.IsZero() {
+			return unixMillis(e.now())
+		}
+		return unixMillis(exec.WakeAt)
+	case Waiting:
+		if !exec.WakeAt.IsZero() {
+			return unixMillis(exec.WakeAt)
+		}
+	}
+
+	return 0
+}
+
+// conflicted - returns true if a write failed because the execution was written since it was read
+func conflicted(err error) bool {
+	return errors.Code(err) == codes.FailedPrecondition
+}
+
+// save - persists the execution if it hasn't been written since it was read, renewing the lease of the engine running it
+func (e *Engine) save(exec *Execution) error {
+	if !exec.leasedUntil.IsZero() {
+		exec.leasedUntil = e.now().Add(e.lease)
+	}
+
+	err := e.documents.CompareAndSet(e.executionKey(exec.Tenant, exec.ID), versionField, exec.version, map[string]interface{}{
+		"tenant":       exec.Tenant,
+		"workflow":     exec.Workflow,
+		"status":       string(exec.Status),
+		"step":         exec.Step,
+		"input":        exec.Input,
+		"outputs":      exec.Outputs,
+		"attempts":     exec.Attempts,
+		"stepStarted":  formatTime(exec.StepStarted),
+		"wakeAt":       formatTime(exec.WakeAt),
+		"error":        exec.Error,
+		"compensation": exec.Compensation,
+		versionField:   exec.version + 1,
+		dueField:       e.dueAt(exec),
+	})
+	if err != nil {
+		return err
+	}
+	exec.version++
+
+	return nil
+}
+
+// settle - persists the execution once it can't be run any further for now, releasing the engine's lease
+func (e *Engine) settle(exec *Execution) error {
+	exec.leasedUntil = time.Time{}
+	return e.save(exec)
+}
+
+// claim - leases a due execution to this engine, returning false if another engine or request wrote it first
+func (e *Engine) claim(exec *Execution) bool {
+	exec.leasedUntil = e.now().Add(e.lease)
+	if err := e.save(exec); err != nil {
+		if !conflicted(err) {
+			log.Default().Printf("error claiming workflow execution %s: %v", exec.ID, err)
+		}
+		return false
+	}
+
+	return true
+}
+
+func executionFromDocument(doc *document.Document) *Execution {
+	exec := &Execution{
+		ID:           doc.Key.Id,
+		Step:         intValue(doc.Content["step"]),
+		Attempts:     intValue(doc.Content["attempts"]),
+		Compensation: intValue(doc.Content["compensation"]),
+		version:      int64(intValue(doc.Content[versionField])),
+		StepStarted:  parseTime(doc.Content["stepStarted"]),
+		WakeAt:       parseTime(doc.Content["wakeAt"]),
+	}
+	exec.Tenant, _ = doc.Content["tenant"].(string)
+	exec.ID = tenancy.StripName(exec.Tenant, exec.ID)
+	exec.Workflow, _ = doc.Content["workflow"].(string)
+	status, _ := doc.Content["status"].(string)
+	exec.Status = Status(status)
+	exec.Error, _ = doc.Content["error"].(string)
+	exec.Input, _ = doc.Content["input"].(map[string]interface{})
+	exec.Outputs, _ = doc.Content["outputs"].(map[string]interface{})
+	if exec.Outputs == nil {
+		exec.Outputs = map[string]interface{}{}
+	}
+
+	return exec
+}
+
+// notify - asks the relay to run due executions without waiting for its next interval
+func (e *Engine) notify() {
+	select {
+	case e.wake <- true:
+	default:
+	}
+}
+
+// Start - Starts a new execution of the named workflow for the tenant, generating an ID if none is given
+func (e *Engine) Start(tenant string, workflow string, id string, input map[string]interface{}) (*Execution, error) {
+	newErr := errors.ErrorsWithScope("Workflow.Start", map[string]interface{}{
+		"tenant":   tenant,
+		"workflow": workflow,
+		"id":       id,
+	})
+
+	if _, ok := e.definitions[workflow]; !ok {
+		return nil, newErr(codes.NotFound, "workflow not found", nil)
+	}
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+
+	exec := &Execution{
+		ID:       id,
+		Tenant:   tenant,
+		Workflow: workflow,
+		Status:   Running,
+		Input:    input,
+		Outputs:  map[string]interface{}{},
+		WakeAt:   e.now(),
+	}
+
+	// Executions are created at version zero, so this fails if one with the same ID exists
+	if err := e.save(exec); conflicted(err) {
+		return nil, newErr(codes.AlreadyExists, "an execution with this ID already exists", nil)
+	} else if err != nil {
+		return nil, newErr(codes.Internal, "unable to persist execution", err)
+	}
+
+	e.notify()
+
+	return exec, nil
+}
+
+// Get - Returns an execution of the tenant
+func (e *Engine) Get(tenant string, id string) (*Execution, error) {
+	doc, err := e.documents.Get(e.executionKey(tenant, id))
+	if err != nil {
+		return nil, errors.ErrorsWithScope("Workflow.Get", map[string]interface{}{"tenant": tenant, "id": id})(
+			errors.Code(err),
+			"unable to get execution",
+			err,
+		)
+	}
+
+	return executionFromDocument(doc), nil
+}
+
+// StepName - Returns the name of the step an execution is running, empty once it has completed
+func (e *Engine) StepName(exec *Execution) string {
+	def, ok := e.definitions[exec.Workflow]
+	if !ok || exec.Step >= len(def.Steps) {
+		return ""
+	}
+
+	return def.Steps[exec.Step].Name
+}
+
+// Signal - Resumes an execution of the tenant waiting for the named signal, the payload becomes the waiting step's output
+func (e *Engine) Signal(tenant string, id string, signal string, payload map[string]interface{}) error {
+	newErr := errors.ErrorsWithScope("Workflow.Signal", map[string]interface{}{
+		"tenant": tenant,
+		"id":     id,
+		"signal": signal,
+	})
+
+	exec, err := e.Get(tenant, id)
+	if err != nil {
+		return err
+	}
+
+	def, ok := e.definitions[exec.Workflow]
+	if !ok {
+		return newErr(codes.FailedPrecondition, "workflow no longer defined", nil)
+	}
+
+	if exec.Status != Waiting || def.Steps[exec.Step].WaitFor != signal {
+		return newErr(codes.FailedPrecondition, "execution isn't waiting for this signal", nil)
+	}
+
+	exec.Outputs[def.Steps[exec.Step].Name] = payload
+	e.next(exec)
+
+	if err := e.save(exec); conflicted(err) {
+		return newErr(codes.Aborted, "execution changed while being signalled, try again", nil)
+	} else if err != nil {
+		return newErr(codes.Internal, "unable to persist execution", err)
+	}
+
+	e.notify()
+
+	return nil
+}
+
+// Cancel - Stops an execution of the tenant, it won't run any further steps
+func (e *Engine) Cancel(tenant string, id string) error {
+	newErr := errors.ErrorsWithScope("Workflow.Cancel", map[string]interface{}{"tenant": tenant, "id": id})
+
+	exec, err := e.Get(tenant, id)
+	if err != nil {
+		return err
+	}
+
+	if exec.Status.finished() || exec.Status == Compensating {
+		return newErr(codes.FailedPrecondition, fmt.Sprintf("execution has already %s", exec.Status), nil)
+	}
+
+	e.fail(exec, "execution was cancelled")
+	if exec.Status == Failed {
+		exec.Status = Cancelled
+	}
+
+	// An engine running the execution fails to persist its next step once it's cancelled, and stops running it
+	if err := e.save(exec); conflicted(err) {
+		return newErr(codes.Aborted, "execution changed while being cancelled, try again", nil)
+	} else if err != nil {
+		return newErr(codes.Internal, "unable to persist execution", err)
+	}
+
+	e.notify()
+
+	return nil
+}
+
+// fail - stops running the execution's steps, compensating those it has completed if the workflow is a saga
+func (e *Engine) fail(exec *Execution, reason string) {
+	exec.Error = reason
+	exec.Attempts = 0
+	exec.WakeAt = time.Time{}
+	exec.Status = Failed
+
+	def, ok := e.definitions[exec.Workflow]
+	if !ok {
+		return
+	}
+
+	for i := exec.Step - 1; i >= 0; i-- {
+		if def.Steps[i].Compensate != nil {
+			exec.Status = Compensating
+			exec.Compensation = i
+			exec.WakeAt = e.now()
+			return
+		}
+	}
+}
+
+// compensate - publishes the compensations of a failed execution's completed steps, in reverse order
+func (e *Engine) compensate(exec *Execution, def *Definition) error {
+	for exec.Compensation >= 0 {
+		step := def.Steps[exec.Compensation]
+		if step.Compensate == nil {
+			exec.Compensation--
+			continue
+		}
+
+		event := &events.NitricEvent{
+			// The same ID is used for each attempt, so subscribers can deduplicate compensations
+			ID:          tenancy.Name(exec.Tenant, exec.ID) + "-" + step.Name + "-compensate",
+			PayloadType: "workflow.compensate",
+			Payload: map[string]interface{}{
+				"execution": exec.ID,
+				"workflow":  exec.Workflow,
+				"step":      step.Name,
+				"input":     exec.Input,
+				"output":    exec.Outputs[step.Name],
+				"error":     exec.Error,
+			},
+		}
+		tenancy.TagEvent(exec.Tenant, event)
+
+		err := e.events.Publish(step.Compensate.Topic, event)
+		if err != nil {
+			exec.Attempts++
+			log.Default().Printf("workflow execution %s compensation for step %s failed, attempt %d: %v", exec.ID, step.Name, exec.Attempts, err)
+
+			retry := step.Compensate.retry()
+			if exec.Attempts >= retry.MaxAttempts {
+				exec.Status = Failed
+				exec.WakeAt = time.Time{}
+				exec.Error = fmt.Sprintf("%s, then compensation for step %s failed: %v", exec.Error, step.Name, err)
+				return e.settle(exec)
+			}
+
+			exec.WakeAt = e.now().Add(retry.delay(exec.Attempts))
+			return e.settle(exec)
+		}
+
+		exec.Compensation--
+		exec.Attempts = 0
+		// Persisted after each compensation, so they aren't published again after a restart
+		if err := e.save(exec); err != nil {
+			return err
+		}
+	}
+
+	exec.Status = Compensated
+	exec.WakeAt = time.Time{}
+	return e.settle(exec)
+}
+
+// next - moves the execution on to its next step
+func (e *Engine) next(exec *Execution) {
+	exec.Step++
+	exec.Status = Running
+	exec.Attempts = 0
+	exec.StepStarted = time.Time{}
+	exec.WakeAt = time.Time{}
+}
+
+// taskPayload - the JSON document tasks are invoked with, including the execution's tenant so tasks can signal it
+func taskPayload(exec *Execution, step *Step) ([]byte, error) {
+	payload := map[string]interface{}{
+		"execution": exec.ID,
+		"workflow":  exec.Workflow,
+		"step":      step.Name,
+		"input":     exec.Input,
+		"outputs":   exec.Outputs,
+	}
+	if exec.Tenant != "" {
+		payload["tenant"] = exec.Tenant
+	}
+
+	return json.Marshal(payload)
+}
+
+// invoke - runs a task, returning its output
+func (e *Engine) invoke(exec *Execution, id string, task *Task, payload []byte) (interface{}, error) {
+	if task.Topic != "" {
+		evt := &triggers.Event{
+			ID:      id,
+			Topic:   task.Topic,
+			Payload: payload,
+		}
+
+		wrkr, err := e.pool.GetWorker(&worker.GetWorkerOptions{Event: evt})
+		if err != nil {
+			return nil, fmt.Errorf("no subscriber for topic %s: %v", task.Topic, err)
+		}
+
+		return nil, wrkr.HandleEvent(evt)
+	}
+
+	req := &triggers.HttpRequest{
+		Method: "POST",
+		Path:   task.Path,
+		Body:   payload,
+		Header: map[string][]string{
+			"Content-Type":                {"application/json"},
+			"x-nitric-workflow-execution": {exec.ID},
+		},
+		Query: map[string][]string{},
+	}
+	if exec.Tenant != "" {
+		req.Header[tenancy.MetadataKey] = []string{exec.Tenant}
+	}
+
+	wrkr, err := e.pool.GetWorker(&worker.GetWorkerOptions{Http: req})
+	if err != nil {
+		return nil, fmt.Errorf("no route for path %s: %v", task.Path, err)
+	}
+
+	resp, err := wrkr.HandleHttpRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Events != nil {
+		resp.Events.Close()
+		return nil, fmt.Errorf("route %s responded with an event stream, which can't be used as a step's output", task.Path)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("route %s responded with status %d: %s", task.Path, resp.StatusCode, resp.Body)
+	}
+
+	if len(resp.Body) == 0 {
+		return nil, nil
+	}
+
+	var output interface{}
+	if err := json.Unmarshal(resp.Body, &output); err != nil {
+		return string(resp.Body), nil
+	}
+
+	return output, nil
+}
+
+// runTasks - runs a step's tasks, in parallel if there's more than one
+func (e *Engine) runTasks(exec *Execution, step *Step) (interface{}, error) {
+	payload, err := taskPayload(exec, step)
+	if err != nil {
+		return nil, err
+	}
+
+	// Task IDs are namespaced like the execution, so tenants' tasks aren't mistaken for duplicates of each other
+	id := tenancy.Name(exec.Tenant, exec.ID) + "-" + step.Name
+	if step.Task != nil {
+		return e.invoke(exec, id, step.Task, payload)
+	}
+
+	outputs := make([]interface{}, len(step.Parallel))
+	errs := make([]error, len(step.Parallel))
+
+	wg := sync.WaitGroup{}
+	for i, task := range step.Parallel {
+		wg.Add(1)
+		go func(i int, task *Task) {
+			defer wg.Done()
+			outputs[i], errs[i] = e.invoke(exec, id+"-"+task.Name, task, payload)
+		}(i, task)
+	}
+	wg.Wait()
+
+	output := make(map[string]interface{}, len(step.Parallel))
+	for i, task := range step.Parallel {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("task %s: %v", task.Name, errs[i])
+		}
+		output[task.Name] = outputs[i]
+	}
+
+	return output, nil
+}
+
+// advance - runs an execution's steps until it completes, fails or has to wait
+func (e *Engine) advance(exec *Execution) error {
+	def, ok := e.definitions[exec.Workflow]
+	if !ok {
+		exec.Status = Failed
+		exec.Error = fmt.Sprintf("workflow %s is no longer defined", exec.Workflow)
+		return e.settle(exec)
+	}
+
+	if exec.Status == Compensating {
+		return e.compensate(exec, def)
+	}
+
+	for exec.Step < len(def.Steps) {
+		step := def.Steps[exec.Step]
+		now := e.now()
+		if exec.StepStarted.IsZero() {
+			exec.StepStarted = now
+		}
+
+		switch {
+		case step.Sleep != "":
+			if until := exec.StepStarted.Add(step.sleep); now.Before(until) {
+				exec.WakeAt = until
+				return e.settle(exec)
+			}
+
+		case step.WaitFor != "":
+			if step.timeout > 0 && !now.Before(exec.StepStarted.Add(step.timeout)) {
+				e.fail(exec, fmt.Sprintf("step %s timed out waiting for signal %s", step.Name, step.WaitFor))
+				return e.advanceFailed(exec, def)
+			}
+
+			exec.Status = Waiting
+			exec.WakeAt = time.Time{}
+			if step.timeout > 0 {
+				exec.WakeAt = exec.StepStarted.Add(step.timeout)
+			}
+			return e.settle(exec)
+
+		default:
+			output, err := e.runTasks(exec, step)
+			if err != nil {
+				exec.Attempts++
+				log.Default().Printf("workflow execution %s step %s failed, attempt %d: %v", exec.ID, step.Name, exec.Attempts, err)
+
+				if exec.Attempts >= step.maxAttempts() {
+					e.fail(exec, fmt.Sprintf("step %s failed: %v", step.Name, err))
+					return e.advanceFailed(exec, def)
+				}
+
+				exec.WakeAt = e.now().Add(step.Retry.delay(exec.Attempts))
+				return e.settle(exec)
+			}
+
+			exec.Outputs[step.Name] = output
+		}
+
+		e.next(exec)
+		// Progress is persisted after every step, so completed steps aren't run again after a restart
+		if err := e.save(exec); err != nil {
+			return err
+		}
+	}
+
+	exec.Status = Completed
+	return e.settle(exec)
+}
+
+// advanceFailed - persists a failed execution, starting its compensations straight away
+func (e *Engine) advanceFailed(exec *Execution, def *Definition) error {
+	if exec.Status == Compensating {
+		return e.compensate(exec, def)
+	}
+
+	return e.settle(exec)
+}
+
+// due - returns true if the execution should be run now, whether or not it was leased to an engine that stopped
+func (e *Engine) due(exec *Execution) bool {
+	switch exec.Status {
+	case Running, Compensating, Waiting:
+		due := e.dueAt(exec)
+		return due > 0 && due <= unixMillis(e.now())
+	default:
+		return false
+	}
+}
+
+// Drain - Runs all executions that are due.
+// Each is claimed before it's run, so engines draining at the same time don't run the same execution
+func (e *Engine) Drain() error {
+	var pagingToken map[string]string
+
+	expressions := []document.QueryExpression{
+		{Operand: dueField, Operator: ">", Value: int64(0)},
+		{Operand: dueField, Operator: "<=", Value: unixMillis(e.now())},
+	}
+
+	for {
+		result, err := e.documents.Query(e.collection, expressions, drainPageSize, pagingToken)
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Documents {
+			exec := executionFromDocument(&result.Documents[i])
+			if !e.due(exec) || !e.claim(exec) {
+				continue
+			}
+
+			if err := e.advance(exec); conflicted(err) {
+				log.Default().Printf("workflow execution %s was signalled, cancelled or taken over while running, leaving it", exec.ID)
+			} else if err != nil {
+				log.Default().Printf("error running workflow execution %s: %v", exec.ID, err)
+			}
+		}
+
+		if len(result.PagingToken) == 0 {
+			return nil
+		}
+		pagingToken = result.PagingToken
+	}
+}
+
+// Run - Begins running due executions at the configured interval, or as soon as they're started or signalled, until Stop is called
+func (e *Engine) Run() {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-ticker.C:
+		case <-e.wake:
+		}
+
+		if err := e.Drain(); err != nil {
+			log.Default().Printf("error running workflow executions: %v", err)
+		}
+	}
+}
+
+// Stop - Stops running executions, those part way through are resumed once an engine is run again
+func (e *Engine) Stop() {
+	close(e.stop)
+}
+
+// New - Creates a new Engine for the given workflows, persisting executions to the given collection and checking for due executions at the given interval.
+// Compensations are published with the events plugin, which may be nil if no workflow has compensations
+func New(documents document.DocumentService, eventsPlugin events.EventService, pool worker.WorkerPool, definitions map[string]*Definition, collection string, interval time.Duration) (*Engine, error) {
+	if documents == nil {
+		return nil, fmt.Errorf("the document plugin is required for workflows")
+	}
+
+	if eventsPlugin == nil {
+		for name, def := range definitions {
+			if def.compensated() {
+				return nil, fmt.Errorf("the events plugin is required for workflow %s compensations", name)
+			}
+		}
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("workflow interval must be positive, got %s", interval)
+	}
+
+	if collection == "" {
+		collection = DefaultCollection
+	}
+
+	return &Engine{
+		documents:   documents,
+		events:      eventsPlugin,
+		pool:        pool,
+		definitions: definitions,
+		collection:  &document.Collection{Name: collection},
+		interval:    interval,
+		lease:       DefaultLease,
+		now:         time.Now,
+		wake:        make(chan bool, 1),
+		stop:        make(chan bool),
+	}, nil
+}
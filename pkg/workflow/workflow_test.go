@@ -0,0 +1,467 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+	"github.com/nitrictech/nitric/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/pkg/plugins/events"
+	"github.com/nitrictech/nitric/pkg/triggers"
+	"github.com/nitrictech/nitric/pkg/worker"
+)
+
+type memoryDocuments struct {
+	document.UnimplementedDocumentPlugin
+	docs map[string]map[string]interface{}
+}
+
+func (m *memoryDocuments) Get(key *document.Key) (*document.Document, error) {
+	content, ok := m.docs[key.Id]
+	if !ok {
+		return nil, errors.ErrorsWithScope("memoryDocuments.Get", nil)(codes.NotFound, "document not found", nil)
+	}
+
+	return &document.Document{Key: key, Content: content}, nil
+}
+
+func (m *memoryDocuments) Set(key *document.Key, content map[string]interface{}) error {
+	m.docs[key.Id] = content
+	return nil
+}
+
+func (m *memoryDocuments) CompareAndSet(key *document.Key, field string, expected int64, content map[string]interface{}) error {
+	if !document.FieldEquals(m.docs[key.Id][field], expected) {
+		return errors.ErrorsWithScope("memoryDocuments.CompareAndSet", nil)(codes.FailedPrecondition, document.ConditionFailed, nil)
+	}
+
+	m.docs[key.Id] = content
+	return nil
+}
+
+// matches - evaluates the integer comparisons the engine queries with
+func matches(content map[string]interface{}, expressions []document.QueryExpression) bool {
+	for _, exp := range expressions {
+		value, err := document.IncrementedValue(content[exp.Operand], 0)
+		if err != nil {
+			return false
+		}
+
+		bound := exp.Value.(int64)
+		if (exp.Operator == ">" && value <= bound) || (exp.Operator == "<=" && value > bound) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *memoryDocuments) Query(collection *document.Collection, expressions []document.QueryExpression, limit int, pagingToken map[string]string) (*document.QueryResult, error) {
+	result := &document.QueryResult{}
+	for id, content := range m.docs {
+		if !matches(content, expressions) {
+			continue
+		}
+
+		result.Documents = append(result.Documents, document.Document{
+			Key:     &document.Key{Collection: collection, Id: id},
+			Content: content,
+		})
+	}
+
+	return result, nil
+}
+
+type publishRecorder struct {
+	events.UnimplementedeventsPlugin
+	failures  int
+	published []string
+}
+
+func (p *publishRecorder) Publish(topic string, event *events.NitricEvent) error {
+	if p.failures > 0 {
+		p.failures--
+		return fmt.Errorf("publish failed")
+	}
+
+	p.published = append(p.published, topic)
+	return nil
+}
+
+// taskAdapter - handles workflow tasks delivered as events or requests
+type taskAdapter struct {
+	events   []*triggers.Event
+	failures int
+	response string
+}
+
+func (a *taskAdapter) HandleEvent(trigger *triggers.Event) error {
+	a.events = append(a.events, trigger)
+	if a.failures > 0 {
+		a.failures--
+		return fmt.Errorf("task failed")
+	}
+
+	return nil
+}
+
+func (a *taskAdapter) HandleHttpRequest(trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return &triggers.HttpResponse{StatusCode: 200, Body: []byte(a.response)}, nil
+}
+
+func newTestEngine(workflows string) (*Engine, *taskAdapter, *time.Time) {
+	engine, adapter, now, _ := newTestSaga(workflows)
+	return engine, adapter, now
+}
+
+func newTestSaga(workflows string) (*Engine, *taskAdapter, *time.Time, *publishRecorder) {
+	def, err := Parse([]byte(workflows))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	adapter := &taskAdapter{response: `{"total":10}`}
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 10})
+	_ = pool.AddWorker(worker.NewSubscriptionWorker(adapter, &worker.SubscriptionWorkerOptions{Topic: "charge"}))
+	_ = pool.AddWorker(worker.NewRouteWorker(adapter, &worker.RouteWorkerOptions{Api: "main", Path: "/price", Methods: []string{"POST"}}))
+
+	publisher := &publishRecorder{}
+	engine, err := New(&memoryDocuments{docs: map[string]map[string]interface{}{}}, publisher, pool, map[string]*Definition{"order": def}, "", time.Second)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	now := time.Now()
+	engine.now = func() time.Time { return now }
+
+	return engine, adapter, &now, publisher
+}
+
+var _ = Describe("Workflow", func() {
+	Context("Parse", func() {
+		When("a step is more than one kind", func() {
+			It("should return an error", func() {
+				_, err := Parse([]byte(`{"steps":[{"name":"a","sleep":"1s","waitFor":"b"}]}`))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("a task has both a topic and a path", func() {
+			It("should return an error", func() {
+				_, err := Parse([]byte(`{"steps":[{"name":"a","task":{"topic":"t","path":"/p"}}]}`))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Engine", func() {
+		When("running a sequence of tasks", func() {
+			It("should run each step and record their outputs", func() {
+				engine, adapter, _ := newTestEngine(`{"steps":[
+					{"name":"price","task":{"path":"/price"}},
+					{"name":"charge","task":{"topic":"charge"}}
+				]}`)
+
+				exec, err := engine.Start("", "order", "order-1", map[string]interface{}{"item": "book"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, err = engine.Get("", exec.ID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(exec.Status).To(Equal(Completed))
+				Expect(exec.Outputs).To(HaveKeyWithValue("price", map[string]interface{}{"total": float64(10)}))
+
+				By("invoking tasks with the execution's input and outputs")
+				Expect(adapter.events).To(HaveLen(1))
+				Expect(adapter.events[0].ID).To(Equal("order-1-charge"))
+				Expect(adapter.events[0].Payload).To(MatchJSON(`{
+					"execution":"order-1","workflow":"order","step":"charge",
+					"input":{"item":"book"},"outputs":{"price":{"total":10}}
+				}`))
+			})
+		})
+
+		When("executions are started by a tenant", func() {
+			It("should only be found by that tenant, and pass the tenant to their tasks", func() {
+				engine, adapter, _ := newTestEngine(`{"steps":[{"name":"charge","task":{"topic":"charge"}}]}`)
+
+				_, err := engine.Start("acme", "order", "order-1", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = engine.Get("", "order-1")
+				Expect(errors.Code(err)).To(Equal(codes.NotFound))
+
+				By("allowing other tenants to use the same ID")
+				_, err = engine.Start("", "order", "order-1", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, err := engine.Get("acme", "order-1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(exec.ID).To(Equal("order-1"))
+				Expect(exec.Status).To(Equal(Completed))
+
+				ids := []string{}
+				for _, evt := range adapter.events {
+					ids = append(ids, evt.ID)
+				}
+				Expect(ids).To(ConsistOf("order-1-charge", "acme-order-1-charge"))
+			})
+		})
+
+		When("starting an execution with an existing ID", func() {
+			It("should return an error", func() {
+				engine, _, _ := newTestEngine(`{"steps":[{"name":"charge","task":{"topic":"charge"}}]}`)
+
+				_, err := engine.Start("", "order", "order-1", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = engine.Start("", "order", "order-1", nil)
+				Expect(errors.Code(err)).To(Equal(codes.AlreadyExists))
+			})
+		})
+
+		When("a task fails", func() {
+			It("should retry it with a backoff, then fail the execution", func() {
+				engine, adapter, now := newTestEngine(`{"steps":[
+					{"name":"charge","task":{"topic":"charge"},"retry":{"maxAttempts":2,"backoff":"10s"}}
+				]}`)
+				adapter.failures = 2
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Running))
+				Expect(exec.WakeAt.Equal(now.Add(10 * time.Second))).To(BeTrue())
+
+				By("not retrying before the backoff has passed")
+				Expect(engine.Drain()).To(Succeed())
+				Expect(adapter.events).To(HaveLen(1))
+
+				*now = now.Add(10 * time.Second)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(adapter.events).To(HaveLen(2))
+				Expect(exec.Status).To(Equal(Failed))
+				Expect(exec.Error).To(ContainSubstring("step charge failed"))
+			})
+		})
+
+		When("a step sleeps", func() {
+			It("should resume once the sleep has passed", func() {
+				engine, adapter, now := newTestEngine(`{"steps":[
+					{"name":"cool-off","sleep":"1h"},
+					{"name":"charge","task":{"topic":"charge"}}
+				]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+				Expect(adapter.events).To(BeEmpty())
+
+				*now = now.Add(time.Hour)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Completed))
+				Expect(adapter.events).To(HaveLen(1))
+			})
+		})
+
+		When("a step waits for a signal", func() {
+			It("should resume once it's sent", func() {
+				engine, _, _ := newTestEngine(`{"steps":[{"name":"approval","waitFor":"approved"}]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Waiting))
+				Expect(engine.StepName(exec)).To(Equal("approval"))
+
+				By("refusing other signals")
+				Expect(errors.Code(engine.Signal("", exec.ID, "rejected", nil))).To(Equal(codes.FailedPrecondition))
+
+				Expect(engine.Signal("", exec.ID, "approved", map[string]interface{}{"by": "jane"})).To(Succeed())
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Completed))
+				Expect(exec.Outputs).To(HaveKeyWithValue("approval", map[string]interface{}{"by": "jane"}))
+			})
+
+			It("should fail if it isn't sent before the timeout", func() {
+				engine, _, now := newTestEngine(`{"steps":[{"name":"approval","waitFor":"approved","timeout":"24h"}]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				*now = now.Add(24 * time.Hour)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Failed))
+			})
+		})
+
+		When("running parallel tasks", func() {
+			It("should record each task's output", func() {
+				engine, _, _ := newTestEngine(`{"steps":[{"name":"prepare","parallel":[
+					{"name":"price","path":"/price"},
+					{"name":"charge","topic":"charge"}
+				]}]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Completed))
+				Expect(exec.Outputs["prepare"]).To(HaveKeyWithValue("price", map[string]interface{}{"total": float64(10)}))
+			})
+		})
+
+		When("an execution is cancelled", func() {
+			It("should not run any further steps", func() {
+				engine, adapter, _ := newTestEngine(`{"steps":[{"name":"charge","task":{"topic":"charge"}}]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Cancel("", exec.ID)).To(Succeed())
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Cancelled))
+				Expect(adapter.events).To(BeEmpty())
+			})
+		})
+
+		When("another engine is running an execution", func() {
+			It("should leave it until the other engine's lease expires", func() {
+				engine, adapter, now := newTestEngine(`{"steps":[{"name":"charge","task":{"topic":"charge"}}]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+
+				By("claiming it for the other engine, which stops before running it")
+				other := *engine
+				Expect(other.claim(exec)).To(BeTrue())
+
+				Expect(engine.Drain()).To(Succeed())
+				Expect(adapter.events).To(BeEmpty())
+
+				*now = now.Add(DefaultLease)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Completed))
+				Expect(adapter.events).To(HaveLen(1))
+			})
+
+			It("should only let one engine claim it", func() {
+				engine, _, _ := newTestEngine(`{"steps":[{"name":"charge","task":{"topic":"charge"}}]}`)
+
+				started, _ := engine.Start("", "order", "", nil)
+				first, _ := engine.Get("", started.ID)
+				second, _ := engine.Get("", started.ID)
+
+				Expect(engine.claim(first)).To(BeTrue())
+				Expect(engine.claim(second)).To(BeFalse())
+			})
+		})
+
+		When("an execution is cancelled while running a step", func() {
+			It("should keep the cancellation and not run any further steps", func() {
+				engine, adapter, _ := newTestEngine(`{"steps":[
+					{"name":"price","task":{"path":"/price"}},
+					{"name":"charge","task":{"topic":"charge"}}
+				]}`)
+
+				started, _ := engine.Start("", "order", "", nil)
+				exec, _ := engine.Get("", started.ID)
+				Expect(engine.claim(exec)).To(BeTrue())
+
+				Expect(engine.Cancel("", exec.ID)).To(Succeed())
+
+				Expect(conflicted(engine.advance(exec))).To(BeTrue())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Cancelled))
+				Expect(adapter.events).To(BeEmpty())
+			})
+		})
+
+		When("a saga fails", func() {
+			saga := `{"steps":[
+				{"name":"reserve","task":{"path":"/price"},"compensate":{"topic":"release"}},
+				{"name":"notify","task":{"path":"/price"}},
+				{"name":"pay","task":{"path":"/price"},"compensate":{"topic":"refund","retry":{"maxAttempts":2,"backoff":"5s"}}},
+				{"name":"charge","task":{"topic":"charge"}}
+			]}`
+
+			It("should publish the compensations of its completed steps in reverse order", func() {
+				engine, adapter, _, publisher := newTestSaga(saga)
+				adapter.failures = 1
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Compensated))
+				Expect(exec.Error).To(ContainSubstring("step charge failed"))
+				Expect(publisher.published).To(Equal([]string{"refund", "release"}))
+			})
+
+			It("should retry compensations that fail to publish", func() {
+				engine, adapter, now, publisher := newTestSaga(saga)
+				adapter.failures = 1
+				publisher.failures = 1
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Compensating))
+				Expect(publisher.published).To(BeEmpty())
+
+				*now = now.Add(5 * time.Second)
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Compensated))
+				Expect(publisher.published).To(Equal([]string{"refund", "release"}))
+			})
+		})
+
+		When("a saga is cancelled", func() {
+			It("should compensate its completed steps", func() {
+				engine, _, _, publisher := newTestSaga(`{"steps":[
+					{"name":"reserve","task":{"path":"/price"},"compensate":{"topic":"release"}},
+					{"name":"approval","waitFor":"approved"}
+				]}`)
+
+				exec, _ := engine.Start("", "order", "", nil)
+				Expect(engine.Drain()).To(Succeed())
+				Expect(engine.Cancel("", exec.ID)).To(Succeed())
+				Expect(engine.Drain()).To(Succeed())
+
+				exec, _ = engine.Get("", exec.ID)
+				Expect(exec.Status).To(Equal(Compensated))
+				Expect(publisher.published).To(Equal([]string{"release"}))
+			})
+		})
+	})
+})
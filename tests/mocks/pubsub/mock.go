@@ -30,8 +30,13 @@ import (
 type MockPubsubClient struct {
 	ifaces_pubsub.PubsubClient
 	topics                []string
+	labels                map[string]map[string]string
 	PublishedMessages     map[string][]ifaces_pubsub.Message
 	publishedMessageCount int64
+	// Granted - the IAM permissions granted on every topic and subscription
+	Granted []string
+	// Seeks records the time each subscription was last seeked to, keyed by subscription ID
+	Seeks map[string]time.Time
 }
 
 type MockPubsubMessage struct {
@@ -60,6 +65,10 @@ func (m MockPubsubMessage) PublishTime() time.Time {
 	return time.Now()
 }
 
+func (m MockPubsubMessage) OrderingKey() string {
+	return ""
+}
+
 func (m MockPubsubMessage) Ack() {
 }
 
@@ -68,6 +77,7 @@ func (m MockPubsubMessage) Nack() {
 
 type MockPubsubOptions struct {
 	Topics   []string
+	Granted  []string
 	Messages map[string][]ifaces_pubsub.Message
 }
 
@@ -81,11 +91,26 @@ func NewMockPubsubClient(opts MockPubsubOptions) *MockPubsubClient {
 	}
 	return &MockPubsubClient{
 		topics:                opts.Topics,
+		Granted:               opts.Granted,
 		PublishedMessages:     opts.Messages,
 		publishedMessageCount: int64(publishedMessageCount),
 	}
 }
 
+// granted - the permissions that are granted
+func (s *MockPubsubClient) granted(permissions []string) []string {
+	granted := []string{}
+	for _, p := range permissions {
+		for _, g := range s.Granted {
+			if p == g {
+				granted = append(granted, p)
+			}
+		}
+	}
+
+	return granted
+}
+
 func (s *MockPubsubClient) Topic(name string) ifaces_pubsub.Topic {
 	return &MockPubsubTopic{
 		name: name,
@@ -94,6 +119,17 @@ func (s *MockPubsubClient) Topic(name string) ifaces_pubsub.Topic {
 	}
 }
 
+// CreateTopic - adds the topic with its labels
+func (s *MockPubsubClient) CreateTopic(ctx context.Context, topicID string, labels map[string]string) (ifaces_pubsub.Topic, error) {
+	s.topics = append(s.topics, topicID)
+	if s.labels == nil {
+		s.labels = map[string]map[string]string{}
+	}
+	s.labels[topicID] = labels
+
+	return s.Topic(topicID), nil
+}
+
 func (s *MockPubsubClient) Topics(context.Context) ifaces_pubsub.TopicIterator {
 	return &MockTopicIterator{
 		c:   s,
@@ -121,14 +157,23 @@ func (s *MockTopicIterator) Next() (ifaces_pubsub.Topic, error) {
 
 type MockPubsubTopic struct {
 	// ifaces.Topic
-	c    *MockPubsubClient
-	name string
+	c       *MockPubsubClient
+	name    string
+	ordered bool
 	// subscriptions []ifaces.Subscription
 }
 
 var _ ifaces_pubsub.Topic = (*MockPubsubTopic)(nil)
 
 func (s *MockPubsubTopic) Publish(ctx context.Context, msg ifaces_pubsub.Message) ifaces_pubsub.PublishResult {
+	// Like pubsub, messages can only be published with an ordering key once ordering is enabled
+	if msg.OrderingKey() != "" && !s.ordered {
+		return &MockPublishResult{
+			id:  "",
+			err: fmt.Errorf("topic %s doesn't have message ordering enabled", s.name),
+		}
+	}
+
 	for _, t := range s.c.topics {
 		if t == s.name {
 			if s.c.PublishedMessages[t] == nil {
@@ -176,12 +221,27 @@ func (s *MockPubsubTopic) ID() string {
 	return s.name
 }
 
+// Labels - returns the labels the topic was created with, topics given in the options have none
+func (s *MockPubsubTopic) Labels(ctx context.Context) (map[string]string, error) {
+	return s.c.labels[s.name], nil
+}
+
+func (s *MockPubsubTopic) EnableMessageOrdering() {
+	s.ordered = true
+}
+
+func (s *MockPubsubTopic) ResumePublish(orderingKey string) {}
+
+func (s *MockPubsubTopic) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	return s.c.granted(permissions), nil
+}
+
 func (s *MockPubsubTopic) String() string {
 	return s.name
 }
 
 type MockSubscription struct {
-	topic ifaces_pubsub.Topic
+	topic *MockPubsubTopic
 }
 
 var _ ifaces_pubsub.Subscription = (*MockSubscription)(nil)
@@ -195,6 +255,19 @@ func (m MockSubscription) String() string {
 	return fmt.Sprintf("projects/%s/subscriptions/%s", MockProjectID, m.ID())
 }
 
+func (m MockSubscription) TestPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	return m.topic.c.granted(permissions), nil
+}
+
+func (m MockSubscription) SeekToTime(ctx context.Context, t time.Time) error {
+	if m.topic.c.Seeks == nil {
+		m.topic.c.Seeks = make(map[string]time.Time)
+	}
+
+	m.topic.c.Seeks[m.ID()] = t
+	return nil
+}
+
 type MockSubscriptionIterator struct {
 	i             int
 	Subscriptions []ifaces_pubsub.Subscription
@@ -238,6 +311,10 @@ func (m MockBaseClient) Acknowledge(ctx context.Context, req *pubsubpb.Acknowled
 	return nil
 }
 
+func (m MockBaseClient) ModifyAckDeadline(ctx context.Context, req *pubsubpb.ModifyAckDeadlineRequest, opts ...gax.CallOption) error {
+	return nil
+}
+
 func (m MockBaseClient) Pull(ctx context.Context, req *pubsubpb.PullRequest, opts ...gax.CallOption) (*pubsubpb.PullResponse, error) {
 	sub := req.Subscription
 
@@ -0,0 +1,87 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document_suite
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+func AggregateTests(docPlugin document.DocumentService) {
+	Context("Aggregate", func() {
+		When("Invalid - blank key.Collection.Name", func() {
+			It("Should return an error", func() {
+				results, err := docPlugin.Aggregate(&document.Collection{}, []document.QueryExpression{}, []document.Aggregation{{Function: document.AggregateCount}})
+				Expect(results).To(BeNil())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		When("Invalid - no aggregations", func() {
+			It("Should return an error", func() {
+				results, err := docPlugin.Aggregate(&ScoresColl, []document.QueryExpression{}, nil)
+				Expect(results).To(BeNil())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		When("key: {scores}, exp: []", func() {
+			It("Should aggregate all scores", func() {
+				LoadScoresData(docPlugin)
+
+				results, err := docPlugin.Aggregate(&ScoresColl, []document.QueryExpression{}, []document.Aggregation{
+					{Function: document.AggregateCount},
+					{Function: document.AggregateSum, Field: "score"},
+					{Function: document.AggregateAvg, Field: "score"},
+					{Function: document.AggregateMin, Field: "score"},
+					{Function: document.AggregateMax, Field: "score"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(results).To(HaveLen(5))
+				Expect(results[0].Value).To(Equal(int64(4)))
+				Expect(results[1].Value).To(BeNumerically("==", 60))
+				Expect(results[2].Value).To(BeNumerically("==", 20))
+				Expect(results[3].Value).To(BeNumerically("==", 10))
+				Expect(results[4].Value).To(BeNumerically("==", 30))
+			})
+		})
+		When("key: {scores}, exp: [team == red]", func() {
+			It("Should aggregate the matching scores", func() {
+				LoadScoresData(docPlugin)
+
+				results, err := docPlugin.Aggregate(&ScoresColl, []document.QueryExpression{
+					{Operand: "team", Operator: "==", Value: "red"},
+				}, []document.Aggregation{
+					{Function: document.AggregateCount},
+					{Function: document.AggregateSum, Field: "score"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(results[0].Value).To(Equal(int64(2)))
+				Expect(results[1].Value).To(BeNumerically("==", 30))
+			})
+		})
+		When("key: {scores}, aggregating a missing field", func() {
+			It("Should return a nil average", func() {
+				LoadScoresData(docPlugin)
+
+				results, err := docPlugin.Aggregate(&ScoresColl, []document.QueryExpression{}, []document.Aggregation{
+					{Function: document.AggregateAvg, Field: "missing"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(results[0].Value).To(BeNil())
+			})
+		})
+	})
+}
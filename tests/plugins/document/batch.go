@@ -0,0 +1,121 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document_suite
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+func BatchTests(docPlugin document.DocumentService) {
+	batchColl := document.Collection{Name: "batch"}
+
+	// more documents than fit in a single DynamoDB batch write
+	batchDocs := make([]document.Document, 0, 30)
+	for i := 0; i < 30; i++ {
+		batchDocs = append(batchDocs, document.Document{
+			Key:     &document.Key{Collection: &batchColl, Id: fmt.Sprintf("%02d", i)},
+			Content: map[string]interface{}{"index": i},
+		})
+	}
+
+	batchKeys := make([]*document.Key, 0, len(batchDocs))
+	for _, doc := range batchDocs {
+		batchKeys = append(batchKeys, doc.Key)
+	}
+
+	Context("SetBatch", func() {
+		When("Valid SetBatch", func() {
+			It("Should store every document", func() {
+				resp, err := docPlugin.SetBatch(batchDocs)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedWrites).To(BeEmpty())
+
+				result, err := docPlugin.Query(&batchColl, []document.QueryExpression{}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(len(batchDocs)))
+
+				_, err = docPlugin.DeleteBatch(batchKeys)
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+		When("Some documents have invalid keys", func() {
+			It("Should report only those documents as failed", func() {
+				invalid := &document.Key{Collection: &batchColl}
+				resp, err := docPlugin.SetBatch([]document.Document{
+					{Key: &UserKey1, Content: UserItem1},
+					{Key: invalid, Content: UserItem2},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedWrites).To(HaveLen(1))
+				Expect(resp.FailedWrites[0].Key).To(Equal(invalid))
+				Expect(resp.FailedWrites[0].Message).ToNot(BeEmpty())
+
+				doc, err := docPlugin.Get(&UserKey1)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(doc.Content).To(Equal(UserItem1))
+			})
+		})
+	})
+
+	Context("DeleteBatch", func() {
+		When("Valid DeleteBatch", func() {
+			It("Should delete every document", func() {
+				_, err := docPlugin.SetBatch(batchDocs)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				resp, err := docPlugin.DeleteBatch(batchKeys)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedWrites).To(BeEmpty())
+
+				result, err := docPlugin.Query(&batchColl, []document.QueryExpression{}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Documents).To(BeEmpty())
+			})
+		})
+		When("Deleting a parent document", func() {
+			It("Should delete its sub collections", func() {
+				LoadCustomersData(docPlugin)
+
+				resp, err := docPlugin.DeleteBatch([]*document.Key{&Customer1.Key, &Customer2.Key})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedWrites).To(BeEmpty())
+
+				result, err := docPlugin.Query(&document.Collection{
+					Name:   "orders",
+					Parent: &document.Key{Collection: &CustomersColl},
+				}, []document.QueryExpression{}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Documents).To(BeEmpty())
+			})
+		})
+		When("Some keys are invalid", func() {
+			It("Should report only those keys as failed", func() {
+				err := docPlugin.Set(batchKeys[0], batchDocs[0].Content)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				invalid := &document.Key{Collection: &batchColl}
+				resp, err := docPlugin.DeleteBatch([]*document.Key{batchKeys[0], invalid})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.FailedWrites).To(HaveLen(1))
+				Expect(resp.FailedWrites[0].Key).To(Equal(invalid))
+			})
+		})
+	})
+}
@@ -49,4 +49,9 @@ var _ = Describe("Bolt", func() {
 	test.DeleteTests(docPlugin)
 	test.QueryTests(docPlugin)
 	test.QueryStreamTests(docPlugin)
+	test.AggregateTests(docPlugin)
+	test.BatchTests(docPlugin)
+	test.IncrementTests(docPlugin)
+	test.GeoTests(docPlugin)
+	test.SubCollectionDepthTests(docPlugin)
 })
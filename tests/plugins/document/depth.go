@@ -0,0 +1,158 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document_suite
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+func projectKey(accountId string, projectId string) *document.Key {
+	return &document.Key{
+		Collection: &document.Collection{
+			Name: "projects",
+			Parent: &document.Key{
+				Collection: &document.Collection{Name: "accounts"},
+				Id:         accountId,
+			},
+		},
+		Id: projectId,
+	}
+}
+
+func taskKey(accountId string, projectId string, taskId string) *document.Key {
+	return &document.Key{
+		Collection: &document.Collection{
+			Name:   "tasks",
+			Parent: projectKey(accountId, projectId),
+		},
+		Id: taskId,
+	}
+}
+
+// loadTasksData - loads two levels of sub-collections under the 'accounts' collection
+// ids are unique within each collection, as required by MongoDB
+func loadTasksData(docPlugin document.DocumentService) {
+	Expect(docPlugin.Set(&document.Key{Collection: &document.Collection{Name: "accounts"}, Id: "1"}, map[string]interface{}{"name": "one"})).To(Succeed())
+	Expect(docPlugin.Set(projectKey("1", "1"), map[string]interface{}{"name": "alpha"})).To(Succeed())
+	Expect(docPlugin.Set(projectKey("1", "2"), map[string]interface{}{"name": "beta"})).To(Succeed())
+	Expect(docPlugin.Set(taskKey("1", "1", "1"), map[string]interface{}{"status": "done"})).To(Succeed())
+	Expect(docPlugin.Set(taskKey("1", "1", "2"), map[string]interface{}{"status": "todo"})).To(Succeed())
+	Expect(docPlugin.Set(taskKey("1", "2", "3"), map[string]interface{}{"status": "todo"})).To(Succeed())
+	Expect(docPlugin.Set(taskKey("2", "3", "4"), map[string]interface{}{"status": "todo"})).To(Succeed())
+}
+
+func SubCollectionDepthTests(docPlugin document.DocumentService) {
+	Context("Sub-collection depth", func() {
+		BeforeEach(func() {
+			Expect(document.SetMaxSubCollectionDepth(2)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(document.SetMaxSubCollectionDepth(document.MaxSubCollectionDepth)).To(Succeed())
+		})
+
+		When("Setting a document deeper than the max depth", func() {
+			It("Should return an error", func() {
+				key := &document.Key{
+					Collection: &document.Collection{
+						Name:   "notes",
+						Parent: taskKey("1", "1", "1"),
+					},
+					Id: "1",
+				}
+
+				Expect(docPlugin.Set(key, map[string]interface{}{"text": "note"})).ToNot(Succeed())
+			})
+		})
+
+		When("Getting a nested document", func() {
+			It("Should return the document", func() {
+				loadTasksData(docPlugin)
+
+				doc, err := docPlugin.Get(taskKey("1", "1", "2"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Content["status"]).To(Equal("todo"))
+			})
+		})
+
+		When("Querying a nested sub-collection", func() {
+			It("Should return only the documents of its parent", func() {
+				loadTasksData(docPlugin)
+
+				result, err := docPlugin.Query(taskKey("1", "1", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(2))
+				for _, doc := range result.Documents {
+					Expect(doc.Key.Collection.Parent.Id).To(Equal("1"))
+					Expect(doc.Key.Collection.Parent.Collection.Parent.Id).To(Equal("1"))
+				}
+			})
+
+			It("Should return the full key of each document", func() {
+				loadTasksData(docPlugin)
+
+				result, err := docPlugin.Query(taskKey("1", "2", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(1))
+				Expect(result.Documents[0].Key).To(Equal(taskKey("1", "2", "3")))
+			})
+
+			It("Should not return documents of deeper sub-collections", func() {
+				loadTasksData(docPlugin)
+
+				result, err := docPlugin.Query(projectKey("1", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(2))
+			})
+		})
+
+		When("Querying a nested sub-collection with blank parent ids", func() {
+			It("Should return the documents of every matching parent", func() {
+				loadTasksData(docPlugin)
+
+				result, err := docPlugin.Query(taskKey("1", "", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(3))
+
+				result, err = docPlugin.Query(taskKey("", "", "").Collection, []document.QueryExpression{
+					{Operand: "status", Operator: "==", Value: "todo"},
+				}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(3))
+			})
+		})
+
+		When("Deleting a document with nested sub-collections", func() {
+			It("Should delete every nested document", func() {
+				loadTasksData(docPlugin)
+
+				Expect(docPlugin.Delete(projectKey("1", "1"))).To(Succeed())
+
+				result, err := docPlugin.Query(taskKey("1", "", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(1))
+
+				Expect(docPlugin.Delete(&document.Key{Collection: &document.Collection{Name: "accounts"}, Id: "1"})).To(Succeed())
+
+				result, err = docPlugin.Query(taskKey("", "", "").Collection, []document.QueryExpression{}, 0, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Documents).To(HaveLen(1))
+			})
+		})
+	})
+}
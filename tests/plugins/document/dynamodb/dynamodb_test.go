@@ -68,6 +68,8 @@ var _ = Describe("DynamoDb", func() {
 		createTable(db, "users-1111111")
 		createTable(db, "items-1111111")
 		createTable(db, "parentItems-1111111")
+		createTable(db, "scores-1111111")
+		createTable(db, "accounts-1111111")
 	})
 
 	AfterEach(func() {
@@ -75,6 +77,8 @@ var _ = Describe("DynamoDb", func() {
 		deleteTable(db, "users-1111111")
 		deleteTable(db, "items-1111111")
 		deleteTable(db, "parentItems-1111111")
+		deleteTable(db, "scores-1111111")
+		deleteTable(db, "accounts-1111111")
 	})
 
 	AfterSuite(func() {
@@ -89,6 +93,8 @@ var _ = Describe("DynamoDb", func() {
 		"users":       "arn:${Partition}:dynamodb:${Region}:${Account}:table/users-1111111",
 		"items":       "arn:${Partition}:dynamodb:${Region}:${Account}:table/items-1111111",
 		"parentItems": "arn:${Partition}:dynamodb:${Region}:${Account}:table/parentItems-1111111",
+		"scores":      "arn:${Partition}:dynamodb:${Region}:${Account}:table/scores-1111111",
+		"accounts":    "arn:${Partition}:dynamodb:${Region}:${Account}:table/accounts-1111111",
 	}, nil)
 
 	docPlugin, err := dynamodb_service.NewWithClient(provider, db)
@@ -101,6 +107,49 @@ var _ = Describe("DynamoDb", func() {
 	test.DeleteTests(docPlugin)
 	test.QueryTests(docPlugin)
 	test.QueryStreamTests(docPlugin)
+	test.AggregateTests(docPlugin)
+	test.BatchTests(docPlugin)
+	test.IncrementTests(docPlugin)
+	test.GeoTests(docPlugin)
+	test.SubCollectionDepthTests(docPlugin)
+})
+
+var _ = Describe("DynamoDb single table", func() {
+	defer GinkgoRecover()
+
+	// Uses the DynamoDB container started for the table per collection tests
+	db := createDynamoClient()
+
+	BeforeEach(func() {
+		createSingleTable(db, "documents-1111111")
+	})
+
+	AfterEach(func() {
+		deleteTable(db, "documents-1111111")
+	})
+
+	ctrl := gomock.NewController(GinkgoT())
+	provider := mock_provider.NewMockAwsProvider(ctrl)
+
+	provider.EXPECT().GetResources(core.AwsResource_Collection).AnyTimes().Return(map[string]string{
+		"documents": "arn:${Partition}:dynamodb:${Region}:${Account}:table/documents-1111111",
+	}, nil)
+
+	docPlugin, err := dynamodb_service.NewWithClient(provider, db, dynamodb_service.WithSingleTable("documents"))
+	if err != nil {
+		panic(err)
+	}
+
+	test.GetTests(docPlugin)
+	test.SetTests(docPlugin)
+	test.DeleteTests(docPlugin)
+	test.QueryTests(docPlugin)
+	test.QueryStreamTests(docPlugin)
+	test.AggregateTests(docPlugin)
+	test.BatchTests(docPlugin)
+	test.IncrementTests(docPlugin)
+	test.GeoTests(docPlugin)
+	test.SubCollectionDepthTests(docPlugin)
 })
 
 func createDynamoClient() *dynamodb.DynamoDB {
@@ -172,6 +221,42 @@ func createTable(db *dynamodb.DynamoDB, tableName string) {
 	}
 }
 
+func createSingleTable(db *dynamodb.DynamoDB, tableName string) {
+	input := &dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{
+				AttributeName: aws.String("_pk"),
+				AttributeType: aws.String("S"),
+			},
+			{
+				AttributeName: aws.String("_sk"),
+				AttributeType: aws.String("S"),
+			},
+			{
+				AttributeName: aws.String(dynamodb_service.AttribCollectionShard),
+				AttributeType: aws.String("S"),
+			},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{
+				AttributeName: aws.String("_pk"),
+				KeyType:       aws.String("HASH"),
+			},
+			{
+				AttributeName: aws.String("_sk"),
+				KeyType:       aws.String("RANGE"),
+			},
+		},
+		GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndex{dynamodb_service.CollectionIndex()},
+		BillingMode:            aws.String(dynamodb.BillingModePayPerRequest),
+		TableName:              aws.String(tableName),
+	}
+	_, err := db.CreateTable(input)
+	if err != nil {
+		panic(fmt.Sprintf("Error calling CreateTable: %s", err))
+	}
+}
+
 func deleteTable(db *dynamodb.DynamoDB, tableName string) {
 	deleteInput := &dynamodb.DeleteTableInput{
 		TableName: aws.String(tableName),
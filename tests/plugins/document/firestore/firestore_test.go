@@ -78,4 +78,9 @@ var _ = Describe("Firestore", func() {
 	test.DeleteTests(docPlugin)
 	test.QueryTests(docPlugin)
 	test.QueryStreamTests(docPlugin)
+	test.AggregateTests(docPlugin)
+	test.BatchTests(docPlugin)
+	test.IncrementTests(docPlugin)
+	test.GeoTests(docPlugin)
+	test.SubCollectionDepthTests(docPlugin)
 })
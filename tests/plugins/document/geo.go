@@ -0,0 +1,150 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document_suite
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+func GeoTests(docPlugin document.DocumentService) {
+	placesColl := document.Collection{Name: "places"}
+
+	// Sydney landmarks, the bridge is about 1.3km north of the opera house and the airport about 9km south
+	places := map[string]map[string]interface{}{
+		"opera-house": {"type": "landmark", "location": map[string]interface{}{"lat": -33.8568, "lng": 151.2153}},
+		"bridge":      {"type": "landmark", "location": map[string]interface{}{"lat": -33.8523, "lng": 151.2108}},
+		"botanic":     {"type": "park", "location": map[string]interface{}{"lat": -33.8642, "lng": 151.2166}},
+		"airport":     {"type": "transport", "location": map[string]interface{}{"lat": -33.9399, "lng": 151.1753}},
+		"unplaced":    {"type": "landmark"},
+	}
+
+	ids := func(result *document.QueryResult) []string {
+		found := make([]string, 0, len(result.Documents))
+		for _, doc := range result.Documents {
+			found = append(found, doc.Key.Id)
+		}
+		return found
+	}
+
+	Context("Geo queries", func() {
+		BeforeEach(func() {
+			for id, content := range places {
+				Expect(docPlugin.Set(&document.Key{Collection: &placesColl, Id: id}, content)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			for id := range places {
+				Expect(docPlugin.Delete(&document.Key{Collection: &placesColl, Id: id})).To(Succeed())
+			}
+		})
+
+		When("Getting a document with a location", func() {
+			It("Should return the content as it was set", func() {
+				doc, err := docPlugin.Get(&document.Key{Collection: &placesColl, Id: "opera-house"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(doc.Content).To(HaveLen(2))
+				Expect(doc.Content["location"]).To(HaveKeyWithValue("lat", BeNumerically("~", -33.8568, 1e-9)))
+			})
+		})
+		When("Querying within a radius", func() {
+			It("Should only return documents with locations within the distance", func() {
+				result, err := docPlugin.Query(&placesColl, []document.QueryExpression{{
+					Operand:  "location",
+					Operator: document.GeoRadiusOperator,
+					Value:    document.GeoRadius{Center: document.GeoPoint{Lat: -33.8568, Lng: 151.2153}, Meters: 1000},
+				}}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ids(result)).To(ConsistOf("opera-house", "botanic", "bridge"))
+
+				for _, doc := range result.Documents {
+					Expect(doc.Content).ToNot(HaveKey(document.GeohashField("location")))
+				}
+			})
+		})
+		When("Querying within a radius and by another field", func() {
+			It("Should return documents matching both", func() {
+				result, err := docPlugin.Query(&placesColl, []document.QueryExpression{
+					{
+						Operand:  "location",
+						Operator: document.GeoRadiusOperator,
+						Value:    document.GeoRadius{Center: document.GeoPoint{Lat: -33.8568, Lng: 151.2153}, Meters: 1000},
+					},
+					{Operand: "type", Operator: "==", Value: "landmark"},
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ids(result)).To(ConsistOf("opera-house", "bridge"))
+			})
+		})
+		When("Querying within a box", func() {
+			It("Should only return documents with locations within the box", func() {
+				result, err := docPlugin.Query(&placesColl, []document.QueryExpression{{
+					Operand:  "location",
+					Operator: document.GeoBoxOperator,
+					Value: document.GeoBox{
+						SouthWest: document.GeoPoint{Lat: -34.0, Lng: 151.1},
+						NorthEast: document.GeoPoint{Lat: -33.86, Lng: 151.3},
+					},
+				}}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ids(result)).To(ConsistOf("botanic", "airport"))
+			})
+		})
+		When("Paging through a geo query", func() {
+			It("Should return every matching document once", func() {
+				exps := []document.QueryExpression{{
+					Operand:  "location",
+					Operator: document.GeoRadiusOperator,
+					Value:    document.GeoRadius{Center: document.GeoPoint{Lat: -33.8568, Lng: 151.2153}, Meters: 20000},
+				}}
+
+				found := make([]string, 0)
+				var pagingToken map[string]string
+				for i := 0; i < 10; i++ {
+					result, err := docPlugin.Query(&placesColl, exps, 2, pagingToken)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(len(result.Documents)).To(BeNumerically("<=", 2))
+
+					found = append(found, ids(result)...)
+					pagingToken = result.PagingToken
+					if len(pagingToken) == 0 {
+						break
+					}
+				}
+
+				Expect(found).To(ConsistOf("opera-house", "botanic", "bridge", "airport"))
+			})
+		})
+		When("Streaming a geo query", func() {
+			It("Should return the matching documents up to the limit", func() {
+				iter := docPlugin.QueryStream(&placesColl, []document.QueryExpression{{
+					Operand:  "location",
+					Operator: document.GeoRadiusOperator,
+					Value:    document.GeoRadius{Center: document.GeoPoint{Lat: -33.8568, Lng: 151.2153}, Meters: 1000},
+				}}, 2)
+
+				docs := unwrapIter(iter)
+
+				Expect(docs).To(HaveLen(2))
+				for _, doc := range docs {
+					Expect([]string{"opera-house", "botanic", "bridge"}).To(ContainElement(doc.Key.Id))
+				}
+			})
+		})
+	})
+}
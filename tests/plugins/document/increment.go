@@ -0,0 +1,98 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package document_suite
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/pkg/plugins/document"
+)
+
+func IncrementTests(docPlugin document.DocumentService) {
+	countersColl := document.Collection{Name: "counters"}
+
+	Context("Increment", func() {
+		When("Blank field", func() {
+			It("Should return error", func() {
+				_, err := docPlugin.Increment(&document.Key{Collection: &countersColl, Id: "visits"}, "", 1)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		When("The document doesn't exist", func() {
+			It("Should create it with the field counted from zero", func() {
+				key := &document.Key{Collection: &countersColl, Id: "new"}
+
+				value, err := docPlugin.Increment(key, "count", 5)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value).To(Equal(int64(5)))
+
+				doc, err := docPlugin.Get(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(doc.Content["count"]).To(BeNumerically("==", 5))
+
+				Expect(docPlugin.Delete(key)).To(Succeed())
+			})
+		})
+		When("The document has other fields", func() {
+			It("Should only change the incremented field", func() {
+				key := &document.Key{Collection: &countersColl, Id: "stock"}
+				err := docPlugin.Set(key, map[string]interface{}{"name": "widget", "quantity": 10})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				value, err := docPlugin.Increment(key, "quantity", -3)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value).To(Equal(int64(7)))
+
+				value, err = docPlugin.Increment(key, "quantity", 1)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value).To(Equal(int64(8)))
+
+				doc, err := docPlugin.Get(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(doc.Content["name"]).To(Equal("widget"))
+				Expect(doc.Content["quantity"]).To(BeNumerically("==", 8))
+
+				Expect(docPlugin.Delete(key)).To(Succeed())
+			})
+		})
+		When("The field isn't a number", func() {
+			It("Should return error", func() {
+				key := &document.Key{Collection: &countersColl, Id: "named"}
+				err := docPlugin.Set(key, map[string]interface{}{"count": "ten"})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = docPlugin.Increment(key, "count", 1)
+				Expect(err).Should(HaveOccurred())
+
+				Expect(docPlugin.Delete(key)).To(Succeed())
+			})
+		})
+		When("The document is in a sub collection", func() {
+			It("Should increment the sub collection document", func() {
+				LoadCustomersData(docPlugin)
+				key := &Customer1.Orders[0].Key
+
+				value, err := docPlugin.Increment(key, "views", 2)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value).To(Equal(int64(2)))
+
+				doc, err := docPlugin.Get(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(doc.Content["views"]).To(BeNumerically("==", 2))
+			})
+		})
+	})
+}
@@ -89,4 +89,9 @@ var _ = Describe("MongoDB", func() {
 	test.DeleteTests(docPlugin)
 	test.QueryTests(docPlugin)
 	test.QueryStreamTests(docPlugin)
+	test.AggregateTests(docPlugin)
+	test.BatchTests(docPlugin)
+	test.IncrementTests(docPlugin)
+	test.GeoTests(docPlugin)
+	test.SubCollectionDepthTests(docPlugin)
 })
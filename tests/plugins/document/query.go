@@ -567,5 +567,133 @@ func QueryTests(docPlugin document.DocumentService) {
 				Expect(result.PagingToken).To(BeEmpty())
 			})
 		})
+		When("key: {scores}, exp: [team in [red]]", func() {
+			It("Should return the scores of red", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "team", Operator: "in", Value: []interface{}{"red", "green"}},
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("1", "2"))
+			})
+		})
+		When("key: {scores}, exp: [team not-in [red]]", func() {
+			It("Should return the scores of other teams", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "team", Operator: "not-in", Value: []interface{}{"red"}},
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("3", "4"))
+			})
+		})
+		When("key: {scores}, exp: [tags array-contains mvp]", func() {
+			It("Should return the scores with the tag in their tags array", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "tags", Operator: "array-contains", Value: "mvp"},
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("1", "3"))
+			})
+		})
+		When("key: {scores}, exp: [tags array-contains-any [captain, rookie]]", func() {
+			It("Should return the scores with any of the tags in their tags array", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "tags", Operator: "array-contains-any", Value: []interface{}{"captain", "rookie"}},
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("1"))
+			})
+		})
+		When("key: {scores}, exp: [team == blue OR score < 15]", func() {
+			It("Should return the scores matching either group", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					document.Or(
+						[]document.QueryExpression{{Operand: "team", Operator: "==", Value: "blue"}},
+						[]document.QueryExpression{{Operand: "score", Operator: "<", Value: 15}},
+					),
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("1", "3", "4"))
+			})
+		})
+		When("key: {scores}, exp: [team == red AND (score > 15 OR tags array-contains captain)]", func() {
+			It("Should return the scores matching the enclosing expression and either group", func() {
+				LoadScoresData(docPlugin)
+
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "team", Operator: "==", Value: "red"},
+					document.Or(
+						[]document.QueryExpression{{Operand: "score", Operator: ">", Value: 15}},
+						[]document.QueryExpression{{Operand: "tags", Operator: "array-contains", Value: "captain"}},
+					),
+				}, 0, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(documentIds(result.Documents)).To(ConsistOf("1", "2"))
+			})
+		})
+		When("key: {scores}, exp: [team == red OR team == blue], limit: 2", func() {
+			It("Should page through the scores matching either group once", func() {
+				LoadScoresData(docPlugin)
+
+				exps := []document.QueryExpression{
+					document.Or(
+						[]document.QueryExpression{{Operand: "team", Operator: "==", Value: "red"}},
+						[]document.QueryExpression{{Operand: "team", Operator: "==", Value: "blue"}},
+					),
+				}
+
+				ids := make([]string, 0)
+				var pagingToken map[string]string
+				for pages := 0; pages < 10; pages++ {
+					result, err := docPlugin.Query(&ScoresColl, exps, 2, pagingToken)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(len(result.Documents)).To(BeNumerically("<=", 2))
+
+					ids = append(ids, documentIds(result.Documents)...)
+					if len(result.PagingToken) == 0 {
+						break
+					}
+					pagingToken = result.PagingToken
+				}
+
+				Expect(ids).To(ConsistOf("1", "2", "3", "4"))
+			})
+		})
+		When("Invalid - or expression with an empty group", func() {
+			It("Should return an error", func() {
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					document.Or([]document.QueryExpression{{Operand: "team", Operator: "==", Value: "red"}}, []document.QueryExpression{}),
+				}, 0, nil)
+				Expect(result).To(BeNil())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		When("Invalid - in without a list value", func() {
+			It("Should return an error", func() {
+				result, err := docPlugin.Query(&ScoresColl, []document.QueryExpression{
+					{Operand: "team", Operator: "in", Value: "red"},
+				}, 0, nil)
+				Expect(result).To(BeNil())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
 	})
 }
+
+func documentIds(docs []document.Document) []string {
+	ids := make([]string, 0, len(docs))
+	for _, d := range docs {
+		ids = append(ids, d.Key.Id)
+	}
+
+	return ids
+}
@@ -441,5 +441,23 @@ func QueryStreamTests(docPlugin document.DocumentService) {
 				Expect(docs).To(HaveLen(10))
 			})
 		})
+		When("key: {scores}, exp: [team == red OR tags array-contains mvp]", func() {
+			It("Should return the scores matching both groups once", func() {
+				LoadScoresData(docPlugin)
+
+				iter := docPlugin.QueryStream(&ScoresColl, []document.QueryExpression{
+					document.Or(
+						[]document.QueryExpression{{Operand: "team", Operator: "==", Value: "red"}},
+						[]document.QueryExpression{{Operand: "tags", Operator: "array-contains", Value: "mvp"}},
+					),
+				}, 0)
+				ids := make([]string, 0)
+				for _, d := range unwrapIter(iter) {
+					ids = append(ids, d.Key.Id)
+				}
+
+				Expect(ids).To(ConsistOf("1", "2", "3"))
+			})
+		})
 	})
 }
@@ -296,6 +296,30 @@ var ChildItemsCollection = document.Collection{
 	},
 }
 
+// Simple 'scores' collection test data, with numeric fields for aggregation and tags for array queries.
+// The tags of score 2 aren't an array, so array queries shouldn't match them
+
+var ScoresColl = document.Collection{Name: "scores"}
+
+var Scores = []Item{
+	{
+		Key:     document.Key{Collection: &ScoresColl, Id: "1"},
+		Content: map[string]interface{}{"team": "red", "score": 10, "tags": []interface{}{"mvp", "captain"}},
+	},
+	{
+		Key:     document.Key{Collection: &ScoresColl, Id: "2"},
+		Content: map[string]interface{}{"team": "red", "score": 20, "tags": "mvp"},
+	},
+	{
+		Key:     document.Key{Collection: &ScoresColl, Id: "3"},
+		Content: map[string]interface{}{"team": "blue", "score": 30, "tags": []interface{}{"mvp"}},
+	},
+	{
+		Key:     document.Key{Collection: &ScoresColl, Id: "4"},
+		Content: map[string]interface{}{"team": "blue"},
+	},
+}
+
 // Test Data Loading Functions ------------------------------------------------
 
 func LoadUsersData(docPlugin document.DocumentService) {
@@ -315,6 +339,12 @@ func LoadCustomersData(docPlugin document.DocumentService) {
 	utils.Must(docPlugin.Set(&Customer2.Orders[1].Key, Customer2.Orders[1].Content))
 }
 
+func LoadScoresData(docPlugin document.DocumentService) {
+	for _, item := range Scores {
+		utils.Must(docPlugin.Set(&item.Key, item.Content))
+	}
+}
+
 func LoadItemsData(docPlugin document.DocumentService) {
 	for _, item := range Items {
 		utils.Must(docPlugin.Set(&item.Key, item.Content))